@@ -0,0 +1,116 @@
+// build_breached_filter converts a Pwned Passwords SHA-1 dump ("HASH:COUNT" per line)
+// into the compact Bloom filter file loaded via BREACHED_PASSWORD_FILTER_PATH.
+//
+// Usage:
+//
+//	go run ./cmd/build_breached_filter -in pwned-passwords-sha1-ordered-by-count.txt -out breached.bpf
+package main
+
+import (
+	"bufio"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"backend-gin/utils"
+)
+
+func main() {
+	in := flag.String("in", "", "Path to Pwned Passwords SHA-1 dump (HASH:COUNT per line)")
+	out := flag.String("out", "breached_passwords.bpf", "Output filter file")
+	fpRate := flag.Float64("fp-rate", 0.001, "Target false positive rate")
+	minCount := flag.Int("min-count", 1, "Skip hashes seen fewer than this many times in breaches")
+	expected := flag.Uint64("expected", 0, "Expected number of entries (0 = count input in a first pass)")
+	flag.Parse()
+
+	if *in == "" {
+		log.Fatal("-in is required")
+	}
+
+	n := *expected
+	if n == 0 {
+		counted, err := countEntries(*in, *minCount)
+		if err != nil {
+			log.Fatalf("Failed to count entries: %v", err)
+		}
+		n = counted
+		log.Printf("Counted %d entries with count >= %d", n, *minCount)
+	}
+	if n == 0 {
+		log.Fatal("No entries to add")
+	}
+
+	filter, err := utils.NewBreachedPasswordFilter(n, *fpRate)
+	if err != nil {
+		log.Fatalf("Failed to create filter: %v", err)
+	}
+
+	skipped, err := forEachEntry(*in, *minCount, filter.AddSHA1)
+	if err != nil {
+		log.Fatalf("Failed to read input: %v", err)
+	}
+
+	// Write to a temp file first so a running server never loads a half-written filter
+	tmp, err := os.CreateTemp(filepath.Dir(*out), ".breached-*.tmp")
+	if err != nil {
+		log.Fatalf("Failed to create output: %v", err)
+	}
+	if _, err := filter.WriteTo(tmp); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		log.Fatalf("Failed to write filter: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		log.Fatalf("Failed to close output: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), *out); err != nil {
+		_ = os.Remove(tmp.Name())
+		log.Fatalf("Failed to move output into place: %v", err)
+	}
+
+	log.Printf("Wrote %s: %d entries (%d malformed lines skipped)", *out, filter.Count(), skipped)
+}
+
+// countEntries counts valid lines meeting minCount
+func countEntries(path string, minCount int) (uint64, error) {
+	var n uint64
+	_, err := forEachEntry(path, minCount, func([20]byte) { n++ })
+	return n, err
+}
+
+// forEachEntry streams the dump and calls fn for each valid entry meeting minCount.
+// Returns the number of malformed lines skipped.
+func forEachEntry(path string, minCount int, fn func([20]byte)) (int, error) {
+	file, err := os.Open(path) // #nosec G304 -- operator-supplied path
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	return scanEntries(file, minCount, fn)
+}
+
+func scanEntries(r io.Reader, minCount int, fn func([20]byte)) (int, error) {
+	skipped := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		digest, count, err := utils.ParsePwnedPasswordsLine(line)
+		if err != nil {
+			skipped++
+			continue
+		}
+		// Bare hashes (no count) are always included
+		if count != 0 && count < minCount {
+			continue
+		}
+		fn(digest)
+	}
+	return skipped, scanner.Err()
+}
@@ -13,6 +13,9 @@ import (
 
 	"backend-gin/ent"
 	entadmin "backend-gin/ent/admin"
+	"backend-gin/ent/adminrole"
+	"backend-gin/middleware"
+	"backend-gin/services"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -24,15 +27,34 @@ func main() {
 	email := flag.String("email", "", "Admin email address (required)")
 	password := flag.String("password", "", "Admin password (required)")
 	name := flag.String("name", "", "Admin display name (required)")
+	roles := flag.String("roles", middleware.AdminRoleSuperAdmin, "Comma-separated role names to assign")
+	permissions := flag.String("permissions", "", "Comma-separated extra permissions, granted through a personal role")
+	resetMFA := flag.Bool("reset-mfa", false, "Remove TOTP and passkeys from an existing admin (recovery; only --email is needed)")
 	flag.Parse()
 
+	if *resetMFA {
+		resetAdminMFA(*email)
+		return
+	}
+
 	// Validate required flags
 	if *email == "" || *password == "" || *name == "" {
-		fmt.Println("Usage: go run cmd/seed_admin/main.go --email=admin@example.com --password=secret --name=\"Admin Name\"")
-		fmt.Println("\nAll flags are required:")
-		fmt.Println("  --email     Admin email address")
-		fmt.Println("  --password  Admin password (min 8 characters)")
-		fmt.Println("  --name      Admin display name")
+		fmt.Println("Usage: go run cmd/seed_admin/main.go --email=admin@example.com --password=secret --name=\"Admin Name\" [--roles=support] [--permissions=cases:move]")
+		fmt.Println("\nRequired flags:")
+		fmt.Println("  --email        Admin email address")
+		fmt.Println("  --password     Admin password (min 8 characters)")
+		fmt.Println("  --name         Admin display name")
+		fmt.Println("\nOptional flags:")
+		fmt.Println("  --roles        Comma-separated roles (default: super_admin; use --roles= for none)")
+		fmt.Println("  --permissions  Comma-separated extra permissions for a scoped admin")
+		fmt.Println("\nRecovery:")
+		fmt.Println("  --reset-mfa --email=admin@example.com  Remove the admin's second factors and revoke their sessions")
+		fmt.Println("\nBuilt-in roles:")
+		for _, r := range middleware.BuiltinAdminRoles {
+			fmt.Printf("  %-13s %s\n", r.Name, strings.Join(r.Permissions, ", "))
+		}
+		fmt.Println("\nPermissions:")
+		fmt.Printf("  %s\n", strings.Join(middleware.AdminPermissions, ", "))
 		os.Exit(1)
 	}
 
@@ -40,6 +62,17 @@ func main() {
 		log.Fatal("Password must be at least 8 characters")
 	}
 
+	roleNames := splitCSV(*roles)
+	extraPermissions := splitCSV(*permissions)
+	for _, p := range extraPermissions {
+		if !middleware.IsValidAdminPermission(p) {
+			log.Fatalf("Unknown permission %q (valid: %s)", p, strings.Join(middleware.AdminPermissions, ", "))
+		}
+	}
+	if len(roleNames) == 0 && len(extraPermissions) == 0 {
+		log.Fatal("Admin must have at least one role or permission")
+	}
+
 	// Load .env
 	godotenv.Load()
 
@@ -61,6 +94,20 @@ func main() {
 		log.Fatalf("Failed to create schema: %v", err)
 	}
 
+	// Built-in roles must exist before they can be assigned
+	if err := services.EnsureBuiltinAdminRoles(ctx, client); err != nil {
+		log.Fatalf("Failed to sync admin roles: %v", err)
+	}
+
+	roleIDs := make([]int, 0, len(roleNames)+1)
+	for _, roleName := range roleNames {
+		role, err := client.AdminRole.Query().Where(adminrole.NameEQ(roleName)).Only(ctx)
+		if err != nil {
+			log.Fatalf("Role %q not found: %v", roleName, err)
+		}
+		roleIDs = append(roleIDs, role.ID)
+	}
+
 	// Check if admin already exists
 	normalizedEmail := strings.ToLower(strings.TrimSpace(*email))
 	exists, err := client.Admin.Query().
@@ -79,19 +126,84 @@ func main() {
 		log.Fatalf("Failed to hash password: %v", err)
 	}
 
+	// Extra permissions live in a personal role so they show up like any other role
+	if len(extraPermissions) > 0 {
+		personal, err := client.AdminRole.Create().
+			SetName("admin:" + normalizedEmail).
+			SetDescription("Izin khusus untuk " + normalizedEmail).
+			SetPermissions(middleware.MergeAdminPermissions(extraPermissions)).
+			Save(ctx)
+		if err != nil {
+			log.Fatalf("Failed to create personal role: %v", err)
+		}
+		roleIDs = append(roleIDs, personal.ID)
+	}
+
 	// Create admin using Ent
 	admin, err := client.Admin.Create().
 		SetEmail(normalizedEmail).
 		SetPasswordHash(string(hashedPassword)).
 		SetName(*name).
+		AddRoleIDs(roleIDs...).
 		Save(ctx)
 	if err != nil {
 		log.Fatalf("Failed to create admin: %v", err)
 	}
 
+	assignedRoles, granted, err := services.AdminRolesAndPermissions(ctx, client, admin.ID)
+	if err != nil {
+		log.Fatalf("Failed to read back admin roles: %v", err)
+	}
+
 	fmt.Printf("✅ Admin created successfully!\n")
-	fmt.Printf("   ID:    %d\n", admin.ID)
-	fmt.Printf("   Email: %s\n", admin.Email)
-	fmt.Printf("   Name:  %s\n", admin.Name)
+	fmt.Printf("   ID:          %d\n", admin.ID)
+	fmt.Printf("   Email:       %s\n", admin.Email)
+	fmt.Printf("   Name:        %s\n", admin.Name)
+	fmt.Printf("   Roles:       %s\n", strings.Join(assignedRoles, ", "))
+	fmt.Printf("   Permissions: %s\n", strings.Join(granted, ", "))
 	fmt.Println("\nYou can now login at /admin/login")
 }
+
+// resetAdminMFA clears an admin's second factors so they can enroll again on next login
+func resetAdminMFA(email string) {
+	normalizedEmail := strings.ToLower(strings.TrimSpace(email))
+	if normalizedEmail == "" {
+		log.Fatal("--reset-mfa requires --email")
+	}
+
+	godotenv.Load()
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL environment variable is required")
+	}
+
+	ctx := context.Background()
+	client, err := ent.Open("postgres", dbURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer client.Close()
+
+	a, err := client.Admin.Query().Where(entadmin.EmailEQ(normalizedEmail)).Only(ctx)
+	if err != nil {
+		log.Fatalf("Admin with email '%s' not found: %v", normalizedEmail, err)
+	}
+	if err := services.ResetAdminMFA(ctx, client, a.ID); err != nil {
+		log.Fatalf("Failed to reset MFA: %v", err)
+	}
+
+	fmt.Printf("✅ MFA reset for %s\n", a.Email)
+	fmt.Println("   TOTP and passkeys removed, sessions revoked, lockout cleared.")
+	fmt.Println("   The admin must enroll a second factor on next login.")
+}
+
+// splitCSV splits a comma-separated flag value, dropping empty entries
+func splitCSV(v string) []string {
+	var out []string
+	for _, part := range strings.Split(v, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
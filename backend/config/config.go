@@ -16,6 +16,12 @@ var (
 	FeatureServiceURL         string
 	TelegramBotToken          string
 	TelegramAuthMaxAgeSeconds int64
+
+	// AdminSessionIdleMinutes revokes an admin session after this long without a request
+	AdminSessionIdleMinutes int
+
+	// EmailChangeRevertDays is how long the "revert email change" link sent to the old address stays valid
+	EmailChangeRevertDays int
 )
 
 func InitConfig() {
@@ -67,4 +73,26 @@ func InitConfig() {
 			TelegramAuthMaxAgeSeconds = parsed
 		}
 	}
+
+	// Email change revert window (link sent to the previous address).
+	EmailChangeRevertDays = 7
+	if rawDays := strings.TrimSpace(os.Getenv("EMAIL_CHANGE_REVERT_DAYS")); rawDays != "" {
+		parsed, err := strconv.Atoi(rawDays)
+		if err != nil || parsed <= 0 {
+			log.Printf("WARN: invalid EMAIL_CHANGE_REVERT_DAYS=%q, using default 7", rawDays)
+		} else {
+			EmailChangeRevertDays = parsed
+		}
+	}
+
+	// Admin session idle timeout (absolute lifetime is fixed at 8 hours).
+	AdminSessionIdleMinutes = 30
+	if rawIdle := strings.TrimSpace(os.Getenv("ADMIN_SESSION_IDLE_MINUTES")); rawIdle != "" {
+		parsed, err := strconv.Atoi(rawIdle)
+		if err != nil || parsed <= 0 {
+			log.Printf("WARN: invalid ADMIN_SESSION_IDLE_MINUTES=%q, using default 30", rawIdle)
+		} else {
+			AdminSessionIdleMinutes = parsed
+		}
+	}
 }
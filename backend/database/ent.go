@@ -340,6 +340,36 @@ func applyWorkflowCyclePreSchemaCleanup(ctx context.Context, db *sql.DB) error {
 	return tx.Commit()
 }
 
+// applyAdminAuditLogImmutability installs triggers that make admin_audit_logs append-only,
+// so rows cannot be altered even by code paths that bypass the Ent hook.
+func applyAdminAuditLogImmutability(ctx context.Context, db *sql.DB) error {
+	statements := []string{
+		`CREATE OR REPLACE FUNCTION admin_audit_logs_append_only() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'admin_audit_logs is append-only';
+		END;
+		$$ LANGUAGE plpgsql`,
+		`DROP TRIGGER IF EXISTS admin_audit_logs_no_modify ON admin_audit_logs`,
+		`CREATE TRIGGER admin_audit_logs_no_modify BEFORE UPDATE OR DELETE ON admin_audit_logs
+			FOR EACH ROW EXECUTE FUNCTION admin_audit_logs_append_only()`,
+		`DROP TRIGGER IF EXISTS admin_audit_logs_no_truncate ON admin_audit_logs`,
+		`CREATE TRIGGER admin_audit_logs_no_truncate BEFORE TRUNCATE ON admin_audit_logs
+			FOR EACH STATEMENT EXECUTE FUNCTION admin_audit_logs_append_only()`,
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 // InitEntDB initializes the Ent client and SQL DB connection.
 func InitEntDB() {
 	// Prefer DATABASE_URL (recommended for Neon)
@@ -445,6 +475,12 @@ func InitEntDB() {
 		logger.Fatal("Failed to apply workflow cycle migrations", zap.Error(err))
 	}
 
+	auditCtx, auditCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer auditCancel()
+	if err := applyAdminAuditLogImmutability(auditCtx, db); err != nil {
+		logger.Fatal("Failed to protect admin audit log", zap.Error(err))
+	}
+
 	logger.Info("Ent database initialized successfully (PGX Simple Protocol)")
 }
 
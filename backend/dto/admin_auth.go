@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AdminLoginRequest is the password step of admin login
+type AdminLoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+// AdminLoginTOTPRequest completes an admin login challenge with a TOTP code
+type AdminLoginTOTPRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// AdminMFATokenRequest identifies an admin login challenge
+type AdminMFATokenRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+}
+
+// AdminLoginPasskeyFinishRequest completes an admin login challenge with a passkey
+type AdminLoginPasskeyFinishRequest struct {
+	MFAToken   string          `json:"mfa_token" binding:"required"`
+	Credential json.RawMessage `json:"credential" binding:"required"`
+}
+
+// AdminMFAChallengeResponse is returned when the password is correct and a second factor is required
+type AdminMFAChallengeResponse struct {
+	MFARequired bool     `json:"mfa_required"`
+	MFAToken    string   `json:"mfa_token"`
+	MFAMethods  []string `json:"mfa_methods"`
+	ExpiresIn   int      `json:"expires_in"`
+}
+
+// AdminInfo describes the signed-in admin
+type AdminInfo struct {
+	ID          int      `json:"id"`
+	Email       string   `json:"email"`
+	Name        string   `json:"name"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+}
+
+// AdminLoginResponse carries the admin session token
+type AdminLoginResponse struct {
+	Token                 string    `json:"token"`
+	ExpiresAt             time.Time `json:"expires_at"`
+	Admin                 AdminInfo `json:"admin"`
+	MFAEnrollmentRequired bool      `json:"mfa_enrollment_required"`
+}
+
+// AdminTOTPCodeRequest carries a TOTP code (enrollment)
+type AdminTOTPCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// AdminPasskeyRegisterFinishRequest completes admin passkey registration
+type AdminPasskeyRegisterFinishRequest struct {
+	Name       string          `json:"name" binding:"max=64"`
+	Credential json.RawMessage `json:"credential" binding:"required"`
+}
+
+// AdminSudoRequest enters admin sudo with password and TOTP
+type AdminSudoRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// AdminSudoPasskeyRequest enters admin sudo with password and a passkey assertion
+type AdminSudoPasskeyRequest struct {
+	Password   string          `json:"password" binding:"required"`
+	Credential json.RawMessage `json:"credential" binding:"required"`
+}
+
+// AdminMFAStatusResponse lists the admin's second factors
+type AdminMFAStatusResponse struct {
+	TOTPEnabled bool              `json:"totp_enabled"`
+	Passkeys    []PasskeyResponse `json:"passkeys"`
+}
+
+// AdminSessionResponse is one of the admin's active sessions
+type AdminSessionResponse struct {
+	ID             int        `json:"id"`
+	IPAddress      string     `json:"ip_address"`
+	UserAgent      string     `json:"user_agent"`
+	MFAMethod      string     `json:"mfa_method"`
+	Current        bool       `json:"current"`
+	CreatedAt      time.Time  `json:"created_at"`
+	LastActivityAt time.Time  `json:"last_activity_at"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	SudoUntil      *time.Time `json:"sudo_until,omitempty"`
+}
@@ -0,0 +1,77 @@
+package dto
+
+import "time"
+
+// AdminModerationRequest carries the mandatory reason for an admin moderation action
+type AdminModerationRequest struct {
+	Reason string `json:"reason" binding:"required,min=5,max=500"`
+}
+
+// AdminLockUserRequest locks a user account. Without an end time the lock lasts until
+// an admin unlocks it.
+type AdminLockUserRequest struct {
+	Reason        string     `json:"reason" binding:"required,min=5,max=500"`
+	Until         *time.Time `json:"until"`
+	DurationHours int        `json:"duration_hours" binding:"omitempty,min=1,max=8760"`
+}
+
+// AdminBlockDevicesRequest blocks some or (when empty) all of a user's devices
+type AdminBlockDevicesRequest struct {
+	Reason            string   `json:"reason" binding:"required,min=5,max=500"`
+	FingerprintHashes []string `json:"fingerprint_hashes" binding:"omitempty,max=50,dive,required,max=128"`
+}
+
+// AdminResetTOTPRequest resets a user's TOTP. IdentityCheck records how the user's
+// identity was confirmed out of band (ticket, call, document check).
+type AdminResetTOTPRequest struct {
+	Reason        string `json:"reason" binding:"required,min=5,max=500"`
+	IdentityCheck string `json:"identity_check" binding:"required,min=5,max=500"`
+}
+
+// SecurityEventResponse is one entry of a user's security history
+type SecurityEventResponse struct {
+	ID        int       `json:"id"`
+	EventType string    `json:"event_type"`
+	UserID    *int      `json:"user_id,omitempty"`
+	Email     string    `json:"email,omitempty"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	Success   bool      `json:"success"`
+	Severity  string    `json:"severity"`
+	Details   string    `json:"details,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AdminLockClusterRequest locks accounts from a user's linkage cluster. UserIDs must be
+// members of the cluster computed with the same depth and include_ips settings.
+type AdminLockClusterRequest struct {
+	Reason        string     `json:"reason" binding:"required,min=5,max=500"`
+	UserIDs       []int      `json:"user_ids" binding:"required,min=1,max=250,dive,min=1"`
+	Depth         int        `json:"depth" binding:"omitempty,min=1,max=4"`
+	IncludeIPs    *bool      `json:"include_ips"`
+	Until         *time.Time `json:"until"`
+	DurationHours int        `json:"duration_hours" binding:"omitempty,min=1,max=8760"`
+}
+
+// AdminImpersonateRequest issues a read-only "view as user" token. Without a duration the
+// token is valid for 15 minutes.
+type AdminImpersonateRequest struct {
+	Reason          string `json:"reason" binding:"required,min=5,max=500"`
+	DurationMinutes int    `json:"duration_minutes" binding:"omitempty,min=1,max=60"`
+}
+
+// ImpersonationSessionResponse describes an impersonation grant (never the token itself)
+type ImpersonationSessionResponse struct {
+	ID               int        `json:"id"`
+	AdminID          int        `json:"admin_id"`
+	AdminEmail       string     `json:"admin_email"`
+	UserID           int        `json:"user_id"`
+	Reason           string     `json:"reason"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	RevokedByAdminID *int       `json:"revoked_by_admin_id,omitempty"`
+	LastUsedAt       *time.Time `json:"last_used_at,omitempty"`
+	RequestCount     int        `json:"request_count"`
+	Active           bool       `json:"active"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
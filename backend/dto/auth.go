@@ -86,3 +86,25 @@ type TOTPStatusResponse struct {
 	Enabled    bool    `json:"enabled"`
 	VerifiedAt *string `json:"verified_at,omitempty"` // ISO8601 timestamp
 }
+
+// ============ Account Credential DTOs ============
+
+// ChangePasswordRequest for changing password while logged in (sudo required)
+type ChangePasswordRequest struct {
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// ChangeEmailRequest for starting an email change (sudo required)
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" binding:"required"`
+}
+
+// EmailChangeTokenRequest for confirming or reverting an email change
+type EmailChangeTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// KillSwitchRequest carries the signed "this wasn't me" token from a login alert email
+type KillSwitchRequest struct {
+	Token string `json:"token" binding:"required"`
+}
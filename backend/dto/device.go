@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// DeviceResponse represents one of the caller's devices in API responses
+type DeviceResponse struct {
+	ID             uint       `json:"id"`
+	Name           string     `json:"name,omitempty"`
+	Browser        string     `json:"browser"`
+	OS             string     `json:"os"`
+	DeviceType     string     `json:"device_type"`
+	LastIP         string     `json:"last_ip,omitempty"`
+	City           string     `json:"city,omitempty"`
+	Country        string     `json:"country,omitempty"`
+	CountryCode    string     `json:"country_code,omitempty"`
+	Trusted        bool       `json:"trusted"`
+	TrustedAt      *time.Time `json:"trusted_at,omitempty"`
+	Current        bool       `json:"current"`
+	ActiveSessions int        `json:"active_sessions"`
+	FirstSeenAt    time.Time  `json:"first_seen_at"`
+	LastSeenAt     time.Time  `json:"last_seen_at"`
+}
+
+// DeviceListResponse is the response for listing devices
+type DeviceListResponse struct {
+	Devices []DeviceResponse `json:"devices"`
+	Count   int              `json:"count"`
+}
+
+// DeviceRenameRequest is for naming a device
+type DeviceRenameRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=64"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// CreateIPRuleRequest adds a rate limiter allow/block rule. CIDR accepts a single address
+// or a range; without ExpiresAt the rule is permanent.
+type CreateIPRuleRequest struct {
+	CIDR      string     `json:"cidr" binding:"required,max=64"`
+	Action    string     `json:"action" binding:"required,oneof=allow block"`
+	Reason    string     `json:"reason" binding:"required,min=5,max=255"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// UpdateIPRuleRequest changes a rule's reason or expiry; Permanent removes the expiry
+type UpdateIPRuleRequest struct {
+	Reason    *string    `json:"reason" binding:"omitempty,min=5,max=255"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Permanent bool       `json:"permanent"`
+}
+
+// IPRuleResponse is one IP rule
+type IPRuleResponse struct {
+	ID               int        `json:"id"`
+	CIDR             string     `json:"cidr"`
+	Action           string     `json:"action"`
+	Reason           string     `json:"reason"`
+	Source           string     `json:"source"`
+	CreatedByAdminID *int       `json:"created_by_admin_id,omitempty"`
+	CreatedByEmail   string     `json:"created_by_email,omitempty"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty"`
+	Active           bool       `json:"active"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
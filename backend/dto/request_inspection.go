@@ -0,0 +1,6 @@
+package dto
+
+// MarkFalsePositiveRequest records why a request inspection hit was legitimate input
+type MarkFalsePositiveRequest struct {
+	Note string `json:"note" binding:"required,min=5,max=500"`
+}
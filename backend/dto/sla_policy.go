@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// SLAPolicyRequest creates or replaces an owner-response SLA policy. An empty
+// sensitivity_level or category_id matches every case.
+type SLAPolicyRequest struct {
+	Name                 string `json:"name" binding:"required,max=100"`
+	SensitivityLevel     string `json:"sensitivity_level" binding:"omitempty,oneof=S0 S1 S2 S3 s0 s1 s2 s3"`
+	CategoryID           *int   `json:"category_id"`
+	OwnerResponseHours   int    `json:"owner_response_hours" binding:"required,min=1"`
+	ReminderHours        []int  `json:"reminder_hours"`
+	BusinessHoursOnly    bool   `json:"business_hours_only"`
+	BusinessDayStartHour *int   `json:"business_day_start_hour"`
+	BusinessDayEndHour   *int   `json:"business_day_end_hour"`
+	Timezone             string `json:"timezone" binding:"max=64"`
+	IsActive             *bool  `json:"is_active"`
+}
+
+// SLAPolicyResponse is one SLA policy
+type SLAPolicyResponse struct {
+	ID                   int       `json:"id"`
+	Name                 string    `json:"name"`
+	SensitivityLevel     string    `json:"sensitivity_level"`
+	CategoryID           *int      `json:"category_id"`
+	OwnerResponseHours   int       `json:"owner_response_hours"`
+	ReminderHours        []int     `json:"reminder_hours"`
+	BusinessHoursOnly    bool      `json:"business_hours_only"`
+	BusinessDayStartHour int       `json:"business_day_start_hour"`
+	BusinessDayEndHour   int       `json:"business_day_end_hour"`
+	Timezone             string    `json:"timezone"`
+	IsActive             bool      `json:"is_active"`
+	CreatedByAdminID     *int      `json:"created_by_admin_id,omitempty"`
+	UpdatedByAdminID     *int      `json:"updated_by_admin_id,omitempty"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
@@ -29,6 +29,20 @@ type Admin struct {
 	PasswordHash string `json:"-"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpEnabled holds the value of the "totp_enabled" field.
+	TotpEnabled bool `json:"totp_enabled,omitempty"`
+	// TotpEnabledAt holds the value of the "totp_enabled_at" field.
+	TotpEnabledAt *time.Time `json:"totp_enabled_at,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// FailedLoginAttempts holds the value of the "failed_login_attempts" field.
+	FailedLoginAttempts int `json:"failed_login_attempts,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// LastLoginAt holds the value of the "last_login_at" field.
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AdminQuery when eager-loading is set.
 	Edges        AdminEdges `json:"edges"`
@@ -39,9 +53,15 @@ type Admin struct {
 type AdminEdges struct {
 	// GrantedBadges holds the value of the granted_badges edge.
 	GrantedBadges []*UserBadge `json:"granted_badges,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*AdminRole `json:"roles,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*AdminSession `json:"sessions,omitempty"`
+	// Passkeys holds the value of the passkeys edge.
+	Passkeys []*AdminPasskey `json:"passkeys,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // GrantedBadgesOrErr returns the GrantedBadges value or an error if the edge
@@ -53,16 +73,45 @@ func (e AdminEdges) GrantedBadgesOrErr() ([]*UserBadge, error) {
 	return nil, &NotLoadedError{edge: "granted_badges"}
 }
 
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e AdminEdges) RolesOrErr() ([]*AdminRole, error) {
+	if e.loadedTypes[1] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AdminEdges) SessionsOrErr() ([]*AdminSession, error) {
+	if e.loadedTypes[2] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// PasskeysOrErr returns the Passkeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e AdminEdges) PasskeysOrErr() ([]*AdminPasskey, error) {
+	if e.loadedTypes[3] {
+		return e.Passkeys, nil
+	}
+	return nil, &NotLoadedError{edge: "passkeys"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Admin) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case admin.FieldID:
+		case admin.FieldTotpEnabled:
+			values[i] = new(sql.NullBool)
+		case admin.FieldID, admin.FieldTotpLastStep, admin.FieldFailedLoginAttempts:
 			values[i] = new(sql.NullInt64)
-		case admin.FieldEmail, admin.FieldPasswordHash, admin.FieldName:
+		case admin.FieldEmail, admin.FieldPasswordHash, admin.FieldName, admin.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case admin.FieldCreatedAt, admin.FieldUpdatedAt, admin.FieldDeletedAt:
+		case admin.FieldCreatedAt, admin.FieldUpdatedAt, admin.FieldDeletedAt, admin.FieldTotpEnabledAt, admin.FieldLockedUntil, admin.FieldLastLoginAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -122,6 +171,52 @@ func (_m *Admin) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case admin.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = new(string)
+				*_m.TotpSecret = value.String
+			}
+		case admin.FieldTotpEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled", values[i])
+			} else if value.Valid {
+				_m.TotpEnabled = value.Bool
+			}
+		case admin.FieldTotpEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled_at", values[i])
+			} else if value.Valid {
+				_m.TotpEnabledAt = new(time.Time)
+				*_m.TotpEnabledAt = value.Time
+			}
+		case admin.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastStep = value.Int64
+			}
+		case admin.FieldFailedLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_attempts", values[i])
+			} else if value.Valid {
+				_m.FailedLoginAttempts = int(value.Int64)
+			}
+		case admin.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
+		case admin.FieldLastLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
+			} else if value.Valid {
+				_m.LastLoginAt = new(time.Time)
+				*_m.LastLoginAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -140,6 +235,21 @@ func (_m *Admin) QueryGrantedBadges() *UserBadgeQuery {
 	return NewAdminClient(_m.config).QueryGrantedBadges(_m)
 }
 
+// QueryRoles queries the "roles" edge of the Admin entity.
+func (_m *Admin) QueryRoles() *AdminRoleQuery {
+	return NewAdminClient(_m.config).QueryRoles(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the Admin entity.
+func (_m *Admin) QuerySessions() *AdminSessionQuery {
+	return NewAdminClient(_m.config).QuerySessions(_m)
+}
+
+// QueryPasskeys queries the "passkeys" edge of the Admin entity.
+func (_m *Admin) QueryPasskeys() *AdminPasskeyQuery {
+	return NewAdminClient(_m.config).QueryPasskeys(_m)
+}
+
 // Update returns a builder for updating this Admin.
 // Note that you need to call Admin.Unwrap() before calling this method if this Admin
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -181,6 +291,32 @@ func (_m *Admin) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpEnabled))
+	builder.WriteString(", ")
+	if v := _m.TotpEnabledAt; v != nil {
+		builder.WriteString("totp_enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("failed_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedLoginAttempts))
+	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastLoginAt; v != nil {
+		builder.WriteString("last_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
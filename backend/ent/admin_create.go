@@ -4,6 +4,9 @@ package ent
 
 import (
 	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminrole"
+	"backend-gin/ent/adminsession"
 	"backend-gin/ent/userbadge"
 	"context"
 	"errors"
@@ -81,6 +84,104 @@ func (_c *AdminCreate) SetName(v string) *AdminCreate {
 	return _c
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (_c *AdminCreate) SetTotpSecret(v string) *AdminCreate {
+	_c.mutation.SetTotpSecret(v)
+	return _c
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableTotpSecret(v *string) *AdminCreate {
+	if v != nil {
+		_c.SetTotpSecret(*v)
+	}
+	return _c
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_c *AdminCreate) SetTotpEnabled(v bool) *AdminCreate {
+	_c.mutation.SetTotpEnabled(v)
+	return _c
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableTotpEnabled(v *bool) *AdminCreate {
+	if v != nil {
+		_c.SetTotpEnabled(*v)
+	}
+	return _c
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (_c *AdminCreate) SetTotpEnabledAt(v time.Time) *AdminCreate {
+	_c.mutation.SetTotpEnabledAt(v)
+	return _c
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableTotpEnabledAt(v *time.Time) *AdminCreate {
+	if v != nil {
+		_c.SetTotpEnabledAt(*v)
+	}
+	return _c
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_c *AdminCreate) SetTotpLastStep(v int64) *AdminCreate {
+	_c.mutation.SetTotpLastStep(v)
+	return _c
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableTotpLastStep(v *int64) *AdminCreate {
+	if v != nil {
+		_c.SetTotpLastStep(*v)
+	}
+	return _c
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (_c *AdminCreate) SetFailedLoginAttempts(v int) *AdminCreate {
+	_c.mutation.SetFailedLoginAttempts(v)
+	return _c
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableFailedLoginAttempts(v *int) *AdminCreate {
+	if v != nil {
+		_c.SetFailedLoginAttempts(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *AdminCreate) SetLockedUntil(v time.Time) *AdminCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableLockedUntil(v *time.Time) *AdminCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_c *AdminCreate) SetLastLoginAt(v time.Time) *AdminCreate {
+	_c.mutation.SetLastLoginAt(v)
+	return _c
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_c *AdminCreate) SetNillableLastLoginAt(v *time.Time) *AdminCreate {
+	if v != nil {
+		_c.SetLastLoginAt(*v)
+	}
+	return _c
+}
+
 // AddGrantedBadgeIDs adds the "granted_badges" edge to the UserBadge entity by IDs.
 func (_c *AdminCreate) AddGrantedBadgeIDs(ids ...int) *AdminCreate {
 	_c.mutation.AddGrantedBadgeIDs(ids...)
@@ -96,6 +197,51 @@ func (_c *AdminCreate) AddGrantedBadges(v ...*UserBadge) *AdminCreate {
 	return _c.AddGrantedBadgeIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the AdminRole entity by IDs.
+func (_c *AdminCreate) AddRoleIDs(ids ...int) *AdminCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the AdminRole entity.
+func (_c *AdminCreate) AddRoles(v ...*AdminRole) *AdminCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the AdminSession entity by IDs.
+func (_c *AdminCreate) AddSessionIDs(ids ...int) *AdminCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the AdminSession entity.
+func (_c *AdminCreate) AddSessions(v ...*AdminSession) *AdminCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddPasskeyIDs adds the "passkeys" edge to the AdminPasskey entity by IDs.
+func (_c *AdminCreate) AddPasskeyIDs(ids ...int) *AdminCreate {
+	_c.mutation.AddPasskeyIDs(ids...)
+	return _c
+}
+
+// AddPasskeys adds the "passkeys" edges to the AdminPasskey entity.
+func (_c *AdminCreate) AddPasskeys(v ...*AdminPasskey) *AdminCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPasskeyIDs(ids...)
+}
+
 // Mutation returns the AdminMutation object of the builder.
 func (_c *AdminCreate) Mutation() *AdminMutation {
 	return _c.mutation
@@ -139,6 +285,18 @@ func (_c *AdminCreate) defaults() {
 		v := admin.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		v := admin.DefaultTotpEnabled
+		_c.mutation.SetTotpEnabled(v)
+	}
+	if _, ok := _c.mutation.TotpLastStep(); !ok {
+		v := admin.DefaultTotpLastStep
+		_c.mutation.SetTotpLastStep(v)
+	}
+	if _, ok := _c.mutation.FailedLoginAttempts(); !ok {
+		v := admin.DefaultFailedLoginAttempts
+		_c.mutation.SetFailedLoginAttempts(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -173,6 +331,15 @@ func (_c *AdminCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Admin.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.TotpEnabled(); !ok {
+		return &ValidationError{Name: "totp_enabled", err: errors.New(`ent: missing required field "Admin.totp_enabled"`)}
+	}
+	if _, ok := _c.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "Admin.totp_last_step"`)}
+	}
+	if _, ok := _c.mutation.FailedLoginAttempts(); !ok {
+		return &ValidationError{Name: "failed_login_attempts", err: errors.New(`ent: missing required field "Admin.failed_login_attempts"`)}
+	}
 	return nil
 }
 
@@ -223,6 +390,34 @@ func (_c *AdminCreate) createSpec() (*Admin, *sqlgraph.CreateSpec) {
 		_spec.SetField(admin.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.TotpSecret(); ok {
+		_spec.SetField(admin.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = &value
+	}
+	if value, ok := _c.mutation.TotpEnabled(); ok {
+		_spec.SetField(admin.FieldTotpEnabled, field.TypeBool, value)
+		_node.TotpEnabled = value
+	}
+	if value, ok := _c.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(admin.FieldTotpEnabledAt, field.TypeTime, value)
+		_node.TotpEnabledAt = &value
+	}
+	if value, ok := _c.mutation.TotpLastStep(); ok {
+		_spec.SetField(admin.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := _c.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(admin.FieldFailedLoginAttempts, field.TypeInt, value)
+		_node.FailedLoginAttempts = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(admin.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.LastLoginAt(); ok {
+		_spec.SetField(admin.FieldLastLoginAt, field.TypeTime, value)
+		_node.LastLoginAt = &value
+	}
 	if nodes := _c.mutation.GrantedBadgesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -239,6 +434,54 @@ func (_c *AdminCreate) createSpec() (*Admin, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PasskeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,9 @@ package ent
 
 import (
 	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminrole"
+	"backend-gin/ent/adminsession"
 	"backend-gin/ent/predicate"
 	"backend-gin/ent/userbadge"
 	"context"
@@ -97,6 +100,142 @@ func (_u *AdminUpdate) SetNillableName(v *string) *AdminUpdate {
 	return _u
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *AdminUpdate) SetTotpSecret(v string) *AdminUpdate {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableTotpSecret(v *string) *AdminUpdate {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *AdminUpdate) ClearTotpSecret() *AdminUpdate {
+	_u.mutation.ClearTotpSecret()
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *AdminUpdate) SetTotpEnabled(v bool) *AdminUpdate {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableTotpEnabled(v *bool) *AdminUpdate {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (_u *AdminUpdate) SetTotpEnabledAt(v time.Time) *AdminUpdate {
+	_u.mutation.SetTotpEnabledAt(v)
+	return _u
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableTotpEnabledAt(v *time.Time) *AdminUpdate {
+	if v != nil {
+		_u.SetTotpEnabledAt(*v)
+	}
+	return _u
+}
+
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (_u *AdminUpdate) ClearTotpEnabledAt() *AdminUpdate {
+	_u.mutation.ClearTotpEnabledAt()
+	return _u
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_u *AdminUpdate) SetTotpLastStep(v int64) *AdminUpdate {
+	_u.mutation.ResetTotpLastStep()
+	_u.mutation.SetTotpLastStep(v)
+	return _u
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableTotpLastStep(v *int64) *AdminUpdate {
+	if v != nil {
+		_u.SetTotpLastStep(*v)
+	}
+	return _u
+}
+
+// AddTotpLastStep adds value to the "totp_last_step" field.
+func (_u *AdminUpdate) AddTotpLastStep(v int64) *AdminUpdate {
+	_u.mutation.AddTotpLastStep(v)
+	return _u
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (_u *AdminUpdate) SetFailedLoginAttempts(v int) *AdminUpdate {
+	_u.mutation.ResetFailedLoginAttempts()
+	_u.mutation.SetFailedLoginAttempts(v)
+	return _u
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableFailedLoginAttempts(v *int) *AdminUpdate {
+	if v != nil {
+		_u.SetFailedLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedLoginAttempts adds value to the "failed_login_attempts" field.
+func (_u *AdminUpdate) AddFailedLoginAttempts(v int) *AdminUpdate {
+	_u.mutation.AddFailedLoginAttempts(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *AdminUpdate) SetLockedUntil(v time.Time) *AdminUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableLockedUntil(v *time.Time) *AdminUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *AdminUpdate) ClearLockedUntil() *AdminUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *AdminUpdate) SetLastLoginAt(v time.Time) *AdminUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *AdminUpdate) SetNillableLastLoginAt(v *time.Time) *AdminUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *AdminUpdate) ClearLastLoginAt() *AdminUpdate {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
 // AddGrantedBadgeIDs adds the "granted_badges" edge to the UserBadge entity by IDs.
 func (_u *AdminUpdate) AddGrantedBadgeIDs(ids ...int) *AdminUpdate {
 	_u.mutation.AddGrantedBadgeIDs(ids...)
@@ -112,6 +251,51 @@ func (_u *AdminUpdate) AddGrantedBadges(v ...*UserBadge) *AdminUpdate {
 	return _u.AddGrantedBadgeIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the AdminRole entity by IDs.
+func (_u *AdminUpdate) AddRoleIDs(ids ...int) *AdminUpdate {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the AdminRole entity.
+func (_u *AdminUpdate) AddRoles(v ...*AdminRole) *AdminUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the AdminSession entity by IDs.
+func (_u *AdminUpdate) AddSessionIDs(ids ...int) *AdminUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the AdminSession entity.
+func (_u *AdminUpdate) AddSessions(v ...*AdminSession) *AdminUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddPasskeyIDs adds the "passkeys" edge to the AdminPasskey entity by IDs.
+func (_u *AdminUpdate) AddPasskeyIDs(ids ...int) *AdminUpdate {
+	_u.mutation.AddPasskeyIDs(ids...)
+	return _u
+}
+
+// AddPasskeys adds the "passkeys" edges to the AdminPasskey entity.
+func (_u *AdminUpdate) AddPasskeys(v ...*AdminPasskey) *AdminUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPasskeyIDs(ids...)
+}
+
 // Mutation returns the AdminMutation object of the builder.
 func (_u *AdminUpdate) Mutation() *AdminMutation {
 	return _u.mutation
@@ -138,6 +322,69 @@ func (_u *AdminUpdate) RemoveGrantedBadges(v ...*UserBadge) *AdminUpdate {
 	return _u.RemoveGrantedBadgeIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the AdminRole entity.
+func (_u *AdminUpdate) ClearRoles() *AdminUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to AdminRole entities by IDs.
+func (_u *AdminUpdate) RemoveRoleIDs(ids ...int) *AdminUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to AdminRole entities.
+func (_u *AdminUpdate) RemoveRoles(v ...*AdminRole) *AdminUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the AdminSession entity.
+func (_u *AdminUpdate) ClearSessions() *AdminUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to AdminSession entities by IDs.
+func (_u *AdminUpdate) RemoveSessionIDs(ids ...int) *AdminUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to AdminSession entities.
+func (_u *AdminUpdate) RemoveSessions(v ...*AdminSession) *AdminUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearPasskeys clears all "passkeys" edges to the AdminPasskey entity.
+func (_u *AdminUpdate) ClearPasskeys() *AdminUpdate {
+	_u.mutation.ClearPasskeys()
+	return _u
+}
+
+// RemovePasskeyIDs removes the "passkeys" edge to AdminPasskey entities by IDs.
+func (_u *AdminUpdate) RemovePasskeyIDs(ids ...int) *AdminUpdate {
+	_u.mutation.RemovePasskeyIDs(ids...)
+	return _u
+}
+
+// RemovePasskeys removes "passkeys" edges to AdminPasskey entities.
+func (_u *AdminUpdate) RemovePasskeys(v ...*AdminPasskey) *AdminUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePasskeyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AdminUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -224,6 +471,45 @@ func (_u *AdminUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(admin.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(admin.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(admin.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(admin.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(admin.FieldTotpEnabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.TotpEnabledAtCleared() {
+		_spec.ClearField(admin.FieldTotpEnabledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpLastStep(); ok {
+		_spec.SetField(admin.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(admin.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(admin.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(admin.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(admin.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(admin.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(admin.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(admin.FieldLastLoginAt, field.TypeTime)
+	}
 	if _u.mutation.GrantedBadgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -269,6 +555,141 @@ func (_u *AdminUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PasskeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPasskeysIDs(); len(nodes) > 0 && !_u.mutation.PasskeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PasskeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{admin.Label}
@@ -357,6 +778,142 @@ func (_u *AdminUpdateOne) SetNillableName(v *string) *AdminUpdateOne {
 	return _u
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (_u *AdminUpdateOne) SetTotpSecret(v string) *AdminUpdateOne {
+	_u.mutation.SetTotpSecret(v)
+	return _u
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableTotpSecret(v *string) *AdminUpdateOne {
+	if v != nil {
+		_u.SetTotpSecret(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (_u *AdminUpdateOne) ClearTotpSecret() *AdminUpdateOne {
+	_u.mutation.ClearTotpSecret()
+	return _u
+}
+
+// SetTotpEnabled sets the "totp_enabled" field.
+func (_u *AdminUpdateOne) SetTotpEnabled(v bool) *AdminUpdateOne {
+	_u.mutation.SetTotpEnabled(v)
+	return _u
+}
+
+// SetNillableTotpEnabled sets the "totp_enabled" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableTotpEnabled(v *bool) *AdminUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabled(*v)
+	}
+	return _u
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (_u *AdminUpdateOne) SetTotpEnabledAt(v time.Time) *AdminUpdateOne {
+	_u.mutation.SetTotpEnabledAt(v)
+	return _u
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableTotpEnabledAt(v *time.Time) *AdminUpdateOne {
+	if v != nil {
+		_u.SetTotpEnabledAt(*v)
+	}
+	return _u
+}
+
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (_u *AdminUpdateOne) ClearTotpEnabledAt() *AdminUpdateOne {
+	_u.mutation.ClearTotpEnabledAt()
+	return _u
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (_u *AdminUpdateOne) SetTotpLastStep(v int64) *AdminUpdateOne {
+	_u.mutation.ResetTotpLastStep()
+	_u.mutation.SetTotpLastStep(v)
+	return _u
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableTotpLastStep(v *int64) *AdminUpdateOne {
+	if v != nil {
+		_u.SetTotpLastStep(*v)
+	}
+	return _u
+}
+
+// AddTotpLastStep adds value to the "totp_last_step" field.
+func (_u *AdminUpdateOne) AddTotpLastStep(v int64) *AdminUpdateOne {
+	_u.mutation.AddTotpLastStep(v)
+	return _u
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (_u *AdminUpdateOne) SetFailedLoginAttempts(v int) *AdminUpdateOne {
+	_u.mutation.ResetFailedLoginAttempts()
+	_u.mutation.SetFailedLoginAttempts(v)
+	return _u
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableFailedLoginAttempts(v *int) *AdminUpdateOne {
+	if v != nil {
+		_u.SetFailedLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedLoginAttempts adds value to the "failed_login_attempts" field.
+func (_u *AdminUpdateOne) AddFailedLoginAttempts(v int) *AdminUpdateOne {
+	_u.mutation.AddFailedLoginAttempts(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *AdminUpdateOne) SetLockedUntil(v time.Time) *AdminUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableLockedUntil(v *time.Time) *AdminUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *AdminUpdateOne) ClearLockedUntil() *AdminUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *AdminUpdateOne) SetLastLoginAt(v time.Time) *AdminUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *AdminUpdateOne) SetNillableLastLoginAt(v *time.Time) *AdminUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *AdminUpdateOne) ClearLastLoginAt() *AdminUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
 // AddGrantedBadgeIDs adds the "granted_badges" edge to the UserBadge entity by IDs.
 func (_u *AdminUpdateOne) AddGrantedBadgeIDs(ids ...int) *AdminUpdateOne {
 	_u.mutation.AddGrantedBadgeIDs(ids...)
@@ -372,6 +929,51 @@ func (_u *AdminUpdateOne) AddGrantedBadges(v ...*UserBadge) *AdminUpdateOne {
 	return _u.AddGrantedBadgeIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the AdminRole entity by IDs.
+func (_u *AdminUpdateOne) AddRoleIDs(ids ...int) *AdminUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the AdminRole entity.
+func (_u *AdminUpdateOne) AddRoles(v ...*AdminRole) *AdminUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the AdminSession entity by IDs.
+func (_u *AdminUpdateOne) AddSessionIDs(ids ...int) *AdminUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the AdminSession entity.
+func (_u *AdminUpdateOne) AddSessions(v ...*AdminSession) *AdminUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddPasskeyIDs adds the "passkeys" edge to the AdminPasskey entity by IDs.
+func (_u *AdminUpdateOne) AddPasskeyIDs(ids ...int) *AdminUpdateOne {
+	_u.mutation.AddPasskeyIDs(ids...)
+	return _u
+}
+
+// AddPasskeys adds the "passkeys" edges to the AdminPasskey entity.
+func (_u *AdminUpdateOne) AddPasskeys(v ...*AdminPasskey) *AdminUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPasskeyIDs(ids...)
+}
+
 // Mutation returns the AdminMutation object of the builder.
 func (_u *AdminUpdateOne) Mutation() *AdminMutation {
 	return _u.mutation
@@ -398,6 +1000,69 @@ func (_u *AdminUpdateOne) RemoveGrantedBadges(v ...*UserBadge) *AdminUpdateOne {
 	return _u.RemoveGrantedBadgeIDs(ids...)
 }
 
+// ClearRoles clears all "roles" edges to the AdminRole entity.
+func (_u *AdminUpdateOne) ClearRoles() *AdminUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to AdminRole entities by IDs.
+func (_u *AdminUpdateOne) RemoveRoleIDs(ids ...int) *AdminUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to AdminRole entities.
+func (_u *AdminUpdateOne) RemoveRoles(v ...*AdminRole) *AdminUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the AdminSession entity.
+func (_u *AdminUpdateOne) ClearSessions() *AdminUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to AdminSession entities by IDs.
+func (_u *AdminUpdateOne) RemoveSessionIDs(ids ...int) *AdminUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to AdminSession entities.
+func (_u *AdminUpdateOne) RemoveSessions(v ...*AdminSession) *AdminUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearPasskeys clears all "passkeys" edges to the AdminPasskey entity.
+func (_u *AdminUpdateOne) ClearPasskeys() *AdminUpdateOne {
+	_u.mutation.ClearPasskeys()
+	return _u
+}
+
+// RemovePasskeyIDs removes the "passkeys" edge to AdminPasskey entities by IDs.
+func (_u *AdminUpdateOne) RemovePasskeyIDs(ids ...int) *AdminUpdateOne {
+	_u.mutation.RemovePasskeyIDs(ids...)
+	return _u
+}
+
+// RemovePasskeys removes "passkeys" edges to AdminPasskey entities.
+func (_u *AdminUpdateOne) RemovePasskeys(v ...*AdminPasskey) *AdminUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePasskeyIDs(ids...)
+}
+
 // Where appends a list predicates to the AdminUpdate builder.
 func (_u *AdminUpdateOne) Where(ps ...predicate.Admin) *AdminUpdateOne {
 	_u.mutation.Where(ps...)
@@ -514,6 +1179,45 @@ func (_u *AdminUpdateOne) sqlSave(ctx context.Context) (_node *Admin, err error)
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(admin.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TotpSecret(); ok {
+		_spec.SetField(admin.FieldTotpSecret, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretCleared() {
+		_spec.ClearField(admin.FieldTotpSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpEnabled(); ok {
+		_spec.SetField(admin.FieldTotpEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(admin.FieldTotpEnabledAt, field.TypeTime, value)
+	}
+	if _u.mutation.TotpEnabledAtCleared() {
+		_spec.ClearField(admin.FieldTotpEnabledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TotpLastStep(); ok {
+		_spec.SetField(admin.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(admin.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(admin.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(admin.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(admin.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(admin.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(admin.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(admin.FieldLastLoginAt, field.TypeTime)
+	}
 	if _u.mutation.GrantedBadgesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -559,6 +1263,141 @@ func (_u *AdminUpdateOne) sqlSave(ctx context.Context) (_node *Admin, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   admin.RolesTable,
+			Columns: admin.RolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminrole.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.SessionsTable,
+			Columns: []string{admin.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PasskeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPasskeysIDs(); len(nodes) > 0 && !_u.mutation.PasskeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PasskeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   admin.PasskeysTable,
+			Columns: []string{admin.PasskeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Admin{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
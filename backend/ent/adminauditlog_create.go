@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/adminauditlog"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminAuditLogCreate is the builder for creating a AdminAuditLog entity.
+type AdminAuditLogCreate struct {
+	config
+	mutation *AdminAuditLogMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminAuditLogCreate) SetCreatedAt(v time.Time) *AdminAuditLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableCreatedAt(v *time.Time) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_c *AdminAuditLogCreate) SetAdminID(v int) *AdminAuditLogCreate {
+	_c.mutation.SetAdminID(v)
+	return _c
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (_c *AdminAuditLogCreate) SetAdminEmail(v string) *AdminAuditLogCreate {
+	_c.mutation.SetAdminEmail(v)
+	return _c
+}
+
+// SetNillableAdminEmail sets the "admin_email" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableAdminEmail(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetAdminEmail(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *AdminAuditLogCreate) SetAction(v string) *AdminAuditLogCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *AdminAuditLogCreate) SetMethod(v string) *AdminAuditLogCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *AdminAuditLogCreate) SetPath(v string) *AdminAuditLogCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetTargetType sets the "target_type" field.
+func (_c *AdminAuditLogCreate) SetTargetType(v string) *AdminAuditLogCreate {
+	_c.mutation.SetTargetType(v)
+	return _c
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableTargetType(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetTargetType(*v)
+	}
+	return _c
+}
+
+// SetTargetID sets the "target_id" field.
+func (_c *AdminAuditLogCreate) SetTargetID(v string) *AdminAuditLogCreate {
+	_c.mutation.SetTargetID(v)
+	return _c
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableTargetID(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetTargetID(*v)
+	}
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *AdminAuditLogCreate) SetChanges(v []map[string]interface{}) *AdminAuditLogCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *AdminAuditLogCreate) SetRequestID(v string) *AdminAuditLogCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableRequestID(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_c *AdminAuditLogCreate) SetIPAddress(v string) *AdminAuditLogCreate {
+	_c.mutation.SetIPAddress(v)
+	return _c
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableIPAddress(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetIPAddress(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *AdminAuditLogCreate) SetUserAgent(v string) *AdminAuditLogCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableUserAgent(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *AdminAuditLogCreate) SetReason(v string) *AdminAuditLogCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableReason(v *string) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_c *AdminAuditLogCreate) SetStatusCode(v int) *AdminAuditLogCreate {
+	_c.mutation.SetStatusCode(v)
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *AdminAuditLogCreate) SetSuccess(v bool) *AdminAuditLogCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_c *AdminAuditLogCreate) SetNillableSuccess(v *bool) *AdminAuditLogCreate {
+	if v != nil {
+		_c.SetSuccess(*v)
+	}
+	return _c
+}
+
+// SetSeq sets the "seq" field.
+func (_c *AdminAuditLogCreate) SetSeq(v int) *AdminAuditLogCreate {
+	_c.mutation.SetSeq(v)
+	return _c
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (_c *AdminAuditLogCreate) SetPrevHash(v string) *AdminAuditLogCreate {
+	_c.mutation.SetPrevHash(v)
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *AdminAuditLogCreate) SetHash(v string) *AdminAuditLogCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// Mutation returns the AdminAuditLogMutation object of the builder.
+func (_c *AdminAuditLogCreate) Mutation() *AdminAuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminAuditLog in the database.
+func (_c *AdminAuditLogCreate) Save(ctx context.Context) (*AdminAuditLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminAuditLogCreate) SaveX(ctx context.Context) *AdminAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminAuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminAuditLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminauditlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.AdminEmail(); !ok {
+		v := adminauditlog.DefaultAdminEmail
+		_c.mutation.SetAdminEmail(v)
+	}
+	if _, ok := _c.mutation.TargetType(); !ok {
+		v := adminauditlog.DefaultTargetType
+		_c.mutation.SetTargetType(v)
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		v := adminauditlog.DefaultTargetID
+		_c.mutation.SetTargetID(v)
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		v := adminauditlog.DefaultRequestID
+		_c.mutation.SetRequestID(v)
+	}
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		v := adminauditlog.DefaultIPAddress
+		_c.mutation.SetIPAddress(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := adminauditlog.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := adminauditlog.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		v := adminauditlog.DefaultSuccess
+		_c.mutation.SetSuccess(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminAuditLogCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminAuditLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.AdminID(); !ok {
+		return &ValidationError{Name: "admin_id", err: errors.New(`ent: missing required field "AdminAuditLog.admin_id"`)}
+	}
+	if _, ok := _c.mutation.AdminEmail(); !ok {
+		return &ValidationError{Name: "admin_email", err: errors.New(`ent: missing required field "AdminAuditLog.admin_email"`)}
+	}
+	if v, ok := _c.mutation.AdminEmail(); ok {
+		if err := adminauditlog.AdminEmailValidator(v); err != nil {
+			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.admin_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AdminAuditLog.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := adminauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "AdminAuditLog.method"`)}
+	}
+	if v, ok := _c.mutation.Method(); ok {
+		if err := adminauditlog.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "AdminAuditLog.path"`)}
+	}
+	if v, ok := _c.mutation.Path(); ok {
+		if err := adminauditlog.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "AdminAuditLog.target_type"`)}
+	}
+	if v, ok := _c.mutation.TargetType(); ok {
+		if err := adminauditlog.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.target_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "AdminAuditLog.target_id"`)}
+	}
+	if v, ok := _c.mutation.TargetID(); ok {
+		if err := adminauditlog.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.target_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "AdminAuditLog.request_id"`)}
+	}
+	if v, ok := _c.mutation.RequestID(); ok {
+		if err := adminauditlog.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "AdminAuditLog.ip_address"`)}
+	}
+	if v, ok := _c.mutation.IPAddress(); ok {
+		if err := adminauditlog.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.ip_address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AdminAuditLog.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := adminauditlog.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AdminAuditLog.reason"`)}
+	}
+	if _, ok := _c.mutation.StatusCode(); !ok {
+		return &ValidationError{Name: "status_code", err: errors.New(`ent: missing required field "AdminAuditLog.status_code"`)}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "AdminAuditLog.success"`)}
+	}
+	if _, ok := _c.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "AdminAuditLog.seq"`)}
+	}
+	if v, ok := _c.mutation.Seq(); ok {
+		if err := adminauditlog.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.seq": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PrevHash(); !ok {
+		return &ValidationError{Name: "prev_hash", err: errors.New(`ent: missing required field "AdminAuditLog.prev_hash"`)}
+	}
+	if v, ok := _c.mutation.PrevHash(); ok {
+		if err := adminauditlog.PrevHashValidator(v); err != nil {
+			return &ValidationError{Name: "prev_hash", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.prev_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AdminAuditLog.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := adminauditlog.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "AdminAuditLog.hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AdminAuditLogCreate) sqlSave(ctx context.Context) (*AdminAuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminAuditLogCreate) createSpec() (*AdminAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminAuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminauditlog.Table, sqlgraph.NewFieldSpec(adminauditlog.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminauditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.AdminID(); ok {
+		_spec.SetField(adminauditlog.FieldAdminID, field.TypeInt, value)
+		_node.AdminID = value
+	}
+	if value, ok := _c.mutation.AdminEmail(); ok {
+		_spec.SetField(adminauditlog.FieldAdminEmail, field.TypeString, value)
+		_node.AdminEmail = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(adminauditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(adminauditlog.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(adminauditlog.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.TargetType(); ok {
+		_spec.SetField(adminauditlog.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := _c.mutation.TargetID(); ok {
+		_spec.SetField(adminauditlog.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(adminauditlog.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(adminauditlog.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.IPAddress(); ok {
+		_spec.SetField(adminauditlog.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(adminauditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(adminauditlog.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.StatusCode(); ok {
+		_spec.SetField(adminauditlog.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(adminauditlog.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.Seq(); ok {
+		_spec.SetField(adminauditlog.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := _c.mutation.PrevHash(); ok {
+		_spec.SetField(adminauditlog.FieldPrevHash, field.TypeString, value)
+		_node.PrevHash = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(adminauditlog.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	return _node, _spec
+}
+
+// AdminAuditLogCreateBulk is the builder for creating many AdminAuditLog entities in bulk.
+type AdminAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AdminAuditLogCreate
+}
+
+// Save creates the AdminAuditLog entities in the database.
+func (_c *AdminAuditLogCreateBulk) Save(ctx context.Context) ([]*AdminAuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminAuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminAuditLogCreateBulk) SaveX(ctx context.Context) []*AdminAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
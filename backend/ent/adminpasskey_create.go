@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminPasskeyCreate is the builder for creating a AdminPasskey entity.
+type AdminPasskeyCreate struct {
+	config
+	mutation *AdminPasskeyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminPasskeyCreate) SetCreatedAt(v time.Time) *AdminPasskeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableCreatedAt(v *time.Time) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AdminPasskeyCreate) SetUpdatedAt(v time.Time) *AdminPasskeyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableUpdatedAt(v *time.Time) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AdminPasskeyCreate) SetDeletedAt(v time.Time) *AdminPasskeyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableDeletedAt(v *time.Time) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_c *AdminPasskeyCreate) SetAdminID(v int) *AdminPasskeyCreate {
+	_c.mutation.SetAdminID(v)
+	return _c
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_c *AdminPasskeyCreate) SetCredentialID(v []byte) *AdminPasskeyCreate {
+	_c.mutation.SetCredentialID(v)
+	return _c
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_c *AdminPasskeyCreate) SetPublicKey(v []byte) *AdminPasskeyCreate {
+	_c.mutation.SetPublicKey(v)
+	return _c
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_c *AdminPasskeyCreate) SetAttestationType(v string) *AdminPasskeyCreate {
+	_c.mutation.SetAttestationType(v)
+	return _c
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_c *AdminPasskeyCreate) SetAaguid(v []byte) *AdminPasskeyCreate {
+	_c.mutation.SetAaguid(v)
+	return _c
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_c *AdminPasskeyCreate) SetSignCount(v uint32) *AdminPasskeyCreate {
+	_c.mutation.SetSignCount(v)
+	return _c
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableSignCount(v *uint32) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetSignCount(*v)
+	}
+	return _c
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_c *AdminPasskeyCreate) SetBackupEligible(v bool) *AdminPasskeyCreate {
+	_c.mutation.SetBackupEligible(v)
+	return _c
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableBackupEligible(v *bool) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetBackupEligible(*v)
+	}
+	return _c
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_c *AdminPasskeyCreate) SetBackupState(v bool) *AdminPasskeyCreate {
+	_c.mutation.SetBackupState(v)
+	return _c
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableBackupState(v *bool) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetBackupState(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AdminPasskeyCreate) SetName(v string) *AdminPasskeyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableName(v *string) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *AdminPasskeyCreate) SetLastUsedAt(v time.Time) *AdminPasskeyCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *AdminPasskeyCreate) SetNillableLastUsedAt(v *time.Time) *AdminPasskeyCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetTransports sets the "transports" field.
+func (_c *AdminPasskeyCreate) SetTransports(v []string) *AdminPasskeyCreate {
+	_c.mutation.SetTransports(v)
+	return _c
+}
+
+// SetAdmin sets the "admin" edge to the Admin entity.
+func (_c *AdminPasskeyCreate) SetAdmin(v *Admin) *AdminPasskeyCreate {
+	return _c.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdminPasskeyMutation object of the builder.
+func (_c *AdminPasskeyCreate) Mutation() *AdminPasskeyMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminPasskey in the database.
+func (_c *AdminPasskeyCreate) Save(ctx context.Context) (*AdminPasskey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminPasskeyCreate) SaveX(ctx context.Context) *AdminPasskey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminPasskeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminPasskeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminPasskeyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminpasskey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := adminpasskey.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		v := adminpasskey.DefaultSignCount
+		_c.mutation.SetSignCount(v)
+	}
+	if _, ok := _c.mutation.BackupEligible(); !ok {
+		v := adminpasskey.DefaultBackupEligible
+		_c.mutation.SetBackupEligible(v)
+	}
+	if _, ok := _c.mutation.BackupState(); !ok {
+		v := adminpasskey.DefaultBackupState
+		_c.mutation.SetBackupState(v)
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := adminpasskey.DefaultName
+		_c.mutation.SetName(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminPasskeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminPasskey.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AdminPasskey.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AdminID(); !ok {
+		return &ValidationError{Name: "admin_id", err: errors.New(`ent: missing required field "AdminPasskey.admin_id"`)}
+	}
+	if v, ok := _c.mutation.AdminID(); ok {
+		if err := adminpasskey.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.admin_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CredentialID(); !ok {
+		return &ValidationError{Name: "credential_id", err: errors.New(`ent: missing required field "AdminPasskey.credential_id"`)}
+	}
+	if v, ok := _c.mutation.CredentialID(); ok {
+		if err := adminpasskey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.credential_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "AdminPasskey.public_key"`)}
+	}
+	if v, ok := _c.mutation.PublicKey(); ok {
+		if err := adminpasskey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.public_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttestationType(); !ok {
+		return &ValidationError{Name: "attestation_type", err: errors.New(`ent: missing required field "AdminPasskey.attestation_type"`)}
+	}
+	if v, ok := _c.mutation.AttestationType(); ok {
+		if err := adminpasskey.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.attestation_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SignCount(); !ok {
+		return &ValidationError{Name: "sign_count", err: errors.New(`ent: missing required field "AdminPasskey.sign_count"`)}
+	}
+	if _, ok := _c.mutation.BackupEligible(); !ok {
+		return &ValidationError{Name: "backup_eligible", err: errors.New(`ent: missing required field "AdminPasskey.backup_eligible"`)}
+	}
+	if _, ok := _c.mutation.BackupState(); !ok {
+		return &ValidationError{Name: "backup_state", err: errors.New(`ent: missing required field "AdminPasskey.backup_state"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AdminPasskey.name"`)}
+	}
+	if len(_c.mutation.AdminIDs()) == 0 {
+		return &ValidationError{Name: "admin", err: errors.New(`ent: missing required edge "AdminPasskey.admin"`)}
+	}
+	return nil
+}
+
+func (_c *AdminPasskeyCreate) sqlSave(ctx context.Context) (*AdminPasskey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminPasskeyCreate) createSpec() (*AdminPasskey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminPasskey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminpasskey.Table, sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminpasskey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminpasskey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(adminpasskey.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CredentialID(); ok {
+		_spec.SetField(adminpasskey.FieldCredentialID, field.TypeBytes, value)
+		_node.CredentialID = value
+	}
+	if value, ok := _c.mutation.PublicKey(); ok {
+		_spec.SetField(adminpasskey.FieldPublicKey, field.TypeBytes, value)
+		_node.PublicKey = value
+	}
+	if value, ok := _c.mutation.AttestationType(); ok {
+		_spec.SetField(adminpasskey.FieldAttestationType, field.TypeString, value)
+		_node.AttestationType = value
+	}
+	if value, ok := _c.mutation.Aaguid(); ok {
+		_spec.SetField(adminpasskey.FieldAaguid, field.TypeBytes, value)
+		_node.Aaguid = value
+	}
+	if value, ok := _c.mutation.SignCount(); ok {
+		_spec.SetField(adminpasskey.FieldSignCount, field.TypeUint32, value)
+		_node.SignCount = value
+	}
+	if value, ok := _c.mutation.BackupEligible(); ok {
+		_spec.SetField(adminpasskey.FieldBackupEligible, field.TypeBool, value)
+		_node.BackupEligible = value
+	}
+	if value, ok := _c.mutation.BackupState(); ok {
+		_spec.SetField(adminpasskey.FieldBackupState, field.TypeBool, value)
+		_node.BackupState = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(adminpasskey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(adminpasskey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.Transports(); ok {
+		_spec.SetField(adminpasskey.FieldTransports, field.TypeJSON, value)
+		_node.Transports = value
+	}
+	if nodes := _c.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminpasskey.AdminTable,
+			Columns: []string{adminpasskey.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AdminID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AdminPasskeyCreateBulk is the builder for creating many AdminPasskey entities in bulk.
+type AdminPasskeyCreateBulk struct {
+	config
+	err      error
+	builders []*AdminPasskeyCreate
+}
+
+// Save creates the AdminPasskey entities in the database.
+func (_c *AdminPasskeyCreateBulk) Save(ctx context.Context) ([]*AdminPasskey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminPasskey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminPasskeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminPasskeyCreateBulk) SaveX(ctx context.Context) []*AdminPasskey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminPasskeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminPasskeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,808 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminPasskeyUpdate is the builder for updating AdminPasskey entities.
+type AdminPasskeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminPasskeyMutation
+}
+
+// Where appends a list predicates to the AdminPasskeyUpdate builder.
+func (_u *AdminPasskeyUpdate) Where(ps ...predicate.AdminPasskey) *AdminPasskeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminPasskeyUpdate) SetUpdatedAt(v time.Time) *AdminPasskeyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AdminPasskeyUpdate) SetDeletedAt(v time.Time) *AdminPasskeyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableDeletedAt(v *time.Time) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AdminPasskeyUpdate) ClearDeletedAt() *AdminPasskeyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *AdminPasskeyUpdate) SetAdminID(v int) *AdminPasskeyUpdate {
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableAdminID(v *int) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *AdminPasskeyUpdate) SetCredentialID(v []byte) *AdminPasskeyUpdate {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *AdminPasskeyUpdate) SetPublicKey(v []byte) *AdminPasskeyUpdate {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *AdminPasskeyUpdate) SetAttestationType(v string) *AdminPasskeyUpdate {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableAttestationType(v *string) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *AdminPasskeyUpdate) SetAaguid(v []byte) *AdminPasskeyUpdate {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *AdminPasskeyUpdate) ClearAaguid() *AdminPasskeyUpdate {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *AdminPasskeyUpdate) SetSignCount(v uint32) *AdminPasskeyUpdate {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableSignCount(v *uint32) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *AdminPasskeyUpdate) AddSignCount(v int32) *AdminPasskeyUpdate {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_u *AdminPasskeyUpdate) SetBackupEligible(v bool) *AdminPasskeyUpdate {
+	_u.mutation.SetBackupEligible(v)
+	return _u
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableBackupEligible(v *bool) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetBackupEligible(*v)
+	}
+	return _u
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_u *AdminPasskeyUpdate) SetBackupState(v bool) *AdminPasskeyUpdate {
+	_u.mutation.SetBackupState(v)
+	return _u
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableBackupState(v *bool) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetBackupState(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AdminPasskeyUpdate) SetName(v string) *AdminPasskeyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableName(v *string) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AdminPasskeyUpdate) SetLastUsedAt(v time.Time) *AdminPasskeyUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AdminPasskeyUpdate) SetNillableLastUsedAt(v *time.Time) *AdminPasskeyUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *AdminPasskeyUpdate) ClearLastUsedAt() *AdminPasskeyUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *AdminPasskeyUpdate) SetTransports(v []string) *AdminPasskeyUpdate {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *AdminPasskeyUpdate) AppendTransports(v []string) *AdminPasskeyUpdate {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *AdminPasskeyUpdate) ClearTransports() *AdminPasskeyUpdate {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetAdmin sets the "admin" edge to the Admin entity.
+func (_u *AdminPasskeyUpdate) SetAdmin(v *Admin) *AdminPasskeyUpdate {
+	return _u.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdminPasskeyMutation object of the builder.
+func (_u *AdminPasskeyUpdate) Mutation() *AdminPasskeyMutation {
+	return _u.mutation
+}
+
+// ClearAdmin clears the "admin" edge to the Admin entity.
+func (_u *AdminPasskeyUpdate) ClearAdmin() *AdminPasskeyUpdate {
+	_u.mutation.ClearAdmin()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminPasskeyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminPasskeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminPasskeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminPasskeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminPasskeyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminpasskey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminPasskeyUpdate) check() error {
+	if v, ok := _u.mutation.AdminID(); ok {
+		if err := adminpasskey.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.admin_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := adminpasskey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := adminpasskey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := adminpasskey.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.attestation_type": %w`, err)}
+		}
+	}
+	if _u.mutation.AdminCleared() && len(_u.mutation.AdminIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdminPasskey.admin"`)
+	}
+	return nil
+}
+
+func (_u *AdminPasskeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminpasskey.Table, adminpasskey.Columns, sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminpasskey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(adminpasskey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(adminpasskey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(adminpasskey.FieldCredentialID, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(adminpasskey.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(adminpasskey.FieldAttestationType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(adminpasskey.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(adminpasskey.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(adminpasskey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(adminpasskey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.BackupEligible(); ok {
+		_spec.SetField(adminpasskey.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupState(); ok {
+		_spec.SetField(adminpasskey.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(adminpasskey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(adminpasskey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(adminpasskey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(adminpasskey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, adminpasskey.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(adminpasskey.FieldTransports, field.TypeJSON)
+	}
+	if _u.mutation.AdminCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminpasskey.AdminTable,
+			Columns: []string{adminpasskey.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminpasskey.AdminTable,
+			Columns: []string{adminpasskey.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminpasskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminPasskeyUpdateOne is the builder for updating a single AdminPasskey entity.
+type AdminPasskeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminPasskeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminPasskeyUpdateOne) SetUpdatedAt(v time.Time) *AdminPasskeyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AdminPasskeyUpdateOne) SetDeletedAt(v time.Time) *AdminPasskeyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableDeletedAt(v *time.Time) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AdminPasskeyUpdateOne) ClearDeletedAt() *AdminPasskeyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *AdminPasskeyUpdateOne) SetAdminID(v int) *AdminPasskeyUpdateOne {
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableAdminID(v *int) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// SetCredentialID sets the "credential_id" field.
+func (_u *AdminPasskeyUpdateOne) SetCredentialID(v []byte) *AdminPasskeyUpdateOne {
+	_u.mutation.SetCredentialID(v)
+	return _u
+}
+
+// SetPublicKey sets the "public_key" field.
+func (_u *AdminPasskeyUpdateOne) SetPublicKey(v []byte) *AdminPasskeyUpdateOne {
+	_u.mutation.SetPublicKey(v)
+	return _u
+}
+
+// SetAttestationType sets the "attestation_type" field.
+func (_u *AdminPasskeyUpdateOne) SetAttestationType(v string) *AdminPasskeyUpdateOne {
+	_u.mutation.SetAttestationType(v)
+	return _u
+}
+
+// SetNillableAttestationType sets the "attestation_type" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableAttestationType(v *string) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetAttestationType(*v)
+	}
+	return _u
+}
+
+// SetAaguid sets the "aaguid" field.
+func (_u *AdminPasskeyUpdateOne) SetAaguid(v []byte) *AdminPasskeyUpdateOne {
+	_u.mutation.SetAaguid(v)
+	return _u
+}
+
+// ClearAaguid clears the value of the "aaguid" field.
+func (_u *AdminPasskeyUpdateOne) ClearAaguid() *AdminPasskeyUpdateOne {
+	_u.mutation.ClearAaguid()
+	return _u
+}
+
+// SetSignCount sets the "sign_count" field.
+func (_u *AdminPasskeyUpdateOne) SetSignCount(v uint32) *AdminPasskeyUpdateOne {
+	_u.mutation.ResetSignCount()
+	_u.mutation.SetSignCount(v)
+	return _u
+}
+
+// SetNillableSignCount sets the "sign_count" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableSignCount(v *uint32) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetSignCount(*v)
+	}
+	return _u
+}
+
+// AddSignCount adds value to the "sign_count" field.
+func (_u *AdminPasskeyUpdateOne) AddSignCount(v int32) *AdminPasskeyUpdateOne {
+	_u.mutation.AddSignCount(v)
+	return _u
+}
+
+// SetBackupEligible sets the "backup_eligible" field.
+func (_u *AdminPasskeyUpdateOne) SetBackupEligible(v bool) *AdminPasskeyUpdateOne {
+	_u.mutation.SetBackupEligible(v)
+	return _u
+}
+
+// SetNillableBackupEligible sets the "backup_eligible" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableBackupEligible(v *bool) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetBackupEligible(*v)
+	}
+	return _u
+}
+
+// SetBackupState sets the "backup_state" field.
+func (_u *AdminPasskeyUpdateOne) SetBackupState(v bool) *AdminPasskeyUpdateOne {
+	_u.mutation.SetBackupState(v)
+	return _u
+}
+
+// SetNillableBackupState sets the "backup_state" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableBackupState(v *bool) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetBackupState(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AdminPasskeyUpdateOne) SetName(v string) *AdminPasskeyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableName(v *string) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *AdminPasskeyUpdateOne) SetLastUsedAt(v time.Time) *AdminPasskeyUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *AdminPasskeyUpdateOne) SetNillableLastUsedAt(v *time.Time) *AdminPasskeyUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *AdminPasskeyUpdateOne) ClearLastUsedAt() *AdminPasskeyUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetTransports sets the "transports" field.
+func (_u *AdminPasskeyUpdateOne) SetTransports(v []string) *AdminPasskeyUpdateOne {
+	_u.mutation.SetTransports(v)
+	return _u
+}
+
+// AppendTransports appends value to the "transports" field.
+func (_u *AdminPasskeyUpdateOne) AppendTransports(v []string) *AdminPasskeyUpdateOne {
+	_u.mutation.AppendTransports(v)
+	return _u
+}
+
+// ClearTransports clears the value of the "transports" field.
+func (_u *AdminPasskeyUpdateOne) ClearTransports() *AdminPasskeyUpdateOne {
+	_u.mutation.ClearTransports()
+	return _u
+}
+
+// SetAdmin sets the "admin" edge to the Admin entity.
+func (_u *AdminPasskeyUpdateOne) SetAdmin(v *Admin) *AdminPasskeyUpdateOne {
+	return _u.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdminPasskeyMutation object of the builder.
+func (_u *AdminPasskeyUpdateOne) Mutation() *AdminPasskeyMutation {
+	return _u.mutation
+}
+
+// ClearAdmin clears the "admin" edge to the Admin entity.
+func (_u *AdminPasskeyUpdateOne) ClearAdmin() *AdminPasskeyUpdateOne {
+	_u.mutation.ClearAdmin()
+	return _u
+}
+
+// Where appends a list predicates to the AdminPasskeyUpdate builder.
+func (_u *AdminPasskeyUpdateOne) Where(ps ...predicate.AdminPasskey) *AdminPasskeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminPasskeyUpdateOne) Select(field string, fields ...string) *AdminPasskeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminPasskey entity.
+func (_u *AdminPasskeyUpdateOne) Save(ctx context.Context) (*AdminPasskey, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminPasskeyUpdateOne) SaveX(ctx context.Context) *AdminPasskey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminPasskeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminPasskeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminPasskeyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminpasskey.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminPasskeyUpdateOne) check() error {
+	if v, ok := _u.mutation.AdminID(); ok {
+		if err := adminpasskey.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.admin_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialID(); ok {
+		if err := adminpasskey.CredentialIDValidator(v); err != nil {
+			return &ValidationError{Name: "credential_id", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.credential_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PublicKey(); ok {
+		if err := adminpasskey.PublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "public_key", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.public_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttestationType(); ok {
+		if err := adminpasskey.AttestationTypeValidator(v); err != nil {
+			return &ValidationError{Name: "attestation_type", err: fmt.Errorf(`ent: validator failed for field "AdminPasskey.attestation_type": %w`, err)}
+		}
+	}
+	if _u.mutation.AdminCleared() && len(_u.mutation.AdminIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdminPasskey.admin"`)
+	}
+	return nil
+}
+
+func (_u *AdminPasskeyUpdateOne) sqlSave(ctx context.Context) (_node *AdminPasskey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminpasskey.Table, adminpasskey.Columns, sqlgraph.NewFieldSpec(adminpasskey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminPasskey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminpasskey.FieldID)
+		for _, f := range fields {
+			if !adminpasskey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminpasskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminpasskey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(adminpasskey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(adminpasskey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CredentialID(); ok {
+		_spec.SetField(adminpasskey.FieldCredentialID, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.PublicKey(); ok {
+		_spec.SetField(adminpasskey.FieldPublicKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AttestationType(); ok {
+		_spec.SetField(adminpasskey.FieldAttestationType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Aaguid(); ok {
+		_spec.SetField(adminpasskey.FieldAaguid, field.TypeBytes, value)
+	}
+	if _u.mutation.AaguidCleared() {
+		_spec.ClearField(adminpasskey.FieldAaguid, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.SignCount(); ok {
+		_spec.SetField(adminpasskey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedSignCount(); ok {
+		_spec.AddField(adminpasskey.FieldSignCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.BackupEligible(); ok {
+		_spec.SetField(adminpasskey.FieldBackupEligible, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BackupState(); ok {
+		_spec.SetField(adminpasskey.FieldBackupState, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(adminpasskey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(adminpasskey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(adminpasskey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Transports(); ok {
+		_spec.SetField(adminpasskey.FieldTransports, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTransports(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, adminpasskey.FieldTransports, value)
+		})
+	}
+	if _u.mutation.TransportsCleared() {
+		_spec.ClearField(adminpasskey.FieldTransports, field.TypeJSON)
+	}
+	if _u.mutation.AdminCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminpasskey.AdminTable,
+			Columns: []string{adminpasskey.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminpasskey.AdminTable,
+			Columns: []string{adminpasskey.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AdminPasskey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminpasskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
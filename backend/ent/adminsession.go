@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminsession"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AdminSession is the model entity for the AdminSession schema.
+type AdminSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// AdminID holds the value of the "admin_id" field.
+	AdminID int `json:"admin_id,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID string `json:"session_id,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// MfaVerified holds the value of the "mfa_verified" field.
+	MfaVerified bool `json:"mfa_verified,omitempty"`
+	// MfaMethod holds the value of the "mfa_method" field.
+	MfaMethod string `json:"mfa_method,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// LastActivityAt holds the value of the "last_activity_at" field.
+	LastActivityAt time.Time `json:"last_activity_at,omitempty"`
+	// SudoUntil holds the value of the "sudo_until" field.
+	SudoUntil *time.Time `json:"sudo_until,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// RevokeReason holds the value of the "revoke_reason" field.
+	RevokeReason string `json:"revoke_reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AdminSessionQuery when eager-loading is set.
+	Edges        AdminSessionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AdminSessionEdges holds the relations/edges for other nodes in the graph.
+type AdminSessionEdges struct {
+	// Admin holds the value of the admin edge.
+	Admin *Admin `json:"admin,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AdminOrErr returns the Admin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdminSessionEdges) AdminOrErr() (*Admin, error) {
+	if e.Admin != nil {
+		return e.Admin, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: admin.Label}
+	}
+	return nil, &NotLoadedError{edge: "admin"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdminSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case adminsession.FieldMfaVerified:
+			values[i] = new(sql.NullBool)
+		case adminsession.FieldID, adminsession.FieldAdminID:
+			values[i] = new(sql.NullInt64)
+		case adminsession.FieldSessionID, adminsession.FieldIPAddress, adminsession.FieldUserAgent, adminsession.FieldMfaMethod, adminsession.FieldRevokeReason:
+			values[i] = new(sql.NullString)
+		case adminsession.FieldCreatedAt, adminsession.FieldUpdatedAt, adminsession.FieldDeletedAt, adminsession.FieldExpiresAt, adminsession.FieldLastActivityAt, adminsession.FieldSudoUntil, adminsession.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdminSession fields.
+func (_m *AdminSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case adminsession.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case adminsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case adminsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case adminsession.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case adminsession.FieldAdminID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_id", values[i])
+			} else if value.Valid {
+				_m.AdminID = int(value.Int64)
+			}
+		case adminsession.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case adminsession.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				_m.IPAddress = value.String
+			}
+		case adminsession.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case adminsession.FieldMfaVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_verified", values[i])
+			} else if value.Valid {
+				_m.MfaVerified = value.Bool
+			}
+		case adminsession.FieldMfaMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_method", values[i])
+			} else if value.Valid {
+				_m.MfaMethod = value.String
+			}
+		case adminsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case adminsession.FieldLastActivityAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_activity_at", values[i])
+			} else if value.Valid {
+				_m.LastActivityAt = value.Time
+			}
+		case adminsession.FieldSudoUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sudo_until", values[i])
+			} else if value.Valid {
+				_m.SudoUntil = new(time.Time)
+				*_m.SudoUntil = value.Time
+			}
+		case adminsession.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		case adminsession.FieldRevokeReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revoke_reason", values[i])
+			} else if value.Valid {
+				_m.RevokeReason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdminSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *AdminSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAdmin queries the "admin" edge of the AdminSession entity.
+func (_m *AdminSession) QueryAdmin() *AdminQuery {
+	return NewAdminSessionClient(_m.config).QueryAdmin(_m)
+}
+
+// Update returns a builder for updating this AdminSession.
+// Note that you need to call AdminSession.Unwrap() before calling this method if this AdminSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AdminSession) Update() *AdminSessionUpdateOne {
+	return NewAdminSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AdminSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AdminSession) Unwrap() *AdminSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdminSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AdminSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdminSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("admin_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdminID))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(_m.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("mfa_verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaVerified))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_method=")
+	builder.WriteString(_m.MfaMethod)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_activity_at=")
+	builder.WriteString(_m.LastActivityAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.SudoUntil; v != nil {
+		builder.WriteString("sudo_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("revoke_reason=")
+	builder.WriteString(_m.RevokeReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdminSessions is a parsable slice of AdminSession.
+type AdminSessions []*AdminSession
@@ -0,0 +1,869 @@
+// Code generated by ent, DO NOT EDIT.
+
+package adminsession
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// AdminID applies equality check predicate on the "admin_id" field. It's identical to AdminIDEQ.
+func AdminID(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldAdminID, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldSessionID, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// MfaVerified applies equality check predicate on the "mfa_verified" field. It's identical to MfaVerifiedEQ.
+func MfaVerified(v bool) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldMfaVerified, v))
+}
+
+// MfaMethod applies equality check predicate on the "mfa_method" field. It's identical to MfaMethodEQ.
+func MfaMethod(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldMfaMethod, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// LastActivityAt applies equality check predicate on the "last_activity_at" field. It's identical to LastActivityAtEQ.
+func LastActivityAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldLastActivityAt, v))
+}
+
+// SudoUntil applies equality check predicate on the "sudo_until" field. It's identical to SudoUntilEQ.
+func SudoUntil(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldSudoUntil, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokeReason applies equality check predicate on the "revoke_reason" field. It's identical to RevokeReasonEQ.
+func RevokeReason(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokeReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// AdminIDEQ applies the EQ predicate on the "admin_id" field.
+func AdminIDEQ(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldAdminID, v))
+}
+
+// AdminIDNEQ applies the NEQ predicate on the "admin_id" field.
+func AdminIDNEQ(v int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldAdminID, v))
+}
+
+// AdminIDIn applies the In predicate on the "admin_id" field.
+func AdminIDIn(vs ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldAdminID, vs...))
+}
+
+// AdminIDNotIn applies the NotIn predicate on the "admin_id" field.
+func AdminIDNotIn(vs ...int) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldAdminID, vs...))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressIsNil applies the IsNil predicate on the "ip_address" field.
+func IPAddressIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldIPAddress))
+}
+
+// IPAddressNotNil applies the NotNil predicate on the "ip_address" field.
+func IPAddressNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldIPAddress))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// MfaVerifiedEQ applies the EQ predicate on the "mfa_verified" field.
+func MfaVerifiedEQ(v bool) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldMfaVerified, v))
+}
+
+// MfaVerifiedNEQ applies the NEQ predicate on the "mfa_verified" field.
+func MfaVerifiedNEQ(v bool) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldMfaVerified, v))
+}
+
+// MfaMethodEQ applies the EQ predicate on the "mfa_method" field.
+func MfaMethodEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldMfaMethod, v))
+}
+
+// MfaMethodNEQ applies the NEQ predicate on the "mfa_method" field.
+func MfaMethodNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldMfaMethod, v))
+}
+
+// MfaMethodIn applies the In predicate on the "mfa_method" field.
+func MfaMethodIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldMfaMethod, vs...))
+}
+
+// MfaMethodNotIn applies the NotIn predicate on the "mfa_method" field.
+func MfaMethodNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldMfaMethod, vs...))
+}
+
+// MfaMethodGT applies the GT predicate on the "mfa_method" field.
+func MfaMethodGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldMfaMethod, v))
+}
+
+// MfaMethodGTE applies the GTE predicate on the "mfa_method" field.
+func MfaMethodGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldMfaMethod, v))
+}
+
+// MfaMethodLT applies the LT predicate on the "mfa_method" field.
+func MfaMethodLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldMfaMethod, v))
+}
+
+// MfaMethodLTE applies the LTE predicate on the "mfa_method" field.
+func MfaMethodLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldMfaMethod, v))
+}
+
+// MfaMethodContains applies the Contains predicate on the "mfa_method" field.
+func MfaMethodContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldMfaMethod, v))
+}
+
+// MfaMethodHasPrefix applies the HasPrefix predicate on the "mfa_method" field.
+func MfaMethodHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldMfaMethod, v))
+}
+
+// MfaMethodHasSuffix applies the HasSuffix predicate on the "mfa_method" field.
+func MfaMethodHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldMfaMethod, v))
+}
+
+// MfaMethodIsNil applies the IsNil predicate on the "mfa_method" field.
+func MfaMethodIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldMfaMethod))
+}
+
+// MfaMethodNotNil applies the NotNil predicate on the "mfa_method" field.
+func MfaMethodNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldMfaMethod))
+}
+
+// MfaMethodEqualFold applies the EqualFold predicate on the "mfa_method" field.
+func MfaMethodEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldMfaMethod, v))
+}
+
+// MfaMethodContainsFold applies the ContainsFold predicate on the "mfa_method" field.
+func MfaMethodContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldMfaMethod, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// LastActivityAtEQ applies the EQ predicate on the "last_activity_at" field.
+func LastActivityAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldLastActivityAt, v))
+}
+
+// LastActivityAtNEQ applies the NEQ predicate on the "last_activity_at" field.
+func LastActivityAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldLastActivityAt, v))
+}
+
+// LastActivityAtIn applies the In predicate on the "last_activity_at" field.
+func LastActivityAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldLastActivityAt, vs...))
+}
+
+// LastActivityAtNotIn applies the NotIn predicate on the "last_activity_at" field.
+func LastActivityAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldLastActivityAt, vs...))
+}
+
+// LastActivityAtGT applies the GT predicate on the "last_activity_at" field.
+func LastActivityAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldLastActivityAt, v))
+}
+
+// LastActivityAtGTE applies the GTE predicate on the "last_activity_at" field.
+func LastActivityAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldLastActivityAt, v))
+}
+
+// LastActivityAtLT applies the LT predicate on the "last_activity_at" field.
+func LastActivityAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldLastActivityAt, v))
+}
+
+// LastActivityAtLTE applies the LTE predicate on the "last_activity_at" field.
+func LastActivityAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldLastActivityAt, v))
+}
+
+// SudoUntilEQ applies the EQ predicate on the "sudo_until" field.
+func SudoUntilEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldSudoUntil, v))
+}
+
+// SudoUntilNEQ applies the NEQ predicate on the "sudo_until" field.
+func SudoUntilNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldSudoUntil, v))
+}
+
+// SudoUntilIn applies the In predicate on the "sudo_until" field.
+func SudoUntilIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldSudoUntil, vs...))
+}
+
+// SudoUntilNotIn applies the NotIn predicate on the "sudo_until" field.
+func SudoUntilNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldSudoUntil, vs...))
+}
+
+// SudoUntilGT applies the GT predicate on the "sudo_until" field.
+func SudoUntilGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldSudoUntil, v))
+}
+
+// SudoUntilGTE applies the GTE predicate on the "sudo_until" field.
+func SudoUntilGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldSudoUntil, v))
+}
+
+// SudoUntilLT applies the LT predicate on the "sudo_until" field.
+func SudoUntilLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldSudoUntil, v))
+}
+
+// SudoUntilLTE applies the LTE predicate on the "sudo_until" field.
+func SudoUntilLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldSudoUntil, v))
+}
+
+// SudoUntilIsNil applies the IsNil predicate on the "sudo_until" field.
+func SudoUntilIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldSudoUntil))
+}
+
+// SudoUntilNotNil applies the NotNil predicate on the "sudo_until" field.
+func SudoUntilNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldSudoUntil))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RevokeReasonEQ applies the EQ predicate on the "revoke_reason" field.
+func RevokeReasonEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEQ(FieldRevokeReason, v))
+}
+
+// RevokeReasonNEQ applies the NEQ predicate on the "revoke_reason" field.
+func RevokeReasonNEQ(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNEQ(FieldRevokeReason, v))
+}
+
+// RevokeReasonIn applies the In predicate on the "revoke_reason" field.
+func RevokeReasonIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIn(FieldRevokeReason, vs...))
+}
+
+// RevokeReasonNotIn applies the NotIn predicate on the "revoke_reason" field.
+func RevokeReasonNotIn(vs ...string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotIn(FieldRevokeReason, vs...))
+}
+
+// RevokeReasonGT applies the GT predicate on the "revoke_reason" field.
+func RevokeReasonGT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGT(FieldRevokeReason, v))
+}
+
+// RevokeReasonGTE applies the GTE predicate on the "revoke_reason" field.
+func RevokeReasonGTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldGTE(FieldRevokeReason, v))
+}
+
+// RevokeReasonLT applies the LT predicate on the "revoke_reason" field.
+func RevokeReasonLT(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLT(FieldRevokeReason, v))
+}
+
+// RevokeReasonLTE applies the LTE predicate on the "revoke_reason" field.
+func RevokeReasonLTE(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldLTE(FieldRevokeReason, v))
+}
+
+// RevokeReasonContains applies the Contains predicate on the "revoke_reason" field.
+func RevokeReasonContains(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContains(FieldRevokeReason, v))
+}
+
+// RevokeReasonHasPrefix applies the HasPrefix predicate on the "revoke_reason" field.
+func RevokeReasonHasPrefix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasPrefix(FieldRevokeReason, v))
+}
+
+// RevokeReasonHasSuffix applies the HasSuffix predicate on the "revoke_reason" field.
+func RevokeReasonHasSuffix(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldHasSuffix(FieldRevokeReason, v))
+}
+
+// RevokeReasonIsNil applies the IsNil predicate on the "revoke_reason" field.
+func RevokeReasonIsNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldIsNull(FieldRevokeReason))
+}
+
+// RevokeReasonNotNil applies the NotNil predicate on the "revoke_reason" field.
+func RevokeReasonNotNil() predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldNotNull(FieldRevokeReason))
+}
+
+// RevokeReasonEqualFold applies the EqualFold predicate on the "revoke_reason" field.
+func RevokeReasonEqualFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldEqualFold(FieldRevokeReason, v))
+}
+
+// RevokeReasonContainsFold applies the ContainsFold predicate on the "revoke_reason" field.
+func RevokeReasonContainsFold(v string) predicate.AdminSession {
+	return predicate.AdminSession(sql.FieldContainsFold(FieldRevokeReason, v))
+}
+
+// HasAdmin applies the HasEdge predicate on the "admin" edge.
+func HasAdmin() predicate.AdminSession {
+	return predicate.AdminSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AdminTable, AdminColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdminWith applies the HasEdge predicate on the "admin" edge with a given conditions (other predicates).
+func HasAdminWith(preds ...predicate.Admin) predicate.AdminSession {
+	return predicate.AdminSession(func(s *sql.Selector) {
+		step := newAdminStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdminSession) predicate.AdminSession {
+	return predicate.AdminSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdminSession) predicate.AdminSession {
+	return predicate.AdminSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdminSession) predicate.AdminSession {
+	return predicate.AdminSession(sql.NotPredicates(p))
+}
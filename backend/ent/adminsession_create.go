@@ -0,0 +1,482 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminsession"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminSessionCreate is the builder for creating a AdminSession entity.
+type AdminSessionCreate struct {
+	config
+	mutation *AdminSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AdminSessionCreate) SetCreatedAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableCreatedAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AdminSessionCreate) SetUpdatedAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableUpdatedAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AdminSessionCreate) SetDeletedAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableDeletedAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_c *AdminSessionCreate) SetAdminID(v int) *AdminSessionCreate {
+	_c.mutation.SetAdminID(v)
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *AdminSessionCreate) SetSessionID(v string) *AdminSessionCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_c *AdminSessionCreate) SetIPAddress(v string) *AdminSessionCreate {
+	_c.mutation.SetIPAddress(v)
+	return _c
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableIPAddress(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetIPAddress(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *AdminSessionCreate) SetUserAgent(v string) *AdminSessionCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableUserAgent(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetMfaVerified sets the "mfa_verified" field.
+func (_c *AdminSessionCreate) SetMfaVerified(v bool) *AdminSessionCreate {
+	_c.mutation.SetMfaVerified(v)
+	return _c
+}
+
+// SetNillableMfaVerified sets the "mfa_verified" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableMfaVerified(v *bool) *AdminSessionCreate {
+	if v != nil {
+		_c.SetMfaVerified(*v)
+	}
+	return _c
+}
+
+// SetMfaMethod sets the "mfa_method" field.
+func (_c *AdminSessionCreate) SetMfaMethod(v string) *AdminSessionCreate {
+	_c.mutation.SetMfaMethod(v)
+	return _c
+}
+
+// SetNillableMfaMethod sets the "mfa_method" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableMfaMethod(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetMfaMethod(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AdminSessionCreate) SetExpiresAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (_c *AdminSessionCreate) SetLastActivityAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetLastActivityAt(v)
+	return _c
+}
+
+// SetSudoUntil sets the "sudo_until" field.
+func (_c *AdminSessionCreate) SetSudoUntil(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetSudoUntil(v)
+	return _c
+}
+
+// SetNillableSudoUntil sets the "sudo_until" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableSudoUntil(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetSudoUntil(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *AdminSessionCreate) SetRevokedAt(v time.Time) *AdminSessionCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableRevokedAt(v *time.Time) *AdminSessionCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (_c *AdminSessionCreate) SetRevokeReason(v string) *AdminSessionCreate {
+	_c.mutation.SetRevokeReason(v)
+	return _c
+}
+
+// SetNillableRevokeReason sets the "revoke_reason" field if the given value is not nil.
+func (_c *AdminSessionCreate) SetNillableRevokeReason(v *string) *AdminSessionCreate {
+	if v != nil {
+		_c.SetRevokeReason(*v)
+	}
+	return _c
+}
+
+// SetAdmin sets the "admin" edge to the Admin entity.
+func (_c *AdminSessionCreate) SetAdmin(v *Admin) *AdminSessionCreate {
+	return _c.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdminSessionMutation object of the builder.
+func (_c *AdminSessionCreate) Mutation() *AdminSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the AdminSession in the database.
+func (_c *AdminSessionCreate) Save(ctx context.Context) (*AdminSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AdminSessionCreate) SaveX(ctx context.Context) *AdminSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AdminSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := adminsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := adminsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.MfaVerified(); !ok {
+		v := adminsession.DefaultMfaVerified
+		_c.mutation.SetMfaVerified(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AdminSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AdminSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AdminSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AdminID(); !ok {
+		return &ValidationError{Name: "admin_id", err: errors.New(`ent: missing required field "AdminSession.admin_id"`)}
+	}
+	if v, ok := _c.mutation.AdminID(); ok {
+		if err := adminsession.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.admin_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "AdminSession.session_id"`)}
+	}
+	if v, ok := _c.mutation.SessionID(); ok {
+		if err := adminsession.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.session_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IPAddress(); ok {
+		if err := adminsession.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminSession.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := adminsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminSession.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MfaVerified(); !ok {
+		return &ValidationError{Name: "mfa_verified", err: errors.New(`ent: missing required field "AdminSession.mfa_verified"`)}
+	}
+	if v, ok := _c.mutation.MfaMethod(); ok {
+		if err := adminsession.MfaMethodValidator(v); err != nil {
+			return &ValidationError{Name: "mfa_method", err: fmt.Errorf(`ent: validator failed for field "AdminSession.mfa_method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AdminSession.expires_at"`)}
+	}
+	if _, ok := _c.mutation.LastActivityAt(); !ok {
+		return &ValidationError{Name: "last_activity_at", err: errors.New(`ent: missing required field "AdminSession.last_activity_at"`)}
+	}
+	if v, ok := _c.mutation.RevokeReason(); ok {
+		if err := adminsession.RevokeReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoke_reason", err: fmt.Errorf(`ent: validator failed for field "AdminSession.revoke_reason": %w`, err)}
+		}
+	}
+	if len(_c.mutation.AdminIDs()) == 0 {
+		return &ValidationError{Name: "admin", err: errors.New(`ent: missing required edge "AdminSession.admin"`)}
+	}
+	return nil
+}
+
+func (_c *AdminSessionCreate) sqlSave(ctx context.Context) (*AdminSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AdminSessionCreate) createSpec() (*AdminSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdminSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(adminsession.Table, sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(adminsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(adminsession.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(adminsession.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.IPAddress(); ok {
+		_spec.SetField(adminsession.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(adminsession.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.MfaVerified(); ok {
+		_spec.SetField(adminsession.FieldMfaVerified, field.TypeBool, value)
+		_node.MfaVerified = value
+	}
+	if value, ok := _c.mutation.MfaMethod(); ok {
+		_spec.SetField(adminsession.FieldMfaMethod, field.TypeString, value)
+		_node.MfaMethod = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.LastActivityAt(); ok {
+		_spec.SetField(adminsession.FieldLastActivityAt, field.TypeTime, value)
+		_node.LastActivityAt = value
+	}
+	if value, ok := _c.mutation.SudoUntil(); ok {
+		_spec.SetField(adminsession.FieldSudoUntil, field.TypeTime, value)
+		_node.SudoUntil = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(adminsession.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.RevokeReason(); ok {
+		_spec.SetField(adminsession.FieldRevokeReason, field.TypeString, value)
+		_node.RevokeReason = value
+	}
+	if nodes := _c.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminsession.AdminTable,
+			Columns: []string{adminsession.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AdminID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AdminSessionCreateBulk is the builder for creating many AdminSession entities in bulk.
+type AdminSessionCreateBulk struct {
+	config
+	err      error
+	builders []*AdminSessionCreate
+}
+
+// Save creates the AdminSession entities in the database.
+func (_c *AdminSessionCreateBulk) Save(ctx context.Context) ([]*AdminSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AdminSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdminSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AdminSessionCreateBulk) SaveX(ctx context.Context) []*AdminSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AdminSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AdminSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
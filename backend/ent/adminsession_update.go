@@ -0,0 +1,903 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminsession"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminSessionUpdate is the builder for updating AdminSession entities.
+type AdminSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminSessionMutation
+}
+
+// Where appends a list predicates to the AdminSessionUpdate builder.
+func (_u *AdminSessionUpdate) Where(ps ...predicate.AdminSession) *AdminSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminSessionUpdate) SetUpdatedAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AdminSessionUpdate) SetDeletedAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableDeletedAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AdminSessionUpdate) ClearDeletedAt() *AdminSessionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *AdminSessionUpdate) SetAdminID(v int) *AdminSessionUpdate {
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableAdminID(v *int) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *AdminSessionUpdate) SetSessionID(v string) *AdminSessionUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableSessionID(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *AdminSessionUpdate) SetIPAddress(v string) *AdminSessionUpdate {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableIPAddress(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (_u *AdminSessionUpdate) ClearIPAddress() *AdminSessionUpdate {
+	_u.mutation.ClearIPAddress()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AdminSessionUpdate) SetUserAgent(v string) *AdminSessionUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableUserAgent(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *AdminSessionUpdate) ClearUserAgent() *AdminSessionUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetMfaVerified sets the "mfa_verified" field.
+func (_u *AdminSessionUpdate) SetMfaVerified(v bool) *AdminSessionUpdate {
+	_u.mutation.SetMfaVerified(v)
+	return _u
+}
+
+// SetNillableMfaVerified sets the "mfa_verified" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableMfaVerified(v *bool) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetMfaVerified(*v)
+	}
+	return _u
+}
+
+// SetMfaMethod sets the "mfa_method" field.
+func (_u *AdminSessionUpdate) SetMfaMethod(v string) *AdminSessionUpdate {
+	_u.mutation.SetMfaMethod(v)
+	return _u
+}
+
+// SetNillableMfaMethod sets the "mfa_method" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableMfaMethod(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetMfaMethod(*v)
+	}
+	return _u
+}
+
+// ClearMfaMethod clears the value of the "mfa_method" field.
+func (_u *AdminSessionUpdate) ClearMfaMethod() *AdminSessionUpdate {
+	_u.mutation.ClearMfaMethod()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AdminSessionUpdate) SetExpiresAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableExpiresAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (_u *AdminSessionUpdate) SetLastActivityAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetLastActivityAt(v)
+	return _u
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableLastActivityAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetLastActivityAt(*v)
+	}
+	return _u
+}
+
+// SetSudoUntil sets the "sudo_until" field.
+func (_u *AdminSessionUpdate) SetSudoUntil(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetSudoUntil(v)
+	return _u
+}
+
+// SetNillableSudoUntil sets the "sudo_until" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableSudoUntil(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetSudoUntil(*v)
+	}
+	return _u
+}
+
+// ClearSudoUntil clears the value of the "sudo_until" field.
+func (_u *AdminSessionUpdate) ClearSudoUntil() *AdminSessionUpdate {
+	_u.mutation.ClearSudoUntil()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *AdminSessionUpdate) SetRevokedAt(v time.Time) *AdminSessionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableRevokedAt(v *time.Time) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *AdminSessionUpdate) ClearRevokedAt() *AdminSessionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (_u *AdminSessionUpdate) SetRevokeReason(v string) *AdminSessionUpdate {
+	_u.mutation.SetRevokeReason(v)
+	return _u
+}
+
+// SetNillableRevokeReason sets the "revoke_reason" field if the given value is not nil.
+func (_u *AdminSessionUpdate) SetNillableRevokeReason(v *string) *AdminSessionUpdate {
+	if v != nil {
+		_u.SetRevokeReason(*v)
+	}
+	return _u
+}
+
+// ClearRevokeReason clears the value of the "revoke_reason" field.
+func (_u *AdminSessionUpdate) ClearRevokeReason() *AdminSessionUpdate {
+	_u.mutation.ClearRevokeReason()
+	return _u
+}
+
+// SetAdmin sets the "admin" edge to the Admin entity.
+func (_u *AdminSessionUpdate) SetAdmin(v *Admin) *AdminSessionUpdate {
+	return _u.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdminSessionMutation object of the builder.
+func (_u *AdminSessionUpdate) Mutation() *AdminSessionMutation {
+	return _u.mutation
+}
+
+// ClearAdmin clears the "admin" edge to the Admin entity.
+func (_u *AdminSessionUpdate) ClearAdmin() *AdminSessionUpdate {
+	_u.mutation.ClearAdmin()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminSessionUpdate) check() error {
+	if v, ok := _u.mutation.AdminID(); ok {
+		if err := adminsession.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.admin_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionID(); ok {
+		if err := adminsession.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.session_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := adminsession.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminSession.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := adminsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminSession.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MfaMethod(); ok {
+		if err := adminsession.MfaMethodValidator(v); err != nil {
+			return &ValidationError{Name: "mfa_method", err: fmt.Errorf(`ent: validator failed for field "AdminSession.mfa_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevokeReason(); ok {
+		if err := adminsession.RevokeReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoke_reason", err: fmt.Errorf(`ent: validator failed for field "AdminSession.revoke_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.AdminCleared() && len(_u.mutation.AdminIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdminSession.admin"`)
+	}
+	return nil
+}
+
+func (_u *AdminSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminsession.Table, adminsession.Columns, sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(adminsession.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(adminsession.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(adminsession.FieldSessionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(adminsession.FieldIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(adminsession.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(adminsession.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(adminsession.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaVerified(); ok {
+		_spec.SetField(adminsession.FieldMfaVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MfaMethod(); ok {
+		_spec.SetField(adminsession.FieldMfaMethod, field.TypeString, value)
+	}
+	if _u.mutation.MfaMethodCleared() {
+		_spec.ClearField(adminsession.FieldMfaMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastActivityAt(); ok {
+		_spec.SetField(adminsession.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SudoUntil(); ok {
+		_spec.SetField(adminsession.FieldSudoUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SudoUntilCleared() {
+		_spec.ClearField(adminsession.FieldSudoUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(adminsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(adminsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokeReason(); ok {
+		_spec.SetField(adminsession.FieldRevokeReason, field.TypeString, value)
+	}
+	if _u.mutation.RevokeReasonCleared() {
+		_spec.ClearField(adminsession.FieldRevokeReason, field.TypeString)
+	}
+	if _u.mutation.AdminCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminsession.AdminTable,
+			Columns: []string{adminsession.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminsession.AdminTable,
+			Columns: []string{adminsession.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminSessionUpdateOne is the builder for updating a single AdminSession entity.
+type AdminSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminSessionUpdateOne) SetUpdatedAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AdminSessionUpdateOne) SetDeletedAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableDeletedAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AdminSessionUpdateOne) ClearDeletedAt() *AdminSessionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_u *AdminSessionUpdateOne) SetAdminID(v int) *AdminSessionUpdateOne {
+	_u.mutation.SetAdminID(v)
+	return _u
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableAdminID(v *int) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetAdminID(*v)
+	}
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *AdminSessionUpdateOne) SetSessionID(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableSessionID(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *AdminSessionUpdateOne) SetIPAddress(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableIPAddress(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (_u *AdminSessionUpdateOne) ClearIPAddress() *AdminSessionUpdateOne {
+	_u.mutation.ClearIPAddress()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *AdminSessionUpdateOne) SetUserAgent(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableUserAgent(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *AdminSessionUpdateOne) ClearUserAgent() *AdminSessionUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetMfaVerified sets the "mfa_verified" field.
+func (_u *AdminSessionUpdateOne) SetMfaVerified(v bool) *AdminSessionUpdateOne {
+	_u.mutation.SetMfaVerified(v)
+	return _u
+}
+
+// SetNillableMfaVerified sets the "mfa_verified" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableMfaVerified(v *bool) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetMfaVerified(*v)
+	}
+	return _u
+}
+
+// SetMfaMethod sets the "mfa_method" field.
+func (_u *AdminSessionUpdateOne) SetMfaMethod(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetMfaMethod(v)
+	return _u
+}
+
+// SetNillableMfaMethod sets the "mfa_method" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableMfaMethod(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetMfaMethod(*v)
+	}
+	return _u
+}
+
+// ClearMfaMethod clears the value of the "mfa_method" field.
+func (_u *AdminSessionUpdateOne) ClearMfaMethod() *AdminSessionUpdateOne {
+	_u.mutation.ClearMfaMethod()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AdminSessionUpdateOne) SetExpiresAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetLastActivityAt sets the "last_activity_at" field.
+func (_u *AdminSessionUpdateOne) SetLastActivityAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetLastActivityAt(v)
+	return _u
+}
+
+// SetNillableLastActivityAt sets the "last_activity_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableLastActivityAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetLastActivityAt(*v)
+	}
+	return _u
+}
+
+// SetSudoUntil sets the "sudo_until" field.
+func (_u *AdminSessionUpdateOne) SetSudoUntil(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetSudoUntil(v)
+	return _u
+}
+
+// SetNillableSudoUntil sets the "sudo_until" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableSudoUntil(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetSudoUntil(*v)
+	}
+	return _u
+}
+
+// ClearSudoUntil clears the value of the "sudo_until" field.
+func (_u *AdminSessionUpdateOne) ClearSudoUntil() *AdminSessionUpdateOne {
+	_u.mutation.ClearSudoUntil()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *AdminSessionUpdateOne) SetRevokedAt(v time.Time) *AdminSessionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableRevokedAt(v *time.Time) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *AdminSessionUpdateOne) ClearRevokedAt() *AdminSessionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokeReason sets the "revoke_reason" field.
+func (_u *AdminSessionUpdateOne) SetRevokeReason(v string) *AdminSessionUpdateOne {
+	_u.mutation.SetRevokeReason(v)
+	return _u
+}
+
+// SetNillableRevokeReason sets the "revoke_reason" field if the given value is not nil.
+func (_u *AdminSessionUpdateOne) SetNillableRevokeReason(v *string) *AdminSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokeReason(*v)
+	}
+	return _u
+}
+
+// ClearRevokeReason clears the value of the "revoke_reason" field.
+func (_u *AdminSessionUpdateOne) ClearRevokeReason() *AdminSessionUpdateOne {
+	_u.mutation.ClearRevokeReason()
+	return _u
+}
+
+// SetAdmin sets the "admin" edge to the Admin entity.
+func (_u *AdminSessionUpdateOne) SetAdmin(v *Admin) *AdminSessionUpdateOne {
+	return _u.SetAdminID(v.ID)
+}
+
+// Mutation returns the AdminSessionMutation object of the builder.
+func (_u *AdminSessionUpdateOne) Mutation() *AdminSessionMutation {
+	return _u.mutation
+}
+
+// ClearAdmin clears the "admin" edge to the Admin entity.
+func (_u *AdminSessionUpdateOne) ClearAdmin() *AdminSessionUpdateOne {
+	_u.mutation.ClearAdmin()
+	return _u
+}
+
+// Where appends a list predicates to the AdminSessionUpdate builder.
+func (_u *AdminSessionUpdateOne) Where(ps ...predicate.AdminSession) *AdminSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminSessionUpdateOne) Select(field string, fields ...string) *AdminSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminSession entity.
+func (_u *AdminSessionUpdateOne) Save(ctx context.Context) (*AdminSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminSessionUpdateOne) SaveX(ctx context.Context) *AdminSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.AdminID(); ok {
+		if err := adminsession.AdminIDValidator(v); err != nil {
+			return &ValidationError{Name: "admin_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.admin_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SessionID(); ok {
+		if err := adminsession.SessionIDValidator(v); err != nil {
+			return &ValidationError{Name: "session_id", err: fmt.Errorf(`ent: validator failed for field "AdminSession.session_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := adminsession.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "AdminSession.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := adminsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AdminSession.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MfaMethod(); ok {
+		if err := adminsession.MfaMethodValidator(v); err != nil {
+			return &ValidationError{Name: "mfa_method", err: fmt.Errorf(`ent: validator failed for field "AdminSession.mfa_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevokeReason(); ok {
+		if err := adminsession.RevokeReasonValidator(v); err != nil {
+			return &ValidationError{Name: "revoke_reason", err: fmt.Errorf(`ent: validator failed for field "AdminSession.revoke_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.AdminCleared() && len(_u.mutation.AdminIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdminSession.admin"`)
+	}
+	return nil
+}
+
+func (_u *AdminSessionUpdateOne) sqlSave(ctx context.Context) (_node *AdminSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminsession.Table, adminsession.Columns, sqlgraph.NewFieldSpec(adminsession.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminsession.FieldID)
+		for _, f := range fields {
+			if !adminsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(adminsession.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(adminsession.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(adminsession.FieldSessionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(adminsession.FieldIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(adminsession.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(adminsession.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(adminsession.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaVerified(); ok {
+		_spec.SetField(adminsession.FieldMfaVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MfaMethod(); ok {
+		_spec.SetField(adminsession.FieldMfaMethod, field.TypeString, value)
+	}
+	if _u.mutation.MfaMethodCleared() {
+		_spec.ClearField(adminsession.FieldMfaMethod, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(adminsession.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastActivityAt(); ok {
+		_spec.SetField(adminsession.FieldLastActivityAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SudoUntil(); ok {
+		_spec.SetField(adminsession.FieldSudoUntil, field.TypeTime, value)
+	}
+	if _u.mutation.SudoUntilCleared() {
+		_spec.ClearField(adminsession.FieldSudoUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(adminsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(adminsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokeReason(); ok {
+		_spec.SetField(adminsession.FieldRevokeReason, field.TypeString, value)
+	}
+	if _u.mutation.RevokeReasonCleared() {
+		_spec.ClearField(adminsession.FieldRevokeReason, field.TypeString)
+	}
+	if _u.mutation.AdminCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminsession.AdminTable,
+			Columns: []string{adminsession.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   adminsession.AdminTable,
+			Columns: []string{adminsession.AdminColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AdminSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseclarification"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CaseClarification is the model entity for the CaseClarification schema.
+type CaseClarification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidationCaseID holds the value of the "validation_case_id" field.
+	ValidationCaseID int `json:"validation_case_id,omitempty"`
+	// ConsultationRequestID holds the value of the "consultation_request_id" field.
+	ConsultationRequestID int `json:"consultation_request_id,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// WorkflowCycle holds the value of the "workflow_cycle" field.
+	WorkflowCycle int `json:"workflow_cycle,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
+	// AnsweredByUserID holds the value of the "answered_by_user_id" field.
+	AnsweredByUserID *int `json:"answered_by_user_id,omitempty"`
+	// AnsweredAt holds the value of the "answered_at" field.
+	AnsweredAt *time.Time `json:"answered_at,omitempty"`
+	// ExpiredAt holds the value of the "expired_at" field.
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// Shared holds the value of the "shared" field.
+	Shared       bool `json:"shared,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CaseClarification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case caseclarification.FieldShared:
+			values[i] = new(sql.NullBool)
+		case caseclarification.FieldID, caseclarification.FieldValidationCaseID, caseclarification.FieldConsultationRequestID, caseclarification.FieldValidatorUserID, caseclarification.FieldWorkflowCycle, caseclarification.FieldAnsweredByUserID:
+			values[i] = new(sql.NullInt64)
+		case caseclarification.FieldKind, caseclarification.FieldBody, caseclarification.FieldStatus, caseclarification.FieldAnswer:
+			values[i] = new(sql.NullString)
+		case caseclarification.FieldCreatedAt, caseclarification.FieldUpdatedAt, caseclarification.FieldDeletedAt, caseclarification.FieldAnsweredAt, caseclarification.FieldExpiredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CaseClarification fields.
+func (_m *CaseClarification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case caseclarification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case caseclarification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case caseclarification.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case caseclarification.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case caseclarification.FieldValidationCaseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_case_id", values[i])
+			} else if value.Valid {
+				_m.ValidationCaseID = int(value.Int64)
+			}
+		case caseclarification.FieldConsultationRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consultation_request_id", values[i])
+			} else if value.Valid {
+				_m.ConsultationRequestID = int(value.Int64)
+			}
+		case caseclarification.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case caseclarification.FieldWorkflowCycle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_cycle", values[i])
+			} else if value.Valid {
+				_m.WorkflowCycle = int(value.Int64)
+			}
+		case caseclarification.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case caseclarification.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case caseclarification.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case caseclarification.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case caseclarification.FieldAnsweredByUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_by_user_id", values[i])
+			} else if value.Valid {
+				_m.AnsweredByUserID = new(int)
+				*_m.AnsweredByUserID = int(value.Int64)
+			}
+		case caseclarification.FieldAnsweredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_at", values[i])
+			} else if value.Valid {
+				_m.AnsweredAt = new(time.Time)
+				*_m.AnsweredAt = value.Time
+			}
+		case caseclarification.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				_m.ExpiredAt = new(time.Time)
+				*_m.ExpiredAt = value.Time
+			}
+		case caseclarification.FieldShared:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shared", values[i])
+			} else if value.Valid {
+				_m.Shared = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CaseClarification.
+// This includes values selected through modifiers, order, etc.
+func (_m *CaseClarification) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CaseClarification.
+// Note that you need to call CaseClarification.Unwrap() before calling this method if this CaseClarification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CaseClarification) Update() *CaseClarificationUpdateOne {
+	return NewCaseClarificationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CaseClarification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CaseClarification) Unwrap() *CaseClarification {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CaseClarification is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CaseClarification) String() string {
+	var builder strings.Builder
+	builder.WriteString("CaseClarification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validation_case_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidationCaseID))
+	builder.WriteString(", ")
+	builder.WriteString("consultation_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConsultationRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_cycle=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowCycle))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	if v := _m.AnsweredByUserID; v != nil {
+		builder.WriteString("answered_by_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AnsweredAt; v != nil {
+		builder.WriteString("answered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiredAt; v != nil {
+		builder.WriteString("expired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("shared=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Shared))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CaseClarifications is a parsable slice of CaseClarification.
+type CaseClarifications []*CaseClarification
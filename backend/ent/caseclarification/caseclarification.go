@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package caseclarification
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the caseclarification type in the database.
+	Label = "case_clarification"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldValidationCaseID holds the string denoting the validation_case_id field in the database.
+	FieldValidationCaseID = "validation_case_id"
+	// FieldConsultationRequestID holds the string denoting the consultation_request_id field in the database.
+	FieldConsultationRequestID = "consultation_request_id"
+	// FieldValidatorUserID holds the string denoting the validator_user_id field in the database.
+	FieldValidatorUserID = "validator_user_id"
+	// FieldWorkflowCycle holds the string denoting the workflow_cycle field in the database.
+	FieldWorkflowCycle = "workflow_cycle"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldAnsweredByUserID holds the string denoting the answered_by_user_id field in the database.
+	FieldAnsweredByUserID = "answered_by_user_id"
+	// FieldAnsweredAt holds the string denoting the answered_at field in the database.
+	FieldAnsweredAt = "answered_at"
+	// FieldExpiredAt holds the string denoting the expired_at field in the database.
+	FieldExpiredAt = "expired_at"
+	// FieldShared holds the string denoting the shared field in the database.
+	FieldShared = "shared"
+	// Table holds the table name of the caseclarification in the database.
+	Table = "case_clarifications"
+)
+
+// Columns holds all SQL columns for caseclarification fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldValidationCaseID,
+	FieldConsultationRequestID,
+	FieldValidatorUserID,
+	FieldWorkflowCycle,
+	FieldKind,
+	FieldBody,
+	FieldStatus,
+	FieldAnswer,
+	FieldAnsweredByUserID,
+	FieldAnsweredAt,
+	FieldExpiredAt,
+	FieldShared,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	ValidationCaseIDValidator func(int) error
+	// ConsultationRequestIDValidator is a validator for the "consultation_request_id" field. It is called by the builders before save.
+	ConsultationRequestIDValidator func(int) error
+	// ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	ValidatorUserIDValidator func(int) error
+	// DefaultWorkflowCycle holds the default value on creation for the "workflow_cycle" field.
+	DefaultWorkflowCycle int
+	// WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	WorkflowCycleValidator func(int) error
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	BodyValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultAnswer holds the default value on creation for the "answer" field.
+	DefaultAnswer string
+	// AnswerValidator is a validator for the "answer" field. It is called by the builders before save.
+	AnswerValidator func(string) error
+	// DefaultShared holds the default value on creation for the "shared" field.
+	DefaultShared bool
+)
+
+// OrderOption defines the ordering options for the CaseClarification queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByValidationCaseID orders the results by the validation_case_id field.
+func ByValidationCaseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationCaseID, opts...).ToFunc()
+}
+
+// ByConsultationRequestID orders the results by the consultation_request_id field.
+func ByConsultationRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsultationRequestID, opts...).ToFunc()
+}
+
+// ByValidatorUserID orders the results by the validator_user_id field.
+func ByValidatorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatorUserID, opts...).ToFunc()
+}
+
+// ByWorkflowCycle orders the results by the workflow_cycle field.
+func ByWorkflowCycle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowCycle, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByAnsweredByUserID orders the results by the answered_by_user_id field.
+func ByAnsweredByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnsweredByUserID, opts...).ToFunc()
+}
+
+// ByAnsweredAt orders the results by the answered_at field.
+func ByAnsweredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnsweredAt, opts...).ToFunc()
+}
+
+// ByExpiredAt orders the results by the expired_at field.
+func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
+}
+
+// ByShared orders the results by the shared field.
+func ByShared(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShared, opts...).ToFunc()
+}
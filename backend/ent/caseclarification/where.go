@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package caseclarification
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ConsultationRequestID applies equality check predicate on the "consultation_request_id" field. It's identical to ConsultationRequestIDEQ.
+func ConsultationRequestID(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldConsultationRequestID, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// WorkflowCycle applies equality check predicate on the "workflow_cycle" field. It's identical to WorkflowCycleEQ.
+func WorkflowCycle(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldKind, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldBody, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldStatus, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnsweredByUserID applies equality check predicate on the "answered_by_user_id" field. It's identical to AnsweredByUserIDEQ.
+func AnsweredByUserID(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldAnsweredByUserID, v))
+}
+
+// AnsweredAt applies equality check predicate on the "answered_at" field. It's identical to AnsweredAtEQ.
+func AnsweredAt(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldAnsweredAt, v))
+}
+
+// ExpiredAt applies equality check predicate on the "expired_at" field. It's identical to ExpiredAtEQ.
+func ExpiredAt(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// Shared applies equality check predicate on the "shared" field. It's identical to SharedEQ.
+func Shared(v bool) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldShared, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// ConsultationRequestIDEQ applies the EQ predicate on the "consultation_request_id" field.
+func ConsultationRequestIDEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDNEQ applies the NEQ predicate on the "consultation_request_id" field.
+func ConsultationRequestIDNEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDIn applies the In predicate on the "consultation_request_id" field.
+func ConsultationRequestIDIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldConsultationRequestID, vs...))
+}
+
+// ConsultationRequestIDNotIn applies the NotIn predicate on the "consultation_request_id" field.
+func ConsultationRequestIDNotIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldConsultationRequestID, vs...))
+}
+
+// ConsultationRequestIDGT applies the GT predicate on the "consultation_request_id" field.
+func ConsultationRequestIDGT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDGTE applies the GTE predicate on the "consultation_request_id" field.
+func ConsultationRequestIDGTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDLT applies the LT predicate on the "consultation_request_id" field.
+func ConsultationRequestIDLT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDLTE applies the LTE predicate on the "consultation_request_id" field.
+func ConsultationRequestIDLTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldConsultationRequestID, v))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// WorkflowCycleEQ applies the EQ predicate on the "workflow_cycle" field.
+func WorkflowCycleEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleNEQ applies the NEQ predicate on the "workflow_cycle" field.
+func WorkflowCycleNEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleIn applies the In predicate on the "workflow_cycle" field.
+func WorkflowCycleIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleNotIn applies the NotIn predicate on the "workflow_cycle" field.
+func WorkflowCycleNotIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleGT applies the GT predicate on the "workflow_cycle" field.
+func WorkflowCycleGT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleGTE applies the GTE predicate on the "workflow_cycle" field.
+func WorkflowCycleGTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLT applies the LT predicate on the "workflow_cycle" field.
+func WorkflowCycleLT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLTE applies the LTE predicate on the "workflow_cycle" field.
+func WorkflowCycleLTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldWorkflowCycle, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContainsFold(FieldKind, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContainsFold(FieldBody, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerIsNil applies the IsNil predicate on the "answer" field.
+func AnswerIsNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIsNull(FieldAnswer))
+}
+
+// AnswerNotNil applies the NotNil predicate on the "answer" field.
+func AnswerNotNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotNull(FieldAnswer))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// AnsweredByUserIDEQ applies the EQ predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldAnsweredByUserID, v))
+}
+
+// AnsweredByUserIDNEQ applies the NEQ predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDNEQ(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldAnsweredByUserID, v))
+}
+
+// AnsweredByUserIDIn applies the In predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldAnsweredByUserID, vs...))
+}
+
+// AnsweredByUserIDNotIn applies the NotIn predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDNotIn(vs ...int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldAnsweredByUserID, vs...))
+}
+
+// AnsweredByUserIDGT applies the GT predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDGT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldAnsweredByUserID, v))
+}
+
+// AnsweredByUserIDGTE applies the GTE predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDGTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldAnsweredByUserID, v))
+}
+
+// AnsweredByUserIDLT applies the LT predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDLT(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldAnsweredByUserID, v))
+}
+
+// AnsweredByUserIDLTE applies the LTE predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDLTE(v int) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldAnsweredByUserID, v))
+}
+
+// AnsweredByUserIDIsNil applies the IsNil predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDIsNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIsNull(FieldAnsweredByUserID))
+}
+
+// AnsweredByUserIDNotNil applies the NotNil predicate on the "answered_by_user_id" field.
+func AnsweredByUserIDNotNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotNull(FieldAnsweredByUserID))
+}
+
+// AnsweredAtEQ applies the EQ predicate on the "answered_at" field.
+func AnsweredAtEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldAnsweredAt, v))
+}
+
+// AnsweredAtNEQ applies the NEQ predicate on the "answered_at" field.
+func AnsweredAtNEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldAnsweredAt, v))
+}
+
+// AnsweredAtIn applies the In predicate on the "answered_at" field.
+func AnsweredAtIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldAnsweredAt, vs...))
+}
+
+// AnsweredAtNotIn applies the NotIn predicate on the "answered_at" field.
+func AnsweredAtNotIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldAnsweredAt, vs...))
+}
+
+// AnsweredAtGT applies the GT predicate on the "answered_at" field.
+func AnsweredAtGT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldAnsweredAt, v))
+}
+
+// AnsweredAtGTE applies the GTE predicate on the "answered_at" field.
+func AnsweredAtGTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldAnsweredAt, v))
+}
+
+// AnsweredAtLT applies the LT predicate on the "answered_at" field.
+func AnsweredAtLT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldAnsweredAt, v))
+}
+
+// AnsweredAtLTE applies the LTE predicate on the "answered_at" field.
+func AnsweredAtLTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldAnsweredAt, v))
+}
+
+// AnsweredAtIsNil applies the IsNil predicate on the "answered_at" field.
+func AnsweredAtIsNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIsNull(FieldAnsweredAt))
+}
+
+// AnsweredAtNotNil applies the NotNil predicate on the "answered_at" field.
+func AnsweredAtNotNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotNull(FieldAnsweredAt))
+}
+
+// ExpiredAtEQ applies the EQ predicate on the "expired_at" field.
+func ExpiredAtEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtNEQ applies the NEQ predicate on the "expired_at" field.
+func ExpiredAtNEQ(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtIn applies the In predicate on the "expired_at" field.
+func ExpiredAtIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtNotIn applies the NotIn predicate on the "expired_at" field.
+func ExpiredAtNotIn(vs ...time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtGT applies the GT predicate on the "expired_at" field.
+func ExpiredAtGT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGT(FieldExpiredAt, v))
+}
+
+// ExpiredAtGTE applies the GTE predicate on the "expired_at" field.
+func ExpiredAtGTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldGTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtLT applies the LT predicate on the "expired_at" field.
+func ExpiredAtLT(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLT(FieldExpiredAt, v))
+}
+
+// ExpiredAtLTE applies the LTE predicate on the "expired_at" field.
+func ExpiredAtLTE(v time.Time) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldLTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtIsNil applies the IsNil predicate on the "expired_at" field.
+func ExpiredAtIsNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldIsNull(FieldExpiredAt))
+}
+
+// ExpiredAtNotNil applies the NotNil predicate on the "expired_at" field.
+func ExpiredAtNotNil() predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNotNull(FieldExpiredAt))
+}
+
+// SharedEQ applies the EQ predicate on the "shared" field.
+func SharedEQ(v bool) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldEQ(FieldShared, v))
+}
+
+// SharedNEQ applies the NEQ predicate on the "shared" field.
+func SharedNEQ(v bool) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.FieldNEQ(FieldShared, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CaseClarification) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CaseClarification) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CaseClarification) predicate.CaseClarification {
+	return predicate.CaseClarification(sql.NotPredicates(p))
+}
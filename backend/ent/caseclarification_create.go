@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseclarification"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CaseClarificationCreate is the builder for creating a CaseClarification entity.
+type CaseClarificationCreate struct {
+	config
+	mutation *CaseClarificationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CaseClarificationCreate) SetCreatedAt(v time.Time) *CaseClarificationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableCreatedAt(v *time.Time) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CaseClarificationCreate) SetUpdatedAt(v time.Time) *CaseClarificationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableUpdatedAt(v *time.Time) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CaseClarificationCreate) SetDeletedAt(v time.Time) *CaseClarificationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableDeletedAt(v *time.Time) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *CaseClarificationCreate) SetValidationCaseID(v int) *CaseClarificationCreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetConsultationRequestID sets the "consultation_request_id" field.
+func (_c *CaseClarificationCreate) SetConsultationRequestID(v int) *CaseClarificationCreate {
+	_c.mutation.SetConsultationRequestID(v)
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *CaseClarificationCreate) SetValidatorUserID(v int) *CaseClarificationCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_c *CaseClarificationCreate) SetWorkflowCycle(v int) *CaseClarificationCreate {
+	_c.mutation.SetWorkflowCycle(v)
+	return _c
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableWorkflowCycle(v *int) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetWorkflowCycle(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *CaseClarificationCreate) SetKind(v string) *CaseClarificationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *CaseClarificationCreate) SetBody(v string) *CaseClarificationCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CaseClarificationCreate) SetStatus(v string) *CaseClarificationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableStatus(v *string) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *CaseClarificationCreate) SetAnswer(v string) *CaseClarificationCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableAnswer(v *string) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
+// SetAnsweredByUserID sets the "answered_by_user_id" field.
+func (_c *CaseClarificationCreate) SetAnsweredByUserID(v int) *CaseClarificationCreate {
+	_c.mutation.SetAnsweredByUserID(v)
+	return _c
+}
+
+// SetNillableAnsweredByUserID sets the "answered_by_user_id" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableAnsweredByUserID(v *int) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetAnsweredByUserID(*v)
+	}
+	return _c
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (_c *CaseClarificationCreate) SetAnsweredAt(v time.Time) *CaseClarificationCreate {
+	_c.mutation.SetAnsweredAt(v)
+	return _c
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableAnsweredAt(v *time.Time) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetAnsweredAt(*v)
+	}
+	return _c
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_c *CaseClarificationCreate) SetExpiredAt(v time.Time) *CaseClarificationCreate {
+	_c.mutation.SetExpiredAt(v)
+	return _c
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableExpiredAt(v *time.Time) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetExpiredAt(*v)
+	}
+	return _c
+}
+
+// SetShared sets the "shared" field.
+func (_c *CaseClarificationCreate) SetShared(v bool) *CaseClarificationCreate {
+	_c.mutation.SetShared(v)
+	return _c
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_c *CaseClarificationCreate) SetNillableShared(v *bool) *CaseClarificationCreate {
+	if v != nil {
+		_c.SetShared(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CaseClarificationMutation object of the builder.
+func (_c *CaseClarificationCreate) Mutation() *CaseClarificationMutation {
+	return _c.mutation
+}
+
+// Save creates the CaseClarification in the database.
+func (_c *CaseClarificationCreate) Save(ctx context.Context) (*CaseClarification, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CaseClarificationCreate) SaveX(ctx context.Context) *CaseClarification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CaseClarificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CaseClarificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CaseClarificationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := caseclarification.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := caseclarification.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		v := caseclarification.DefaultWorkflowCycle
+		_c.mutation.SetWorkflowCycle(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := caseclarification.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		v := caseclarification.DefaultAnswer
+		_c.mutation.SetAnswer(v)
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		v := caseclarification.DefaultShared
+		_c.mutation.SetShared(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CaseClarificationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CaseClarification.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CaseClarification.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "CaseClarification.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := caseclarification.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConsultationRequestID(); !ok {
+		return &ValidationError{Name: "consultation_request_id", err: errors.New(`ent: missing required field "CaseClarification.consultation_request_id"`)}
+	}
+	if v, ok := _c.mutation.ConsultationRequestID(); ok {
+		if err := caseclarification.ConsultationRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "consultation_request_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.consultation_request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "CaseClarification.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := caseclarification.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		return &ValidationError{Name: "workflow_cycle", err: errors.New(`ent: missing required field "CaseClarification.workflow_cycle"`)}
+	}
+	if v, ok := _c.mutation.WorkflowCycle(); ok {
+		if err := caseclarification.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.workflow_cycle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "CaseClarification.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := caseclarification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "CaseClarification.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := caseclarification.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CaseClarification.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := caseclarification.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Answer(); ok {
+		if err := caseclarification.AnswerValidator(v); err != nil {
+			return &ValidationError{Name: "answer", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.answer": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		return &ValidationError{Name: "shared", err: errors.New(`ent: missing required field "CaseClarification.shared"`)}
+	}
+	return nil
+}
+
+func (_c *CaseClarificationCreate) sqlSave(ctx context.Context) (*CaseClarification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CaseClarificationCreate) createSpec() (*CaseClarification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CaseClarification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(caseclarification.Table, sqlgraph.NewFieldSpec(caseclarification.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(caseclarification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(caseclarification.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(caseclarification.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(caseclarification.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.ConsultationRequestID(); ok {
+		_spec.SetField(caseclarification.FieldConsultationRequestID, field.TypeInt, value)
+		_node.ConsultationRequestID = value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(caseclarification.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.WorkflowCycle(); ok {
+		_spec.SetField(caseclarification.FieldWorkflowCycle, field.TypeInt, value)
+		_node.WorkflowCycle = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(caseclarification.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(caseclarification.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(caseclarification.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(caseclarification.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.AnsweredByUserID(); ok {
+		_spec.SetField(caseclarification.FieldAnsweredByUserID, field.TypeInt, value)
+		_node.AnsweredByUserID = &value
+	}
+	if value, ok := _c.mutation.AnsweredAt(); ok {
+		_spec.SetField(caseclarification.FieldAnsweredAt, field.TypeTime, value)
+		_node.AnsweredAt = &value
+	}
+	if value, ok := _c.mutation.ExpiredAt(); ok {
+		_spec.SetField(caseclarification.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = &value
+	}
+	if value, ok := _c.mutation.Shared(); ok {
+		_spec.SetField(caseclarification.FieldShared, field.TypeBool, value)
+		_node.Shared = value
+	}
+	return _node, _spec
+}
+
+// CaseClarificationCreateBulk is the builder for creating many CaseClarification entities in bulk.
+type CaseClarificationCreateBulk struct {
+	config
+	err      error
+	builders []*CaseClarificationCreate
+}
+
+// Save creates the CaseClarification entities in the database.
+func (_c *CaseClarificationCreateBulk) Save(ctx context.Context) ([]*CaseClarification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CaseClarification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CaseClarificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CaseClarificationCreateBulk) SaveX(ctx context.Context) []*CaseClarification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CaseClarificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CaseClarificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
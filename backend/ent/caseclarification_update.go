@@ -0,0 +1,940 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CaseClarificationUpdate is the builder for updating CaseClarification entities.
+type CaseClarificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CaseClarificationMutation
+}
+
+// Where appends a list predicates to the CaseClarificationUpdate builder.
+func (_u *CaseClarificationUpdate) Where(ps ...predicate.CaseClarification) *CaseClarificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CaseClarificationUpdate) SetUpdatedAt(v time.Time) *CaseClarificationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CaseClarificationUpdate) SetDeletedAt(v time.Time) *CaseClarificationUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableDeletedAt(v *time.Time) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CaseClarificationUpdate) ClearDeletedAt() *CaseClarificationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *CaseClarificationUpdate) SetValidationCaseID(v int) *CaseClarificationUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableValidationCaseID(v *int) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *CaseClarificationUpdate) AddValidationCaseID(v int) *CaseClarificationUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetConsultationRequestID sets the "consultation_request_id" field.
+func (_u *CaseClarificationUpdate) SetConsultationRequestID(v int) *CaseClarificationUpdate {
+	_u.mutation.ResetConsultationRequestID()
+	_u.mutation.SetConsultationRequestID(v)
+	return _u
+}
+
+// SetNillableConsultationRequestID sets the "consultation_request_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableConsultationRequestID(v *int) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetConsultationRequestID(*v)
+	}
+	return _u
+}
+
+// AddConsultationRequestID adds value to the "consultation_request_id" field.
+func (_u *CaseClarificationUpdate) AddConsultationRequestID(v int) *CaseClarificationUpdate {
+	_u.mutation.AddConsultationRequestID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *CaseClarificationUpdate) SetValidatorUserID(v int) *CaseClarificationUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableValidatorUserID(v *int) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *CaseClarificationUpdate) AddValidatorUserID(v int) *CaseClarificationUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *CaseClarificationUpdate) SetWorkflowCycle(v int) *CaseClarificationUpdate {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableWorkflowCycle(v *int) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *CaseClarificationUpdate) AddWorkflowCycle(v int) *CaseClarificationUpdate {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CaseClarificationUpdate) SetKind(v string) *CaseClarificationUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableKind(v *string) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *CaseClarificationUpdate) SetBody(v string) *CaseClarificationUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableBody(v *string) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CaseClarificationUpdate) SetStatus(v string) *CaseClarificationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableStatus(v *string) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *CaseClarificationUpdate) SetAnswer(v string) *CaseClarificationUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableAnswer(v *string) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *CaseClarificationUpdate) ClearAnswer() *CaseClarificationUpdate {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
+// SetAnsweredByUserID sets the "answered_by_user_id" field.
+func (_u *CaseClarificationUpdate) SetAnsweredByUserID(v int) *CaseClarificationUpdate {
+	_u.mutation.ResetAnsweredByUserID()
+	_u.mutation.SetAnsweredByUserID(v)
+	return _u
+}
+
+// SetNillableAnsweredByUserID sets the "answered_by_user_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableAnsweredByUserID(v *int) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetAnsweredByUserID(*v)
+	}
+	return _u
+}
+
+// AddAnsweredByUserID adds value to the "answered_by_user_id" field.
+func (_u *CaseClarificationUpdate) AddAnsweredByUserID(v int) *CaseClarificationUpdate {
+	_u.mutation.AddAnsweredByUserID(v)
+	return _u
+}
+
+// ClearAnsweredByUserID clears the value of the "answered_by_user_id" field.
+func (_u *CaseClarificationUpdate) ClearAnsweredByUserID() *CaseClarificationUpdate {
+	_u.mutation.ClearAnsweredByUserID()
+	return _u
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (_u *CaseClarificationUpdate) SetAnsweredAt(v time.Time) *CaseClarificationUpdate {
+	_u.mutation.SetAnsweredAt(v)
+	return _u
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableAnsweredAt(v *time.Time) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetAnsweredAt(*v)
+	}
+	return _u
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (_u *CaseClarificationUpdate) ClearAnsweredAt() *CaseClarificationUpdate {
+	_u.mutation.ClearAnsweredAt()
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *CaseClarificationUpdate) SetExpiredAt(v time.Time) *CaseClarificationUpdate {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableExpiredAt(v *time.Time) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *CaseClarificationUpdate) ClearExpiredAt() *CaseClarificationUpdate {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *CaseClarificationUpdate) SetShared(v bool) *CaseClarificationUpdate {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *CaseClarificationUpdate) SetNillableShared(v *bool) *CaseClarificationUpdate {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CaseClarificationMutation object of the builder.
+func (_u *CaseClarificationUpdate) Mutation() *CaseClarificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CaseClarificationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CaseClarificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CaseClarificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CaseClarificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CaseClarificationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := caseclarification.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CaseClarificationUpdate) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := caseclarification.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConsultationRequestID(); ok {
+		if err := caseclarification.ConsultationRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "consultation_request_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.consultation_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := caseclarification.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := caseclarification.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := caseclarification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := caseclarification.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := caseclarification.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Answer(); ok {
+		if err := caseclarification.AnswerValidator(v); err != nil {
+			return &ValidationError{Name: "answer", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.answer": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CaseClarificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(caseclarification.Table, caseclarification.Columns, sqlgraph.NewFieldSpec(caseclarification.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(caseclarification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(caseclarification.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(caseclarification.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(caseclarification.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(caseclarification.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConsultationRequestID(); ok {
+		_spec.SetField(caseclarification.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsultationRequestID(); ok {
+		_spec.AddField(caseclarification.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(caseclarification.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(caseclarification.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(caseclarification.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(caseclarification.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(caseclarification.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(caseclarification.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(caseclarification.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(caseclarification.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(caseclarification.FieldAnswer, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnsweredByUserID(); ok {
+		_spec.SetField(caseclarification.FieldAnsweredByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnsweredByUserID(); ok {
+		_spec.AddField(caseclarification.FieldAnsweredByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.AnsweredByUserIDCleared() {
+		_spec.ClearField(caseclarification.FieldAnsweredByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AnsweredAt(); ok {
+		_spec.SetField(caseclarification.FieldAnsweredAt, field.TypeTime, value)
+	}
+	if _u.mutation.AnsweredAtCleared() {
+		_spec.ClearField(caseclarification.FieldAnsweredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(caseclarification.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(caseclarification.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(caseclarification.FieldShared, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{caseclarification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CaseClarificationUpdateOne is the builder for updating a single CaseClarification entity.
+type CaseClarificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CaseClarificationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CaseClarificationUpdateOne) SetUpdatedAt(v time.Time) *CaseClarificationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CaseClarificationUpdateOne) SetDeletedAt(v time.Time) *CaseClarificationUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableDeletedAt(v *time.Time) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CaseClarificationUpdateOne) ClearDeletedAt() *CaseClarificationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *CaseClarificationUpdateOne) SetValidationCaseID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableValidationCaseID(v *int) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *CaseClarificationUpdateOne) AddValidationCaseID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetConsultationRequestID sets the "consultation_request_id" field.
+func (_u *CaseClarificationUpdateOne) SetConsultationRequestID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.ResetConsultationRequestID()
+	_u.mutation.SetConsultationRequestID(v)
+	return _u
+}
+
+// SetNillableConsultationRequestID sets the "consultation_request_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableConsultationRequestID(v *int) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetConsultationRequestID(*v)
+	}
+	return _u
+}
+
+// AddConsultationRequestID adds value to the "consultation_request_id" field.
+func (_u *CaseClarificationUpdateOne) AddConsultationRequestID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.AddConsultationRequestID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *CaseClarificationUpdateOne) SetValidatorUserID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableValidatorUserID(v *int) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *CaseClarificationUpdateOne) AddValidatorUserID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *CaseClarificationUpdateOne) SetWorkflowCycle(v int) *CaseClarificationUpdateOne {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableWorkflowCycle(v *int) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *CaseClarificationUpdateOne) AddWorkflowCycle(v int) *CaseClarificationUpdateOne {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CaseClarificationUpdateOne) SetKind(v string) *CaseClarificationUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableKind(v *string) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *CaseClarificationUpdateOne) SetBody(v string) *CaseClarificationUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableBody(v *string) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CaseClarificationUpdateOne) SetStatus(v string) *CaseClarificationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableStatus(v *string) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *CaseClarificationUpdateOne) SetAnswer(v string) *CaseClarificationUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableAnswer(v *string) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (_u *CaseClarificationUpdateOne) ClearAnswer() *CaseClarificationUpdateOne {
+	_u.mutation.ClearAnswer()
+	return _u
+}
+
+// SetAnsweredByUserID sets the "answered_by_user_id" field.
+func (_u *CaseClarificationUpdateOne) SetAnsweredByUserID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.ResetAnsweredByUserID()
+	_u.mutation.SetAnsweredByUserID(v)
+	return _u
+}
+
+// SetNillableAnsweredByUserID sets the "answered_by_user_id" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableAnsweredByUserID(v *int) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetAnsweredByUserID(*v)
+	}
+	return _u
+}
+
+// AddAnsweredByUserID adds value to the "answered_by_user_id" field.
+func (_u *CaseClarificationUpdateOne) AddAnsweredByUserID(v int) *CaseClarificationUpdateOne {
+	_u.mutation.AddAnsweredByUserID(v)
+	return _u
+}
+
+// ClearAnsweredByUserID clears the value of the "answered_by_user_id" field.
+func (_u *CaseClarificationUpdateOne) ClearAnsweredByUserID() *CaseClarificationUpdateOne {
+	_u.mutation.ClearAnsweredByUserID()
+	return _u
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (_u *CaseClarificationUpdateOne) SetAnsweredAt(v time.Time) *CaseClarificationUpdateOne {
+	_u.mutation.SetAnsweredAt(v)
+	return _u
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableAnsweredAt(v *time.Time) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetAnsweredAt(*v)
+	}
+	return _u
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (_u *CaseClarificationUpdateOne) ClearAnsweredAt() *CaseClarificationUpdateOne {
+	_u.mutation.ClearAnsweredAt()
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *CaseClarificationUpdateOne) SetExpiredAt(v time.Time) *CaseClarificationUpdateOne {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableExpiredAt(v *time.Time) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *CaseClarificationUpdateOne) ClearExpiredAt() *CaseClarificationUpdateOne {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *CaseClarificationUpdateOne) SetShared(v bool) *CaseClarificationUpdateOne {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *CaseClarificationUpdateOne) SetNillableShared(v *bool) *CaseClarificationUpdateOne {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CaseClarificationMutation object of the builder.
+func (_u *CaseClarificationUpdateOne) Mutation() *CaseClarificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CaseClarificationUpdate builder.
+func (_u *CaseClarificationUpdateOne) Where(ps ...predicate.CaseClarification) *CaseClarificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CaseClarificationUpdateOne) Select(field string, fields ...string) *CaseClarificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CaseClarification entity.
+func (_u *CaseClarificationUpdateOne) Save(ctx context.Context) (*CaseClarification, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CaseClarificationUpdateOne) SaveX(ctx context.Context) *CaseClarification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CaseClarificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CaseClarificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CaseClarificationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := caseclarification.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CaseClarificationUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := caseclarification.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConsultationRequestID(); ok {
+		if err := caseclarification.ConsultationRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "consultation_request_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.consultation_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := caseclarification.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := caseclarification.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := caseclarification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := caseclarification.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := caseclarification.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Answer(); ok {
+		if err := caseclarification.AnswerValidator(v); err != nil {
+			return &ValidationError{Name: "answer", err: fmt.Errorf(`ent: validator failed for field "CaseClarification.answer": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CaseClarificationUpdateOne) sqlSave(ctx context.Context) (_node *CaseClarification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(caseclarification.Table, caseclarification.Columns, sqlgraph.NewFieldSpec(caseclarification.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CaseClarification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, caseclarification.FieldID)
+		for _, f := range fields {
+			if !caseclarification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != caseclarification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(caseclarification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(caseclarification.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(caseclarification.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(caseclarification.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(caseclarification.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConsultationRequestID(); ok {
+		_spec.SetField(caseclarification.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsultationRequestID(); ok {
+		_spec.AddField(caseclarification.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(caseclarification.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(caseclarification.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(caseclarification.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(caseclarification.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(caseclarification.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(caseclarification.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(caseclarification.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(caseclarification.FieldAnswer, field.TypeString, value)
+	}
+	if _u.mutation.AnswerCleared() {
+		_spec.ClearField(caseclarification.FieldAnswer, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnsweredByUserID(); ok {
+		_spec.SetField(caseclarification.FieldAnsweredByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnsweredByUserID(); ok {
+		_spec.AddField(caseclarification.FieldAnsweredByUserID, field.TypeInt, value)
+	}
+	if _u.mutation.AnsweredByUserIDCleared() {
+		_spec.ClearField(caseclarification.FieldAnsweredByUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AnsweredAt(); ok {
+		_spec.SetField(caseclarification.FieldAnsweredAt, field.TypeTime, value)
+	}
+	if _u.mutation.AnsweredAtCleared() {
+		_spec.ClearField(caseclarification.FieldAnsweredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(caseclarification.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(caseclarification.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(caseclarification.FieldShared, field.TypeBool, value)
+	}
+	_node = &CaseClarification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{caseclarification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
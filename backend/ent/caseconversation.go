@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseconversation"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CaseConversation is the model entity for the CaseConversation schema.
+type CaseConversation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidationCaseID holds the value of the "validation_case_id" field.
+	ValidationCaseID int `json:"validation_case_id,omitempty"`
+	// OwnerUserID holds the value of the "owner_user_id" field.
+	OwnerUserID int `json:"owner_user_id,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// LastMessageAt holds the value of the "last_message_at" field.
+	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CaseConversationQuery when eager-loading is set.
+	Edges        CaseConversationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CaseConversationEdges holds the relations/edges for other nodes in the graph.
+type CaseConversationEdges struct {
+	// Messages holds the value of the messages edge.
+	Messages []*CaseMessage `json:"messages,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e CaseConversationEdges) MessagesOrErr() ([]*CaseMessage, error) {
+	if e.loadedTypes[0] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CaseConversation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case caseconversation.FieldID, caseconversation.FieldValidationCaseID, caseconversation.FieldOwnerUserID, caseconversation.FieldValidatorUserID:
+			values[i] = new(sql.NullInt64)
+		case caseconversation.FieldCreatedAt, caseconversation.FieldUpdatedAt, caseconversation.FieldDeletedAt, caseconversation.FieldLastMessageAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CaseConversation fields.
+func (_m *CaseConversation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case caseconversation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case caseconversation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case caseconversation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case caseconversation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case caseconversation.FieldValidationCaseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_case_id", values[i])
+			} else if value.Valid {
+				_m.ValidationCaseID = int(value.Int64)
+			}
+		case caseconversation.FieldOwnerUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_user_id", values[i])
+			} else if value.Valid {
+				_m.OwnerUserID = int(value.Int64)
+			}
+		case caseconversation.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case caseconversation.FieldLastMessageAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_message_at", values[i])
+			} else if value.Valid {
+				_m.LastMessageAt = new(time.Time)
+				*_m.LastMessageAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CaseConversation.
+// This includes values selected through modifiers, order, etc.
+func (_m *CaseConversation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMessages queries the "messages" edge of the CaseConversation entity.
+func (_m *CaseConversation) QueryMessages() *CaseMessageQuery {
+	return NewCaseConversationClient(_m.config).QueryMessages(_m)
+}
+
+// Update returns a builder for updating this CaseConversation.
+// Note that you need to call CaseConversation.Unwrap() before calling this method if this CaseConversation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CaseConversation) Update() *CaseConversationUpdateOne {
+	return NewCaseConversationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CaseConversation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CaseConversation) Unwrap() *CaseConversation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CaseConversation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CaseConversation) String() string {
+	var builder strings.Builder
+	builder.WriteString("CaseConversation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validation_case_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidationCaseID))
+	builder.WriteString(", ")
+	builder.WriteString("owner_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerUserID))
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	if v := _m.LastMessageAt; v != nil {
+		builder.WriteString("last_message_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CaseConversations is a parsable slice of CaseConversation.
+type CaseConversations []*CaseConversation
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package caseconversation
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// OwnerUserID applies equality check predicate on the "owner_user_id" field. It's identical to OwnerUserIDEQ.
+func OwnerUserID(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldOwnerUserID, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// LastMessageAt applies equality check predicate on the "last_message_at" field. It's identical to LastMessageAtEQ.
+func LastMessageAt(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// OwnerUserIDEQ applies the EQ predicate on the "owner_user_id" field.
+func OwnerUserIDEQ(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDNEQ applies the NEQ predicate on the "owner_user_id" field.
+func OwnerUserIDNEQ(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDIn applies the In predicate on the "owner_user_id" field.
+func OwnerUserIDIn(vs ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldOwnerUserID, vs...))
+}
+
+// OwnerUserIDNotIn applies the NotIn predicate on the "owner_user_id" field.
+func OwnerUserIDNotIn(vs ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldOwnerUserID, vs...))
+}
+
+// OwnerUserIDGT applies the GT predicate on the "owner_user_id" field.
+func OwnerUserIDGT(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDGTE applies the GTE predicate on the "owner_user_id" field.
+func OwnerUserIDGTE(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDLT applies the LT predicate on the "owner_user_id" field.
+func OwnerUserIDLT(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDLTE applies the LTE predicate on the "owner_user_id" field.
+func OwnerUserIDLTE(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldOwnerUserID, v))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// LastMessageAtEQ applies the EQ predicate on the "last_message_at" field.
+func LastMessageAtEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtNEQ applies the NEQ predicate on the "last_message_at" field.
+func LastMessageAtNEQ(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNEQ(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIn applies the In predicate on the "last_message_at" field.
+func LastMessageAtIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtNotIn applies the NotIn predicate on the "last_message_at" field.
+func LastMessageAtNotIn(vs ...time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotIn(FieldLastMessageAt, vs...))
+}
+
+// LastMessageAtGT applies the GT predicate on the "last_message_at" field.
+func LastMessageAtGT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtGTE applies the GTE predicate on the "last_message_at" field.
+func LastMessageAtGTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldGTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLT applies the LT predicate on the "last_message_at" field.
+func LastMessageAtLT(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLT(FieldLastMessageAt, v))
+}
+
+// LastMessageAtLTE applies the LTE predicate on the "last_message_at" field.
+func LastMessageAtLTE(v time.Time) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldLTE(FieldLastMessageAt, v))
+}
+
+// LastMessageAtIsNil applies the IsNil predicate on the "last_message_at" field.
+func LastMessageAtIsNil() predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldIsNull(FieldLastMessageAt))
+}
+
+// LastMessageAtNotNil applies the NotNil predicate on the "last_message_at" field.
+func LastMessageAtNotNil() predicate.CaseConversation {
+	return predicate.CaseConversation(sql.FieldNotNull(FieldLastMessageAt))
+}
+
+// HasMessages applies the HasEdge predicate on the "messages" edge.
+func HasMessages() predicate.CaseConversation {
+	return predicate.CaseConversation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessagesWith applies the HasEdge predicate on the "messages" edge with a given conditions (other predicates).
+func HasMessagesWith(preds ...predicate.CaseMessage) predicate.CaseConversation {
+	return predicate.CaseConversation(func(s *sql.Selector) {
+		step := newMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CaseConversation) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CaseConversation) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CaseConversation) predicate.CaseConversation {
+	return predicate.CaseConversation(sql.NotPredicates(p))
+}
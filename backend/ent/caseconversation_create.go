@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CaseConversationCreate is the builder for creating a CaseConversation entity.
+type CaseConversationCreate struct {
+	config
+	mutation *CaseConversationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CaseConversationCreate) SetCreatedAt(v time.Time) *CaseConversationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CaseConversationCreate) SetNillableCreatedAt(v *time.Time) *CaseConversationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CaseConversationCreate) SetUpdatedAt(v time.Time) *CaseConversationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CaseConversationCreate) SetNillableUpdatedAt(v *time.Time) *CaseConversationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CaseConversationCreate) SetDeletedAt(v time.Time) *CaseConversationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CaseConversationCreate) SetNillableDeletedAt(v *time.Time) *CaseConversationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *CaseConversationCreate) SetValidationCaseID(v int) *CaseConversationCreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetOwnerUserID sets the "owner_user_id" field.
+func (_c *CaseConversationCreate) SetOwnerUserID(v int) *CaseConversationCreate {
+	_c.mutation.SetOwnerUserID(v)
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *CaseConversationCreate) SetValidatorUserID(v int) *CaseConversationCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_c *CaseConversationCreate) SetLastMessageAt(v time.Time) *CaseConversationCreate {
+	_c.mutation.SetLastMessageAt(v)
+	return _c
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_c *CaseConversationCreate) SetNillableLastMessageAt(v *time.Time) *CaseConversationCreate {
+	if v != nil {
+		_c.SetLastMessageAt(*v)
+	}
+	return _c
+}
+
+// AddMessageIDs adds the "messages" edge to the CaseMessage entity by IDs.
+func (_c *CaseConversationCreate) AddMessageIDs(ids ...int) *CaseConversationCreate {
+	_c.mutation.AddMessageIDs(ids...)
+	return _c
+}
+
+// AddMessages adds the "messages" edges to the CaseMessage entity.
+func (_c *CaseConversationCreate) AddMessages(v ...*CaseMessage) *CaseConversationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMessageIDs(ids...)
+}
+
+// Mutation returns the CaseConversationMutation object of the builder.
+func (_c *CaseConversationCreate) Mutation() *CaseConversationMutation {
+	return _c.mutation
+}
+
+// Save creates the CaseConversation in the database.
+func (_c *CaseConversationCreate) Save(ctx context.Context) (*CaseConversation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CaseConversationCreate) SaveX(ctx context.Context) *CaseConversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CaseConversationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CaseConversationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CaseConversationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := caseconversation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := caseconversation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CaseConversationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CaseConversation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CaseConversation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "CaseConversation.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := caseconversation.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OwnerUserID(); !ok {
+		return &ValidationError{Name: "owner_user_id", err: errors.New(`ent: missing required field "CaseConversation.owner_user_id"`)}
+	}
+	if v, ok := _c.mutation.OwnerUserID(); ok {
+		if err := caseconversation.OwnerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.owner_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "CaseConversation.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := caseconversation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.validator_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CaseConversationCreate) sqlSave(ctx context.Context) (*CaseConversation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CaseConversationCreate) createSpec() (*CaseConversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CaseConversation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(caseconversation.Table, sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(caseconversation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(caseconversation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(caseconversation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(caseconversation.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.OwnerUserID(); ok {
+		_spec.SetField(caseconversation.FieldOwnerUserID, field.TypeInt, value)
+		_node.OwnerUserID = value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(caseconversation.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.LastMessageAt(); ok {
+		_spec.SetField(caseconversation.FieldLastMessageAt, field.TypeTime, value)
+		_node.LastMessageAt = &value
+	}
+	if nodes := _c.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CaseConversationCreateBulk is the builder for creating many CaseConversation entities in bulk.
+type CaseConversationCreateBulk struct {
+	config
+	err      error
+	builders []*CaseConversationCreate
+}
+
+// Save creates the CaseConversation entities in the database.
+func (_c *CaseConversationCreateBulk) Save(ctx context.Context) ([]*CaseConversation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CaseConversation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CaseConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CaseConversationCreateBulk) SaveX(ctx context.Context) []*CaseConversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CaseConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CaseConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
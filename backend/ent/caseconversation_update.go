@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CaseConversationUpdate is the builder for updating CaseConversation entities.
+type CaseConversationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CaseConversationMutation
+}
+
+// Where appends a list predicates to the CaseConversationUpdate builder.
+func (_u *CaseConversationUpdate) Where(ps ...predicate.CaseConversation) *CaseConversationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CaseConversationUpdate) SetUpdatedAt(v time.Time) *CaseConversationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CaseConversationUpdate) SetDeletedAt(v time.Time) *CaseConversationUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CaseConversationUpdate) SetNillableDeletedAt(v *time.Time) *CaseConversationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CaseConversationUpdate) ClearDeletedAt() *CaseConversationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *CaseConversationUpdate) SetValidationCaseID(v int) *CaseConversationUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *CaseConversationUpdate) SetNillableValidationCaseID(v *int) *CaseConversationUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *CaseConversationUpdate) AddValidationCaseID(v int) *CaseConversationUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetOwnerUserID sets the "owner_user_id" field.
+func (_u *CaseConversationUpdate) SetOwnerUserID(v int) *CaseConversationUpdate {
+	_u.mutation.ResetOwnerUserID()
+	_u.mutation.SetOwnerUserID(v)
+	return _u
+}
+
+// SetNillableOwnerUserID sets the "owner_user_id" field if the given value is not nil.
+func (_u *CaseConversationUpdate) SetNillableOwnerUserID(v *int) *CaseConversationUpdate {
+	if v != nil {
+		_u.SetOwnerUserID(*v)
+	}
+	return _u
+}
+
+// AddOwnerUserID adds value to the "owner_user_id" field.
+func (_u *CaseConversationUpdate) AddOwnerUserID(v int) *CaseConversationUpdate {
+	_u.mutation.AddOwnerUserID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *CaseConversationUpdate) SetValidatorUserID(v int) *CaseConversationUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *CaseConversationUpdate) SetNillableValidatorUserID(v *int) *CaseConversationUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *CaseConversationUpdate) AddValidatorUserID(v int) *CaseConversationUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *CaseConversationUpdate) SetLastMessageAt(v time.Time) *CaseConversationUpdate {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *CaseConversationUpdate) SetNillableLastMessageAt(v *time.Time) *CaseConversationUpdate {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *CaseConversationUpdate) ClearLastMessageAt() *CaseConversationUpdate {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the CaseMessage entity by IDs.
+func (_u *CaseConversationUpdate) AddMessageIDs(ids ...int) *CaseConversationUpdate {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the CaseMessage entity.
+func (_u *CaseConversationUpdate) AddMessages(v ...*CaseMessage) *CaseConversationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// Mutation returns the CaseConversationMutation object of the builder.
+func (_u *CaseConversationUpdate) Mutation() *CaseConversationMutation {
+	return _u.mutation
+}
+
+// ClearMessages clears all "messages" edges to the CaseMessage entity.
+func (_u *CaseConversationUpdate) ClearMessages() *CaseConversationUpdate {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to CaseMessage entities by IDs.
+func (_u *CaseConversationUpdate) RemoveMessageIDs(ids ...int) *CaseConversationUpdate {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to CaseMessage entities.
+func (_u *CaseConversationUpdate) RemoveMessages(v ...*CaseMessage) *CaseConversationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CaseConversationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CaseConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CaseConversationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CaseConversationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CaseConversationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := caseconversation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CaseConversationUpdate) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := caseconversation.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OwnerUserID(); ok {
+		if err := caseconversation.OwnerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.owner_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := caseconversation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.validator_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CaseConversationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(caseconversation.Table, caseconversation.Columns, sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(caseconversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(caseconversation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(caseconversation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(caseconversation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(caseconversation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerUserID(); ok {
+		_spec.SetField(caseconversation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerUserID(); ok {
+		_spec.AddField(caseconversation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(caseconversation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(caseconversation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(caseconversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(caseconversation.FieldLastMessageAt, field.TypeTime)
+	}
+	if _u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !_u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{caseconversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CaseConversationUpdateOne is the builder for updating a single CaseConversation entity.
+type CaseConversationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CaseConversationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CaseConversationUpdateOne) SetUpdatedAt(v time.Time) *CaseConversationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CaseConversationUpdateOne) SetDeletedAt(v time.Time) *CaseConversationUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CaseConversationUpdateOne) SetNillableDeletedAt(v *time.Time) *CaseConversationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CaseConversationUpdateOne) ClearDeletedAt() *CaseConversationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *CaseConversationUpdateOne) SetValidationCaseID(v int) *CaseConversationUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *CaseConversationUpdateOne) SetNillableValidationCaseID(v *int) *CaseConversationUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *CaseConversationUpdateOne) AddValidationCaseID(v int) *CaseConversationUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetOwnerUserID sets the "owner_user_id" field.
+func (_u *CaseConversationUpdateOne) SetOwnerUserID(v int) *CaseConversationUpdateOne {
+	_u.mutation.ResetOwnerUserID()
+	_u.mutation.SetOwnerUserID(v)
+	return _u
+}
+
+// SetNillableOwnerUserID sets the "owner_user_id" field if the given value is not nil.
+func (_u *CaseConversationUpdateOne) SetNillableOwnerUserID(v *int) *CaseConversationUpdateOne {
+	if v != nil {
+		_u.SetOwnerUserID(*v)
+	}
+	return _u
+}
+
+// AddOwnerUserID adds value to the "owner_user_id" field.
+func (_u *CaseConversationUpdateOne) AddOwnerUserID(v int) *CaseConversationUpdateOne {
+	_u.mutation.AddOwnerUserID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *CaseConversationUpdateOne) SetValidatorUserID(v int) *CaseConversationUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *CaseConversationUpdateOne) SetNillableValidatorUserID(v *int) *CaseConversationUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *CaseConversationUpdateOne) AddValidatorUserID(v int) *CaseConversationUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *CaseConversationUpdateOne) SetLastMessageAt(v time.Time) *CaseConversationUpdateOne {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *CaseConversationUpdateOne) SetNillableLastMessageAt(v *time.Time) *CaseConversationUpdateOne {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *CaseConversationUpdateOne) ClearLastMessageAt() *CaseConversationUpdateOne {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the CaseMessage entity by IDs.
+func (_u *CaseConversationUpdateOne) AddMessageIDs(ids ...int) *CaseConversationUpdateOne {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the CaseMessage entity.
+func (_u *CaseConversationUpdateOne) AddMessages(v ...*CaseMessage) *CaseConversationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// Mutation returns the CaseConversationMutation object of the builder.
+func (_u *CaseConversationUpdateOne) Mutation() *CaseConversationMutation {
+	return _u.mutation
+}
+
+// ClearMessages clears all "messages" edges to the CaseMessage entity.
+func (_u *CaseConversationUpdateOne) ClearMessages() *CaseConversationUpdateOne {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to CaseMessage entities by IDs.
+func (_u *CaseConversationUpdateOne) RemoveMessageIDs(ids ...int) *CaseConversationUpdateOne {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to CaseMessage entities.
+func (_u *CaseConversationUpdateOne) RemoveMessages(v ...*CaseMessage) *CaseConversationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// Where appends a list predicates to the CaseConversationUpdate builder.
+func (_u *CaseConversationUpdateOne) Where(ps ...predicate.CaseConversation) *CaseConversationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CaseConversationUpdateOne) Select(field string, fields ...string) *CaseConversationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CaseConversation entity.
+func (_u *CaseConversationUpdateOne) Save(ctx context.Context) (*CaseConversation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CaseConversationUpdateOne) SaveX(ctx context.Context) *CaseConversation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CaseConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CaseConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CaseConversationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := caseconversation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CaseConversationUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := caseconversation.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OwnerUserID(); ok {
+		if err := caseconversation.OwnerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.owner_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := caseconversation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseConversation.validator_user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CaseConversationUpdateOne) sqlSave(ctx context.Context) (_node *CaseConversation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(caseconversation.Table, caseconversation.Columns, sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CaseConversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, caseconversation.FieldID)
+		for _, f := range fields {
+			if !caseconversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != caseconversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(caseconversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(caseconversation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(caseconversation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(caseconversation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(caseconversation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerUserID(); ok {
+		_spec.SetField(caseconversation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerUserID(); ok {
+		_spec.AddField(caseconversation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(caseconversation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(caseconversation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(caseconversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(caseconversation.FieldLastMessageAt, field.TypeTime)
+	}
+	if _u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !_u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   caseconversation.MessagesTable,
+			Columns: []string{caseconversation.MessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CaseConversation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{caseconversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CaseMessage is the model entity for the CaseMessage schema.
+type CaseMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ConversationID holds the value of the "conversation_id" field.
+	ConversationID int `json:"conversation_id,omitempty"`
+	// SenderUserID holds the value of the "sender_user_id" field.
+	SenderUserID int `json:"sender_user_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// AttachmentDocumentIds holds the value of the "attachment_document_ids" field.
+	AttachmentDocumentIds []string `json:"attachment_document_ids,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CaseMessageQuery when eager-loading is set.
+	Edges        CaseMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CaseMessageEdges holds the relations/edges for other nodes in the graph.
+type CaseMessageEdges struct {
+	// Conversation holds the value of the conversation edge.
+	Conversation *CaseConversation `json:"conversation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConversationOrErr returns the Conversation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CaseMessageEdges) ConversationOrErr() (*CaseConversation, error) {
+	if e.Conversation != nil {
+		return e.Conversation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: caseconversation.Label}
+	}
+	return nil, &NotLoadedError{edge: "conversation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CaseMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case casemessage.FieldAttachmentDocumentIds:
+			values[i] = new([]byte)
+		case casemessage.FieldID, casemessage.FieldConversationID, casemessage.FieldSenderUserID:
+			values[i] = new(sql.NullInt64)
+		case casemessage.FieldBody:
+			values[i] = new(sql.NullString)
+		case casemessage.FieldCreatedAt, casemessage.FieldUpdatedAt, casemessage.FieldDeletedAt, casemessage.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CaseMessage fields.
+func (_m *CaseMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case casemessage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case casemessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case casemessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case casemessage.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case casemessage.FieldConversationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				_m.ConversationID = int(value.Int64)
+			}
+		case casemessage.FieldSenderUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_user_id", values[i])
+			} else if value.Valid {
+				_m.SenderUserID = int(value.Int64)
+			}
+		case casemessage.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case casemessage.FieldAttachmentDocumentIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_document_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttachmentDocumentIds); err != nil {
+					return fmt.Errorf("unmarshal field attachment_document_ids: %w", err)
+				}
+			}
+		case casemessage.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				_m.ReadAt = new(time.Time)
+				*_m.ReadAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CaseMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *CaseMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryConversation queries the "conversation" edge of the CaseMessage entity.
+func (_m *CaseMessage) QueryConversation() *CaseConversationQuery {
+	return NewCaseMessageClient(_m.config).QueryConversation(_m)
+}
+
+// Update returns a builder for updating this CaseMessage.
+// Note that you need to call CaseMessage.Unwrap() before calling this method if this CaseMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CaseMessage) Update() *CaseMessageUpdateOne {
+	return NewCaseMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CaseMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CaseMessage) Unwrap() *CaseMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CaseMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CaseMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("CaseMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("conversation_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ConversationID))
+	builder.WriteString(", ")
+	builder.WriteString("sender_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SenderUserID))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("attachment_document_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachmentDocumentIds))
+	builder.WriteString(", ")
+	if v := _m.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CaseMessages is a parsable slice of CaseMessage.
+type CaseMessages []*CaseMessage
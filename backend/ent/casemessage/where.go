@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package casemessage
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// SenderUserID applies equality check predicate on the "sender_user_id" field. It's identical to SenderUserIDEQ.
+func SenderUserID(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldSenderUserID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// ReadAt applies equality check predicate on the "read_at" field. It's identical to ReadAtEQ.
+func ReadAt(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldReadAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// SenderUserIDEQ applies the EQ predicate on the "sender_user_id" field.
+func SenderUserIDEQ(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldSenderUserID, v))
+}
+
+// SenderUserIDNEQ applies the NEQ predicate on the "sender_user_id" field.
+func SenderUserIDNEQ(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldSenderUserID, v))
+}
+
+// SenderUserIDIn applies the In predicate on the "sender_user_id" field.
+func SenderUserIDIn(vs ...int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldSenderUserID, vs...))
+}
+
+// SenderUserIDNotIn applies the NotIn predicate on the "sender_user_id" field.
+func SenderUserIDNotIn(vs ...int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldSenderUserID, vs...))
+}
+
+// SenderUserIDGT applies the GT predicate on the "sender_user_id" field.
+func SenderUserIDGT(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldSenderUserID, v))
+}
+
+// SenderUserIDGTE applies the GTE predicate on the "sender_user_id" field.
+func SenderUserIDGTE(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldSenderUserID, v))
+}
+
+// SenderUserIDLT applies the LT predicate on the "sender_user_id" field.
+func SenderUserIDLT(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldSenderUserID, v))
+}
+
+// SenderUserIDLTE applies the LTE predicate on the "sender_user_id" field.
+func SenderUserIDLTE(v int) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldSenderUserID, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotNull(FieldBody))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldContainsFold(FieldBody, v))
+}
+
+// AttachmentDocumentIdsIsNil applies the IsNil predicate on the "attachment_document_ids" field.
+func AttachmentDocumentIdsIsNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIsNull(FieldAttachmentDocumentIds))
+}
+
+// AttachmentDocumentIdsNotNil applies the NotNil predicate on the "attachment_document_ids" field.
+func AttachmentDocumentIdsNotNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotNull(FieldAttachmentDocumentIds))
+}
+
+// ReadAtEQ applies the EQ predicate on the "read_at" field.
+func ReadAtEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ReadAtNEQ applies the NEQ predicate on the "read_at" field.
+func ReadAtNEQ(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNEQ(FieldReadAt, v))
+}
+
+// ReadAtIn applies the In predicate on the "read_at" field.
+func ReadAtIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIn(FieldReadAt, vs...))
+}
+
+// ReadAtNotIn applies the NotIn predicate on the "read_at" field.
+func ReadAtNotIn(vs ...time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotIn(FieldReadAt, vs...))
+}
+
+// ReadAtGT applies the GT predicate on the "read_at" field.
+func ReadAtGT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGT(FieldReadAt, v))
+}
+
+// ReadAtGTE applies the GTE predicate on the "read_at" field.
+func ReadAtGTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldGTE(FieldReadAt, v))
+}
+
+// ReadAtLT applies the LT predicate on the "read_at" field.
+func ReadAtLT(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLT(FieldReadAt, v))
+}
+
+// ReadAtLTE applies the LTE predicate on the "read_at" field.
+func ReadAtLTE(v time.Time) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldLTE(FieldReadAt, v))
+}
+
+// ReadAtIsNil applies the IsNil predicate on the "read_at" field.
+func ReadAtIsNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldIsNull(FieldReadAt))
+}
+
+// ReadAtNotNil applies the NotNil predicate on the "read_at" field.
+func ReadAtNotNil() predicate.CaseMessage {
+	return predicate.CaseMessage(sql.FieldNotNull(FieldReadAt))
+}
+
+// HasConversation applies the HasEdge predicate on the "conversation" edge.
+func HasConversation() predicate.CaseMessage {
+	return predicate.CaseMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConversationTable, ConversationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationWith applies the HasEdge predicate on the "conversation" edge with a given conditions (other predicates).
+func HasConversationWith(preds ...predicate.CaseConversation) predicate.CaseMessage {
+	return predicate.CaseMessage(func(s *sql.Selector) {
+		step := newConversationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CaseMessage) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CaseMessage) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CaseMessage) predicate.CaseMessage {
+	return predicate.CaseMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CaseMessageCreate is the builder for creating a CaseMessage entity.
+type CaseMessageCreate struct {
+	config
+	mutation *CaseMessageMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CaseMessageCreate) SetCreatedAt(v time.Time) *CaseMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CaseMessageCreate) SetNillableCreatedAt(v *time.Time) *CaseMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CaseMessageCreate) SetUpdatedAt(v time.Time) *CaseMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CaseMessageCreate) SetNillableUpdatedAt(v *time.Time) *CaseMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CaseMessageCreate) SetDeletedAt(v time.Time) *CaseMessageCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CaseMessageCreate) SetNillableDeletedAt(v *time.Time) *CaseMessageCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *CaseMessageCreate) SetConversationID(v int) *CaseMessageCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetSenderUserID sets the "sender_user_id" field.
+func (_c *CaseMessageCreate) SetSenderUserID(v int) *CaseMessageCreate {
+	_c.mutation.SetSenderUserID(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *CaseMessageCreate) SetBody(v string) *CaseMessageCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_c *CaseMessageCreate) SetNillableBody(v *string) *CaseMessageCreate {
+	if v != nil {
+		_c.SetBody(*v)
+	}
+	return _c
+}
+
+// SetAttachmentDocumentIds sets the "attachment_document_ids" field.
+func (_c *CaseMessageCreate) SetAttachmentDocumentIds(v []string) *CaseMessageCreate {
+	_c.mutation.SetAttachmentDocumentIds(v)
+	return _c
+}
+
+// SetReadAt sets the "read_at" field.
+func (_c *CaseMessageCreate) SetReadAt(v time.Time) *CaseMessageCreate {
+	_c.mutation.SetReadAt(v)
+	return _c
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_c *CaseMessageCreate) SetNillableReadAt(v *time.Time) *CaseMessageCreate {
+	if v != nil {
+		_c.SetReadAt(*v)
+	}
+	return _c
+}
+
+// SetConversation sets the "conversation" edge to the CaseConversation entity.
+func (_c *CaseMessageCreate) SetConversation(v *CaseConversation) *CaseMessageCreate {
+	return _c.SetConversationID(v.ID)
+}
+
+// Mutation returns the CaseMessageMutation object of the builder.
+func (_c *CaseMessageCreate) Mutation() *CaseMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the CaseMessage in the database.
+func (_c *CaseMessageCreate) Save(ctx context.Context) (*CaseMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CaseMessageCreate) SaveX(ctx context.Context) *CaseMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CaseMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CaseMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CaseMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := casemessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := casemessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		v := casemessage.DefaultBody
+		_c.mutation.SetBody(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CaseMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CaseMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CaseMessage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ConversationID(); !ok {
+		return &ValidationError{Name: "conversation_id", err: errors.New(`ent: missing required field "CaseMessage.conversation_id"`)}
+	}
+	if v, ok := _c.mutation.ConversationID(); ok {
+		if err := casemessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.conversation_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SenderUserID(); !ok {
+		return &ValidationError{Name: "sender_user_id", err: errors.New(`ent: missing required field "CaseMessage.sender_user_id"`)}
+	}
+	if v, ok := _c.mutation.SenderUserID(); ok {
+		if err := casemessage.SenderUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.sender_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := casemessage.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.body": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ConversationIDs()) == 0 {
+		return &ValidationError{Name: "conversation", err: errors.New(`ent: missing required edge "CaseMessage.conversation"`)}
+	}
+	return nil
+}
+
+func (_c *CaseMessageCreate) sqlSave(ctx context.Context) (*CaseMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CaseMessageCreate) createSpec() (*CaseMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CaseMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(casemessage.Table, sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(casemessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(casemessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(casemessage.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.SenderUserID(); ok {
+		_spec.SetField(casemessage.FieldSenderUserID, field.TypeInt, value)
+		_node.SenderUserID = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(casemessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.AttachmentDocumentIds(); ok {
+		_spec.SetField(casemessage.FieldAttachmentDocumentIds, field.TypeJSON, value)
+		_node.AttachmentDocumentIds = value
+	}
+	if value, ok := _c.mutation.ReadAt(); ok {
+		_spec.SetField(casemessage.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if nodes := _c.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casemessage.ConversationTable,
+			Columns: []string{casemessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConversationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CaseMessageCreateBulk is the builder for creating many CaseMessage entities in bulk.
+type CaseMessageCreateBulk struct {
+	config
+	err      error
+	builders []*CaseMessageCreate
+}
+
+// Save creates the CaseMessage entities in the database.
+func (_c *CaseMessageCreateBulk) Save(ctx context.Context) ([]*CaseMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CaseMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CaseMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CaseMessageCreateBulk) SaveX(ctx context.Context) []*CaseMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CaseMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CaseMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// CaseMessageUpdate is the builder for updating CaseMessage entities.
+type CaseMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CaseMessageMutation
+}
+
+// Where appends a list predicates to the CaseMessageUpdate builder.
+func (_u *CaseMessageUpdate) Where(ps ...predicate.CaseMessage) *CaseMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CaseMessageUpdate) SetUpdatedAt(v time.Time) *CaseMessageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CaseMessageUpdate) SetDeletedAt(v time.Time) *CaseMessageUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CaseMessageUpdate) SetNillableDeletedAt(v *time.Time) *CaseMessageUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CaseMessageUpdate) ClearDeletedAt() *CaseMessageUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *CaseMessageUpdate) SetConversationID(v int) *CaseMessageUpdate {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *CaseMessageUpdate) SetNillableConversationID(v *int) *CaseMessageUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// SetSenderUserID sets the "sender_user_id" field.
+func (_u *CaseMessageUpdate) SetSenderUserID(v int) *CaseMessageUpdate {
+	_u.mutation.ResetSenderUserID()
+	_u.mutation.SetSenderUserID(v)
+	return _u
+}
+
+// SetNillableSenderUserID sets the "sender_user_id" field if the given value is not nil.
+func (_u *CaseMessageUpdate) SetNillableSenderUserID(v *int) *CaseMessageUpdate {
+	if v != nil {
+		_u.SetSenderUserID(*v)
+	}
+	return _u
+}
+
+// AddSenderUserID adds value to the "sender_user_id" field.
+func (_u *CaseMessageUpdate) AddSenderUserID(v int) *CaseMessageUpdate {
+	_u.mutation.AddSenderUserID(v)
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *CaseMessageUpdate) SetBody(v string) *CaseMessageUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *CaseMessageUpdate) SetNillableBody(v *string) *CaseMessageUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *CaseMessageUpdate) ClearBody() *CaseMessageUpdate {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetAttachmentDocumentIds sets the "attachment_document_ids" field.
+func (_u *CaseMessageUpdate) SetAttachmentDocumentIds(v []string) *CaseMessageUpdate {
+	_u.mutation.SetAttachmentDocumentIds(v)
+	return _u
+}
+
+// AppendAttachmentDocumentIds appends value to the "attachment_document_ids" field.
+func (_u *CaseMessageUpdate) AppendAttachmentDocumentIds(v []string) *CaseMessageUpdate {
+	_u.mutation.AppendAttachmentDocumentIds(v)
+	return _u
+}
+
+// ClearAttachmentDocumentIds clears the value of the "attachment_document_ids" field.
+func (_u *CaseMessageUpdate) ClearAttachmentDocumentIds() *CaseMessageUpdate {
+	_u.mutation.ClearAttachmentDocumentIds()
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *CaseMessageUpdate) SetReadAt(v time.Time) *CaseMessageUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *CaseMessageUpdate) SetNillableReadAt(v *time.Time) *CaseMessageUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *CaseMessageUpdate) ClearReadAt() *CaseMessageUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the CaseConversation entity.
+func (_u *CaseMessageUpdate) SetConversation(v *CaseConversation) *CaseMessageUpdate {
+	return _u.SetConversationID(v.ID)
+}
+
+// Mutation returns the CaseMessageMutation object of the builder.
+func (_u *CaseMessageUpdate) Mutation() *CaseMessageMutation {
+	return _u.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the CaseConversation entity.
+func (_u *CaseMessageUpdate) ClearConversation() *CaseMessageUpdate {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CaseMessageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CaseMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CaseMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CaseMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CaseMessageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := casemessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CaseMessageUpdate) check() error {
+	if v, ok := _u.mutation.ConversationID(); ok {
+		if err := casemessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SenderUserID(); ok {
+		if err := casemessage.SenderUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.sender_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := casemessage.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.body": %w`, err)}
+		}
+	}
+	if _u.mutation.ConversationCleared() && len(_u.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CaseMessage.conversation"`)
+	}
+	return nil
+}
+
+func (_u *CaseMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(casemessage.Table, casemessage.Columns, sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(casemessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(casemessage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(casemessage.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SenderUserID(); ok {
+		_spec.SetField(casemessage.FieldSenderUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSenderUserID(); ok {
+		_spec.AddField(casemessage.FieldSenderUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(casemessage.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(casemessage.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentDocumentIds(); ok {
+		_spec.SetField(casemessage.FieldAttachmentDocumentIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachmentDocumentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, casemessage.FieldAttachmentDocumentIds, value)
+		})
+	}
+	if _u.mutation.AttachmentDocumentIdsCleared() {
+		_spec.ClearField(casemessage.FieldAttachmentDocumentIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(casemessage.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(casemessage.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casemessage.ConversationTable,
+			Columns: []string{casemessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casemessage.ConversationTable,
+			Columns: []string{casemessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{casemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CaseMessageUpdateOne is the builder for updating a single CaseMessage entity.
+type CaseMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CaseMessageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CaseMessageUpdateOne) SetUpdatedAt(v time.Time) *CaseMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CaseMessageUpdateOne) SetDeletedAt(v time.Time) *CaseMessageUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CaseMessageUpdateOne) SetNillableDeletedAt(v *time.Time) *CaseMessageUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CaseMessageUpdateOne) ClearDeletedAt() *CaseMessageUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *CaseMessageUpdateOne) SetConversationID(v int) *CaseMessageUpdateOne {
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *CaseMessageUpdateOne) SetNillableConversationID(v *int) *CaseMessageUpdateOne {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// SetSenderUserID sets the "sender_user_id" field.
+func (_u *CaseMessageUpdateOne) SetSenderUserID(v int) *CaseMessageUpdateOne {
+	_u.mutation.ResetSenderUserID()
+	_u.mutation.SetSenderUserID(v)
+	return _u
+}
+
+// SetNillableSenderUserID sets the "sender_user_id" field if the given value is not nil.
+func (_u *CaseMessageUpdateOne) SetNillableSenderUserID(v *int) *CaseMessageUpdateOne {
+	if v != nil {
+		_u.SetSenderUserID(*v)
+	}
+	return _u
+}
+
+// AddSenderUserID adds value to the "sender_user_id" field.
+func (_u *CaseMessageUpdateOne) AddSenderUserID(v int) *CaseMessageUpdateOne {
+	_u.mutation.AddSenderUserID(v)
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *CaseMessageUpdateOne) SetBody(v string) *CaseMessageUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *CaseMessageUpdateOne) SetNillableBody(v *string) *CaseMessageUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *CaseMessageUpdateOne) ClearBody() *CaseMessageUpdateOne {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetAttachmentDocumentIds sets the "attachment_document_ids" field.
+func (_u *CaseMessageUpdateOne) SetAttachmentDocumentIds(v []string) *CaseMessageUpdateOne {
+	_u.mutation.SetAttachmentDocumentIds(v)
+	return _u
+}
+
+// AppendAttachmentDocumentIds appends value to the "attachment_document_ids" field.
+func (_u *CaseMessageUpdateOne) AppendAttachmentDocumentIds(v []string) *CaseMessageUpdateOne {
+	_u.mutation.AppendAttachmentDocumentIds(v)
+	return _u
+}
+
+// ClearAttachmentDocumentIds clears the value of the "attachment_document_ids" field.
+func (_u *CaseMessageUpdateOne) ClearAttachmentDocumentIds() *CaseMessageUpdateOne {
+	_u.mutation.ClearAttachmentDocumentIds()
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *CaseMessageUpdateOne) SetReadAt(v time.Time) *CaseMessageUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *CaseMessageUpdateOne) SetNillableReadAt(v *time.Time) *CaseMessageUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *CaseMessageUpdateOne) ClearReadAt() *CaseMessageUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// SetConversation sets the "conversation" edge to the CaseConversation entity.
+func (_u *CaseMessageUpdateOne) SetConversation(v *CaseConversation) *CaseMessageUpdateOne {
+	return _u.SetConversationID(v.ID)
+}
+
+// Mutation returns the CaseMessageMutation object of the builder.
+func (_u *CaseMessageUpdateOne) Mutation() *CaseMessageMutation {
+	return _u.mutation
+}
+
+// ClearConversation clears the "conversation" edge to the CaseConversation entity.
+func (_u *CaseMessageUpdateOne) ClearConversation() *CaseMessageUpdateOne {
+	_u.mutation.ClearConversation()
+	return _u
+}
+
+// Where appends a list predicates to the CaseMessageUpdate builder.
+func (_u *CaseMessageUpdateOne) Where(ps ...predicate.CaseMessage) *CaseMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CaseMessageUpdateOne) Select(field string, fields ...string) *CaseMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CaseMessage entity.
+func (_u *CaseMessageUpdateOne) Save(ctx context.Context) (*CaseMessage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CaseMessageUpdateOne) SaveX(ctx context.Context) *CaseMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CaseMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CaseMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CaseMessageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := casemessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CaseMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.ConversationID(); ok {
+		if err := casemessage.ConversationIDValidator(v); err != nil {
+			return &ValidationError{Name: "conversation_id", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.conversation_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SenderUserID(); ok {
+		if err := casemessage.SenderUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "sender_user_id", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.sender_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := casemessage.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "CaseMessage.body": %w`, err)}
+		}
+	}
+	if _u.mutation.ConversationCleared() && len(_u.mutation.ConversationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CaseMessage.conversation"`)
+	}
+	return nil
+}
+
+func (_u *CaseMessageUpdateOne) sqlSave(ctx context.Context) (_node *CaseMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(casemessage.Table, casemessage.Columns, sqlgraph.NewFieldSpec(casemessage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CaseMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, casemessage.FieldID)
+		for _, f := range fields {
+			if !casemessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != casemessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(casemessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(casemessage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(casemessage.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SenderUserID(); ok {
+		_spec.SetField(casemessage.FieldSenderUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSenderUserID(); ok {
+		_spec.AddField(casemessage.FieldSenderUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(casemessage.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(casemessage.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentDocumentIds(); ok {
+		_spec.SetField(casemessage.FieldAttachmentDocumentIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttachmentDocumentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, casemessage.FieldAttachmentDocumentIds, value)
+		})
+	}
+	if _u.mutation.AttachmentDocumentIdsCleared() {
+		_spec.ClearField(casemessage.FieldAttachmentDocumentIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(casemessage.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(casemessage.FieldReadAt, field.TypeTime)
+	}
+	if _u.mutation.ConversationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casemessage.ConversationTable,
+			Columns: []string{casemessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casemessage.ConversationTable,
+			Columns: []string{casemessage.ConversationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(caseconversation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CaseMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{casemessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
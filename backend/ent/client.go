@@ -12,11 +12,19 @@ import (
 	"backend-gin/ent/migrate"
 
 	"backend-gin/ent/admin"
+	"backend-gin/ent/adminauditlog"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminrole"
+	"backend-gin/ent/adminsession"
 	"backend-gin/ent/artifactsubmission"
 	"backend-gin/ent/backupcode"
 	"backend-gin/ent/badge"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
 	"backend-gin/ent/category"
 	"backend-gin/ent/chaincursor"
+	"backend-gin/ent/consultationinvitation"
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/credential"
 	"backend-gin/ent/devicefingerprint"
@@ -24,14 +32,21 @@ import (
 	"backend-gin/ent/emailverificationtoken"
 	"backend-gin/ent/endorsement"
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
+	"backend-gin/ent/guaranteeslash"
+	"backend-gin/ent/impersonationsession"
 	"backend-gin/ent/ipgeocache"
+	"backend-gin/ent/iprule"
 	"backend-gin/ent/marketpurchaseorder"
 	"backend-gin/ent/marketpurchaseorderstep"
 	"backend-gin/ent/passkey"
 	"backend-gin/ent/passwordresettoken"
+	"backend-gin/ent/reputationevent"
 	"backend-gin/ent/securityevent"
 	"backend-gin/ent/session"
 	"backend-gin/ent/sessionlock"
+	"backend-gin/ent/slapolicy"
 	"backend-gin/ent/sudosession"
 	"backend-gin/ent/tag"
 	"backend-gin/ent/totppendingtoken"
@@ -39,6 +54,9 @@ import (
 	"backend-gin/ent/userbadge"
 	"backend-gin/ent/validationcase"
 	"backend-gin/ent/validationcaselog"
+	"backend-gin/ent/validatordeliverysla"
+	"backend-gin/ent/validatormatchingaggregate"
+	"backend-gin/ent/validatorreputation"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -53,16 +71,32 @@ type Client struct {
 	Schema *migrate.Schema
 	// Admin is the client for interacting with the Admin builders.
 	Admin *AdminClient
+	// AdminAuditLog is the client for interacting with the AdminAuditLog builders.
+	AdminAuditLog *AdminAuditLogClient
+	// AdminPasskey is the client for interacting with the AdminPasskey builders.
+	AdminPasskey *AdminPasskeyClient
+	// AdminRole is the client for interacting with the AdminRole builders.
+	AdminRole *AdminRoleClient
+	// AdminSession is the client for interacting with the AdminSession builders.
+	AdminSession *AdminSessionClient
 	// ArtifactSubmission is the client for interacting with the ArtifactSubmission builders.
 	ArtifactSubmission *ArtifactSubmissionClient
 	// BackupCode is the client for interacting with the BackupCode builders.
 	BackupCode *BackupCodeClient
 	// Badge is the client for interacting with the Badge builders.
 	Badge *BadgeClient
+	// CaseClarification is the client for interacting with the CaseClarification builders.
+	CaseClarification *CaseClarificationClient
+	// CaseConversation is the client for interacting with the CaseConversation builders.
+	CaseConversation *CaseConversationClient
+	// CaseMessage is the client for interacting with the CaseMessage builders.
+	CaseMessage *CaseMessageClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// ChainCursor is the client for interacting with the ChainCursor builders.
 	ChainCursor *ChainCursorClient
+	// ConsultationInvitation is the client for interacting with the ConsultationInvitation builders.
+	ConsultationInvitation *ConsultationInvitationClient
 	// ConsultationRequest is the client for interacting with the ConsultationRequest builders.
 	ConsultationRequest *ConsultationRequestClient
 	// Credential is the client for interacting with the Credential builders.
@@ -77,8 +111,18 @@ type Client struct {
 	Endorsement *EndorsementClient
 	// FinalOffer is the client for interacting with the FinalOffer builders.
 	FinalOffer *FinalOfferClient
+	// FinalOfferMilestone is the client for interacting with the FinalOfferMilestone builders.
+	FinalOfferMilestone *FinalOfferMilestoneClient
+	// FinalOfferRevision is the client for interacting with the FinalOfferRevision builders.
+	FinalOfferRevision *FinalOfferRevisionClient
+	// GuaranteeSlash is the client for interacting with the GuaranteeSlash builders.
+	GuaranteeSlash *GuaranteeSlashClient
 	// IPGeoCache is the client for interacting with the IPGeoCache builders.
 	IPGeoCache *IPGeoCacheClient
+	// IPRule is the client for interacting with the IPRule builders.
+	IPRule *IPRuleClient
+	// ImpersonationSession is the client for interacting with the ImpersonationSession builders.
+	ImpersonationSession *ImpersonationSessionClient
 	// MarketPurchaseOrder is the client for interacting with the MarketPurchaseOrder builders.
 	MarketPurchaseOrder *MarketPurchaseOrderClient
 	// MarketPurchaseOrderStep is the client for interacting with the MarketPurchaseOrderStep builders.
@@ -87,6 +131,10 @@ type Client struct {
 	Passkey *PasskeyClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
 	PasswordResetToken *PasswordResetTokenClient
+	// ReputationEvent is the client for interacting with the ReputationEvent builders.
+	ReputationEvent *ReputationEventClient
+	// SLAPolicy is the client for interacting with the SLAPolicy builders.
+	SLAPolicy *SLAPolicyClient
 	// SecurityEvent is the client for interacting with the SecurityEvent builders.
 	SecurityEvent *SecurityEventClient
 	// Session is the client for interacting with the Session builders.
@@ -107,6 +155,12 @@ type Client struct {
 	ValidationCase *ValidationCaseClient
 	// ValidationCaseLog is the client for interacting with the ValidationCaseLog builders.
 	ValidationCaseLog *ValidationCaseLogClient
+	// ValidatorDeliverySLA is the client for interacting with the ValidatorDeliverySLA builders.
+	ValidatorDeliverySLA *ValidatorDeliverySLAClient
+	// ValidatorMatchingAggregate is the client for interacting with the ValidatorMatchingAggregate builders.
+	ValidatorMatchingAggregate *ValidatorMatchingAggregateClient
+	// ValidatorReputation is the client for interacting with the ValidatorReputation builders.
+	ValidatorReputation *ValidatorReputationClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -119,11 +173,19 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Admin = NewAdminClient(c.config)
+	c.AdminAuditLog = NewAdminAuditLogClient(c.config)
+	c.AdminPasskey = NewAdminPasskeyClient(c.config)
+	c.AdminRole = NewAdminRoleClient(c.config)
+	c.AdminSession = NewAdminSessionClient(c.config)
 	c.ArtifactSubmission = NewArtifactSubmissionClient(c.config)
 	c.BackupCode = NewBackupCodeClient(c.config)
 	c.Badge = NewBadgeClient(c.config)
+	c.CaseClarification = NewCaseClarificationClient(c.config)
+	c.CaseConversation = NewCaseConversationClient(c.config)
+	c.CaseMessage = NewCaseMessageClient(c.config)
 	c.Category = NewCategoryClient(c.config)
 	c.ChainCursor = NewChainCursorClient(c.config)
+	c.ConsultationInvitation = NewConsultationInvitationClient(c.config)
 	c.ConsultationRequest = NewConsultationRequestClient(c.config)
 	c.Credential = NewCredentialClient(c.config)
 	c.DeviceFingerprint = NewDeviceFingerprintClient(c.config)
@@ -131,11 +193,18 @@ func (c *Client) init() {
 	c.EmailVerificationToken = NewEmailVerificationTokenClient(c.config)
 	c.Endorsement = NewEndorsementClient(c.config)
 	c.FinalOffer = NewFinalOfferClient(c.config)
+	c.FinalOfferMilestone = NewFinalOfferMilestoneClient(c.config)
+	c.FinalOfferRevision = NewFinalOfferRevisionClient(c.config)
+	c.GuaranteeSlash = NewGuaranteeSlashClient(c.config)
 	c.IPGeoCache = NewIPGeoCacheClient(c.config)
+	c.IPRule = NewIPRuleClient(c.config)
+	c.ImpersonationSession = NewImpersonationSessionClient(c.config)
 	c.MarketPurchaseOrder = NewMarketPurchaseOrderClient(c.config)
 	c.MarketPurchaseOrderStep = NewMarketPurchaseOrderStepClient(c.config)
 	c.Passkey = NewPasskeyClient(c.config)
 	c.PasswordResetToken = NewPasswordResetTokenClient(c.config)
+	c.ReputationEvent = NewReputationEventClient(c.config)
+	c.SLAPolicy = NewSLAPolicyClient(c.config)
 	c.SecurityEvent = NewSecurityEventClient(c.config)
 	c.Session = NewSessionClient(c.config)
 	c.SessionLock = NewSessionLockClient(c.config)
@@ -146,6 +215,9 @@ func (c *Client) init() {
 	c.UserBadge = NewUserBadgeClient(c.config)
 	c.ValidationCase = NewValidationCaseClient(c.config)
 	c.ValidationCaseLog = NewValidationCaseLogClient(c.config)
+	c.ValidatorDeliverySLA = NewValidatorDeliverySLAClient(c.config)
+	c.ValidatorMatchingAggregate = NewValidatorMatchingAggregateClient(c.config)
+	c.ValidatorReputation = NewValidatorReputationClient(c.config)
 }
 
 type (
@@ -236,36 +308,54 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                     ctx,
-		config:                  cfg,
-		Admin:                   NewAdminClient(cfg),
-		ArtifactSubmission:      NewArtifactSubmissionClient(cfg),
-		BackupCode:              NewBackupCodeClient(cfg),
-		Badge:                   NewBadgeClient(cfg),
-		Category:                NewCategoryClient(cfg),
-		ChainCursor:             NewChainCursorClient(cfg),
-		ConsultationRequest:     NewConsultationRequestClient(cfg),
-		Credential:              NewCredentialClient(cfg),
-		DeviceFingerprint:       NewDeviceFingerprintClient(cfg),
-		DeviceUserMapping:       NewDeviceUserMappingClient(cfg),
-		EmailVerificationToken:  NewEmailVerificationTokenClient(cfg),
-		Endorsement:             NewEndorsementClient(cfg),
-		FinalOffer:              NewFinalOfferClient(cfg),
-		IPGeoCache:              NewIPGeoCacheClient(cfg),
-		MarketPurchaseOrder:     NewMarketPurchaseOrderClient(cfg),
-		MarketPurchaseOrderStep: NewMarketPurchaseOrderStepClient(cfg),
-		Passkey:                 NewPasskeyClient(cfg),
-		PasswordResetToken:      NewPasswordResetTokenClient(cfg),
-		SecurityEvent:           NewSecurityEventClient(cfg),
-		Session:                 NewSessionClient(cfg),
-		SessionLock:             NewSessionLockClient(cfg),
-		SudoSession:             NewSudoSessionClient(cfg),
-		TOTPPendingToken:        NewTOTPPendingTokenClient(cfg),
-		Tag:                     NewTagClient(cfg),
-		User:                    NewUserClient(cfg),
-		UserBadge:               NewUserBadgeClient(cfg),
-		ValidationCase:          NewValidationCaseClient(cfg),
-		ValidationCaseLog:       NewValidationCaseLogClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		Admin:                      NewAdminClient(cfg),
+		AdminAuditLog:              NewAdminAuditLogClient(cfg),
+		AdminPasskey:               NewAdminPasskeyClient(cfg),
+		AdminRole:                  NewAdminRoleClient(cfg),
+		AdminSession:               NewAdminSessionClient(cfg),
+		ArtifactSubmission:         NewArtifactSubmissionClient(cfg),
+		BackupCode:                 NewBackupCodeClient(cfg),
+		Badge:                      NewBadgeClient(cfg),
+		CaseClarification:          NewCaseClarificationClient(cfg),
+		CaseConversation:           NewCaseConversationClient(cfg),
+		CaseMessage:                NewCaseMessageClient(cfg),
+		Category:                   NewCategoryClient(cfg),
+		ChainCursor:                NewChainCursorClient(cfg),
+		ConsultationInvitation:     NewConsultationInvitationClient(cfg),
+		ConsultationRequest:        NewConsultationRequestClient(cfg),
+		Credential:                 NewCredentialClient(cfg),
+		DeviceFingerprint:          NewDeviceFingerprintClient(cfg),
+		DeviceUserMapping:          NewDeviceUserMappingClient(cfg),
+		EmailVerificationToken:     NewEmailVerificationTokenClient(cfg),
+		Endorsement:                NewEndorsementClient(cfg),
+		FinalOffer:                 NewFinalOfferClient(cfg),
+		FinalOfferMilestone:        NewFinalOfferMilestoneClient(cfg),
+		FinalOfferRevision:         NewFinalOfferRevisionClient(cfg),
+		GuaranteeSlash:             NewGuaranteeSlashClient(cfg),
+		IPGeoCache:                 NewIPGeoCacheClient(cfg),
+		IPRule:                     NewIPRuleClient(cfg),
+		ImpersonationSession:       NewImpersonationSessionClient(cfg),
+		MarketPurchaseOrder:        NewMarketPurchaseOrderClient(cfg),
+		MarketPurchaseOrderStep:    NewMarketPurchaseOrderStepClient(cfg),
+		Passkey:                    NewPasskeyClient(cfg),
+		PasswordResetToken:         NewPasswordResetTokenClient(cfg),
+		ReputationEvent:            NewReputationEventClient(cfg),
+		SLAPolicy:                  NewSLAPolicyClient(cfg),
+		SecurityEvent:              NewSecurityEventClient(cfg),
+		Session:                    NewSessionClient(cfg),
+		SessionLock:                NewSessionLockClient(cfg),
+		SudoSession:                NewSudoSessionClient(cfg),
+		TOTPPendingToken:           NewTOTPPendingTokenClient(cfg),
+		Tag:                        NewTagClient(cfg),
+		User:                       NewUserClient(cfg),
+		UserBadge:                  NewUserBadgeClient(cfg),
+		ValidationCase:             NewValidationCaseClient(cfg),
+		ValidationCaseLog:          NewValidationCaseLogClient(cfg),
+		ValidatorDeliverySLA:       NewValidatorDeliverySLAClient(cfg),
+		ValidatorMatchingAggregate: NewValidatorMatchingAggregateClient(cfg),
+		ValidatorReputation:        NewValidatorReputationClient(cfg),
 	}, nil
 }
 
@@ -283,36 +373,54 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                     ctx,
-		config:                  cfg,
-		Admin:                   NewAdminClient(cfg),
-		ArtifactSubmission:      NewArtifactSubmissionClient(cfg),
-		BackupCode:              NewBackupCodeClient(cfg),
-		Badge:                   NewBadgeClient(cfg),
-		Category:                NewCategoryClient(cfg),
-		ChainCursor:             NewChainCursorClient(cfg),
-		ConsultationRequest:     NewConsultationRequestClient(cfg),
-		Credential:              NewCredentialClient(cfg),
-		DeviceFingerprint:       NewDeviceFingerprintClient(cfg),
-		DeviceUserMapping:       NewDeviceUserMappingClient(cfg),
-		EmailVerificationToken:  NewEmailVerificationTokenClient(cfg),
-		Endorsement:             NewEndorsementClient(cfg),
-		FinalOffer:              NewFinalOfferClient(cfg),
-		IPGeoCache:              NewIPGeoCacheClient(cfg),
-		MarketPurchaseOrder:     NewMarketPurchaseOrderClient(cfg),
-		MarketPurchaseOrderStep: NewMarketPurchaseOrderStepClient(cfg),
-		Passkey:                 NewPasskeyClient(cfg),
-		PasswordResetToken:      NewPasswordResetTokenClient(cfg),
-		SecurityEvent:           NewSecurityEventClient(cfg),
-		Session:                 NewSessionClient(cfg),
-		SessionLock:             NewSessionLockClient(cfg),
-		SudoSession:             NewSudoSessionClient(cfg),
-		TOTPPendingToken:        NewTOTPPendingTokenClient(cfg),
-		Tag:                     NewTagClient(cfg),
-		User:                    NewUserClient(cfg),
-		UserBadge:               NewUserBadgeClient(cfg),
-		ValidationCase:          NewValidationCaseClient(cfg),
-		ValidationCaseLog:       NewValidationCaseLogClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		Admin:                      NewAdminClient(cfg),
+		AdminAuditLog:              NewAdminAuditLogClient(cfg),
+		AdminPasskey:               NewAdminPasskeyClient(cfg),
+		AdminRole:                  NewAdminRoleClient(cfg),
+		AdminSession:               NewAdminSessionClient(cfg),
+		ArtifactSubmission:         NewArtifactSubmissionClient(cfg),
+		BackupCode:                 NewBackupCodeClient(cfg),
+		Badge:                      NewBadgeClient(cfg),
+		CaseClarification:          NewCaseClarificationClient(cfg),
+		CaseConversation:           NewCaseConversationClient(cfg),
+		CaseMessage:                NewCaseMessageClient(cfg),
+		Category:                   NewCategoryClient(cfg),
+		ChainCursor:                NewChainCursorClient(cfg),
+		ConsultationInvitation:     NewConsultationInvitationClient(cfg),
+		ConsultationRequest:        NewConsultationRequestClient(cfg),
+		Credential:                 NewCredentialClient(cfg),
+		DeviceFingerprint:          NewDeviceFingerprintClient(cfg),
+		DeviceUserMapping:          NewDeviceUserMappingClient(cfg),
+		EmailVerificationToken:     NewEmailVerificationTokenClient(cfg),
+		Endorsement:                NewEndorsementClient(cfg),
+		FinalOffer:                 NewFinalOfferClient(cfg),
+		FinalOfferMilestone:        NewFinalOfferMilestoneClient(cfg),
+		FinalOfferRevision:         NewFinalOfferRevisionClient(cfg),
+		GuaranteeSlash:             NewGuaranteeSlashClient(cfg),
+		IPGeoCache:                 NewIPGeoCacheClient(cfg),
+		IPRule:                     NewIPRuleClient(cfg),
+		ImpersonationSession:       NewImpersonationSessionClient(cfg),
+		MarketPurchaseOrder:        NewMarketPurchaseOrderClient(cfg),
+		MarketPurchaseOrderStep:    NewMarketPurchaseOrderStepClient(cfg),
+		Passkey:                    NewPasskeyClient(cfg),
+		PasswordResetToken:         NewPasswordResetTokenClient(cfg),
+		ReputationEvent:            NewReputationEventClient(cfg),
+		SLAPolicy:                  NewSLAPolicyClient(cfg),
+		SecurityEvent:              NewSecurityEventClient(cfg),
+		Session:                    NewSessionClient(cfg),
+		SessionLock:                NewSessionLockClient(cfg),
+		SudoSession:                NewSudoSessionClient(cfg),
+		TOTPPendingToken:           NewTOTPPendingTokenClient(cfg),
+		Tag:                        NewTagClient(cfg),
+		User:                       NewUserClient(cfg),
+		UserBadge:                  NewUserBadgeClient(cfg),
+		ValidationCase:             NewValidationCaseClient(cfg),
+		ValidationCaseLog:          NewValidationCaseLogClient(cfg),
+		ValidatorDeliverySLA:       NewValidatorDeliverySLAClient(cfg),
+		ValidatorMatchingAggregate: NewValidatorMatchingAggregateClient(cfg),
+		ValidatorReputation:        NewValidatorReputationClient(cfg),
 	}, nil
 }
 
@@ -342,13 +450,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Admin, c.ArtifactSubmission, c.BackupCode, c.Badge, c.Category, c.ChainCursor,
-		c.ConsultationRequest, c.Credential, c.DeviceFingerprint, c.DeviceUserMapping,
-		c.EmailVerificationToken, c.Endorsement, c.FinalOffer, c.IPGeoCache,
+		c.Admin, c.AdminAuditLog, c.AdminPasskey, c.AdminRole, c.AdminSession,
+		c.ArtifactSubmission, c.BackupCode, c.Badge, c.CaseClarification,
+		c.CaseConversation, c.CaseMessage, c.Category, c.ChainCursor,
+		c.ConsultationInvitation, c.ConsultationRequest, c.Credential,
+		c.DeviceFingerprint, c.DeviceUserMapping, c.EmailVerificationToken,
+		c.Endorsement, c.FinalOffer, c.FinalOfferMilestone, c.FinalOfferRevision,
+		c.GuaranteeSlash, c.IPGeoCache, c.IPRule, c.ImpersonationSession,
 		c.MarketPurchaseOrder, c.MarketPurchaseOrderStep, c.Passkey,
-		c.PasswordResetToken, c.SecurityEvent, c.Session, c.SessionLock, c.SudoSession,
-		c.TOTPPendingToken, c.Tag, c.User, c.UserBadge, c.ValidationCase,
-		c.ValidationCaseLog,
+		c.PasswordResetToken, c.ReputationEvent, c.SLAPolicy, c.SecurityEvent,
+		c.Session, c.SessionLock, c.SudoSession, c.TOTPPendingToken, c.Tag, c.User,
+		c.UserBadge, c.ValidationCase, c.ValidationCaseLog, c.ValidatorDeliverySLA,
+		c.ValidatorMatchingAggregate, c.ValidatorReputation,
 	} {
 		n.Use(hooks...)
 	}
@@ -358,13 +471,18 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Admin, c.ArtifactSubmission, c.BackupCode, c.Badge, c.Category, c.ChainCursor,
-		c.ConsultationRequest, c.Credential, c.DeviceFingerprint, c.DeviceUserMapping,
-		c.EmailVerificationToken, c.Endorsement, c.FinalOffer, c.IPGeoCache,
+		c.Admin, c.AdminAuditLog, c.AdminPasskey, c.AdminRole, c.AdminSession,
+		c.ArtifactSubmission, c.BackupCode, c.Badge, c.CaseClarification,
+		c.CaseConversation, c.CaseMessage, c.Category, c.ChainCursor,
+		c.ConsultationInvitation, c.ConsultationRequest, c.Credential,
+		c.DeviceFingerprint, c.DeviceUserMapping, c.EmailVerificationToken,
+		c.Endorsement, c.FinalOffer, c.FinalOfferMilestone, c.FinalOfferRevision,
+		c.GuaranteeSlash, c.IPGeoCache, c.IPRule, c.ImpersonationSession,
 		c.MarketPurchaseOrder, c.MarketPurchaseOrderStep, c.Passkey,
-		c.PasswordResetToken, c.SecurityEvent, c.Session, c.SessionLock, c.SudoSession,
-		c.TOTPPendingToken, c.Tag, c.User, c.UserBadge, c.ValidationCase,
-		c.ValidationCaseLog,
+		c.PasswordResetToken, c.ReputationEvent, c.SLAPolicy, c.SecurityEvent,
+		c.Session, c.SessionLock, c.SudoSession, c.TOTPPendingToken, c.Tag, c.User,
+		c.UserBadge, c.ValidationCase, c.ValidationCaseLog, c.ValidatorDeliverySLA,
+		c.ValidatorMatchingAggregate, c.ValidatorReputation,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -375,16 +493,32 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AdminMutation:
 		return c.Admin.mutate(ctx, m)
+	case *AdminAuditLogMutation:
+		return c.AdminAuditLog.mutate(ctx, m)
+	case *AdminPasskeyMutation:
+		return c.AdminPasskey.mutate(ctx, m)
+	case *AdminRoleMutation:
+		return c.AdminRole.mutate(ctx, m)
+	case *AdminSessionMutation:
+		return c.AdminSession.mutate(ctx, m)
 	case *ArtifactSubmissionMutation:
 		return c.ArtifactSubmission.mutate(ctx, m)
 	case *BackupCodeMutation:
 		return c.BackupCode.mutate(ctx, m)
 	case *BadgeMutation:
 		return c.Badge.mutate(ctx, m)
+	case *CaseClarificationMutation:
+		return c.CaseClarification.mutate(ctx, m)
+	case *CaseConversationMutation:
+		return c.CaseConversation.mutate(ctx, m)
+	case *CaseMessageMutation:
+		return c.CaseMessage.mutate(ctx, m)
 	case *CategoryMutation:
 		return c.Category.mutate(ctx, m)
 	case *ChainCursorMutation:
 		return c.ChainCursor.mutate(ctx, m)
+	case *ConsultationInvitationMutation:
+		return c.ConsultationInvitation.mutate(ctx, m)
 	case *ConsultationRequestMutation:
 		return c.ConsultationRequest.mutate(ctx, m)
 	case *CredentialMutation:
@@ -399,8 +533,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Endorsement.mutate(ctx, m)
 	case *FinalOfferMutation:
 		return c.FinalOffer.mutate(ctx, m)
+	case *FinalOfferMilestoneMutation:
+		return c.FinalOfferMilestone.mutate(ctx, m)
+	case *FinalOfferRevisionMutation:
+		return c.FinalOfferRevision.mutate(ctx, m)
+	case *GuaranteeSlashMutation:
+		return c.GuaranteeSlash.mutate(ctx, m)
 	case *IPGeoCacheMutation:
 		return c.IPGeoCache.mutate(ctx, m)
+	case *IPRuleMutation:
+		return c.IPRule.mutate(ctx, m)
+	case *ImpersonationSessionMutation:
+		return c.ImpersonationSession.mutate(ctx, m)
 	case *MarketPurchaseOrderMutation:
 		return c.MarketPurchaseOrder.mutate(ctx, m)
 	case *MarketPurchaseOrderStepMutation:
@@ -409,6 +553,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Passkey.mutate(ctx, m)
 	case *PasswordResetTokenMutation:
 		return c.PasswordResetToken.mutate(ctx, m)
+	case *ReputationEventMutation:
+		return c.ReputationEvent.mutate(ctx, m)
+	case *SLAPolicyMutation:
+		return c.SLAPolicy.mutate(ctx, m)
 	case *SecurityEventMutation:
 		return c.SecurityEvent.mutate(ctx, m)
 	case *SessionMutation:
@@ -429,6 +577,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ValidationCase.mutate(ctx, m)
 	case *ValidationCaseLogMutation:
 		return c.ValidationCaseLog.mutate(ctx, m)
+	case *ValidatorDeliverySLAMutation:
+		return c.ValidatorDeliverySLA.mutate(ctx, m)
+	case *ValidatorMatchingAggregateMutation:
+		return c.ValidatorMatchingAggregate.mutate(ctx, m)
+	case *ValidatorReputationMutation:
+		return c.ValidatorReputation.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -558,6 +712,54 @@ func (c *AdminClient) QueryGrantedBadges(_m *Admin) *UserBadgeQuery {
 	return query
 }
 
+// QueryRoles queries the roles edge of a Admin.
+func (c *AdminClient) QueryRoles(_m *Admin) *AdminRoleQuery {
+	query := (&AdminRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admin.Table, admin.FieldID, id),
+			sqlgraph.To(adminrole.Table, adminrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, admin.RolesTable, admin.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a Admin.
+func (c *AdminClient) QuerySessions(_m *Admin) *AdminSessionQuery {
+	query := (&AdminSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admin.Table, admin.FieldID, id),
+			sqlgraph.To(adminsession.Table, adminsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, admin.SessionsTable, admin.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPasskeys queries the passkeys edge of a Admin.
+func (c *AdminClient) QueryPasskeys(_m *Admin) *AdminPasskeyQuery {
+	query := (&AdminPasskeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admin.Table, admin.FieldID, id),
+			sqlgraph.To(adminpasskey.Table, adminpasskey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, admin.PasskeysTable, admin.PasskeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AdminClient) Hooks() []Hook {
 	return c.hooks.Admin
@@ -583,107 +785,107 @@ func (c *AdminClient) mutate(ctx context.Context, m *AdminMutation) (Value, erro
 	}
 }
 
-// ArtifactSubmissionClient is a client for the ArtifactSubmission schema.
-type ArtifactSubmissionClient struct {
+// AdminAuditLogClient is a client for the AdminAuditLog schema.
+type AdminAuditLogClient struct {
 	config
 }
 
-// NewArtifactSubmissionClient returns a client for the ArtifactSubmission from the given config.
-func NewArtifactSubmissionClient(c config) *ArtifactSubmissionClient {
-	return &ArtifactSubmissionClient{config: c}
+// NewAdminAuditLogClient returns a client for the AdminAuditLog from the given config.
+func NewAdminAuditLogClient(c config) *AdminAuditLogClient {
+	return &AdminAuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `artifactsubmission.Hooks(f(g(h())))`.
-func (c *ArtifactSubmissionClient) Use(hooks ...Hook) {
-	c.hooks.ArtifactSubmission = append(c.hooks.ArtifactSubmission, hooks...)
+// A call to `Use(f, g, h)` equals to `adminauditlog.Hooks(f(g(h())))`.
+func (c *AdminAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AdminAuditLog = append(c.hooks.AdminAuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `artifactsubmission.Intercept(f(g(h())))`.
-func (c *ArtifactSubmissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ArtifactSubmission = append(c.inters.ArtifactSubmission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminauditlog.Intercept(f(g(h())))`.
+func (c *AdminAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminAuditLog = append(c.inters.AdminAuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a ArtifactSubmission entity.
-func (c *ArtifactSubmissionClient) Create() *ArtifactSubmissionCreate {
-	mutation := newArtifactSubmissionMutation(c.config, OpCreate)
-	return &ArtifactSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminAuditLog entity.
+func (c *AdminAuditLogClient) Create() *AdminAuditLogCreate {
+	mutation := newAdminAuditLogMutation(c.config, OpCreate)
+	return &AdminAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ArtifactSubmission entities.
-func (c *ArtifactSubmissionClient) CreateBulk(builders ...*ArtifactSubmissionCreate) *ArtifactSubmissionCreateBulk {
-	return &ArtifactSubmissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminAuditLog entities.
+func (c *AdminAuditLogClient) CreateBulk(builders ...*AdminAuditLogCreate) *AdminAuditLogCreateBulk {
+	return &AdminAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ArtifactSubmissionClient) MapCreateBulk(slice any, setFunc func(*ArtifactSubmissionCreate, int)) *ArtifactSubmissionCreateBulk {
+func (c *AdminAuditLogClient) MapCreateBulk(slice any, setFunc func(*AdminAuditLogCreate, int)) *AdminAuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ArtifactSubmissionCreateBulk{err: fmt.Errorf("calling to ArtifactSubmissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminAuditLogCreateBulk{err: fmt.Errorf("calling to AdminAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ArtifactSubmissionCreate, rv.Len())
+	builders := make([]*AdminAuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ArtifactSubmissionCreateBulk{config: c.config, builders: builders}
+	return &AdminAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ArtifactSubmission.
-func (c *ArtifactSubmissionClient) Update() *ArtifactSubmissionUpdate {
-	mutation := newArtifactSubmissionMutation(c.config, OpUpdate)
-	return &ArtifactSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Update() *AdminAuditLogUpdate {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdate)
+	return &AdminAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ArtifactSubmissionClient) UpdateOne(_m *ArtifactSubmission) *ArtifactSubmissionUpdateOne {
-	mutation := newArtifactSubmissionMutation(c.config, OpUpdateOne, withArtifactSubmission(_m))
-	return &ArtifactSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminAuditLogClient) UpdateOne(_m *AdminAuditLog) *AdminAuditLogUpdateOne {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdateOne, withAdminAuditLog(_m))
+	return &AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ArtifactSubmissionClient) UpdateOneID(id int) *ArtifactSubmissionUpdateOne {
-	mutation := newArtifactSubmissionMutation(c.config, OpUpdateOne, withArtifactSubmissionID(id))
-	return &ArtifactSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminAuditLogClient) UpdateOneID(id int) *AdminAuditLogUpdateOne {
+	mutation := newAdminAuditLogMutation(c.config, OpUpdateOne, withAdminAuditLogID(id))
+	return &AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ArtifactSubmission.
-func (c *ArtifactSubmissionClient) Delete() *ArtifactSubmissionDelete {
-	mutation := newArtifactSubmissionMutation(c.config, OpDelete)
-	return &ArtifactSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Delete() *AdminAuditLogDelete {
+	mutation := newAdminAuditLogMutation(c.config, OpDelete)
+	return &AdminAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ArtifactSubmissionClient) DeleteOne(_m *ArtifactSubmission) *ArtifactSubmissionDeleteOne {
+func (c *AdminAuditLogClient) DeleteOne(_m *AdminAuditLog) *AdminAuditLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ArtifactSubmissionClient) DeleteOneID(id int) *ArtifactSubmissionDeleteOne {
-	builder := c.Delete().Where(artifactsubmission.ID(id))
+func (c *AdminAuditLogClient) DeleteOneID(id int) *AdminAuditLogDeleteOne {
+	builder := c.Delete().Where(adminauditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ArtifactSubmissionDeleteOne{builder}
+	return &AdminAuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for ArtifactSubmission.
-func (c *ArtifactSubmissionClient) Query() *ArtifactSubmissionQuery {
-	return &ArtifactSubmissionQuery{
+// Query returns a query builder for AdminAuditLog.
+func (c *AdminAuditLogClient) Query() *AdminAuditLogQuery {
+	return &AdminAuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeArtifactSubmission},
+		ctx:    &QueryContext{Type: TypeAdminAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ArtifactSubmission entity by its id.
-func (c *ArtifactSubmissionClient) Get(ctx context.Context, id int) (*ArtifactSubmission, error) {
-	return c.Query().Where(artifactsubmission.ID(id)).Only(ctx)
+// Get returns a AdminAuditLog entity by its id.
+func (c *AdminAuditLogClient) Get(ctx context.Context, id int) (*AdminAuditLog, error) {
+	return c.Query().Where(adminauditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ArtifactSubmissionClient) GetX(ctx context.Context, id int) *ArtifactSubmission {
+func (c *AdminAuditLogClient) GetX(ctx context.Context, id int) *AdminAuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -691,164 +893,132 @@ func (c *ArtifactSubmissionClient) GetX(ctx context.Context, id int) *ArtifactSu
 	return obj
 }
 
-// QueryValidationCase queries the validation_case edge of a ArtifactSubmission.
-func (c *ArtifactSubmissionClient) QueryValidationCase(_m *ArtifactSubmission) *ValidationCaseQuery {
-	query := (&ValidationCaseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(artifactsubmission.Table, artifactsubmission.FieldID, id),
-			sqlgraph.To(validationcase.Table, validationcase.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, artifactsubmission.ValidationCaseTable, artifactsubmission.ValidationCaseColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryValidatorUser queries the validator_user edge of a ArtifactSubmission.
-func (c *ArtifactSubmissionClient) QueryValidatorUser(_m *ArtifactSubmission) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(artifactsubmission.Table, artifactsubmission.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, artifactsubmission.ValidatorUserTable, artifactsubmission.ValidatorUserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ArtifactSubmissionClient) Hooks() []Hook {
-	return c.hooks.ArtifactSubmission
+func (c *AdminAuditLogClient) Hooks() []Hook {
+	return c.hooks.AdminAuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *ArtifactSubmissionClient) Interceptors() []Interceptor {
-	return c.inters.ArtifactSubmission
+func (c *AdminAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AdminAuditLog
 }
 
-func (c *ArtifactSubmissionClient) mutate(ctx context.Context, m *ArtifactSubmissionMutation) (Value, error) {
+func (c *AdminAuditLogClient) mutate(ctx context.Context, m *AdminAuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ArtifactSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ArtifactSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ArtifactSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ArtifactSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ArtifactSubmission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminAuditLog mutation op: %q", m.Op())
 	}
 }
 
-// BackupCodeClient is a client for the BackupCode schema.
-type BackupCodeClient struct {
+// AdminPasskeyClient is a client for the AdminPasskey schema.
+type AdminPasskeyClient struct {
 	config
 }
 
-// NewBackupCodeClient returns a client for the BackupCode from the given config.
-func NewBackupCodeClient(c config) *BackupCodeClient {
-	return &BackupCodeClient{config: c}
+// NewAdminPasskeyClient returns a client for the AdminPasskey from the given config.
+func NewAdminPasskeyClient(c config) *AdminPasskeyClient {
+	return &AdminPasskeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `backupcode.Hooks(f(g(h())))`.
-func (c *BackupCodeClient) Use(hooks ...Hook) {
-	c.hooks.BackupCode = append(c.hooks.BackupCode, hooks...)
+// A call to `Use(f, g, h)` equals to `adminpasskey.Hooks(f(g(h())))`.
+func (c *AdminPasskeyClient) Use(hooks ...Hook) {
+	c.hooks.AdminPasskey = append(c.hooks.AdminPasskey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `backupcode.Intercept(f(g(h())))`.
-func (c *BackupCodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BackupCode = append(c.inters.BackupCode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminpasskey.Intercept(f(g(h())))`.
+func (c *AdminPasskeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminPasskey = append(c.inters.AdminPasskey, interceptors...)
 }
 
-// Create returns a builder for creating a BackupCode entity.
-func (c *BackupCodeClient) Create() *BackupCodeCreate {
-	mutation := newBackupCodeMutation(c.config, OpCreate)
-	return &BackupCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminPasskey entity.
+func (c *AdminPasskeyClient) Create() *AdminPasskeyCreate {
+	mutation := newAdminPasskeyMutation(c.config, OpCreate)
+	return &AdminPasskeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BackupCode entities.
-func (c *BackupCodeClient) CreateBulk(builders ...*BackupCodeCreate) *BackupCodeCreateBulk {
-	return &BackupCodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminPasskey entities.
+func (c *AdminPasskeyClient) CreateBulk(builders ...*AdminPasskeyCreate) *AdminPasskeyCreateBulk {
+	return &AdminPasskeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BackupCodeClient) MapCreateBulk(slice any, setFunc func(*BackupCodeCreate, int)) *BackupCodeCreateBulk {
+func (c *AdminPasskeyClient) MapCreateBulk(slice any, setFunc func(*AdminPasskeyCreate, int)) *AdminPasskeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BackupCodeCreateBulk{err: fmt.Errorf("calling to BackupCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminPasskeyCreateBulk{err: fmt.Errorf("calling to AdminPasskeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BackupCodeCreate, rv.Len())
+	builders := make([]*AdminPasskeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BackupCodeCreateBulk{config: c.config, builders: builders}
+	return &AdminPasskeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BackupCode.
-func (c *BackupCodeClient) Update() *BackupCodeUpdate {
-	mutation := newBackupCodeMutation(c.config, OpUpdate)
-	return &BackupCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminPasskey.
+func (c *AdminPasskeyClient) Update() *AdminPasskeyUpdate {
+	mutation := newAdminPasskeyMutation(c.config, OpUpdate)
+	return &AdminPasskeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BackupCodeClient) UpdateOne(_m *BackupCode) *BackupCodeUpdateOne {
-	mutation := newBackupCodeMutation(c.config, OpUpdateOne, withBackupCode(_m))
-	return &BackupCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminPasskeyClient) UpdateOne(_m *AdminPasskey) *AdminPasskeyUpdateOne {
+	mutation := newAdminPasskeyMutation(c.config, OpUpdateOne, withAdminPasskey(_m))
+	return &AdminPasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BackupCodeClient) UpdateOneID(id int) *BackupCodeUpdateOne {
-	mutation := newBackupCodeMutation(c.config, OpUpdateOne, withBackupCodeID(id))
-	return &BackupCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminPasskeyClient) UpdateOneID(id int) *AdminPasskeyUpdateOne {
+	mutation := newAdminPasskeyMutation(c.config, OpUpdateOne, withAdminPasskeyID(id))
+	return &AdminPasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BackupCode.
-func (c *BackupCodeClient) Delete() *BackupCodeDelete {
-	mutation := newBackupCodeMutation(c.config, OpDelete)
-	return &BackupCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminPasskey.
+func (c *AdminPasskeyClient) Delete() *AdminPasskeyDelete {
+	mutation := newAdminPasskeyMutation(c.config, OpDelete)
+	return &AdminPasskeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BackupCodeClient) DeleteOne(_m *BackupCode) *BackupCodeDeleteOne {
+func (c *AdminPasskeyClient) DeleteOne(_m *AdminPasskey) *AdminPasskeyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BackupCodeClient) DeleteOneID(id int) *BackupCodeDeleteOne {
-	builder := c.Delete().Where(backupcode.ID(id))
+func (c *AdminPasskeyClient) DeleteOneID(id int) *AdminPasskeyDeleteOne {
+	builder := c.Delete().Where(adminpasskey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BackupCodeDeleteOne{builder}
+	return &AdminPasskeyDeleteOne{builder}
 }
 
-// Query returns a query builder for BackupCode.
-func (c *BackupCodeClient) Query() *BackupCodeQuery {
-	return &BackupCodeQuery{
+// Query returns a query builder for AdminPasskey.
+func (c *AdminPasskeyClient) Query() *AdminPasskeyQuery {
+	return &AdminPasskeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBackupCode},
+		ctx:    &QueryContext{Type: TypeAdminPasskey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BackupCode entity by its id.
-func (c *BackupCodeClient) Get(ctx context.Context, id int) (*BackupCode, error) {
-	return c.Query().Where(backupcode.ID(id)).Only(ctx)
+// Get returns a AdminPasskey entity by its id.
+func (c *AdminPasskeyClient) Get(ctx context.Context, id int) (*AdminPasskey, error) {
+	return c.Query().Where(adminpasskey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BackupCodeClient) GetX(ctx context.Context, id int) *BackupCode {
+func (c *AdminPasskeyClient) GetX(ctx context.Context, id int) *AdminPasskey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -856,15 +1026,15 @@ func (c *BackupCodeClient) GetX(ctx context.Context, id int) *BackupCode {
 	return obj
 }
 
-// QueryUser queries the user edge of a BackupCode.
-func (c *BackupCodeClient) QueryUser(_m *BackupCode) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryAdmin queries the admin edge of a AdminPasskey.
+func (c *AdminPasskeyClient) QueryAdmin(_m *AdminPasskey) *AdminQuery {
+	query := (&AdminClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(backupcode.Table, backupcode.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, backupcode.UserTable, backupcode.UserColumn),
+			sqlgraph.From(adminpasskey.Table, adminpasskey.FieldID, id),
+			sqlgraph.To(admin.Table, admin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, adminpasskey.AdminTable, adminpasskey.AdminColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -873,131 +1043,131 @@ func (c *BackupCodeClient) QueryUser(_m *BackupCode) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *BackupCodeClient) Hooks() []Hook {
-	return c.hooks.BackupCode
+func (c *AdminPasskeyClient) Hooks() []Hook {
+	return c.hooks.AdminPasskey
 }
 
 // Interceptors returns the client interceptors.
-func (c *BackupCodeClient) Interceptors() []Interceptor {
-	return c.inters.BackupCode
+func (c *AdminPasskeyClient) Interceptors() []Interceptor {
+	return c.inters.AdminPasskey
 }
 
-func (c *BackupCodeClient) mutate(ctx context.Context, m *BackupCodeMutation) (Value, error) {
+func (c *AdminPasskeyClient) mutate(ctx context.Context, m *AdminPasskeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BackupCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminPasskeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BackupCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminPasskeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BackupCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminPasskeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BackupCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminPasskeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown BackupCode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminPasskey mutation op: %q", m.Op())
 	}
 }
 
-// BadgeClient is a client for the Badge schema.
-type BadgeClient struct {
+// AdminRoleClient is a client for the AdminRole schema.
+type AdminRoleClient struct {
 	config
 }
 
-// NewBadgeClient returns a client for the Badge from the given config.
-func NewBadgeClient(c config) *BadgeClient {
-	return &BadgeClient{config: c}
+// NewAdminRoleClient returns a client for the AdminRole from the given config.
+func NewAdminRoleClient(c config) *AdminRoleClient {
+	return &AdminRoleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `badge.Hooks(f(g(h())))`.
-func (c *BadgeClient) Use(hooks ...Hook) {
-	c.hooks.Badge = append(c.hooks.Badge, hooks...)
+// A call to `Use(f, g, h)` equals to `adminrole.Hooks(f(g(h())))`.
+func (c *AdminRoleClient) Use(hooks ...Hook) {
+	c.hooks.AdminRole = append(c.hooks.AdminRole, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `badge.Intercept(f(g(h())))`.
-func (c *BadgeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Badge = append(c.inters.Badge, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminrole.Intercept(f(g(h())))`.
+func (c *AdminRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminRole = append(c.inters.AdminRole, interceptors...)
 }
 
-// Create returns a builder for creating a Badge entity.
-func (c *BadgeClient) Create() *BadgeCreate {
-	mutation := newBadgeMutation(c.config, OpCreate)
-	return &BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminRole entity.
+func (c *AdminRoleClient) Create() *AdminRoleCreate {
+	mutation := newAdminRoleMutation(c.config, OpCreate)
+	return &AdminRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Badge entities.
-func (c *BadgeClient) CreateBulk(builders ...*BadgeCreate) *BadgeCreateBulk {
-	return &BadgeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminRole entities.
+func (c *AdminRoleClient) CreateBulk(builders ...*AdminRoleCreate) *AdminRoleCreateBulk {
+	return &AdminRoleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BadgeClient) MapCreateBulk(slice any, setFunc func(*BadgeCreate, int)) *BadgeCreateBulk {
+func (c *AdminRoleClient) MapCreateBulk(slice any, setFunc func(*AdminRoleCreate, int)) *AdminRoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BadgeCreateBulk{err: fmt.Errorf("calling to BadgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminRoleCreateBulk{err: fmt.Errorf("calling to AdminRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BadgeCreate, rv.Len())
+	builders := make([]*AdminRoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BadgeCreateBulk{config: c.config, builders: builders}
+	return &AdminRoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Badge.
-func (c *BadgeClient) Update() *BadgeUpdate {
-	mutation := newBadgeMutation(c.config, OpUpdate)
-	return &BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminRole.
+func (c *AdminRoleClient) Update() *AdminRoleUpdate {
+	mutation := newAdminRoleMutation(c.config, OpUpdate)
+	return &AdminRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BadgeClient) UpdateOne(_m *Badge) *BadgeUpdateOne {
-	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadge(_m))
-	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRoleClient) UpdateOne(_m *AdminRole) *AdminRoleUpdateOne {
+	mutation := newAdminRoleMutation(c.config, OpUpdateOne, withAdminRole(_m))
+	return &AdminRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BadgeClient) UpdateOneID(id int) *BadgeUpdateOne {
-	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadgeID(id))
-	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminRoleClient) UpdateOneID(id int) *AdminRoleUpdateOne {
+	mutation := newAdminRoleMutation(c.config, OpUpdateOne, withAdminRoleID(id))
+	return &AdminRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Badge.
-func (c *BadgeClient) Delete() *BadgeDelete {
-	mutation := newBadgeMutation(c.config, OpDelete)
-	return &BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminRole.
+func (c *AdminRoleClient) Delete() *AdminRoleDelete {
+	mutation := newAdminRoleMutation(c.config, OpDelete)
+	return &AdminRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BadgeClient) DeleteOne(_m *Badge) *BadgeDeleteOne {
+func (c *AdminRoleClient) DeleteOne(_m *AdminRole) *AdminRoleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BadgeClient) DeleteOneID(id int) *BadgeDeleteOne {
-	builder := c.Delete().Where(badge.ID(id))
+func (c *AdminRoleClient) DeleteOneID(id int) *AdminRoleDeleteOne {
+	builder := c.Delete().Where(adminrole.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BadgeDeleteOne{builder}
+	return &AdminRoleDeleteOne{builder}
 }
 
-// Query returns a query builder for Badge.
-func (c *BadgeClient) Query() *BadgeQuery {
-	return &BadgeQuery{
+// Query returns a query builder for AdminRole.
+func (c *AdminRoleClient) Query() *AdminRoleQuery {
+	return &AdminRoleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBadge},
+		ctx:    &QueryContext{Type: TypeAdminRole},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Badge entity by its id.
-func (c *BadgeClient) Get(ctx context.Context, id int) (*Badge, error) {
-	return c.Query().Where(badge.ID(id)).Only(ctx)
+// Get returns a AdminRole entity by its id.
+func (c *AdminRoleClient) Get(ctx context.Context, id int) (*AdminRole, error) {
+	return c.Query().Where(adminrole.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BadgeClient) GetX(ctx context.Context, id int) *Badge {
+func (c *AdminRoleClient) GetX(ctx context.Context, id int) *AdminRole {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1005,31 +1175,15 @@ func (c *BadgeClient) GetX(ctx context.Context, id int) *Badge {
 	return obj
 }
 
-// QueryUserBadges queries the user_badges edge of a Badge.
-func (c *BadgeClient) QueryUserBadges(_m *Badge) *UserBadgeQuery {
-	query := (&UserBadgeClient{config: c.config}).Query()
+// QueryAdmins queries the admins edge of a AdminRole.
+func (c *AdminRoleClient) QueryAdmins(_m *AdminRole) *AdminQuery {
+	query := (&AdminClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(badge.Table, badge.FieldID, id),
-			sqlgraph.To(userbadge.Table, userbadge.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, badge.UserBadgesTable, badge.UserBadgesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPrimaryUsers queries the primary_users edge of a Badge.
-func (c *BadgeClient) QueryPrimaryUsers(_m *Badge) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(badge.Table, badge.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, badge.PrimaryUsersTable, badge.PrimaryUsersColumn),
+			sqlgraph.From(adminrole.Table, adminrole.FieldID, id),
+			sqlgraph.To(admin.Table, admin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, adminrole.AdminsTable, adminrole.AdminsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1038,131 +1192,131 @@ func (c *BadgeClient) QueryPrimaryUsers(_m *Badge) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *BadgeClient) Hooks() []Hook {
-	return c.hooks.Badge
+func (c *AdminRoleClient) Hooks() []Hook {
+	return c.hooks.AdminRole
 }
 
 // Interceptors returns the client interceptors.
-func (c *BadgeClient) Interceptors() []Interceptor {
-	return c.inters.Badge
+func (c *AdminRoleClient) Interceptors() []Interceptor {
+	return c.inters.AdminRole
 }
 
-func (c *BadgeClient) mutate(ctx context.Context, m *BadgeMutation) (Value, error) {
+func (c *AdminRoleClient) mutate(ctx context.Context, m *AdminRoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Badge mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminRole mutation op: %q", m.Op())
 	}
 }
 
-// CategoryClient is a client for the Category schema.
-type CategoryClient struct {
+// AdminSessionClient is a client for the AdminSession schema.
+type AdminSessionClient struct {
 	config
 }
 
-// NewCategoryClient returns a client for the Category from the given config.
-func NewCategoryClient(c config) *CategoryClient {
-	return &CategoryClient{config: c}
+// NewAdminSessionClient returns a client for the AdminSession from the given config.
+func NewAdminSessionClient(c config) *AdminSessionClient {
+	return &AdminSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
-func (c *CategoryClient) Use(hooks ...Hook) {
-	c.hooks.Category = append(c.hooks.Category, hooks...)
+// A call to `Use(f, g, h)` equals to `adminsession.Hooks(f(g(h())))`.
+func (c *AdminSessionClient) Use(hooks ...Hook) {
+	c.hooks.AdminSession = append(c.hooks.AdminSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
-func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Category = append(c.inters.Category, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `adminsession.Intercept(f(g(h())))`.
+func (c *AdminSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminSession = append(c.inters.AdminSession, interceptors...)
 }
 
-// Create returns a builder for creating a Category entity.
-func (c *CategoryClient) Create() *CategoryCreate {
-	mutation := newCategoryMutation(c.config, OpCreate)
-	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AdminSession entity.
+func (c *AdminSessionClient) Create() *AdminSessionCreate {
+	mutation := newAdminSessionMutation(c.config, OpCreate)
+	return &AdminSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Category entities.
-func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
-	return &CategoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AdminSession entities.
+func (c *AdminSessionClient) CreateBulk(builders ...*AdminSessionCreate) *AdminSessionCreateBulk {
+	return &AdminSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
+func (c *AdminSessionClient) MapCreateBulk(slice any, setFunc func(*AdminSessionCreate, int)) *AdminSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AdminSessionCreateBulk{err: fmt.Errorf("calling to AdminSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CategoryCreate, rv.Len())
+	builders := make([]*AdminSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CategoryCreateBulk{config: c.config, builders: builders}
+	return &AdminSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Category.
-func (c *CategoryClient) Update() *CategoryUpdate {
-	mutation := newCategoryMutation(c.config, OpUpdate)
-	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AdminSession.
+func (c *AdminSessionClient) Update() *AdminSessionUpdate {
+	mutation := newAdminSessionMutation(c.config, OpUpdate)
+	return &AdminSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
-	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
-	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminSessionClient) UpdateOne(_m *AdminSession) *AdminSessionUpdateOne {
+	mutation := newAdminSessionMutation(c.config, OpUpdateOne, withAdminSession(_m))
+	return &AdminSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CategoryClient) UpdateOneID(id int) *CategoryUpdateOne {
-	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
-	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AdminSessionClient) UpdateOneID(id int) *AdminSessionUpdateOne {
+	mutation := newAdminSessionMutation(c.config, OpUpdateOne, withAdminSessionID(id))
+	return &AdminSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Category.
-func (c *CategoryClient) Delete() *CategoryDelete {
-	mutation := newCategoryMutation(c.config, OpDelete)
-	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AdminSession.
+func (c *AdminSessionClient) Delete() *AdminSessionDelete {
+	mutation := newAdminSessionMutation(c.config, OpDelete)
+	return &AdminSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
+func (c *AdminSessionClient) DeleteOne(_m *AdminSession) *AdminSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CategoryClient) DeleteOneID(id int) *CategoryDeleteOne {
-	builder := c.Delete().Where(category.ID(id))
+func (c *AdminSessionClient) DeleteOneID(id int) *AdminSessionDeleteOne {
+	builder := c.Delete().Where(adminsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CategoryDeleteOne{builder}
+	return &AdminSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Category.
-func (c *CategoryClient) Query() *CategoryQuery {
-	return &CategoryQuery{
+// Query returns a query builder for AdminSession.
+func (c *AdminSessionClient) Query() *AdminSessionQuery {
+	return &AdminSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCategory},
+		ctx:    &QueryContext{Type: TypeAdminSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Category entity by its id.
-func (c *CategoryClient) Get(ctx context.Context, id int) (*Category, error) {
-	return c.Query().Where(category.ID(id)).Only(ctx)
+// Get returns a AdminSession entity by its id.
+func (c *AdminSessionClient) Get(ctx context.Context, id int) (*AdminSession, error) {
+	return c.Query().Where(adminsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CategoryClient) GetX(ctx context.Context, id int) *Category {
+func (c *AdminSessionClient) GetX(ctx context.Context, id int) *AdminSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1170,15 +1324,15 @@ func (c *CategoryClient) GetX(ctx context.Context, id int) *Category {
 	return obj
 }
 
-// QueryValidationCases queries the validation_cases edge of a Category.
-func (c *CategoryClient) QueryValidationCases(_m *Category) *ValidationCaseQuery {
-	query := (&ValidationCaseClient{config: c.config}).Query()
+// QueryAdmin queries the admin edge of a AdminSession.
+func (c *AdminSessionClient) QueryAdmin(_m *AdminSession) *AdminQuery {
+	query := (&AdminClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(category.Table, category.FieldID, id),
-			sqlgraph.To(validationcase.Table, validationcase.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, category.ValidationCasesTable, category.ValidationCasesColumn),
+			sqlgraph.From(adminsession.Table, adminsession.FieldID, id),
+			sqlgraph.To(admin.Table, admin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, adminsession.AdminTable, adminsession.AdminColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1187,131 +1341,131 @@ func (c *CategoryClient) QueryValidationCases(_m *Category) *ValidationCaseQuery
 }
 
 // Hooks returns the client hooks.
-func (c *CategoryClient) Hooks() []Hook {
-	return c.hooks.Category
+func (c *AdminSessionClient) Hooks() []Hook {
+	return c.hooks.AdminSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *CategoryClient) Interceptors() []Interceptor {
-	return c.inters.Category
+func (c *AdminSessionClient) Interceptors() []Interceptor {
+	return c.inters.AdminSession
 }
 
-func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
+func (c *AdminSessionClient) mutate(ctx context.Context, m *AdminSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AdminSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AdminSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AdminSession mutation op: %q", m.Op())
 	}
 }
 
-// ChainCursorClient is a client for the ChainCursor schema.
-type ChainCursorClient struct {
+// ArtifactSubmissionClient is a client for the ArtifactSubmission schema.
+type ArtifactSubmissionClient struct {
 	config
 }
 
-// NewChainCursorClient returns a client for the ChainCursor from the given config.
-func NewChainCursorClient(c config) *ChainCursorClient {
-	return &ChainCursorClient{config: c}
+// NewArtifactSubmissionClient returns a client for the ArtifactSubmission from the given config.
+func NewArtifactSubmissionClient(c config) *ArtifactSubmissionClient {
+	return &ArtifactSubmissionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `chaincursor.Hooks(f(g(h())))`.
-func (c *ChainCursorClient) Use(hooks ...Hook) {
-	c.hooks.ChainCursor = append(c.hooks.ChainCursor, hooks...)
+// A call to `Use(f, g, h)` equals to `artifactsubmission.Hooks(f(g(h())))`.
+func (c *ArtifactSubmissionClient) Use(hooks ...Hook) {
+	c.hooks.ArtifactSubmission = append(c.hooks.ArtifactSubmission, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `chaincursor.Intercept(f(g(h())))`.
-func (c *ChainCursorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ChainCursor = append(c.inters.ChainCursor, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `artifactsubmission.Intercept(f(g(h())))`.
+func (c *ArtifactSubmissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArtifactSubmission = append(c.inters.ArtifactSubmission, interceptors...)
 }
 
-// Create returns a builder for creating a ChainCursor entity.
-func (c *ChainCursorClient) Create() *ChainCursorCreate {
-	mutation := newChainCursorMutation(c.config, OpCreate)
-	return &ChainCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ArtifactSubmission entity.
+func (c *ArtifactSubmissionClient) Create() *ArtifactSubmissionCreate {
+	mutation := newArtifactSubmissionMutation(c.config, OpCreate)
+	return &ArtifactSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ChainCursor entities.
-func (c *ChainCursorClient) CreateBulk(builders ...*ChainCursorCreate) *ChainCursorCreateBulk {
-	return &ChainCursorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ArtifactSubmission entities.
+func (c *ArtifactSubmissionClient) CreateBulk(builders ...*ArtifactSubmissionCreate) *ArtifactSubmissionCreateBulk {
+	return &ArtifactSubmissionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ChainCursorClient) MapCreateBulk(slice any, setFunc func(*ChainCursorCreate, int)) *ChainCursorCreateBulk {
+func (c *ArtifactSubmissionClient) MapCreateBulk(slice any, setFunc func(*ArtifactSubmissionCreate, int)) *ArtifactSubmissionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ChainCursorCreateBulk{err: fmt.Errorf("calling to ChainCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArtifactSubmissionCreateBulk{err: fmt.Errorf("calling to ArtifactSubmissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ChainCursorCreate, rv.Len())
+	builders := make([]*ArtifactSubmissionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ChainCursorCreateBulk{config: c.config, builders: builders}
+	return &ArtifactSubmissionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ChainCursor.
-func (c *ChainCursorClient) Update() *ChainCursorUpdate {
-	mutation := newChainCursorMutation(c.config, OpUpdate)
-	return &ChainCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ArtifactSubmission.
+func (c *ArtifactSubmissionClient) Update() *ArtifactSubmissionUpdate {
+	mutation := newArtifactSubmissionMutation(c.config, OpUpdate)
+	return &ArtifactSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ChainCursorClient) UpdateOne(_m *ChainCursor) *ChainCursorUpdateOne {
-	mutation := newChainCursorMutation(c.config, OpUpdateOne, withChainCursor(_m))
-	return &ChainCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArtifactSubmissionClient) UpdateOne(_m *ArtifactSubmission) *ArtifactSubmissionUpdateOne {
+	mutation := newArtifactSubmissionMutation(c.config, OpUpdateOne, withArtifactSubmission(_m))
+	return &ArtifactSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ChainCursorClient) UpdateOneID(id int) *ChainCursorUpdateOne {
-	mutation := newChainCursorMutation(c.config, OpUpdateOne, withChainCursorID(id))
-	return &ChainCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArtifactSubmissionClient) UpdateOneID(id int) *ArtifactSubmissionUpdateOne {
+	mutation := newArtifactSubmissionMutation(c.config, OpUpdateOne, withArtifactSubmissionID(id))
+	return &ArtifactSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ChainCursor.
-func (c *ChainCursorClient) Delete() *ChainCursorDelete {
-	mutation := newChainCursorMutation(c.config, OpDelete)
-	return &ChainCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ArtifactSubmission.
+func (c *ArtifactSubmissionClient) Delete() *ArtifactSubmissionDelete {
+	mutation := newArtifactSubmissionMutation(c.config, OpDelete)
+	return &ArtifactSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ChainCursorClient) DeleteOne(_m *ChainCursor) *ChainCursorDeleteOne {
+func (c *ArtifactSubmissionClient) DeleteOne(_m *ArtifactSubmission) *ArtifactSubmissionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ChainCursorClient) DeleteOneID(id int) *ChainCursorDeleteOne {
-	builder := c.Delete().Where(chaincursor.ID(id))
+func (c *ArtifactSubmissionClient) DeleteOneID(id int) *ArtifactSubmissionDeleteOne {
+	builder := c.Delete().Where(artifactsubmission.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ChainCursorDeleteOne{builder}
+	return &ArtifactSubmissionDeleteOne{builder}
 }
 
-// Query returns a query builder for ChainCursor.
-func (c *ChainCursorClient) Query() *ChainCursorQuery {
-	return &ChainCursorQuery{
+// Query returns a query builder for ArtifactSubmission.
+func (c *ArtifactSubmissionClient) Query() *ArtifactSubmissionQuery {
+	return &ArtifactSubmissionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeChainCursor},
+		ctx:    &QueryContext{Type: TypeArtifactSubmission},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ChainCursor entity by its id.
-func (c *ChainCursorClient) Get(ctx context.Context, id int) (*ChainCursor, error) {
-	return c.Query().Where(chaincursor.ID(id)).Only(ctx)
+// Get returns a ArtifactSubmission entity by its id.
+func (c *ArtifactSubmissionClient) Get(ctx context.Context, id int) (*ArtifactSubmission, error) {
+	return c.Query().Where(artifactsubmission.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ChainCursorClient) GetX(ctx context.Context, id int) *ChainCursor {
+func (c *ArtifactSubmissionClient) GetX(ctx context.Context, id int) *ArtifactSubmission {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1319,132 +1473,164 @@ func (c *ChainCursorClient) GetX(ctx context.Context, id int) *ChainCursor {
 	return obj
 }
 
+// QueryValidationCase queries the validation_case edge of a ArtifactSubmission.
+func (c *ArtifactSubmissionClient) QueryValidationCase(_m *ArtifactSubmission) *ValidationCaseQuery {
+	query := (&ValidationCaseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifactsubmission.Table, artifactsubmission.FieldID, id),
+			sqlgraph.To(validationcase.Table, validationcase.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, artifactsubmission.ValidationCaseTable, artifactsubmission.ValidationCaseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryValidatorUser queries the validator_user edge of a ArtifactSubmission.
+func (c *ArtifactSubmissionClient) QueryValidatorUser(_m *ArtifactSubmission) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifactsubmission.Table, artifactsubmission.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, artifactsubmission.ValidatorUserTable, artifactsubmission.ValidatorUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *ChainCursorClient) Hooks() []Hook {
-	return c.hooks.ChainCursor
+func (c *ArtifactSubmissionClient) Hooks() []Hook {
+	return c.hooks.ArtifactSubmission
 }
 
 // Interceptors returns the client interceptors.
-func (c *ChainCursorClient) Interceptors() []Interceptor {
-	return c.inters.ChainCursor
+func (c *ArtifactSubmissionClient) Interceptors() []Interceptor {
+	return c.inters.ArtifactSubmission
 }
 
-func (c *ChainCursorClient) mutate(ctx context.Context, m *ChainCursorMutation) (Value, error) {
+func (c *ArtifactSubmissionClient) mutate(ctx context.Context, m *ArtifactSubmissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ChainCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArtifactSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ChainCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArtifactSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ChainCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArtifactSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ChainCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ArtifactSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ChainCursor mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ArtifactSubmission mutation op: %q", m.Op())
 	}
 }
 
-// ConsultationRequestClient is a client for the ConsultationRequest schema.
-type ConsultationRequestClient struct {
+// BackupCodeClient is a client for the BackupCode schema.
+type BackupCodeClient struct {
 	config
 }
 
-// NewConsultationRequestClient returns a client for the ConsultationRequest from the given config.
-func NewConsultationRequestClient(c config) *ConsultationRequestClient {
-	return &ConsultationRequestClient{config: c}
+// NewBackupCodeClient returns a client for the BackupCode from the given config.
+func NewBackupCodeClient(c config) *BackupCodeClient {
+	return &BackupCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `consultationrequest.Hooks(f(g(h())))`.
-func (c *ConsultationRequestClient) Use(hooks ...Hook) {
-	c.hooks.ConsultationRequest = append(c.hooks.ConsultationRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `backupcode.Hooks(f(g(h())))`.
+func (c *BackupCodeClient) Use(hooks ...Hook) {
+	c.hooks.BackupCode = append(c.hooks.BackupCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `consultationrequest.Intercept(f(g(h())))`.
-func (c *ConsultationRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ConsultationRequest = append(c.inters.ConsultationRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `backupcode.Intercept(f(g(h())))`.
+func (c *BackupCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupCode = append(c.inters.BackupCode, interceptors...)
 }
 
-// Create returns a builder for creating a ConsultationRequest entity.
-func (c *ConsultationRequestClient) Create() *ConsultationRequestCreate {
-	mutation := newConsultationRequestMutation(c.config, OpCreate)
-	return &ConsultationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
+// Create returns a builder for creating a BackupCode entity.
+func (c *BackupCodeClient) Create() *BackupCodeCreate {
+	mutation := newBackupCodeMutation(c.config, OpCreate)
+	return &BackupCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
 
-// CreateBulk returns a builder for creating a bulk of ConsultationRequest entities.
-func (c *ConsultationRequestClient) CreateBulk(builders ...*ConsultationRequestCreate) *ConsultationRequestCreateBulk {
-	return &ConsultationRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BackupCode entities.
+func (c *BackupCodeClient) CreateBulk(builders ...*BackupCodeCreate) *BackupCodeCreateBulk {
+	return &BackupCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ConsultationRequestClient) MapCreateBulk(slice any, setFunc func(*ConsultationRequestCreate, int)) *ConsultationRequestCreateBulk {
+func (c *BackupCodeClient) MapCreateBulk(slice any, setFunc func(*BackupCodeCreate, int)) *BackupCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ConsultationRequestCreateBulk{err: fmt.Errorf("calling to ConsultationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BackupCodeCreateBulk{err: fmt.Errorf("calling to BackupCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ConsultationRequestCreate, rv.Len())
+	builders := make([]*BackupCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ConsultationRequestCreateBulk{config: c.config, builders: builders}
+	return &BackupCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ConsultationRequest.
-func (c *ConsultationRequestClient) Update() *ConsultationRequestUpdate {
-	mutation := newConsultationRequestMutation(c.config, OpUpdate)
-	return &ConsultationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BackupCode.
+func (c *BackupCodeClient) Update() *BackupCodeUpdate {
+	mutation := newBackupCodeMutation(c.config, OpUpdate)
+	return &BackupCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ConsultationRequestClient) UpdateOne(_m *ConsultationRequest) *ConsultationRequestUpdateOne {
-	mutation := newConsultationRequestMutation(c.config, OpUpdateOne, withConsultationRequest(_m))
-	return &ConsultationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BackupCodeClient) UpdateOne(_m *BackupCode) *BackupCodeUpdateOne {
+	mutation := newBackupCodeMutation(c.config, OpUpdateOne, withBackupCode(_m))
+	return &BackupCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ConsultationRequestClient) UpdateOneID(id int) *ConsultationRequestUpdateOne {
-	mutation := newConsultationRequestMutation(c.config, OpUpdateOne, withConsultationRequestID(id))
-	return &ConsultationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BackupCodeClient) UpdateOneID(id int) *BackupCodeUpdateOne {
+	mutation := newBackupCodeMutation(c.config, OpUpdateOne, withBackupCodeID(id))
+	return &BackupCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ConsultationRequest.
-func (c *ConsultationRequestClient) Delete() *ConsultationRequestDelete {
-	mutation := newConsultationRequestMutation(c.config, OpDelete)
-	return &ConsultationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BackupCode.
+func (c *BackupCodeClient) Delete() *BackupCodeDelete {
+	mutation := newBackupCodeMutation(c.config, OpDelete)
+	return &BackupCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ConsultationRequestClient) DeleteOne(_m *ConsultationRequest) *ConsultationRequestDeleteOne {
+func (c *BackupCodeClient) DeleteOne(_m *BackupCode) *BackupCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ConsultationRequestClient) DeleteOneID(id int) *ConsultationRequestDeleteOne {
-	builder := c.Delete().Where(consultationrequest.ID(id))
+func (c *BackupCodeClient) DeleteOneID(id int) *BackupCodeDeleteOne {
+	builder := c.Delete().Where(backupcode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ConsultationRequestDeleteOne{builder}
+	return &BackupCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for ConsultationRequest.
-func (c *ConsultationRequestClient) Query() *ConsultationRequestQuery {
-	return &ConsultationRequestQuery{
+// Query returns a query builder for BackupCode.
+func (c *BackupCodeClient) Query() *BackupCodeQuery {
+	return &BackupCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeConsultationRequest},
+		ctx:    &QueryContext{Type: TypeBackupCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ConsultationRequest entity by its id.
-func (c *ConsultationRequestClient) Get(ctx context.Context, id int) (*ConsultationRequest, error) {
-	return c.Query().Where(consultationrequest.ID(id)).Only(ctx)
+// Get returns a BackupCode entity by its id.
+func (c *BackupCodeClient) Get(ctx context.Context, id int) (*BackupCode, error) {
+	return c.Query().Where(backupcode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ConsultationRequestClient) GetX(ctx context.Context, id int) *ConsultationRequest {
+func (c *BackupCodeClient) GetX(ctx context.Context, id int) *BackupCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1452,31 +1638,15 @@ func (c *ConsultationRequestClient) GetX(ctx context.Context, id int) *Consultat
 	return obj
 }
 
-// QueryValidationCase queries the validation_case edge of a ConsultationRequest.
-func (c *ConsultationRequestClient) QueryValidationCase(_m *ConsultationRequest) *ValidationCaseQuery {
-	query := (&ValidationCaseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(consultationrequest.Table, consultationrequest.FieldID, id),
-			sqlgraph.To(validationcase.Table, validationcase.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, consultationrequest.ValidationCaseTable, consultationrequest.ValidationCaseColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryValidatorUser queries the validator_user edge of a ConsultationRequest.
-func (c *ConsultationRequestClient) QueryValidatorUser(_m *ConsultationRequest) *UserQuery {
+// QueryUser queries the user edge of a BackupCode.
+func (c *BackupCodeClient) QueryUser(_m *BackupCode) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(consultationrequest.Table, consultationrequest.FieldID, id),
+			sqlgraph.From(backupcode.Table, backupcode.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, consultationrequest.ValidatorUserTable, consultationrequest.ValidatorUserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, backupcode.UserTable, backupcode.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1485,131 +1655,131 @@ func (c *ConsultationRequestClient) QueryValidatorUser(_m *ConsultationRequest)
 }
 
 // Hooks returns the client hooks.
-func (c *ConsultationRequestClient) Hooks() []Hook {
-	return c.hooks.ConsultationRequest
+func (c *BackupCodeClient) Hooks() []Hook {
+	return c.hooks.BackupCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *ConsultationRequestClient) Interceptors() []Interceptor {
-	return c.inters.ConsultationRequest
+func (c *BackupCodeClient) Interceptors() []Interceptor {
+	return c.inters.BackupCode
 }
 
-func (c *ConsultationRequestClient) mutate(ctx context.Context, m *ConsultationRequestMutation) (Value, error) {
+func (c *BackupCodeClient) mutate(ctx context.Context, m *BackupCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ConsultationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BackupCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ConsultationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BackupCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ConsultationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BackupCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ConsultationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BackupCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ConsultationRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BackupCode mutation op: %q", m.Op())
 	}
 }
 
-// CredentialClient is a client for the Credential schema.
-type CredentialClient struct {
+// BadgeClient is a client for the Badge schema.
+type BadgeClient struct {
 	config
 }
 
-// NewCredentialClient returns a client for the Credential from the given config.
-func NewCredentialClient(c config) *CredentialClient {
-	return &CredentialClient{config: c}
+// NewBadgeClient returns a client for the Badge from the given config.
+func NewBadgeClient(c config) *BadgeClient {
+	return &BadgeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `credential.Hooks(f(g(h())))`.
-func (c *CredentialClient) Use(hooks ...Hook) {
-	c.hooks.Credential = append(c.hooks.Credential, hooks...)
+// A call to `Use(f, g, h)` equals to `badge.Hooks(f(g(h())))`.
+func (c *BadgeClient) Use(hooks ...Hook) {
+	c.hooks.Badge = append(c.hooks.Badge, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `credential.Intercept(f(g(h())))`.
-func (c *CredentialClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Credential = append(c.inters.Credential, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `badge.Intercept(f(g(h())))`.
+func (c *BadgeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Badge = append(c.inters.Badge, interceptors...)
 }
 
-// Create returns a builder for creating a Credential entity.
-func (c *CredentialClient) Create() *CredentialCreate {
-	mutation := newCredentialMutation(c.config, OpCreate)
-	return &CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Badge entity.
+func (c *BadgeClient) Create() *BadgeCreate {
+	mutation := newBadgeMutation(c.config, OpCreate)
+	return &BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Credential entities.
-func (c *CredentialClient) CreateBulk(builders ...*CredentialCreate) *CredentialCreateBulk {
-	return &CredentialCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Badge entities.
+func (c *BadgeClient) CreateBulk(builders ...*BadgeCreate) *BadgeCreateBulk {
+	return &BadgeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CredentialClient) MapCreateBulk(slice any, setFunc func(*CredentialCreate, int)) *CredentialCreateBulk {
+func (c *BadgeClient) MapCreateBulk(slice any, setFunc func(*BadgeCreate, int)) *BadgeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CredentialCreateBulk{err: fmt.Errorf("calling to CredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BadgeCreateBulk{err: fmt.Errorf("calling to BadgeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CredentialCreate, rv.Len())
+	builders := make([]*BadgeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CredentialCreateBulk{config: c.config, builders: builders}
+	return &BadgeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Credential.
-func (c *CredentialClient) Update() *CredentialUpdate {
-	mutation := newCredentialMutation(c.config, OpUpdate)
-	return &CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Badge.
+func (c *BadgeClient) Update() *BadgeUpdate {
+	mutation := newBadgeMutation(c.config, OpUpdate)
+	return &BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CredentialClient) UpdateOne(_m *Credential) *CredentialUpdateOne {
-	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredential(_m))
-	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BadgeClient) UpdateOne(_m *Badge) *BadgeUpdateOne {
+	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadge(_m))
+	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CredentialClient) UpdateOneID(id int) *CredentialUpdateOne {
-	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredentialID(id))
-	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BadgeClient) UpdateOneID(id int) *BadgeUpdateOne {
+	mutation := newBadgeMutation(c.config, OpUpdateOne, withBadgeID(id))
+	return &BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Credential.
-func (c *CredentialClient) Delete() *CredentialDelete {
-	mutation := newCredentialMutation(c.config, OpDelete)
-	return &CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Badge.
+func (c *BadgeClient) Delete() *BadgeDelete {
+	mutation := newBadgeMutation(c.config, OpDelete)
+	return &BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CredentialClient) DeleteOne(_m *Credential) *CredentialDeleteOne {
+func (c *BadgeClient) DeleteOne(_m *Badge) *BadgeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CredentialClient) DeleteOneID(id int) *CredentialDeleteOne {
-	builder := c.Delete().Where(credential.ID(id))
+func (c *BadgeClient) DeleteOneID(id int) *BadgeDeleteOne {
+	builder := c.Delete().Where(badge.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CredentialDeleteOne{builder}
+	return &BadgeDeleteOne{builder}
 }
 
-// Query returns a query builder for Credential.
-func (c *CredentialClient) Query() *CredentialQuery {
-	return &CredentialQuery{
+// Query returns a query builder for Badge.
+func (c *BadgeClient) Query() *BadgeQuery {
+	return &BadgeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCredential},
+		ctx:    &QueryContext{Type: TypeBadge},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Credential entity by its id.
-func (c *CredentialClient) Get(ctx context.Context, id int) (*Credential, error) {
-	return c.Query().Where(credential.ID(id)).Only(ctx)
+// Get returns a Badge entity by its id.
+func (c *BadgeClient) Get(ctx context.Context, id int) (*Badge, error) {
+	return c.Query().Where(badge.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CredentialClient) GetX(ctx context.Context, id int) *Credential {
+func (c *BadgeClient) GetX(ctx context.Context, id int) *Badge {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1617,15 +1787,31 @@ func (c *CredentialClient) GetX(ctx context.Context, id int) *Credential {
 	return obj
 }
 
-// QueryUser queries the user edge of a Credential.
-func (c *CredentialClient) QueryUser(_m *Credential) *UserQuery {
+// QueryUserBadges queries the user_badges edge of a Badge.
+func (c *BadgeClient) QueryUserBadges(_m *Badge) *UserBadgeQuery {
+	query := (&UserBadgeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(badge.Table, badge.FieldID, id),
+			sqlgraph.To(userbadge.Table, userbadge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, badge.UserBadgesTable, badge.UserBadgesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrimaryUsers queries the primary_users edge of a Badge.
+func (c *BadgeClient) QueryPrimaryUsers(_m *Badge) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(credential.Table, credential.FieldID, id),
+			sqlgraph.From(badge.Table, badge.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, credential.UserTable, credential.UserColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, badge.PrimaryUsersTable, badge.PrimaryUsersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1634,131 +1820,131 @@ func (c *CredentialClient) QueryUser(_m *Credential) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CredentialClient) Hooks() []Hook {
-	return c.hooks.Credential
+func (c *BadgeClient) Hooks() []Hook {
+	return c.hooks.Badge
 }
 
 // Interceptors returns the client interceptors.
-func (c *CredentialClient) Interceptors() []Interceptor {
-	return c.inters.Credential
+func (c *BadgeClient) Interceptors() []Interceptor {
+	return c.inters.Badge
 }
 
-func (c *CredentialClient) mutate(ctx context.Context, m *CredentialMutation) (Value, error) {
+func (c *BadgeClient) mutate(ctx context.Context, m *BadgeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BadgeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BadgeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BadgeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BadgeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Credential mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Badge mutation op: %q", m.Op())
 	}
 }
 
-// DeviceFingerprintClient is a client for the DeviceFingerprint schema.
-type DeviceFingerprintClient struct {
+// CaseClarificationClient is a client for the CaseClarification schema.
+type CaseClarificationClient struct {
 	config
 }
 
-// NewDeviceFingerprintClient returns a client for the DeviceFingerprint from the given config.
-func NewDeviceFingerprintClient(c config) *DeviceFingerprintClient {
-	return &DeviceFingerprintClient{config: c}
+// NewCaseClarificationClient returns a client for the CaseClarification from the given config.
+func NewCaseClarificationClient(c config) *CaseClarificationClient {
+	return &CaseClarificationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `devicefingerprint.Hooks(f(g(h())))`.
-func (c *DeviceFingerprintClient) Use(hooks ...Hook) {
-	c.hooks.DeviceFingerprint = append(c.hooks.DeviceFingerprint, hooks...)
+// A call to `Use(f, g, h)` equals to `caseclarification.Hooks(f(g(h())))`.
+func (c *CaseClarificationClient) Use(hooks ...Hook) {
+	c.hooks.CaseClarification = append(c.hooks.CaseClarification, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `devicefingerprint.Intercept(f(g(h())))`.
-func (c *DeviceFingerprintClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeviceFingerprint = append(c.inters.DeviceFingerprint, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `caseclarification.Intercept(f(g(h())))`.
+func (c *CaseClarificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CaseClarification = append(c.inters.CaseClarification, interceptors...)
 }
 
-// Create returns a builder for creating a DeviceFingerprint entity.
-func (c *DeviceFingerprintClient) Create() *DeviceFingerprintCreate {
-	mutation := newDeviceFingerprintMutation(c.config, OpCreate)
-	return &DeviceFingerprintCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CaseClarification entity.
+func (c *CaseClarificationClient) Create() *CaseClarificationCreate {
+	mutation := newCaseClarificationMutation(c.config, OpCreate)
+	return &CaseClarificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeviceFingerprint entities.
-func (c *DeviceFingerprintClient) CreateBulk(builders ...*DeviceFingerprintCreate) *DeviceFingerprintCreateBulk {
-	return &DeviceFingerprintCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CaseClarification entities.
+func (c *CaseClarificationClient) CreateBulk(builders ...*CaseClarificationCreate) *CaseClarificationCreateBulk {
+	return &CaseClarificationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeviceFingerprintClient) MapCreateBulk(slice any, setFunc func(*DeviceFingerprintCreate, int)) *DeviceFingerprintCreateBulk {
+func (c *CaseClarificationClient) MapCreateBulk(slice any, setFunc func(*CaseClarificationCreate, int)) *CaseClarificationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeviceFingerprintCreateBulk{err: fmt.Errorf("calling to DeviceFingerprintClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CaseClarificationCreateBulk{err: fmt.Errorf("calling to CaseClarificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeviceFingerprintCreate, rv.Len())
+	builders := make([]*CaseClarificationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeviceFingerprintCreateBulk{config: c.config, builders: builders}
+	return &CaseClarificationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeviceFingerprint.
-func (c *DeviceFingerprintClient) Update() *DeviceFingerprintUpdate {
-	mutation := newDeviceFingerprintMutation(c.config, OpUpdate)
-	return &DeviceFingerprintUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CaseClarification.
+func (c *CaseClarificationClient) Update() *CaseClarificationUpdate {
+	mutation := newCaseClarificationMutation(c.config, OpUpdate)
+	return &CaseClarificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeviceFingerprintClient) UpdateOne(_m *DeviceFingerprint) *DeviceFingerprintUpdateOne {
-	mutation := newDeviceFingerprintMutation(c.config, OpUpdateOne, withDeviceFingerprint(_m))
-	return &DeviceFingerprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CaseClarificationClient) UpdateOne(_m *CaseClarification) *CaseClarificationUpdateOne {
+	mutation := newCaseClarificationMutation(c.config, OpUpdateOne, withCaseClarification(_m))
+	return &CaseClarificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeviceFingerprintClient) UpdateOneID(id int) *DeviceFingerprintUpdateOne {
-	mutation := newDeviceFingerprintMutation(c.config, OpUpdateOne, withDeviceFingerprintID(id))
-	return &DeviceFingerprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CaseClarificationClient) UpdateOneID(id int) *CaseClarificationUpdateOne {
+	mutation := newCaseClarificationMutation(c.config, OpUpdateOne, withCaseClarificationID(id))
+	return &CaseClarificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeviceFingerprint.
-func (c *DeviceFingerprintClient) Delete() *DeviceFingerprintDelete {
-	mutation := newDeviceFingerprintMutation(c.config, OpDelete)
-	return &DeviceFingerprintDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CaseClarification.
+func (c *CaseClarificationClient) Delete() *CaseClarificationDelete {
+	mutation := newCaseClarificationMutation(c.config, OpDelete)
+	return &CaseClarificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeviceFingerprintClient) DeleteOne(_m *DeviceFingerprint) *DeviceFingerprintDeleteOne {
+func (c *CaseClarificationClient) DeleteOne(_m *CaseClarification) *CaseClarificationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeviceFingerprintClient) DeleteOneID(id int) *DeviceFingerprintDeleteOne {
-	builder := c.Delete().Where(devicefingerprint.ID(id))
+func (c *CaseClarificationClient) DeleteOneID(id int) *CaseClarificationDeleteOne {
+	builder := c.Delete().Where(caseclarification.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeviceFingerprintDeleteOne{builder}
+	return &CaseClarificationDeleteOne{builder}
 }
 
-// Query returns a query builder for DeviceFingerprint.
-func (c *DeviceFingerprintClient) Query() *DeviceFingerprintQuery {
-	return &DeviceFingerprintQuery{
+// Query returns a query builder for CaseClarification.
+func (c *CaseClarificationClient) Query() *CaseClarificationQuery {
+	return &CaseClarificationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeviceFingerprint},
+		ctx:    &QueryContext{Type: TypeCaseClarification},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeviceFingerprint entity by its id.
-func (c *DeviceFingerprintClient) Get(ctx context.Context, id int) (*DeviceFingerprint, error) {
-	return c.Query().Where(devicefingerprint.ID(id)).Only(ctx)
+// Get returns a CaseClarification entity by its id.
+func (c *CaseClarificationClient) Get(ctx context.Context, id int) (*CaseClarification, error) {
+	return c.Query().Where(caseclarification.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeviceFingerprintClient) GetX(ctx context.Context, id int) *DeviceFingerprint {
+func (c *CaseClarificationClient) GetX(ctx context.Context, id int) *CaseClarification {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1766,148 +1952,132 @@ func (c *DeviceFingerprintClient) GetX(ctx context.Context, id int) *DeviceFinge
 	return obj
 }
 
-// QueryUser queries the user edge of a DeviceFingerprint.
-func (c *DeviceFingerprintClient) QueryUser(_m *DeviceFingerprint) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(devicefingerprint.Table, devicefingerprint.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, devicefingerprint.UserTable, devicefingerprint.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DeviceFingerprintClient) Hooks() []Hook {
-	return c.hooks.DeviceFingerprint
+func (c *CaseClarificationClient) Hooks() []Hook {
+	return c.hooks.CaseClarification
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeviceFingerprintClient) Interceptors() []Interceptor {
-	return c.inters.DeviceFingerprint
+func (c *CaseClarificationClient) Interceptors() []Interceptor {
+	return c.inters.CaseClarification
 }
 
-func (c *DeviceFingerprintClient) mutate(ctx context.Context, m *DeviceFingerprintMutation) (Value, error) {
+func (c *CaseClarificationClient) mutate(ctx context.Context, m *CaseClarificationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeviceFingerprintCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseClarificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeviceFingerprintUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseClarificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeviceFingerprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseClarificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeviceFingerprintDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CaseClarificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeviceFingerprint mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CaseClarification mutation op: %q", m.Op())
 	}
 }
 
-// DeviceUserMappingClient is a client for the DeviceUserMapping schema.
-type DeviceUserMappingClient struct {
+// CaseConversationClient is a client for the CaseConversation schema.
+type CaseConversationClient struct {
 	config
 }
 
-// NewDeviceUserMappingClient returns a client for the DeviceUserMapping from the given config.
-func NewDeviceUserMappingClient(c config) *DeviceUserMappingClient {
-	return &DeviceUserMappingClient{config: c}
+// NewCaseConversationClient returns a client for the CaseConversation from the given config.
+func NewCaseConversationClient(c config) *CaseConversationClient {
+	return &CaseConversationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `deviceusermapping.Hooks(f(g(h())))`.
-func (c *DeviceUserMappingClient) Use(hooks ...Hook) {
-	c.hooks.DeviceUserMapping = append(c.hooks.DeviceUserMapping, hooks...)
+// A call to `Use(f, g, h)` equals to `caseconversation.Hooks(f(g(h())))`.
+func (c *CaseConversationClient) Use(hooks ...Hook) {
+	c.hooks.CaseConversation = append(c.hooks.CaseConversation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `deviceusermapping.Intercept(f(g(h())))`.
-func (c *DeviceUserMappingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DeviceUserMapping = append(c.inters.DeviceUserMapping, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `caseconversation.Intercept(f(g(h())))`.
+func (c *CaseConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CaseConversation = append(c.inters.CaseConversation, interceptors...)
 }
 
-// Create returns a builder for creating a DeviceUserMapping entity.
-func (c *DeviceUserMappingClient) Create() *DeviceUserMappingCreate {
-	mutation := newDeviceUserMappingMutation(c.config, OpCreate)
-	return &DeviceUserMappingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CaseConversation entity.
+func (c *CaseConversationClient) Create() *CaseConversationCreate {
+	mutation := newCaseConversationMutation(c.config, OpCreate)
+	return &CaseConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DeviceUserMapping entities.
-func (c *DeviceUserMappingClient) CreateBulk(builders ...*DeviceUserMappingCreate) *DeviceUserMappingCreateBulk {
-	return &DeviceUserMappingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CaseConversation entities.
+func (c *CaseConversationClient) CreateBulk(builders ...*CaseConversationCreate) *CaseConversationCreateBulk {
+	return &CaseConversationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DeviceUserMappingClient) MapCreateBulk(slice any, setFunc func(*DeviceUserMappingCreate, int)) *DeviceUserMappingCreateBulk {
+func (c *CaseConversationClient) MapCreateBulk(slice any, setFunc func(*CaseConversationCreate, int)) *CaseConversationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DeviceUserMappingCreateBulk{err: fmt.Errorf("calling to DeviceUserMappingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CaseConversationCreateBulk{err: fmt.Errorf("calling to CaseConversationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DeviceUserMappingCreate, rv.Len())
+	builders := make([]*CaseConversationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DeviceUserMappingCreateBulk{config: c.config, builders: builders}
+	return &CaseConversationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DeviceUserMapping.
-func (c *DeviceUserMappingClient) Update() *DeviceUserMappingUpdate {
-	mutation := newDeviceUserMappingMutation(c.config, OpUpdate)
-	return &DeviceUserMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CaseConversation.
+func (c *CaseConversationClient) Update() *CaseConversationUpdate {
+	mutation := newCaseConversationMutation(c.config, OpUpdate)
+	return &CaseConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DeviceUserMappingClient) UpdateOne(_m *DeviceUserMapping) *DeviceUserMappingUpdateOne {
-	mutation := newDeviceUserMappingMutation(c.config, OpUpdateOne, withDeviceUserMapping(_m))
-	return &DeviceUserMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CaseConversationClient) UpdateOne(_m *CaseConversation) *CaseConversationUpdateOne {
+	mutation := newCaseConversationMutation(c.config, OpUpdateOne, withCaseConversation(_m))
+	return &CaseConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DeviceUserMappingClient) UpdateOneID(id int) *DeviceUserMappingUpdateOne {
-	mutation := newDeviceUserMappingMutation(c.config, OpUpdateOne, withDeviceUserMappingID(id))
-	return &DeviceUserMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CaseConversationClient) UpdateOneID(id int) *CaseConversationUpdateOne {
+	mutation := newCaseConversationMutation(c.config, OpUpdateOne, withCaseConversationID(id))
+	return &CaseConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DeviceUserMapping.
-func (c *DeviceUserMappingClient) Delete() *DeviceUserMappingDelete {
-	mutation := newDeviceUserMappingMutation(c.config, OpDelete)
-	return &DeviceUserMappingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CaseConversation.
+func (c *CaseConversationClient) Delete() *CaseConversationDelete {
+	mutation := newCaseConversationMutation(c.config, OpDelete)
+	return &CaseConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DeviceUserMappingClient) DeleteOne(_m *DeviceUserMapping) *DeviceUserMappingDeleteOne {
+func (c *CaseConversationClient) DeleteOne(_m *CaseConversation) *CaseConversationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DeviceUserMappingClient) DeleteOneID(id int) *DeviceUserMappingDeleteOne {
-	builder := c.Delete().Where(deviceusermapping.ID(id))
+func (c *CaseConversationClient) DeleteOneID(id int) *CaseConversationDeleteOne {
+	builder := c.Delete().Where(caseconversation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DeviceUserMappingDeleteOne{builder}
+	return &CaseConversationDeleteOne{builder}
 }
 
-// Query returns a query builder for DeviceUserMapping.
-func (c *DeviceUserMappingClient) Query() *DeviceUserMappingQuery {
-	return &DeviceUserMappingQuery{
+// Query returns a query builder for CaseConversation.
+func (c *CaseConversationClient) Query() *CaseConversationQuery {
+	return &CaseConversationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDeviceUserMapping},
+		ctx:    &QueryContext{Type: TypeCaseConversation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DeviceUserMapping entity by its id.
-func (c *DeviceUserMappingClient) Get(ctx context.Context, id int) (*DeviceUserMapping, error) {
-	return c.Query().Where(deviceusermapping.ID(id)).Only(ctx)
+// Get returns a CaseConversation entity by its id.
+func (c *CaseConversationClient) Get(ctx context.Context, id int) (*CaseConversation, error) {
+	return c.Query().Where(caseconversation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DeviceUserMappingClient) GetX(ctx context.Context, id int) *DeviceUserMapping {
+func (c *CaseConversationClient) GetX(ctx context.Context, id int) *CaseConversation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1915,15 +2085,15 @@ func (c *DeviceUserMappingClient) GetX(ctx context.Context, id int) *DeviceUserM
 	return obj
 }
 
-// QueryUser queries the user edge of a DeviceUserMapping.
-func (c *DeviceUserMappingClient) QueryUser(_m *DeviceUserMapping) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryMessages queries the messages edge of a CaseConversation.
+func (c *CaseConversationClient) QueryMessages(_m *CaseConversation) *CaseMessageQuery {
+	query := (&CaseMessageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(deviceusermapping.Table, deviceusermapping.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, deviceusermapping.UserTable, deviceusermapping.UserColumn),
+			sqlgraph.From(caseconversation.Table, caseconversation.FieldID, id),
+			sqlgraph.To(casemessage.Table, casemessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, caseconversation.MessagesTable, caseconversation.MessagesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1932,131 +2102,131 @@ func (c *DeviceUserMappingClient) QueryUser(_m *DeviceUserMapping) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *DeviceUserMappingClient) Hooks() []Hook {
-	return c.hooks.DeviceUserMapping
+func (c *CaseConversationClient) Hooks() []Hook {
+	return c.hooks.CaseConversation
 }
 
 // Interceptors returns the client interceptors.
-func (c *DeviceUserMappingClient) Interceptors() []Interceptor {
-	return c.inters.DeviceUserMapping
+func (c *CaseConversationClient) Interceptors() []Interceptor {
+	return c.inters.CaseConversation
 }
 
-func (c *DeviceUserMappingClient) mutate(ctx context.Context, m *DeviceUserMappingMutation) (Value, error) {
+func (c *CaseConversationClient) mutate(ctx context.Context, m *CaseConversationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DeviceUserMappingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DeviceUserMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DeviceUserMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DeviceUserMappingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CaseConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DeviceUserMapping mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CaseConversation mutation op: %q", m.Op())
 	}
 }
 
-// EmailVerificationTokenClient is a client for the EmailVerificationToken schema.
-type EmailVerificationTokenClient struct {
+// CaseMessageClient is a client for the CaseMessage schema.
+type CaseMessageClient struct {
 	config
 }
 
-// NewEmailVerificationTokenClient returns a client for the EmailVerificationToken from the given config.
-func NewEmailVerificationTokenClient(c config) *EmailVerificationTokenClient {
-	return &EmailVerificationTokenClient{config: c}
+// NewCaseMessageClient returns a client for the CaseMessage from the given config.
+func NewCaseMessageClient(c config) *CaseMessageClient {
+	return &CaseMessageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailverificationtoken.Hooks(f(g(h())))`.
-func (c *EmailVerificationTokenClient) Use(hooks ...Hook) {
-	c.hooks.EmailVerificationToken = append(c.hooks.EmailVerificationToken, hooks...)
+// A call to `Use(f, g, h)` equals to `casemessage.Hooks(f(g(h())))`.
+func (c *CaseMessageClient) Use(hooks ...Hook) {
+	c.hooks.CaseMessage = append(c.hooks.CaseMessage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailverificationtoken.Intercept(f(g(h())))`.
-func (c *EmailVerificationTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailVerificationToken = append(c.inters.EmailVerificationToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `casemessage.Intercept(f(g(h())))`.
+func (c *CaseMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CaseMessage = append(c.inters.CaseMessage, interceptors...)
 }
 
-// Create returns a builder for creating a EmailVerificationToken entity.
-func (c *EmailVerificationTokenClient) Create() *EmailVerificationTokenCreate {
-	mutation := newEmailVerificationTokenMutation(c.config, OpCreate)
-	return &EmailVerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CaseMessage entity.
+func (c *CaseMessageClient) Create() *CaseMessageCreate {
+	mutation := newCaseMessageMutation(c.config, OpCreate)
+	return &CaseMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailVerificationToken entities.
-func (c *EmailVerificationTokenClient) CreateBulk(builders ...*EmailVerificationTokenCreate) *EmailVerificationTokenCreateBulk {
-	return &EmailVerificationTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CaseMessage entities.
+func (c *CaseMessageClient) CreateBulk(builders ...*CaseMessageCreate) *CaseMessageCreateBulk {
+	return &CaseMessageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailVerificationTokenClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationTokenCreate, int)) *EmailVerificationTokenCreateBulk {
+func (c *CaseMessageClient) MapCreateBulk(slice any, setFunc func(*CaseMessageCreate, int)) *CaseMessageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailVerificationTokenCreateBulk{err: fmt.Errorf("calling to EmailVerificationTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CaseMessageCreateBulk{err: fmt.Errorf("calling to CaseMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailVerificationTokenCreate, rv.Len())
+	builders := make([]*CaseMessageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailVerificationTokenCreateBulk{config: c.config, builders: builders}
+	return &CaseMessageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailVerificationToken.
-func (c *EmailVerificationTokenClient) Update() *EmailVerificationTokenUpdate {
-	mutation := newEmailVerificationTokenMutation(c.config, OpUpdate)
-	return &EmailVerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CaseMessage.
+func (c *CaseMessageClient) Update() *CaseMessageUpdate {
+	mutation := newCaseMessageMutation(c.config, OpUpdate)
+	return &CaseMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailVerificationTokenClient) UpdateOne(_m *EmailVerificationToken) *EmailVerificationTokenUpdateOne {
-	mutation := newEmailVerificationTokenMutation(c.config, OpUpdateOne, withEmailVerificationToken(_m))
-	return &EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CaseMessageClient) UpdateOne(_m *CaseMessage) *CaseMessageUpdateOne {
+	mutation := newCaseMessageMutation(c.config, OpUpdateOne, withCaseMessage(_m))
+	return &CaseMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailVerificationTokenClient) UpdateOneID(id int) *EmailVerificationTokenUpdateOne {
-	mutation := newEmailVerificationTokenMutation(c.config, OpUpdateOne, withEmailVerificationTokenID(id))
-	return &EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CaseMessageClient) UpdateOneID(id int) *CaseMessageUpdateOne {
+	mutation := newCaseMessageMutation(c.config, OpUpdateOne, withCaseMessageID(id))
+	return &CaseMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailVerificationToken.
-func (c *EmailVerificationTokenClient) Delete() *EmailVerificationTokenDelete {
-	mutation := newEmailVerificationTokenMutation(c.config, OpDelete)
-	return &EmailVerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CaseMessage.
+func (c *CaseMessageClient) Delete() *CaseMessageDelete {
+	mutation := newCaseMessageMutation(c.config, OpDelete)
+	return &CaseMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailVerificationTokenClient) DeleteOne(_m *EmailVerificationToken) *EmailVerificationTokenDeleteOne {
+func (c *CaseMessageClient) DeleteOne(_m *CaseMessage) *CaseMessageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailVerificationTokenClient) DeleteOneID(id int) *EmailVerificationTokenDeleteOne {
-	builder := c.Delete().Where(emailverificationtoken.ID(id))
+func (c *CaseMessageClient) DeleteOneID(id int) *CaseMessageDeleteOne {
+	builder := c.Delete().Where(casemessage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailVerificationTokenDeleteOne{builder}
+	return &CaseMessageDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailVerificationToken.
-func (c *EmailVerificationTokenClient) Query() *EmailVerificationTokenQuery {
-	return &EmailVerificationTokenQuery{
+// Query returns a query builder for CaseMessage.
+func (c *CaseMessageClient) Query() *CaseMessageQuery {
+	return &CaseMessageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailVerificationToken},
+		ctx:    &QueryContext{Type: TypeCaseMessage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailVerificationToken entity by its id.
-func (c *EmailVerificationTokenClient) Get(ctx context.Context, id int) (*EmailVerificationToken, error) {
-	return c.Query().Where(emailverificationtoken.ID(id)).Only(ctx)
+// Get returns a CaseMessage entity by its id.
+func (c *CaseMessageClient) Get(ctx context.Context, id int) (*CaseMessage, error) {
+	return c.Query().Where(casemessage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailVerificationTokenClient) GetX(ctx context.Context, id int) *EmailVerificationToken {
+func (c *CaseMessageClient) GetX(ctx context.Context, id int) *CaseMessage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2064,15 +2234,15 @@ func (c *EmailVerificationTokenClient) GetX(ctx context.Context, id int) *EmailV
 	return obj
 }
 
-// QueryUser queries the user edge of a EmailVerificationToken.
-func (c *EmailVerificationTokenClient) QueryUser(_m *EmailVerificationToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryConversation queries the conversation edge of a CaseMessage.
+func (c *CaseMessageClient) QueryConversation(_m *CaseMessage) *CaseConversationQuery {
+	query := (&CaseConversationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(emailverificationtoken.Table, emailverificationtoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, emailverificationtoken.UserTable, emailverificationtoken.UserColumn),
+			sqlgraph.From(casemessage.Table, casemessage.FieldID, id),
+			sqlgraph.To(caseconversation.Table, caseconversation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, casemessage.ConversationTable, casemessage.ConversationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2081,131 +2251,131 @@ func (c *EmailVerificationTokenClient) QueryUser(_m *EmailVerificationToken) *Us
 }
 
 // Hooks returns the client hooks.
-func (c *EmailVerificationTokenClient) Hooks() []Hook {
-	return c.hooks.EmailVerificationToken
+func (c *CaseMessageClient) Hooks() []Hook {
+	return c.hooks.CaseMessage
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailVerificationTokenClient) Interceptors() []Interceptor {
-	return c.inters.EmailVerificationToken
+func (c *CaseMessageClient) Interceptors() []Interceptor {
+	return c.inters.CaseMessage
 }
 
-func (c *EmailVerificationTokenClient) mutate(ctx context.Context, m *EmailVerificationTokenMutation) (Value, error) {
+func (c *CaseMessageClient) mutate(ctx context.Context, m *CaseMessageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailVerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailVerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CaseMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailVerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CaseMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailVerificationToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CaseMessage mutation op: %q", m.Op())
 	}
 }
 
-// EndorsementClient is a client for the Endorsement schema.
-type EndorsementClient struct {
+// CategoryClient is a client for the Category schema.
+type CategoryClient struct {
 	config
 }
 
-// NewEndorsementClient returns a client for the Endorsement from the given config.
-func NewEndorsementClient(c config) *EndorsementClient {
-	return &EndorsementClient{config: c}
+// NewCategoryClient returns a client for the Category from the given config.
+func NewCategoryClient(c config) *CategoryClient {
+	return &CategoryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `endorsement.Hooks(f(g(h())))`.
-func (c *EndorsementClient) Use(hooks ...Hook) {
-	c.hooks.Endorsement = append(c.hooks.Endorsement, hooks...)
+// A call to `Use(f, g, h)` equals to `category.Hooks(f(g(h())))`.
+func (c *CategoryClient) Use(hooks ...Hook) {
+	c.hooks.Category = append(c.hooks.Category, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `endorsement.Intercept(f(g(h())))`.
-func (c *EndorsementClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Endorsement = append(c.inters.Endorsement, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `category.Intercept(f(g(h())))`.
+func (c *CategoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Category = append(c.inters.Category, interceptors...)
 }
 
-// Create returns a builder for creating a Endorsement entity.
-func (c *EndorsementClient) Create() *EndorsementCreate {
-	mutation := newEndorsementMutation(c.config, OpCreate)
-	return &EndorsementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Category entity.
+func (c *CategoryClient) Create() *CategoryCreate {
+	mutation := newCategoryMutation(c.config, OpCreate)
+	return &CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Endorsement entities.
-func (c *EndorsementClient) CreateBulk(builders ...*EndorsementCreate) *EndorsementCreateBulk {
-	return &EndorsementCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Category entities.
+func (c *CategoryClient) CreateBulk(builders ...*CategoryCreate) *CategoryCreateBulk {
+	return &CategoryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EndorsementClient) MapCreateBulk(slice any, setFunc func(*EndorsementCreate, int)) *EndorsementCreateBulk {
+func (c *CategoryClient) MapCreateBulk(slice any, setFunc func(*CategoryCreate, int)) *CategoryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EndorsementCreateBulk{err: fmt.Errorf("calling to EndorsementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CategoryCreateBulk{err: fmt.Errorf("calling to CategoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EndorsementCreate, rv.Len())
+	builders := make([]*CategoryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EndorsementCreateBulk{config: c.config, builders: builders}
+	return &CategoryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Endorsement.
-func (c *EndorsementClient) Update() *EndorsementUpdate {
-	mutation := newEndorsementMutation(c.config, OpUpdate)
-	return &EndorsementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Category.
+func (c *CategoryClient) Update() *CategoryUpdate {
+	mutation := newCategoryMutation(c.config, OpUpdate)
+	return &CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EndorsementClient) UpdateOne(_m *Endorsement) *EndorsementUpdateOne {
-	mutation := newEndorsementMutation(c.config, OpUpdateOne, withEndorsement(_m))
-	return &EndorsementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategoryClient) UpdateOne(_m *Category) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategory(_m))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EndorsementClient) UpdateOneID(id int) *EndorsementUpdateOne {
-	mutation := newEndorsementMutation(c.config, OpUpdateOne, withEndorsementID(id))
-	return &EndorsementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CategoryClient) UpdateOneID(id int) *CategoryUpdateOne {
+	mutation := newCategoryMutation(c.config, OpUpdateOne, withCategoryID(id))
+	return &CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Endorsement.
-func (c *EndorsementClient) Delete() *EndorsementDelete {
-	mutation := newEndorsementMutation(c.config, OpDelete)
-	return &EndorsementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Category.
+func (c *CategoryClient) Delete() *CategoryDelete {
+	mutation := newCategoryMutation(c.config, OpDelete)
+	return &CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EndorsementClient) DeleteOne(_m *Endorsement) *EndorsementDeleteOne {
+func (c *CategoryClient) DeleteOne(_m *Category) *CategoryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EndorsementClient) DeleteOneID(id int) *EndorsementDeleteOne {
-	builder := c.Delete().Where(endorsement.ID(id))
+func (c *CategoryClient) DeleteOneID(id int) *CategoryDeleteOne {
+	builder := c.Delete().Where(category.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EndorsementDeleteOne{builder}
+	return &CategoryDeleteOne{builder}
 }
 
-// Query returns a query builder for Endorsement.
-func (c *EndorsementClient) Query() *EndorsementQuery {
-	return &EndorsementQuery{
+// Query returns a query builder for Category.
+func (c *CategoryClient) Query() *CategoryQuery {
+	return &CategoryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEndorsement},
+		ctx:    &QueryContext{Type: TypeCategory},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Endorsement entity by its id.
-func (c *EndorsementClient) Get(ctx context.Context, id int) (*Endorsement, error) {
-	return c.Query().Where(endorsement.ID(id)).Only(ctx)
+// Get returns a Category entity by its id.
+func (c *CategoryClient) Get(ctx context.Context, id int) (*Category, error) {
+	return c.Query().Where(category.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EndorsementClient) GetX(ctx context.Context, id int) *Endorsement {
+func (c *CategoryClient) GetX(ctx context.Context, id int) *Category {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2213,31 +2383,15 @@ func (c *EndorsementClient) GetX(ctx context.Context, id int) *Endorsement {
 	return obj
 }
 
-// QueryValidationCase queries the validation_case edge of a Endorsement.
-func (c *EndorsementClient) QueryValidationCase(_m *Endorsement) *ValidationCaseQuery {
+// QueryValidationCases queries the validation_cases edge of a Category.
+func (c *CategoryClient) QueryValidationCases(_m *Category) *ValidationCaseQuery {
 	query := (&ValidationCaseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(endorsement.Table, endorsement.FieldID, id),
+			sqlgraph.From(category.Table, category.FieldID, id),
 			sqlgraph.To(validationcase.Table, validationcase.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, endorsement.ValidationCaseTable, endorsement.ValidationCaseColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryValidatorUser queries the validator_user edge of a Endorsement.
-func (c *EndorsementClient) QueryValidatorUser(_m *Endorsement) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(endorsement.Table, endorsement.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, endorsement.ValidatorUserTable, endorsement.ValidatorUserColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, category.ValidationCasesTable, category.ValidationCasesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2246,131 +2400,131 @@ func (c *EndorsementClient) QueryValidatorUser(_m *Endorsement) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EndorsementClient) Hooks() []Hook {
-	return c.hooks.Endorsement
+func (c *CategoryClient) Hooks() []Hook {
+	return c.hooks.Category
 }
 
 // Interceptors returns the client interceptors.
-func (c *EndorsementClient) Interceptors() []Interceptor {
-	return c.inters.Endorsement
+func (c *CategoryClient) Interceptors() []Interceptor {
+	return c.inters.Category
 }
 
-func (c *EndorsementClient) mutate(ctx context.Context, m *EndorsementMutation) (Value, error) {
+func (c *CategoryClient) mutate(ctx context.Context, m *CategoryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EndorsementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EndorsementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EndorsementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CategoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EndorsementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CategoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Endorsement mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Category mutation op: %q", m.Op())
 	}
 }
 
-// FinalOfferClient is a client for the FinalOffer schema.
-type FinalOfferClient struct {
+// ChainCursorClient is a client for the ChainCursor schema.
+type ChainCursorClient struct {
 	config
 }
 
-// NewFinalOfferClient returns a client for the FinalOffer from the given config.
-func NewFinalOfferClient(c config) *FinalOfferClient {
-	return &FinalOfferClient{config: c}
+// NewChainCursorClient returns a client for the ChainCursor from the given config.
+func NewChainCursorClient(c config) *ChainCursorClient {
+	return &ChainCursorClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `finaloffer.Hooks(f(g(h())))`.
-func (c *FinalOfferClient) Use(hooks ...Hook) {
-	c.hooks.FinalOffer = append(c.hooks.FinalOffer, hooks...)
+// A call to `Use(f, g, h)` equals to `chaincursor.Hooks(f(g(h())))`.
+func (c *ChainCursorClient) Use(hooks ...Hook) {
+	c.hooks.ChainCursor = append(c.hooks.ChainCursor, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `finaloffer.Intercept(f(g(h())))`.
-func (c *FinalOfferClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FinalOffer = append(c.inters.FinalOffer, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `chaincursor.Intercept(f(g(h())))`.
+func (c *ChainCursorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChainCursor = append(c.inters.ChainCursor, interceptors...)
 }
 
-// Create returns a builder for creating a FinalOffer entity.
-func (c *FinalOfferClient) Create() *FinalOfferCreate {
-	mutation := newFinalOfferMutation(c.config, OpCreate)
-	return &FinalOfferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ChainCursor entity.
+func (c *ChainCursorClient) Create() *ChainCursorCreate {
+	mutation := newChainCursorMutation(c.config, OpCreate)
+	return &ChainCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FinalOffer entities.
-func (c *FinalOfferClient) CreateBulk(builders ...*FinalOfferCreate) *FinalOfferCreateBulk {
-	return &FinalOfferCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ChainCursor entities.
+func (c *ChainCursorClient) CreateBulk(builders ...*ChainCursorCreate) *ChainCursorCreateBulk {
+	return &ChainCursorCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FinalOfferClient) MapCreateBulk(slice any, setFunc func(*FinalOfferCreate, int)) *FinalOfferCreateBulk {
+func (c *ChainCursorClient) MapCreateBulk(slice any, setFunc func(*ChainCursorCreate, int)) *ChainCursorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FinalOfferCreateBulk{err: fmt.Errorf("calling to FinalOfferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChainCursorCreateBulk{err: fmt.Errorf("calling to ChainCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FinalOfferCreate, rv.Len())
+	builders := make([]*ChainCursorCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FinalOfferCreateBulk{config: c.config, builders: builders}
+	return &ChainCursorCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FinalOffer.
-func (c *FinalOfferClient) Update() *FinalOfferUpdate {
-	mutation := newFinalOfferMutation(c.config, OpUpdate)
-	return &FinalOfferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ChainCursor.
+func (c *ChainCursorClient) Update() *ChainCursorUpdate {
+	mutation := newChainCursorMutation(c.config, OpUpdate)
+	return &ChainCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FinalOfferClient) UpdateOne(_m *FinalOffer) *FinalOfferUpdateOne {
-	mutation := newFinalOfferMutation(c.config, OpUpdateOne, withFinalOffer(_m))
-	return &FinalOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChainCursorClient) UpdateOne(_m *ChainCursor) *ChainCursorUpdateOne {
+	mutation := newChainCursorMutation(c.config, OpUpdateOne, withChainCursor(_m))
+	return &ChainCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FinalOfferClient) UpdateOneID(id int) *FinalOfferUpdateOne {
-	mutation := newFinalOfferMutation(c.config, OpUpdateOne, withFinalOfferID(id))
-	return &FinalOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChainCursorClient) UpdateOneID(id int) *ChainCursorUpdateOne {
+	mutation := newChainCursorMutation(c.config, OpUpdateOne, withChainCursorID(id))
+	return &ChainCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FinalOffer.
-func (c *FinalOfferClient) Delete() *FinalOfferDelete {
-	mutation := newFinalOfferMutation(c.config, OpDelete)
-	return &FinalOfferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ChainCursor.
+func (c *ChainCursorClient) Delete() *ChainCursorDelete {
+	mutation := newChainCursorMutation(c.config, OpDelete)
+	return &ChainCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FinalOfferClient) DeleteOne(_m *FinalOffer) *FinalOfferDeleteOne {
+func (c *ChainCursorClient) DeleteOne(_m *ChainCursor) *ChainCursorDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FinalOfferClient) DeleteOneID(id int) *FinalOfferDeleteOne {
-	builder := c.Delete().Where(finaloffer.ID(id))
+func (c *ChainCursorClient) DeleteOneID(id int) *ChainCursorDeleteOne {
+	builder := c.Delete().Where(chaincursor.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FinalOfferDeleteOne{builder}
+	return &ChainCursorDeleteOne{builder}
 }
 
-// Query returns a query builder for FinalOffer.
-func (c *FinalOfferClient) Query() *FinalOfferQuery {
-	return &FinalOfferQuery{
+// Query returns a query builder for ChainCursor.
+func (c *ChainCursorClient) Query() *ChainCursorQuery {
+	return &ChainCursorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFinalOffer},
+		ctx:    &QueryContext{Type: TypeChainCursor},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FinalOffer entity by its id.
-func (c *FinalOfferClient) Get(ctx context.Context, id int) (*FinalOffer, error) {
-	return c.Query().Where(finaloffer.ID(id)).Only(ctx)
+// Get returns a ChainCursor entity by its id.
+func (c *ChainCursorClient) Get(ctx context.Context, id int) (*ChainCursor, error) {
+	return c.Query().Where(chaincursor.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FinalOfferClient) GetX(ctx context.Context, id int) *FinalOffer {
+func (c *ChainCursorClient) GetX(ctx context.Context, id int) *ChainCursor {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2378,164 +2532,2085 @@ func (c *FinalOfferClient) GetX(ctx context.Context, id int) *FinalOffer {
 	return obj
 }
 
-// QueryValidationCase queries the validation_case edge of a FinalOffer.
-func (c *FinalOfferClient) QueryValidationCase(_m *FinalOffer) *ValidationCaseQuery {
-	query := (&ValidationCaseClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, id),
-			sqlgraph.To(validationcase.Table, validationcase.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, finaloffer.ValidationCaseTable, finaloffer.ValidationCaseColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ChainCursorClient) Hooks() []Hook {
+	return c.hooks.ChainCursor
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChainCursorClient) Interceptors() []Interceptor {
+	return c.inters.ChainCursor
+}
+
+func (c *ChainCursorClient) mutate(ctx context.Context, m *ChainCursorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChainCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChainCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChainCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChainCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChainCursor mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryValidatorUser queries the validator_user edge of a FinalOffer.
-func (c *FinalOfferClient) QueryValidatorUser(_m *FinalOffer) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, finaloffer.ValidatorUserTable, finaloffer.ValidatorUserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// ConsultationInvitationClient is a client for the ConsultationInvitation schema.
+type ConsultationInvitationClient struct {
+	config
+}
+
+// NewConsultationInvitationClient returns a client for the ConsultationInvitation from the given config.
+func NewConsultationInvitationClient(c config) *ConsultationInvitationClient {
+	return &ConsultationInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `consultationinvitation.Hooks(f(g(h())))`.
+func (c *ConsultationInvitationClient) Use(hooks ...Hook) {
+	c.hooks.ConsultationInvitation = append(c.hooks.ConsultationInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `consultationinvitation.Intercept(f(g(h())))`.
+func (c *ConsultationInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConsultationInvitation = append(c.inters.ConsultationInvitation, interceptors...)
+}
+
+// Create returns a builder for creating a ConsultationInvitation entity.
+func (c *ConsultationInvitationClient) Create() *ConsultationInvitationCreate {
+	mutation := newConsultationInvitationMutation(c.config, OpCreate)
+	return &ConsultationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConsultationInvitation entities.
+func (c *ConsultationInvitationClient) CreateBulk(builders ...*ConsultationInvitationCreate) *ConsultationInvitationCreateBulk {
+	return &ConsultationInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConsultationInvitationClient) MapCreateBulk(slice any, setFunc func(*ConsultationInvitationCreate, int)) *ConsultationInvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConsultationInvitationCreateBulk{err: fmt.Errorf("calling to ConsultationInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConsultationInvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConsultationInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConsultationInvitation.
+func (c *ConsultationInvitationClient) Update() *ConsultationInvitationUpdate {
+	mutation := newConsultationInvitationMutation(c.config, OpUpdate)
+	return &ConsultationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsultationInvitationClient) UpdateOne(_m *ConsultationInvitation) *ConsultationInvitationUpdateOne {
+	mutation := newConsultationInvitationMutation(c.config, OpUpdateOne, withConsultationInvitation(_m))
+	return &ConsultationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsultationInvitationClient) UpdateOneID(id int) *ConsultationInvitationUpdateOne {
+	mutation := newConsultationInvitationMutation(c.config, OpUpdateOne, withConsultationInvitationID(id))
+	return &ConsultationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConsultationInvitation.
+func (c *ConsultationInvitationClient) Delete() *ConsultationInvitationDelete {
+	mutation := newConsultationInvitationMutation(c.config, OpDelete)
+	return &ConsultationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConsultationInvitationClient) DeleteOne(_m *ConsultationInvitation) *ConsultationInvitationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConsultationInvitationClient) DeleteOneID(id int) *ConsultationInvitationDeleteOne {
+	builder := c.Delete().Where(consultationinvitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsultationInvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for ConsultationInvitation.
+func (c *ConsultationInvitationClient) Query() *ConsultationInvitationQuery {
+	return &ConsultationInvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConsultationInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConsultationInvitation entity by its id.
+func (c *ConsultationInvitationClient) Get(ctx context.Context, id int) (*ConsultationInvitation, error) {
+	return c.Query().Where(consultationinvitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsultationInvitationClient) GetX(ctx context.Context, id int) *ConsultationInvitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConsultationInvitationClient) Hooks() []Hook {
+	return c.hooks.ConsultationInvitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConsultationInvitationClient) Interceptors() []Interceptor {
+	return c.inters.ConsultationInvitation
+}
+
+func (c *ConsultationInvitationClient) mutate(ctx context.Context, m *ConsultationInvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConsultationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConsultationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConsultationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConsultationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConsultationInvitation mutation op: %q", m.Op())
+	}
+}
+
+// ConsultationRequestClient is a client for the ConsultationRequest schema.
+type ConsultationRequestClient struct {
+	config
+}
+
+// NewConsultationRequestClient returns a client for the ConsultationRequest from the given config.
+func NewConsultationRequestClient(c config) *ConsultationRequestClient {
+	return &ConsultationRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `consultationrequest.Hooks(f(g(h())))`.
+func (c *ConsultationRequestClient) Use(hooks ...Hook) {
+	c.hooks.ConsultationRequest = append(c.hooks.ConsultationRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `consultationrequest.Intercept(f(g(h())))`.
+func (c *ConsultationRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConsultationRequest = append(c.inters.ConsultationRequest, interceptors...)
+}
+
+// Create returns a builder for creating a ConsultationRequest entity.
+func (c *ConsultationRequestClient) Create() *ConsultationRequestCreate {
+	mutation := newConsultationRequestMutation(c.config, OpCreate)
+	return &ConsultationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConsultationRequest entities.
+func (c *ConsultationRequestClient) CreateBulk(builders ...*ConsultationRequestCreate) *ConsultationRequestCreateBulk {
+	return &ConsultationRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConsultationRequestClient) MapCreateBulk(slice any, setFunc func(*ConsultationRequestCreate, int)) *ConsultationRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConsultationRequestCreateBulk{err: fmt.Errorf("calling to ConsultationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConsultationRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConsultationRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConsultationRequest.
+func (c *ConsultationRequestClient) Update() *ConsultationRequestUpdate {
+	mutation := newConsultationRequestMutation(c.config, OpUpdate)
+	return &ConsultationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsultationRequestClient) UpdateOne(_m *ConsultationRequest) *ConsultationRequestUpdateOne {
+	mutation := newConsultationRequestMutation(c.config, OpUpdateOne, withConsultationRequest(_m))
+	return &ConsultationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsultationRequestClient) UpdateOneID(id int) *ConsultationRequestUpdateOne {
+	mutation := newConsultationRequestMutation(c.config, OpUpdateOne, withConsultationRequestID(id))
+	return &ConsultationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConsultationRequest.
+func (c *ConsultationRequestClient) Delete() *ConsultationRequestDelete {
+	mutation := newConsultationRequestMutation(c.config, OpDelete)
+	return &ConsultationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConsultationRequestClient) DeleteOne(_m *ConsultationRequest) *ConsultationRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConsultationRequestClient) DeleteOneID(id int) *ConsultationRequestDeleteOne {
+	builder := c.Delete().Where(consultationrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsultationRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for ConsultationRequest.
+func (c *ConsultationRequestClient) Query() *ConsultationRequestQuery {
+	return &ConsultationRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConsultationRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConsultationRequest entity by its id.
+func (c *ConsultationRequestClient) Get(ctx context.Context, id int) (*ConsultationRequest, error) {
+	return c.Query().Where(consultationrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsultationRequestClient) GetX(ctx context.Context, id int) *ConsultationRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryValidationCase queries the validation_case edge of a ConsultationRequest.
+func (c *ConsultationRequestClient) QueryValidationCase(_m *ConsultationRequest) *ValidationCaseQuery {
+	query := (&ValidationCaseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consultationrequest.Table, consultationrequest.FieldID, id),
+			sqlgraph.To(validationcase.Table, validationcase.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consultationrequest.ValidationCaseTable, consultationrequest.ValidationCaseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryValidatorUser queries the validator_user edge of a ConsultationRequest.
+func (c *ConsultationRequestClient) QueryValidatorUser(_m *ConsultationRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consultationrequest.Table, consultationrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consultationrequest.ValidatorUserTable, consultationrequest.ValidatorUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConsultationRequestClient) Hooks() []Hook {
+	return c.hooks.ConsultationRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConsultationRequestClient) Interceptors() []Interceptor {
+	return c.inters.ConsultationRequest
+}
+
+func (c *ConsultationRequestClient) mutate(ctx context.Context, m *ConsultationRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConsultationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConsultationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConsultationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConsultationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConsultationRequest mutation op: %q", m.Op())
+	}
+}
+
+// CredentialClient is a client for the Credential schema.
+type CredentialClient struct {
+	config
+}
+
+// NewCredentialClient returns a client for the Credential from the given config.
+func NewCredentialClient(c config) *CredentialClient {
+	return &CredentialClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `credential.Hooks(f(g(h())))`.
+func (c *CredentialClient) Use(hooks ...Hook) {
+	c.hooks.Credential = append(c.hooks.Credential, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `credential.Intercept(f(g(h())))`.
+func (c *CredentialClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Credential = append(c.inters.Credential, interceptors...)
+}
+
+// Create returns a builder for creating a Credential entity.
+func (c *CredentialClient) Create() *CredentialCreate {
+	mutation := newCredentialMutation(c.config, OpCreate)
+	return &CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Credential entities.
+func (c *CredentialClient) CreateBulk(builders ...*CredentialCreate) *CredentialCreateBulk {
+	return &CredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CredentialClient) MapCreateBulk(slice any, setFunc func(*CredentialCreate, int)) *CredentialCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CredentialCreateBulk{err: fmt.Errorf("calling to CredentialClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CredentialCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CredentialCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Credential.
+func (c *CredentialClient) Update() *CredentialUpdate {
+	mutation := newCredentialMutation(c.config, OpUpdate)
+	return &CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CredentialClient) UpdateOne(_m *Credential) *CredentialUpdateOne {
+	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredential(_m))
+	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CredentialClient) UpdateOneID(id int) *CredentialUpdateOne {
+	mutation := newCredentialMutation(c.config, OpUpdateOne, withCredentialID(id))
+	return &CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Credential.
+func (c *CredentialClient) Delete() *CredentialDelete {
+	mutation := newCredentialMutation(c.config, OpDelete)
+	return &CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CredentialClient) DeleteOne(_m *Credential) *CredentialDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CredentialClient) DeleteOneID(id int) *CredentialDeleteOne {
+	builder := c.Delete().Where(credential.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CredentialDeleteOne{builder}
+}
+
+// Query returns a query builder for Credential.
+func (c *CredentialClient) Query() *CredentialQuery {
+	return &CredentialQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCredential},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Credential entity by its id.
+func (c *CredentialClient) Get(ctx context.Context, id int) (*Credential, error) {
+	return c.Query().Where(credential.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CredentialClient) GetX(ctx context.Context, id int) *Credential {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Credential.
+func (c *CredentialClient) QueryUser(_m *Credential) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, credential.UserTable, credential.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CredentialClient) Hooks() []Hook {
+	return c.hooks.Credential
+}
+
+// Interceptors returns the client interceptors.
+func (c *CredentialClient) Interceptors() []Interceptor {
+	return c.inters.Credential
+}
+
+func (c *CredentialClient) mutate(ctx context.Context, m *CredentialMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CredentialCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CredentialUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CredentialUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CredentialDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Credential mutation op: %q", m.Op())
+	}
+}
+
+// DeviceFingerprintClient is a client for the DeviceFingerprint schema.
+type DeviceFingerprintClient struct {
+	config
+}
+
+// NewDeviceFingerprintClient returns a client for the DeviceFingerprint from the given config.
+func NewDeviceFingerprintClient(c config) *DeviceFingerprintClient {
+	return &DeviceFingerprintClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `devicefingerprint.Hooks(f(g(h())))`.
+func (c *DeviceFingerprintClient) Use(hooks ...Hook) {
+	c.hooks.DeviceFingerprint = append(c.hooks.DeviceFingerprint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `devicefingerprint.Intercept(f(g(h())))`.
+func (c *DeviceFingerprintClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceFingerprint = append(c.inters.DeviceFingerprint, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceFingerprint entity.
+func (c *DeviceFingerprintClient) Create() *DeviceFingerprintCreate {
+	mutation := newDeviceFingerprintMutation(c.config, OpCreate)
+	return &DeviceFingerprintCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceFingerprint entities.
+func (c *DeviceFingerprintClient) CreateBulk(builders ...*DeviceFingerprintCreate) *DeviceFingerprintCreateBulk {
+	return &DeviceFingerprintCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceFingerprintClient) MapCreateBulk(slice any, setFunc func(*DeviceFingerprintCreate, int)) *DeviceFingerprintCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceFingerprintCreateBulk{err: fmt.Errorf("calling to DeviceFingerprintClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceFingerprintCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceFingerprintCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceFingerprint.
+func (c *DeviceFingerprintClient) Update() *DeviceFingerprintUpdate {
+	mutation := newDeviceFingerprintMutation(c.config, OpUpdate)
+	return &DeviceFingerprintUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceFingerprintClient) UpdateOne(_m *DeviceFingerprint) *DeviceFingerprintUpdateOne {
+	mutation := newDeviceFingerprintMutation(c.config, OpUpdateOne, withDeviceFingerprint(_m))
+	return &DeviceFingerprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceFingerprintClient) UpdateOneID(id int) *DeviceFingerprintUpdateOne {
+	mutation := newDeviceFingerprintMutation(c.config, OpUpdateOne, withDeviceFingerprintID(id))
+	return &DeviceFingerprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceFingerprint.
+func (c *DeviceFingerprintClient) Delete() *DeviceFingerprintDelete {
+	mutation := newDeviceFingerprintMutation(c.config, OpDelete)
+	return &DeviceFingerprintDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceFingerprintClient) DeleteOne(_m *DeviceFingerprint) *DeviceFingerprintDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceFingerprintClient) DeleteOneID(id int) *DeviceFingerprintDeleteOne {
+	builder := c.Delete().Where(devicefingerprint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceFingerprintDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceFingerprint.
+func (c *DeviceFingerprintClient) Query() *DeviceFingerprintQuery {
+	return &DeviceFingerprintQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceFingerprint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceFingerprint entity by its id.
+func (c *DeviceFingerprintClient) Get(ctx context.Context, id int) (*DeviceFingerprint, error) {
+	return c.Query().Where(devicefingerprint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceFingerprintClient) GetX(ctx context.Context, id int) *DeviceFingerprint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DeviceFingerprint.
+func (c *DeviceFingerprintClient) QueryUser(_m *DeviceFingerprint) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(devicefingerprint.Table, devicefingerprint.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, devicefingerprint.UserTable, devicefingerprint.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceFingerprintClient) Hooks() []Hook {
+	return c.hooks.DeviceFingerprint
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceFingerprintClient) Interceptors() []Interceptor {
+	return c.inters.DeviceFingerprint
+}
+
+func (c *DeviceFingerprintClient) mutate(ctx context.Context, m *DeviceFingerprintMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceFingerprintCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceFingerprintUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceFingerprintUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceFingerprintDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceFingerprint mutation op: %q", m.Op())
+	}
+}
+
+// DeviceUserMappingClient is a client for the DeviceUserMapping schema.
+type DeviceUserMappingClient struct {
+	config
+}
+
+// NewDeviceUserMappingClient returns a client for the DeviceUserMapping from the given config.
+func NewDeviceUserMappingClient(c config) *DeviceUserMappingClient {
+	return &DeviceUserMappingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deviceusermapping.Hooks(f(g(h())))`.
+func (c *DeviceUserMappingClient) Use(hooks ...Hook) {
+	c.hooks.DeviceUserMapping = append(c.hooks.DeviceUserMapping, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deviceusermapping.Intercept(f(g(h())))`.
+func (c *DeviceUserMappingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceUserMapping = append(c.inters.DeviceUserMapping, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceUserMapping entity.
+func (c *DeviceUserMappingClient) Create() *DeviceUserMappingCreate {
+	mutation := newDeviceUserMappingMutation(c.config, OpCreate)
+	return &DeviceUserMappingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceUserMapping entities.
+func (c *DeviceUserMappingClient) CreateBulk(builders ...*DeviceUserMappingCreate) *DeviceUserMappingCreateBulk {
+	return &DeviceUserMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceUserMappingClient) MapCreateBulk(slice any, setFunc func(*DeviceUserMappingCreate, int)) *DeviceUserMappingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceUserMappingCreateBulk{err: fmt.Errorf("calling to DeviceUserMappingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceUserMappingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceUserMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceUserMapping.
+func (c *DeviceUserMappingClient) Update() *DeviceUserMappingUpdate {
+	mutation := newDeviceUserMappingMutation(c.config, OpUpdate)
+	return &DeviceUserMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceUserMappingClient) UpdateOne(_m *DeviceUserMapping) *DeviceUserMappingUpdateOne {
+	mutation := newDeviceUserMappingMutation(c.config, OpUpdateOne, withDeviceUserMapping(_m))
+	return &DeviceUserMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceUserMappingClient) UpdateOneID(id int) *DeviceUserMappingUpdateOne {
+	mutation := newDeviceUserMappingMutation(c.config, OpUpdateOne, withDeviceUserMappingID(id))
+	return &DeviceUserMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceUserMapping.
+func (c *DeviceUserMappingClient) Delete() *DeviceUserMappingDelete {
+	mutation := newDeviceUserMappingMutation(c.config, OpDelete)
+	return &DeviceUserMappingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceUserMappingClient) DeleteOne(_m *DeviceUserMapping) *DeviceUserMappingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceUserMappingClient) DeleteOneID(id int) *DeviceUserMappingDeleteOne {
+	builder := c.Delete().Where(deviceusermapping.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceUserMappingDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceUserMapping.
+func (c *DeviceUserMappingClient) Query() *DeviceUserMappingQuery {
+	return &DeviceUserMappingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceUserMapping},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceUserMapping entity by its id.
+func (c *DeviceUserMappingClient) Get(ctx context.Context, id int) (*DeviceUserMapping, error) {
+	return c.Query().Where(deviceusermapping.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceUserMappingClient) GetX(ctx context.Context, id int) *DeviceUserMapping {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DeviceUserMapping.
+func (c *DeviceUserMappingClient) QueryUser(_m *DeviceUserMapping) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deviceusermapping.Table, deviceusermapping.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deviceusermapping.UserTable, deviceusermapping.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceUserMappingClient) Hooks() []Hook {
+	return c.hooks.DeviceUserMapping
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceUserMappingClient) Interceptors() []Interceptor {
+	return c.inters.DeviceUserMapping
+}
+
+func (c *DeviceUserMappingClient) mutate(ctx context.Context, m *DeviceUserMappingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceUserMappingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceUserMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceUserMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceUserMappingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceUserMapping mutation op: %q", m.Op())
+	}
+}
+
+// EmailVerificationTokenClient is a client for the EmailVerificationToken schema.
+type EmailVerificationTokenClient struct {
+	config
+}
+
+// NewEmailVerificationTokenClient returns a client for the EmailVerificationToken from the given config.
+func NewEmailVerificationTokenClient(c config) *EmailVerificationTokenClient {
+	return &EmailVerificationTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailverificationtoken.Hooks(f(g(h())))`.
+func (c *EmailVerificationTokenClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerificationToken = append(c.hooks.EmailVerificationToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailverificationtoken.Intercept(f(g(h())))`.
+func (c *EmailVerificationTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailVerificationToken = append(c.inters.EmailVerificationToken, interceptors...)
+}
+
+// Create returns a builder for creating a EmailVerificationToken entity.
+func (c *EmailVerificationTokenClient) Create() *EmailVerificationTokenCreate {
+	mutation := newEmailVerificationTokenMutation(c.config, OpCreate)
+	return &EmailVerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailVerificationToken entities.
+func (c *EmailVerificationTokenClient) CreateBulk(builders ...*EmailVerificationTokenCreate) *EmailVerificationTokenCreateBulk {
+	return &EmailVerificationTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailVerificationTokenClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationTokenCreate, int)) *EmailVerificationTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailVerificationTokenCreateBulk{err: fmt.Errorf("calling to EmailVerificationTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailVerificationTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailVerificationTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailVerificationToken.
+func (c *EmailVerificationTokenClient) Update() *EmailVerificationTokenUpdate {
+	mutation := newEmailVerificationTokenMutation(c.config, OpUpdate)
+	return &EmailVerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailVerificationTokenClient) UpdateOne(_m *EmailVerificationToken) *EmailVerificationTokenUpdateOne {
+	mutation := newEmailVerificationTokenMutation(c.config, OpUpdateOne, withEmailVerificationToken(_m))
+	return &EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailVerificationTokenClient) UpdateOneID(id int) *EmailVerificationTokenUpdateOne {
+	mutation := newEmailVerificationTokenMutation(c.config, OpUpdateOne, withEmailVerificationTokenID(id))
+	return &EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailVerificationToken.
+func (c *EmailVerificationTokenClient) Delete() *EmailVerificationTokenDelete {
+	mutation := newEmailVerificationTokenMutation(c.config, OpDelete)
+	return &EmailVerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailVerificationTokenClient) DeleteOne(_m *EmailVerificationToken) *EmailVerificationTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailVerificationTokenClient) DeleteOneID(id int) *EmailVerificationTokenDeleteOne {
+	builder := c.Delete().Where(emailverificationtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailVerificationTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailVerificationToken.
+func (c *EmailVerificationTokenClient) Query() *EmailVerificationTokenQuery {
+	return &EmailVerificationTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailVerificationToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailVerificationToken entity by its id.
+func (c *EmailVerificationTokenClient) Get(ctx context.Context, id int) (*EmailVerificationToken, error) {
+	return c.Query().Where(emailverificationtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailVerificationTokenClient) GetX(ctx context.Context, id int) *EmailVerificationToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a EmailVerificationToken.
+func (c *EmailVerificationTokenClient) QueryUser(_m *EmailVerificationToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailverificationtoken.Table, emailverificationtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailverificationtoken.UserTable, emailverificationtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EmailVerificationTokenClient) Hooks() []Hook {
+	return c.hooks.EmailVerificationToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailVerificationTokenClient) Interceptors() []Interceptor {
+	return c.inters.EmailVerificationToken
+}
+
+func (c *EmailVerificationTokenClient) mutate(ctx context.Context, m *EmailVerificationTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailVerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailVerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailVerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailVerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailVerificationToken mutation op: %q", m.Op())
+	}
+}
+
+// EndorsementClient is a client for the Endorsement schema.
+type EndorsementClient struct {
+	config
+}
+
+// NewEndorsementClient returns a client for the Endorsement from the given config.
+func NewEndorsementClient(c config) *EndorsementClient {
+	return &EndorsementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `endorsement.Hooks(f(g(h())))`.
+func (c *EndorsementClient) Use(hooks ...Hook) {
+	c.hooks.Endorsement = append(c.hooks.Endorsement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `endorsement.Intercept(f(g(h())))`.
+func (c *EndorsementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Endorsement = append(c.inters.Endorsement, interceptors...)
+}
+
+// Create returns a builder for creating a Endorsement entity.
+func (c *EndorsementClient) Create() *EndorsementCreate {
+	mutation := newEndorsementMutation(c.config, OpCreate)
+	return &EndorsementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Endorsement entities.
+func (c *EndorsementClient) CreateBulk(builders ...*EndorsementCreate) *EndorsementCreateBulk {
+	return &EndorsementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EndorsementClient) MapCreateBulk(slice any, setFunc func(*EndorsementCreate, int)) *EndorsementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EndorsementCreateBulk{err: fmt.Errorf("calling to EndorsementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EndorsementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EndorsementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Endorsement.
+func (c *EndorsementClient) Update() *EndorsementUpdate {
+	mutation := newEndorsementMutation(c.config, OpUpdate)
+	return &EndorsementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EndorsementClient) UpdateOne(_m *Endorsement) *EndorsementUpdateOne {
+	mutation := newEndorsementMutation(c.config, OpUpdateOne, withEndorsement(_m))
+	return &EndorsementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EndorsementClient) UpdateOneID(id int) *EndorsementUpdateOne {
+	mutation := newEndorsementMutation(c.config, OpUpdateOne, withEndorsementID(id))
+	return &EndorsementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Endorsement.
+func (c *EndorsementClient) Delete() *EndorsementDelete {
+	mutation := newEndorsementMutation(c.config, OpDelete)
+	return &EndorsementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EndorsementClient) DeleteOne(_m *Endorsement) *EndorsementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EndorsementClient) DeleteOneID(id int) *EndorsementDeleteOne {
+	builder := c.Delete().Where(endorsement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EndorsementDeleteOne{builder}
+}
+
+// Query returns a query builder for Endorsement.
+func (c *EndorsementClient) Query() *EndorsementQuery {
+	return &EndorsementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEndorsement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Endorsement entity by its id.
+func (c *EndorsementClient) Get(ctx context.Context, id int) (*Endorsement, error) {
+	return c.Query().Where(endorsement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EndorsementClient) GetX(ctx context.Context, id int) *Endorsement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryValidationCase queries the validation_case edge of a Endorsement.
+func (c *EndorsementClient) QueryValidationCase(_m *Endorsement) *ValidationCaseQuery {
+	query := (&ValidationCaseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(endorsement.Table, endorsement.FieldID, id),
+			sqlgraph.To(validationcase.Table, validationcase.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, endorsement.ValidationCaseTable, endorsement.ValidationCaseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryValidatorUser queries the validator_user edge of a Endorsement.
+func (c *EndorsementClient) QueryValidatorUser(_m *Endorsement) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(endorsement.Table, endorsement.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, endorsement.ValidatorUserTable, endorsement.ValidatorUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EndorsementClient) Hooks() []Hook {
+	return c.hooks.Endorsement
+}
+
+// Interceptors returns the client interceptors.
+func (c *EndorsementClient) Interceptors() []Interceptor {
+	return c.inters.Endorsement
+}
+
+func (c *EndorsementClient) mutate(ctx context.Context, m *EndorsementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EndorsementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EndorsementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EndorsementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EndorsementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Endorsement mutation op: %q", m.Op())
+	}
+}
+
+// FinalOfferClient is a client for the FinalOffer schema.
+type FinalOfferClient struct {
+	config
+}
+
+// NewFinalOfferClient returns a client for the FinalOffer from the given config.
+func NewFinalOfferClient(c config) *FinalOfferClient {
+	return &FinalOfferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `finaloffer.Hooks(f(g(h())))`.
+func (c *FinalOfferClient) Use(hooks ...Hook) {
+	c.hooks.FinalOffer = append(c.hooks.FinalOffer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `finaloffer.Intercept(f(g(h())))`.
+func (c *FinalOfferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FinalOffer = append(c.inters.FinalOffer, interceptors...)
+}
+
+// Create returns a builder for creating a FinalOffer entity.
+func (c *FinalOfferClient) Create() *FinalOfferCreate {
+	mutation := newFinalOfferMutation(c.config, OpCreate)
+	return &FinalOfferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FinalOffer entities.
+func (c *FinalOfferClient) CreateBulk(builders ...*FinalOfferCreate) *FinalOfferCreateBulk {
+	return &FinalOfferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FinalOfferClient) MapCreateBulk(slice any, setFunc func(*FinalOfferCreate, int)) *FinalOfferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FinalOfferCreateBulk{err: fmt.Errorf("calling to FinalOfferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FinalOfferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FinalOfferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FinalOffer.
+func (c *FinalOfferClient) Update() *FinalOfferUpdate {
+	mutation := newFinalOfferMutation(c.config, OpUpdate)
+	return &FinalOfferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FinalOfferClient) UpdateOne(_m *FinalOffer) *FinalOfferUpdateOne {
+	mutation := newFinalOfferMutation(c.config, OpUpdateOne, withFinalOffer(_m))
+	return &FinalOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FinalOfferClient) UpdateOneID(id int) *FinalOfferUpdateOne {
+	mutation := newFinalOfferMutation(c.config, OpUpdateOne, withFinalOfferID(id))
+	return &FinalOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FinalOffer.
+func (c *FinalOfferClient) Delete() *FinalOfferDelete {
+	mutation := newFinalOfferMutation(c.config, OpDelete)
+	return &FinalOfferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FinalOfferClient) DeleteOne(_m *FinalOffer) *FinalOfferDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FinalOfferClient) DeleteOneID(id int) *FinalOfferDeleteOne {
+	builder := c.Delete().Where(finaloffer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FinalOfferDeleteOne{builder}
+}
+
+// Query returns a query builder for FinalOffer.
+func (c *FinalOfferClient) Query() *FinalOfferQuery {
+	return &FinalOfferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFinalOffer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FinalOffer entity by its id.
+func (c *FinalOfferClient) Get(ctx context.Context, id int) (*FinalOffer, error) {
+	return c.Query().Where(finaloffer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FinalOfferClient) GetX(ctx context.Context, id int) *FinalOffer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryValidationCase queries the validation_case edge of a FinalOffer.
+func (c *FinalOfferClient) QueryValidationCase(_m *FinalOffer) *ValidationCaseQuery {
+	query := (&ValidationCaseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, id),
+			sqlgraph.To(validationcase.Table, validationcase.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, finaloffer.ValidationCaseTable, finaloffer.ValidationCaseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryValidatorUser queries the validator_user edge of a FinalOffer.
+func (c *FinalOfferClient) QueryValidatorUser(_m *FinalOffer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, finaloffer.ValidatorUserTable, finaloffer.ValidatorUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a FinalOffer.
+func (c *FinalOfferClient) QueryRevisions(_m *FinalOffer) *FinalOfferRevisionQuery {
+	query := (&FinalOfferRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, id),
+			sqlgraph.To(finalofferrevision.Table, finalofferrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, finaloffer.RevisionsTable, finaloffer.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMilestones queries the milestones edge of a FinalOffer.
+func (c *FinalOfferClient) QueryMilestones(_m *FinalOffer) *FinalOfferMilestoneQuery {
+	query := (&FinalOfferMilestoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, id),
+			sqlgraph.To(finaloffermilestone.Table, finaloffermilestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, finaloffer.MilestonesTable, finaloffer.MilestonesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FinalOfferClient) Hooks() []Hook {
+	return c.hooks.FinalOffer
+}
+
+// Interceptors returns the client interceptors.
+func (c *FinalOfferClient) Interceptors() []Interceptor {
+	return c.inters.FinalOffer
+}
+
+func (c *FinalOfferClient) mutate(ctx context.Context, m *FinalOfferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FinalOfferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FinalOfferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FinalOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FinalOfferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FinalOffer mutation op: %q", m.Op())
+	}
+}
+
+// FinalOfferMilestoneClient is a client for the FinalOfferMilestone schema.
+type FinalOfferMilestoneClient struct {
+	config
+}
+
+// NewFinalOfferMilestoneClient returns a client for the FinalOfferMilestone from the given config.
+func NewFinalOfferMilestoneClient(c config) *FinalOfferMilestoneClient {
+	return &FinalOfferMilestoneClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `finaloffermilestone.Hooks(f(g(h())))`.
+func (c *FinalOfferMilestoneClient) Use(hooks ...Hook) {
+	c.hooks.FinalOfferMilestone = append(c.hooks.FinalOfferMilestone, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `finaloffermilestone.Intercept(f(g(h())))`.
+func (c *FinalOfferMilestoneClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FinalOfferMilestone = append(c.inters.FinalOfferMilestone, interceptors...)
+}
+
+// Create returns a builder for creating a FinalOfferMilestone entity.
+func (c *FinalOfferMilestoneClient) Create() *FinalOfferMilestoneCreate {
+	mutation := newFinalOfferMilestoneMutation(c.config, OpCreate)
+	return &FinalOfferMilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FinalOfferMilestone entities.
+func (c *FinalOfferMilestoneClient) CreateBulk(builders ...*FinalOfferMilestoneCreate) *FinalOfferMilestoneCreateBulk {
+	return &FinalOfferMilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FinalOfferMilestoneClient) MapCreateBulk(slice any, setFunc func(*FinalOfferMilestoneCreate, int)) *FinalOfferMilestoneCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FinalOfferMilestoneCreateBulk{err: fmt.Errorf("calling to FinalOfferMilestoneClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FinalOfferMilestoneCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FinalOfferMilestoneCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FinalOfferMilestone.
+func (c *FinalOfferMilestoneClient) Update() *FinalOfferMilestoneUpdate {
+	mutation := newFinalOfferMilestoneMutation(c.config, OpUpdate)
+	return &FinalOfferMilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FinalOfferMilestoneClient) UpdateOne(_m *FinalOfferMilestone) *FinalOfferMilestoneUpdateOne {
+	mutation := newFinalOfferMilestoneMutation(c.config, OpUpdateOne, withFinalOfferMilestone(_m))
+	return &FinalOfferMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FinalOfferMilestoneClient) UpdateOneID(id int) *FinalOfferMilestoneUpdateOne {
+	mutation := newFinalOfferMilestoneMutation(c.config, OpUpdateOne, withFinalOfferMilestoneID(id))
+	return &FinalOfferMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FinalOfferMilestone.
+func (c *FinalOfferMilestoneClient) Delete() *FinalOfferMilestoneDelete {
+	mutation := newFinalOfferMilestoneMutation(c.config, OpDelete)
+	return &FinalOfferMilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FinalOfferMilestoneClient) DeleteOne(_m *FinalOfferMilestone) *FinalOfferMilestoneDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FinalOfferMilestoneClient) DeleteOneID(id int) *FinalOfferMilestoneDeleteOne {
+	builder := c.Delete().Where(finaloffermilestone.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FinalOfferMilestoneDeleteOne{builder}
+}
+
+// Query returns a query builder for FinalOfferMilestone.
+func (c *FinalOfferMilestoneClient) Query() *FinalOfferMilestoneQuery {
+	return &FinalOfferMilestoneQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFinalOfferMilestone},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FinalOfferMilestone entity by its id.
+func (c *FinalOfferMilestoneClient) Get(ctx context.Context, id int) (*FinalOfferMilestone, error) {
+	return c.Query().Where(finaloffermilestone.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FinalOfferMilestoneClient) GetX(ctx context.Context, id int) *FinalOfferMilestone {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFinalOffer queries the final_offer edge of a FinalOfferMilestone.
+func (c *FinalOfferMilestoneClient) QueryFinalOffer(_m *FinalOfferMilestone) *FinalOfferQuery {
+	query := (&FinalOfferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffermilestone.Table, finaloffermilestone.FieldID, id),
+			sqlgraph.To(finaloffer.Table, finaloffer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, finaloffermilestone.FinalOfferTable, finaloffermilestone.FinalOfferColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FinalOfferMilestoneClient) Hooks() []Hook {
+	return c.hooks.FinalOfferMilestone
+}
+
+// Interceptors returns the client interceptors.
+func (c *FinalOfferMilestoneClient) Interceptors() []Interceptor {
+	return c.inters.FinalOfferMilestone
+}
+
+func (c *FinalOfferMilestoneClient) mutate(ctx context.Context, m *FinalOfferMilestoneMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FinalOfferMilestoneCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FinalOfferMilestoneUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FinalOfferMilestoneUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FinalOfferMilestoneDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FinalOfferMilestone mutation op: %q", m.Op())
+	}
+}
+
+// FinalOfferRevisionClient is a client for the FinalOfferRevision schema.
+type FinalOfferRevisionClient struct {
+	config
+}
+
+// NewFinalOfferRevisionClient returns a client for the FinalOfferRevision from the given config.
+func NewFinalOfferRevisionClient(c config) *FinalOfferRevisionClient {
+	return &FinalOfferRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `finalofferrevision.Hooks(f(g(h())))`.
+func (c *FinalOfferRevisionClient) Use(hooks ...Hook) {
+	c.hooks.FinalOfferRevision = append(c.hooks.FinalOfferRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `finalofferrevision.Intercept(f(g(h())))`.
+func (c *FinalOfferRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FinalOfferRevision = append(c.inters.FinalOfferRevision, interceptors...)
+}
+
+// Create returns a builder for creating a FinalOfferRevision entity.
+func (c *FinalOfferRevisionClient) Create() *FinalOfferRevisionCreate {
+	mutation := newFinalOfferRevisionMutation(c.config, OpCreate)
+	return &FinalOfferRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FinalOfferRevision entities.
+func (c *FinalOfferRevisionClient) CreateBulk(builders ...*FinalOfferRevisionCreate) *FinalOfferRevisionCreateBulk {
+	return &FinalOfferRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FinalOfferRevisionClient) MapCreateBulk(slice any, setFunc func(*FinalOfferRevisionCreate, int)) *FinalOfferRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FinalOfferRevisionCreateBulk{err: fmt.Errorf("calling to FinalOfferRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FinalOfferRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FinalOfferRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FinalOfferRevision.
+func (c *FinalOfferRevisionClient) Update() *FinalOfferRevisionUpdate {
+	mutation := newFinalOfferRevisionMutation(c.config, OpUpdate)
+	return &FinalOfferRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FinalOfferRevisionClient) UpdateOne(_m *FinalOfferRevision) *FinalOfferRevisionUpdateOne {
+	mutation := newFinalOfferRevisionMutation(c.config, OpUpdateOne, withFinalOfferRevision(_m))
+	return &FinalOfferRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FinalOfferRevisionClient) UpdateOneID(id int) *FinalOfferRevisionUpdateOne {
+	mutation := newFinalOfferRevisionMutation(c.config, OpUpdateOne, withFinalOfferRevisionID(id))
+	return &FinalOfferRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FinalOfferRevision.
+func (c *FinalOfferRevisionClient) Delete() *FinalOfferRevisionDelete {
+	mutation := newFinalOfferRevisionMutation(c.config, OpDelete)
+	return &FinalOfferRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FinalOfferRevisionClient) DeleteOne(_m *FinalOfferRevision) *FinalOfferRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FinalOfferRevisionClient) DeleteOneID(id int) *FinalOfferRevisionDeleteOne {
+	builder := c.Delete().Where(finalofferrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FinalOfferRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for FinalOfferRevision.
+func (c *FinalOfferRevisionClient) Query() *FinalOfferRevisionQuery {
+	return &FinalOfferRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFinalOfferRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FinalOfferRevision entity by its id.
+func (c *FinalOfferRevisionClient) Get(ctx context.Context, id int) (*FinalOfferRevision, error) {
+	return c.Query().Where(finalofferrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FinalOfferRevisionClient) GetX(ctx context.Context, id int) *FinalOfferRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFinalOffer queries the final_offer edge of a FinalOfferRevision.
+func (c *FinalOfferRevisionClient) QueryFinalOffer(_m *FinalOfferRevision) *FinalOfferQuery {
+	query := (&FinalOfferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finalofferrevision.Table, finalofferrevision.FieldID, id),
+			sqlgraph.To(finaloffer.Table, finaloffer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, finalofferrevision.FinalOfferTable, finalofferrevision.FinalOfferColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FinalOfferRevisionClient) Hooks() []Hook {
+	return c.hooks.FinalOfferRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *FinalOfferRevisionClient) Interceptors() []Interceptor {
+	return c.inters.FinalOfferRevision
+}
+
+func (c *FinalOfferRevisionClient) mutate(ctx context.Context, m *FinalOfferRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FinalOfferRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FinalOfferRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FinalOfferRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FinalOfferRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FinalOfferRevision mutation op: %q", m.Op())
+	}
+}
+
+// GuaranteeSlashClient is a client for the GuaranteeSlash schema.
+type GuaranteeSlashClient struct {
+	config
+}
+
+// NewGuaranteeSlashClient returns a client for the GuaranteeSlash from the given config.
+func NewGuaranteeSlashClient(c config) *GuaranteeSlashClient {
+	return &GuaranteeSlashClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `guaranteeslash.Hooks(f(g(h())))`.
+func (c *GuaranteeSlashClient) Use(hooks ...Hook) {
+	c.hooks.GuaranteeSlash = append(c.hooks.GuaranteeSlash, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `guaranteeslash.Intercept(f(g(h())))`.
+func (c *GuaranteeSlashClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GuaranteeSlash = append(c.inters.GuaranteeSlash, interceptors...)
+}
+
+// Create returns a builder for creating a GuaranteeSlash entity.
+func (c *GuaranteeSlashClient) Create() *GuaranteeSlashCreate {
+	mutation := newGuaranteeSlashMutation(c.config, OpCreate)
+	return &GuaranteeSlashCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GuaranteeSlash entities.
+func (c *GuaranteeSlashClient) CreateBulk(builders ...*GuaranteeSlashCreate) *GuaranteeSlashCreateBulk {
+	return &GuaranteeSlashCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GuaranteeSlashClient) MapCreateBulk(slice any, setFunc func(*GuaranteeSlashCreate, int)) *GuaranteeSlashCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GuaranteeSlashCreateBulk{err: fmt.Errorf("calling to GuaranteeSlashClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GuaranteeSlashCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GuaranteeSlashCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GuaranteeSlash.
+func (c *GuaranteeSlashClient) Update() *GuaranteeSlashUpdate {
+	mutation := newGuaranteeSlashMutation(c.config, OpUpdate)
+	return &GuaranteeSlashUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GuaranteeSlashClient) UpdateOne(_m *GuaranteeSlash) *GuaranteeSlashUpdateOne {
+	mutation := newGuaranteeSlashMutation(c.config, OpUpdateOne, withGuaranteeSlash(_m))
+	return &GuaranteeSlashUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GuaranteeSlashClient) UpdateOneID(id int) *GuaranteeSlashUpdateOne {
+	mutation := newGuaranteeSlashMutation(c.config, OpUpdateOne, withGuaranteeSlashID(id))
+	return &GuaranteeSlashUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GuaranteeSlash.
+func (c *GuaranteeSlashClient) Delete() *GuaranteeSlashDelete {
+	mutation := newGuaranteeSlashMutation(c.config, OpDelete)
+	return &GuaranteeSlashDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GuaranteeSlashClient) DeleteOne(_m *GuaranteeSlash) *GuaranteeSlashDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GuaranteeSlashClient) DeleteOneID(id int) *GuaranteeSlashDeleteOne {
+	builder := c.Delete().Where(guaranteeslash.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GuaranteeSlashDeleteOne{builder}
+}
+
+// Query returns a query builder for GuaranteeSlash.
+func (c *GuaranteeSlashClient) Query() *GuaranteeSlashQuery {
+	return &GuaranteeSlashQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGuaranteeSlash},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GuaranteeSlash entity by its id.
+func (c *GuaranteeSlashClient) Get(ctx context.Context, id int) (*GuaranteeSlash, error) {
+	return c.Query().Where(guaranteeslash.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GuaranteeSlashClient) GetX(ctx context.Context, id int) *GuaranteeSlash {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GuaranteeSlashClient) Hooks() []Hook {
+	return c.hooks.GuaranteeSlash
+}
+
+// Interceptors returns the client interceptors.
+func (c *GuaranteeSlashClient) Interceptors() []Interceptor {
+	return c.inters.GuaranteeSlash
+}
+
+func (c *GuaranteeSlashClient) mutate(ctx context.Context, m *GuaranteeSlashMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GuaranteeSlashCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GuaranteeSlashUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GuaranteeSlashUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GuaranteeSlashDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GuaranteeSlash mutation op: %q", m.Op())
+	}
+}
+
+// IPGeoCacheClient is a client for the IPGeoCache schema.
+type IPGeoCacheClient struct {
+	config
+}
+
+// NewIPGeoCacheClient returns a client for the IPGeoCache from the given config.
+func NewIPGeoCacheClient(c config) *IPGeoCacheClient {
+	return &IPGeoCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ipgeocache.Hooks(f(g(h())))`.
+func (c *IPGeoCacheClient) Use(hooks ...Hook) {
+	c.hooks.IPGeoCache = append(c.hooks.IPGeoCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ipgeocache.Intercept(f(g(h())))`.
+func (c *IPGeoCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IPGeoCache = append(c.inters.IPGeoCache, interceptors...)
+}
+
+// Create returns a builder for creating a IPGeoCache entity.
+func (c *IPGeoCacheClient) Create() *IPGeoCacheCreate {
+	mutation := newIPGeoCacheMutation(c.config, OpCreate)
+	return &IPGeoCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IPGeoCache entities.
+func (c *IPGeoCacheClient) CreateBulk(builders ...*IPGeoCacheCreate) *IPGeoCacheCreateBulk {
+	return &IPGeoCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IPGeoCacheClient) MapCreateBulk(slice any, setFunc func(*IPGeoCacheCreate, int)) *IPGeoCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IPGeoCacheCreateBulk{err: fmt.Errorf("calling to IPGeoCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IPGeoCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IPGeoCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IPGeoCache.
+func (c *IPGeoCacheClient) Update() *IPGeoCacheUpdate {
+	mutation := newIPGeoCacheMutation(c.config, OpUpdate)
+	return &IPGeoCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IPGeoCacheClient) UpdateOne(_m *IPGeoCache) *IPGeoCacheUpdateOne {
+	mutation := newIPGeoCacheMutation(c.config, OpUpdateOne, withIPGeoCache(_m))
+	return &IPGeoCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IPGeoCacheClient) UpdateOneID(id int) *IPGeoCacheUpdateOne {
+	mutation := newIPGeoCacheMutation(c.config, OpUpdateOne, withIPGeoCacheID(id))
+	return &IPGeoCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IPGeoCache.
+func (c *IPGeoCacheClient) Delete() *IPGeoCacheDelete {
+	mutation := newIPGeoCacheMutation(c.config, OpDelete)
+	return &IPGeoCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IPGeoCacheClient) DeleteOne(_m *IPGeoCache) *IPGeoCacheDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IPGeoCacheClient) DeleteOneID(id int) *IPGeoCacheDeleteOne {
+	builder := c.Delete().Where(ipgeocache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IPGeoCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for IPGeoCache.
+func (c *IPGeoCacheClient) Query() *IPGeoCacheQuery {
+	return &IPGeoCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIPGeoCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IPGeoCache entity by its id.
+func (c *IPGeoCacheClient) Get(ctx context.Context, id int) (*IPGeoCache, error) {
+	return c.Query().Where(ipgeocache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IPGeoCacheClient) GetX(ctx context.Context, id int) *IPGeoCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IPGeoCacheClient) Hooks() []Hook {
+	return c.hooks.IPGeoCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *IPGeoCacheClient) Interceptors() []Interceptor {
+	return c.inters.IPGeoCache
+}
+
+func (c *IPGeoCacheClient) mutate(ctx context.Context, m *IPGeoCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IPGeoCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IPGeoCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IPGeoCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IPGeoCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IPGeoCache mutation op: %q", m.Op())
+	}
+}
+
+// IPRuleClient is a client for the IPRule schema.
+type IPRuleClient struct {
+	config
+}
+
+// NewIPRuleClient returns a client for the IPRule from the given config.
+func NewIPRuleClient(c config) *IPRuleClient {
+	return &IPRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `iprule.Hooks(f(g(h())))`.
+func (c *IPRuleClient) Use(hooks ...Hook) {
+	c.hooks.IPRule = append(c.hooks.IPRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `iprule.Intercept(f(g(h())))`.
+func (c *IPRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IPRule = append(c.inters.IPRule, interceptors...)
+}
+
+// Create returns a builder for creating a IPRule entity.
+func (c *IPRuleClient) Create() *IPRuleCreate {
+	mutation := newIPRuleMutation(c.config, OpCreate)
+	return &IPRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IPRule entities.
+func (c *IPRuleClient) CreateBulk(builders ...*IPRuleCreate) *IPRuleCreateBulk {
+	return &IPRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IPRuleClient) MapCreateBulk(slice any, setFunc func(*IPRuleCreate, int)) *IPRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IPRuleCreateBulk{err: fmt.Errorf("calling to IPRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IPRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IPRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IPRule.
+func (c *IPRuleClient) Update() *IPRuleUpdate {
+	mutation := newIPRuleMutation(c.config, OpUpdate)
+	return &IPRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IPRuleClient) UpdateOne(_m *IPRule) *IPRuleUpdateOne {
+	mutation := newIPRuleMutation(c.config, OpUpdateOne, withIPRule(_m))
+	return &IPRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IPRuleClient) UpdateOneID(id int) *IPRuleUpdateOne {
+	mutation := newIPRuleMutation(c.config, OpUpdateOne, withIPRuleID(id))
+	return &IPRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IPRule.
+func (c *IPRuleClient) Delete() *IPRuleDelete {
+	mutation := newIPRuleMutation(c.config, OpDelete)
+	return &IPRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IPRuleClient) DeleteOne(_m *IPRule) *IPRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IPRuleClient) DeleteOneID(id int) *IPRuleDeleteOne {
+	builder := c.Delete().Where(iprule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IPRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for IPRule.
+func (c *IPRuleClient) Query() *IPRuleQuery {
+	return &IPRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIPRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IPRule entity by its id.
+func (c *IPRuleClient) Get(ctx context.Context, id int) (*IPRule, error) {
+	return c.Query().Where(iprule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IPRuleClient) GetX(ctx context.Context, id int) *IPRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *FinalOfferClient) Hooks() []Hook {
-	return c.hooks.FinalOffer
+func (c *IPRuleClient) Hooks() []Hook {
+	return c.hooks.IPRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *FinalOfferClient) Interceptors() []Interceptor {
-	return c.inters.FinalOffer
+func (c *IPRuleClient) Interceptors() []Interceptor {
+	return c.inters.IPRule
 }
 
-func (c *FinalOfferClient) mutate(ctx context.Context, m *FinalOfferMutation) (Value, error) {
+func (c *IPRuleClient) mutate(ctx context.Context, m *IPRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FinalOfferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IPRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FinalOfferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IPRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FinalOfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IPRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FinalOfferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&IPRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FinalOffer mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown IPRule mutation op: %q", m.Op())
 	}
 }
 
-// IPGeoCacheClient is a client for the IPGeoCache schema.
-type IPGeoCacheClient struct {
+// ImpersonationSessionClient is a client for the ImpersonationSession schema.
+type ImpersonationSessionClient struct {
 	config
 }
 
-// NewIPGeoCacheClient returns a client for the IPGeoCache from the given config.
-func NewIPGeoCacheClient(c config) *IPGeoCacheClient {
-	return &IPGeoCacheClient{config: c}
+// NewImpersonationSessionClient returns a client for the ImpersonationSession from the given config.
+func NewImpersonationSessionClient(c config) *ImpersonationSessionClient {
+	return &ImpersonationSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ipgeocache.Hooks(f(g(h())))`.
-func (c *IPGeoCacheClient) Use(hooks ...Hook) {
-	c.hooks.IPGeoCache = append(c.hooks.IPGeoCache, hooks...)
+// A call to `Use(f, g, h)` equals to `impersonationsession.Hooks(f(g(h())))`.
+func (c *ImpersonationSessionClient) Use(hooks ...Hook) {
+	c.hooks.ImpersonationSession = append(c.hooks.ImpersonationSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ipgeocache.Intercept(f(g(h())))`.
-func (c *IPGeoCacheClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IPGeoCache = append(c.inters.IPGeoCache, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `impersonationsession.Intercept(f(g(h())))`.
+func (c *ImpersonationSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImpersonationSession = append(c.inters.ImpersonationSession, interceptors...)
 }
 
-// Create returns a builder for creating a IPGeoCache entity.
-func (c *IPGeoCacheClient) Create() *IPGeoCacheCreate {
-	mutation := newIPGeoCacheMutation(c.config, OpCreate)
-	return &IPGeoCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ImpersonationSession entity.
+func (c *ImpersonationSessionClient) Create() *ImpersonationSessionCreate {
+	mutation := newImpersonationSessionMutation(c.config, OpCreate)
+	return &ImpersonationSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IPGeoCache entities.
-func (c *IPGeoCacheClient) CreateBulk(builders ...*IPGeoCacheCreate) *IPGeoCacheCreateBulk {
-	return &IPGeoCacheCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ImpersonationSession entities.
+func (c *ImpersonationSessionClient) CreateBulk(builders ...*ImpersonationSessionCreate) *ImpersonationSessionCreateBulk {
+	return &ImpersonationSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IPGeoCacheClient) MapCreateBulk(slice any, setFunc func(*IPGeoCacheCreate, int)) *IPGeoCacheCreateBulk {
+func (c *ImpersonationSessionClient) MapCreateBulk(slice any, setFunc func(*ImpersonationSessionCreate, int)) *ImpersonationSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IPGeoCacheCreateBulk{err: fmt.Errorf("calling to IPGeoCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImpersonationSessionCreateBulk{err: fmt.Errorf("calling to ImpersonationSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IPGeoCacheCreate, rv.Len())
+	builders := make([]*ImpersonationSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IPGeoCacheCreateBulk{config: c.config, builders: builders}
+	return &ImpersonationSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IPGeoCache.
-func (c *IPGeoCacheClient) Update() *IPGeoCacheUpdate {
-	mutation := newIPGeoCacheMutation(c.config, OpUpdate)
-	return &IPGeoCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ImpersonationSession.
+func (c *ImpersonationSessionClient) Update() *ImpersonationSessionUpdate {
+	mutation := newImpersonationSessionMutation(c.config, OpUpdate)
+	return &ImpersonationSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IPGeoCacheClient) UpdateOne(_m *IPGeoCache) *IPGeoCacheUpdateOne {
-	mutation := newIPGeoCacheMutation(c.config, OpUpdateOne, withIPGeoCache(_m))
-	return &IPGeoCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImpersonationSessionClient) UpdateOne(_m *ImpersonationSession) *ImpersonationSessionUpdateOne {
+	mutation := newImpersonationSessionMutation(c.config, OpUpdateOne, withImpersonationSession(_m))
+	return &ImpersonationSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IPGeoCacheClient) UpdateOneID(id int) *IPGeoCacheUpdateOne {
-	mutation := newIPGeoCacheMutation(c.config, OpUpdateOne, withIPGeoCacheID(id))
-	return &IPGeoCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImpersonationSessionClient) UpdateOneID(id int) *ImpersonationSessionUpdateOne {
+	mutation := newImpersonationSessionMutation(c.config, OpUpdateOne, withImpersonationSessionID(id))
+	return &ImpersonationSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IPGeoCache.
-func (c *IPGeoCacheClient) Delete() *IPGeoCacheDelete {
-	mutation := newIPGeoCacheMutation(c.config, OpDelete)
-	return &IPGeoCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ImpersonationSession.
+func (c *ImpersonationSessionClient) Delete() *ImpersonationSessionDelete {
+	mutation := newImpersonationSessionMutation(c.config, OpDelete)
+	return &ImpersonationSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IPGeoCacheClient) DeleteOne(_m *IPGeoCache) *IPGeoCacheDeleteOne {
+func (c *ImpersonationSessionClient) DeleteOne(_m *ImpersonationSession) *ImpersonationSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IPGeoCacheClient) DeleteOneID(id int) *IPGeoCacheDeleteOne {
-	builder := c.Delete().Where(ipgeocache.ID(id))
+func (c *ImpersonationSessionClient) DeleteOneID(id int) *ImpersonationSessionDeleteOne {
+	builder := c.Delete().Where(impersonationsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IPGeoCacheDeleteOne{builder}
+	return &ImpersonationSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for IPGeoCache.
-func (c *IPGeoCacheClient) Query() *IPGeoCacheQuery {
-	return &IPGeoCacheQuery{
+// Query returns a query builder for ImpersonationSession.
+func (c *ImpersonationSessionClient) Query() *ImpersonationSessionQuery {
+	return &ImpersonationSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIPGeoCache},
+		ctx:    &QueryContext{Type: TypeImpersonationSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IPGeoCache entity by its id.
-func (c *IPGeoCacheClient) Get(ctx context.Context, id int) (*IPGeoCache, error) {
-	return c.Query().Where(ipgeocache.ID(id)).Only(ctx)
+// Get returns a ImpersonationSession entity by its id.
+func (c *ImpersonationSessionClient) Get(ctx context.Context, id int) (*ImpersonationSession, error) {
+	return c.Query().Where(impersonationsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IPGeoCacheClient) GetX(ctx context.Context, id int) *IPGeoCache {
+func (c *ImpersonationSessionClient) GetX(ctx context.Context, id int) *ImpersonationSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2544,27 +4619,27 @@ func (c *IPGeoCacheClient) GetX(ctx context.Context, id int) *IPGeoCache {
 }
 
 // Hooks returns the client hooks.
-func (c *IPGeoCacheClient) Hooks() []Hook {
-	return c.hooks.IPGeoCache
+func (c *ImpersonationSessionClient) Hooks() []Hook {
+	return c.hooks.ImpersonationSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *IPGeoCacheClient) Interceptors() []Interceptor {
-	return c.inters.IPGeoCache
+func (c *ImpersonationSessionClient) Interceptors() []Interceptor {
+	return c.inters.ImpersonationSession
 }
 
-func (c *IPGeoCacheClient) mutate(ctx context.Context, m *IPGeoCacheMutation) (Value, error) {
+func (c *ImpersonationSessionClient) mutate(ctx context.Context, m *ImpersonationSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IPGeoCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImpersonationSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IPGeoCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImpersonationSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IPGeoCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImpersonationSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IPGeoCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImpersonationSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IPGeoCache mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ImpersonationSession mutation op: %q", m.Op())
 	}
 }
 
@@ -3072,18 +5147,300 @@ func (c *PasswordResetTokenClient) DeleteOneID(id int) *PasswordResetTokenDelete
 func (c *PasswordResetTokenClient) Query() *PasswordResetTokenQuery {
 	return &PasswordResetTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePasswordResetToken},
+		ctx:    &QueryContext{Type: TypePasswordResetToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordResetToken entity by its id.
+func (c *PasswordResetTokenClient) Get(ctx context.Context, id int) (*PasswordResetToken, error) {
+	return c.Query().Where(passwordresettoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordResetTokenClient) GetX(ctx context.Context, id int) *PasswordResetToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordResetToken.
+func (c *PasswordResetTokenClient) QueryUser(_m *PasswordResetToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordresettoken.Table, passwordresettoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passwordresettoken.UserTable, passwordresettoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordResetTokenClient) Hooks() []Hook {
+	return c.hooks.PasswordResetToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordResetTokenClient) Interceptors() []Interceptor {
+	return c.inters.PasswordResetToken
+}
+
+func (c *PasswordResetTokenClient) mutate(ctx context.Context, m *PasswordResetTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordResetTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordResetTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordResetTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordResetTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordResetToken mutation op: %q", m.Op())
+	}
+}
+
+// ReputationEventClient is a client for the ReputationEvent schema.
+type ReputationEventClient struct {
+	config
+}
+
+// NewReputationEventClient returns a client for the ReputationEvent from the given config.
+func NewReputationEventClient(c config) *ReputationEventClient {
+	return &ReputationEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reputationevent.Hooks(f(g(h())))`.
+func (c *ReputationEventClient) Use(hooks ...Hook) {
+	c.hooks.ReputationEvent = append(c.hooks.ReputationEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reputationevent.Intercept(f(g(h())))`.
+func (c *ReputationEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReputationEvent = append(c.inters.ReputationEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ReputationEvent entity.
+func (c *ReputationEventClient) Create() *ReputationEventCreate {
+	mutation := newReputationEventMutation(c.config, OpCreate)
+	return &ReputationEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReputationEvent entities.
+func (c *ReputationEventClient) CreateBulk(builders ...*ReputationEventCreate) *ReputationEventCreateBulk {
+	return &ReputationEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReputationEventClient) MapCreateBulk(slice any, setFunc func(*ReputationEventCreate, int)) *ReputationEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReputationEventCreateBulk{err: fmt.Errorf("calling to ReputationEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReputationEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReputationEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReputationEvent.
+func (c *ReputationEventClient) Update() *ReputationEventUpdate {
+	mutation := newReputationEventMutation(c.config, OpUpdate)
+	return &ReputationEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReputationEventClient) UpdateOne(_m *ReputationEvent) *ReputationEventUpdateOne {
+	mutation := newReputationEventMutation(c.config, OpUpdateOne, withReputationEvent(_m))
+	return &ReputationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReputationEventClient) UpdateOneID(id int) *ReputationEventUpdateOne {
+	mutation := newReputationEventMutation(c.config, OpUpdateOne, withReputationEventID(id))
+	return &ReputationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReputationEvent.
+func (c *ReputationEventClient) Delete() *ReputationEventDelete {
+	mutation := newReputationEventMutation(c.config, OpDelete)
+	return &ReputationEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReputationEventClient) DeleteOne(_m *ReputationEvent) *ReputationEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReputationEventClient) DeleteOneID(id int) *ReputationEventDeleteOne {
+	builder := c.Delete().Where(reputationevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReputationEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ReputationEvent.
+func (c *ReputationEventClient) Query() *ReputationEventQuery {
+	return &ReputationEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReputationEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReputationEvent entity by its id.
+func (c *ReputationEventClient) Get(ctx context.Context, id int) (*ReputationEvent, error) {
+	return c.Query().Where(reputationevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReputationEventClient) GetX(ctx context.Context, id int) *ReputationEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReputationEventClient) Hooks() []Hook {
+	return c.hooks.ReputationEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReputationEventClient) Interceptors() []Interceptor {
+	return c.inters.ReputationEvent
+}
+
+func (c *ReputationEventClient) mutate(ctx context.Context, m *ReputationEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReputationEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReputationEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReputationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReputationEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReputationEvent mutation op: %q", m.Op())
+	}
+}
+
+// SLAPolicyClient is a client for the SLAPolicy schema.
+type SLAPolicyClient struct {
+	config
+}
+
+// NewSLAPolicyClient returns a client for the SLAPolicy from the given config.
+func NewSLAPolicyClient(c config) *SLAPolicyClient {
+	return &SLAPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `slapolicy.Hooks(f(g(h())))`.
+func (c *SLAPolicyClient) Use(hooks ...Hook) {
+	c.hooks.SLAPolicy = append(c.hooks.SLAPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `slapolicy.Intercept(f(g(h())))`.
+func (c *SLAPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SLAPolicy = append(c.inters.SLAPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a SLAPolicy entity.
+func (c *SLAPolicyClient) Create() *SLAPolicyCreate {
+	mutation := newSLAPolicyMutation(c.config, OpCreate)
+	return &SLAPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SLAPolicy entities.
+func (c *SLAPolicyClient) CreateBulk(builders ...*SLAPolicyCreate) *SLAPolicyCreateBulk {
+	return &SLAPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SLAPolicyClient) MapCreateBulk(slice any, setFunc func(*SLAPolicyCreate, int)) *SLAPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SLAPolicyCreateBulk{err: fmt.Errorf("calling to SLAPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SLAPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SLAPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SLAPolicy.
+func (c *SLAPolicyClient) Update() *SLAPolicyUpdate {
+	mutation := newSLAPolicyMutation(c.config, OpUpdate)
+	return &SLAPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SLAPolicyClient) UpdateOne(_m *SLAPolicy) *SLAPolicyUpdateOne {
+	mutation := newSLAPolicyMutation(c.config, OpUpdateOne, withSLAPolicy(_m))
+	return &SLAPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SLAPolicyClient) UpdateOneID(id int) *SLAPolicyUpdateOne {
+	mutation := newSLAPolicyMutation(c.config, OpUpdateOne, withSLAPolicyID(id))
+	return &SLAPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SLAPolicy.
+func (c *SLAPolicyClient) Delete() *SLAPolicyDelete {
+	mutation := newSLAPolicyMutation(c.config, OpDelete)
+	return &SLAPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SLAPolicyClient) DeleteOne(_m *SLAPolicy) *SLAPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SLAPolicyClient) DeleteOneID(id int) *SLAPolicyDeleteOne {
+	builder := c.Delete().Where(slapolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SLAPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for SLAPolicy.
+func (c *SLAPolicyClient) Query() *SLAPolicyQuery {
+	return &SLAPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSLAPolicy},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PasswordResetToken entity by its id.
-func (c *PasswordResetTokenClient) Get(ctx context.Context, id int) (*PasswordResetToken, error) {
-	return c.Query().Where(passwordresettoken.ID(id)).Only(ctx)
+// Get returns a SLAPolicy entity by its id.
+func (c *SLAPolicyClient) Get(ctx context.Context, id int) (*SLAPolicy, error) {
+	return c.Query().Where(slapolicy.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PasswordResetTokenClient) GetX(ctx context.Context, id int) *PasswordResetToken {
+func (c *SLAPolicyClient) GetX(ctx context.Context, id int) *SLAPolicy {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3091,44 +5448,28 @@ func (c *PasswordResetTokenClient) GetX(ctx context.Context, id int) *PasswordRe
 	return obj
 }
 
-// QueryUser queries the user edge of a PasswordResetToken.
-func (c *PasswordResetTokenClient) QueryUser(_m *PasswordResetToken) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(passwordresettoken.Table, passwordresettoken.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, passwordresettoken.UserTable, passwordresettoken.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PasswordResetTokenClient) Hooks() []Hook {
-	return c.hooks.PasswordResetToken
+func (c *SLAPolicyClient) Hooks() []Hook {
+	return c.hooks.SLAPolicy
 }
 
 // Interceptors returns the client interceptors.
-func (c *PasswordResetTokenClient) Interceptors() []Interceptor {
-	return c.inters.PasswordResetToken
+func (c *SLAPolicyClient) Interceptors() []Interceptor {
+	return c.inters.SLAPolicy
 }
 
-func (c *PasswordResetTokenClient) mutate(ctx context.Context, m *PasswordResetTokenMutation) (Value, error) {
+func (c *SLAPolicyClient) mutate(ctx context.Context, m *SLAPolicyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PasswordResetTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SLAPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PasswordResetTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SLAPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PasswordResetTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SLAPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PasswordResetTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SLAPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PasswordResetToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SLAPolicy mutation op: %q", m.Op())
 	}
 }
 
@@ -5086,22 +7427,429 @@ func (c *ValidationCaseLogClient) mutate(ctx context.Context, m *ValidationCaseL
 	}
 }
 
+// ValidatorDeliverySLAClient is a client for the ValidatorDeliverySLA schema.
+type ValidatorDeliverySLAClient struct {
+	config
+}
+
+// NewValidatorDeliverySLAClient returns a client for the ValidatorDeliverySLA from the given config.
+func NewValidatorDeliverySLAClient(c config) *ValidatorDeliverySLAClient {
+	return &ValidatorDeliverySLAClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `validatordeliverysla.Hooks(f(g(h())))`.
+func (c *ValidatorDeliverySLAClient) Use(hooks ...Hook) {
+	c.hooks.ValidatorDeliverySLA = append(c.hooks.ValidatorDeliverySLA, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `validatordeliverysla.Intercept(f(g(h())))`.
+func (c *ValidatorDeliverySLAClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ValidatorDeliverySLA = append(c.inters.ValidatorDeliverySLA, interceptors...)
+}
+
+// Create returns a builder for creating a ValidatorDeliverySLA entity.
+func (c *ValidatorDeliverySLAClient) Create() *ValidatorDeliverySLACreate {
+	mutation := newValidatorDeliverySLAMutation(c.config, OpCreate)
+	return &ValidatorDeliverySLACreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ValidatorDeliverySLA entities.
+func (c *ValidatorDeliverySLAClient) CreateBulk(builders ...*ValidatorDeliverySLACreate) *ValidatorDeliverySLACreateBulk {
+	return &ValidatorDeliverySLACreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ValidatorDeliverySLAClient) MapCreateBulk(slice any, setFunc func(*ValidatorDeliverySLACreate, int)) *ValidatorDeliverySLACreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ValidatorDeliverySLACreateBulk{err: fmt.Errorf("calling to ValidatorDeliverySLAClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ValidatorDeliverySLACreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ValidatorDeliverySLACreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ValidatorDeliverySLA.
+func (c *ValidatorDeliverySLAClient) Update() *ValidatorDeliverySLAUpdate {
+	mutation := newValidatorDeliverySLAMutation(c.config, OpUpdate)
+	return &ValidatorDeliverySLAUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ValidatorDeliverySLAClient) UpdateOne(_m *ValidatorDeliverySLA) *ValidatorDeliverySLAUpdateOne {
+	mutation := newValidatorDeliverySLAMutation(c.config, OpUpdateOne, withValidatorDeliverySLA(_m))
+	return &ValidatorDeliverySLAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ValidatorDeliverySLAClient) UpdateOneID(id int) *ValidatorDeliverySLAUpdateOne {
+	mutation := newValidatorDeliverySLAMutation(c.config, OpUpdateOne, withValidatorDeliverySLAID(id))
+	return &ValidatorDeliverySLAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ValidatorDeliverySLA.
+func (c *ValidatorDeliverySLAClient) Delete() *ValidatorDeliverySLADelete {
+	mutation := newValidatorDeliverySLAMutation(c.config, OpDelete)
+	return &ValidatorDeliverySLADelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ValidatorDeliverySLAClient) DeleteOne(_m *ValidatorDeliverySLA) *ValidatorDeliverySLADeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ValidatorDeliverySLAClient) DeleteOneID(id int) *ValidatorDeliverySLADeleteOne {
+	builder := c.Delete().Where(validatordeliverysla.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ValidatorDeliverySLADeleteOne{builder}
+}
+
+// Query returns a query builder for ValidatorDeliverySLA.
+func (c *ValidatorDeliverySLAClient) Query() *ValidatorDeliverySLAQuery {
+	return &ValidatorDeliverySLAQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeValidatorDeliverySLA},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ValidatorDeliverySLA entity by its id.
+func (c *ValidatorDeliverySLAClient) Get(ctx context.Context, id int) (*ValidatorDeliverySLA, error) {
+	return c.Query().Where(validatordeliverysla.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ValidatorDeliverySLAClient) GetX(ctx context.Context, id int) *ValidatorDeliverySLA {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ValidatorDeliverySLAClient) Hooks() []Hook {
+	return c.hooks.ValidatorDeliverySLA
+}
+
+// Interceptors returns the client interceptors.
+func (c *ValidatorDeliverySLAClient) Interceptors() []Interceptor {
+	return c.inters.ValidatorDeliverySLA
+}
+
+func (c *ValidatorDeliverySLAClient) mutate(ctx context.Context, m *ValidatorDeliverySLAMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ValidatorDeliverySLACreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ValidatorDeliverySLAUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ValidatorDeliverySLAUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ValidatorDeliverySLADelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ValidatorDeliverySLA mutation op: %q", m.Op())
+	}
+}
+
+// ValidatorMatchingAggregateClient is a client for the ValidatorMatchingAggregate schema.
+type ValidatorMatchingAggregateClient struct {
+	config
+}
+
+// NewValidatorMatchingAggregateClient returns a client for the ValidatorMatchingAggregate from the given config.
+func NewValidatorMatchingAggregateClient(c config) *ValidatorMatchingAggregateClient {
+	return &ValidatorMatchingAggregateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `validatormatchingaggregate.Hooks(f(g(h())))`.
+func (c *ValidatorMatchingAggregateClient) Use(hooks ...Hook) {
+	c.hooks.ValidatorMatchingAggregate = append(c.hooks.ValidatorMatchingAggregate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `validatormatchingaggregate.Intercept(f(g(h())))`.
+func (c *ValidatorMatchingAggregateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ValidatorMatchingAggregate = append(c.inters.ValidatorMatchingAggregate, interceptors...)
+}
+
+// Create returns a builder for creating a ValidatorMatchingAggregate entity.
+func (c *ValidatorMatchingAggregateClient) Create() *ValidatorMatchingAggregateCreate {
+	mutation := newValidatorMatchingAggregateMutation(c.config, OpCreate)
+	return &ValidatorMatchingAggregateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ValidatorMatchingAggregate entities.
+func (c *ValidatorMatchingAggregateClient) CreateBulk(builders ...*ValidatorMatchingAggregateCreate) *ValidatorMatchingAggregateCreateBulk {
+	return &ValidatorMatchingAggregateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ValidatorMatchingAggregateClient) MapCreateBulk(slice any, setFunc func(*ValidatorMatchingAggregateCreate, int)) *ValidatorMatchingAggregateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ValidatorMatchingAggregateCreateBulk{err: fmt.Errorf("calling to ValidatorMatchingAggregateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ValidatorMatchingAggregateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ValidatorMatchingAggregateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ValidatorMatchingAggregate.
+func (c *ValidatorMatchingAggregateClient) Update() *ValidatorMatchingAggregateUpdate {
+	mutation := newValidatorMatchingAggregateMutation(c.config, OpUpdate)
+	return &ValidatorMatchingAggregateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ValidatorMatchingAggregateClient) UpdateOne(_m *ValidatorMatchingAggregate) *ValidatorMatchingAggregateUpdateOne {
+	mutation := newValidatorMatchingAggregateMutation(c.config, OpUpdateOne, withValidatorMatchingAggregate(_m))
+	return &ValidatorMatchingAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ValidatorMatchingAggregateClient) UpdateOneID(id int) *ValidatorMatchingAggregateUpdateOne {
+	mutation := newValidatorMatchingAggregateMutation(c.config, OpUpdateOne, withValidatorMatchingAggregateID(id))
+	return &ValidatorMatchingAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ValidatorMatchingAggregate.
+func (c *ValidatorMatchingAggregateClient) Delete() *ValidatorMatchingAggregateDelete {
+	mutation := newValidatorMatchingAggregateMutation(c.config, OpDelete)
+	return &ValidatorMatchingAggregateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ValidatorMatchingAggregateClient) DeleteOne(_m *ValidatorMatchingAggregate) *ValidatorMatchingAggregateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ValidatorMatchingAggregateClient) DeleteOneID(id int) *ValidatorMatchingAggregateDeleteOne {
+	builder := c.Delete().Where(validatormatchingaggregate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ValidatorMatchingAggregateDeleteOne{builder}
+}
+
+// Query returns a query builder for ValidatorMatchingAggregate.
+func (c *ValidatorMatchingAggregateClient) Query() *ValidatorMatchingAggregateQuery {
+	return &ValidatorMatchingAggregateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeValidatorMatchingAggregate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ValidatorMatchingAggregate entity by its id.
+func (c *ValidatorMatchingAggregateClient) Get(ctx context.Context, id int) (*ValidatorMatchingAggregate, error) {
+	return c.Query().Where(validatormatchingaggregate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ValidatorMatchingAggregateClient) GetX(ctx context.Context, id int) *ValidatorMatchingAggregate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ValidatorMatchingAggregateClient) Hooks() []Hook {
+	return c.hooks.ValidatorMatchingAggregate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ValidatorMatchingAggregateClient) Interceptors() []Interceptor {
+	return c.inters.ValidatorMatchingAggregate
+}
+
+func (c *ValidatorMatchingAggregateClient) mutate(ctx context.Context, m *ValidatorMatchingAggregateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ValidatorMatchingAggregateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ValidatorMatchingAggregateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ValidatorMatchingAggregateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ValidatorMatchingAggregateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ValidatorMatchingAggregate mutation op: %q", m.Op())
+	}
+}
+
+// ValidatorReputationClient is a client for the ValidatorReputation schema.
+type ValidatorReputationClient struct {
+	config
+}
+
+// NewValidatorReputationClient returns a client for the ValidatorReputation from the given config.
+func NewValidatorReputationClient(c config) *ValidatorReputationClient {
+	return &ValidatorReputationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `validatorreputation.Hooks(f(g(h())))`.
+func (c *ValidatorReputationClient) Use(hooks ...Hook) {
+	c.hooks.ValidatorReputation = append(c.hooks.ValidatorReputation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `validatorreputation.Intercept(f(g(h())))`.
+func (c *ValidatorReputationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ValidatorReputation = append(c.inters.ValidatorReputation, interceptors...)
+}
+
+// Create returns a builder for creating a ValidatorReputation entity.
+func (c *ValidatorReputationClient) Create() *ValidatorReputationCreate {
+	mutation := newValidatorReputationMutation(c.config, OpCreate)
+	return &ValidatorReputationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ValidatorReputation entities.
+func (c *ValidatorReputationClient) CreateBulk(builders ...*ValidatorReputationCreate) *ValidatorReputationCreateBulk {
+	return &ValidatorReputationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ValidatorReputationClient) MapCreateBulk(slice any, setFunc func(*ValidatorReputationCreate, int)) *ValidatorReputationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ValidatorReputationCreateBulk{err: fmt.Errorf("calling to ValidatorReputationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ValidatorReputationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ValidatorReputationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ValidatorReputation.
+func (c *ValidatorReputationClient) Update() *ValidatorReputationUpdate {
+	mutation := newValidatorReputationMutation(c.config, OpUpdate)
+	return &ValidatorReputationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ValidatorReputationClient) UpdateOne(_m *ValidatorReputation) *ValidatorReputationUpdateOne {
+	mutation := newValidatorReputationMutation(c.config, OpUpdateOne, withValidatorReputation(_m))
+	return &ValidatorReputationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ValidatorReputationClient) UpdateOneID(id int) *ValidatorReputationUpdateOne {
+	mutation := newValidatorReputationMutation(c.config, OpUpdateOne, withValidatorReputationID(id))
+	return &ValidatorReputationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ValidatorReputation.
+func (c *ValidatorReputationClient) Delete() *ValidatorReputationDelete {
+	mutation := newValidatorReputationMutation(c.config, OpDelete)
+	return &ValidatorReputationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ValidatorReputationClient) DeleteOne(_m *ValidatorReputation) *ValidatorReputationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ValidatorReputationClient) DeleteOneID(id int) *ValidatorReputationDeleteOne {
+	builder := c.Delete().Where(validatorreputation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ValidatorReputationDeleteOne{builder}
+}
+
+// Query returns a query builder for ValidatorReputation.
+func (c *ValidatorReputationClient) Query() *ValidatorReputationQuery {
+	return &ValidatorReputationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeValidatorReputation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ValidatorReputation entity by its id.
+func (c *ValidatorReputationClient) Get(ctx context.Context, id int) (*ValidatorReputation, error) {
+	return c.Query().Where(validatorreputation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ValidatorReputationClient) GetX(ctx context.Context, id int) *ValidatorReputation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ValidatorReputationClient) Hooks() []Hook {
+	return c.hooks.ValidatorReputation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ValidatorReputationClient) Interceptors() []Interceptor {
+	return c.inters.ValidatorReputation
+}
+
+func (c *ValidatorReputationClient) mutate(ctx context.Context, m *ValidatorReputationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ValidatorReputationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ValidatorReputationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ValidatorReputationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ValidatorReputationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ValidatorReputation mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Admin, ArtifactSubmission, BackupCode, Badge, Category, ChainCursor,
-		ConsultationRequest, Credential, DeviceFingerprint, DeviceUserMapping,
-		EmailVerificationToken, Endorsement, FinalOffer, IPGeoCache,
-		MarketPurchaseOrder, MarketPurchaseOrderStep, Passkey, PasswordResetToken,
-		SecurityEvent, Session, SessionLock, SudoSession, TOTPPendingToken, Tag, User,
-		UserBadge, ValidationCase, ValidationCaseLog []ent.Hook
+		Admin, AdminAuditLog, AdminPasskey, AdminRole, AdminSession, ArtifactSubmission,
+		BackupCode, Badge, CaseClarification, CaseConversation, CaseMessage, Category,
+		ChainCursor, ConsultationInvitation, ConsultationRequest, Credential,
+		DeviceFingerprint, DeviceUserMapping, EmailVerificationToken, Endorsement,
+		FinalOffer, FinalOfferMilestone, FinalOfferRevision, GuaranteeSlash,
+		IPGeoCache, IPRule, ImpersonationSession, MarketPurchaseOrder,
+		MarketPurchaseOrderStep, Passkey, PasswordResetToken, ReputationEvent,
+		SLAPolicy, SecurityEvent, Session, SessionLock, SudoSession, TOTPPendingToken,
+		Tag, User, UserBadge, ValidationCase, ValidationCaseLog, ValidatorDeliverySLA,
+		ValidatorMatchingAggregate, ValidatorReputation []ent.Hook
 	}
 	inters struct {
-		Admin, ArtifactSubmission, BackupCode, Badge, Category, ChainCursor,
-		ConsultationRequest, Credential, DeviceFingerprint, DeviceUserMapping,
-		EmailVerificationToken, Endorsement, FinalOffer, IPGeoCache,
-		MarketPurchaseOrder, MarketPurchaseOrderStep, Passkey, PasswordResetToken,
-		SecurityEvent, Session, SessionLock, SudoSession, TOTPPendingToken, Tag, User,
-		UserBadge, ValidationCase, ValidationCaseLog []ent.Interceptor
+		Admin, AdminAuditLog, AdminPasskey, AdminRole, AdminSession, ArtifactSubmission,
+		BackupCode, Badge, CaseClarification, CaseConversation, CaseMessage, Category,
+		ChainCursor, ConsultationInvitation, ConsultationRequest, Credential,
+		DeviceFingerprint, DeviceUserMapping, EmailVerificationToken, Endorsement,
+		FinalOffer, FinalOfferMilestone, FinalOfferRevision, GuaranteeSlash,
+		IPGeoCache, IPRule, ImpersonationSession, MarketPurchaseOrder,
+		MarketPurchaseOrderStep, Passkey, PasswordResetToken, ReputationEvent,
+		SLAPolicy, SecurityEvent, Session, SessionLock, SudoSession, TOTPPendingToken,
+		Tag, User, UserBadge, ValidationCase, ValidationCaseLog, ValidatorDeliverySLA,
+		ValidatorMatchingAggregate, ValidatorReputation []ent.Interceptor
 	}
 )
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/consultationinvitation"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ConsultationInvitation is the model entity for the ConsultationInvitation schema.
+type ConsultationInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidationCaseID holds the value of the "validation_case_id" field.
+	ValidationCaseID int `json:"validation_case_id,omitempty"`
+	// OwnerUserID holds the value of the "owner_user_id" field.
+	OwnerUserID int `json:"owner_user_id,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// WorkflowCycle holds the value of the "workflow_cycle" field.
+	WorkflowCycle int `json:"workflow_cycle,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// ConsultationRequestID holds the value of the "consultation_request_id" field.
+	ConsultationRequestID *int `json:"consultation_request_id,omitempty"`
+	selectValues          sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConsultationInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consultationinvitation.FieldID, consultationinvitation.FieldValidationCaseID, consultationinvitation.FieldOwnerUserID, consultationinvitation.FieldValidatorUserID, consultationinvitation.FieldWorkflowCycle, consultationinvitation.FieldConsultationRequestID:
+			values[i] = new(sql.NullInt64)
+		case consultationinvitation.FieldStatus, consultationinvitation.FieldMessage:
+			values[i] = new(sql.NullString)
+		case consultationinvitation.FieldCreatedAt, consultationinvitation.FieldUpdatedAt, consultationinvitation.FieldDeletedAt, consultationinvitation.FieldExpiresAt, consultationinvitation.FieldRespondedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConsultationInvitation fields.
+func (_m *ConsultationInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consultationinvitation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case consultationinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case consultationinvitation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case consultationinvitation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case consultationinvitation.FieldValidationCaseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_case_id", values[i])
+			} else if value.Valid {
+				_m.ValidationCaseID = int(value.Int64)
+			}
+		case consultationinvitation.FieldOwnerUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_user_id", values[i])
+			} else if value.Valid {
+				_m.OwnerUserID = int(value.Int64)
+			}
+		case consultationinvitation.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case consultationinvitation.FieldWorkflowCycle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_cycle", values[i])
+			} else if value.Valid {
+				_m.WorkflowCycle = int(value.Int64)
+			}
+		case consultationinvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case consultationinvitation.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case consultationinvitation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case consultationinvitation.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		case consultationinvitation.FieldConsultationRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consultation_request_id", values[i])
+			} else if value.Valid {
+				_m.ConsultationRequestID = new(int)
+				*_m.ConsultationRequestID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConsultationInvitation.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConsultationInvitation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConsultationInvitation.
+// Note that you need to call ConsultationInvitation.Unwrap() before calling this method if this ConsultationInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConsultationInvitation) Update() *ConsultationInvitationUpdateOne {
+	return NewConsultationInvitationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConsultationInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConsultationInvitation) Unwrap() *ConsultationInvitation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConsultationInvitation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConsultationInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConsultationInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validation_case_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidationCaseID))
+	builder.WriteString(", ")
+	builder.WriteString("owner_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerUserID))
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_cycle=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowCycle))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ConsultationRequestID; v != nil {
+		builder.WriteString("consultation_request_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConsultationInvitations is a parsable slice of ConsultationInvitation.
+type ConsultationInvitations []*ConsultationInvitation
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consultationinvitation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the consultationinvitation type in the database.
+	Label = "consultation_invitation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldValidationCaseID holds the string denoting the validation_case_id field in the database.
+	FieldValidationCaseID = "validation_case_id"
+	// FieldOwnerUserID holds the string denoting the owner_user_id field in the database.
+	FieldOwnerUserID = "owner_user_id"
+	// FieldValidatorUserID holds the string denoting the validator_user_id field in the database.
+	FieldValidatorUserID = "validator_user_id"
+	// FieldWorkflowCycle holds the string denoting the workflow_cycle field in the database.
+	FieldWorkflowCycle = "workflow_cycle"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRespondedAt holds the string denoting the responded_at field in the database.
+	FieldRespondedAt = "responded_at"
+	// FieldConsultationRequestID holds the string denoting the consultation_request_id field in the database.
+	FieldConsultationRequestID = "consultation_request_id"
+	// Table holds the table name of the consultationinvitation in the database.
+	Table = "consultation_invitations"
+)
+
+// Columns holds all SQL columns for consultationinvitation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldValidationCaseID,
+	FieldOwnerUserID,
+	FieldValidatorUserID,
+	FieldWorkflowCycle,
+	FieldStatus,
+	FieldMessage,
+	FieldExpiresAt,
+	FieldRespondedAt,
+	FieldConsultationRequestID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	ValidationCaseIDValidator func(int) error
+	// OwnerUserIDValidator is a validator for the "owner_user_id" field. It is called by the builders before save.
+	OwnerUserIDValidator func(int) error
+	// ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	ValidatorUserIDValidator func(int) error
+	// DefaultWorkflowCycle holds the default value on creation for the "workflow_cycle" field.
+	DefaultWorkflowCycle int
+	// WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	WorkflowCycleValidator func(int) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultMessage holds the default value on creation for the "message" field.
+	DefaultMessage string
+	// MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	MessageValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ConsultationInvitation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByValidationCaseID orders the results by the validation_case_id field.
+func ByValidationCaseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationCaseID, opts...).ToFunc()
+}
+
+// ByOwnerUserID orders the results by the owner_user_id field.
+func ByOwnerUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerUserID, opts...).ToFunc()
+}
+
+// ByValidatorUserID orders the results by the validator_user_id field.
+func ByValidatorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatorUserID, opts...).ToFunc()
+}
+
+// ByWorkflowCycle orders the results by the workflow_cycle field.
+func ByWorkflowCycle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowCycle, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRespondedAt orders the results by the responded_at field.
+func ByRespondedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRespondedAt, opts...).ToFunc()
+}
+
+// ByConsultationRequestID orders the results by the consultation_request_id field.
+func ByConsultationRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsultationRequestID, opts...).ToFunc()
+}
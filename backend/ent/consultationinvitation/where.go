@@ -0,0 +1,700 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consultationinvitation
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// OwnerUserID applies equality check predicate on the "owner_user_id" field. It's identical to OwnerUserIDEQ.
+func OwnerUserID(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldOwnerUserID, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// WorkflowCycle applies equality check predicate on the "workflow_cycle" field. It's identical to WorkflowCycleEQ.
+func WorkflowCycle(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldMessage, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// ConsultationRequestID applies equality check predicate on the "consultation_request_id" field. It's identical to ConsultationRequestIDEQ.
+func ConsultationRequestID(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldConsultationRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// OwnerUserIDEQ applies the EQ predicate on the "owner_user_id" field.
+func OwnerUserIDEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDNEQ applies the NEQ predicate on the "owner_user_id" field.
+func OwnerUserIDNEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDIn applies the In predicate on the "owner_user_id" field.
+func OwnerUserIDIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldOwnerUserID, vs...))
+}
+
+// OwnerUserIDNotIn applies the NotIn predicate on the "owner_user_id" field.
+func OwnerUserIDNotIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldOwnerUserID, vs...))
+}
+
+// OwnerUserIDGT applies the GT predicate on the "owner_user_id" field.
+func OwnerUserIDGT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDGTE applies the GTE predicate on the "owner_user_id" field.
+func OwnerUserIDGTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDLT applies the LT predicate on the "owner_user_id" field.
+func OwnerUserIDLT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldOwnerUserID, v))
+}
+
+// OwnerUserIDLTE applies the LTE predicate on the "owner_user_id" field.
+func OwnerUserIDLTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldOwnerUserID, v))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// WorkflowCycleEQ applies the EQ predicate on the "workflow_cycle" field.
+func WorkflowCycleEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleNEQ applies the NEQ predicate on the "workflow_cycle" field.
+func WorkflowCycleNEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleIn applies the In predicate on the "workflow_cycle" field.
+func WorkflowCycleIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleNotIn applies the NotIn predicate on the "workflow_cycle" field.
+func WorkflowCycleNotIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleGT applies the GT predicate on the "workflow_cycle" field.
+func WorkflowCycleGT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleGTE applies the GTE predicate on the "workflow_cycle" field.
+func WorkflowCycleGTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLT applies the LT predicate on the "workflow_cycle" field.
+func WorkflowCycleLT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLTE applies the LTE predicate on the "workflow_cycle" field.
+func WorkflowCycleLTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldWorkflowCycle, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// ConsultationRequestIDEQ applies the EQ predicate on the "consultation_request_id" field.
+func ConsultationRequestIDEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldEQ(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDNEQ applies the NEQ predicate on the "consultation_request_id" field.
+func ConsultationRequestIDNEQ(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNEQ(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDIn applies the In predicate on the "consultation_request_id" field.
+func ConsultationRequestIDIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIn(FieldConsultationRequestID, vs...))
+}
+
+// ConsultationRequestIDNotIn applies the NotIn predicate on the "consultation_request_id" field.
+func ConsultationRequestIDNotIn(vs ...int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotIn(FieldConsultationRequestID, vs...))
+}
+
+// ConsultationRequestIDGT applies the GT predicate on the "consultation_request_id" field.
+func ConsultationRequestIDGT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGT(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDGTE applies the GTE predicate on the "consultation_request_id" field.
+func ConsultationRequestIDGTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldGTE(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDLT applies the LT predicate on the "consultation_request_id" field.
+func ConsultationRequestIDLT(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLT(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDLTE applies the LTE predicate on the "consultation_request_id" field.
+func ConsultationRequestIDLTE(v int) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldLTE(FieldConsultationRequestID, v))
+}
+
+// ConsultationRequestIDIsNil applies the IsNil predicate on the "consultation_request_id" field.
+func ConsultationRequestIDIsNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldIsNull(FieldConsultationRequestID))
+}
+
+// ConsultationRequestIDNotNil applies the NotNil predicate on the "consultation_request_id" field.
+func ConsultationRequestIDNotNil() predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.FieldNotNull(FieldConsultationRequestID))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConsultationInvitation) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConsultationInvitation) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConsultationInvitation) predicate.ConsultationInvitation {
+	return predicate.ConsultationInvitation(sql.NotPredicates(p))
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/consultationinvitation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConsultationInvitationCreate is the builder for creating a ConsultationInvitation entity.
+type ConsultationInvitationCreate struct {
+	config
+	mutation *ConsultationInvitationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConsultationInvitationCreate) SetCreatedAt(v time.Time) *ConsultationInvitationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableCreatedAt(v *time.Time) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConsultationInvitationCreate) SetUpdatedAt(v time.Time) *ConsultationInvitationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableUpdatedAt(v *time.Time) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ConsultationInvitationCreate) SetDeletedAt(v time.Time) *ConsultationInvitationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableDeletedAt(v *time.Time) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *ConsultationInvitationCreate) SetValidationCaseID(v int) *ConsultationInvitationCreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetOwnerUserID sets the "owner_user_id" field.
+func (_c *ConsultationInvitationCreate) SetOwnerUserID(v int) *ConsultationInvitationCreate {
+	_c.mutation.SetOwnerUserID(v)
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *ConsultationInvitationCreate) SetValidatorUserID(v int) *ConsultationInvitationCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_c *ConsultationInvitationCreate) SetWorkflowCycle(v int) *ConsultationInvitationCreate {
+	_c.mutation.SetWorkflowCycle(v)
+	return _c
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableWorkflowCycle(v *int) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetWorkflowCycle(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ConsultationInvitationCreate) SetStatus(v string) *ConsultationInvitationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableStatus(v *string) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ConsultationInvitationCreate) SetMessage(v string) *ConsultationInvitationCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableMessage(v *string) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ConsultationInvitationCreate) SetExpiresAt(v time.Time) *ConsultationInvitationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *ConsultationInvitationCreate) SetRespondedAt(v time.Time) *ConsultationInvitationCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableRespondedAt(v *time.Time) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetConsultationRequestID sets the "consultation_request_id" field.
+func (_c *ConsultationInvitationCreate) SetConsultationRequestID(v int) *ConsultationInvitationCreate {
+	_c.mutation.SetConsultationRequestID(v)
+	return _c
+}
+
+// SetNillableConsultationRequestID sets the "consultation_request_id" field if the given value is not nil.
+func (_c *ConsultationInvitationCreate) SetNillableConsultationRequestID(v *int) *ConsultationInvitationCreate {
+	if v != nil {
+		_c.SetConsultationRequestID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ConsultationInvitationMutation object of the builder.
+func (_c *ConsultationInvitationCreate) Mutation() *ConsultationInvitationMutation {
+	return _c.mutation
+}
+
+// Save creates the ConsultationInvitation in the database.
+func (_c *ConsultationInvitationCreate) Save(ctx context.Context) (*ConsultationInvitation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConsultationInvitationCreate) SaveX(ctx context.Context) *ConsultationInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConsultationInvitationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConsultationInvitationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConsultationInvitationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := consultationinvitation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := consultationinvitation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		v := consultationinvitation.DefaultWorkflowCycle
+		_c.mutation.SetWorkflowCycle(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := consultationinvitation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		v := consultationinvitation.DefaultMessage
+		_c.mutation.SetMessage(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConsultationInvitationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConsultationInvitation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConsultationInvitation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "ConsultationInvitation.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := consultationinvitation.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OwnerUserID(); !ok {
+		return &ValidationError{Name: "owner_user_id", err: errors.New(`ent: missing required field "ConsultationInvitation.owner_user_id"`)}
+	}
+	if v, ok := _c.mutation.OwnerUserID(); ok {
+		if err := consultationinvitation.OwnerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_user_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.owner_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "ConsultationInvitation.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := consultationinvitation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		return &ValidationError{Name: "workflow_cycle", err: errors.New(`ent: missing required field "ConsultationInvitation.workflow_cycle"`)}
+	}
+	if v, ok := _c.mutation.WorkflowCycle(); ok {
+		if err := consultationinvitation.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.workflow_cycle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ConsultationInvitation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := consultationinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := consultationinvitation.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ConsultationInvitation.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *ConsultationInvitationCreate) sqlSave(ctx context.Context) (*ConsultationInvitation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConsultationInvitationCreate) createSpec() (*ConsultationInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConsultationInvitation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(consultationinvitation.Table, sqlgraph.NewFieldSpec(consultationinvitation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(consultationinvitation.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.OwnerUserID(); ok {
+		_spec.SetField(consultationinvitation.FieldOwnerUserID, field.TypeInt, value)
+		_node.OwnerUserID = value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(consultationinvitation.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.WorkflowCycle(); ok {
+		_spec.SetField(consultationinvitation.FieldWorkflowCycle, field.TypeInt, value)
+		_node.WorkflowCycle = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(consultationinvitation.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(consultationinvitation.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(consultationinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.ConsultationRequestID(); ok {
+		_spec.SetField(consultationinvitation.FieldConsultationRequestID, field.TypeInt, value)
+		_node.ConsultationRequestID = &value
+	}
+	return _node, _spec
+}
+
+// ConsultationInvitationCreateBulk is the builder for creating many ConsultationInvitation entities in bulk.
+type ConsultationInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*ConsultationInvitationCreate
+}
+
+// Save creates the ConsultationInvitation entities in the database.
+func (_c *ConsultationInvitationCreateBulk) Save(ctx context.Context) ([]*ConsultationInvitation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConsultationInvitation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsultationInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConsultationInvitationCreateBulk) SaveX(ctx context.Context) []*ConsultationInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConsultationInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConsultationInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
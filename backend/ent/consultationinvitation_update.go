@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/consultationinvitation"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConsultationInvitationUpdate is the builder for updating ConsultationInvitation entities.
+type ConsultationInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsultationInvitationMutation
+}
+
+// Where appends a list predicates to the ConsultationInvitationUpdate builder.
+func (_u *ConsultationInvitationUpdate) Where(ps ...predicate.ConsultationInvitation) *ConsultationInvitationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConsultationInvitationUpdate) SetUpdatedAt(v time.Time) *ConsultationInvitationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ConsultationInvitationUpdate) SetDeletedAt(v time.Time) *ConsultationInvitationUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableDeletedAt(v *time.Time) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ConsultationInvitationUpdate) ClearDeletedAt() *ConsultationInvitationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *ConsultationInvitationUpdate) SetValidationCaseID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableValidationCaseID(v *int) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *ConsultationInvitationUpdate) AddValidationCaseID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetOwnerUserID sets the "owner_user_id" field.
+func (_u *ConsultationInvitationUpdate) SetOwnerUserID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.ResetOwnerUserID()
+	_u.mutation.SetOwnerUserID(v)
+	return _u
+}
+
+// SetNillableOwnerUserID sets the "owner_user_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableOwnerUserID(v *int) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetOwnerUserID(*v)
+	}
+	return _u
+}
+
+// AddOwnerUserID adds value to the "owner_user_id" field.
+func (_u *ConsultationInvitationUpdate) AddOwnerUserID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.AddOwnerUserID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ConsultationInvitationUpdate) SetValidatorUserID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableValidatorUserID(v *int) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ConsultationInvitationUpdate) AddValidatorUserID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *ConsultationInvitationUpdate) SetWorkflowCycle(v int) *ConsultationInvitationUpdate {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableWorkflowCycle(v *int) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *ConsultationInvitationUpdate) AddWorkflowCycle(v int) *ConsultationInvitationUpdate {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ConsultationInvitationUpdate) SetStatus(v string) *ConsultationInvitationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableStatus(v *string) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ConsultationInvitationUpdate) SetMessage(v string) *ConsultationInvitationUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableMessage(v *string) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ConsultationInvitationUpdate) ClearMessage() *ConsultationInvitationUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ConsultationInvitationUpdate) SetExpiresAt(v time.Time) *ConsultationInvitationUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableExpiresAt(v *time.Time) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *ConsultationInvitationUpdate) SetRespondedAt(v time.Time) *ConsultationInvitationUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableRespondedAt(v *time.Time) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *ConsultationInvitationUpdate) ClearRespondedAt() *ConsultationInvitationUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetConsultationRequestID sets the "consultation_request_id" field.
+func (_u *ConsultationInvitationUpdate) SetConsultationRequestID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.ResetConsultationRequestID()
+	_u.mutation.SetConsultationRequestID(v)
+	return _u
+}
+
+// SetNillableConsultationRequestID sets the "consultation_request_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdate) SetNillableConsultationRequestID(v *int) *ConsultationInvitationUpdate {
+	if v != nil {
+		_u.SetConsultationRequestID(*v)
+	}
+	return _u
+}
+
+// AddConsultationRequestID adds value to the "consultation_request_id" field.
+func (_u *ConsultationInvitationUpdate) AddConsultationRequestID(v int) *ConsultationInvitationUpdate {
+	_u.mutation.AddConsultationRequestID(v)
+	return _u
+}
+
+// ClearConsultationRequestID clears the value of the "consultation_request_id" field.
+func (_u *ConsultationInvitationUpdate) ClearConsultationRequestID() *ConsultationInvitationUpdate {
+	_u.mutation.ClearConsultationRequestID()
+	return _u
+}
+
+// Mutation returns the ConsultationInvitationMutation object of the builder.
+func (_u *ConsultationInvitationUpdate) Mutation() *ConsultationInvitationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConsultationInvitationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConsultationInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConsultationInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConsultationInvitationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConsultationInvitationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := consultationinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConsultationInvitationUpdate) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := consultationinvitation.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OwnerUserID(); ok {
+		if err := consultationinvitation.OwnerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_user_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.owner_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := consultationinvitation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := consultationinvitation.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := consultationinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := consultationinvitation.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConsultationInvitationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consultationinvitation.Table, consultationinvitation.Columns, sqlgraph.NewFieldSpec(consultationinvitation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(consultationinvitation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(consultationinvitation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(consultationinvitation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerUserID(); ok {
+		_spec.SetField(consultationinvitation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerUserID(); ok {
+		_spec.AddField(consultationinvitation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(consultationinvitation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(consultationinvitation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(consultationinvitation.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(consultationinvitation.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(consultationinvitation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(consultationinvitation.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(consultationinvitation.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(consultationinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(consultationinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ConsultationRequestID(); ok {
+		_spec.SetField(consultationinvitation.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsultationRequestID(); ok {
+		_spec.AddField(consultationinvitation.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if _u.mutation.ConsultationRequestIDCleared() {
+		_spec.ClearField(consultationinvitation.FieldConsultationRequestID, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consultationinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConsultationInvitationUpdateOne is the builder for updating a single ConsultationInvitation entity.
+type ConsultationInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsultationInvitationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConsultationInvitationUpdateOne) SetUpdatedAt(v time.Time) *ConsultationInvitationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ConsultationInvitationUpdateOne) SetDeletedAt(v time.Time) *ConsultationInvitationUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableDeletedAt(v *time.Time) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ConsultationInvitationUpdateOne) ClearDeletedAt() *ConsultationInvitationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *ConsultationInvitationUpdateOne) SetValidationCaseID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableValidationCaseID(v *int) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *ConsultationInvitationUpdateOne) AddValidationCaseID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetOwnerUserID sets the "owner_user_id" field.
+func (_u *ConsultationInvitationUpdateOne) SetOwnerUserID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.ResetOwnerUserID()
+	_u.mutation.SetOwnerUserID(v)
+	return _u
+}
+
+// SetNillableOwnerUserID sets the "owner_user_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableOwnerUserID(v *int) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetOwnerUserID(*v)
+	}
+	return _u
+}
+
+// AddOwnerUserID adds value to the "owner_user_id" field.
+func (_u *ConsultationInvitationUpdateOne) AddOwnerUserID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.AddOwnerUserID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ConsultationInvitationUpdateOne) SetValidatorUserID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableValidatorUserID(v *int) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ConsultationInvitationUpdateOne) AddValidatorUserID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *ConsultationInvitationUpdateOne) SetWorkflowCycle(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableWorkflowCycle(v *int) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *ConsultationInvitationUpdateOne) AddWorkflowCycle(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ConsultationInvitationUpdateOne) SetStatus(v string) *ConsultationInvitationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableStatus(v *string) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ConsultationInvitationUpdateOne) SetMessage(v string) *ConsultationInvitationUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableMessage(v *string) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ConsultationInvitationUpdateOne) ClearMessage() *ConsultationInvitationUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ConsultationInvitationUpdateOne) SetExpiresAt(v time.Time) *ConsultationInvitationUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableExpiresAt(v *time.Time) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *ConsultationInvitationUpdateOne) SetRespondedAt(v time.Time) *ConsultationInvitationUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableRespondedAt(v *time.Time) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *ConsultationInvitationUpdateOne) ClearRespondedAt() *ConsultationInvitationUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetConsultationRequestID sets the "consultation_request_id" field.
+func (_u *ConsultationInvitationUpdateOne) SetConsultationRequestID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.ResetConsultationRequestID()
+	_u.mutation.SetConsultationRequestID(v)
+	return _u
+}
+
+// SetNillableConsultationRequestID sets the "consultation_request_id" field if the given value is not nil.
+func (_u *ConsultationInvitationUpdateOne) SetNillableConsultationRequestID(v *int) *ConsultationInvitationUpdateOne {
+	if v != nil {
+		_u.SetConsultationRequestID(*v)
+	}
+	return _u
+}
+
+// AddConsultationRequestID adds value to the "consultation_request_id" field.
+func (_u *ConsultationInvitationUpdateOne) AddConsultationRequestID(v int) *ConsultationInvitationUpdateOne {
+	_u.mutation.AddConsultationRequestID(v)
+	return _u
+}
+
+// ClearConsultationRequestID clears the value of the "consultation_request_id" field.
+func (_u *ConsultationInvitationUpdateOne) ClearConsultationRequestID() *ConsultationInvitationUpdateOne {
+	_u.mutation.ClearConsultationRequestID()
+	return _u
+}
+
+// Mutation returns the ConsultationInvitationMutation object of the builder.
+func (_u *ConsultationInvitationUpdateOne) Mutation() *ConsultationInvitationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConsultationInvitationUpdate builder.
+func (_u *ConsultationInvitationUpdateOne) Where(ps ...predicate.ConsultationInvitation) *ConsultationInvitationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConsultationInvitationUpdateOne) Select(field string, fields ...string) *ConsultationInvitationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConsultationInvitation entity.
+func (_u *ConsultationInvitationUpdateOne) Save(ctx context.Context) (*ConsultationInvitation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConsultationInvitationUpdateOne) SaveX(ctx context.Context) *ConsultationInvitation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConsultationInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConsultationInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConsultationInvitationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := consultationinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConsultationInvitationUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := consultationinvitation.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OwnerUserID(); ok {
+		if err := consultationinvitation.OwnerUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_user_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.owner_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := consultationinvitation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := consultationinvitation.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := consultationinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := consultationinvitation.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ConsultationInvitation.message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ConsultationInvitationUpdateOne) sqlSave(ctx context.Context) (_node *ConsultationInvitation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consultationinvitation.Table, consultationinvitation.Columns, sqlgraph.NewFieldSpec(consultationinvitation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConsultationInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consultationinvitation.FieldID)
+		for _, f := range fields {
+			if !consultationinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consultationinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(consultationinvitation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(consultationinvitation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(consultationinvitation.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerUserID(); ok {
+		_spec.SetField(consultationinvitation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerUserID(); ok {
+		_spec.AddField(consultationinvitation.FieldOwnerUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(consultationinvitation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(consultationinvitation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(consultationinvitation.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(consultationinvitation.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(consultationinvitation.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(consultationinvitation.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(consultationinvitation.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(consultationinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(consultationinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(consultationinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ConsultationRequestID(); ok {
+		_spec.SetField(consultationinvitation.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConsultationRequestID(); ok {
+		_spec.AddField(consultationinvitation.FieldConsultationRequestID, field.TypeInt, value)
+	}
+	if _u.mutation.ConsultationRequestIDCleared() {
+		_spec.ClearField(consultationinvitation.FieldConsultationRequestID, field.TypeInt)
+	}
+	_node = &ConsultationInvitation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consultationinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
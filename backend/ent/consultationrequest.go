@@ -6,6 +6,7 @@ import (
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -45,6 +46,10 @@ type ConsultationRequest struct {
 	ReminderCount int `json:"reminder_count,omitempty"`
 	// AutoClosedReason holds the value of the "auto_closed_reason" field.
 	AutoClosedReason *string `json:"auto_closed_reason,omitempty"`
+	// SLAPolicySnapshot holds the value of the "sla_policy_snapshot" field.
+	SLAPolicySnapshot map[string]interface{} `json:"sla_policy_snapshot,omitempty"`
+	// OwnerResponseStartedAt holds the value of the "owner_response_started_at" field.
+	OwnerResponseStartedAt *time.Time `json:"owner_response_started_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ConsultationRequestQuery when eager-loading is set.
 	Edges        ConsultationRequestEdges `json:"edges"`
@@ -89,11 +94,13 @@ func (*ConsultationRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case consultationrequest.FieldSLAPolicySnapshot:
+			values[i] = new([]byte)
 		case consultationrequest.FieldID, consultationrequest.FieldValidationCaseID, consultationrequest.FieldValidatorUserID, consultationrequest.FieldWorkflowCycle, consultationrequest.FieldReminderCount:
 			values[i] = new(sql.NullInt64)
 		case consultationrequest.FieldStatus, consultationrequest.FieldAutoClosedReason:
 			values[i] = new(sql.NullString)
-		case consultationrequest.FieldCreatedAt, consultationrequest.FieldUpdatedAt, consultationrequest.FieldDeletedAt, consultationrequest.FieldApprovedAt, consultationrequest.FieldRejectedAt, consultationrequest.FieldExpiresAt, consultationrequest.FieldOwnerResponseDueAt:
+		case consultationrequest.FieldCreatedAt, consultationrequest.FieldUpdatedAt, consultationrequest.FieldDeletedAt, consultationrequest.FieldApprovedAt, consultationrequest.FieldRejectedAt, consultationrequest.FieldExpiresAt, consultationrequest.FieldOwnerResponseDueAt, consultationrequest.FieldOwnerResponseStartedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -200,6 +207,21 @@ func (_m *ConsultationRequest) assignValues(columns []string, values []any) erro
 				_m.AutoClosedReason = new(string)
 				*_m.AutoClosedReason = value.String
 			}
+		case consultationrequest.FieldSLAPolicySnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_policy_snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SLAPolicySnapshot); err != nil {
+					return fmt.Errorf("unmarshal field sla_policy_snapshot: %w", err)
+				}
+			}
+		case consultationrequest.FieldOwnerResponseStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_response_started_at", values[i])
+			} else if value.Valid {
+				_m.OwnerResponseStartedAt = new(time.Time)
+				*_m.OwnerResponseStartedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -296,6 +318,14 @@ func (_m *ConsultationRequest) String() string {
 		builder.WriteString("auto_closed_reason=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("sla_policy_snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SLAPolicySnapshot))
+	builder.WriteString(", ")
+	if v := _m.OwnerResponseStartedAt; v != nil {
+		builder.WriteString("owner_response_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -40,6 +40,10 @@ const (
 	FieldReminderCount = "reminder_count"
 	// FieldAutoClosedReason holds the string denoting the auto_closed_reason field in the database.
 	FieldAutoClosedReason = "auto_closed_reason"
+	// FieldSLAPolicySnapshot holds the string denoting the sla_policy_snapshot field in the database.
+	FieldSLAPolicySnapshot = "sla_policy_snapshot"
+	// FieldOwnerResponseStartedAt holds the string denoting the owner_response_started_at field in the database.
+	FieldOwnerResponseStartedAt = "owner_response_started_at"
 	// EdgeValidationCase holds the string denoting the validation_case edge name in mutations.
 	EdgeValidationCase = "validation_case"
 	// EdgeValidatorUser holds the string denoting the validator_user edge name in mutations.
@@ -78,6 +82,8 @@ var Columns = []string{
 	FieldOwnerResponseDueAt,
 	FieldReminderCount,
 	FieldAutoClosedReason,
+	FieldSLAPolicySnapshot,
+	FieldOwnerResponseStartedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -190,6 +196,11 @@ func ByAutoClosedReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAutoClosedReason, opts...).ToFunc()
 }
 
+// ByOwnerResponseStartedAt orders the results by the owner_response_started_at field.
+func ByOwnerResponseStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerResponseStartedAt, opts...).ToFunc()
+}
+
 // ByValidationCaseField orders the results by validation_case field.
 func ByValidationCaseField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
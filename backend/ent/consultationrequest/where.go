@@ -120,6 +120,11 @@ func AutoClosedReason(v string) predicate.ConsultationRequest {
 	return predicate.ConsultationRequest(sql.FieldEQ(FieldAutoClosedReason, v))
 }
 
+// OwnerResponseStartedAt applies equality check predicate on the "owner_response_started_at" field. It's identical to OwnerResponseStartedAtEQ.
+func OwnerResponseStartedAt(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldEQ(FieldOwnerResponseStartedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ConsultationRequest {
 	return predicate.ConsultationRequest(sql.FieldEQ(FieldCreatedAt, v))
@@ -710,6 +715,66 @@ func AutoClosedReasonContainsFold(v string) predicate.ConsultationRequest {
 	return predicate.ConsultationRequest(sql.FieldContainsFold(FieldAutoClosedReason, v))
 }
 
+// SLAPolicySnapshotIsNil applies the IsNil predicate on the "sla_policy_snapshot" field.
+func SLAPolicySnapshotIsNil() predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldIsNull(FieldSLAPolicySnapshot))
+}
+
+// SLAPolicySnapshotNotNil applies the NotNil predicate on the "sla_policy_snapshot" field.
+func SLAPolicySnapshotNotNil() predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldNotNull(FieldSLAPolicySnapshot))
+}
+
+// OwnerResponseStartedAtEQ applies the EQ predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtEQ(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldEQ(FieldOwnerResponseStartedAt, v))
+}
+
+// OwnerResponseStartedAtNEQ applies the NEQ predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtNEQ(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldNEQ(FieldOwnerResponseStartedAt, v))
+}
+
+// OwnerResponseStartedAtIn applies the In predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtIn(vs ...time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldIn(FieldOwnerResponseStartedAt, vs...))
+}
+
+// OwnerResponseStartedAtNotIn applies the NotIn predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtNotIn(vs ...time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldNotIn(FieldOwnerResponseStartedAt, vs...))
+}
+
+// OwnerResponseStartedAtGT applies the GT predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtGT(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldGT(FieldOwnerResponseStartedAt, v))
+}
+
+// OwnerResponseStartedAtGTE applies the GTE predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtGTE(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldGTE(FieldOwnerResponseStartedAt, v))
+}
+
+// OwnerResponseStartedAtLT applies the LT predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtLT(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldLT(FieldOwnerResponseStartedAt, v))
+}
+
+// OwnerResponseStartedAtLTE applies the LTE predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtLTE(v time.Time) predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldLTE(FieldOwnerResponseStartedAt, v))
+}
+
+// OwnerResponseStartedAtIsNil applies the IsNil predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtIsNil() predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldIsNull(FieldOwnerResponseStartedAt))
+}
+
+// OwnerResponseStartedAtNotNil applies the NotNil predicate on the "owner_response_started_at" field.
+func OwnerResponseStartedAtNotNil() predicate.ConsultationRequest {
+	return predicate.ConsultationRequest(sql.FieldNotNull(FieldOwnerResponseStartedAt))
+}
+
 // HasValidationCase applies the HasEdge predicate on the "validation_case" edge.
 func HasValidationCase() predicate.ConsultationRequest {
 	return predicate.ConsultationRequest(func(s *sql.Selector) {
@@ -188,6 +188,26 @@ func (_c *ConsultationRequestCreate) SetNillableAutoClosedReason(v *string) *Con
 	return _c
 }
 
+// SetSLAPolicySnapshot sets the "sla_policy_snapshot" field.
+func (_c *ConsultationRequestCreate) SetSLAPolicySnapshot(v map[string]interface{}) *ConsultationRequestCreate {
+	_c.mutation.SetSLAPolicySnapshot(v)
+	return _c
+}
+
+// SetOwnerResponseStartedAt sets the "owner_response_started_at" field.
+func (_c *ConsultationRequestCreate) SetOwnerResponseStartedAt(v time.Time) *ConsultationRequestCreate {
+	_c.mutation.SetOwnerResponseStartedAt(v)
+	return _c
+}
+
+// SetNillableOwnerResponseStartedAt sets the "owner_response_started_at" field if the given value is not nil.
+func (_c *ConsultationRequestCreate) SetNillableOwnerResponseStartedAt(v *time.Time) *ConsultationRequestCreate {
+	if v != nil {
+		_c.SetOwnerResponseStartedAt(*v)
+	}
+	return _c
+}
+
 // SetValidationCase sets the "validation_case" edge to the ValidationCase entity.
 func (_c *ConsultationRequestCreate) SetValidationCase(v *ValidationCase) *ConsultationRequestCreate {
 	return _c.SetValidationCaseID(v.ID)
@@ -384,6 +404,14 @@ func (_c *ConsultationRequestCreate) createSpec() (*ConsultationRequest, *sqlgra
 		_spec.SetField(consultationrequest.FieldAutoClosedReason, field.TypeString, value)
 		_node.AutoClosedReason = &value
 	}
+	if value, ok := _c.mutation.SLAPolicySnapshot(); ok {
+		_spec.SetField(consultationrequest.FieldSLAPolicySnapshot, field.TypeJSON, value)
+		_node.SLAPolicySnapshot = value
+	}
+	if value, ok := _c.mutation.OwnerResponseStartedAt(); ok {
+		_spec.SetField(consultationrequest.FieldOwnerResponseStartedAt, field.TypeTime, value)
+		_node.OwnerResponseStartedAt = &value
+	}
 	if nodes := _c.mutation.ValidationCaseIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -240,6 +240,38 @@ func (_u *ConsultationRequestUpdate) ClearAutoClosedReason() *ConsultationReques
 	return _u
 }
 
+// SetSLAPolicySnapshot sets the "sla_policy_snapshot" field.
+func (_u *ConsultationRequestUpdate) SetSLAPolicySnapshot(v map[string]interface{}) *ConsultationRequestUpdate {
+	_u.mutation.SetSLAPolicySnapshot(v)
+	return _u
+}
+
+// ClearSLAPolicySnapshot clears the value of the "sla_policy_snapshot" field.
+func (_u *ConsultationRequestUpdate) ClearSLAPolicySnapshot() *ConsultationRequestUpdate {
+	_u.mutation.ClearSLAPolicySnapshot()
+	return _u
+}
+
+// SetOwnerResponseStartedAt sets the "owner_response_started_at" field.
+func (_u *ConsultationRequestUpdate) SetOwnerResponseStartedAt(v time.Time) *ConsultationRequestUpdate {
+	_u.mutation.SetOwnerResponseStartedAt(v)
+	return _u
+}
+
+// SetNillableOwnerResponseStartedAt sets the "owner_response_started_at" field if the given value is not nil.
+func (_u *ConsultationRequestUpdate) SetNillableOwnerResponseStartedAt(v *time.Time) *ConsultationRequestUpdate {
+	if v != nil {
+		_u.SetOwnerResponseStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearOwnerResponseStartedAt clears the value of the "owner_response_started_at" field.
+func (_u *ConsultationRequestUpdate) ClearOwnerResponseStartedAt() *ConsultationRequestUpdate {
+	_u.mutation.ClearOwnerResponseStartedAt()
+	return _u
+}
+
 // SetValidationCase sets the "validation_case" edge to the ValidationCase entity.
 func (_u *ConsultationRequestUpdate) SetValidationCase(v *ValidationCase) *ConsultationRequestUpdate {
 	return _u.SetValidationCaseID(v.ID)
@@ -410,6 +442,18 @@ func (_u *ConsultationRequestUpdate) sqlSave(ctx context.Context) (_node int, er
 	if _u.mutation.AutoClosedReasonCleared() {
 		_spec.ClearField(consultationrequest.FieldAutoClosedReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.SLAPolicySnapshot(); ok {
+		_spec.SetField(consultationrequest.FieldSLAPolicySnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.SLAPolicySnapshotCleared() {
+		_spec.ClearField(consultationrequest.FieldSLAPolicySnapshot, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OwnerResponseStartedAt(); ok {
+		_spec.SetField(consultationrequest.FieldOwnerResponseStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerResponseStartedAtCleared() {
+		_spec.ClearField(consultationrequest.FieldOwnerResponseStartedAt, field.TypeTime)
+	}
 	if _u.mutation.ValidationCaseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -698,6 +742,38 @@ func (_u *ConsultationRequestUpdateOne) ClearAutoClosedReason() *ConsultationReq
 	return _u
 }
 
+// SetSLAPolicySnapshot sets the "sla_policy_snapshot" field.
+func (_u *ConsultationRequestUpdateOne) SetSLAPolicySnapshot(v map[string]interface{}) *ConsultationRequestUpdateOne {
+	_u.mutation.SetSLAPolicySnapshot(v)
+	return _u
+}
+
+// ClearSLAPolicySnapshot clears the value of the "sla_policy_snapshot" field.
+func (_u *ConsultationRequestUpdateOne) ClearSLAPolicySnapshot() *ConsultationRequestUpdateOne {
+	_u.mutation.ClearSLAPolicySnapshot()
+	return _u
+}
+
+// SetOwnerResponseStartedAt sets the "owner_response_started_at" field.
+func (_u *ConsultationRequestUpdateOne) SetOwnerResponseStartedAt(v time.Time) *ConsultationRequestUpdateOne {
+	_u.mutation.SetOwnerResponseStartedAt(v)
+	return _u
+}
+
+// SetNillableOwnerResponseStartedAt sets the "owner_response_started_at" field if the given value is not nil.
+func (_u *ConsultationRequestUpdateOne) SetNillableOwnerResponseStartedAt(v *time.Time) *ConsultationRequestUpdateOne {
+	if v != nil {
+		_u.SetOwnerResponseStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearOwnerResponseStartedAt clears the value of the "owner_response_started_at" field.
+func (_u *ConsultationRequestUpdateOne) ClearOwnerResponseStartedAt() *ConsultationRequestUpdateOne {
+	_u.mutation.ClearOwnerResponseStartedAt()
+	return _u
+}
+
 // SetValidationCase sets the "validation_case" edge to the ValidationCase entity.
 func (_u *ConsultationRequestUpdateOne) SetValidationCase(v *ValidationCase) *ConsultationRequestUpdateOne {
 	return _u.SetValidationCaseID(v.ID)
@@ -898,6 +974,18 @@ func (_u *ConsultationRequestUpdateOne) sqlSave(ctx context.Context) (_node *Con
 	if _u.mutation.AutoClosedReasonCleared() {
 		_spec.ClearField(consultationrequest.FieldAutoClosedReason, field.TypeString)
 	}
+	if value, ok := _u.mutation.SLAPolicySnapshot(); ok {
+		_spec.SetField(consultationrequest.FieldSLAPolicySnapshot, field.TypeJSON, value)
+	}
+	if _u.mutation.SLAPolicySnapshotCleared() {
+		_spec.ClearField(consultationrequest.FieldSLAPolicySnapshot, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OwnerResponseStartedAt(); ok {
+		_spec.SetField(consultationrequest.FieldOwnerResponseStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerResponseStartedAtCleared() {
+		_spec.ClearField(consultationrequest.FieldOwnerResponseStartedAt, field.TypeTime)
+	}
 	if _u.mutation.ValidationCaseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
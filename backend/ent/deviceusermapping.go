@@ -32,6 +32,18 @@ type DeviceUserMapping struct {
 	FirstSeenAt time.Time `json:"first_seen_at,omitempty"`
 	// LastSeenAt holds the value of the "last_seen_at" field.
 	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
+	// LastIP holds the value of the "last_ip" field.
+	LastIP string `json:"last_ip,omitempty"`
+	// LastUserAgent holds the value of the "last_user_agent" field.
+	LastUserAgent string `json:"last_user_agent,omitempty"`
+	// Name holds the value of the "name" field.
+	Name *string `json:"name,omitempty"`
+	// Trusted holds the value of the "trusted" field.
+	Trusted bool `json:"trusted,omitempty"`
+	// TrustedAt holds the value of the "trusted_at" field.
+	TrustedAt *time.Time `json:"trusted_at,omitempty"`
+	// RemovedAt holds the value of the "removed_at" field.
+	RemovedAt *time.Time `json:"removed_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeviceUserMappingQuery when eager-loading is set.
 	Edges        DeviceUserMappingEdges `json:"edges"`
@@ -63,11 +75,13 @@ func (*DeviceUserMapping) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case deviceusermapping.FieldTrusted:
+			values[i] = new(sql.NullBool)
 		case deviceusermapping.FieldID, deviceusermapping.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case deviceusermapping.FieldFingerprintHash:
+		case deviceusermapping.FieldFingerprintHash, deviceusermapping.FieldLastIP, deviceusermapping.FieldLastUserAgent, deviceusermapping.FieldName:
 			values[i] = new(sql.NullString)
-		case deviceusermapping.FieldCreatedAt, deviceusermapping.FieldUpdatedAt, deviceusermapping.FieldDeletedAt, deviceusermapping.FieldFirstSeenAt, deviceusermapping.FieldLastSeenAt:
+		case deviceusermapping.FieldCreatedAt, deviceusermapping.FieldUpdatedAt, deviceusermapping.FieldDeletedAt, deviceusermapping.FieldFirstSeenAt, deviceusermapping.FieldLastSeenAt, deviceusermapping.FieldTrustedAt, deviceusermapping.FieldRemovedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -133,6 +147,45 @@ func (_m *DeviceUserMapping) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.LastSeenAt = value.Time
 			}
+		case deviceusermapping.FieldLastIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_ip", values[i])
+			} else if value.Valid {
+				_m.LastIP = value.String
+			}
+		case deviceusermapping.FieldLastUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_user_agent", values[i])
+			} else if value.Valid {
+				_m.LastUserAgent = value.String
+			}
+		case deviceusermapping.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = new(string)
+				*_m.Name = value.String
+			}
+		case deviceusermapping.FieldTrusted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trusted", values[i])
+			} else if value.Valid {
+				_m.Trusted = value.Bool
+			}
+		case deviceusermapping.FieldTrustedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trusted_at", values[i])
+			} else if value.Valid {
+				_m.TrustedAt = new(time.Time)
+				*_m.TrustedAt = value.Time
+			}
+		case deviceusermapping.FieldRemovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field removed_at", values[i])
+			} else if value.Valid {
+				_m.RemovedAt = new(time.Time)
+				*_m.RemovedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -196,6 +249,30 @@ func (_m *DeviceUserMapping) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("last_seen_at=")
 	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_ip=")
+	builder.WriteString(_m.LastIP)
+	builder.WriteString(", ")
+	builder.WriteString("last_user_agent=")
+	builder.WriteString(_m.LastUserAgent)
+	builder.WriteString(", ")
+	if v := _m.Name; v != nil {
+		builder.WriteString("name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trusted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Trusted))
+	builder.WriteString(", ")
+	if v := _m.TrustedAt; v != nil {
+		builder.WriteString("trusted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RemovedAt; v != nil {
+		builder.WriteString("removed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,18 @@ const (
 	FieldFirstSeenAt = "first_seen_at"
 	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
 	FieldLastSeenAt = "last_seen_at"
+	// FieldLastIP holds the string denoting the last_ip field in the database.
+	FieldLastIP = "last_ip"
+	// FieldLastUserAgent holds the string denoting the last_user_agent field in the database.
+	FieldLastUserAgent = "last_user_agent"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTrusted holds the string denoting the trusted field in the database.
+	FieldTrusted = "trusted"
+	// FieldTrustedAt holds the string denoting the trusted_at field in the database.
+	FieldTrustedAt = "trusted_at"
+	// FieldRemovedAt holds the string denoting the removed_at field in the database.
+	FieldRemovedAt = "removed_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the deviceusermapping in the database.
@@ -51,6 +63,12 @@ var Columns = []string{
 	FieldUserID,
 	FieldFirstSeenAt,
 	FieldLastSeenAt,
+	FieldLastIP,
+	FieldLastUserAgent,
+	FieldName,
+	FieldTrusted,
+	FieldTrustedAt,
+	FieldRemovedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -78,6 +96,14 @@ var (
 	DefaultFirstSeenAt func() time.Time
 	// DefaultLastSeenAt holds the default value on creation for the "last_seen_at" field.
 	DefaultLastSeenAt func() time.Time
+	// LastIPValidator is a validator for the "last_ip" field. It is called by the builders before save.
+	LastIPValidator func(string) error
+	// LastUserAgentValidator is a validator for the "last_user_agent" field. It is called by the builders before save.
+	LastUserAgentValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultTrusted holds the default value on creation for the "trusted" field.
+	DefaultTrusted bool
 )
 
 // OrderOption defines the ordering options for the DeviceUserMapping queries.
@@ -123,6 +149,36 @@ func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
 }
 
+// ByLastIP orders the results by the last_ip field.
+func ByLastIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastIP, opts...).ToFunc()
+}
+
+// ByLastUserAgent orders the results by the last_user_agent field.
+func ByLastUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUserAgent, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByTrusted orders the results by the trusted field.
+func ByTrusted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrusted, opts...).ToFunc()
+}
+
+// ByTrustedAt orders the results by the trusted_at field.
+func ByTrustedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrustedAt, opts...).ToFunc()
+}
+
+// ByRemovedAt orders the results by the removed_at field.
+func ByRemovedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemovedAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
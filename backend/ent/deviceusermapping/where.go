@@ -90,6 +90,36 @@ func LastSeenAt(v time.Time) predicate.DeviceUserMapping {
 	return predicate.DeviceUserMapping(sql.FieldEQ(FieldLastSeenAt, v))
 }
 
+// LastIP applies equality check predicate on the "last_ip" field. It's identical to LastIPEQ.
+func LastIP(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldLastIP, v))
+}
+
+// LastUserAgent applies equality check predicate on the "last_user_agent" field. It's identical to LastUserAgentEQ.
+func LastUserAgent(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldLastUserAgent, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldName, v))
+}
+
+// Trusted applies equality check predicate on the "trusted" field. It's identical to TrustedEQ.
+func Trusted(v bool) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldTrusted, v))
+}
+
+// TrustedAt applies equality check predicate on the "trusted_at" field. It's identical to TrustedAtEQ.
+func TrustedAt(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldTrustedAt, v))
+}
+
+// RemovedAt applies equality check predicate on the "removed_at" field. It's identical to RemovedAtEQ.
+func RemovedAt(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldRemovedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.DeviceUserMapping {
 	return predicate.DeviceUserMapping(sql.FieldEQ(FieldCreatedAt, v))
@@ -385,6 +415,341 @@ func LastSeenAtLTE(v time.Time) predicate.DeviceUserMapping {
 	return predicate.DeviceUserMapping(sql.FieldLTE(FieldLastSeenAt, v))
 }
 
+// LastIPEQ applies the EQ predicate on the "last_ip" field.
+func LastIPEQ(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldLastIP, v))
+}
+
+// LastIPNEQ applies the NEQ predicate on the "last_ip" field.
+func LastIPNEQ(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNEQ(FieldLastIP, v))
+}
+
+// LastIPIn applies the In predicate on the "last_ip" field.
+func LastIPIn(vs ...string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIn(FieldLastIP, vs...))
+}
+
+// LastIPNotIn applies the NotIn predicate on the "last_ip" field.
+func LastIPNotIn(vs ...string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotIn(FieldLastIP, vs...))
+}
+
+// LastIPGT applies the GT predicate on the "last_ip" field.
+func LastIPGT(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGT(FieldLastIP, v))
+}
+
+// LastIPGTE applies the GTE predicate on the "last_ip" field.
+func LastIPGTE(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGTE(FieldLastIP, v))
+}
+
+// LastIPLT applies the LT predicate on the "last_ip" field.
+func LastIPLT(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLT(FieldLastIP, v))
+}
+
+// LastIPLTE applies the LTE predicate on the "last_ip" field.
+func LastIPLTE(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLTE(FieldLastIP, v))
+}
+
+// LastIPContains applies the Contains predicate on the "last_ip" field.
+func LastIPContains(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldContains(FieldLastIP, v))
+}
+
+// LastIPHasPrefix applies the HasPrefix predicate on the "last_ip" field.
+func LastIPHasPrefix(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldHasPrefix(FieldLastIP, v))
+}
+
+// LastIPHasSuffix applies the HasSuffix predicate on the "last_ip" field.
+func LastIPHasSuffix(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldHasSuffix(FieldLastIP, v))
+}
+
+// LastIPIsNil applies the IsNil predicate on the "last_ip" field.
+func LastIPIsNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIsNull(FieldLastIP))
+}
+
+// LastIPNotNil applies the NotNil predicate on the "last_ip" field.
+func LastIPNotNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotNull(FieldLastIP))
+}
+
+// LastIPEqualFold applies the EqualFold predicate on the "last_ip" field.
+func LastIPEqualFold(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEqualFold(FieldLastIP, v))
+}
+
+// LastIPContainsFold applies the ContainsFold predicate on the "last_ip" field.
+func LastIPContainsFold(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldContainsFold(FieldLastIP, v))
+}
+
+// LastUserAgentEQ applies the EQ predicate on the "last_user_agent" field.
+func LastUserAgentEQ(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldLastUserAgent, v))
+}
+
+// LastUserAgentNEQ applies the NEQ predicate on the "last_user_agent" field.
+func LastUserAgentNEQ(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNEQ(FieldLastUserAgent, v))
+}
+
+// LastUserAgentIn applies the In predicate on the "last_user_agent" field.
+func LastUserAgentIn(vs ...string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIn(FieldLastUserAgent, vs...))
+}
+
+// LastUserAgentNotIn applies the NotIn predicate on the "last_user_agent" field.
+func LastUserAgentNotIn(vs ...string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotIn(FieldLastUserAgent, vs...))
+}
+
+// LastUserAgentGT applies the GT predicate on the "last_user_agent" field.
+func LastUserAgentGT(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGT(FieldLastUserAgent, v))
+}
+
+// LastUserAgentGTE applies the GTE predicate on the "last_user_agent" field.
+func LastUserAgentGTE(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGTE(FieldLastUserAgent, v))
+}
+
+// LastUserAgentLT applies the LT predicate on the "last_user_agent" field.
+func LastUserAgentLT(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLT(FieldLastUserAgent, v))
+}
+
+// LastUserAgentLTE applies the LTE predicate on the "last_user_agent" field.
+func LastUserAgentLTE(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLTE(FieldLastUserAgent, v))
+}
+
+// LastUserAgentContains applies the Contains predicate on the "last_user_agent" field.
+func LastUserAgentContains(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldContains(FieldLastUserAgent, v))
+}
+
+// LastUserAgentHasPrefix applies the HasPrefix predicate on the "last_user_agent" field.
+func LastUserAgentHasPrefix(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldHasPrefix(FieldLastUserAgent, v))
+}
+
+// LastUserAgentHasSuffix applies the HasSuffix predicate on the "last_user_agent" field.
+func LastUserAgentHasSuffix(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldHasSuffix(FieldLastUserAgent, v))
+}
+
+// LastUserAgentIsNil applies the IsNil predicate on the "last_user_agent" field.
+func LastUserAgentIsNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIsNull(FieldLastUserAgent))
+}
+
+// LastUserAgentNotNil applies the NotNil predicate on the "last_user_agent" field.
+func LastUserAgentNotNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotNull(FieldLastUserAgent))
+}
+
+// LastUserAgentEqualFold applies the EqualFold predicate on the "last_user_agent" field.
+func LastUserAgentEqualFold(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEqualFold(FieldLastUserAgent, v))
+}
+
+// LastUserAgentContainsFold applies the ContainsFold predicate on the "last_user_agent" field.
+func LastUserAgentContainsFold(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldContainsFold(FieldLastUserAgent, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldContainsFold(FieldName, v))
+}
+
+// TrustedEQ applies the EQ predicate on the "trusted" field.
+func TrustedEQ(v bool) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldTrusted, v))
+}
+
+// TrustedNEQ applies the NEQ predicate on the "trusted" field.
+func TrustedNEQ(v bool) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNEQ(FieldTrusted, v))
+}
+
+// TrustedAtEQ applies the EQ predicate on the "trusted_at" field.
+func TrustedAtEQ(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldTrustedAt, v))
+}
+
+// TrustedAtNEQ applies the NEQ predicate on the "trusted_at" field.
+func TrustedAtNEQ(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNEQ(FieldTrustedAt, v))
+}
+
+// TrustedAtIn applies the In predicate on the "trusted_at" field.
+func TrustedAtIn(vs ...time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIn(FieldTrustedAt, vs...))
+}
+
+// TrustedAtNotIn applies the NotIn predicate on the "trusted_at" field.
+func TrustedAtNotIn(vs ...time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotIn(FieldTrustedAt, vs...))
+}
+
+// TrustedAtGT applies the GT predicate on the "trusted_at" field.
+func TrustedAtGT(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGT(FieldTrustedAt, v))
+}
+
+// TrustedAtGTE applies the GTE predicate on the "trusted_at" field.
+func TrustedAtGTE(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGTE(FieldTrustedAt, v))
+}
+
+// TrustedAtLT applies the LT predicate on the "trusted_at" field.
+func TrustedAtLT(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLT(FieldTrustedAt, v))
+}
+
+// TrustedAtLTE applies the LTE predicate on the "trusted_at" field.
+func TrustedAtLTE(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLTE(FieldTrustedAt, v))
+}
+
+// TrustedAtIsNil applies the IsNil predicate on the "trusted_at" field.
+func TrustedAtIsNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIsNull(FieldTrustedAt))
+}
+
+// TrustedAtNotNil applies the NotNil predicate on the "trusted_at" field.
+func TrustedAtNotNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotNull(FieldTrustedAt))
+}
+
+// RemovedAtEQ applies the EQ predicate on the "removed_at" field.
+func RemovedAtEQ(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldEQ(FieldRemovedAt, v))
+}
+
+// RemovedAtNEQ applies the NEQ predicate on the "removed_at" field.
+func RemovedAtNEQ(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNEQ(FieldRemovedAt, v))
+}
+
+// RemovedAtIn applies the In predicate on the "removed_at" field.
+func RemovedAtIn(vs ...time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIn(FieldRemovedAt, vs...))
+}
+
+// RemovedAtNotIn applies the NotIn predicate on the "removed_at" field.
+func RemovedAtNotIn(vs ...time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotIn(FieldRemovedAt, vs...))
+}
+
+// RemovedAtGT applies the GT predicate on the "removed_at" field.
+func RemovedAtGT(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGT(FieldRemovedAt, v))
+}
+
+// RemovedAtGTE applies the GTE predicate on the "removed_at" field.
+func RemovedAtGTE(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldGTE(FieldRemovedAt, v))
+}
+
+// RemovedAtLT applies the LT predicate on the "removed_at" field.
+func RemovedAtLT(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLT(FieldRemovedAt, v))
+}
+
+// RemovedAtLTE applies the LTE predicate on the "removed_at" field.
+func RemovedAtLTE(v time.Time) predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldLTE(FieldRemovedAt, v))
+}
+
+// RemovedAtIsNil applies the IsNil predicate on the "removed_at" field.
+func RemovedAtIsNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldIsNull(FieldRemovedAt))
+}
+
+// RemovedAtNotNil applies the NotNil predicate on the "removed_at" field.
+func RemovedAtNotNil() predicate.DeviceUserMapping {
+	return predicate.DeviceUserMapping(sql.FieldNotNull(FieldRemovedAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.DeviceUserMapping {
 	return predicate.DeviceUserMapping(func(s *sql.Selector) {
@@ -103,6 +103,90 @@ func (_c *DeviceUserMappingCreate) SetNillableLastSeenAt(v *time.Time) *DeviceUs
 	return _c
 }
 
+// SetLastIP sets the "last_ip" field.
+func (_c *DeviceUserMappingCreate) SetLastIP(v string) *DeviceUserMappingCreate {
+	_c.mutation.SetLastIP(v)
+	return _c
+}
+
+// SetNillableLastIP sets the "last_ip" field if the given value is not nil.
+func (_c *DeviceUserMappingCreate) SetNillableLastIP(v *string) *DeviceUserMappingCreate {
+	if v != nil {
+		_c.SetLastIP(*v)
+	}
+	return _c
+}
+
+// SetLastUserAgent sets the "last_user_agent" field.
+func (_c *DeviceUserMappingCreate) SetLastUserAgent(v string) *DeviceUserMappingCreate {
+	_c.mutation.SetLastUserAgent(v)
+	return _c
+}
+
+// SetNillableLastUserAgent sets the "last_user_agent" field if the given value is not nil.
+func (_c *DeviceUserMappingCreate) SetNillableLastUserAgent(v *string) *DeviceUserMappingCreate {
+	if v != nil {
+		_c.SetLastUserAgent(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DeviceUserMappingCreate) SetName(v string) *DeviceUserMappingCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *DeviceUserMappingCreate) SetNillableName(v *string) *DeviceUserMappingCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetTrusted sets the "trusted" field.
+func (_c *DeviceUserMappingCreate) SetTrusted(v bool) *DeviceUserMappingCreate {
+	_c.mutation.SetTrusted(v)
+	return _c
+}
+
+// SetNillableTrusted sets the "trusted" field if the given value is not nil.
+func (_c *DeviceUserMappingCreate) SetNillableTrusted(v *bool) *DeviceUserMappingCreate {
+	if v != nil {
+		_c.SetTrusted(*v)
+	}
+	return _c
+}
+
+// SetTrustedAt sets the "trusted_at" field.
+func (_c *DeviceUserMappingCreate) SetTrustedAt(v time.Time) *DeviceUserMappingCreate {
+	_c.mutation.SetTrustedAt(v)
+	return _c
+}
+
+// SetNillableTrustedAt sets the "trusted_at" field if the given value is not nil.
+func (_c *DeviceUserMappingCreate) SetNillableTrustedAt(v *time.Time) *DeviceUserMappingCreate {
+	if v != nil {
+		_c.SetTrustedAt(*v)
+	}
+	return _c
+}
+
+// SetRemovedAt sets the "removed_at" field.
+func (_c *DeviceUserMappingCreate) SetRemovedAt(v time.Time) *DeviceUserMappingCreate {
+	_c.mutation.SetRemovedAt(v)
+	return _c
+}
+
+// SetNillableRemovedAt sets the "removed_at" field if the given value is not nil.
+func (_c *DeviceUserMappingCreate) SetNillableRemovedAt(v *time.Time) *DeviceUserMappingCreate {
+	if v != nil {
+		_c.SetRemovedAt(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *DeviceUserMappingCreate) SetUser(v *User) *DeviceUserMappingCreate {
 	return _c.SetUserID(v.ID)
@@ -159,6 +243,10 @@ func (_c *DeviceUserMappingCreate) defaults() {
 		v := deviceusermapping.DefaultLastSeenAt()
 		_c.mutation.SetLastSeenAt(v)
 	}
+	if _, ok := _c.mutation.Trusted(); !ok {
+		v := deviceusermapping.DefaultTrusted
+		_c.mutation.SetTrusted(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -191,6 +279,24 @@ func (_c *DeviceUserMappingCreate) check() error {
 	if _, ok := _c.mutation.LastSeenAt(); !ok {
 		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "DeviceUserMapping.last_seen_at"`)}
 	}
+	if v, ok := _c.mutation.LastIP(); ok {
+		if err := deviceusermapping.LastIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_ip", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.last_ip": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastUserAgent(); ok {
+		if err := deviceusermapping.LastUserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "last_user_agent", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.last_user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := deviceusermapping.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Trusted(); !ok {
+		return &ValidationError{Name: "trusted", err: errors.New(`ent: missing required field "DeviceUserMapping.trusted"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DeviceUserMapping.user"`)}
 	}
@@ -244,6 +350,30 @@ func (_c *DeviceUserMappingCreate) createSpec() (*DeviceUserMapping, *sqlgraph.C
 		_spec.SetField(deviceusermapping.FieldLastSeenAt, field.TypeTime, value)
 		_node.LastSeenAt = value
 	}
+	if value, ok := _c.mutation.LastIP(); ok {
+		_spec.SetField(deviceusermapping.FieldLastIP, field.TypeString, value)
+		_node.LastIP = value
+	}
+	if value, ok := _c.mutation.LastUserAgent(); ok {
+		_spec.SetField(deviceusermapping.FieldLastUserAgent, field.TypeString, value)
+		_node.LastUserAgent = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(deviceusermapping.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.Trusted(); ok {
+		_spec.SetField(deviceusermapping.FieldTrusted, field.TypeBool, value)
+		_node.Trusted = value
+	}
+	if value, ok := _c.mutation.TrustedAt(); ok {
+		_spec.SetField(deviceusermapping.FieldTrustedAt, field.TypeTime, value)
+		_node.TrustedAt = &value
+	}
+	if value, ok := _c.mutation.RemovedAt(); ok {
+		_spec.SetField(deviceusermapping.FieldRemovedAt, field.TypeTime, value)
+		_node.RemovedAt = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
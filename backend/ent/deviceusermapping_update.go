@@ -111,6 +111,120 @@ func (_u *DeviceUserMappingUpdate) SetNillableLastSeenAt(v *time.Time) *DeviceUs
 	return _u
 }
 
+// SetLastIP sets the "last_ip" field.
+func (_u *DeviceUserMappingUpdate) SetLastIP(v string) *DeviceUserMappingUpdate {
+	_u.mutation.SetLastIP(v)
+	return _u
+}
+
+// SetNillableLastIP sets the "last_ip" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdate) SetNillableLastIP(v *string) *DeviceUserMappingUpdate {
+	if v != nil {
+		_u.SetLastIP(*v)
+	}
+	return _u
+}
+
+// ClearLastIP clears the value of the "last_ip" field.
+func (_u *DeviceUserMappingUpdate) ClearLastIP() *DeviceUserMappingUpdate {
+	_u.mutation.ClearLastIP()
+	return _u
+}
+
+// SetLastUserAgent sets the "last_user_agent" field.
+func (_u *DeviceUserMappingUpdate) SetLastUserAgent(v string) *DeviceUserMappingUpdate {
+	_u.mutation.SetLastUserAgent(v)
+	return _u
+}
+
+// SetNillableLastUserAgent sets the "last_user_agent" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdate) SetNillableLastUserAgent(v *string) *DeviceUserMappingUpdate {
+	if v != nil {
+		_u.SetLastUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearLastUserAgent clears the value of the "last_user_agent" field.
+func (_u *DeviceUserMappingUpdate) ClearLastUserAgent() *DeviceUserMappingUpdate {
+	_u.mutation.ClearLastUserAgent()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DeviceUserMappingUpdate) SetName(v string) *DeviceUserMappingUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdate) SetNillableName(v *string) *DeviceUserMappingUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *DeviceUserMappingUpdate) ClearName() *DeviceUserMappingUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetTrusted sets the "trusted" field.
+func (_u *DeviceUserMappingUpdate) SetTrusted(v bool) *DeviceUserMappingUpdate {
+	_u.mutation.SetTrusted(v)
+	return _u
+}
+
+// SetNillableTrusted sets the "trusted" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdate) SetNillableTrusted(v *bool) *DeviceUserMappingUpdate {
+	if v != nil {
+		_u.SetTrusted(*v)
+	}
+	return _u
+}
+
+// SetTrustedAt sets the "trusted_at" field.
+func (_u *DeviceUserMappingUpdate) SetTrustedAt(v time.Time) *DeviceUserMappingUpdate {
+	_u.mutation.SetTrustedAt(v)
+	return _u
+}
+
+// SetNillableTrustedAt sets the "trusted_at" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdate) SetNillableTrustedAt(v *time.Time) *DeviceUserMappingUpdate {
+	if v != nil {
+		_u.SetTrustedAt(*v)
+	}
+	return _u
+}
+
+// ClearTrustedAt clears the value of the "trusted_at" field.
+func (_u *DeviceUserMappingUpdate) ClearTrustedAt() *DeviceUserMappingUpdate {
+	_u.mutation.ClearTrustedAt()
+	return _u
+}
+
+// SetRemovedAt sets the "removed_at" field.
+func (_u *DeviceUserMappingUpdate) SetRemovedAt(v time.Time) *DeviceUserMappingUpdate {
+	_u.mutation.SetRemovedAt(v)
+	return _u
+}
+
+// SetNillableRemovedAt sets the "removed_at" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdate) SetNillableRemovedAt(v *time.Time) *DeviceUserMappingUpdate {
+	if v != nil {
+		_u.SetRemovedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemovedAt clears the value of the "removed_at" field.
+func (_u *DeviceUserMappingUpdate) ClearRemovedAt() *DeviceUserMappingUpdate {
+	_u.mutation.ClearRemovedAt()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *DeviceUserMappingUpdate) SetUser(v *User) *DeviceUserMappingUpdate {
 	return _u.SetUserID(v.ID)
@@ -175,6 +289,21 @@ func (_u *DeviceUserMappingUpdate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.user_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LastIP(); ok {
+		if err := deviceusermapping.LastIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_ip", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.last_ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastUserAgent(); ok {
+		if err := deviceusermapping.LastUserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "last_user_agent", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.last_user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := deviceusermapping.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.name": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "DeviceUserMapping.user"`)
 	}
@@ -211,6 +340,39 @@ func (_u *DeviceUserMappingUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.LastSeenAt(); ok {
 		_spec.SetField(deviceusermapping.FieldLastSeenAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.LastIP(); ok {
+		_spec.SetField(deviceusermapping.FieldLastIP, field.TypeString, value)
+	}
+	if _u.mutation.LastIPCleared() {
+		_spec.ClearField(deviceusermapping.FieldLastIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUserAgent(); ok {
+		_spec.SetField(deviceusermapping.FieldLastUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.LastUserAgentCleared() {
+		_spec.ClearField(deviceusermapping.FieldLastUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(deviceusermapping.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(deviceusermapping.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Trusted(); ok {
+		_spec.SetField(deviceusermapping.FieldTrusted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TrustedAt(); ok {
+		_spec.SetField(deviceusermapping.FieldTrustedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrustedAtCleared() {
+		_spec.ClearField(deviceusermapping.FieldTrustedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemovedAt(); ok {
+		_spec.SetField(deviceusermapping.FieldRemovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemovedAtCleared() {
+		_spec.ClearField(deviceusermapping.FieldRemovedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -342,6 +504,120 @@ func (_u *DeviceUserMappingUpdateOne) SetNillableLastSeenAt(v *time.Time) *Devic
 	return _u
 }
 
+// SetLastIP sets the "last_ip" field.
+func (_u *DeviceUserMappingUpdateOne) SetLastIP(v string) *DeviceUserMappingUpdateOne {
+	_u.mutation.SetLastIP(v)
+	return _u
+}
+
+// SetNillableLastIP sets the "last_ip" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdateOne) SetNillableLastIP(v *string) *DeviceUserMappingUpdateOne {
+	if v != nil {
+		_u.SetLastIP(*v)
+	}
+	return _u
+}
+
+// ClearLastIP clears the value of the "last_ip" field.
+func (_u *DeviceUserMappingUpdateOne) ClearLastIP() *DeviceUserMappingUpdateOne {
+	_u.mutation.ClearLastIP()
+	return _u
+}
+
+// SetLastUserAgent sets the "last_user_agent" field.
+func (_u *DeviceUserMappingUpdateOne) SetLastUserAgent(v string) *DeviceUserMappingUpdateOne {
+	_u.mutation.SetLastUserAgent(v)
+	return _u
+}
+
+// SetNillableLastUserAgent sets the "last_user_agent" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdateOne) SetNillableLastUserAgent(v *string) *DeviceUserMappingUpdateOne {
+	if v != nil {
+		_u.SetLastUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearLastUserAgent clears the value of the "last_user_agent" field.
+func (_u *DeviceUserMappingUpdateOne) ClearLastUserAgent() *DeviceUserMappingUpdateOne {
+	_u.mutation.ClearLastUserAgent()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DeviceUserMappingUpdateOne) SetName(v string) *DeviceUserMappingUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdateOne) SetNillableName(v *string) *DeviceUserMappingUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *DeviceUserMappingUpdateOne) ClearName() *DeviceUserMappingUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetTrusted sets the "trusted" field.
+func (_u *DeviceUserMappingUpdateOne) SetTrusted(v bool) *DeviceUserMappingUpdateOne {
+	_u.mutation.SetTrusted(v)
+	return _u
+}
+
+// SetNillableTrusted sets the "trusted" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdateOne) SetNillableTrusted(v *bool) *DeviceUserMappingUpdateOne {
+	if v != nil {
+		_u.SetTrusted(*v)
+	}
+	return _u
+}
+
+// SetTrustedAt sets the "trusted_at" field.
+func (_u *DeviceUserMappingUpdateOne) SetTrustedAt(v time.Time) *DeviceUserMappingUpdateOne {
+	_u.mutation.SetTrustedAt(v)
+	return _u
+}
+
+// SetNillableTrustedAt sets the "trusted_at" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdateOne) SetNillableTrustedAt(v *time.Time) *DeviceUserMappingUpdateOne {
+	if v != nil {
+		_u.SetTrustedAt(*v)
+	}
+	return _u
+}
+
+// ClearTrustedAt clears the value of the "trusted_at" field.
+func (_u *DeviceUserMappingUpdateOne) ClearTrustedAt() *DeviceUserMappingUpdateOne {
+	_u.mutation.ClearTrustedAt()
+	return _u
+}
+
+// SetRemovedAt sets the "removed_at" field.
+func (_u *DeviceUserMappingUpdateOne) SetRemovedAt(v time.Time) *DeviceUserMappingUpdateOne {
+	_u.mutation.SetRemovedAt(v)
+	return _u
+}
+
+// SetNillableRemovedAt sets the "removed_at" field if the given value is not nil.
+func (_u *DeviceUserMappingUpdateOne) SetNillableRemovedAt(v *time.Time) *DeviceUserMappingUpdateOne {
+	if v != nil {
+		_u.SetRemovedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemovedAt clears the value of the "removed_at" field.
+func (_u *DeviceUserMappingUpdateOne) ClearRemovedAt() *DeviceUserMappingUpdateOne {
+	_u.mutation.ClearRemovedAt()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *DeviceUserMappingUpdateOne) SetUser(v *User) *DeviceUserMappingUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -419,6 +695,21 @@ func (_u *DeviceUserMappingUpdateOne) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.user_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.LastIP(); ok {
+		if err := deviceusermapping.LastIPValidator(v); err != nil {
+			return &ValidationError{Name: "last_ip", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.last_ip": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastUserAgent(); ok {
+		if err := deviceusermapping.LastUserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "last_user_agent", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.last_user_agent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := deviceusermapping.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeviceUserMapping.name": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "DeviceUserMapping.user"`)
 	}
@@ -472,6 +763,39 @@ func (_u *DeviceUserMappingUpdateOne) sqlSave(ctx context.Context) (_node *Devic
 	if value, ok := _u.mutation.LastSeenAt(); ok {
 		_spec.SetField(deviceusermapping.FieldLastSeenAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.LastIP(); ok {
+		_spec.SetField(deviceusermapping.FieldLastIP, field.TypeString, value)
+	}
+	if _u.mutation.LastIPCleared() {
+		_spec.ClearField(deviceusermapping.FieldLastIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastUserAgent(); ok {
+		_spec.SetField(deviceusermapping.FieldLastUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.LastUserAgentCleared() {
+		_spec.ClearField(deviceusermapping.FieldLastUserAgent, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(deviceusermapping.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(deviceusermapping.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Trusted(); ok {
+		_spec.SetField(deviceusermapping.FieldTrusted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TrustedAt(); ok {
+		_spec.SetField(deviceusermapping.FieldTrustedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TrustedAtCleared() {
+		_spec.ClearField(deviceusermapping.FieldTrustedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemovedAt(); ok {
+		_spec.SetField(deviceusermapping.FieldRemovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemovedAtCleared() {
+		_spec.ClearField(deviceusermapping.FieldRemovedAt, field.TypeTime)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -32,6 +32,10 @@ type EmailVerificationToken struct {
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	// UsedAt holds the value of the "used_at" field.
 	UsedAt *time.Time `json:"used_at,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose string `json:"purpose,omitempty"`
+	// TargetEmail holds the value of the "target_email" field.
+	TargetEmail *string `json:"target_email,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EmailVerificationTokenQuery when eager-loading is set.
 	Edges        EmailVerificationTokenEdges `json:"edges"`
@@ -65,7 +69,7 @@ func (*EmailVerificationToken) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case emailverificationtoken.FieldID, emailverificationtoken.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case emailverificationtoken.FieldTokenHash:
+		case emailverificationtoken.FieldTokenHash, emailverificationtoken.FieldPurpose, emailverificationtoken.FieldTargetEmail:
 			values[i] = new(sql.NullString)
 		case emailverificationtoken.FieldCreatedAt, emailverificationtoken.FieldUpdatedAt, emailverificationtoken.FieldDeletedAt, emailverificationtoken.FieldExpiresAt, emailverificationtoken.FieldUsedAt:
 			values[i] = new(sql.NullTime)
@@ -134,6 +138,19 @@ func (_m *EmailVerificationToken) assignValues(columns []string, values []any) e
 				_m.UsedAt = new(time.Time)
 				*_m.UsedAt = value.Time
 			}
+		case emailverificationtoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				_m.Purpose = value.String
+			}
+		case emailverificationtoken.FieldTargetEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_email", values[i])
+			} else if value.Valid {
+				_m.TargetEmail = new(string)
+				*_m.TargetEmail = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -199,6 +216,14 @@ func (_m *EmailVerificationToken) String() string {
 		builder.WriteString("used_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(_m.Purpose)
+	builder.WriteString(", ")
+	if v := _m.TargetEmail; v != nil {
+		builder.WriteString("target_email=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
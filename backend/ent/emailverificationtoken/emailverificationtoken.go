@@ -28,6 +28,10 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldUsedAt holds the string denoting the used_at field in the database.
 	FieldUsedAt = "used_at"
+	// FieldPurpose holds the string denoting the purpose field in the database.
+	FieldPurpose = "purpose"
+	// FieldTargetEmail holds the string denoting the target_email field in the database.
+	FieldTargetEmail = "target_email"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the emailverificationtoken in the database.
@@ -51,6 +55,8 @@ var Columns = []string{
 	FieldTokenHash,
 	FieldExpiresAt,
 	FieldUsedAt,
+	FieldPurpose,
+	FieldTargetEmail,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +80,12 @@ var (
 	UserIDValidator func(int) error
 	// TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
 	TokenHashValidator func(string) error
+	// DefaultPurpose holds the default value on creation for the "purpose" field.
+	DefaultPurpose string
+	// PurposeValidator is a validator for the "purpose" field. It is called by the builders before save.
+	PurposeValidator func(string) error
+	// TargetEmailValidator is a validator for the "target_email" field. It is called by the builders before save.
+	TargetEmailValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the EmailVerificationToken queries.
@@ -119,6 +131,16 @@ func ByUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsedAt, opts...).ToFunc()
 }
 
+// ByPurpose orders the results by the purpose field.
+func ByPurpose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurpose, opts...).ToFunc()
+}
+
+// ByTargetEmail orders the results by the target_email field.
+func ByTargetEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetEmail, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
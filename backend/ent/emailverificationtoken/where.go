@@ -90,6 +90,16 @@ func UsedAt(v time.Time) predicate.EmailVerificationToken {
 	return predicate.EmailVerificationToken(sql.FieldEQ(FieldUsedAt, v))
 }
 
+// Purpose applies equality check predicate on the "purpose" field. It's identical to PurposeEQ.
+func Purpose(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldEQ(FieldPurpose, v))
+}
+
+// TargetEmail applies equality check predicate on the "target_email" field. It's identical to TargetEmailEQ.
+func TargetEmail(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldEQ(FieldTargetEmail, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.EmailVerificationToken {
 	return predicate.EmailVerificationToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -395,6 +405,146 @@ func UsedAtNotNil() predicate.EmailVerificationToken {
 	return predicate.EmailVerificationToken(sql.FieldNotNull(FieldUsedAt))
 }
 
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// PurposeGT applies the GT predicate on the "purpose" field.
+func PurposeGT(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldGT(FieldPurpose, v))
+}
+
+// PurposeGTE applies the GTE predicate on the "purpose" field.
+func PurposeGTE(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldGTE(FieldPurpose, v))
+}
+
+// PurposeLT applies the LT predicate on the "purpose" field.
+func PurposeLT(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldLT(FieldPurpose, v))
+}
+
+// PurposeLTE applies the LTE predicate on the "purpose" field.
+func PurposeLTE(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldLTE(FieldPurpose, v))
+}
+
+// PurposeContains applies the Contains predicate on the "purpose" field.
+func PurposeContains(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldContains(FieldPurpose, v))
+}
+
+// PurposeHasPrefix applies the HasPrefix predicate on the "purpose" field.
+func PurposeHasPrefix(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldHasPrefix(FieldPurpose, v))
+}
+
+// PurposeHasSuffix applies the HasSuffix predicate on the "purpose" field.
+func PurposeHasSuffix(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldHasSuffix(FieldPurpose, v))
+}
+
+// PurposeEqualFold applies the EqualFold predicate on the "purpose" field.
+func PurposeEqualFold(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldEqualFold(FieldPurpose, v))
+}
+
+// PurposeContainsFold applies the ContainsFold predicate on the "purpose" field.
+func PurposeContainsFold(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldContainsFold(FieldPurpose, v))
+}
+
+// TargetEmailEQ applies the EQ predicate on the "target_email" field.
+func TargetEmailEQ(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldEQ(FieldTargetEmail, v))
+}
+
+// TargetEmailNEQ applies the NEQ predicate on the "target_email" field.
+func TargetEmailNEQ(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldNEQ(FieldTargetEmail, v))
+}
+
+// TargetEmailIn applies the In predicate on the "target_email" field.
+func TargetEmailIn(vs ...string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldIn(FieldTargetEmail, vs...))
+}
+
+// TargetEmailNotIn applies the NotIn predicate on the "target_email" field.
+func TargetEmailNotIn(vs ...string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldNotIn(FieldTargetEmail, vs...))
+}
+
+// TargetEmailGT applies the GT predicate on the "target_email" field.
+func TargetEmailGT(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldGT(FieldTargetEmail, v))
+}
+
+// TargetEmailGTE applies the GTE predicate on the "target_email" field.
+func TargetEmailGTE(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldGTE(FieldTargetEmail, v))
+}
+
+// TargetEmailLT applies the LT predicate on the "target_email" field.
+func TargetEmailLT(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldLT(FieldTargetEmail, v))
+}
+
+// TargetEmailLTE applies the LTE predicate on the "target_email" field.
+func TargetEmailLTE(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldLTE(FieldTargetEmail, v))
+}
+
+// TargetEmailContains applies the Contains predicate on the "target_email" field.
+func TargetEmailContains(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldContains(FieldTargetEmail, v))
+}
+
+// TargetEmailHasPrefix applies the HasPrefix predicate on the "target_email" field.
+func TargetEmailHasPrefix(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldHasPrefix(FieldTargetEmail, v))
+}
+
+// TargetEmailHasSuffix applies the HasSuffix predicate on the "target_email" field.
+func TargetEmailHasSuffix(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldHasSuffix(FieldTargetEmail, v))
+}
+
+// TargetEmailIsNil applies the IsNil predicate on the "target_email" field.
+func TargetEmailIsNil() predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldIsNull(FieldTargetEmail))
+}
+
+// TargetEmailNotNil applies the NotNil predicate on the "target_email" field.
+func TargetEmailNotNil() predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldNotNull(FieldTargetEmail))
+}
+
+// TargetEmailEqualFold applies the EqualFold predicate on the "target_email" field.
+func TargetEmailEqualFold(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldEqualFold(FieldTargetEmail, v))
+}
+
+// TargetEmailContainsFold applies the ContainsFold predicate on the "target_email" field.
+func TargetEmailContainsFold(v string) predicate.EmailVerificationToken {
+	return predicate.EmailVerificationToken(sql.FieldContainsFold(FieldTargetEmail, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.EmailVerificationToken {
 	return predicate.EmailVerificationToken(func(s *sql.Selector) {
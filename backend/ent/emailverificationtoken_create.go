@@ -95,6 +95,34 @@ func (_c *EmailVerificationTokenCreate) SetNillableUsedAt(v *time.Time) *EmailVe
 	return _c
 }
 
+// SetPurpose sets the "purpose" field.
+func (_c *EmailVerificationTokenCreate) SetPurpose(v string) *EmailVerificationTokenCreate {
+	_c.mutation.SetPurpose(v)
+	return _c
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_c *EmailVerificationTokenCreate) SetNillablePurpose(v *string) *EmailVerificationTokenCreate {
+	if v != nil {
+		_c.SetPurpose(*v)
+	}
+	return _c
+}
+
+// SetTargetEmail sets the "target_email" field.
+func (_c *EmailVerificationTokenCreate) SetTargetEmail(v string) *EmailVerificationTokenCreate {
+	_c.mutation.SetTargetEmail(v)
+	return _c
+}
+
+// SetNillableTargetEmail sets the "target_email" field if the given value is not nil.
+func (_c *EmailVerificationTokenCreate) SetNillableTargetEmail(v *string) *EmailVerificationTokenCreate {
+	if v != nil {
+		_c.SetTargetEmail(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *EmailVerificationTokenCreate) SetUser(v *User) *EmailVerificationTokenCreate {
 	return _c.SetUserID(v.ID)
@@ -143,6 +171,10 @@ func (_c *EmailVerificationTokenCreate) defaults() {
 		v := emailverificationtoken.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		v := emailverificationtoken.DefaultPurpose
+		_c.mutation.SetPurpose(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -172,6 +204,19 @@ func (_c *EmailVerificationTokenCreate) check() error {
 	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "EmailVerificationToken.expires_at"`)}
 	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "EmailVerificationToken.purpose"`)}
+	}
+	if v, ok := _c.mutation.Purpose(); ok {
+		if err := emailverificationtoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TargetEmail(); ok {
+		if err := emailverificationtoken.TargetEmailValidator(v); err != nil {
+			return &ValidationError{Name: "target_email", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.target_email": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "EmailVerificationToken.user"`)}
 	}
@@ -225,6 +270,14 @@ func (_c *EmailVerificationTokenCreate) createSpec() (*EmailVerificationToken, *
 		_spec.SetField(emailverificationtoken.FieldUsedAt, field.TypeTime, value)
 		_node.UsedAt = &value
 	}
+	if value, ok := _c.mutation.Purpose(); ok {
+		_spec.SetField(emailverificationtoken.FieldPurpose, field.TypeString, value)
+		_node.Purpose = value
+	}
+	if value, ok := _c.mutation.TargetEmail(); ok {
+		_spec.SetField(emailverificationtoken.FieldTargetEmail, field.TypeString, value)
+		_node.TargetEmail = &value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
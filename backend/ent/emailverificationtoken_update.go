@@ -117,6 +117,40 @@ func (_u *EmailVerificationTokenUpdate) ClearUsedAt() *EmailVerificationTokenUpd
 	return _u
 }
 
+// SetPurpose sets the "purpose" field.
+func (_u *EmailVerificationTokenUpdate) SetPurpose(v string) *EmailVerificationTokenUpdate {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdate) SetNillablePurpose(v *string) *EmailVerificationTokenUpdate {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetTargetEmail sets the "target_email" field.
+func (_u *EmailVerificationTokenUpdate) SetTargetEmail(v string) *EmailVerificationTokenUpdate {
+	_u.mutation.SetTargetEmail(v)
+	return _u
+}
+
+// SetNillableTargetEmail sets the "target_email" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdate) SetNillableTargetEmail(v *string) *EmailVerificationTokenUpdate {
+	if v != nil {
+		_u.SetTargetEmail(*v)
+	}
+	return _u
+}
+
+// ClearTargetEmail clears the value of the "target_email" field.
+func (_u *EmailVerificationTokenUpdate) ClearTargetEmail() *EmailVerificationTokenUpdate {
+	_u.mutation.ClearTargetEmail()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *EmailVerificationTokenUpdate) SetUser(v *User) *EmailVerificationTokenUpdate {
 	return _u.SetUserID(v.ID)
@@ -181,6 +215,16 @@ func (_u *EmailVerificationTokenUpdate) check() error {
 			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Purpose(); ok {
+		if err := emailverificationtoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetEmail(); ok {
+		if err := emailverificationtoken.TargetEmailValidator(v); err != nil {
+			return &ValidationError{Name: "target_email", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.target_email": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "EmailVerificationToken.user"`)
 	}
@@ -220,6 +264,15 @@ func (_u *EmailVerificationTokenUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.UsedAtCleared() {
 		_spec.ClearField(emailverificationtoken.FieldUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(emailverificationtoken.FieldPurpose, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetEmail(); ok {
+		_spec.SetField(emailverificationtoken.FieldTargetEmail, field.TypeString, value)
+	}
+	if _u.mutation.TargetEmailCleared() {
+		_spec.ClearField(emailverificationtoken.FieldTargetEmail, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -357,6 +410,40 @@ func (_u *EmailVerificationTokenUpdateOne) ClearUsedAt() *EmailVerificationToken
 	return _u
 }
 
+// SetPurpose sets the "purpose" field.
+func (_u *EmailVerificationTokenUpdateOne) SetPurpose(v string) *EmailVerificationTokenUpdateOne {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdateOne) SetNillablePurpose(v *string) *EmailVerificationTokenUpdateOne {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetTargetEmail sets the "target_email" field.
+func (_u *EmailVerificationTokenUpdateOne) SetTargetEmail(v string) *EmailVerificationTokenUpdateOne {
+	_u.mutation.SetTargetEmail(v)
+	return _u
+}
+
+// SetNillableTargetEmail sets the "target_email" field if the given value is not nil.
+func (_u *EmailVerificationTokenUpdateOne) SetNillableTargetEmail(v *string) *EmailVerificationTokenUpdateOne {
+	if v != nil {
+		_u.SetTargetEmail(*v)
+	}
+	return _u
+}
+
+// ClearTargetEmail clears the value of the "target_email" field.
+func (_u *EmailVerificationTokenUpdateOne) ClearTargetEmail() *EmailVerificationTokenUpdateOne {
+	_u.mutation.ClearTargetEmail()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *EmailVerificationTokenUpdateOne) SetUser(v *User) *EmailVerificationTokenUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -434,6 +521,16 @@ func (_u *EmailVerificationTokenUpdateOne) check() error {
 			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.token_hash": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Purpose(); ok {
+		if err := emailverificationtoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.purpose": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetEmail(); ok {
+		if err := emailverificationtoken.TargetEmailValidator(v); err != nil {
+			return &ValidationError{Name: "target_email", err: fmt.Errorf(`ent: validator failed for field "EmailVerificationToken.target_email": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "EmailVerificationToken.user"`)
 	}
@@ -490,6 +587,15 @@ func (_u *EmailVerificationTokenUpdateOne) sqlSave(ctx context.Context) (_node *
 	if _u.mutation.UsedAtCleared() {
 		_spec.ClearField(emailverificationtoken.FieldUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(emailverificationtoken.FieldPurpose, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetEmail(); ok {
+		_spec.SetField(emailverificationtoken.FieldTargetEmail, field.TypeString, value)
+	}
+	if _u.mutation.TargetEmailCleared() {
+		_spec.ClearField(emailverificationtoken.FieldTargetEmail, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -4,11 +4,19 @@ package ent
 
 import (
 	"backend-gin/ent/admin"
+	"backend-gin/ent/adminauditlog"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminrole"
+	"backend-gin/ent/adminsession"
 	"backend-gin/ent/artifactsubmission"
 	"backend-gin/ent/backupcode"
 	"backend-gin/ent/badge"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
 	"backend-gin/ent/category"
 	"backend-gin/ent/chaincursor"
+	"backend-gin/ent/consultationinvitation"
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/credential"
 	"backend-gin/ent/devicefingerprint"
@@ -16,14 +24,21 @@ import (
 	"backend-gin/ent/emailverificationtoken"
 	"backend-gin/ent/endorsement"
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
+	"backend-gin/ent/guaranteeslash"
+	"backend-gin/ent/impersonationsession"
 	"backend-gin/ent/ipgeocache"
+	"backend-gin/ent/iprule"
 	"backend-gin/ent/marketpurchaseorder"
 	"backend-gin/ent/marketpurchaseorderstep"
 	"backend-gin/ent/passkey"
 	"backend-gin/ent/passwordresettoken"
+	"backend-gin/ent/reputationevent"
 	"backend-gin/ent/securityevent"
 	"backend-gin/ent/session"
 	"backend-gin/ent/sessionlock"
+	"backend-gin/ent/slapolicy"
 	"backend-gin/ent/sudosession"
 	"backend-gin/ent/tag"
 	"backend-gin/ent/totppendingtoken"
@@ -31,6 +46,9 @@ import (
 	"backend-gin/ent/userbadge"
 	"backend-gin/ent/validationcase"
 	"backend-gin/ent/validationcaselog"
+	"backend-gin/ent/validatordeliverysla"
+	"backend-gin/ent/validatormatchingaggregate"
+	"backend-gin/ent/validatorreputation"
 	"context"
 	"errors"
 	"fmt"
@@ -100,34 +118,52 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			admin.Table:                   admin.ValidColumn,
-			artifactsubmission.Table:      artifactsubmission.ValidColumn,
-			backupcode.Table:              backupcode.ValidColumn,
-			badge.Table:                   badge.ValidColumn,
-			category.Table:                category.ValidColumn,
-			chaincursor.Table:             chaincursor.ValidColumn,
-			consultationrequest.Table:     consultationrequest.ValidColumn,
-			credential.Table:              credential.ValidColumn,
-			devicefingerprint.Table:       devicefingerprint.ValidColumn,
-			deviceusermapping.Table:       deviceusermapping.ValidColumn,
-			emailverificationtoken.Table:  emailverificationtoken.ValidColumn,
-			endorsement.Table:             endorsement.ValidColumn,
-			finaloffer.Table:              finaloffer.ValidColumn,
-			ipgeocache.Table:              ipgeocache.ValidColumn,
-			marketpurchaseorder.Table:     marketpurchaseorder.ValidColumn,
-			marketpurchaseorderstep.Table: marketpurchaseorderstep.ValidColumn,
-			passkey.Table:                 passkey.ValidColumn,
-			passwordresettoken.Table:      passwordresettoken.ValidColumn,
-			securityevent.Table:           securityevent.ValidColumn,
-			session.Table:                 session.ValidColumn,
-			sessionlock.Table:             sessionlock.ValidColumn,
-			sudosession.Table:             sudosession.ValidColumn,
-			totppendingtoken.Table:        totppendingtoken.ValidColumn,
-			tag.Table:                     tag.ValidColumn,
-			user.Table:                    user.ValidColumn,
-			userbadge.Table:               userbadge.ValidColumn,
-			validationcase.Table:          validationcase.ValidColumn,
-			validationcaselog.Table:       validationcaselog.ValidColumn,
+			admin.Table:                      admin.ValidColumn,
+			adminauditlog.Table:              adminauditlog.ValidColumn,
+			adminpasskey.Table:               adminpasskey.ValidColumn,
+			adminrole.Table:                  adminrole.ValidColumn,
+			adminsession.Table:               adminsession.ValidColumn,
+			artifactsubmission.Table:         artifactsubmission.ValidColumn,
+			backupcode.Table:                 backupcode.ValidColumn,
+			badge.Table:                      badge.ValidColumn,
+			caseclarification.Table:          caseclarification.ValidColumn,
+			caseconversation.Table:           caseconversation.ValidColumn,
+			casemessage.Table:                casemessage.ValidColumn,
+			category.Table:                   category.ValidColumn,
+			chaincursor.Table:                chaincursor.ValidColumn,
+			consultationinvitation.Table:     consultationinvitation.ValidColumn,
+			consultationrequest.Table:        consultationrequest.ValidColumn,
+			credential.Table:                 credential.ValidColumn,
+			devicefingerprint.Table:          devicefingerprint.ValidColumn,
+			deviceusermapping.Table:          deviceusermapping.ValidColumn,
+			emailverificationtoken.Table:     emailverificationtoken.ValidColumn,
+			endorsement.Table:                endorsement.ValidColumn,
+			finaloffer.Table:                 finaloffer.ValidColumn,
+			finaloffermilestone.Table:        finaloffermilestone.ValidColumn,
+			finalofferrevision.Table:         finalofferrevision.ValidColumn,
+			guaranteeslash.Table:             guaranteeslash.ValidColumn,
+			ipgeocache.Table:                 ipgeocache.ValidColumn,
+			iprule.Table:                     iprule.ValidColumn,
+			impersonationsession.Table:       impersonationsession.ValidColumn,
+			marketpurchaseorder.Table:        marketpurchaseorder.ValidColumn,
+			marketpurchaseorderstep.Table:    marketpurchaseorderstep.ValidColumn,
+			passkey.Table:                    passkey.ValidColumn,
+			passwordresettoken.Table:         passwordresettoken.ValidColumn,
+			reputationevent.Table:            reputationevent.ValidColumn,
+			slapolicy.Table:                  slapolicy.ValidColumn,
+			securityevent.Table:              securityevent.ValidColumn,
+			session.Table:                    session.ValidColumn,
+			sessionlock.Table:                sessionlock.ValidColumn,
+			sudosession.Table:                sudosession.ValidColumn,
+			totppendingtoken.Table:           totppendingtoken.ValidColumn,
+			tag.Table:                        tag.ValidColumn,
+			user.Table:                       user.ValidColumn,
+			userbadge.Table:                  userbadge.ValidColumn,
+			validationcase.Table:             validationcase.ValidColumn,
+			validationcaselog.Table:          validationcaselog.ValidColumn,
+			validatordeliverysla.Table:       validatordeliverysla.ValidColumn,
+			validatormatchingaggregate.Table: validatormatchingaggregate.ValidColumn,
+			validatorreputation.Table:        validatorreputation.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
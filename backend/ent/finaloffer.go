@@ -6,6 +6,7 @@ import (
 	"backend-gin/ent/finaloffer"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -45,6 +46,18 @@ type FinalOffer struct {
 	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
 	// RejectedAt holds the value of the "rejected_at" field.
 	RejectedAt *time.Time `json:"rejected_at,omitempty"`
+	// CurrentRevision holds the value of the "current_revision" field.
+	CurrentRevision int `json:"current_revision,omitempty"`
+	// LastProposedBy holds the value of the "last_proposed_by" field.
+	LastProposedBy string `json:"last_proposed_by,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// WithdrawnAt holds the value of the "withdrawn_at" field.
+	WithdrawnAt *time.Time `json:"withdrawn_at,omitempty"`
+	// ExpiredAt holds the value of the "expired_at" field.
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+	// MilestonePlan holds the value of the "milestone_plan" field.
+	MilestonePlan []map[string]interface{} `json:"milestone_plan,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FinalOfferQuery when eager-loading is set.
 	Edges        FinalOfferEdges `json:"edges"`
@@ -57,9 +70,13 @@ type FinalOfferEdges struct {
 	ValidationCase *ValidationCase `json:"validation_case,omitempty"`
 	// ValidatorUser holds the value of the validator_user edge.
 	ValidatorUser *User `json:"validator_user,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*FinalOfferRevision `json:"revisions,omitempty"`
+	// Milestones holds the value of the milestones edge.
+	Milestones []*FinalOfferMilestone `json:"milestones,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ValidationCaseOrErr returns the ValidationCase value or an error if the edge
@@ -84,16 +101,36 @@ func (e FinalOfferEdges) ValidatorUserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "validator_user"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e FinalOfferEdges) RevisionsOrErr() ([]*FinalOfferRevision, error) {
+	if e.loadedTypes[2] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e FinalOfferEdges) MilestonesOrErr() ([]*FinalOfferMilestone, error) {
+	if e.loadedTypes[3] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*FinalOffer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case finaloffer.FieldID, finaloffer.FieldValidationCaseID, finaloffer.FieldValidatorUserID, finaloffer.FieldWorkflowCycle, finaloffer.FieldAmount, finaloffer.FieldHoldHours:
+		case finaloffer.FieldMilestonePlan:
+			values[i] = new([]byte)
+		case finaloffer.FieldID, finaloffer.FieldValidationCaseID, finaloffer.FieldValidatorUserID, finaloffer.FieldWorkflowCycle, finaloffer.FieldAmount, finaloffer.FieldHoldHours, finaloffer.FieldCurrentRevision:
 			values[i] = new(sql.NullInt64)
-		case finaloffer.FieldSubmissionKey, finaloffer.FieldTerms, finaloffer.FieldStatus:
+		case finaloffer.FieldSubmissionKey, finaloffer.FieldTerms, finaloffer.FieldStatus, finaloffer.FieldLastProposedBy:
 			values[i] = new(sql.NullString)
-		case finaloffer.FieldCreatedAt, finaloffer.FieldUpdatedAt, finaloffer.FieldDeletedAt, finaloffer.FieldAcceptedAt, finaloffer.FieldRejectedAt:
+		case finaloffer.FieldCreatedAt, finaloffer.FieldUpdatedAt, finaloffer.FieldDeletedAt, finaloffer.FieldAcceptedAt, finaloffer.FieldRejectedAt, finaloffer.FieldExpiresAt, finaloffer.FieldWithdrawnAt, finaloffer.FieldExpiredAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -198,6 +235,47 @@ func (_m *FinalOffer) assignValues(columns []string, values []any) error {
 				_m.RejectedAt = new(time.Time)
 				*_m.RejectedAt = value.Time
 			}
+		case finaloffer.FieldCurrentRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field current_revision", values[i])
+			} else if value.Valid {
+				_m.CurrentRevision = int(value.Int64)
+			}
+		case finaloffer.FieldLastProposedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_proposed_by", values[i])
+			} else if value.Valid {
+				_m.LastProposedBy = value.String
+			}
+		case finaloffer.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case finaloffer.FieldWithdrawnAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field withdrawn_at", values[i])
+			} else if value.Valid {
+				_m.WithdrawnAt = new(time.Time)
+				*_m.WithdrawnAt = value.Time
+			}
+		case finaloffer.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				_m.ExpiredAt = new(time.Time)
+				*_m.ExpiredAt = value.Time
+			}
+		case finaloffer.FieldMilestonePlan:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_plan", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MilestonePlan); err != nil {
+					return fmt.Errorf("unmarshal field milestone_plan: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -221,6 +299,16 @@ func (_m *FinalOffer) QueryValidatorUser() *UserQuery {
 	return NewFinalOfferClient(_m.config).QueryValidatorUser(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the FinalOffer entity.
+func (_m *FinalOffer) QueryRevisions() *FinalOfferRevisionQuery {
+	return NewFinalOfferClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryMilestones queries the "milestones" edge of the FinalOffer entity.
+func (_m *FinalOffer) QueryMilestones() *FinalOfferMilestoneQuery {
+	return NewFinalOfferClient(_m.config).QueryMilestones(_m)
+}
+
 // Update returns a builder for updating this FinalOffer.
 // Note that you need to call FinalOffer.Unwrap() before calling this method if this FinalOffer
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -290,6 +378,30 @@ func (_m *FinalOffer) String() string {
 		builder.WriteString("rejected_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("current_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CurrentRevision))
+	builder.WriteString(", ")
+	builder.WriteString("last_proposed_by=")
+	builder.WriteString(_m.LastProposedBy)
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.WithdrawnAt; v != nil {
+		builder.WriteString("withdrawn_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiredAt; v != nil {
+		builder.WriteString("expired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("milestone_plan=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MilestonePlan))
 	builder.WriteByte(')')
 	return builder.String()
 }
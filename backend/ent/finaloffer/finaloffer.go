@@ -40,10 +40,26 @@ const (
 	FieldAcceptedAt = "accepted_at"
 	// FieldRejectedAt holds the string denoting the rejected_at field in the database.
 	FieldRejectedAt = "rejected_at"
+	// FieldCurrentRevision holds the string denoting the current_revision field in the database.
+	FieldCurrentRevision = "current_revision"
+	// FieldLastProposedBy holds the string denoting the last_proposed_by field in the database.
+	FieldLastProposedBy = "last_proposed_by"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldWithdrawnAt holds the string denoting the withdrawn_at field in the database.
+	FieldWithdrawnAt = "withdrawn_at"
+	// FieldExpiredAt holds the string denoting the expired_at field in the database.
+	FieldExpiredAt = "expired_at"
+	// FieldMilestonePlan holds the string denoting the milestone_plan field in the database.
+	FieldMilestonePlan = "milestone_plan"
 	// EdgeValidationCase holds the string denoting the validation_case edge name in mutations.
 	EdgeValidationCase = "validation_case"
 	// EdgeValidatorUser holds the string denoting the validator_user edge name in mutations.
 	EdgeValidatorUser = "validator_user"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeMilestones holds the string denoting the milestones edge name in mutations.
+	EdgeMilestones = "milestones"
 	// Table holds the table name of the finaloffer in the database.
 	Table = "final_offers"
 	// ValidationCaseTable is the table that holds the validation_case relation/edge.
@@ -60,6 +76,20 @@ const (
 	ValidatorUserInverseTable = "users"
 	// ValidatorUserColumn is the table column denoting the validator_user relation/edge.
 	ValidatorUserColumn = "validator_user_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "final_offer_revisions"
+	// RevisionsInverseTable is the table name for the FinalOfferRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "finalofferrevision" package.
+	RevisionsInverseTable = "final_offer_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "final_offer_id"
+	// MilestonesTable is the table that holds the milestones relation/edge.
+	MilestonesTable = "final_offer_milestones"
+	// MilestonesInverseTable is the table name for the FinalOfferMilestone entity.
+	// It exists in this package in order to avoid circular dependency with the "finaloffermilestone" package.
+	MilestonesInverseTable = "final_offer_milestones"
+	// MilestonesColumn is the table column denoting the milestones relation/edge.
+	MilestonesColumn = "final_offer_id"
 )
 
 // Columns holds all SQL columns for finaloffer fields.
@@ -78,6 +108,12 @@ var Columns = []string{
 	FieldStatus,
 	FieldAcceptedAt,
 	FieldRejectedAt,
+	FieldCurrentRevision,
+	FieldLastProposedBy,
+	FieldExpiresAt,
+	FieldWithdrawnAt,
+	FieldExpiredAt,
+	FieldMilestonePlan,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -119,6 +155,14 @@ var (
 	DefaultStatus string
 	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	StatusValidator func(string) error
+	// DefaultCurrentRevision holds the default value on creation for the "current_revision" field.
+	DefaultCurrentRevision int
+	// CurrentRevisionValidator is a validator for the "current_revision" field. It is called by the builders before save.
+	CurrentRevisionValidator func(int) error
+	// DefaultLastProposedBy holds the default value on creation for the "last_proposed_by" field.
+	DefaultLastProposedBy string
+	// LastProposedByValidator is a validator for the "last_proposed_by" field. It is called by the builders before save.
+	LastProposedByValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the FinalOffer queries.
@@ -194,6 +238,31 @@ func ByRejectedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRejectedAt, opts...).ToFunc()
 }
 
+// ByCurrentRevision orders the results by the current_revision field.
+func ByCurrentRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentRevision, opts...).ToFunc()
+}
+
+// ByLastProposedBy orders the results by the last_proposed_by field.
+func ByLastProposedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastProposedBy, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByWithdrawnAt orders the results by the withdrawn_at field.
+func ByWithdrawnAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithdrawnAt, opts...).ToFunc()
+}
+
+// ByExpiredAt orders the results by the expired_at field.
+func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
+}
+
 // ByValidationCaseField orders the results by validation_case field.
 func ByValidationCaseField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -207,6 +276,34 @@ func ByValidatorUserField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newValidatorUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMilestonesCount orders the results by milestones count.
+func ByMilestonesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMilestonesStep(), opts...)
+	}
+}
+
+// ByMilestones orders the results by milestones terms.
+func ByMilestones(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMilestonesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newValidationCaseStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -221,3 +318,17 @@ func newValidatorUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ValidatorUserTable, ValidatorUserColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newMilestonesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MilestonesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+	)
+}
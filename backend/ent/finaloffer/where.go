@@ -120,6 +120,31 @@ func RejectedAt(v time.Time) predicate.FinalOffer {
 	return predicate.FinalOffer(sql.FieldEQ(FieldRejectedAt, v))
 }
 
+// CurrentRevision applies equality check predicate on the "current_revision" field. It's identical to CurrentRevisionEQ.
+func CurrentRevision(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldCurrentRevision, v))
+}
+
+// LastProposedBy applies equality check predicate on the "last_proposed_by" field. It's identical to LastProposedByEQ.
+func LastProposedBy(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldLastProposedBy, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// WithdrawnAt applies equality check predicate on the "withdrawn_at" field. It's identical to WithdrawnAtEQ.
+func WithdrawnAt(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldWithdrawnAt, v))
+}
+
+// ExpiredAt applies equality check predicate on the "expired_at" field. It's identical to ExpiredAtEQ.
+func ExpiredAt(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldExpiredAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.FinalOffer {
 	return predicate.FinalOffer(sql.FieldEQ(FieldCreatedAt, v))
@@ -725,6 +750,271 @@ func RejectedAtNotNil() predicate.FinalOffer {
 	return predicate.FinalOffer(sql.FieldNotNull(FieldRejectedAt))
 }
 
+// CurrentRevisionEQ applies the EQ predicate on the "current_revision" field.
+func CurrentRevisionEQ(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionNEQ applies the NEQ predicate on the "current_revision" field.
+func CurrentRevisionNEQ(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNEQ(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionIn applies the In predicate on the "current_revision" field.
+func CurrentRevisionIn(vs ...int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIn(FieldCurrentRevision, vs...))
+}
+
+// CurrentRevisionNotIn applies the NotIn predicate on the "current_revision" field.
+func CurrentRevisionNotIn(vs ...int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotIn(FieldCurrentRevision, vs...))
+}
+
+// CurrentRevisionGT applies the GT predicate on the "current_revision" field.
+func CurrentRevisionGT(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGT(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionGTE applies the GTE predicate on the "current_revision" field.
+func CurrentRevisionGTE(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGTE(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionLT applies the LT predicate on the "current_revision" field.
+func CurrentRevisionLT(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLT(FieldCurrentRevision, v))
+}
+
+// CurrentRevisionLTE applies the LTE predicate on the "current_revision" field.
+func CurrentRevisionLTE(v int) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLTE(FieldCurrentRevision, v))
+}
+
+// LastProposedByEQ applies the EQ predicate on the "last_proposed_by" field.
+func LastProposedByEQ(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldLastProposedBy, v))
+}
+
+// LastProposedByNEQ applies the NEQ predicate on the "last_proposed_by" field.
+func LastProposedByNEQ(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNEQ(FieldLastProposedBy, v))
+}
+
+// LastProposedByIn applies the In predicate on the "last_proposed_by" field.
+func LastProposedByIn(vs ...string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIn(FieldLastProposedBy, vs...))
+}
+
+// LastProposedByNotIn applies the NotIn predicate on the "last_proposed_by" field.
+func LastProposedByNotIn(vs ...string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotIn(FieldLastProposedBy, vs...))
+}
+
+// LastProposedByGT applies the GT predicate on the "last_proposed_by" field.
+func LastProposedByGT(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGT(FieldLastProposedBy, v))
+}
+
+// LastProposedByGTE applies the GTE predicate on the "last_proposed_by" field.
+func LastProposedByGTE(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGTE(FieldLastProposedBy, v))
+}
+
+// LastProposedByLT applies the LT predicate on the "last_proposed_by" field.
+func LastProposedByLT(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLT(FieldLastProposedBy, v))
+}
+
+// LastProposedByLTE applies the LTE predicate on the "last_proposed_by" field.
+func LastProposedByLTE(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLTE(FieldLastProposedBy, v))
+}
+
+// LastProposedByContains applies the Contains predicate on the "last_proposed_by" field.
+func LastProposedByContains(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldContains(FieldLastProposedBy, v))
+}
+
+// LastProposedByHasPrefix applies the HasPrefix predicate on the "last_proposed_by" field.
+func LastProposedByHasPrefix(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldHasPrefix(FieldLastProposedBy, v))
+}
+
+// LastProposedByHasSuffix applies the HasSuffix predicate on the "last_proposed_by" field.
+func LastProposedByHasSuffix(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldHasSuffix(FieldLastProposedBy, v))
+}
+
+// LastProposedByEqualFold applies the EqualFold predicate on the "last_proposed_by" field.
+func LastProposedByEqualFold(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEqualFold(FieldLastProposedBy, v))
+}
+
+// LastProposedByContainsFold applies the ContainsFold predicate on the "last_proposed_by" field.
+func LastProposedByContainsFold(v string) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldContainsFold(FieldLastProposedBy, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// WithdrawnAtEQ applies the EQ predicate on the "withdrawn_at" field.
+func WithdrawnAtEQ(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldWithdrawnAt, v))
+}
+
+// WithdrawnAtNEQ applies the NEQ predicate on the "withdrawn_at" field.
+func WithdrawnAtNEQ(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNEQ(FieldWithdrawnAt, v))
+}
+
+// WithdrawnAtIn applies the In predicate on the "withdrawn_at" field.
+func WithdrawnAtIn(vs ...time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIn(FieldWithdrawnAt, vs...))
+}
+
+// WithdrawnAtNotIn applies the NotIn predicate on the "withdrawn_at" field.
+func WithdrawnAtNotIn(vs ...time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotIn(FieldWithdrawnAt, vs...))
+}
+
+// WithdrawnAtGT applies the GT predicate on the "withdrawn_at" field.
+func WithdrawnAtGT(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGT(FieldWithdrawnAt, v))
+}
+
+// WithdrawnAtGTE applies the GTE predicate on the "withdrawn_at" field.
+func WithdrawnAtGTE(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGTE(FieldWithdrawnAt, v))
+}
+
+// WithdrawnAtLT applies the LT predicate on the "withdrawn_at" field.
+func WithdrawnAtLT(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLT(FieldWithdrawnAt, v))
+}
+
+// WithdrawnAtLTE applies the LTE predicate on the "withdrawn_at" field.
+func WithdrawnAtLTE(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLTE(FieldWithdrawnAt, v))
+}
+
+// WithdrawnAtIsNil applies the IsNil predicate on the "withdrawn_at" field.
+func WithdrawnAtIsNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIsNull(FieldWithdrawnAt))
+}
+
+// WithdrawnAtNotNil applies the NotNil predicate on the "withdrawn_at" field.
+func WithdrawnAtNotNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotNull(FieldWithdrawnAt))
+}
+
+// ExpiredAtEQ applies the EQ predicate on the "expired_at" field.
+func ExpiredAtEQ(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtNEQ applies the NEQ predicate on the "expired_at" field.
+func ExpiredAtNEQ(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtIn applies the In predicate on the "expired_at" field.
+func ExpiredAtIn(vs ...time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtNotIn applies the NotIn predicate on the "expired_at" field.
+func ExpiredAtNotIn(vs ...time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtGT applies the GT predicate on the "expired_at" field.
+func ExpiredAtGT(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGT(FieldExpiredAt, v))
+}
+
+// ExpiredAtGTE applies the GTE predicate on the "expired_at" field.
+func ExpiredAtGTE(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldGTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtLT applies the LT predicate on the "expired_at" field.
+func ExpiredAtLT(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLT(FieldExpiredAt, v))
+}
+
+// ExpiredAtLTE applies the LTE predicate on the "expired_at" field.
+func ExpiredAtLTE(v time.Time) predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldLTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtIsNil applies the IsNil predicate on the "expired_at" field.
+func ExpiredAtIsNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIsNull(FieldExpiredAt))
+}
+
+// ExpiredAtNotNil applies the NotNil predicate on the "expired_at" field.
+func ExpiredAtNotNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotNull(FieldExpiredAt))
+}
+
+// MilestonePlanIsNil applies the IsNil predicate on the "milestone_plan" field.
+func MilestonePlanIsNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldIsNull(FieldMilestonePlan))
+}
+
+// MilestonePlanNotNil applies the NotNil predicate on the "milestone_plan" field.
+func MilestonePlanNotNil() predicate.FinalOffer {
+	return predicate.FinalOffer(sql.FieldNotNull(FieldMilestonePlan))
+}
+
 // HasValidationCase applies the HasEdge predicate on the "validation_case" edge.
 func HasValidationCase() predicate.FinalOffer {
 	return predicate.FinalOffer(func(s *sql.Selector) {
@@ -771,6 +1061,52 @@ func HasValidatorUserWith(preds ...predicate.User) predicate.FinalOffer {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.FinalOffer {
+	return predicate.FinalOffer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.FinalOfferRevision) predicate.FinalOffer {
+	return predicate.FinalOffer(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestones applies the HasEdge predicate on the "milestones" edge.
+func HasMilestones() predicate.FinalOffer {
+	return predicate.FinalOffer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestonesWith applies the HasEdge predicate on the "milestones" edge with a given conditions (other predicates).
+func HasMilestonesWith(preds ...predicate.FinalOfferMilestone) predicate.FinalOffer {
+	return predicate.FinalOffer(func(s *sql.Selector) {
+		step := newMilestonesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FinalOffer) predicate.FinalOffer {
 	return predicate.FinalOffer(sql.AndPredicates(predicates...))
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
 	"context"
@@ -180,6 +182,82 @@ func (_c *FinalOfferCreate) SetNillableRejectedAt(v *time.Time) *FinalOfferCreat
 	return _c
 }
 
+// SetCurrentRevision sets the "current_revision" field.
+func (_c *FinalOfferCreate) SetCurrentRevision(v int) *FinalOfferCreate {
+	_c.mutation.SetCurrentRevision(v)
+	return _c
+}
+
+// SetNillableCurrentRevision sets the "current_revision" field if the given value is not nil.
+func (_c *FinalOfferCreate) SetNillableCurrentRevision(v *int) *FinalOfferCreate {
+	if v != nil {
+		_c.SetCurrentRevision(*v)
+	}
+	return _c
+}
+
+// SetLastProposedBy sets the "last_proposed_by" field.
+func (_c *FinalOfferCreate) SetLastProposedBy(v string) *FinalOfferCreate {
+	_c.mutation.SetLastProposedBy(v)
+	return _c
+}
+
+// SetNillableLastProposedBy sets the "last_proposed_by" field if the given value is not nil.
+func (_c *FinalOfferCreate) SetNillableLastProposedBy(v *string) *FinalOfferCreate {
+	if v != nil {
+		_c.SetLastProposedBy(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *FinalOfferCreate) SetExpiresAt(v time.Time) *FinalOfferCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *FinalOfferCreate) SetNillableExpiresAt(v *time.Time) *FinalOfferCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetWithdrawnAt sets the "withdrawn_at" field.
+func (_c *FinalOfferCreate) SetWithdrawnAt(v time.Time) *FinalOfferCreate {
+	_c.mutation.SetWithdrawnAt(v)
+	return _c
+}
+
+// SetNillableWithdrawnAt sets the "withdrawn_at" field if the given value is not nil.
+func (_c *FinalOfferCreate) SetNillableWithdrawnAt(v *time.Time) *FinalOfferCreate {
+	if v != nil {
+		_c.SetWithdrawnAt(*v)
+	}
+	return _c
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_c *FinalOfferCreate) SetExpiredAt(v time.Time) *FinalOfferCreate {
+	_c.mutation.SetExpiredAt(v)
+	return _c
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_c *FinalOfferCreate) SetNillableExpiredAt(v *time.Time) *FinalOfferCreate {
+	if v != nil {
+		_c.SetExpiredAt(*v)
+	}
+	return _c
+}
+
+// SetMilestonePlan sets the "milestone_plan" field.
+func (_c *FinalOfferCreate) SetMilestonePlan(v []map[string]interface{}) *FinalOfferCreate {
+	_c.mutation.SetMilestonePlan(v)
+	return _c
+}
+
 // SetValidationCase sets the "validation_case" edge to the ValidationCase entity.
 func (_c *FinalOfferCreate) SetValidationCase(v *ValidationCase) *FinalOfferCreate {
 	return _c.SetValidationCaseID(v.ID)
@@ -190,6 +268,36 @@ func (_c *FinalOfferCreate) SetValidatorUser(v *User) *FinalOfferCreate {
 	return _c.SetValidatorUserID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the FinalOfferRevision entity by IDs.
+func (_c *FinalOfferCreate) AddRevisionIDs(ids ...int) *FinalOfferCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the FinalOfferRevision entity.
+func (_c *FinalOfferCreate) AddRevisions(v ...*FinalOfferRevision) *FinalOfferCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the FinalOfferMilestone entity by IDs.
+func (_c *FinalOfferCreate) AddMilestoneIDs(ids ...int) *FinalOfferCreate {
+	_c.mutation.AddMilestoneIDs(ids...)
+	return _c
+}
+
+// AddMilestones adds the "milestones" edges to the FinalOfferMilestone entity.
+func (_c *FinalOfferCreate) AddMilestones(v ...*FinalOfferMilestone) *FinalOfferCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMilestoneIDs(ids...)
+}
+
 // Mutation returns the FinalOfferMutation object of the builder.
 func (_c *FinalOfferCreate) Mutation() *FinalOfferMutation {
 	return _c.mutation
@@ -249,6 +357,14 @@ func (_c *FinalOfferCreate) defaults() {
 		v := finaloffer.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.CurrentRevision(); !ok {
+		v := finaloffer.DefaultCurrentRevision
+		_c.mutation.SetCurrentRevision(v)
+	}
+	if _, ok := _c.mutation.LastProposedBy(); !ok {
+		v := finaloffer.DefaultLastProposedBy
+		_c.mutation.SetLastProposedBy(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -312,6 +428,22 @@ func (_c *FinalOfferCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.CurrentRevision(); !ok {
+		return &ValidationError{Name: "current_revision", err: errors.New(`ent: missing required field "FinalOffer.current_revision"`)}
+	}
+	if v, ok := _c.mutation.CurrentRevision(); ok {
+		if err := finaloffer.CurrentRevisionValidator(v); err != nil {
+			return &ValidationError{Name: "current_revision", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.current_revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LastProposedBy(); !ok {
+		return &ValidationError{Name: "last_proposed_by", err: errors.New(`ent: missing required field "FinalOffer.last_proposed_by"`)}
+	}
+	if v, ok := _c.mutation.LastProposedBy(); ok {
+		if err := finaloffer.LastProposedByValidator(v); err != nil {
+			return &ValidationError{Name: "last_proposed_by", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.last_proposed_by": %w`, err)}
+		}
+	}
 	if len(_c.mutation.ValidationCaseIDs()) == 0 {
 		return &ValidationError{Name: "validation_case", err: errors.New(`ent: missing required edge "FinalOffer.validation_case"`)}
 	}
@@ -388,6 +520,30 @@ func (_c *FinalOfferCreate) createSpec() (*FinalOffer, *sqlgraph.CreateSpec) {
 		_spec.SetField(finaloffer.FieldRejectedAt, field.TypeTime, value)
 		_node.RejectedAt = &value
 	}
+	if value, ok := _c.mutation.CurrentRevision(); ok {
+		_spec.SetField(finaloffer.FieldCurrentRevision, field.TypeInt, value)
+		_node.CurrentRevision = value
+	}
+	if value, ok := _c.mutation.LastProposedBy(); ok {
+		_spec.SetField(finaloffer.FieldLastProposedBy, field.TypeString, value)
+		_node.LastProposedBy = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(finaloffer.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.WithdrawnAt(); ok {
+		_spec.SetField(finaloffer.FieldWithdrawnAt, field.TypeTime, value)
+		_node.WithdrawnAt = &value
+	}
+	if value, ok := _c.mutation.ExpiredAt(); ok {
+		_spec.SetField(finaloffer.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = &value
+	}
+	if value, ok := _c.mutation.MilestonePlan(); ok {
+		_spec.SetField(finaloffer.FieldMilestonePlan, field.TypeJSON, value)
+		_node.MilestonePlan = value
+	}
 	if nodes := _c.mutation.ValidationCaseIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -422,6 +578,38 @@ func (_c *FinalOfferCreate) createSpec() (*FinalOffer, *sqlgraph.CreateSpec) {
 		_node.ValidatorUserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
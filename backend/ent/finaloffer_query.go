@@ -4,10 +4,13 @@ package ent
 
 import (
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
 	"backend-gin/ent/predicate"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -26,6 +29,8 @@ type FinalOfferQuery struct {
 	predicates         []predicate.FinalOffer
 	withValidationCase *ValidationCaseQuery
 	withValidatorUser  *UserQuery
+	withRevisions      *FinalOfferRevisionQuery
+	withMilestones     *FinalOfferMilestoneQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +111,50 @@ func (_q *FinalOfferQuery) QueryValidatorUser() *UserQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *FinalOfferQuery) QueryRevisions() *FinalOfferRevisionQuery {
+	query := (&FinalOfferRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, selector),
+			sqlgraph.To(finalofferrevision.Table, finalofferrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, finaloffer.RevisionsTable, finaloffer.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMilestones chains the current query on the "milestones" edge.
+func (_q *FinalOfferQuery) QueryMilestones() *FinalOfferMilestoneQuery {
+	query := (&FinalOfferMilestoneClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(finaloffer.Table, finaloffer.FieldID, selector),
+			sqlgraph.To(finaloffermilestone.Table, finaloffermilestone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, finaloffer.MilestonesTable, finaloffer.MilestonesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first FinalOffer entity from the query.
 // Returns a *NotFoundError when no FinalOffer was found.
 func (_q *FinalOfferQuery) First(ctx context.Context) (*FinalOffer, error) {
@@ -300,6 +349,8 @@ func (_q *FinalOfferQuery) Clone() *FinalOfferQuery {
 		predicates:         append([]predicate.FinalOffer{}, _q.predicates...),
 		withValidationCase: _q.withValidationCase.Clone(),
 		withValidatorUser:  _q.withValidatorUser.Clone(),
+		withRevisions:      _q.withRevisions.Clone(),
+		withMilestones:     _q.withMilestones.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -328,6 +379,28 @@ func (_q *FinalOfferQuery) WithValidatorUser(opts ...func(*UserQuery)) *FinalOff
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FinalOfferQuery) WithRevisions(opts ...func(*FinalOfferRevisionQuery)) *FinalOfferQuery {
+	query := (&FinalOfferRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithMilestones tells the query-builder to eager-load the nodes that are connected to
+// the "milestones" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FinalOfferQuery) WithMilestones(opts ...func(*FinalOfferMilestoneQuery)) *FinalOfferQuery {
+	query := (&FinalOfferMilestoneClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMilestones = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +479,11 @@ func (_q *FinalOfferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*F
 	var (
 		nodes       = []*FinalOffer{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withValidationCase != nil,
 			_q.withValidatorUser != nil,
+			_q.withRevisions != nil,
+			_q.withMilestones != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +516,20 @@ func (_q *FinalOfferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*F
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *FinalOffer) { n.Edges.Revisions = []*FinalOfferRevision{} },
+			func(n *FinalOffer, e *FinalOfferRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMilestones; query != nil {
+		if err := _q.loadMilestones(ctx, query, nodes,
+			func(n *FinalOffer) { n.Edges.Milestones = []*FinalOfferMilestone{} },
+			func(n *FinalOffer, e *FinalOfferMilestone) { n.Edges.Milestones = append(n.Edges.Milestones, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +591,66 @@ func (_q *FinalOfferQuery) loadValidatorUser(ctx context.Context, query *UserQue
 	}
 	return nil
 }
+func (_q *FinalOfferQuery) loadRevisions(ctx context.Context, query *FinalOfferRevisionQuery, nodes []*FinalOffer, init func(*FinalOffer), assign func(*FinalOffer, *FinalOfferRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*FinalOffer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(finalofferrevision.FieldFinalOfferID)
+	}
+	query.Where(predicate.FinalOfferRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(finaloffer.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FinalOfferID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "final_offer_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FinalOfferQuery) loadMilestones(ctx context.Context, query *FinalOfferMilestoneQuery, nodes []*FinalOffer, init func(*FinalOffer), assign func(*FinalOffer, *FinalOfferMilestone)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*FinalOffer)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(finaloffermilestone.FieldFinalOfferID)
+	}
+	query.Where(predicate.FinalOfferMilestone(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(finaloffer.MilestonesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FinalOfferID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "final_offer_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *FinalOfferQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
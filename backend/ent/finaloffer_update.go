@@ -4,6 +4,8 @@ package ent
 
 import (
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
 	"backend-gin/ent/predicate"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
@@ -14,6 +16,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -241,6 +244,119 @@ func (_u *FinalOfferUpdate) ClearRejectedAt() *FinalOfferUpdate {
 	return _u
 }
 
+// SetCurrentRevision sets the "current_revision" field.
+func (_u *FinalOfferUpdate) SetCurrentRevision(v int) *FinalOfferUpdate {
+	_u.mutation.ResetCurrentRevision()
+	_u.mutation.SetCurrentRevision(v)
+	return _u
+}
+
+// SetNillableCurrentRevision sets the "current_revision" field if the given value is not nil.
+func (_u *FinalOfferUpdate) SetNillableCurrentRevision(v *int) *FinalOfferUpdate {
+	if v != nil {
+		_u.SetCurrentRevision(*v)
+	}
+	return _u
+}
+
+// AddCurrentRevision adds value to the "current_revision" field.
+func (_u *FinalOfferUpdate) AddCurrentRevision(v int) *FinalOfferUpdate {
+	_u.mutation.AddCurrentRevision(v)
+	return _u
+}
+
+// SetLastProposedBy sets the "last_proposed_by" field.
+func (_u *FinalOfferUpdate) SetLastProposedBy(v string) *FinalOfferUpdate {
+	_u.mutation.SetLastProposedBy(v)
+	return _u
+}
+
+// SetNillableLastProposedBy sets the "last_proposed_by" field if the given value is not nil.
+func (_u *FinalOfferUpdate) SetNillableLastProposedBy(v *string) *FinalOfferUpdate {
+	if v != nil {
+		_u.SetLastProposedBy(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FinalOfferUpdate) SetExpiresAt(v time.Time) *FinalOfferUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FinalOfferUpdate) SetNillableExpiresAt(v *time.Time) *FinalOfferUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FinalOfferUpdate) ClearExpiresAt() *FinalOfferUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetWithdrawnAt sets the "withdrawn_at" field.
+func (_u *FinalOfferUpdate) SetWithdrawnAt(v time.Time) *FinalOfferUpdate {
+	_u.mutation.SetWithdrawnAt(v)
+	return _u
+}
+
+// SetNillableWithdrawnAt sets the "withdrawn_at" field if the given value is not nil.
+func (_u *FinalOfferUpdate) SetNillableWithdrawnAt(v *time.Time) *FinalOfferUpdate {
+	if v != nil {
+		_u.SetWithdrawnAt(*v)
+	}
+	return _u
+}
+
+// ClearWithdrawnAt clears the value of the "withdrawn_at" field.
+func (_u *FinalOfferUpdate) ClearWithdrawnAt() *FinalOfferUpdate {
+	_u.mutation.ClearWithdrawnAt()
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *FinalOfferUpdate) SetExpiredAt(v time.Time) *FinalOfferUpdate {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *FinalOfferUpdate) SetNillableExpiredAt(v *time.Time) *FinalOfferUpdate {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *FinalOfferUpdate) ClearExpiredAt() *FinalOfferUpdate {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// SetMilestonePlan sets the "milestone_plan" field.
+func (_u *FinalOfferUpdate) SetMilestonePlan(v []map[string]interface{}) *FinalOfferUpdate {
+	_u.mutation.SetMilestonePlan(v)
+	return _u
+}
+
+// AppendMilestonePlan appends value to the "milestone_plan" field.
+func (_u *FinalOfferUpdate) AppendMilestonePlan(v []map[string]interface{}) *FinalOfferUpdate {
+	_u.mutation.AppendMilestonePlan(v)
+	return _u
+}
+
+// ClearMilestonePlan clears the value of the "milestone_plan" field.
+func (_u *FinalOfferUpdate) ClearMilestonePlan() *FinalOfferUpdate {
+	_u.mutation.ClearMilestonePlan()
+	return _u
+}
+
 // SetValidationCase sets the "validation_case" edge to the ValidationCase entity.
 func (_u *FinalOfferUpdate) SetValidationCase(v *ValidationCase) *FinalOfferUpdate {
 	return _u.SetValidationCaseID(v.ID)
@@ -251,6 +367,36 @@ func (_u *FinalOfferUpdate) SetValidatorUser(v *User) *FinalOfferUpdate {
 	return _u.SetValidatorUserID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the FinalOfferRevision entity by IDs.
+func (_u *FinalOfferUpdate) AddRevisionIDs(ids ...int) *FinalOfferUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the FinalOfferRevision entity.
+func (_u *FinalOfferUpdate) AddRevisions(v ...*FinalOfferRevision) *FinalOfferUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the FinalOfferMilestone entity by IDs.
+func (_u *FinalOfferUpdate) AddMilestoneIDs(ids ...int) *FinalOfferUpdate {
+	_u.mutation.AddMilestoneIDs(ids...)
+	return _u
+}
+
+// AddMilestones adds the "milestones" edges to the FinalOfferMilestone entity.
+func (_u *FinalOfferUpdate) AddMilestones(v ...*FinalOfferMilestone) *FinalOfferUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMilestoneIDs(ids...)
+}
+
 // Mutation returns the FinalOfferMutation object of the builder.
 func (_u *FinalOfferUpdate) Mutation() *FinalOfferMutation {
 	return _u.mutation
@@ -268,6 +414,48 @@ func (_u *FinalOfferUpdate) ClearValidatorUser() *FinalOfferUpdate {
 	return _u
 }
 
+// ClearRevisions clears all "revisions" edges to the FinalOfferRevision entity.
+func (_u *FinalOfferUpdate) ClearRevisions() *FinalOfferUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to FinalOfferRevision entities by IDs.
+func (_u *FinalOfferUpdate) RemoveRevisionIDs(ids ...int) *FinalOfferUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to FinalOfferRevision entities.
+func (_u *FinalOfferUpdate) RemoveRevisions(v ...*FinalOfferRevision) *FinalOfferUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the FinalOfferMilestone entity.
+func (_u *FinalOfferUpdate) ClearMilestones() *FinalOfferUpdate {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to FinalOfferMilestone entities by IDs.
+func (_u *FinalOfferUpdate) RemoveMilestoneIDs(ids ...int) *FinalOfferUpdate {
+	_u.mutation.RemoveMilestoneIDs(ids...)
+	return _u
+}
+
+// RemoveMilestones removes "milestones" edges to FinalOfferMilestone entities.
+func (_u *FinalOfferUpdate) RemoveMilestones(v ...*FinalOfferMilestone) *FinalOfferUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMilestoneIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FinalOfferUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -341,6 +529,16 @@ func (_u *FinalOfferUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CurrentRevision(); ok {
+		if err := finaloffer.CurrentRevisionValidator(v); err != nil {
+			return &ValidationError{Name: "current_revision", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.current_revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastProposedBy(); ok {
+		if err := finaloffer.LastProposedByValidator(v); err != nil {
+			return &ValidationError{Name: "last_proposed_by", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.last_proposed_by": %w`, err)}
+		}
+	}
 	if _u.mutation.ValidationCaseCleared() && len(_u.mutation.ValidationCaseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FinalOffer.validation_case"`)
 	}
@@ -416,6 +614,44 @@ func (_u *FinalOfferUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.RejectedAtCleared() {
 		_spec.ClearField(finaloffer.FieldRejectedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CurrentRevision(); ok {
+		_spec.SetField(finaloffer.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentRevision(); ok {
+		_spec.AddField(finaloffer.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastProposedBy(); ok {
+		_spec.SetField(finaloffer.FieldLastProposedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(finaloffer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(finaloffer.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.WithdrawnAt(); ok {
+		_spec.SetField(finaloffer.FieldWithdrawnAt, field.TypeTime, value)
+	}
+	if _u.mutation.WithdrawnAtCleared() {
+		_spec.ClearField(finaloffer.FieldWithdrawnAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(finaloffer.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(finaloffer.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MilestonePlan(); ok {
+		_spec.SetField(finaloffer.FieldMilestonePlan, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMilestonePlan(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, finaloffer.FieldMilestonePlan, value)
+		})
+	}
+	if _u.mutation.MilestonePlanCleared() {
+		_spec.ClearField(finaloffer.FieldMilestonePlan, field.TypeJSON)
+	}
 	if _u.mutation.ValidationCaseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -474,6 +710,96 @@ func (_u *FinalOfferUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !_u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{finaloffer.Label}
@@ -705,6 +1031,119 @@ func (_u *FinalOfferUpdateOne) ClearRejectedAt() *FinalOfferUpdateOne {
 	return _u
 }
 
+// SetCurrentRevision sets the "current_revision" field.
+func (_u *FinalOfferUpdateOne) SetCurrentRevision(v int) *FinalOfferUpdateOne {
+	_u.mutation.ResetCurrentRevision()
+	_u.mutation.SetCurrentRevision(v)
+	return _u
+}
+
+// SetNillableCurrentRevision sets the "current_revision" field if the given value is not nil.
+func (_u *FinalOfferUpdateOne) SetNillableCurrentRevision(v *int) *FinalOfferUpdateOne {
+	if v != nil {
+		_u.SetCurrentRevision(*v)
+	}
+	return _u
+}
+
+// AddCurrentRevision adds value to the "current_revision" field.
+func (_u *FinalOfferUpdateOne) AddCurrentRevision(v int) *FinalOfferUpdateOne {
+	_u.mutation.AddCurrentRevision(v)
+	return _u
+}
+
+// SetLastProposedBy sets the "last_proposed_by" field.
+func (_u *FinalOfferUpdateOne) SetLastProposedBy(v string) *FinalOfferUpdateOne {
+	_u.mutation.SetLastProposedBy(v)
+	return _u
+}
+
+// SetNillableLastProposedBy sets the "last_proposed_by" field if the given value is not nil.
+func (_u *FinalOfferUpdateOne) SetNillableLastProposedBy(v *string) *FinalOfferUpdateOne {
+	if v != nil {
+		_u.SetLastProposedBy(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FinalOfferUpdateOne) SetExpiresAt(v time.Time) *FinalOfferUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FinalOfferUpdateOne) SetNillableExpiresAt(v *time.Time) *FinalOfferUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FinalOfferUpdateOne) ClearExpiresAt() *FinalOfferUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetWithdrawnAt sets the "withdrawn_at" field.
+func (_u *FinalOfferUpdateOne) SetWithdrawnAt(v time.Time) *FinalOfferUpdateOne {
+	_u.mutation.SetWithdrawnAt(v)
+	return _u
+}
+
+// SetNillableWithdrawnAt sets the "withdrawn_at" field if the given value is not nil.
+func (_u *FinalOfferUpdateOne) SetNillableWithdrawnAt(v *time.Time) *FinalOfferUpdateOne {
+	if v != nil {
+		_u.SetWithdrawnAt(*v)
+	}
+	return _u
+}
+
+// ClearWithdrawnAt clears the value of the "withdrawn_at" field.
+func (_u *FinalOfferUpdateOne) ClearWithdrawnAt() *FinalOfferUpdateOne {
+	_u.mutation.ClearWithdrawnAt()
+	return _u
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (_u *FinalOfferUpdateOne) SetExpiredAt(v time.Time) *FinalOfferUpdateOne {
+	_u.mutation.SetExpiredAt(v)
+	return _u
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (_u *FinalOfferUpdateOne) SetNillableExpiredAt(v *time.Time) *FinalOfferUpdateOne {
+	if v != nil {
+		_u.SetExpiredAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiredAt clears the value of the "expired_at" field.
+func (_u *FinalOfferUpdateOne) ClearExpiredAt() *FinalOfferUpdateOne {
+	_u.mutation.ClearExpiredAt()
+	return _u
+}
+
+// SetMilestonePlan sets the "milestone_plan" field.
+func (_u *FinalOfferUpdateOne) SetMilestonePlan(v []map[string]interface{}) *FinalOfferUpdateOne {
+	_u.mutation.SetMilestonePlan(v)
+	return _u
+}
+
+// AppendMilestonePlan appends value to the "milestone_plan" field.
+func (_u *FinalOfferUpdateOne) AppendMilestonePlan(v []map[string]interface{}) *FinalOfferUpdateOne {
+	_u.mutation.AppendMilestonePlan(v)
+	return _u
+}
+
+// ClearMilestonePlan clears the value of the "milestone_plan" field.
+func (_u *FinalOfferUpdateOne) ClearMilestonePlan() *FinalOfferUpdateOne {
+	_u.mutation.ClearMilestonePlan()
+	return _u
+}
+
 // SetValidationCase sets the "validation_case" edge to the ValidationCase entity.
 func (_u *FinalOfferUpdateOne) SetValidationCase(v *ValidationCase) *FinalOfferUpdateOne {
 	return _u.SetValidationCaseID(v.ID)
@@ -715,6 +1154,36 @@ func (_u *FinalOfferUpdateOne) SetValidatorUser(v *User) *FinalOfferUpdateOne {
 	return _u.SetValidatorUserID(v.ID)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the FinalOfferRevision entity by IDs.
+func (_u *FinalOfferUpdateOne) AddRevisionIDs(ids ...int) *FinalOfferUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the FinalOfferRevision entity.
+func (_u *FinalOfferUpdateOne) AddRevisions(v ...*FinalOfferRevision) *FinalOfferUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the FinalOfferMilestone entity by IDs.
+func (_u *FinalOfferUpdateOne) AddMilestoneIDs(ids ...int) *FinalOfferUpdateOne {
+	_u.mutation.AddMilestoneIDs(ids...)
+	return _u
+}
+
+// AddMilestones adds the "milestones" edges to the FinalOfferMilestone entity.
+func (_u *FinalOfferUpdateOne) AddMilestones(v ...*FinalOfferMilestone) *FinalOfferUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMilestoneIDs(ids...)
+}
+
 // Mutation returns the FinalOfferMutation object of the builder.
 func (_u *FinalOfferUpdateOne) Mutation() *FinalOfferMutation {
 	return _u.mutation
@@ -732,6 +1201,48 @@ func (_u *FinalOfferUpdateOne) ClearValidatorUser() *FinalOfferUpdateOne {
 	return _u
 }
 
+// ClearRevisions clears all "revisions" edges to the FinalOfferRevision entity.
+func (_u *FinalOfferUpdateOne) ClearRevisions() *FinalOfferUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to FinalOfferRevision entities by IDs.
+func (_u *FinalOfferUpdateOne) RemoveRevisionIDs(ids ...int) *FinalOfferUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to FinalOfferRevision entities.
+func (_u *FinalOfferUpdateOne) RemoveRevisions(v ...*FinalOfferRevision) *FinalOfferUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the FinalOfferMilestone entity.
+func (_u *FinalOfferUpdateOne) ClearMilestones() *FinalOfferUpdateOne {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to FinalOfferMilestone entities by IDs.
+func (_u *FinalOfferUpdateOne) RemoveMilestoneIDs(ids ...int) *FinalOfferUpdateOne {
+	_u.mutation.RemoveMilestoneIDs(ids...)
+	return _u
+}
+
+// RemoveMilestones removes "milestones" edges to FinalOfferMilestone entities.
+func (_u *FinalOfferUpdateOne) RemoveMilestones(v ...*FinalOfferMilestone) *FinalOfferUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMilestoneIDs(ids...)
+}
+
 // Where appends a list predicates to the FinalOfferUpdate builder.
 func (_u *FinalOfferUpdateOne) Where(ps ...predicate.FinalOffer) *FinalOfferUpdateOne {
 	_u.mutation.Where(ps...)
@@ -818,6 +1329,16 @@ func (_u *FinalOfferUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CurrentRevision(); ok {
+		if err := finaloffer.CurrentRevisionValidator(v); err != nil {
+			return &ValidationError{Name: "current_revision", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.current_revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastProposedBy(); ok {
+		if err := finaloffer.LastProposedByValidator(v); err != nil {
+			return &ValidationError{Name: "last_proposed_by", err: fmt.Errorf(`ent: validator failed for field "FinalOffer.last_proposed_by": %w`, err)}
+		}
+	}
 	if _u.mutation.ValidationCaseCleared() && len(_u.mutation.ValidationCaseIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FinalOffer.validation_case"`)
 	}
@@ -910,6 +1431,44 @@ func (_u *FinalOfferUpdateOne) sqlSave(ctx context.Context) (_node *FinalOffer,
 	if _u.mutation.RejectedAtCleared() {
 		_spec.ClearField(finaloffer.FieldRejectedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CurrentRevision(); ok {
+		_spec.SetField(finaloffer.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentRevision(); ok {
+		_spec.AddField(finaloffer.FieldCurrentRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastProposedBy(); ok {
+		_spec.SetField(finaloffer.FieldLastProposedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(finaloffer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(finaloffer.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.WithdrawnAt(); ok {
+		_spec.SetField(finaloffer.FieldWithdrawnAt, field.TypeTime, value)
+	}
+	if _u.mutation.WithdrawnAtCleared() {
+		_spec.ClearField(finaloffer.FieldWithdrawnAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAt(); ok {
+		_spec.SetField(finaloffer.FieldExpiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiredAtCleared() {
+		_spec.ClearField(finaloffer.FieldExpiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MilestonePlan(); ok {
+		_spec.SetField(finaloffer.FieldMilestonePlan, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMilestonePlan(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, finaloffer.FieldMilestonePlan, value)
+		})
+	}
+	if _u.mutation.MilestonePlanCleared() {
+		_spec.ClearField(finaloffer.FieldMilestonePlan, field.TypeJSON)
+	}
 	if _u.mutation.ValidationCaseCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -968,6 +1527,96 @@ func (_u *FinalOfferUpdateOne) sqlSave(ctx context.Context) (_node *FinalOffer,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.RevisionsTable,
+			Columns: []string{finaloffer.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !_u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   finaloffer.MilestonesTable,
+			Columns: []string{finaloffer.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &FinalOffer{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
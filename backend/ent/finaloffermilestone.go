@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FinalOfferMilestone is the model entity for the FinalOfferMilestone schema.
+type FinalOfferMilestone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// FinalOfferID holds the value of the "final_offer_id" field.
+	FinalOfferID int `json:"final_offer_id,omitempty"`
+	// ValidationCaseID holds the value of the "validation_case_id" field.
+	ValidationCaseID int `json:"validation_case_id,omitempty"`
+	// Sequence holds the value of the "sequence" field.
+	Sequence int `json:"sequence,omitempty"`
+	// Deliverable holds the value of the "deliverable" field.
+	Deliverable string `json:"deliverable,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int64 `json:"amount,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt time.Time `json:"due_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// EscrowTransferID holds the value of the "escrow_transfer_id" field.
+	EscrowTransferID *string `json:"escrow_transfer_id,omitempty"`
+	// ArtifactDocumentID holds the value of the "artifact_document_id" field.
+	ArtifactDocumentID *string `json:"artifact_document_id,omitempty"`
+	// DisputeID holds the value of the "dispute_id" field.
+	DisputeID *string `json:"dispute_id,omitempty"`
+	// FundsLockedAt holds the value of the "funds_locked_at" field.
+	FundsLockedAt *time.Time `json:"funds_locked_at,omitempty"`
+	// ArtifactSubmittedAt holds the value of the "artifact_submitted_at" field.
+	ArtifactSubmittedAt *time.Time `json:"artifact_submitted_at,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// DisputedAt holds the value of the "disputed_at" field.
+	DisputedAt *time.Time `json:"disputed_at,omitempty"`
+	// RefundedAt holds the value of the "refunded_at" field.
+	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FinalOfferMilestoneQuery when eager-loading is set.
+	Edges        FinalOfferMilestoneEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FinalOfferMilestoneEdges holds the relations/edges for other nodes in the graph.
+type FinalOfferMilestoneEdges struct {
+	// FinalOffer holds the value of the final_offer edge.
+	FinalOffer *FinalOffer `json:"final_offer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FinalOfferOrErr returns the FinalOffer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FinalOfferMilestoneEdges) FinalOfferOrErr() (*FinalOffer, error) {
+	if e.FinalOffer != nil {
+		return e.FinalOffer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: finaloffer.Label}
+	}
+	return nil, &NotLoadedError{edge: "final_offer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FinalOfferMilestone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case finaloffermilestone.FieldID, finaloffermilestone.FieldFinalOfferID, finaloffermilestone.FieldValidationCaseID, finaloffermilestone.FieldSequence, finaloffermilestone.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case finaloffermilestone.FieldDeliverable, finaloffermilestone.FieldStatus, finaloffermilestone.FieldEscrowTransferID, finaloffermilestone.FieldArtifactDocumentID, finaloffermilestone.FieldDisputeID:
+			values[i] = new(sql.NullString)
+		case finaloffermilestone.FieldCreatedAt, finaloffermilestone.FieldUpdatedAt, finaloffermilestone.FieldDeletedAt, finaloffermilestone.FieldDueAt, finaloffermilestone.FieldFundsLockedAt, finaloffermilestone.FieldArtifactSubmittedAt, finaloffermilestone.FieldReleasedAt, finaloffermilestone.FieldDisputedAt, finaloffermilestone.FieldRefundedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FinalOfferMilestone fields.
+func (_m *FinalOfferMilestone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case finaloffermilestone.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case finaloffermilestone.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case finaloffermilestone.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case finaloffermilestone.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case finaloffermilestone.FieldFinalOfferID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field final_offer_id", values[i])
+			} else if value.Valid {
+				_m.FinalOfferID = int(value.Int64)
+			}
+		case finaloffermilestone.FieldValidationCaseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_case_id", values[i])
+			} else if value.Valid {
+				_m.ValidationCaseID = int(value.Int64)
+			}
+		case finaloffermilestone.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				_m.Sequence = int(value.Int64)
+			}
+		case finaloffermilestone.FieldDeliverable:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deliverable", values[i])
+			} else if value.Valid {
+				_m.Deliverable = value.String
+			}
+		case finaloffermilestone.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case finaloffermilestone.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = value.Time
+			}
+		case finaloffermilestone.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case finaloffermilestone.FieldEscrowTransferID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field escrow_transfer_id", values[i])
+			} else if value.Valid {
+				_m.EscrowTransferID = new(string)
+				*_m.EscrowTransferID = value.String
+			}
+		case finaloffermilestone.FieldArtifactDocumentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_document_id", values[i])
+			} else if value.Valid {
+				_m.ArtifactDocumentID = new(string)
+				*_m.ArtifactDocumentID = value.String
+			}
+		case finaloffermilestone.FieldDisputeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_id", values[i])
+			} else if value.Valid {
+				_m.DisputeID = new(string)
+				*_m.DisputeID = value.String
+			}
+		case finaloffermilestone.FieldFundsLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field funds_locked_at", values[i])
+			} else if value.Valid {
+				_m.FundsLockedAt = new(time.Time)
+				*_m.FundsLockedAt = value.Time
+			}
+		case finaloffermilestone.FieldArtifactSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_submitted_at", values[i])
+			} else if value.Valid {
+				_m.ArtifactSubmittedAt = new(time.Time)
+				*_m.ArtifactSubmittedAt = value.Time
+			}
+		case finaloffermilestone.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		case finaloffermilestone.FieldDisputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disputed_at", values[i])
+			} else if value.Valid {
+				_m.DisputedAt = new(time.Time)
+				*_m.DisputedAt = value.Time
+			}
+		case finaloffermilestone.FieldRefundedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_at", values[i])
+			} else if value.Valid {
+				_m.RefundedAt = new(time.Time)
+				*_m.RefundedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FinalOfferMilestone.
+// This includes values selected through modifiers, order, etc.
+func (_m *FinalOfferMilestone) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFinalOffer queries the "final_offer" edge of the FinalOfferMilestone entity.
+func (_m *FinalOfferMilestone) QueryFinalOffer() *FinalOfferQuery {
+	return NewFinalOfferMilestoneClient(_m.config).QueryFinalOffer(_m)
+}
+
+// Update returns a builder for updating this FinalOfferMilestone.
+// Note that you need to call FinalOfferMilestone.Unwrap() before calling this method if this FinalOfferMilestone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FinalOfferMilestone) Update() *FinalOfferMilestoneUpdateOne {
+	return NewFinalOfferMilestoneClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FinalOfferMilestone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FinalOfferMilestone) Unwrap() *FinalOfferMilestone {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FinalOfferMilestone is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FinalOfferMilestone) String() string {
+	var builder strings.Builder
+	builder.WriteString("FinalOfferMilestone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("final_offer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinalOfferID))
+	builder.WriteString(", ")
+	builder.WriteString("validation_case_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidationCaseID))
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("deliverable=")
+	builder.WriteString(_m.Deliverable)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("due_at=")
+	builder.WriteString(_m.DueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.EscrowTransferID; v != nil {
+		builder.WriteString("escrow_transfer_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ArtifactDocumentID; v != nil {
+		builder.WriteString("artifact_document_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DisputeID; v != nil {
+		builder.WriteString("dispute_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FundsLockedAt; v != nil {
+		builder.WriteString("funds_locked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArtifactSubmittedAt; v != nil {
+		builder.WriteString("artifact_submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DisputedAt; v != nil {
+		builder.WriteString("disputed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RefundedAt; v != nil {
+		builder.WriteString("refunded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FinalOfferMilestones is a parsable slice of FinalOfferMilestone.
+type FinalOfferMilestones []*FinalOfferMilestone
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package finaloffermilestone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the finaloffermilestone type in the database.
+	Label = "final_offer_milestone"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFinalOfferID holds the string denoting the final_offer_id field in the database.
+	FieldFinalOfferID = "final_offer_id"
+	// FieldValidationCaseID holds the string denoting the validation_case_id field in the database.
+	FieldValidationCaseID = "validation_case_id"
+	// FieldSequence holds the string denoting the sequence field in the database.
+	FieldSequence = "sequence"
+	// FieldDeliverable holds the string denoting the deliverable field in the database.
+	FieldDeliverable = "deliverable"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldEscrowTransferID holds the string denoting the escrow_transfer_id field in the database.
+	FieldEscrowTransferID = "escrow_transfer_id"
+	// FieldArtifactDocumentID holds the string denoting the artifact_document_id field in the database.
+	FieldArtifactDocumentID = "artifact_document_id"
+	// FieldDisputeID holds the string denoting the dispute_id field in the database.
+	FieldDisputeID = "dispute_id"
+	// FieldFundsLockedAt holds the string denoting the funds_locked_at field in the database.
+	FieldFundsLockedAt = "funds_locked_at"
+	// FieldArtifactSubmittedAt holds the string denoting the artifact_submitted_at field in the database.
+	FieldArtifactSubmittedAt = "artifact_submitted_at"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldDisputedAt holds the string denoting the disputed_at field in the database.
+	FieldDisputedAt = "disputed_at"
+	// FieldRefundedAt holds the string denoting the refunded_at field in the database.
+	FieldRefundedAt = "refunded_at"
+	// EdgeFinalOffer holds the string denoting the final_offer edge name in mutations.
+	EdgeFinalOffer = "final_offer"
+	// Table holds the table name of the finaloffermilestone in the database.
+	Table = "final_offer_milestones"
+	// FinalOfferTable is the table that holds the final_offer relation/edge.
+	FinalOfferTable = "final_offer_milestones"
+	// FinalOfferInverseTable is the table name for the FinalOffer entity.
+	// It exists in this package in order to avoid circular dependency with the "finaloffer" package.
+	FinalOfferInverseTable = "final_offers"
+	// FinalOfferColumn is the table column denoting the final_offer relation/edge.
+	FinalOfferColumn = "final_offer_id"
+)
+
+// Columns holds all SQL columns for finaloffermilestone fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldFinalOfferID,
+	FieldValidationCaseID,
+	FieldSequence,
+	FieldDeliverable,
+	FieldAmount,
+	FieldDueAt,
+	FieldStatus,
+	FieldEscrowTransferID,
+	FieldArtifactDocumentID,
+	FieldDisputeID,
+	FieldFundsLockedAt,
+	FieldArtifactSubmittedAt,
+	FieldReleasedAt,
+	FieldDisputedAt,
+	FieldRefundedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	FinalOfferIDValidator func(int) error
+	// ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	ValidationCaseIDValidator func(int) error
+	// SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	SequenceValidator func(int) error
+	// DeliverableValidator is a validator for the "deliverable" field. It is called by the builders before save.
+	DeliverableValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int64) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// EscrowTransferIDValidator is a validator for the "escrow_transfer_id" field. It is called by the builders before save.
+	EscrowTransferIDValidator func(string) error
+	// DisputeIDValidator is a validator for the "dispute_id" field. It is called by the builders before save.
+	DisputeIDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FinalOfferMilestone queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFinalOfferID orders the results by the final_offer_id field.
+func ByFinalOfferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalOfferID, opts...).ToFunc()
+}
+
+// ByValidationCaseID orders the results by the validation_case_id field.
+func ByValidationCaseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationCaseID, opts...).ToFunc()
+}
+
+// BySequence orders the results by the sequence field.
+func BySequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSequence, opts...).ToFunc()
+}
+
+// ByDeliverable orders the results by the deliverable field.
+func ByDeliverable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliverable, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByEscrowTransferID orders the results by the escrow_transfer_id field.
+func ByEscrowTransferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscrowTransferID, opts...).ToFunc()
+}
+
+// ByArtifactDocumentID orders the results by the artifact_document_id field.
+func ByArtifactDocumentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArtifactDocumentID, opts...).ToFunc()
+}
+
+// ByDisputeID orders the results by the dispute_id field.
+func ByDisputeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputeID, opts...).ToFunc()
+}
+
+// ByFundsLockedAt orders the results by the funds_locked_at field.
+func ByFundsLockedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFundsLockedAt, opts...).ToFunc()
+}
+
+// ByArtifactSubmittedAt orders the results by the artifact_submitted_at field.
+func ByArtifactSubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArtifactSubmittedAt, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByDisputedAt orders the results by the disputed_at field.
+func ByDisputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputedAt, opts...).ToFunc()
+}
+
+// ByRefundedAt orders the results by the refunded_at field.
+func ByRefundedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedAt, opts...).ToFunc()
+}
+
+// ByFinalOfferField orders the results by final_offer field.
+func ByFinalOfferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFinalOfferStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFinalOfferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FinalOfferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FinalOfferTable, FinalOfferColumn),
+	)
+}
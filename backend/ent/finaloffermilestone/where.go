@@ -0,0 +1,1099 @@
+// Code generated by ent, DO NOT EDIT.
+
+package finaloffermilestone
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FinalOfferID applies equality check predicate on the "final_offer_id" field. It's identical to FinalOfferIDEQ.
+func FinalOfferID(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldSequence, v))
+}
+
+// Deliverable applies equality check predicate on the "deliverable" field. It's identical to DeliverableEQ.
+func Deliverable(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDeliverable, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldAmount, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDueAt, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldStatus, v))
+}
+
+// EscrowTransferID applies equality check predicate on the "escrow_transfer_id" field. It's identical to EscrowTransferIDEQ.
+func EscrowTransferID(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldEscrowTransferID, v))
+}
+
+// ArtifactDocumentID applies equality check predicate on the "artifact_document_id" field. It's identical to ArtifactDocumentIDEQ.
+func ArtifactDocumentID(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldArtifactDocumentID, v))
+}
+
+// DisputeID applies equality check predicate on the "dispute_id" field. It's identical to DisputeIDEQ.
+func DisputeID(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDisputeID, v))
+}
+
+// FundsLockedAt applies equality check predicate on the "funds_locked_at" field. It's identical to FundsLockedAtEQ.
+func FundsLockedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldFundsLockedAt, v))
+}
+
+// ArtifactSubmittedAt applies equality check predicate on the "artifact_submitted_at" field. It's identical to ArtifactSubmittedAtEQ.
+func ArtifactSubmittedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldArtifactSubmittedAt, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// DisputedAt applies equality check predicate on the "disputed_at" field. It's identical to DisputedAtEQ.
+func DisputedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDisputedAt, v))
+}
+
+// RefundedAt applies equality check predicate on the "refunded_at" field. It's identical to RefundedAtEQ.
+func RefundedAt(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldRefundedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FinalOfferIDEQ applies the EQ predicate on the "final_offer_id" field.
+func FinalOfferIDEQ(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDNEQ applies the NEQ predicate on the "final_offer_id" field.
+func FinalOfferIDNEQ(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDIn applies the In predicate on the "final_offer_id" field.
+func FinalOfferIDIn(vs ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldFinalOfferID, vs...))
+}
+
+// FinalOfferIDNotIn applies the NotIn predicate on the "final_offer_id" field.
+func FinalOfferIDNotIn(vs ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldFinalOfferID, vs...))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v int) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldSequence, v))
+}
+
+// DeliverableEQ applies the EQ predicate on the "deliverable" field.
+func DeliverableEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDeliverable, v))
+}
+
+// DeliverableNEQ applies the NEQ predicate on the "deliverable" field.
+func DeliverableNEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldDeliverable, v))
+}
+
+// DeliverableIn applies the In predicate on the "deliverable" field.
+func DeliverableIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldDeliverable, vs...))
+}
+
+// DeliverableNotIn applies the NotIn predicate on the "deliverable" field.
+func DeliverableNotIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldDeliverable, vs...))
+}
+
+// DeliverableGT applies the GT predicate on the "deliverable" field.
+func DeliverableGT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldDeliverable, v))
+}
+
+// DeliverableGTE applies the GTE predicate on the "deliverable" field.
+func DeliverableGTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldDeliverable, v))
+}
+
+// DeliverableLT applies the LT predicate on the "deliverable" field.
+func DeliverableLT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldDeliverable, v))
+}
+
+// DeliverableLTE applies the LTE predicate on the "deliverable" field.
+func DeliverableLTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldDeliverable, v))
+}
+
+// DeliverableContains applies the Contains predicate on the "deliverable" field.
+func DeliverableContains(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContains(FieldDeliverable, v))
+}
+
+// DeliverableHasPrefix applies the HasPrefix predicate on the "deliverable" field.
+func DeliverableHasPrefix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasPrefix(FieldDeliverable, v))
+}
+
+// DeliverableHasSuffix applies the HasSuffix predicate on the "deliverable" field.
+func DeliverableHasSuffix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasSuffix(FieldDeliverable, v))
+}
+
+// DeliverableEqualFold applies the EqualFold predicate on the "deliverable" field.
+func DeliverableEqualFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEqualFold(FieldDeliverable, v))
+}
+
+// DeliverableContainsFold applies the ContainsFold predicate on the "deliverable" field.
+func DeliverableContainsFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContainsFold(FieldDeliverable, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldAmount, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldDueAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// EscrowTransferIDEQ applies the EQ predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDNEQ applies the NEQ predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDNEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDIn applies the In predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldEscrowTransferID, vs...))
+}
+
+// EscrowTransferIDNotIn applies the NotIn predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDNotIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldEscrowTransferID, vs...))
+}
+
+// EscrowTransferIDGT applies the GT predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDGT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDGTE applies the GTE predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDGTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDLT applies the LT predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDLT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDLTE applies the LTE predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDLTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDContains applies the Contains predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDContains(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContains(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDHasPrefix applies the HasPrefix predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDHasPrefix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasPrefix(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDHasSuffix applies the HasSuffix predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDHasSuffix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasSuffix(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDIsNil applies the IsNil predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldEscrowTransferID))
+}
+
+// EscrowTransferIDNotNil applies the NotNil predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldEscrowTransferID))
+}
+
+// EscrowTransferIDEqualFold applies the EqualFold predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDEqualFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEqualFold(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDContainsFold applies the ContainsFold predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDContainsFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContainsFold(FieldEscrowTransferID, v))
+}
+
+// ArtifactDocumentIDEQ applies the EQ predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDNEQ applies the NEQ predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDNEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDIn applies the In predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldArtifactDocumentID, vs...))
+}
+
+// ArtifactDocumentIDNotIn applies the NotIn predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDNotIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldArtifactDocumentID, vs...))
+}
+
+// ArtifactDocumentIDGT applies the GT predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDGT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDGTE applies the GTE predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDGTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDLT applies the LT predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDLT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDLTE applies the LTE predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDLTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDContains applies the Contains predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDContains(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContains(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDHasPrefix applies the HasPrefix predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDHasPrefix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasPrefix(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDHasSuffix applies the HasSuffix predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDHasSuffix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasSuffix(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDIsNil applies the IsNil predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldArtifactDocumentID))
+}
+
+// ArtifactDocumentIDNotNil applies the NotNil predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldArtifactDocumentID))
+}
+
+// ArtifactDocumentIDEqualFold applies the EqualFold predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDEqualFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEqualFold(FieldArtifactDocumentID, v))
+}
+
+// ArtifactDocumentIDContainsFold applies the ContainsFold predicate on the "artifact_document_id" field.
+func ArtifactDocumentIDContainsFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContainsFold(FieldArtifactDocumentID, v))
+}
+
+// DisputeIDEQ applies the EQ predicate on the "dispute_id" field.
+func DisputeIDEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDisputeID, v))
+}
+
+// DisputeIDNEQ applies the NEQ predicate on the "dispute_id" field.
+func DisputeIDNEQ(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldDisputeID, v))
+}
+
+// DisputeIDIn applies the In predicate on the "dispute_id" field.
+func DisputeIDIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldDisputeID, vs...))
+}
+
+// DisputeIDNotIn applies the NotIn predicate on the "dispute_id" field.
+func DisputeIDNotIn(vs ...string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldDisputeID, vs...))
+}
+
+// DisputeIDGT applies the GT predicate on the "dispute_id" field.
+func DisputeIDGT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldDisputeID, v))
+}
+
+// DisputeIDGTE applies the GTE predicate on the "dispute_id" field.
+func DisputeIDGTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldDisputeID, v))
+}
+
+// DisputeIDLT applies the LT predicate on the "dispute_id" field.
+func DisputeIDLT(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldDisputeID, v))
+}
+
+// DisputeIDLTE applies the LTE predicate on the "dispute_id" field.
+func DisputeIDLTE(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldDisputeID, v))
+}
+
+// DisputeIDContains applies the Contains predicate on the "dispute_id" field.
+func DisputeIDContains(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContains(FieldDisputeID, v))
+}
+
+// DisputeIDHasPrefix applies the HasPrefix predicate on the "dispute_id" field.
+func DisputeIDHasPrefix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasPrefix(FieldDisputeID, v))
+}
+
+// DisputeIDHasSuffix applies the HasSuffix predicate on the "dispute_id" field.
+func DisputeIDHasSuffix(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldHasSuffix(FieldDisputeID, v))
+}
+
+// DisputeIDIsNil applies the IsNil predicate on the "dispute_id" field.
+func DisputeIDIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldDisputeID))
+}
+
+// DisputeIDNotNil applies the NotNil predicate on the "dispute_id" field.
+func DisputeIDNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldDisputeID))
+}
+
+// DisputeIDEqualFold applies the EqualFold predicate on the "dispute_id" field.
+func DisputeIDEqualFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEqualFold(FieldDisputeID, v))
+}
+
+// DisputeIDContainsFold applies the ContainsFold predicate on the "dispute_id" field.
+func DisputeIDContainsFold(v string) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldContainsFold(FieldDisputeID, v))
+}
+
+// FundsLockedAtEQ applies the EQ predicate on the "funds_locked_at" field.
+func FundsLockedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldFundsLockedAt, v))
+}
+
+// FundsLockedAtNEQ applies the NEQ predicate on the "funds_locked_at" field.
+func FundsLockedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldFundsLockedAt, v))
+}
+
+// FundsLockedAtIn applies the In predicate on the "funds_locked_at" field.
+func FundsLockedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldFundsLockedAt, vs...))
+}
+
+// FundsLockedAtNotIn applies the NotIn predicate on the "funds_locked_at" field.
+func FundsLockedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldFundsLockedAt, vs...))
+}
+
+// FundsLockedAtGT applies the GT predicate on the "funds_locked_at" field.
+func FundsLockedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldFundsLockedAt, v))
+}
+
+// FundsLockedAtGTE applies the GTE predicate on the "funds_locked_at" field.
+func FundsLockedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldFundsLockedAt, v))
+}
+
+// FundsLockedAtLT applies the LT predicate on the "funds_locked_at" field.
+func FundsLockedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldFundsLockedAt, v))
+}
+
+// FundsLockedAtLTE applies the LTE predicate on the "funds_locked_at" field.
+func FundsLockedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldFundsLockedAt, v))
+}
+
+// FundsLockedAtIsNil applies the IsNil predicate on the "funds_locked_at" field.
+func FundsLockedAtIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldFundsLockedAt))
+}
+
+// FundsLockedAtNotNil applies the NotNil predicate on the "funds_locked_at" field.
+func FundsLockedAtNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldFundsLockedAt))
+}
+
+// ArtifactSubmittedAtEQ applies the EQ predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldArtifactSubmittedAt, v))
+}
+
+// ArtifactSubmittedAtNEQ applies the NEQ predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldArtifactSubmittedAt, v))
+}
+
+// ArtifactSubmittedAtIn applies the In predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldArtifactSubmittedAt, vs...))
+}
+
+// ArtifactSubmittedAtNotIn applies the NotIn predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldArtifactSubmittedAt, vs...))
+}
+
+// ArtifactSubmittedAtGT applies the GT predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldArtifactSubmittedAt, v))
+}
+
+// ArtifactSubmittedAtGTE applies the GTE predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldArtifactSubmittedAt, v))
+}
+
+// ArtifactSubmittedAtLT applies the LT predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldArtifactSubmittedAt, v))
+}
+
+// ArtifactSubmittedAtLTE applies the LTE predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldArtifactSubmittedAt, v))
+}
+
+// ArtifactSubmittedAtIsNil applies the IsNil predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldArtifactSubmittedAt))
+}
+
+// ArtifactSubmittedAtNotNil applies the NotNil predicate on the "artifact_submitted_at" field.
+func ArtifactSubmittedAtNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldArtifactSubmittedAt))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// DisputedAtEQ applies the EQ predicate on the "disputed_at" field.
+func DisputedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldDisputedAt, v))
+}
+
+// DisputedAtNEQ applies the NEQ predicate on the "disputed_at" field.
+func DisputedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldDisputedAt, v))
+}
+
+// DisputedAtIn applies the In predicate on the "disputed_at" field.
+func DisputedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldDisputedAt, vs...))
+}
+
+// DisputedAtNotIn applies the NotIn predicate on the "disputed_at" field.
+func DisputedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldDisputedAt, vs...))
+}
+
+// DisputedAtGT applies the GT predicate on the "disputed_at" field.
+func DisputedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldDisputedAt, v))
+}
+
+// DisputedAtGTE applies the GTE predicate on the "disputed_at" field.
+func DisputedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldDisputedAt, v))
+}
+
+// DisputedAtLT applies the LT predicate on the "disputed_at" field.
+func DisputedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldDisputedAt, v))
+}
+
+// DisputedAtLTE applies the LTE predicate on the "disputed_at" field.
+func DisputedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldDisputedAt, v))
+}
+
+// DisputedAtIsNil applies the IsNil predicate on the "disputed_at" field.
+func DisputedAtIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldDisputedAt))
+}
+
+// DisputedAtNotNil applies the NotNil predicate on the "disputed_at" field.
+func DisputedAtNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldDisputedAt))
+}
+
+// RefundedAtEQ applies the EQ predicate on the "refunded_at" field.
+func RefundedAtEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldEQ(FieldRefundedAt, v))
+}
+
+// RefundedAtNEQ applies the NEQ predicate on the "refunded_at" field.
+func RefundedAtNEQ(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNEQ(FieldRefundedAt, v))
+}
+
+// RefundedAtIn applies the In predicate on the "refunded_at" field.
+func RefundedAtIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIn(FieldRefundedAt, vs...))
+}
+
+// RefundedAtNotIn applies the NotIn predicate on the "refunded_at" field.
+func RefundedAtNotIn(vs ...time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotIn(FieldRefundedAt, vs...))
+}
+
+// RefundedAtGT applies the GT predicate on the "refunded_at" field.
+func RefundedAtGT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGT(FieldRefundedAt, v))
+}
+
+// RefundedAtGTE applies the GTE predicate on the "refunded_at" field.
+func RefundedAtGTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldGTE(FieldRefundedAt, v))
+}
+
+// RefundedAtLT applies the LT predicate on the "refunded_at" field.
+func RefundedAtLT(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLT(FieldRefundedAt, v))
+}
+
+// RefundedAtLTE applies the LTE predicate on the "refunded_at" field.
+func RefundedAtLTE(v time.Time) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldLTE(FieldRefundedAt, v))
+}
+
+// RefundedAtIsNil applies the IsNil predicate on the "refunded_at" field.
+func RefundedAtIsNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldIsNull(FieldRefundedAt))
+}
+
+// RefundedAtNotNil applies the NotNil predicate on the "refunded_at" field.
+func RefundedAtNotNil() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.FieldNotNull(FieldRefundedAt))
+}
+
+// HasFinalOffer applies the HasEdge predicate on the "final_offer" edge.
+func HasFinalOffer() predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FinalOfferTable, FinalOfferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFinalOfferWith applies the HasEdge predicate on the "final_offer" edge with a given conditions (other predicates).
+func HasFinalOfferWith(preds ...predicate.FinalOffer) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(func(s *sql.Selector) {
+		step := newFinalOfferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FinalOfferMilestone) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FinalOfferMilestone) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FinalOfferMilestone) predicate.FinalOfferMilestone {
+	return predicate.FinalOfferMilestone(sql.NotPredicates(p))
+}
@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FinalOfferMilestoneCreate is the builder for creating a FinalOfferMilestone entity.
+type FinalOfferMilestoneCreate struct {
+	config
+	mutation *FinalOfferMilestoneMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FinalOfferMilestoneCreate) SetCreatedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableCreatedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FinalOfferMilestoneCreate) SetUpdatedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableUpdatedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FinalOfferMilestoneCreate) SetDeletedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableDeletedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_c *FinalOfferMilestoneCreate) SetFinalOfferID(v int) *FinalOfferMilestoneCreate {
+	_c.mutation.SetFinalOfferID(v)
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *FinalOfferMilestoneCreate) SetValidationCaseID(v int) *FinalOfferMilestoneCreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetSequence sets the "sequence" field.
+func (_c *FinalOfferMilestoneCreate) SetSequence(v int) *FinalOfferMilestoneCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetDeliverable sets the "deliverable" field.
+func (_c *FinalOfferMilestoneCreate) SetDeliverable(v string) *FinalOfferMilestoneCreate {
+	_c.mutation.SetDeliverable(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *FinalOfferMilestoneCreate) SetAmount(v int64) *FinalOfferMilestoneCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *FinalOfferMilestoneCreate) SetDueAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *FinalOfferMilestoneCreate) SetStatus(v string) *FinalOfferMilestoneCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableStatus(v *string) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetEscrowTransferID sets the "escrow_transfer_id" field.
+func (_c *FinalOfferMilestoneCreate) SetEscrowTransferID(v string) *FinalOfferMilestoneCreate {
+	_c.mutation.SetEscrowTransferID(v)
+	return _c
+}
+
+// SetNillableEscrowTransferID sets the "escrow_transfer_id" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableEscrowTransferID(v *string) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetEscrowTransferID(*v)
+	}
+	return _c
+}
+
+// SetArtifactDocumentID sets the "artifact_document_id" field.
+func (_c *FinalOfferMilestoneCreate) SetArtifactDocumentID(v string) *FinalOfferMilestoneCreate {
+	_c.mutation.SetArtifactDocumentID(v)
+	return _c
+}
+
+// SetNillableArtifactDocumentID sets the "artifact_document_id" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableArtifactDocumentID(v *string) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetArtifactDocumentID(*v)
+	}
+	return _c
+}
+
+// SetDisputeID sets the "dispute_id" field.
+func (_c *FinalOfferMilestoneCreate) SetDisputeID(v string) *FinalOfferMilestoneCreate {
+	_c.mutation.SetDisputeID(v)
+	return _c
+}
+
+// SetNillableDisputeID sets the "dispute_id" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableDisputeID(v *string) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetDisputeID(*v)
+	}
+	return _c
+}
+
+// SetFundsLockedAt sets the "funds_locked_at" field.
+func (_c *FinalOfferMilestoneCreate) SetFundsLockedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetFundsLockedAt(v)
+	return _c
+}
+
+// SetNillableFundsLockedAt sets the "funds_locked_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableFundsLockedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetFundsLockedAt(*v)
+	}
+	return _c
+}
+
+// SetArtifactSubmittedAt sets the "artifact_submitted_at" field.
+func (_c *FinalOfferMilestoneCreate) SetArtifactSubmittedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetArtifactSubmittedAt(v)
+	return _c
+}
+
+// SetNillableArtifactSubmittedAt sets the "artifact_submitted_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableArtifactSubmittedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetArtifactSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *FinalOfferMilestoneCreate) SetReleasedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableReleasedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetDisputedAt sets the "disputed_at" field.
+func (_c *FinalOfferMilestoneCreate) SetDisputedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetDisputedAt(v)
+	return _c
+}
+
+// SetNillableDisputedAt sets the "disputed_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableDisputedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetDisputedAt(*v)
+	}
+	return _c
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_c *FinalOfferMilestoneCreate) SetRefundedAt(v time.Time) *FinalOfferMilestoneCreate {
+	_c.mutation.SetRefundedAt(v)
+	return _c
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_c *FinalOfferMilestoneCreate) SetNillableRefundedAt(v *time.Time) *FinalOfferMilestoneCreate {
+	if v != nil {
+		_c.SetRefundedAt(*v)
+	}
+	return _c
+}
+
+// SetFinalOffer sets the "final_offer" edge to the FinalOffer entity.
+func (_c *FinalOfferMilestoneCreate) SetFinalOffer(v *FinalOffer) *FinalOfferMilestoneCreate {
+	return _c.SetFinalOfferID(v.ID)
+}
+
+// Mutation returns the FinalOfferMilestoneMutation object of the builder.
+func (_c *FinalOfferMilestoneCreate) Mutation() *FinalOfferMilestoneMutation {
+	return _c.mutation
+}
+
+// Save creates the FinalOfferMilestone in the database.
+func (_c *FinalOfferMilestoneCreate) Save(ctx context.Context) (*FinalOfferMilestone, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FinalOfferMilestoneCreate) SaveX(ctx context.Context) *FinalOfferMilestone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FinalOfferMilestoneCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FinalOfferMilestoneCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FinalOfferMilestoneCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := finaloffermilestone.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := finaloffermilestone.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := finaloffermilestone.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FinalOfferMilestoneCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FinalOfferMilestone.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FinalOfferMilestone.updated_at"`)}
+	}
+	if _, ok := _c.mutation.FinalOfferID(); !ok {
+		return &ValidationError{Name: "final_offer_id", err: errors.New(`ent: missing required field "FinalOfferMilestone.final_offer_id"`)}
+	}
+	if v, ok := _c.mutation.FinalOfferID(); ok {
+		if err := finaloffermilestone.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.final_offer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "FinalOfferMilestone.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := finaloffermilestone.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "FinalOfferMilestone.sequence"`)}
+	}
+	if v, ok := _c.mutation.Sequence(); ok {
+		if err := finaloffermilestone.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.sequence": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Deliverable(); !ok {
+		return &ValidationError{Name: "deliverable", err: errors.New(`ent: missing required field "FinalOfferMilestone.deliverable"`)}
+	}
+	if v, ok := _c.mutation.Deliverable(); ok {
+		if err := finaloffermilestone.DeliverableValidator(v); err != nil {
+			return &ValidationError{Name: "deliverable", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.deliverable": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "FinalOfferMilestone.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := finaloffermilestone.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DueAt(); !ok {
+		return &ValidationError{Name: "due_at", err: errors.New(`ent: missing required field "FinalOfferMilestone.due_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "FinalOfferMilestone.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := finaloffermilestone.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EscrowTransferID(); ok {
+		if err := finaloffermilestone.EscrowTransferIDValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_transfer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.escrow_transfer_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DisputeID(); ok {
+		if err := finaloffermilestone.DisputeIDValidator(v); err != nil {
+			return &ValidationError{Name: "dispute_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.dispute_id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.FinalOfferIDs()) == 0 {
+		return &ValidationError{Name: "final_offer", err: errors.New(`ent: missing required edge "FinalOfferMilestone.final_offer"`)}
+	}
+	return nil
+}
+
+func (_c *FinalOfferMilestoneCreate) sqlSave(ctx context.Context) (*FinalOfferMilestone, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FinalOfferMilestoneCreate) createSpec() (*FinalOfferMilestone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FinalOfferMilestone{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(finaloffermilestone.Table, sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(finaloffermilestone.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(finaloffermilestone.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := _c.mutation.Deliverable(); ok {
+		_spec.SetField(finaloffermilestone.FieldDeliverable, field.TypeString, value)
+		_node.Deliverable = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(finaloffermilestone.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(finaloffermilestone.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.EscrowTransferID(); ok {
+		_spec.SetField(finaloffermilestone.FieldEscrowTransferID, field.TypeString, value)
+		_node.EscrowTransferID = &value
+	}
+	if value, ok := _c.mutation.ArtifactDocumentID(); ok {
+		_spec.SetField(finaloffermilestone.FieldArtifactDocumentID, field.TypeString, value)
+		_node.ArtifactDocumentID = &value
+	}
+	if value, ok := _c.mutation.DisputeID(); ok {
+		_spec.SetField(finaloffermilestone.FieldDisputeID, field.TypeString, value)
+		_node.DisputeID = &value
+	}
+	if value, ok := _c.mutation.FundsLockedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldFundsLockedAt, field.TypeTime, value)
+		_node.FundsLockedAt = &value
+	}
+	if value, ok := _c.mutation.ArtifactSubmittedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldArtifactSubmittedAt, field.TypeTime, value)
+		_node.ArtifactSubmittedAt = &value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := _c.mutation.DisputedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDisputedAt, field.TypeTime, value)
+		_node.DisputedAt = &value
+	}
+	if value, ok := _c.mutation.RefundedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldRefundedAt, field.TypeTime, value)
+		_node.RefundedAt = &value
+	}
+	if nodes := _c.mutation.FinalOfferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finaloffermilestone.FinalOfferTable,
+			Columns: []string{finaloffermilestone.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FinalOfferID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FinalOfferMilestoneCreateBulk is the builder for creating many FinalOfferMilestone entities in bulk.
+type FinalOfferMilestoneCreateBulk struct {
+	config
+	err      error
+	builders []*FinalOfferMilestoneCreate
+}
+
+// Save creates the FinalOfferMilestone entities in the database.
+func (_c *FinalOfferMilestoneCreateBulk) Save(ctx context.Context) ([]*FinalOfferMilestone, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FinalOfferMilestone, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FinalOfferMilestoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FinalOfferMilestoneCreateBulk) SaveX(ctx context.Context) []*FinalOfferMilestone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FinalOfferMilestoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FinalOfferMilestoneCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FinalOfferMilestoneDelete is the builder for deleting a FinalOfferMilestone entity.
+type FinalOfferMilestoneDelete struct {
+	config
+	hooks    []Hook
+	mutation *FinalOfferMilestoneMutation
+}
+
+// Where appends a list predicates to the FinalOfferMilestoneDelete builder.
+func (_d *FinalOfferMilestoneDelete) Where(ps ...predicate.FinalOfferMilestone) *FinalOfferMilestoneDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FinalOfferMilestoneDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FinalOfferMilestoneDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FinalOfferMilestoneDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(finaloffermilestone.Table, sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FinalOfferMilestoneDeleteOne is the builder for deleting a single FinalOfferMilestone entity.
+type FinalOfferMilestoneDeleteOne struct {
+	_d *FinalOfferMilestoneDelete
+}
+
+// Where appends a list predicates to the FinalOfferMilestoneDelete builder.
+func (_d *FinalOfferMilestoneDeleteOne) Where(ps ...predicate.FinalOfferMilestone) *FinalOfferMilestoneDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FinalOfferMilestoneDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{finaloffermilestone.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FinalOfferMilestoneDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FinalOfferMilestoneUpdate is the builder for updating FinalOfferMilestone entities.
+type FinalOfferMilestoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FinalOfferMilestoneMutation
+}
+
+// Where appends a list predicates to the FinalOfferMilestoneUpdate builder.
+func (_u *FinalOfferMilestoneUpdate) Where(ps ...predicate.FinalOfferMilestone) *FinalOfferMilestoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetUpdatedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetDeletedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableDeletedAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FinalOfferMilestoneUpdate) ClearDeletedAt() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *FinalOfferMilestoneUpdate) SetFinalOfferID(v int) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableFinalOfferID(v *int) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *FinalOfferMilestoneUpdate) SetValidationCaseID(v int) *FinalOfferMilestoneUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableValidationCaseID(v *int) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *FinalOfferMilestoneUpdate) AddValidationCaseID(v int) *FinalOfferMilestoneUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *FinalOfferMilestoneUpdate) SetSequence(v int) *FinalOfferMilestoneUpdate {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableSequence(v *int) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *FinalOfferMilestoneUpdate) AddSequence(v int) *FinalOfferMilestoneUpdate {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetDeliverable sets the "deliverable" field.
+func (_u *FinalOfferMilestoneUpdate) SetDeliverable(v string) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetDeliverable(v)
+	return _u
+}
+
+// SetNillableDeliverable sets the "deliverable" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableDeliverable(v *string) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetDeliverable(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *FinalOfferMilestoneUpdate) SetAmount(v int64) *FinalOfferMilestoneUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableAmount(v *int64) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *FinalOfferMilestoneUpdate) AddAmount(v int64) *FinalOfferMilestoneUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetDueAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableDueAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *FinalOfferMilestoneUpdate) SetStatus(v string) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableStatus(v *string) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEscrowTransferID sets the "escrow_transfer_id" field.
+func (_u *FinalOfferMilestoneUpdate) SetEscrowTransferID(v string) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetEscrowTransferID(v)
+	return _u
+}
+
+// SetNillableEscrowTransferID sets the "escrow_transfer_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableEscrowTransferID(v *string) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetEscrowTransferID(*v)
+	}
+	return _u
+}
+
+// ClearEscrowTransferID clears the value of the "escrow_transfer_id" field.
+func (_u *FinalOfferMilestoneUpdate) ClearEscrowTransferID() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearEscrowTransferID()
+	return _u
+}
+
+// SetArtifactDocumentID sets the "artifact_document_id" field.
+func (_u *FinalOfferMilestoneUpdate) SetArtifactDocumentID(v string) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetArtifactDocumentID(v)
+	return _u
+}
+
+// SetNillableArtifactDocumentID sets the "artifact_document_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableArtifactDocumentID(v *string) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetArtifactDocumentID(*v)
+	}
+	return _u
+}
+
+// ClearArtifactDocumentID clears the value of the "artifact_document_id" field.
+func (_u *FinalOfferMilestoneUpdate) ClearArtifactDocumentID() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearArtifactDocumentID()
+	return _u
+}
+
+// SetDisputeID sets the "dispute_id" field.
+func (_u *FinalOfferMilestoneUpdate) SetDisputeID(v string) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetDisputeID(v)
+	return _u
+}
+
+// SetNillableDisputeID sets the "dispute_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableDisputeID(v *string) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetDisputeID(*v)
+	}
+	return _u
+}
+
+// ClearDisputeID clears the value of the "dispute_id" field.
+func (_u *FinalOfferMilestoneUpdate) ClearDisputeID() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearDisputeID()
+	return _u
+}
+
+// SetFundsLockedAt sets the "funds_locked_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetFundsLockedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetFundsLockedAt(v)
+	return _u
+}
+
+// SetNillableFundsLockedAt sets the "funds_locked_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableFundsLockedAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetFundsLockedAt(*v)
+	}
+	return _u
+}
+
+// ClearFundsLockedAt clears the value of the "funds_locked_at" field.
+func (_u *FinalOfferMilestoneUpdate) ClearFundsLockedAt() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearFundsLockedAt()
+	return _u
+}
+
+// SetArtifactSubmittedAt sets the "artifact_submitted_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetArtifactSubmittedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetArtifactSubmittedAt(v)
+	return _u
+}
+
+// SetNillableArtifactSubmittedAt sets the "artifact_submitted_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableArtifactSubmittedAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetArtifactSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearArtifactSubmittedAt clears the value of the "artifact_submitted_at" field.
+func (_u *FinalOfferMilestoneUpdate) ClearArtifactSubmittedAt() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearArtifactSubmittedAt()
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetReleasedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableReleasedAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *FinalOfferMilestoneUpdate) ClearReleasedAt() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetDisputedAt sets the "disputed_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetDisputedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetDisputedAt(v)
+	return _u
+}
+
+// SetNillableDisputedAt sets the "disputed_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableDisputedAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetDisputedAt(*v)
+	}
+	return _u
+}
+
+// ClearDisputedAt clears the value of the "disputed_at" field.
+func (_u *FinalOfferMilestoneUpdate) ClearDisputedAt() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearDisputedAt()
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *FinalOfferMilestoneUpdate) SetRefundedAt(v time.Time) *FinalOfferMilestoneUpdate {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdate) SetNillableRefundedAt(v *time.Time) *FinalOfferMilestoneUpdate {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *FinalOfferMilestoneUpdate) ClearRefundedAt() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// SetFinalOffer sets the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferMilestoneUpdate) SetFinalOffer(v *FinalOffer) *FinalOfferMilestoneUpdate {
+	return _u.SetFinalOfferID(v.ID)
+}
+
+// Mutation returns the FinalOfferMilestoneMutation object of the builder.
+func (_u *FinalOfferMilestoneUpdate) Mutation() *FinalOfferMilestoneMutation {
+	return _u.mutation
+}
+
+// ClearFinalOffer clears the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferMilestoneUpdate) ClearFinalOffer() *FinalOfferMilestoneUpdate {
+	_u.mutation.ClearFinalOffer()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FinalOfferMilestoneUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FinalOfferMilestoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FinalOfferMilestoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FinalOfferMilestoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FinalOfferMilestoneUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := finaloffermilestone.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FinalOfferMilestoneUpdate) check() error {
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := finaloffermilestone.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := finaloffermilestone.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sequence(); ok {
+		if err := finaloffermilestone.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.sequence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Deliverable(); ok {
+		if err := finaloffermilestone.DeliverableValidator(v); err != nil {
+			return &ValidationError{Name: "deliverable", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.deliverable": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := finaloffermilestone.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := finaloffermilestone.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscrowTransferID(); ok {
+		if err := finaloffermilestone.EscrowTransferIDValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_transfer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.escrow_transfer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisputeID(); ok {
+		if err := finaloffermilestone.DisputeIDValidator(v); err != nil {
+			return &ValidationError{Name: "dispute_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.dispute_id": %w`, err)}
+		}
+	}
+	if _u.mutation.FinalOfferCleared() && len(_u.mutation.FinalOfferIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FinalOfferMilestone.final_offer"`)
+	}
+	return nil
+}
+
+func (_u *FinalOfferMilestoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(finaloffermilestone.Table, finaloffermilestone.Columns, sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(finaloffermilestone.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(finaloffermilestone.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(finaloffermilestone.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(finaloffermilestone.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Deliverable(); ok {
+		_spec.SetField(finaloffermilestone.FieldDeliverable, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(finaloffermilestone.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(finaloffermilestone.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(finaloffermilestone.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EscrowTransferID(); ok {
+		_spec.SetField(finaloffermilestone.FieldEscrowTransferID, field.TypeString, value)
+	}
+	if _u.mutation.EscrowTransferIDCleared() {
+		_spec.ClearField(finaloffermilestone.FieldEscrowTransferID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArtifactDocumentID(); ok {
+		_spec.SetField(finaloffermilestone.FieldArtifactDocumentID, field.TypeString, value)
+	}
+	if _u.mutation.ArtifactDocumentIDCleared() {
+		_spec.ClearField(finaloffermilestone.FieldArtifactDocumentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisputeID(); ok {
+		_spec.SetField(finaloffermilestone.FieldDisputeID, field.TypeString, value)
+	}
+	if _u.mutation.DisputeIDCleared() {
+		_spec.ClearField(finaloffermilestone.FieldDisputeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FundsLockedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldFundsLockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FundsLockedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldFundsLockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArtifactSubmittedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldArtifactSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArtifactSubmittedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldArtifactSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisputedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDisputedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DisputedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldDisputedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldRefundedAt, field.TypeTime)
+	}
+	if _u.mutation.FinalOfferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finaloffermilestone.FinalOfferTable,
+			Columns: []string{finaloffermilestone.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FinalOfferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finaloffermilestone.FinalOfferTable,
+			Columns: []string{finaloffermilestone.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{finaloffermilestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FinalOfferMilestoneUpdateOne is the builder for updating a single FinalOfferMilestone entity.
+type FinalOfferMilestoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FinalOfferMilestoneMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetUpdatedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetDeletedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableDeletedAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearDeletedAt() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetFinalOfferID(v int) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableFinalOfferID(v *int) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetValidationCaseID(v int) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableValidationCaseID(v *int) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) AddValidationCaseID(v int) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetSequence(v int) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableSequence(v *int) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *FinalOfferMilestoneUpdateOne) AddSequence(v int) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetDeliverable sets the "deliverable" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetDeliverable(v string) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetDeliverable(v)
+	return _u
+}
+
+// SetNillableDeliverable sets the "deliverable" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableDeliverable(v *string) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetDeliverable(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetAmount(v int64) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableAmount(v *int64) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *FinalOfferMilestoneUpdateOne) AddAmount(v int64) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetDueAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableDueAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetStatus(v string) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableStatus(v *string) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEscrowTransferID sets the "escrow_transfer_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetEscrowTransferID(v string) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetEscrowTransferID(v)
+	return _u
+}
+
+// SetNillableEscrowTransferID sets the "escrow_transfer_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableEscrowTransferID(v *string) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetEscrowTransferID(*v)
+	}
+	return _u
+}
+
+// ClearEscrowTransferID clears the value of the "escrow_transfer_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearEscrowTransferID() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearEscrowTransferID()
+	return _u
+}
+
+// SetArtifactDocumentID sets the "artifact_document_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetArtifactDocumentID(v string) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetArtifactDocumentID(v)
+	return _u
+}
+
+// SetNillableArtifactDocumentID sets the "artifact_document_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableArtifactDocumentID(v *string) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetArtifactDocumentID(*v)
+	}
+	return _u
+}
+
+// ClearArtifactDocumentID clears the value of the "artifact_document_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearArtifactDocumentID() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearArtifactDocumentID()
+	return _u
+}
+
+// SetDisputeID sets the "dispute_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetDisputeID(v string) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetDisputeID(v)
+	return _u
+}
+
+// SetNillableDisputeID sets the "dispute_id" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableDisputeID(v *string) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetDisputeID(*v)
+	}
+	return _u
+}
+
+// ClearDisputeID clears the value of the "dispute_id" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearDisputeID() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearDisputeID()
+	return _u
+}
+
+// SetFundsLockedAt sets the "funds_locked_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetFundsLockedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetFundsLockedAt(v)
+	return _u
+}
+
+// SetNillableFundsLockedAt sets the "funds_locked_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableFundsLockedAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetFundsLockedAt(*v)
+	}
+	return _u
+}
+
+// ClearFundsLockedAt clears the value of the "funds_locked_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearFundsLockedAt() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearFundsLockedAt()
+	return _u
+}
+
+// SetArtifactSubmittedAt sets the "artifact_submitted_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetArtifactSubmittedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetArtifactSubmittedAt(v)
+	return _u
+}
+
+// SetNillableArtifactSubmittedAt sets the "artifact_submitted_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableArtifactSubmittedAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetArtifactSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearArtifactSubmittedAt clears the value of the "artifact_submitted_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearArtifactSubmittedAt() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearArtifactSubmittedAt()
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetReleasedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableReleasedAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearReleasedAt() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetDisputedAt sets the "disputed_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetDisputedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetDisputedAt(v)
+	return _u
+}
+
+// SetNillableDisputedAt sets the "disputed_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableDisputedAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetDisputedAt(*v)
+	}
+	return _u
+}
+
+// ClearDisputedAt clears the value of the "disputed_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearDisputedAt() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearDisputedAt()
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) SetRefundedAt(v time.Time) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *FinalOfferMilestoneUpdateOne) SetNillableRefundedAt(v *time.Time) *FinalOfferMilestoneUpdateOne {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *FinalOfferMilestoneUpdateOne) ClearRefundedAt() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// SetFinalOffer sets the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferMilestoneUpdateOne) SetFinalOffer(v *FinalOffer) *FinalOfferMilestoneUpdateOne {
+	return _u.SetFinalOfferID(v.ID)
+}
+
+// Mutation returns the FinalOfferMilestoneMutation object of the builder.
+func (_u *FinalOfferMilestoneUpdateOne) Mutation() *FinalOfferMilestoneMutation {
+	return _u.mutation
+}
+
+// ClearFinalOffer clears the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferMilestoneUpdateOne) ClearFinalOffer() *FinalOfferMilestoneUpdateOne {
+	_u.mutation.ClearFinalOffer()
+	return _u
+}
+
+// Where appends a list predicates to the FinalOfferMilestoneUpdate builder.
+func (_u *FinalOfferMilestoneUpdateOne) Where(ps ...predicate.FinalOfferMilestone) *FinalOfferMilestoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FinalOfferMilestoneUpdateOne) Select(field string, fields ...string) *FinalOfferMilestoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FinalOfferMilestone entity.
+func (_u *FinalOfferMilestoneUpdateOne) Save(ctx context.Context) (*FinalOfferMilestone, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FinalOfferMilestoneUpdateOne) SaveX(ctx context.Context) *FinalOfferMilestone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FinalOfferMilestoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FinalOfferMilestoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FinalOfferMilestoneUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := finaloffermilestone.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FinalOfferMilestoneUpdateOne) check() error {
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := finaloffermilestone.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := finaloffermilestone.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sequence(); ok {
+		if err := finaloffermilestone.SequenceValidator(v); err != nil {
+			return &ValidationError{Name: "sequence", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.sequence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Deliverable(); ok {
+		if err := finaloffermilestone.DeliverableValidator(v); err != nil {
+			return &ValidationError{Name: "deliverable", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.deliverable": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := finaloffermilestone.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := finaloffermilestone.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscrowTransferID(); ok {
+		if err := finaloffermilestone.EscrowTransferIDValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_transfer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.escrow_transfer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisputeID(); ok {
+		if err := finaloffermilestone.DisputeIDValidator(v); err != nil {
+			return &ValidationError{Name: "dispute_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferMilestone.dispute_id": %w`, err)}
+		}
+	}
+	if _u.mutation.FinalOfferCleared() && len(_u.mutation.FinalOfferIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FinalOfferMilestone.final_offer"`)
+	}
+	return nil
+}
+
+func (_u *FinalOfferMilestoneUpdateOne) sqlSave(ctx context.Context) (_node *FinalOfferMilestone, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(finaloffermilestone.Table, finaloffermilestone.Columns, sqlgraph.NewFieldSpec(finaloffermilestone.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FinalOfferMilestone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, finaloffermilestone.FieldID)
+		for _, f := range fields {
+			if !finaloffermilestone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != finaloffermilestone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(finaloffermilestone.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(finaloffermilestone.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(finaloffermilestone.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(finaloffermilestone.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Deliverable(); ok {
+		_spec.SetField(finaloffermilestone.FieldDeliverable, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(finaloffermilestone.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(finaloffermilestone.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(finaloffermilestone.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EscrowTransferID(); ok {
+		_spec.SetField(finaloffermilestone.FieldEscrowTransferID, field.TypeString, value)
+	}
+	if _u.mutation.EscrowTransferIDCleared() {
+		_spec.ClearField(finaloffermilestone.FieldEscrowTransferID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ArtifactDocumentID(); ok {
+		_spec.SetField(finaloffermilestone.FieldArtifactDocumentID, field.TypeString, value)
+	}
+	if _u.mutation.ArtifactDocumentIDCleared() {
+		_spec.ClearField(finaloffermilestone.FieldArtifactDocumentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisputeID(); ok {
+		_spec.SetField(finaloffermilestone.FieldDisputeID, field.TypeString, value)
+	}
+	if _u.mutation.DisputeIDCleared() {
+		_spec.ClearField(finaloffermilestone.FieldDisputeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.FundsLockedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldFundsLockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FundsLockedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldFundsLockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArtifactSubmittedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldArtifactSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArtifactSubmittedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldArtifactSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DisputedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldDisputedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DisputedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldDisputedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(finaloffermilestone.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(finaloffermilestone.FieldRefundedAt, field.TypeTime)
+	}
+	if _u.mutation.FinalOfferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finaloffermilestone.FinalOfferTable,
+			Columns: []string{finaloffermilestone.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FinalOfferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finaloffermilestone.FinalOfferTable,
+			Columns: []string{finaloffermilestone.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FinalOfferMilestone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{finaloffermilestone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finalofferrevision"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// FinalOfferRevision is the model entity for the FinalOfferRevision schema.
+type FinalOfferRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// FinalOfferID holds the value of the "final_offer_id" field.
+	FinalOfferID int `json:"final_offer_id,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
+	// ProposedByUserID holds the value of the "proposed_by_user_id" field.
+	ProposedByUserID int `json:"proposed_by_user_id,omitempty"`
+	// ProposerRole holds the value of the "proposer_role" field.
+	ProposerRole string `json:"proposer_role,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int64 `json:"amount,omitempty"`
+	// HoldHours holds the value of the "hold_hours" field.
+	HoldHours int `json:"hold_hours,omitempty"`
+	// Terms holds the value of the "terms" field.
+	Terms string `json:"terms,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// MilestonePlan holds the value of the "milestone_plan" field.
+	MilestonePlan []map[string]interface{} `json:"milestone_plan,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FinalOfferRevisionQuery when eager-loading is set.
+	Edges        FinalOfferRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FinalOfferRevisionEdges holds the relations/edges for other nodes in the graph.
+type FinalOfferRevisionEdges struct {
+	// FinalOffer holds the value of the final_offer edge.
+	FinalOffer *FinalOffer `json:"final_offer,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FinalOfferOrErr returns the FinalOffer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FinalOfferRevisionEdges) FinalOfferOrErr() (*FinalOffer, error) {
+	if e.FinalOffer != nil {
+		return e.FinalOffer, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: finaloffer.Label}
+	}
+	return nil, &NotLoadedError{edge: "final_offer"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FinalOfferRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case finalofferrevision.FieldMilestonePlan:
+			values[i] = new([]byte)
+		case finalofferrevision.FieldID, finalofferrevision.FieldFinalOfferID, finalofferrevision.FieldRevision, finalofferrevision.FieldProposedByUserID, finalofferrevision.FieldAmount, finalofferrevision.FieldHoldHours:
+			values[i] = new(sql.NullInt64)
+		case finalofferrevision.FieldProposerRole, finalofferrevision.FieldAction, finalofferrevision.FieldTerms, finalofferrevision.FieldNote:
+			values[i] = new(sql.NullString)
+		case finalofferrevision.FieldCreatedAt, finalofferrevision.FieldUpdatedAt, finalofferrevision.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FinalOfferRevision fields.
+func (_m *FinalOfferRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case finalofferrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case finalofferrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case finalofferrevision.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case finalofferrevision.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case finalofferrevision.FieldFinalOfferID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field final_offer_id", values[i])
+			} else if value.Valid {
+				_m.FinalOfferID = int(value.Int64)
+			}
+		case finalofferrevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case finalofferrevision.FieldProposedByUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field proposed_by_user_id", values[i])
+			} else if value.Valid {
+				_m.ProposedByUserID = int(value.Int64)
+			}
+		case finalofferrevision.FieldProposerRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proposer_role", values[i])
+			} else if value.Valid {
+				_m.ProposerRole = value.String
+			}
+		case finalofferrevision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case finalofferrevision.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case finalofferrevision.FieldHoldHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_hours", values[i])
+			} else if value.Valid {
+				_m.HoldHours = int(value.Int64)
+			}
+		case finalofferrevision.FieldTerms:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field terms", values[i])
+			} else if value.Valid {
+				_m.Terms = value.String
+			}
+		case finalofferrevision.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case finalofferrevision.FieldMilestonePlan:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_plan", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MilestonePlan); err != nil {
+					return fmt.Errorf("unmarshal field milestone_plan: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FinalOfferRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *FinalOfferRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFinalOffer queries the "final_offer" edge of the FinalOfferRevision entity.
+func (_m *FinalOfferRevision) QueryFinalOffer() *FinalOfferQuery {
+	return NewFinalOfferRevisionClient(_m.config).QueryFinalOffer(_m)
+}
+
+// Update returns a builder for updating this FinalOfferRevision.
+// Note that you need to call FinalOfferRevision.Unwrap() before calling this method if this FinalOfferRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FinalOfferRevision) Update() *FinalOfferRevisionUpdateOne {
+	return NewFinalOfferRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FinalOfferRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FinalOfferRevision) Unwrap() *FinalOfferRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FinalOfferRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FinalOfferRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("FinalOfferRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("final_offer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinalOfferID))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("proposed_by_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProposedByUserID))
+	builder.WriteString(", ")
+	builder.WriteString("proposer_role=")
+	builder.WriteString(_m.ProposerRole)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("hold_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HoldHours))
+	builder.WriteString(", ")
+	builder.WriteString("terms=")
+	builder.WriteString(_m.Terms)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("milestone_plan=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MilestonePlan))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FinalOfferRevisions is a parsable slice of FinalOfferRevision.
+type FinalOfferRevisions []*FinalOfferRevision
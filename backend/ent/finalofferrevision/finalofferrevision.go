@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package finalofferrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the finalofferrevision type in the database.
+	Label = "final_offer_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFinalOfferID holds the string denoting the final_offer_id field in the database.
+	FieldFinalOfferID = "final_offer_id"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldProposedByUserID holds the string denoting the proposed_by_user_id field in the database.
+	FieldProposedByUserID = "proposed_by_user_id"
+	// FieldProposerRole holds the string denoting the proposer_role field in the database.
+	FieldProposerRole = "proposer_role"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldHoldHours holds the string denoting the hold_hours field in the database.
+	FieldHoldHours = "hold_hours"
+	// FieldTerms holds the string denoting the terms field in the database.
+	FieldTerms = "terms"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldMilestonePlan holds the string denoting the milestone_plan field in the database.
+	FieldMilestonePlan = "milestone_plan"
+	// EdgeFinalOffer holds the string denoting the final_offer edge name in mutations.
+	EdgeFinalOffer = "final_offer"
+	// Table holds the table name of the finalofferrevision in the database.
+	Table = "final_offer_revisions"
+	// FinalOfferTable is the table that holds the final_offer relation/edge.
+	FinalOfferTable = "final_offer_revisions"
+	// FinalOfferInverseTable is the table name for the FinalOffer entity.
+	// It exists in this package in order to avoid circular dependency with the "finaloffer" package.
+	FinalOfferInverseTable = "final_offers"
+	// FinalOfferColumn is the table column denoting the final_offer relation/edge.
+	FinalOfferColumn = "final_offer_id"
+)
+
+// Columns holds all SQL columns for finalofferrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldFinalOfferID,
+	FieldRevision,
+	FieldProposedByUserID,
+	FieldProposerRole,
+	FieldAction,
+	FieldAmount,
+	FieldHoldHours,
+	FieldTerms,
+	FieldNote,
+	FieldMilestonePlan,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	FinalOfferIDValidator func(int) error
+	// RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	RevisionValidator func(int) error
+	// ProposedByUserIDValidator is a validator for the "proposed_by_user_id" field. It is called by the builders before save.
+	ProposedByUserIDValidator func(int) error
+	// ProposerRoleValidator is a validator for the "proposer_role" field. It is called by the builders before save.
+	ProposerRoleValidator func(string) error
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int64) error
+	// HoldHoursValidator is a validator for the "hold_hours" field. It is called by the builders before save.
+	HoldHoursValidator func(int) error
+	// DefaultTerms holds the default value on creation for the "terms" field.
+	DefaultTerms string
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FinalOfferRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFinalOfferID orders the results by the final_offer_id field.
+func ByFinalOfferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalOfferID, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByProposedByUserID orders the results by the proposed_by_user_id field.
+func ByProposedByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProposedByUserID, opts...).ToFunc()
+}
+
+// ByProposerRole orders the results by the proposer_role field.
+func ByProposerRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProposerRole, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByHoldHours orders the results by the hold_hours field.
+func ByHoldHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldHours, opts...).ToFunc()
+}
+
+// ByTerms orders the results by the terms field.
+func ByTerms(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTerms, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByFinalOfferField orders the results by final_offer field.
+func ByFinalOfferField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFinalOfferStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFinalOfferStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FinalOfferInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FinalOfferTable, FinalOfferColumn),
+	)
+}
@@ -0,0 +1,754 @@
+// Code generated by ent, DO NOT EDIT.
+
+package finalofferrevision
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FinalOfferID applies equality check predicate on the "final_offer_id" field. It's identical to FinalOfferIDEQ.
+func FinalOfferID(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// ProposedByUserID applies equality check predicate on the "proposed_by_user_id" field. It's identical to ProposedByUserIDEQ.
+func ProposedByUserID(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldProposedByUserID, v))
+}
+
+// ProposerRole applies equality check predicate on the "proposer_role" field. It's identical to ProposerRoleEQ.
+func ProposerRole(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldProposerRole, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldAction, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldAmount, v))
+}
+
+// HoldHours applies equality check predicate on the "hold_hours" field. It's identical to HoldHoursEQ.
+func HoldHours(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldHoldHours, v))
+}
+
+// Terms applies equality check predicate on the "terms" field. It's identical to TermsEQ.
+func Terms(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldTerms, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FinalOfferIDEQ applies the EQ predicate on the "final_offer_id" field.
+func FinalOfferIDEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDNEQ applies the NEQ predicate on the "final_offer_id" field.
+func FinalOfferIDNEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDIn applies the In predicate on the "final_offer_id" field.
+func FinalOfferIDIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldFinalOfferID, vs...))
+}
+
+// FinalOfferIDNotIn applies the NotIn predicate on the "final_offer_id" field.
+func FinalOfferIDNotIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldFinalOfferID, vs...))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldRevision, v))
+}
+
+// ProposedByUserIDEQ applies the EQ predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldProposedByUserID, v))
+}
+
+// ProposedByUserIDNEQ applies the NEQ predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDNEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldProposedByUserID, v))
+}
+
+// ProposedByUserIDIn applies the In predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldProposedByUserID, vs...))
+}
+
+// ProposedByUserIDNotIn applies the NotIn predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDNotIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldProposedByUserID, vs...))
+}
+
+// ProposedByUserIDGT applies the GT predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDGT(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldProposedByUserID, v))
+}
+
+// ProposedByUserIDGTE applies the GTE predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDGTE(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldProposedByUserID, v))
+}
+
+// ProposedByUserIDLT applies the LT predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDLT(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldProposedByUserID, v))
+}
+
+// ProposedByUserIDLTE applies the LTE predicate on the "proposed_by_user_id" field.
+func ProposedByUserIDLTE(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldProposedByUserID, v))
+}
+
+// ProposerRoleEQ applies the EQ predicate on the "proposer_role" field.
+func ProposerRoleEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldProposerRole, v))
+}
+
+// ProposerRoleNEQ applies the NEQ predicate on the "proposer_role" field.
+func ProposerRoleNEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldProposerRole, v))
+}
+
+// ProposerRoleIn applies the In predicate on the "proposer_role" field.
+func ProposerRoleIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldProposerRole, vs...))
+}
+
+// ProposerRoleNotIn applies the NotIn predicate on the "proposer_role" field.
+func ProposerRoleNotIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldProposerRole, vs...))
+}
+
+// ProposerRoleGT applies the GT predicate on the "proposer_role" field.
+func ProposerRoleGT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldProposerRole, v))
+}
+
+// ProposerRoleGTE applies the GTE predicate on the "proposer_role" field.
+func ProposerRoleGTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldProposerRole, v))
+}
+
+// ProposerRoleLT applies the LT predicate on the "proposer_role" field.
+func ProposerRoleLT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldProposerRole, v))
+}
+
+// ProposerRoleLTE applies the LTE predicate on the "proposer_role" field.
+func ProposerRoleLTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldProposerRole, v))
+}
+
+// ProposerRoleContains applies the Contains predicate on the "proposer_role" field.
+func ProposerRoleContains(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContains(FieldProposerRole, v))
+}
+
+// ProposerRoleHasPrefix applies the HasPrefix predicate on the "proposer_role" field.
+func ProposerRoleHasPrefix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasPrefix(FieldProposerRole, v))
+}
+
+// ProposerRoleHasSuffix applies the HasSuffix predicate on the "proposer_role" field.
+func ProposerRoleHasSuffix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasSuffix(FieldProposerRole, v))
+}
+
+// ProposerRoleEqualFold applies the EqualFold predicate on the "proposer_role" field.
+func ProposerRoleEqualFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEqualFold(FieldProposerRole, v))
+}
+
+// ProposerRoleContainsFold applies the ContainsFold predicate on the "proposer_role" field.
+func ProposerRoleContainsFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContainsFold(FieldProposerRole, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContainsFold(FieldAction, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldAmount, v))
+}
+
+// HoldHoursEQ applies the EQ predicate on the "hold_hours" field.
+func HoldHoursEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldHoldHours, v))
+}
+
+// HoldHoursNEQ applies the NEQ predicate on the "hold_hours" field.
+func HoldHoursNEQ(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldHoldHours, v))
+}
+
+// HoldHoursIn applies the In predicate on the "hold_hours" field.
+func HoldHoursIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldHoldHours, vs...))
+}
+
+// HoldHoursNotIn applies the NotIn predicate on the "hold_hours" field.
+func HoldHoursNotIn(vs ...int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldHoldHours, vs...))
+}
+
+// HoldHoursGT applies the GT predicate on the "hold_hours" field.
+func HoldHoursGT(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldHoldHours, v))
+}
+
+// HoldHoursGTE applies the GTE predicate on the "hold_hours" field.
+func HoldHoursGTE(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldHoldHours, v))
+}
+
+// HoldHoursLT applies the LT predicate on the "hold_hours" field.
+func HoldHoursLT(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldHoldHours, v))
+}
+
+// HoldHoursLTE applies the LTE predicate on the "hold_hours" field.
+func HoldHoursLTE(v int) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldHoldHours, v))
+}
+
+// TermsEQ applies the EQ predicate on the "terms" field.
+func TermsEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldTerms, v))
+}
+
+// TermsNEQ applies the NEQ predicate on the "terms" field.
+func TermsNEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldTerms, v))
+}
+
+// TermsIn applies the In predicate on the "terms" field.
+func TermsIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldTerms, vs...))
+}
+
+// TermsNotIn applies the NotIn predicate on the "terms" field.
+func TermsNotIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldTerms, vs...))
+}
+
+// TermsGT applies the GT predicate on the "terms" field.
+func TermsGT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldTerms, v))
+}
+
+// TermsGTE applies the GTE predicate on the "terms" field.
+func TermsGTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldTerms, v))
+}
+
+// TermsLT applies the LT predicate on the "terms" field.
+func TermsLT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldTerms, v))
+}
+
+// TermsLTE applies the LTE predicate on the "terms" field.
+func TermsLTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldTerms, v))
+}
+
+// TermsContains applies the Contains predicate on the "terms" field.
+func TermsContains(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContains(FieldTerms, v))
+}
+
+// TermsHasPrefix applies the HasPrefix predicate on the "terms" field.
+func TermsHasPrefix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasPrefix(FieldTerms, v))
+}
+
+// TermsHasSuffix applies the HasSuffix predicate on the "terms" field.
+func TermsHasSuffix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasSuffix(FieldTerms, v))
+}
+
+// TermsIsNil applies the IsNil predicate on the "terms" field.
+func TermsIsNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIsNull(FieldTerms))
+}
+
+// TermsNotNil applies the NotNil predicate on the "terms" field.
+func TermsNotNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotNull(FieldTerms))
+}
+
+// TermsEqualFold applies the EqualFold predicate on the "terms" field.
+func TermsEqualFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEqualFold(FieldTerms, v))
+}
+
+// TermsContainsFold applies the ContainsFold predicate on the "terms" field.
+func TermsContainsFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContainsFold(FieldTerms, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldContainsFold(FieldNote, v))
+}
+
+// MilestonePlanIsNil applies the IsNil predicate on the "milestone_plan" field.
+func MilestonePlanIsNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldIsNull(FieldMilestonePlan))
+}
+
+// MilestonePlanNotNil applies the NotNil predicate on the "milestone_plan" field.
+func MilestonePlanNotNil() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.FieldNotNull(FieldMilestonePlan))
+}
+
+// HasFinalOffer applies the HasEdge predicate on the "final_offer" edge.
+func HasFinalOffer() predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FinalOfferTable, FinalOfferColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFinalOfferWith applies the HasEdge predicate on the "final_offer" edge with a given conditions (other predicates).
+func HasFinalOfferWith(preds ...predicate.FinalOffer) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(func(s *sql.Selector) {
+		step := newFinalOfferStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FinalOfferRevision) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FinalOfferRevision) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FinalOfferRevision) predicate.FinalOfferRevision {
+	return predicate.FinalOfferRevision(sql.NotPredicates(p))
+}
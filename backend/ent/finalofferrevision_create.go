@@ -0,0 +1,452 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finalofferrevision"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FinalOfferRevisionCreate is the builder for creating a FinalOfferRevision entity.
+type FinalOfferRevisionCreate struct {
+	config
+	mutation *FinalOfferRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FinalOfferRevisionCreate) SetCreatedAt(v time.Time) *FinalOfferRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FinalOfferRevisionCreate) SetNillableCreatedAt(v *time.Time) *FinalOfferRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FinalOfferRevisionCreate) SetUpdatedAt(v time.Time) *FinalOfferRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FinalOfferRevisionCreate) SetNillableUpdatedAt(v *time.Time) *FinalOfferRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FinalOfferRevisionCreate) SetDeletedAt(v time.Time) *FinalOfferRevisionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FinalOfferRevisionCreate) SetNillableDeletedAt(v *time.Time) *FinalOfferRevisionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_c *FinalOfferRevisionCreate) SetFinalOfferID(v int) *FinalOfferRevisionCreate {
+	_c.mutation.SetFinalOfferID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *FinalOfferRevisionCreate) SetRevision(v int) *FinalOfferRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetProposedByUserID sets the "proposed_by_user_id" field.
+func (_c *FinalOfferRevisionCreate) SetProposedByUserID(v int) *FinalOfferRevisionCreate {
+	_c.mutation.SetProposedByUserID(v)
+	return _c
+}
+
+// SetProposerRole sets the "proposer_role" field.
+func (_c *FinalOfferRevisionCreate) SetProposerRole(v string) *FinalOfferRevisionCreate {
+	_c.mutation.SetProposerRole(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *FinalOfferRevisionCreate) SetAction(v string) *FinalOfferRevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *FinalOfferRevisionCreate) SetAmount(v int64) *FinalOfferRevisionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetHoldHours sets the "hold_hours" field.
+func (_c *FinalOfferRevisionCreate) SetHoldHours(v int) *FinalOfferRevisionCreate {
+	_c.mutation.SetHoldHours(v)
+	return _c
+}
+
+// SetTerms sets the "terms" field.
+func (_c *FinalOfferRevisionCreate) SetTerms(v string) *FinalOfferRevisionCreate {
+	_c.mutation.SetTerms(v)
+	return _c
+}
+
+// SetNillableTerms sets the "terms" field if the given value is not nil.
+func (_c *FinalOfferRevisionCreate) SetNillableTerms(v *string) *FinalOfferRevisionCreate {
+	if v != nil {
+		_c.SetTerms(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *FinalOfferRevisionCreate) SetNote(v string) *FinalOfferRevisionCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *FinalOfferRevisionCreate) SetNillableNote(v *string) *FinalOfferRevisionCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetMilestonePlan sets the "milestone_plan" field.
+func (_c *FinalOfferRevisionCreate) SetMilestonePlan(v []map[string]interface{}) *FinalOfferRevisionCreate {
+	_c.mutation.SetMilestonePlan(v)
+	return _c
+}
+
+// SetFinalOffer sets the "final_offer" edge to the FinalOffer entity.
+func (_c *FinalOfferRevisionCreate) SetFinalOffer(v *FinalOffer) *FinalOfferRevisionCreate {
+	return _c.SetFinalOfferID(v.ID)
+}
+
+// Mutation returns the FinalOfferRevisionMutation object of the builder.
+func (_c *FinalOfferRevisionCreate) Mutation() *FinalOfferRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the FinalOfferRevision in the database.
+func (_c *FinalOfferRevisionCreate) Save(ctx context.Context) (*FinalOfferRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FinalOfferRevisionCreate) SaveX(ctx context.Context) *FinalOfferRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FinalOfferRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FinalOfferRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FinalOfferRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := finalofferrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := finalofferrevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Terms(); !ok {
+		v := finalofferrevision.DefaultTerms
+		_c.mutation.SetTerms(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := finalofferrevision.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FinalOfferRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FinalOfferRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FinalOfferRevision.updated_at"`)}
+	}
+	if _, ok := _c.mutation.FinalOfferID(); !ok {
+		return &ValidationError{Name: "final_offer_id", err: errors.New(`ent: missing required field "FinalOfferRevision.final_offer_id"`)}
+	}
+	if v, ok := _c.mutation.FinalOfferID(); ok {
+		if err := finalofferrevision.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.final_offer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "FinalOfferRevision.revision"`)}
+	}
+	if v, ok := _c.mutation.Revision(); ok {
+		if err := finalofferrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.revision": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProposedByUserID(); !ok {
+		return &ValidationError{Name: "proposed_by_user_id", err: errors.New(`ent: missing required field "FinalOfferRevision.proposed_by_user_id"`)}
+	}
+	if v, ok := _c.mutation.ProposedByUserID(); ok {
+		if err := finalofferrevision.ProposedByUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "proposed_by_user_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.proposed_by_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProposerRole(); !ok {
+		return &ValidationError{Name: "proposer_role", err: errors.New(`ent: missing required field "FinalOfferRevision.proposer_role"`)}
+	}
+	if v, ok := _c.mutation.ProposerRole(); ok {
+		if err := finalofferrevision.ProposerRoleValidator(v); err != nil {
+			return &ValidationError{Name: "proposer_role", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.proposer_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "FinalOfferRevision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := finalofferrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "FinalOfferRevision.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := finalofferrevision.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HoldHours(); !ok {
+		return &ValidationError{Name: "hold_hours", err: errors.New(`ent: missing required field "FinalOfferRevision.hold_hours"`)}
+	}
+	if v, ok := _c.mutation.HoldHours(); ok {
+		if err := finalofferrevision.HoldHoursValidator(v); err != nil {
+			return &ValidationError{Name: "hold_hours", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.hold_hours": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := finalofferrevision.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.FinalOfferIDs()) == 0 {
+		return &ValidationError{Name: "final_offer", err: errors.New(`ent: missing required edge "FinalOfferRevision.final_offer"`)}
+	}
+	return nil
+}
+
+func (_c *FinalOfferRevisionCreate) sqlSave(ctx context.Context) (*FinalOfferRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FinalOfferRevisionCreate) createSpec() (*FinalOfferRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FinalOfferRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(finalofferrevision.Table, sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(finalofferrevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.ProposedByUserID(); ok {
+		_spec.SetField(finalofferrevision.FieldProposedByUserID, field.TypeInt, value)
+		_node.ProposedByUserID = value
+	}
+	if value, ok := _c.mutation.ProposerRole(); ok {
+		_spec.SetField(finalofferrevision.FieldProposerRole, field.TypeString, value)
+		_node.ProposerRole = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(finalofferrevision.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(finalofferrevision.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.HoldHours(); ok {
+		_spec.SetField(finalofferrevision.FieldHoldHours, field.TypeInt, value)
+		_node.HoldHours = value
+	}
+	if value, ok := _c.mutation.Terms(); ok {
+		_spec.SetField(finalofferrevision.FieldTerms, field.TypeString, value)
+		_node.Terms = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(finalofferrevision.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.MilestonePlan(); ok {
+		_spec.SetField(finalofferrevision.FieldMilestonePlan, field.TypeJSON, value)
+		_node.MilestonePlan = value
+	}
+	if nodes := _c.mutation.FinalOfferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finalofferrevision.FinalOfferTable,
+			Columns: []string{finalofferrevision.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FinalOfferID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FinalOfferRevisionCreateBulk is the builder for creating many FinalOfferRevision entities in bulk.
+type FinalOfferRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*FinalOfferRevisionCreate
+}
+
+// Save creates the FinalOfferRevision entities in the database.
+func (_c *FinalOfferRevisionCreateBulk) Save(ctx context.Context) ([]*FinalOfferRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FinalOfferRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FinalOfferRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FinalOfferRevisionCreateBulk) SaveX(ctx context.Context) []*FinalOfferRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FinalOfferRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FinalOfferRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
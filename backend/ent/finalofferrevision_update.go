@@ -0,0 +1,922 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finalofferrevision"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// FinalOfferRevisionUpdate is the builder for updating FinalOfferRevision entities.
+type FinalOfferRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FinalOfferRevisionMutation
+}
+
+// Where appends a list predicates to the FinalOfferRevisionUpdate builder.
+func (_u *FinalOfferRevisionUpdate) Where(ps ...predicate.FinalOfferRevision) *FinalOfferRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FinalOfferRevisionUpdate) SetUpdatedAt(v time.Time) *FinalOfferRevisionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FinalOfferRevisionUpdate) SetDeletedAt(v time.Time) *FinalOfferRevisionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableDeletedAt(v *time.Time) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FinalOfferRevisionUpdate) ClearDeletedAt() *FinalOfferRevisionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *FinalOfferRevisionUpdate) SetFinalOfferID(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableFinalOfferID(v *int) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *FinalOfferRevisionUpdate) SetRevision(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableRevision(v *int) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *FinalOfferRevisionUpdate) AddRevision(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetProposedByUserID sets the "proposed_by_user_id" field.
+func (_u *FinalOfferRevisionUpdate) SetProposedByUserID(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.ResetProposedByUserID()
+	_u.mutation.SetProposedByUserID(v)
+	return _u
+}
+
+// SetNillableProposedByUserID sets the "proposed_by_user_id" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableProposedByUserID(v *int) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetProposedByUserID(*v)
+	}
+	return _u
+}
+
+// AddProposedByUserID adds value to the "proposed_by_user_id" field.
+func (_u *FinalOfferRevisionUpdate) AddProposedByUserID(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.AddProposedByUserID(v)
+	return _u
+}
+
+// SetProposerRole sets the "proposer_role" field.
+func (_u *FinalOfferRevisionUpdate) SetProposerRole(v string) *FinalOfferRevisionUpdate {
+	_u.mutation.SetProposerRole(v)
+	return _u
+}
+
+// SetNillableProposerRole sets the "proposer_role" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableProposerRole(v *string) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetProposerRole(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *FinalOfferRevisionUpdate) SetAction(v string) *FinalOfferRevisionUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableAction(v *string) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *FinalOfferRevisionUpdate) SetAmount(v int64) *FinalOfferRevisionUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableAmount(v *int64) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *FinalOfferRevisionUpdate) AddAmount(v int64) *FinalOfferRevisionUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetHoldHours sets the "hold_hours" field.
+func (_u *FinalOfferRevisionUpdate) SetHoldHours(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.ResetHoldHours()
+	_u.mutation.SetHoldHours(v)
+	return _u
+}
+
+// SetNillableHoldHours sets the "hold_hours" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableHoldHours(v *int) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetHoldHours(*v)
+	}
+	return _u
+}
+
+// AddHoldHours adds value to the "hold_hours" field.
+func (_u *FinalOfferRevisionUpdate) AddHoldHours(v int) *FinalOfferRevisionUpdate {
+	_u.mutation.AddHoldHours(v)
+	return _u
+}
+
+// SetTerms sets the "terms" field.
+func (_u *FinalOfferRevisionUpdate) SetTerms(v string) *FinalOfferRevisionUpdate {
+	_u.mutation.SetTerms(v)
+	return _u
+}
+
+// SetNillableTerms sets the "terms" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableTerms(v *string) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetTerms(*v)
+	}
+	return _u
+}
+
+// ClearTerms clears the value of the "terms" field.
+func (_u *FinalOfferRevisionUpdate) ClearTerms() *FinalOfferRevisionUpdate {
+	_u.mutation.ClearTerms()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *FinalOfferRevisionUpdate) SetNote(v string) *FinalOfferRevisionUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdate) SetNillableNote(v *string) *FinalOfferRevisionUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *FinalOfferRevisionUpdate) ClearNote() *FinalOfferRevisionUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetMilestonePlan sets the "milestone_plan" field.
+func (_u *FinalOfferRevisionUpdate) SetMilestonePlan(v []map[string]interface{}) *FinalOfferRevisionUpdate {
+	_u.mutation.SetMilestonePlan(v)
+	return _u
+}
+
+// AppendMilestonePlan appends value to the "milestone_plan" field.
+func (_u *FinalOfferRevisionUpdate) AppendMilestonePlan(v []map[string]interface{}) *FinalOfferRevisionUpdate {
+	_u.mutation.AppendMilestonePlan(v)
+	return _u
+}
+
+// ClearMilestonePlan clears the value of the "milestone_plan" field.
+func (_u *FinalOfferRevisionUpdate) ClearMilestonePlan() *FinalOfferRevisionUpdate {
+	_u.mutation.ClearMilestonePlan()
+	return _u
+}
+
+// SetFinalOffer sets the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferRevisionUpdate) SetFinalOffer(v *FinalOffer) *FinalOfferRevisionUpdate {
+	return _u.SetFinalOfferID(v.ID)
+}
+
+// Mutation returns the FinalOfferRevisionMutation object of the builder.
+func (_u *FinalOfferRevisionUpdate) Mutation() *FinalOfferRevisionMutation {
+	return _u.mutation
+}
+
+// ClearFinalOffer clears the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferRevisionUpdate) ClearFinalOffer() *FinalOfferRevisionUpdate {
+	_u.mutation.ClearFinalOffer()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FinalOfferRevisionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FinalOfferRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FinalOfferRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FinalOfferRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FinalOfferRevisionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := finalofferrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FinalOfferRevisionUpdate) check() error {
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := finalofferrevision.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := finalofferrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProposedByUserID(); ok {
+		if err := finalofferrevision.ProposedByUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "proposed_by_user_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.proposed_by_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProposerRole(); ok {
+		if err := finalofferrevision.ProposerRoleValidator(v); err != nil {
+			return &ValidationError{Name: "proposer_role", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.proposer_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := finalofferrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := finalofferrevision.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoldHours(); ok {
+		if err := finalofferrevision.HoldHoursValidator(v); err != nil {
+			return &ValidationError{Name: "hold_hours", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.hold_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := finalofferrevision.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.note": %w`, err)}
+		}
+	}
+	if _u.mutation.FinalOfferCleared() && len(_u.mutation.FinalOfferIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FinalOfferRevision.final_offer"`)
+	}
+	return nil
+}
+
+func (_u *FinalOfferRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(finalofferrevision.Table, finalofferrevision.Columns, sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(finalofferrevision.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(finalofferrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(finalofferrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProposedByUserID(); ok {
+		_spec.SetField(finalofferrevision.FieldProposedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProposedByUserID(); ok {
+		_spec.AddField(finalofferrevision.FieldProposedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProposerRole(); ok {
+		_spec.SetField(finalofferrevision.FieldProposerRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(finalofferrevision.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(finalofferrevision.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(finalofferrevision.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.HoldHours(); ok {
+		_spec.SetField(finalofferrevision.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHoldHours(); ok {
+		_spec.AddField(finalofferrevision.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Terms(); ok {
+		_spec.SetField(finalofferrevision.FieldTerms, field.TypeString, value)
+	}
+	if _u.mutation.TermsCleared() {
+		_spec.ClearField(finalofferrevision.FieldTerms, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(finalofferrevision.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(finalofferrevision.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.MilestonePlan(); ok {
+		_spec.SetField(finalofferrevision.FieldMilestonePlan, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMilestonePlan(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, finalofferrevision.FieldMilestonePlan, value)
+		})
+	}
+	if _u.mutation.MilestonePlanCleared() {
+		_spec.ClearField(finalofferrevision.FieldMilestonePlan, field.TypeJSON)
+	}
+	if _u.mutation.FinalOfferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finalofferrevision.FinalOfferTable,
+			Columns: []string{finalofferrevision.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FinalOfferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finalofferrevision.FinalOfferTable,
+			Columns: []string{finalofferrevision.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{finalofferrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FinalOfferRevisionUpdateOne is the builder for updating a single FinalOfferRevision entity.
+type FinalOfferRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FinalOfferRevisionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FinalOfferRevisionUpdateOne) SetUpdatedAt(v time.Time) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FinalOfferRevisionUpdateOne) SetDeletedAt(v time.Time) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableDeletedAt(v *time.Time) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FinalOfferRevisionUpdateOne) ClearDeletedAt() *FinalOfferRevisionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *FinalOfferRevisionUpdateOne) SetFinalOfferID(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableFinalOfferID(v *int) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *FinalOfferRevisionUpdateOne) SetRevision(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableRevision(v *int) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *FinalOfferRevisionUpdateOne) AddRevision(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetProposedByUserID sets the "proposed_by_user_id" field.
+func (_u *FinalOfferRevisionUpdateOne) SetProposedByUserID(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.ResetProposedByUserID()
+	_u.mutation.SetProposedByUserID(v)
+	return _u
+}
+
+// SetNillableProposedByUserID sets the "proposed_by_user_id" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableProposedByUserID(v *int) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetProposedByUserID(*v)
+	}
+	return _u
+}
+
+// AddProposedByUserID adds value to the "proposed_by_user_id" field.
+func (_u *FinalOfferRevisionUpdateOne) AddProposedByUserID(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.AddProposedByUserID(v)
+	return _u
+}
+
+// SetProposerRole sets the "proposer_role" field.
+func (_u *FinalOfferRevisionUpdateOne) SetProposerRole(v string) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetProposerRole(v)
+	return _u
+}
+
+// SetNillableProposerRole sets the "proposer_role" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableProposerRole(v *string) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetProposerRole(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *FinalOfferRevisionUpdateOne) SetAction(v string) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableAction(v *string) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *FinalOfferRevisionUpdateOne) SetAmount(v int64) *FinalOfferRevisionUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableAmount(v *int64) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *FinalOfferRevisionUpdateOne) AddAmount(v int64) *FinalOfferRevisionUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetHoldHours sets the "hold_hours" field.
+func (_u *FinalOfferRevisionUpdateOne) SetHoldHours(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.ResetHoldHours()
+	_u.mutation.SetHoldHours(v)
+	return _u
+}
+
+// SetNillableHoldHours sets the "hold_hours" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableHoldHours(v *int) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetHoldHours(*v)
+	}
+	return _u
+}
+
+// AddHoldHours adds value to the "hold_hours" field.
+func (_u *FinalOfferRevisionUpdateOne) AddHoldHours(v int) *FinalOfferRevisionUpdateOne {
+	_u.mutation.AddHoldHours(v)
+	return _u
+}
+
+// SetTerms sets the "terms" field.
+func (_u *FinalOfferRevisionUpdateOne) SetTerms(v string) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetTerms(v)
+	return _u
+}
+
+// SetNillableTerms sets the "terms" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableTerms(v *string) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetTerms(*v)
+	}
+	return _u
+}
+
+// ClearTerms clears the value of the "terms" field.
+func (_u *FinalOfferRevisionUpdateOne) ClearTerms() *FinalOfferRevisionUpdateOne {
+	_u.mutation.ClearTerms()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *FinalOfferRevisionUpdateOne) SetNote(v string) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *FinalOfferRevisionUpdateOne) SetNillableNote(v *string) *FinalOfferRevisionUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *FinalOfferRevisionUpdateOne) ClearNote() *FinalOfferRevisionUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetMilestonePlan sets the "milestone_plan" field.
+func (_u *FinalOfferRevisionUpdateOne) SetMilestonePlan(v []map[string]interface{}) *FinalOfferRevisionUpdateOne {
+	_u.mutation.SetMilestonePlan(v)
+	return _u
+}
+
+// AppendMilestonePlan appends value to the "milestone_plan" field.
+func (_u *FinalOfferRevisionUpdateOne) AppendMilestonePlan(v []map[string]interface{}) *FinalOfferRevisionUpdateOne {
+	_u.mutation.AppendMilestonePlan(v)
+	return _u
+}
+
+// ClearMilestonePlan clears the value of the "milestone_plan" field.
+func (_u *FinalOfferRevisionUpdateOne) ClearMilestonePlan() *FinalOfferRevisionUpdateOne {
+	_u.mutation.ClearMilestonePlan()
+	return _u
+}
+
+// SetFinalOffer sets the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferRevisionUpdateOne) SetFinalOffer(v *FinalOffer) *FinalOfferRevisionUpdateOne {
+	return _u.SetFinalOfferID(v.ID)
+}
+
+// Mutation returns the FinalOfferRevisionMutation object of the builder.
+func (_u *FinalOfferRevisionUpdateOne) Mutation() *FinalOfferRevisionMutation {
+	return _u.mutation
+}
+
+// ClearFinalOffer clears the "final_offer" edge to the FinalOffer entity.
+func (_u *FinalOfferRevisionUpdateOne) ClearFinalOffer() *FinalOfferRevisionUpdateOne {
+	_u.mutation.ClearFinalOffer()
+	return _u
+}
+
+// Where appends a list predicates to the FinalOfferRevisionUpdate builder.
+func (_u *FinalOfferRevisionUpdateOne) Where(ps ...predicate.FinalOfferRevision) *FinalOfferRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FinalOfferRevisionUpdateOne) Select(field string, fields ...string) *FinalOfferRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FinalOfferRevision entity.
+func (_u *FinalOfferRevisionUpdateOne) Save(ctx context.Context) (*FinalOfferRevision, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FinalOfferRevisionUpdateOne) SaveX(ctx context.Context) *FinalOfferRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FinalOfferRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FinalOfferRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FinalOfferRevisionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := finalofferrevision.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FinalOfferRevisionUpdateOne) check() error {
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := finalofferrevision.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Revision(); ok {
+		if err := finalofferrevision.RevisionValidator(v); err != nil {
+			return &ValidationError{Name: "revision", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.revision": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProposedByUserID(); ok {
+		if err := finalofferrevision.ProposedByUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "proposed_by_user_id", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.proposed_by_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProposerRole(); ok {
+		if err := finalofferrevision.ProposerRoleValidator(v); err != nil {
+			return &ValidationError{Name: "proposer_role", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.proposer_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := finalofferrevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := finalofferrevision.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoldHours(); ok {
+		if err := finalofferrevision.HoldHoursValidator(v); err != nil {
+			return &ValidationError{Name: "hold_hours", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.hold_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := finalofferrevision.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "FinalOfferRevision.note": %w`, err)}
+		}
+	}
+	if _u.mutation.FinalOfferCleared() && len(_u.mutation.FinalOfferIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FinalOfferRevision.final_offer"`)
+	}
+	return nil
+}
+
+func (_u *FinalOfferRevisionUpdateOne) sqlSave(ctx context.Context) (_node *FinalOfferRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(finalofferrevision.Table, finalofferrevision.Columns, sqlgraph.NewFieldSpec(finalofferrevision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FinalOfferRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, finalofferrevision.FieldID)
+		for _, f := range fields {
+			if !finalofferrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != finalofferrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(finalofferrevision.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(finalofferrevision.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(finalofferrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(finalofferrevision.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProposedByUserID(); ok {
+		_spec.SetField(finalofferrevision.FieldProposedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProposedByUserID(); ok {
+		_spec.AddField(finalofferrevision.FieldProposedByUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProposerRole(); ok {
+		_spec.SetField(finalofferrevision.FieldProposerRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(finalofferrevision.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(finalofferrevision.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(finalofferrevision.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.HoldHours(); ok {
+		_spec.SetField(finalofferrevision.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHoldHours(); ok {
+		_spec.AddField(finalofferrevision.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Terms(); ok {
+		_spec.SetField(finalofferrevision.FieldTerms, field.TypeString, value)
+	}
+	if _u.mutation.TermsCleared() {
+		_spec.ClearField(finalofferrevision.FieldTerms, field.TypeString)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(finalofferrevision.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(finalofferrevision.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.MilestonePlan(); ok {
+		_spec.SetField(finalofferrevision.FieldMilestonePlan, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMilestonePlan(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, finalofferrevision.FieldMilestonePlan, value)
+		})
+	}
+	if _u.mutation.MilestonePlanCleared() {
+		_spec.ClearField(finalofferrevision.FieldMilestonePlan, field.TypeJSON)
+	}
+	if _u.mutation.FinalOfferCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finalofferrevision.FinalOfferTable,
+			Columns: []string{finalofferrevision.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FinalOfferIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   finalofferrevision.FinalOfferTable,
+			Columns: []string{finalofferrevision.FinalOfferColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(finaloffer.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FinalOfferRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{finalofferrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/guaranteeslash"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GuaranteeSlash is the model entity for the GuaranteeSlash schema.
+type GuaranteeSlash struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidationCaseID holds the value of the "validation_case_id" field.
+	ValidationCaseID int `json:"validation_case_id,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// FinalOfferID holds the value of the "final_offer_id" field.
+	FinalOfferID int `json:"final_offer_id,omitempty"`
+	// MilestoneID holds the value of the "milestone_id" field.
+	MilestoneID *int `json:"milestone_id,omitempty"`
+	// WorkflowCycle holds the value of the "workflow_cycle" field.
+	WorkflowCycle int `json:"workflow_cycle,omitempty"`
+	// DisputeID holds the value of the "dispute_id" field.
+	DisputeID string `json:"dispute_id,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// GuaranteeAmount holds the value of the "guarantee_amount" field.
+	GuaranteeAmount int64 `json:"guarantee_amount,omitempty"`
+	// Percent holds the value of the "percent" field.
+	Percent int `json:"percent,omitempty"`
+	// CapAmount holds the value of the "cap_amount" field.
+	CapAmount int64 `json:"cap_amount,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int64 `json:"amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// SkipReason holds the value of the "skip_reason" field.
+	SkipReason *string `json:"skip_reason,omitempty"`
+	// AppealDeadlineAt holds the value of the "appeal_deadline_at" field.
+	AppealDeadlineAt time.Time `json:"appeal_deadline_at,omitempty"`
+	// AppealReason holds the value of the "appeal_reason" field.
+	AppealReason *string `json:"appeal_reason,omitempty"`
+	// AppealedAt holds the value of the "appealed_at" field.
+	AppealedAt *time.Time `json:"appealed_at,omitempty"`
+	// ResolvedByAdminID holds the value of the "resolved_by_admin_id" field.
+	ResolvedByAdminID *int `json:"resolved_by_admin_id,omitempty"`
+	// ResolutionNote holds the value of the "resolution_note" field.
+	ResolutionNote *string `json:"resolution_note,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// ExecutedAt holds the value of the "executed_at" field.
+	ExecutedAt *time.Time `json:"executed_at,omitempty"`
+	// RemainingGuaranteeAmount holds the value of the "remaining_guarantee_amount" field.
+	RemainingGuaranteeAmount *int64 `json:"remaining_guarantee_amount,omitempty"`
+	// AttemptCount holds the value of the "attempt_count" field.
+	AttemptCount int `json:"attempt_count,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError    *string `json:"last_error,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GuaranteeSlash) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case guaranteeslash.FieldID, guaranteeslash.FieldValidationCaseID, guaranteeslash.FieldValidatorUserID, guaranteeslash.FieldFinalOfferID, guaranteeslash.FieldMilestoneID, guaranteeslash.FieldWorkflowCycle, guaranteeslash.FieldGuaranteeAmount, guaranteeslash.FieldPercent, guaranteeslash.FieldCapAmount, guaranteeslash.FieldAmount, guaranteeslash.FieldResolvedByAdminID, guaranteeslash.FieldRemainingGuaranteeAmount, guaranteeslash.FieldAttemptCount:
+			values[i] = new(sql.NullInt64)
+		case guaranteeslash.FieldDisputeID, guaranteeslash.FieldIdempotencyKey, guaranteeslash.FieldStatus, guaranteeslash.FieldSkipReason, guaranteeslash.FieldAppealReason, guaranteeslash.FieldResolutionNote, guaranteeslash.FieldLastError:
+			values[i] = new(sql.NullString)
+		case guaranteeslash.FieldCreatedAt, guaranteeslash.FieldUpdatedAt, guaranteeslash.FieldDeletedAt, guaranteeslash.FieldAppealDeadlineAt, guaranteeslash.FieldAppealedAt, guaranteeslash.FieldResolvedAt, guaranteeslash.FieldExecutedAt, guaranteeslash.FieldNextAttemptAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GuaranteeSlash fields.
+func (_m *GuaranteeSlash) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case guaranteeslash.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case guaranteeslash.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case guaranteeslash.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case guaranteeslash.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case guaranteeslash.FieldValidationCaseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_case_id", values[i])
+			} else if value.Valid {
+				_m.ValidationCaseID = int(value.Int64)
+			}
+		case guaranteeslash.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case guaranteeslash.FieldFinalOfferID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field final_offer_id", values[i])
+			} else if value.Valid {
+				_m.FinalOfferID = int(value.Int64)
+			}
+		case guaranteeslash.FieldMilestoneID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_id", values[i])
+			} else if value.Valid {
+				_m.MilestoneID = new(int)
+				*_m.MilestoneID = int(value.Int64)
+			}
+		case guaranteeslash.FieldWorkflowCycle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_cycle", values[i])
+			} else if value.Valid {
+				_m.WorkflowCycle = int(value.Int64)
+			}
+		case guaranteeslash.FieldDisputeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_id", values[i])
+			} else if value.Valid {
+				_m.DisputeID = value.String
+			}
+		case guaranteeslash.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = value.String
+			}
+		case guaranteeslash.FieldGuaranteeAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field guarantee_amount", values[i])
+			} else if value.Valid {
+				_m.GuaranteeAmount = value.Int64
+			}
+		case guaranteeslash.FieldPercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field percent", values[i])
+			} else if value.Valid {
+				_m.Percent = int(value.Int64)
+			}
+		case guaranteeslash.FieldCapAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cap_amount", values[i])
+			} else if value.Valid {
+				_m.CapAmount = value.Int64
+			}
+		case guaranteeslash.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case guaranteeslash.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case guaranteeslash.FieldSkipReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_reason", values[i])
+			} else if value.Valid {
+				_m.SkipReason = new(string)
+				*_m.SkipReason = value.String
+			}
+		case guaranteeslash.FieldAppealDeadlineAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field appeal_deadline_at", values[i])
+			} else if value.Valid {
+				_m.AppealDeadlineAt = value.Time
+			}
+		case guaranteeslash.FieldAppealReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field appeal_reason", values[i])
+			} else if value.Valid {
+				_m.AppealReason = new(string)
+				*_m.AppealReason = value.String
+			}
+		case guaranteeslash.FieldAppealedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field appealed_at", values[i])
+			} else if value.Valid {
+				_m.AppealedAt = new(time.Time)
+				*_m.AppealedAt = value.Time
+			}
+		case guaranteeslash.FieldResolvedByAdminID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by_admin_id", values[i])
+			} else if value.Valid {
+				_m.ResolvedByAdminID = new(int)
+				*_m.ResolvedByAdminID = int(value.Int64)
+			}
+		case guaranteeslash.FieldResolutionNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_note", values[i])
+			} else if value.Valid {
+				_m.ResolutionNote = new(string)
+				*_m.ResolutionNote = value.String
+			}
+		case guaranteeslash.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case guaranteeslash.FieldExecutedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_at", values[i])
+			} else if value.Valid {
+				_m.ExecutedAt = new(time.Time)
+				*_m.ExecutedAt = value.Time
+			}
+		case guaranteeslash.FieldRemainingGuaranteeAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining_guarantee_amount", values[i])
+			} else if value.Valid {
+				_m.RemainingGuaranteeAmount = new(int64)
+				*_m.RemainingGuaranteeAmount = value.Int64
+			}
+		case guaranteeslash.FieldAttemptCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_count", values[i])
+			} else if value.Valid {
+				_m.AttemptCount = int(value.Int64)
+			}
+		case guaranteeslash.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = new(time.Time)
+				*_m.NextAttemptAt = value.Time
+			}
+		case guaranteeslash.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = new(string)
+				*_m.LastError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GuaranteeSlash.
+// This includes values selected through modifiers, order, etc.
+func (_m *GuaranteeSlash) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GuaranteeSlash.
+// Note that you need to call GuaranteeSlash.Unwrap() before calling this method if this GuaranteeSlash
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GuaranteeSlash) Update() *GuaranteeSlashUpdateOne {
+	return NewGuaranteeSlashClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GuaranteeSlash entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GuaranteeSlash) Unwrap() *GuaranteeSlash {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GuaranteeSlash is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GuaranteeSlash) String() string {
+	var builder strings.Builder
+	builder.WriteString("GuaranteeSlash(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validation_case_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidationCaseID))
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("final_offer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinalOfferID))
+	builder.WriteString(", ")
+	if v := _m.MilestoneID; v != nil {
+		builder.WriteString("milestone_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("workflow_cycle=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowCycle))
+	builder.WriteString(", ")
+	builder.WriteString("dispute_id=")
+	builder.WriteString(_m.DisputeID)
+	builder.WriteString(", ")
+	builder.WriteString("idempotency_key=")
+	builder.WriteString(_m.IdempotencyKey)
+	builder.WriteString(", ")
+	builder.WriteString("guarantee_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GuaranteeAmount))
+	builder.WriteString(", ")
+	builder.WriteString("percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Percent))
+	builder.WriteString(", ")
+	builder.WriteString("cap_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CapAmount))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.SkipReason; v != nil {
+		builder.WriteString("skip_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("appeal_deadline_at=")
+	builder.WriteString(_m.AppealDeadlineAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.AppealReason; v != nil {
+		builder.WriteString("appeal_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AppealedAt; v != nil {
+		builder.WriteString("appealed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedByAdminID; v != nil {
+		builder.WriteString("resolved_by_admin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolutionNote; v != nil {
+		builder.WriteString("resolution_note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExecutedAt; v != nil {
+		builder.WriteString("executed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RemainingGuaranteeAmount; v != nil {
+		builder.WriteString("remaining_guarantee_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempt_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptCount))
+	builder.WriteString(", ")
+	if v := _m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GuaranteeSlashes is a parsable slice of GuaranteeSlash.
+type GuaranteeSlashes []*GuaranteeSlash
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guaranteeslash
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the guaranteeslash type in the database.
+	Label = "guarantee_slash"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldValidationCaseID holds the string denoting the validation_case_id field in the database.
+	FieldValidationCaseID = "validation_case_id"
+	// FieldValidatorUserID holds the string denoting the validator_user_id field in the database.
+	FieldValidatorUserID = "validator_user_id"
+	// FieldFinalOfferID holds the string denoting the final_offer_id field in the database.
+	FieldFinalOfferID = "final_offer_id"
+	// FieldMilestoneID holds the string denoting the milestone_id field in the database.
+	FieldMilestoneID = "milestone_id"
+	// FieldWorkflowCycle holds the string denoting the workflow_cycle field in the database.
+	FieldWorkflowCycle = "workflow_cycle"
+	// FieldDisputeID holds the string denoting the dispute_id field in the database.
+	FieldDisputeID = "dispute_id"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldGuaranteeAmount holds the string denoting the guarantee_amount field in the database.
+	FieldGuaranteeAmount = "guarantee_amount"
+	// FieldPercent holds the string denoting the percent field in the database.
+	FieldPercent = "percent"
+	// FieldCapAmount holds the string denoting the cap_amount field in the database.
+	FieldCapAmount = "cap_amount"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSkipReason holds the string denoting the skip_reason field in the database.
+	FieldSkipReason = "skip_reason"
+	// FieldAppealDeadlineAt holds the string denoting the appeal_deadline_at field in the database.
+	FieldAppealDeadlineAt = "appeal_deadline_at"
+	// FieldAppealReason holds the string denoting the appeal_reason field in the database.
+	FieldAppealReason = "appeal_reason"
+	// FieldAppealedAt holds the string denoting the appealed_at field in the database.
+	FieldAppealedAt = "appealed_at"
+	// FieldResolvedByAdminID holds the string denoting the resolved_by_admin_id field in the database.
+	FieldResolvedByAdminID = "resolved_by_admin_id"
+	// FieldResolutionNote holds the string denoting the resolution_note field in the database.
+	FieldResolutionNote = "resolution_note"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldExecutedAt holds the string denoting the executed_at field in the database.
+	FieldExecutedAt = "executed_at"
+	// FieldRemainingGuaranteeAmount holds the string denoting the remaining_guarantee_amount field in the database.
+	FieldRemainingGuaranteeAmount = "remaining_guarantee_amount"
+	// FieldAttemptCount holds the string denoting the attempt_count field in the database.
+	FieldAttemptCount = "attempt_count"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// Table holds the table name of the guaranteeslash in the database.
+	Table = "guarantee_slashes"
+)
+
+// Columns holds all SQL columns for guaranteeslash fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldValidationCaseID,
+	FieldValidatorUserID,
+	FieldFinalOfferID,
+	FieldMilestoneID,
+	FieldWorkflowCycle,
+	FieldDisputeID,
+	FieldIdempotencyKey,
+	FieldGuaranteeAmount,
+	FieldPercent,
+	FieldCapAmount,
+	FieldAmount,
+	FieldStatus,
+	FieldSkipReason,
+	FieldAppealDeadlineAt,
+	FieldAppealReason,
+	FieldAppealedAt,
+	FieldResolvedByAdminID,
+	FieldResolutionNote,
+	FieldResolvedAt,
+	FieldExecutedAt,
+	FieldRemainingGuaranteeAmount,
+	FieldAttemptCount,
+	FieldNextAttemptAt,
+	FieldLastError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	ValidationCaseIDValidator func(int) error
+	// ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	ValidatorUserIDValidator func(int) error
+	// FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	FinalOfferIDValidator func(int) error
+	// DefaultWorkflowCycle holds the default value on creation for the "workflow_cycle" field.
+	DefaultWorkflowCycle int
+	// WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	WorkflowCycleValidator func(int) error
+	// DisputeIDValidator is a validator for the "dispute_id" field. It is called by the builders before save.
+	DisputeIDValidator func(string) error
+	// IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	IdempotencyKeyValidator func(string) error
+	// GuaranteeAmountValidator is a validator for the "guarantee_amount" field. It is called by the builders before save.
+	GuaranteeAmountValidator func(int64) error
+	// PercentValidator is a validator for the "percent" field. It is called by the builders before save.
+	PercentValidator func(int) error
+	// DefaultCapAmount holds the default value on creation for the "cap_amount" field.
+	DefaultCapAmount int64
+	// CapAmountValidator is a validator for the "cap_amount" field. It is called by the builders before save.
+	CapAmountValidator func(int64) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(int64) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// SkipReasonValidator is a validator for the "skip_reason" field. It is called by the builders before save.
+	SkipReasonValidator func(string) error
+	// AppealReasonValidator is a validator for the "appeal_reason" field. It is called by the builders before save.
+	AppealReasonValidator func(string) error
+	// ResolutionNoteValidator is a validator for the "resolution_note" field. It is called by the builders before save.
+	ResolutionNoteValidator func(string) error
+	// DefaultAttemptCount holds the default value on creation for the "attempt_count" field.
+	DefaultAttemptCount int
+	// AttemptCountValidator is a validator for the "attempt_count" field. It is called by the builders before save.
+	AttemptCountValidator func(int) error
+	// LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	LastErrorValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GuaranteeSlash queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByValidationCaseID orders the results by the validation_case_id field.
+func ByValidationCaseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationCaseID, opts...).ToFunc()
+}
+
+// ByValidatorUserID orders the results by the validator_user_id field.
+func ByValidatorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatorUserID, opts...).ToFunc()
+}
+
+// ByFinalOfferID orders the results by the final_offer_id field.
+func ByFinalOfferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalOfferID, opts...).ToFunc()
+}
+
+// ByMilestoneID orders the results by the milestone_id field.
+func ByMilestoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestoneID, opts...).ToFunc()
+}
+
+// ByWorkflowCycle orders the results by the workflow_cycle field.
+func ByWorkflowCycle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowCycle, opts...).ToFunc()
+}
+
+// ByDisputeID orders the results by the dispute_id field.
+func ByDisputeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputeID, opts...).ToFunc()
+}
+
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
+// ByGuaranteeAmount orders the results by the guarantee_amount field.
+func ByGuaranteeAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuaranteeAmount, opts...).ToFunc()
+}
+
+// ByPercent orders the results by the percent field.
+func ByPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPercent, opts...).ToFunc()
+}
+
+// ByCapAmount orders the results by the cap_amount field.
+func ByCapAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapAmount, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySkipReason orders the results by the skip_reason field.
+func BySkipReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipReason, opts...).ToFunc()
+}
+
+// ByAppealDeadlineAt orders the results by the appeal_deadline_at field.
+func ByAppealDeadlineAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppealDeadlineAt, opts...).ToFunc()
+}
+
+// ByAppealReason orders the results by the appeal_reason field.
+func ByAppealReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppealReason, opts...).ToFunc()
+}
+
+// ByAppealedAt orders the results by the appealed_at field.
+func ByAppealedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppealedAt, opts...).ToFunc()
+}
+
+// ByResolvedByAdminID orders the results by the resolved_by_admin_id field.
+func ByResolvedByAdminID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedByAdminID, opts...).ToFunc()
+}
+
+// ByResolutionNote orders the results by the resolution_note field.
+func ByResolutionNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionNote, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByExecutedAt orders the results by the executed_at field.
+func ByExecutedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutedAt, opts...).ToFunc()
+}
+
+// ByRemainingGuaranteeAmount orders the results by the remaining_guarantee_amount field.
+func ByRemainingGuaranteeAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemainingGuaranteeAmount, opts...).ToFunc()
+}
+
+// ByAttemptCount orders the results by the attempt_count field.
+func ByAttemptCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptCount, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
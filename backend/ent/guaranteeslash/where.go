@@ -0,0 +1,1580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guaranteeslash
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// FinalOfferID applies equality check predicate on the "final_offer_id" field. It's identical to FinalOfferIDEQ.
+func FinalOfferID(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// MilestoneID applies equality check predicate on the "milestone_id" field. It's identical to MilestoneIDEQ.
+func MilestoneID(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// WorkflowCycle applies equality check predicate on the "workflow_cycle" field. It's identical to WorkflowCycleEQ.
+func WorkflowCycle(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// DisputeID applies equality check predicate on the "dispute_id" field. It's identical to DisputeIDEQ.
+func DisputeID(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldDisputeID, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// GuaranteeAmount applies equality check predicate on the "guarantee_amount" field. It's identical to GuaranteeAmountEQ.
+func GuaranteeAmount(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldGuaranteeAmount, v))
+}
+
+// Percent applies equality check predicate on the "percent" field. It's identical to PercentEQ.
+func Percent(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldPercent, v))
+}
+
+// CapAmount applies equality check predicate on the "cap_amount" field. It's identical to CapAmountEQ.
+func CapAmount(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldCapAmount, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAmount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldStatus, v))
+}
+
+// SkipReason applies equality check predicate on the "skip_reason" field. It's identical to SkipReasonEQ.
+func SkipReason(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldSkipReason, v))
+}
+
+// AppealDeadlineAt applies equality check predicate on the "appeal_deadline_at" field. It's identical to AppealDeadlineAtEQ.
+func AppealDeadlineAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAppealDeadlineAt, v))
+}
+
+// AppealReason applies equality check predicate on the "appeal_reason" field. It's identical to AppealReasonEQ.
+func AppealReason(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAppealReason, v))
+}
+
+// AppealedAt applies equality check predicate on the "appealed_at" field. It's identical to AppealedAtEQ.
+func AppealedAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAppealedAt, v))
+}
+
+// ResolvedByAdminID applies equality check predicate on the "resolved_by_admin_id" field. It's identical to ResolvedByAdminIDEQ.
+func ResolvedByAdminID(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldResolvedByAdminID, v))
+}
+
+// ResolutionNote applies equality check predicate on the "resolution_note" field. It's identical to ResolutionNoteEQ.
+func ResolutionNote(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldResolutionNote, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ExecutedAt applies equality check predicate on the "executed_at" field. It's identical to ExecutedAtEQ.
+func ExecutedAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// RemainingGuaranteeAmount applies equality check predicate on the "remaining_guarantee_amount" field. It's identical to RemainingGuaranteeAmountEQ.
+func RemainingGuaranteeAmount(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldRemainingGuaranteeAmount, v))
+}
+
+// AttemptCount applies equality check predicate on the "attempt_count" field. It's identical to AttemptCountEQ.
+func AttemptCount(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// FinalOfferIDEQ applies the EQ predicate on the "final_offer_id" field.
+func FinalOfferIDEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDNEQ applies the NEQ predicate on the "final_offer_id" field.
+func FinalOfferIDNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDIn applies the In predicate on the "final_offer_id" field.
+func FinalOfferIDIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldFinalOfferID, vs...))
+}
+
+// FinalOfferIDNotIn applies the NotIn predicate on the "final_offer_id" field.
+func FinalOfferIDNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldFinalOfferID, vs...))
+}
+
+// FinalOfferIDGT applies the GT predicate on the "final_offer_id" field.
+func FinalOfferIDGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDGTE applies the GTE predicate on the "final_offer_id" field.
+func FinalOfferIDGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDLT applies the LT predicate on the "final_offer_id" field.
+func FinalOfferIDLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDLTE applies the LTE predicate on the "final_offer_id" field.
+func FinalOfferIDLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldFinalOfferID, v))
+}
+
+// MilestoneIDEQ applies the EQ predicate on the "milestone_id" field.
+func MilestoneIDEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDNEQ applies the NEQ predicate on the "milestone_id" field.
+func MilestoneIDNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDIn applies the In predicate on the "milestone_id" field.
+func MilestoneIDIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDNotIn applies the NotIn predicate on the "milestone_id" field.
+func MilestoneIDNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDGT applies the GT predicate on the "milestone_id" field.
+func MilestoneIDGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldMilestoneID, v))
+}
+
+// MilestoneIDGTE applies the GTE predicate on the "milestone_id" field.
+func MilestoneIDGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldMilestoneID, v))
+}
+
+// MilestoneIDLT applies the LT predicate on the "milestone_id" field.
+func MilestoneIDLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldMilestoneID, v))
+}
+
+// MilestoneIDLTE applies the LTE predicate on the "milestone_id" field.
+func MilestoneIDLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldMilestoneID, v))
+}
+
+// MilestoneIDIsNil applies the IsNil predicate on the "milestone_id" field.
+func MilestoneIDIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldMilestoneID))
+}
+
+// MilestoneIDNotNil applies the NotNil predicate on the "milestone_id" field.
+func MilestoneIDNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldMilestoneID))
+}
+
+// WorkflowCycleEQ applies the EQ predicate on the "workflow_cycle" field.
+func WorkflowCycleEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleNEQ applies the NEQ predicate on the "workflow_cycle" field.
+func WorkflowCycleNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleIn applies the In predicate on the "workflow_cycle" field.
+func WorkflowCycleIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleNotIn applies the NotIn predicate on the "workflow_cycle" field.
+func WorkflowCycleNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleGT applies the GT predicate on the "workflow_cycle" field.
+func WorkflowCycleGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleGTE applies the GTE predicate on the "workflow_cycle" field.
+func WorkflowCycleGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLT applies the LT predicate on the "workflow_cycle" field.
+func WorkflowCycleLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLTE applies the LTE predicate on the "workflow_cycle" field.
+func WorkflowCycleLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldWorkflowCycle, v))
+}
+
+// DisputeIDEQ applies the EQ predicate on the "dispute_id" field.
+func DisputeIDEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldDisputeID, v))
+}
+
+// DisputeIDNEQ applies the NEQ predicate on the "dispute_id" field.
+func DisputeIDNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldDisputeID, v))
+}
+
+// DisputeIDIn applies the In predicate on the "dispute_id" field.
+func DisputeIDIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldDisputeID, vs...))
+}
+
+// DisputeIDNotIn applies the NotIn predicate on the "dispute_id" field.
+func DisputeIDNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldDisputeID, vs...))
+}
+
+// DisputeIDGT applies the GT predicate on the "dispute_id" field.
+func DisputeIDGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldDisputeID, v))
+}
+
+// DisputeIDGTE applies the GTE predicate on the "dispute_id" field.
+func DisputeIDGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldDisputeID, v))
+}
+
+// DisputeIDLT applies the LT predicate on the "dispute_id" field.
+func DisputeIDLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldDisputeID, v))
+}
+
+// DisputeIDLTE applies the LTE predicate on the "dispute_id" field.
+func DisputeIDLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldDisputeID, v))
+}
+
+// DisputeIDContains applies the Contains predicate on the "dispute_id" field.
+func DisputeIDContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldDisputeID, v))
+}
+
+// DisputeIDHasPrefix applies the HasPrefix predicate on the "dispute_id" field.
+func DisputeIDHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldDisputeID, v))
+}
+
+// DisputeIDHasSuffix applies the HasSuffix predicate on the "dispute_id" field.
+func DisputeIDHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldDisputeID, v))
+}
+
+// DisputeIDEqualFold applies the EqualFold predicate on the "dispute_id" field.
+func DisputeIDEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldDisputeID, v))
+}
+
+// DisputeIDContainsFold applies the ContainsFold predicate on the "dispute_id" field.
+func DisputeIDContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldDisputeID, v))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// GuaranteeAmountEQ applies the EQ predicate on the "guarantee_amount" field.
+func GuaranteeAmountEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldGuaranteeAmount, v))
+}
+
+// GuaranteeAmountNEQ applies the NEQ predicate on the "guarantee_amount" field.
+func GuaranteeAmountNEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldGuaranteeAmount, v))
+}
+
+// GuaranteeAmountIn applies the In predicate on the "guarantee_amount" field.
+func GuaranteeAmountIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldGuaranteeAmount, vs...))
+}
+
+// GuaranteeAmountNotIn applies the NotIn predicate on the "guarantee_amount" field.
+func GuaranteeAmountNotIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldGuaranteeAmount, vs...))
+}
+
+// GuaranteeAmountGT applies the GT predicate on the "guarantee_amount" field.
+func GuaranteeAmountGT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldGuaranteeAmount, v))
+}
+
+// GuaranteeAmountGTE applies the GTE predicate on the "guarantee_amount" field.
+func GuaranteeAmountGTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldGuaranteeAmount, v))
+}
+
+// GuaranteeAmountLT applies the LT predicate on the "guarantee_amount" field.
+func GuaranteeAmountLT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldGuaranteeAmount, v))
+}
+
+// GuaranteeAmountLTE applies the LTE predicate on the "guarantee_amount" field.
+func GuaranteeAmountLTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldGuaranteeAmount, v))
+}
+
+// PercentEQ applies the EQ predicate on the "percent" field.
+func PercentEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldPercent, v))
+}
+
+// PercentNEQ applies the NEQ predicate on the "percent" field.
+func PercentNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldPercent, v))
+}
+
+// PercentIn applies the In predicate on the "percent" field.
+func PercentIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldPercent, vs...))
+}
+
+// PercentNotIn applies the NotIn predicate on the "percent" field.
+func PercentNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldPercent, vs...))
+}
+
+// PercentGT applies the GT predicate on the "percent" field.
+func PercentGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldPercent, v))
+}
+
+// PercentGTE applies the GTE predicate on the "percent" field.
+func PercentGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldPercent, v))
+}
+
+// PercentLT applies the LT predicate on the "percent" field.
+func PercentLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldPercent, v))
+}
+
+// PercentLTE applies the LTE predicate on the "percent" field.
+func PercentLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldPercent, v))
+}
+
+// CapAmountEQ applies the EQ predicate on the "cap_amount" field.
+func CapAmountEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldCapAmount, v))
+}
+
+// CapAmountNEQ applies the NEQ predicate on the "cap_amount" field.
+func CapAmountNEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldCapAmount, v))
+}
+
+// CapAmountIn applies the In predicate on the "cap_amount" field.
+func CapAmountIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldCapAmount, vs...))
+}
+
+// CapAmountNotIn applies the NotIn predicate on the "cap_amount" field.
+func CapAmountNotIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldCapAmount, vs...))
+}
+
+// CapAmountGT applies the GT predicate on the "cap_amount" field.
+func CapAmountGT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldCapAmount, v))
+}
+
+// CapAmountGTE applies the GTE predicate on the "cap_amount" field.
+func CapAmountGTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldCapAmount, v))
+}
+
+// CapAmountLT applies the LT predicate on the "cap_amount" field.
+func CapAmountLT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldCapAmount, v))
+}
+
+// CapAmountLTE applies the LTE predicate on the "cap_amount" field.
+func CapAmountLTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldCapAmount, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// SkipReasonEQ applies the EQ predicate on the "skip_reason" field.
+func SkipReasonEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldSkipReason, v))
+}
+
+// SkipReasonNEQ applies the NEQ predicate on the "skip_reason" field.
+func SkipReasonNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldSkipReason, v))
+}
+
+// SkipReasonIn applies the In predicate on the "skip_reason" field.
+func SkipReasonIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldSkipReason, vs...))
+}
+
+// SkipReasonNotIn applies the NotIn predicate on the "skip_reason" field.
+func SkipReasonNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldSkipReason, vs...))
+}
+
+// SkipReasonGT applies the GT predicate on the "skip_reason" field.
+func SkipReasonGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldSkipReason, v))
+}
+
+// SkipReasonGTE applies the GTE predicate on the "skip_reason" field.
+func SkipReasonGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldSkipReason, v))
+}
+
+// SkipReasonLT applies the LT predicate on the "skip_reason" field.
+func SkipReasonLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldSkipReason, v))
+}
+
+// SkipReasonLTE applies the LTE predicate on the "skip_reason" field.
+func SkipReasonLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldSkipReason, v))
+}
+
+// SkipReasonContains applies the Contains predicate on the "skip_reason" field.
+func SkipReasonContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldSkipReason, v))
+}
+
+// SkipReasonHasPrefix applies the HasPrefix predicate on the "skip_reason" field.
+func SkipReasonHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldSkipReason, v))
+}
+
+// SkipReasonHasSuffix applies the HasSuffix predicate on the "skip_reason" field.
+func SkipReasonHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldSkipReason, v))
+}
+
+// SkipReasonIsNil applies the IsNil predicate on the "skip_reason" field.
+func SkipReasonIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldSkipReason))
+}
+
+// SkipReasonNotNil applies the NotNil predicate on the "skip_reason" field.
+func SkipReasonNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldSkipReason))
+}
+
+// SkipReasonEqualFold applies the EqualFold predicate on the "skip_reason" field.
+func SkipReasonEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldSkipReason, v))
+}
+
+// SkipReasonContainsFold applies the ContainsFold predicate on the "skip_reason" field.
+func SkipReasonContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldSkipReason, v))
+}
+
+// AppealDeadlineAtEQ applies the EQ predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAppealDeadlineAt, v))
+}
+
+// AppealDeadlineAtNEQ applies the NEQ predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldAppealDeadlineAt, v))
+}
+
+// AppealDeadlineAtIn applies the In predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldAppealDeadlineAt, vs...))
+}
+
+// AppealDeadlineAtNotIn applies the NotIn predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldAppealDeadlineAt, vs...))
+}
+
+// AppealDeadlineAtGT applies the GT predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldAppealDeadlineAt, v))
+}
+
+// AppealDeadlineAtGTE applies the GTE predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldAppealDeadlineAt, v))
+}
+
+// AppealDeadlineAtLT applies the LT predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldAppealDeadlineAt, v))
+}
+
+// AppealDeadlineAtLTE applies the LTE predicate on the "appeal_deadline_at" field.
+func AppealDeadlineAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldAppealDeadlineAt, v))
+}
+
+// AppealReasonEQ applies the EQ predicate on the "appeal_reason" field.
+func AppealReasonEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAppealReason, v))
+}
+
+// AppealReasonNEQ applies the NEQ predicate on the "appeal_reason" field.
+func AppealReasonNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldAppealReason, v))
+}
+
+// AppealReasonIn applies the In predicate on the "appeal_reason" field.
+func AppealReasonIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldAppealReason, vs...))
+}
+
+// AppealReasonNotIn applies the NotIn predicate on the "appeal_reason" field.
+func AppealReasonNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldAppealReason, vs...))
+}
+
+// AppealReasonGT applies the GT predicate on the "appeal_reason" field.
+func AppealReasonGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldAppealReason, v))
+}
+
+// AppealReasonGTE applies the GTE predicate on the "appeal_reason" field.
+func AppealReasonGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldAppealReason, v))
+}
+
+// AppealReasonLT applies the LT predicate on the "appeal_reason" field.
+func AppealReasonLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldAppealReason, v))
+}
+
+// AppealReasonLTE applies the LTE predicate on the "appeal_reason" field.
+func AppealReasonLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldAppealReason, v))
+}
+
+// AppealReasonContains applies the Contains predicate on the "appeal_reason" field.
+func AppealReasonContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldAppealReason, v))
+}
+
+// AppealReasonHasPrefix applies the HasPrefix predicate on the "appeal_reason" field.
+func AppealReasonHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldAppealReason, v))
+}
+
+// AppealReasonHasSuffix applies the HasSuffix predicate on the "appeal_reason" field.
+func AppealReasonHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldAppealReason, v))
+}
+
+// AppealReasonIsNil applies the IsNil predicate on the "appeal_reason" field.
+func AppealReasonIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldAppealReason))
+}
+
+// AppealReasonNotNil applies the NotNil predicate on the "appeal_reason" field.
+func AppealReasonNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldAppealReason))
+}
+
+// AppealReasonEqualFold applies the EqualFold predicate on the "appeal_reason" field.
+func AppealReasonEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldAppealReason, v))
+}
+
+// AppealReasonContainsFold applies the ContainsFold predicate on the "appeal_reason" field.
+func AppealReasonContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldAppealReason, v))
+}
+
+// AppealedAtEQ applies the EQ predicate on the "appealed_at" field.
+func AppealedAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAppealedAt, v))
+}
+
+// AppealedAtNEQ applies the NEQ predicate on the "appealed_at" field.
+func AppealedAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldAppealedAt, v))
+}
+
+// AppealedAtIn applies the In predicate on the "appealed_at" field.
+func AppealedAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldAppealedAt, vs...))
+}
+
+// AppealedAtNotIn applies the NotIn predicate on the "appealed_at" field.
+func AppealedAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldAppealedAt, vs...))
+}
+
+// AppealedAtGT applies the GT predicate on the "appealed_at" field.
+func AppealedAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldAppealedAt, v))
+}
+
+// AppealedAtGTE applies the GTE predicate on the "appealed_at" field.
+func AppealedAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldAppealedAt, v))
+}
+
+// AppealedAtLT applies the LT predicate on the "appealed_at" field.
+func AppealedAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldAppealedAt, v))
+}
+
+// AppealedAtLTE applies the LTE predicate on the "appealed_at" field.
+func AppealedAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldAppealedAt, v))
+}
+
+// AppealedAtIsNil applies the IsNil predicate on the "appealed_at" field.
+func AppealedAtIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldAppealedAt))
+}
+
+// AppealedAtNotNil applies the NotNil predicate on the "appealed_at" field.
+func AppealedAtNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldAppealedAt))
+}
+
+// ResolvedByAdminIDEQ applies the EQ predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldResolvedByAdminID, v))
+}
+
+// ResolvedByAdminIDNEQ applies the NEQ predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldResolvedByAdminID, v))
+}
+
+// ResolvedByAdminIDIn applies the In predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldResolvedByAdminID, vs...))
+}
+
+// ResolvedByAdminIDNotIn applies the NotIn predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldResolvedByAdminID, vs...))
+}
+
+// ResolvedByAdminIDGT applies the GT predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldResolvedByAdminID, v))
+}
+
+// ResolvedByAdminIDGTE applies the GTE predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldResolvedByAdminID, v))
+}
+
+// ResolvedByAdminIDLT applies the LT predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldResolvedByAdminID, v))
+}
+
+// ResolvedByAdminIDLTE applies the LTE predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldResolvedByAdminID, v))
+}
+
+// ResolvedByAdminIDIsNil applies the IsNil predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldResolvedByAdminID))
+}
+
+// ResolvedByAdminIDNotNil applies the NotNil predicate on the "resolved_by_admin_id" field.
+func ResolvedByAdminIDNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldResolvedByAdminID))
+}
+
+// ResolutionNoteEQ applies the EQ predicate on the "resolution_note" field.
+func ResolutionNoteEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldResolutionNote, v))
+}
+
+// ResolutionNoteNEQ applies the NEQ predicate on the "resolution_note" field.
+func ResolutionNoteNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldResolutionNote, v))
+}
+
+// ResolutionNoteIn applies the In predicate on the "resolution_note" field.
+func ResolutionNoteIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldResolutionNote, vs...))
+}
+
+// ResolutionNoteNotIn applies the NotIn predicate on the "resolution_note" field.
+func ResolutionNoteNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldResolutionNote, vs...))
+}
+
+// ResolutionNoteGT applies the GT predicate on the "resolution_note" field.
+func ResolutionNoteGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldResolutionNote, v))
+}
+
+// ResolutionNoteGTE applies the GTE predicate on the "resolution_note" field.
+func ResolutionNoteGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldResolutionNote, v))
+}
+
+// ResolutionNoteLT applies the LT predicate on the "resolution_note" field.
+func ResolutionNoteLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldResolutionNote, v))
+}
+
+// ResolutionNoteLTE applies the LTE predicate on the "resolution_note" field.
+func ResolutionNoteLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldResolutionNote, v))
+}
+
+// ResolutionNoteContains applies the Contains predicate on the "resolution_note" field.
+func ResolutionNoteContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldResolutionNote, v))
+}
+
+// ResolutionNoteHasPrefix applies the HasPrefix predicate on the "resolution_note" field.
+func ResolutionNoteHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldResolutionNote, v))
+}
+
+// ResolutionNoteHasSuffix applies the HasSuffix predicate on the "resolution_note" field.
+func ResolutionNoteHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldResolutionNote, v))
+}
+
+// ResolutionNoteIsNil applies the IsNil predicate on the "resolution_note" field.
+func ResolutionNoteIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldResolutionNote))
+}
+
+// ResolutionNoteNotNil applies the NotNil predicate on the "resolution_note" field.
+func ResolutionNoteNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldResolutionNote))
+}
+
+// ResolutionNoteEqualFold applies the EqualFold predicate on the "resolution_note" field.
+func ResolutionNoteEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldResolutionNote, v))
+}
+
+// ResolutionNoteContainsFold applies the ContainsFold predicate on the "resolution_note" field.
+func ResolutionNoteContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldResolutionNote, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// ExecutedAtEQ applies the EQ predicate on the "executed_at" field.
+func ExecutedAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtNEQ applies the NEQ predicate on the "executed_at" field.
+func ExecutedAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtIn applies the In predicate on the "executed_at" field.
+func ExecutedAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtNotIn applies the NotIn predicate on the "executed_at" field.
+func ExecutedAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtGT applies the GT predicate on the "executed_at" field.
+func ExecutedAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldExecutedAt, v))
+}
+
+// ExecutedAtGTE applies the GTE predicate on the "executed_at" field.
+func ExecutedAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtLT applies the LT predicate on the "executed_at" field.
+func ExecutedAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldExecutedAt, v))
+}
+
+// ExecutedAtLTE applies the LTE predicate on the "executed_at" field.
+func ExecutedAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtIsNil applies the IsNil predicate on the "executed_at" field.
+func ExecutedAtIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldExecutedAt))
+}
+
+// ExecutedAtNotNil applies the NotNil predicate on the "executed_at" field.
+func ExecutedAtNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldExecutedAt))
+}
+
+// RemainingGuaranteeAmountEQ applies the EQ predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldRemainingGuaranteeAmount, v))
+}
+
+// RemainingGuaranteeAmountNEQ applies the NEQ predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountNEQ(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldRemainingGuaranteeAmount, v))
+}
+
+// RemainingGuaranteeAmountIn applies the In predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldRemainingGuaranteeAmount, vs...))
+}
+
+// RemainingGuaranteeAmountNotIn applies the NotIn predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountNotIn(vs ...int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldRemainingGuaranteeAmount, vs...))
+}
+
+// RemainingGuaranteeAmountGT applies the GT predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountGT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldRemainingGuaranteeAmount, v))
+}
+
+// RemainingGuaranteeAmountGTE applies the GTE predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountGTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldRemainingGuaranteeAmount, v))
+}
+
+// RemainingGuaranteeAmountLT applies the LT predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountLT(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldRemainingGuaranteeAmount, v))
+}
+
+// RemainingGuaranteeAmountLTE applies the LTE predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountLTE(v int64) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldRemainingGuaranteeAmount, v))
+}
+
+// RemainingGuaranteeAmountIsNil applies the IsNil predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldRemainingGuaranteeAmount))
+}
+
+// RemainingGuaranteeAmountNotNil applies the NotNil predicate on the "remaining_guarantee_amount" field.
+func RemainingGuaranteeAmountNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldRemainingGuaranteeAmount))
+}
+
+// AttemptCountEQ applies the EQ predicate on the "attempt_count" field.
+func AttemptCountEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountNEQ applies the NEQ predicate on the "attempt_count" field.
+func AttemptCountNEQ(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountIn applies the In predicate on the "attempt_count" field.
+func AttemptCountIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountNotIn applies the NotIn predicate on the "attempt_count" field.
+func AttemptCountNotIn(vs ...int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountGT applies the GT predicate on the "attempt_count" field.
+func AttemptCountGT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldAttemptCount, v))
+}
+
+// AttemptCountGTE applies the GTE predicate on the "attempt_count" field.
+func AttemptCountGTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldAttemptCount, v))
+}
+
+// AttemptCountLT applies the LT predicate on the "attempt_count" field.
+func AttemptCountLT(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldAttemptCount, v))
+}
+
+// AttemptCountLTE applies the LTE predicate on the "attempt_count" field.
+func AttemptCountLTE(v int) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldAttemptCount, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GuaranteeSlash) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GuaranteeSlash) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GuaranteeSlash) predicate.GuaranteeSlash {
+	return predicate.GuaranteeSlash(sql.NotPredicates(p))
+}
@@ -0,0 +1,740 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/guaranteeslash"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GuaranteeSlashCreate is the builder for creating a GuaranteeSlash entity.
+type GuaranteeSlashCreate struct {
+	config
+	mutation *GuaranteeSlashMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GuaranteeSlashCreate) SetCreatedAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableCreatedAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GuaranteeSlashCreate) SetUpdatedAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableUpdatedAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *GuaranteeSlashCreate) SetDeletedAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableDeletedAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *GuaranteeSlashCreate) SetValidationCaseID(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *GuaranteeSlashCreate) SetValidatorUserID(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_c *GuaranteeSlashCreate) SetFinalOfferID(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetFinalOfferID(v)
+	return _c
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_c *GuaranteeSlashCreate) SetMilestoneID(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetMilestoneID(v)
+	return _c
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableMilestoneID(v *int) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetMilestoneID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_c *GuaranteeSlashCreate) SetWorkflowCycle(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetWorkflowCycle(v)
+	return _c
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableWorkflowCycle(v *int) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetWorkflowCycle(*v)
+	}
+	return _c
+}
+
+// SetDisputeID sets the "dispute_id" field.
+func (_c *GuaranteeSlashCreate) SetDisputeID(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetDisputeID(v)
+	return _c
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *GuaranteeSlashCreate) SetIdempotencyKey(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetGuaranteeAmount sets the "guarantee_amount" field.
+func (_c *GuaranteeSlashCreate) SetGuaranteeAmount(v int64) *GuaranteeSlashCreate {
+	_c.mutation.SetGuaranteeAmount(v)
+	return _c
+}
+
+// SetPercent sets the "percent" field.
+func (_c *GuaranteeSlashCreate) SetPercent(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetPercent(v)
+	return _c
+}
+
+// SetCapAmount sets the "cap_amount" field.
+func (_c *GuaranteeSlashCreate) SetCapAmount(v int64) *GuaranteeSlashCreate {
+	_c.mutation.SetCapAmount(v)
+	return _c
+}
+
+// SetNillableCapAmount sets the "cap_amount" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableCapAmount(v *int64) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetCapAmount(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *GuaranteeSlashCreate) SetAmount(v int64) *GuaranteeSlashCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GuaranteeSlashCreate) SetStatus(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableStatus(v *string) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (_c *GuaranteeSlashCreate) SetSkipReason(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetSkipReason(v)
+	return _c
+}
+
+// SetNillableSkipReason sets the "skip_reason" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableSkipReason(v *string) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetSkipReason(*v)
+	}
+	return _c
+}
+
+// SetAppealDeadlineAt sets the "appeal_deadline_at" field.
+func (_c *GuaranteeSlashCreate) SetAppealDeadlineAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetAppealDeadlineAt(v)
+	return _c
+}
+
+// SetAppealReason sets the "appeal_reason" field.
+func (_c *GuaranteeSlashCreate) SetAppealReason(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetAppealReason(v)
+	return _c
+}
+
+// SetNillableAppealReason sets the "appeal_reason" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableAppealReason(v *string) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetAppealReason(*v)
+	}
+	return _c
+}
+
+// SetAppealedAt sets the "appealed_at" field.
+func (_c *GuaranteeSlashCreate) SetAppealedAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetAppealedAt(v)
+	return _c
+}
+
+// SetNillableAppealedAt sets the "appealed_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableAppealedAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetAppealedAt(*v)
+	}
+	return _c
+}
+
+// SetResolvedByAdminID sets the "resolved_by_admin_id" field.
+func (_c *GuaranteeSlashCreate) SetResolvedByAdminID(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetResolvedByAdminID(v)
+	return _c
+}
+
+// SetNillableResolvedByAdminID sets the "resolved_by_admin_id" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableResolvedByAdminID(v *int) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetResolvedByAdminID(*v)
+	}
+	return _c
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (_c *GuaranteeSlashCreate) SetResolutionNote(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetResolutionNote(v)
+	return _c
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableResolutionNote(v *string) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetResolutionNote(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *GuaranteeSlashCreate) SetResolvedAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableResolvedAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_c *GuaranteeSlashCreate) SetExecutedAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetExecutedAt(v)
+	return _c
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableExecutedAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetExecutedAt(*v)
+	}
+	return _c
+}
+
+// SetRemainingGuaranteeAmount sets the "remaining_guarantee_amount" field.
+func (_c *GuaranteeSlashCreate) SetRemainingGuaranteeAmount(v int64) *GuaranteeSlashCreate {
+	_c.mutation.SetRemainingGuaranteeAmount(v)
+	return _c
+}
+
+// SetNillableRemainingGuaranteeAmount sets the "remaining_guarantee_amount" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableRemainingGuaranteeAmount(v *int64) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetRemainingGuaranteeAmount(*v)
+	}
+	return _c
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_c *GuaranteeSlashCreate) SetAttemptCount(v int) *GuaranteeSlashCreate {
+	_c.mutation.SetAttemptCount(v)
+	return _c
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableAttemptCount(v *int) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetAttemptCount(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *GuaranteeSlashCreate) SetNextAttemptAt(v time.Time) *GuaranteeSlashCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableNextAttemptAt(v *time.Time) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *GuaranteeSlashCreate) SetLastError(v string) *GuaranteeSlashCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *GuaranteeSlashCreate) SetNillableLastError(v *string) *GuaranteeSlashCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GuaranteeSlashMutation object of the builder.
+func (_c *GuaranteeSlashCreate) Mutation() *GuaranteeSlashMutation {
+	return _c.mutation
+}
+
+// Save creates the GuaranteeSlash in the database.
+func (_c *GuaranteeSlashCreate) Save(ctx context.Context) (*GuaranteeSlash, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GuaranteeSlashCreate) SaveX(ctx context.Context) *GuaranteeSlash {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuaranteeSlashCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuaranteeSlashCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GuaranteeSlashCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := guaranteeslash.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := guaranteeslash.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		v := guaranteeslash.DefaultWorkflowCycle
+		_c.mutation.SetWorkflowCycle(v)
+	}
+	if _, ok := _c.mutation.CapAmount(); !ok {
+		v := guaranteeslash.DefaultCapAmount
+		_c.mutation.SetCapAmount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := guaranteeslash.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.AttemptCount(); !ok {
+		v := guaranteeslash.DefaultAttemptCount
+		_c.mutation.SetAttemptCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GuaranteeSlashCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GuaranteeSlash.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GuaranteeSlash.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "GuaranteeSlash.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := guaranteeslash.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "GuaranteeSlash.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := guaranteeslash.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FinalOfferID(); !ok {
+		return &ValidationError{Name: "final_offer_id", err: errors.New(`ent: missing required field "GuaranteeSlash.final_offer_id"`)}
+	}
+	if v, ok := _c.mutation.FinalOfferID(); ok {
+		if err := guaranteeslash.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.final_offer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		return &ValidationError{Name: "workflow_cycle", err: errors.New(`ent: missing required field "GuaranteeSlash.workflow_cycle"`)}
+	}
+	if v, ok := _c.mutation.WorkflowCycle(); ok {
+		if err := guaranteeslash.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.workflow_cycle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DisputeID(); !ok {
+		return &ValidationError{Name: "dispute_id", err: errors.New(`ent: missing required field "GuaranteeSlash.dispute_id"`)}
+	}
+	if v, ok := _c.mutation.DisputeID(); ok {
+		if err := guaranteeslash.DisputeIDValidator(v); err != nil {
+			return &ValidationError{Name: "dispute_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.dispute_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IdempotencyKey(); !ok {
+		return &ValidationError{Name: "idempotency_key", err: errors.New(`ent: missing required field "GuaranteeSlash.idempotency_key"`)}
+	}
+	if v, ok := _c.mutation.IdempotencyKey(); ok {
+		if err := guaranteeslash.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.idempotency_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GuaranteeAmount(); !ok {
+		return &ValidationError{Name: "guarantee_amount", err: errors.New(`ent: missing required field "GuaranteeSlash.guarantee_amount"`)}
+	}
+	if v, ok := _c.mutation.GuaranteeAmount(); ok {
+		if err := guaranteeslash.GuaranteeAmountValidator(v); err != nil {
+			return &ValidationError{Name: "guarantee_amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.guarantee_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Percent(); !ok {
+		return &ValidationError{Name: "percent", err: errors.New(`ent: missing required field "GuaranteeSlash.percent"`)}
+	}
+	if v, ok := _c.mutation.Percent(); ok {
+		if err := guaranteeslash.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.percent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CapAmount(); !ok {
+		return &ValidationError{Name: "cap_amount", err: errors.New(`ent: missing required field "GuaranteeSlash.cap_amount"`)}
+	}
+	if v, ok := _c.mutation.CapAmount(); ok {
+		if err := guaranteeslash.CapAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cap_amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.cap_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "GuaranteeSlash.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := guaranteeslash.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "GuaranteeSlash.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := guaranteeslash.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SkipReason(); ok {
+		if err := guaranteeslash.SkipReasonValidator(v); err != nil {
+			return &ValidationError{Name: "skip_reason", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.skip_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AppealDeadlineAt(); !ok {
+		return &ValidationError{Name: "appeal_deadline_at", err: errors.New(`ent: missing required field "GuaranteeSlash.appeal_deadline_at"`)}
+	}
+	if v, ok := _c.mutation.AppealReason(); ok {
+		if err := guaranteeslash.AppealReasonValidator(v); err != nil {
+			return &ValidationError{Name: "appeal_reason", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.appeal_reason": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ResolutionNote(); ok {
+		if err := guaranteeslash.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.resolution_note": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttemptCount(); !ok {
+		return &ValidationError{Name: "attempt_count", err: errors.New(`ent: missing required field "GuaranteeSlash.attempt_count"`)}
+	}
+	if v, ok := _c.mutation.AttemptCount(); ok {
+		if err := guaranteeslash.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := guaranteeslash.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GuaranteeSlashCreate) sqlSave(ctx context.Context) (*GuaranteeSlash, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GuaranteeSlashCreate) createSpec() (*GuaranteeSlash, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuaranteeSlash{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(guaranteeslash.Table, sqlgraph.NewFieldSpec(guaranteeslash.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(guaranteeslash.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(guaranteeslash.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.FinalOfferID(); ok {
+		_spec.SetField(guaranteeslash.FieldFinalOfferID, field.TypeInt, value)
+		_node.FinalOfferID = value
+	}
+	if value, ok := _c.mutation.MilestoneID(); ok {
+		_spec.SetField(guaranteeslash.FieldMilestoneID, field.TypeInt, value)
+		_node.MilestoneID = &value
+	}
+	if value, ok := _c.mutation.WorkflowCycle(); ok {
+		_spec.SetField(guaranteeslash.FieldWorkflowCycle, field.TypeInt, value)
+		_node.WorkflowCycle = value
+	}
+	if value, ok := _c.mutation.DisputeID(); ok {
+		_spec.SetField(guaranteeslash.FieldDisputeID, field.TypeString, value)
+		_node.DisputeID = value
+	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(guaranteeslash.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = value
+	}
+	if value, ok := _c.mutation.GuaranteeAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldGuaranteeAmount, field.TypeInt64, value)
+		_node.GuaranteeAmount = value
+	}
+	if value, ok := _c.mutation.Percent(); ok {
+		_spec.SetField(guaranteeslash.FieldPercent, field.TypeInt, value)
+		_node.Percent = value
+	}
+	if value, ok := _c.mutation.CapAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldCapAmount, field.TypeInt64, value)
+		_node.CapAmount = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(guaranteeslash.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(guaranteeslash.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.SkipReason(); ok {
+		_spec.SetField(guaranteeslash.FieldSkipReason, field.TypeString, value)
+		_node.SkipReason = &value
+	}
+	if value, ok := _c.mutation.AppealDeadlineAt(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealDeadlineAt, field.TypeTime, value)
+		_node.AppealDeadlineAt = value
+	}
+	if value, ok := _c.mutation.AppealReason(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealReason, field.TypeString, value)
+		_node.AppealReason = &value
+	}
+	if value, ok := _c.mutation.AppealedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealedAt, field.TypeTime, value)
+		_node.AppealedAt = &value
+	}
+	if value, ok := _c.mutation.ResolvedByAdminID(); ok {
+		_spec.SetField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt, value)
+		_node.ResolvedByAdminID = &value
+	}
+	if value, ok := _c.mutation.ResolutionNote(); ok {
+		_spec.SetField(guaranteeslash.FieldResolutionNote, field.TypeString, value)
+		_node.ResolutionNote = &value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.ExecutedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldExecutedAt, field.TypeTime, value)
+		_node.ExecutedAt = &value
+	}
+	if value, ok := _c.mutation.RemainingGuaranteeAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64, value)
+		_node.RemainingGuaranteeAmount = &value
+	}
+	if value, ok := _c.mutation.AttemptCount(); ok {
+		_spec.SetField(guaranteeslash.FieldAttemptCount, field.TypeInt, value)
+		_node.AttemptCount = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(guaranteeslash.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(guaranteeslash.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	return _node, _spec
+}
+
+// GuaranteeSlashCreateBulk is the builder for creating many GuaranteeSlash entities in bulk.
+type GuaranteeSlashCreateBulk struct {
+	config
+	err      error
+	builders []*GuaranteeSlashCreate
+}
+
+// Save creates the GuaranteeSlash entities in the database.
+func (_c *GuaranteeSlashCreateBulk) Save(ctx context.Context) ([]*GuaranteeSlash, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GuaranteeSlash, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuaranteeSlashMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GuaranteeSlashCreateBulk) SaveX(ctx context.Context) []*GuaranteeSlash {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuaranteeSlashCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuaranteeSlashCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
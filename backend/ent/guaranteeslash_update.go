@@ -0,0 +1,1694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/guaranteeslash"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GuaranteeSlashUpdate is the builder for updating GuaranteeSlash entities.
+type GuaranteeSlashUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuaranteeSlashMutation
+}
+
+// Where appends a list predicates to the GuaranteeSlashUpdate builder.
+func (_u *GuaranteeSlashUpdate) Where(ps ...predicate.GuaranteeSlash) *GuaranteeSlashUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GuaranteeSlashUpdate) SetUpdatedAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *GuaranteeSlashUpdate) SetDeletedAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableDeletedAt(v *time.Time) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *GuaranteeSlashUpdate) ClearDeletedAt() *GuaranteeSlashUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *GuaranteeSlashUpdate) SetValidationCaseID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableValidationCaseID(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *GuaranteeSlashUpdate) AddValidationCaseID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *GuaranteeSlashUpdate) SetValidatorUserID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableValidatorUserID(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *GuaranteeSlashUpdate) AddValidatorUserID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *GuaranteeSlashUpdate) SetFinalOfferID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetFinalOfferID()
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableFinalOfferID(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// AddFinalOfferID adds value to the "final_offer_id" field.
+func (_u *GuaranteeSlashUpdate) AddFinalOfferID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddFinalOfferID(v)
+	return _u
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_u *GuaranteeSlashUpdate) SetMilestoneID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetMilestoneID()
+	_u.mutation.SetMilestoneID(v)
+	return _u
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableMilestoneID(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetMilestoneID(*v)
+	}
+	return _u
+}
+
+// AddMilestoneID adds value to the "milestone_id" field.
+func (_u *GuaranteeSlashUpdate) AddMilestoneID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddMilestoneID(v)
+	return _u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (_u *GuaranteeSlashUpdate) ClearMilestoneID() *GuaranteeSlashUpdate {
+	_u.mutation.ClearMilestoneID()
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *GuaranteeSlashUpdate) SetWorkflowCycle(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableWorkflowCycle(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *GuaranteeSlashUpdate) AddWorkflowCycle(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetDisputeID sets the "dispute_id" field.
+func (_u *GuaranteeSlashUpdate) SetDisputeID(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetDisputeID(v)
+	return _u
+}
+
+// SetNillableDisputeID sets the "dispute_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableDisputeID(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetDisputeID(*v)
+	}
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *GuaranteeSlashUpdate) SetIdempotencyKey(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableIdempotencyKey(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// SetGuaranteeAmount sets the "guarantee_amount" field.
+func (_u *GuaranteeSlashUpdate) SetGuaranteeAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.ResetGuaranteeAmount()
+	_u.mutation.SetGuaranteeAmount(v)
+	return _u
+}
+
+// SetNillableGuaranteeAmount sets the "guarantee_amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableGuaranteeAmount(v *int64) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetGuaranteeAmount(*v)
+	}
+	return _u
+}
+
+// AddGuaranteeAmount adds value to the "guarantee_amount" field.
+func (_u *GuaranteeSlashUpdate) AddGuaranteeAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.AddGuaranteeAmount(v)
+	return _u
+}
+
+// SetPercent sets the "percent" field.
+func (_u *GuaranteeSlashUpdate) SetPercent(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetPercent()
+	_u.mutation.SetPercent(v)
+	return _u
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillablePercent(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetPercent(*v)
+	}
+	return _u
+}
+
+// AddPercent adds value to the "percent" field.
+func (_u *GuaranteeSlashUpdate) AddPercent(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddPercent(v)
+	return _u
+}
+
+// SetCapAmount sets the "cap_amount" field.
+func (_u *GuaranteeSlashUpdate) SetCapAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.ResetCapAmount()
+	_u.mutation.SetCapAmount(v)
+	return _u
+}
+
+// SetNillableCapAmount sets the "cap_amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableCapAmount(v *int64) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetCapAmount(*v)
+	}
+	return _u
+}
+
+// AddCapAmount adds value to the "cap_amount" field.
+func (_u *GuaranteeSlashUpdate) AddCapAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.AddCapAmount(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *GuaranteeSlashUpdate) SetAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableAmount(v *int64) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *GuaranteeSlashUpdate) AddAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GuaranteeSlashUpdate) SetStatus(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableStatus(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (_u *GuaranteeSlashUpdate) SetSkipReason(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetSkipReason(v)
+	return _u
+}
+
+// SetNillableSkipReason sets the "skip_reason" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableSkipReason(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetSkipReason(*v)
+	}
+	return _u
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (_u *GuaranteeSlashUpdate) ClearSkipReason() *GuaranteeSlashUpdate {
+	_u.mutation.ClearSkipReason()
+	return _u
+}
+
+// SetAppealDeadlineAt sets the "appeal_deadline_at" field.
+func (_u *GuaranteeSlashUpdate) SetAppealDeadlineAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetAppealDeadlineAt(v)
+	return _u
+}
+
+// SetNillableAppealDeadlineAt sets the "appeal_deadline_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableAppealDeadlineAt(v *time.Time) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetAppealDeadlineAt(*v)
+	}
+	return _u
+}
+
+// SetAppealReason sets the "appeal_reason" field.
+func (_u *GuaranteeSlashUpdate) SetAppealReason(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetAppealReason(v)
+	return _u
+}
+
+// SetNillableAppealReason sets the "appeal_reason" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableAppealReason(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetAppealReason(*v)
+	}
+	return _u
+}
+
+// ClearAppealReason clears the value of the "appeal_reason" field.
+func (_u *GuaranteeSlashUpdate) ClearAppealReason() *GuaranteeSlashUpdate {
+	_u.mutation.ClearAppealReason()
+	return _u
+}
+
+// SetAppealedAt sets the "appealed_at" field.
+func (_u *GuaranteeSlashUpdate) SetAppealedAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetAppealedAt(v)
+	return _u
+}
+
+// SetNillableAppealedAt sets the "appealed_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableAppealedAt(v *time.Time) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetAppealedAt(*v)
+	}
+	return _u
+}
+
+// ClearAppealedAt clears the value of the "appealed_at" field.
+func (_u *GuaranteeSlashUpdate) ClearAppealedAt() *GuaranteeSlashUpdate {
+	_u.mutation.ClearAppealedAt()
+	return _u
+}
+
+// SetResolvedByAdminID sets the "resolved_by_admin_id" field.
+func (_u *GuaranteeSlashUpdate) SetResolvedByAdminID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetResolvedByAdminID()
+	_u.mutation.SetResolvedByAdminID(v)
+	return _u
+}
+
+// SetNillableResolvedByAdminID sets the "resolved_by_admin_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableResolvedByAdminID(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetResolvedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddResolvedByAdminID adds value to the "resolved_by_admin_id" field.
+func (_u *GuaranteeSlashUpdate) AddResolvedByAdminID(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddResolvedByAdminID(v)
+	return _u
+}
+
+// ClearResolvedByAdminID clears the value of the "resolved_by_admin_id" field.
+func (_u *GuaranteeSlashUpdate) ClearResolvedByAdminID() *GuaranteeSlashUpdate {
+	_u.mutation.ClearResolvedByAdminID()
+	return _u
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (_u *GuaranteeSlashUpdate) SetResolutionNote(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetResolutionNote(v)
+	return _u
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableResolutionNote(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetResolutionNote(*v)
+	}
+	return _u
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (_u *GuaranteeSlashUpdate) ClearResolutionNote() *GuaranteeSlashUpdate {
+	_u.mutation.ClearResolutionNote()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GuaranteeSlashUpdate) SetResolvedAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableResolvedAt(v *time.Time) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GuaranteeSlashUpdate) ClearResolvedAt() *GuaranteeSlashUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *GuaranteeSlashUpdate) SetExecutedAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableExecutedAt(v *time.Time) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (_u *GuaranteeSlashUpdate) ClearExecutedAt() *GuaranteeSlashUpdate {
+	_u.mutation.ClearExecutedAt()
+	return _u
+}
+
+// SetRemainingGuaranteeAmount sets the "remaining_guarantee_amount" field.
+func (_u *GuaranteeSlashUpdate) SetRemainingGuaranteeAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.ResetRemainingGuaranteeAmount()
+	_u.mutation.SetRemainingGuaranteeAmount(v)
+	return _u
+}
+
+// SetNillableRemainingGuaranteeAmount sets the "remaining_guarantee_amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableRemainingGuaranteeAmount(v *int64) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetRemainingGuaranteeAmount(*v)
+	}
+	return _u
+}
+
+// AddRemainingGuaranteeAmount adds value to the "remaining_guarantee_amount" field.
+func (_u *GuaranteeSlashUpdate) AddRemainingGuaranteeAmount(v int64) *GuaranteeSlashUpdate {
+	_u.mutation.AddRemainingGuaranteeAmount(v)
+	return _u
+}
+
+// ClearRemainingGuaranteeAmount clears the value of the "remaining_guarantee_amount" field.
+func (_u *GuaranteeSlashUpdate) ClearRemainingGuaranteeAmount() *GuaranteeSlashUpdate {
+	_u.mutation.ClearRemainingGuaranteeAmount()
+	return _u
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_u *GuaranteeSlashUpdate) SetAttemptCount(v int) *GuaranteeSlashUpdate {
+	_u.mutation.ResetAttemptCount()
+	_u.mutation.SetAttemptCount(v)
+	return _u
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableAttemptCount(v *int) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetAttemptCount(*v)
+	}
+	return _u
+}
+
+// AddAttemptCount adds value to the "attempt_count" field.
+func (_u *GuaranteeSlashUpdate) AddAttemptCount(v int) *GuaranteeSlashUpdate {
+	_u.mutation.AddAttemptCount(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *GuaranteeSlashUpdate) SetNextAttemptAt(v time.Time) *GuaranteeSlashUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableNextAttemptAt(v *time.Time) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *GuaranteeSlashUpdate) ClearNextAttemptAt() *GuaranteeSlashUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *GuaranteeSlashUpdate) SetLastError(v string) *GuaranteeSlashUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdate) SetNillableLastError(v *string) *GuaranteeSlashUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *GuaranteeSlashUpdate) ClearLastError() *GuaranteeSlashUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the GuaranteeSlashMutation object of the builder.
+func (_u *GuaranteeSlashUpdate) Mutation() *GuaranteeSlashMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GuaranteeSlashUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuaranteeSlashUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GuaranteeSlashUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuaranteeSlashUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GuaranteeSlashUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := guaranteeslash.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GuaranteeSlashUpdate) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := guaranteeslash.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := guaranteeslash.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := guaranteeslash.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := guaranteeslash.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisputeID(); ok {
+		if err := guaranteeslash.DisputeIDValidator(v); err != nil {
+			return &ValidationError{Name: "dispute_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.dispute_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := guaranteeslash.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.idempotency_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GuaranteeAmount(); ok {
+		if err := guaranteeslash.GuaranteeAmountValidator(v); err != nil {
+			return &ValidationError{Name: "guarantee_amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.guarantee_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Percent(); ok {
+		if err := guaranteeslash.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CapAmount(); ok {
+		if err := guaranteeslash.CapAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cap_amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.cap_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := guaranteeslash.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := guaranteeslash.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SkipReason(); ok {
+		if err := guaranteeslash.SkipReasonValidator(v); err != nil {
+			return &ValidationError{Name: "skip_reason", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.skip_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppealReason(); ok {
+		if err := guaranteeslash.AppealReasonValidator(v); err != nil {
+			return &ValidationError{Name: "appeal_reason", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.appeal_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResolutionNote(); ok {
+		if err := guaranteeslash.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.resolution_note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttemptCount(); ok {
+		if err := guaranteeslash.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := guaranteeslash.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GuaranteeSlashUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guaranteeslash.Table, guaranteeslash.Columns, sqlgraph.NewFieldSpec(guaranteeslash.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(guaranteeslash.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(guaranteeslash.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(guaranteeslash.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(guaranteeslash.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FinalOfferID(); ok {
+		_spec.SetField(guaranteeslash.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFinalOfferID(); ok {
+		_spec.AddField(guaranteeslash.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MilestoneID(); ok {
+		_spec.SetField(guaranteeslash.FieldMilestoneID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMilestoneID(); ok {
+		_spec.AddField(guaranteeslash.FieldMilestoneID, field.TypeInt, value)
+	}
+	if _u.mutation.MilestoneIDCleared() {
+		_spec.ClearField(guaranteeslash.FieldMilestoneID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(guaranteeslash.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(guaranteeslash.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DisputeID(); ok {
+		_spec.SetField(guaranteeslash.FieldDisputeID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(guaranteeslash.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GuaranteeAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldGuaranteeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGuaranteeAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldGuaranteeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Percent(); ok {
+		_spec.SetField(guaranteeslash.FieldPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercent(); ok {
+		_spec.AddField(guaranteeslash.FieldPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CapAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldCapAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCapAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldCapAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(guaranteeslash.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(guaranteeslash.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkipReason(); ok {
+		_spec.SetField(guaranteeslash.FieldSkipReason, field.TypeString, value)
+	}
+	if _u.mutation.SkipReasonCleared() {
+		_spec.ClearField(guaranteeslash.FieldSkipReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppealDeadlineAt(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealDeadlineAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AppealReason(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealReason, field.TypeString, value)
+	}
+	if _u.mutation.AppealReasonCleared() {
+		_spec.ClearField(guaranteeslash.FieldAppealReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppealedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppealedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldAppealedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedByAdminID(); ok {
+		_spec.SetField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResolvedByAdminID(); ok {
+		_spec.AddField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.ResolvedByAdminIDCleared() {
+		_spec.ClearField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ResolutionNote(); ok {
+		_spec.SetField(guaranteeslash.FieldResolutionNote, field.TypeString, value)
+	}
+	if _u.mutation.ResolutionNoteCleared() {
+		_spec.ClearField(guaranteeslash.FieldResolutionNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExecutedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldExecutedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemainingGuaranteeAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRemainingGuaranteeAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.RemainingGuaranteeAmountCleared() {
+		_spec.ClearField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AttemptCount(); ok {
+		_spec.SetField(guaranteeslash.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(guaranteeslash.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(guaranteeslash.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(guaranteeslash.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(guaranteeslash.FieldLastError, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guaranteeslash.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GuaranteeSlashUpdateOne is the builder for updating a single GuaranteeSlash entity.
+type GuaranteeSlashUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuaranteeSlashMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetUpdatedAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetDeletedAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableDeletedAt(v *time.Time) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *GuaranteeSlashUpdateOne) ClearDeletedAt() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *GuaranteeSlashUpdateOne) SetValidationCaseID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableValidationCaseID(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *GuaranteeSlashUpdateOne) AddValidationCaseID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *GuaranteeSlashUpdateOne) SetValidatorUserID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableValidatorUserID(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *GuaranteeSlashUpdateOne) AddValidatorUserID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *GuaranteeSlashUpdateOne) SetFinalOfferID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetFinalOfferID()
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableFinalOfferID(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// AddFinalOfferID adds value to the "final_offer_id" field.
+func (_u *GuaranteeSlashUpdateOne) AddFinalOfferID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddFinalOfferID(v)
+	return _u
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_u *GuaranteeSlashUpdateOne) SetMilestoneID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetMilestoneID()
+	_u.mutation.SetMilestoneID(v)
+	return _u
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableMilestoneID(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetMilestoneID(*v)
+	}
+	return _u
+}
+
+// AddMilestoneID adds value to the "milestone_id" field.
+func (_u *GuaranteeSlashUpdateOne) AddMilestoneID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddMilestoneID(v)
+	return _u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (_u *GuaranteeSlashUpdateOne) ClearMilestoneID() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearMilestoneID()
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *GuaranteeSlashUpdateOne) SetWorkflowCycle(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableWorkflowCycle(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *GuaranteeSlashUpdateOne) AddWorkflowCycle(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetDisputeID sets the "dispute_id" field.
+func (_u *GuaranteeSlashUpdateOne) SetDisputeID(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetDisputeID(v)
+	return _u
+}
+
+// SetNillableDisputeID sets the "dispute_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableDisputeID(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetDisputeID(*v)
+	}
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *GuaranteeSlashUpdateOne) SetIdempotencyKey(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableIdempotencyKey(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// SetGuaranteeAmount sets the "guarantee_amount" field.
+func (_u *GuaranteeSlashUpdateOne) SetGuaranteeAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetGuaranteeAmount()
+	_u.mutation.SetGuaranteeAmount(v)
+	return _u
+}
+
+// SetNillableGuaranteeAmount sets the "guarantee_amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableGuaranteeAmount(v *int64) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetGuaranteeAmount(*v)
+	}
+	return _u
+}
+
+// AddGuaranteeAmount adds value to the "guarantee_amount" field.
+func (_u *GuaranteeSlashUpdateOne) AddGuaranteeAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddGuaranteeAmount(v)
+	return _u
+}
+
+// SetPercent sets the "percent" field.
+func (_u *GuaranteeSlashUpdateOne) SetPercent(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetPercent()
+	_u.mutation.SetPercent(v)
+	return _u
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillablePercent(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetPercent(*v)
+	}
+	return _u
+}
+
+// AddPercent adds value to the "percent" field.
+func (_u *GuaranteeSlashUpdateOne) AddPercent(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddPercent(v)
+	return _u
+}
+
+// SetCapAmount sets the "cap_amount" field.
+func (_u *GuaranteeSlashUpdateOne) SetCapAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetCapAmount()
+	_u.mutation.SetCapAmount(v)
+	return _u
+}
+
+// SetNillableCapAmount sets the "cap_amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableCapAmount(v *int64) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetCapAmount(*v)
+	}
+	return _u
+}
+
+// AddCapAmount adds value to the "cap_amount" field.
+func (_u *GuaranteeSlashUpdateOne) AddCapAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddCapAmount(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *GuaranteeSlashUpdateOne) SetAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableAmount(v *int64) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *GuaranteeSlashUpdateOne) AddAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GuaranteeSlashUpdateOne) SetStatus(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableStatus(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSkipReason sets the "skip_reason" field.
+func (_u *GuaranteeSlashUpdateOne) SetSkipReason(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetSkipReason(v)
+	return _u
+}
+
+// SetNillableSkipReason sets the "skip_reason" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableSkipReason(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetSkipReason(*v)
+	}
+	return _u
+}
+
+// ClearSkipReason clears the value of the "skip_reason" field.
+func (_u *GuaranteeSlashUpdateOne) ClearSkipReason() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearSkipReason()
+	return _u
+}
+
+// SetAppealDeadlineAt sets the "appeal_deadline_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetAppealDeadlineAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetAppealDeadlineAt(v)
+	return _u
+}
+
+// SetNillableAppealDeadlineAt sets the "appeal_deadline_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableAppealDeadlineAt(v *time.Time) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetAppealDeadlineAt(*v)
+	}
+	return _u
+}
+
+// SetAppealReason sets the "appeal_reason" field.
+func (_u *GuaranteeSlashUpdateOne) SetAppealReason(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetAppealReason(v)
+	return _u
+}
+
+// SetNillableAppealReason sets the "appeal_reason" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableAppealReason(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetAppealReason(*v)
+	}
+	return _u
+}
+
+// ClearAppealReason clears the value of the "appeal_reason" field.
+func (_u *GuaranteeSlashUpdateOne) ClearAppealReason() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearAppealReason()
+	return _u
+}
+
+// SetAppealedAt sets the "appealed_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetAppealedAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetAppealedAt(v)
+	return _u
+}
+
+// SetNillableAppealedAt sets the "appealed_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableAppealedAt(v *time.Time) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetAppealedAt(*v)
+	}
+	return _u
+}
+
+// ClearAppealedAt clears the value of the "appealed_at" field.
+func (_u *GuaranteeSlashUpdateOne) ClearAppealedAt() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearAppealedAt()
+	return _u
+}
+
+// SetResolvedByAdminID sets the "resolved_by_admin_id" field.
+func (_u *GuaranteeSlashUpdateOne) SetResolvedByAdminID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetResolvedByAdminID()
+	_u.mutation.SetResolvedByAdminID(v)
+	return _u
+}
+
+// SetNillableResolvedByAdminID sets the "resolved_by_admin_id" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableResolvedByAdminID(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetResolvedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddResolvedByAdminID adds value to the "resolved_by_admin_id" field.
+func (_u *GuaranteeSlashUpdateOne) AddResolvedByAdminID(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddResolvedByAdminID(v)
+	return _u
+}
+
+// ClearResolvedByAdminID clears the value of the "resolved_by_admin_id" field.
+func (_u *GuaranteeSlashUpdateOne) ClearResolvedByAdminID() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearResolvedByAdminID()
+	return _u
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (_u *GuaranteeSlashUpdateOne) SetResolutionNote(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetResolutionNote(v)
+	return _u
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableResolutionNote(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetResolutionNote(*v)
+	}
+	return _u
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (_u *GuaranteeSlashUpdateOne) ClearResolutionNote() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearResolutionNote()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetResolvedAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableResolvedAt(v *time.Time) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *GuaranteeSlashUpdateOne) ClearResolvedAt() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetExecutedAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableExecutedAt(v *time.Time) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (_u *GuaranteeSlashUpdateOne) ClearExecutedAt() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearExecutedAt()
+	return _u
+}
+
+// SetRemainingGuaranteeAmount sets the "remaining_guarantee_amount" field.
+func (_u *GuaranteeSlashUpdateOne) SetRemainingGuaranteeAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetRemainingGuaranteeAmount()
+	_u.mutation.SetRemainingGuaranteeAmount(v)
+	return _u
+}
+
+// SetNillableRemainingGuaranteeAmount sets the "remaining_guarantee_amount" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableRemainingGuaranteeAmount(v *int64) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetRemainingGuaranteeAmount(*v)
+	}
+	return _u
+}
+
+// AddRemainingGuaranteeAmount adds value to the "remaining_guarantee_amount" field.
+func (_u *GuaranteeSlashUpdateOne) AddRemainingGuaranteeAmount(v int64) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddRemainingGuaranteeAmount(v)
+	return _u
+}
+
+// ClearRemainingGuaranteeAmount clears the value of the "remaining_guarantee_amount" field.
+func (_u *GuaranteeSlashUpdateOne) ClearRemainingGuaranteeAmount() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearRemainingGuaranteeAmount()
+	return _u
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_u *GuaranteeSlashUpdateOne) SetAttemptCount(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.ResetAttemptCount()
+	_u.mutation.SetAttemptCount(v)
+	return _u
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableAttemptCount(v *int) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetAttemptCount(*v)
+	}
+	return _u
+}
+
+// AddAttemptCount adds value to the "attempt_count" field.
+func (_u *GuaranteeSlashUpdateOne) AddAttemptCount(v int) *GuaranteeSlashUpdateOne {
+	_u.mutation.AddAttemptCount(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *GuaranteeSlashUpdateOne) SetNextAttemptAt(v time.Time) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableNextAttemptAt(v *time.Time) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *GuaranteeSlashUpdateOne) ClearNextAttemptAt() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *GuaranteeSlashUpdateOne) SetLastError(v string) *GuaranteeSlashUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *GuaranteeSlashUpdateOne) SetNillableLastError(v *string) *GuaranteeSlashUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *GuaranteeSlashUpdateOne) ClearLastError() *GuaranteeSlashUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// Mutation returns the GuaranteeSlashMutation object of the builder.
+func (_u *GuaranteeSlashUpdateOne) Mutation() *GuaranteeSlashMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GuaranteeSlashUpdate builder.
+func (_u *GuaranteeSlashUpdateOne) Where(ps ...predicate.GuaranteeSlash) *GuaranteeSlashUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GuaranteeSlashUpdateOne) Select(field string, fields ...string) *GuaranteeSlashUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GuaranteeSlash entity.
+func (_u *GuaranteeSlashUpdateOne) Save(ctx context.Context) (*GuaranteeSlash, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GuaranteeSlashUpdateOne) SaveX(ctx context.Context) *GuaranteeSlash {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GuaranteeSlashUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GuaranteeSlashUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GuaranteeSlashUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := guaranteeslash.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GuaranteeSlashUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := guaranteeslash.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := guaranteeslash.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := guaranteeslash.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := guaranteeslash.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisputeID(); ok {
+		if err := guaranteeslash.DisputeIDValidator(v); err != nil {
+			return &ValidationError{Name: "dispute_id", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.dispute_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IdempotencyKey(); ok {
+		if err := guaranteeslash.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.idempotency_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GuaranteeAmount(); ok {
+		if err := guaranteeslash.GuaranteeAmountValidator(v); err != nil {
+			return &ValidationError{Name: "guarantee_amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.guarantee_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Percent(); ok {
+		if err := guaranteeslash.PercentValidator(v); err != nil {
+			return &ValidationError{Name: "percent", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CapAmount(); ok {
+		if err := guaranteeslash.CapAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cap_amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.cap_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := guaranteeslash.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := guaranteeslash.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SkipReason(); ok {
+		if err := guaranteeslash.SkipReasonValidator(v); err != nil {
+			return &ValidationError{Name: "skip_reason", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.skip_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppealReason(); ok {
+		if err := guaranteeslash.AppealReasonValidator(v); err != nil {
+			return &ValidationError{Name: "appeal_reason", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.appeal_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResolutionNote(); ok {
+		if err := guaranteeslash.ResolutionNoteValidator(v); err != nil {
+			return &ValidationError{Name: "resolution_note", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.resolution_note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AttemptCount(); ok {
+		if err := guaranteeslash.AttemptCountValidator(v); err != nil {
+			return &ValidationError{Name: "attempt_count", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.attempt_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := guaranteeslash.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "GuaranteeSlash.last_error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GuaranteeSlashUpdateOne) sqlSave(ctx context.Context) (_node *GuaranteeSlash, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guaranteeslash.Table, guaranteeslash.Columns, sqlgraph.NewFieldSpec(guaranteeslash.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuaranteeSlash.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guaranteeslash.FieldID)
+		for _, f := range fields {
+			if !guaranteeslash.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guaranteeslash.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(guaranteeslash.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(guaranteeslash.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(guaranteeslash.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(guaranteeslash.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FinalOfferID(); ok {
+		_spec.SetField(guaranteeslash.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFinalOfferID(); ok {
+		_spec.AddField(guaranteeslash.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MilestoneID(); ok {
+		_spec.SetField(guaranteeslash.FieldMilestoneID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMilestoneID(); ok {
+		_spec.AddField(guaranteeslash.FieldMilestoneID, field.TypeInt, value)
+	}
+	if _u.mutation.MilestoneIDCleared() {
+		_spec.ClearField(guaranteeslash.FieldMilestoneID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(guaranteeslash.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(guaranteeslash.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DisputeID(); ok {
+		_spec.SetField(guaranteeslash.FieldDisputeID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(guaranteeslash.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GuaranteeAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldGuaranteeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGuaranteeAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldGuaranteeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Percent(); ok {
+		_spec.SetField(guaranteeslash.FieldPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercent(); ok {
+		_spec.AddField(guaranteeslash.FieldPercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CapAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldCapAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCapAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldCapAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(guaranteeslash.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(guaranteeslash.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SkipReason(); ok {
+		_spec.SetField(guaranteeslash.FieldSkipReason, field.TypeString, value)
+	}
+	if _u.mutation.SkipReasonCleared() {
+		_spec.ClearField(guaranteeslash.FieldSkipReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppealDeadlineAt(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealDeadlineAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AppealReason(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealReason, field.TypeString, value)
+	}
+	if _u.mutation.AppealReasonCleared() {
+		_spec.ClearField(guaranteeslash.FieldAppealReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.AppealedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldAppealedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppealedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldAppealedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolvedByAdminID(); ok {
+		_spec.SetField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResolvedByAdminID(); ok {
+		_spec.AddField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.ResolvedByAdminIDCleared() {
+		_spec.ClearField(guaranteeslash.FieldResolvedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ResolutionNote(); ok {
+		_spec.SetField(guaranteeslash.FieldResolutionNote, field.TypeString, value)
+	}
+	if _u.mutation.ResolutionNoteCleared() {
+		_spec.ClearField(guaranteeslash.FieldResolutionNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(guaranteeslash.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExecutedAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldExecutedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemainingGuaranteeAmount(); ok {
+		_spec.SetField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRemainingGuaranteeAmount(); ok {
+		_spec.AddField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.RemainingGuaranteeAmountCleared() {
+		_spec.ClearField(guaranteeslash.FieldRemainingGuaranteeAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.AttemptCount(); ok {
+		_spec.SetField(guaranteeslash.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(guaranteeslash.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(guaranteeslash.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(guaranteeslash.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(guaranteeslash.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(guaranteeslash.FieldLastError, field.TypeString)
+	}
+	_node = &GuaranteeSlash{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guaranteeslash.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
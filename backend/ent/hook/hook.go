@@ -20,6 +20,54 @@ func (f AdminFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminMutation", m)
 }
 
+// The AdminAuditLogFunc type is an adapter to allow the use of ordinary
+// function as AdminAuditLog mutator.
+type AdminAuditLogFunc func(context.Context, *ent.AdminAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminAuditLogMutation", m)
+}
+
+// The AdminPasskeyFunc type is an adapter to allow the use of ordinary
+// function as AdminPasskey mutator.
+type AdminPasskeyFunc func(context.Context, *ent.AdminPasskeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminPasskeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminPasskeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminPasskeyMutation", m)
+}
+
+// The AdminRoleFunc type is an adapter to allow the use of ordinary
+// function as AdminRole mutator.
+type AdminRoleFunc func(context.Context, *ent.AdminRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminRoleMutation", m)
+}
+
+// The AdminSessionFunc type is an adapter to allow the use of ordinary
+// function as AdminSession mutator.
+type AdminSessionFunc func(context.Context, *ent.AdminSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdminSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdminSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdminSessionMutation", m)
+}
+
 // The ArtifactSubmissionFunc type is an adapter to allow the use of ordinary
 // function as ArtifactSubmission mutator.
 type ArtifactSubmissionFunc func(context.Context, *ent.ArtifactSubmissionMutation) (ent.Value, error)
@@ -56,6 +104,42 @@ func (f BadgeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BadgeMutation", m)
 }
 
+// The CaseClarificationFunc type is an adapter to allow the use of ordinary
+// function as CaseClarification mutator.
+type CaseClarificationFunc func(context.Context, *ent.CaseClarificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CaseClarificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CaseClarificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CaseClarificationMutation", m)
+}
+
+// The CaseConversationFunc type is an adapter to allow the use of ordinary
+// function as CaseConversation mutator.
+type CaseConversationFunc func(context.Context, *ent.CaseConversationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CaseConversationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CaseConversationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CaseConversationMutation", m)
+}
+
+// The CaseMessageFunc type is an adapter to allow the use of ordinary
+// function as CaseMessage mutator.
+type CaseMessageFunc func(context.Context, *ent.CaseMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CaseMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CaseMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CaseMessageMutation", m)
+}
+
 // The CategoryFunc type is an adapter to allow the use of ordinary
 // function as Category mutator.
 type CategoryFunc func(context.Context, *ent.CategoryMutation) (ent.Value, error)
@@ -80,6 +164,18 @@ func (f ChainCursorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChainCursorMutation", m)
 }
 
+// The ConsultationInvitationFunc type is an adapter to allow the use of ordinary
+// function as ConsultationInvitation mutator.
+type ConsultationInvitationFunc func(context.Context, *ent.ConsultationInvitationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsultationInvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConsultationInvitationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConsultationInvitationMutation", m)
+}
+
 // The ConsultationRequestFunc type is an adapter to allow the use of ordinary
 // function as ConsultationRequest mutator.
 type ConsultationRequestFunc func(context.Context, *ent.ConsultationRequestMutation) (ent.Value, error)
@@ -164,6 +260,42 @@ func (f FinalOfferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FinalOfferMutation", m)
 }
 
+// The FinalOfferMilestoneFunc type is an adapter to allow the use of ordinary
+// function as FinalOfferMilestone mutator.
+type FinalOfferMilestoneFunc func(context.Context, *ent.FinalOfferMilestoneMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FinalOfferMilestoneFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FinalOfferMilestoneMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FinalOfferMilestoneMutation", m)
+}
+
+// The FinalOfferRevisionFunc type is an adapter to allow the use of ordinary
+// function as FinalOfferRevision mutator.
+type FinalOfferRevisionFunc func(context.Context, *ent.FinalOfferRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FinalOfferRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FinalOfferRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FinalOfferRevisionMutation", m)
+}
+
+// The GuaranteeSlashFunc type is an adapter to allow the use of ordinary
+// function as GuaranteeSlash mutator.
+type GuaranteeSlashFunc func(context.Context, *ent.GuaranteeSlashMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GuaranteeSlashFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GuaranteeSlashMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GuaranteeSlashMutation", m)
+}
+
 // The IPGeoCacheFunc type is an adapter to allow the use of ordinary
 // function as IPGeoCache mutator.
 type IPGeoCacheFunc func(context.Context, *ent.IPGeoCacheMutation) (ent.Value, error)
@@ -176,6 +308,30 @@ func (f IPGeoCacheFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IPGeoCacheMutation", m)
 }
 
+// The IPRuleFunc type is an adapter to allow the use of ordinary
+// function as IPRule mutator.
+type IPRuleFunc func(context.Context, *ent.IPRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IPRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IPRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IPRuleMutation", m)
+}
+
+// The ImpersonationSessionFunc type is an adapter to allow the use of ordinary
+// function as ImpersonationSession mutator.
+type ImpersonationSessionFunc func(context.Context, *ent.ImpersonationSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ImpersonationSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ImpersonationSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImpersonationSessionMutation", m)
+}
+
 // The MarketPurchaseOrderFunc type is an adapter to allow the use of ordinary
 // function as MarketPurchaseOrder mutator.
 type MarketPurchaseOrderFunc func(context.Context, *ent.MarketPurchaseOrderMutation) (ent.Value, error)
@@ -224,6 +380,30 @@ func (f PasswordResetTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PasswordResetTokenMutation", m)
 }
 
+// The ReputationEventFunc type is an adapter to allow the use of ordinary
+// function as ReputationEvent mutator.
+type ReputationEventFunc func(context.Context, *ent.ReputationEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReputationEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReputationEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReputationEventMutation", m)
+}
+
+// The SLAPolicyFunc type is an adapter to allow the use of ordinary
+// function as SLAPolicy mutator.
+type SLAPolicyFunc func(context.Context, *ent.SLAPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SLAPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SLAPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SLAPolicyMutation", m)
+}
+
 // The SecurityEventFunc type is an adapter to allow the use of ordinary
 // function as SecurityEvent mutator.
 type SecurityEventFunc func(context.Context, *ent.SecurityEventMutation) (ent.Value, error)
@@ -344,6 +524,42 @@ func (f ValidationCaseLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ValidationCaseLogMutation", m)
 }
 
+// The ValidatorDeliverySLAFunc type is an adapter to allow the use of ordinary
+// function as ValidatorDeliverySLA mutator.
+type ValidatorDeliverySLAFunc func(context.Context, *ent.ValidatorDeliverySLAMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ValidatorDeliverySLAFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ValidatorDeliverySLAMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ValidatorDeliverySLAMutation", m)
+}
+
+// The ValidatorMatchingAggregateFunc type is an adapter to allow the use of ordinary
+// function as ValidatorMatchingAggregate mutator.
+type ValidatorMatchingAggregateFunc func(context.Context, *ent.ValidatorMatchingAggregateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ValidatorMatchingAggregateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ValidatorMatchingAggregateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ValidatorMatchingAggregateMutation", m)
+}
+
+// The ValidatorReputationFunc type is an adapter to allow the use of ordinary
+// function as ValidatorReputation mutator.
+type ValidatorReputationFunc func(context.Context, *ent.ValidatorReputationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ValidatorReputationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ValidatorReputationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ValidatorReputationMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,910 @@
+// Code generated by ent, DO NOT EDIT.
+
+package impersonationsession
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// AdminID applies equality check predicate on the "admin_id" field. It's identical to AdminIDEQ.
+func AdminID(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldAdminID, v))
+}
+
+// AdminEmail applies equality check predicate on the "admin_email" field. It's identical to AdminEmailEQ.
+func AdminEmail(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldAdminEmail, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldReason, v))
+}
+
+// Jti applies equality check predicate on the "jti" field. It's identical to JtiEQ.
+func Jti(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldJti, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedByAdminID applies equality check predicate on the "revoked_by_admin_id" field. It's identical to RevokedByAdminIDEQ.
+func RevokedByAdminID(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldRevokedByAdminID, v))
+}
+
+// IPAddress applies equality check predicate on the "ip_address" field. It's identical to IPAddressEQ.
+func IPAddress(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// RequestCount applies equality check predicate on the "request_count" field. It's identical to RequestCountEQ.
+func RequestCount(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// AdminIDEQ applies the EQ predicate on the "admin_id" field.
+func AdminIDEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldAdminID, v))
+}
+
+// AdminIDNEQ applies the NEQ predicate on the "admin_id" field.
+func AdminIDNEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldAdminID, v))
+}
+
+// AdminIDIn applies the In predicate on the "admin_id" field.
+func AdminIDIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldAdminID, vs...))
+}
+
+// AdminIDNotIn applies the NotIn predicate on the "admin_id" field.
+func AdminIDNotIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldAdminID, vs...))
+}
+
+// AdminIDGT applies the GT predicate on the "admin_id" field.
+func AdminIDGT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldAdminID, v))
+}
+
+// AdminIDGTE applies the GTE predicate on the "admin_id" field.
+func AdminIDGTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldAdminID, v))
+}
+
+// AdminIDLT applies the LT predicate on the "admin_id" field.
+func AdminIDLT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldAdminID, v))
+}
+
+// AdminIDLTE applies the LTE predicate on the "admin_id" field.
+func AdminIDLTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldAdminID, v))
+}
+
+// AdminEmailEQ applies the EQ predicate on the "admin_email" field.
+func AdminEmailEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldAdminEmail, v))
+}
+
+// AdminEmailNEQ applies the NEQ predicate on the "admin_email" field.
+func AdminEmailNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldAdminEmail, v))
+}
+
+// AdminEmailIn applies the In predicate on the "admin_email" field.
+func AdminEmailIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldAdminEmail, vs...))
+}
+
+// AdminEmailNotIn applies the NotIn predicate on the "admin_email" field.
+func AdminEmailNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldAdminEmail, vs...))
+}
+
+// AdminEmailGT applies the GT predicate on the "admin_email" field.
+func AdminEmailGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldAdminEmail, v))
+}
+
+// AdminEmailGTE applies the GTE predicate on the "admin_email" field.
+func AdminEmailGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldAdminEmail, v))
+}
+
+// AdminEmailLT applies the LT predicate on the "admin_email" field.
+func AdminEmailLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldAdminEmail, v))
+}
+
+// AdminEmailLTE applies the LTE predicate on the "admin_email" field.
+func AdminEmailLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldAdminEmail, v))
+}
+
+// AdminEmailContains applies the Contains predicate on the "admin_email" field.
+func AdminEmailContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldAdminEmail, v))
+}
+
+// AdminEmailHasPrefix applies the HasPrefix predicate on the "admin_email" field.
+func AdminEmailHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldAdminEmail, v))
+}
+
+// AdminEmailHasSuffix applies the HasSuffix predicate on the "admin_email" field.
+func AdminEmailHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldAdminEmail, v))
+}
+
+// AdminEmailEqualFold applies the EqualFold predicate on the "admin_email" field.
+func AdminEmailEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldAdminEmail, v))
+}
+
+// AdminEmailContainsFold applies the ContainsFold predicate on the "admin_email" field.
+func AdminEmailContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldAdminEmail, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldUserID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldReason, v))
+}
+
+// JtiEQ applies the EQ predicate on the "jti" field.
+func JtiEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldJti, v))
+}
+
+// JtiNEQ applies the NEQ predicate on the "jti" field.
+func JtiNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldJti, v))
+}
+
+// JtiIn applies the In predicate on the "jti" field.
+func JtiIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldJti, vs...))
+}
+
+// JtiNotIn applies the NotIn predicate on the "jti" field.
+func JtiNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldJti, vs...))
+}
+
+// JtiGT applies the GT predicate on the "jti" field.
+func JtiGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldJti, v))
+}
+
+// JtiGTE applies the GTE predicate on the "jti" field.
+func JtiGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldJti, v))
+}
+
+// JtiLT applies the LT predicate on the "jti" field.
+func JtiLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldJti, v))
+}
+
+// JtiLTE applies the LTE predicate on the "jti" field.
+func JtiLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldJti, v))
+}
+
+// JtiContains applies the Contains predicate on the "jti" field.
+func JtiContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldJti, v))
+}
+
+// JtiHasPrefix applies the HasPrefix predicate on the "jti" field.
+func JtiHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldJti, v))
+}
+
+// JtiHasSuffix applies the HasSuffix predicate on the "jti" field.
+func JtiHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldJti, v))
+}
+
+// JtiEqualFold applies the EqualFold predicate on the "jti" field.
+func JtiEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldJti, v))
+}
+
+// JtiContainsFold applies the ContainsFold predicate on the "jti" field.
+func JtiContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldJti, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// RevokedByAdminIDEQ applies the EQ predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldRevokedByAdminID, v))
+}
+
+// RevokedByAdminIDNEQ applies the NEQ predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDNEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldRevokedByAdminID, v))
+}
+
+// RevokedByAdminIDIn applies the In predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldRevokedByAdminID, vs...))
+}
+
+// RevokedByAdminIDNotIn applies the NotIn predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDNotIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldRevokedByAdminID, vs...))
+}
+
+// RevokedByAdminIDGT applies the GT predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDGT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldRevokedByAdminID, v))
+}
+
+// RevokedByAdminIDGTE applies the GTE predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDGTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldRevokedByAdminID, v))
+}
+
+// RevokedByAdminIDLT applies the LT predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDLT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldRevokedByAdminID, v))
+}
+
+// RevokedByAdminIDLTE applies the LTE predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDLTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldRevokedByAdminID, v))
+}
+
+// RevokedByAdminIDIsNil applies the IsNil predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldRevokedByAdminID))
+}
+
+// RevokedByAdminIDNotNil applies the NotNil predicate on the "revoked_by_admin_id" field.
+func RevokedByAdminIDNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldRevokedByAdminID))
+}
+
+// IPAddressEQ applies the EQ predicate on the "ip_address" field.
+func IPAddressEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldIPAddress, v))
+}
+
+// IPAddressNEQ applies the NEQ predicate on the "ip_address" field.
+func IPAddressNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldIPAddress, v))
+}
+
+// IPAddressIn applies the In predicate on the "ip_address" field.
+func IPAddressIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldIPAddress, vs...))
+}
+
+// IPAddressNotIn applies the NotIn predicate on the "ip_address" field.
+func IPAddressNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldIPAddress, vs...))
+}
+
+// IPAddressGT applies the GT predicate on the "ip_address" field.
+func IPAddressGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldIPAddress, v))
+}
+
+// IPAddressGTE applies the GTE predicate on the "ip_address" field.
+func IPAddressGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldIPAddress, v))
+}
+
+// IPAddressLT applies the LT predicate on the "ip_address" field.
+func IPAddressLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldIPAddress, v))
+}
+
+// IPAddressLTE applies the LTE predicate on the "ip_address" field.
+func IPAddressLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldIPAddress, v))
+}
+
+// IPAddressContains applies the Contains predicate on the "ip_address" field.
+func IPAddressContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldIPAddress, v))
+}
+
+// IPAddressHasPrefix applies the HasPrefix predicate on the "ip_address" field.
+func IPAddressHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldIPAddress, v))
+}
+
+// IPAddressHasSuffix applies the HasSuffix predicate on the "ip_address" field.
+func IPAddressHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldIPAddress, v))
+}
+
+// IPAddressEqualFold applies the EqualFold predicate on the "ip_address" field.
+func IPAddressEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldIPAddress, v))
+}
+
+// IPAddressContainsFold applies the ContainsFold predicate on the "ip_address" field.
+func IPAddressContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldIPAddress, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// RequestCountEQ applies the EQ predicate on the "request_count" field.
+func RequestCountEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// RequestCountNEQ applies the NEQ predicate on the "request_count" field.
+func RequestCountNEQ(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNEQ(FieldRequestCount, v))
+}
+
+// RequestCountIn applies the In predicate on the "request_count" field.
+func RequestCountIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldIn(FieldRequestCount, vs...))
+}
+
+// RequestCountNotIn applies the NotIn predicate on the "request_count" field.
+func RequestCountNotIn(vs ...int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldNotIn(FieldRequestCount, vs...))
+}
+
+// RequestCountGT applies the GT predicate on the "request_count" field.
+func RequestCountGT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGT(FieldRequestCount, v))
+}
+
+// RequestCountGTE applies the GTE predicate on the "request_count" field.
+func RequestCountGTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldGTE(FieldRequestCount, v))
+}
+
+// RequestCountLT applies the LT predicate on the "request_count" field.
+func RequestCountLT(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLT(FieldRequestCount, v))
+}
+
+// RequestCountLTE applies the LTE predicate on the "request_count" field.
+func RequestCountLTE(v int) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.FieldLTE(FieldRequestCount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImpersonationSession) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImpersonationSession) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImpersonationSession) predicate.ImpersonationSession {
+	return predicate.ImpersonationSession(sql.NotPredicates(p))
+}
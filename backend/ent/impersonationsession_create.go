@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/impersonationsession"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImpersonationSessionCreate is the builder for creating a ImpersonationSession entity.
+type ImpersonationSessionCreate struct {
+	config
+	mutation *ImpersonationSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ImpersonationSessionCreate) SetCreatedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableCreatedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ImpersonationSessionCreate) SetUpdatedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableUpdatedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ImpersonationSessionCreate) SetDeletedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableDeletedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetAdminID sets the "admin_id" field.
+func (_c *ImpersonationSessionCreate) SetAdminID(v int) *ImpersonationSessionCreate {
+	_c.mutation.SetAdminID(v)
+	return _c
+}
+
+// SetAdminEmail sets the "admin_email" field.
+func (_c *ImpersonationSessionCreate) SetAdminEmail(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetAdminEmail(v)
+	return _c
+}
+
+// SetNillableAdminEmail sets the "admin_email" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableAdminEmail(v *string) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetAdminEmail(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ImpersonationSessionCreate) SetUserID(v int) *ImpersonationSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ImpersonationSessionCreate) SetReason(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetJti sets the "jti" field.
+func (_c *ImpersonationSessionCreate) SetJti(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetJti(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ImpersonationSessionCreate) SetExpiresAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *ImpersonationSessionCreate) SetRevokedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableRevokedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedByAdminID sets the "revoked_by_admin_id" field.
+func (_c *ImpersonationSessionCreate) SetRevokedByAdminID(v int) *ImpersonationSessionCreate {
+	_c.mutation.SetRevokedByAdminID(v)
+	return _c
+}
+
+// SetNillableRevokedByAdminID sets the "revoked_by_admin_id" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableRevokedByAdminID(v *int) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetRevokedByAdminID(*v)
+	}
+	return _c
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_c *ImpersonationSessionCreate) SetIPAddress(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetIPAddress(v)
+	return _c
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableIPAddress(v *string) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetIPAddress(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *ImpersonationSessionCreate) SetUserAgent(v string) *ImpersonationSessionCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableUserAgent(v *string) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_c *ImpersonationSessionCreate) SetLastUsedAt(v time.Time) *ImpersonationSessionCreate {
+	_c.mutation.SetLastUsedAt(v)
+	return _c
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableLastUsedAt(v *time.Time) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetLastUsedAt(*v)
+	}
+	return _c
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_c *ImpersonationSessionCreate) SetRequestCount(v int) *ImpersonationSessionCreate {
+	_c.mutation.SetRequestCount(v)
+	return _c
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_c *ImpersonationSessionCreate) SetNillableRequestCount(v *int) *ImpersonationSessionCreate {
+	if v != nil {
+		_c.SetRequestCount(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ImpersonationSessionMutation object of the builder.
+func (_c *ImpersonationSessionCreate) Mutation() *ImpersonationSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the ImpersonationSession in the database.
+func (_c *ImpersonationSessionCreate) Save(ctx context.Context) (*ImpersonationSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImpersonationSessionCreate) SaveX(ctx context.Context) *ImpersonationSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImpersonationSessionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := impersonationsession.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := impersonationsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.AdminEmail(); !ok {
+		v := impersonationsession.DefaultAdminEmail
+		_c.mutation.SetAdminEmail(v)
+	}
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		v := impersonationsession.DefaultIPAddress
+		_c.mutation.SetIPAddress(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := impersonationsession.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		v := impersonationsession.DefaultRequestCount
+		_c.mutation.SetRequestCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImpersonationSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImpersonationSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ImpersonationSession.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AdminID(); !ok {
+		return &ValidationError{Name: "admin_id", err: errors.New(`ent: missing required field "ImpersonationSession.admin_id"`)}
+	}
+	if _, ok := _c.mutation.AdminEmail(); !ok {
+		return &ValidationError{Name: "admin_email", err: errors.New(`ent: missing required field "ImpersonationSession.admin_email"`)}
+	}
+	if v, ok := _c.mutation.AdminEmail(); ok {
+		if err := impersonationsession.AdminEmailValidator(v); err != nil {
+			return &ValidationError{Name: "admin_email", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.admin_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ImpersonationSession.user_id"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ImpersonationSession.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := impersonationsession.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`ent: missing required field "ImpersonationSession.jti"`)}
+	}
+	if v, ok := _c.mutation.Jti(); ok {
+		if err := impersonationsession.JtiValidator(v); err != nil {
+			return &ValidationError{Name: "jti", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.jti": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ImpersonationSession.expires_at"`)}
+	}
+	if _, ok := _c.mutation.IPAddress(); !ok {
+		return &ValidationError{Name: "ip_address", err: errors.New(`ent: missing required field "ImpersonationSession.ip_address"`)}
+	}
+	if v, ok := _c.mutation.IPAddress(); ok {
+		if err := impersonationsession.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.ip_address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "ImpersonationSession.user_agent"`)}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := impersonationsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		return &ValidationError{Name: "request_count", err: errors.New(`ent: missing required field "ImpersonationSession.request_count"`)}
+	}
+	return nil
+}
+
+func (_c *ImpersonationSessionCreate) sqlSave(ctx context.Context) (*ImpersonationSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImpersonationSessionCreate) createSpec() (*ImpersonationSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImpersonationSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(impersonationsession.Table, sqlgraph.NewFieldSpec(impersonationsession.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(impersonationsession.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.AdminID(); ok {
+		_spec.SetField(impersonationsession.FieldAdminID, field.TypeInt, value)
+		_node.AdminID = value
+	}
+	if value, ok := _c.mutation.AdminEmail(); ok {
+		_spec.SetField(impersonationsession.FieldAdminEmail, field.TypeString, value)
+		_node.AdminEmail = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(impersonationsession.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(impersonationsession.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Jti(); ok {
+		_spec.SetField(impersonationsession.FieldJti, field.TypeString, value)
+		_node.Jti = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationsession.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(impersonationsession.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.RevokedByAdminID(); ok {
+		_spec.SetField(impersonationsession.FieldRevokedByAdminID, field.TypeInt, value)
+		_node.RevokedByAdminID = &value
+	}
+	if value, ok := _c.mutation.IPAddress(); ok {
+		_spec.SetField(impersonationsession.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(impersonationsession.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.LastUsedAt(); ok {
+		_spec.SetField(impersonationsession.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := _c.mutation.RequestCount(); ok {
+		_spec.SetField(impersonationsession.FieldRequestCount, field.TypeInt, value)
+		_node.RequestCount = value
+	}
+	return _node, _spec
+}
+
+// ImpersonationSessionCreateBulk is the builder for creating many ImpersonationSession entities in bulk.
+type ImpersonationSessionCreateBulk struct {
+	config
+	err      error
+	builders []*ImpersonationSessionCreate
+}
+
+// Save creates the ImpersonationSession entities in the database.
+func (_c *ImpersonationSessionCreateBulk) Save(ctx context.Context) ([]*ImpersonationSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImpersonationSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImpersonationSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImpersonationSessionCreateBulk) SaveX(ctx context.Context) []*ImpersonationSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
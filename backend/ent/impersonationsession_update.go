@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/impersonationsession"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImpersonationSessionUpdate is the builder for updating ImpersonationSession entities.
+type ImpersonationSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImpersonationSessionMutation
+}
+
+// Where appends a list predicates to the ImpersonationSessionUpdate builder.
+func (_u *ImpersonationSessionUpdate) Where(ps ...predicate.ImpersonationSession) *ImpersonationSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImpersonationSessionUpdate) SetUpdatedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ImpersonationSessionUpdate) SetDeletedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableDeletedAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ImpersonationSessionUpdate) ClearDeletedAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ImpersonationSessionUpdate) SetRevokedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableRevokedAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ImpersonationSessionUpdate) ClearRevokedAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedByAdminID sets the "revoked_by_admin_id" field.
+func (_u *ImpersonationSessionUpdate) SetRevokedByAdminID(v int) *ImpersonationSessionUpdate {
+	_u.mutation.ResetRevokedByAdminID()
+	_u.mutation.SetRevokedByAdminID(v)
+	return _u
+}
+
+// SetNillableRevokedByAdminID sets the "revoked_by_admin_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableRevokedByAdminID(v *int) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetRevokedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddRevokedByAdminID adds value to the "revoked_by_admin_id" field.
+func (_u *ImpersonationSessionUpdate) AddRevokedByAdminID(v int) *ImpersonationSessionUpdate {
+	_u.mutation.AddRevokedByAdminID(v)
+	return _u
+}
+
+// ClearRevokedByAdminID clears the value of the "revoked_by_admin_id" field.
+func (_u *ImpersonationSessionUpdate) ClearRevokedByAdminID() *ImpersonationSessionUpdate {
+	_u.mutation.ClearRevokedByAdminID()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *ImpersonationSessionUpdate) SetIPAddress(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableIPAddress(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *ImpersonationSessionUpdate) SetUserAgent(v string) *ImpersonationSessionUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableUserAgent(v *string) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ImpersonationSessionUpdate) SetLastUsedAt(v time.Time) *ImpersonationSessionUpdate {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableLastUsedAt(v *time.Time) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ImpersonationSessionUpdate) ClearLastUsedAt() *ImpersonationSessionUpdate {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *ImpersonationSessionUpdate) SetRequestCount(v int) *ImpersonationSessionUpdate {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdate) SetNillableRequestCount(v *int) *ImpersonationSessionUpdate {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *ImpersonationSessionUpdate) AddRequestCount(v int) *ImpersonationSessionUpdate {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// Mutation returns the ImpersonationSessionMutation object of the builder.
+func (_u *ImpersonationSessionUpdate) Mutation() *ImpersonationSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImpersonationSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImpersonationSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := impersonationsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationSessionUpdate) check() error {
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := impersonationsession.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := impersonationsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImpersonationSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonationsession.Table, impersonationsession.Columns, sqlgraph.NewFieldSpec(impersonationsession.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(impersonationsession.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(impersonationsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedByAdminID(); ok {
+		_spec.SetField(impersonationsession.FieldRevokedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevokedByAdminID(); ok {
+		_spec.AddField(impersonationsession.FieldRevokedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.RevokedByAdminIDCleared() {
+		_spec.ClearField(impersonationsession.FieldRevokedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(impersonationsession.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(impersonationsession.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(impersonationsession.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(impersonationsession.FieldRequestCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(impersonationsession.FieldRequestCount, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonationsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImpersonationSessionUpdateOne is the builder for updating a single ImpersonationSession entity.
+type ImpersonationSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImpersonationSessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetUpdatedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetDeletedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableDeletedAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearDeletedAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetRevokedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableRevokedAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearRevokedAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetRevokedByAdminID sets the "revoked_by_admin_id" field.
+func (_u *ImpersonationSessionUpdateOne) SetRevokedByAdminID(v int) *ImpersonationSessionUpdateOne {
+	_u.mutation.ResetRevokedByAdminID()
+	_u.mutation.SetRevokedByAdminID(v)
+	return _u
+}
+
+// SetNillableRevokedByAdminID sets the "revoked_by_admin_id" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableRevokedByAdminID(v *int) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetRevokedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddRevokedByAdminID adds value to the "revoked_by_admin_id" field.
+func (_u *ImpersonationSessionUpdateOne) AddRevokedByAdminID(v int) *ImpersonationSessionUpdateOne {
+	_u.mutation.AddRevokedByAdminID(v)
+	return _u
+}
+
+// ClearRevokedByAdminID clears the value of the "revoked_by_admin_id" field.
+func (_u *ImpersonationSessionUpdateOne) ClearRevokedByAdminID() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearRevokedByAdminID()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *ImpersonationSessionUpdateOne) SetIPAddress(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableIPAddress(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *ImpersonationSessionUpdateOne) SetUserAgent(v string) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableUserAgent(v *string) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (_u *ImpersonationSessionUpdateOne) SetLastUsedAt(v time.Time) *ImpersonationSessionUpdateOne {
+	_u.mutation.SetLastUsedAt(v)
+	return _u
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableLastUsedAt(v *time.Time) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetLastUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (_u *ImpersonationSessionUpdateOne) ClearLastUsedAt() *ImpersonationSessionUpdateOne {
+	_u.mutation.ClearLastUsedAt()
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *ImpersonationSessionUpdateOne) SetRequestCount(v int) *ImpersonationSessionUpdateOne {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *ImpersonationSessionUpdateOne) SetNillableRequestCount(v *int) *ImpersonationSessionUpdateOne {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *ImpersonationSessionUpdateOne) AddRequestCount(v int) *ImpersonationSessionUpdateOne {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// Mutation returns the ImpersonationSessionMutation object of the builder.
+func (_u *ImpersonationSessionUpdateOne) Mutation() *ImpersonationSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ImpersonationSessionUpdate builder.
+func (_u *ImpersonationSessionUpdateOne) Where(ps ...predicate.ImpersonationSession) *ImpersonationSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImpersonationSessionUpdateOne) Select(field string, fields ...string) *ImpersonationSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImpersonationSession entity.
+func (_u *ImpersonationSessionUpdateOne) Save(ctx context.Context) (*ImpersonationSession, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdateOne) SaveX(ctx context.Context) *ImpersonationSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImpersonationSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := impersonationsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := impersonationsession.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := impersonationsession.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "ImpersonationSession.user_agent": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImpersonationSessionUpdateOne) sqlSave(ctx context.Context) (_node *ImpersonationSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonationsession.Table, impersonationsession.Columns, sqlgraph.NewFieldSpec(impersonationsession.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImpersonationSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, impersonationsession.FieldID)
+		for _, f := range fields {
+			if !impersonationsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != impersonationsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(impersonationsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(impersonationsession.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(impersonationsession.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedByAdminID(); ok {
+		_spec.SetField(impersonationsession.FieldRevokedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevokedByAdminID(); ok {
+		_spec.AddField(impersonationsession.FieldRevokedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.RevokedByAdminIDCleared() {
+		_spec.ClearField(impersonationsession.FieldRevokedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(impersonationsession.FieldIPAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(impersonationsession.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastUsedAt(); ok {
+		_spec.SetField(impersonationsession.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUsedAtCleared() {
+		_spec.ClearField(impersonationsession.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(impersonationsession.FieldRequestCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(impersonationsession.FieldRequestCount, field.TypeInt, value)
+	}
+	_node = &ImpersonationSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonationsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -35,6 +35,8 @@ type IPGeoCache struct {
 	Latitude float64 `json:"latitude,omitempty"`
 	// Longitude holds the value of the "longitude" field.
 	Longitude float64 `json:"longitude,omitempty"`
+	// Asn holds the value of the "asn" field.
+	Asn string `json:"asn,omitempty"`
 	// CachedAt holds the value of the "cached_at" field.
 	CachedAt     time.Time `json:"cached_at,omitempty"`
 	selectValues sql.SelectValues
@@ -49,7 +51,7 @@ func (*IPGeoCache) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case ipgeocache.FieldID:
 			values[i] = new(sql.NullInt64)
-		case ipgeocache.FieldIPAddress, ipgeocache.FieldCountryCode, ipgeocache.FieldCountryName, ipgeocache.FieldCity:
+		case ipgeocache.FieldIPAddress, ipgeocache.FieldCountryCode, ipgeocache.FieldCountryName, ipgeocache.FieldCity, ipgeocache.FieldAsn:
 			values[i] = new(sql.NullString)
 		case ipgeocache.FieldCreatedAt, ipgeocache.FieldUpdatedAt, ipgeocache.FieldDeletedAt, ipgeocache.FieldCachedAt:
 			values[i] = new(sql.NullTime)
@@ -129,6 +131,12 @@ func (_m *IPGeoCache) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Longitude = value.Float64
 			}
+		case ipgeocache.FieldAsn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asn", values[i])
+			} else if value.Valid {
+				_m.Asn = value.String
+			}
 		case ipgeocache.FieldCachedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field cached_at", values[i])
@@ -200,6 +208,9 @@ func (_m *IPGeoCache) String() string {
 	builder.WriteString("longitude=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Longitude))
 	builder.WriteString(", ")
+	builder.WriteString("asn=")
+	builder.WriteString(_m.Asn)
+	builder.WriteString(", ")
 	builder.WriteString("cached_at=")
 	builder.WriteString(_m.CachedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -31,6 +31,8 @@ const (
 	FieldLatitude = "latitude"
 	// FieldLongitude holds the string denoting the longitude field in the database.
 	FieldLongitude = "longitude"
+	// FieldAsn holds the string denoting the asn field in the database.
+	FieldAsn = "asn"
 	// FieldCachedAt holds the string denoting the cached_at field in the database.
 	FieldCachedAt = "cached_at"
 	// Table holds the table name of the ipgeocache in the database.
@@ -49,6 +51,7 @@ var Columns = []string{
 	FieldCity,
 	FieldLatitude,
 	FieldLongitude,
+	FieldAsn,
 	FieldCachedAt,
 }
 
@@ -77,6 +80,8 @@ var (
 	CountryNameValidator func(string) error
 	// CityValidator is a validator for the "city" field. It is called by the builders before save.
 	CityValidator func(string) error
+	// AsnValidator is a validator for the "asn" field. It is called by the builders before save.
+	AsnValidator func(string) error
 	// DefaultCachedAt holds the default value on creation for the "cached_at" field.
 	DefaultCachedAt func() time.Time
 )
@@ -134,6 +139,11 @@ func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
 }
 
+// ByAsn orders the results by the asn field.
+func ByAsn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsn, opts...).ToFunc()
+}
+
 // ByCachedAt orders the results by the cached_at field.
 func ByCachedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCachedAt, opts...).ToFunc()
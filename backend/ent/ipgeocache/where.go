@@ -99,6 +99,11 @@ func Longitude(v float64) predicate.IPGeoCache {
 	return predicate.IPGeoCache(sql.FieldEQ(FieldLongitude, v))
 }
 
+// Asn applies equality check predicate on the "asn" field. It's identical to AsnEQ.
+func Asn(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldEQ(FieldAsn, v))
+}
+
 // CachedAt applies equality check predicate on the "cached_at" field. It's identical to CachedAtEQ.
 func CachedAt(v time.Time) predicate.IPGeoCache {
 	return predicate.IPGeoCache(sql.FieldEQ(FieldCachedAt, v))
@@ -624,6 +629,81 @@ func LongitudeNotNil() predicate.IPGeoCache {
 	return predicate.IPGeoCache(sql.FieldNotNull(FieldLongitude))
 }
 
+// AsnEQ applies the EQ predicate on the "asn" field.
+func AsnEQ(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldEQ(FieldAsn, v))
+}
+
+// AsnNEQ applies the NEQ predicate on the "asn" field.
+func AsnNEQ(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldNEQ(FieldAsn, v))
+}
+
+// AsnIn applies the In predicate on the "asn" field.
+func AsnIn(vs ...string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldIn(FieldAsn, vs...))
+}
+
+// AsnNotIn applies the NotIn predicate on the "asn" field.
+func AsnNotIn(vs ...string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldNotIn(FieldAsn, vs...))
+}
+
+// AsnGT applies the GT predicate on the "asn" field.
+func AsnGT(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldGT(FieldAsn, v))
+}
+
+// AsnGTE applies the GTE predicate on the "asn" field.
+func AsnGTE(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldGTE(FieldAsn, v))
+}
+
+// AsnLT applies the LT predicate on the "asn" field.
+func AsnLT(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldLT(FieldAsn, v))
+}
+
+// AsnLTE applies the LTE predicate on the "asn" field.
+func AsnLTE(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldLTE(FieldAsn, v))
+}
+
+// AsnContains applies the Contains predicate on the "asn" field.
+func AsnContains(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldContains(FieldAsn, v))
+}
+
+// AsnHasPrefix applies the HasPrefix predicate on the "asn" field.
+func AsnHasPrefix(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldHasPrefix(FieldAsn, v))
+}
+
+// AsnHasSuffix applies the HasSuffix predicate on the "asn" field.
+func AsnHasSuffix(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldHasSuffix(FieldAsn, v))
+}
+
+// AsnIsNil applies the IsNil predicate on the "asn" field.
+func AsnIsNil() predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldIsNull(FieldAsn))
+}
+
+// AsnNotNil applies the NotNil predicate on the "asn" field.
+func AsnNotNil() predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldNotNull(FieldAsn))
+}
+
+// AsnEqualFold applies the EqualFold predicate on the "asn" field.
+func AsnEqualFold(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldEqualFold(FieldAsn, v))
+}
+
+// AsnContainsFold applies the ContainsFold predicate on the "asn" field.
+func AsnContainsFold(v string) predicate.IPGeoCache {
+	return predicate.IPGeoCache(sql.FieldContainsFold(FieldAsn, v))
+}
+
 // CachedAtEQ applies the EQ predicate on the "cached_at" field.
 func CachedAtEQ(v time.Time) predicate.IPGeoCache {
 	return predicate.IPGeoCache(sql.FieldEQ(FieldCachedAt, v))
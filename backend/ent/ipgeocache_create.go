@@ -138,6 +138,20 @@ func (_c *IPGeoCacheCreate) SetNillableLongitude(v *float64) *IPGeoCacheCreate {
 	return _c
 }
 
+// SetAsn sets the "asn" field.
+func (_c *IPGeoCacheCreate) SetAsn(v string) *IPGeoCacheCreate {
+	_c.mutation.SetAsn(v)
+	return _c
+}
+
+// SetNillableAsn sets the "asn" field if the given value is not nil.
+func (_c *IPGeoCacheCreate) SetNillableAsn(v *string) *IPGeoCacheCreate {
+	if v != nil {
+		_c.SetAsn(*v)
+	}
+	return _c
+}
+
 // SetCachedAt sets the "cached_at" field.
 func (_c *IPGeoCacheCreate) SetCachedAt(v time.Time) *IPGeoCacheCreate {
 	_c.mutation.SetCachedAt(v)
@@ -232,6 +246,11 @@ func (_c *IPGeoCacheCreate) check() error {
 			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "IPGeoCache.city": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Asn(); ok {
+		if err := ipgeocache.AsnValidator(v); err != nil {
+			return &ValidationError{Name: "asn", err: fmt.Errorf(`ent: validator failed for field "IPGeoCache.asn": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CachedAt(); !ok {
 		return &ValidationError{Name: "cached_at", err: errors.New(`ent: missing required field "IPGeoCache.cached_at"`)}
 	}
@@ -297,6 +316,10 @@ func (_c *IPGeoCacheCreate) createSpec() (*IPGeoCache, *sqlgraph.CreateSpec) {
 		_spec.SetField(ipgeocache.FieldLongitude, field.TypeFloat64, value)
 		_node.Longitude = value
 	}
+	if value, ok := _c.mutation.Asn(); ok {
+		_spec.SetField(ipgeocache.FieldAsn, field.TypeString, value)
+		_node.Asn = value
+	}
 	if value, ok := _c.mutation.CachedAt(); ok {
 		_spec.SetField(ipgeocache.FieldCachedAt, field.TypeTime, value)
 		_node.CachedAt = value
@@ -182,6 +182,26 @@ func (_u *IPGeoCacheUpdate) ClearLongitude() *IPGeoCacheUpdate {
 	return _u
 }
 
+// SetAsn sets the "asn" field.
+func (_u *IPGeoCacheUpdate) SetAsn(v string) *IPGeoCacheUpdate {
+	_u.mutation.SetAsn(v)
+	return _u
+}
+
+// SetNillableAsn sets the "asn" field if the given value is not nil.
+func (_u *IPGeoCacheUpdate) SetNillableAsn(v *string) *IPGeoCacheUpdate {
+	if v != nil {
+		_u.SetAsn(*v)
+	}
+	return _u
+}
+
+// ClearAsn clears the value of the "asn" field.
+func (_u *IPGeoCacheUpdate) ClearAsn() *IPGeoCacheUpdate {
+	_u.mutation.ClearAsn()
+	return _u
+}
+
 // SetCachedAt sets the "cached_at" field.
 func (_u *IPGeoCacheUpdate) SetCachedAt(v time.Time) *IPGeoCacheUpdate {
 	_u.mutation.SetCachedAt(v)
@@ -259,6 +279,11 @@ func (_u *IPGeoCacheUpdate) check() error {
 			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "IPGeoCache.city": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Asn(); ok {
+		if err := ipgeocache.AsnValidator(v); err != nil {
+			return &ValidationError{Name: "asn", err: fmt.Errorf(`ent: validator failed for field "IPGeoCache.asn": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -322,6 +347,12 @@ func (_u *IPGeoCacheUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.LongitudeCleared() {
 		_spec.ClearField(ipgeocache.FieldLongitude, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.Asn(); ok {
+		_spec.SetField(ipgeocache.FieldAsn, field.TypeString, value)
+	}
+	if _u.mutation.AsnCleared() {
+		_spec.ClearField(ipgeocache.FieldAsn, field.TypeString)
+	}
 	if value, ok := _u.mutation.CachedAt(); ok {
 		_spec.SetField(ipgeocache.FieldCachedAt, field.TypeTime, value)
 	}
@@ -499,6 +530,26 @@ func (_u *IPGeoCacheUpdateOne) ClearLongitude() *IPGeoCacheUpdateOne {
 	return _u
 }
 
+// SetAsn sets the "asn" field.
+func (_u *IPGeoCacheUpdateOne) SetAsn(v string) *IPGeoCacheUpdateOne {
+	_u.mutation.SetAsn(v)
+	return _u
+}
+
+// SetNillableAsn sets the "asn" field if the given value is not nil.
+func (_u *IPGeoCacheUpdateOne) SetNillableAsn(v *string) *IPGeoCacheUpdateOne {
+	if v != nil {
+		_u.SetAsn(*v)
+	}
+	return _u
+}
+
+// ClearAsn clears the value of the "asn" field.
+func (_u *IPGeoCacheUpdateOne) ClearAsn() *IPGeoCacheUpdateOne {
+	_u.mutation.ClearAsn()
+	return _u
+}
+
 // SetCachedAt sets the "cached_at" field.
 func (_u *IPGeoCacheUpdateOne) SetCachedAt(v time.Time) *IPGeoCacheUpdateOne {
 	_u.mutation.SetCachedAt(v)
@@ -589,6 +640,11 @@ func (_u *IPGeoCacheUpdateOne) check() error {
 			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "IPGeoCache.city": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Asn(); ok {
+		if err := ipgeocache.AsnValidator(v); err != nil {
+			return &ValidationError{Name: "asn", err: fmt.Errorf(`ent: validator failed for field "IPGeoCache.asn": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -669,6 +725,12 @@ func (_u *IPGeoCacheUpdateOne) sqlSave(ctx context.Context) (_node *IPGeoCache,
 	if _u.mutation.LongitudeCleared() {
 		_spec.ClearField(ipgeocache.FieldLongitude, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.Asn(); ok {
+		_spec.SetField(ipgeocache.FieldAsn, field.TypeString, value)
+	}
+	if _u.mutation.AsnCleared() {
+		_spec.ClearField(ipgeocache.FieldAsn, field.TypeString)
+	}
 	if value, ok := _u.mutation.CachedAt(); ok {
 		_spec.SetField(ipgeocache.FieldCachedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package iprule
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Cidr applies equality check predicate on the "cidr" field. It's identical to CidrEQ.
+func Cidr(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCidr, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldAction, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldReason, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldSource, v))
+}
+
+// CreatedByAdminID applies equality check predicate on the "created_by_admin_id" field. It's identical to CreatedByAdminIDEQ.
+func CreatedByAdminID(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCreatedByAdminID, v))
+}
+
+// CreatedByEmail applies equality check predicate on the "created_by_email" field. It's identical to CreatedByEmailEQ.
+func CreatedByEmail(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCreatedByEmail, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CidrEQ applies the EQ predicate on the "cidr" field.
+func CidrEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCidr, v))
+}
+
+// CidrNEQ applies the NEQ predicate on the "cidr" field.
+func CidrNEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldCidr, v))
+}
+
+// CidrIn applies the In predicate on the "cidr" field.
+func CidrIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldCidr, vs...))
+}
+
+// CidrNotIn applies the NotIn predicate on the "cidr" field.
+func CidrNotIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldCidr, vs...))
+}
+
+// CidrGT applies the GT predicate on the "cidr" field.
+func CidrGT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldCidr, v))
+}
+
+// CidrGTE applies the GTE predicate on the "cidr" field.
+func CidrGTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldCidr, v))
+}
+
+// CidrLT applies the LT predicate on the "cidr" field.
+func CidrLT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldCidr, v))
+}
+
+// CidrLTE applies the LTE predicate on the "cidr" field.
+func CidrLTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldCidr, v))
+}
+
+// CidrContains applies the Contains predicate on the "cidr" field.
+func CidrContains(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContains(FieldCidr, v))
+}
+
+// CidrHasPrefix applies the HasPrefix predicate on the "cidr" field.
+func CidrHasPrefix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasPrefix(FieldCidr, v))
+}
+
+// CidrHasSuffix applies the HasSuffix predicate on the "cidr" field.
+func CidrHasSuffix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasSuffix(FieldCidr, v))
+}
+
+// CidrEqualFold applies the EqualFold predicate on the "cidr" field.
+func CidrEqualFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEqualFold(FieldCidr, v))
+}
+
+// CidrContainsFold applies the ContainsFold predicate on the "cidr" field.
+func CidrContainsFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContainsFold(FieldCidr, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContainsFold(FieldReason, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContainsFold(FieldSource, v))
+}
+
+// CreatedByAdminIDEQ applies the EQ predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDEQ(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDNEQ applies the NEQ predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDNEQ(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDIn applies the In predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDIn(vs ...int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldCreatedByAdminID, vs...))
+}
+
+// CreatedByAdminIDNotIn applies the NotIn predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDNotIn(vs ...int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldCreatedByAdminID, vs...))
+}
+
+// CreatedByAdminIDGT applies the GT predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDGT(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDGTE applies the GTE predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDGTE(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDLT applies the LT predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDLT(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDLTE applies the LTE predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDLTE(v int) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDIsNil applies the IsNil predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDIsNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldIsNull(FieldCreatedByAdminID))
+}
+
+// CreatedByAdminIDNotNil applies the NotNil predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDNotNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotNull(FieldCreatedByAdminID))
+}
+
+// CreatedByEmailEQ applies the EQ predicate on the "created_by_email" field.
+func CreatedByEmailEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailNEQ applies the NEQ predicate on the "created_by_email" field.
+func CreatedByEmailNEQ(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailIn applies the In predicate on the "created_by_email" field.
+func CreatedByEmailIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldCreatedByEmail, vs...))
+}
+
+// CreatedByEmailNotIn applies the NotIn predicate on the "created_by_email" field.
+func CreatedByEmailNotIn(vs ...string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldCreatedByEmail, vs...))
+}
+
+// CreatedByEmailGT applies the GT predicate on the "created_by_email" field.
+func CreatedByEmailGT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailGTE applies the GTE predicate on the "created_by_email" field.
+func CreatedByEmailGTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailLT applies the LT predicate on the "created_by_email" field.
+func CreatedByEmailLT(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailLTE applies the LTE predicate on the "created_by_email" field.
+func CreatedByEmailLTE(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailContains applies the Contains predicate on the "created_by_email" field.
+func CreatedByEmailContains(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContains(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailHasPrefix applies the HasPrefix predicate on the "created_by_email" field.
+func CreatedByEmailHasPrefix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasPrefix(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailHasSuffix applies the HasSuffix predicate on the "created_by_email" field.
+func CreatedByEmailHasSuffix(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldHasSuffix(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailIsNil applies the IsNil predicate on the "created_by_email" field.
+func CreatedByEmailIsNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldIsNull(FieldCreatedByEmail))
+}
+
+// CreatedByEmailNotNil applies the NotNil predicate on the "created_by_email" field.
+func CreatedByEmailNotNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotNull(FieldCreatedByEmail))
+}
+
+// CreatedByEmailEqualFold applies the EqualFold predicate on the "created_by_email" field.
+func CreatedByEmailEqualFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEqualFold(FieldCreatedByEmail, v))
+}
+
+// CreatedByEmailContainsFold applies the ContainsFold predicate on the "created_by_email" field.
+func CreatedByEmailContainsFold(v string) predicate.IPRule {
+	return predicate.IPRule(sql.FieldContainsFold(FieldCreatedByEmail, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.IPRule {
+	return predicate.IPRule(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.IPRule {
+	return predicate.IPRule(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IPRule) predicate.IPRule {
+	return predicate.IPRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IPRule) predicate.IPRule {
+	return predicate.IPRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IPRule) predicate.IPRule {
+	return predicate.IPRule(sql.NotPredicates(p))
+}
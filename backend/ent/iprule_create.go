@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/iprule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IPRuleCreate is the builder for creating a IPRule entity.
+type IPRuleCreate struct {
+	config
+	mutation *IPRuleMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *IPRuleCreate) SetCreatedAt(v time.Time) *IPRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableCreatedAt(v *time.Time) *IPRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *IPRuleCreate) SetUpdatedAt(v time.Time) *IPRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableUpdatedAt(v *time.Time) *IPRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *IPRuleCreate) SetDeletedAt(v time.Time) *IPRuleCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableDeletedAt(v *time.Time) *IPRuleCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCidr sets the "cidr" field.
+func (_c *IPRuleCreate) SetCidr(v string) *IPRuleCreate {
+	_c.mutation.SetCidr(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *IPRuleCreate) SetAction(v string) *IPRuleCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *IPRuleCreate) SetReason(v string) *IPRuleCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *IPRuleCreate) SetSource(v string) *IPRuleCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableSource(v *string) *IPRuleCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetCreatedByAdminID sets the "created_by_admin_id" field.
+func (_c *IPRuleCreate) SetCreatedByAdminID(v int) *IPRuleCreate {
+	_c.mutation.SetCreatedByAdminID(v)
+	return _c
+}
+
+// SetNillableCreatedByAdminID sets the "created_by_admin_id" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableCreatedByAdminID(v *int) *IPRuleCreate {
+	if v != nil {
+		_c.SetCreatedByAdminID(*v)
+	}
+	return _c
+}
+
+// SetCreatedByEmail sets the "created_by_email" field.
+func (_c *IPRuleCreate) SetCreatedByEmail(v string) *IPRuleCreate {
+	_c.mutation.SetCreatedByEmail(v)
+	return _c
+}
+
+// SetNillableCreatedByEmail sets the "created_by_email" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableCreatedByEmail(v *string) *IPRuleCreate {
+	if v != nil {
+		_c.SetCreatedByEmail(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *IPRuleCreate) SetExpiresAt(v time.Time) *IPRuleCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *IPRuleCreate) SetNillableExpiresAt(v *time.Time) *IPRuleCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the IPRuleMutation object of the builder.
+func (_c *IPRuleCreate) Mutation() *IPRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the IPRule in the database.
+func (_c *IPRuleCreate) Save(ctx context.Context) (*IPRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *IPRuleCreate) SaveX(ctx context.Context) *IPRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IPRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IPRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *IPRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := iprule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := iprule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := iprule.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *IPRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IPRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IPRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Cidr(); !ok {
+		return &ValidationError{Name: "cidr", err: errors.New(`ent: missing required field "IPRule.cidr"`)}
+	}
+	if v, ok := _c.mutation.Cidr(); ok {
+		if err := iprule.CidrValidator(v); err != nil {
+			return &ValidationError{Name: "cidr", err: fmt.Errorf(`ent: validator failed for field "IPRule.cidr": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "IPRule.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := iprule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "IPRule.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "IPRule.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := iprule.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "IPRule.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "IPRule.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := iprule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "IPRule.source": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CreatedByEmail(); ok {
+		if err := iprule.CreatedByEmailValidator(v); err != nil {
+			return &ValidationError{Name: "created_by_email", err: fmt.Errorf(`ent: validator failed for field "IPRule.created_by_email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *IPRuleCreate) sqlSave(ctx context.Context) (*IPRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *IPRuleCreate) createSpec() (*IPRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IPRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(iprule.Table, sqlgraph.NewFieldSpec(iprule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(iprule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(iprule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(iprule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Cidr(); ok {
+		_spec.SetField(iprule.FieldCidr, field.TypeString, value)
+		_node.Cidr = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(iprule.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(iprule.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(iprule.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.CreatedByAdminID(); ok {
+		_spec.SetField(iprule.FieldCreatedByAdminID, field.TypeInt, value)
+		_node.CreatedByAdminID = &value
+	}
+	if value, ok := _c.mutation.CreatedByEmail(); ok {
+		_spec.SetField(iprule.FieldCreatedByEmail, field.TypeString, value)
+		_node.CreatedByEmail = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(iprule.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// IPRuleCreateBulk is the builder for creating many IPRule entities in bulk.
+type IPRuleCreateBulk struct {
+	config
+	err      error
+	builders []*IPRuleCreate
+}
+
+// Save creates the IPRule entities in the database.
+func (_c *IPRuleCreateBulk) Save(ctx context.Context) ([]*IPRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*IPRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IPRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *IPRuleCreateBulk) SaveX(ctx context.Context) []*IPRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IPRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IPRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
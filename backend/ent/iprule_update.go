@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/iprule"
+	"backend-gin/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IPRuleUpdate is the builder for updating IPRule entities.
+type IPRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IPRuleMutation
+}
+
+// Where appends a list predicates to the IPRuleUpdate builder.
+func (_u *IPRuleUpdate) Where(ps ...predicate.IPRule) *IPRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IPRuleUpdate) SetUpdatedAt(v time.Time) *IPRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *IPRuleUpdate) SetDeletedAt(v time.Time) *IPRuleUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableDeletedAt(v *time.Time) *IPRuleUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *IPRuleUpdate) ClearDeletedAt() *IPRuleUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCidr sets the "cidr" field.
+func (_u *IPRuleUpdate) SetCidr(v string) *IPRuleUpdate {
+	_u.mutation.SetCidr(v)
+	return _u
+}
+
+// SetNillableCidr sets the "cidr" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableCidr(v *string) *IPRuleUpdate {
+	if v != nil {
+		_u.SetCidr(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *IPRuleUpdate) SetAction(v string) *IPRuleUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableAction(v *string) *IPRuleUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *IPRuleUpdate) SetReason(v string) *IPRuleUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableReason(v *string) *IPRuleUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *IPRuleUpdate) SetSource(v string) *IPRuleUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableSource(v *string) *IPRuleUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetCreatedByAdminID sets the "created_by_admin_id" field.
+func (_u *IPRuleUpdate) SetCreatedByAdminID(v int) *IPRuleUpdate {
+	_u.mutation.ResetCreatedByAdminID()
+	_u.mutation.SetCreatedByAdminID(v)
+	return _u
+}
+
+// SetNillableCreatedByAdminID sets the "created_by_admin_id" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableCreatedByAdminID(v *int) *IPRuleUpdate {
+	if v != nil {
+		_u.SetCreatedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddCreatedByAdminID adds value to the "created_by_admin_id" field.
+func (_u *IPRuleUpdate) AddCreatedByAdminID(v int) *IPRuleUpdate {
+	_u.mutation.AddCreatedByAdminID(v)
+	return _u
+}
+
+// ClearCreatedByAdminID clears the value of the "created_by_admin_id" field.
+func (_u *IPRuleUpdate) ClearCreatedByAdminID() *IPRuleUpdate {
+	_u.mutation.ClearCreatedByAdminID()
+	return _u
+}
+
+// SetCreatedByEmail sets the "created_by_email" field.
+func (_u *IPRuleUpdate) SetCreatedByEmail(v string) *IPRuleUpdate {
+	_u.mutation.SetCreatedByEmail(v)
+	return _u
+}
+
+// SetNillableCreatedByEmail sets the "created_by_email" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableCreatedByEmail(v *string) *IPRuleUpdate {
+	if v != nil {
+		_u.SetCreatedByEmail(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByEmail clears the value of the "created_by_email" field.
+func (_u *IPRuleUpdate) ClearCreatedByEmail() *IPRuleUpdate {
+	_u.mutation.ClearCreatedByEmail()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *IPRuleUpdate) SetExpiresAt(v time.Time) *IPRuleUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *IPRuleUpdate) SetNillableExpiresAt(v *time.Time) *IPRuleUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *IPRuleUpdate) ClearExpiresAt() *IPRuleUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the IPRuleMutation object of the builder.
+func (_u *IPRuleUpdate) Mutation() *IPRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *IPRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IPRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *IPRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IPRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IPRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := iprule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IPRuleUpdate) check() error {
+	if v, ok := _u.mutation.Cidr(); ok {
+		if err := iprule.CidrValidator(v); err != nil {
+			return &ValidationError{Name: "cidr", err: fmt.Errorf(`ent: validator failed for field "IPRule.cidr": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := iprule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "IPRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := iprule.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "IPRule.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := iprule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "IPRule.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedByEmail(); ok {
+		if err := iprule.CreatedByEmailValidator(v); err != nil {
+			return &ValidationError{Name: "created_by_email", err: fmt.Errorf(`ent: validator failed for field "IPRule.created_by_email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *IPRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(iprule.Table, iprule.Columns, sqlgraph.NewFieldSpec(iprule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(iprule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(iprule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(iprule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cidr(); ok {
+		_spec.SetField(iprule.FieldCidr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(iprule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(iprule.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(iprule.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedByAdminID(); ok {
+		_spec.SetField(iprule.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedByAdminID(); ok {
+		_spec.AddField(iprule.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByAdminIDCleared() {
+		_spec.ClearField(iprule.FieldCreatedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedByEmail(); ok {
+		_spec.SetField(iprule.FieldCreatedByEmail, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByEmailCleared() {
+		_spec.ClearField(iprule.FieldCreatedByEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(iprule.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(iprule.FieldExpiresAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{iprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// IPRuleUpdateOne is the builder for updating a single IPRule entity.
+type IPRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IPRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IPRuleUpdateOne) SetUpdatedAt(v time.Time) *IPRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *IPRuleUpdateOne) SetDeletedAt(v time.Time) *IPRuleUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableDeletedAt(v *time.Time) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *IPRuleUpdateOne) ClearDeletedAt() *IPRuleUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCidr sets the "cidr" field.
+func (_u *IPRuleUpdateOne) SetCidr(v string) *IPRuleUpdateOne {
+	_u.mutation.SetCidr(v)
+	return _u
+}
+
+// SetNillableCidr sets the "cidr" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableCidr(v *string) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetCidr(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *IPRuleUpdateOne) SetAction(v string) *IPRuleUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableAction(v *string) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *IPRuleUpdateOne) SetReason(v string) *IPRuleUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableReason(v *string) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *IPRuleUpdateOne) SetSource(v string) *IPRuleUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableSource(v *string) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetCreatedByAdminID sets the "created_by_admin_id" field.
+func (_u *IPRuleUpdateOne) SetCreatedByAdminID(v int) *IPRuleUpdateOne {
+	_u.mutation.ResetCreatedByAdminID()
+	_u.mutation.SetCreatedByAdminID(v)
+	return _u
+}
+
+// SetNillableCreatedByAdminID sets the "created_by_admin_id" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableCreatedByAdminID(v *int) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddCreatedByAdminID adds value to the "created_by_admin_id" field.
+func (_u *IPRuleUpdateOne) AddCreatedByAdminID(v int) *IPRuleUpdateOne {
+	_u.mutation.AddCreatedByAdminID(v)
+	return _u
+}
+
+// ClearCreatedByAdminID clears the value of the "created_by_admin_id" field.
+func (_u *IPRuleUpdateOne) ClearCreatedByAdminID() *IPRuleUpdateOne {
+	_u.mutation.ClearCreatedByAdminID()
+	return _u
+}
+
+// SetCreatedByEmail sets the "created_by_email" field.
+func (_u *IPRuleUpdateOne) SetCreatedByEmail(v string) *IPRuleUpdateOne {
+	_u.mutation.SetCreatedByEmail(v)
+	return _u
+}
+
+// SetNillableCreatedByEmail sets the "created_by_email" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableCreatedByEmail(v *string) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedByEmail(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByEmail clears the value of the "created_by_email" field.
+func (_u *IPRuleUpdateOne) ClearCreatedByEmail() *IPRuleUpdateOne {
+	_u.mutation.ClearCreatedByEmail()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *IPRuleUpdateOne) SetExpiresAt(v time.Time) *IPRuleUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *IPRuleUpdateOne) SetNillableExpiresAt(v *time.Time) *IPRuleUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *IPRuleUpdateOne) ClearExpiresAt() *IPRuleUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the IPRuleMutation object of the builder.
+func (_u *IPRuleUpdateOne) Mutation() *IPRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the IPRuleUpdate builder.
+func (_u *IPRuleUpdateOne) Where(ps ...predicate.IPRule) *IPRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *IPRuleUpdateOne) Select(field string, fields ...string) *IPRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated IPRule entity.
+func (_u *IPRuleUpdateOne) Save(ctx context.Context) (*IPRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IPRuleUpdateOne) SaveX(ctx context.Context) *IPRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *IPRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IPRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IPRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := iprule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IPRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Cidr(); ok {
+		if err := iprule.CidrValidator(v); err != nil {
+			return &ValidationError{Name: "cidr", err: fmt.Errorf(`ent: validator failed for field "IPRule.cidr": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := iprule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "IPRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := iprule.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "IPRule.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := iprule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "IPRule.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreatedByEmail(); ok {
+		if err := iprule.CreatedByEmailValidator(v); err != nil {
+			return &ValidationError{Name: "created_by_email", err: fmt.Errorf(`ent: validator failed for field "IPRule.created_by_email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *IPRuleUpdateOne) sqlSave(ctx context.Context) (_node *IPRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(iprule.Table, iprule.Columns, sqlgraph.NewFieldSpec(iprule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IPRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, iprule.FieldID)
+		for _, f := range fields {
+			if !iprule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != iprule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(iprule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(iprule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(iprule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Cidr(); ok {
+		_spec.SetField(iprule.FieldCidr, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(iprule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(iprule.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(iprule.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedByAdminID(); ok {
+		_spec.SetField(iprule.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedByAdminID(); ok {
+		_spec.AddField(iprule.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByAdminIDCleared() {
+		_spec.ClearField(iprule.FieldCreatedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedByEmail(); ok {
+		_spec.SetField(iprule.FieldCreatedByEmail, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByEmailCleared() {
+		_spec.ClearField(iprule.FieldCreatedByEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(iprule.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(iprule.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &IPRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{iprule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -18,6 +18,13 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "failed_login_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 	}
 	// AdminsTable holds the schema information for the "admins" table.
 	AdminsTable = &schema.Table{
@@ -32,6 +39,178 @@ var (
 			},
 		},
 	}
+	// AdminAuditLogsColumns holds the columns for the "admin_audit_logs" table.
+	AdminAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "admin_id", Type: field.TypeInt},
+		{Name: "admin_email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "action", Type: field.TypeString, Size: 200},
+		{Name: "method", Type: field.TypeString, Size: 10},
+		{Name: "path", Type: field.TypeString, Size: 512},
+		{Name: "target_type", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "target_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "status_code", Type: field.TypeInt},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "seq", Type: field.TypeInt},
+		{Name: "prev_hash", Type: field.TypeString, Size: 64},
+		{Name: "hash", Type: field.TypeString, Size: 64},
+	}
+	// AdminAuditLogsTable holds the schema information for the "admin_audit_logs" table.
+	AdminAuditLogsTable = &schema.Table{
+		Name:       "admin_audit_logs",
+		Columns:    AdminAuditLogsColumns,
+		PrimaryKey: []*schema.Column{AdminAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminauditlog_admin_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[2], AdminAuditLogsColumns[1]},
+			},
+			{
+				Name:    "adminauditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[4]},
+			},
+			{
+				Name:    "adminauditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[7], AdminAuditLogsColumns[8]},
+			},
+			{
+				Name:    "adminauditlog_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[10]},
+			},
+			{
+				Name:    "adminauditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminAuditLogsColumns[1]},
+			},
+			{
+				Name:    "adminauditlog_seq",
+				Unique:  true,
+				Columns: []*schema.Column{AdminAuditLogsColumns[16]},
+			},
+		},
+	}
+	// AdminPasskeysColumns holds the columns for the "admin_passkeys" table.
+	AdminPasskeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "name", Type: field.TypeString, Default: "Passkey"},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "admin_id", Type: field.TypeInt},
+	}
+	// AdminPasskeysTable holds the schema information for the "admin_passkeys" table.
+	AdminPasskeysTable = &schema.Table{
+		Name:       "admin_passkeys",
+		Columns:    AdminPasskeysColumns,
+		PrimaryKey: []*schema.Column{AdminPasskeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "admin_passkeys_admins_passkeys",
+				Columns:    []*schema.Column{AdminPasskeysColumns[14]},
+				RefColumns: []*schema.Column{AdminsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminpasskey_admin_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdminPasskeysColumns[14]},
+			},
+			{
+				Name:    "adminpasskey_credential_id",
+				Unique:  true,
+				Columns: []*schema.Column{AdminPasskeysColumns[4]},
+			},
+		},
+	}
+	// AdminRolesColumns holds the columns for the "admin_roles" table.
+	AdminRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255, Default: ""},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "is_system", Type: field.TypeBool, Default: false},
+	}
+	// AdminRolesTable holds the schema information for the "admin_roles" table.
+	AdminRolesTable = &schema.Table{
+		Name:       "admin_roles",
+		Columns:    AdminRolesColumns,
+		PrimaryKey: []*schema.Column{AdminRolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminrole_name",
+				Unique:  true,
+				Columns: []*schema.Column{AdminRolesColumns[4]},
+			},
+		},
+	}
+	// AdminSessionsColumns holds the columns for the "admin_sessions" table.
+	AdminSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "session_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "mfa_verified", Type: field.TypeBool, Default: false},
+		{Name: "mfa_method", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_activity_at", Type: field.TypeTime},
+		{Name: "sudo_until", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoke_reason", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "admin_id", Type: field.TypeInt},
+	}
+	// AdminSessionsTable holds the schema information for the "admin_sessions" table.
+	AdminSessionsTable = &schema.Table{
+		Name:       "admin_sessions",
+		Columns:    AdminSessionsColumns,
+		PrimaryKey: []*schema.Column{AdminSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "admin_sessions_admins_sessions",
+				Columns:    []*schema.Column{AdminSessionsColumns[14]},
+				RefColumns: []*schema.Column{AdminsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "adminsession_session_id",
+				Unique:  true,
+				Columns: []*schema.Column{AdminSessionsColumns[4]},
+			},
+			{
+				Name:    "adminsession_admin_id_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{AdminSessionsColumns[14], AdminSessionsColumns[12]},
+			},
+		},
+	}
 	// ArtifactSubmissionsColumns holds the columns for the "artifact_submissions" table.
 	ArtifactSubmissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -130,6 +309,110 @@ var (
 			},
 		},
 	}
+	// CaseClarificationsColumns holds the columns for the "case_clarifications" table.
+	CaseClarificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "consultation_request_id", Type: field.TypeInt},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "workflow_cycle", Type: field.TypeInt, Default: 1},
+		{Name: "kind", Type: field.TypeString, Size: 16},
+		{Name: "body", Type: field.TypeString, Size: 4000},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "pending"},
+		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 4000, Default: ""},
+		{Name: "answered_by_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "answered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "shared", Type: field.TypeBool, Default: false},
+	}
+	// CaseClarificationsTable holds the schema information for the "case_clarifications" table.
+	CaseClarificationsTable = &schema.Table{
+		Name:       "case_clarifications",
+		Columns:    CaseClarificationsColumns,
+		PrimaryKey: []*schema.Column{CaseClarificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "caseclarification_validation_case_id_workflow_cycle",
+				Unique:  false,
+				Columns: []*schema.Column{CaseClarificationsColumns[4], CaseClarificationsColumns[7]},
+			},
+			{
+				Name:    "caseclarification_consultation_request_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{CaseClarificationsColumns[5], CaseClarificationsColumns[10]},
+			},
+			{
+				Name:    "caseclarification_validator_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CaseClarificationsColumns[6]},
+			},
+		},
+	}
+	// CaseConversationsColumns holds the columns for the "case_conversations" table.
+	CaseConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "owner_user_id", Type: field.TypeInt},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
+	}
+	// CaseConversationsTable holds the schema information for the "case_conversations" table.
+	CaseConversationsTable = &schema.Table{
+		Name:       "case_conversations",
+		Columns:    CaseConversationsColumns,
+		PrimaryKey: []*schema.Column{CaseConversationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "caseconversation_validation_case_id_validator_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{CaseConversationsColumns[4], CaseConversationsColumns[6]},
+			},
+			{
+				Name:    "caseconversation_validator_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CaseConversationsColumns[6]},
+			},
+		},
+	}
+	// CaseMessagesColumns holds the columns for the "case_messages" table.
+	CaseMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sender_user_id", Type: field.TypeInt},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 4000, Default: ""},
+		{Name: "attachment_document_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "conversation_id", Type: field.TypeInt},
+	}
+	// CaseMessagesTable holds the schema information for the "case_messages" table.
+	CaseMessagesTable = &schema.Table{
+		Name:       "case_messages",
+		Columns:    CaseMessagesColumns,
+		PrimaryKey: []*schema.Column{CaseMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "case_messages_case_conversations_messages",
+				Columns:    []*schema.Column{CaseMessagesColumns[8]},
+				RefColumns: []*schema.Column{CaseConversationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "casemessage_conversation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CaseMessagesColumns[8], CaseMessagesColumns[1]},
+			},
+		},
+	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -175,6 +458,45 @@ var (
 			},
 		},
 	}
+	// ConsultationInvitationsColumns holds the columns for the "consultation_invitations" table.
+	ConsultationInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "owner_user_id", Type: field.TypeInt},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "workflow_cycle", Type: field.TypeInt, Default: 1},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "pending"},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 1000, Default: ""},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "consultation_request_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ConsultationInvitationsTable holds the schema information for the "consultation_invitations" table.
+	ConsultationInvitationsTable = &schema.Table{
+		Name:       "consultation_invitations",
+		Columns:    ConsultationInvitationsColumns,
+		PrimaryKey: []*schema.Column{ConsultationInvitationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consultationinvitation_validation_case_id_workflow_cycle",
+				Unique:  false,
+				Columns: []*schema.Column{ConsultationInvitationsColumns[4], ConsultationInvitationsColumns[7]},
+			},
+			{
+				Name:    "consultationinvitation_validator_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ConsultationInvitationsColumns[6], ConsultationInvitationsColumns[8]},
+			},
+			{
+				Name:    "consultationinvitation_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConsultationInvitationsColumns[8], ConsultationInvitationsColumns[10]},
+			},
+		},
+	}
 	// ConsultationRequestsColumns holds the columns for the "consultation_requests" table.
 	ConsultationRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -189,6 +511,8 @@ var (
 		{Name: "owner_response_due_at", Type: field.TypeTime, Nullable: true},
 		{Name: "reminder_count", Type: field.TypeInt, Default: 0},
 		{Name: "auto_closed_reason", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "sla_policy_snapshot", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_response_started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "validator_user_id", Type: field.TypeInt},
 		{Name: "validation_case_id", Type: field.TypeInt},
 	}
@@ -200,13 +524,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "consultation_requests_users_consultation_requests",
-				Columns:    []*schema.Column{ConsultationRequestsColumns[12]},
+				Columns:    []*schema.Column{ConsultationRequestsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "consultation_requests_validation_cases_consultation_requests",
-				Columns:    []*schema.Column{ConsultationRequestsColumns[13]},
+				Columns:    []*schema.Column{ConsultationRequestsColumns[15]},
 				RefColumns: []*schema.Column{ValidationCasesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -215,22 +539,22 @@ var (
 			{
 				Name:    "consultationrequest_validation_case_id_validator_user_id_workflow_cycle",
 				Unique:  true,
-				Columns: []*schema.Column{ConsultationRequestsColumns[13], ConsultationRequestsColumns[12], ConsultationRequestsColumns[5]},
+				Columns: []*schema.Column{ConsultationRequestsColumns[15], ConsultationRequestsColumns[14], ConsultationRequestsColumns[5]},
 			},
 			{
 				Name:    "consultationrequest_validation_case_id",
 				Unique:  false,
-				Columns: []*schema.Column{ConsultationRequestsColumns[13]},
+				Columns: []*schema.Column{ConsultationRequestsColumns[15]},
 			},
 			{
 				Name:    "consultationrequest_validator_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{ConsultationRequestsColumns[12]},
+				Columns: []*schema.Column{ConsultationRequestsColumns[14]},
 			},
 			{
 				Name:    "consultationrequest_validation_case_id_workflow_cycle",
 				Unique:  false,
-				Columns: []*schema.Column{ConsultationRequestsColumns[13], ConsultationRequestsColumns[5]},
+				Columns: []*schema.Column{ConsultationRequestsColumns[15], ConsultationRequestsColumns[5]},
 			},
 			{
 				Name:    "consultationrequest_status",
@@ -245,12 +569,12 @@ var (
 			{
 				Name:    "consultationrequest_validator_user_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ConsultationRequestsColumns[12], ConsultationRequestsColumns[4]},
+				Columns: []*schema.Column{ConsultationRequestsColumns[14], ConsultationRequestsColumns[4]},
 			},
 			{
 				Name:    "consultationrequest_validator_user_id_status_workflow_cycle",
 				Unique:  false,
-				Columns: []*schema.Column{ConsultationRequestsColumns[12], ConsultationRequestsColumns[4], ConsultationRequestsColumns[5]},
+				Columns: []*schema.Column{ConsultationRequestsColumns[14], ConsultationRequestsColumns[4], ConsultationRequestsColumns[5]},
 			},
 		},
 	}
@@ -335,6 +659,12 @@ var (
 		{Name: "fingerprint_hash", Type: field.TypeString, Size: 64},
 		{Name: "first_seen_at", Type: field.TypeTime},
 		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "last_ip", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "last_user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "trusted", Type: field.TypeBool, Default: false},
+		{Name: "trusted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "removed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// DeviceUserMappingsTable holds the schema information for the "device_user_mappings" table.
@@ -345,7 +675,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "device_user_mappings_users_device_user_mappings",
-				Columns:    []*schema.Column{DeviceUserMappingsColumns[7]},
+				Columns:    []*schema.Column{DeviceUserMappingsColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -359,7 +689,7 @@ var (
 			{
 				Name:    "deviceusermapping_fingerprint_hash_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{DeviceUserMappingsColumns[4], DeviceUserMappingsColumns[7]},
+				Columns: []*schema.Column{DeviceUserMappingsColumns[4], DeviceUserMappingsColumns[13]},
 			},
 		},
 	}
@@ -372,6 +702,8 @@ var (
 		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 128},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "purpose", Type: field.TypeString, Size: 32, Default: "verify_email"},
+		{Name: "target_email", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// EmailVerificationTokensTable holds the schema information for the "email_verification_tokens" table.
@@ -382,7 +714,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "email_verification_tokens_users_email_verification_tokens",
-				Columns:    []*schema.Column{EmailVerificationTokensColumns[7]},
+				Columns:    []*schema.Column{EmailVerificationTokensColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -393,6 +725,11 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{EmailVerificationTokensColumns[4]},
 			},
+			{
+				Name:    "emailverificationtoken_user_id_purpose",
+				Unique:  false,
+				Columns: []*schema.Column{EmailVerificationTokensColumns[9], EmailVerificationTokensColumns[7]},
+			},
 		},
 	}
 	// EndorsementsColumns holds the columns for the "endorsements" table.
@@ -448,6 +785,12 @@ var (
 		{Name: "status", Type: field.TypeString, Size: 32, Default: "submitted"},
 		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "rejected_at", Type: field.TypeTime, Nullable: true},
+		{Name: "current_revision", Type: field.TypeInt, Default: 1},
+		{Name: "last_proposed_by", Type: field.TypeString, Size: 16, Default: "validator"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "withdrawn_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "milestone_plan", Type: field.TypeJSON, Nullable: true},
 		{Name: "validator_user_id", Type: field.TypeInt},
 		{Name: "validation_case_id", Type: field.TypeInt},
 	}
@@ -459,13 +802,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "final_offers_users_final_offers",
-				Columns:    []*schema.Column{FinalOffersColumns[12]},
+				Columns:    []*schema.Column{FinalOffersColumns[18]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "final_offers_validation_cases_final_offers",
-				Columns:    []*schema.Column{FinalOffersColumns[13]},
+				Columns:    []*schema.Column{FinalOffersColumns[19]},
 				RefColumns: []*schema.Column{ValidationCasesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -474,28 +817,183 @@ var (
 			{
 				Name:    "finaloffer_validation_case_id",
 				Unique:  false,
-				Columns: []*schema.Column{FinalOffersColumns[13]},
+				Columns: []*schema.Column{FinalOffersColumns[19]},
 			},
 			{
 				Name:    "finaloffer_validator_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{FinalOffersColumns[12]},
+				Columns: []*schema.Column{FinalOffersColumns[18]},
 			},
 			{
 				Name:    "finaloffer_validation_case_id_validator_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{FinalOffersColumns[13], FinalOffersColumns[12]},
+				Columns: []*schema.Column{FinalOffersColumns[19], FinalOffersColumns[18]},
 			},
 			{
 				Name:    "finaloffer_validation_case_id_validator_user_id_workflow_cycle",
 				Unique:  true,
-				Columns: []*schema.Column{FinalOffersColumns[13], FinalOffersColumns[12], FinalOffersColumns[5]},
+				Columns: []*schema.Column{FinalOffersColumns[19], FinalOffersColumns[18], FinalOffersColumns[5]},
 			},
 			{
 				Name:    "finaloffer_status",
 				Unique:  false,
 				Columns: []*schema.Column{FinalOffersColumns[9]},
 			},
+			{
+				Name:    "finaloffer_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{FinalOffersColumns[9], FinalOffersColumns[14]},
+			},
+		},
+	}
+	// FinalOfferMilestonesColumns holds the columns for the "final_offer_milestones" table.
+	FinalOfferMilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "sequence", Type: field.TypeInt},
+		{Name: "deliverable", Type: field.TypeString, Size: 500},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "due_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "pending"},
+		{Name: "escrow_transfer_id", Type: field.TypeString, Unique: true, Nullable: true, Size: 128},
+		{Name: "artifact_document_id", Type: field.TypeString, Nullable: true},
+		{Name: "dispute_id", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "funds_locked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "artifact_submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "disputed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "final_offer_id", Type: field.TypeInt},
+	}
+	// FinalOfferMilestonesTable holds the schema information for the "final_offer_milestones" table.
+	FinalOfferMilestonesTable = &schema.Table{
+		Name:       "final_offer_milestones",
+		Columns:    FinalOfferMilestonesColumns,
+		PrimaryKey: []*schema.Column{FinalOfferMilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "final_offer_milestones_final_offers_milestones",
+				Columns:    []*schema.Column{FinalOfferMilestonesColumns[18]},
+				RefColumns: []*schema.Column{FinalOffersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "finaloffermilestone_final_offer_id_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{FinalOfferMilestonesColumns[18], FinalOfferMilestonesColumns[5]},
+			},
+			{
+				Name:    "finaloffermilestone_validation_case_id",
+				Unique:  false,
+				Columns: []*schema.Column{FinalOfferMilestonesColumns[4]},
+			},
+			{
+				Name:    "finaloffermilestone_status",
+				Unique:  false,
+				Columns: []*schema.Column{FinalOfferMilestonesColumns[9]},
+			},
+		},
+	}
+	// FinalOfferRevisionsColumns holds the columns for the "final_offer_revisions" table.
+	FinalOfferRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "proposed_by_user_id", Type: field.TypeInt},
+		{Name: "proposer_role", Type: field.TypeString, Size: 16},
+		{Name: "action", Type: field.TypeString, Size: 32},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "hold_hours", Type: field.TypeInt},
+		{Name: "terms", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 1000, Default: ""},
+		{Name: "milestone_plan", Type: field.TypeJSON, Nullable: true},
+		{Name: "final_offer_id", Type: field.TypeInt},
+	}
+	// FinalOfferRevisionsTable holds the schema information for the "final_offer_revisions" table.
+	FinalOfferRevisionsTable = &schema.Table{
+		Name:       "final_offer_revisions",
+		Columns:    FinalOfferRevisionsColumns,
+		PrimaryKey: []*schema.Column{FinalOfferRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "final_offer_revisions_final_offers_revisions",
+				Columns:    []*schema.Column{FinalOfferRevisionsColumns[13]},
+				RefColumns: []*schema.Column{FinalOffersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "finalofferrevision_final_offer_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{FinalOfferRevisionsColumns[13], FinalOfferRevisionsColumns[4]},
+			},
+		},
+	}
+	// GuaranteeSlashesColumns holds the columns for the "guarantee_slashes" table.
+	GuaranteeSlashesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "final_offer_id", Type: field.TypeInt},
+		{Name: "milestone_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workflow_cycle", Type: field.TypeInt, Default: 1},
+		{Name: "dispute_id", Type: field.TypeString, Size: 128},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true, Size: 191},
+		{Name: "guarantee_amount", Type: field.TypeInt64},
+		{Name: "percent", Type: field.TypeInt},
+		{Name: "cap_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "pending_appeal"},
+		{Name: "skip_reason", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "appeal_deadline_at", Type: field.TypeTime},
+		{Name: "appeal_reason", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "appealed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "resolved_by_admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "resolution_note", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "executed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "remaining_guarantee_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 500},
+	}
+	// GuaranteeSlashesTable holds the schema information for the "guarantee_slashes" table.
+	GuaranteeSlashesTable = &schema.Table{
+		Name:       "guarantee_slashes",
+		Columns:    GuaranteeSlashesColumns,
+		PrimaryKey: []*schema.Column{GuaranteeSlashesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "guaranteeslash_validation_case_id",
+				Unique:  false,
+				Columns: []*schema.Column{GuaranteeSlashesColumns[4]},
+			},
+			{
+				Name:    "guaranteeslash_validator_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{GuaranteeSlashesColumns[5], GuaranteeSlashesColumns[1]},
+			},
+			{
+				Name:    "guaranteeslash_status_appeal_deadline_at",
+				Unique:  false,
+				Columns: []*schema.Column{GuaranteeSlashesColumns[15], GuaranteeSlashesColumns[17]},
+			},
+			{
+				Name:    "guaranteeslash_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{GuaranteeSlashesColumns[15], GuaranteeSlashesColumns[26]},
+			},
 		},
 	}
 	// IPGeoCacheColumns holds the columns for the "ip_geo_cache" table.
@@ -510,6 +1008,7 @@ var (
 		{Name: "city", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
 		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "asn", Type: field.TypeString, Nullable: true, Size: 128},
 		{Name: "cached_at", Type: field.TypeTime},
 	}
 	// IPGeoCacheTable holds the schema information for the "ip_geo_cache" table.
@@ -531,7 +1030,81 @@ var (
 			{
 				Name:    "ipgeocache_cached_at",
 				Unique:  false,
-				Columns: []*schema.Column{IPGeoCacheColumns[10]},
+				Columns: []*schema.Column{IPGeoCacheColumns[11]},
+			},
+		},
+	}
+	// IPRulesColumns holds the columns for the "ip_rules" table.
+	IPRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cidr", Type: field.TypeString, Size: 64},
+		{Name: "action", Type: field.TypeString, Size: 10},
+		{Name: "reason", Type: field.TypeString, Size: 255},
+		{Name: "source", Type: field.TypeString, Size: 20, Default: "admin"},
+		{Name: "created_by_admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by_email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// IPRulesTable holds the schema information for the "ip_rules" table.
+	IPRulesTable = &schema.Table{
+		Name:       "ip_rules",
+		Columns:    IPRulesColumns,
+		PrimaryKey: []*schema.Column{IPRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "iprule_cidr_action",
+				Unique:  false,
+				Columns: []*schema.Column{IPRulesColumns[4], IPRulesColumns[5]},
+			},
+			{
+				Name:    "iprule_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IPRulesColumns[10]},
+			},
+		},
+	}
+	// ImpersonationSessionsColumns holds the columns for the "impersonation_sessions" table.
+	ImpersonationSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "admin_id", Type: field.TypeInt},
+		{Name: "admin_email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "jti", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_by_admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 512, Default: ""},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "request_count", Type: field.TypeInt, Default: 0},
+	}
+	// ImpersonationSessionsTable holds the schema information for the "impersonation_sessions" table.
+	ImpersonationSessionsTable = &schema.Table{
+		Name:       "impersonation_sessions",
+		Columns:    ImpersonationSessionsColumns,
+		PrimaryKey: []*schema.Column{ImpersonationSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "impersonationsession_admin_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationSessionsColumns[4], ImpersonationSessionsColumns[1]},
+			},
+			{
+				Name:    "impersonationsession_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationSessionsColumns[6], ImpersonationSessionsColumns[1]},
+			},
+			{
+				Name:    "impersonationsession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationSessionsColumns[9]},
 			},
 		},
 	}
@@ -692,6 +1265,82 @@ var (
 			},
 		},
 	}
+	// ReputationEventsColumns holds the columns for the "reputation_events" table.
+	ReputationEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "workflow_cycle", Type: field.TypeInt, Default: 1},
+		{Name: "kind", Type: field.TypeString, Size: 32},
+		{Name: "points", Type: field.TypeInt},
+		{Name: "domain_slugs", Type: field.TypeJSON, Nullable: true},
+		{Name: "dedupe_key", Type: field.TypeString, Size: 191},
+		{Name: "detail_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime},
+	}
+	// ReputationEventsTable holds the schema information for the "reputation_events" table.
+	ReputationEventsTable = &schema.Table{
+		Name:       "reputation_events",
+		Columns:    ReputationEventsColumns,
+		PrimaryKey: []*schema.Column{ReputationEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reputationevent_dedupe_key",
+				Unique:  true,
+				Columns: []*schema.Column{ReputationEventsColumns[10]},
+			},
+			{
+				Name:    "reputationevent_validator_user_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReputationEventsColumns[4], ReputationEventsColumns[12]},
+			},
+			{
+				Name:    "reputationevent_validation_case_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReputationEventsColumns[5]},
+			},
+		},
+	}
+	// SLAPoliciesColumns holds the columns for the "sla_policies" table.
+	SLAPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "sensitivity_level", Type: field.TypeString, Size: 8, Default: ""},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "owner_response_hours", Type: field.TypeInt, Default: 12},
+		{Name: "reminder_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "business_hours_only", Type: field.TypeBool, Default: false},
+		{Name: "business_day_start_hour", Type: field.TypeInt, Default: 9},
+		{Name: "business_day_end_hour", Type: field.TypeInt, Default: 17},
+		{Name: "timezone", Type: field.TypeString, Size: 64, Default: "Asia/Jakarta"},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_by_admin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "updated_by_admin_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SLAPoliciesTable holds the schema information for the "sla_policies" table.
+	SLAPoliciesTable = &schema.Table{
+		Name:       "sla_policies",
+		Columns:    SLAPoliciesColumns,
+		PrimaryKey: []*schema.Column{SLAPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "slapolicy_is_active_sensitivity_level",
+				Unique:  false,
+				Columns: []*schema.Column{SLAPoliciesColumns[13], SLAPoliciesColumns[5]},
+			},
+			{
+				Name:    "slapolicy_category_id",
+				Unique:  false,
+				Columns: []*schema.Column{SLAPoliciesColumns[6]},
+			},
+		},
+	}
 	// SecurityEventsColumns holds the columns for the "security_events" table.
 	SecurityEventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -722,19 +1371,29 @@ var (
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "securityevent_user_id",
+				Name:    "securityevent_email",
 				Unique:  false,
-				Columns: []*schema.Column{SecurityEventsColumns[11]},
+				Columns: []*schema.Column{SecurityEventsColumns[4]},
 			},
 			{
-				Name:    "securityevent_email",
+				Name:    "securityevent_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SecurityEventsColumns[4]},
+				Columns: []*schema.Column{SecurityEventsColumns[1]},
+			},
+			{
+				Name:    "securityevent_event_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[5], SecurityEventsColumns[1]},
+			},
+			{
+				Name:    "securityevent_ip_address_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityEventsColumns[6], SecurityEventsColumns[1]},
 			},
 			{
-				Name:    "securityevent_event_type",
+				Name:    "securityevent_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SecurityEventsColumns[5]},
+				Columns: []*schema.Column{SecurityEventsColumns[11], SecurityEventsColumns[1]},
 			},
 		},
 	}
@@ -754,6 +1413,7 @@ var (
 		{Name: "revoke_reason", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "token_family", Type: field.TypeString, Size: 64},
 		{Name: "is_used", Type: field.TypeBool, Default: false},
+		{Name: "device_fingerprint_hash", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// SessionsTable holds the schema information for the "sessions" table.
@@ -764,7 +1424,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sessions_users_sessions",
-				Columns:    []*schema.Column{SessionsColumns[14]},
+				Columns:    []*schema.Column{SessionsColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -773,13 +1433,23 @@ var (
 			{
 				Name:    "session_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{SessionsColumns[14]},
+				Columns: []*schema.Column{SessionsColumns[15]},
 			},
 			{
 				Name:    "session_token_family",
 				Unique:  false,
 				Columns: []*schema.Column{SessionsColumns[12]},
 			},
+			{
+				Name:    "session_user_id_device_fingerprint_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[15], SessionsColumns[14]},
+			},
+			{
+				Name:    "session_ip_address",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[6]},
+			},
 		},
 	}
 	// SessionLocksColumns holds the columns for the "session_locks" table.
@@ -864,6 +1534,9 @@ var (
 		{Name: "token_hash", Type: field.TypeString, Unique: true},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "purpose", Type: field.TypeString, Default: "totp"},
+		{Name: "email_code_hash", Type: field.TypeString, Nullable: true},
+		{Name: "code_attempts", Type: field.TypeInt, Default: 0},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// TotpPendingTokensTable holds the schema information for the "totp_pending_tokens" table.
@@ -874,7 +1547,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "totp_pending_tokens_users_totp_pending_tokens",
-				Columns:    []*schema.Column{TotpPendingTokensColumns[7]},
+				Columns:    []*schema.Column{TotpPendingTokensColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -888,7 +1561,7 @@ var (
 			{
 				Name:    "totppendingtoken_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TotpPendingTokensColumns[7]},
+				Columns: []*schema.Column{TotpPendingTokensColumns[10]},
 			},
 		},
 	}
@@ -990,6 +1663,11 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{UsersColumns[5]},
 			},
+			{
+				Name:    "user_last_login_ip",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[29]},
+			},
 		},
 	}
 	// UserBadgesColumns holds the columns for the "user_badges" table.
@@ -1062,6 +1740,7 @@ var (
 		{Name: "clarification_state", Type: field.TypeString, Size: 64, Default: "none"},
 		{Name: "owner_inactivity_count", Type: field.TypeInt, Default: 0},
 		{Name: "workflow_cycle", Type: field.TypeInt, Default: 1},
+		{Name: "telegram_reveal_disabled", Type: field.TypeBool, Default: false},
 		{Name: "escrow_transfer_id", Type: field.TypeString, Nullable: true},
 		{Name: "dispute_id", Type: field.TypeString, Nullable: true},
 		{Name: "accepted_final_offer_id", Type: field.TypeInt, Nullable: true},
@@ -1078,13 +1757,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "validation_cases_categories_validation_cases",
-				Columns:    []*schema.Column{ValidationCasesColumns[21]},
+				Columns:    []*schema.Column{ValidationCasesColumns[22]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "validation_cases_users_validation_cases",
-				Columns:    []*schema.Column{ValidationCasesColumns[22]},
+				Columns:    []*schema.Column{ValidationCasesColumns[23]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1093,12 +1772,12 @@ var (
 			{
 				Name:    "validationcase_category_id",
 				Unique:  false,
-				Columns: []*schema.Column{ValidationCasesColumns[21]},
+				Columns: []*schema.Column{ValidationCasesColumns[22]},
 			},
 			{
 				Name:    "validationcase_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{ValidationCasesColumns[22]},
+				Columns: []*schema.Column{ValidationCasesColumns[23]},
 			},
 			{
 				Name:    "validationcase_status",
@@ -1128,7 +1807,7 @@ var (
 			{
 				Name:    "validationcase_user_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ValidationCasesColumns[22], ValidationCasesColumns[10]},
+				Columns: []*schema.Column{ValidationCasesColumns[23], ValidationCasesColumns[10]},
 			},
 		},
 	}
@@ -1185,6 +1864,140 @@ var (
 			},
 		},
 	}
+	// ValidatorDeliverySlasColumns holds the columns for the "validator_delivery_slas" table.
+	ValidatorDeliverySlasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validation_case_id", Type: field.TypeInt},
+		{Name: "final_offer_id", Type: field.TypeInt},
+		{Name: "milestone_id", Type: field.TypeInt, Nullable: true},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "workflow_cycle", Type: field.TypeInt, Default: 1},
+		{Name: "escrow_transfer_id", Type: field.TypeString, Unique: true, Size: 128},
+		{Name: "hold_hours", Type: field.TypeInt},
+		{Name: "locked_at", Type: field.TypeTime},
+		{Name: "due_at", Type: field.TypeTime},
+		{Name: "grace_until", Type: field.TypeTime},
+		{Name: "reminder_count", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "active"},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "missed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "escalated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "recommendation", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "dispute_draft", Type: field.TypeJSON, Nullable: true},
+	}
+	// ValidatorDeliverySlasTable holds the schema information for the "validator_delivery_slas" table.
+	ValidatorDeliverySlasTable = &schema.Table{
+		Name:       "validator_delivery_slas",
+		Columns:    ValidatorDeliverySlasColumns,
+		PrimaryKey: []*schema.Column{ValidatorDeliverySlasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "validatordeliverysla_validation_case_id",
+				Unique:  false,
+				Columns: []*schema.Column{ValidatorDeliverySlasColumns[4]},
+			},
+			{
+				Name:    "validatordeliverysla_validator_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ValidatorDeliverySlasColumns[7]},
+			},
+			{
+				Name:    "validatordeliverysla_status_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{ValidatorDeliverySlasColumns[15], ValidatorDeliverySlasColumns[12]},
+			},
+		},
+	}
+	// ValidatorMatchingAggregatesColumns holds the columns for the "validator_matching_aggregates" table.
+	ValidatorMatchingAggregatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "domain_slugs", Type: field.TypeJSON, Nullable: true},
+		{Name: "evidence_slugs", Type: field.TypeJSON, Nullable: true},
+		{Name: "history_case_count", Type: field.TypeInt, Default: 0},
+		{Name: "disputed_case_count", Type: field.TypeInt, Default: 0},
+		{Name: "approved_consultation_count", Type: field.TypeInt, Default: 0},
+		{Name: "delivery_sla_count", Type: field.TypeInt, Default: 0},
+		{Name: "responsiveness_score", Type: field.TypeInt, Default: 50},
+		{Name: "computed_at", Type: field.TypeTime},
+	}
+	// ValidatorMatchingAggregatesTable holds the schema information for the "validator_matching_aggregates" table.
+	ValidatorMatchingAggregatesTable = &schema.Table{
+		Name:       "validator_matching_aggregates",
+		Columns:    ValidatorMatchingAggregatesColumns,
+		PrimaryKey: []*schema.Column{ValidatorMatchingAggregatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "validatormatchingaggregate_validator_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ValidatorMatchingAggregatesColumns[4]},
+			},
+			{
+				Name:    "validatormatchingaggregate_computed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ValidatorMatchingAggregatesColumns[12]},
+			},
+		},
+	}
+	// ValidatorReputationsColumns holds the columns for the "validator_reputations" table.
+	ValidatorReputationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validator_user_id", Type: field.TypeInt},
+		{Name: "domain_slug", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "score", Type: field.TypeInt, Default: 50},
+		{Name: "decayed_points", Type: field.TypeFloat64, Default: 0},
+		{Name: "event_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_event_at", Type: field.TypeTime, Nullable: true},
+		{Name: "computed_at", Type: field.TypeTime},
+	}
+	// ValidatorReputationsTable holds the schema information for the "validator_reputations" table.
+	ValidatorReputationsTable = &schema.Table{
+		Name:       "validator_reputations",
+		Columns:    ValidatorReputationsColumns,
+		PrimaryKey: []*schema.Column{ValidatorReputationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "validatorreputation_validator_user_id_domain_slug",
+				Unique:  true,
+				Columns: []*schema.Column{ValidatorReputationsColumns[4], ValidatorReputationsColumns[5]},
+			},
+		},
+	}
+	// AdminRoleAssignmentsColumns holds the columns for the "admin_role_assignments" table.
+	AdminRoleAssignmentsColumns = []*schema.Column{
+		{Name: "admin_id", Type: field.TypeInt},
+		{Name: "admin_role_id", Type: field.TypeInt},
+	}
+	// AdminRoleAssignmentsTable holds the schema information for the "admin_role_assignments" table.
+	AdminRoleAssignmentsTable = &schema.Table{
+		Name:       "admin_role_assignments",
+		Columns:    AdminRoleAssignmentsColumns,
+		PrimaryKey: []*schema.Column{AdminRoleAssignmentsColumns[0], AdminRoleAssignmentsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "admin_role_assignments_admin_id",
+				Columns:    []*schema.Column{AdminRoleAssignmentsColumns[0]},
+				RefColumns: []*schema.Column{AdminsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "admin_role_assignments_admin_role_id",
+				Columns:    []*schema.Column{AdminRoleAssignmentsColumns[1]},
+				RefColumns: []*schema.Column{AdminRolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TagValidationCasesColumns holds the columns for the "tag_validation_cases" table.
 	TagValidationCasesColumns = []*schema.Column{
 		{Name: "tag_id", Type: field.TypeInt},
@@ -1213,11 +2026,19 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AdminsTable,
+		AdminAuditLogsTable,
+		AdminPasskeysTable,
+		AdminRolesTable,
+		AdminSessionsTable,
 		ArtifactSubmissionsTable,
 		BackupCodesTable,
 		BadgesTable,
+		CaseClarificationsTable,
+		CaseConversationsTable,
+		CaseMessagesTable,
 		CategoriesTable,
 		ChainCursorsTable,
+		ConsultationInvitationsTable,
 		ConsultationRequestsTable,
 		CredentialsTable,
 		DeviceFingerprintsTable,
@@ -1225,11 +2046,18 @@ var (
 		EmailVerificationTokensTable,
 		EndorsementsTable,
 		FinalOffersTable,
+		FinalOfferMilestonesTable,
+		FinalOfferRevisionsTable,
+		GuaranteeSlashesTable,
 		IPGeoCacheTable,
+		IPRulesTable,
+		ImpersonationSessionsTable,
 		MarketPurchaseOrdersTable,
 		MarketPurchaseOrderStepsTable,
 		PasskeysTable,
 		PasswordResetTokensTable,
+		ReputationEventsTable,
+		SLAPoliciesTable,
 		SecurityEventsTable,
 		SessionsTable,
 		SessionLocksTable,
@@ -1240,6 +2068,10 @@ var (
 		UserBadgesTable,
 		ValidationCasesTable,
 		ValidationCaseLogsTable,
+		ValidatorDeliverySlasTable,
+		ValidatorMatchingAggregatesTable,
+		ValidatorReputationsTable,
+		AdminRoleAssignmentsTable,
 		TagValidationCasesTable,
 	}
 )
@@ -1248,6 +2080,20 @@ func init() {
 	AdminsTable.Annotation = &entsql.Annotation{
 		Table: "admins",
 	}
+	AdminAuditLogsTable.Annotation = &entsql.Annotation{
+		Table: "admin_audit_logs",
+	}
+	AdminPasskeysTable.ForeignKeys[0].RefTable = AdminsTable
+	AdminPasskeysTable.Annotation = &entsql.Annotation{
+		Table: "admin_passkeys",
+	}
+	AdminRolesTable.Annotation = &entsql.Annotation{
+		Table: "admin_roles",
+	}
+	AdminSessionsTable.ForeignKeys[0].RefTable = AdminsTable
+	AdminSessionsTable.Annotation = &entsql.Annotation{
+		Table: "admin_sessions",
+	}
 	ArtifactSubmissionsTable.ForeignKeys[0].RefTable = UsersTable
 	ArtifactSubmissionsTable.ForeignKeys[1].RefTable = ValidationCasesTable
 	ArtifactSubmissionsTable.Annotation = &entsql.Annotation{
@@ -1260,12 +2106,25 @@ func init() {
 	BadgesTable.Annotation = &entsql.Annotation{
 		Table: "badges",
 	}
+	CaseClarificationsTable.Annotation = &entsql.Annotation{
+		Table: "case_clarifications",
+	}
+	CaseConversationsTable.Annotation = &entsql.Annotation{
+		Table: "case_conversations",
+	}
+	CaseMessagesTable.ForeignKeys[0].RefTable = CaseConversationsTable
+	CaseMessagesTable.Annotation = &entsql.Annotation{
+		Table: "case_messages",
+	}
 	CategoriesTable.Annotation = &entsql.Annotation{
 		Table: "categories",
 	}
 	ChainCursorsTable.Annotation = &entsql.Annotation{
 		Table: "chain_cursors",
 	}
+	ConsultationInvitationsTable.Annotation = &entsql.Annotation{
+		Table: "consultation_invitations",
+	}
 	ConsultationRequestsTable.ForeignKeys[0].RefTable = UsersTable
 	ConsultationRequestsTable.ForeignKeys[1].RefTable = ValidationCasesTable
 	ConsultationRequestsTable.Annotation = &entsql.Annotation{
@@ -1297,9 +2156,26 @@ func init() {
 	FinalOffersTable.Annotation = &entsql.Annotation{
 		Table: "final_offers",
 	}
+	FinalOfferMilestonesTable.ForeignKeys[0].RefTable = FinalOffersTable
+	FinalOfferMilestonesTable.Annotation = &entsql.Annotation{
+		Table: "final_offer_milestones",
+	}
+	FinalOfferRevisionsTable.ForeignKeys[0].RefTable = FinalOffersTable
+	FinalOfferRevisionsTable.Annotation = &entsql.Annotation{
+		Table: "final_offer_revisions",
+	}
+	GuaranteeSlashesTable.Annotation = &entsql.Annotation{
+		Table: "guarantee_slashes",
+	}
 	IPGeoCacheTable.Annotation = &entsql.Annotation{
 		Table: "ip_geo_cache",
 	}
+	IPRulesTable.Annotation = &entsql.Annotation{
+		Table: "ip_rules",
+	}
+	ImpersonationSessionsTable.Annotation = &entsql.Annotation{
+		Table: "impersonation_sessions",
+	}
 	MarketPurchaseOrdersTable.Annotation = &entsql.Annotation{
 		Table: "market_purchase_orders",
 	}
@@ -1314,6 +2190,12 @@ func init() {
 	PasswordResetTokensTable.Annotation = &entsql.Annotation{
 		Table: "password_reset_tokens",
 	}
+	ReputationEventsTable.Annotation = &entsql.Annotation{
+		Table: "reputation_events",
+	}
+	SLAPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "sla_policies",
+	}
 	SecurityEventsTable.ForeignKeys[0].RefTable = UsersTable
 	SecurityEventsTable.Annotation = &entsql.Annotation{
 		Table: "security_events",
@@ -1357,6 +2239,17 @@ func init() {
 	ValidationCaseLogsTable.Annotation = &entsql.Annotation{
 		Table: "validation_case_logs",
 	}
+	ValidatorDeliverySlasTable.Annotation = &entsql.Annotation{
+		Table: "validator_delivery_slas",
+	}
+	ValidatorMatchingAggregatesTable.Annotation = &entsql.Annotation{
+		Table: "validator_matching_aggregates",
+	}
+	ValidatorReputationsTable.Annotation = &entsql.Annotation{
+		Table: "validator_reputations",
+	}
+	AdminRoleAssignmentsTable.ForeignKeys[0].RefTable = AdminsTable
+	AdminRoleAssignmentsTable.ForeignKeys[1].RefTable = AdminRolesTable
 	TagValidationCasesTable.ForeignKeys[0].RefTable = TagsTable
 	TagValidationCasesTable.ForeignKeys[1].RefTable = ValidationCasesTable
 }
@@ -9,6 +9,18 @@ import (
 // Admin is the predicate function for admin builders.
 type Admin func(*sql.Selector)
 
+// AdminAuditLog is the predicate function for adminauditlog builders.
+type AdminAuditLog func(*sql.Selector)
+
+// AdminPasskey is the predicate function for adminpasskey builders.
+type AdminPasskey func(*sql.Selector)
+
+// AdminRole is the predicate function for adminrole builders.
+type AdminRole func(*sql.Selector)
+
+// AdminSession is the predicate function for adminsession builders.
+type AdminSession func(*sql.Selector)
+
 // ArtifactSubmission is the predicate function for artifactsubmission builders.
 type ArtifactSubmission func(*sql.Selector)
 
@@ -18,12 +30,24 @@ type BackupCode func(*sql.Selector)
 // Badge is the predicate function for badge builders.
 type Badge func(*sql.Selector)
 
+// CaseClarification is the predicate function for caseclarification builders.
+type CaseClarification func(*sql.Selector)
+
+// CaseConversation is the predicate function for caseconversation builders.
+type CaseConversation func(*sql.Selector)
+
+// CaseMessage is the predicate function for casemessage builders.
+type CaseMessage func(*sql.Selector)
+
 // Category is the predicate function for category builders.
 type Category func(*sql.Selector)
 
 // ChainCursor is the predicate function for chaincursor builders.
 type ChainCursor func(*sql.Selector)
 
+// ConsultationInvitation is the predicate function for consultationinvitation builders.
+type ConsultationInvitation func(*sql.Selector)
+
 // ConsultationRequest is the predicate function for consultationrequest builders.
 type ConsultationRequest func(*sql.Selector)
 
@@ -45,9 +69,24 @@ type Endorsement func(*sql.Selector)
 // FinalOffer is the predicate function for finaloffer builders.
 type FinalOffer func(*sql.Selector)
 
+// FinalOfferMilestone is the predicate function for finaloffermilestone builders.
+type FinalOfferMilestone func(*sql.Selector)
+
+// FinalOfferRevision is the predicate function for finalofferrevision builders.
+type FinalOfferRevision func(*sql.Selector)
+
+// GuaranteeSlash is the predicate function for guaranteeslash builders.
+type GuaranteeSlash func(*sql.Selector)
+
 // IPGeoCache is the predicate function for ipgeocache builders.
 type IPGeoCache func(*sql.Selector)
 
+// IPRule is the predicate function for iprule builders.
+type IPRule func(*sql.Selector)
+
+// ImpersonationSession is the predicate function for impersonationsession builders.
+type ImpersonationSession func(*sql.Selector)
+
 // MarketPurchaseOrder is the predicate function for marketpurchaseorder builders.
 type MarketPurchaseOrder func(*sql.Selector)
 
@@ -60,6 +99,12 @@ type Passkey func(*sql.Selector)
 // PasswordResetToken is the predicate function for passwordresettoken builders.
 type PasswordResetToken func(*sql.Selector)
 
+// ReputationEvent is the predicate function for reputationevent builders.
+type ReputationEvent func(*sql.Selector)
+
+// SLAPolicy is the predicate function for slapolicy builders.
+type SLAPolicy func(*sql.Selector)
+
 // SecurityEvent is the predicate function for securityevent builders.
 type SecurityEvent func(*sql.Selector)
 
@@ -89,3 +134,12 @@ type ValidationCase func(*sql.Selector)
 
 // ValidationCaseLog is the predicate function for validationcaselog builders.
 type ValidationCaseLog func(*sql.Selector)
+
+// ValidatorDeliverySLA is the predicate function for validatordeliverysla builders.
+type ValidatorDeliverySLA func(*sql.Selector)
+
+// ValidatorMatchingAggregate is the predicate function for validatormatchingaggregate builders.
+type ValidatorMatchingAggregate func(*sql.Selector)
+
+// ValidatorReputation is the predicate function for validatorreputation builders.
+type ValidatorReputation func(*sql.Selector)
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reputationevent
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// WorkflowCycle applies equality check predicate on the "workflow_cycle" field. It's identical to WorkflowCycleEQ.
+func WorkflowCycle(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldKind, v))
+}
+
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldPoints, v))
+}
+
+// DedupeKey applies equality check predicate on the "dedupe_key" field. It's identical to DedupeKeyEQ.
+func DedupeKey(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldDedupeKey, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// WorkflowCycleEQ applies the EQ predicate on the "workflow_cycle" field.
+func WorkflowCycleEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleNEQ applies the NEQ predicate on the "workflow_cycle" field.
+func WorkflowCycleNEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleIn applies the In predicate on the "workflow_cycle" field.
+func WorkflowCycleIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleNotIn applies the NotIn predicate on the "workflow_cycle" field.
+func WorkflowCycleNotIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleGT applies the GT predicate on the "workflow_cycle" field.
+func WorkflowCycleGT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleGTE applies the GTE predicate on the "workflow_cycle" field.
+func WorkflowCycleGTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLT applies the LT predicate on the "workflow_cycle" field.
+func WorkflowCycleLT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLTE applies the LTE predicate on the "workflow_cycle" field.
+func WorkflowCycleLTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldWorkflowCycle, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldContainsFold(FieldKind, v))
+}
+
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldPoints, v))
+}
+
+// DomainSlugsIsNil applies the IsNil predicate on the "domain_slugs" field.
+func DomainSlugsIsNil() predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIsNull(FieldDomainSlugs))
+}
+
+// DomainSlugsNotNil applies the NotNil predicate on the "domain_slugs" field.
+func DomainSlugsNotNil() predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotNull(FieldDomainSlugs))
+}
+
+// DedupeKeyEQ applies the EQ predicate on the "dedupe_key" field.
+func DedupeKeyEQ(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldDedupeKey, v))
+}
+
+// DedupeKeyNEQ applies the NEQ predicate on the "dedupe_key" field.
+func DedupeKeyNEQ(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldDedupeKey, v))
+}
+
+// DedupeKeyIn applies the In predicate on the "dedupe_key" field.
+func DedupeKeyIn(vs ...string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldDedupeKey, vs...))
+}
+
+// DedupeKeyNotIn applies the NotIn predicate on the "dedupe_key" field.
+func DedupeKeyNotIn(vs ...string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldDedupeKey, vs...))
+}
+
+// DedupeKeyGT applies the GT predicate on the "dedupe_key" field.
+func DedupeKeyGT(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldDedupeKey, v))
+}
+
+// DedupeKeyGTE applies the GTE predicate on the "dedupe_key" field.
+func DedupeKeyGTE(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldDedupeKey, v))
+}
+
+// DedupeKeyLT applies the LT predicate on the "dedupe_key" field.
+func DedupeKeyLT(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldDedupeKey, v))
+}
+
+// DedupeKeyLTE applies the LTE predicate on the "dedupe_key" field.
+func DedupeKeyLTE(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldDedupeKey, v))
+}
+
+// DedupeKeyContains applies the Contains predicate on the "dedupe_key" field.
+func DedupeKeyContains(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldContains(FieldDedupeKey, v))
+}
+
+// DedupeKeyHasPrefix applies the HasPrefix predicate on the "dedupe_key" field.
+func DedupeKeyHasPrefix(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldHasPrefix(FieldDedupeKey, v))
+}
+
+// DedupeKeyHasSuffix applies the HasSuffix predicate on the "dedupe_key" field.
+func DedupeKeyHasSuffix(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldHasSuffix(FieldDedupeKey, v))
+}
+
+// DedupeKeyEqualFold applies the EqualFold predicate on the "dedupe_key" field.
+func DedupeKeyEqualFold(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEqualFold(FieldDedupeKey, v))
+}
+
+// DedupeKeyContainsFold applies the ContainsFold predicate on the "dedupe_key" field.
+func DedupeKeyContainsFold(v string) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldContainsFold(FieldDedupeKey, v))
+}
+
+// DetailJSONIsNil applies the IsNil predicate on the "detail_json" field.
+func DetailJSONIsNil() predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIsNull(FieldDetailJSON))
+}
+
+// DetailJSONNotNil applies the NotNil predicate on the "detail_json" field.
+func DetailJSONNotNil() predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotNull(FieldDetailJSON))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReputationEvent) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReputationEvent) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReputationEvent) predicate.ReputationEvent {
+	return predicate.ReputationEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/reputationevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReputationEventCreate is the builder for creating a ReputationEvent entity.
+type ReputationEventCreate struct {
+	config
+	mutation *ReputationEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReputationEventCreate) SetCreatedAt(v time.Time) *ReputationEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReputationEventCreate) SetNillableCreatedAt(v *time.Time) *ReputationEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReputationEventCreate) SetUpdatedAt(v time.Time) *ReputationEventCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReputationEventCreate) SetNillableUpdatedAt(v *time.Time) *ReputationEventCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ReputationEventCreate) SetDeletedAt(v time.Time) *ReputationEventCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ReputationEventCreate) SetNillableDeletedAt(v *time.Time) *ReputationEventCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *ReputationEventCreate) SetValidatorUserID(v int) *ReputationEventCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *ReputationEventCreate) SetValidationCaseID(v int) *ReputationEventCreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_c *ReputationEventCreate) SetWorkflowCycle(v int) *ReputationEventCreate {
+	_c.mutation.SetWorkflowCycle(v)
+	return _c
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_c *ReputationEventCreate) SetNillableWorkflowCycle(v *int) *ReputationEventCreate {
+	if v != nil {
+		_c.SetWorkflowCycle(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ReputationEventCreate) SetKind(v string) *ReputationEventCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetPoints sets the "points" field.
+func (_c *ReputationEventCreate) SetPoints(v int) *ReputationEventCreate {
+	_c.mutation.SetPoints(v)
+	return _c
+}
+
+// SetDomainSlugs sets the "domain_slugs" field.
+func (_c *ReputationEventCreate) SetDomainSlugs(v []string) *ReputationEventCreate {
+	_c.mutation.SetDomainSlugs(v)
+	return _c
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (_c *ReputationEventCreate) SetDedupeKey(v string) *ReputationEventCreate {
+	_c.mutation.SetDedupeKey(v)
+	return _c
+}
+
+// SetDetailJSON sets the "detail_json" field.
+func (_c *ReputationEventCreate) SetDetailJSON(v map[string]interface{}) *ReputationEventCreate {
+	_c.mutation.SetDetailJSON(v)
+	return _c
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_c *ReputationEventCreate) SetOccurredAt(v time.Time) *ReputationEventCreate {
+	_c.mutation.SetOccurredAt(v)
+	return _c
+}
+
+// Mutation returns the ReputationEventMutation object of the builder.
+func (_c *ReputationEventCreate) Mutation() *ReputationEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ReputationEvent in the database.
+func (_c *ReputationEventCreate) Save(ctx context.Context) (*ReputationEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReputationEventCreate) SaveX(ctx context.Context) *ReputationEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReputationEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReputationEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReputationEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reputationevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reputationevent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		v := reputationevent.DefaultWorkflowCycle
+		_c.mutation.SetWorkflowCycle(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReputationEventCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReputationEvent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReputationEvent.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "ReputationEvent.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := reputationevent.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "ReputationEvent.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := reputationevent.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		return &ValidationError{Name: "workflow_cycle", err: errors.New(`ent: missing required field "ReputationEvent.workflow_cycle"`)}
+	}
+	if v, ok := _c.mutation.WorkflowCycle(); ok {
+		if err := reputationevent.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.workflow_cycle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ReputationEvent.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := reputationevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "ReputationEvent.points"`)}
+	}
+	if _, ok := _c.mutation.DedupeKey(); !ok {
+		return &ValidationError{Name: "dedupe_key", err: errors.New(`ent: missing required field "ReputationEvent.dedupe_key"`)}
+	}
+	if v, ok := _c.mutation.DedupeKey(); ok {
+		if err := reputationevent.DedupeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedupe_key", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.dedupe_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "ReputationEvent.occurred_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReputationEventCreate) sqlSave(ctx context.Context) (*ReputationEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReputationEventCreate) createSpec() (*ReputationEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReputationEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reputationevent.Table, sqlgraph.NewFieldSpec(reputationevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reputationevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reputationevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(reputationevent.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(reputationevent.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(reputationevent.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.WorkflowCycle(); ok {
+		_spec.SetField(reputationevent.FieldWorkflowCycle, field.TypeInt, value)
+		_node.WorkflowCycle = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(reputationevent.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Points(); ok {
+		_spec.SetField(reputationevent.FieldPoints, field.TypeInt, value)
+		_node.Points = value
+	}
+	if value, ok := _c.mutation.DomainSlugs(); ok {
+		_spec.SetField(reputationevent.FieldDomainSlugs, field.TypeJSON, value)
+		_node.DomainSlugs = value
+	}
+	if value, ok := _c.mutation.DedupeKey(); ok {
+		_spec.SetField(reputationevent.FieldDedupeKey, field.TypeString, value)
+		_node.DedupeKey = value
+	}
+	if value, ok := _c.mutation.DetailJSON(); ok {
+		_spec.SetField(reputationevent.FieldDetailJSON, field.TypeJSON, value)
+		_node.DetailJSON = value
+	}
+	if value, ok := _c.mutation.OccurredAt(); ok {
+		_spec.SetField(reputationevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	return _node, _spec
+}
+
+// ReputationEventCreateBulk is the builder for creating many ReputationEvent entities in bulk.
+type ReputationEventCreateBulk struct {
+	config
+	err      error
+	builders []*ReputationEventCreate
+}
+
+// Save creates the ReputationEvent entities in the database.
+func (_c *ReputationEventCreateBulk) Save(ctx context.Context) ([]*ReputationEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReputationEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReputationEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReputationEventCreateBulk) SaveX(ctx context.Context) []*ReputationEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReputationEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReputationEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/reputationevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ReputationEventUpdate is the builder for updating ReputationEvent entities.
+type ReputationEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReputationEventMutation
+}
+
+// Where appends a list predicates to the ReputationEventUpdate builder.
+func (_u *ReputationEventUpdate) Where(ps ...predicate.ReputationEvent) *ReputationEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReputationEventUpdate) SetUpdatedAt(v time.Time) *ReputationEventUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ReputationEventUpdate) SetDeletedAt(v time.Time) *ReputationEventUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableDeletedAt(v *time.Time) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ReputationEventUpdate) ClearDeletedAt() *ReputationEventUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ReputationEventUpdate) SetValidatorUserID(v int) *ReputationEventUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableValidatorUserID(v *int) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ReputationEventUpdate) AddValidatorUserID(v int) *ReputationEventUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *ReputationEventUpdate) SetValidationCaseID(v int) *ReputationEventUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableValidationCaseID(v *int) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *ReputationEventUpdate) AddValidationCaseID(v int) *ReputationEventUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *ReputationEventUpdate) SetWorkflowCycle(v int) *ReputationEventUpdate {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableWorkflowCycle(v *int) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *ReputationEventUpdate) AddWorkflowCycle(v int) *ReputationEventUpdate {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReputationEventUpdate) SetKind(v string) *ReputationEventUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableKind(v *string) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetPoints sets the "points" field.
+func (_u *ReputationEventUpdate) SetPoints(v int) *ReputationEventUpdate {
+	_u.mutation.ResetPoints()
+	_u.mutation.SetPoints(v)
+	return _u
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillablePoints(v *int) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetPoints(*v)
+	}
+	return _u
+}
+
+// AddPoints adds value to the "points" field.
+func (_u *ReputationEventUpdate) AddPoints(v int) *ReputationEventUpdate {
+	_u.mutation.AddPoints(v)
+	return _u
+}
+
+// SetDomainSlugs sets the "domain_slugs" field.
+func (_u *ReputationEventUpdate) SetDomainSlugs(v []string) *ReputationEventUpdate {
+	_u.mutation.SetDomainSlugs(v)
+	return _u
+}
+
+// AppendDomainSlugs appends value to the "domain_slugs" field.
+func (_u *ReputationEventUpdate) AppendDomainSlugs(v []string) *ReputationEventUpdate {
+	_u.mutation.AppendDomainSlugs(v)
+	return _u
+}
+
+// ClearDomainSlugs clears the value of the "domain_slugs" field.
+func (_u *ReputationEventUpdate) ClearDomainSlugs() *ReputationEventUpdate {
+	_u.mutation.ClearDomainSlugs()
+	return _u
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (_u *ReputationEventUpdate) SetDedupeKey(v string) *ReputationEventUpdate {
+	_u.mutation.SetDedupeKey(v)
+	return _u
+}
+
+// SetNillableDedupeKey sets the "dedupe_key" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableDedupeKey(v *string) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetDedupeKey(*v)
+	}
+	return _u
+}
+
+// SetDetailJSON sets the "detail_json" field.
+func (_u *ReputationEventUpdate) SetDetailJSON(v map[string]interface{}) *ReputationEventUpdate {
+	_u.mutation.SetDetailJSON(v)
+	return _u
+}
+
+// ClearDetailJSON clears the value of the "detail_json" field.
+func (_u *ReputationEventUpdate) ClearDetailJSON() *ReputationEventUpdate {
+	_u.mutation.ClearDetailJSON()
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *ReputationEventUpdate) SetOccurredAt(v time.Time) *ReputationEventUpdate {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *ReputationEventUpdate) SetNillableOccurredAt(v *time.Time) *ReputationEventUpdate {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ReputationEventMutation object of the builder.
+func (_u *ReputationEventUpdate) Mutation() *ReputationEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReputationEventUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReputationEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReputationEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReputationEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReputationEventUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reputationevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReputationEventUpdate) check() error {
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := reputationevent.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := reputationevent.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := reputationevent.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := reputationevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DedupeKey(); ok {
+		if err := reputationevent.DedupeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedupe_key", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.dedupe_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReputationEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reputationevent.Table, reputationevent.Columns, sqlgraph.NewFieldSpec(reputationevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reputationevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(reputationevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(reputationevent.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(reputationevent.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(reputationevent.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(reputationevent.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(reputationevent.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(reputationevent.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(reputationevent.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(reputationevent.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Points(); ok {
+		_spec.SetField(reputationevent.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPoints(); ok {
+		_spec.AddField(reputationevent.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DomainSlugs(); ok {
+		_spec.SetField(reputationevent.FieldDomainSlugs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDomainSlugs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reputationevent.FieldDomainSlugs, value)
+		})
+	}
+	if _u.mutation.DomainSlugsCleared() {
+		_spec.ClearField(reputationevent.FieldDomainSlugs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DedupeKey(); ok {
+		_spec.SetField(reputationevent.FieldDedupeKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DetailJSON(); ok {
+		_spec.SetField(reputationevent.FieldDetailJSON, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailJSONCleared() {
+		_spec.ClearField(reputationevent.FieldDetailJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(reputationevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reputationevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReputationEventUpdateOne is the builder for updating a single ReputationEvent entity.
+type ReputationEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReputationEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReputationEventUpdateOne) SetUpdatedAt(v time.Time) *ReputationEventUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ReputationEventUpdateOne) SetDeletedAt(v time.Time) *ReputationEventUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableDeletedAt(v *time.Time) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ReputationEventUpdateOne) ClearDeletedAt() *ReputationEventUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ReputationEventUpdateOne) SetValidatorUserID(v int) *ReputationEventUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableValidatorUserID(v *int) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ReputationEventUpdateOne) AddValidatorUserID(v int) *ReputationEventUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *ReputationEventUpdateOne) SetValidationCaseID(v int) *ReputationEventUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableValidationCaseID(v *int) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *ReputationEventUpdateOne) AddValidationCaseID(v int) *ReputationEventUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *ReputationEventUpdateOne) SetWorkflowCycle(v int) *ReputationEventUpdateOne {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableWorkflowCycle(v *int) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *ReputationEventUpdateOne) AddWorkflowCycle(v int) *ReputationEventUpdateOne {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReputationEventUpdateOne) SetKind(v string) *ReputationEventUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableKind(v *string) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetPoints sets the "points" field.
+func (_u *ReputationEventUpdateOne) SetPoints(v int) *ReputationEventUpdateOne {
+	_u.mutation.ResetPoints()
+	_u.mutation.SetPoints(v)
+	return _u
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillablePoints(v *int) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetPoints(*v)
+	}
+	return _u
+}
+
+// AddPoints adds value to the "points" field.
+func (_u *ReputationEventUpdateOne) AddPoints(v int) *ReputationEventUpdateOne {
+	_u.mutation.AddPoints(v)
+	return _u
+}
+
+// SetDomainSlugs sets the "domain_slugs" field.
+func (_u *ReputationEventUpdateOne) SetDomainSlugs(v []string) *ReputationEventUpdateOne {
+	_u.mutation.SetDomainSlugs(v)
+	return _u
+}
+
+// AppendDomainSlugs appends value to the "domain_slugs" field.
+func (_u *ReputationEventUpdateOne) AppendDomainSlugs(v []string) *ReputationEventUpdateOne {
+	_u.mutation.AppendDomainSlugs(v)
+	return _u
+}
+
+// ClearDomainSlugs clears the value of the "domain_slugs" field.
+func (_u *ReputationEventUpdateOne) ClearDomainSlugs() *ReputationEventUpdateOne {
+	_u.mutation.ClearDomainSlugs()
+	return _u
+}
+
+// SetDedupeKey sets the "dedupe_key" field.
+func (_u *ReputationEventUpdateOne) SetDedupeKey(v string) *ReputationEventUpdateOne {
+	_u.mutation.SetDedupeKey(v)
+	return _u
+}
+
+// SetNillableDedupeKey sets the "dedupe_key" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableDedupeKey(v *string) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetDedupeKey(*v)
+	}
+	return _u
+}
+
+// SetDetailJSON sets the "detail_json" field.
+func (_u *ReputationEventUpdateOne) SetDetailJSON(v map[string]interface{}) *ReputationEventUpdateOne {
+	_u.mutation.SetDetailJSON(v)
+	return _u
+}
+
+// ClearDetailJSON clears the value of the "detail_json" field.
+func (_u *ReputationEventUpdateOne) ClearDetailJSON() *ReputationEventUpdateOne {
+	_u.mutation.ClearDetailJSON()
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *ReputationEventUpdateOne) SetOccurredAt(v time.Time) *ReputationEventUpdateOne {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *ReputationEventUpdateOne) SetNillableOccurredAt(v *time.Time) *ReputationEventUpdateOne {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ReputationEventMutation object of the builder.
+func (_u *ReputationEventUpdateOne) Mutation() *ReputationEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReputationEventUpdate builder.
+func (_u *ReputationEventUpdateOne) Where(ps ...predicate.ReputationEvent) *ReputationEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReputationEventUpdateOne) Select(field string, fields ...string) *ReputationEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReputationEvent entity.
+func (_u *ReputationEventUpdateOne) Save(ctx context.Context) (*ReputationEvent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReputationEventUpdateOne) SaveX(ctx context.Context) *ReputationEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReputationEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReputationEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReputationEventUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reputationevent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReputationEventUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := reputationevent.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := reputationevent.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := reputationevent.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := reputationevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DedupeKey(); ok {
+		if err := reputationevent.DedupeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedupe_key", err: fmt.Errorf(`ent: validator failed for field "ReputationEvent.dedupe_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReputationEventUpdateOne) sqlSave(ctx context.Context) (_node *ReputationEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reputationevent.Table, reputationevent.Columns, sqlgraph.NewFieldSpec(reputationevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReputationEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reputationevent.FieldID)
+		for _, f := range fields {
+			if !reputationevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reputationevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reputationevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(reputationevent.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(reputationevent.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(reputationevent.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(reputationevent.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(reputationevent.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(reputationevent.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(reputationevent.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(reputationevent.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(reputationevent.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Points(); ok {
+		_spec.SetField(reputationevent.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPoints(); ok {
+		_spec.AddField(reputationevent.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DomainSlugs(); ok {
+		_spec.SetField(reputationevent.FieldDomainSlugs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDomainSlugs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reputationevent.FieldDomainSlugs, value)
+		})
+	}
+	if _u.mutation.DomainSlugsCleared() {
+		_spec.ClearField(reputationevent.FieldDomainSlugs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DedupeKey(); ok {
+		_spec.SetField(reputationevent.FieldDedupeKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DetailJSON(); ok {
+		_spec.SetField(reputationevent.FieldDetailJSON, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailJSONCleared() {
+		_spec.ClearField(reputationevent.FieldDetailJSON, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(reputationevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	_node = &ReputationEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reputationevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
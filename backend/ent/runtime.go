@@ -4,11 +4,19 @@ package ent
 
 import (
 	"backend-gin/ent/admin"
+	"backend-gin/ent/adminauditlog"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminrole"
+	"backend-gin/ent/adminsession"
 	"backend-gin/ent/artifactsubmission"
 	"backend-gin/ent/backupcode"
 	"backend-gin/ent/badge"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
 	"backend-gin/ent/category"
 	"backend-gin/ent/chaincursor"
+	"backend-gin/ent/consultationinvitation"
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/credential"
 	"backend-gin/ent/devicefingerprint"
@@ -16,15 +24,22 @@ import (
 	"backend-gin/ent/emailverificationtoken"
 	"backend-gin/ent/endorsement"
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
+	"backend-gin/ent/guaranteeslash"
+	"backend-gin/ent/impersonationsession"
 	"backend-gin/ent/ipgeocache"
+	"backend-gin/ent/iprule"
 	"backend-gin/ent/marketpurchaseorder"
 	"backend-gin/ent/marketpurchaseorderstep"
 	"backend-gin/ent/passkey"
 	"backend-gin/ent/passwordresettoken"
+	"backend-gin/ent/reputationevent"
 	"backend-gin/ent/schema"
 	"backend-gin/ent/securityevent"
 	"backend-gin/ent/session"
 	"backend-gin/ent/sessionlock"
+	"backend-gin/ent/slapolicy"
 	"backend-gin/ent/sudosession"
 	"backend-gin/ent/tag"
 	"backend-gin/ent/totppendingtoken"
@@ -32,6 +47,9 @@ import (
 	"backend-gin/ent/userbadge"
 	"backend-gin/ent/validationcase"
 	"backend-gin/ent/validationcaselog"
+	"backend-gin/ent/validatordeliverysla"
+	"backend-gin/ent/validatormatchingaggregate"
+	"backend-gin/ent/validatorreputation"
 	"time"
 )
 
@@ -66,6 +84,257 @@ func init() {
 	adminDescName := adminFields[2].Descriptor()
 	// admin.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	admin.NameValidator = adminDescName.Validators[0].(func(string) error)
+	// adminDescTotpEnabled is the schema descriptor for totp_enabled field.
+	adminDescTotpEnabled := adminFields[4].Descriptor()
+	// admin.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	admin.DefaultTotpEnabled = adminDescTotpEnabled.Default.(bool)
+	// adminDescTotpLastStep is the schema descriptor for totp_last_step field.
+	adminDescTotpLastStep := adminFields[6].Descriptor()
+	// admin.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	admin.DefaultTotpLastStep = adminDescTotpLastStep.Default.(int64)
+	// adminDescFailedLoginAttempts is the schema descriptor for failed_login_attempts field.
+	adminDescFailedLoginAttempts := adminFields[7].Descriptor()
+	// admin.DefaultFailedLoginAttempts holds the default value on creation for the failed_login_attempts field.
+	admin.DefaultFailedLoginAttempts = adminDescFailedLoginAttempts.Default.(int)
+	adminauditlogFields := schema.AdminAuditLog{}.Fields()
+	_ = adminauditlogFields
+	// adminauditlogDescCreatedAt is the schema descriptor for created_at field.
+	adminauditlogDescCreatedAt := adminauditlogFields[0].Descriptor()
+	// adminauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminauditlog.DefaultCreatedAt = adminauditlogDescCreatedAt.Default.(func() time.Time)
+	// adminauditlogDescAdminEmail is the schema descriptor for admin_email field.
+	adminauditlogDescAdminEmail := adminauditlogFields[2].Descriptor()
+	// adminauditlog.DefaultAdminEmail holds the default value on creation for the admin_email field.
+	adminauditlog.DefaultAdminEmail = adminauditlogDescAdminEmail.Default.(string)
+	// adminauditlog.AdminEmailValidator is a validator for the "admin_email" field. It is called by the builders before save.
+	adminauditlog.AdminEmailValidator = adminauditlogDescAdminEmail.Validators[0].(func(string) error)
+	// adminauditlogDescAction is the schema descriptor for action field.
+	adminauditlogDescAction := adminauditlogFields[3].Descriptor()
+	// adminauditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	adminauditlog.ActionValidator = func() func(string) error {
+		validators := adminauditlogDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminauditlogDescMethod is the schema descriptor for method field.
+	adminauditlogDescMethod := adminauditlogFields[4].Descriptor()
+	// adminauditlog.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	adminauditlog.MethodValidator = adminauditlogDescMethod.Validators[0].(func(string) error)
+	// adminauditlogDescPath is the schema descriptor for path field.
+	adminauditlogDescPath := adminauditlogFields[5].Descriptor()
+	// adminauditlog.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	adminauditlog.PathValidator = adminauditlogDescPath.Validators[0].(func(string) error)
+	// adminauditlogDescTargetType is the schema descriptor for target_type field.
+	adminauditlogDescTargetType := adminauditlogFields[6].Descriptor()
+	// adminauditlog.DefaultTargetType holds the default value on creation for the target_type field.
+	adminauditlog.DefaultTargetType = adminauditlogDescTargetType.Default.(string)
+	// adminauditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	adminauditlog.TargetTypeValidator = adminauditlogDescTargetType.Validators[0].(func(string) error)
+	// adminauditlogDescTargetID is the schema descriptor for target_id field.
+	adminauditlogDescTargetID := adminauditlogFields[7].Descriptor()
+	// adminauditlog.DefaultTargetID holds the default value on creation for the target_id field.
+	adminauditlog.DefaultTargetID = adminauditlogDescTargetID.Default.(string)
+	// adminauditlog.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	adminauditlog.TargetIDValidator = adminauditlogDescTargetID.Validators[0].(func(string) error)
+	// adminauditlogDescRequestID is the schema descriptor for request_id field.
+	adminauditlogDescRequestID := adminauditlogFields[9].Descriptor()
+	// adminauditlog.DefaultRequestID holds the default value on creation for the request_id field.
+	adminauditlog.DefaultRequestID = adminauditlogDescRequestID.Default.(string)
+	// adminauditlog.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	adminauditlog.RequestIDValidator = adminauditlogDescRequestID.Validators[0].(func(string) error)
+	// adminauditlogDescIPAddress is the schema descriptor for ip_address field.
+	adminauditlogDescIPAddress := adminauditlogFields[10].Descriptor()
+	// adminauditlog.DefaultIPAddress holds the default value on creation for the ip_address field.
+	adminauditlog.DefaultIPAddress = adminauditlogDescIPAddress.Default.(string)
+	// adminauditlog.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	adminauditlog.IPAddressValidator = adminauditlogDescIPAddress.Validators[0].(func(string) error)
+	// adminauditlogDescUserAgent is the schema descriptor for user_agent field.
+	adminauditlogDescUserAgent := adminauditlogFields[11].Descriptor()
+	// adminauditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	adminauditlog.DefaultUserAgent = adminauditlogDescUserAgent.Default.(string)
+	// adminauditlog.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	adminauditlog.UserAgentValidator = adminauditlogDescUserAgent.Validators[0].(func(string) error)
+	// adminauditlogDescReason is the schema descriptor for reason field.
+	adminauditlogDescReason := adminauditlogFields[12].Descriptor()
+	// adminauditlog.DefaultReason holds the default value on creation for the reason field.
+	adminauditlog.DefaultReason = adminauditlogDescReason.Default.(string)
+	// adminauditlogDescSuccess is the schema descriptor for success field.
+	adminauditlogDescSuccess := adminauditlogFields[14].Descriptor()
+	// adminauditlog.DefaultSuccess holds the default value on creation for the success field.
+	adminauditlog.DefaultSuccess = adminauditlogDescSuccess.Default.(bool)
+	// adminauditlogDescSeq is the schema descriptor for seq field.
+	adminauditlogDescSeq := adminauditlogFields[15].Descriptor()
+	// adminauditlog.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	adminauditlog.SeqValidator = adminauditlogDescSeq.Validators[0].(func(int) error)
+	// adminauditlogDescPrevHash is the schema descriptor for prev_hash field.
+	adminauditlogDescPrevHash := adminauditlogFields[16].Descriptor()
+	// adminauditlog.PrevHashValidator is a validator for the "prev_hash" field. It is called by the builders before save.
+	adminauditlog.PrevHashValidator = adminauditlogDescPrevHash.Validators[0].(func(string) error)
+	// adminauditlogDescHash is the schema descriptor for hash field.
+	adminauditlogDescHash := adminauditlogFields[17].Descriptor()
+	// adminauditlog.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	adminauditlog.HashValidator = adminauditlogDescHash.Validators[0].(func(string) error)
+	adminpasskeyMixin := schema.AdminPasskey{}.Mixin()
+	adminpasskeyMixinFields0 := adminpasskeyMixin[0].Fields()
+	_ = adminpasskeyMixinFields0
+	adminpasskeyFields := schema.AdminPasskey{}.Fields()
+	_ = adminpasskeyFields
+	// adminpasskeyDescCreatedAt is the schema descriptor for created_at field.
+	adminpasskeyDescCreatedAt := adminpasskeyMixinFields0[0].Descriptor()
+	// adminpasskey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminpasskey.DefaultCreatedAt = adminpasskeyDescCreatedAt.Default.(func() time.Time)
+	// adminpasskeyDescUpdatedAt is the schema descriptor for updated_at field.
+	adminpasskeyDescUpdatedAt := adminpasskeyMixinFields0[1].Descriptor()
+	// adminpasskey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adminpasskey.DefaultUpdatedAt = adminpasskeyDescUpdatedAt.Default.(func() time.Time)
+	// adminpasskey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adminpasskey.UpdateDefaultUpdatedAt = adminpasskeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// adminpasskeyDescAdminID is the schema descriptor for admin_id field.
+	adminpasskeyDescAdminID := adminpasskeyFields[0].Descriptor()
+	// adminpasskey.AdminIDValidator is a validator for the "admin_id" field. It is called by the builders before save.
+	adminpasskey.AdminIDValidator = adminpasskeyDescAdminID.Validators[0].(func(int) error)
+	// adminpasskeyDescCredentialID is the schema descriptor for credential_id field.
+	adminpasskeyDescCredentialID := adminpasskeyFields[1].Descriptor()
+	// adminpasskey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	adminpasskey.CredentialIDValidator = adminpasskeyDescCredentialID.Validators[0].(func([]byte) error)
+	// adminpasskeyDescPublicKey is the schema descriptor for public_key field.
+	adminpasskeyDescPublicKey := adminpasskeyFields[2].Descriptor()
+	// adminpasskey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	adminpasskey.PublicKeyValidator = adminpasskeyDescPublicKey.Validators[0].(func([]byte) error)
+	// adminpasskeyDescAttestationType is the schema descriptor for attestation_type field.
+	adminpasskeyDescAttestationType := adminpasskeyFields[3].Descriptor()
+	// adminpasskey.AttestationTypeValidator is a validator for the "attestation_type" field. It is called by the builders before save.
+	adminpasskey.AttestationTypeValidator = adminpasskeyDescAttestationType.Validators[0].(func(string) error)
+	// adminpasskeyDescSignCount is the schema descriptor for sign_count field.
+	adminpasskeyDescSignCount := adminpasskeyFields[5].Descriptor()
+	// adminpasskey.DefaultSignCount holds the default value on creation for the sign_count field.
+	adminpasskey.DefaultSignCount = adminpasskeyDescSignCount.Default.(uint32)
+	// adminpasskeyDescBackupEligible is the schema descriptor for backup_eligible field.
+	adminpasskeyDescBackupEligible := adminpasskeyFields[6].Descriptor()
+	// adminpasskey.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	adminpasskey.DefaultBackupEligible = adminpasskeyDescBackupEligible.Default.(bool)
+	// adminpasskeyDescBackupState is the schema descriptor for backup_state field.
+	adminpasskeyDescBackupState := adminpasskeyFields[7].Descriptor()
+	// adminpasskey.DefaultBackupState holds the default value on creation for the backup_state field.
+	adminpasskey.DefaultBackupState = adminpasskeyDescBackupState.Default.(bool)
+	// adminpasskeyDescName is the schema descriptor for name field.
+	adminpasskeyDescName := adminpasskeyFields[8].Descriptor()
+	// adminpasskey.DefaultName holds the default value on creation for the name field.
+	adminpasskey.DefaultName = adminpasskeyDescName.Default.(string)
+	adminroleMixin := schema.AdminRole{}.Mixin()
+	adminroleMixinFields0 := adminroleMixin[0].Fields()
+	_ = adminroleMixinFields0
+	adminroleFields := schema.AdminRole{}.Fields()
+	_ = adminroleFields
+	// adminroleDescCreatedAt is the schema descriptor for created_at field.
+	adminroleDescCreatedAt := adminroleMixinFields0[0].Descriptor()
+	// adminrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminrole.DefaultCreatedAt = adminroleDescCreatedAt.Default.(func() time.Time)
+	// adminroleDescUpdatedAt is the schema descriptor for updated_at field.
+	adminroleDescUpdatedAt := adminroleMixinFields0[1].Descriptor()
+	// adminrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adminrole.DefaultUpdatedAt = adminroleDescUpdatedAt.Default.(func() time.Time)
+	// adminrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adminrole.UpdateDefaultUpdatedAt = adminroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// adminroleDescName is the schema descriptor for name field.
+	adminroleDescName := adminroleFields[0].Descriptor()
+	// adminrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	adminrole.NameValidator = func() func(string) error {
+		validators := adminroleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminroleDescDescription is the schema descriptor for description field.
+	adminroleDescDescription := adminroleFields[1].Descriptor()
+	// adminrole.DefaultDescription holds the default value on creation for the description field.
+	adminrole.DefaultDescription = adminroleDescDescription.Default.(string)
+	// adminrole.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	adminrole.DescriptionValidator = adminroleDescDescription.Validators[0].(func(string) error)
+	// adminroleDescPermissions is the schema descriptor for permissions field.
+	adminroleDescPermissions := adminroleFields[2].Descriptor()
+	// adminrole.DefaultPermissions holds the default value on creation for the permissions field.
+	adminrole.DefaultPermissions = adminroleDescPermissions.Default.([]string)
+	// adminroleDescIsSystem is the schema descriptor for is_system field.
+	adminroleDescIsSystem := adminroleFields[3].Descriptor()
+	// adminrole.DefaultIsSystem holds the default value on creation for the is_system field.
+	adminrole.DefaultIsSystem = adminroleDescIsSystem.Default.(bool)
+	adminsessionMixin := schema.AdminSession{}.Mixin()
+	adminsessionMixinFields0 := adminsessionMixin[0].Fields()
+	_ = adminsessionMixinFields0
+	adminsessionFields := schema.AdminSession{}.Fields()
+	_ = adminsessionFields
+	// adminsessionDescCreatedAt is the schema descriptor for created_at field.
+	adminsessionDescCreatedAt := adminsessionMixinFields0[0].Descriptor()
+	// adminsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminsession.DefaultCreatedAt = adminsessionDescCreatedAt.Default.(func() time.Time)
+	// adminsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	adminsessionDescUpdatedAt := adminsessionMixinFields0[1].Descriptor()
+	// adminsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adminsession.DefaultUpdatedAt = adminsessionDescUpdatedAt.Default.(func() time.Time)
+	// adminsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adminsession.UpdateDefaultUpdatedAt = adminsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// adminsessionDescAdminID is the schema descriptor for admin_id field.
+	adminsessionDescAdminID := adminsessionFields[0].Descriptor()
+	// adminsession.AdminIDValidator is a validator for the "admin_id" field. It is called by the builders before save.
+	adminsession.AdminIDValidator = adminsessionDescAdminID.Validators[0].(func(int) error)
+	// adminsessionDescSessionID is the schema descriptor for session_id field.
+	adminsessionDescSessionID := adminsessionFields[1].Descriptor()
+	// adminsession.SessionIDValidator is a validator for the "session_id" field. It is called by the builders before save.
+	adminsession.SessionIDValidator = func() func(string) error {
+		validators := adminsessionDescSessionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(session_id string) error {
+			for _, fn := range fns {
+				if err := fn(session_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// adminsessionDescIPAddress is the schema descriptor for ip_address field.
+	adminsessionDescIPAddress := adminsessionFields[2].Descriptor()
+	// adminsession.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	adminsession.IPAddressValidator = adminsessionDescIPAddress.Validators[0].(func(string) error)
+	// adminsessionDescUserAgent is the schema descriptor for user_agent field.
+	adminsessionDescUserAgent := adminsessionFields[3].Descriptor()
+	// adminsession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	adminsession.UserAgentValidator = adminsessionDescUserAgent.Validators[0].(func(string) error)
+	// adminsessionDescMfaVerified is the schema descriptor for mfa_verified field.
+	adminsessionDescMfaVerified := adminsessionFields[4].Descriptor()
+	// adminsession.DefaultMfaVerified holds the default value on creation for the mfa_verified field.
+	adminsession.DefaultMfaVerified = adminsessionDescMfaVerified.Default.(bool)
+	// adminsessionDescMfaMethod is the schema descriptor for mfa_method field.
+	adminsessionDescMfaMethod := adminsessionFields[5].Descriptor()
+	// adminsession.MfaMethodValidator is a validator for the "mfa_method" field. It is called by the builders before save.
+	adminsession.MfaMethodValidator = adminsessionDescMfaMethod.Validators[0].(func(string) error)
+	// adminsessionDescRevokeReason is the schema descriptor for revoke_reason field.
+	adminsessionDescRevokeReason := adminsessionFields[10].Descriptor()
+	// adminsession.RevokeReasonValidator is a validator for the "revoke_reason" field. It is called by the builders before save.
+	adminsession.RevokeReasonValidator = adminsessionDescRevokeReason.Validators[0].(func(string) error)
 	artifactsubmissionMixin := schema.ArtifactSubmission{}.Mixin()
 	artifactsubmissionMixinFields0 := artifactsubmissionMixin[0].Fields()
 	_ = artifactsubmissionMixinFields0
@@ -151,6 +420,119 @@ func init() {
 	badgeDescColor := badgeFields[4].Descriptor()
 	// badge.DefaultColor holds the default value on creation for the color field.
 	badge.DefaultColor = badgeDescColor.Default.(string)
+	caseclarificationMixin := schema.CaseClarification{}.Mixin()
+	caseclarificationMixinFields0 := caseclarificationMixin[0].Fields()
+	_ = caseclarificationMixinFields0
+	caseclarificationFields := schema.CaseClarification{}.Fields()
+	_ = caseclarificationFields
+	// caseclarificationDescCreatedAt is the schema descriptor for created_at field.
+	caseclarificationDescCreatedAt := caseclarificationMixinFields0[0].Descriptor()
+	// caseclarification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	caseclarification.DefaultCreatedAt = caseclarificationDescCreatedAt.Default.(func() time.Time)
+	// caseclarificationDescUpdatedAt is the schema descriptor for updated_at field.
+	caseclarificationDescUpdatedAt := caseclarificationMixinFields0[1].Descriptor()
+	// caseclarification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	caseclarification.DefaultUpdatedAt = caseclarificationDescUpdatedAt.Default.(func() time.Time)
+	// caseclarification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	caseclarification.UpdateDefaultUpdatedAt = caseclarificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// caseclarificationDescValidationCaseID is the schema descriptor for validation_case_id field.
+	caseclarificationDescValidationCaseID := caseclarificationFields[0].Descriptor()
+	// caseclarification.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	caseclarification.ValidationCaseIDValidator = caseclarificationDescValidationCaseID.Validators[0].(func(int) error)
+	// caseclarificationDescConsultationRequestID is the schema descriptor for consultation_request_id field.
+	caseclarificationDescConsultationRequestID := caseclarificationFields[1].Descriptor()
+	// caseclarification.ConsultationRequestIDValidator is a validator for the "consultation_request_id" field. It is called by the builders before save.
+	caseclarification.ConsultationRequestIDValidator = caseclarificationDescConsultationRequestID.Validators[0].(func(int) error)
+	// caseclarificationDescValidatorUserID is the schema descriptor for validator_user_id field.
+	caseclarificationDescValidatorUserID := caseclarificationFields[2].Descriptor()
+	// caseclarification.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	caseclarification.ValidatorUserIDValidator = caseclarificationDescValidatorUserID.Validators[0].(func(int) error)
+	// caseclarificationDescWorkflowCycle is the schema descriptor for workflow_cycle field.
+	caseclarificationDescWorkflowCycle := caseclarificationFields[3].Descriptor()
+	// caseclarification.DefaultWorkflowCycle holds the default value on creation for the workflow_cycle field.
+	caseclarification.DefaultWorkflowCycle = caseclarificationDescWorkflowCycle.Default.(int)
+	// caseclarification.WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	caseclarification.WorkflowCycleValidator = caseclarificationDescWorkflowCycle.Validators[0].(func(int) error)
+	// caseclarificationDescKind is the schema descriptor for kind field.
+	caseclarificationDescKind := caseclarificationFields[4].Descriptor()
+	// caseclarification.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	caseclarification.KindValidator = caseclarificationDescKind.Validators[0].(func(string) error)
+	// caseclarificationDescBody is the schema descriptor for body field.
+	caseclarificationDescBody := caseclarificationFields[5].Descriptor()
+	// caseclarification.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	caseclarification.BodyValidator = caseclarificationDescBody.Validators[0].(func(string) error)
+	// caseclarificationDescStatus is the schema descriptor for status field.
+	caseclarificationDescStatus := caseclarificationFields[6].Descriptor()
+	// caseclarification.DefaultStatus holds the default value on creation for the status field.
+	caseclarification.DefaultStatus = caseclarificationDescStatus.Default.(string)
+	// caseclarification.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	caseclarification.StatusValidator = caseclarificationDescStatus.Validators[0].(func(string) error)
+	// caseclarificationDescAnswer is the schema descriptor for answer field.
+	caseclarificationDescAnswer := caseclarificationFields[7].Descriptor()
+	// caseclarification.DefaultAnswer holds the default value on creation for the answer field.
+	caseclarification.DefaultAnswer = caseclarificationDescAnswer.Default.(string)
+	// caseclarification.AnswerValidator is a validator for the "answer" field. It is called by the builders before save.
+	caseclarification.AnswerValidator = caseclarificationDescAnswer.Validators[0].(func(string) error)
+	// caseclarificationDescShared is the schema descriptor for shared field.
+	caseclarificationDescShared := caseclarificationFields[11].Descriptor()
+	// caseclarification.DefaultShared holds the default value on creation for the shared field.
+	caseclarification.DefaultShared = caseclarificationDescShared.Default.(bool)
+	caseconversationMixin := schema.CaseConversation{}.Mixin()
+	caseconversationMixinFields0 := caseconversationMixin[0].Fields()
+	_ = caseconversationMixinFields0
+	caseconversationFields := schema.CaseConversation{}.Fields()
+	_ = caseconversationFields
+	// caseconversationDescCreatedAt is the schema descriptor for created_at field.
+	caseconversationDescCreatedAt := caseconversationMixinFields0[0].Descriptor()
+	// caseconversation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	caseconversation.DefaultCreatedAt = caseconversationDescCreatedAt.Default.(func() time.Time)
+	// caseconversationDescUpdatedAt is the schema descriptor for updated_at field.
+	caseconversationDescUpdatedAt := caseconversationMixinFields0[1].Descriptor()
+	// caseconversation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	caseconversation.DefaultUpdatedAt = caseconversationDescUpdatedAt.Default.(func() time.Time)
+	// caseconversation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	caseconversation.UpdateDefaultUpdatedAt = caseconversationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// caseconversationDescValidationCaseID is the schema descriptor for validation_case_id field.
+	caseconversationDescValidationCaseID := caseconversationFields[0].Descriptor()
+	// caseconversation.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	caseconversation.ValidationCaseIDValidator = caseconversationDescValidationCaseID.Validators[0].(func(int) error)
+	// caseconversationDescOwnerUserID is the schema descriptor for owner_user_id field.
+	caseconversationDescOwnerUserID := caseconversationFields[1].Descriptor()
+	// caseconversation.OwnerUserIDValidator is a validator for the "owner_user_id" field. It is called by the builders before save.
+	caseconversation.OwnerUserIDValidator = caseconversationDescOwnerUserID.Validators[0].(func(int) error)
+	// caseconversationDescValidatorUserID is the schema descriptor for validator_user_id field.
+	caseconversationDescValidatorUserID := caseconversationFields[2].Descriptor()
+	// caseconversation.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	caseconversation.ValidatorUserIDValidator = caseconversationDescValidatorUserID.Validators[0].(func(int) error)
+	casemessageMixin := schema.CaseMessage{}.Mixin()
+	casemessageMixinFields0 := casemessageMixin[0].Fields()
+	_ = casemessageMixinFields0
+	casemessageFields := schema.CaseMessage{}.Fields()
+	_ = casemessageFields
+	// casemessageDescCreatedAt is the schema descriptor for created_at field.
+	casemessageDescCreatedAt := casemessageMixinFields0[0].Descriptor()
+	// casemessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	casemessage.DefaultCreatedAt = casemessageDescCreatedAt.Default.(func() time.Time)
+	// casemessageDescUpdatedAt is the schema descriptor for updated_at field.
+	casemessageDescUpdatedAt := casemessageMixinFields0[1].Descriptor()
+	// casemessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	casemessage.DefaultUpdatedAt = casemessageDescUpdatedAt.Default.(func() time.Time)
+	// casemessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	casemessage.UpdateDefaultUpdatedAt = casemessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// casemessageDescConversationID is the schema descriptor for conversation_id field.
+	casemessageDescConversationID := casemessageFields[0].Descriptor()
+	// casemessage.ConversationIDValidator is a validator for the "conversation_id" field. It is called by the builders before save.
+	casemessage.ConversationIDValidator = casemessageDescConversationID.Validators[0].(func(int) error)
+	// casemessageDescSenderUserID is the schema descriptor for sender_user_id field.
+	casemessageDescSenderUserID := casemessageFields[1].Descriptor()
+	// casemessage.SenderUserIDValidator is a validator for the "sender_user_id" field. It is called by the builders before save.
+	casemessage.SenderUserIDValidator = casemessageDescSenderUserID.Validators[0].(func(int) error)
+	// casemessageDescBody is the schema descriptor for body field.
+	casemessageDescBody := casemessageFields[2].Descriptor()
+	// casemessage.DefaultBody holds the default value on creation for the body field.
+	casemessage.DefaultBody = casemessageDescBody.Default.(string)
+	// casemessage.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	casemessage.BodyValidator = casemessageDescBody.Validators[0].(func(string) error)
 	categoryMixin := schema.Category{}.Mixin()
 	categoryMixinFields0 := categoryMixin[0].Fields()
 	_ = categoryMixinFields0
@@ -212,6 +594,51 @@ func init() {
 	chaincursor.DefaultUpdatedAt = chaincursorDescUpdatedAt.Default.(func() time.Time)
 	// chaincursor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	chaincursor.UpdateDefaultUpdatedAt = chaincursorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	consultationinvitationMixin := schema.ConsultationInvitation{}.Mixin()
+	consultationinvitationMixinFields0 := consultationinvitationMixin[0].Fields()
+	_ = consultationinvitationMixinFields0
+	consultationinvitationFields := schema.ConsultationInvitation{}.Fields()
+	_ = consultationinvitationFields
+	// consultationinvitationDescCreatedAt is the schema descriptor for created_at field.
+	consultationinvitationDescCreatedAt := consultationinvitationMixinFields0[0].Descriptor()
+	// consultationinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	consultationinvitation.DefaultCreatedAt = consultationinvitationDescCreatedAt.Default.(func() time.Time)
+	// consultationinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	consultationinvitationDescUpdatedAt := consultationinvitationMixinFields0[1].Descriptor()
+	// consultationinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	consultationinvitation.DefaultUpdatedAt = consultationinvitationDescUpdatedAt.Default.(func() time.Time)
+	// consultationinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	consultationinvitation.UpdateDefaultUpdatedAt = consultationinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// consultationinvitationDescValidationCaseID is the schema descriptor for validation_case_id field.
+	consultationinvitationDescValidationCaseID := consultationinvitationFields[0].Descriptor()
+	// consultationinvitation.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	consultationinvitation.ValidationCaseIDValidator = consultationinvitationDescValidationCaseID.Validators[0].(func(int) error)
+	// consultationinvitationDescOwnerUserID is the schema descriptor for owner_user_id field.
+	consultationinvitationDescOwnerUserID := consultationinvitationFields[1].Descriptor()
+	// consultationinvitation.OwnerUserIDValidator is a validator for the "owner_user_id" field. It is called by the builders before save.
+	consultationinvitation.OwnerUserIDValidator = consultationinvitationDescOwnerUserID.Validators[0].(func(int) error)
+	// consultationinvitationDescValidatorUserID is the schema descriptor for validator_user_id field.
+	consultationinvitationDescValidatorUserID := consultationinvitationFields[2].Descriptor()
+	// consultationinvitation.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	consultationinvitation.ValidatorUserIDValidator = consultationinvitationDescValidatorUserID.Validators[0].(func(int) error)
+	// consultationinvitationDescWorkflowCycle is the schema descriptor for workflow_cycle field.
+	consultationinvitationDescWorkflowCycle := consultationinvitationFields[3].Descriptor()
+	// consultationinvitation.DefaultWorkflowCycle holds the default value on creation for the workflow_cycle field.
+	consultationinvitation.DefaultWorkflowCycle = consultationinvitationDescWorkflowCycle.Default.(int)
+	// consultationinvitation.WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	consultationinvitation.WorkflowCycleValidator = consultationinvitationDescWorkflowCycle.Validators[0].(func(int) error)
+	// consultationinvitationDescStatus is the schema descriptor for status field.
+	consultationinvitationDescStatus := consultationinvitationFields[4].Descriptor()
+	// consultationinvitation.DefaultStatus holds the default value on creation for the status field.
+	consultationinvitation.DefaultStatus = consultationinvitationDescStatus.Default.(string)
+	// consultationinvitation.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	consultationinvitation.StatusValidator = consultationinvitationDescStatus.Validators[0].(func(string) error)
+	// consultationinvitationDescMessage is the schema descriptor for message field.
+	consultationinvitationDescMessage := consultationinvitationFields[5].Descriptor()
+	// consultationinvitation.DefaultMessage holds the default value on creation for the message field.
+	consultationinvitation.DefaultMessage = consultationinvitationDescMessage.Default.(string)
+	// consultationinvitation.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	consultationinvitation.MessageValidator = consultationinvitationDescMessage.Validators[0].(func(string) error)
 	consultationrequestMixin := schema.ConsultationRequest{}.Mixin()
 	consultationrequestMixinFields0 := consultationrequestMixin[0].Fields()
 	_ = consultationrequestMixinFields0
@@ -386,6 +813,22 @@ func init() {
 	deviceusermappingDescLastSeenAt := deviceusermappingFields[3].Descriptor()
 	// deviceusermapping.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
 	deviceusermapping.DefaultLastSeenAt = deviceusermappingDescLastSeenAt.Default.(func() time.Time)
+	// deviceusermappingDescLastIP is the schema descriptor for last_ip field.
+	deviceusermappingDescLastIP := deviceusermappingFields[4].Descriptor()
+	// deviceusermapping.LastIPValidator is a validator for the "last_ip" field. It is called by the builders before save.
+	deviceusermapping.LastIPValidator = deviceusermappingDescLastIP.Validators[0].(func(string) error)
+	// deviceusermappingDescLastUserAgent is the schema descriptor for last_user_agent field.
+	deviceusermappingDescLastUserAgent := deviceusermappingFields[5].Descriptor()
+	// deviceusermapping.LastUserAgentValidator is a validator for the "last_user_agent" field. It is called by the builders before save.
+	deviceusermapping.LastUserAgentValidator = deviceusermappingDescLastUserAgent.Validators[0].(func(string) error)
+	// deviceusermappingDescName is the schema descriptor for name field.
+	deviceusermappingDescName := deviceusermappingFields[6].Descriptor()
+	// deviceusermapping.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deviceusermapping.NameValidator = deviceusermappingDescName.Validators[0].(func(string) error)
+	// deviceusermappingDescTrusted is the schema descriptor for trusted field.
+	deviceusermappingDescTrusted := deviceusermappingFields[7].Descriptor()
+	// deviceusermapping.DefaultTrusted holds the default value on creation for the trusted field.
+	deviceusermapping.DefaultTrusted = deviceusermappingDescTrusted.Default.(bool)
 	emailverificationtokenMixin := schema.EmailVerificationToken{}.Mixin()
 	emailverificationtokenMixinFields0 := emailverificationtokenMixin[0].Fields()
 	_ = emailverificationtokenMixinFields0
@@ -423,6 +866,16 @@ func init() {
 			return nil
 		}
 	}()
+	// emailverificationtokenDescPurpose is the schema descriptor for purpose field.
+	emailverificationtokenDescPurpose := emailverificationtokenFields[4].Descriptor()
+	// emailverificationtoken.DefaultPurpose holds the default value on creation for the purpose field.
+	emailverificationtoken.DefaultPurpose = emailverificationtokenDescPurpose.Default.(string)
+	// emailverificationtoken.PurposeValidator is a validator for the "purpose" field. It is called by the builders before save.
+	emailverificationtoken.PurposeValidator = emailverificationtokenDescPurpose.Validators[0].(func(string) error)
+	// emailverificationtokenDescTargetEmail is the schema descriptor for target_email field.
+	emailverificationtokenDescTargetEmail := emailverificationtokenFields[5].Descriptor()
+	// emailverificationtoken.TargetEmailValidator is a validator for the "target_email" field. It is called by the builders before save.
+	emailverificationtoken.TargetEmailValidator = emailverificationtokenDescTargetEmail.Validators[0].(func(string) error)
 	endorsementMixin := schema.Endorsement{}.Mixin()
 	endorsementMixinFields0 := endorsementMixin[0].Fields()
 	_ = endorsementMixinFields0
@@ -509,6 +962,207 @@ func init() {
 	finaloffer.DefaultStatus = finalofferDescStatus.Default.(string)
 	// finaloffer.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	finaloffer.StatusValidator = finalofferDescStatus.Validators[0].(func(string) error)
+	// finalofferDescCurrentRevision is the schema descriptor for current_revision field.
+	finalofferDescCurrentRevision := finalofferFields[10].Descriptor()
+	// finaloffer.DefaultCurrentRevision holds the default value on creation for the current_revision field.
+	finaloffer.DefaultCurrentRevision = finalofferDescCurrentRevision.Default.(int)
+	// finaloffer.CurrentRevisionValidator is a validator for the "current_revision" field. It is called by the builders before save.
+	finaloffer.CurrentRevisionValidator = finalofferDescCurrentRevision.Validators[0].(func(int) error)
+	// finalofferDescLastProposedBy is the schema descriptor for last_proposed_by field.
+	finalofferDescLastProposedBy := finalofferFields[11].Descriptor()
+	// finaloffer.DefaultLastProposedBy holds the default value on creation for the last_proposed_by field.
+	finaloffer.DefaultLastProposedBy = finalofferDescLastProposedBy.Default.(string)
+	// finaloffer.LastProposedByValidator is a validator for the "last_proposed_by" field. It is called by the builders before save.
+	finaloffer.LastProposedByValidator = finalofferDescLastProposedBy.Validators[0].(func(string) error)
+	finaloffermilestoneMixin := schema.FinalOfferMilestone{}.Mixin()
+	finaloffermilestoneMixinFields0 := finaloffermilestoneMixin[0].Fields()
+	_ = finaloffermilestoneMixinFields0
+	finaloffermilestoneFields := schema.FinalOfferMilestone{}.Fields()
+	_ = finaloffermilestoneFields
+	// finaloffermilestoneDescCreatedAt is the schema descriptor for created_at field.
+	finaloffermilestoneDescCreatedAt := finaloffermilestoneMixinFields0[0].Descriptor()
+	// finaloffermilestone.DefaultCreatedAt holds the default value on creation for the created_at field.
+	finaloffermilestone.DefaultCreatedAt = finaloffermilestoneDescCreatedAt.Default.(func() time.Time)
+	// finaloffermilestoneDescUpdatedAt is the schema descriptor for updated_at field.
+	finaloffermilestoneDescUpdatedAt := finaloffermilestoneMixinFields0[1].Descriptor()
+	// finaloffermilestone.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	finaloffermilestone.DefaultUpdatedAt = finaloffermilestoneDescUpdatedAt.Default.(func() time.Time)
+	// finaloffermilestone.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	finaloffermilestone.UpdateDefaultUpdatedAt = finaloffermilestoneDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// finaloffermilestoneDescFinalOfferID is the schema descriptor for final_offer_id field.
+	finaloffermilestoneDescFinalOfferID := finaloffermilestoneFields[0].Descriptor()
+	// finaloffermilestone.FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	finaloffermilestone.FinalOfferIDValidator = finaloffermilestoneDescFinalOfferID.Validators[0].(func(int) error)
+	// finaloffermilestoneDescValidationCaseID is the schema descriptor for validation_case_id field.
+	finaloffermilestoneDescValidationCaseID := finaloffermilestoneFields[1].Descriptor()
+	// finaloffermilestone.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	finaloffermilestone.ValidationCaseIDValidator = finaloffermilestoneDescValidationCaseID.Validators[0].(func(int) error)
+	// finaloffermilestoneDescSequence is the schema descriptor for sequence field.
+	finaloffermilestoneDescSequence := finaloffermilestoneFields[2].Descriptor()
+	// finaloffermilestone.SequenceValidator is a validator for the "sequence" field. It is called by the builders before save.
+	finaloffermilestone.SequenceValidator = finaloffermilestoneDescSequence.Validators[0].(func(int) error)
+	// finaloffermilestoneDescDeliverable is the schema descriptor for deliverable field.
+	finaloffermilestoneDescDeliverable := finaloffermilestoneFields[3].Descriptor()
+	// finaloffermilestone.DeliverableValidator is a validator for the "deliverable" field. It is called by the builders before save.
+	finaloffermilestone.DeliverableValidator = finaloffermilestoneDescDeliverable.Validators[0].(func(string) error)
+	// finaloffermilestoneDescAmount is the schema descriptor for amount field.
+	finaloffermilestoneDescAmount := finaloffermilestoneFields[4].Descriptor()
+	// finaloffermilestone.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	finaloffermilestone.AmountValidator = finaloffermilestoneDescAmount.Validators[0].(func(int64) error)
+	// finaloffermilestoneDescStatus is the schema descriptor for status field.
+	finaloffermilestoneDescStatus := finaloffermilestoneFields[6].Descriptor()
+	// finaloffermilestone.DefaultStatus holds the default value on creation for the status field.
+	finaloffermilestone.DefaultStatus = finaloffermilestoneDescStatus.Default.(string)
+	// finaloffermilestone.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	finaloffermilestone.StatusValidator = finaloffermilestoneDescStatus.Validators[0].(func(string) error)
+	// finaloffermilestoneDescEscrowTransferID is the schema descriptor for escrow_transfer_id field.
+	finaloffermilestoneDescEscrowTransferID := finaloffermilestoneFields[7].Descriptor()
+	// finaloffermilestone.EscrowTransferIDValidator is a validator for the "escrow_transfer_id" field. It is called by the builders before save.
+	finaloffermilestone.EscrowTransferIDValidator = finaloffermilestoneDescEscrowTransferID.Validators[0].(func(string) error)
+	// finaloffermilestoneDescDisputeID is the schema descriptor for dispute_id field.
+	finaloffermilestoneDescDisputeID := finaloffermilestoneFields[9].Descriptor()
+	// finaloffermilestone.DisputeIDValidator is a validator for the "dispute_id" field. It is called by the builders before save.
+	finaloffermilestone.DisputeIDValidator = finaloffermilestoneDescDisputeID.Validators[0].(func(string) error)
+	finalofferrevisionMixin := schema.FinalOfferRevision{}.Mixin()
+	finalofferrevisionMixinFields0 := finalofferrevisionMixin[0].Fields()
+	_ = finalofferrevisionMixinFields0
+	finalofferrevisionFields := schema.FinalOfferRevision{}.Fields()
+	_ = finalofferrevisionFields
+	// finalofferrevisionDescCreatedAt is the schema descriptor for created_at field.
+	finalofferrevisionDescCreatedAt := finalofferrevisionMixinFields0[0].Descriptor()
+	// finalofferrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	finalofferrevision.DefaultCreatedAt = finalofferrevisionDescCreatedAt.Default.(func() time.Time)
+	// finalofferrevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	finalofferrevisionDescUpdatedAt := finalofferrevisionMixinFields0[1].Descriptor()
+	// finalofferrevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	finalofferrevision.DefaultUpdatedAt = finalofferrevisionDescUpdatedAt.Default.(func() time.Time)
+	// finalofferrevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	finalofferrevision.UpdateDefaultUpdatedAt = finalofferrevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// finalofferrevisionDescFinalOfferID is the schema descriptor for final_offer_id field.
+	finalofferrevisionDescFinalOfferID := finalofferrevisionFields[0].Descriptor()
+	// finalofferrevision.FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	finalofferrevision.FinalOfferIDValidator = finalofferrevisionDescFinalOfferID.Validators[0].(func(int) error)
+	// finalofferrevisionDescRevision is the schema descriptor for revision field.
+	finalofferrevisionDescRevision := finalofferrevisionFields[1].Descriptor()
+	// finalofferrevision.RevisionValidator is a validator for the "revision" field. It is called by the builders before save.
+	finalofferrevision.RevisionValidator = finalofferrevisionDescRevision.Validators[0].(func(int) error)
+	// finalofferrevisionDescProposedByUserID is the schema descriptor for proposed_by_user_id field.
+	finalofferrevisionDescProposedByUserID := finalofferrevisionFields[2].Descriptor()
+	// finalofferrevision.ProposedByUserIDValidator is a validator for the "proposed_by_user_id" field. It is called by the builders before save.
+	finalofferrevision.ProposedByUserIDValidator = finalofferrevisionDescProposedByUserID.Validators[0].(func(int) error)
+	// finalofferrevisionDescProposerRole is the schema descriptor for proposer_role field.
+	finalofferrevisionDescProposerRole := finalofferrevisionFields[3].Descriptor()
+	// finalofferrevision.ProposerRoleValidator is a validator for the "proposer_role" field. It is called by the builders before save.
+	finalofferrevision.ProposerRoleValidator = finalofferrevisionDescProposerRole.Validators[0].(func(string) error)
+	// finalofferrevisionDescAction is the schema descriptor for action field.
+	finalofferrevisionDescAction := finalofferrevisionFields[4].Descriptor()
+	// finalofferrevision.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	finalofferrevision.ActionValidator = finalofferrevisionDescAction.Validators[0].(func(string) error)
+	// finalofferrevisionDescAmount is the schema descriptor for amount field.
+	finalofferrevisionDescAmount := finalofferrevisionFields[5].Descriptor()
+	// finalofferrevision.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	finalofferrevision.AmountValidator = finalofferrevisionDescAmount.Validators[0].(func(int64) error)
+	// finalofferrevisionDescHoldHours is the schema descriptor for hold_hours field.
+	finalofferrevisionDescHoldHours := finalofferrevisionFields[6].Descriptor()
+	// finalofferrevision.HoldHoursValidator is a validator for the "hold_hours" field. It is called by the builders before save.
+	finalofferrevision.HoldHoursValidator = finalofferrevisionDescHoldHours.Validators[0].(func(int) error)
+	// finalofferrevisionDescTerms is the schema descriptor for terms field.
+	finalofferrevisionDescTerms := finalofferrevisionFields[7].Descriptor()
+	// finalofferrevision.DefaultTerms holds the default value on creation for the terms field.
+	finalofferrevision.DefaultTerms = finalofferrevisionDescTerms.Default.(string)
+	// finalofferrevisionDescNote is the schema descriptor for note field.
+	finalofferrevisionDescNote := finalofferrevisionFields[8].Descriptor()
+	// finalofferrevision.DefaultNote holds the default value on creation for the note field.
+	finalofferrevision.DefaultNote = finalofferrevisionDescNote.Default.(string)
+	// finalofferrevision.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	finalofferrevision.NoteValidator = finalofferrevisionDescNote.Validators[0].(func(string) error)
+	guaranteeslashMixin := schema.GuaranteeSlash{}.Mixin()
+	guaranteeslashMixinFields0 := guaranteeslashMixin[0].Fields()
+	_ = guaranteeslashMixinFields0
+	guaranteeslashFields := schema.GuaranteeSlash{}.Fields()
+	_ = guaranteeslashFields
+	// guaranteeslashDescCreatedAt is the schema descriptor for created_at field.
+	guaranteeslashDescCreatedAt := guaranteeslashMixinFields0[0].Descriptor()
+	// guaranteeslash.DefaultCreatedAt holds the default value on creation for the created_at field.
+	guaranteeslash.DefaultCreatedAt = guaranteeslashDescCreatedAt.Default.(func() time.Time)
+	// guaranteeslashDescUpdatedAt is the schema descriptor for updated_at field.
+	guaranteeslashDescUpdatedAt := guaranteeslashMixinFields0[1].Descriptor()
+	// guaranteeslash.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	guaranteeslash.DefaultUpdatedAt = guaranteeslashDescUpdatedAt.Default.(func() time.Time)
+	// guaranteeslash.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	guaranteeslash.UpdateDefaultUpdatedAt = guaranteeslashDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// guaranteeslashDescValidationCaseID is the schema descriptor for validation_case_id field.
+	guaranteeslashDescValidationCaseID := guaranteeslashFields[0].Descriptor()
+	// guaranteeslash.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	guaranteeslash.ValidationCaseIDValidator = guaranteeslashDescValidationCaseID.Validators[0].(func(int) error)
+	// guaranteeslashDescValidatorUserID is the schema descriptor for validator_user_id field.
+	guaranteeslashDescValidatorUserID := guaranteeslashFields[1].Descriptor()
+	// guaranteeslash.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	guaranteeslash.ValidatorUserIDValidator = guaranteeslashDescValidatorUserID.Validators[0].(func(int) error)
+	// guaranteeslashDescFinalOfferID is the schema descriptor for final_offer_id field.
+	guaranteeslashDescFinalOfferID := guaranteeslashFields[2].Descriptor()
+	// guaranteeslash.FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	guaranteeslash.FinalOfferIDValidator = guaranteeslashDescFinalOfferID.Validators[0].(func(int) error)
+	// guaranteeslashDescWorkflowCycle is the schema descriptor for workflow_cycle field.
+	guaranteeslashDescWorkflowCycle := guaranteeslashFields[4].Descriptor()
+	// guaranteeslash.DefaultWorkflowCycle holds the default value on creation for the workflow_cycle field.
+	guaranteeslash.DefaultWorkflowCycle = guaranteeslashDescWorkflowCycle.Default.(int)
+	// guaranteeslash.WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	guaranteeslash.WorkflowCycleValidator = guaranteeslashDescWorkflowCycle.Validators[0].(func(int) error)
+	// guaranteeslashDescDisputeID is the schema descriptor for dispute_id field.
+	guaranteeslashDescDisputeID := guaranteeslashFields[5].Descriptor()
+	// guaranteeslash.DisputeIDValidator is a validator for the "dispute_id" field. It is called by the builders before save.
+	guaranteeslash.DisputeIDValidator = guaranteeslashDescDisputeID.Validators[0].(func(string) error)
+	// guaranteeslashDescIdempotencyKey is the schema descriptor for idempotency_key field.
+	guaranteeslashDescIdempotencyKey := guaranteeslashFields[6].Descriptor()
+	// guaranteeslash.IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	guaranteeslash.IdempotencyKeyValidator = guaranteeslashDescIdempotencyKey.Validators[0].(func(string) error)
+	// guaranteeslashDescGuaranteeAmount is the schema descriptor for guarantee_amount field.
+	guaranteeslashDescGuaranteeAmount := guaranteeslashFields[7].Descriptor()
+	// guaranteeslash.GuaranteeAmountValidator is a validator for the "guarantee_amount" field. It is called by the builders before save.
+	guaranteeslash.GuaranteeAmountValidator = guaranteeslashDescGuaranteeAmount.Validators[0].(func(int64) error)
+	// guaranteeslashDescPercent is the schema descriptor for percent field.
+	guaranteeslashDescPercent := guaranteeslashFields[8].Descriptor()
+	// guaranteeslash.PercentValidator is a validator for the "percent" field. It is called by the builders before save.
+	guaranteeslash.PercentValidator = guaranteeslashDescPercent.Validators[0].(func(int) error)
+	// guaranteeslashDescCapAmount is the schema descriptor for cap_amount field.
+	guaranteeslashDescCapAmount := guaranteeslashFields[9].Descriptor()
+	// guaranteeslash.DefaultCapAmount holds the default value on creation for the cap_amount field.
+	guaranteeslash.DefaultCapAmount = guaranteeslashDescCapAmount.Default.(int64)
+	// guaranteeslash.CapAmountValidator is a validator for the "cap_amount" field. It is called by the builders before save.
+	guaranteeslash.CapAmountValidator = guaranteeslashDescCapAmount.Validators[0].(func(int64) error)
+	// guaranteeslashDescAmount is the schema descriptor for amount field.
+	guaranteeslashDescAmount := guaranteeslashFields[10].Descriptor()
+	// guaranteeslash.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	guaranteeslash.AmountValidator = guaranteeslashDescAmount.Validators[0].(func(int64) error)
+	// guaranteeslashDescStatus is the schema descriptor for status field.
+	guaranteeslashDescStatus := guaranteeslashFields[11].Descriptor()
+	// guaranteeslash.DefaultStatus holds the default value on creation for the status field.
+	guaranteeslash.DefaultStatus = guaranteeslashDescStatus.Default.(string)
+	// guaranteeslash.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	guaranteeslash.StatusValidator = guaranteeslashDescStatus.Validators[0].(func(string) error)
+	// guaranteeslashDescSkipReason is the schema descriptor for skip_reason field.
+	guaranteeslashDescSkipReason := guaranteeslashFields[12].Descriptor()
+	// guaranteeslash.SkipReasonValidator is a validator for the "skip_reason" field. It is called by the builders before save.
+	guaranteeslash.SkipReasonValidator = guaranteeslashDescSkipReason.Validators[0].(func(string) error)
+	// guaranteeslashDescAppealReason is the schema descriptor for appeal_reason field.
+	guaranteeslashDescAppealReason := guaranteeslashFields[14].Descriptor()
+	// guaranteeslash.AppealReasonValidator is a validator for the "appeal_reason" field. It is called by the builders before save.
+	guaranteeslash.AppealReasonValidator = guaranteeslashDescAppealReason.Validators[0].(func(string) error)
+	// guaranteeslashDescResolutionNote is the schema descriptor for resolution_note field.
+	guaranteeslashDescResolutionNote := guaranteeslashFields[17].Descriptor()
+	// guaranteeslash.ResolutionNoteValidator is a validator for the "resolution_note" field. It is called by the builders before save.
+	guaranteeslash.ResolutionNoteValidator = guaranteeslashDescResolutionNote.Validators[0].(func(string) error)
+	// guaranteeslashDescAttemptCount is the schema descriptor for attempt_count field.
+	guaranteeslashDescAttemptCount := guaranteeslashFields[21].Descriptor()
+	// guaranteeslash.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	guaranteeslash.DefaultAttemptCount = guaranteeslashDescAttemptCount.Default.(int)
+	// guaranteeslash.AttemptCountValidator is a validator for the "attempt_count" field. It is called by the builders before save.
+	guaranteeslash.AttemptCountValidator = guaranteeslashDescAttemptCount.Validators[0].(func(int) error)
+	// guaranteeslashDescLastError is the schema descriptor for last_error field.
+	guaranteeslashDescLastError := guaranteeslashFields[23].Descriptor()
+	// guaranteeslash.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	guaranteeslash.LastErrorValidator = guaranteeslashDescLastError.Validators[0].(func(string) error)
 	ipgeocacheMixin := schema.IPGeoCache{}.Mixin()
 	ipgeocacheMixinFields0 := ipgeocacheMixin[0].Fields()
 	_ = ipgeocacheMixinFields0
@@ -554,10 +1208,152 @@ func init() {
 	ipgeocacheDescCity := ipgeocacheFields[3].Descriptor()
 	// ipgeocache.CityValidator is a validator for the "city" field. It is called by the builders before save.
 	ipgeocache.CityValidator = ipgeocacheDescCity.Validators[0].(func(string) error)
+	// ipgeocacheDescAsn is the schema descriptor for asn field.
+	ipgeocacheDescAsn := ipgeocacheFields[6].Descriptor()
+	// ipgeocache.AsnValidator is a validator for the "asn" field. It is called by the builders before save.
+	ipgeocache.AsnValidator = ipgeocacheDescAsn.Validators[0].(func(string) error)
 	// ipgeocacheDescCachedAt is the schema descriptor for cached_at field.
-	ipgeocacheDescCachedAt := ipgeocacheFields[6].Descriptor()
+	ipgeocacheDescCachedAt := ipgeocacheFields[7].Descriptor()
 	// ipgeocache.DefaultCachedAt holds the default value on creation for the cached_at field.
 	ipgeocache.DefaultCachedAt = ipgeocacheDescCachedAt.Default.(func() time.Time)
+	ipruleMixin := schema.IPRule{}.Mixin()
+	ipruleMixinFields0 := ipruleMixin[0].Fields()
+	_ = ipruleMixinFields0
+	ipruleFields := schema.IPRule{}.Fields()
+	_ = ipruleFields
+	// ipruleDescCreatedAt is the schema descriptor for created_at field.
+	ipruleDescCreatedAt := ipruleMixinFields0[0].Descriptor()
+	// iprule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	iprule.DefaultCreatedAt = ipruleDescCreatedAt.Default.(func() time.Time)
+	// ipruleDescUpdatedAt is the schema descriptor for updated_at field.
+	ipruleDescUpdatedAt := ipruleMixinFields0[1].Descriptor()
+	// iprule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	iprule.DefaultUpdatedAt = ipruleDescUpdatedAt.Default.(func() time.Time)
+	// iprule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	iprule.UpdateDefaultUpdatedAt = ipruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ipruleDescCidr is the schema descriptor for cidr field.
+	ipruleDescCidr := ipruleFields[0].Descriptor()
+	// iprule.CidrValidator is a validator for the "cidr" field. It is called by the builders before save.
+	iprule.CidrValidator = func() func(string) error {
+		validators := ipruleDescCidr.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(cidr string) error {
+			for _, fn := range fns {
+				if err := fn(cidr); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ipruleDescAction is the schema descriptor for action field.
+	ipruleDescAction := ipruleFields[1].Descriptor()
+	// iprule.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	iprule.ActionValidator = func() func(string) error {
+		validators := ipruleDescAction.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(action string) error {
+			for _, fn := range fns {
+				if err := fn(action); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ipruleDescReason is the schema descriptor for reason field.
+	ipruleDescReason := ipruleFields[2].Descriptor()
+	// iprule.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	iprule.ReasonValidator = func() func(string) error {
+		validators := ipruleDescReason.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reason string) error {
+			for _, fn := range fns {
+				if err := fn(reason); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ipruleDescSource is the schema descriptor for source field.
+	ipruleDescSource := ipruleFields[3].Descriptor()
+	// iprule.DefaultSource holds the default value on creation for the source field.
+	iprule.DefaultSource = ipruleDescSource.Default.(string)
+	// iprule.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	iprule.SourceValidator = ipruleDescSource.Validators[0].(func(string) error)
+	// ipruleDescCreatedByEmail is the schema descriptor for created_by_email field.
+	ipruleDescCreatedByEmail := ipruleFields[5].Descriptor()
+	// iprule.CreatedByEmailValidator is a validator for the "created_by_email" field. It is called by the builders before save.
+	iprule.CreatedByEmailValidator = ipruleDescCreatedByEmail.Validators[0].(func(string) error)
+	impersonationsessionMixin := schema.ImpersonationSession{}.Mixin()
+	impersonationsessionMixinFields0 := impersonationsessionMixin[0].Fields()
+	_ = impersonationsessionMixinFields0
+	impersonationsessionFields := schema.ImpersonationSession{}.Fields()
+	_ = impersonationsessionFields
+	// impersonationsessionDescCreatedAt is the schema descriptor for created_at field.
+	impersonationsessionDescCreatedAt := impersonationsessionMixinFields0[0].Descriptor()
+	// impersonationsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	impersonationsession.DefaultCreatedAt = impersonationsessionDescCreatedAt.Default.(func() time.Time)
+	// impersonationsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	impersonationsessionDescUpdatedAt := impersonationsessionMixinFields0[1].Descriptor()
+	// impersonationsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	impersonationsession.DefaultUpdatedAt = impersonationsessionDescUpdatedAt.Default.(func() time.Time)
+	// impersonationsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	impersonationsession.UpdateDefaultUpdatedAt = impersonationsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// impersonationsessionDescAdminEmail is the schema descriptor for admin_email field.
+	impersonationsessionDescAdminEmail := impersonationsessionFields[1].Descriptor()
+	// impersonationsession.DefaultAdminEmail holds the default value on creation for the admin_email field.
+	impersonationsession.DefaultAdminEmail = impersonationsessionDescAdminEmail.Default.(string)
+	// impersonationsession.AdminEmailValidator is a validator for the "admin_email" field. It is called by the builders before save.
+	impersonationsession.AdminEmailValidator = impersonationsessionDescAdminEmail.Validators[0].(func(string) error)
+	// impersonationsessionDescReason is the schema descriptor for reason field.
+	impersonationsessionDescReason := impersonationsessionFields[3].Descriptor()
+	// impersonationsession.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	impersonationsession.ReasonValidator = impersonationsessionDescReason.Validators[0].(func(string) error)
+	// impersonationsessionDescJti is the schema descriptor for jti field.
+	impersonationsessionDescJti := impersonationsessionFields[4].Descriptor()
+	// impersonationsession.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	impersonationsession.JtiValidator = func() func(string) error {
+		validators := impersonationsessionDescJti.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(jti string) error {
+			for _, fn := range fns {
+				if err := fn(jti); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// impersonationsessionDescIPAddress is the schema descriptor for ip_address field.
+	impersonationsessionDescIPAddress := impersonationsessionFields[8].Descriptor()
+	// impersonationsession.DefaultIPAddress holds the default value on creation for the ip_address field.
+	impersonationsession.DefaultIPAddress = impersonationsessionDescIPAddress.Default.(string)
+	// impersonationsession.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	impersonationsession.IPAddressValidator = impersonationsessionDescIPAddress.Validators[0].(func(string) error)
+	// impersonationsessionDescUserAgent is the schema descriptor for user_agent field.
+	impersonationsessionDescUserAgent := impersonationsessionFields[9].Descriptor()
+	// impersonationsession.DefaultUserAgent holds the default value on creation for the user_agent field.
+	impersonationsession.DefaultUserAgent = impersonationsessionDescUserAgent.Default.(string)
+	// impersonationsession.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	impersonationsession.UserAgentValidator = impersonationsessionDescUserAgent.Validators[0].(func(string) error)
+	// impersonationsessionDescRequestCount is the schema descriptor for request_count field.
+	impersonationsessionDescRequestCount := impersonationsessionFields[11].Descriptor()
+	// impersonationsession.DefaultRequestCount holds the default value on creation for the request_count field.
+	impersonationsession.DefaultRequestCount = impersonationsessionDescRequestCount.Default.(int)
 	marketpurchaseorderMixin := schema.MarketPurchaseOrder{}.Mixin()
 	marketpurchaseorderMixinFields0 := marketpurchaseorderMixin[0].Fields()
 	_ = marketpurchaseorderMixinFields0
@@ -866,6 +1662,114 @@ func init() {
 			return nil
 		}
 	}()
+	reputationeventMixin := schema.ReputationEvent{}.Mixin()
+	reputationeventMixinFields0 := reputationeventMixin[0].Fields()
+	_ = reputationeventMixinFields0
+	reputationeventFields := schema.ReputationEvent{}.Fields()
+	_ = reputationeventFields
+	// reputationeventDescCreatedAt is the schema descriptor for created_at field.
+	reputationeventDescCreatedAt := reputationeventMixinFields0[0].Descriptor()
+	// reputationevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reputationevent.DefaultCreatedAt = reputationeventDescCreatedAt.Default.(func() time.Time)
+	// reputationeventDescUpdatedAt is the schema descriptor for updated_at field.
+	reputationeventDescUpdatedAt := reputationeventMixinFields0[1].Descriptor()
+	// reputationevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reputationevent.DefaultUpdatedAt = reputationeventDescUpdatedAt.Default.(func() time.Time)
+	// reputationevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reputationevent.UpdateDefaultUpdatedAt = reputationeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reputationeventDescValidatorUserID is the schema descriptor for validator_user_id field.
+	reputationeventDescValidatorUserID := reputationeventFields[0].Descriptor()
+	// reputationevent.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	reputationevent.ValidatorUserIDValidator = reputationeventDescValidatorUserID.Validators[0].(func(int) error)
+	// reputationeventDescValidationCaseID is the schema descriptor for validation_case_id field.
+	reputationeventDescValidationCaseID := reputationeventFields[1].Descriptor()
+	// reputationevent.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	reputationevent.ValidationCaseIDValidator = reputationeventDescValidationCaseID.Validators[0].(func(int) error)
+	// reputationeventDescWorkflowCycle is the schema descriptor for workflow_cycle field.
+	reputationeventDescWorkflowCycle := reputationeventFields[2].Descriptor()
+	// reputationevent.DefaultWorkflowCycle holds the default value on creation for the workflow_cycle field.
+	reputationevent.DefaultWorkflowCycle = reputationeventDescWorkflowCycle.Default.(int)
+	// reputationevent.WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	reputationevent.WorkflowCycleValidator = reputationeventDescWorkflowCycle.Validators[0].(func(int) error)
+	// reputationeventDescKind is the schema descriptor for kind field.
+	reputationeventDescKind := reputationeventFields[3].Descriptor()
+	// reputationevent.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	reputationevent.KindValidator = reputationeventDescKind.Validators[0].(func(string) error)
+	// reputationeventDescDedupeKey is the schema descriptor for dedupe_key field.
+	reputationeventDescDedupeKey := reputationeventFields[6].Descriptor()
+	// reputationevent.DedupeKeyValidator is a validator for the "dedupe_key" field. It is called by the builders before save.
+	reputationevent.DedupeKeyValidator = reputationeventDescDedupeKey.Validators[0].(func(string) error)
+	slapolicyMixin := schema.SLAPolicy{}.Mixin()
+	slapolicyMixinFields0 := slapolicyMixin[0].Fields()
+	_ = slapolicyMixinFields0
+	slapolicyFields := schema.SLAPolicy{}.Fields()
+	_ = slapolicyFields
+	// slapolicyDescCreatedAt is the schema descriptor for created_at field.
+	slapolicyDescCreatedAt := slapolicyMixinFields0[0].Descriptor()
+	// slapolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	slapolicy.DefaultCreatedAt = slapolicyDescCreatedAt.Default.(func() time.Time)
+	// slapolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	slapolicyDescUpdatedAt := slapolicyMixinFields0[1].Descriptor()
+	// slapolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	slapolicy.DefaultUpdatedAt = slapolicyDescUpdatedAt.Default.(func() time.Time)
+	// slapolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	slapolicy.UpdateDefaultUpdatedAt = slapolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// slapolicyDescName is the schema descriptor for name field.
+	slapolicyDescName := slapolicyFields[0].Descriptor()
+	// slapolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	slapolicy.NameValidator = func() func(string) error {
+		validators := slapolicyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// slapolicyDescSensitivityLevel is the schema descriptor for sensitivity_level field.
+	slapolicyDescSensitivityLevel := slapolicyFields[1].Descriptor()
+	// slapolicy.DefaultSensitivityLevel holds the default value on creation for the sensitivity_level field.
+	slapolicy.DefaultSensitivityLevel = slapolicyDescSensitivityLevel.Default.(string)
+	// slapolicy.SensitivityLevelValidator is a validator for the "sensitivity_level" field. It is called by the builders before save.
+	slapolicy.SensitivityLevelValidator = slapolicyDescSensitivityLevel.Validators[0].(func(string) error)
+	// slapolicyDescOwnerResponseHours is the schema descriptor for owner_response_hours field.
+	slapolicyDescOwnerResponseHours := slapolicyFields[3].Descriptor()
+	// slapolicy.DefaultOwnerResponseHours holds the default value on creation for the owner_response_hours field.
+	slapolicy.DefaultOwnerResponseHours = slapolicyDescOwnerResponseHours.Default.(int)
+	// slapolicy.OwnerResponseHoursValidator is a validator for the "owner_response_hours" field. It is called by the builders before save.
+	slapolicy.OwnerResponseHoursValidator = slapolicyDescOwnerResponseHours.Validators[0].(func(int) error)
+	// slapolicyDescBusinessHoursOnly is the schema descriptor for business_hours_only field.
+	slapolicyDescBusinessHoursOnly := slapolicyFields[5].Descriptor()
+	// slapolicy.DefaultBusinessHoursOnly holds the default value on creation for the business_hours_only field.
+	slapolicy.DefaultBusinessHoursOnly = slapolicyDescBusinessHoursOnly.Default.(bool)
+	// slapolicyDescBusinessDayStartHour is the schema descriptor for business_day_start_hour field.
+	slapolicyDescBusinessDayStartHour := slapolicyFields[6].Descriptor()
+	// slapolicy.DefaultBusinessDayStartHour holds the default value on creation for the business_day_start_hour field.
+	slapolicy.DefaultBusinessDayStartHour = slapolicyDescBusinessDayStartHour.Default.(int)
+	// slapolicy.BusinessDayStartHourValidator is a validator for the "business_day_start_hour" field. It is called by the builders before save.
+	slapolicy.BusinessDayStartHourValidator = slapolicyDescBusinessDayStartHour.Validators[0].(func(int) error)
+	// slapolicyDescBusinessDayEndHour is the schema descriptor for business_day_end_hour field.
+	slapolicyDescBusinessDayEndHour := slapolicyFields[7].Descriptor()
+	// slapolicy.DefaultBusinessDayEndHour holds the default value on creation for the business_day_end_hour field.
+	slapolicy.DefaultBusinessDayEndHour = slapolicyDescBusinessDayEndHour.Default.(int)
+	// slapolicy.BusinessDayEndHourValidator is a validator for the "business_day_end_hour" field. It is called by the builders before save.
+	slapolicy.BusinessDayEndHourValidator = slapolicyDescBusinessDayEndHour.Validators[0].(func(int) error)
+	// slapolicyDescTimezone is the schema descriptor for timezone field.
+	slapolicyDescTimezone := slapolicyFields[8].Descriptor()
+	// slapolicy.DefaultTimezone holds the default value on creation for the timezone field.
+	slapolicy.DefaultTimezone = slapolicyDescTimezone.Default.(string)
+	// slapolicy.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	slapolicy.TimezoneValidator = slapolicyDescTimezone.Validators[0].(func(string) error)
+	// slapolicyDescIsActive is the schema descriptor for is_active field.
+	slapolicyDescIsActive := slapolicyFields[9].Descriptor()
+	// slapolicy.DefaultIsActive holds the default value on creation for the is_active field.
+	slapolicy.DefaultIsActive = slapolicyDescIsActive.Default.(bool)
 	securityeventMixin := schema.SecurityEvent{}.Mixin()
 	securityeventMixinFields0 := securityeventMixin[0].Fields()
 	_ = securityeventMixinFields0
@@ -1010,6 +1914,10 @@ func init() {
 	sessionDescIsUsed := sessionFields[10].Descriptor()
 	// session.DefaultIsUsed holds the default value on creation for the is_used field.
 	session.DefaultIsUsed = sessionDescIsUsed.Default.(bool)
+	// sessionDescDeviceFingerprintHash is the schema descriptor for device_fingerprint_hash field.
+	sessionDescDeviceFingerprintHash := sessionFields[11].Descriptor()
+	// session.DeviceFingerprintHashValidator is a validator for the "device_fingerprint_hash" field. It is called by the builders before save.
+	session.DeviceFingerprintHashValidator = sessionDescDeviceFingerprintHash.Validators[0].(func(string) error)
 	sessionlockMixin := schema.SessionLock{}.Mixin()
 	sessionlockMixinFields0 := sessionlockMixin[0].Fields()
 	_ = sessionlockMixinFields0
@@ -1117,6 +2025,14 @@ func init() {
 	totppendingtokenDescTokenHash := totppendingtokenFields[1].Descriptor()
 	// totppendingtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
 	totppendingtoken.TokenHashValidator = totppendingtokenDescTokenHash.Validators[0].(func(string) error)
+	// totppendingtokenDescPurpose is the schema descriptor for purpose field.
+	totppendingtokenDescPurpose := totppendingtokenFields[4].Descriptor()
+	// totppendingtoken.DefaultPurpose holds the default value on creation for the purpose field.
+	totppendingtoken.DefaultPurpose = totppendingtokenDescPurpose.Default.(string)
+	// totppendingtokenDescCodeAttempts is the schema descriptor for code_attempts field.
+	totppendingtokenDescCodeAttempts := totppendingtokenFields[6].Descriptor()
+	// totppendingtoken.DefaultCodeAttempts holds the default value on creation for the code_attempts field.
+	totppendingtoken.DefaultCodeAttempts = totppendingtokenDescCodeAttempts.Default.(int)
 	tagMixin := schema.Tag{}.Mixin()
 	tagMixinFields0 := tagMixin[0].Fields()
 	_ = tagMixinFields0
@@ -1367,8 +2283,12 @@ func init() {
 	validationcase.DefaultWorkflowCycle = validationcaseDescWorkflowCycle.Default.(int)
 	// validationcase.WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
 	validationcase.WorkflowCycleValidator = validationcaseDescWorkflowCycle.Validators[0].(func(int) error)
+	// validationcaseDescTelegramRevealDisabled is the schema descriptor for telegram_reveal_disabled field.
+	validationcaseDescTelegramRevealDisabled := validationcaseFields[14].Descriptor()
+	// validationcase.DefaultTelegramRevealDisabled holds the default value on creation for the telegram_reveal_disabled field.
+	validationcase.DefaultTelegramRevealDisabled = validationcaseDescTelegramRevealDisabled.Default.(bool)
 	// validationcaseDescAcceptedFinalOfferID is the schema descriptor for accepted_final_offer_id field.
-	validationcaseDescAcceptedFinalOfferID := validationcaseFields[16].Descriptor()
+	validationcaseDescAcceptedFinalOfferID := validationcaseFields[17].Descriptor()
 	// validationcase.AcceptedFinalOfferIDValidator is a validator for the "accepted_final_offer_id" field. It is called by the builders before save.
 	validationcase.AcceptedFinalOfferIDValidator = validationcaseDescAcceptedFinalOfferID.Validators[0].(func(int) error)
 	validationcaselogMixin := schema.ValidationCaseLog{}.Mixin()
@@ -1412,4 +2332,179 @@ func init() {
 			return nil
 		}
 	}()
+	validatordeliveryslaMixin := schema.ValidatorDeliverySLA{}.Mixin()
+	validatordeliveryslaMixinFields0 := validatordeliveryslaMixin[0].Fields()
+	_ = validatordeliveryslaMixinFields0
+	validatordeliveryslaFields := schema.ValidatorDeliverySLA{}.Fields()
+	_ = validatordeliveryslaFields
+	// validatordeliveryslaDescCreatedAt is the schema descriptor for created_at field.
+	validatordeliveryslaDescCreatedAt := validatordeliveryslaMixinFields0[0].Descriptor()
+	// validatordeliverysla.DefaultCreatedAt holds the default value on creation for the created_at field.
+	validatordeliverysla.DefaultCreatedAt = validatordeliveryslaDescCreatedAt.Default.(func() time.Time)
+	// validatordeliveryslaDescUpdatedAt is the schema descriptor for updated_at field.
+	validatordeliveryslaDescUpdatedAt := validatordeliveryslaMixinFields0[1].Descriptor()
+	// validatordeliverysla.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	validatordeliverysla.DefaultUpdatedAt = validatordeliveryslaDescUpdatedAt.Default.(func() time.Time)
+	// validatordeliverysla.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	validatordeliverysla.UpdateDefaultUpdatedAt = validatordeliveryslaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// validatordeliveryslaDescValidationCaseID is the schema descriptor for validation_case_id field.
+	validatordeliveryslaDescValidationCaseID := validatordeliveryslaFields[0].Descriptor()
+	// validatordeliverysla.ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	validatordeliverysla.ValidationCaseIDValidator = validatordeliveryslaDescValidationCaseID.Validators[0].(func(int) error)
+	// validatordeliveryslaDescFinalOfferID is the schema descriptor for final_offer_id field.
+	validatordeliveryslaDescFinalOfferID := validatordeliveryslaFields[1].Descriptor()
+	// validatordeliverysla.FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	validatordeliverysla.FinalOfferIDValidator = validatordeliveryslaDescFinalOfferID.Validators[0].(func(int) error)
+	// validatordeliveryslaDescValidatorUserID is the schema descriptor for validator_user_id field.
+	validatordeliveryslaDescValidatorUserID := validatordeliveryslaFields[3].Descriptor()
+	// validatordeliverysla.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	validatordeliverysla.ValidatorUserIDValidator = validatordeliveryslaDescValidatorUserID.Validators[0].(func(int) error)
+	// validatordeliveryslaDescWorkflowCycle is the schema descriptor for workflow_cycle field.
+	validatordeliveryslaDescWorkflowCycle := validatordeliveryslaFields[4].Descriptor()
+	// validatordeliverysla.DefaultWorkflowCycle holds the default value on creation for the workflow_cycle field.
+	validatordeliverysla.DefaultWorkflowCycle = validatordeliveryslaDescWorkflowCycle.Default.(int)
+	// validatordeliverysla.WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	validatordeliverysla.WorkflowCycleValidator = validatordeliveryslaDescWorkflowCycle.Validators[0].(func(int) error)
+	// validatordeliveryslaDescEscrowTransferID is the schema descriptor for escrow_transfer_id field.
+	validatordeliveryslaDescEscrowTransferID := validatordeliveryslaFields[5].Descriptor()
+	// validatordeliverysla.EscrowTransferIDValidator is a validator for the "escrow_transfer_id" field. It is called by the builders before save.
+	validatordeliverysla.EscrowTransferIDValidator = validatordeliveryslaDescEscrowTransferID.Validators[0].(func(string) error)
+	// validatordeliveryslaDescHoldHours is the schema descriptor for hold_hours field.
+	validatordeliveryslaDescHoldHours := validatordeliveryslaFields[6].Descriptor()
+	// validatordeliverysla.HoldHoursValidator is a validator for the "hold_hours" field. It is called by the builders before save.
+	validatordeliverysla.HoldHoursValidator = validatordeliveryslaDescHoldHours.Validators[0].(func(int) error)
+	// validatordeliveryslaDescReminderCount is the schema descriptor for reminder_count field.
+	validatordeliveryslaDescReminderCount := validatordeliveryslaFields[10].Descriptor()
+	// validatordeliverysla.DefaultReminderCount holds the default value on creation for the reminder_count field.
+	validatordeliverysla.DefaultReminderCount = validatordeliveryslaDescReminderCount.Default.(int)
+	// validatordeliverysla.ReminderCountValidator is a validator for the "reminder_count" field. It is called by the builders before save.
+	validatordeliverysla.ReminderCountValidator = validatordeliveryslaDescReminderCount.Validators[0].(func(int) error)
+	// validatordeliveryslaDescStatus is the schema descriptor for status field.
+	validatordeliveryslaDescStatus := validatordeliveryslaFields[11].Descriptor()
+	// validatordeliverysla.DefaultStatus holds the default value on creation for the status field.
+	validatordeliverysla.DefaultStatus = validatordeliveryslaDescStatus.Default.(string)
+	// validatordeliverysla.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	validatordeliverysla.StatusValidator = validatordeliveryslaDescStatus.Validators[0].(func(string) error)
+	// validatordeliveryslaDescRecommendation is the schema descriptor for recommendation field.
+	validatordeliveryslaDescRecommendation := validatordeliveryslaFields[16].Descriptor()
+	// validatordeliverysla.RecommendationValidator is a validator for the "recommendation" field. It is called by the builders before save.
+	validatordeliverysla.RecommendationValidator = validatordeliveryslaDescRecommendation.Validators[0].(func(string) error)
+	validatormatchingaggregateMixin := schema.ValidatorMatchingAggregate{}.Mixin()
+	validatormatchingaggregateMixinFields0 := validatormatchingaggregateMixin[0].Fields()
+	_ = validatormatchingaggregateMixinFields0
+	validatormatchingaggregateFields := schema.ValidatorMatchingAggregate{}.Fields()
+	_ = validatormatchingaggregateFields
+	// validatormatchingaggregateDescCreatedAt is the schema descriptor for created_at field.
+	validatormatchingaggregateDescCreatedAt := validatormatchingaggregateMixinFields0[0].Descriptor()
+	// validatormatchingaggregate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	validatormatchingaggregate.DefaultCreatedAt = validatormatchingaggregateDescCreatedAt.Default.(func() time.Time)
+	// validatormatchingaggregateDescUpdatedAt is the schema descriptor for updated_at field.
+	validatormatchingaggregateDescUpdatedAt := validatormatchingaggregateMixinFields0[1].Descriptor()
+	// validatormatchingaggregate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	validatormatchingaggregate.DefaultUpdatedAt = validatormatchingaggregateDescUpdatedAt.Default.(func() time.Time)
+	// validatormatchingaggregate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	validatormatchingaggregate.UpdateDefaultUpdatedAt = validatormatchingaggregateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// validatormatchingaggregateDescValidatorUserID is the schema descriptor for validator_user_id field.
+	validatormatchingaggregateDescValidatorUserID := validatormatchingaggregateFields[0].Descriptor()
+	// validatormatchingaggregate.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	validatormatchingaggregate.ValidatorUserIDValidator = validatormatchingaggregateDescValidatorUserID.Validators[0].(func(int) error)
+	// validatormatchingaggregateDescHistoryCaseCount is the schema descriptor for history_case_count field.
+	validatormatchingaggregateDescHistoryCaseCount := validatormatchingaggregateFields[3].Descriptor()
+	// validatormatchingaggregate.DefaultHistoryCaseCount holds the default value on creation for the history_case_count field.
+	validatormatchingaggregate.DefaultHistoryCaseCount = validatormatchingaggregateDescHistoryCaseCount.Default.(int)
+	// validatormatchingaggregate.HistoryCaseCountValidator is a validator for the "history_case_count" field. It is called by the builders before save.
+	validatormatchingaggregate.HistoryCaseCountValidator = validatormatchingaggregateDescHistoryCaseCount.Validators[0].(func(int) error)
+	// validatormatchingaggregateDescDisputedCaseCount is the schema descriptor for disputed_case_count field.
+	validatormatchingaggregateDescDisputedCaseCount := validatormatchingaggregateFields[4].Descriptor()
+	// validatormatchingaggregate.DefaultDisputedCaseCount holds the default value on creation for the disputed_case_count field.
+	validatormatchingaggregate.DefaultDisputedCaseCount = validatormatchingaggregateDescDisputedCaseCount.Default.(int)
+	// validatormatchingaggregate.DisputedCaseCountValidator is a validator for the "disputed_case_count" field. It is called by the builders before save.
+	validatormatchingaggregate.DisputedCaseCountValidator = validatormatchingaggregateDescDisputedCaseCount.Validators[0].(func(int) error)
+	// validatormatchingaggregateDescApprovedConsultationCount is the schema descriptor for approved_consultation_count field.
+	validatormatchingaggregateDescApprovedConsultationCount := validatormatchingaggregateFields[5].Descriptor()
+	// validatormatchingaggregate.DefaultApprovedConsultationCount holds the default value on creation for the approved_consultation_count field.
+	validatormatchingaggregate.DefaultApprovedConsultationCount = validatormatchingaggregateDescApprovedConsultationCount.Default.(int)
+	// validatormatchingaggregate.ApprovedConsultationCountValidator is a validator for the "approved_consultation_count" field. It is called by the builders before save.
+	validatormatchingaggregate.ApprovedConsultationCountValidator = validatormatchingaggregateDescApprovedConsultationCount.Validators[0].(func(int) error)
+	// validatormatchingaggregateDescDeliverySLACount is the schema descriptor for delivery_sla_count field.
+	validatormatchingaggregateDescDeliverySLACount := validatormatchingaggregateFields[6].Descriptor()
+	// validatormatchingaggregate.DefaultDeliverySLACount holds the default value on creation for the delivery_sla_count field.
+	validatormatchingaggregate.DefaultDeliverySLACount = validatormatchingaggregateDescDeliverySLACount.Default.(int)
+	// validatormatchingaggregate.DeliverySLACountValidator is a validator for the "delivery_sla_count" field. It is called by the builders before save.
+	validatormatchingaggregate.DeliverySLACountValidator = validatormatchingaggregateDescDeliverySLACount.Validators[0].(func(int) error)
+	// validatormatchingaggregateDescResponsivenessScore is the schema descriptor for responsiveness_score field.
+	validatormatchingaggregateDescResponsivenessScore := validatormatchingaggregateFields[7].Descriptor()
+	// validatormatchingaggregate.DefaultResponsivenessScore holds the default value on creation for the responsiveness_score field.
+	validatormatchingaggregate.DefaultResponsivenessScore = validatormatchingaggregateDescResponsivenessScore.Default.(int)
+	// validatormatchingaggregate.ResponsivenessScoreValidator is a validator for the "responsiveness_score" field. It is called by the builders before save.
+	validatormatchingaggregate.ResponsivenessScoreValidator = func() func(int) error {
+		validators := validatormatchingaggregateDescResponsivenessScore.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(responsiveness_score int) error {
+			for _, fn := range fns {
+				if err := fn(responsiveness_score); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	validatorreputationMixin := schema.ValidatorReputation{}.Mixin()
+	validatorreputationMixinFields0 := validatorreputationMixin[0].Fields()
+	_ = validatorreputationMixinFields0
+	validatorreputationFields := schema.ValidatorReputation{}.Fields()
+	_ = validatorreputationFields
+	// validatorreputationDescCreatedAt is the schema descriptor for created_at field.
+	validatorreputationDescCreatedAt := validatorreputationMixinFields0[0].Descriptor()
+	// validatorreputation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	validatorreputation.DefaultCreatedAt = validatorreputationDescCreatedAt.Default.(func() time.Time)
+	// validatorreputationDescUpdatedAt is the schema descriptor for updated_at field.
+	validatorreputationDescUpdatedAt := validatorreputationMixinFields0[1].Descriptor()
+	// validatorreputation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	validatorreputation.DefaultUpdatedAt = validatorreputationDescUpdatedAt.Default.(func() time.Time)
+	// validatorreputation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	validatorreputation.UpdateDefaultUpdatedAt = validatorreputationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// validatorreputationDescValidatorUserID is the schema descriptor for validator_user_id field.
+	validatorreputationDescValidatorUserID := validatorreputationFields[0].Descriptor()
+	// validatorreputation.ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	validatorreputation.ValidatorUserIDValidator = validatorreputationDescValidatorUserID.Validators[0].(func(int) error)
+	// validatorreputationDescDomainSlug is the schema descriptor for domain_slug field.
+	validatorreputationDescDomainSlug := validatorreputationFields[1].Descriptor()
+	// validatorreputation.DefaultDomainSlug holds the default value on creation for the domain_slug field.
+	validatorreputation.DefaultDomainSlug = validatorreputationDescDomainSlug.Default.(string)
+	// validatorreputation.DomainSlugValidator is a validator for the "domain_slug" field. It is called by the builders before save.
+	validatorreputation.DomainSlugValidator = validatorreputationDescDomainSlug.Validators[0].(func(string) error)
+	// validatorreputationDescScore is the schema descriptor for score field.
+	validatorreputationDescScore := validatorreputationFields[2].Descriptor()
+	// validatorreputation.DefaultScore holds the default value on creation for the score field.
+	validatorreputation.DefaultScore = validatorreputationDescScore.Default.(int)
+	// validatorreputation.ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	validatorreputation.ScoreValidator = func() func(int) error {
+		validators := validatorreputationDescScore.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(score int) error {
+			for _, fn := range fns {
+				if err := fn(score); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// validatorreputationDescDecayedPoints is the schema descriptor for decayed_points field.
+	validatorreputationDescDecayedPoints := validatorreputationFields[3].Descriptor()
+	// validatorreputation.DefaultDecayedPoints holds the default value on creation for the decayed_points field.
+	validatorreputation.DefaultDecayedPoints = validatorreputationDescDecayedPoints.Default.(float64)
+	// validatorreputationDescEventCount is the schema descriptor for event_count field.
+	validatorreputationDescEventCount := validatorreputationFields[4].Descriptor()
+	// validatorreputation.DefaultEventCount holds the default value on creation for the event_count field.
+	validatorreputation.DefaultEventCount = validatorreputationDescEventCount.Default.(int)
+	// validatorreputation.EventCountValidator is a validator for the "event_count" field. It is called by the builders before save.
+	validatorreputation.EventCountValidator = validatorreputationDescEventCount.Validators[0].(func(int) error)
 }
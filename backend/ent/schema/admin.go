@@ -37,6 +37,28 @@ func (Admin) Fields() []ent.Field {
 			Sensitive(),
 		field.String("name").
 			NotEmpty(),
+		// Second factors. An admin without TOTP or a passkey only gets enrollment access.
+		field.String("totp_secret").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Bool("totp_enabled").
+			Default(false),
+		field.Time("totp_enabled_at").
+			Optional().
+			Nillable(),
+		// Last accepted TOTP time step, so a code cannot be replayed within its window
+		field.Int64("totp_last_step").
+			Default(0),
+		// Lockout after repeated failed password or second-factor attempts
+		field.Int("failed_login_attempts").
+			Default(0),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		field.Time("last_login_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -44,6 +66,10 @@ func (Admin) Fields() []ent.Field {
 func (Admin) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("granted_badges", UserBadge.Type),
+		edge.To("roles", AdminRole.Type).
+			StorageKey(edge.Table("admin_role_assignments"), edge.Columns("admin_id", "admin_role_id")),
+		edge.To("sessions", AdminSession.Type),
+		edge.To("passkeys", AdminPasskey.Type),
 	}
 }
 
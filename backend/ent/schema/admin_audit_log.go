@@ -0,0 +1,110 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminAuditLog is an append-only record of a mutating admin request. Rows are written by
+// middleware.AdminAuditMiddleware and hash-chained on insert; updates and deletes are rejected
+// by an Ent hook and a database trigger.
+type AdminAuditLog struct {
+	ent.Schema
+}
+
+func (AdminAuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "admin_audit_logs"},
+	}
+}
+
+func (AdminAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Int("admin_id").
+			Immutable(),
+		field.String("admin_email").
+			MaxLen(255).
+			Default("").
+			Immutable(),
+		// Route template, e.g. "DELETE /admin/badges/:id"
+		field.String("action").
+			MaxLen(200).
+			NotEmpty().
+			Immutable(),
+		field.String("method").
+			MaxLen(10).
+			Immutable(),
+		field.String("path").
+			MaxLen(512).
+			Immutable(),
+		// First entity changed by the request (e.g. "Badge", "42"); empty when nothing changed
+		field.String("target_type").
+			MaxLen(64).
+			Default("").
+			Immutable(),
+		field.String("target_id").
+			MaxLen(64).
+			Default("").
+			Immutable(),
+		// Entity changes captured by the Ent hook: entity, op, ids, before, after, edges.
+		// before holds the old values of the changed fields (update_one) or the affected rows
+		// (update, delete, delete_one; before_truncated counts rows beyond the first 50).
+		field.JSON("changes", []map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.String("request_id").
+			MaxLen(64).
+			Default("").
+			Immutable(),
+		field.String("ip_address").
+			MaxLen(45).
+			Default("").
+			Immutable(),
+		field.String("user_agent").
+			MaxLen(512).
+			Default("").
+			Immutable(),
+		field.Text("reason").
+			Default("").
+			Immutable(),
+		field.Int("status_code").
+			Immutable(),
+		field.Bool("success").
+			Default(false).
+			Immutable(),
+		// Hash chain set on insert by services.AdminAuditChainHook. seq is the position in the
+		// chain (1 for the first row); hash = sha256(prev_hash + record), where prev_hash is the
+		// hash of row seq-1 ("" for the first). Commit order can differ from ID order, so the
+		// chain follows seq.
+		field.Int("seq").
+			Positive().
+			Immutable(),
+		field.String("prev_hash").
+			MaxLen(64).
+			Immutable(),
+		field.String("hash").
+			MaxLen(64).
+			Immutable(),
+	}
+}
+
+func (AdminAuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_id", "created_at"),
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+		index.Fields("request_id"),
+		index.Fields("created_at"),
+		// At most one row per chain position, so concurrent inserts cannot fork the chain
+		index.Fields("seq").
+			Unique(),
+	}
+}
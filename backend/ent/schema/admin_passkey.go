@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminPasskey is a WebAuthn credential registered by an admin. It mirrors Passkey but is
+// kept separate so admin and user credentials can never satisfy each other's ceremonies.
+type AdminPasskey struct {
+	ent.Schema
+}
+
+func (AdminPasskey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "admin_passkeys"},
+	}
+}
+
+func (AdminPasskey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the AdminPasskey.
+func (AdminPasskey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("admin_id").
+			Positive(),
+		field.Bytes("credential_id").
+			Unique().
+			NotEmpty(),
+		field.Bytes("public_key").
+			NotEmpty(),
+		field.String("attestation_type").
+			NotEmpty(),
+		field.Bytes("aaguid").
+			Optional(),
+		field.Uint32("sign_count").
+			Default(0),
+		field.Bool("backup_eligible").
+			Default(false),
+		field.Bool("backup_state").
+			Default(false),
+		field.String("name").
+			Default("Passkey"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.JSON("transports", []string{}).
+			Optional(),
+	}
+}
+
+// Edges of the AdminPasskey.
+func (AdminPasskey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("admin", Admin.Type).
+			Ref("passkeys").
+			Field("admin_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (AdminPasskey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_id"),
+		index.Fields("credential_id").Unique(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminRole holds the schema definition for the AdminRole entity.
+// A role is a named set of admin permissions (e.g. "users:read", "cases:move").
+type AdminRole struct {
+	ent.Schema
+}
+
+func (AdminRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "admin_roles"},
+	}
+}
+
+func (AdminRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the AdminRole.
+func (AdminRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(64).
+			Unique(),
+		field.String("description").
+			Optional().
+			Default("").
+			MaxLen(255),
+		field.JSON("permissions", []string{}).
+			Default([]string{}),
+		// Built-in roles are re-synced at startup and cannot be edited through the API
+		field.Bool("is_system").
+			Default(false),
+	}
+}
+
+// Edges of the AdminRole.
+func (AdminRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("admins", Admin.Type).
+			Ref("roles"),
+	}
+}
+
+func (AdminRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdminSession is a server-side admin login. The admin JWT carries its session_id, so
+// revoking the row or letting it idle out invalidates the token immediately.
+type AdminSession struct {
+	ent.Schema
+}
+
+func (AdminSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "admin_sessions"},
+	}
+}
+
+func (AdminSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the AdminSession.
+func (AdminSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("admin_id").
+			Positive(),
+		field.String("session_id").
+			Unique().
+			MaxLen(64).
+			NotEmpty(),
+		field.String("ip_address").
+			Optional().
+			MaxLen(45),
+		field.String("user_agent").
+			Optional().
+			MaxLen(512),
+		// False until the admin passes (or enrolls) a second factor
+		field.Bool("mfa_verified").
+			Default(false),
+		field.String("mfa_method").
+			Optional().
+			MaxLen(16),
+		field.Time("expires_at"),
+		field.Time("last_activity_at"),
+		// Admin sudo is bound to the session rather than a separate token
+		field.Time("sudo_until").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.String("revoke_reason").
+			Optional().
+			MaxLen(64),
+	}
+}
+
+// Edges of the AdminSession.
+func (AdminSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("admin", Admin.Type).
+			Ref("sessions").
+			Field("admin_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (AdminSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id").Unique(),
+		index.Fields("admin_id", "revoked_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CaseClarification is one question or proposed assumption from an approved validator to
+// the case owner. While it is pending the consultation request waits on the owner-response
+// SLA; the owner's answer or decision closes it.
+type CaseClarification struct {
+	ent.Schema
+}
+
+func (CaseClarification) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "case_clarifications"},
+	}
+}
+
+func (CaseClarification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (CaseClarification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("consultation_request_id").
+			Positive(),
+		field.Int("validator_user_id").
+			Positive(),
+		field.Int("workflow_cycle").
+			Positive().
+			Default(1),
+		// kind: question | assumption
+		field.String("kind").
+			MaxLen(16),
+		field.String("body").
+			MaxLen(4000),
+		// status: pending | answered | approved | rejected | expired
+		field.String("status").
+			MaxLen(32).
+			Default("pending"),
+		field.String("answer").
+			MaxLen(4000).
+			Optional().
+			Default(""),
+		field.Int("answered_by_user_id").
+			Optional().
+			Nillable(),
+		field.Time("answered_at").
+			Optional().
+			Nillable(),
+		field.Time("expired_at").
+			Optional().
+			Nillable(),
+		// shared makes the answered exchange visible to every approved validator on the cycle.
+		field.Bool("shared").
+			Default(false),
+	}
+}
+
+func (CaseClarification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validation_case_id", "workflow_cycle"),
+		index.Fields("consultation_request_id", "status"),
+		index.Fields("validator_user_id"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CaseConversation is the private message thread between a case owner and one validator.
+// It spans workflow cycles so the whole exchange stays available as dispute evidence.
+type CaseConversation struct {
+	ent.Schema
+}
+
+func (CaseConversation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "case_conversations"},
+	}
+}
+
+func (CaseConversation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (CaseConversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("owner_user_id").
+			Positive(),
+		field.Int("validator_user_id").
+			Positive(),
+		field.Time("last_message_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (CaseConversation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("messages", CaseMessage.Type),
+	}
+}
+
+func (CaseConversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validation_case_id", "validator_user_id").
+			Unique(),
+		index.Fields("validator_user_id"),
+	}
+}
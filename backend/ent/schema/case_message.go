@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CaseMessage is one message in a CaseConversation. Rows are append-only apart from read_at,
+// which the recipient sets when they read the thread.
+type CaseMessage struct {
+	ent.Schema
+}
+
+func (CaseMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "case_messages"},
+	}
+}
+
+func (CaseMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (CaseMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("conversation_id").
+			Positive(),
+		field.Int("sender_user_id").
+			Positive(),
+		field.String("body").
+			MaxLen(4000).
+			Optional().
+			Default(""),
+		// Feature Service document IDs, shared with the recipient when the message is sent.
+		field.JSON("attachment_document_ids", []string{}).
+			Optional(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (CaseMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("conversation", CaseConversation.Type).
+			Ref("messages").
+			Field("conversation_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (CaseMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "created_at"),
+	}
+}
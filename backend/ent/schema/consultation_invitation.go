@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConsultationInvitation is an owner's invitation for a specific validator to consult on a
+// Validation Case cycle. Accepting it creates an already approved ConsultationRequest.
+type ConsultationInvitation struct {
+	ent.Schema
+}
+
+func (ConsultationInvitation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "consultation_invitations"},
+	}
+}
+
+func (ConsultationInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ConsultationInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("owner_user_id").
+			Positive(),
+		field.Int("validator_user_id").
+			Positive(),
+		field.Int("workflow_cycle").
+			Positive().
+			Default(1),
+		// status: pending | accepted | declined | revoked | expired
+		field.String("status").
+			MaxLen(32).
+			Default("pending"),
+		field.String("message").
+			MaxLen(1000).
+			Optional().
+			Default(""),
+		field.Time("expires_at"),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+		// Set when the invitation is accepted.
+		field.Int("consultation_request_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ConsultationInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validation_case_id", "workflow_cycle"),
+		index.Fields("validator_user_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
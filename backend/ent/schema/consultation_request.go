@@ -57,6 +57,12 @@ func (ConsultationRequest) Fields() []ent.Field {
 			MaxLen(128).
 			Optional().
 			Nillable(),
+		// Owner-response SLA policy in force when the request was created (see SLAPolicy).
+		field.JSON("sla_policy_snapshot", map[string]interface{}{}).
+			Optional(),
+		field.Time("owner_response_started_at").
+			Optional().
+			Nillable(),
 	}
 }
 
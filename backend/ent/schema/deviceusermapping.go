@@ -40,6 +40,27 @@ func (DeviceUserMapping) Fields() []ent.Field {
 			Default(time.Now),
 		field.Time("last_seen_at").
 			Default(time.Now),
+		field.String("last_ip").
+			Optional().
+			MaxLen(45),
+		field.String("last_user_agent").
+			Optional().
+			MaxLen(512),
+		// User-managed metadata (account device list)
+		field.String("name").
+			Optional().
+			Nillable().
+			MaxLen(64),
+		field.Bool("trusted").
+			Default(false),
+		field.Time("trusted_at").
+			Optional().
+			Nillable(),
+		// removed_at hides the device from the user's list; the row is kept so
+		// per-device account limits still count it
+		field.Time("removed_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -39,6 +39,15 @@ func (EmailVerificationToken) Fields() []ent.Field {
 		field.Time("used_at").
 			Optional().
 			Nillable(),
+		// purpose distinguishes signup verification from email-change confirm/revert tokens
+		field.String("purpose").
+			MaxLen(32).
+			Default("verify_email"),
+		// target_email is the new address (email_change) or the previous address (email_revert)
+		field.String("target_email").
+			Optional().
+			Nillable().
+			MaxLen(255),
 	}
 }
 
@@ -56,5 +65,6 @@ func (EmailVerificationToken) Edges() []ent.Edge {
 func (EmailVerificationToken) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("token_hash").Unique(),
+		index.Fields("user_id", "purpose"),
 	}
 }
@@ -59,6 +59,27 @@ func (FinalOffer) Fields() []ent.Field {
 		field.Time("rejected_at").
 			Optional().
 			Nillable(),
+		// Negotiation state. amount/hold_hours/terms above always mirror current_revision.
+		field.Int("current_revision").
+			Positive().
+			Default(1),
+		// last_proposed_by: validator | owner
+		field.String("last_proposed_by").
+			MaxLen(16).
+			Default("validator"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("withdrawn_at").
+			Optional().
+			Nillable(),
+		field.Time("expired_at").
+			Optional().
+			Nillable(),
+		// Milestone plan of the current revision (deliverable, amount, due_at). Empty for
+		// single-stage offers; materialized into final_offer_milestones on acceptance.
+		field.JSON("milestone_plan", []map[string]interface{}{}).
+			Optional(),
 	}
 }
 
@@ -74,6 +95,8 @@ func (FinalOffer) Edges() []ent.Edge {
 			Field("validator_user_id").
 			Required().
 			Unique(),
+		edge.To("revisions", FinalOfferRevision.Type),
+		edge.To("milestones", FinalOfferMilestone.Type),
 	}
 }
 
@@ -85,5 +108,6 @@ func (FinalOffer) Indexes() []ent.Index {
 		index.Fields("validation_case_id", "validator_user_id", "workflow_cycle").
 			Unique(),
 		index.Fields("status"),
+		index.Fields("status", "expires_at"),
 	}
 }
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FinalOfferMilestone is one stage of an accepted milestone-based Final Offer. Each milestone
+// has its own Feature Service escrow transfer, artifact submission, release and dispute.
+type FinalOfferMilestone struct {
+	ent.Schema
+}
+
+func (FinalOfferMilestone) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "final_offer_milestones"},
+	}
+}
+
+func (FinalOfferMilestone) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (FinalOfferMilestone) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("final_offer_id").
+			Positive(),
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("sequence").
+			Positive(),
+		field.String("deliverable").
+			MaxLen(500),
+		field.Int64("amount").
+			Positive(),
+		field.Time("due_at"),
+		// status: pending | funds_locked | artifact_submitted | released | disputed | refunded
+		field.String("status").
+			MaxLen(32).
+			Default("pending"),
+		field.String("escrow_transfer_id").
+			MaxLen(128).
+			Optional().
+			Nillable().
+			Unique(),
+		field.String("artifact_document_id").
+			Optional().
+			Nillable(),
+		field.String("dispute_id").
+			MaxLen(128).
+			Optional().
+			Nillable(),
+		field.Time("funds_locked_at").
+			Optional().
+			Nillable(),
+		field.Time("artifact_submitted_at").
+			Optional().
+			Nillable(),
+		field.Time("released_at").
+			Optional().
+			Nillable(),
+		field.Time("disputed_at").
+			Optional().
+			Nillable(),
+		field.Time("refunded_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (FinalOfferMilestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("final_offer", FinalOffer.Type).
+			Ref("milestones").
+			Field("final_offer_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (FinalOfferMilestone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("final_offer_id", "sequence").
+			Unique(),
+		index.Fields("validation_case_id"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FinalOfferRevision is one proposal in a Final Offer negotiation thread. Rows are
+// append-only; the FinalOffer row mirrors the latest revision.
+type FinalOfferRevision struct {
+	ent.Schema
+}
+
+func (FinalOfferRevision) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "final_offer_revisions"},
+	}
+}
+
+func (FinalOfferRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (FinalOfferRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("final_offer_id").
+			Positive(),
+		field.Int("revision").
+			Positive(),
+		field.Int("proposed_by_user_id").
+			Positive(),
+		// proposer_role: validator | owner
+		field.String("proposer_role").
+			MaxLen(16),
+		// action: submitted | countered | revised | resubmitted
+		field.String("action").
+			MaxLen(32),
+		field.Int64("amount").
+			Positive(),
+		field.Int("hold_hours").
+			Positive(),
+		field.Text("terms").
+			Optional().
+			Default(""),
+		field.String("note").
+			MaxLen(1000).
+			Optional().
+			Default(""),
+		field.JSON("milestone_plan", []map[string]interface{}{}).
+			Optional(),
+	}
+}
+
+func (FinalOfferRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("final_offer", FinalOffer.Type).
+			Ref("revisions").
+			Field("final_offer_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (FinalOfferRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("final_offer_id", "revision").
+			Unique(),
+	}
+}
@@ -0,0 +1,115 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GuaranteeSlash is a penalty on a validator's guarantee after a dispute was settled against
+// them (refund to the owner). It waits out an appeal window and is then executed through
+// Feature Service with an idempotency key derived from the dispute ID.
+type GuaranteeSlash struct {
+	ent.Schema
+}
+
+func (GuaranteeSlash) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "guarantee_slashes"},
+	}
+}
+
+func (GuaranteeSlash) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (GuaranteeSlash) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("validator_user_id").
+			Positive(),
+		field.Int("final_offer_id").
+			Positive(),
+		// milestone_id is set when the lost dispute covered a single milestone.
+		field.Int("milestone_id").
+			Optional().
+			Nillable(),
+		field.Int("workflow_cycle").
+			Positive().
+			Default(1),
+		field.String("dispute_id").
+			MaxLen(128),
+		field.String("idempotency_key").
+			MaxLen(191).
+			Unique(),
+		// Guarantee and policy snapshot at the time the slash was scheduled.
+		field.Int64("guarantee_amount").
+			NonNegative(),
+		field.Int("percent").
+			NonNegative(),
+		field.Int64("cap_amount").
+			NonNegative().
+			Default(0),
+		field.Int64("amount").
+			NonNegative(),
+		// status: pending_appeal | appealed | upheld | overturned | executed | skipped | failed
+		field.String("status").
+			MaxLen(32).
+			Default("pending_appeal"),
+		// skip_reason: cooldown | no_guarantee
+		field.String("skip_reason").
+			MaxLen(32).
+			Optional().
+			Nillable(),
+		field.Time("appeal_deadline_at"),
+		field.String("appeal_reason").
+			MaxLen(2000).
+			Optional().
+			Nillable(),
+		field.Time("appealed_at").
+			Optional().
+			Nillable(),
+		field.Int("resolved_by_admin_id").
+			Optional().
+			Nillable(),
+		field.String("resolution_note").
+			MaxLen(2000).
+			Optional().
+			Nillable(),
+		field.Time("resolved_at").
+			Optional().
+			Nillable(),
+		field.Time("executed_at").
+			Optional().
+			Nillable(),
+		field.Int64("remaining_guarantee_amount").
+			Optional().
+			Nillable(),
+		// Feature Service attempts; failures are retried by the SLA worker with backoff until
+		// the attempt limit, after which the slash is marked failed for an admin to resolve.
+		field.Int("attempt_count").
+			NonNegative().
+			Default(0),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable(),
+		field.String("last_error").
+			MaxLen(500).
+			Optional().
+			Nillable(),
+	}
+}
+
+func (GuaranteeSlash) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validation_case_id"),
+		index.Fields("validator_user_id", "created_at"),
+		index.Fields("status", "appeal_deadline_at"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
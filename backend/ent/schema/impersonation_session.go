@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImpersonationSession holds the schema definition for the ImpersonationSession entity.
+// A short-lived, read-only grant for an admin to view the API as a user. The token's JTI
+// must match an unexpired, unrevoked row on every request.
+type ImpersonationSession struct {
+	ent.Schema
+}
+
+func (ImpersonationSession) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "impersonation_sessions"},
+	}
+}
+
+func (ImpersonationSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ImpersonationSession.
+func (ImpersonationSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("admin_id").
+			Immutable(),
+		field.String("admin_email").
+			MaxLen(255).
+			Default("").
+			Immutable(),
+		// Impersonated user
+		field.Int("user_id").
+			Immutable(),
+		field.Text("reason").
+			NotEmpty().
+			Immutable(),
+		field.String("jti").
+			NotEmpty().
+			MaxLen(64).
+			Unique().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Int("revoked_by_admin_id").
+			Optional().
+			Nillable(),
+		field.String("ip_address").
+			MaxLen(45).
+			Default(""),
+		field.String("user_agent").
+			MaxLen(512).
+			Default(""),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Int("request_count").
+			Default(0),
+	}
+}
+
+// Edges of the ImpersonationSession.
+func (ImpersonationSession) Edges() []ent.Edge {
+	return nil
+}
+
+func (ImpersonationSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("admin_id", "created_at"),
+		index.Fields("user_id", "created_at"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IPRule holds the schema definition for the IPRule entity.
+// Allow/block rules enforced by the rate limiter on every instance.
+type IPRule struct {
+	ent.Schema
+}
+
+func (IPRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "ip_rules"},
+	}
+}
+
+func (IPRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the IPRule.
+func (IPRule) Fields() []ent.Field {
+	return []ent.Field{
+		// Canonical CIDR; single addresses are stored as /32 or /128
+		field.String("cidr").
+			NotEmpty().
+			MaxLen(64),
+		// "allow" or "block"
+		field.String("action").
+			NotEmpty().
+			MaxLen(10),
+		field.String("reason").
+			NotEmpty().
+			MaxLen(255),
+		// "admin", "brute_force" or "rate_limit"
+		field.String("source").
+			MaxLen(20).
+			Default("admin"),
+		field.Int("created_by_admin_id").
+			Optional().
+			Nillable(),
+		field.String("created_by_email").
+			Optional().
+			MaxLen(255),
+		// Nil means the rule never expires
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the IPRule.
+func (IPRule) Edges() []ent.Edge {
+	return nil
+}
+
+func (IPRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cidr", "action"),
+		index.Fields("expires_at"),
+	}
+}
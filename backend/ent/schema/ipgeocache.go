@@ -47,6 +47,10 @@ func (IPGeoCache) Fields() []ent.Field {
 			Optional(),
 		field.Float("longitude").
 			Optional(),
+		// asn is the autonomous system ("AS15169 Google LLC") used for network-change risk signals
+		field.String("asn").
+			MaxLen(128).
+			Optional(),
 		field.Time("cached_at").
 			Default(time.Now),
 	}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReputationEvent is one entry of a validator's append-only reputation ledger, emitted by a
+// workflow transition. Events are never updated; ValidatorReputation is derived from them.
+type ReputationEvent struct {
+	ent.Schema
+}
+
+func (ReputationEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reputation_events"},
+	}
+}
+
+func (ReputationEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ReputationEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validator_user_id").
+			Positive(),
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("workflow_cycle").
+			Positive().
+			Default(1),
+		// kind: case_completed | dispute_won | dispute_lost | delivery_sla_missed | owner_timeout_released |
+		// guarantee_slashed
+		field.String("kind").
+			MaxLen(32),
+		field.Int("points"),
+		// Domain tag slugs of the case when the event happened.
+		field.JSON("domain_slugs", []string{}).
+			Optional(),
+		// dedupe_key makes emission idempotent when a transition is replayed.
+		field.String("dedupe_key").
+			MaxLen(191),
+		field.JSON("detail_json", map[string]interface{}{}).
+			Optional(),
+		field.Time("occurred_at"),
+	}
+}
+
+func (ReputationEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("dedupe_key").
+			Unique(),
+		index.Fields("validator_user_id", "occurred_at"),
+		index.Fields("validation_case_id"),
+	}
+}
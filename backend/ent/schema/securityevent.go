@@ -69,8 +69,11 @@ func (SecurityEvent) Edges() []ent.Edge {
 
 func (SecurityEvent) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("user_id"),
 		index.Fields("email"),
-		index.Fields("event_type"),
+		// Admin security analytics filter by type/IP/user within a time range
+		index.Fields("created_at"),
+		index.Fields("event_type", "created_at"),
+		index.Fields("ip_address", "created_at"),
+		index.Fields("user_id", "created_at"),
 	}
 }
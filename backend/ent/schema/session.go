@@ -61,6 +61,10 @@ func (Session) Fields() []ent.Field {
 			MaxLen(64),
 		field.Bool("is_used").
 			Default(false),
+		// Hashed device fingerprint the session was created from (empty if none was sent)
+		field.String("device_fingerprint_hash").
+			Optional().
+			MaxLen(64),
 	}
 }
 
@@ -79,5 +83,8 @@ func (Session) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id"),
 		index.Fields("token_family"),
+		index.Fields("user_id", "device_fingerprint_hash"),
+		// Account linkage lookups (admin fraud investigation)
+		index.Fields("ip_address"),
 	}
 }
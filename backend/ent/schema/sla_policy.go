@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SLAPolicy configures the owner-response SLA for Validation Cases matching a sensitivity
+// level and/or category. The most specific active policy wins; its values are snapshotted
+// onto each consultation request so later edits do not move running timers.
+type SLAPolicy struct {
+	ent.Schema
+}
+
+func (SLAPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sla_policies"},
+	}
+}
+
+func (SLAPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (SLAPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+		// sensitivity_level: S0 | S1 | S2 | S3, empty matches every level
+		field.String("sensitivity_level").
+			MaxLen(8).
+			Default(""),
+		// category_id: nil matches every category
+		field.Int("category_id").
+			Optional().
+			Nillable(),
+		field.Int("owner_response_hours").
+			Positive().
+			Default(12),
+		// Hours after the start of the window at which the owner is reminded, ascending.
+		field.JSON("reminder_hours", []int{}).
+			Optional(),
+		// business_hours_only counts only hours inside the business day, skipping weekends
+		// and holidays from the loaded calendar.
+		field.Bool("business_hours_only").
+			Default(false),
+		field.Int("business_day_start_hour").
+			NonNegative().
+			Default(9),
+		field.Int("business_day_end_hour").
+			Positive().
+			Default(17),
+		field.String("timezone").
+			MaxLen(64).
+			Default("Asia/Jakarta"),
+		field.Bool("is_active").
+			Default(true),
+		field.Int("created_by_admin_id").
+			Optional().
+			Nillable(),
+		field.Int("updated_by_admin_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (SLAPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active", "sensitivity_level"),
+		index.Fields("category_id"),
+	}
+}
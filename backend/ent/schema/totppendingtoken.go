@@ -38,6 +38,16 @@ func (TOTPPendingToken) Fields() []ent.Field {
 		field.Time("used_at").
 			Optional().
 			Nillable(),
+		// purpose is "totp" for the TOTP step, or "step_up" for a risk step-up challenge
+		field.String("purpose").
+			Default("totp"),
+		// email_code_hash is set when a step-up challenge is answered with an emailed code
+		field.String("email_code_hash").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Int("code_attempts").
+			Default(0),
 	}
 }
 
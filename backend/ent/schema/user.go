@@ -176,5 +176,7 @@ func (User) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("email").Unique(),
 		index.Fields("username").Unique(),
+		// Account linkage lookups (admin fraud investigation)
+		index.Fields("last_login_ip"),
 	}
 }
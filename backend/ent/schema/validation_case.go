@@ -69,6 +69,10 @@ func (ValidationCase) Fields() []ent.Field {
 		field.Int("workflow_cycle").
 			Positive().
 			Default(1),
+		// Owner opt-out of Telegram contact reveal; validators use in-platform messages instead.
+		// S2/S3 cases never reveal Telegram regardless of this flag.
+		field.Bool("telegram_reveal_disabled").
+			Default(false),
 
 		// Feature-service linkage (escrow/dispute/document IDs).
 		field.String("escrow_transfer_id").
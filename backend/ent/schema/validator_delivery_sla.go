@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ValidatorDeliverySLA tracks the validator's delivery deadline for one escrow lock (the whole
+// case, or one milestone). It is created at Lock Funds and closed by artifact submission,
+// dispute, refund or escalation.
+type ValidatorDeliverySLA struct {
+	ent.Schema
+}
+
+func (ValidatorDeliverySLA) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "validator_delivery_slas"},
+	}
+}
+
+func (ValidatorDeliverySLA) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ValidatorDeliverySLA) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validation_case_id").
+			Positive(),
+		field.Int("final_offer_id").
+			Positive(),
+		// milestone_id is set when the lock funded a single milestone of the offer.
+		field.Int("milestone_id").
+			Optional().
+			Nillable(),
+		field.Int("validator_user_id").
+			Positive(),
+		field.Int("workflow_cycle").
+			Positive().
+			Default(1),
+		field.String("escrow_transfer_id").
+			MaxLen(128).
+			Unique(),
+		field.Int("hold_hours").
+			Positive(),
+		field.Time("locked_at"),
+		field.Time("due_at"),
+		field.Time("grace_until"),
+		field.Int("reminder_count").
+			NonNegative().
+			Default(0),
+		// status: active | delivered | missed | escalated | cancelled
+		field.String("status").
+			MaxLen(32).
+			Default("active"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("missed_at").
+			Optional().
+			Nillable(),
+		field.Time("escalated_at").
+			Optional().
+			Nillable(),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		// recommendation: owner_refund | dispute (set on escalation)
+		field.String("recommendation").
+			MaxLen(32).
+			Optional().
+			Nillable(),
+		// Prefilled dispute for the owner to file in Feature Service after escalation.
+		field.JSON("dispute_draft", map[string]interface{}{}).
+			Optional(),
+	}
+}
+
+func (ValidatorDeliverySLA) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validation_case_id"),
+		index.Fields("validator_user_id"),
+		index.Fields("status", "due_at"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ValidatorMatchingAggregate caches the case-independent inputs of a validator's matching
+// score so recommendations can rank every eligible validator without replaying history.
+type ValidatorMatchingAggregate struct {
+	ent.Schema
+}
+
+func (ValidatorMatchingAggregate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "validator_matching_aggregates"},
+	}
+}
+
+func (ValidatorMatchingAggregate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ValidatorMatchingAggregate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validator_user_id").
+			Positive(),
+		// Domain and evidence tag slugs of the cases the validator made Final Offers on.
+		field.JSON("domain_slugs", []string{}).
+			Optional(),
+		field.JSON("evidence_slugs", []string{}).
+			Optional(),
+		field.Int("history_case_count").
+			NonNegative().
+			Default(0),
+		field.Int("disputed_case_count").
+			NonNegative().
+			Default(0),
+		field.Int("approved_consultation_count").
+			NonNegative().
+			Default(0),
+		field.Int("delivery_sla_count").
+			NonNegative().
+			Default(0),
+		field.Int("responsiveness_score").
+			Min(0).
+			Max(100).
+			Default(50),
+		field.Time("computed_at"),
+	}
+}
+
+func (ValidatorMatchingAggregate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validator_user_id").
+			Unique(),
+		index.Fields("computed_at"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ValidatorReputation is the decayed reputation score materialized from a validator's
+// ReputationEvents, overall (empty domain_slug) and per domain tag.
+type ValidatorReputation struct {
+	ent.Schema
+}
+
+func (ValidatorReputation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "validator_reputations"},
+	}
+}
+
+func (ValidatorReputation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ValidatorReputation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("validator_user_id").
+			Positive(),
+		field.String("domain_slug").
+			MaxLen(128).
+			Default(""),
+		field.Int("score").
+			Min(0).
+			Max(100).
+			Default(50),
+		field.Float("decayed_points").
+			Default(0),
+		field.Int("event_count").
+			NonNegative().
+			Default(0),
+		field.Time("last_event_at").
+			Optional().
+			Nillable(),
+		field.Time("computed_at"),
+	}
+}
+
+func (ValidatorReputation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("validator_user_id", "domain_slug").
+			Unique(),
+	}
+}
@@ -46,6 +46,8 @@ type Session struct {
 	TokenFamily string `json:"token_family,omitempty"`
 	// IsUsed holds the value of the "is_used" field.
 	IsUsed bool `json:"is_used,omitempty"`
+	// DeviceFingerprintHash holds the value of the "device_fingerprint_hash" field.
+	DeviceFingerprintHash string `json:"device_fingerprint_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SessionQuery when eager-loading is set.
 	Edges        SessionEdges `json:"edges"`
@@ -81,7 +83,7 @@ func (*Session) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case session.FieldID, session.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case session.FieldRefreshTokenHash, session.FieldAccessTokenJti, session.FieldIPAddress, session.FieldUserAgent, session.FieldRevokeReason, session.FieldTokenFamily:
+		case session.FieldRefreshTokenHash, session.FieldAccessTokenJti, session.FieldIPAddress, session.FieldUserAgent, session.FieldRevokeReason, session.FieldTokenFamily, session.FieldDeviceFingerprintHash:
 			values[i] = new(sql.NullString)
 		case session.FieldCreatedAt, session.FieldUpdatedAt, session.FieldDeletedAt, session.FieldExpiresAt, session.FieldLastUsedAt, session.FieldRevokedAt:
 			values[i] = new(sql.NullTime)
@@ -192,6 +194,12 @@ func (_m *Session) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsUsed = value.Bool
 			}
+		case session.FieldDeviceFingerprintHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_fingerprint_hash", values[i])
+			} else if value.Valid {
+				_m.DeviceFingerprintHash = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -278,6 +286,9 @@ func (_m *Session) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_used=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsUsed))
+	builder.WriteString(", ")
+	builder.WriteString("device_fingerprint_hash=")
+	builder.WriteString(_m.DeviceFingerprintHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
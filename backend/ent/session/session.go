@@ -42,6 +42,8 @@ const (
 	FieldTokenFamily = "token_family"
 	// FieldIsUsed holds the string denoting the is_used field in the database.
 	FieldIsUsed = "is_used"
+	// FieldDeviceFingerprintHash holds the string denoting the device_fingerprint_hash field in the database.
+	FieldDeviceFingerprintHash = "device_fingerprint_hash"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the session in the database.
@@ -72,6 +74,7 @@ var Columns = []string{
 	FieldRevokeReason,
 	FieldTokenFamily,
 	FieldIsUsed,
+	FieldDeviceFingerprintHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -111,6 +114,8 @@ var (
 	TokenFamilyValidator func(string) error
 	// DefaultIsUsed holds the default value on creation for the "is_used" field.
 	DefaultIsUsed bool
+	// DeviceFingerprintHashValidator is a validator for the "device_fingerprint_hash" field. It is called by the builders before save.
+	DeviceFingerprintHashValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Session queries.
@@ -191,6 +196,11 @@ func ByIsUsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsUsed, opts...).ToFunc()
 }
 
+// ByDeviceFingerprintHash orders the results by the device_fingerprint_hash field.
+func ByDeviceFingerprintHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceFingerprintHash, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -125,6 +125,11 @@ func IsUsed(v bool) predicate.Session {
 	return predicate.Session(sql.FieldEQ(FieldIsUsed, v))
 }
 
+// DeviceFingerprintHash applies equality check predicate on the "device_fingerprint_hash" field. It's identical to DeviceFingerprintHashEQ.
+func DeviceFingerprintHash(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDeviceFingerprintHash, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Session {
 	return predicate.Session(sql.FieldEQ(FieldCreatedAt, v))
@@ -845,6 +850,81 @@ func IsUsedNEQ(v bool) predicate.Session {
 	return predicate.Session(sql.FieldNEQ(FieldIsUsed, v))
 }
 
+// DeviceFingerprintHashEQ applies the EQ predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashNEQ applies the NEQ predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashNEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashIn applies the In predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldDeviceFingerprintHash, vs...))
+}
+
+// DeviceFingerprintHashNotIn applies the NotIn predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashNotIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldDeviceFingerprintHash, vs...))
+}
+
+// DeviceFingerprintHashGT applies the GT predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashGT(v string) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashGTE applies the GTE predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashGTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashLT applies the LT predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashLT(v string) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashLTE applies the LTE predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashLTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashContains applies the Contains predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashContains(v string) predicate.Session {
+	return predicate.Session(sql.FieldContains(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashHasPrefix applies the HasPrefix predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashHasPrefix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasPrefix(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashHasSuffix applies the HasSuffix predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashHasSuffix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasSuffix(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashIsNil applies the IsNil predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldDeviceFingerprintHash))
+}
+
+// DeviceFingerprintHashNotNil applies the NotNil predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldDeviceFingerprintHash))
+}
+
+// DeviceFingerprintHashEqualFold applies the EqualFold predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashEqualFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldEqualFold(FieldDeviceFingerprintHash, v))
+}
+
+// DeviceFingerprintHashContainsFold applies the ContainsFold predicate on the "device_fingerprint_hash" field.
+func DeviceFingerprintHashContainsFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldContainsFold(FieldDeviceFingerprintHash, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Session {
 	return predicate.Session(func(s *sql.Selector) {
@@ -193,6 +193,20 @@ func (_c *SessionCreate) SetNillableIsUsed(v *bool) *SessionCreate {
 	return _c
 }
 
+// SetDeviceFingerprintHash sets the "device_fingerprint_hash" field.
+func (_c *SessionCreate) SetDeviceFingerprintHash(v string) *SessionCreate {
+	_c.mutation.SetDeviceFingerprintHash(v)
+	return _c
+}
+
+// SetNillableDeviceFingerprintHash sets the "device_fingerprint_hash" field if the given value is not nil.
+func (_c *SessionCreate) SetNillableDeviceFingerprintHash(v *string) *SessionCreate {
+	if v != nil {
+		_c.SetDeviceFingerprintHash(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *SessionCreate) SetUser(v *User) *SessionCreate {
 	return _c.SetUserID(v.ID)
@@ -316,6 +330,11 @@ func (_c *SessionCreate) check() error {
 	if _, ok := _c.mutation.IsUsed(); !ok {
 		return &ValidationError{Name: "is_used", err: errors.New(`ent: missing required field "Session.is_used"`)}
 	}
+	if v, ok := _c.mutation.DeviceFingerprintHash(); ok {
+		if err := session.DeviceFingerprintHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_fingerprint_hash", err: fmt.Errorf(`ent: validator failed for field "Session.device_fingerprint_hash": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Session.user"`)}
 	}
@@ -397,6 +416,10 @@ func (_c *SessionCreate) createSpec() (*Session, *sqlgraph.CreateSpec) {
 		_spec.SetField(session.FieldIsUsed, field.TypeBool, value)
 		_node.IsUsed = value
 	}
+	if value, ok := _c.mutation.DeviceFingerprintHash(); ok {
+		_spec.SetField(session.FieldDeviceFingerprintHash, field.TypeString, value)
+		_node.DeviceFingerprintHash = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
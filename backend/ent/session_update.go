@@ -239,6 +239,26 @@ func (_u *SessionUpdate) SetNillableIsUsed(v *bool) *SessionUpdate {
 	return _u
 }
 
+// SetDeviceFingerprintHash sets the "device_fingerprint_hash" field.
+func (_u *SessionUpdate) SetDeviceFingerprintHash(v string) *SessionUpdate {
+	_u.mutation.SetDeviceFingerprintHash(v)
+	return _u
+}
+
+// SetNillableDeviceFingerprintHash sets the "device_fingerprint_hash" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillableDeviceFingerprintHash(v *string) *SessionUpdate {
+	if v != nil {
+		_u.SetDeviceFingerprintHash(*v)
+	}
+	return _u
+}
+
+// ClearDeviceFingerprintHash clears the value of the "device_fingerprint_hash" field.
+func (_u *SessionUpdate) ClearDeviceFingerprintHash() *SessionUpdate {
+	_u.mutation.ClearDeviceFingerprintHash()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *SessionUpdate) SetUser(v *User) *SessionUpdate {
 	return _u.SetUserID(v.ID)
@@ -328,6 +348,11 @@ func (_u *SessionUpdate) check() error {
 			return &ValidationError{Name: "token_family", err: fmt.Errorf(`ent: validator failed for field "Session.token_family": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeviceFingerprintHash(); ok {
+		if err := session.DeviceFingerprintHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_fingerprint_hash", err: fmt.Errorf(`ent: validator failed for field "Session.device_fingerprint_hash": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Session.user"`)
 	}
@@ -400,6 +425,12 @@ func (_u *SessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsUsed(); ok {
 		_spec.SetField(session.FieldIsUsed, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DeviceFingerprintHash(); ok {
+		_spec.SetField(session.FieldDeviceFingerprintHash, field.TypeString, value)
+	}
+	if _u.mutation.DeviceFingerprintHashCleared() {
+		_spec.ClearField(session.FieldDeviceFingerprintHash, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -659,6 +690,26 @@ func (_u *SessionUpdateOne) SetNillableIsUsed(v *bool) *SessionUpdateOne {
 	return _u
 }
 
+// SetDeviceFingerprintHash sets the "device_fingerprint_hash" field.
+func (_u *SessionUpdateOne) SetDeviceFingerprintHash(v string) *SessionUpdateOne {
+	_u.mutation.SetDeviceFingerprintHash(v)
+	return _u
+}
+
+// SetNillableDeviceFingerprintHash sets the "device_fingerprint_hash" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillableDeviceFingerprintHash(v *string) *SessionUpdateOne {
+	if v != nil {
+		_u.SetDeviceFingerprintHash(*v)
+	}
+	return _u
+}
+
+// ClearDeviceFingerprintHash clears the value of the "device_fingerprint_hash" field.
+func (_u *SessionUpdateOne) ClearDeviceFingerprintHash() *SessionUpdateOne {
+	_u.mutation.ClearDeviceFingerprintHash()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *SessionUpdateOne) SetUser(v *User) *SessionUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -761,6 +812,11 @@ func (_u *SessionUpdateOne) check() error {
 			return &ValidationError{Name: "token_family", err: fmt.Errorf(`ent: validator failed for field "Session.token_family": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeviceFingerprintHash(); ok {
+		if err := session.DeviceFingerprintHashValidator(v); err != nil {
+			return &ValidationError{Name: "device_fingerprint_hash", err: fmt.Errorf(`ent: validator failed for field "Session.device_fingerprint_hash": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Session.user"`)
 	}
@@ -850,6 +906,12 @@ func (_u *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err er
 	if value, ok := _u.mutation.IsUsed(); ok {
 		_spec.SetField(session.FieldIsUsed, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.DeviceFingerprintHash(); ok {
+		_spec.SetField(session.FieldDeviceFingerprintHash, field.TypeString, value)
+	}
+	if _u.mutation.DeviceFingerprintHashCleared() {
+		_spec.ClearField(session.FieldDeviceFingerprintHash, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
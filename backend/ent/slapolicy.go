@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/slapolicy"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SLAPolicy is the model entity for the SLAPolicy schema.
+type SLAPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// SensitivityLevel holds the value of the "sensitivity_level" field.
+	SensitivityLevel string `json:"sensitivity_level,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// OwnerResponseHours holds the value of the "owner_response_hours" field.
+	OwnerResponseHours int `json:"owner_response_hours,omitempty"`
+	// ReminderHours holds the value of the "reminder_hours" field.
+	ReminderHours []int `json:"reminder_hours,omitempty"`
+	// BusinessHoursOnly holds the value of the "business_hours_only" field.
+	BusinessHoursOnly bool `json:"business_hours_only,omitempty"`
+	// BusinessDayStartHour holds the value of the "business_day_start_hour" field.
+	BusinessDayStartHour int `json:"business_day_start_hour,omitempty"`
+	// BusinessDayEndHour holds the value of the "business_day_end_hour" field.
+	BusinessDayEndHour int `json:"business_day_end_hour,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedByAdminID holds the value of the "created_by_admin_id" field.
+	CreatedByAdminID *int `json:"created_by_admin_id,omitempty"`
+	// UpdatedByAdminID holds the value of the "updated_by_admin_id" field.
+	UpdatedByAdminID *int `json:"updated_by_admin_id,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SLAPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case slapolicy.FieldReminderHours:
+			values[i] = new([]byte)
+		case slapolicy.FieldBusinessHoursOnly, slapolicy.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case slapolicy.FieldID, slapolicy.FieldCategoryID, slapolicy.FieldOwnerResponseHours, slapolicy.FieldBusinessDayStartHour, slapolicy.FieldBusinessDayEndHour, slapolicy.FieldCreatedByAdminID, slapolicy.FieldUpdatedByAdminID:
+			values[i] = new(sql.NullInt64)
+		case slapolicy.FieldName, slapolicy.FieldSensitivityLevel, slapolicy.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case slapolicy.FieldCreatedAt, slapolicy.FieldUpdatedAt, slapolicy.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SLAPolicy fields.
+func (_m *SLAPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case slapolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case slapolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case slapolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case slapolicy.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case slapolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case slapolicy.FieldSensitivityLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sensitivity_level", values[i])
+			} else if value.Valid {
+				_m.SensitivityLevel = value.String
+			}
+		case slapolicy.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(int)
+				*_m.CategoryID = int(value.Int64)
+			}
+		case slapolicy.FieldOwnerResponseHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_response_hours", values[i])
+			} else if value.Valid {
+				_m.OwnerResponseHours = int(value.Int64)
+			}
+		case slapolicy.FieldReminderHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reminder_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ReminderHours); err != nil {
+					return fmt.Errorf("unmarshal field reminder_hours: %w", err)
+				}
+			}
+		case slapolicy.FieldBusinessHoursOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field business_hours_only", values[i])
+			} else if value.Valid {
+				_m.BusinessHoursOnly = value.Bool
+			}
+		case slapolicy.FieldBusinessDayStartHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field business_day_start_hour", values[i])
+			} else if value.Valid {
+				_m.BusinessDayStartHour = int(value.Int64)
+			}
+		case slapolicy.FieldBusinessDayEndHour:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field business_day_end_hour", values[i])
+			} else if value.Valid {
+				_m.BusinessDayEndHour = int(value.Int64)
+			}
+		case slapolicy.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case slapolicy.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				_m.IsActive = value.Bool
+			}
+		case slapolicy.FieldCreatedByAdminID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_admin_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByAdminID = new(int)
+				*_m.CreatedByAdminID = int(value.Int64)
+			}
+		case slapolicy.FieldUpdatedByAdminID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by_admin_id", values[i])
+			} else if value.Valid {
+				_m.UpdatedByAdminID = new(int)
+				*_m.UpdatedByAdminID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SLAPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *SLAPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SLAPolicy.
+// Note that you need to call SLAPolicy.Unwrap() before calling this method if this SLAPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SLAPolicy) Update() *SLAPolicyUpdateOne {
+	return NewSLAPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SLAPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SLAPolicy) Unwrap() *SLAPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SLAPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SLAPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("SLAPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("sensitivity_level=")
+	builder.WriteString(_m.SensitivityLevel)
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("owner_response_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerResponseHours))
+	builder.WriteString(", ")
+	builder.WriteString("reminder_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReminderHours))
+	builder.WriteString(", ")
+	builder.WriteString("business_hours_only=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BusinessHoursOnly))
+	builder.WriteString(", ")
+	builder.WriteString("business_day_start_hour=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BusinessDayStartHour))
+	builder.WriteString(", ")
+	builder.WriteString("business_day_end_hour=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BusinessDayEndHour))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
+	builder.WriteString(", ")
+	if v := _m.CreatedByAdminID; v != nil {
+		builder.WriteString("created_by_admin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedByAdminID; v != nil {
+		builder.WriteString("updated_by_admin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SLAPolicies is a parsable slice of SLAPolicy.
+type SLAPolicies []*SLAPolicy
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slapolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the slapolicy type in the database.
+	Label = "sla_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSensitivityLevel holds the string denoting the sensitivity_level field in the database.
+	FieldSensitivityLevel = "sensitivity_level"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldOwnerResponseHours holds the string denoting the owner_response_hours field in the database.
+	FieldOwnerResponseHours = "owner_response_hours"
+	// FieldReminderHours holds the string denoting the reminder_hours field in the database.
+	FieldReminderHours = "reminder_hours"
+	// FieldBusinessHoursOnly holds the string denoting the business_hours_only field in the database.
+	FieldBusinessHoursOnly = "business_hours_only"
+	// FieldBusinessDayStartHour holds the string denoting the business_day_start_hour field in the database.
+	FieldBusinessDayStartHour = "business_day_start_hour"
+	// FieldBusinessDayEndHour holds the string denoting the business_day_end_hour field in the database.
+	FieldBusinessDayEndHour = "business_day_end_hour"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldCreatedByAdminID holds the string denoting the created_by_admin_id field in the database.
+	FieldCreatedByAdminID = "created_by_admin_id"
+	// FieldUpdatedByAdminID holds the string denoting the updated_by_admin_id field in the database.
+	FieldUpdatedByAdminID = "updated_by_admin_id"
+	// Table holds the table name of the slapolicy in the database.
+	Table = "sla_policies"
+)
+
+// Columns holds all SQL columns for slapolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldName,
+	FieldSensitivityLevel,
+	FieldCategoryID,
+	FieldOwnerResponseHours,
+	FieldReminderHours,
+	FieldBusinessHoursOnly,
+	FieldBusinessDayStartHour,
+	FieldBusinessDayEndHour,
+	FieldTimezone,
+	FieldIsActive,
+	FieldCreatedByAdminID,
+	FieldUpdatedByAdminID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultSensitivityLevel holds the default value on creation for the "sensitivity_level" field.
+	DefaultSensitivityLevel string
+	// SensitivityLevelValidator is a validator for the "sensitivity_level" field. It is called by the builders before save.
+	SensitivityLevelValidator func(string) error
+	// DefaultOwnerResponseHours holds the default value on creation for the "owner_response_hours" field.
+	DefaultOwnerResponseHours int
+	// OwnerResponseHoursValidator is a validator for the "owner_response_hours" field. It is called by the builders before save.
+	OwnerResponseHoursValidator func(int) error
+	// DefaultBusinessHoursOnly holds the default value on creation for the "business_hours_only" field.
+	DefaultBusinessHoursOnly bool
+	// DefaultBusinessDayStartHour holds the default value on creation for the "business_day_start_hour" field.
+	DefaultBusinessDayStartHour int
+	// BusinessDayStartHourValidator is a validator for the "business_day_start_hour" field. It is called by the builders before save.
+	BusinessDayStartHourValidator func(int) error
+	// DefaultBusinessDayEndHour holds the default value on creation for the "business_day_end_hour" field.
+	DefaultBusinessDayEndHour int
+	// BusinessDayEndHourValidator is a validator for the "business_day_end_hour" field. It is called by the builders before save.
+	BusinessDayEndHourValidator func(int) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+)
+
+// OrderOption defines the ordering options for the SLAPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySensitivityLevel orders the results by the sensitivity_level field.
+func BySensitivityLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSensitivityLevel, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByOwnerResponseHours orders the results by the owner_response_hours field.
+func ByOwnerResponseHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerResponseHours, opts...).ToFunc()
+}
+
+// ByBusinessHoursOnly orders the results by the business_hours_only field.
+func ByBusinessHoursOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessHoursOnly, opts...).ToFunc()
+}
+
+// ByBusinessDayStartHour orders the results by the business_day_start_hour field.
+func ByBusinessDayStartHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessDayStartHour, opts...).ToFunc()
+}
+
+// ByBusinessDayEndHour orders the results by the business_day_end_hour field.
+func ByBusinessDayEndHour(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessDayEndHour, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
+}
+
+// ByCreatedByAdminID orders the results by the created_by_admin_id field.
+func ByCreatedByAdminID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedByAdminID, opts...).ToFunc()
+}
+
+// ByUpdatedByAdminID orders the results by the updated_by_admin_id field.
+func ByUpdatedByAdminID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedByAdminID, opts...).ToFunc()
+}
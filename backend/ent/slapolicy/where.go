@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slapolicy
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// SensitivityLevel applies equality check predicate on the "sensitivity_level" field. It's identical to SensitivityLevelEQ.
+func SensitivityLevel(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldSensitivityLevel, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// OwnerResponseHours applies equality check predicate on the "owner_response_hours" field. It's identical to OwnerResponseHoursEQ.
+func OwnerResponseHours(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldOwnerResponseHours, v))
+}
+
+// BusinessHoursOnly applies equality check predicate on the "business_hours_only" field. It's identical to BusinessHoursOnlyEQ.
+func BusinessHoursOnly(v bool) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldBusinessHoursOnly, v))
+}
+
+// BusinessDayStartHour applies equality check predicate on the "business_day_start_hour" field. It's identical to BusinessDayStartHourEQ.
+func BusinessDayStartHour(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayEndHour applies equality check predicate on the "business_day_end_hour" field. It's identical to BusinessDayEndHourEQ.
+func BusinessDayEndHour(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldBusinessDayEndHour, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldTimezone, v))
+}
+
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldIsActive, v))
+}
+
+// CreatedByAdminID applies equality check predicate on the "created_by_admin_id" field. It's identical to CreatedByAdminIDEQ.
+func CreatedByAdminID(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCreatedByAdminID, v))
+}
+
+// UpdatedByAdminID applies equality check predicate on the "updated_by_admin_id" field. It's identical to UpdatedByAdminIDEQ.
+func UpdatedByAdminID(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldUpdatedByAdminID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// SensitivityLevelEQ applies the EQ predicate on the "sensitivity_level" field.
+func SensitivityLevelEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelNEQ applies the NEQ predicate on the "sensitivity_level" field.
+func SensitivityLevelNEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelIn applies the In predicate on the "sensitivity_level" field.
+func SensitivityLevelIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldSensitivityLevel, vs...))
+}
+
+// SensitivityLevelNotIn applies the NotIn predicate on the "sensitivity_level" field.
+func SensitivityLevelNotIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldSensitivityLevel, vs...))
+}
+
+// SensitivityLevelGT applies the GT predicate on the "sensitivity_level" field.
+func SensitivityLevelGT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelGTE applies the GTE predicate on the "sensitivity_level" field.
+func SensitivityLevelGTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelLT applies the LT predicate on the "sensitivity_level" field.
+func SensitivityLevelLT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelLTE applies the LTE predicate on the "sensitivity_level" field.
+func SensitivityLevelLTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelContains applies the Contains predicate on the "sensitivity_level" field.
+func SensitivityLevelContains(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContains(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelHasPrefix applies the HasPrefix predicate on the "sensitivity_level" field.
+func SensitivityLevelHasPrefix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasPrefix(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelHasSuffix applies the HasSuffix predicate on the "sensitivity_level" field.
+func SensitivityLevelHasSuffix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasSuffix(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelEqualFold applies the EqualFold predicate on the "sensitivity_level" field.
+func SensitivityLevelEqualFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEqualFold(FieldSensitivityLevel, v))
+}
+
+// SensitivityLevelContainsFold applies the ContainsFold predicate on the "sensitivity_level" field.
+func SensitivityLevelContainsFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContainsFold(FieldSensitivityLevel, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldCategoryID))
+}
+
+// OwnerResponseHoursEQ applies the EQ predicate on the "owner_response_hours" field.
+func OwnerResponseHoursEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldOwnerResponseHours, v))
+}
+
+// OwnerResponseHoursNEQ applies the NEQ predicate on the "owner_response_hours" field.
+func OwnerResponseHoursNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldOwnerResponseHours, v))
+}
+
+// OwnerResponseHoursIn applies the In predicate on the "owner_response_hours" field.
+func OwnerResponseHoursIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldOwnerResponseHours, vs...))
+}
+
+// OwnerResponseHoursNotIn applies the NotIn predicate on the "owner_response_hours" field.
+func OwnerResponseHoursNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldOwnerResponseHours, vs...))
+}
+
+// OwnerResponseHoursGT applies the GT predicate on the "owner_response_hours" field.
+func OwnerResponseHoursGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldOwnerResponseHours, v))
+}
+
+// OwnerResponseHoursGTE applies the GTE predicate on the "owner_response_hours" field.
+func OwnerResponseHoursGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldOwnerResponseHours, v))
+}
+
+// OwnerResponseHoursLT applies the LT predicate on the "owner_response_hours" field.
+func OwnerResponseHoursLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldOwnerResponseHours, v))
+}
+
+// OwnerResponseHoursLTE applies the LTE predicate on the "owner_response_hours" field.
+func OwnerResponseHoursLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldOwnerResponseHours, v))
+}
+
+// ReminderHoursIsNil applies the IsNil predicate on the "reminder_hours" field.
+func ReminderHoursIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldReminderHours))
+}
+
+// ReminderHoursNotNil applies the NotNil predicate on the "reminder_hours" field.
+func ReminderHoursNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldReminderHours))
+}
+
+// BusinessHoursOnlyEQ applies the EQ predicate on the "business_hours_only" field.
+func BusinessHoursOnlyEQ(v bool) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldBusinessHoursOnly, v))
+}
+
+// BusinessHoursOnlyNEQ applies the NEQ predicate on the "business_hours_only" field.
+func BusinessHoursOnlyNEQ(v bool) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldBusinessHoursOnly, v))
+}
+
+// BusinessDayStartHourEQ applies the EQ predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayStartHourNEQ applies the NEQ predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayStartHourIn applies the In predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldBusinessDayStartHour, vs...))
+}
+
+// BusinessDayStartHourNotIn applies the NotIn predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldBusinessDayStartHour, vs...))
+}
+
+// BusinessDayStartHourGT applies the GT predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayStartHourGTE applies the GTE predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayStartHourLT applies the LT predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayStartHourLTE applies the LTE predicate on the "business_day_start_hour" field.
+func BusinessDayStartHourLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldBusinessDayStartHour, v))
+}
+
+// BusinessDayEndHourEQ applies the EQ predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldBusinessDayEndHour, v))
+}
+
+// BusinessDayEndHourNEQ applies the NEQ predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldBusinessDayEndHour, v))
+}
+
+// BusinessDayEndHourIn applies the In predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldBusinessDayEndHour, vs...))
+}
+
+// BusinessDayEndHourNotIn applies the NotIn predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldBusinessDayEndHour, vs...))
+}
+
+// BusinessDayEndHourGT applies the GT predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldBusinessDayEndHour, v))
+}
+
+// BusinessDayEndHourGTE applies the GTE predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldBusinessDayEndHour, v))
+}
+
+// BusinessDayEndHourLT applies the LT predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldBusinessDayEndHour, v))
+}
+
+// BusinessDayEndHourLTE applies the LTE predicate on the "business_day_end_hour" field.
+func BusinessDayEndHourLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldBusinessDayEndHour, v))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldIsActive, v))
+}
+
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldIsActive, v))
+}
+
+// CreatedByAdminIDEQ applies the EQ predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDNEQ applies the NEQ predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDIn applies the In predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldCreatedByAdminID, vs...))
+}
+
+// CreatedByAdminIDNotIn applies the NotIn predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldCreatedByAdminID, vs...))
+}
+
+// CreatedByAdminIDGT applies the GT predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDGTE applies the GTE predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDLT applies the LT predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDLTE applies the LTE predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldCreatedByAdminID, v))
+}
+
+// CreatedByAdminIDIsNil applies the IsNil predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldCreatedByAdminID))
+}
+
+// CreatedByAdminIDNotNil applies the NotNil predicate on the "created_by_admin_id" field.
+func CreatedByAdminIDNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldCreatedByAdminID))
+}
+
+// UpdatedByAdminIDEQ applies the EQ predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldUpdatedByAdminID, v))
+}
+
+// UpdatedByAdminIDNEQ applies the NEQ predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldUpdatedByAdminID, v))
+}
+
+// UpdatedByAdminIDIn applies the In predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldUpdatedByAdminID, vs...))
+}
+
+// UpdatedByAdminIDNotIn applies the NotIn predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldUpdatedByAdminID, vs...))
+}
+
+// UpdatedByAdminIDGT applies the GT predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldUpdatedByAdminID, v))
+}
+
+// UpdatedByAdminIDGTE applies the GTE predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldUpdatedByAdminID, v))
+}
+
+// UpdatedByAdminIDLT applies the LT predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldUpdatedByAdminID, v))
+}
+
+// UpdatedByAdminIDLTE applies the LTE predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldUpdatedByAdminID, v))
+}
+
+// UpdatedByAdminIDIsNil applies the IsNil predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldUpdatedByAdminID))
+}
+
+// UpdatedByAdminIDNotNil applies the NotNil predicate on the "updated_by_admin_id" field.
+func UpdatedByAdminIDNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldUpdatedByAdminID))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SLAPolicy) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SLAPolicy) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SLAPolicy) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/slapolicy"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SLAPolicyCreate is the builder for creating a SLAPolicy entity.
+type SLAPolicyCreate struct {
+	config
+	mutation *SLAPolicyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SLAPolicyCreate) SetCreatedAt(v time.Time) *SLAPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableCreatedAt(v *time.Time) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SLAPolicyCreate) SetUpdatedAt(v time.Time) *SLAPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableUpdatedAt(v *time.Time) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *SLAPolicyCreate) SetDeletedAt(v time.Time) *SLAPolicyCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableDeletedAt(v *time.Time) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SLAPolicyCreate) SetName(v string) *SLAPolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSensitivityLevel sets the "sensitivity_level" field.
+func (_c *SLAPolicyCreate) SetSensitivityLevel(v string) *SLAPolicyCreate {
+	_c.mutation.SetSensitivityLevel(v)
+	return _c
+}
+
+// SetNillableSensitivityLevel sets the "sensitivity_level" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableSensitivityLevel(v *string) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetSensitivityLevel(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *SLAPolicyCreate) SetCategoryID(v int) *SLAPolicyCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableCategoryID(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetOwnerResponseHours sets the "owner_response_hours" field.
+func (_c *SLAPolicyCreate) SetOwnerResponseHours(v int) *SLAPolicyCreate {
+	_c.mutation.SetOwnerResponseHours(v)
+	return _c
+}
+
+// SetNillableOwnerResponseHours sets the "owner_response_hours" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableOwnerResponseHours(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetOwnerResponseHours(*v)
+	}
+	return _c
+}
+
+// SetReminderHours sets the "reminder_hours" field.
+func (_c *SLAPolicyCreate) SetReminderHours(v []int) *SLAPolicyCreate {
+	_c.mutation.SetReminderHours(v)
+	return _c
+}
+
+// SetBusinessHoursOnly sets the "business_hours_only" field.
+func (_c *SLAPolicyCreate) SetBusinessHoursOnly(v bool) *SLAPolicyCreate {
+	_c.mutation.SetBusinessHoursOnly(v)
+	return _c
+}
+
+// SetNillableBusinessHoursOnly sets the "business_hours_only" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableBusinessHoursOnly(v *bool) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetBusinessHoursOnly(*v)
+	}
+	return _c
+}
+
+// SetBusinessDayStartHour sets the "business_day_start_hour" field.
+func (_c *SLAPolicyCreate) SetBusinessDayStartHour(v int) *SLAPolicyCreate {
+	_c.mutation.SetBusinessDayStartHour(v)
+	return _c
+}
+
+// SetNillableBusinessDayStartHour sets the "business_day_start_hour" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableBusinessDayStartHour(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetBusinessDayStartHour(*v)
+	}
+	return _c
+}
+
+// SetBusinessDayEndHour sets the "business_day_end_hour" field.
+func (_c *SLAPolicyCreate) SetBusinessDayEndHour(v int) *SLAPolicyCreate {
+	_c.mutation.SetBusinessDayEndHour(v)
+	return _c
+}
+
+// SetNillableBusinessDayEndHour sets the "business_day_end_hour" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableBusinessDayEndHour(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetBusinessDayEndHour(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *SLAPolicyCreate) SetTimezone(v string) *SLAPolicyCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableTimezone(v *string) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetIsActive sets the "is_active" field.
+func (_c *SLAPolicyCreate) SetIsActive(v bool) *SLAPolicyCreate {
+	_c.mutation.SetIsActive(v)
+	return _c
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableIsActive(v *bool) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetIsActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedByAdminID sets the "created_by_admin_id" field.
+func (_c *SLAPolicyCreate) SetCreatedByAdminID(v int) *SLAPolicyCreate {
+	_c.mutation.SetCreatedByAdminID(v)
+	return _c
+}
+
+// SetNillableCreatedByAdminID sets the "created_by_admin_id" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableCreatedByAdminID(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetCreatedByAdminID(*v)
+	}
+	return _c
+}
+
+// SetUpdatedByAdminID sets the "updated_by_admin_id" field.
+func (_c *SLAPolicyCreate) SetUpdatedByAdminID(v int) *SLAPolicyCreate {
+	_c.mutation.SetUpdatedByAdminID(v)
+	return _c
+}
+
+// SetNillableUpdatedByAdminID sets the "updated_by_admin_id" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableUpdatedByAdminID(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedByAdminID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SLAPolicyMutation object of the builder.
+func (_c *SLAPolicyCreate) Mutation() *SLAPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the SLAPolicy in the database.
+func (_c *SLAPolicyCreate) Save(ctx context.Context) (*SLAPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SLAPolicyCreate) SaveX(ctx context.Context) *SLAPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SLAPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SLAPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SLAPolicyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := slapolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := slapolicy.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SensitivityLevel(); !ok {
+		v := slapolicy.DefaultSensitivityLevel
+		_c.mutation.SetSensitivityLevel(v)
+	}
+	if _, ok := _c.mutation.OwnerResponseHours(); !ok {
+		v := slapolicy.DefaultOwnerResponseHours
+		_c.mutation.SetOwnerResponseHours(v)
+	}
+	if _, ok := _c.mutation.BusinessHoursOnly(); !ok {
+		v := slapolicy.DefaultBusinessHoursOnly
+		_c.mutation.SetBusinessHoursOnly(v)
+	}
+	if _, ok := _c.mutation.BusinessDayStartHour(); !ok {
+		v := slapolicy.DefaultBusinessDayStartHour
+		_c.mutation.SetBusinessDayStartHour(v)
+	}
+	if _, ok := _c.mutation.BusinessDayEndHour(); !ok {
+		v := slapolicy.DefaultBusinessDayEndHour
+		_c.mutation.SetBusinessDayEndHour(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := slapolicy.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		v := slapolicy.DefaultIsActive
+		_c.mutation.SetIsActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SLAPolicyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SLAPolicy.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SLAPolicy.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SLAPolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := slapolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SensitivityLevel(); !ok {
+		return &ValidationError{Name: "sensitivity_level", err: errors.New(`ent: missing required field "SLAPolicy.sensitivity_level"`)}
+	}
+	if v, ok := _c.mutation.SensitivityLevel(); ok {
+		if err := slapolicy.SensitivityLevelValidator(v); err != nil {
+			return &ValidationError{Name: "sensitivity_level", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.sensitivity_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OwnerResponseHours(); !ok {
+		return &ValidationError{Name: "owner_response_hours", err: errors.New(`ent: missing required field "SLAPolicy.owner_response_hours"`)}
+	}
+	if v, ok := _c.mutation.OwnerResponseHours(); ok {
+		if err := slapolicy.OwnerResponseHoursValidator(v); err != nil {
+			return &ValidationError{Name: "owner_response_hours", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.owner_response_hours": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BusinessHoursOnly(); !ok {
+		return &ValidationError{Name: "business_hours_only", err: errors.New(`ent: missing required field "SLAPolicy.business_hours_only"`)}
+	}
+	if _, ok := _c.mutation.BusinessDayStartHour(); !ok {
+		return &ValidationError{Name: "business_day_start_hour", err: errors.New(`ent: missing required field "SLAPolicy.business_day_start_hour"`)}
+	}
+	if v, ok := _c.mutation.BusinessDayStartHour(); ok {
+		if err := slapolicy.BusinessDayStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "business_day_start_hour", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.business_day_start_hour": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BusinessDayEndHour(); !ok {
+		return &ValidationError{Name: "business_day_end_hour", err: errors.New(`ent: missing required field "SLAPolicy.business_day_end_hour"`)}
+	}
+	if v, ok := _c.mutation.BusinessDayEndHour(); ok {
+		if err := slapolicy.BusinessDayEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "business_day_end_hour", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.business_day_end_hour": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "SLAPolicy.timezone"`)}
+	}
+	if v, ok := _c.mutation.Timezone(); ok {
+		if err := slapolicy.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.timezone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "SLAPolicy.is_active"`)}
+	}
+	return nil
+}
+
+func (_c *SLAPolicyCreate) sqlSave(ctx context.Context) (*SLAPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SLAPolicyCreate) createSpec() (*SLAPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SLAPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(slapolicy.Table, sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(slapolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(slapolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(slapolicy.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(slapolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.SensitivityLevel(); ok {
+		_spec.SetField(slapolicy.FieldSensitivityLevel, field.TypeString, value)
+		_node.SensitivityLevel = value
+	}
+	if value, ok := _c.mutation.CategoryID(); ok {
+		_spec.SetField(slapolicy.FieldCategoryID, field.TypeInt, value)
+		_node.CategoryID = &value
+	}
+	if value, ok := _c.mutation.OwnerResponseHours(); ok {
+		_spec.SetField(slapolicy.FieldOwnerResponseHours, field.TypeInt, value)
+		_node.OwnerResponseHours = value
+	}
+	if value, ok := _c.mutation.ReminderHours(); ok {
+		_spec.SetField(slapolicy.FieldReminderHours, field.TypeJSON, value)
+		_node.ReminderHours = value
+	}
+	if value, ok := _c.mutation.BusinessHoursOnly(); ok {
+		_spec.SetField(slapolicy.FieldBusinessHoursOnly, field.TypeBool, value)
+		_node.BusinessHoursOnly = value
+	}
+	if value, ok := _c.mutation.BusinessDayStartHour(); ok {
+		_spec.SetField(slapolicy.FieldBusinessDayStartHour, field.TypeInt, value)
+		_node.BusinessDayStartHour = value
+	}
+	if value, ok := _c.mutation.BusinessDayEndHour(); ok {
+		_spec.SetField(slapolicy.FieldBusinessDayEndHour, field.TypeInt, value)
+		_node.BusinessDayEndHour = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(slapolicy.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.IsActive(); ok {
+		_spec.SetField(slapolicy.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := _c.mutation.CreatedByAdminID(); ok {
+		_spec.SetField(slapolicy.FieldCreatedByAdminID, field.TypeInt, value)
+		_node.CreatedByAdminID = &value
+	}
+	if value, ok := _c.mutation.UpdatedByAdminID(); ok {
+		_spec.SetField(slapolicy.FieldUpdatedByAdminID, field.TypeInt, value)
+		_node.UpdatedByAdminID = &value
+	}
+	return _node, _spec
+}
+
+// SLAPolicyCreateBulk is the builder for creating many SLAPolicy entities in bulk.
+type SLAPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*SLAPolicyCreate
+}
+
+// Save creates the SLAPolicy entities in the database.
+func (_c *SLAPolicyCreateBulk) Save(ctx context.Context) ([]*SLAPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SLAPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SLAPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SLAPolicyCreateBulk) SaveX(ctx context.Context) []*SLAPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SLAPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SLAPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
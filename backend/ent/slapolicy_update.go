@@ -0,0 +1,947 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/slapolicy"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SLAPolicyUpdate is the builder for updating SLAPolicy entities.
+type SLAPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SLAPolicyMutation
+}
+
+// Where appends a list predicates to the SLAPolicyUpdate builder.
+func (_u *SLAPolicyUpdate) Where(ps ...predicate.SLAPolicy) *SLAPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SLAPolicyUpdate) SetUpdatedAt(v time.Time) *SLAPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *SLAPolicyUpdate) SetDeletedAt(v time.Time) *SLAPolicyUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableDeletedAt(v *time.Time) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *SLAPolicyUpdate) ClearDeletedAt() *SLAPolicyUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SLAPolicyUpdate) SetName(v string) *SLAPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableName(v *string) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSensitivityLevel sets the "sensitivity_level" field.
+func (_u *SLAPolicyUpdate) SetSensitivityLevel(v string) *SLAPolicyUpdate {
+	_u.mutation.SetSensitivityLevel(v)
+	return _u
+}
+
+// SetNillableSensitivityLevel sets the "sensitivity_level" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableSensitivityLevel(v *string) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetSensitivityLevel(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *SLAPolicyUpdate) SetCategoryID(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableCategoryID(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *SLAPolicyUpdate) AddCategoryID(v int) *SLAPolicyUpdate {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *SLAPolicyUpdate) ClearCategoryID() *SLAPolicyUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetOwnerResponseHours sets the "owner_response_hours" field.
+func (_u *SLAPolicyUpdate) SetOwnerResponseHours(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetOwnerResponseHours()
+	_u.mutation.SetOwnerResponseHours(v)
+	return _u
+}
+
+// SetNillableOwnerResponseHours sets the "owner_response_hours" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableOwnerResponseHours(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetOwnerResponseHours(*v)
+	}
+	return _u
+}
+
+// AddOwnerResponseHours adds value to the "owner_response_hours" field.
+func (_u *SLAPolicyUpdate) AddOwnerResponseHours(v int) *SLAPolicyUpdate {
+	_u.mutation.AddOwnerResponseHours(v)
+	return _u
+}
+
+// SetReminderHours sets the "reminder_hours" field.
+func (_u *SLAPolicyUpdate) SetReminderHours(v []int) *SLAPolicyUpdate {
+	_u.mutation.SetReminderHours(v)
+	return _u
+}
+
+// AppendReminderHours appends value to the "reminder_hours" field.
+func (_u *SLAPolicyUpdate) AppendReminderHours(v []int) *SLAPolicyUpdate {
+	_u.mutation.AppendReminderHours(v)
+	return _u
+}
+
+// ClearReminderHours clears the value of the "reminder_hours" field.
+func (_u *SLAPolicyUpdate) ClearReminderHours() *SLAPolicyUpdate {
+	_u.mutation.ClearReminderHours()
+	return _u
+}
+
+// SetBusinessHoursOnly sets the "business_hours_only" field.
+func (_u *SLAPolicyUpdate) SetBusinessHoursOnly(v bool) *SLAPolicyUpdate {
+	_u.mutation.SetBusinessHoursOnly(v)
+	return _u
+}
+
+// SetNillableBusinessHoursOnly sets the "business_hours_only" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableBusinessHoursOnly(v *bool) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetBusinessHoursOnly(*v)
+	}
+	return _u
+}
+
+// SetBusinessDayStartHour sets the "business_day_start_hour" field.
+func (_u *SLAPolicyUpdate) SetBusinessDayStartHour(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetBusinessDayStartHour()
+	_u.mutation.SetBusinessDayStartHour(v)
+	return _u
+}
+
+// SetNillableBusinessDayStartHour sets the "business_day_start_hour" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableBusinessDayStartHour(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetBusinessDayStartHour(*v)
+	}
+	return _u
+}
+
+// AddBusinessDayStartHour adds value to the "business_day_start_hour" field.
+func (_u *SLAPolicyUpdate) AddBusinessDayStartHour(v int) *SLAPolicyUpdate {
+	_u.mutation.AddBusinessDayStartHour(v)
+	return _u
+}
+
+// SetBusinessDayEndHour sets the "business_day_end_hour" field.
+func (_u *SLAPolicyUpdate) SetBusinessDayEndHour(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetBusinessDayEndHour()
+	_u.mutation.SetBusinessDayEndHour(v)
+	return _u
+}
+
+// SetNillableBusinessDayEndHour sets the "business_day_end_hour" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableBusinessDayEndHour(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetBusinessDayEndHour(*v)
+	}
+	return _u
+}
+
+// AddBusinessDayEndHour adds value to the "business_day_end_hour" field.
+func (_u *SLAPolicyUpdate) AddBusinessDayEndHour(v int) *SLAPolicyUpdate {
+	_u.mutation.AddBusinessDayEndHour(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *SLAPolicyUpdate) SetTimezone(v string) *SLAPolicyUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableTimezone(v *string) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *SLAPolicyUpdate) SetIsActive(v bool) *SLAPolicyUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableIsActive(v *bool) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedByAdminID sets the "created_by_admin_id" field.
+func (_u *SLAPolicyUpdate) SetCreatedByAdminID(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetCreatedByAdminID()
+	_u.mutation.SetCreatedByAdminID(v)
+	return _u
+}
+
+// SetNillableCreatedByAdminID sets the "created_by_admin_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableCreatedByAdminID(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddCreatedByAdminID adds value to the "created_by_admin_id" field.
+func (_u *SLAPolicyUpdate) AddCreatedByAdminID(v int) *SLAPolicyUpdate {
+	_u.mutation.AddCreatedByAdminID(v)
+	return _u
+}
+
+// ClearCreatedByAdminID clears the value of the "created_by_admin_id" field.
+func (_u *SLAPolicyUpdate) ClearCreatedByAdminID() *SLAPolicyUpdate {
+	_u.mutation.ClearCreatedByAdminID()
+	return _u
+}
+
+// SetUpdatedByAdminID sets the "updated_by_admin_id" field.
+func (_u *SLAPolicyUpdate) SetUpdatedByAdminID(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetUpdatedByAdminID()
+	_u.mutation.SetUpdatedByAdminID(v)
+	return _u
+}
+
+// SetNillableUpdatedByAdminID sets the "updated_by_admin_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableUpdatedByAdminID(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetUpdatedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddUpdatedByAdminID adds value to the "updated_by_admin_id" field.
+func (_u *SLAPolicyUpdate) AddUpdatedByAdminID(v int) *SLAPolicyUpdate {
+	_u.mutation.AddUpdatedByAdminID(v)
+	return _u
+}
+
+// ClearUpdatedByAdminID clears the value of the "updated_by_admin_id" field.
+func (_u *SLAPolicyUpdate) ClearUpdatedByAdminID() *SLAPolicyUpdate {
+	_u.mutation.ClearUpdatedByAdminID()
+	return _u
+}
+
+// Mutation returns the SLAPolicyMutation object of the builder.
+func (_u *SLAPolicyUpdate) Mutation() *SLAPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SLAPolicyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SLAPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SLAPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SLAPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SLAPolicyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := slapolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SLAPolicyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := slapolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SensitivityLevel(); ok {
+		if err := slapolicy.SensitivityLevelValidator(v); err != nil {
+			return &ValidationError{Name: "sensitivity_level", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.sensitivity_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OwnerResponseHours(); ok {
+		if err := slapolicy.OwnerResponseHoursValidator(v); err != nil {
+			return &ValidationError{Name: "owner_response_hours", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.owner_response_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BusinessDayStartHour(); ok {
+		if err := slapolicy.BusinessDayStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "business_day_start_hour", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.business_day_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BusinessDayEndHour(); ok {
+		if err := slapolicy.BusinessDayEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "business_day_end_hour", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.business_day_end_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := slapolicy.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.timezone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SLAPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slapolicy.Table, slapolicy.Columns, sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(slapolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(slapolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(slapolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(slapolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SensitivityLevel(); ok {
+		_spec.SetField(slapolicy.FieldSensitivityLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(slapolicy.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(slapolicy.FieldCategoryID, field.TypeInt, value)
+	}
+	if _u.mutation.CategoryIDCleared() {
+		_spec.ClearField(slapolicy.FieldCategoryID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OwnerResponseHours(); ok {
+		_spec.SetField(slapolicy.FieldOwnerResponseHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerResponseHours(); ok {
+		_spec.AddField(slapolicy.FieldOwnerResponseHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReminderHours(); ok {
+		_spec.SetField(slapolicy.FieldReminderHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReminderHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, slapolicy.FieldReminderHours, value)
+		})
+	}
+	if _u.mutation.ReminderHoursCleared() {
+		_spec.ClearField(slapolicy.FieldReminderHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BusinessHoursOnly(); ok {
+		_spec.SetField(slapolicy.FieldBusinessHoursOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BusinessDayStartHour(); ok {
+		_spec.SetField(slapolicy.FieldBusinessDayStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBusinessDayStartHour(); ok {
+		_spec.AddField(slapolicy.FieldBusinessDayStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BusinessDayEndHour(); ok {
+		_spec.SetField(slapolicy.FieldBusinessDayEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBusinessDayEndHour(); ok {
+		_spec.AddField(slapolicy.FieldBusinessDayEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(slapolicy.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(slapolicy.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedByAdminID(); ok {
+		_spec.SetField(slapolicy.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedByAdminID(); ok {
+		_spec.AddField(slapolicy.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByAdminIDCleared() {
+		_spec.ClearField(slapolicy.FieldCreatedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedByAdminID(); ok {
+		_spec.SetField(slapolicy.FieldUpdatedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedByAdminID(); ok {
+		_spec.AddField(slapolicy.FieldUpdatedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.UpdatedByAdminIDCleared() {
+		_spec.ClearField(slapolicy.FieldUpdatedByAdminID, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slapolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SLAPolicyUpdateOne is the builder for updating a single SLAPolicy entity.
+type SLAPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SLAPolicyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SLAPolicyUpdateOne) SetUpdatedAt(v time.Time) *SLAPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *SLAPolicyUpdateOne) SetDeletedAt(v time.Time) *SLAPolicyUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableDeletedAt(v *time.Time) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *SLAPolicyUpdateOne) ClearDeletedAt() *SLAPolicyUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SLAPolicyUpdateOne) SetName(v string) *SLAPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableName(v *string) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSensitivityLevel sets the "sensitivity_level" field.
+func (_u *SLAPolicyUpdateOne) SetSensitivityLevel(v string) *SLAPolicyUpdateOne {
+	_u.mutation.SetSensitivityLevel(v)
+	return _u
+}
+
+// SetNillableSensitivityLevel sets the "sensitivity_level" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableSensitivityLevel(v *string) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetSensitivityLevel(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *SLAPolicyUpdateOne) SetCategoryID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableCategoryID(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *SLAPolicyUpdateOne) AddCategoryID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *SLAPolicyUpdateOne) ClearCategoryID() *SLAPolicyUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetOwnerResponseHours sets the "owner_response_hours" field.
+func (_u *SLAPolicyUpdateOne) SetOwnerResponseHours(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetOwnerResponseHours()
+	_u.mutation.SetOwnerResponseHours(v)
+	return _u
+}
+
+// SetNillableOwnerResponseHours sets the "owner_response_hours" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableOwnerResponseHours(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetOwnerResponseHours(*v)
+	}
+	return _u
+}
+
+// AddOwnerResponseHours adds value to the "owner_response_hours" field.
+func (_u *SLAPolicyUpdateOne) AddOwnerResponseHours(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddOwnerResponseHours(v)
+	return _u
+}
+
+// SetReminderHours sets the "reminder_hours" field.
+func (_u *SLAPolicyUpdateOne) SetReminderHours(v []int) *SLAPolicyUpdateOne {
+	_u.mutation.SetReminderHours(v)
+	return _u
+}
+
+// AppendReminderHours appends value to the "reminder_hours" field.
+func (_u *SLAPolicyUpdateOne) AppendReminderHours(v []int) *SLAPolicyUpdateOne {
+	_u.mutation.AppendReminderHours(v)
+	return _u
+}
+
+// ClearReminderHours clears the value of the "reminder_hours" field.
+func (_u *SLAPolicyUpdateOne) ClearReminderHours() *SLAPolicyUpdateOne {
+	_u.mutation.ClearReminderHours()
+	return _u
+}
+
+// SetBusinessHoursOnly sets the "business_hours_only" field.
+func (_u *SLAPolicyUpdateOne) SetBusinessHoursOnly(v bool) *SLAPolicyUpdateOne {
+	_u.mutation.SetBusinessHoursOnly(v)
+	return _u
+}
+
+// SetNillableBusinessHoursOnly sets the "business_hours_only" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableBusinessHoursOnly(v *bool) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetBusinessHoursOnly(*v)
+	}
+	return _u
+}
+
+// SetBusinessDayStartHour sets the "business_day_start_hour" field.
+func (_u *SLAPolicyUpdateOne) SetBusinessDayStartHour(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetBusinessDayStartHour()
+	_u.mutation.SetBusinessDayStartHour(v)
+	return _u
+}
+
+// SetNillableBusinessDayStartHour sets the "business_day_start_hour" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableBusinessDayStartHour(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetBusinessDayStartHour(*v)
+	}
+	return _u
+}
+
+// AddBusinessDayStartHour adds value to the "business_day_start_hour" field.
+func (_u *SLAPolicyUpdateOne) AddBusinessDayStartHour(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddBusinessDayStartHour(v)
+	return _u
+}
+
+// SetBusinessDayEndHour sets the "business_day_end_hour" field.
+func (_u *SLAPolicyUpdateOne) SetBusinessDayEndHour(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetBusinessDayEndHour()
+	_u.mutation.SetBusinessDayEndHour(v)
+	return _u
+}
+
+// SetNillableBusinessDayEndHour sets the "business_day_end_hour" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableBusinessDayEndHour(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetBusinessDayEndHour(*v)
+	}
+	return _u
+}
+
+// AddBusinessDayEndHour adds value to the "business_day_end_hour" field.
+func (_u *SLAPolicyUpdateOne) AddBusinessDayEndHour(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddBusinessDayEndHour(v)
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *SLAPolicyUpdateOne) SetTimezone(v string) *SLAPolicyUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableTimezone(v *string) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *SLAPolicyUpdateOne) SetIsActive(v bool) *SLAPolicyUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableIsActive(v *bool) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedByAdminID sets the "created_by_admin_id" field.
+func (_u *SLAPolicyUpdateOne) SetCreatedByAdminID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetCreatedByAdminID()
+	_u.mutation.SetCreatedByAdminID(v)
+	return _u
+}
+
+// SetNillableCreatedByAdminID sets the "created_by_admin_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableCreatedByAdminID(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddCreatedByAdminID adds value to the "created_by_admin_id" field.
+func (_u *SLAPolicyUpdateOne) AddCreatedByAdminID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddCreatedByAdminID(v)
+	return _u
+}
+
+// ClearCreatedByAdminID clears the value of the "created_by_admin_id" field.
+func (_u *SLAPolicyUpdateOne) ClearCreatedByAdminID() *SLAPolicyUpdateOne {
+	_u.mutation.ClearCreatedByAdminID()
+	return _u
+}
+
+// SetUpdatedByAdminID sets the "updated_by_admin_id" field.
+func (_u *SLAPolicyUpdateOne) SetUpdatedByAdminID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetUpdatedByAdminID()
+	_u.mutation.SetUpdatedByAdminID(v)
+	return _u
+}
+
+// SetNillableUpdatedByAdminID sets the "updated_by_admin_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableUpdatedByAdminID(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdatedByAdminID(*v)
+	}
+	return _u
+}
+
+// AddUpdatedByAdminID adds value to the "updated_by_admin_id" field.
+func (_u *SLAPolicyUpdateOne) AddUpdatedByAdminID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddUpdatedByAdminID(v)
+	return _u
+}
+
+// ClearUpdatedByAdminID clears the value of the "updated_by_admin_id" field.
+func (_u *SLAPolicyUpdateOne) ClearUpdatedByAdminID() *SLAPolicyUpdateOne {
+	_u.mutation.ClearUpdatedByAdminID()
+	return _u
+}
+
+// Mutation returns the SLAPolicyMutation object of the builder.
+func (_u *SLAPolicyUpdateOne) Mutation() *SLAPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SLAPolicyUpdate builder.
+func (_u *SLAPolicyUpdateOne) Where(ps ...predicate.SLAPolicy) *SLAPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SLAPolicyUpdateOne) Select(field string, fields ...string) *SLAPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SLAPolicy entity.
+func (_u *SLAPolicyUpdateOne) Save(ctx context.Context) (*SLAPolicy, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SLAPolicyUpdateOne) SaveX(ctx context.Context) *SLAPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SLAPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SLAPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SLAPolicyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := slapolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SLAPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := slapolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SensitivityLevel(); ok {
+		if err := slapolicy.SensitivityLevelValidator(v); err != nil {
+			return &ValidationError{Name: "sensitivity_level", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.sensitivity_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OwnerResponseHours(); ok {
+		if err := slapolicy.OwnerResponseHoursValidator(v); err != nil {
+			return &ValidationError{Name: "owner_response_hours", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.owner_response_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BusinessDayStartHour(); ok {
+		if err := slapolicy.BusinessDayStartHourValidator(v); err != nil {
+			return &ValidationError{Name: "business_day_start_hour", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.business_day_start_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BusinessDayEndHour(); ok {
+		if err := slapolicy.BusinessDayEndHourValidator(v); err != nil {
+			return &ValidationError{Name: "business_day_end_hour", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.business_day_end_hour": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := slapolicy.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.timezone": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SLAPolicyUpdateOne) sqlSave(ctx context.Context) (_node *SLAPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slapolicy.Table, slapolicy.Columns, sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SLAPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slapolicy.FieldID)
+		for _, f := range fields {
+			if !slapolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != slapolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(slapolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(slapolicy.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(slapolicy.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(slapolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SensitivityLevel(); ok {
+		_spec.SetField(slapolicy.FieldSensitivityLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(slapolicy.FieldCategoryID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(slapolicy.FieldCategoryID, field.TypeInt, value)
+	}
+	if _u.mutation.CategoryIDCleared() {
+		_spec.ClearField(slapolicy.FieldCategoryID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OwnerResponseHours(); ok {
+		_spec.SetField(slapolicy.FieldOwnerResponseHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerResponseHours(); ok {
+		_spec.AddField(slapolicy.FieldOwnerResponseHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReminderHours(); ok {
+		_spec.SetField(slapolicy.FieldReminderHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReminderHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, slapolicy.FieldReminderHours, value)
+		})
+	}
+	if _u.mutation.ReminderHoursCleared() {
+		_spec.ClearField(slapolicy.FieldReminderHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BusinessHoursOnly(); ok {
+		_spec.SetField(slapolicy.FieldBusinessHoursOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BusinessDayStartHour(); ok {
+		_spec.SetField(slapolicy.FieldBusinessDayStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBusinessDayStartHour(); ok {
+		_spec.AddField(slapolicy.FieldBusinessDayStartHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BusinessDayEndHour(); ok {
+		_spec.SetField(slapolicy.FieldBusinessDayEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBusinessDayEndHour(); ok {
+		_spec.AddField(slapolicy.FieldBusinessDayEndHour, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(slapolicy.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(slapolicy.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedByAdminID(); ok {
+		_spec.SetField(slapolicy.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedByAdminID(); ok {
+		_spec.AddField(slapolicy.FieldCreatedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByAdminIDCleared() {
+		_spec.ClearField(slapolicy.FieldCreatedByAdminID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedByAdminID(); ok {
+		_spec.SetField(slapolicy.FieldUpdatedByAdminID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedByAdminID(); ok {
+		_spec.AddField(slapolicy.FieldUpdatedByAdminID, field.TypeInt, value)
+	}
+	if _u.mutation.UpdatedByAdminIDCleared() {
+		_spec.ClearField(slapolicy.FieldUpdatedByAdminID, field.TypeInt)
+	}
+	_node = &SLAPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slapolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,6 +32,12 @@ type TOTPPendingToken struct {
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	// UsedAt holds the value of the "used_at" field.
 	UsedAt *time.Time `json:"used_at,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose string `json:"purpose,omitempty"`
+	// EmailCodeHash holds the value of the "email_code_hash" field.
+	EmailCodeHash *string `json:"-"`
+	// CodeAttempts holds the value of the "code_attempts" field.
+	CodeAttempts int `json:"code_attempts,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TOTPPendingTokenQuery when eager-loading is set.
 	Edges        TOTPPendingTokenEdges `json:"edges"`
@@ -63,9 +69,9 @@ func (*TOTPPendingToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case totppendingtoken.FieldID, totppendingtoken.FieldUserID:
+		case totppendingtoken.FieldID, totppendingtoken.FieldUserID, totppendingtoken.FieldCodeAttempts:
 			values[i] = new(sql.NullInt64)
-		case totppendingtoken.FieldTokenHash:
+		case totppendingtoken.FieldTokenHash, totppendingtoken.FieldPurpose, totppendingtoken.FieldEmailCodeHash:
 			values[i] = new(sql.NullString)
 		case totppendingtoken.FieldCreatedAt, totppendingtoken.FieldUpdatedAt, totppendingtoken.FieldDeletedAt, totppendingtoken.FieldExpiresAt, totppendingtoken.FieldUsedAt:
 			values[i] = new(sql.NullTime)
@@ -134,6 +140,25 @@ func (_m *TOTPPendingToken) assignValues(columns []string, values []any) error {
 				_m.UsedAt = new(time.Time)
 				*_m.UsedAt = value.Time
 			}
+		case totppendingtoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				_m.Purpose = value.String
+			}
+		case totppendingtoken.FieldEmailCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_code_hash", values[i])
+			} else if value.Valid {
+				_m.EmailCodeHash = new(string)
+				*_m.EmailCodeHash = value.String
+			}
+		case totppendingtoken.FieldCodeAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field code_attempts", values[i])
+			} else if value.Valid {
+				_m.CodeAttempts = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -199,6 +224,14 @@ func (_m *TOTPPendingToken) String() string {
 		builder.WriteString("used_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(_m.Purpose)
+	builder.WriteString(", ")
+	builder.WriteString("email_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("code_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CodeAttempts))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,12 @@ const (
 	FieldExpiresAt = "expires_at"
 	// FieldUsedAt holds the string denoting the used_at field in the database.
 	FieldUsedAt = "used_at"
+	// FieldPurpose holds the string denoting the purpose field in the database.
+	FieldPurpose = "purpose"
+	// FieldEmailCodeHash holds the string denoting the email_code_hash field in the database.
+	FieldEmailCodeHash = "email_code_hash"
+	// FieldCodeAttempts holds the string denoting the code_attempts field in the database.
+	FieldCodeAttempts = "code_attempts"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the totppendingtoken in the database.
@@ -51,6 +57,9 @@ var Columns = []string{
 	FieldTokenHash,
 	FieldExpiresAt,
 	FieldUsedAt,
+	FieldPurpose,
+	FieldEmailCodeHash,
+	FieldCodeAttempts,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,6 +83,10 @@ var (
 	UserIDValidator func(int) error
 	// TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
 	TokenHashValidator func(string) error
+	// DefaultPurpose holds the default value on creation for the "purpose" field.
+	DefaultPurpose string
+	// DefaultCodeAttempts holds the default value on creation for the "code_attempts" field.
+	DefaultCodeAttempts int
 )
 
 // OrderOption defines the ordering options for the TOTPPendingToken queries.
@@ -119,6 +132,21 @@ func ByUsedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsedAt, opts...).ToFunc()
 }
 
+// ByPurpose orders the results by the purpose field.
+func ByPurpose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurpose, opts...).ToFunc()
+}
+
+// ByEmailCodeHash orders the results by the email_code_hash field.
+func ByEmailCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailCodeHash, opts...).ToFunc()
+}
+
+// ByCodeAttempts orders the results by the code_attempts field.
+func ByCodeAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeAttempts, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
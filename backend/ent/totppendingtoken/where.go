@@ -90,6 +90,21 @@ func UsedAt(v time.Time) predicate.TOTPPendingToken {
 	return predicate.TOTPPendingToken(sql.FieldEQ(FieldUsedAt, v))
 }
 
+// Purpose applies equality check predicate on the "purpose" field. It's identical to PurposeEQ.
+func Purpose(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEQ(FieldPurpose, v))
+}
+
+// EmailCodeHash applies equality check predicate on the "email_code_hash" field. It's identical to EmailCodeHashEQ.
+func EmailCodeHash(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEQ(FieldEmailCodeHash, v))
+}
+
+// CodeAttempts applies equality check predicate on the "code_attempts" field. It's identical to CodeAttemptsEQ.
+func CodeAttempts(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEQ(FieldCodeAttempts, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.TOTPPendingToken {
 	return predicate.TOTPPendingToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -395,6 +410,186 @@ func UsedAtNotNil() predicate.TOTPPendingToken {
 	return predicate.TOTPPendingToken(sql.FieldNotNull(FieldUsedAt))
 }
 
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// PurposeGT applies the GT predicate on the "purpose" field.
+func PurposeGT(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldGT(FieldPurpose, v))
+}
+
+// PurposeGTE applies the GTE predicate on the "purpose" field.
+func PurposeGTE(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldGTE(FieldPurpose, v))
+}
+
+// PurposeLT applies the LT predicate on the "purpose" field.
+func PurposeLT(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldLT(FieldPurpose, v))
+}
+
+// PurposeLTE applies the LTE predicate on the "purpose" field.
+func PurposeLTE(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldLTE(FieldPurpose, v))
+}
+
+// PurposeContains applies the Contains predicate on the "purpose" field.
+func PurposeContains(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldContains(FieldPurpose, v))
+}
+
+// PurposeHasPrefix applies the HasPrefix predicate on the "purpose" field.
+func PurposeHasPrefix(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldHasPrefix(FieldPurpose, v))
+}
+
+// PurposeHasSuffix applies the HasSuffix predicate on the "purpose" field.
+func PurposeHasSuffix(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldHasSuffix(FieldPurpose, v))
+}
+
+// PurposeEqualFold applies the EqualFold predicate on the "purpose" field.
+func PurposeEqualFold(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEqualFold(FieldPurpose, v))
+}
+
+// PurposeContainsFold applies the ContainsFold predicate on the "purpose" field.
+func PurposeContainsFold(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldContainsFold(FieldPurpose, v))
+}
+
+// EmailCodeHashEQ applies the EQ predicate on the "email_code_hash" field.
+func EmailCodeHashEQ(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEQ(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashNEQ applies the NEQ predicate on the "email_code_hash" field.
+func EmailCodeHashNEQ(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNEQ(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashIn applies the In predicate on the "email_code_hash" field.
+func EmailCodeHashIn(vs ...string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldIn(FieldEmailCodeHash, vs...))
+}
+
+// EmailCodeHashNotIn applies the NotIn predicate on the "email_code_hash" field.
+func EmailCodeHashNotIn(vs ...string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNotIn(FieldEmailCodeHash, vs...))
+}
+
+// EmailCodeHashGT applies the GT predicate on the "email_code_hash" field.
+func EmailCodeHashGT(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldGT(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashGTE applies the GTE predicate on the "email_code_hash" field.
+func EmailCodeHashGTE(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldGTE(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashLT applies the LT predicate on the "email_code_hash" field.
+func EmailCodeHashLT(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldLT(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashLTE applies the LTE predicate on the "email_code_hash" field.
+func EmailCodeHashLTE(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldLTE(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashContains applies the Contains predicate on the "email_code_hash" field.
+func EmailCodeHashContains(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldContains(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashHasPrefix applies the HasPrefix predicate on the "email_code_hash" field.
+func EmailCodeHashHasPrefix(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldHasPrefix(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashHasSuffix applies the HasSuffix predicate on the "email_code_hash" field.
+func EmailCodeHashHasSuffix(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldHasSuffix(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashIsNil applies the IsNil predicate on the "email_code_hash" field.
+func EmailCodeHashIsNil() predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldIsNull(FieldEmailCodeHash))
+}
+
+// EmailCodeHashNotNil applies the NotNil predicate on the "email_code_hash" field.
+func EmailCodeHashNotNil() predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNotNull(FieldEmailCodeHash))
+}
+
+// EmailCodeHashEqualFold applies the EqualFold predicate on the "email_code_hash" field.
+func EmailCodeHashEqualFold(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEqualFold(FieldEmailCodeHash, v))
+}
+
+// EmailCodeHashContainsFold applies the ContainsFold predicate on the "email_code_hash" field.
+func EmailCodeHashContainsFold(v string) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldContainsFold(FieldEmailCodeHash, v))
+}
+
+// CodeAttemptsEQ applies the EQ predicate on the "code_attempts" field.
+func CodeAttemptsEQ(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldEQ(FieldCodeAttempts, v))
+}
+
+// CodeAttemptsNEQ applies the NEQ predicate on the "code_attempts" field.
+func CodeAttemptsNEQ(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNEQ(FieldCodeAttempts, v))
+}
+
+// CodeAttemptsIn applies the In predicate on the "code_attempts" field.
+func CodeAttemptsIn(vs ...int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldIn(FieldCodeAttempts, vs...))
+}
+
+// CodeAttemptsNotIn applies the NotIn predicate on the "code_attempts" field.
+func CodeAttemptsNotIn(vs ...int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldNotIn(FieldCodeAttempts, vs...))
+}
+
+// CodeAttemptsGT applies the GT predicate on the "code_attempts" field.
+func CodeAttemptsGT(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldGT(FieldCodeAttempts, v))
+}
+
+// CodeAttemptsGTE applies the GTE predicate on the "code_attempts" field.
+func CodeAttemptsGTE(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldGTE(FieldCodeAttempts, v))
+}
+
+// CodeAttemptsLT applies the LT predicate on the "code_attempts" field.
+func CodeAttemptsLT(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldLT(FieldCodeAttempts, v))
+}
+
+// CodeAttemptsLTE applies the LTE predicate on the "code_attempts" field.
+func CodeAttemptsLTE(v int) predicate.TOTPPendingToken {
+	return predicate.TOTPPendingToken(sql.FieldLTE(FieldCodeAttempts, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.TOTPPendingToken {
 	return predicate.TOTPPendingToken(func(s *sql.Selector) {
@@ -95,6 +95,48 @@ func (_c *TOTPPendingTokenCreate) SetNillableUsedAt(v *time.Time) *TOTPPendingTo
 	return _c
 }
 
+// SetPurpose sets the "purpose" field.
+func (_c *TOTPPendingTokenCreate) SetPurpose(v string) *TOTPPendingTokenCreate {
+	_c.mutation.SetPurpose(v)
+	return _c
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_c *TOTPPendingTokenCreate) SetNillablePurpose(v *string) *TOTPPendingTokenCreate {
+	if v != nil {
+		_c.SetPurpose(*v)
+	}
+	return _c
+}
+
+// SetEmailCodeHash sets the "email_code_hash" field.
+func (_c *TOTPPendingTokenCreate) SetEmailCodeHash(v string) *TOTPPendingTokenCreate {
+	_c.mutation.SetEmailCodeHash(v)
+	return _c
+}
+
+// SetNillableEmailCodeHash sets the "email_code_hash" field if the given value is not nil.
+func (_c *TOTPPendingTokenCreate) SetNillableEmailCodeHash(v *string) *TOTPPendingTokenCreate {
+	if v != nil {
+		_c.SetEmailCodeHash(*v)
+	}
+	return _c
+}
+
+// SetCodeAttempts sets the "code_attempts" field.
+func (_c *TOTPPendingTokenCreate) SetCodeAttempts(v int) *TOTPPendingTokenCreate {
+	_c.mutation.SetCodeAttempts(v)
+	return _c
+}
+
+// SetNillableCodeAttempts sets the "code_attempts" field if the given value is not nil.
+func (_c *TOTPPendingTokenCreate) SetNillableCodeAttempts(v *int) *TOTPPendingTokenCreate {
+	if v != nil {
+		_c.SetCodeAttempts(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *TOTPPendingTokenCreate) SetUser(v *User) *TOTPPendingTokenCreate {
 	return _c.SetUserID(v.ID)
@@ -143,6 +185,14 @@ func (_c *TOTPPendingTokenCreate) defaults() {
 		v := totppendingtoken.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		v := totppendingtoken.DefaultPurpose
+		_c.mutation.SetPurpose(v)
+	}
+	if _, ok := _c.mutation.CodeAttempts(); !ok {
+		v := totppendingtoken.DefaultCodeAttempts
+		_c.mutation.SetCodeAttempts(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -172,6 +222,12 @@ func (_c *TOTPPendingTokenCreate) check() error {
 	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TOTPPendingToken.expires_at"`)}
 	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "TOTPPendingToken.purpose"`)}
+	}
+	if _, ok := _c.mutation.CodeAttempts(); !ok {
+		return &ValidationError{Name: "code_attempts", err: errors.New(`ent: missing required field "TOTPPendingToken.code_attempts"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TOTPPendingToken.user"`)}
 	}
@@ -225,6 +281,18 @@ func (_c *TOTPPendingTokenCreate) createSpec() (*TOTPPendingToken, *sqlgraph.Cre
 		_spec.SetField(totppendingtoken.FieldUsedAt, field.TypeTime, value)
 		_node.UsedAt = &value
 	}
+	if value, ok := _c.mutation.Purpose(); ok {
+		_spec.SetField(totppendingtoken.FieldPurpose, field.TypeString, value)
+		_node.Purpose = value
+	}
+	if value, ok := _c.mutation.EmailCodeHash(); ok {
+		_spec.SetField(totppendingtoken.FieldEmailCodeHash, field.TypeString, value)
+		_node.EmailCodeHash = &value
+	}
+	if value, ok := _c.mutation.CodeAttempts(); ok {
+		_spec.SetField(totppendingtoken.FieldCodeAttempts, field.TypeInt, value)
+		_node.CodeAttempts = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
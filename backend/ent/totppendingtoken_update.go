@@ -117,6 +117,61 @@ func (_u *TOTPPendingTokenUpdate) ClearUsedAt() *TOTPPendingTokenUpdate {
 	return _u
 }
 
+// SetPurpose sets the "purpose" field.
+func (_u *TOTPPendingTokenUpdate) SetPurpose(v string) *TOTPPendingTokenUpdate {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *TOTPPendingTokenUpdate) SetNillablePurpose(v *string) *TOTPPendingTokenUpdate {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetEmailCodeHash sets the "email_code_hash" field.
+func (_u *TOTPPendingTokenUpdate) SetEmailCodeHash(v string) *TOTPPendingTokenUpdate {
+	_u.mutation.SetEmailCodeHash(v)
+	return _u
+}
+
+// SetNillableEmailCodeHash sets the "email_code_hash" field if the given value is not nil.
+func (_u *TOTPPendingTokenUpdate) SetNillableEmailCodeHash(v *string) *TOTPPendingTokenUpdate {
+	if v != nil {
+		_u.SetEmailCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearEmailCodeHash clears the value of the "email_code_hash" field.
+func (_u *TOTPPendingTokenUpdate) ClearEmailCodeHash() *TOTPPendingTokenUpdate {
+	_u.mutation.ClearEmailCodeHash()
+	return _u
+}
+
+// SetCodeAttempts sets the "code_attempts" field.
+func (_u *TOTPPendingTokenUpdate) SetCodeAttempts(v int) *TOTPPendingTokenUpdate {
+	_u.mutation.ResetCodeAttempts()
+	_u.mutation.SetCodeAttempts(v)
+	return _u
+}
+
+// SetNillableCodeAttempts sets the "code_attempts" field if the given value is not nil.
+func (_u *TOTPPendingTokenUpdate) SetNillableCodeAttempts(v *int) *TOTPPendingTokenUpdate {
+	if v != nil {
+		_u.SetCodeAttempts(*v)
+	}
+	return _u
+}
+
+// AddCodeAttempts adds value to the "code_attempts" field.
+func (_u *TOTPPendingTokenUpdate) AddCodeAttempts(v int) *TOTPPendingTokenUpdate {
+	_u.mutation.AddCodeAttempts(v)
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *TOTPPendingTokenUpdate) SetUser(v *User) *TOTPPendingTokenUpdate {
 	return _u.SetUserID(v.ID)
@@ -220,6 +275,21 @@ func (_u *TOTPPendingTokenUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.UsedAtCleared() {
 		_spec.ClearField(totppendingtoken.FieldUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(totppendingtoken.FieldPurpose, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailCodeHash(); ok {
+		_spec.SetField(totppendingtoken.FieldEmailCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.EmailCodeHashCleared() {
+		_spec.ClearField(totppendingtoken.FieldEmailCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeAttempts(); ok {
+		_spec.SetField(totppendingtoken.FieldCodeAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCodeAttempts(); ok {
+		_spec.AddField(totppendingtoken.FieldCodeAttempts, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -357,6 +427,61 @@ func (_u *TOTPPendingTokenUpdateOne) ClearUsedAt() *TOTPPendingTokenUpdateOne {
 	return _u
 }
 
+// SetPurpose sets the "purpose" field.
+func (_u *TOTPPendingTokenUpdateOne) SetPurpose(v string) *TOTPPendingTokenUpdateOne {
+	_u.mutation.SetPurpose(v)
+	return _u
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_u *TOTPPendingTokenUpdateOne) SetNillablePurpose(v *string) *TOTPPendingTokenUpdateOne {
+	if v != nil {
+		_u.SetPurpose(*v)
+	}
+	return _u
+}
+
+// SetEmailCodeHash sets the "email_code_hash" field.
+func (_u *TOTPPendingTokenUpdateOne) SetEmailCodeHash(v string) *TOTPPendingTokenUpdateOne {
+	_u.mutation.SetEmailCodeHash(v)
+	return _u
+}
+
+// SetNillableEmailCodeHash sets the "email_code_hash" field if the given value is not nil.
+func (_u *TOTPPendingTokenUpdateOne) SetNillableEmailCodeHash(v *string) *TOTPPendingTokenUpdateOne {
+	if v != nil {
+		_u.SetEmailCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearEmailCodeHash clears the value of the "email_code_hash" field.
+func (_u *TOTPPendingTokenUpdateOne) ClearEmailCodeHash() *TOTPPendingTokenUpdateOne {
+	_u.mutation.ClearEmailCodeHash()
+	return _u
+}
+
+// SetCodeAttempts sets the "code_attempts" field.
+func (_u *TOTPPendingTokenUpdateOne) SetCodeAttempts(v int) *TOTPPendingTokenUpdateOne {
+	_u.mutation.ResetCodeAttempts()
+	_u.mutation.SetCodeAttempts(v)
+	return _u
+}
+
+// SetNillableCodeAttempts sets the "code_attempts" field if the given value is not nil.
+func (_u *TOTPPendingTokenUpdateOne) SetNillableCodeAttempts(v *int) *TOTPPendingTokenUpdateOne {
+	if v != nil {
+		_u.SetCodeAttempts(*v)
+	}
+	return _u
+}
+
+// AddCodeAttempts adds value to the "code_attempts" field.
+func (_u *TOTPPendingTokenUpdateOne) AddCodeAttempts(v int) *TOTPPendingTokenUpdateOne {
+	_u.mutation.AddCodeAttempts(v)
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *TOTPPendingTokenUpdateOne) SetUser(v *User) *TOTPPendingTokenUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -490,6 +615,21 @@ func (_u *TOTPPendingTokenUpdateOne) sqlSave(ctx context.Context) (_node *TOTPPe
 	if _u.mutation.UsedAtCleared() {
 		_spec.ClearField(totppendingtoken.FieldUsedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Purpose(); ok {
+		_spec.SetField(totppendingtoken.FieldPurpose, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailCodeHash(); ok {
+		_spec.SetField(totppendingtoken.FieldEmailCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.EmailCodeHashCleared() {
+		_spec.ClearField(totppendingtoken.FieldEmailCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeAttempts(); ok {
+		_spec.SetField(totppendingtoken.FieldCodeAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCodeAttempts(); ok {
+		_spec.AddField(totppendingtoken.FieldCodeAttempts, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -14,16 +14,32 @@ type Tx struct {
 	config
 	// Admin is the client for interacting with the Admin builders.
 	Admin *AdminClient
+	// AdminAuditLog is the client for interacting with the AdminAuditLog builders.
+	AdminAuditLog *AdminAuditLogClient
+	// AdminPasskey is the client for interacting with the AdminPasskey builders.
+	AdminPasskey *AdminPasskeyClient
+	// AdminRole is the client for interacting with the AdminRole builders.
+	AdminRole *AdminRoleClient
+	// AdminSession is the client for interacting with the AdminSession builders.
+	AdminSession *AdminSessionClient
 	// ArtifactSubmission is the client for interacting with the ArtifactSubmission builders.
 	ArtifactSubmission *ArtifactSubmissionClient
 	// BackupCode is the client for interacting with the BackupCode builders.
 	BackupCode *BackupCodeClient
 	// Badge is the client for interacting with the Badge builders.
 	Badge *BadgeClient
+	// CaseClarification is the client for interacting with the CaseClarification builders.
+	CaseClarification *CaseClarificationClient
+	// CaseConversation is the client for interacting with the CaseConversation builders.
+	CaseConversation *CaseConversationClient
+	// CaseMessage is the client for interacting with the CaseMessage builders.
+	CaseMessage *CaseMessageClient
 	// Category is the client for interacting with the Category builders.
 	Category *CategoryClient
 	// ChainCursor is the client for interacting with the ChainCursor builders.
 	ChainCursor *ChainCursorClient
+	// ConsultationInvitation is the client for interacting with the ConsultationInvitation builders.
+	ConsultationInvitation *ConsultationInvitationClient
 	// ConsultationRequest is the client for interacting with the ConsultationRequest builders.
 	ConsultationRequest *ConsultationRequestClient
 	// Credential is the client for interacting with the Credential builders.
@@ -38,8 +54,18 @@ type Tx struct {
 	Endorsement *EndorsementClient
 	// FinalOffer is the client for interacting with the FinalOffer builders.
 	FinalOffer *FinalOfferClient
+	// FinalOfferMilestone is the client for interacting with the FinalOfferMilestone builders.
+	FinalOfferMilestone *FinalOfferMilestoneClient
+	// FinalOfferRevision is the client for interacting with the FinalOfferRevision builders.
+	FinalOfferRevision *FinalOfferRevisionClient
+	// GuaranteeSlash is the client for interacting with the GuaranteeSlash builders.
+	GuaranteeSlash *GuaranteeSlashClient
 	// IPGeoCache is the client for interacting with the IPGeoCache builders.
 	IPGeoCache *IPGeoCacheClient
+	// IPRule is the client for interacting with the IPRule builders.
+	IPRule *IPRuleClient
+	// ImpersonationSession is the client for interacting with the ImpersonationSession builders.
+	ImpersonationSession *ImpersonationSessionClient
 	// MarketPurchaseOrder is the client for interacting with the MarketPurchaseOrder builders.
 	MarketPurchaseOrder *MarketPurchaseOrderClient
 	// MarketPurchaseOrderStep is the client for interacting with the MarketPurchaseOrderStep builders.
@@ -48,6 +74,10 @@ type Tx struct {
 	Passkey *PasskeyClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
 	PasswordResetToken *PasswordResetTokenClient
+	// ReputationEvent is the client for interacting with the ReputationEvent builders.
+	ReputationEvent *ReputationEventClient
+	// SLAPolicy is the client for interacting with the SLAPolicy builders.
+	SLAPolicy *SLAPolicyClient
 	// SecurityEvent is the client for interacting with the SecurityEvent builders.
 	SecurityEvent *SecurityEventClient
 	// Session is the client for interacting with the Session builders.
@@ -68,6 +98,12 @@ type Tx struct {
 	ValidationCase *ValidationCaseClient
 	// ValidationCaseLog is the client for interacting with the ValidationCaseLog builders.
 	ValidationCaseLog *ValidationCaseLogClient
+	// ValidatorDeliverySLA is the client for interacting with the ValidatorDeliverySLA builders.
+	ValidatorDeliverySLA *ValidatorDeliverySLAClient
+	// ValidatorMatchingAggregate is the client for interacting with the ValidatorMatchingAggregate builders.
+	ValidatorMatchingAggregate *ValidatorMatchingAggregateClient
+	// ValidatorReputation is the client for interacting with the ValidatorReputation builders.
+	ValidatorReputation *ValidatorReputationClient
 
 	// lazily loaded.
 	client     *Client
@@ -200,11 +236,19 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Admin = NewAdminClient(tx.config)
+	tx.AdminAuditLog = NewAdminAuditLogClient(tx.config)
+	tx.AdminPasskey = NewAdminPasskeyClient(tx.config)
+	tx.AdminRole = NewAdminRoleClient(tx.config)
+	tx.AdminSession = NewAdminSessionClient(tx.config)
 	tx.ArtifactSubmission = NewArtifactSubmissionClient(tx.config)
 	tx.BackupCode = NewBackupCodeClient(tx.config)
 	tx.Badge = NewBadgeClient(tx.config)
+	tx.CaseClarification = NewCaseClarificationClient(tx.config)
+	tx.CaseConversation = NewCaseConversationClient(tx.config)
+	tx.CaseMessage = NewCaseMessageClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.ChainCursor = NewChainCursorClient(tx.config)
+	tx.ConsultationInvitation = NewConsultationInvitationClient(tx.config)
 	tx.ConsultationRequest = NewConsultationRequestClient(tx.config)
 	tx.Credential = NewCredentialClient(tx.config)
 	tx.DeviceFingerprint = NewDeviceFingerprintClient(tx.config)
@@ -212,11 +256,18 @@ func (tx *Tx) init() {
 	tx.EmailVerificationToken = NewEmailVerificationTokenClient(tx.config)
 	tx.Endorsement = NewEndorsementClient(tx.config)
 	tx.FinalOffer = NewFinalOfferClient(tx.config)
+	tx.FinalOfferMilestone = NewFinalOfferMilestoneClient(tx.config)
+	tx.FinalOfferRevision = NewFinalOfferRevisionClient(tx.config)
+	tx.GuaranteeSlash = NewGuaranteeSlashClient(tx.config)
 	tx.IPGeoCache = NewIPGeoCacheClient(tx.config)
+	tx.IPRule = NewIPRuleClient(tx.config)
+	tx.ImpersonationSession = NewImpersonationSessionClient(tx.config)
 	tx.MarketPurchaseOrder = NewMarketPurchaseOrderClient(tx.config)
 	tx.MarketPurchaseOrderStep = NewMarketPurchaseOrderStepClient(tx.config)
 	tx.Passkey = NewPasskeyClient(tx.config)
 	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
+	tx.ReputationEvent = NewReputationEventClient(tx.config)
+	tx.SLAPolicy = NewSLAPolicyClient(tx.config)
 	tx.SecurityEvent = NewSecurityEventClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.SessionLock = NewSessionLockClient(tx.config)
@@ -227,6 +278,9 @@ func (tx *Tx) init() {
 	tx.UserBadge = NewUserBadgeClient(tx.config)
 	tx.ValidationCase = NewValidationCaseClient(tx.config)
 	tx.ValidationCaseLog = NewValidationCaseLogClient(tx.config)
+	tx.ValidatorDeliverySLA = NewValidatorDeliverySLAClient(tx.config)
+	tx.ValidatorMatchingAggregate = NewValidatorMatchingAggregateClient(tx.config)
+	tx.ValidatorReputation = NewValidatorReputationClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -54,6 +54,8 @@ type ValidationCase struct {
 	OwnerInactivityCount int `json:"owner_inactivity_count,omitempty"`
 	// WorkflowCycle holds the value of the "workflow_cycle" field.
 	WorkflowCycle int `json:"workflow_cycle,omitempty"`
+	// TelegramRevealDisabled holds the value of the "telegram_reveal_disabled" field.
+	TelegramRevealDisabled bool `json:"telegram_reveal_disabled,omitempty"`
 	// EscrowTransferID holds the value of the "escrow_transfer_id" field.
 	EscrowTransferID *string `json:"escrow_transfer_id,omitempty"`
 	// DisputeID holds the value of the "dispute_id" field.
@@ -176,6 +178,8 @@ func (*ValidationCase) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case validationcase.FieldContentJSON, validationcase.FieldMeta:
 			values[i] = new([]byte)
+		case validationcase.FieldTelegramRevealDisabled:
+			values[i] = new(sql.NullBool)
 		case validationcase.FieldID, validationcase.FieldCategoryID, validationcase.FieldUserID, validationcase.FieldBountyAmount, validationcase.FieldOwnerInactivityCount, validationcase.FieldWorkflowCycle, validationcase.FieldAcceptedFinalOfferID:
 			values[i] = new(sql.NullInt64)
 		case validationcase.FieldTitle, validationcase.FieldSummary, validationcase.FieldContentType, validationcase.FieldStatus, validationcase.FieldSensitivityLevel, validationcase.FieldIntakeSchemaVersion, validationcase.FieldClarificationState, validationcase.FieldEscrowTransferID, validationcase.FieldDisputeID, validationcase.FieldArtifactDocumentID, validationcase.FieldCertifiedArtifactDocumentID:
@@ -310,6 +314,12 @@ func (_m *ValidationCase) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.WorkflowCycle = int(value.Int64)
 			}
+		case validationcase.FieldTelegramRevealDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field telegram_reveal_disabled", values[i])
+			} else if value.Valid {
+				_m.TelegramRevealDisabled = value.Bool
+			}
 		case validationcase.FieldEscrowTransferID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field escrow_transfer_id", values[i])
@@ -474,6 +484,9 @@ func (_m *ValidationCase) String() string {
 	builder.WriteString("workflow_cycle=")
 	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowCycle))
 	builder.WriteString(", ")
+	builder.WriteString("telegram_reveal_disabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TelegramRevealDisabled))
+	builder.WriteString(", ")
 	if v := _m.EscrowTransferID; v != nil {
 		builder.WriteString("escrow_transfer_id=")
 		builder.WriteString(*v)
@@ -48,6 +48,8 @@ const (
 	FieldOwnerInactivityCount = "owner_inactivity_count"
 	// FieldWorkflowCycle holds the string denoting the workflow_cycle field in the database.
 	FieldWorkflowCycle = "workflow_cycle"
+	// FieldTelegramRevealDisabled holds the string denoting the telegram_reveal_disabled field in the database.
+	FieldTelegramRevealDisabled = "telegram_reveal_disabled"
 	// FieldEscrowTransferID holds the string denoting the escrow_transfer_id field in the database.
 	FieldEscrowTransferID = "escrow_transfer_id"
 	// FieldDisputeID holds the string denoting the dispute_id field in the database.
@@ -152,6 +154,7 @@ var Columns = []string{
 	FieldClarificationState,
 	FieldOwnerInactivityCount,
 	FieldWorkflowCycle,
+	FieldTelegramRevealDisabled,
 	FieldEscrowTransferID,
 	FieldDisputeID,
 	FieldAcceptedFinalOfferID,
@@ -220,6 +223,8 @@ var (
 	DefaultWorkflowCycle int
 	// WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
 	WorkflowCycleValidator func(int) error
+	// DefaultTelegramRevealDisabled holds the default value on creation for the "telegram_reveal_disabled" field.
+	DefaultTelegramRevealDisabled bool
 	// AcceptedFinalOfferIDValidator is a validator for the "accepted_final_offer_id" field. It is called by the builders before save.
 	AcceptedFinalOfferIDValidator func(int) error
 )
@@ -307,6 +312,11 @@ func ByWorkflowCycle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWorkflowCycle, opts...).ToFunc()
 }
 
+// ByTelegramRevealDisabled orders the results by the telegram_reveal_disabled field.
+func ByTelegramRevealDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTelegramRevealDisabled, opts...).ToFunc()
+}
+
 // ByEscrowTransferID orders the results by the escrow_transfer_id field.
 func ByEscrowTransferID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEscrowTransferID, opts...).ToFunc()
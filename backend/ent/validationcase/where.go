@@ -130,6 +130,11 @@ func WorkflowCycle(v int) predicate.ValidationCase {
 	return predicate.ValidationCase(sql.FieldEQ(FieldWorkflowCycle, v))
 }
 
+// TelegramRevealDisabled applies equality check predicate on the "telegram_reveal_disabled" field. It's identical to TelegramRevealDisabledEQ.
+func TelegramRevealDisabled(v bool) predicate.ValidationCase {
+	return predicate.ValidationCase(sql.FieldEQ(FieldTelegramRevealDisabled, v))
+}
+
 // EscrowTransferID applies equality check predicate on the "escrow_transfer_id" field. It's identical to EscrowTransferIDEQ.
 func EscrowTransferID(v string) predicate.ValidationCase {
 	return predicate.ValidationCase(sql.FieldEQ(FieldEscrowTransferID, v))
@@ -930,6 +935,16 @@ func WorkflowCycleLTE(v int) predicate.ValidationCase {
 	return predicate.ValidationCase(sql.FieldLTE(FieldWorkflowCycle, v))
 }
 
+// TelegramRevealDisabledEQ applies the EQ predicate on the "telegram_reveal_disabled" field.
+func TelegramRevealDisabledEQ(v bool) predicate.ValidationCase {
+	return predicate.ValidationCase(sql.FieldEQ(FieldTelegramRevealDisabled, v))
+}
+
+// TelegramRevealDisabledNEQ applies the NEQ predicate on the "telegram_reveal_disabled" field.
+func TelegramRevealDisabledNEQ(v bool) predicate.ValidationCase {
+	return predicate.ValidationCase(sql.FieldNEQ(FieldTelegramRevealDisabled, v))
+}
+
 // EscrowTransferIDEQ applies the EQ predicate on the "escrow_transfer_id" field.
 func EscrowTransferIDEQ(v string) predicate.ValidationCase {
 	return predicate.ValidationCase(sql.FieldEQ(FieldEscrowTransferID, v))
@@ -226,6 +226,20 @@ func (_c *ValidationCaseCreate) SetNillableWorkflowCycle(v *int) *ValidationCase
 	return _c
 }
 
+// SetTelegramRevealDisabled sets the "telegram_reveal_disabled" field.
+func (_c *ValidationCaseCreate) SetTelegramRevealDisabled(v bool) *ValidationCaseCreate {
+	_c.mutation.SetTelegramRevealDisabled(v)
+	return _c
+}
+
+// SetNillableTelegramRevealDisabled sets the "telegram_reveal_disabled" field if the given value is not nil.
+func (_c *ValidationCaseCreate) SetNillableTelegramRevealDisabled(v *bool) *ValidationCaseCreate {
+	if v != nil {
+		_c.SetTelegramRevealDisabled(*v)
+	}
+	return _c
+}
+
 // SetEscrowTransferID sets the "escrow_transfer_id" field.
 func (_c *ValidationCaseCreate) SetEscrowTransferID(v string) *ValidationCaseCreate {
 	_c.mutation.SetEscrowTransferID(v)
@@ -475,6 +489,10 @@ func (_c *ValidationCaseCreate) defaults() {
 		v := validationcase.DefaultWorkflowCycle
 		_c.mutation.SetWorkflowCycle(v)
 	}
+	if _, ok := _c.mutation.TelegramRevealDisabled(); !ok {
+		v := validationcase.DefaultTelegramRevealDisabled
+		_c.mutation.SetTelegramRevealDisabled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -568,6 +586,9 @@ func (_c *ValidationCaseCreate) check() error {
 			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ValidationCase.workflow_cycle": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.TelegramRevealDisabled(); !ok {
+		return &ValidationError{Name: "telegram_reveal_disabled", err: errors.New(`ent: missing required field "ValidationCase.telegram_reveal_disabled"`)}
+	}
 	if v, ok := _c.mutation.AcceptedFinalOfferID(); ok {
 		if err := validationcase.AcceptedFinalOfferIDValidator(v); err != nil {
 			return &ValidationError{Name: "accepted_final_offer_id", err: fmt.Errorf(`ent: validator failed for field "ValidationCase.accepted_final_offer_id": %w`, err)}
@@ -665,6 +686,10 @@ func (_c *ValidationCaseCreate) createSpec() (*ValidationCase, *sqlgraph.CreateS
 		_spec.SetField(validationcase.FieldWorkflowCycle, field.TypeInt, value)
 		_node.WorkflowCycle = value
 	}
+	if value, ok := _c.mutation.TelegramRevealDisabled(); ok {
+		_spec.SetField(validationcase.FieldTelegramRevealDisabled, field.TypeBool, value)
+		_node.TelegramRevealDisabled = value
+	}
 	if value, ok := _c.mutation.EscrowTransferID(); ok {
 		_spec.SetField(validationcase.FieldEscrowTransferID, field.TypeString, value)
 		_node.EscrowTransferID = &value
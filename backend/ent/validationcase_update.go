@@ -281,6 +281,20 @@ func (_u *ValidationCaseUpdate) AddWorkflowCycle(v int) *ValidationCaseUpdate {
 	return _u
 }
 
+// SetTelegramRevealDisabled sets the "telegram_reveal_disabled" field.
+func (_u *ValidationCaseUpdate) SetTelegramRevealDisabled(v bool) *ValidationCaseUpdate {
+	_u.mutation.SetTelegramRevealDisabled(v)
+	return _u
+}
+
+// SetNillableTelegramRevealDisabled sets the "telegram_reveal_disabled" field if the given value is not nil.
+func (_u *ValidationCaseUpdate) SetNillableTelegramRevealDisabled(v *bool) *ValidationCaseUpdate {
+	if v != nil {
+		_u.SetTelegramRevealDisabled(*v)
+	}
+	return _u
+}
+
 // SetEscrowTransferID sets the "escrow_transfer_id" field.
 func (_u *ValidationCaseUpdate) SetEscrowTransferID(v string) *ValidationCaseUpdate {
 	_u.mutation.SetEscrowTransferID(v)
@@ -808,6 +822,9 @@ func (_u *ValidationCaseUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
 		_spec.AddField(validationcase.FieldWorkflowCycle, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.TelegramRevealDisabled(); ok {
+		_spec.SetField(validationcase.FieldTelegramRevealDisabled, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.EscrowTransferID(); ok {
 		_spec.SetField(validationcase.FieldEscrowTransferID, field.TypeString, value)
 	}
@@ -1434,6 +1451,20 @@ func (_u *ValidationCaseUpdateOne) AddWorkflowCycle(v int) *ValidationCaseUpdate
 	return _u
 }
 
+// SetTelegramRevealDisabled sets the "telegram_reveal_disabled" field.
+func (_u *ValidationCaseUpdateOne) SetTelegramRevealDisabled(v bool) *ValidationCaseUpdateOne {
+	_u.mutation.SetTelegramRevealDisabled(v)
+	return _u
+}
+
+// SetNillableTelegramRevealDisabled sets the "telegram_reveal_disabled" field if the given value is not nil.
+func (_u *ValidationCaseUpdateOne) SetNillableTelegramRevealDisabled(v *bool) *ValidationCaseUpdateOne {
+	if v != nil {
+		_u.SetTelegramRevealDisabled(*v)
+	}
+	return _u
+}
+
 // SetEscrowTransferID sets the "escrow_transfer_id" field.
 func (_u *ValidationCaseUpdateOne) SetEscrowTransferID(v string) *ValidationCaseUpdateOne {
 	_u.mutation.SetEscrowTransferID(v)
@@ -1991,6 +2022,9 @@ func (_u *ValidationCaseUpdateOne) sqlSave(ctx context.Context) (_node *Validati
 	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
 		_spec.AddField(validationcase.FieldWorkflowCycle, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.TelegramRevealDisabled(); ok {
+		_spec.SetField(validationcase.FieldTelegramRevealDisabled, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.EscrowTransferID(); ok {
 		_spec.SetField(validationcase.FieldEscrowTransferID, field.TypeString, value)
 	}
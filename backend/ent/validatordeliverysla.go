@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/validatordeliverysla"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ValidatorDeliverySLA is the model entity for the ValidatorDeliverySLA schema.
+type ValidatorDeliverySLA struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidationCaseID holds the value of the "validation_case_id" field.
+	ValidationCaseID int `json:"validation_case_id,omitempty"`
+	// FinalOfferID holds the value of the "final_offer_id" field.
+	FinalOfferID int `json:"final_offer_id,omitempty"`
+	// MilestoneID holds the value of the "milestone_id" field.
+	MilestoneID *int `json:"milestone_id,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// WorkflowCycle holds the value of the "workflow_cycle" field.
+	WorkflowCycle int `json:"workflow_cycle,omitempty"`
+	// EscrowTransferID holds the value of the "escrow_transfer_id" field.
+	EscrowTransferID string `json:"escrow_transfer_id,omitempty"`
+	// HoldHours holds the value of the "hold_hours" field.
+	HoldHours int `json:"hold_hours,omitempty"`
+	// LockedAt holds the value of the "locked_at" field.
+	LockedAt time.Time `json:"locked_at,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt time.Time `json:"due_at,omitempty"`
+	// GraceUntil holds the value of the "grace_until" field.
+	GraceUntil time.Time `json:"grace_until,omitempty"`
+	// ReminderCount holds the value of the "reminder_count" field.
+	ReminderCount int `json:"reminder_count,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// MissedAt holds the value of the "missed_at" field.
+	MissedAt *time.Time `json:"missed_at,omitempty"`
+	// EscalatedAt holds the value of the "escalated_at" field.
+	EscalatedAt *time.Time `json:"escalated_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// Recommendation holds the value of the "recommendation" field.
+	Recommendation *string `json:"recommendation,omitempty"`
+	// DisputeDraft holds the value of the "dispute_draft" field.
+	DisputeDraft map[string]interface{} `json:"dispute_draft,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ValidatorDeliverySLA) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case validatordeliverysla.FieldDisputeDraft:
+			values[i] = new([]byte)
+		case validatordeliverysla.FieldID, validatordeliverysla.FieldValidationCaseID, validatordeliverysla.FieldFinalOfferID, validatordeliverysla.FieldMilestoneID, validatordeliverysla.FieldValidatorUserID, validatordeliverysla.FieldWorkflowCycle, validatordeliverysla.FieldHoldHours, validatordeliverysla.FieldReminderCount:
+			values[i] = new(sql.NullInt64)
+		case validatordeliverysla.FieldEscrowTransferID, validatordeliverysla.FieldStatus, validatordeliverysla.FieldRecommendation:
+			values[i] = new(sql.NullString)
+		case validatordeliverysla.FieldCreatedAt, validatordeliverysla.FieldUpdatedAt, validatordeliverysla.FieldDeletedAt, validatordeliverysla.FieldLockedAt, validatordeliverysla.FieldDueAt, validatordeliverysla.FieldGraceUntil, validatordeliverysla.FieldDeliveredAt, validatordeliverysla.FieldMissedAt, validatordeliverysla.FieldEscalatedAt, validatordeliverysla.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ValidatorDeliverySLA fields.
+func (_m *ValidatorDeliverySLA) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case validatordeliverysla.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case validatordeliverysla.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case validatordeliverysla.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case validatordeliverysla.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case validatordeliverysla.FieldValidationCaseID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validation_case_id", values[i])
+			} else if value.Valid {
+				_m.ValidationCaseID = int(value.Int64)
+			}
+		case validatordeliverysla.FieldFinalOfferID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field final_offer_id", values[i])
+			} else if value.Valid {
+				_m.FinalOfferID = int(value.Int64)
+			}
+		case validatordeliverysla.FieldMilestoneID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field milestone_id", values[i])
+			} else if value.Valid {
+				_m.MilestoneID = new(int)
+				*_m.MilestoneID = int(value.Int64)
+			}
+		case validatordeliverysla.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case validatordeliverysla.FieldWorkflowCycle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_cycle", values[i])
+			} else if value.Valid {
+				_m.WorkflowCycle = int(value.Int64)
+			}
+		case validatordeliverysla.FieldEscrowTransferID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field escrow_transfer_id", values[i])
+			} else if value.Valid {
+				_m.EscrowTransferID = value.String
+			}
+		case validatordeliverysla.FieldHoldHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_hours", values[i])
+			} else if value.Valid {
+				_m.HoldHours = int(value.Int64)
+			}
+		case validatordeliverysla.FieldLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_at", values[i])
+			} else if value.Valid {
+				_m.LockedAt = value.Time
+			}
+		case validatordeliverysla.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = value.Time
+			}
+		case validatordeliverysla.FieldGraceUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field grace_until", values[i])
+			} else if value.Valid {
+				_m.GraceUntil = value.Time
+			}
+		case validatordeliverysla.FieldReminderCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reminder_count", values[i])
+			} else if value.Valid {
+				_m.ReminderCount = int(value.Int64)
+			}
+		case validatordeliverysla.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case validatordeliverysla.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				_m.DeliveredAt = new(time.Time)
+				*_m.DeliveredAt = value.Time
+			}
+		case validatordeliverysla.FieldMissedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field missed_at", values[i])
+			} else if value.Valid {
+				_m.MissedAt = new(time.Time)
+				*_m.MissedAt = value.Time
+			}
+		case validatordeliverysla.FieldEscalatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field escalated_at", values[i])
+			} else if value.Valid {
+				_m.EscalatedAt = new(time.Time)
+				*_m.EscalatedAt = value.Time
+			}
+		case validatordeliverysla.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case validatordeliverysla.FieldRecommendation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recommendation", values[i])
+			} else if value.Valid {
+				_m.Recommendation = new(string)
+				*_m.Recommendation = value.String
+			}
+		case validatordeliverysla.FieldDisputeDraft:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_draft", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DisputeDraft); err != nil {
+					return fmt.Errorf("unmarshal field dispute_draft: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ValidatorDeliverySLA.
+// This includes values selected through modifiers, order, etc.
+func (_m *ValidatorDeliverySLA) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ValidatorDeliverySLA.
+// Note that you need to call ValidatorDeliverySLA.Unwrap() before calling this method if this ValidatorDeliverySLA
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ValidatorDeliverySLA) Update() *ValidatorDeliverySLAUpdateOne {
+	return NewValidatorDeliverySLAClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ValidatorDeliverySLA entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ValidatorDeliverySLA) Unwrap() *ValidatorDeliverySLA {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ValidatorDeliverySLA is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ValidatorDeliverySLA) String() string {
+	var builder strings.Builder
+	builder.WriteString("ValidatorDeliverySLA(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validation_case_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidationCaseID))
+	builder.WriteString(", ")
+	builder.WriteString("final_offer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinalOfferID))
+	builder.WriteString(", ")
+	if v := _m.MilestoneID; v != nil {
+		builder.WriteString("milestone_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_cycle=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowCycle))
+	builder.WriteString(", ")
+	builder.WriteString("escrow_transfer_id=")
+	builder.WriteString(_m.EscrowTransferID)
+	builder.WriteString(", ")
+	builder.WriteString("hold_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HoldHours))
+	builder.WriteString(", ")
+	builder.WriteString("locked_at=")
+	builder.WriteString(_m.LockedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("due_at=")
+	builder.WriteString(_m.DueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("grace_until=")
+	builder.WriteString(_m.GraceUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reminder_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReminderCount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.MissedAt; v != nil {
+		builder.WriteString("missed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EscalatedAt; v != nil {
+		builder.WriteString("escalated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Recommendation; v != nil {
+		builder.WriteString("recommendation=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dispute_draft=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisputeDraft))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ValidatorDeliverySLAs is a parsable slice of ValidatorDeliverySLA.
+type ValidatorDeliverySLAs []*ValidatorDeliverySLA
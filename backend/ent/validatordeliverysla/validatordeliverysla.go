@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package validatordeliverysla
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the validatordeliverysla type in the database.
+	Label = "validator_delivery_sla"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldValidationCaseID holds the string denoting the validation_case_id field in the database.
+	FieldValidationCaseID = "validation_case_id"
+	// FieldFinalOfferID holds the string denoting the final_offer_id field in the database.
+	FieldFinalOfferID = "final_offer_id"
+	// FieldMilestoneID holds the string denoting the milestone_id field in the database.
+	FieldMilestoneID = "milestone_id"
+	// FieldValidatorUserID holds the string denoting the validator_user_id field in the database.
+	FieldValidatorUserID = "validator_user_id"
+	// FieldWorkflowCycle holds the string denoting the workflow_cycle field in the database.
+	FieldWorkflowCycle = "workflow_cycle"
+	// FieldEscrowTransferID holds the string denoting the escrow_transfer_id field in the database.
+	FieldEscrowTransferID = "escrow_transfer_id"
+	// FieldHoldHours holds the string denoting the hold_hours field in the database.
+	FieldHoldHours = "hold_hours"
+	// FieldLockedAt holds the string denoting the locked_at field in the database.
+	FieldLockedAt = "locked_at"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldGraceUntil holds the string denoting the grace_until field in the database.
+	FieldGraceUntil = "grace_until"
+	// FieldReminderCount holds the string denoting the reminder_count field in the database.
+	FieldReminderCount = "reminder_count"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDeliveredAt holds the string denoting the delivered_at field in the database.
+	FieldDeliveredAt = "delivered_at"
+	// FieldMissedAt holds the string denoting the missed_at field in the database.
+	FieldMissedAt = "missed_at"
+	// FieldEscalatedAt holds the string denoting the escalated_at field in the database.
+	FieldEscalatedAt = "escalated_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldRecommendation holds the string denoting the recommendation field in the database.
+	FieldRecommendation = "recommendation"
+	// FieldDisputeDraft holds the string denoting the dispute_draft field in the database.
+	FieldDisputeDraft = "dispute_draft"
+	// Table holds the table name of the validatordeliverysla in the database.
+	Table = "validator_delivery_slas"
+)
+
+// Columns holds all SQL columns for validatordeliverysla fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldValidationCaseID,
+	FieldFinalOfferID,
+	FieldMilestoneID,
+	FieldValidatorUserID,
+	FieldWorkflowCycle,
+	FieldEscrowTransferID,
+	FieldHoldHours,
+	FieldLockedAt,
+	FieldDueAt,
+	FieldGraceUntil,
+	FieldReminderCount,
+	FieldStatus,
+	FieldDeliveredAt,
+	FieldMissedAt,
+	FieldEscalatedAt,
+	FieldCancelledAt,
+	FieldRecommendation,
+	FieldDisputeDraft,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValidationCaseIDValidator is a validator for the "validation_case_id" field. It is called by the builders before save.
+	ValidationCaseIDValidator func(int) error
+	// FinalOfferIDValidator is a validator for the "final_offer_id" field. It is called by the builders before save.
+	FinalOfferIDValidator func(int) error
+	// ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	ValidatorUserIDValidator func(int) error
+	// DefaultWorkflowCycle holds the default value on creation for the "workflow_cycle" field.
+	DefaultWorkflowCycle int
+	// WorkflowCycleValidator is a validator for the "workflow_cycle" field. It is called by the builders before save.
+	WorkflowCycleValidator func(int) error
+	// EscrowTransferIDValidator is a validator for the "escrow_transfer_id" field. It is called by the builders before save.
+	EscrowTransferIDValidator func(string) error
+	// HoldHoursValidator is a validator for the "hold_hours" field. It is called by the builders before save.
+	HoldHoursValidator func(int) error
+	// DefaultReminderCount holds the default value on creation for the "reminder_count" field.
+	DefaultReminderCount int
+	// ReminderCountValidator is a validator for the "reminder_count" field. It is called by the builders before save.
+	ReminderCountValidator func(int) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// RecommendationValidator is a validator for the "recommendation" field. It is called by the builders before save.
+	RecommendationValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the ValidatorDeliverySLA queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByValidationCaseID orders the results by the validation_case_id field.
+func ByValidationCaseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidationCaseID, opts...).ToFunc()
+}
+
+// ByFinalOfferID orders the results by the final_offer_id field.
+func ByFinalOfferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalOfferID, opts...).ToFunc()
+}
+
+// ByMilestoneID orders the results by the milestone_id field.
+func ByMilestoneID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMilestoneID, opts...).ToFunc()
+}
+
+// ByValidatorUserID orders the results by the validator_user_id field.
+func ByValidatorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatorUserID, opts...).ToFunc()
+}
+
+// ByWorkflowCycle orders the results by the workflow_cycle field.
+func ByWorkflowCycle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowCycle, opts...).ToFunc()
+}
+
+// ByEscrowTransferID orders the results by the escrow_transfer_id field.
+func ByEscrowTransferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscrowTransferID, opts...).ToFunc()
+}
+
+// ByHoldHours orders the results by the hold_hours field.
+func ByHoldHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldHours, opts...).ToFunc()
+}
+
+// ByLockedAt orders the results by the locked_at field.
+func ByLockedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedAt, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByGraceUntil orders the results by the grace_until field.
+func ByGraceUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraceUntil, opts...).ToFunc()
+}
+
+// ByReminderCount orders the results by the reminder_count field.
+func ByReminderCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReminderCount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDeliveredAt orders the results by the delivered_at field.
+func ByDeliveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredAt, opts...).ToFunc()
+}
+
+// ByMissedAt orders the results by the missed_at field.
+func ByMissedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMissedAt, opts...).ToFunc()
+}
+
+// ByEscalatedAt orders the results by the escalated_at field.
+func ByEscalatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalatedAt, opts...).ToFunc()
+}
+
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByRecommendation orders the results by the recommendation field.
+func ByRecommendation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecommendation, opts...).ToFunc()
+}
@@ -0,0 +1,1125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package validatordeliverysla
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidationCaseID applies equality check predicate on the "validation_case_id" field. It's identical to ValidationCaseIDEQ.
+func ValidationCaseID(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// FinalOfferID applies equality check predicate on the "final_offer_id" field. It's identical to FinalOfferIDEQ.
+func FinalOfferID(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// MilestoneID applies equality check predicate on the "milestone_id" field. It's identical to MilestoneIDEQ.
+func MilestoneID(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// WorkflowCycle applies equality check predicate on the "workflow_cycle" field. It's identical to WorkflowCycleEQ.
+func WorkflowCycle(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// EscrowTransferID applies equality check predicate on the "escrow_transfer_id" field. It's identical to EscrowTransferIDEQ.
+func EscrowTransferID(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldEscrowTransferID, v))
+}
+
+// HoldHours applies equality check predicate on the "hold_hours" field. It's identical to HoldHoursEQ.
+func HoldHours(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldHoldHours, v))
+}
+
+// LockedAt applies equality check predicate on the "locked_at" field. It's identical to LockedAtEQ.
+func LockedAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldDueAt, v))
+}
+
+// GraceUntil applies equality check predicate on the "grace_until" field. It's identical to GraceUntilEQ.
+func GraceUntil(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldGraceUntil, v))
+}
+
+// ReminderCount applies equality check predicate on the "reminder_count" field. It's identical to ReminderCountEQ.
+func ReminderCount(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldReminderCount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldStatus, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// MissedAt applies equality check predicate on the "missed_at" field. It's identical to MissedAtEQ.
+func MissedAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldMissedAt, v))
+}
+
+// EscalatedAt applies equality check predicate on the "escalated_at" field. It's identical to EscalatedAtEQ.
+func EscalatedAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// Recommendation applies equality check predicate on the "recommendation" field. It's identical to RecommendationEQ.
+func Recommendation(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldRecommendation, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidationCaseIDEQ applies the EQ predicate on the "validation_case_id" field.
+func ValidationCaseIDEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDNEQ applies the NEQ predicate on the "validation_case_id" field.
+func ValidationCaseIDNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDIn applies the In predicate on the "validation_case_id" field.
+func ValidationCaseIDIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDNotIn applies the NotIn predicate on the "validation_case_id" field.
+func ValidationCaseIDNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldValidationCaseID, vs...))
+}
+
+// ValidationCaseIDGT applies the GT predicate on the "validation_case_id" field.
+func ValidationCaseIDGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDGTE applies the GTE predicate on the "validation_case_id" field.
+func ValidationCaseIDGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLT applies the LT predicate on the "validation_case_id" field.
+func ValidationCaseIDLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldValidationCaseID, v))
+}
+
+// ValidationCaseIDLTE applies the LTE predicate on the "validation_case_id" field.
+func ValidationCaseIDLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldValidationCaseID, v))
+}
+
+// FinalOfferIDEQ applies the EQ predicate on the "final_offer_id" field.
+func FinalOfferIDEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDNEQ applies the NEQ predicate on the "final_offer_id" field.
+func FinalOfferIDNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDIn applies the In predicate on the "final_offer_id" field.
+func FinalOfferIDIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldFinalOfferID, vs...))
+}
+
+// FinalOfferIDNotIn applies the NotIn predicate on the "final_offer_id" field.
+func FinalOfferIDNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldFinalOfferID, vs...))
+}
+
+// FinalOfferIDGT applies the GT predicate on the "final_offer_id" field.
+func FinalOfferIDGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDGTE applies the GTE predicate on the "final_offer_id" field.
+func FinalOfferIDGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDLT applies the LT predicate on the "final_offer_id" field.
+func FinalOfferIDLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldFinalOfferID, v))
+}
+
+// FinalOfferIDLTE applies the LTE predicate on the "final_offer_id" field.
+func FinalOfferIDLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldFinalOfferID, v))
+}
+
+// MilestoneIDEQ applies the EQ predicate on the "milestone_id" field.
+func MilestoneIDEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDNEQ applies the NEQ predicate on the "milestone_id" field.
+func MilestoneIDNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldMilestoneID, v))
+}
+
+// MilestoneIDIn applies the In predicate on the "milestone_id" field.
+func MilestoneIDIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDNotIn applies the NotIn predicate on the "milestone_id" field.
+func MilestoneIDNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldMilestoneID, vs...))
+}
+
+// MilestoneIDGT applies the GT predicate on the "milestone_id" field.
+func MilestoneIDGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldMilestoneID, v))
+}
+
+// MilestoneIDGTE applies the GTE predicate on the "milestone_id" field.
+func MilestoneIDGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldMilestoneID, v))
+}
+
+// MilestoneIDLT applies the LT predicate on the "milestone_id" field.
+func MilestoneIDLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldMilestoneID, v))
+}
+
+// MilestoneIDLTE applies the LTE predicate on the "milestone_id" field.
+func MilestoneIDLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldMilestoneID, v))
+}
+
+// MilestoneIDIsNil applies the IsNil predicate on the "milestone_id" field.
+func MilestoneIDIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldMilestoneID))
+}
+
+// MilestoneIDNotNil applies the NotNil predicate on the "milestone_id" field.
+func MilestoneIDNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldMilestoneID))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// WorkflowCycleEQ applies the EQ predicate on the "workflow_cycle" field.
+func WorkflowCycleEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleNEQ applies the NEQ predicate on the "workflow_cycle" field.
+func WorkflowCycleNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleIn applies the In predicate on the "workflow_cycle" field.
+func WorkflowCycleIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleNotIn applies the NotIn predicate on the "workflow_cycle" field.
+func WorkflowCycleNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldWorkflowCycle, vs...))
+}
+
+// WorkflowCycleGT applies the GT predicate on the "workflow_cycle" field.
+func WorkflowCycleGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleGTE applies the GTE predicate on the "workflow_cycle" field.
+func WorkflowCycleGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLT applies the LT predicate on the "workflow_cycle" field.
+func WorkflowCycleLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldWorkflowCycle, v))
+}
+
+// WorkflowCycleLTE applies the LTE predicate on the "workflow_cycle" field.
+func WorkflowCycleLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldWorkflowCycle, v))
+}
+
+// EscrowTransferIDEQ applies the EQ predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDEQ(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDNEQ applies the NEQ predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDNEQ(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDIn applies the In predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDIn(vs ...string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldEscrowTransferID, vs...))
+}
+
+// EscrowTransferIDNotIn applies the NotIn predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDNotIn(vs ...string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldEscrowTransferID, vs...))
+}
+
+// EscrowTransferIDGT applies the GT predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDGT(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDGTE applies the GTE predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDGTE(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDLT applies the LT predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDLT(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDLTE applies the LTE predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDLTE(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDContains applies the Contains predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDContains(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldContains(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDHasPrefix applies the HasPrefix predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDHasPrefix(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldHasPrefix(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDHasSuffix applies the HasSuffix predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDHasSuffix(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldHasSuffix(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDEqualFold applies the EqualFold predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDEqualFold(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEqualFold(FieldEscrowTransferID, v))
+}
+
+// EscrowTransferIDContainsFold applies the ContainsFold predicate on the "escrow_transfer_id" field.
+func EscrowTransferIDContainsFold(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldContainsFold(FieldEscrowTransferID, v))
+}
+
+// HoldHoursEQ applies the EQ predicate on the "hold_hours" field.
+func HoldHoursEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldHoldHours, v))
+}
+
+// HoldHoursNEQ applies the NEQ predicate on the "hold_hours" field.
+func HoldHoursNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldHoldHours, v))
+}
+
+// HoldHoursIn applies the In predicate on the "hold_hours" field.
+func HoldHoursIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldHoldHours, vs...))
+}
+
+// HoldHoursNotIn applies the NotIn predicate on the "hold_hours" field.
+func HoldHoursNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldHoldHours, vs...))
+}
+
+// HoldHoursGT applies the GT predicate on the "hold_hours" field.
+func HoldHoursGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldHoldHours, v))
+}
+
+// HoldHoursGTE applies the GTE predicate on the "hold_hours" field.
+func HoldHoursGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldHoldHours, v))
+}
+
+// HoldHoursLT applies the LT predicate on the "hold_hours" field.
+func HoldHoursLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldHoldHours, v))
+}
+
+// HoldHoursLTE applies the LTE predicate on the "hold_hours" field.
+func HoldHoursLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldHoldHours, v))
+}
+
+// LockedAtEQ applies the EQ predicate on the "locked_at" field.
+func LockedAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedAtNEQ applies the NEQ predicate on the "locked_at" field.
+func LockedAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldLockedAt, v))
+}
+
+// LockedAtIn applies the In predicate on the "locked_at" field.
+func LockedAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldLockedAt, vs...))
+}
+
+// LockedAtNotIn applies the NotIn predicate on the "locked_at" field.
+func LockedAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldLockedAt, vs...))
+}
+
+// LockedAtGT applies the GT predicate on the "locked_at" field.
+func LockedAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldLockedAt, v))
+}
+
+// LockedAtGTE applies the GTE predicate on the "locked_at" field.
+func LockedAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldLockedAt, v))
+}
+
+// LockedAtLT applies the LT predicate on the "locked_at" field.
+func LockedAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldLockedAt, v))
+}
+
+// LockedAtLTE applies the LTE predicate on the "locked_at" field.
+func LockedAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldLockedAt, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldDueAt, v))
+}
+
+// GraceUntilEQ applies the EQ predicate on the "grace_until" field.
+func GraceUntilEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldGraceUntil, v))
+}
+
+// GraceUntilNEQ applies the NEQ predicate on the "grace_until" field.
+func GraceUntilNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldGraceUntil, v))
+}
+
+// GraceUntilIn applies the In predicate on the "grace_until" field.
+func GraceUntilIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldGraceUntil, vs...))
+}
+
+// GraceUntilNotIn applies the NotIn predicate on the "grace_until" field.
+func GraceUntilNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldGraceUntil, vs...))
+}
+
+// GraceUntilGT applies the GT predicate on the "grace_until" field.
+func GraceUntilGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldGraceUntil, v))
+}
+
+// GraceUntilGTE applies the GTE predicate on the "grace_until" field.
+func GraceUntilGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldGraceUntil, v))
+}
+
+// GraceUntilLT applies the LT predicate on the "grace_until" field.
+func GraceUntilLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldGraceUntil, v))
+}
+
+// GraceUntilLTE applies the LTE predicate on the "grace_until" field.
+func GraceUntilLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldGraceUntil, v))
+}
+
+// ReminderCountEQ applies the EQ predicate on the "reminder_count" field.
+func ReminderCountEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldReminderCount, v))
+}
+
+// ReminderCountNEQ applies the NEQ predicate on the "reminder_count" field.
+func ReminderCountNEQ(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldReminderCount, v))
+}
+
+// ReminderCountIn applies the In predicate on the "reminder_count" field.
+func ReminderCountIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldReminderCount, vs...))
+}
+
+// ReminderCountNotIn applies the NotIn predicate on the "reminder_count" field.
+func ReminderCountNotIn(vs ...int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldReminderCount, vs...))
+}
+
+// ReminderCountGT applies the GT predicate on the "reminder_count" field.
+func ReminderCountGT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldReminderCount, v))
+}
+
+// ReminderCountGTE applies the GTE predicate on the "reminder_count" field.
+func ReminderCountGTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldReminderCount, v))
+}
+
+// ReminderCountLT applies the LT predicate on the "reminder_count" field.
+func ReminderCountLT(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldReminderCount, v))
+}
+
+// ReminderCountLTE applies the LTE predicate on the "reminder_count" field.
+func ReminderCountLTE(v int) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldReminderCount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// MissedAtEQ applies the EQ predicate on the "missed_at" field.
+func MissedAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldMissedAt, v))
+}
+
+// MissedAtNEQ applies the NEQ predicate on the "missed_at" field.
+func MissedAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldMissedAt, v))
+}
+
+// MissedAtIn applies the In predicate on the "missed_at" field.
+func MissedAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldMissedAt, vs...))
+}
+
+// MissedAtNotIn applies the NotIn predicate on the "missed_at" field.
+func MissedAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldMissedAt, vs...))
+}
+
+// MissedAtGT applies the GT predicate on the "missed_at" field.
+func MissedAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldMissedAt, v))
+}
+
+// MissedAtGTE applies the GTE predicate on the "missed_at" field.
+func MissedAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldMissedAt, v))
+}
+
+// MissedAtLT applies the LT predicate on the "missed_at" field.
+func MissedAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldMissedAt, v))
+}
+
+// MissedAtLTE applies the LTE predicate on the "missed_at" field.
+func MissedAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldMissedAt, v))
+}
+
+// MissedAtIsNil applies the IsNil predicate on the "missed_at" field.
+func MissedAtIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldMissedAt))
+}
+
+// MissedAtNotNil applies the NotNil predicate on the "missed_at" field.
+func MissedAtNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldMissedAt))
+}
+
+// EscalatedAtEQ applies the EQ predicate on the "escalated_at" field.
+func EscalatedAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtNEQ applies the NEQ predicate on the "escalated_at" field.
+func EscalatedAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIn applies the In predicate on the "escalated_at" field.
+func EscalatedAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtNotIn applies the NotIn predicate on the "escalated_at" field.
+func EscalatedAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldEscalatedAt, vs...))
+}
+
+// EscalatedAtGT applies the GT predicate on the "escalated_at" field.
+func EscalatedAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtGTE applies the GTE predicate on the "escalated_at" field.
+func EscalatedAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLT applies the LT predicate on the "escalated_at" field.
+func EscalatedAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldEscalatedAt, v))
+}
+
+// EscalatedAtLTE applies the LTE predicate on the "escalated_at" field.
+func EscalatedAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldEscalatedAt, v))
+}
+
+// EscalatedAtIsNil applies the IsNil predicate on the "escalated_at" field.
+func EscalatedAtIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldEscalatedAt))
+}
+
+// EscalatedAtNotNil applies the NotNil predicate on the "escalated_at" field.
+func EscalatedAtNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldEscalatedAt))
+}
+
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// RecommendationEQ applies the EQ predicate on the "recommendation" field.
+func RecommendationEQ(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEQ(FieldRecommendation, v))
+}
+
+// RecommendationNEQ applies the NEQ predicate on the "recommendation" field.
+func RecommendationNEQ(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNEQ(FieldRecommendation, v))
+}
+
+// RecommendationIn applies the In predicate on the "recommendation" field.
+func RecommendationIn(vs ...string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIn(FieldRecommendation, vs...))
+}
+
+// RecommendationNotIn applies the NotIn predicate on the "recommendation" field.
+func RecommendationNotIn(vs ...string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotIn(FieldRecommendation, vs...))
+}
+
+// RecommendationGT applies the GT predicate on the "recommendation" field.
+func RecommendationGT(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGT(FieldRecommendation, v))
+}
+
+// RecommendationGTE applies the GTE predicate on the "recommendation" field.
+func RecommendationGTE(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldGTE(FieldRecommendation, v))
+}
+
+// RecommendationLT applies the LT predicate on the "recommendation" field.
+func RecommendationLT(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLT(FieldRecommendation, v))
+}
+
+// RecommendationLTE applies the LTE predicate on the "recommendation" field.
+func RecommendationLTE(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldLTE(FieldRecommendation, v))
+}
+
+// RecommendationContains applies the Contains predicate on the "recommendation" field.
+func RecommendationContains(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldContains(FieldRecommendation, v))
+}
+
+// RecommendationHasPrefix applies the HasPrefix predicate on the "recommendation" field.
+func RecommendationHasPrefix(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldHasPrefix(FieldRecommendation, v))
+}
+
+// RecommendationHasSuffix applies the HasSuffix predicate on the "recommendation" field.
+func RecommendationHasSuffix(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldHasSuffix(FieldRecommendation, v))
+}
+
+// RecommendationIsNil applies the IsNil predicate on the "recommendation" field.
+func RecommendationIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldRecommendation))
+}
+
+// RecommendationNotNil applies the NotNil predicate on the "recommendation" field.
+func RecommendationNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldRecommendation))
+}
+
+// RecommendationEqualFold applies the EqualFold predicate on the "recommendation" field.
+func RecommendationEqualFold(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldEqualFold(FieldRecommendation, v))
+}
+
+// RecommendationContainsFold applies the ContainsFold predicate on the "recommendation" field.
+func RecommendationContainsFold(v string) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldContainsFold(FieldRecommendation, v))
+}
+
+// DisputeDraftIsNil applies the IsNil predicate on the "dispute_draft" field.
+func DisputeDraftIsNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldIsNull(FieldDisputeDraft))
+}
+
+// DisputeDraftNotNil applies the NotNil predicate on the "dispute_draft" field.
+func DisputeDraftNotNil() predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.FieldNotNull(FieldDisputeDraft))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ValidatorDeliverySLA) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ValidatorDeliverySLA) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ValidatorDeliverySLA) predicate.ValidatorDeliverySLA {
+	return predicate.ValidatorDeliverySLA(sql.NotPredicates(p))
+}
@@ -0,0 +1,587 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/validatordeliverysla"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorDeliverySLACreate is the builder for creating a ValidatorDeliverySLA entity.
+type ValidatorDeliverySLACreate struct {
+	config
+	mutation *ValidatorDeliverySLAMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ValidatorDeliverySLACreate) SetCreatedAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableCreatedAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ValidatorDeliverySLACreate) SetUpdatedAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableUpdatedAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ValidatorDeliverySLACreate) SetDeletedAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableDeletedAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_c *ValidatorDeliverySLACreate) SetValidationCaseID(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetValidationCaseID(v)
+	return _c
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_c *ValidatorDeliverySLACreate) SetFinalOfferID(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetFinalOfferID(v)
+	return _c
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_c *ValidatorDeliverySLACreate) SetMilestoneID(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetMilestoneID(v)
+	return _c
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableMilestoneID(v *int) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetMilestoneID(*v)
+	}
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *ValidatorDeliverySLACreate) SetValidatorUserID(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_c *ValidatorDeliverySLACreate) SetWorkflowCycle(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetWorkflowCycle(v)
+	return _c
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableWorkflowCycle(v *int) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetWorkflowCycle(*v)
+	}
+	return _c
+}
+
+// SetEscrowTransferID sets the "escrow_transfer_id" field.
+func (_c *ValidatorDeliverySLACreate) SetEscrowTransferID(v string) *ValidatorDeliverySLACreate {
+	_c.mutation.SetEscrowTransferID(v)
+	return _c
+}
+
+// SetHoldHours sets the "hold_hours" field.
+func (_c *ValidatorDeliverySLACreate) SetHoldHours(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetHoldHours(v)
+	return _c
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_c *ValidatorDeliverySLACreate) SetLockedAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetLockedAt(v)
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *ValidatorDeliverySLACreate) SetDueAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (_c *ValidatorDeliverySLACreate) SetGraceUntil(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetGraceUntil(v)
+	return _c
+}
+
+// SetReminderCount sets the "reminder_count" field.
+func (_c *ValidatorDeliverySLACreate) SetReminderCount(v int) *ValidatorDeliverySLACreate {
+	_c.mutation.SetReminderCount(v)
+	return _c
+}
+
+// SetNillableReminderCount sets the "reminder_count" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableReminderCount(v *int) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetReminderCount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ValidatorDeliverySLACreate) SetStatus(v string) *ValidatorDeliverySLACreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableStatus(v *string) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_c *ValidatorDeliverySLACreate) SetDeliveredAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetDeliveredAt(v)
+	return _c
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableDeliveredAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetDeliveredAt(*v)
+	}
+	return _c
+}
+
+// SetMissedAt sets the "missed_at" field.
+func (_c *ValidatorDeliverySLACreate) SetMissedAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetMissedAt(v)
+	return _c
+}
+
+// SetNillableMissedAt sets the "missed_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableMissedAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetMissedAt(*v)
+	}
+	return _c
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (_c *ValidatorDeliverySLACreate) SetEscalatedAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetEscalatedAt(v)
+	return _c
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableEscalatedAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetEscalatedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *ValidatorDeliverySLACreate) SetCancelledAt(v time.Time) *ValidatorDeliverySLACreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableCancelledAt(v *time.Time) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetRecommendation sets the "recommendation" field.
+func (_c *ValidatorDeliverySLACreate) SetRecommendation(v string) *ValidatorDeliverySLACreate {
+	_c.mutation.SetRecommendation(v)
+	return _c
+}
+
+// SetNillableRecommendation sets the "recommendation" field if the given value is not nil.
+func (_c *ValidatorDeliverySLACreate) SetNillableRecommendation(v *string) *ValidatorDeliverySLACreate {
+	if v != nil {
+		_c.SetRecommendation(*v)
+	}
+	return _c
+}
+
+// SetDisputeDraft sets the "dispute_draft" field.
+func (_c *ValidatorDeliverySLACreate) SetDisputeDraft(v map[string]interface{}) *ValidatorDeliverySLACreate {
+	_c.mutation.SetDisputeDraft(v)
+	return _c
+}
+
+// Mutation returns the ValidatorDeliverySLAMutation object of the builder.
+func (_c *ValidatorDeliverySLACreate) Mutation() *ValidatorDeliverySLAMutation {
+	return _c.mutation
+}
+
+// Save creates the ValidatorDeliverySLA in the database.
+func (_c *ValidatorDeliverySLACreate) Save(ctx context.Context) (*ValidatorDeliverySLA, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ValidatorDeliverySLACreate) SaveX(ctx context.Context) *ValidatorDeliverySLA {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValidatorDeliverySLACreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValidatorDeliverySLACreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ValidatorDeliverySLACreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := validatordeliverysla.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := validatordeliverysla.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		v := validatordeliverysla.DefaultWorkflowCycle
+		_c.mutation.SetWorkflowCycle(v)
+	}
+	if _, ok := _c.mutation.ReminderCount(); !ok {
+		v := validatordeliverysla.DefaultReminderCount
+		_c.mutation.SetReminderCount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := validatordeliverysla.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ValidatorDeliverySLACreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidationCaseID(); !ok {
+		return &ValidationError{Name: "validation_case_id", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.validation_case_id"`)}
+	}
+	if v, ok := _c.mutation.ValidationCaseID(); ok {
+		if err := validatordeliverysla.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.validation_case_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FinalOfferID(); !ok {
+		return &ValidationError{Name: "final_offer_id", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.final_offer_id"`)}
+	}
+	if v, ok := _c.mutation.FinalOfferID(); ok {
+		if err := validatordeliverysla.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.final_offer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := validatordeliverysla.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WorkflowCycle(); !ok {
+		return &ValidationError{Name: "workflow_cycle", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.workflow_cycle"`)}
+	}
+	if v, ok := _c.mutation.WorkflowCycle(); ok {
+		if err := validatordeliverysla.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.workflow_cycle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EscrowTransferID(); !ok {
+		return &ValidationError{Name: "escrow_transfer_id", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.escrow_transfer_id"`)}
+	}
+	if v, ok := _c.mutation.EscrowTransferID(); ok {
+		if err := validatordeliverysla.EscrowTransferIDValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_transfer_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.escrow_transfer_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HoldHours(); !ok {
+		return &ValidationError{Name: "hold_hours", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.hold_hours"`)}
+	}
+	if v, ok := _c.mutation.HoldHours(); ok {
+		if err := validatordeliverysla.HoldHoursValidator(v); err != nil {
+			return &ValidationError{Name: "hold_hours", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.hold_hours": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LockedAt(); !ok {
+		return &ValidationError{Name: "locked_at", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.locked_at"`)}
+	}
+	if _, ok := _c.mutation.DueAt(); !ok {
+		return &ValidationError{Name: "due_at", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.due_at"`)}
+	}
+	if _, ok := _c.mutation.GraceUntil(); !ok {
+		return &ValidationError{Name: "grace_until", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.grace_until"`)}
+	}
+	if _, ok := _c.mutation.ReminderCount(); !ok {
+		return &ValidationError{Name: "reminder_count", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.reminder_count"`)}
+	}
+	if v, ok := _c.mutation.ReminderCount(); ok {
+		if err := validatordeliverysla.ReminderCountValidator(v); err != nil {
+			return &ValidationError{Name: "reminder_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.reminder_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ValidatorDeliverySLA.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := validatordeliverysla.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Recommendation(); ok {
+		if err := validatordeliverysla.RecommendationValidator(v); err != nil {
+			return &ValidationError{Name: "recommendation", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.recommendation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ValidatorDeliverySLACreate) sqlSave(ctx context.Context) (*ValidatorDeliverySLA, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ValidatorDeliverySLACreate) createSpec() (*ValidatorDeliverySLA, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ValidatorDeliverySLA{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(validatordeliverysla.Table, sqlgraph.NewFieldSpec(validatordeliverysla.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidationCaseID(); ok {
+		_spec.SetField(validatordeliverysla.FieldValidationCaseID, field.TypeInt, value)
+		_node.ValidationCaseID = value
+	}
+	if value, ok := _c.mutation.FinalOfferID(); ok {
+		_spec.SetField(validatordeliverysla.FieldFinalOfferID, field.TypeInt, value)
+		_node.FinalOfferID = value
+	}
+	if value, ok := _c.mutation.MilestoneID(); ok {
+		_spec.SetField(validatordeliverysla.FieldMilestoneID, field.TypeInt, value)
+		_node.MilestoneID = &value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatordeliverysla.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.WorkflowCycle(); ok {
+		_spec.SetField(validatordeliverysla.FieldWorkflowCycle, field.TypeInt, value)
+		_node.WorkflowCycle = value
+	}
+	if value, ok := _c.mutation.EscrowTransferID(); ok {
+		_spec.SetField(validatordeliverysla.FieldEscrowTransferID, field.TypeString, value)
+		_node.EscrowTransferID = value
+	}
+	if value, ok := _c.mutation.HoldHours(); ok {
+		_spec.SetField(validatordeliverysla.FieldHoldHours, field.TypeInt, value)
+		_node.HoldHours = value
+	}
+	if value, ok := _c.mutation.LockedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = value
+	}
+	if value, ok := _c.mutation.GraceUntil(); ok {
+		_spec.SetField(validatordeliverysla.FieldGraceUntil, field.TypeTime, value)
+		_node.GraceUntil = value
+	}
+	if value, ok := _c.mutation.ReminderCount(); ok {
+		_spec.SetField(validatordeliverysla.FieldReminderCount, field.TypeInt, value)
+		_node.ReminderCount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(validatordeliverysla.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DeliveredAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := _c.mutation.MissedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldMissedAt, field.TypeTime, value)
+		_node.MissedAt = &value
+	}
+	if value, ok := _c.mutation.EscalatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldEscalatedAt, field.TypeTime, value)
+		_node.EscalatedAt = &value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.Recommendation(); ok {
+		_spec.SetField(validatordeliverysla.FieldRecommendation, field.TypeString, value)
+		_node.Recommendation = &value
+	}
+	if value, ok := _c.mutation.DisputeDraft(); ok {
+		_spec.SetField(validatordeliverysla.FieldDisputeDraft, field.TypeJSON, value)
+		_node.DisputeDraft = value
+	}
+	return _node, _spec
+}
+
+// ValidatorDeliverySLACreateBulk is the builder for creating many ValidatorDeliverySLA entities in bulk.
+type ValidatorDeliverySLACreateBulk struct {
+	config
+	err      error
+	builders []*ValidatorDeliverySLACreate
+}
+
+// Save creates the ValidatorDeliverySLA entities in the database.
+func (_c *ValidatorDeliverySLACreateBulk) Save(ctx context.Context) ([]*ValidatorDeliverySLA, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ValidatorDeliverySLA, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ValidatorDeliverySLAMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ValidatorDeliverySLACreateBulk) SaveX(ctx context.Context) []*ValidatorDeliverySLA {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValidatorDeliverySLACreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValidatorDeliverySLACreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
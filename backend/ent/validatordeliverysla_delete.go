@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/validatordeliverysla"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorDeliverySLADelete is the builder for deleting a ValidatorDeliverySLA entity.
+type ValidatorDeliverySLADelete struct {
+	config
+	hooks    []Hook
+	mutation *ValidatorDeliverySLAMutation
+}
+
+// Where appends a list predicates to the ValidatorDeliverySLADelete builder.
+func (_d *ValidatorDeliverySLADelete) Where(ps ...predicate.ValidatorDeliverySLA) *ValidatorDeliverySLADelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ValidatorDeliverySLADelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ValidatorDeliverySLADelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ValidatorDeliverySLADelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(validatordeliverysla.Table, sqlgraph.NewFieldSpec(validatordeliverysla.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ValidatorDeliverySLADeleteOne is the builder for deleting a single ValidatorDeliverySLA entity.
+type ValidatorDeliverySLADeleteOne struct {
+	_d *ValidatorDeliverySLADelete
+}
+
+// Where appends a list predicates to the ValidatorDeliverySLADelete builder.
+func (_d *ValidatorDeliverySLADeleteOne) Where(ps ...predicate.ValidatorDeliverySLA) *ValidatorDeliverySLADeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ValidatorDeliverySLADeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{validatordeliverysla.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ValidatorDeliverySLADeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
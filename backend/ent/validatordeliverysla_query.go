@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/validatordeliverysla"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorDeliverySLAQuery is the builder for querying ValidatorDeliverySLA entities.
+type ValidatorDeliverySLAQuery struct {
+	config
+	ctx        *QueryContext
+	order      []validatordeliverysla.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ValidatorDeliverySLA
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ValidatorDeliverySLAQuery builder.
+func (_q *ValidatorDeliverySLAQuery) Where(ps ...predicate.ValidatorDeliverySLA) *ValidatorDeliverySLAQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ValidatorDeliverySLAQuery) Limit(limit int) *ValidatorDeliverySLAQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ValidatorDeliverySLAQuery) Offset(offset int) *ValidatorDeliverySLAQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ValidatorDeliverySLAQuery) Unique(unique bool) *ValidatorDeliverySLAQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ValidatorDeliverySLAQuery) Order(o ...validatordeliverysla.OrderOption) *ValidatorDeliverySLAQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first ValidatorDeliverySLA entity from the query.
+// Returns a *NotFoundError when no ValidatorDeliverySLA was found.
+func (_q *ValidatorDeliverySLAQuery) First(ctx context.Context) (*ValidatorDeliverySLA, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{validatordeliverysla.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) FirstX(ctx context.Context) *ValidatorDeliverySLA {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ValidatorDeliverySLA ID from the query.
+// Returns a *NotFoundError when no ValidatorDeliverySLA ID was found.
+func (_q *ValidatorDeliverySLAQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{validatordeliverysla.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ValidatorDeliverySLA entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ValidatorDeliverySLA entity is found.
+// Returns a *NotFoundError when no ValidatorDeliverySLA entities are found.
+func (_q *ValidatorDeliverySLAQuery) Only(ctx context.Context) (*ValidatorDeliverySLA, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{validatordeliverysla.Label}
+	default:
+		return nil, &NotSingularError{validatordeliverysla.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) OnlyX(ctx context.Context) *ValidatorDeliverySLA {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ValidatorDeliverySLA ID in the query.
+// Returns a *NotSingularError when more than one ValidatorDeliverySLA ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ValidatorDeliverySLAQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{validatordeliverysla.Label}
+	default:
+		err = &NotSingularError{validatordeliverysla.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ValidatorDeliverySLAs.
+func (_q *ValidatorDeliverySLAQuery) All(ctx context.Context) ([]*ValidatorDeliverySLA, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ValidatorDeliverySLA, *ValidatorDeliverySLAQuery]()
+	return withInterceptors[[]*ValidatorDeliverySLA](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) AllX(ctx context.Context) []*ValidatorDeliverySLA {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ValidatorDeliverySLA IDs.
+func (_q *ValidatorDeliverySLAQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(validatordeliverysla.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ValidatorDeliverySLAQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ValidatorDeliverySLAQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ValidatorDeliverySLAQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ValidatorDeliverySLAQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ValidatorDeliverySLAQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ValidatorDeliverySLAQuery) Clone() *ValidatorDeliverySLAQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ValidatorDeliverySLAQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]validatordeliverysla.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.ValidatorDeliverySLA{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ValidatorDeliverySLA.Query().
+//		GroupBy(validatordeliverysla.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ValidatorDeliverySLAQuery) GroupBy(field string, fields ...string) *ValidatorDeliverySLAGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ValidatorDeliverySLAGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = validatordeliverysla.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ValidatorDeliverySLA.Query().
+//		Select(validatordeliverysla.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *ValidatorDeliverySLAQuery) Select(fields ...string) *ValidatorDeliverySLASelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ValidatorDeliverySLASelect{ValidatorDeliverySLAQuery: _q}
+	sbuild.label = validatordeliverysla.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ValidatorDeliverySLASelect configured with the given aggregations.
+func (_q *ValidatorDeliverySLAQuery) Aggregate(fns ...AggregateFunc) *ValidatorDeliverySLASelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ValidatorDeliverySLAQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !validatordeliverysla.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ValidatorDeliverySLAQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ValidatorDeliverySLA, error) {
+	var (
+		nodes = []*ValidatorDeliverySLA{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ValidatorDeliverySLA).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ValidatorDeliverySLA{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *ValidatorDeliverySLAQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ValidatorDeliverySLAQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(validatordeliverysla.Table, validatordeliverysla.Columns, sqlgraph.NewFieldSpec(validatordeliverysla.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, validatordeliverysla.FieldID)
+		for i := range fields {
+			if fields[i] != validatordeliverysla.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ValidatorDeliverySLAQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(validatordeliverysla.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = validatordeliverysla.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ValidatorDeliverySLAGroupBy is the group-by builder for ValidatorDeliverySLA entities.
+type ValidatorDeliverySLAGroupBy struct {
+	selector
+	build *ValidatorDeliverySLAQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ValidatorDeliverySLAGroupBy) Aggregate(fns ...AggregateFunc) *ValidatorDeliverySLAGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ValidatorDeliverySLAGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ValidatorDeliverySLAQuery, *ValidatorDeliverySLAGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ValidatorDeliverySLAGroupBy) sqlScan(ctx context.Context, root *ValidatorDeliverySLAQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ValidatorDeliverySLASelect is the builder for selecting fields of ValidatorDeliverySLA entities.
+type ValidatorDeliverySLASelect struct {
+	*ValidatorDeliverySLAQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ValidatorDeliverySLASelect) Aggregate(fns ...AggregateFunc) *ValidatorDeliverySLASelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ValidatorDeliverySLASelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ValidatorDeliverySLAQuery, *ValidatorDeliverySLASelect](ctx, _s.ValidatorDeliverySLAQuery, _s, _s.inters, v)
+}
+
+func (_s *ValidatorDeliverySLASelect) sqlScan(ctx context.Context, root *ValidatorDeliverySLAQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/validatordeliverysla"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorDeliverySLAUpdate is the builder for updating ValidatorDeliverySLA entities.
+type ValidatorDeliverySLAUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ValidatorDeliverySLAMutation
+}
+
+// Where appends a list predicates to the ValidatorDeliverySLAUpdate builder.
+func (_u *ValidatorDeliverySLAUpdate) Where(ps ...predicate.ValidatorDeliverySLA) *ValidatorDeliverySLAUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetUpdatedAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetDeletedAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableDeletedAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearDeletedAt() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *ValidatorDeliverySLAUpdate) SetValidationCaseID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableValidationCaseID(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *ValidatorDeliverySLAUpdate) AddValidationCaseID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *ValidatorDeliverySLAUpdate) SetFinalOfferID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetFinalOfferID()
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableFinalOfferID(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// AddFinalOfferID adds value to the "final_offer_id" field.
+func (_u *ValidatorDeliverySLAUpdate) AddFinalOfferID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddFinalOfferID(v)
+	return _u
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_u *ValidatorDeliverySLAUpdate) SetMilestoneID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetMilestoneID()
+	_u.mutation.SetMilestoneID(v)
+	return _u
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableMilestoneID(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetMilestoneID(*v)
+	}
+	return _u
+}
+
+// AddMilestoneID adds value to the "milestone_id" field.
+func (_u *ValidatorDeliverySLAUpdate) AddMilestoneID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddMilestoneID(v)
+	return _u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearMilestoneID() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearMilestoneID()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ValidatorDeliverySLAUpdate) SetValidatorUserID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableValidatorUserID(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ValidatorDeliverySLAUpdate) AddValidatorUserID(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *ValidatorDeliverySLAUpdate) SetWorkflowCycle(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableWorkflowCycle(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *ValidatorDeliverySLAUpdate) AddWorkflowCycle(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetEscrowTransferID sets the "escrow_transfer_id" field.
+func (_u *ValidatorDeliverySLAUpdate) SetEscrowTransferID(v string) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetEscrowTransferID(v)
+	return _u
+}
+
+// SetNillableEscrowTransferID sets the "escrow_transfer_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableEscrowTransferID(v *string) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetEscrowTransferID(*v)
+	}
+	return _u
+}
+
+// SetHoldHours sets the "hold_hours" field.
+func (_u *ValidatorDeliverySLAUpdate) SetHoldHours(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetHoldHours()
+	_u.mutation.SetHoldHours(v)
+	return _u
+}
+
+// SetNillableHoldHours sets the "hold_hours" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableHoldHours(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetHoldHours(*v)
+	}
+	return _u
+}
+
+// AddHoldHours adds value to the "hold_hours" field.
+func (_u *ValidatorDeliverySLAUpdate) AddHoldHours(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddHoldHours(v)
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetLockedAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableLockedAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetDueAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableDueAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (_u *ValidatorDeliverySLAUpdate) SetGraceUntil(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetGraceUntil(v)
+	return _u
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableGraceUntil(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetGraceUntil(*v)
+	}
+	return _u
+}
+
+// SetReminderCount sets the "reminder_count" field.
+func (_u *ValidatorDeliverySLAUpdate) SetReminderCount(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.ResetReminderCount()
+	_u.mutation.SetReminderCount(v)
+	return _u
+}
+
+// SetNillableReminderCount sets the "reminder_count" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableReminderCount(v *int) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetReminderCount(*v)
+	}
+	return _u
+}
+
+// AddReminderCount adds value to the "reminder_count" field.
+func (_u *ValidatorDeliverySLAUpdate) AddReminderCount(v int) *ValidatorDeliverySLAUpdate {
+	_u.mutation.AddReminderCount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ValidatorDeliverySLAUpdate) SetStatus(v string) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableStatus(v *string) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetDeliveredAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableDeliveredAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearDeliveredAt() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetMissedAt sets the "missed_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetMissedAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetMissedAt(v)
+	return _u
+}
+
+// SetNillableMissedAt sets the "missed_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableMissedAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetMissedAt(*v)
+	}
+	return _u
+}
+
+// ClearMissedAt clears the value of the "missed_at" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearMissedAt() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearMissedAt()
+	return _u
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetEscalatedAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetEscalatedAt(v)
+	return _u
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableEscalatedAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetEscalatedAt(*v)
+	}
+	return _u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearEscalatedAt() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearEscalatedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *ValidatorDeliverySLAUpdate) SetCancelledAt(v time.Time) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableCancelledAt(v *time.Time) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearCancelledAt() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetRecommendation sets the "recommendation" field.
+func (_u *ValidatorDeliverySLAUpdate) SetRecommendation(v string) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetRecommendation(v)
+	return _u
+}
+
+// SetNillableRecommendation sets the "recommendation" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdate) SetNillableRecommendation(v *string) *ValidatorDeliverySLAUpdate {
+	if v != nil {
+		_u.SetRecommendation(*v)
+	}
+	return _u
+}
+
+// ClearRecommendation clears the value of the "recommendation" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearRecommendation() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearRecommendation()
+	return _u
+}
+
+// SetDisputeDraft sets the "dispute_draft" field.
+func (_u *ValidatorDeliverySLAUpdate) SetDisputeDraft(v map[string]interface{}) *ValidatorDeliverySLAUpdate {
+	_u.mutation.SetDisputeDraft(v)
+	return _u
+}
+
+// ClearDisputeDraft clears the value of the "dispute_draft" field.
+func (_u *ValidatorDeliverySLAUpdate) ClearDisputeDraft() *ValidatorDeliverySLAUpdate {
+	_u.mutation.ClearDisputeDraft()
+	return _u
+}
+
+// Mutation returns the ValidatorDeliverySLAMutation object of the builder.
+func (_u *ValidatorDeliverySLAUpdate) Mutation() *ValidatorDeliverySLAMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ValidatorDeliverySLAUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValidatorDeliverySLAUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ValidatorDeliverySLAUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValidatorDeliverySLAUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValidatorDeliverySLAUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := validatordeliverysla.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValidatorDeliverySLAUpdate) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := validatordeliverysla.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := validatordeliverysla.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := validatordeliverysla.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := validatordeliverysla.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscrowTransferID(); ok {
+		if err := validatordeliverysla.EscrowTransferIDValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_transfer_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.escrow_transfer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoldHours(); ok {
+		if err := validatordeliverysla.HoldHoursValidator(v); err != nil {
+			return &ValidationError{Name: "hold_hours", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.hold_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReminderCount(); ok {
+		if err := validatordeliverysla.ReminderCountValidator(v); err != nil {
+			return &ValidationError{Name: "reminder_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.reminder_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := validatordeliverysla.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recommendation(); ok {
+		if err := validatordeliverysla.RecommendationValidator(v); err != nil {
+			return &ValidationError{Name: "recommendation", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.recommendation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ValidatorDeliverySLAUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(validatordeliverysla.Table, validatordeliverysla.Columns, sqlgraph.NewFieldSpec(validatordeliverysla.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(validatordeliverysla.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(validatordeliverysla.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FinalOfferID(); ok {
+		_spec.SetField(validatordeliverysla.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFinalOfferID(); ok {
+		_spec.AddField(validatordeliverysla.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MilestoneID(); ok {
+		_spec.SetField(validatordeliverysla.FieldMilestoneID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMilestoneID(); ok {
+		_spec.AddField(validatordeliverysla.FieldMilestoneID, field.TypeInt, value)
+	}
+	if _u.mutation.MilestoneIDCleared() {
+		_spec.ClearField(validatordeliverysla.FieldMilestoneID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatordeliverysla.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(validatordeliverysla.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(validatordeliverysla.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(validatordeliverysla.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EscrowTransferID(); ok {
+		_spec.SetField(validatordeliverysla.FieldEscrowTransferID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HoldHours(); ok {
+		_spec.SetField(validatordeliverysla.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHoldHours(); ok {
+		_spec.AddField(validatordeliverysla.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldLockedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GraceUntil(); ok {
+		_spec.SetField(validatordeliverysla.FieldGraceUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReminderCount(); ok {
+		_spec.SetField(validatordeliverysla.FieldReminderCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReminderCount(); ok {
+		_spec.AddField(validatordeliverysla.FieldReminderCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(validatordeliverysla.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MissedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldMissedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MissedAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldMissedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EscalatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EscalatedAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldEscalatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Recommendation(); ok {
+		_spec.SetField(validatordeliverysla.FieldRecommendation, field.TypeString, value)
+	}
+	if _u.mutation.RecommendationCleared() {
+		_spec.ClearField(validatordeliverysla.FieldRecommendation, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisputeDraft(); ok {
+		_spec.SetField(validatordeliverysla.FieldDisputeDraft, field.TypeJSON, value)
+	}
+	if _u.mutation.DisputeDraftCleared() {
+		_spec.ClearField(validatordeliverysla.FieldDisputeDraft, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{validatordeliverysla.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ValidatorDeliverySLAUpdateOne is the builder for updating a single ValidatorDeliverySLA entity.
+type ValidatorDeliverySLAUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ValidatorDeliverySLAMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetUpdatedAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetDeletedAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableDeletedAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearDeletedAt() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidationCaseID sets the "validation_case_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetValidationCaseID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetValidationCaseID()
+	_u.mutation.SetValidationCaseID(v)
+	return _u
+}
+
+// SetNillableValidationCaseID sets the "validation_case_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableValidationCaseID(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetValidationCaseID(*v)
+	}
+	return _u
+}
+
+// AddValidationCaseID adds value to the "validation_case_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddValidationCaseID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddValidationCaseID(v)
+	return _u
+}
+
+// SetFinalOfferID sets the "final_offer_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetFinalOfferID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetFinalOfferID()
+	_u.mutation.SetFinalOfferID(v)
+	return _u
+}
+
+// SetNillableFinalOfferID sets the "final_offer_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableFinalOfferID(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetFinalOfferID(*v)
+	}
+	return _u
+}
+
+// AddFinalOfferID adds value to the "final_offer_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddFinalOfferID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddFinalOfferID(v)
+	return _u
+}
+
+// SetMilestoneID sets the "milestone_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetMilestoneID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetMilestoneID()
+	_u.mutation.SetMilestoneID(v)
+	return _u
+}
+
+// SetNillableMilestoneID sets the "milestone_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableMilestoneID(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetMilestoneID(*v)
+	}
+	return _u
+}
+
+// AddMilestoneID adds value to the "milestone_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddMilestoneID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddMilestoneID(v)
+	return _u
+}
+
+// ClearMilestoneID clears the value of the "milestone_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearMilestoneID() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearMilestoneID()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetValidatorUserID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableValidatorUserID(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddValidatorUserID(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetWorkflowCycle sets the "workflow_cycle" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetWorkflowCycle(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetWorkflowCycle()
+	_u.mutation.SetWorkflowCycle(v)
+	return _u
+}
+
+// SetNillableWorkflowCycle sets the "workflow_cycle" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableWorkflowCycle(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetWorkflowCycle(*v)
+	}
+	return _u
+}
+
+// AddWorkflowCycle adds value to the "workflow_cycle" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddWorkflowCycle(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddWorkflowCycle(v)
+	return _u
+}
+
+// SetEscrowTransferID sets the "escrow_transfer_id" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetEscrowTransferID(v string) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetEscrowTransferID(v)
+	return _u
+}
+
+// SetNillableEscrowTransferID sets the "escrow_transfer_id" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableEscrowTransferID(v *string) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetEscrowTransferID(*v)
+	}
+	return _u
+}
+
+// SetHoldHours sets the "hold_hours" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetHoldHours(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetHoldHours()
+	_u.mutation.SetHoldHours(v)
+	return _u
+}
+
+// SetNillableHoldHours sets the "hold_hours" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableHoldHours(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetHoldHours(*v)
+	}
+	return _u
+}
+
+// AddHoldHours adds value to the "hold_hours" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddHoldHours(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddHoldHours(v)
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetLockedAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableLockedAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetDueAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableDueAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetGraceUntil(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetGraceUntil(v)
+	return _u
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableGraceUntil(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetGraceUntil(*v)
+	}
+	return _u
+}
+
+// SetReminderCount sets the "reminder_count" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetReminderCount(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ResetReminderCount()
+	_u.mutation.SetReminderCount(v)
+	return _u
+}
+
+// SetNillableReminderCount sets the "reminder_count" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableReminderCount(v *int) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetReminderCount(*v)
+	}
+	return _u
+}
+
+// AddReminderCount adds value to the "reminder_count" field.
+func (_u *ValidatorDeliverySLAUpdateOne) AddReminderCount(v int) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.AddReminderCount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetStatus(v string) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableStatus(v *string) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetDeliveredAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableDeliveredAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearDeliveredAt() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// SetMissedAt sets the "missed_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetMissedAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetMissedAt(v)
+	return _u
+}
+
+// SetNillableMissedAt sets the "missed_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableMissedAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetMissedAt(*v)
+	}
+	return _u
+}
+
+// ClearMissedAt clears the value of the "missed_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearMissedAt() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearMissedAt()
+	return _u
+}
+
+// SetEscalatedAt sets the "escalated_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetEscalatedAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetEscalatedAt(v)
+	return _u
+}
+
+// SetNillableEscalatedAt sets the "escalated_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableEscalatedAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetEscalatedAt(*v)
+	}
+	return _u
+}
+
+// ClearEscalatedAt clears the value of the "escalated_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearEscalatedAt() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearEscalatedAt()
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetCancelledAt(v time.Time) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableCancelledAt(v *time.Time) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearCancelledAt() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetRecommendation sets the "recommendation" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetRecommendation(v string) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetRecommendation(v)
+	return _u
+}
+
+// SetNillableRecommendation sets the "recommendation" field if the given value is not nil.
+func (_u *ValidatorDeliverySLAUpdateOne) SetNillableRecommendation(v *string) *ValidatorDeliverySLAUpdateOne {
+	if v != nil {
+		_u.SetRecommendation(*v)
+	}
+	return _u
+}
+
+// ClearRecommendation clears the value of the "recommendation" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearRecommendation() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearRecommendation()
+	return _u
+}
+
+// SetDisputeDraft sets the "dispute_draft" field.
+func (_u *ValidatorDeliverySLAUpdateOne) SetDisputeDraft(v map[string]interface{}) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.SetDisputeDraft(v)
+	return _u
+}
+
+// ClearDisputeDraft clears the value of the "dispute_draft" field.
+func (_u *ValidatorDeliverySLAUpdateOne) ClearDisputeDraft() *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.ClearDisputeDraft()
+	return _u
+}
+
+// Mutation returns the ValidatorDeliverySLAMutation object of the builder.
+func (_u *ValidatorDeliverySLAUpdateOne) Mutation() *ValidatorDeliverySLAMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ValidatorDeliverySLAUpdate builder.
+func (_u *ValidatorDeliverySLAUpdateOne) Where(ps ...predicate.ValidatorDeliverySLA) *ValidatorDeliverySLAUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ValidatorDeliverySLAUpdateOne) Select(field string, fields ...string) *ValidatorDeliverySLAUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ValidatorDeliverySLA entity.
+func (_u *ValidatorDeliverySLAUpdateOne) Save(ctx context.Context) (*ValidatorDeliverySLA, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValidatorDeliverySLAUpdateOne) SaveX(ctx context.Context) *ValidatorDeliverySLA {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ValidatorDeliverySLAUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValidatorDeliverySLAUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValidatorDeliverySLAUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := validatordeliverysla.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValidatorDeliverySLAUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidationCaseID(); ok {
+		if err := validatordeliverysla.ValidationCaseIDValidator(v); err != nil {
+			return &ValidationError{Name: "validation_case_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.validation_case_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FinalOfferID(); ok {
+		if err := validatordeliverysla.FinalOfferIDValidator(v); err != nil {
+			return &ValidationError{Name: "final_offer_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.final_offer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := validatordeliverysla.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WorkflowCycle(); ok {
+		if err := validatordeliverysla.WorkflowCycleValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_cycle", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.workflow_cycle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EscrowTransferID(); ok {
+		if err := validatordeliverysla.EscrowTransferIDValidator(v); err != nil {
+			return &ValidationError{Name: "escrow_transfer_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.escrow_transfer_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoldHours(); ok {
+		if err := validatordeliverysla.HoldHoursValidator(v); err != nil {
+			return &ValidationError{Name: "hold_hours", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.hold_hours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReminderCount(); ok {
+		if err := validatordeliverysla.ReminderCountValidator(v); err != nil {
+			return &ValidationError{Name: "reminder_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.reminder_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := validatordeliverysla.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recommendation(); ok {
+		if err := validatordeliverysla.RecommendationValidator(v); err != nil {
+			return &ValidationError{Name: "recommendation", err: fmt.Errorf(`ent: validator failed for field "ValidatorDeliverySLA.recommendation": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ValidatorDeliverySLAUpdateOne) sqlSave(ctx context.Context) (_node *ValidatorDeliverySLA, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(validatordeliverysla.Table, validatordeliverysla.Columns, sqlgraph.NewFieldSpec(validatordeliverysla.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ValidatorDeliverySLA.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, validatordeliverysla.FieldID)
+		for _, f := range fields {
+			if !validatordeliverysla.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != validatordeliverysla.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidationCaseID(); ok {
+		_spec.SetField(validatordeliverysla.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidationCaseID(); ok {
+		_spec.AddField(validatordeliverysla.FieldValidationCaseID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FinalOfferID(); ok {
+		_spec.SetField(validatordeliverysla.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFinalOfferID(); ok {
+		_spec.AddField(validatordeliverysla.FieldFinalOfferID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MilestoneID(); ok {
+		_spec.SetField(validatordeliverysla.FieldMilestoneID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMilestoneID(); ok {
+		_spec.AddField(validatordeliverysla.FieldMilestoneID, field.TypeInt, value)
+	}
+	if _u.mutation.MilestoneIDCleared() {
+		_spec.ClearField(validatordeliverysla.FieldMilestoneID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatordeliverysla.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(validatordeliverysla.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.WorkflowCycle(); ok {
+		_spec.SetField(validatordeliverysla.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWorkflowCycle(); ok {
+		_spec.AddField(validatordeliverysla.FieldWorkflowCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EscrowTransferID(); ok {
+		_spec.SetField(validatordeliverysla.FieldEscrowTransferID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HoldHours(); ok {
+		_spec.SetField(validatordeliverysla.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHoldHours(); ok {
+		_spec.AddField(validatordeliverysla.FieldHoldHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldLockedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GraceUntil(); ok {
+		_spec.SetField(validatordeliverysla.FieldGraceUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReminderCount(); ok {
+		_spec.SetField(validatordeliverysla.FieldReminderCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReminderCount(); ok {
+		_spec.AddField(validatordeliverysla.FieldReminderCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(validatordeliverysla.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MissedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldMissedAt, field.TypeTime, value)
+	}
+	if _u.mutation.MissedAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldMissedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EscalatedAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldEscalatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EscalatedAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldEscalatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(validatordeliverysla.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(validatordeliverysla.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Recommendation(); ok {
+		_spec.SetField(validatordeliverysla.FieldRecommendation, field.TypeString, value)
+	}
+	if _u.mutation.RecommendationCleared() {
+		_spec.ClearField(validatordeliverysla.FieldRecommendation, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisputeDraft(); ok {
+		_spec.SetField(validatordeliverysla.FieldDisputeDraft, field.TypeJSON, value)
+	}
+	if _u.mutation.DisputeDraftCleared() {
+		_spec.ClearField(validatordeliverysla.FieldDisputeDraft, field.TypeJSON)
+	}
+	_node = &ValidatorDeliverySLA{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{validatordeliverysla.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
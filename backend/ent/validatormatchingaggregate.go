@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/validatormatchingaggregate"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ValidatorMatchingAggregate is the model entity for the ValidatorMatchingAggregate schema.
+type ValidatorMatchingAggregate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// DomainSlugs holds the value of the "domain_slugs" field.
+	DomainSlugs []string `json:"domain_slugs,omitempty"`
+	// EvidenceSlugs holds the value of the "evidence_slugs" field.
+	EvidenceSlugs []string `json:"evidence_slugs,omitempty"`
+	// HistoryCaseCount holds the value of the "history_case_count" field.
+	HistoryCaseCount int `json:"history_case_count,omitempty"`
+	// DisputedCaseCount holds the value of the "disputed_case_count" field.
+	DisputedCaseCount int `json:"disputed_case_count,omitempty"`
+	// ApprovedConsultationCount holds the value of the "approved_consultation_count" field.
+	ApprovedConsultationCount int `json:"approved_consultation_count,omitempty"`
+	// DeliverySLACount holds the value of the "delivery_sla_count" field.
+	DeliverySLACount int `json:"delivery_sla_count,omitempty"`
+	// ResponsivenessScore holds the value of the "responsiveness_score" field.
+	ResponsivenessScore int `json:"responsiveness_score,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt   time.Time `json:"computed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ValidatorMatchingAggregate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case validatormatchingaggregate.FieldDomainSlugs, validatormatchingaggregate.FieldEvidenceSlugs:
+			values[i] = new([]byte)
+		case validatormatchingaggregate.FieldID, validatormatchingaggregate.FieldValidatorUserID, validatormatchingaggregate.FieldHistoryCaseCount, validatormatchingaggregate.FieldDisputedCaseCount, validatormatchingaggregate.FieldApprovedConsultationCount, validatormatchingaggregate.FieldDeliverySLACount, validatormatchingaggregate.FieldResponsivenessScore:
+			values[i] = new(sql.NullInt64)
+		case validatormatchingaggregate.FieldCreatedAt, validatormatchingaggregate.FieldUpdatedAt, validatormatchingaggregate.FieldDeletedAt, validatormatchingaggregate.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ValidatorMatchingAggregate fields.
+func (_m *ValidatorMatchingAggregate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case validatormatchingaggregate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case validatormatchingaggregate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case validatormatchingaggregate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case validatormatchingaggregate.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case validatormatchingaggregate.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case validatormatchingaggregate.FieldDomainSlugs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_slugs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DomainSlugs); err != nil {
+					return fmt.Errorf("unmarshal field domain_slugs: %w", err)
+				}
+			}
+		case validatormatchingaggregate.FieldEvidenceSlugs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field evidence_slugs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EvidenceSlugs); err != nil {
+					return fmt.Errorf("unmarshal field evidence_slugs: %w", err)
+				}
+			}
+		case validatormatchingaggregate.FieldHistoryCaseCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field history_case_count", values[i])
+			} else if value.Valid {
+				_m.HistoryCaseCount = int(value.Int64)
+			}
+		case validatormatchingaggregate.FieldDisputedCaseCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field disputed_case_count", values[i])
+			} else if value.Valid {
+				_m.DisputedCaseCount = int(value.Int64)
+			}
+		case validatormatchingaggregate.FieldApprovedConsultationCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_consultation_count", values[i])
+			} else if value.Valid {
+				_m.ApprovedConsultationCount = int(value.Int64)
+			}
+		case validatormatchingaggregate.FieldDeliverySLACount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_sla_count", values[i])
+			} else if value.Valid {
+				_m.DeliverySLACount = int(value.Int64)
+			}
+		case validatormatchingaggregate.FieldResponsivenessScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field responsiveness_score", values[i])
+			} else if value.Valid {
+				_m.ResponsivenessScore = int(value.Int64)
+			}
+		case validatormatchingaggregate.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				_m.ComputedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ValidatorMatchingAggregate.
+// This includes values selected through modifiers, order, etc.
+func (_m *ValidatorMatchingAggregate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ValidatorMatchingAggregate.
+// Note that you need to call ValidatorMatchingAggregate.Unwrap() before calling this method if this ValidatorMatchingAggregate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ValidatorMatchingAggregate) Update() *ValidatorMatchingAggregateUpdateOne {
+	return NewValidatorMatchingAggregateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ValidatorMatchingAggregate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ValidatorMatchingAggregate) Unwrap() *ValidatorMatchingAggregate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ValidatorMatchingAggregate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ValidatorMatchingAggregate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ValidatorMatchingAggregate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("domain_slugs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DomainSlugs))
+	builder.WriteString(", ")
+	builder.WriteString("evidence_slugs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EvidenceSlugs))
+	builder.WriteString(", ")
+	builder.WriteString("history_case_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HistoryCaseCount))
+	builder.WriteString(", ")
+	builder.WriteString("disputed_case_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisputedCaseCount))
+	builder.WriteString(", ")
+	builder.WriteString("approved_consultation_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovedConsultationCount))
+	builder.WriteString(", ")
+	builder.WriteString("delivery_sla_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliverySLACount))
+	builder.WriteString(", ")
+	builder.WriteString("responsiveness_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResponsivenessScore))
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(_m.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ValidatorMatchingAggregates is a parsable slice of ValidatorMatchingAggregate.
+type ValidatorMatchingAggregates []*ValidatorMatchingAggregate
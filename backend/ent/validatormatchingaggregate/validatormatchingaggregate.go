@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package validatormatchingaggregate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the validatormatchingaggregate type in the database.
+	Label = "validator_matching_aggregate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldValidatorUserID holds the string denoting the validator_user_id field in the database.
+	FieldValidatorUserID = "validator_user_id"
+	// FieldDomainSlugs holds the string denoting the domain_slugs field in the database.
+	FieldDomainSlugs = "domain_slugs"
+	// FieldEvidenceSlugs holds the string denoting the evidence_slugs field in the database.
+	FieldEvidenceSlugs = "evidence_slugs"
+	// FieldHistoryCaseCount holds the string denoting the history_case_count field in the database.
+	FieldHistoryCaseCount = "history_case_count"
+	// FieldDisputedCaseCount holds the string denoting the disputed_case_count field in the database.
+	FieldDisputedCaseCount = "disputed_case_count"
+	// FieldApprovedConsultationCount holds the string denoting the approved_consultation_count field in the database.
+	FieldApprovedConsultationCount = "approved_consultation_count"
+	// FieldDeliverySLACount holds the string denoting the delivery_sla_count field in the database.
+	FieldDeliverySLACount = "delivery_sla_count"
+	// FieldResponsivenessScore holds the string denoting the responsiveness_score field in the database.
+	FieldResponsivenessScore = "responsiveness_score"
+	// FieldComputedAt holds the string denoting the computed_at field in the database.
+	FieldComputedAt = "computed_at"
+	// Table holds the table name of the validatormatchingaggregate in the database.
+	Table = "validator_matching_aggregates"
+)
+
+// Columns holds all SQL columns for validatormatchingaggregate fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldValidatorUserID,
+	FieldDomainSlugs,
+	FieldEvidenceSlugs,
+	FieldHistoryCaseCount,
+	FieldDisputedCaseCount,
+	FieldApprovedConsultationCount,
+	FieldDeliverySLACount,
+	FieldResponsivenessScore,
+	FieldComputedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	ValidatorUserIDValidator func(int) error
+	// DefaultHistoryCaseCount holds the default value on creation for the "history_case_count" field.
+	DefaultHistoryCaseCount int
+	// HistoryCaseCountValidator is a validator for the "history_case_count" field. It is called by the builders before save.
+	HistoryCaseCountValidator func(int) error
+	// DefaultDisputedCaseCount holds the default value on creation for the "disputed_case_count" field.
+	DefaultDisputedCaseCount int
+	// DisputedCaseCountValidator is a validator for the "disputed_case_count" field. It is called by the builders before save.
+	DisputedCaseCountValidator func(int) error
+	// DefaultApprovedConsultationCount holds the default value on creation for the "approved_consultation_count" field.
+	DefaultApprovedConsultationCount int
+	// ApprovedConsultationCountValidator is a validator for the "approved_consultation_count" field. It is called by the builders before save.
+	ApprovedConsultationCountValidator func(int) error
+	// DefaultDeliverySLACount holds the default value on creation for the "delivery_sla_count" field.
+	DefaultDeliverySLACount int
+	// DeliverySLACountValidator is a validator for the "delivery_sla_count" field. It is called by the builders before save.
+	DeliverySLACountValidator func(int) error
+	// DefaultResponsivenessScore holds the default value on creation for the "responsiveness_score" field.
+	DefaultResponsivenessScore int
+	// ResponsivenessScoreValidator is a validator for the "responsiveness_score" field. It is called by the builders before save.
+	ResponsivenessScoreValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the ValidatorMatchingAggregate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByValidatorUserID orders the results by the validator_user_id field.
+func ByValidatorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatorUserID, opts...).ToFunc()
+}
+
+// ByHistoryCaseCount orders the results by the history_case_count field.
+func ByHistoryCaseCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHistoryCaseCount, opts...).ToFunc()
+}
+
+// ByDisputedCaseCount orders the results by the disputed_case_count field.
+func ByDisputedCaseCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisputedCaseCount, opts...).ToFunc()
+}
+
+// ByApprovedConsultationCount orders the results by the approved_consultation_count field.
+func ByApprovedConsultationCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovedConsultationCount, opts...).ToFunc()
+}
+
+// ByDeliverySLACount orders the results by the delivery_sla_count field.
+func ByDeliverySLACount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliverySLACount, opts...).ToFunc()
+}
+
+// ByResponsivenessScore orders the results by the responsiveness_score field.
+func ByResponsivenessScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponsivenessScore, opts...).ToFunc()
+}
+
+// ByComputedAt orders the results by the computed_at field.
+func ByComputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputedAt, opts...).ToFunc()
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package validatormatchingaggregate
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// HistoryCaseCount applies equality check predicate on the "history_case_count" field. It's identical to HistoryCaseCountEQ.
+func HistoryCaseCount(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldHistoryCaseCount, v))
+}
+
+// DisputedCaseCount applies equality check predicate on the "disputed_case_count" field. It's identical to DisputedCaseCountEQ.
+func DisputedCaseCount(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldDisputedCaseCount, v))
+}
+
+// ApprovedConsultationCount applies equality check predicate on the "approved_consultation_count" field. It's identical to ApprovedConsultationCountEQ.
+func ApprovedConsultationCount(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldApprovedConsultationCount, v))
+}
+
+// DeliverySLACount applies equality check predicate on the "delivery_sla_count" field. It's identical to DeliverySLACountEQ.
+func DeliverySLACount(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldDeliverySLACount, v))
+}
+
+// ResponsivenessScore applies equality check predicate on the "responsiveness_score" field. It's identical to ResponsivenessScoreEQ.
+func ResponsivenessScore(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldResponsivenessScore, v))
+}
+
+// ComputedAt applies equality check predicate on the "computed_at" field. It's identical to ComputedAtEQ.
+func ComputedAt(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// DomainSlugsIsNil applies the IsNil predicate on the "domain_slugs" field.
+func DomainSlugsIsNil() predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIsNull(FieldDomainSlugs))
+}
+
+// DomainSlugsNotNil applies the NotNil predicate on the "domain_slugs" field.
+func DomainSlugsNotNil() predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotNull(FieldDomainSlugs))
+}
+
+// EvidenceSlugsIsNil applies the IsNil predicate on the "evidence_slugs" field.
+func EvidenceSlugsIsNil() predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIsNull(FieldEvidenceSlugs))
+}
+
+// EvidenceSlugsNotNil applies the NotNil predicate on the "evidence_slugs" field.
+func EvidenceSlugsNotNil() predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotNull(FieldEvidenceSlugs))
+}
+
+// HistoryCaseCountEQ applies the EQ predicate on the "history_case_count" field.
+func HistoryCaseCountEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldHistoryCaseCount, v))
+}
+
+// HistoryCaseCountNEQ applies the NEQ predicate on the "history_case_count" field.
+func HistoryCaseCountNEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldHistoryCaseCount, v))
+}
+
+// HistoryCaseCountIn applies the In predicate on the "history_case_count" field.
+func HistoryCaseCountIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldHistoryCaseCount, vs...))
+}
+
+// HistoryCaseCountNotIn applies the NotIn predicate on the "history_case_count" field.
+func HistoryCaseCountNotIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldHistoryCaseCount, vs...))
+}
+
+// HistoryCaseCountGT applies the GT predicate on the "history_case_count" field.
+func HistoryCaseCountGT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldHistoryCaseCount, v))
+}
+
+// HistoryCaseCountGTE applies the GTE predicate on the "history_case_count" field.
+func HistoryCaseCountGTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldHistoryCaseCount, v))
+}
+
+// HistoryCaseCountLT applies the LT predicate on the "history_case_count" field.
+func HistoryCaseCountLT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldHistoryCaseCount, v))
+}
+
+// HistoryCaseCountLTE applies the LTE predicate on the "history_case_count" field.
+func HistoryCaseCountLTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldHistoryCaseCount, v))
+}
+
+// DisputedCaseCountEQ applies the EQ predicate on the "disputed_case_count" field.
+func DisputedCaseCountEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldDisputedCaseCount, v))
+}
+
+// DisputedCaseCountNEQ applies the NEQ predicate on the "disputed_case_count" field.
+func DisputedCaseCountNEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldDisputedCaseCount, v))
+}
+
+// DisputedCaseCountIn applies the In predicate on the "disputed_case_count" field.
+func DisputedCaseCountIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldDisputedCaseCount, vs...))
+}
+
+// DisputedCaseCountNotIn applies the NotIn predicate on the "disputed_case_count" field.
+func DisputedCaseCountNotIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldDisputedCaseCount, vs...))
+}
+
+// DisputedCaseCountGT applies the GT predicate on the "disputed_case_count" field.
+func DisputedCaseCountGT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldDisputedCaseCount, v))
+}
+
+// DisputedCaseCountGTE applies the GTE predicate on the "disputed_case_count" field.
+func DisputedCaseCountGTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldDisputedCaseCount, v))
+}
+
+// DisputedCaseCountLT applies the LT predicate on the "disputed_case_count" field.
+func DisputedCaseCountLT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldDisputedCaseCount, v))
+}
+
+// DisputedCaseCountLTE applies the LTE predicate on the "disputed_case_count" field.
+func DisputedCaseCountLTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldDisputedCaseCount, v))
+}
+
+// ApprovedConsultationCountEQ applies the EQ predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldApprovedConsultationCount, v))
+}
+
+// ApprovedConsultationCountNEQ applies the NEQ predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountNEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldApprovedConsultationCount, v))
+}
+
+// ApprovedConsultationCountIn applies the In predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldApprovedConsultationCount, vs...))
+}
+
+// ApprovedConsultationCountNotIn applies the NotIn predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountNotIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldApprovedConsultationCount, vs...))
+}
+
+// ApprovedConsultationCountGT applies the GT predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountGT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldApprovedConsultationCount, v))
+}
+
+// ApprovedConsultationCountGTE applies the GTE predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountGTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldApprovedConsultationCount, v))
+}
+
+// ApprovedConsultationCountLT applies the LT predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountLT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldApprovedConsultationCount, v))
+}
+
+// ApprovedConsultationCountLTE applies the LTE predicate on the "approved_consultation_count" field.
+func ApprovedConsultationCountLTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldApprovedConsultationCount, v))
+}
+
+// DeliverySLACountEQ applies the EQ predicate on the "delivery_sla_count" field.
+func DeliverySLACountEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldDeliverySLACount, v))
+}
+
+// DeliverySLACountNEQ applies the NEQ predicate on the "delivery_sla_count" field.
+func DeliverySLACountNEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldDeliverySLACount, v))
+}
+
+// DeliverySLACountIn applies the In predicate on the "delivery_sla_count" field.
+func DeliverySLACountIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldDeliverySLACount, vs...))
+}
+
+// DeliverySLACountNotIn applies the NotIn predicate on the "delivery_sla_count" field.
+func DeliverySLACountNotIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldDeliverySLACount, vs...))
+}
+
+// DeliverySLACountGT applies the GT predicate on the "delivery_sla_count" field.
+func DeliverySLACountGT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldDeliverySLACount, v))
+}
+
+// DeliverySLACountGTE applies the GTE predicate on the "delivery_sla_count" field.
+func DeliverySLACountGTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldDeliverySLACount, v))
+}
+
+// DeliverySLACountLT applies the LT predicate on the "delivery_sla_count" field.
+func DeliverySLACountLT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldDeliverySLACount, v))
+}
+
+// DeliverySLACountLTE applies the LTE predicate on the "delivery_sla_count" field.
+func DeliverySLACountLTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldDeliverySLACount, v))
+}
+
+// ResponsivenessScoreEQ applies the EQ predicate on the "responsiveness_score" field.
+func ResponsivenessScoreEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldResponsivenessScore, v))
+}
+
+// ResponsivenessScoreNEQ applies the NEQ predicate on the "responsiveness_score" field.
+func ResponsivenessScoreNEQ(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldResponsivenessScore, v))
+}
+
+// ResponsivenessScoreIn applies the In predicate on the "responsiveness_score" field.
+func ResponsivenessScoreIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldResponsivenessScore, vs...))
+}
+
+// ResponsivenessScoreNotIn applies the NotIn predicate on the "responsiveness_score" field.
+func ResponsivenessScoreNotIn(vs ...int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldResponsivenessScore, vs...))
+}
+
+// ResponsivenessScoreGT applies the GT predicate on the "responsiveness_score" field.
+func ResponsivenessScoreGT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldResponsivenessScore, v))
+}
+
+// ResponsivenessScoreGTE applies the GTE predicate on the "responsiveness_score" field.
+func ResponsivenessScoreGTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldResponsivenessScore, v))
+}
+
+// ResponsivenessScoreLT applies the LT predicate on the "responsiveness_score" field.
+func ResponsivenessScoreLT(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldResponsivenessScore, v))
+}
+
+// ResponsivenessScoreLTE applies the LTE predicate on the "responsiveness_score" field.
+func ResponsivenessScoreLTE(v int) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldResponsivenessScore, v))
+}
+
+// ComputedAtEQ applies the EQ predicate on the "computed_at" field.
+func ComputedAtEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ComputedAtNEQ applies the NEQ predicate on the "computed_at" field.
+func ComputedAtNEQ(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNEQ(FieldComputedAt, v))
+}
+
+// ComputedAtIn applies the In predicate on the "computed_at" field.
+func ComputedAtIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtNotIn applies the NotIn predicate on the "computed_at" field.
+func ComputedAtNotIn(vs ...time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldNotIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtGT applies the GT predicate on the "computed_at" field.
+func ComputedAtGT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGT(FieldComputedAt, v))
+}
+
+// ComputedAtGTE applies the GTE predicate on the "computed_at" field.
+func ComputedAtGTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldGTE(FieldComputedAt, v))
+}
+
+// ComputedAtLT applies the LT predicate on the "computed_at" field.
+func ComputedAtLT(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLT(FieldComputedAt, v))
+}
+
+// ComputedAtLTE applies the LTE predicate on the "computed_at" field.
+func ComputedAtLTE(v time.Time) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.FieldLTE(FieldComputedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ValidatorMatchingAggregate) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ValidatorMatchingAggregate) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ValidatorMatchingAggregate) predicate.ValidatorMatchingAggregate {
+	return predicate.ValidatorMatchingAggregate(sql.NotPredicates(p))
+}
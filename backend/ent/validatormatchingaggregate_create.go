@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/validatormatchingaggregate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorMatchingAggregateCreate is the builder for creating a ValidatorMatchingAggregate entity.
+type ValidatorMatchingAggregateCreate struct {
+	config
+	mutation *ValidatorMatchingAggregateMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ValidatorMatchingAggregateCreate) SetCreatedAt(v time.Time) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableCreatedAt(v *time.Time) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ValidatorMatchingAggregateCreate) SetUpdatedAt(v time.Time) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableUpdatedAt(v *time.Time) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ValidatorMatchingAggregateCreate) SetDeletedAt(v time.Time) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableDeletedAt(v *time.Time) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *ValidatorMatchingAggregateCreate) SetValidatorUserID(v int) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetDomainSlugs sets the "domain_slugs" field.
+func (_c *ValidatorMatchingAggregateCreate) SetDomainSlugs(v []string) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetDomainSlugs(v)
+	return _c
+}
+
+// SetEvidenceSlugs sets the "evidence_slugs" field.
+func (_c *ValidatorMatchingAggregateCreate) SetEvidenceSlugs(v []string) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetEvidenceSlugs(v)
+	return _c
+}
+
+// SetHistoryCaseCount sets the "history_case_count" field.
+func (_c *ValidatorMatchingAggregateCreate) SetHistoryCaseCount(v int) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetHistoryCaseCount(v)
+	return _c
+}
+
+// SetNillableHistoryCaseCount sets the "history_case_count" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableHistoryCaseCount(v *int) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetHistoryCaseCount(*v)
+	}
+	return _c
+}
+
+// SetDisputedCaseCount sets the "disputed_case_count" field.
+func (_c *ValidatorMatchingAggregateCreate) SetDisputedCaseCount(v int) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetDisputedCaseCount(v)
+	return _c
+}
+
+// SetNillableDisputedCaseCount sets the "disputed_case_count" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableDisputedCaseCount(v *int) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetDisputedCaseCount(*v)
+	}
+	return _c
+}
+
+// SetApprovedConsultationCount sets the "approved_consultation_count" field.
+func (_c *ValidatorMatchingAggregateCreate) SetApprovedConsultationCount(v int) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetApprovedConsultationCount(v)
+	return _c
+}
+
+// SetNillableApprovedConsultationCount sets the "approved_consultation_count" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableApprovedConsultationCount(v *int) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetApprovedConsultationCount(*v)
+	}
+	return _c
+}
+
+// SetDeliverySLACount sets the "delivery_sla_count" field.
+func (_c *ValidatorMatchingAggregateCreate) SetDeliverySLACount(v int) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetDeliverySLACount(v)
+	return _c
+}
+
+// SetNillableDeliverySLACount sets the "delivery_sla_count" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableDeliverySLACount(v *int) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetDeliverySLACount(*v)
+	}
+	return _c
+}
+
+// SetResponsivenessScore sets the "responsiveness_score" field.
+func (_c *ValidatorMatchingAggregateCreate) SetResponsivenessScore(v int) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetResponsivenessScore(v)
+	return _c
+}
+
+// SetNillableResponsivenessScore sets the "responsiveness_score" field if the given value is not nil.
+func (_c *ValidatorMatchingAggregateCreate) SetNillableResponsivenessScore(v *int) *ValidatorMatchingAggregateCreate {
+	if v != nil {
+		_c.SetResponsivenessScore(*v)
+	}
+	return _c
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_c *ValidatorMatchingAggregateCreate) SetComputedAt(v time.Time) *ValidatorMatchingAggregateCreate {
+	_c.mutation.SetComputedAt(v)
+	return _c
+}
+
+// Mutation returns the ValidatorMatchingAggregateMutation object of the builder.
+func (_c *ValidatorMatchingAggregateCreate) Mutation() *ValidatorMatchingAggregateMutation {
+	return _c.mutation
+}
+
+// Save creates the ValidatorMatchingAggregate in the database.
+func (_c *ValidatorMatchingAggregateCreate) Save(ctx context.Context) (*ValidatorMatchingAggregate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ValidatorMatchingAggregateCreate) SaveX(ctx context.Context) *ValidatorMatchingAggregate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValidatorMatchingAggregateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValidatorMatchingAggregateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ValidatorMatchingAggregateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := validatormatchingaggregate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := validatormatchingaggregate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.HistoryCaseCount(); !ok {
+		v := validatormatchingaggregate.DefaultHistoryCaseCount
+		_c.mutation.SetHistoryCaseCount(v)
+	}
+	if _, ok := _c.mutation.DisputedCaseCount(); !ok {
+		v := validatormatchingaggregate.DefaultDisputedCaseCount
+		_c.mutation.SetDisputedCaseCount(v)
+	}
+	if _, ok := _c.mutation.ApprovedConsultationCount(); !ok {
+		v := validatormatchingaggregate.DefaultApprovedConsultationCount
+		_c.mutation.SetApprovedConsultationCount(v)
+	}
+	if _, ok := _c.mutation.DeliverySLACount(); !ok {
+		v := validatormatchingaggregate.DefaultDeliverySLACount
+		_c.mutation.SetDeliverySLACount(v)
+	}
+	if _, ok := _c.mutation.ResponsivenessScore(); !ok {
+		v := validatormatchingaggregate.DefaultResponsivenessScore
+		_c.mutation.SetResponsivenessScore(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ValidatorMatchingAggregateCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := validatormatchingaggregate.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HistoryCaseCount(); !ok {
+		return &ValidationError{Name: "history_case_count", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.history_case_count"`)}
+	}
+	if v, ok := _c.mutation.HistoryCaseCount(); ok {
+		if err := validatormatchingaggregate.HistoryCaseCountValidator(v); err != nil {
+			return &ValidationError{Name: "history_case_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.history_case_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DisputedCaseCount(); !ok {
+		return &ValidationError{Name: "disputed_case_count", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.disputed_case_count"`)}
+	}
+	if v, ok := _c.mutation.DisputedCaseCount(); ok {
+		if err := validatormatchingaggregate.DisputedCaseCountValidator(v); err != nil {
+			return &ValidationError{Name: "disputed_case_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.disputed_case_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ApprovedConsultationCount(); !ok {
+		return &ValidationError{Name: "approved_consultation_count", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.approved_consultation_count"`)}
+	}
+	if v, ok := _c.mutation.ApprovedConsultationCount(); ok {
+		if err := validatormatchingaggregate.ApprovedConsultationCountValidator(v); err != nil {
+			return &ValidationError{Name: "approved_consultation_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.approved_consultation_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeliverySLACount(); !ok {
+		return &ValidationError{Name: "delivery_sla_count", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.delivery_sla_count"`)}
+	}
+	if v, ok := _c.mutation.DeliverySLACount(); ok {
+		if err := validatormatchingaggregate.DeliverySLACountValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_sla_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.delivery_sla_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResponsivenessScore(); !ok {
+		return &ValidationError{Name: "responsiveness_score", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.responsiveness_score"`)}
+	}
+	if v, ok := _c.mutation.ResponsivenessScore(); ok {
+		if err := validatormatchingaggregate.ResponsivenessScoreValidator(v); err != nil {
+			return &ValidationError{Name: "responsiveness_score", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.responsiveness_score": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "ValidatorMatchingAggregate.computed_at"`)}
+	}
+	return nil
+}
+
+func (_c *ValidatorMatchingAggregateCreate) sqlSave(ctx context.Context) (*ValidatorMatchingAggregate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ValidatorMatchingAggregateCreate) createSpec() (*ValidatorMatchingAggregate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ValidatorMatchingAggregate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(validatormatchingaggregate.Table, sqlgraph.NewFieldSpec(validatormatchingaggregate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.DomainSlugs(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDomainSlugs, field.TypeJSON, value)
+		_node.DomainSlugs = value
+	}
+	if value, ok := _c.mutation.EvidenceSlugs(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldEvidenceSlugs, field.TypeJSON, value)
+		_node.EvidenceSlugs = value
+	}
+	if value, ok := _c.mutation.HistoryCaseCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldHistoryCaseCount, field.TypeInt, value)
+		_node.HistoryCaseCount = value
+	}
+	if value, ok := _c.mutation.DisputedCaseCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDisputedCaseCount, field.TypeInt, value)
+		_node.DisputedCaseCount = value
+	}
+	if value, ok := _c.mutation.ApprovedConsultationCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldApprovedConsultationCount, field.TypeInt, value)
+		_node.ApprovedConsultationCount = value
+	}
+	if value, ok := _c.mutation.DeliverySLACount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDeliverySLACount, field.TypeInt, value)
+		_node.DeliverySLACount = value
+	}
+	if value, ok := _c.mutation.ResponsivenessScore(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldResponsivenessScore, field.TypeInt, value)
+		_node.ResponsivenessScore = value
+	}
+	if value, ok := _c.mutation.ComputedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	return _node, _spec
+}
+
+// ValidatorMatchingAggregateCreateBulk is the builder for creating many ValidatorMatchingAggregate entities in bulk.
+type ValidatorMatchingAggregateCreateBulk struct {
+	config
+	err      error
+	builders []*ValidatorMatchingAggregateCreate
+}
+
+// Save creates the ValidatorMatchingAggregate entities in the database.
+func (_c *ValidatorMatchingAggregateCreateBulk) Save(ctx context.Context) ([]*ValidatorMatchingAggregate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ValidatorMatchingAggregate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ValidatorMatchingAggregateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ValidatorMatchingAggregateCreateBulk) SaveX(ctx context.Context) []*ValidatorMatchingAggregate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValidatorMatchingAggregateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValidatorMatchingAggregateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
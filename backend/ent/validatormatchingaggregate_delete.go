@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/validatormatchingaggregate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorMatchingAggregateDelete is the builder for deleting a ValidatorMatchingAggregate entity.
+type ValidatorMatchingAggregateDelete struct {
+	config
+	hooks    []Hook
+	mutation *ValidatorMatchingAggregateMutation
+}
+
+// Where appends a list predicates to the ValidatorMatchingAggregateDelete builder.
+func (_d *ValidatorMatchingAggregateDelete) Where(ps ...predicate.ValidatorMatchingAggregate) *ValidatorMatchingAggregateDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ValidatorMatchingAggregateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ValidatorMatchingAggregateDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ValidatorMatchingAggregateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(validatormatchingaggregate.Table, sqlgraph.NewFieldSpec(validatormatchingaggregate.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ValidatorMatchingAggregateDeleteOne is the builder for deleting a single ValidatorMatchingAggregate entity.
+type ValidatorMatchingAggregateDeleteOne struct {
+	_d *ValidatorMatchingAggregateDelete
+}
+
+// Where appends a list predicates to the ValidatorMatchingAggregateDelete builder.
+func (_d *ValidatorMatchingAggregateDeleteOne) Where(ps ...predicate.ValidatorMatchingAggregate) *ValidatorMatchingAggregateDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ValidatorMatchingAggregateDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{validatormatchingaggregate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ValidatorMatchingAggregateDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,815 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/validatormatchingaggregate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorMatchingAggregateUpdate is the builder for updating ValidatorMatchingAggregate entities.
+type ValidatorMatchingAggregateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ValidatorMatchingAggregateMutation
+}
+
+// Where appends a list predicates to the ValidatorMatchingAggregateUpdate builder.
+func (_u *ValidatorMatchingAggregateUpdate) Where(ps ...predicate.ValidatorMatchingAggregate) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetUpdatedAt(v time.Time) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetDeletedAt(v time.Time) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableDeletedAt(v *time.Time) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ValidatorMatchingAggregateUpdate) ClearDeletedAt() *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetValidatorUserID(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableValidatorUserID(v *int) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ValidatorMatchingAggregateUpdate) AddValidatorUserID(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetDomainSlugs sets the "domain_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetDomainSlugs(v []string) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.SetDomainSlugs(v)
+	return _u
+}
+
+// AppendDomainSlugs appends value to the "domain_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdate) AppendDomainSlugs(v []string) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AppendDomainSlugs(v)
+	return _u
+}
+
+// ClearDomainSlugs clears the value of the "domain_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdate) ClearDomainSlugs() *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ClearDomainSlugs()
+	return _u
+}
+
+// SetEvidenceSlugs sets the "evidence_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetEvidenceSlugs(v []string) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.SetEvidenceSlugs(v)
+	return _u
+}
+
+// AppendEvidenceSlugs appends value to the "evidence_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdate) AppendEvidenceSlugs(v []string) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AppendEvidenceSlugs(v)
+	return _u
+}
+
+// ClearEvidenceSlugs clears the value of the "evidence_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdate) ClearEvidenceSlugs() *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ClearEvidenceSlugs()
+	return _u
+}
+
+// SetHistoryCaseCount sets the "history_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetHistoryCaseCount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ResetHistoryCaseCount()
+	_u.mutation.SetHistoryCaseCount(v)
+	return _u
+}
+
+// SetNillableHistoryCaseCount sets the "history_case_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableHistoryCaseCount(v *int) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetHistoryCaseCount(*v)
+	}
+	return _u
+}
+
+// AddHistoryCaseCount adds value to the "history_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) AddHistoryCaseCount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AddHistoryCaseCount(v)
+	return _u
+}
+
+// SetDisputedCaseCount sets the "disputed_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetDisputedCaseCount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ResetDisputedCaseCount()
+	_u.mutation.SetDisputedCaseCount(v)
+	return _u
+}
+
+// SetNillableDisputedCaseCount sets the "disputed_case_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableDisputedCaseCount(v *int) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetDisputedCaseCount(*v)
+	}
+	return _u
+}
+
+// AddDisputedCaseCount adds value to the "disputed_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) AddDisputedCaseCount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AddDisputedCaseCount(v)
+	return _u
+}
+
+// SetApprovedConsultationCount sets the "approved_consultation_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetApprovedConsultationCount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ResetApprovedConsultationCount()
+	_u.mutation.SetApprovedConsultationCount(v)
+	return _u
+}
+
+// SetNillableApprovedConsultationCount sets the "approved_consultation_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableApprovedConsultationCount(v *int) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetApprovedConsultationCount(*v)
+	}
+	return _u
+}
+
+// AddApprovedConsultationCount adds value to the "approved_consultation_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) AddApprovedConsultationCount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AddApprovedConsultationCount(v)
+	return _u
+}
+
+// SetDeliverySLACount sets the "delivery_sla_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetDeliverySLACount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ResetDeliverySLACount()
+	_u.mutation.SetDeliverySLACount(v)
+	return _u
+}
+
+// SetNillableDeliverySLACount sets the "delivery_sla_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableDeliverySLACount(v *int) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetDeliverySLACount(*v)
+	}
+	return _u
+}
+
+// AddDeliverySLACount adds value to the "delivery_sla_count" field.
+func (_u *ValidatorMatchingAggregateUpdate) AddDeliverySLACount(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AddDeliverySLACount(v)
+	return _u
+}
+
+// SetResponsivenessScore sets the "responsiveness_score" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetResponsivenessScore(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.ResetResponsivenessScore()
+	_u.mutation.SetResponsivenessScore(v)
+	return _u
+}
+
+// SetNillableResponsivenessScore sets the "responsiveness_score" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableResponsivenessScore(v *int) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetResponsivenessScore(*v)
+	}
+	return _u
+}
+
+// AddResponsivenessScore adds value to the "responsiveness_score" field.
+func (_u *ValidatorMatchingAggregateUpdate) AddResponsivenessScore(v int) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.AddResponsivenessScore(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *ValidatorMatchingAggregateUpdate) SetComputedAt(v time.Time) *ValidatorMatchingAggregateUpdate {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdate) SetNillableComputedAt(v *time.Time) *ValidatorMatchingAggregateUpdate {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ValidatorMatchingAggregateMutation object of the builder.
+func (_u *ValidatorMatchingAggregateUpdate) Mutation() *ValidatorMatchingAggregateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ValidatorMatchingAggregateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValidatorMatchingAggregateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ValidatorMatchingAggregateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValidatorMatchingAggregateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValidatorMatchingAggregateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := validatormatchingaggregate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValidatorMatchingAggregateUpdate) check() error {
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := validatormatchingaggregate.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HistoryCaseCount(); ok {
+		if err := validatormatchingaggregate.HistoryCaseCountValidator(v); err != nil {
+			return &ValidationError{Name: "history_case_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.history_case_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisputedCaseCount(); ok {
+		if err := validatormatchingaggregate.DisputedCaseCountValidator(v); err != nil {
+			return &ValidationError{Name: "disputed_case_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.disputed_case_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApprovedConsultationCount(); ok {
+		if err := validatormatchingaggregate.ApprovedConsultationCountValidator(v); err != nil {
+			return &ValidationError{Name: "approved_consultation_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.approved_consultation_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliverySLACount(); ok {
+		if err := validatormatchingaggregate.DeliverySLACountValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_sla_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.delivery_sla_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResponsivenessScore(); ok {
+		if err := validatormatchingaggregate.ResponsivenessScoreValidator(v); err != nil {
+			return &ValidationError{Name: "responsiveness_score", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.responsiveness_score": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ValidatorMatchingAggregateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(validatormatchingaggregate.Table, validatormatchingaggregate.Columns, sqlgraph.NewFieldSpec(validatormatchingaggregate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(validatormatchingaggregate.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DomainSlugs(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDomainSlugs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDomainSlugs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, validatormatchingaggregate.FieldDomainSlugs, value)
+		})
+	}
+	if _u.mutation.DomainSlugsCleared() {
+		_spec.ClearField(validatormatchingaggregate.FieldDomainSlugs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EvidenceSlugs(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldEvidenceSlugs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvidenceSlugs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, validatormatchingaggregate.FieldEvidenceSlugs, value)
+		})
+	}
+	if _u.mutation.EvidenceSlugsCleared() {
+		_spec.ClearField(validatormatchingaggregate.FieldEvidenceSlugs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HistoryCaseCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldHistoryCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHistoryCaseCount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldHistoryCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DisputedCaseCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDisputedCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisputedCaseCount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldDisputedCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ApprovedConsultationCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldApprovedConsultationCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedConsultationCount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldApprovedConsultationCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeliverySLACount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDeliverySLACount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliverySLACount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldDeliverySLACount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponsivenessScore(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldResponsivenessScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponsivenessScore(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldResponsivenessScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldComputedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{validatormatchingaggregate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ValidatorMatchingAggregateUpdateOne is the builder for updating a single ValidatorMatchingAggregate entity.
+type ValidatorMatchingAggregateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ValidatorMatchingAggregateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetUpdatedAt(v time.Time) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetDeletedAt(v time.Time) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableDeletedAt(v *time.Time) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) ClearDeletedAt() *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetValidatorUserID(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableValidatorUserID(v *int) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AddValidatorUserID(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetDomainSlugs sets the "domain_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetDomainSlugs(v []string) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.SetDomainSlugs(v)
+	return _u
+}
+
+// AppendDomainSlugs appends value to the "domain_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AppendDomainSlugs(v []string) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AppendDomainSlugs(v)
+	return _u
+}
+
+// ClearDomainSlugs clears the value of the "domain_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) ClearDomainSlugs() *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ClearDomainSlugs()
+	return _u
+}
+
+// SetEvidenceSlugs sets the "evidence_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetEvidenceSlugs(v []string) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.SetEvidenceSlugs(v)
+	return _u
+}
+
+// AppendEvidenceSlugs appends value to the "evidence_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AppendEvidenceSlugs(v []string) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AppendEvidenceSlugs(v)
+	return _u
+}
+
+// ClearEvidenceSlugs clears the value of the "evidence_slugs" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) ClearEvidenceSlugs() *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ClearEvidenceSlugs()
+	return _u
+}
+
+// SetHistoryCaseCount sets the "history_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetHistoryCaseCount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ResetHistoryCaseCount()
+	_u.mutation.SetHistoryCaseCount(v)
+	return _u
+}
+
+// SetNillableHistoryCaseCount sets the "history_case_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableHistoryCaseCount(v *int) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetHistoryCaseCount(*v)
+	}
+	return _u
+}
+
+// AddHistoryCaseCount adds value to the "history_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AddHistoryCaseCount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AddHistoryCaseCount(v)
+	return _u
+}
+
+// SetDisputedCaseCount sets the "disputed_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetDisputedCaseCount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ResetDisputedCaseCount()
+	_u.mutation.SetDisputedCaseCount(v)
+	return _u
+}
+
+// SetNillableDisputedCaseCount sets the "disputed_case_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableDisputedCaseCount(v *int) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetDisputedCaseCount(*v)
+	}
+	return _u
+}
+
+// AddDisputedCaseCount adds value to the "disputed_case_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AddDisputedCaseCount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AddDisputedCaseCount(v)
+	return _u
+}
+
+// SetApprovedConsultationCount sets the "approved_consultation_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetApprovedConsultationCount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ResetApprovedConsultationCount()
+	_u.mutation.SetApprovedConsultationCount(v)
+	return _u
+}
+
+// SetNillableApprovedConsultationCount sets the "approved_consultation_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableApprovedConsultationCount(v *int) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetApprovedConsultationCount(*v)
+	}
+	return _u
+}
+
+// AddApprovedConsultationCount adds value to the "approved_consultation_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AddApprovedConsultationCount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AddApprovedConsultationCount(v)
+	return _u
+}
+
+// SetDeliverySLACount sets the "delivery_sla_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetDeliverySLACount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ResetDeliverySLACount()
+	_u.mutation.SetDeliverySLACount(v)
+	return _u
+}
+
+// SetNillableDeliverySLACount sets the "delivery_sla_count" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableDeliverySLACount(v *int) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetDeliverySLACount(*v)
+	}
+	return _u
+}
+
+// AddDeliverySLACount adds value to the "delivery_sla_count" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AddDeliverySLACount(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AddDeliverySLACount(v)
+	return _u
+}
+
+// SetResponsivenessScore sets the "responsiveness_score" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetResponsivenessScore(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.ResetResponsivenessScore()
+	_u.mutation.SetResponsivenessScore(v)
+	return _u
+}
+
+// SetNillableResponsivenessScore sets the "responsiveness_score" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableResponsivenessScore(v *int) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetResponsivenessScore(*v)
+	}
+	return _u
+}
+
+// AddResponsivenessScore adds value to the "responsiveness_score" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) AddResponsivenessScore(v int) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.AddResponsivenessScore(v)
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetComputedAt(v time.Time) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *ValidatorMatchingAggregateUpdateOne) SetNillableComputedAt(v *time.Time) *ValidatorMatchingAggregateUpdateOne {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ValidatorMatchingAggregateMutation object of the builder.
+func (_u *ValidatorMatchingAggregateUpdateOne) Mutation() *ValidatorMatchingAggregateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ValidatorMatchingAggregateUpdate builder.
+func (_u *ValidatorMatchingAggregateUpdateOne) Where(ps ...predicate.ValidatorMatchingAggregate) *ValidatorMatchingAggregateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ValidatorMatchingAggregateUpdateOne) Select(field string, fields ...string) *ValidatorMatchingAggregateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ValidatorMatchingAggregate entity.
+func (_u *ValidatorMatchingAggregateUpdateOne) Save(ctx context.Context) (*ValidatorMatchingAggregate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValidatorMatchingAggregateUpdateOne) SaveX(ctx context.Context) *ValidatorMatchingAggregate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ValidatorMatchingAggregateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValidatorMatchingAggregateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValidatorMatchingAggregateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := validatormatchingaggregate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValidatorMatchingAggregateUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := validatormatchingaggregate.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HistoryCaseCount(); ok {
+		if err := validatormatchingaggregate.HistoryCaseCountValidator(v); err != nil {
+			return &ValidationError{Name: "history_case_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.history_case_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisputedCaseCount(); ok {
+		if err := validatormatchingaggregate.DisputedCaseCountValidator(v); err != nil {
+			return &ValidationError{Name: "disputed_case_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.disputed_case_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ApprovedConsultationCount(); ok {
+		if err := validatormatchingaggregate.ApprovedConsultationCountValidator(v); err != nil {
+			return &ValidationError{Name: "approved_consultation_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.approved_consultation_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliverySLACount(); ok {
+		if err := validatormatchingaggregate.DeliverySLACountValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_sla_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.delivery_sla_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResponsivenessScore(); ok {
+		if err := validatormatchingaggregate.ResponsivenessScoreValidator(v); err != nil {
+			return &ValidationError{Name: "responsiveness_score", err: fmt.Errorf(`ent: validator failed for field "ValidatorMatchingAggregate.responsiveness_score": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ValidatorMatchingAggregateUpdateOne) sqlSave(ctx context.Context) (_node *ValidatorMatchingAggregate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(validatormatchingaggregate.Table, validatormatchingaggregate.Columns, sqlgraph.NewFieldSpec(validatormatchingaggregate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ValidatorMatchingAggregate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, validatormatchingaggregate.FieldID)
+		for _, f := range fields {
+			if !validatormatchingaggregate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != validatormatchingaggregate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(validatormatchingaggregate.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DomainSlugs(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDomainSlugs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDomainSlugs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, validatormatchingaggregate.FieldDomainSlugs, value)
+		})
+	}
+	if _u.mutation.DomainSlugsCleared() {
+		_spec.ClearField(validatormatchingaggregate.FieldDomainSlugs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EvidenceSlugs(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldEvidenceSlugs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEvidenceSlugs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, validatormatchingaggregate.FieldEvidenceSlugs, value)
+		})
+	}
+	if _u.mutation.EvidenceSlugsCleared() {
+		_spec.ClearField(validatormatchingaggregate.FieldEvidenceSlugs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HistoryCaseCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldHistoryCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHistoryCaseCount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldHistoryCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DisputedCaseCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDisputedCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDisputedCaseCount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldDisputedCaseCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ApprovedConsultationCount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldApprovedConsultationCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovedConsultationCount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldApprovedConsultationCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeliverySLACount(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldDeliverySLACount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeliverySLACount(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldDeliverySLACount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponsivenessScore(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldResponsivenessScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponsivenessScore(); ok {
+		_spec.AddField(validatormatchingaggregate.FieldResponsivenessScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(validatormatchingaggregate.FieldComputedAt, field.TypeTime, value)
+	}
+	_node = &ValidatorMatchingAggregate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{validatormatchingaggregate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
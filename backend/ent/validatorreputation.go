@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/validatorreputation"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ValidatorReputation is the model entity for the ValidatorReputation schema.
+type ValidatorReputation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// ValidatorUserID holds the value of the "validator_user_id" field.
+	ValidatorUserID int `json:"validator_user_id,omitempty"`
+	// DomainSlug holds the value of the "domain_slug" field.
+	DomainSlug string `json:"domain_slug,omitempty"`
+	// Score holds the value of the "score" field.
+	Score int `json:"score,omitempty"`
+	// DecayedPoints holds the value of the "decayed_points" field.
+	DecayedPoints float64 `json:"decayed_points,omitempty"`
+	// EventCount holds the value of the "event_count" field.
+	EventCount int `json:"event_count,omitempty"`
+	// LastEventAt holds the value of the "last_event_at" field.
+	LastEventAt *time.Time `json:"last_event_at,omitempty"`
+	// ComputedAt holds the value of the "computed_at" field.
+	ComputedAt   time.Time `json:"computed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ValidatorReputation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case validatorreputation.FieldDecayedPoints:
+			values[i] = new(sql.NullFloat64)
+		case validatorreputation.FieldID, validatorreputation.FieldValidatorUserID, validatorreputation.FieldScore, validatorreputation.FieldEventCount:
+			values[i] = new(sql.NullInt64)
+		case validatorreputation.FieldDomainSlug:
+			values[i] = new(sql.NullString)
+		case validatorreputation.FieldCreatedAt, validatorreputation.FieldUpdatedAt, validatorreputation.FieldDeletedAt, validatorreputation.FieldLastEventAt, validatorreputation.FieldComputedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ValidatorReputation fields.
+func (_m *ValidatorReputation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case validatorreputation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case validatorreputation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case validatorreputation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case validatorreputation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case validatorreputation.FieldValidatorUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validator_user_id", values[i])
+			} else if value.Valid {
+				_m.ValidatorUserID = int(value.Int64)
+			}
+		case validatorreputation.FieldDomainSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_slug", values[i])
+			} else if value.Valid {
+				_m.DomainSlug = value.String
+			}
+		case validatorreputation.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = int(value.Int64)
+			}
+		case validatorreputation.FieldDecayedPoints:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field decayed_points", values[i])
+			} else if value.Valid {
+				_m.DecayedPoints = value.Float64
+			}
+		case validatorreputation.FieldEventCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_count", values[i])
+			} else if value.Valid {
+				_m.EventCount = int(value.Int64)
+			}
+		case validatorreputation.FieldLastEventAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_event_at", values[i])
+			} else if value.Valid {
+				_m.LastEventAt = new(time.Time)
+				*_m.LastEventAt = value.Time
+			}
+		case validatorreputation.FieldComputedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field computed_at", values[i])
+			} else if value.Valid {
+				_m.ComputedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ValidatorReputation.
+// This includes values selected through modifiers, order, etc.
+func (_m *ValidatorReputation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ValidatorReputation.
+// Note that you need to call ValidatorReputation.Unwrap() before calling this method if this ValidatorReputation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ValidatorReputation) Update() *ValidatorReputationUpdateOne {
+	return NewValidatorReputationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ValidatorReputation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ValidatorReputation) Unwrap() *ValidatorReputation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ValidatorReputation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ValidatorReputation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ValidatorReputation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("validator_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ValidatorUserID))
+	builder.WriteString(", ")
+	builder.WriteString("domain_slug=")
+	builder.WriteString(_m.DomainSlug)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("decayed_points=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DecayedPoints))
+	builder.WriteString(", ")
+	builder.WriteString("event_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventCount))
+	builder.WriteString(", ")
+	if v := _m.LastEventAt; v != nil {
+		builder.WriteString("last_event_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("computed_at=")
+	builder.WriteString(_m.ComputedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ValidatorReputations is a parsable slice of ValidatorReputation.
+type ValidatorReputations []*ValidatorReputation
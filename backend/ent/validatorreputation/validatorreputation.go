@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package validatorreputation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the validatorreputation type in the database.
+	Label = "validator_reputation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldValidatorUserID holds the string denoting the validator_user_id field in the database.
+	FieldValidatorUserID = "validator_user_id"
+	// FieldDomainSlug holds the string denoting the domain_slug field in the database.
+	FieldDomainSlug = "domain_slug"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldDecayedPoints holds the string denoting the decayed_points field in the database.
+	FieldDecayedPoints = "decayed_points"
+	// FieldEventCount holds the string denoting the event_count field in the database.
+	FieldEventCount = "event_count"
+	// FieldLastEventAt holds the string denoting the last_event_at field in the database.
+	FieldLastEventAt = "last_event_at"
+	// FieldComputedAt holds the string denoting the computed_at field in the database.
+	FieldComputedAt = "computed_at"
+	// Table holds the table name of the validatorreputation in the database.
+	Table = "validator_reputations"
+)
+
+// Columns holds all SQL columns for validatorreputation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldValidatorUserID,
+	FieldDomainSlug,
+	FieldScore,
+	FieldDecayedPoints,
+	FieldEventCount,
+	FieldLastEventAt,
+	FieldComputedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// ValidatorUserIDValidator is a validator for the "validator_user_id" field. It is called by the builders before save.
+	ValidatorUserIDValidator func(int) error
+	// DefaultDomainSlug holds the default value on creation for the "domain_slug" field.
+	DefaultDomainSlug string
+	// DomainSlugValidator is a validator for the "domain_slug" field. It is called by the builders before save.
+	DomainSlugValidator func(string) error
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore int
+	// ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	ScoreValidator func(int) error
+	// DefaultDecayedPoints holds the default value on creation for the "decayed_points" field.
+	DefaultDecayedPoints float64
+	// DefaultEventCount holds the default value on creation for the "event_count" field.
+	DefaultEventCount int
+	// EventCountValidator is a validator for the "event_count" field. It is called by the builders before save.
+	EventCountValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the ValidatorReputation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByValidatorUserID orders the results by the validator_user_id field.
+func ByValidatorUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatorUserID, opts...).ToFunc()
+}
+
+// ByDomainSlug orders the results by the domain_slug field.
+func ByDomainSlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomainSlug, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByDecayedPoints orders the results by the decayed_points field.
+func ByDecayedPoints(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecayedPoints, opts...).ToFunc()
+}
+
+// ByEventCount orders the results by the event_count field.
+func ByEventCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventCount, opts...).ToFunc()
+}
+
+// ByLastEventAt orders the results by the last_event_at field.
+func ByLastEventAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastEventAt, opts...).ToFunc()
+}
+
+// ByComputedAt orders the results by the computed_at field.
+func ByComputedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComputedAt, opts...).ToFunc()
+}
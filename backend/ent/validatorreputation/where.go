@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package validatorreputation
+
+import (
+	"backend-gin/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ValidatorUserID applies equality check predicate on the "validator_user_id" field. It's identical to ValidatorUserIDEQ.
+func ValidatorUserID(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// DomainSlug applies equality check predicate on the "domain_slug" field. It's identical to DomainSlugEQ.
+func DomainSlug(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldDomainSlug, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldScore, v))
+}
+
+// DecayedPoints applies equality check predicate on the "decayed_points" field. It's identical to DecayedPointsEQ.
+func DecayedPoints(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldDecayedPoints, v))
+}
+
+// EventCount applies equality check predicate on the "event_count" field. It's identical to EventCountEQ.
+func EventCount(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldEventCount, v))
+}
+
+// LastEventAt applies equality check predicate on the "last_event_at" field. It's identical to LastEventAtEQ.
+func LastEventAt(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldLastEventAt, v))
+}
+
+// ComputedAt applies equality check predicate on the "computed_at" field. It's identical to ComputedAtEQ.
+func ComputedAt(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// ValidatorUserIDEQ applies the EQ predicate on the "validator_user_id" field.
+func ValidatorUserIDEQ(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDNEQ applies the NEQ predicate on the "validator_user_id" field.
+func ValidatorUserIDNEQ(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDIn applies the In predicate on the "validator_user_id" field.
+func ValidatorUserIDIn(vs ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDNotIn applies the NotIn predicate on the "validator_user_id" field.
+func ValidatorUserIDNotIn(vs ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldValidatorUserID, vs...))
+}
+
+// ValidatorUserIDGT applies the GT predicate on the "validator_user_id" field.
+func ValidatorUserIDGT(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDGTE applies the GTE predicate on the "validator_user_id" field.
+func ValidatorUserIDGTE(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLT applies the LT predicate on the "validator_user_id" field.
+func ValidatorUserIDLT(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldValidatorUserID, v))
+}
+
+// ValidatorUserIDLTE applies the LTE predicate on the "validator_user_id" field.
+func ValidatorUserIDLTE(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldValidatorUserID, v))
+}
+
+// DomainSlugEQ applies the EQ predicate on the "domain_slug" field.
+func DomainSlugEQ(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldDomainSlug, v))
+}
+
+// DomainSlugNEQ applies the NEQ predicate on the "domain_slug" field.
+func DomainSlugNEQ(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldDomainSlug, v))
+}
+
+// DomainSlugIn applies the In predicate on the "domain_slug" field.
+func DomainSlugIn(vs ...string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldDomainSlug, vs...))
+}
+
+// DomainSlugNotIn applies the NotIn predicate on the "domain_slug" field.
+func DomainSlugNotIn(vs ...string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldDomainSlug, vs...))
+}
+
+// DomainSlugGT applies the GT predicate on the "domain_slug" field.
+func DomainSlugGT(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldDomainSlug, v))
+}
+
+// DomainSlugGTE applies the GTE predicate on the "domain_slug" field.
+func DomainSlugGTE(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldDomainSlug, v))
+}
+
+// DomainSlugLT applies the LT predicate on the "domain_slug" field.
+func DomainSlugLT(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldDomainSlug, v))
+}
+
+// DomainSlugLTE applies the LTE predicate on the "domain_slug" field.
+func DomainSlugLTE(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldDomainSlug, v))
+}
+
+// DomainSlugContains applies the Contains predicate on the "domain_slug" field.
+func DomainSlugContains(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldContains(FieldDomainSlug, v))
+}
+
+// DomainSlugHasPrefix applies the HasPrefix predicate on the "domain_slug" field.
+func DomainSlugHasPrefix(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldHasPrefix(FieldDomainSlug, v))
+}
+
+// DomainSlugHasSuffix applies the HasSuffix predicate on the "domain_slug" field.
+func DomainSlugHasSuffix(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldHasSuffix(FieldDomainSlug, v))
+}
+
+// DomainSlugEqualFold applies the EqualFold predicate on the "domain_slug" field.
+func DomainSlugEqualFold(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEqualFold(FieldDomainSlug, v))
+}
+
+// DomainSlugContainsFold applies the ContainsFold predicate on the "domain_slug" field.
+func DomainSlugContainsFold(v string) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldContainsFold(FieldDomainSlug, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldScore, v))
+}
+
+// DecayedPointsEQ applies the EQ predicate on the "decayed_points" field.
+func DecayedPointsEQ(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldDecayedPoints, v))
+}
+
+// DecayedPointsNEQ applies the NEQ predicate on the "decayed_points" field.
+func DecayedPointsNEQ(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldDecayedPoints, v))
+}
+
+// DecayedPointsIn applies the In predicate on the "decayed_points" field.
+func DecayedPointsIn(vs ...float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldDecayedPoints, vs...))
+}
+
+// DecayedPointsNotIn applies the NotIn predicate on the "decayed_points" field.
+func DecayedPointsNotIn(vs ...float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldDecayedPoints, vs...))
+}
+
+// DecayedPointsGT applies the GT predicate on the "decayed_points" field.
+func DecayedPointsGT(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldDecayedPoints, v))
+}
+
+// DecayedPointsGTE applies the GTE predicate on the "decayed_points" field.
+func DecayedPointsGTE(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldDecayedPoints, v))
+}
+
+// DecayedPointsLT applies the LT predicate on the "decayed_points" field.
+func DecayedPointsLT(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldDecayedPoints, v))
+}
+
+// DecayedPointsLTE applies the LTE predicate on the "decayed_points" field.
+func DecayedPointsLTE(v float64) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldDecayedPoints, v))
+}
+
+// EventCountEQ applies the EQ predicate on the "event_count" field.
+func EventCountEQ(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldEventCount, v))
+}
+
+// EventCountNEQ applies the NEQ predicate on the "event_count" field.
+func EventCountNEQ(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldEventCount, v))
+}
+
+// EventCountIn applies the In predicate on the "event_count" field.
+func EventCountIn(vs ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldEventCount, vs...))
+}
+
+// EventCountNotIn applies the NotIn predicate on the "event_count" field.
+func EventCountNotIn(vs ...int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldEventCount, vs...))
+}
+
+// EventCountGT applies the GT predicate on the "event_count" field.
+func EventCountGT(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldEventCount, v))
+}
+
+// EventCountGTE applies the GTE predicate on the "event_count" field.
+func EventCountGTE(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldEventCount, v))
+}
+
+// EventCountLT applies the LT predicate on the "event_count" field.
+func EventCountLT(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldEventCount, v))
+}
+
+// EventCountLTE applies the LTE predicate on the "event_count" field.
+func EventCountLTE(v int) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldEventCount, v))
+}
+
+// LastEventAtEQ applies the EQ predicate on the "last_event_at" field.
+func LastEventAtEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldLastEventAt, v))
+}
+
+// LastEventAtNEQ applies the NEQ predicate on the "last_event_at" field.
+func LastEventAtNEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldLastEventAt, v))
+}
+
+// LastEventAtIn applies the In predicate on the "last_event_at" field.
+func LastEventAtIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldLastEventAt, vs...))
+}
+
+// LastEventAtNotIn applies the NotIn predicate on the "last_event_at" field.
+func LastEventAtNotIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldLastEventAt, vs...))
+}
+
+// LastEventAtGT applies the GT predicate on the "last_event_at" field.
+func LastEventAtGT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldLastEventAt, v))
+}
+
+// LastEventAtGTE applies the GTE predicate on the "last_event_at" field.
+func LastEventAtGTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldLastEventAt, v))
+}
+
+// LastEventAtLT applies the LT predicate on the "last_event_at" field.
+func LastEventAtLT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldLastEventAt, v))
+}
+
+// LastEventAtLTE applies the LTE predicate on the "last_event_at" field.
+func LastEventAtLTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldLastEventAt, v))
+}
+
+// LastEventAtIsNil applies the IsNil predicate on the "last_event_at" field.
+func LastEventAtIsNil() predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIsNull(FieldLastEventAt))
+}
+
+// LastEventAtNotNil applies the NotNil predicate on the "last_event_at" field.
+func LastEventAtNotNil() predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotNull(FieldLastEventAt))
+}
+
+// ComputedAtEQ applies the EQ predicate on the "computed_at" field.
+func ComputedAtEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldEQ(FieldComputedAt, v))
+}
+
+// ComputedAtNEQ applies the NEQ predicate on the "computed_at" field.
+func ComputedAtNEQ(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNEQ(FieldComputedAt, v))
+}
+
+// ComputedAtIn applies the In predicate on the "computed_at" field.
+func ComputedAtIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtNotIn applies the NotIn predicate on the "computed_at" field.
+func ComputedAtNotIn(vs ...time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldNotIn(FieldComputedAt, vs...))
+}
+
+// ComputedAtGT applies the GT predicate on the "computed_at" field.
+func ComputedAtGT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGT(FieldComputedAt, v))
+}
+
+// ComputedAtGTE applies the GTE predicate on the "computed_at" field.
+func ComputedAtGTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldGTE(FieldComputedAt, v))
+}
+
+// ComputedAtLT applies the LT predicate on the "computed_at" field.
+func ComputedAtLT(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLT(FieldComputedAt, v))
+}
+
+// ComputedAtLTE applies the LTE predicate on the "computed_at" field.
+func ComputedAtLTE(v time.Time) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.FieldLTE(FieldComputedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ValidatorReputation) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ValidatorReputation) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ValidatorReputation) predicate.ValidatorReputation {
+	return predicate.ValidatorReputation(sql.NotPredicates(p))
+}
@@ -0,0 +1,409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/validatorreputation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorReputationCreate is the builder for creating a ValidatorReputation entity.
+type ValidatorReputationCreate struct {
+	config
+	mutation *ValidatorReputationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ValidatorReputationCreate) SetCreatedAt(v time.Time) *ValidatorReputationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableCreatedAt(v *time.Time) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ValidatorReputationCreate) SetUpdatedAt(v time.Time) *ValidatorReputationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableUpdatedAt(v *time.Time) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ValidatorReputationCreate) SetDeletedAt(v time.Time) *ValidatorReputationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableDeletedAt(v *time.Time) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_c *ValidatorReputationCreate) SetValidatorUserID(v int) *ValidatorReputationCreate {
+	_c.mutation.SetValidatorUserID(v)
+	return _c
+}
+
+// SetDomainSlug sets the "domain_slug" field.
+func (_c *ValidatorReputationCreate) SetDomainSlug(v string) *ValidatorReputationCreate {
+	_c.mutation.SetDomainSlug(v)
+	return _c
+}
+
+// SetNillableDomainSlug sets the "domain_slug" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableDomainSlug(v *string) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetDomainSlug(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *ValidatorReputationCreate) SetScore(v int) *ValidatorReputationCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableScore(v *int) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetDecayedPoints sets the "decayed_points" field.
+func (_c *ValidatorReputationCreate) SetDecayedPoints(v float64) *ValidatorReputationCreate {
+	_c.mutation.SetDecayedPoints(v)
+	return _c
+}
+
+// SetNillableDecayedPoints sets the "decayed_points" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableDecayedPoints(v *float64) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetDecayedPoints(*v)
+	}
+	return _c
+}
+
+// SetEventCount sets the "event_count" field.
+func (_c *ValidatorReputationCreate) SetEventCount(v int) *ValidatorReputationCreate {
+	_c.mutation.SetEventCount(v)
+	return _c
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableEventCount(v *int) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetEventCount(*v)
+	}
+	return _c
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (_c *ValidatorReputationCreate) SetLastEventAt(v time.Time) *ValidatorReputationCreate {
+	_c.mutation.SetLastEventAt(v)
+	return _c
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (_c *ValidatorReputationCreate) SetNillableLastEventAt(v *time.Time) *ValidatorReputationCreate {
+	if v != nil {
+		_c.SetLastEventAt(*v)
+	}
+	return _c
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_c *ValidatorReputationCreate) SetComputedAt(v time.Time) *ValidatorReputationCreate {
+	_c.mutation.SetComputedAt(v)
+	return _c
+}
+
+// Mutation returns the ValidatorReputationMutation object of the builder.
+func (_c *ValidatorReputationCreate) Mutation() *ValidatorReputationMutation {
+	return _c.mutation
+}
+
+// Save creates the ValidatorReputation in the database.
+func (_c *ValidatorReputationCreate) Save(ctx context.Context) (*ValidatorReputation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ValidatorReputationCreate) SaveX(ctx context.Context) *ValidatorReputation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValidatorReputationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValidatorReputationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ValidatorReputationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := validatorreputation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := validatorreputation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DomainSlug(); !ok {
+		v := validatorreputation.DefaultDomainSlug
+		_c.mutation.SetDomainSlug(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := validatorreputation.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.DecayedPoints(); !ok {
+		v := validatorreputation.DefaultDecayedPoints
+		_c.mutation.SetDecayedPoints(v)
+	}
+	if _, ok := _c.mutation.EventCount(); !ok {
+		v := validatorreputation.DefaultEventCount
+		_c.mutation.SetEventCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ValidatorReputationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ValidatorReputation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ValidatorReputation.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ValidatorUserID(); !ok {
+		return &ValidationError{Name: "validator_user_id", err: errors.New(`ent: missing required field "ValidatorReputation.validator_user_id"`)}
+	}
+	if v, ok := _c.mutation.ValidatorUserID(); ok {
+		if err := validatorreputation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.validator_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DomainSlug(); !ok {
+		return &ValidationError{Name: "domain_slug", err: errors.New(`ent: missing required field "ValidatorReputation.domain_slug"`)}
+	}
+	if v, ok := _c.mutation.DomainSlug(); ok {
+		if err := validatorreputation.DomainSlugValidator(v); err != nil {
+			return &ValidationError{Name: "domain_slug", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.domain_slug": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "ValidatorReputation.score"`)}
+	}
+	if v, ok := _c.mutation.Score(); ok {
+		if err := validatorreputation.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.score": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DecayedPoints(); !ok {
+		return &ValidationError{Name: "decayed_points", err: errors.New(`ent: missing required field "ValidatorReputation.decayed_points"`)}
+	}
+	if _, ok := _c.mutation.EventCount(); !ok {
+		return &ValidationError{Name: "event_count", err: errors.New(`ent: missing required field "ValidatorReputation.event_count"`)}
+	}
+	if v, ok := _c.mutation.EventCount(); ok {
+		if err := validatorreputation.EventCountValidator(v); err != nil {
+			return &ValidationError{Name: "event_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.event_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ComputedAt(); !ok {
+		return &ValidationError{Name: "computed_at", err: errors.New(`ent: missing required field "ValidatorReputation.computed_at"`)}
+	}
+	return nil
+}
+
+func (_c *ValidatorReputationCreate) sqlSave(ctx context.Context) (*ValidatorReputation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ValidatorReputationCreate) createSpec() (*ValidatorReputation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ValidatorReputation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(validatorreputation.Table, sqlgraph.NewFieldSpec(validatorreputation.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(validatorreputation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatorreputation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(validatorreputation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatorreputation.FieldValidatorUserID, field.TypeInt, value)
+		_node.ValidatorUserID = value
+	}
+	if value, ok := _c.mutation.DomainSlug(); ok {
+		_spec.SetField(validatorreputation.FieldDomainSlug, field.TypeString, value)
+		_node.DomainSlug = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(validatorreputation.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.DecayedPoints(); ok {
+		_spec.SetField(validatorreputation.FieldDecayedPoints, field.TypeFloat64, value)
+		_node.DecayedPoints = value
+	}
+	if value, ok := _c.mutation.EventCount(); ok {
+		_spec.SetField(validatorreputation.FieldEventCount, field.TypeInt, value)
+		_node.EventCount = value
+	}
+	if value, ok := _c.mutation.LastEventAt(); ok {
+		_spec.SetField(validatorreputation.FieldLastEventAt, field.TypeTime, value)
+		_node.LastEventAt = &value
+	}
+	if value, ok := _c.mutation.ComputedAt(); ok {
+		_spec.SetField(validatorreputation.FieldComputedAt, field.TypeTime, value)
+		_node.ComputedAt = value
+	}
+	return _node, _spec
+}
+
+// ValidatorReputationCreateBulk is the builder for creating many ValidatorReputation entities in bulk.
+type ValidatorReputationCreateBulk struct {
+	config
+	err      error
+	builders []*ValidatorReputationCreate
+}
+
+// Save creates the ValidatorReputation entities in the database.
+func (_c *ValidatorReputationCreateBulk) Save(ctx context.Context) ([]*ValidatorReputation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ValidatorReputation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ValidatorReputationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ValidatorReputationCreateBulk) SaveX(ctx context.Context) []*ValidatorReputation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ValidatorReputationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ValidatorReputationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
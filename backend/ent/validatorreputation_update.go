@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/validatorreputation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ValidatorReputationUpdate is the builder for updating ValidatorReputation entities.
+type ValidatorReputationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ValidatorReputationMutation
+}
+
+// Where appends a list predicates to the ValidatorReputationUpdate builder.
+func (_u *ValidatorReputationUpdate) Where(ps ...predicate.ValidatorReputation) *ValidatorReputationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValidatorReputationUpdate) SetUpdatedAt(v time.Time) *ValidatorReputationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ValidatorReputationUpdate) SetDeletedAt(v time.Time) *ValidatorReputationUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableDeletedAt(v *time.Time) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ValidatorReputationUpdate) ClearDeletedAt() *ValidatorReputationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ValidatorReputationUpdate) SetValidatorUserID(v int) *ValidatorReputationUpdate {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableValidatorUserID(v *int) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ValidatorReputationUpdate) AddValidatorUserID(v int) *ValidatorReputationUpdate {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetDomainSlug sets the "domain_slug" field.
+func (_u *ValidatorReputationUpdate) SetDomainSlug(v string) *ValidatorReputationUpdate {
+	_u.mutation.SetDomainSlug(v)
+	return _u
+}
+
+// SetNillableDomainSlug sets the "domain_slug" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableDomainSlug(v *string) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetDomainSlug(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *ValidatorReputationUpdate) SetScore(v int) *ValidatorReputationUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableScore(v *int) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *ValidatorReputationUpdate) AddScore(v int) *ValidatorReputationUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetDecayedPoints sets the "decayed_points" field.
+func (_u *ValidatorReputationUpdate) SetDecayedPoints(v float64) *ValidatorReputationUpdate {
+	_u.mutation.ResetDecayedPoints()
+	_u.mutation.SetDecayedPoints(v)
+	return _u
+}
+
+// SetNillableDecayedPoints sets the "decayed_points" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableDecayedPoints(v *float64) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetDecayedPoints(*v)
+	}
+	return _u
+}
+
+// AddDecayedPoints adds value to the "decayed_points" field.
+func (_u *ValidatorReputationUpdate) AddDecayedPoints(v float64) *ValidatorReputationUpdate {
+	_u.mutation.AddDecayedPoints(v)
+	return _u
+}
+
+// SetEventCount sets the "event_count" field.
+func (_u *ValidatorReputationUpdate) SetEventCount(v int) *ValidatorReputationUpdate {
+	_u.mutation.ResetEventCount()
+	_u.mutation.SetEventCount(v)
+	return _u
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableEventCount(v *int) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetEventCount(*v)
+	}
+	return _u
+}
+
+// AddEventCount adds value to the "event_count" field.
+func (_u *ValidatorReputationUpdate) AddEventCount(v int) *ValidatorReputationUpdate {
+	_u.mutation.AddEventCount(v)
+	return _u
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (_u *ValidatorReputationUpdate) SetLastEventAt(v time.Time) *ValidatorReputationUpdate {
+	_u.mutation.SetLastEventAt(v)
+	return _u
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableLastEventAt(v *time.Time) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetLastEventAt(*v)
+	}
+	return _u
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (_u *ValidatorReputationUpdate) ClearLastEventAt() *ValidatorReputationUpdate {
+	_u.mutation.ClearLastEventAt()
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *ValidatorReputationUpdate) SetComputedAt(v time.Time) *ValidatorReputationUpdate {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *ValidatorReputationUpdate) SetNillableComputedAt(v *time.Time) *ValidatorReputationUpdate {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ValidatorReputationMutation object of the builder.
+func (_u *ValidatorReputationUpdate) Mutation() *ValidatorReputationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ValidatorReputationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValidatorReputationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ValidatorReputationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValidatorReputationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValidatorReputationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := validatorreputation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValidatorReputationUpdate) check() error {
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := validatorreputation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DomainSlug(); ok {
+		if err := validatorreputation.DomainSlugValidator(v); err != nil {
+			return &ValidationError{Name: "domain_slug", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.domain_slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Score(); ok {
+		if err := validatorreputation.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.score": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventCount(); ok {
+		if err := validatorreputation.EventCountValidator(v); err != nil {
+			return &ValidationError{Name: "event_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.event_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ValidatorReputationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(validatorreputation.Table, validatorreputation.Columns, sqlgraph.NewFieldSpec(validatorreputation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatorreputation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(validatorreputation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(validatorreputation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatorreputation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(validatorreputation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DomainSlug(); ok {
+		_spec.SetField(validatorreputation.FieldDomainSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(validatorreputation.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(validatorreputation.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DecayedPoints(); ok {
+		_spec.SetField(validatorreputation.FieldDecayedPoints, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDecayedPoints(); ok {
+		_spec.AddField(validatorreputation.FieldDecayedPoints, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EventCount(); ok {
+		_spec.SetField(validatorreputation.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventCount(); ok {
+		_spec.AddField(validatorreputation.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastEventAt(); ok {
+		_spec.SetField(validatorreputation.FieldLastEventAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastEventAtCleared() {
+		_spec.ClearField(validatorreputation.FieldLastEventAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(validatorreputation.FieldComputedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{validatorreputation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ValidatorReputationUpdateOne is the builder for updating a single ValidatorReputation entity.
+type ValidatorReputationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ValidatorReputationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ValidatorReputationUpdateOne) SetUpdatedAt(v time.Time) *ValidatorReputationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ValidatorReputationUpdateOne) SetDeletedAt(v time.Time) *ValidatorReputationUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableDeletedAt(v *time.Time) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ValidatorReputationUpdateOne) ClearDeletedAt() *ValidatorReputationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetValidatorUserID sets the "validator_user_id" field.
+func (_u *ValidatorReputationUpdateOne) SetValidatorUserID(v int) *ValidatorReputationUpdateOne {
+	_u.mutation.ResetValidatorUserID()
+	_u.mutation.SetValidatorUserID(v)
+	return _u
+}
+
+// SetNillableValidatorUserID sets the "validator_user_id" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableValidatorUserID(v *int) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetValidatorUserID(*v)
+	}
+	return _u
+}
+
+// AddValidatorUserID adds value to the "validator_user_id" field.
+func (_u *ValidatorReputationUpdateOne) AddValidatorUserID(v int) *ValidatorReputationUpdateOne {
+	_u.mutation.AddValidatorUserID(v)
+	return _u
+}
+
+// SetDomainSlug sets the "domain_slug" field.
+func (_u *ValidatorReputationUpdateOne) SetDomainSlug(v string) *ValidatorReputationUpdateOne {
+	_u.mutation.SetDomainSlug(v)
+	return _u
+}
+
+// SetNillableDomainSlug sets the "domain_slug" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableDomainSlug(v *string) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetDomainSlug(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *ValidatorReputationUpdateOne) SetScore(v int) *ValidatorReputationUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableScore(v *int) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *ValidatorReputationUpdateOne) AddScore(v int) *ValidatorReputationUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetDecayedPoints sets the "decayed_points" field.
+func (_u *ValidatorReputationUpdateOne) SetDecayedPoints(v float64) *ValidatorReputationUpdateOne {
+	_u.mutation.ResetDecayedPoints()
+	_u.mutation.SetDecayedPoints(v)
+	return _u
+}
+
+// SetNillableDecayedPoints sets the "decayed_points" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableDecayedPoints(v *float64) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetDecayedPoints(*v)
+	}
+	return _u
+}
+
+// AddDecayedPoints adds value to the "decayed_points" field.
+func (_u *ValidatorReputationUpdateOne) AddDecayedPoints(v float64) *ValidatorReputationUpdateOne {
+	_u.mutation.AddDecayedPoints(v)
+	return _u
+}
+
+// SetEventCount sets the "event_count" field.
+func (_u *ValidatorReputationUpdateOne) SetEventCount(v int) *ValidatorReputationUpdateOne {
+	_u.mutation.ResetEventCount()
+	_u.mutation.SetEventCount(v)
+	return _u
+}
+
+// SetNillableEventCount sets the "event_count" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableEventCount(v *int) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetEventCount(*v)
+	}
+	return _u
+}
+
+// AddEventCount adds value to the "event_count" field.
+func (_u *ValidatorReputationUpdateOne) AddEventCount(v int) *ValidatorReputationUpdateOne {
+	_u.mutation.AddEventCount(v)
+	return _u
+}
+
+// SetLastEventAt sets the "last_event_at" field.
+func (_u *ValidatorReputationUpdateOne) SetLastEventAt(v time.Time) *ValidatorReputationUpdateOne {
+	_u.mutation.SetLastEventAt(v)
+	return _u
+}
+
+// SetNillableLastEventAt sets the "last_event_at" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableLastEventAt(v *time.Time) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetLastEventAt(*v)
+	}
+	return _u
+}
+
+// ClearLastEventAt clears the value of the "last_event_at" field.
+func (_u *ValidatorReputationUpdateOne) ClearLastEventAt() *ValidatorReputationUpdateOne {
+	_u.mutation.ClearLastEventAt()
+	return _u
+}
+
+// SetComputedAt sets the "computed_at" field.
+func (_u *ValidatorReputationUpdateOne) SetComputedAt(v time.Time) *ValidatorReputationUpdateOne {
+	_u.mutation.SetComputedAt(v)
+	return _u
+}
+
+// SetNillableComputedAt sets the "computed_at" field if the given value is not nil.
+func (_u *ValidatorReputationUpdateOne) SetNillableComputedAt(v *time.Time) *ValidatorReputationUpdateOne {
+	if v != nil {
+		_u.SetComputedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ValidatorReputationMutation object of the builder.
+func (_u *ValidatorReputationUpdateOne) Mutation() *ValidatorReputationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ValidatorReputationUpdate builder.
+func (_u *ValidatorReputationUpdateOne) Where(ps ...predicate.ValidatorReputation) *ValidatorReputationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ValidatorReputationUpdateOne) Select(field string, fields ...string) *ValidatorReputationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ValidatorReputation entity.
+func (_u *ValidatorReputationUpdateOne) Save(ctx context.Context) (*ValidatorReputation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ValidatorReputationUpdateOne) SaveX(ctx context.Context) *ValidatorReputation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ValidatorReputationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ValidatorReputationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ValidatorReputationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := validatorreputation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ValidatorReputationUpdateOne) check() error {
+	if v, ok := _u.mutation.ValidatorUserID(); ok {
+		if err := validatorreputation.ValidatorUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "validator_user_id", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.validator_user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DomainSlug(); ok {
+		if err := validatorreputation.DomainSlugValidator(v); err != nil {
+			return &ValidationError{Name: "domain_slug", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.domain_slug": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Score(); ok {
+		if err := validatorreputation.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.score": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventCount(); ok {
+		if err := validatorreputation.EventCountValidator(v); err != nil {
+			return &ValidationError{Name: "event_count", err: fmt.Errorf(`ent: validator failed for field "ValidatorReputation.event_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ValidatorReputationUpdateOne) sqlSave(ctx context.Context) (_node *ValidatorReputation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(validatorreputation.Table, validatorreputation.Columns, sqlgraph.NewFieldSpec(validatorreputation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ValidatorReputation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, validatorreputation.FieldID)
+		for _, f := range fields {
+			if !validatorreputation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != validatorreputation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(validatorreputation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(validatorreputation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(validatorreputation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidatorUserID(); ok {
+		_spec.SetField(validatorreputation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValidatorUserID(); ok {
+		_spec.AddField(validatorreputation.FieldValidatorUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DomainSlug(); ok {
+		_spec.SetField(validatorreputation.FieldDomainSlug, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(validatorreputation.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(validatorreputation.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DecayedPoints(); ok {
+		_spec.SetField(validatorreputation.FieldDecayedPoints, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDecayedPoints(); ok {
+		_spec.AddField(validatorreputation.FieldDecayedPoints, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EventCount(); ok {
+		_spec.SetField(validatorreputation.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventCount(); ok {
+		_spec.AddField(validatorreputation.FieldEventCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastEventAt(); ok {
+		_spec.SetField(validatorreputation.FieldLastEventAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastEventAtCleared() {
+		_spec.ClearField(validatorreputation.FieldLastEventAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ComputedAt(); ok {
+		_spec.SetField(validatorreputation.FieldComputedAt, field.TypeTime, value)
+	}
+	_node = &ValidatorReputation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{validatorreputation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -66,12 +66,37 @@ var (
 	ErrDeviceLimitReached  = NewAppError("AUTH019", "Perangkat ini sudah digunakan untuk maksimal akun yang diizinkan", http.StatusForbidden)
 	ErrDeviceBlocked       = NewAppError("AUTH020", "Perangkat ini diblokir karena aktivitas mencurigakan", http.StatusForbidden)
 	ErrTelegramAuthInvalid = NewAppError("AUTH021", "Telegram auth tidak valid atau sudah kedaluwarsa", http.StatusBadRequest)
+	ErrBreachedPassword    = NewAppError("AUTH022", "Password ini pernah muncul dalam kebocoran data publik. Silakan gunakan password lain.", http.StatusBadRequest)
+	ErrEmailUnavailable    = NewAppError("AUTH023", "Email ini sudah digunakan oleh akun lain", http.StatusConflict)
+	ErrRiskBlocked         = NewAppError("AUTH024", "Login diblokir karena aktivitas berisiko tinggi terdeteksi. Silakan coba lagi nanti atau hubungi dukungan.", http.StatusForbidden)
+	ErrReauthRequired      = NewAppError("AUTH025", "Verifikasi ulang diperlukan. Silakan login kembali.", http.StatusUnauthorized)
+
+	// Admin impersonation tokens are read-only
+	ErrImpersonationReadOnly = NewAppError("AUTH026", "Sesi impersonasi admin hanya dapat membaca data", http.StatusForbidden)
+
+	// Admin auth errors (ADMIN001-ADMIN005 and ADMIN007 are emitted by the admin middleware)
+	ErrAdminInvalidCredentials = NewAppError("ADMIN006", "Email atau password salah", http.StatusUnauthorized)
+	ErrAdminLocked             = NewAppError("ADMIN008", "Akun admin dikunci sementara karena terlalu banyak percobaan gagal", http.StatusForbidden)
+	ErrAdminMFAInvalid         = NewAppError("ADMIN009", "Kode TOTP atau passkey admin tidak valid", http.StatusUnauthorized)
+	ErrAdminMFAChallenge       = NewAppError("ADMIN010", "Sesi verifikasi login sudah kedaluwarsa. Silakan login ulang.", http.StatusUnauthorized)
+	ErrAdminMFARequired        = NewAppError("ADMIN011", "Aktifkan TOTP atau passkey admin terlebih dahulu", http.StatusForbidden)
+	ErrAdminSudoRequired       = NewAppError("ADMIN012", "Verifikasi ulang admin (sudo) diperlukan untuk aksi ini", http.StatusForbidden)
+	ErrAdminSessionInvalid     = NewAppError("ADMIN013", "Sesi admin tidak valid, sudah dicabut, atau tidak aktif terlalu lama", http.StatusUnauthorized)
+	ErrAdminTOTPAlreadyEnabled = NewAppError("ADMIN014", "TOTP admin sudah aktif", http.StatusConflict)
+	ErrAdminTOTPNotSetup       = NewAppError("ADMIN015", "Setup TOTP admin belum dimulai", http.StatusBadRequest)
+	ErrAdminLastFactor         = NewAppError("ADMIN016", "Faktor verifikasi kedua terakhir tidak dapat dihapus", http.StatusBadRequest)
+	ErrAdminPasskeyNotFound    = NewAppError("ADMIN017", "Passkey admin tidak ditemukan", http.StatusNotFound)
+	ErrIPRuleNotFound          = NewAppError("ADMIN018", "Aturan IP tidak ditemukan", http.StatusNotFound)
+	ErrSecurityEventNotFound   = NewAppError("ADMIN019", "Security event tidak ditemukan", http.StatusNotFound)
+	ErrImpersonationNotFound   = NewAppError("ADMIN020", "Sesi impersonasi tidak ditemukan", http.StatusNotFound)
+	ErrSLAPolicyNotFound       = NewAppError("ADMIN021", "Kebijakan SLA tidak ditemukan", http.StatusNotFound)
 
 	// User errors
 	ErrUserNotFound          = NewAppError("USER001", "Pengguna tidak ditemukan", http.StatusNotFound)
 	ErrUnauthorized          = NewAppError("USER002", "Tidak memiliki akses", http.StatusUnauthorized)
 	ErrInvalidUserInput      = NewAppError("USER003", "Username harus menggunakan huruf kecil, tanpa spasi, dan minimal 7 character", http.StatusBadRequest)
 	ErrTelegramAlreadyLinked = NewAppError("USER004", "Akun Telegram ini sudah terhubung ke akun lain", http.StatusConflict)
+	ErrDeviceNotFound        = NewAppError("USER005", "Perangkat tidak ditemukan", http.StatusNotFound)
 
 	// Validation Case errors (domain replacement for legacy "Thread")
 	ErrValidationCaseNotFound    = NewAppError("CASE001", "Validation Case tidak ditemukan", http.StatusNotFound)
@@ -95,6 +120,7 @@ var (
 	ErrInvalidInput       = NewAppError("VAL002", "Input tidak valid", http.StatusBadRequest)
 	ErrMissingField       = NewAppError("VAL003", "Field wajib tidak ada", http.StatusBadRequest)
 	ErrInvalidRequestBody = NewAppError("VAL004", "Request body tidak valid", http.StatusBadRequest)
+	ErrSuspiciousInput    = NewAppError("VAL005", "Permintaan ditolak karena berisi input yang mencurigakan", http.StatusBadRequest)
 
 	// Internal errors
 	ErrInternalServer = NewAppError("SRV001", "Terjadi kesalahan internal", http.StatusInternalServerError)
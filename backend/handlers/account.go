@@ -15,6 +15,10 @@ import (
 	"backend-gin/ent"
 	"backend-gin/ent/artifactsubmission"
 	"backend-gin/ent/backupcode"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"backend-gin/ent/consultationinvitation"
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/credential"
 	"backend-gin/ent/devicefingerprint"
@@ -22,8 +26,12 @@ import (
 	"backend-gin/ent/emailverificationtoken"
 	"backend-gin/ent/endorsement"
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
+	"backend-gin/ent/guaranteeslash"
 	"backend-gin/ent/passkey"
 	"backend-gin/ent/passwordresettoken"
+	"backend-gin/ent/reputationevent"
 	"backend-gin/ent/securityevent"
 	"backend-gin/ent/session"
 	"backend-gin/ent/sessionlock"
@@ -33,6 +41,9 @@ import (
 	"backend-gin/ent/userbadge"
 	"backend-gin/ent/validationcase"
 	"backend-gin/ent/validationcaselog"
+	"backend-gin/ent/validatordeliverysla"
+	"backend-gin/ent/validatormatchingaggregate"
+	"backend-gin/ent/validatorreputation"
 	apperrors "backend-gin/errors"
 	"backend-gin/logger"
 	"backend-gin/services"
@@ -363,7 +374,7 @@ func BuildPublicProfileFromEnt(c *gin.Context, u *ent.User) gin.H {
 		validationCaseCount = 0
 	}
 
-	return gin.H{
+	profile := gin.H{
 		"username":              name,
 		"full_name":             u.FullName,
 		"bio":                   u.Bio,
@@ -377,6 +388,10 @@ func BuildPublicProfileFromEnt(c *gin.Context, u *ent.User) gin.H {
 		"primary_badge":         primaryBadge,
 		"badges":                badges,
 	}
+	if reputation, err := services.GetValidatorReputationSummary(ctx, database.GetEntClient(), u.ID); err == nil {
+		profile["reputation"] = reputation
+	}
+	return profile
 }
 
 // PUT /api/account/avatar
@@ -702,6 +717,63 @@ func DeleteAccountHandler(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Consultation Request"})
 		return
 	}
+	ownedCaseIDs, err := tx.ValidationCase.Query().
+		Where(validationcase.UserIDEQ(ownerUserID)).
+		IDs(ctx)
+	if err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Validation Case"})
+		return
+	}
+	if _, err := tx.CaseMessage.Delete().
+		Where(casemessage.HasConversationWith(caseconversation.ValidationCaseIDIn(ownedCaseIDs...))).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Pesan"})
+		return
+	}
+	if _, err := tx.CaseConversation.Delete().
+		Where(caseconversation.ValidationCaseIDIn(ownedCaseIDs...)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Pesan"})
+		return
+	}
+	if _, err := tx.ConsultationInvitation.Delete().
+		Where(consultationinvitation.ValidationCaseIDIn(ownedCaseIDs...)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Undangan Konsultasi"})
+		return
+	}
+	if _, err := tx.CaseClarification.Delete().
+		Where(caseclarification.ValidationCaseIDIn(ownedCaseIDs...)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Klarifikasi"})
+		return
+	}
+	if _, err := tx.ValidatorDeliverySLA.Delete().
+		Where(validatordeliverysla.ValidationCaseIDIn(ownedCaseIDs...)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Delivery SLA"})
+		return
+	}
+	if _, err := tx.FinalOfferMilestone.Delete().
+		Where(finaloffermilestone.HasFinalOfferWith(finaloffer.HasValidationCaseWith(validationcase.UserIDEQ(ownerUserID)))).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Final Offer"})
+		return
+	}
+	if _, err := tx.FinalOfferRevision.Delete().
+		Where(finalofferrevision.HasFinalOfferWith(finaloffer.HasValidationCaseWith(validationcase.UserIDEQ(ownerUserID)))).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Final Offer"})
+		return
+	}
 	if _, err := tx.FinalOffer.Delete().
 		Where(finaloffer.HasValidationCaseWith(validationcase.UserIDEQ(ownerUserID))).
 		Exec(ctx); err != nil {
@@ -739,6 +811,83 @@ func DeleteAccountHandler(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Consultation Request user"})
 		return
 	}
+	if _, err := tx.CaseMessage.Delete().
+		Where(casemessage.HasConversationWith(caseconversation.ValidatorUserIDEQ(ownerUserID))).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Pesan user"})
+		return
+	}
+	if _, err := tx.CaseConversation.Delete().
+		Where(caseconversation.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Pesan user"})
+		return
+	}
+	if _, err := tx.ValidatorMatchingAggregate.Delete().
+		Where(validatormatchingaggregate.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Agregat Validator user"})
+		return
+	}
+	if _, err := tx.ReputationEvent.Delete().
+		Where(reputationevent.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Riwayat Reputasi user"})
+		return
+	}
+	if _, err := tx.ValidatorReputation.Delete().
+		Where(validatorreputation.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Reputasi Validator user"})
+		return
+	}
+	if _, err := tx.ConsultationInvitation.Delete().
+		Where(consultationinvitation.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Undangan Konsultasi user"})
+		return
+	}
+	if _, err := tx.CaseClarification.Delete().
+		Where(caseclarification.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Klarifikasi user"})
+		return
+	}
+	if _, err := tx.ValidatorDeliverySLA.Delete().
+		Where(validatordeliverysla.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Delivery SLA user"})
+		return
+	}
+	if _, err := tx.GuaranteeSlash.Delete().
+		Where(guaranteeslash.ValidatorUserIDEQ(ownerUserID)).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Penalti Jaminan user"})
+		return
+	}
+	if _, err := tx.FinalOfferMilestone.Delete().
+		Where(finaloffermilestone.HasFinalOfferWith(finaloffer.ValidatorUserIDEQ(ownerUserID))).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Final Offer user"})
+		return
+	}
+	if _, err := tx.FinalOfferRevision.Delete().
+		Where(finalofferrevision.HasFinalOfferWith(finaloffer.ValidatorUserIDEQ(ownerUserID))).
+		Exec(ctx); err != nil {
+		rollbackWithLog()
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Gagal menghapus Final Offer user"})
+		return
+	}
 	if _, err := tx.FinalOffer.Delete().
 		Where(finaloffer.ValidatorUserIDEQ(ownerUserID)).
 		Exec(ctx); err != nil {
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"backend-gin/dto"
+	apperrors "backend-gin/errors"
+	"backend-gin/middleware"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccountSecurityHandler handles password and email changes for logged-in users
+// and the "this wasn't me" link from login alert emails
+type AccountSecurityHandler struct {
+	accountService *services.EntAccountService
+	alertService   *services.LoginAlertService
+	tokenLimiter   *middleware.RateLimiter
+}
+
+func NewAccountSecurityHandler(accountService *services.EntAccountService, alertService *services.LoginAlertService) *AccountSecurityHandler {
+	return &AccountSecurityHandler{
+		accountService: accountService,
+		alertService:   alertService,
+		tokenLimiter:   middleware.NewRateLimiter(10, time.Minute),
+	}
+}
+
+// currentJTI returns the access token ID of the calling session
+func currentJTI(c *gin.Context) string {
+	if v, ok := c.Get("claims"); ok {
+		if claims, ok := v.(*middleware.Claims); ok {
+			return claims.JTI
+		}
+	}
+	return ""
+}
+
+// POST /api/account/password (sudo required)
+func (h *AccountSecurityHandler) ChangePassword(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Password baru wajib diisi"))
+		return
+	}
+
+	if err := h.accountService.ChangePassword(c.Request.Context(), user.ID, currentJTI(c), req.NewPassword, c.ClientIP()); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Password berhasil diubah. Sesi di perangkat lain telah dikeluarkan.",
+	})
+}
+
+// POST /api/account/email (sudo required)
+func (h *AccountSecurityHandler) RequestEmailChange(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Email baru wajib diisi"))
+		return
+	}
+
+	if err := h.accountService.RequestEmailChange(c.Request.Context(), user.ID, req.NewEmail, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Link konfirmasi telah dikirim ke email baru. Email akun belum berubah sampai dikonfirmasi.",
+	})
+}
+
+// POST /api/account/email/confirm
+func (h *AccountSecurityHandler) ConfirmEmailChange(c *gin.Context) {
+	if !h.tokenLimiter.Allow(c.ClientIP()) {
+		handleError(c, apperrors.ErrTooManyRequests)
+		return
+	}
+
+	var req dto.EmailChangeTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Token wajib diisi"))
+		return
+	}
+
+	if err := h.accountService.ConfirmEmailChange(c.Request.Context(), req.Token, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Email berhasil diubah"})
+}
+
+// POST /api/account/email/revert
+func (h *AccountSecurityHandler) RevertEmailChange(c *gin.Context) {
+	if !h.tokenLimiter.Allow(c.ClientIP()) {
+		handleError(c, apperrors.ErrTooManyRequests)
+		return
+	}
+
+	var req dto.EmailChangeTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Token wajib diisi"))
+		return
+	}
+
+	if err := h.accountService.RevertEmailChange(c.Request.Context(), req.Token, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Email lama telah dipulihkan dan semua sesi dikeluarkan. Segera reset password Anda.",
+	})
+}
+
+// POST /api/account/security/not-me
+func (h *AccountSecurityHandler) ReportUnrecognizedLogin(c *gin.Context) {
+	if !h.tokenLimiter.Allow(c.ClientIP()) {
+		handleError(c, apperrors.ErrTooManyRequests)
+		return
+	}
+
+	var req dto.KillSwitchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Token wajib diisi"))
+		return
+	}
+
+	if err := h.alertService.KillSwitch(c.Request.Context(), req.Token, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Semua sesi telah dikeluarkan dan akun dikunci. Cek email Anda untuk mengatur ulang password.",
+	})
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend-gin/dto"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAccountLinkageHandler exposes the multi-account linkage graph under
+// /admin/users/:userId/linkage
+type AdminAccountLinkageHandler struct {
+	linkage *services.EntAccountLinkageService
+}
+
+func NewAdminAccountLinkageHandler(linkage *services.EntAccountLinkageService) *AdminAccountLinkageHandler {
+	return &AdminAccountLinkageHandler{linkage: linkage}
+}
+
+// GET /admin/users/:userId/linkage?depth=&max_nodes=&include_ips=
+func (h *AdminAccountLinkageHandler) GetLinkage(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	opts := services.LinkageOptions{IncludeIPs: true}
+	if raw := c.Query("depth"); raw != "" {
+		depth, err := strconv.Atoi(raw)
+		if err != nil || depth < 1 || depth > services.LinkageMaxDepth {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("depth harus antara 1 dan 4"))
+			return
+		}
+		opts.MaxDepth = depth
+	}
+	if raw := c.Query("max_nodes"); raw != "" {
+		maxNodes, err := strconv.Atoi(raw)
+		if err != nil || maxNodes < 2 || maxNodes > services.LinkageMaxNodes {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("max_nodes harus antara 2 dan 250"))
+			return
+		}
+		opts.MaxNodes = maxNodes
+	}
+	if raw := c.Query("include_ips"); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("include_ips harus true atau false"))
+			return
+		}
+		opts.IncludeIPs = v
+	}
+
+	graph, err := h.linkage.Cluster(c.Request.Context(), int(userID), opts)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, graph)
+}
+
+// POST /admin/users/:userId/linkage/lock
+func (h *AdminAccountLinkageHandler) LockCluster(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminLockClusterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("reason (minimal 5 karakter) dan user_ids wajib diisi"))
+		return
+	}
+	until, ok := lockUntil(c, req.Until, req.DurationHours)
+	if !ok {
+		return
+	}
+	opts := services.LinkageOptions{MaxDepth: req.Depth, MaxNodes: services.LinkageMaxNodes, IncludeIPs: true}
+	if req.IncludeIPs != nil {
+		opts.IncludeIPs = *req.IncludeIPs
+	}
+
+	result, err := h.linkage.LockCluster(c.Request.Context(), adminActor(c), int(userID), opts,
+		req.UserIDs, strings.TrimSpace(req.Reason), until)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Akun dalam klaster dikunci",
+		"result":  result,
+	})
+}
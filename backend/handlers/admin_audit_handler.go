@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+var auditHashPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// AdminAuditHandler exposes the admin audit trail under /admin/audit-logs
+type AdminAuditHandler struct {
+	auditService *services.EntAdminAuditService
+}
+
+func NewAdminAuditHandler(auditService *services.EntAdminAuditService) *AdminAuditHandler {
+	return &AdminAuditHandler{auditService: auditService}
+}
+
+// GET /admin/audit-logs?admin_id=&action=&target_type=&target_id=&request_id=&from=&to=&page=&pageSize=
+func (h *AdminAuditHandler) ListAuditLogs(c *gin.Context) {
+	filter := services.AdminAuditFilter{
+		Action:     strings.TrimSpace(c.Query("action")),
+		TargetType: strings.TrimSpace(c.Query("target_type")),
+		TargetID:   strings.TrimSpace(c.Query("target_id")),
+		RequestID:  strings.TrimSpace(c.Query("request_id")),
+	}
+	filter.Page, _ = strconv.Atoi(c.DefaultQuery("page", "1"))
+	filter.PageSize, _ = strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+
+	if raw := c.Query("admin_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id < 1 {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("admin_id harus berupa angka"))
+			return
+		}
+		filter.AdminID = id
+	}
+	var ok bool
+	if filter.From, ok = parseAuditTime(c, "from"); !ok {
+		return
+	}
+	if filter.To, ok = parseAuditTime(c, "to"); !ok {
+		return
+	}
+
+	logs, total, err := h.auditService.Search(c.Request.Context(), filter)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	items := make([]services.AdminAuditRecord, len(logs))
+	for i, l := range logs {
+		items[i] = services.NewAdminAuditRecord(l)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"logs":     items,
+		"total":    total,
+		"page":     filter.Page,
+		"pageSize": filter.PageSize,
+	})
+}
+
+// GET /admin/audit-logs/export?after_id=&prev_hash=
+// Streams hash-chained JSONL. Pass the last exported id and hash to continue a chain.
+func (h *AdminAuditHandler) ExportAuditLogs(c *gin.Context) {
+	afterID := 0
+	if raw := c.Query("after_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id < 0 {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("after_id harus berupa angka"))
+			return
+		}
+		afterID = id
+	}
+	prevHash := strings.ToLower(strings.TrimSpace(c.Query("prev_hash")))
+	if (afterID > 0) != (prevHash != "") {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("after_id dan prev_hash harus diisi bersamaan"))
+		return
+	}
+	if prevHash != "" && !auditHashPattern.MatchString(prevHash) {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("prev_hash harus berupa SHA-256 hex"))
+		return
+	}
+
+	filename := fmt.Sprintf("admin-audit-%s.jsonl", time.Now().UTC().Format("20060102T150405Z"))
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Status(http.StatusOK)
+
+	head, count, err := h.auditService.Export(c.Request.Context(), c.Writer, afterID, prevHash)
+	if err != nil {
+		// Headers are already sent; a truncated file fails verification on the client
+		logger.Error("Admin audit export aborted", zap.Error(err), zap.Int("lines_written", count))
+		return
+	}
+	logger.Info("Admin audit log exported",
+		zap.Uint("admin_id", c.GetUint("admin_id")),
+		zap.Int("after_id", afterID),
+		zap.Int("lines", count),
+		zap.String("head_hash", head))
+}
+
+func parseAuditTime(c *gin.Context, name string) (*time.Time, bool) {
+	raw := strings.TrimSpace(c.Query(name))
+	if raw == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails(name+" harus berformat RFC3339"))
+		return nil, false
+	}
+	return &t, true
+}
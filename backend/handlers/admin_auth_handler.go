@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"time"
+
+	"backend-gin/dto"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+)
+
+// AdminAuthHandler handles admin login (password + second factor), admin sessions,
+// second-factor enrollment and admin sudo under /admin/auth
+type AdminAuthHandler struct {
+	auth *services.EntAdminAuthService
+}
+
+func NewAdminAuthHandler(auth *services.EntAdminAuthService) *AdminAuthHandler {
+	return &AdminAuthHandler{auth: auth}
+}
+
+// adminSession returns the admin ID and session ID set by AdminAuthMiddleware
+func adminSession(c *gin.Context) (int, string) {
+	return int(c.GetUint("admin_id")), c.GetString("admin_session_id")
+}
+
+func (h *AdminAuthHandler) respondLogin(c *gin.Context, result *services.AdminLoginResult) {
+	if result.MFAToken != "" {
+		c.JSON(http.StatusOK, dto.AdminMFAChallengeResponse{
+			MFARequired: true,
+			MFAToken:    result.MFAToken,
+			MFAMethods:  result.MFAMethods,
+			ExpiresIn:   int(services.AdminMFAChallengeTTL.Seconds()),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, dto.AdminLoginResponse{
+		Token:     result.Token,
+		ExpiresAt: result.ExpiresAt,
+		Admin: dto.AdminInfo{
+			ID:          result.Admin.ID,
+			Email:       result.Admin.Email,
+			Name:        result.Admin.Name,
+			Roles:       result.Roles,
+			Permissions: result.Permissions,
+		},
+		MFAEnrollmentRequired: result.MFAEnrollmentRequired,
+	})
+}
+
+// POST /admin/auth/login
+func (h *AdminAuthHandler) Login(c *gin.Context) {
+	var req dto.AdminLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("Email dan password wajib diisi"))
+		return
+	}
+
+	result, err := h.auth.Login(c.Request.Context(), req.Email, req.Password, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	h.respondLogin(c, result)
+}
+
+// POST /admin/auth/login/totp
+func (h *AdminAuthHandler) LoginTOTP(c *gin.Context) {
+	var req dto.AdminLoginTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("mfa_token dan code wajib diisi"))
+		return
+	}
+
+	result, err := h.auth.LoginWithTOTP(c.Request.Context(), req.MFAToken, req.Code, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	h.respondLogin(c, result)
+}
+
+// POST /admin/auth/login/passkey/begin
+func (h *AdminAuthHandler) LoginPasskeyBegin(c *gin.Context) {
+	var req dto.AdminMFATokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("mfa_token wajib diisi"))
+		return
+	}
+
+	options, err := h.auth.BeginPasskeyLogin(c.Request.Context(), req.MFAToken)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"options": options})
+}
+
+// POST /admin/auth/login/passkey/finish
+func (h *AdminAuthHandler) LoginPasskeyFinish(c *gin.Context) {
+	var req dto.AdminLoginPasskeyFinishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("mfa_token dan credential wajib diisi"))
+		return
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(req.Credential))
+	if err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Format credential tidak valid"))
+		return
+	}
+
+	result, err := h.auth.FinishPasskeyLogin(c.Request.Context(), req.MFAToken, parsed, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	h.respondLogin(c, result)
+}
+
+// POST /admin/auth/logout
+func (h *AdminAuthHandler) Logout(c *gin.Context) {
+	_, sessionID := adminSession(c)
+	if err := h.auth.Logout(c.Request.Context(), sessionID); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Berhasil logout"})
+}
+
+// GET /admin/auth/sessions
+func (h *AdminAuthHandler) ListSessions(c *gin.Context) {
+	adminID, sessionID := adminSession(c)
+	sessions, err := h.auth.ListSessions(c.Request.Context(), adminID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	items := make([]dto.AdminSessionResponse, len(sessions))
+	for i, s := range sessions {
+		items[i] = dto.AdminSessionResponse{
+			ID:             s.ID,
+			IPAddress:      s.IPAddress,
+			UserAgent:      s.UserAgent,
+			MFAMethod:      s.MfaMethod,
+			Current:        s.SessionID == sessionID,
+			CreatedAt:      s.CreatedAt,
+			LastActivityAt: s.LastActivityAt,
+			ExpiresAt:      s.ExpiresAt,
+			SudoUntil:      s.SudoUntil,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"sessions": items, "count": len(items)})
+}
+
+// DELETE /admin/auth/sessions/:id
+func (h *AdminAuthHandler) RevokeSession(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	id, ok := parseUintParam(c, "id", "ID sesi")
+	if !ok {
+		return
+	}
+	if err := h.auth.RevokeSession(c.Request.Context(), adminID, int(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Sesi admin dicabut"})
+}
+
+// GET /admin/auth/mfa
+func (h *AdminAuthHandler) MFAStatus(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	status, err := h.auth.MFAStatus(c.Request.Context(), adminID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	passkeys := make([]dto.PasskeyResponse, len(status.Passkeys))
+	for i, pk := range status.Passkeys {
+		passkeys[i] = dto.PasskeyResponse{
+			ID:         uint(pk.ID),
+			Name:       pk.Name,
+			CreatedAt:  pk.CreatedAt,
+			LastUsedAt: pk.LastUsedAt,
+			Transports: pk.Transports,
+		}
+	}
+	c.JSON(http.StatusOK, dto.AdminMFAStatusResponse{
+		TOTPEnabled: status.TOTPEnabled,
+		Passkeys:    passkeys,
+	})
+}
+
+// POST /admin/auth/mfa/totp/setup
+func (h *AdminAuthHandler) BeginTOTPSetup(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	setup, err := h.auth.BeginTOTPSetup(c.Request.Context(), adminID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, setup)
+}
+
+// POST /admin/auth/mfa/totp/enable
+func (h *AdminAuthHandler) EnableTOTP(c *gin.Context) {
+	adminID, sessionID := adminSession(c)
+	var req dto.AdminTOTPCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("Kode TOTP wajib diisi"))
+		return
+	}
+
+	if err := h.auth.EnableTOTP(c.Request.Context(), adminID, sessionID, req.Code, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "TOTP admin diaktifkan"})
+}
+
+// DELETE /admin/auth/mfa/totp (sudo required)
+func (h *AdminAuthHandler) DisableTOTP(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	if err := h.auth.DisableTOTP(c.Request.Context(), adminID, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "TOTP admin dinonaktifkan"})
+}
+
+// POST /admin/auth/mfa/passkeys/register/begin
+func (h *AdminAuthHandler) BeginPasskeyRegistration(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	options, err := h.auth.BeginPasskeyRegistration(c.Request.Context(), adminID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"options": options})
+}
+
+// POST /admin/auth/mfa/passkeys/register/finish
+func (h *AdminAuthHandler) FinishPasskeyRegistration(c *gin.Context) {
+	adminID, sessionID := adminSession(c)
+	var req dto.AdminPasskeyRegisterFinishRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("credential wajib diisi"))
+		return
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(req.Credential))
+	if err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Format credential tidak valid"))
+		return
+	}
+
+	pk, err := h.auth.FinishPasskeyRegistration(c.Request.Context(), adminID, sessionID, req.Name, parsed, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.PasskeyResponse{
+		ID:         uint(pk.ID),
+		Name:       pk.Name,
+		CreatedAt:  pk.CreatedAt,
+		Transports: pk.Transports,
+	})
+}
+
+// DELETE /admin/auth/mfa/passkeys/:id (sudo required)
+func (h *AdminAuthHandler) DeletePasskey(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	id, ok := parseUintParam(c, "id", "ID passkey")
+	if !ok {
+		return
+	}
+	if err := h.auth.DeletePasskey(c.Request.Context(), adminID, int(id), c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Passkey admin dihapus"})
+}
+
+// POST /admin/auth/sudo
+func (h *AdminAuthHandler) Sudo(c *gin.Context) {
+	adminID, sessionID := adminSession(c)
+	var req dto.AdminSudoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("Password dan kode TOTP wajib diisi"))
+		return
+	}
+
+	until, err := h.auth.SudoWithTOTP(c.Request.Context(), adminID, sessionID, req.Password, req.Code, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	respondAdminSudo(c, until)
+}
+
+// POST /admin/auth/sudo/passkey/begin
+func (h *AdminAuthHandler) SudoPasskeyBegin(c *gin.Context) {
+	adminID, _ := adminSession(c)
+	options, err := h.auth.BeginPasskeySudo(c.Request.Context(), adminID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"options": options})
+}
+
+// POST /admin/auth/sudo/passkey/finish
+func (h *AdminAuthHandler) SudoPasskeyFinish(c *gin.Context) {
+	adminID, sessionID := adminSession(c)
+	var req dto.AdminSudoPasskeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("Password dan credential wajib diisi"))
+		return
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(req.Credential))
+	if err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Format credential tidak valid"))
+		return
+	}
+
+	until, err := h.auth.SudoWithPasskey(c.Request.Context(), adminID, sessionID, req.Password, parsed, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	respondAdminSudo(c, until)
+}
+
+func respondAdminSudo(c *gin.Context, until time.Time) {
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Mode sudo admin aktif",
+		"sudo_until": until,
+		"expires_in": int(time.Until(until).Seconds()),
+	})
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminDisputeEvidenceHandler exports the on-platform record of disputed Validation Cases,
+// including private owner–validator message transcripts, for admin dispute review.
+type AdminDisputeEvidenceHandler struct {
+	workflow *services.EntValidationCaseWorkflowService
+}
+
+func NewAdminDisputeEvidenceHandler(workflow *services.EntValidationCaseWorkflowService) *AdminDisputeEvidenceHandler {
+	return &AdminDisputeEvidenceHandler{workflow: workflow}
+}
+
+// GetEvidenceBundle handles GET /admin/validation-cases/:id/dispute-evidence
+func (h *AdminDisputeEvidenceHandler) GetEvidenceBundle(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+
+	bundle, err := h.workflow.GetDisputeEvidenceBundle(c.Request.Context(), validationCaseID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"evidence": bundle})
+}
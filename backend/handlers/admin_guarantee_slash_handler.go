@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminGuaranteeSlashHandler lets admins review guarantee slashes and decide appealed or failed ones.
+type AdminGuaranteeSlashHandler struct {
+	workflow *services.EntValidationCaseWorkflowService
+}
+
+func NewAdminGuaranteeSlashHandler(workflow *services.EntValidationCaseWorkflowService) *AdminGuaranteeSlashHandler {
+	return &AdminGuaranteeSlashHandler{workflow: workflow}
+}
+
+// GET /admin/guarantee-slashes?status=
+func (h *AdminGuaranteeSlashHandler) ListSlashes(c *gin.Context) {
+	items, err := h.workflow.ListGuaranteeSlashes(c.Request.Context(), c.Query("status"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"guarantee_slashes": items})
+}
+
+// POST /admin/guarantee-slashes/:id/resolve
+func (h *AdminGuaranteeSlashHandler) ResolveAppeal(c *gin.Context) {
+	slashID, ok := parseUintParam(c, "id", "guarantee_slash_id")
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Decision string `json:"decision" binding:"required"`
+		Note     string `json:"note" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	item, err := h.workflow.ResolveGuaranteeSlashAppeal(c.Request.Context(), adminActor(c), slashID, req.Decision, req.Note)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"guarantee_slash": item})
+}
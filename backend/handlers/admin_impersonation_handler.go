@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/dto"
+	"backend-gin/ent"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminImpersonationHandler issues and revokes read-only "view as user" tokens. Issuing
+// is recorded by the admin audit trail; each impersonated request is recorded by
+// AuthMiddleware.
+type AdminImpersonationHandler struct {
+	impersonation *services.EntAdminImpersonationService
+}
+
+func NewAdminImpersonationHandler(impersonation *services.EntAdminImpersonationService) *AdminImpersonationHandler {
+	return &AdminImpersonationHandler{impersonation: impersonation}
+}
+
+func impersonationResponse(s *ent.ImpersonationSession, now time.Time) dto.ImpersonationSessionResponse {
+	return dto.ImpersonationSessionResponse{
+		ID:               s.ID,
+		AdminID:          s.AdminID,
+		AdminEmail:       s.AdminEmail,
+		UserID:           s.UserID,
+		Reason:           s.Reason,
+		ExpiresAt:        s.ExpiresAt,
+		RevokedAt:        s.RevokedAt,
+		RevokedByAdminID: s.RevokedByAdminID,
+		LastUsedAt:       s.LastUsedAt,
+		RequestCount:     s.RequestCount,
+		Active:           s.RevokedAt == nil && s.ExpiresAt.After(now),
+		CreatedAt:        s.CreatedAt,
+	}
+}
+
+// POST /admin/users/:userId/impersonate
+func (h *AdminImpersonationHandler) Impersonate(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminImpersonateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("reason wajib diisi (minimal 5 karakter); duration_minutes antara 1 dan 60"))
+		return
+	}
+
+	ttl := time.Duration(req.DurationMinutes) * time.Minute
+	grant, err := h.impersonation.Start(c.Request.Context(), adminActor(c), int(userID), strings.TrimSpace(req.Reason), ttl)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, gin.H{
+		"access_token":  grant.AccessToken,
+		"token_type":    "Bearer",
+		"read_only":     true,
+		"expires_at":    grant.Session.ExpiresAt,
+		"impersonation": impersonationResponse(grant.Session, time.Now()),
+	})
+}
+
+// GET /admin/impersonations?admin_id=&user_id=&active=&page=&pageSize=
+func (h *AdminImpersonationHandler) ListImpersonations(c *gin.Context) {
+	var f services.ImpersonationFilter
+	if raw := c.Query("admin_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id < 1 {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("admin_id harus berupa angka"))
+			return
+		}
+		f.AdminID = id
+	}
+	if raw := c.Query("user_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id < 1 {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("user_id harus berupa angka"))
+			return
+		}
+		f.UserID = id
+	}
+	f.ActiveOnly, _ = strconv.ParseBool(c.DefaultQuery("active", "false"))
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	sessions, total, err := h.impersonation.List(c.Request.Context(), f, page, pageSize)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	now := time.Now()
+	items := make([]dto.ImpersonationSessionResponse, len(sessions))
+	for i, s := range sessions {
+		items[i] = impersonationResponse(s, now)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"impersonations": items,
+		"total":          total,
+		"page":           page,
+		"pageSize":       pageSize,
+	})
+}
+
+// DELETE /admin/impersonations/:id
+func (h *AdminImpersonationHandler) RevokeImpersonation(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "ID impersonasi")
+	if !ok {
+		return
+	}
+	sess, err := h.impersonation.Revoke(c.Request.Context(), adminActor(c), int(id))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Sesi impersonasi dicabut",
+		"impersonation": impersonationResponse(sess, time.Now()),
+	})
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/dto"
+	"backend-gin/ent"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminIPRuleHandler manages the rate limiter's persistent IP allow/block rules under
+// /admin/ip-rules. Changes reach every instance through Redis.
+type AdminIPRuleHandler struct {
+	rules *services.EntIPRuleService
+}
+
+func NewAdminIPRuleHandler(rules *services.EntIPRuleService) *AdminIPRuleHandler {
+	return &AdminIPRuleHandler{rules: rules}
+}
+
+func ipRuleResponse(r *ent.IPRule, now time.Time) dto.IPRuleResponse {
+	return dto.IPRuleResponse{
+		ID:               r.ID,
+		CIDR:             r.Cidr,
+		Action:           r.Action,
+		Reason:           r.Reason,
+		Source:           r.Source,
+		CreatedByAdminID: r.CreatedByAdminID,
+		CreatedByEmail:   r.CreatedByEmail,
+		ExpiresAt:        r.ExpiresAt,
+		Active:           r.ExpiresAt == nil || r.ExpiresAt.After(now),
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
+
+// GET /admin/ip-rules?action=allow|block&include_expired=&page=&pageSize=
+func (h *AdminIPRuleHandler) ListRules(c *gin.Context) {
+	action := strings.TrimSpace(c.Query("action"))
+	if action != "" && action != services.IPRuleAllow && action != services.IPRuleBlock {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("action harus allow atau block"))
+		return
+	}
+	includeExpired, _ := strconv.ParseBool(c.DefaultQuery("include_expired", "false"))
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	rules, total, err := h.rules.List(c.Request.Context(), action, includeExpired, page, pageSize)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	now := time.Now()
+	items := make([]dto.IPRuleResponse, len(rules))
+	for i, r := range rules {
+		items[i] = ipRuleResponse(r, now)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"rules":    items,
+		"total":    total,
+		"page":     page,
+		"pageSize": pageSize,
+	})
+}
+
+// POST /admin/ip-rules
+func (h *AdminIPRuleHandler) CreateRule(c *gin.Context) {
+	var req dto.CreateIPRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("cidr, action (allow/block) dan reason (minimal 5 karakter) wajib diisi"))
+		return
+	}
+
+	rule, err := h.rules.Create(c.Request.Context(), adminActor(c), services.IPRuleInput{
+		CIDR:      req.CIDR,
+		Action:    req.Action,
+		Reason:    strings.TrimSpace(req.Reason),
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Aturan IP dibuat",
+		"rule":    ipRuleResponse(rule, time.Now()),
+	})
+}
+
+// PATCH /admin/ip-rules/:id
+func (h *AdminIPRuleHandler) UpdateRule(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "ID aturan IP")
+	if !ok {
+		return
+	}
+	var req dto.UpdateIPRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("reason minimal 5 karakter"))
+		return
+	}
+	if req.Reason == nil && req.ExpiresAt == nil && !req.Permanent {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Tidak ada perubahan"))
+		return
+	}
+	if req.Reason != nil {
+		trimmed := strings.TrimSpace(*req.Reason)
+		req.Reason = &trimmed
+	}
+
+	rule, err := h.rules.Update(c.Request.Context(), int(id), services.IPRuleUpdate{
+		Reason:    req.Reason,
+		ExpiresAt: req.ExpiresAt,
+		Permanent: req.Permanent,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Aturan IP diperbarui",
+		"rule":    ipRuleResponse(rule, time.Now()),
+	})
+}
+
+// DELETE /admin/ip-rules/:id
+func (h *AdminIPRuleHandler) DeleteRule(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "ID aturan IP")
+	if !ok {
+		return
+	}
+	if err := h.rules.Delete(c.Request.Context(), int(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Aturan IP dihapus"})
+}
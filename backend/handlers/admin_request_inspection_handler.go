@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"backend-gin/dto"
+	"backend-gin/ent"
+	apperrors "backend-gin/errors"
+	"backend-gin/middleware"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminRequestInspectionHandler reports request inspection detector metrics and lets
+// admins mark recorded hits as false positives
+type AdminRequestInspectionHandler struct {
+	audit     *services.EntSecurityAuditService
+	inspector *middleware.RequestInspector
+}
+
+func NewAdminRequestInspectionHandler(audit *services.EntSecurityAuditService, inspector *middleware.RequestInspector) *AdminRequestInspectionHandler {
+	return &AdminRequestInspectionHandler{audit: audit, inspector: inspector}
+}
+
+// GET /admin/request-inspection?from=&to=
+// "instance" holds this instance's counters since startup, including suppressed
+// (allowlisted) hits; "recorded" aggregates SecurityEvents across instances.
+func (h *AdminRequestInspectionHandler) GetMetrics(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	f = services.SecurityEventAnalyticsRange(f, time.Now())
+
+	recorded, err := h.audit.RequestInspectionStats(c.Request.Context(), f)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"mode":     h.inspector.Mode(),
+		"from":     f.From,
+		"to":       f.To,
+		"instance": h.inspector.Stats(),
+		"recorded": recorded,
+	})
+}
+
+// POST /admin/security-events/:id/false-positive
+func (h *AdminRequestInspectionHandler) MarkFalsePositive(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "ID event")
+	if !ok {
+		return
+	}
+	var req dto.MarkFalsePositiveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("note wajib diisi, minimal 5 karakter"))
+		return
+	}
+
+	marker, err := h.audit.MarkInspectionFalsePositive(c.Request.Context(), adminActor(c), int(id), req.Note)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"event": securityEventResponses([]*ent.SecurityEvent{marker})[0]})
+}
@@ -0,0 +1,250 @@
+package handlers
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/dto"
+	"backend-gin/ent"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AdminSecurityEventHandler exposes SecurityEvent search, analytics and export under
+// /admin/security-events
+type AdminSecurityEventHandler struct {
+	audit *services.EntSecurityAuditService
+}
+
+func NewAdminSecurityEventHandler(audit *services.EntSecurityAuditService) *AdminSecurityEventHandler {
+	return &AdminSecurityEventHandler{audit: audit}
+}
+
+// parseSecurityEventFilter reads event_type, severity, ip (address or CIDR), email,
+// user_id, success, from and to. Writes the error response on failure.
+func parseSecurityEventFilter(c *gin.Context) (services.SecurityEventFilter, bool) {
+	f := services.SecurityEventFilter{
+		EventTypes: splitQueryList(c.Query("event_type")),
+		Severities: splitQueryList(c.Query("severity")),
+		Email:      strings.TrimSpace(c.Query("email")),
+	}
+
+	if raw := strings.TrimSpace(c.Query("ip")); raw != "" {
+		if strings.Contains(raw, "/") {
+			_, network, err := net.ParseCIDR(raw)
+			if err != nil {
+				handleError(c, apperrors.ErrInvalidInput.WithDetails("ip harus berupa alamat IP atau CIDR"))
+				return f, false
+			}
+			f.CIDR = network
+		} else {
+			ip := net.ParseIP(raw)
+			if ip == nil {
+				handleError(c, apperrors.ErrInvalidInput.WithDetails("ip harus berupa alamat IP atau CIDR"))
+				return f, false
+			}
+			f.IP = ip.String()
+		}
+	}
+	if raw := c.Query("user_id"); raw != "" {
+		id, err := strconv.Atoi(raw)
+		if err != nil || id < 1 {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("user_id harus berupa angka"))
+			return f, false
+		}
+		f.UserID = id
+	}
+	if raw := c.Query("success"); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("success harus true atau false"))
+			return f, false
+		}
+		f.Success = &v
+	}
+
+	var ok bool
+	if f.From, ok = parseAuditTime(c, "from"); !ok {
+		return f, false
+	}
+	if f.To, ok = parseAuditTime(c, "to"); !ok {
+		return f, false
+	}
+	return f, true
+}
+
+func securityEventResponses(events []*ent.SecurityEvent) []dto.SecurityEventResponse {
+	items := make([]dto.SecurityEventResponse, len(events))
+	for i, e := range events {
+		items[i] = dto.SecurityEventResponse{
+			ID:        e.ID,
+			EventType: e.EventType,
+			UserID:    e.UserID,
+			Email:     e.Email,
+			IPAddress: e.IPAddress,
+			UserAgent: e.UserAgent,
+			Success:   e.Success,
+			Severity:  e.Severity,
+			Details:   e.Details,
+			CreatedAt: e.CreatedAt,
+		}
+	}
+	return items
+}
+
+func splitQueryList(raw string) []string {
+	var out []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+func queryLimit(c *gin.Context, def, max int) int {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(def)))
+	if err != nil || limit < 1 || limit > max {
+		return def
+	}
+	return limit
+}
+
+// GET /admin/security-events?event_type=&severity=&ip=&email=&user_id=&success=&from=&to=&page=&pageSize=
+func (h *AdminSecurityEventHandler) ListEvents(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	events, total, err := h.audit.SearchEvents(c.Request.Context(), f, page, pageSize)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"events":   securityEventResponses(events),
+		"total":    total,
+		"page":     page,
+		"pageSize": pageSize,
+	})
+}
+
+// GET /admin/security-events/export?format=csv|jsonl&from=&to=&...
+// from and to are required; the range is capped at 93 days.
+func (h *AdminSecurityEventHandler) ExportEvents(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	format := strings.ToLower(c.DefaultQuery("format", services.SecurityEventExportCSV))
+	if err := services.ValidateSecurityEventExport(f, format); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == services.SecurityEventExportJSONL {
+		contentType = "application/x-ndjson"
+	}
+	filename := fmt.Sprintf("security-events-%s.%s", time.Now().UTC().Format("20060102T150405Z"), format)
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Status(http.StatusOK)
+
+	count, err := h.audit.ExportEvents(c.Request.Context(), c.Writer, f, format)
+	if err != nil {
+		// Headers are already sent; the client receives a truncated file
+		logger.Error("Security event export aborted", zap.Error(err), zap.Int("rows_written", count))
+		return
+	}
+	logger.Info("Security events exported",
+		zap.Uint("admin_id", c.GetUint("admin_id")),
+		zap.String("format", format),
+		zap.Int("rows", count))
+}
+
+// GET /admin/security-events/analytics/failed-logins?bucket=hour|day&...
+func (h *AdminSecurityEventHandler) FailedLogins(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	bucket := c.DefaultQuery("bucket", "hour")
+	if bucket != "hour" && bucket != "day" {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("bucket harus hour atau day"))
+		return
+	}
+	f = services.SecurityEventAnalyticsRange(f, time.Now())
+
+	buckets, err := h.audit.FailedLoginsOverTime(c.Request.Context(), f, bucket)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"from": f.From, "to": f.To, "bucket": bucket, "series": buckets})
+}
+
+// GET /admin/security-events/analytics/top-ips?limit=&...
+func (h *AdminSecurityEventHandler) TopIPs(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	f = services.SecurityEventAnalyticsRange(f, time.Now())
+
+	ips, err := h.audit.TopIPs(c.Request.Context(), f, queryLimit(c, 20, 100))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"from": f.From, "to": f.To, "ips": ips})
+}
+
+// GET /admin/security-events/analytics/token-reuse?limit=&...
+func (h *AdminSecurityEventHandler) TokenReuse(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	f = services.SecurityEventAnalyticsRange(f, time.Now())
+
+	incidents, err := h.audit.TokenReuseIncidents(c.Request.Context(), f, queryLimit(c, 50, 200))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"from": f.From, "to": f.To, "incidents": incidents})
+}
+
+// GET /admin/security-events/analytics/locked-accounts?...
+func (h *AdminSecurityEventHandler) LockedAccounts(c *gin.Context) {
+	f, ok := parseSecurityEventFilter(c)
+	if !ok {
+		return
+	}
+	f = services.SecurityEventAnalyticsRange(f, time.Now())
+
+	days, err := h.audit.AccountsLockedPerDay(c.Request.Context(), f)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"from": f.From, "to": f.To, "series": days})
+}
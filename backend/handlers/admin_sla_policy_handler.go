@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"backend-gin/dto"
+	"backend-gin/ent"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminSLAPolicyHandler manages owner-response SLA policies under /admin/sla-policies.
+// Consultation requests snapshot the matching policy, so changes apply to new requests only.
+type AdminSLAPolicyHandler struct {
+	policies *services.EntSLAPolicyService
+}
+
+func NewAdminSLAPolicyHandler(policies *services.EntSLAPolicyService) *AdminSLAPolicyHandler {
+	return &AdminSLAPolicyHandler{policies: policies}
+}
+
+func slaPolicyResponse(p *ent.SLAPolicy) dto.SLAPolicyResponse {
+	reminders := p.ReminderHours
+	if reminders == nil {
+		reminders = []int{}
+	}
+	return dto.SLAPolicyResponse{
+		ID:                   p.ID,
+		Name:                 p.Name,
+		SensitivityLevel:     p.SensitivityLevel,
+		CategoryID:           p.CategoryID,
+		OwnerResponseHours:   p.OwnerResponseHours,
+		ReminderHours:        reminders,
+		BusinessHoursOnly:    p.BusinessHoursOnly,
+		BusinessDayStartHour: p.BusinessDayStartHour,
+		BusinessDayEndHour:   p.BusinessDayEndHour,
+		Timezone:             p.Timezone,
+		IsActive:             p.IsActive,
+		CreatedByAdminID:     p.CreatedByAdminID,
+		UpdatedByAdminID:     p.UpdatedByAdminID,
+		CreatedAt:            p.CreatedAt,
+		UpdatedAt:            p.UpdatedAt,
+	}
+}
+
+func bindSLAPolicyRequest(c *gin.Context) (services.SLAPolicyInput, bool) {
+	var req dto.SLAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("name, owner_response_hours dan sensitivity_level (S0-S3 atau kosong) tidak valid"))
+		return services.SLAPolicyInput{}, false
+	}
+	in := services.SLAPolicyInput{
+		Name:                 req.Name,
+		SensitivityLevel:     req.SensitivityLevel,
+		CategoryID:           req.CategoryID,
+		OwnerResponseHours:   req.OwnerResponseHours,
+		ReminderHours:        req.ReminderHours,
+		BusinessHoursOnly:    req.BusinessHoursOnly,
+		BusinessDayStartHour: 9,
+		BusinessDayEndHour:   17,
+		Timezone:             req.Timezone,
+		IsActive:             true,
+	}
+	if req.BusinessDayStartHour != nil {
+		in.BusinessDayStartHour = *req.BusinessDayStartHour
+	}
+	if req.BusinessDayEndHour != nil {
+		in.BusinessDayEndHour = *req.BusinessDayEndHour
+	}
+	if req.IsActive != nil {
+		in.IsActive = *req.IsActive
+	}
+	return in, true
+}
+
+// GET /admin/sla-policies?include_inactive=
+func (h *AdminSLAPolicyHandler) ListPolicies(c *gin.Context) {
+	includeInactive, _ := strconv.ParseBool(c.DefaultQuery("include_inactive", "false"))
+	policies, err := h.policies.List(c.Request.Context(), includeInactive)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	items := make([]dto.SLAPolicyResponse, len(policies))
+	for i, p := range policies {
+		items[i] = slaPolicyResponse(p)
+	}
+	c.JSON(http.StatusOK, gin.H{"policies": items})
+}
+
+// GET /admin/sla-policies/holidays
+func (h *AdminSLAPolicyHandler) ListHolidays(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"holidays": services.SLAHolidayCalendar().Holidays()})
+}
+
+// POST /admin/sla-policies
+func (h *AdminSLAPolicyHandler) CreatePolicy(c *gin.Context) {
+	in, ok := bindSLAPolicyRequest(c)
+	if !ok {
+		return
+	}
+	policy, err := h.policies.Create(c.Request.Context(), adminActor(c), in)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Kebijakan SLA dibuat",
+		"policy":  slaPolicyResponse(policy),
+	})
+}
+
+// PUT /admin/sla-policies/:id
+func (h *AdminSLAPolicyHandler) UpdatePolicy(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "ID kebijakan SLA")
+	if !ok {
+		return
+	}
+	in, ok := bindSLAPolicyRequest(c)
+	if !ok {
+		return
+	}
+	policy, err := h.policies.Update(c.Request.Context(), adminActor(c), int(id), in)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Kebijakan SLA diperbarui",
+		"policy":  slaPolicyResponse(policy),
+	})
+}
+
+// DELETE /admin/sla-policies/:id
+func (h *AdminSLAPolicyHandler) DeletePolicy(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "ID kebijakan SLA")
+	if !ok {
+		return
+	}
+	if err := h.policies.Delete(c.Request.Context(), int(id)); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Kebijakan SLA dihapus"})
+}
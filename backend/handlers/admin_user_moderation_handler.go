@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/dto"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminUserModerationHandler handles moderation actions on user accounts under
+// /admin/users/:userId. Requests are recorded by the admin audit trail.
+type AdminUserModerationHandler struct {
+	moderation *services.EntAdminUserModerationService
+}
+
+func NewAdminUserModerationHandler(moderation *services.EntAdminUserModerationService) *AdminUserModerationHandler {
+	return &AdminUserModerationHandler{moderation: moderation}
+}
+
+func adminActor(c *gin.Context) services.AdminActor {
+	return services.AdminActor{
+		ID:        int(c.GetUint("admin_id")),
+		Email:     c.GetString("admin_email"),
+		IP:        c.ClientIP(),
+		UserAgent: c.GetHeader("User-Agent"),
+	}
+}
+
+func bindModerationRequest(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("Alasan (reason) wajib diisi, minimal 5 karakter"))
+		return false
+	}
+	return true
+}
+
+// lockUntil resolves the lock end from until or duration_hours. The zero time means
+// indefinite. Writes the error response on failure.
+func lockUntil(c *gin.Context, until *time.Time, durationHours int) (time.Time, bool) {
+	switch {
+	case until != nil && durationHours > 0:
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Gunakan until atau duration_hours, bukan keduanya"))
+		return time.Time{}, false
+	case until != nil:
+		if !until.After(time.Now()) {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("until harus di masa depan"))
+			return time.Time{}, false
+		}
+		return *until, true
+	case durationHours > 0:
+		return time.Now().Add(time.Duration(durationHours) * time.Hour), true
+	}
+	return time.Time{}, true
+}
+
+// POST /admin/users/:userId/lock
+func (h *AdminUserModerationHandler) LockUser(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminLockUserRequest
+	if !bindModerationRequest(c, &req) {
+		return
+	}
+
+	until, ok := lockUntil(c, req.Until, req.DurationHours)
+	if !ok {
+		return
+	}
+
+	lockedUntil, err := h.moderation.LockUser(c.Request.Context(), adminActor(c), int(userID), strings.TrimSpace(req.Reason), until)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "Akun pengguna dikunci dan seluruh sesi dicabut",
+		"locked_until": lockedUntil,
+	})
+}
+
+// POST /admin/users/:userId/unlock
+func (h *AdminUserModerationHandler) UnlockUser(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminModerationRequest
+	if !bindModerationRequest(c, &req) {
+		return
+	}
+
+	if err := h.moderation.UnlockUser(c.Request.Context(), adminActor(c), int(userID), strings.TrimSpace(req.Reason)); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Akun pengguna dibuka"})
+}
+
+// POST /admin/users/:userId/sessions/revoke
+func (h *AdminUserModerationHandler) RevokeSessions(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminModerationRequest
+	if !bindModerationRequest(c, &req) {
+		return
+	}
+
+	revoked, err := h.moderation.RevokeSessions(c.Request.Context(), adminActor(c), int(userID), strings.TrimSpace(req.Reason))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":          "Seluruh sesi pengguna dicabut",
+		"sessions_revoked": revoked,
+	})
+}
+
+// POST /admin/users/:userId/devices/block
+func (h *AdminUserModerationHandler) BlockDevices(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminBlockDevicesRequest
+	if !bindModerationRequest(c, &req) {
+		return
+	}
+
+	blocked, err := h.moderation.BlockUserDevices(c.Request.Context(), adminActor(c), int(userID), req.FingerprintHashes, strings.TrimSpace(req.Reason))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":            "Perangkat pengguna diblokir",
+		"fingerprint_hashes": blocked,
+	})
+}
+
+// POST /admin/users/:userId/totp/reset
+func (h *AdminUserModerationHandler) ResetTOTP(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	var req dto.AdminResetTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrValidationFailed.WithDetails("reason dan identity_check wajib diisi, minimal 5 karakter"))
+		return
+	}
+
+	if err := h.moderation.ResetTOTP(c.Request.Context(), adminActor(c), int(userID),
+		strings.TrimSpace(req.Reason), strings.TrimSpace(req.IdentityCheck)); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "2FA pengguna direset. Pengguna dapat login dengan password dan mendaftar ulang."})
+}
+
+// GET /admin/users/:userId/security-events?event_type=&page=&pageSize=
+func (h *AdminUserModerationHandler) ListSecurityEvents(c *gin.Context) {
+	userID, ok := parseUintParam(c, "userId", "ID user")
+	if !ok {
+		return
+	}
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "50"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 50
+	}
+
+	events, total, err := h.moderation.ListSecurityEvents(c.Request.Context(), int(userID),
+		strings.TrimSpace(c.Query("event_type")), page, pageSize)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events":   securityEventResponses(events),
+		"total":    total,
+		"page":     page,
+		"pageSize": pageSize,
+	})
+}
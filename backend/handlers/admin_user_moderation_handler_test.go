@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Input validation runs before the moderation service is touched, so a nil service is enough
+func TestAdminUserModerationHandler_RejectsInvalidInput(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := NewAdminUserModerationHandler(nil)
+
+	router := gin.New()
+	router.POST("/users/:userId/lock", h.LockUser)
+	router.POST("/users/:userId/unlock", h.UnlockUser)
+	router.POST("/users/:userId/totp/reset", h.ResetTOTP)
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{"bad user id", "/users/abc/lock", `{"reason":"spam bot"}`},
+		{"missing reason", "/users/7/unlock", `{}`},
+		{"short reason", "/users/7/lock", `{"reason":"x"}`},
+		{"until and duration", "/users/7/lock", `{"reason":"spam bot","until":"2099-01-01T00:00:00Z","duration_hours":24}`},
+		{"until in the past", "/users/7/lock", `{"reason":"spam bot","until":"2020-01-01T00:00:00Z"}`},
+		{"duration too long", "/users/7/lock", `{"reason":"spam bot","duration_hours":100000}`},
+		{"reset without identity check", "/users/7/totp/reset", `{"reason":"lost phone"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
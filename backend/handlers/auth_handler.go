@@ -115,6 +115,21 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	// Risk engine requires a stronger factor (passkey or email code) for this login
+	if response.RequiresStepUp {
+		c.JSON(http.StatusOK, gin.H{
+			"requires_step_up": true,
+			"step_up_methods":  response.StepUpMethods,
+			"step_up_token":    response.StepUpToken,
+			"user": gin.H{
+				"email":     response.Email,
+				"username":  response.Username,
+				"full_name": response.FullName,
+			},
+		})
+		return
+	}
+
 	if response.RefreshToken != "" {
 		setRefreshTokenCookie(c, response.RefreshToken)
 	}
@@ -413,6 +428,47 @@ func (h *AuthHandler) LoginTOTP(c *gin.Context) {
 	})
 }
 
+// POST /api/auth/login/step-up/email-code
+func (h *AuthHandler) LoginStepUpEmailCode(c *gin.Context) {
+	if !h.loginLimiter.Allow(c.ClientIP()) {
+		handleError(c, apperrors.ErrTooManyRequests)
+		return
+	}
+
+	var req struct {
+		StepUpToken       string `json:"step_up_token" binding:"required"`
+		Code              string `json:"code" binding:"required"`
+		DeviceFingerprint string `json:"device_fingerprint"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Debug("Invalid step-up login request", zap.Error(err))
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Format request tidak valid"))
+		return
+	}
+
+	response, err := h.authService.CompleteStepUpWithEmailCode(c.Request.Context(), req.StepUpToken, req.Code, c.ClientIP(), c.GetHeader("User-Agent"), req.DeviceFingerprint)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	if response.RefreshToken != "" {
+		setRefreshTokenCookie(c, response.RefreshToken)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token":  response.AccessToken,
+		"refresh_token": response.RefreshToken,
+		"expires_in":    response.ExpiresIn,
+		"token_type":    "Bearer",
+		"user": gin.H{
+			"email":     response.Email,
+			"username":  response.Username,
+			"full_name": response.FullName,
+		},
+	})
+}
+
 // POST /api/auth/login/backup-code
 func (h *AuthHandler) LoginBackupCode(c *gin.Context) {
 	if !h.loginLimiter.Allow(c.ClientIP()) {
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+
+	"backend-gin/dto"
+	apperrors "backend-gin/errors"
+	"backend-gin/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceHandler exposes the caller's recorded devices under /api/account/devices
+type DeviceHandler struct {
+	deviceService *services.EntDeviceService
+}
+
+func NewDeviceHandler(deviceService *services.EntDeviceService) *DeviceHandler {
+	return &DeviceHandler{deviceService: deviceService}
+}
+
+// GET /api/account/devices
+func (h *DeviceHandler) ListDevices(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	devices, err := h.deviceService.ListDevices(c.Request.Context(), user.ID, currentJTI(c))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	response := make([]dto.DeviceResponse, len(devices))
+	for i, d := range devices {
+		m := d.Mapping
+		item := dto.DeviceResponse{
+			ID:             uint(m.ID),
+			Browser:        d.UserAgent.Browser,
+			OS:             d.UserAgent.OS,
+			DeviceType:     d.UserAgent.DeviceType,
+			LastIP:         m.LastIP,
+			Trusted:        m.Trusted,
+			TrustedAt:      m.TrustedAt,
+			Current:        d.Current,
+			ActiveSessions: d.ActiveSessions,
+			FirstSeenAt:    m.FirstSeenAt,
+			LastSeenAt:     m.LastSeenAt,
+		}
+		if m.Name != nil {
+			item.Name = *m.Name
+		}
+		if d.Location != nil {
+			item.City = d.Location.City
+			item.Country = d.Location.CountryName
+			item.CountryCode = d.Location.CountryCode
+		}
+		response[i] = item
+	}
+
+	c.JSON(http.StatusOK, dto.DeviceListResponse{
+		Devices: response,
+		Count:   len(response),
+	})
+}
+
+// PUT /api/account/devices/:id/name
+func (h *DeviceHandler) RenameDevice(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+	deviceID, ok := parseUintParam(c, "id", "ID perangkat")
+	if !ok {
+		return
+	}
+
+	var req dto.DeviceRenameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidInput.WithDetails("Nama perangkat harus 1-64 karakter"))
+		return
+	}
+
+	if err := h.deviceService.RenameDevice(c.Request.Context(), user.ID, int(deviceID), req.Name); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Nama perangkat diperbarui"})
+}
+
+// POST /api/account/devices/:id/trust (sudo required)
+func (h *DeviceHandler) TrustDevice(c *gin.Context) {
+	h.setTrusted(c, true, "Perangkat ditandai tepercaya")
+}
+
+// DELETE /api/account/devices/:id/trust
+func (h *DeviceHandler) UntrustDevice(c *gin.Context) {
+	h.setTrusted(c, false, "Perangkat tidak lagi tepercaya")
+}
+
+func (h *DeviceHandler) setTrusted(c *gin.Context, trusted bool, message string) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+	deviceID, ok := parseUintParam(c, "id", "ID perangkat")
+	if !ok {
+		return
+	}
+
+	if err := h.deviceService.SetDeviceTrusted(c.Request.Context(), user.ID, int(deviceID), trusted, c.ClientIP(), c.GetHeader("User-Agent")); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": message})
+}
+
+// DELETE /api/account/devices/:id
+func (h *DeviceHandler) RemoveDevice(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+	deviceID, ok := parseUintParam(c, "id", "ID perangkat")
+	if !ok {
+		return
+	}
+
+	revoked, err := h.deviceService.RemoveDevice(c.Request.Context(), user.ID, int(deviceID), c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":          "Perangkat dihapus dan sesinya dikeluarkan",
+		"sessions_revoked": revoked,
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"backend-gin/ent/marketpurchaseorder"
 	"backend-gin/ent/marketpurchaseorderstep"
 	applog "backend-gin/logger"
+	"backend-gin/middleware"
 	"backend-gin/services"
 
 	"github.com/gin-gonic/gin"
@@ -588,6 +589,11 @@ func (h *LZTMarketHandler) GetMyPublicChatGPTOrderDetail(c *gin.Context) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
 		return
 	}
+	// Delivery holds the purchased account's login details; support staff viewing as the
+	// user see the order without them
+	if _, impersonated := middleware.ImpersonationFromContext(c); impersonated {
+		order.Delivery = nil
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"order": order,
@@ -35,6 +35,7 @@ type passkeyService interface {
 
 type passkeyAuthService interface {
 	LoginWithPasskey(ctx context.Context, u *ent.User, ipAddress, userAgent, deviceFingerprint string) (*services.LoginResponse, error)
+	CompleteStepUpWithPasskey(ctx context.Context, stepUpToken string, u *ent.User, ipAddress, userAgent, deviceFingerprint string) (*services.LoginResponse, error)
 }
 
 type passkeyWalletStatusClient interface {
@@ -480,6 +481,7 @@ func (h *PasskeyHandler) FinishLogin(c *gin.Context) {
 		SessionID         string          `json:"session_id"`
 		Credential        json.RawMessage `json:"credential"`
 		DeviceFingerprint string          `json:"device_fingerprint"`
+		StepUpToken       string          `json:"step_up_token"` // Set when completing a risk step-up of a password login
 	}
 	if err := c.ShouldBindJSON(&rawRequest); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
@@ -545,7 +547,12 @@ func (h *PasskeyHandler) FinishLogin(c *gin.Context) {
 	)
 
 	// Generate tokens using auth service with Ent user
-	response, err := h.authService.LoginWithPasskey(c.Request.Context(), entUser, clientIP, userAgent, rawRequest.DeviceFingerprint)
+	var response *services.LoginResponse
+	if rawRequest.StepUpToken != "" {
+		response, err = h.authService.CompleteStepUpWithPasskey(ctx, rawRequest.StepUpToken, entUser, clientIP, userAgent, rawRequest.DeviceFingerprint)
+	} else {
+		response, err = h.authService.LoginWithPasskey(ctx, entUser, clientIP, userAgent, rawRequest.DeviceFingerprint)
+	}
 	if err != nil {
 		handleError(c, err)
 		return
@@ -73,6 +73,10 @@ func (f *fakeAuthService) LoginWithPasskey(ctx context.Context, u *ent.User, ipA
 	return &services.LoginResponse{}, nil
 }
 
+func (f *fakeAuthService) CompleteStepUpWithPasskey(ctx context.Context, stepUpToken string, u *ent.User, ipAddress, userAgent, deviceFingerprint string) (*services.LoginResponse, error) {
+	return &services.LoginResponse{}, nil
+}
+
 type fakeWalletStatusClient struct {
 	status       *services.FeaturePinStatusResult
 	err          error
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	apperrors "backend-gin/errors"
@@ -129,6 +130,236 @@ func (h *ValidationCaseWorkflowHandler) RejectConsultationRequest(c *gin.Context
 	c.JSON(http.StatusOK, gin.H{"status": "ok"})
 }
 
+func (h *ValidationCaseWorkflowHandler) InviteValidator(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		ValidatorUserID uint   `json:"validator_user_id"`
+		Username        string `json:"username"`
+		Message         string `json:"message"`
+		ExpiresInHours  int    `json:"expires_in_hours"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	item, err := h.workflow.InviteValidator(c.Request.Context(), validationCaseID, uint(user.ID), services.ConsultationInvitationInput{
+		ValidatorUserID: req.ValidatorUserID,
+		Username:        req.Username,
+		Message:         req.Message,
+		ExpiresInHours:  req.ExpiresInHours,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"invitation": item})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListInvitations(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListConsultationInvitations(c.Request.Context(), validationCaseID, uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"invitations": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) SuggestValidators(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	items, err := h.workflow.SuggestValidatorsForInvitation(c.Request.Context(), validationCaseID, uint(user.ID), limit)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"suggestions": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) RecommendValidators(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var filter services.ValidatorRecommendationFilter
+	if raw := strings.TrimSpace(c.Query("min_stake")); raw != "" {
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("min_stake tidak valid"))
+			return
+		}
+		filter.MinStake = v
+	}
+	if raw := strings.TrimSpace(c.Query("max_active_consultations")); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			handleError(c, apperrors.ErrInvalidInput.WithDetails("max_active_consultations tidak valid"))
+			return
+		}
+		filter.MaxActiveConsultations = v
+	}
+	filter.Limit, _ = strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	items, err := h.workflow.RecommendValidators(c.Request.Context(), validationCaseID, uint(user.ID), filter)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"validators": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListMyInvitations(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListMyConsultationInvitations(c.Request.Context(), uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"invitations": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) AcceptInvitation(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	invitationID, ok := parseUintParam(c, "invitationId", "invitation_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	requestID, err := h.workflow.AcceptConsultationInvitation(c.Request.Context(), validationCaseID, uint(user.ID), invitationID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"consultation_request_id": requestID})
+}
+
+func (h *ValidationCaseWorkflowHandler) DeclineInvitation(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	invitationID, ok := parseUintParam(c, "invitationId", "invitation_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.workflow.DeclineConsultationInvitation(c.Request.Context(), validationCaseID, uint(user.ID), invitationID); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) RevokeInvitation(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	invitationID, ok := parseUintParam(c, "invitationId", "invitation_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	if err := h.workflow.RevokeConsultationInvitation(c.Request.Context(), validationCaseID, uint(user.ID), invitationID); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListMyGuaranteeSlashes(c *gin.Context) {
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListMyGuaranteeSlashes(c.Request.Context(), uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"guarantee_slashes": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) AppealGuaranteeSlash(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	slashID, ok := parseUintParam(c, "slashId", "guarantee_slash_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	item, err := h.workflow.AppealGuaranteeSlash(c.Request.Context(), validationCaseID, slashID, uint(user.ID), req.Reason)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"guarantee_slash": item})
+}
+
 func (h *ValidationCaseWorkflowHandler) RevealContact(c *gin.Context) {
 	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
 	if !ok {
@@ -139,12 +370,212 @@ func (h *ValidationCaseWorkflowHandler) RevealContact(c *gin.Context) {
 		return
 	}
 
-	telegram, err := h.workflow.RevealOwnerTelegramContact(c.Request.Context(), validationCaseID, uint(user.ID))
+	telegram, err := h.workflow.RevealOwnerTelegramContact(c.Request.Context(), validationCaseID, uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"telegram": telegram})
+}
+
+func (h *ValidationCaseWorkflowHandler) PostClarification(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Kind string `json:"kind"`
+		Body string `json:"body" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	item, err := h.workflow.PostClarification(c.Request.Context(), validationCaseID, uint(user.ID), req.Kind, req.Body)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"clarification": item})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListClarifications(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListClarifications(c.Request.Context(), validationCaseID, uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"clarifications": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) RespondClarification(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	clarificationID, ok := parseUintParam(c, "clarificationId", "clarification_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Answer   string `json:"answer"`
+		Decision string `json:"decision"`
+		Share    bool   `json:"share"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	item, err := h.workflow.RespondClarification(c.Request.Context(), validationCaseID, uint(user.ID), clarificationID, services.ClarificationResponse{
+		Answer:   req.Answer,
+		Decision: req.Decision,
+		Share:    req.Share,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"clarification": item})
+}
+
+func (h *ValidationCaseWorkflowHandler) UpdateContactSettings(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TelegramRevealDisabled *bool `json:"telegram_reveal_disabled" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	if err := h.workflow.SetTelegramRevealDisabled(c.Request.Context(), validationCaseID, uint(user.ID), *req.TelegramRevealDisabled); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListConversations(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListCaseConversations(c.Request.Context(), validationCaseID, uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"conversations": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListMessages(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	validatorUserID, ok := parseUintParam(c, "validatorId", "validator_user_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListCaseMessages(c.Request.Context(), validationCaseID, uint(user.ID), validatorUserID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"messages": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) SendMessage(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	validatorUserID, ok := parseUintParam(c, "validatorId", "validator_user_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Body                  string   `json:"body"`
+		AttachmentDocumentIDs []string `json:"attachment_document_ids"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	authHeader := c.GetHeader("Authorization")
+	item, err := h.workflow.SendCaseMessage(c.Request.Context(), validationCaseID, uint(user.ID), validatorUserID, req.Body, req.AttachmentDocumentIDs, authHeader)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": item})
+}
+
+func (h *ValidationCaseWorkflowHandler) MarkMessagesRead(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	validatorUserID, ok := parseUintParam(c, "validatorId", "validator_user_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	marked, err := h.workflow.MarkCaseMessagesRead(c.Request.Context(), validationCaseID, uint(user.ID), validatorUserID)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"telegram": telegram})
+	c.JSON(http.StatusOK, gin.H{"marked": marked})
 }
 
 func (h *ValidationCaseWorkflowHandler) SubmitFinalOffer(c *gin.Context) {
@@ -158,15 +589,16 @@ func (h *ValidationCaseWorkflowHandler) SubmitFinalOffer(c *gin.Context) {
 	}
 
 	var req struct {
-		HoldHours int    `json:"hold_hours"`
-		Terms     string `json:"terms"`
+		HoldHours  int                                `json:"hold_hours"`
+		Terms      string                             `json:"terms"`
+		Milestones []services.FinalOfferMilestoneSpec `json:"milestones"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
 		return
 	}
 
-	id, err := h.workflow.SubmitFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), req.HoldHours, req.Terms)
+	id, err := h.workflow.SubmitFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), req.HoldHours, req.Terms, req.Milestones)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -206,7 +638,16 @@ func (h *ValidationCaseWorkflowHandler) AcceptFinalOffer(c *gin.Context) {
 		return
 	}
 
-	draft, err := h.workflow.AcceptFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), offerID)
+	// Body is optional for offers that were never revised.
+	var req struct {
+		Revision int `json:"revision"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	draft, err := h.workflow.AcceptFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), offerID, req.Revision)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -214,6 +655,134 @@ func (h *ValidationCaseWorkflowHandler) AcceptFinalOffer(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"escrow_draft": draft})
 }
 
+type finalOfferProposalRequest struct {
+	Amount    int64  `json:"amount" binding:"required"`
+	HoldHours int    `json:"hold_hours"`
+	Terms     string `json:"terms"`
+	Note      string `json:"note"`
+	// Omitted keeps the current milestone plan; [] removes it.
+	Milestones []services.FinalOfferMilestoneSpec `json:"milestones"`
+	Revision   int                                `json:"revision"`
+}
+
+func (r finalOfferProposalRequest) proposal() services.FinalOfferProposal {
+	return services.FinalOfferProposal{
+		Amount:           r.Amount,
+		HoldHours:        r.HoldHours,
+		Terms:            r.Terms,
+		Note:             r.Note,
+		Milestones:       r.Milestones,
+		ExpectedRevision: r.Revision,
+	}
+}
+
+func (h *ValidationCaseWorkflowHandler) CounterFinalOffer(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	offerID, ok := parseUintParam(c, "offerId", "final_offer_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req finalOfferProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	revision, err := h.workflow.CounterFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), offerID, req.proposal())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revision": revision})
+}
+
+func (h *ValidationCaseWorkflowHandler) ReviseFinalOffer(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	offerID, ok := parseUintParam(c, "offerId", "final_offer_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req finalOfferProposalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	revision, err := h.workflow.ReviseFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), offerID, req.proposal())
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revision": revision})
+}
+
+func (h *ValidationCaseWorkflowHandler) WithdrawFinalOffer(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	offerID, ok := parseUintParam(c, "offerId", "final_offer_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	if err := h.workflow.WithdrawFinalOffer(c.Request.Context(), validationCaseID, uint(user.ID), offerID, req.Reason); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListFinalOfferRevisions(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	offerID, ok := parseUintParam(c, "offerId", "final_offer_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListFinalOfferRevisions(c.Request.Context(), validationCaseID, uint(user.ID), offerID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"revisions": items})
+}
+
 func (h *ValidationCaseWorkflowHandler) ConfirmLockFunds(c *gin.Context) {
 	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
 	if !ok {
@@ -408,3 +977,151 @@ func (h *ValidationCaseWorkflowHandler) GetCaseLog(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, gin.H{"case_log": items})
 }
+
+func (h *ValidationCaseWorkflowHandler) ListMilestones(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListMilestones(c.Request.Context(), validationCaseID, uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"milestones": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) ListValidatorDeliverySLAs(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	items, err := h.workflow.ListValidatorDeliverySLAs(c.Request.Context(), validationCaseID, uint(user.ID))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"delivery_slas": items})
+}
+
+func (h *ValidationCaseWorkflowHandler) ConfirmMilestoneLockFunds(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	milestoneID, ok := parseUintParam(c, "milestoneId", "milestone_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		TransferID string `json:"transfer_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	authHeader := c.GetHeader("Authorization")
+	if err := h.workflow.ConfirmMilestoneLockFunds(c.Request.Context(), validationCaseID, uint(user.ID), milestoneID, req.TransferID, authHeader); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) SubmitMilestoneArtifact(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	milestoneID, ok := parseUintParam(c, "milestoneId", "milestone_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		DocumentID string `json:"document_id"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	authHeader := c.GetHeader("Authorization")
+	if err := h.workflow.SubmitMilestoneArtifact(c.Request.Context(), validationCaseID, uint(user.ID), milestoneID, req.DocumentID, authHeader); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) MarkMilestoneEscrowReleased(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	milestoneID, ok := parseUintParam(c, "milestoneId", "milestone_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	authHeader := c.GetHeader("Authorization")
+	if err := h.workflow.MarkMilestoneEscrowReleased(c.Request.Context(), validationCaseID, uint(user.ID), milestoneID, authHeader); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *ValidationCaseWorkflowHandler) AttachMilestoneDispute(c *gin.Context) {
+	validationCaseID, ok := parseUintParam(c, "id", "validation_case_id")
+	if !ok {
+		return
+	}
+	milestoneID, ok := parseUintParam(c, "milestoneId", "milestone_id")
+	if !ok {
+		return
+	}
+	user, ok := mustGetUser(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		DisputeID string `json:"dispute_id" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, apperrors.ErrInvalidRequestBody.WithDetails(err.Error()))
+		return
+	}
+
+	authHeader := c.GetHeader("Authorization")
+	if err := h.workflow.AttachMilestoneDispute(c.Request.Context(), validationCaseID, uint(user.ID), milestoneID, req.DisputeID, authHeader); err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"net/url"
@@ -42,6 +43,7 @@ func DeleteAccountRateLimit() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ip := c.ClientIP()
 		if !deleteAccountLimiter.Allow(ip) {
+			c.Header("Retry-After", "3600")
 			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
 				"error": "Terlalu banyak percobaan. Silakan coba lagi dalam 1 jam.",
 			})
@@ -55,6 +57,8 @@ func buildCORSConfig() cors.Config {
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
 	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Authorization", "X-Sudo-Token"}
+	corsConfig.ExposeHeaders = []string{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After",
+		middleware.ImpersonationHeader, middleware.ImpersonationAdminHeader, middleware.ImpersonationExpiresAtHeader}
 	corsConfig.AllowCredentials = true
 
 	frontend := strings.TrimSpace(os.Getenv("FRONTEND_BASE_URL"))
@@ -269,6 +273,88 @@ func buildRateLimitConfig() middleware.RateLimitConfig {
 	return cfg
 }
 
+// buildPolicyRateLimiter loads per-route policies from RATE_LIMIT_POLICY_FILE and watches
+// the file for changes. A missing or invalid file leaves per-route policies disabled.
+func buildPolicyRateLimiter(exempt func(ip string) bool) *middleware.PolicyRateLimiter {
+	path := strings.TrimSpace(os.Getenv("RATE_LIMIT_POLICY_FILE"))
+	if path == "" {
+		path = "config/rate_limit_policies.json"
+	}
+	limiter := middleware.NewPolicyRateLimiter(nil)
+	limiter.SetRedisClient(services.RedisClient)
+	limiter.SetExempt(exempt)
+	if err := limiter.LoadFile(path); err != nil {
+		logger.Warn("Rate limit policy file not loaded", zap.String("path", path), zap.Error(err))
+	} else {
+		logger.Info("Rate limit policies loaded", zap.String("path", path), zap.Int("policies", len(limiter.Policies())))
+	}
+	limiter.WatchFile(path, time.Duration(getEnvPositiveInt("RATE_LIMIT_POLICY_RELOAD_SECONDS", 10))*time.Second)
+	return limiter
+}
+
+// loadSLAHolidayCalendar loads the public holidays skipped by business-hours SLA policies
+// from SLA_HOLIDAY_CALENDAR_FILE. A missing file leaves only weekends as non-business days.
+func loadSLAHolidayCalendar() {
+	path := strings.TrimSpace(os.Getenv("SLA_HOLIDAY_CALENDAR_FILE"))
+	if path == "" {
+		path = "config/holidays_id.json"
+	}
+	calendar := services.SLAHolidayCalendar()
+	if err := calendar.LoadFile(path); err != nil {
+		logger.Warn("SLA holiday calendar not loaded", zap.String("path", path), zap.Error(err))
+		return
+	}
+	logger.Info("SLA holiday calendar loaded", zap.String("path", path), zap.Int("holidays", len(calendar.Holidays())))
+	if year := time.Now().Year(); !calendar.HasYear(year) {
+		logger.Warn("SLA holiday calendar has no holidays for the current year; business-hours SLAs only skip weekends",
+			zap.String("path", path), zap.Int("year", year))
+	}
+}
+
+func buildRequestInspector(audit *services.EntSecurityAuditService) *middleware.RequestInspector {
+	inspector := middleware.NewRequestInspector(strings.ToLower(strings.TrimSpace(os.Getenv("REQUEST_INSPECTION_MODE"))), nil)
+	path := strings.TrimSpace(os.Getenv("REQUEST_INSPECTION_ALLOWLIST_FILE"))
+	if path == "" {
+		path = "config/request_inspection.json"
+	}
+	if err := inspector.LoadFile(path); err != nil {
+		logger.Warn("Request inspection allowlist not loaded", zap.String("path", path), zap.Error(err))
+	}
+	inspector.OnHit(func(ctx context.Context, report middleware.InspectionReport) {
+		rec := services.RequestInspectionRecord{
+			Mode:      report.Mode,
+			Method:    report.Method,
+			Route:     report.Route,
+			IP:        report.IP,
+			UserAgent: report.UserAgent,
+			Blocked:   report.Blocked,
+		}
+		for _, h := range report.Hits {
+			rec.Hits = append(rec.Hits, services.RequestInspectionHit(h))
+		}
+		audit.LogRequestInspection(ctx, rec)
+	})
+	logger.Info("Request inspection configured", zap.String("mode", inspector.Mode()))
+	return inspector
+}
+
+func buildRiskConfig() services.RiskConfig {
+	cfg := services.DefaultRiskConfig()
+
+	cfg.Enabled = getEnvBool("RISK_ENGINE_ENABLED", cfg.Enabled)
+	cfg.StepUpThreshold = getEnvPositiveInt("RISK_STEPUP_THRESHOLD", cfg.StepUpThreshold)
+	cfg.BlockThreshold = getEnvPositiveInt("RISK_BLOCK_THRESHOLD", cfg.BlockThreshold)
+
+	if cfg.BlockThreshold < cfg.StepUpThreshold {
+		logger.Warn("RISK_BLOCK_THRESHOLD is below RISK_STEPUP_THRESHOLD; step-up will never trigger",
+			zap.Int("step_up_threshold", cfg.StepUpThreshold),
+			zap.Int("block_threshold", cfg.BlockThreshold),
+		)
+	}
+
+	return cfg
+}
+
 func main() {
 	err := godotenv.Load()
 	if err != nil {
@@ -295,6 +381,14 @@ func main() {
 	database.InitEntDB()
 	defer database.CloseEntDB()
 
+	// Record admin mutations in the hash-chained audit trail and keep admin_audit_logs append-only
+	database.GetEntClient().Use(middleware.AdminAuditHook(), services.AdminAuditChainHook())
+
+	// Sync built-in admin roles (RBAC for /admin routes)
+	if err := services.EnsureBuiltinAdminRoles(context.Background(), database.GetEntClient()); err != nil {
+		logger.Fatal("Failed to sync admin roles", zap.Error(err))
+	}
+
 	// Initialize Redis (optional - graceful degradation if unavailable)
 	if err := services.InitRedis(); err != nil {
 		logger.Info("Redis not available - using in-memory rate limiting", zap.String("note", "This is acceptable for development"))
@@ -304,12 +398,30 @@ func main() {
 
 	config.InitConfig()
 
+	// Load offline breached-password filter (optional - screening disabled if unset)
+	if path := strings.TrimSpace(os.Getenv("BREACHED_PASSWORD_FILTER_PATH")); path != "" {
+		filter, err := utils.LoadBreachedPasswordFilter(path)
+		if err != nil {
+			logger.Fatal("Failed to load breached password filter", zap.String("path", path), zap.Error(err))
+		}
+		utils.SetBreachedPasswordFilter(filter)
+		logger.Info("Breached password filter loaded", zap.Uint64("entries", filter.Count()))
+	} else {
+		logger.Info("Breached password screening disabled", zap.String("note", "Set BREACHED_PASSWORD_FILTER_PATH to enable"))
+	}
+
 	// Initialize device tracker (must be before auth service)
 	services.InitEntDeviceTracker()
 
 	// Initialize geo lookup service for impossible travel detection
 	services.InitGeoLookupService()
 
+	// Initialize risk engine for adaptive authentication (uses geo lookup + device tracker)
+	services.InitRiskEngine(buildRiskConfig())
+
+	// Initialize new-device / suspicious-login email alerts (kill-switch links are signed with a key derived from JWT_SECRET)
+	services.InitLoginAlertService(config.JWTKey)
+
 	// Initialize Feature Service device ban checker
 	deviceBanChecker := services.NewFeatureServiceDeviceBanChecker(config.FeatureServiceURL, config.ServiceToken)
 	services.SetDeviceBanChecker(deviceBanChecker)
@@ -319,13 +431,25 @@ func main() {
 	sessionEntService := services.NewEntSessionService()
 	totpEntService := services.NewEntTOTPService(logger.GetLogger())
 	sudoEntService := services.NewEntSudoService(logger.GetLogger(), totpEntService)
+	accountEntService := services.NewEntAccountService(sessionEntService)
+	deviceEntService := services.NewEntDeviceService(sessionEntService)
 
 	var caseService services.ValidationCaseServiceInterface = services.NewEntValidationCaseService()
 	workflowService := services.NewEntValidationCaseWorkflowService()
 	repoWorkflowService := services.NewEntValidationCaseRepoWorkflowService()
+	loadSLAHolidayCalendar()
 	ownerResponseSLAWorker := services.NewOwnerResponseSLAWorker(workflowService)
 	ownerResponseSLAWorker.Start()
 	defer ownerResponseSLAWorker.Stop()
+	finalOfferExpiryWorker := services.NewFinalOfferExpiryWorker(workflowService)
+	finalOfferExpiryWorker.Start()
+	defer finalOfferExpiryWorker.Stop()
+	validatorMatchingAggregateWorker := services.NewValidatorMatchingAggregateWorker(workflowService)
+	validatorMatchingAggregateWorker.Start()
+	defer validatorMatchingAggregateWorker.Stop()
+	validatorReputationDecayWorker := services.NewValidatorReputationDecayWorker(workflowService)
+	validatorReputationDecayWorker.Start()
+	defer validatorReputationDecayWorker.Stop()
 
 	// Initialize passkey service with WebAuthn config
 	rpID := strings.TrimSpace(os.Getenv("WEBAUTHN_RP_ID"))
@@ -363,6 +487,23 @@ func main() {
 	)
 	sudoHandler := handlers.NewEntSudoHandler(sudoEntService, logger.GetLogger())
 	sudoValidator := services.NewSudoValidatorAdapter(sudoEntService)
+	accountSecurityHandler := handlers.NewAccountSecurityHandler(accountEntService, services.GetLoginAlertService())
+	deviceHandler := handlers.NewDeviceHandler(deviceEntService)
+	adminAuditHandler := handlers.NewAdminAuditHandler(services.NewEntAdminAuditService())
+	adminAuthHandler := handlers.NewAdminAuthHandler(services.NewEntAdminAuthService(passkeyService))
+	adminSecurityEventHandler := handlers.NewAdminSecurityEventHandler(services.NewEntSecurityAuditService())
+	requestInspector := buildRequestInspector(services.NewEntSecurityAuditService())
+	adminRequestInspectionHandler := handlers.NewAdminRequestInspectionHandler(services.NewEntSecurityAuditService(), requestInspector)
+	adminImpersonationHandler := handlers.NewAdminImpersonationHandler(services.NewEntAdminImpersonationService())
+	ipRuleService := services.NewEntIPRuleService()
+	services.SetIPRuleService(ipRuleService)
+	adminIPRuleHandler := handlers.NewAdminIPRuleHandler(ipRuleService)
+	adminSLAPolicyHandler := handlers.NewAdminSLAPolicyHandler(services.NewEntSLAPolicyService())
+	adminDisputeEvidenceHandler := handlers.NewAdminDisputeEvidenceHandler(workflowService)
+	adminGuaranteeSlashHandler := handlers.NewAdminGuaranteeSlashHandler(workflowService)
+	adminModerationService := services.NewEntAdminUserModerationService(sessionEntService)
+	adminModerationHandler := handlers.NewAdminUserModerationHandler(adminModerationService)
+	adminLinkageHandler := handlers.NewAdminAccountLinkageHandler(services.NewEntAccountLinkageService(adminModerationService))
 	lztMarketClient := services.NewLZTMarketClientFromEnv()
 	lztMarketHandler := handlers.NewLZTMarketHandler(lztMarketClient)
 	// Financial features are handled by the ASP.NET service; keep Go focused on core identity/content.
@@ -409,6 +550,33 @@ func main() {
 	// Kept in main so middleware package stays independent from services package.
 	enhancedRateLimiter.SetRedisClient(services.RedisClient)
 	deleteAccountLimiter.SetRedisClient(services.RedisClient)
+	policyRateLimiter := buildPolicyRateLimiter(enhancedRateLimiter.IsWhitelisted)
+	defer policyRateLimiter.Stop()
+	// IP allow/block rules from the database, reloaded on Redis notification.
+	ipRuleSyncer := services.NewIPRuleSyncer(ipRuleService, func(rules []services.ActiveIPRule) {
+		var allow, block []middleware.DynamicIPRule
+		for _, r := range rules {
+			rule := middleware.DynamicIPRule{Prefix: r.Prefix, ExpiresAt: r.ExpiresAt}
+			if r.Action == services.IPRuleAllow {
+				allow = append(allow, rule)
+			} else {
+				block = append(block, rule)
+			}
+		}
+		enhancedRateLimiter.SetDynamicIPRules(allow, block)
+	})
+	ipRuleSyncer.Start()
+	defer ipRuleSyncer.Stop()
+	// Share auth limiter blocks with other instances
+	enhancedRateLimiter.OnBlock(func(ip string, duration time.Duration) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := ipRuleService.AutoBlock(ctx, ip, duration, services.IPRuleSourceRateLimit, "Terlalu banyak percobaan autentikasi"); err != nil {
+				logger.Warn("Failed to persist rate limit block", zap.Error(err), zap.String("ip", ip))
+			}
+		}()
+	})
 	logger.Info("Enhanced rate limiter configured",
 		zap.Int("requests_per_minute", rateLimitConfig.RequestsPerMinute),
 		zap.Int("requests_per_hour", rateLimitConfig.RequestsPerHour),
@@ -435,7 +603,7 @@ func main() {
 
 		// Keep health/readiness outside request rate limits.
 		apiRateLimited := api.Group("")
-		apiRateLimited.Use(enhancedRateLimiter.Middleware())
+		apiRateLimited.Use(enhancedRateLimiter.Middleware(), policyRateLimiter.Middleware(), requestInspector.Middleware())
 		{
 			auth := apiRateLimited.Group("/auth")
 			{
@@ -445,6 +613,7 @@ func main() {
 				authSensitive.POST("/login", authHandler.Login)
 				authSensitive.POST("/login/totp", authHandler.LoginTOTP)
 				authSensitive.POST("/login/backup-code", authHandler.LoginBackupCode)
+				authSensitive.POST("/login/step-up/email-code", authHandler.LoginStepUpEmailCode)
 				authSensitive.POST("/refresh", authHandler.RefreshToken)
 				authSensitive.POST("/logout", authHandler.Logout)
 				authSensitive.POST("/verify/request", authHandler.RequestVerification)
@@ -514,6 +683,20 @@ func main() {
 				account.GET("/can-delete", middleware.AuthMiddleware(), handlers.CanDeleteAccountHandler)
 				// Delete account requires sudo mode
 				account.DELETE("", middleware.AuthMiddleware(), DeleteAccountRateLimit(), middleware.RequireSudo(sudoValidator), handlers.DeleteAccountHandler)
+				// Credential changes require sudo mode
+				account.POST("/password", middleware.AuthMiddleware(), middleware.RequireSudo(sudoValidator), accountSecurityHandler.ChangePassword)
+				account.POST("/email", middleware.AuthMiddleware(), middleware.RequireSudo(sudoValidator), accountSecurityHandler.RequestEmailChange)
+				// Token-based (links from email), no login required
+				account.POST("/email/confirm", accountSecurityHandler.ConfirmEmailChange)
+				account.POST("/email/revert", accountSecurityHandler.RevertEmailChange)
+				// "This wasn't me" link from login alert emails (signed token, no session required)
+				account.POST("/security/not-me", accountSecurityHandler.ReportUnrecognizedLogin)
+				// Devices recorded for the caller (trusted devices skip risk step-up, so trusting requires sudo)
+				account.GET("/devices", middleware.AuthMiddleware(), deviceHandler.ListDevices)
+				account.PUT("/devices/:id/name", middleware.AuthMiddleware(), deviceHandler.RenameDevice)
+				account.POST("/devices/:id/trust", middleware.AuthMiddleware(), middleware.RequireSudo(sudoValidator), deviceHandler.TrustDevice)
+				account.DELETE("/devices/:id/trust", middleware.AuthMiddleware(), deviceHandler.UntrustDevice)
+				account.DELETE("/devices/:id", middleware.AuthMiddleware(), deviceHandler.RemoveDevice)
 			}
 
 			user := apiRateLimited.Group("/user")
@@ -563,16 +746,44 @@ func main() {
 				validationCases.GET("/:id/consultation-requests/me", middleware.AuthMiddleware(), workflowHandler.GetMyConsultationRequest)
 				validationCases.POST("/:id/consultation-requests/:requestId/approve", middleware.AuthMiddleware(), workflowHandler.ApproveConsultationRequest)
 				validationCases.POST("/:id/consultation-requests/:requestId/reject", middleware.AuthMiddleware(), workflowHandler.RejectConsultationRequest)
-				validationCases.GET("/:id/contact", middleware.AuthMiddleware(), workflowHandler.RevealContact)
+				validationCases.GET("/invitations/me", middleware.AuthMiddleware(), workflowHandler.ListMyInvitations)
+				validationCases.GET("/:id/recommended-validators", middleware.AuthMiddleware(), workflowHandler.RecommendValidators)
+				validationCases.POST("/:id/invitations", middleware.AuthMiddleware(), workflowHandler.InviteValidator)
+				validationCases.GET("/:id/invitations", middleware.AuthMiddleware(), workflowHandler.ListInvitations)
+				validationCases.GET("/:id/invitations/suggestions", middleware.AuthMiddleware(), workflowHandler.SuggestValidators)
+				validationCases.POST("/:id/invitations/:invitationId/accept", middleware.AuthMiddleware(), workflowHandler.AcceptInvitation)
+				validationCases.POST("/:id/invitations/:invitationId/decline", middleware.AuthMiddleware(), workflowHandler.DeclineInvitation)
+				validationCases.POST("/:id/invitations/:invitationId/revoke", middleware.AuthMiddleware(), workflowHandler.RevokeInvitation)
+				validationCases.GET("/guarantee-slashes/me", middleware.AuthMiddleware(), workflowHandler.ListMyGuaranteeSlashes)
+				validationCases.POST("/:id/guarantee-slashes/:slashId/appeal", middleware.AuthMiddleware(), workflowHandler.AppealGuaranteeSlash)
+				validationCases.GET("/:id/contact", middleware.AuthMiddleware(), middleware.RejectImpersonation(), workflowHandler.RevealContact)
+				validationCases.PUT("/:id/contact-settings", middleware.AuthMiddleware(), workflowHandler.UpdateContactSettings)
+				validationCases.GET("/:id/conversations", middleware.AuthMiddleware(), workflowHandler.ListConversations)
+				validationCases.GET("/:id/conversations/:validatorId/messages", middleware.AuthMiddleware(), middleware.RejectImpersonation(), workflowHandler.ListMessages)
+				validationCases.POST("/:id/conversations/:validatorId/messages", middleware.AuthMiddleware(), workflowHandler.SendMessage)
+				validationCases.POST("/:id/conversations/:validatorId/read", middleware.AuthMiddleware(), workflowHandler.MarkMessagesRead)
+				validationCases.POST("/:id/clarifications", middleware.AuthMiddleware(), workflowHandler.PostClarification)
+				validationCases.GET("/:id/clarifications", middleware.AuthMiddleware(), workflowHandler.ListClarifications)
+				validationCases.POST("/:id/clarifications/:clarificationId/respond", middleware.AuthMiddleware(), workflowHandler.RespondClarification)
 
 				validationCases.POST("/:id/final-offers", middleware.AuthMiddleware(), workflowHandler.SubmitFinalOffer)
 				validationCases.GET("/:id/final-offers", middleware.AuthMiddleware(), workflowHandler.ListFinalOffers)
 				validationCases.POST("/:id/final-offers/:offerId/accept", middleware.AuthMiddleware(), workflowHandler.AcceptFinalOffer)
+				validationCases.POST("/:id/final-offers/:offerId/counter", middleware.AuthMiddleware(), workflowHandler.CounterFinalOffer)
+				validationCases.POST("/:id/final-offers/:offerId/revise", middleware.AuthMiddleware(), workflowHandler.ReviseFinalOffer)
+				validationCases.POST("/:id/final-offers/:offerId/withdraw", middleware.AuthMiddleware(), workflowHandler.WithdrawFinalOffer)
+				validationCases.GET("/:id/final-offers/:offerId/revisions", middleware.AuthMiddleware(), workflowHandler.ListFinalOfferRevisions)
 
 				validationCases.POST("/:id/lock-funds", middleware.AuthMiddleware(), workflowHandler.ConfirmLockFunds)
 				validationCases.POST("/:id/artifact-submission", middleware.AuthMiddleware(), workflowHandler.SubmitArtifact)
 				validationCases.POST("/:id/escrow/released", middleware.AuthMiddleware(), workflowHandler.MarkEscrowReleased)
 				validationCases.POST("/:id/dispute/attach", middleware.AuthMiddleware(), workflowHandler.AttachDispute)
+				validationCases.GET("/:id/milestones", middleware.AuthMiddleware(), workflowHandler.ListMilestones)
+				validationCases.POST("/:id/milestones/:milestoneId/lock-funds", middleware.AuthMiddleware(), workflowHandler.ConfirmMilestoneLockFunds)
+				validationCases.POST("/:id/milestones/:milestoneId/artifact-submission", middleware.AuthMiddleware(), workflowHandler.SubmitMilestoneArtifact)
+				validationCases.POST("/:id/milestones/:milestoneId/escrow/released", middleware.AuthMiddleware(), workflowHandler.MarkMilestoneEscrowReleased)
+				validationCases.POST("/:id/milestones/:milestoneId/dispute/attach", middleware.AuthMiddleware(), workflowHandler.AttachMilestoneDispute)
+				validationCases.GET("/:id/delivery-sla", middleware.AuthMiddleware(), workflowHandler.ListValidatorDeliverySLAs)
 
 				validationCases.GET("/:id/case-log", middleware.AuthMiddleware(), workflowHandler.GetCaseLog)
 
@@ -635,39 +846,106 @@ func main() {
 
 	// Admin routes (separate auth)
 	admin := router.Group("/admin")
-	admin.Use(enhancedRateLimiter.Middleware())
+	admin.Use(enhancedRateLimiter.Middleware(), policyRateLimiter.Middleware())
 	{
-		admin.POST("/auth/login", enhancedRateLimiter.AuthMiddleware(), handlers.AdminLogin)
+		// Login: password, then TOTP or passkey (admins without a second factor get an enrollment-only session)
+		admin.POST("/auth/login", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.Login)
+		admin.POST("/auth/login/totp", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.LoginTOTP)
+		admin.POST("/auth/login/passkey/begin", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.LoginPasskeyBegin)
+		admin.POST("/auth/login/passkey/finish", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.LoginPasskeyFinish)
+
+		// Any valid admin session, including enrollment-only ones
+		adminSession := admin.Group("")
+		adminSession.Use(middleware.AdminAuthMiddleware(), middleware.AdminAuditMiddleware())
+		{
+			adminSession.POST("/auth/logout", adminAuthHandler.Logout)
+			adminSession.GET("/auth/mfa", adminAuthHandler.MFAStatus)
+			// Adding a factor needs sudo once the admin has one; first enrollment does not
+			adminSession.POST("/auth/mfa/totp/setup", middleware.RequireAdminSudoToEnroll(), adminAuthHandler.BeginTOTPSetup)
+			adminSession.POST("/auth/mfa/totp/enable", middleware.RequireAdminSudoToEnroll(), adminAuthHandler.EnableTOTP)
+			adminSession.POST("/auth/mfa/passkeys/register/begin", middleware.RequireAdminSudoToEnroll(), adminAuthHandler.BeginPasskeyRegistration)
+			adminSession.POST("/auth/mfa/passkeys/register/finish", middleware.RequireAdminSudoToEnroll(), adminAuthHandler.FinishPasskeyRegistration)
+		}
 
-		// Protected admin routes
-		adminProtected := admin.Group("")
-		adminProtected.Use(middleware.AdminAuthMiddleware())
+		// Protected admin routes: second factor verified; each route declares the permission it needs
+		adminProtected := adminSession.Group("")
+		adminProtected.Use(middleware.RequireAdminMFA())
 		{
+			// Admin sessions, sudo and second-factor removal
+			adminProtected.GET("/auth/sessions", adminAuthHandler.ListSessions)
+			adminProtected.DELETE("/auth/sessions/:id", adminAuthHandler.RevokeSession)
+			adminProtected.POST("/auth/sudo", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.Sudo)
+			adminProtected.POST("/auth/sudo/passkey/begin", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.SudoPasskeyBegin)
+			adminProtected.POST("/auth/sudo/passkey/finish", enhancedRateLimiter.AuthMiddleware(), adminAuthHandler.SudoPasskeyFinish)
+			adminProtected.DELETE("/auth/mfa/totp", middleware.RequireAdminSudo(), adminAuthHandler.DisableTOTP)
+			adminProtected.DELETE("/auth/mfa/passkeys/:id", middleware.RequireAdminSudo(), adminAuthHandler.DeletePasskey)
+
 			// Badge management
-			adminProtected.POST("/badges", handlers.CreateBadge)
-			adminProtected.GET("/badges", handlers.ListBadges)
-			adminProtected.GET("/badges/:id", handlers.GetBadge)
-			adminProtected.PUT("/badges/:id", handlers.UpdateBadge)
-			adminProtected.DELETE("/badges/:id", handlers.DeleteBadge)
+			adminProtected.POST("/badges", middleware.RequirePermission(middleware.PermBadgesWrite), handlers.CreateBadge)
+			adminProtected.GET("/badges", middleware.RequirePermission(middleware.PermBadgesRead), handlers.ListBadges)
+			adminProtected.GET("/badges/:id", middleware.RequirePermission(middleware.PermBadgesRead), handlers.GetBadge)
+			adminProtected.PUT("/badges/:id", middleware.RequirePermission(middleware.PermBadgesWrite), handlers.UpdateBadge)
+			adminProtected.DELETE("/badges/:id", middleware.RequirePermission(middleware.PermBadgesWrite), middleware.RequireAdminSudo(), handlers.DeleteBadge)
 
 			// User management
-			adminProtected.GET("/users", enhancedRateLimiter.SearchMiddleware(), handlers.AdminListUsers)
-			adminProtected.GET("/users/:userId", handlers.AdminGetUser)
-			adminProtected.POST("/users/:userId/badges", handlers.AssignBadgeToUser)
-			adminProtected.DELETE("/users/:userId/badges/:badgeId", handlers.RevokeBadgeFromUser)
+			adminProtected.GET("/users", middleware.RequirePermission(middleware.PermUsersRead), enhancedRateLimiter.SearchMiddleware(), handlers.AdminListUsers)
+			adminProtected.GET("/users/:userId", middleware.RequirePermission(middleware.PermUsersRead), handlers.AdminGetUser)
+			adminProtected.GET("/users/:userId/security-events", middleware.RequirePermission(middleware.PermSecurityRead), middleware.AuditAdminRead(), adminModerationHandler.ListSecurityEvents)
+			adminProtected.POST("/users/:userId/lock", middleware.RequirePermission(middleware.PermUsersModerate), adminModerationHandler.LockUser)
+			adminProtected.POST("/users/:userId/unlock", middleware.RequirePermission(middleware.PermUsersModerate), middleware.RequireAdminSudo(), adminModerationHandler.UnlockUser)
+			adminProtected.POST("/users/:userId/sessions/revoke", middleware.RequirePermission(middleware.PermUsersModerate), adminModerationHandler.RevokeSessions)
+			adminProtected.POST("/users/:userId/devices/block", middleware.RequirePermission(middleware.PermUsersModerate), adminModerationHandler.BlockDevices)
+			adminProtected.GET("/users/:userId/linkage", middleware.RequirePermission(middleware.PermSecurityRead), middleware.AuditAdminRead(), adminLinkageHandler.GetLinkage)
+			adminProtected.POST("/users/:userId/linkage/lock", middleware.RequirePermission(middleware.PermUsersModerate), middleware.RequireAdminSudo(), adminLinkageHandler.LockCluster)
+			adminProtected.POST("/users/:userId/impersonate", middleware.RequirePermission(middleware.PermUsersImpersonate), middleware.RequireAdminSudo(), adminImpersonationHandler.Impersonate)
+			adminProtected.GET("/impersonations", middleware.RequirePermission(middleware.PermSecurityRead), adminImpersonationHandler.ListImpersonations)
+			adminProtected.DELETE("/impersonations/:id", middleware.RequirePermission(middleware.PermUsersImpersonate), adminImpersonationHandler.RevokeImpersonation)
+			adminProtected.POST("/users/:userId/totp/reset", middleware.RequirePermission(middleware.PermUsersMFAReset), middleware.RequireAdminSudo(), adminModerationHandler.ResetTOTP)
+			adminProtected.POST("/users/:userId/badges", middleware.RequirePermission(middleware.PermBadgesWrite), handlers.AssignBadgeToUser)
+			adminProtected.DELETE("/users/:userId/badges/:badgeId", middleware.RequirePermission(middleware.PermBadgesWrite), middleware.RequireAdminSudo(), handlers.RevokeBadgeFromUser)
+
+			// Rate limiter IP allow/block rules
+			adminProtected.GET("/ip-rules", middleware.RequirePermission(middleware.PermIPRulesRead), adminIPRuleHandler.ListRules)
+			adminProtected.POST("/ip-rules", middleware.RequirePermission(middleware.PermIPRulesWrite), adminIPRuleHandler.CreateRule)
+			adminProtected.PATCH("/ip-rules/:id", middleware.RequirePermission(middleware.PermIPRulesWrite), adminIPRuleHandler.UpdateRule)
+			adminProtected.DELETE("/ip-rules/:id", middleware.RequirePermission(middleware.PermIPRulesWrite), middleware.RequireAdminSudo(), adminIPRuleHandler.DeleteRule)
+
+			// Owner-response SLA policies (per sensitivity level / category, business calendar)
+			adminProtected.GET("/sla-policies", middleware.RequirePermission(middleware.PermSLAPoliciesRead), adminSLAPolicyHandler.ListPolicies)
+			adminProtected.GET("/sla-policies/holidays", middleware.RequirePermission(middleware.PermSLAPoliciesRead), adminSLAPolicyHandler.ListHolidays)
+			adminProtected.POST("/sla-policies", middleware.RequirePermission(middleware.PermSLAPoliciesWrite), adminSLAPolicyHandler.CreatePolicy)
+			adminProtected.PUT("/sla-policies/:id", middleware.RequirePermission(middleware.PermSLAPoliciesWrite), adminSLAPolicyHandler.UpdatePolicy)
+			adminProtected.DELETE("/sla-policies/:id", middleware.RequirePermission(middleware.PermSLAPoliciesWrite), adminSLAPolicyHandler.DeletePolicy)
 
 			// Category management (admin only)
-			adminProtected.GET("/categories", handlers.AdminListCategories)
+			adminProtected.GET("/categories", middleware.RequirePermission(middleware.PermCategoriesRead), handlers.AdminListCategories)
 			// Validation Case management (admin only)
-			adminProtected.POST("/validation-cases/:id/move", handlers.AdminMoveValidationCase)
+			adminProtected.POST("/validation-cases/:id/move", middleware.RequirePermission(middleware.PermCasesMove), handlers.AdminMoveValidationCase)
+			adminProtected.GET("/validation-cases/:id/dispute-evidence", middleware.RequirePermission(middleware.PermCasesEvidence), middleware.AuditAdminRead(), adminDisputeEvidenceHandler.GetEvidenceBundle)
+			adminProtected.GET("/guarantee-slashes", middleware.RequirePermission(middleware.PermCasesSlashes), adminGuaranteeSlashHandler.ListSlashes)
+			adminProtected.POST("/guarantee-slashes/:id/resolve", middleware.RequirePermission(middleware.PermCasesSlashes), adminGuaranteeSlashHandler.ResolveAppeal)
 
 			// Observed devices (read-only)
-			adminProtected.GET("/observed-devices", handlers.AdminListObservedDevices)
+			adminProtected.GET("/observed-devices", middleware.RequirePermission(middleware.PermDevicesRead), handlers.AdminListObservedDevices)
 
 			// External integration (LZT Market API)
-			adminProtected.GET("/integrations/lzt/config", lztMarketHandler.GetConfig)
-			adminProtected.GET("/integrations/lzt/chatgpt", lztMarketHandler.GetChatGPTAccounts)
-			adminProtected.POST("/integrations/lzt/request", lztMarketHandler.ProxyRequest)
+			adminProtected.GET("/integrations/lzt/config", middleware.RequirePermission(middleware.PermLZTRead), lztMarketHandler.GetConfig)
+			adminProtected.GET("/integrations/lzt/chatgpt", middleware.RequirePermission(middleware.PermLZTRead), lztMarketHandler.GetChatGPTAccounts)
+			adminProtected.POST("/integrations/lzt/request", middleware.RequirePermission(middleware.PermLZTProxy), middleware.RequireAdminSudo(), lztMarketHandler.ProxyRequest)
+
+			// Security events: search, analytics and incident export
+			adminProtected.GET("/security-events", middleware.RequirePermission(middleware.PermSecurityRead), adminSecurityEventHandler.ListEvents)
+			adminProtected.GET("/security-events/export", middleware.RequirePermission(middleware.PermSecurityExport), middleware.AuditAdminRead(), adminSecurityEventHandler.ExportEvents)
+			adminProtected.GET("/security-events/analytics/failed-logins", middleware.RequirePermission(middleware.PermSecurityRead), adminSecurityEventHandler.FailedLogins)
+			adminProtected.GET("/security-events/analytics/top-ips", middleware.RequirePermission(middleware.PermSecurityRead), adminSecurityEventHandler.TopIPs)
+			adminProtected.GET("/security-events/analytics/token-reuse", middleware.RequirePermission(middleware.PermSecurityRead), adminSecurityEventHandler.TokenReuse)
+			adminProtected.GET("/security-events/analytics/locked-accounts", middleware.RequirePermission(middleware.PermSecurityRead), adminSecurityEventHandler.LockedAccounts)
+			adminProtected.POST("/security-events/:id/false-positive", middleware.RequirePermission(middleware.PermSecurityTriage), adminRequestInspectionHandler.MarkFalsePositive)
+			adminProtected.GET("/request-inspection", middleware.RequirePermission(middleware.PermSecurityRead), adminRequestInspectionHandler.GetMetrics)
+
+			// Admin audit trail (every mutating admin request is recorded by AdminAuditMiddleware)
+			adminProtected.GET("/audit-logs", middleware.RequirePermission(middleware.PermAuditRead), adminAuditHandler.ListAuditLogs)
+			adminProtected.GET("/audit-logs/export", middleware.RequirePermission(middleware.PermAuditExport), adminAuditHandler.ExportAuditLogs)
 
 		}
 	}
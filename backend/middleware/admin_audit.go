@@ -0,0 +1,353 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AdminReasonHeader lets admin clients state why they performed an action. When absent,
+// a top-level "reason" field in the JSON body is used instead.
+const AdminReasonHeader = "X-Admin-Reason"
+
+const (
+	maxAdminAuditChanges    = 50
+	maxAdminAuditBeforeRows = 50
+	maxAdminAuditReasonLen  = 1000
+	maxAdminAuditBodyPeek   = 1 << 20
+)
+
+// ErrAdminAuditLogImmutable is returned when code tries to update or delete an audit row
+var ErrAdminAuditLogImmutable = errors.New("admin audit log is append-only")
+
+// Field names containing any of these are never copied into audit diffs
+var adminAuditRedactedFields = []string{"password", "secret", "token", "code_hash", "_key"}
+
+type adminAuditCtxKey struct{}
+
+// adminAuditCollector accumulates the entity changes made while serving one admin request
+type adminAuditCollector struct {
+	mu      sync.Mutex
+	changes []map[string]interface{}
+	dropped int
+}
+
+func (col *adminAuditCollector) add(change map[string]interface{}) {
+	col.mu.Lock()
+	defer col.mu.Unlock()
+	if len(col.changes) >= maxAdminAuditChanges {
+		col.dropped++
+		return
+	}
+	col.changes = append(col.changes, change)
+}
+
+// AdminAuditHook records every Ent mutation made on behalf of an admin request into the
+// request's audit collector, and rejects updates and deletes of AdminAuditLog rows.
+// Register once on the client at startup: client.Use(middleware.AdminAuditHook()).
+func AdminAuditHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Type() == ent.TypeAdminAuditLog {
+				if !m.Op().Is(ent.OpCreate) {
+					return nil, ErrAdminAuditLogImmutable
+				}
+				return next.Mutate(ctx, m)
+			}
+
+			col, ok := ctx.Value(adminAuditCtxKey{}).(*adminAuditCollector)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			change := map[string]interface{}{
+				"entity": m.Type(),
+				"op":     adminAuditOpName(m.Op()),
+			}
+			// IDs and old values must be read before the mutation runs
+			var ids []int
+			if !m.Op().Is(ent.OpCreate) {
+				if idm, ok := m.(interface {
+					IDs(context.Context) ([]int, error)
+				}); ok {
+					if got, err := idm.IDs(ctx); err == nil {
+						ids = got
+						change["ids"] = ids
+					}
+				}
+			}
+			if m.Op().Is(ent.OpUpdate|ent.OpDelete|ent.OpDeleteOne) && len(ids) > 0 {
+				change["before"] = adminAuditRowsBefore(ctx, m, ids)
+				if len(ids) > maxAdminAuditBeforeRows {
+					change["before_truncated"] = len(ids) - maxAdminAuditBeforeRows
+				}
+			}
+			if m.Op().Is(ent.OpUpdateOne) {
+				before := make(map[string]interface{})
+				for _, name := range append(m.Fields(), m.ClearedFields()...) {
+					if old, err := m.OldField(ctx, name); err == nil {
+						before[name] = redactAdminAuditValue(name, old)
+					}
+				}
+				change["before"] = before
+			}
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				after := make(map[string]interface{})
+				for _, name := range m.Fields() {
+					if v, ok := m.Field(name); ok {
+						after[name] = redactAdminAuditValue(name, v)
+					}
+				}
+				for _, name := range m.ClearedFields() {
+					after[name] = nil
+				}
+				change["after"] = after
+				if edges := adminAuditEdges(m); len(edges) > 0 {
+					change["edges"] = edges
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if m.Op().Is(ent.OpCreate) {
+				if idm, ok := m.(interface{ ID() (int, bool) }); ok {
+					if id, exists := idm.ID(); exists {
+						change["ids"] = []int{id}
+					}
+				}
+			}
+			col.add(change)
+			return v, nil
+		})
+	}
+}
+
+// adminAuditRowsBefore loads up to maxAdminAuditBeforeRows of the rows a bulk update or a
+// delete is about to change. Rows that cannot be loaded are skipped.
+func adminAuditRowsBefore(ctx context.Context, m ent.Mutation, ids []int) []map[string]interface{} {
+	rows := []map[string]interface{}{}
+	cm, ok := m.(interface{ Client() *ent.Client })
+	if !ok {
+		return rows
+	}
+	// Every generated entity client is a field of ent.Client named after its type
+	typeClient := reflect.ValueOf(cm.Client()).Elem().FieldByName(m.Type())
+	if !typeClient.IsValid() {
+		return rows
+	}
+	get := typeClient.MethodByName("Get")
+	if !get.IsValid() {
+		return rows
+	}
+	if len(ids) > maxAdminAuditBeforeRows {
+		ids = ids[:maxAdminAuditBeforeRows]
+	}
+	for _, id := range ids {
+		out := get.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(id)})
+		if len(out) != 2 || !out[1].IsNil() {
+			continue
+		}
+		if row := adminAuditRowSnapshot(out[0].Interface()); row != nil {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// adminAuditRowSnapshot converts an entity to its JSON fields without edges, redacting
+// secret-like fields. Sensitive schema fields are already left out of entity JSON.
+func adminAuditRowSnapshot(entity interface{}) map[string]interface{} {
+	raw, err := json.Marshal(entity)
+	if err != nil {
+		return nil
+	}
+	var row map[string]interface{}
+	if err := json.Unmarshal(raw, &row); err != nil {
+		return nil
+	}
+	delete(row, "edges")
+	for name, v := range row {
+		row[name] = redactAdminAuditValue(name, v)
+	}
+	return row
+}
+
+func adminAuditOpName(op ent.Op) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return "create"
+	case op.Is(ent.OpUpdateOne):
+		return "update_one"
+	case op.Is(ent.OpUpdate):
+		return "update"
+	case op.Is(ent.OpDeleteOne):
+		return "delete_one"
+	case op.Is(ent.OpDelete):
+		return "delete"
+	default:
+		return op.String()
+	}
+}
+
+func adminAuditEdges(m ent.Mutation) map[string]interface{} {
+	edges := make(map[string]interface{})
+	for _, name := range m.AddedEdges() {
+		edges["+"+name] = m.AddedIDs(name)
+	}
+	for _, name := range m.RemovedEdges() {
+		edges["-"+name] = m.RemovedIDs(name)
+	}
+	for _, name := range m.ClearedEdges() {
+		edges["-"+name] = "cleared"
+	}
+	return edges
+}
+
+func redactAdminAuditValue(field string, v interface{}) interface{} {
+	lower := strings.ToLower(field)
+	for _, s := range adminAuditRedactedFields {
+		if strings.Contains(lower, s) {
+			return "[redacted]"
+		}
+	}
+	return v
+}
+
+// adminAuditReadKey marks a read-only route whose access must also be audited
+const adminAuditReadKey = "admin_audit_read"
+
+// AuditAdminRead opts a GET route into the admin audit trail (e.g. viewing a user's
+// security history). Use on routes registered under AdminAuditMiddleware.
+func AuditAdminRead() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(adminAuditReadKey, true)
+		c.Next()
+	}
+}
+
+// AdminAuditMiddleware writes an AdminAuditLog row for every mutating admin request,
+// including ones rejected by RequirePermission, and for reads marked with AuditAdminRead.
+// Entity diffs are only kept for successful requests, since failed ones are usually
+// rolled back. Must run after AdminAuthMiddleware.
+func AdminAuditMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		readOnly := false
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			readOnly = true
+		}
+
+		reason := adminAuditReason(c)
+		col := &adminAuditCollector{}
+		if !readOnly {
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), adminAuditCtxKey{}, col))
+		}
+
+		c.Next()
+
+		if readOnly && !c.GetBool(adminAuditReadKey) {
+			return
+		}
+
+		status := c.Writer.Status()
+		success := status < http.StatusBadRequest
+		adminID, _ := c.Get("admin_id")
+		id, _ := adminID.(uint)
+
+		action := c.FullPath()
+		if action == "" {
+			action = c.Request.URL.Path
+		}
+
+		create := database.GetEntClient().AdminAuditLog.Create().
+			SetAdminID(int(id)).
+			SetAdminEmail(c.GetString("admin_email")).
+			SetAction(truncateAudit(c.Request.Method+" "+action, 200)).
+			SetMethod(c.Request.Method).
+			SetPath(truncateAudit(c.Request.URL.Path, 512)).
+			SetRequestID(truncateAudit(GetRequestID(c), 64)).
+			SetIPAddress(truncateAudit(c.ClientIP(), 45)).
+			SetUserAgent(truncateAudit(c.GetHeader("User-Agent"), 512)).
+			SetReason(reason).
+			SetStatusCode(status).
+			SetSuccess(success)
+
+		col.mu.Lock()
+		if success && len(col.changes) > 0 {
+			changes := col.changes
+			if col.dropped > 0 {
+				changes = append(changes, map[string]interface{}{"truncated": col.dropped})
+			}
+			create.SetChanges(changes)
+			first := col.changes[0]
+			create.SetTargetType(fmt.Sprint(first["entity"]))
+			if ids, ok := first["ids"].([]int); ok && len(ids) > 0 {
+				create.SetTargetID(fmt.Sprint(ids[0]))
+			}
+		}
+		col.mu.Unlock()
+
+		// The request context may already be cancelled; the audit row must still land
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := create.Save(ctx); err != nil {
+			logger.Error("Failed to write admin audit log",
+				zap.Error(err),
+				zap.Uint("admin_id", id),
+				zap.String("action", action),
+				zap.String("request_id", GetRequestID(c)))
+		}
+	}
+}
+
+// adminAuditReason reads the X-Admin-Reason header, falling back to a JSON body "reason"
+// field. The body is restored so handlers can still bind it.
+func adminAuditReason(c *gin.Context) string {
+	if r := strings.TrimSpace(c.GetHeader(AdminReasonHeader)); r != "" {
+		return truncateAudit(r, maxAdminAuditReasonLen)
+	}
+	if c.Request.Body == nil || !strings.Contains(c.ContentType(), "json") {
+		return ""
+	}
+
+	peek, err := io.ReadAll(io.LimitReader(c.Request.Body, maxAdminAuditBodyPeek))
+	c.Request.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(peek), c.Request.Body), c.Request.Body}
+	if err != nil {
+		return ""
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if json.Unmarshal(peek, &body) != nil {
+		return ""
+	}
+	return truncateAudit(strings.TrimSpace(body.Reason), maxAdminAuditReasonLen)
+}
+
+func truncateAudit(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max]
+}
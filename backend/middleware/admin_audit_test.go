@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAdminAuditReason(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newContext := func(body, contentType, header string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/admin/badges", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", contentType)
+		if header != "" {
+			c.Request.Header.Set(AdminReasonHeader, header)
+		}
+		return c
+	}
+
+	t.Run("header wins", func(t *testing.T) {
+		c := newContext(`{"reason":"from body"}`, "application/json", "from header")
+		if got := adminAuditReason(c); got != "from header" {
+			t.Fatalf("expected header reason, got %q", got)
+		}
+	})
+
+	t.Run("json body is read and restored", func(t *testing.T) {
+		body := `{"name":"Verified","reason":"  spam cleanup "}`
+		c := newContext(body, "application/json; charset=utf-8", "")
+		if got := adminAuditReason(c); got != "spam cleanup" {
+			t.Fatalf("expected body reason, got %q", got)
+		}
+		rest, _ := io.ReadAll(c.Request.Body)
+		if string(rest) != body {
+			t.Fatalf("body not restored, got %q", rest)
+		}
+	})
+
+	t.Run("non-json body ignored", func(t *testing.T) {
+		c := newContext(`reason=x`, "application/x-www-form-urlencoded", "")
+		if got := adminAuditReason(c); got != "" {
+			t.Fatalf("expected empty reason, got %q", got)
+		}
+	})
+}
+
+func TestRedactAdminAuditValue(t *testing.T) {
+	for _, f := range []string{"password_hash", "totp_secret", "refresh_token_hash", "public_key"} {
+		if got := redactAdminAuditValue(f, "value"); got != "[redacted]" {
+			t.Errorf("expected %s to be redacted, got %v", f, got)
+		}
+	}
+	if got := redactAdminAuditValue("name", "Verified"); got != "Verified" {
+		t.Errorf("expected name to pass through, got %v", got)
+	}
+}
+
+func TestAdminAuditRowSnapshot(t *testing.T) {
+	type row struct {
+		ID      int               `json:"id,omitempty"`
+		Name    string            `json:"name,omitempty"`
+		APIKey  string            `json:"api_key,omitempty"`
+		Private string            `json:"-"`
+		Edges   map[string]string `json:"edges"`
+	}
+	got := adminAuditRowSnapshot(&row{ID: 3, Name: "Verified", APIKey: "k", Private: "p", Edges: map[string]string{"a": "b"}})
+	if got["name"] != "Verified" || got["id"] != float64(3) {
+		t.Errorf("expected plain fields to be kept, got %v", got)
+	}
+	if got["api_key"] != "[redacted]" {
+		t.Errorf("expected api_key to be redacted, got %v", got["api_key"])
+	}
+	if _, ok := got["edges"]; ok {
+		t.Errorf("expected edges to be dropped, got %v", got)
+	}
+	if len(got) != 3 {
+		t.Errorf("expected 3 fields, got %v", got)
+	}
+}
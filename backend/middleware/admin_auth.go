@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"backend-gin/config"
+	apperrors "backend-gin/errors"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -88,11 +89,41 @@ func AdminAuthMiddleware() gin.HandlerFunc {
 		adminID, _ := claims["admin_id"].(float64)
 		adminEmail, _ := claims["email"].(string)
 		adminName, _ := claims["name"].(string)
+		sessionID, _ := claims["sid"].(string)
+
+		// Every admin token is bound to a server-side session (revocation + idle timeout)
+		sess, ok := loadAdminSession(c, int(adminID), sessionID)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, apperrors.ErrorResponse(apperrors.ErrAdminSessionInvalid))
+			return
+		}
 
 		c.Set("admin_id", uint(adminID))
+		c.Set("admin_session_id", sess.SessionID)
+		c.Set("admin_mfa_verified", sess.MfaVerified)
+		if sess.SudoUntil != nil {
+			c.Set("admin_sudo_until", *sess.SudoUntil)
+		}
 		c.Set("admin_email", adminEmail)
 		c.Set("admin_name", adminName)
+		c.Set("admin_roles", claimStrings(claims["roles"]))
+		c.Set("admin_permissions", claimStrings(claims["permissions"]))
 
 		c.Next()
 	}
 }
+
+// claimStrings converts a JSON array claim into a string slice (missing or malformed = empty)
+func claimStrings(v interface{}) []string {
+	items, ok := v.([]interface{})
+	if !ok {
+		return []string{}
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok && s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
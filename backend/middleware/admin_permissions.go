@@ -0,0 +1,174 @@
+package middleware
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Admin permissions, checked per route by RequirePermission.
+// Format is "resource:action"; "resource:*" and "*" act as wildcards.
+const (
+	PermAll = "*"
+
+	PermBadgesRead  = "badges:read"
+	PermBadgesWrite = "badges:write" // create/update/delete badges, assign/revoke user badges
+
+	PermUsersRead        = "users:read"
+	PermUsersModerate    = "users:moderate"    // lock/unlock, revoke sessions, block devices
+	PermUsersMFAReset    = "users:mfa_reset"   // reset a user's TOTP after an out-of-band identity check
+	PermUsersImpersonate = "users:impersonate" // issue read-only "view as user" tokens
+
+	PermSecurityRead   = "security:read"   // SecurityEvent history, search and analytics
+	PermSecurityExport = "security:export" // CSV/JSONL export of SecurityEvents
+	PermSecurityTriage = "security:triage" // mark request inspection hits as false positives
+
+	PermIPRulesRead  = "ip_rules:read"
+	PermIPRulesWrite = "ip_rules:write" // create/update/delete rate limiter IP allow/block rules
+
+	PermCategoriesRead = "categories:read"
+	PermCasesMove      = "cases:move"
+	PermCasesEvidence  = "cases:evidence" // dispute evidence bundles incl. private message transcripts
+	PermCasesSlashes   = "cases:slashes"  // review and decide guarantee slash appeals
+
+	PermSLAPoliciesRead  = "sla_policies:read"
+	PermSLAPoliciesWrite = "sla_policies:write" // owner-response SLA policies per sensitivity/category
+
+	PermDevicesRead = "devices:read"
+
+	PermLZTRead  = "lzt:read"
+	PermLZTProxy = "lzt:proxy" // raw supplier API proxy; can spend supplier balance
+
+	PermAuditRead   = "audit:read"
+	PermAuditExport = "audit:export" // full hash-chained JSONL export
+)
+
+// AdminPermissions lists every concrete permission, for validation and documentation
+var AdminPermissions = []string{
+	PermBadgesRead,
+	PermBadgesWrite,
+	PermUsersRead,
+	PermUsersModerate,
+	PermUsersMFAReset,
+	PermUsersImpersonate,
+	PermSecurityRead,
+	PermSecurityExport,
+	PermSecurityTriage,
+	PermIPRulesRead,
+	PermIPRulesWrite,
+	PermCategoriesRead,
+	PermCasesMove,
+	PermCasesEvidence,
+	PermCasesSlashes,
+	PermSLAPoliciesRead,
+	PermSLAPoliciesWrite,
+	PermDevicesRead,
+	PermLZTRead,
+	PermLZTProxy,
+	PermAuditRead,
+	PermAuditExport,
+}
+
+// BuiltinAdminRole describes a role that is created (and kept in sync) at startup
+type BuiltinAdminRole struct {
+	Name        string
+	Description string
+	Permissions []string
+}
+
+// AdminRoleSuperAdmin holds every permission; existing admins receive it when RBAC is first enabled
+const AdminRoleSuperAdmin = "super_admin"
+
+// BuiltinAdminRoles are the default roles available to seed_admin
+var BuiltinAdminRoles = []BuiltinAdminRole{
+	{
+		Name:        AdminRoleSuperAdmin,
+		Description: "Akses penuh ke seluruh panel admin",
+		Permissions: []string{PermAll},
+	},
+	{
+		Name:        "support",
+		Description: "Melihat pengguna (termasuk view-as-user), riwayat keamanan, aturan IP, badge, kategori dan perangkat (read-only)",
+		Permissions: []string{PermUsersRead, PermUsersImpersonate, PermSecurityRead, PermIPRulesRead, PermBadgesRead, PermCategoriesRead, PermDevicesRead},
+	},
+	{
+		Name:        "moderator",
+		Description: "Mengelola badge, memoderasi akun pengguna dan memindahkan Validation Case",
+		Permissions: []string{PermUsersRead, PermUsersModerate, PermSecurityRead, PermBadgesRead, PermBadgesWrite, PermCategoriesRead, PermCasesMove, PermSLAPoliciesRead},
+	},
+	{
+		Name:        "integrations",
+		Description: "Mengakses integrasi LZT Market termasuk proxy request",
+		Permissions: []string{PermLZTRead, PermLZTProxy},
+	},
+}
+
+// IsValidAdminPermission reports whether p is a known permission or wildcard
+func IsValidAdminPermission(p string) bool {
+	if p == PermAll {
+		return true
+	}
+	if resource, ok := strings.CutSuffix(p, ":*"); ok {
+		for _, known := range AdminPermissions {
+			if strings.HasPrefix(known, resource+":") {
+				return true
+			}
+		}
+		return false
+	}
+	for _, known := range AdminPermissions {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeAdminPermissions returns the sorted, de-duplicated union of permission sets
+func MergeAdminPermissions(sets ...[]string) []string {
+	seen := make(map[string]bool)
+	merged := []string{}
+	for _, set := range sets {
+		for _, p := range set {
+			p = strings.TrimSpace(p)
+			if p == "" || seen[p] {
+				continue
+			}
+			seen[p] = true
+			merged = append(merged, p)
+		}
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+// HasAdminPermission reports whether granted covers required (exact, "resource:*" or "*")
+func HasAdminPermission(granted []string, required string) bool {
+	resource, _, _ := strings.Cut(required, ":")
+	for _, p := range granted {
+		if p == PermAll || p == required || p == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
+
+// RequirePermission rejects admins whose token does not carry the given permission.
+// Must run after AdminAuthMiddleware.
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		granted, _ := c.Get("admin_permissions")
+		perms, _ := granted.([]string)
+		if !HasAdminPermission(perms, permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"code":    "ADMIN007",
+				"message": "Izin admin tidak mencukupi untuk aksi ini",
+				"details": "Diperlukan izin: " + permission,
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHasAdminPermission(t *testing.T) {
+	tests := []struct {
+		name     string
+		granted  []string
+		required string
+		want     bool
+	}{
+		{"exact", []string{PermUsersRead}, PermUsersRead, true},
+		{"other permission", []string{PermUsersRead}, PermCasesMove, false},
+		{"resource wildcard", []string{"lzt:*"}, PermLZTProxy, true},
+		{"other resource wildcard", []string{"badges:*"}, PermLZTProxy, false},
+		{"global wildcard", []string{PermAll}, PermLZTProxy, true},
+		{"none", nil, PermBadgesRead, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasAdminPermission(tt.granted, tt.required); got != tt.want {
+				t.Fatalf("HasAdminPermission(%v, %q) = %v, want %v", tt.granted, tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidAdminPermission(t *testing.T) {
+	for _, p := range []string{PermAll, PermCasesMove, "lzt:*"} {
+		if !IsValidAdminPermission(p) {
+			t.Errorf("expected %q to be valid", p)
+		}
+	}
+	for _, p := range []string{"", "cases:delete", "nope:*"} {
+		if IsValidAdminPermission(p) {
+			t.Errorf("expected %q to be invalid", p)
+		}
+	}
+}
+
+func TestBuiltinAdminRolesUseKnownPermissions(t *testing.T) {
+	for _, role := range BuiltinAdminRoles {
+		for _, p := range role.Permissions {
+			if !IsValidAdminPermission(p) {
+				t.Errorf("role %s has unknown permission %q", role.Name, p)
+			}
+		}
+	}
+}
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(perms []string) *gin.Engine {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set("admin_permissions", perms)
+			c.Next()
+		})
+		router.POST("/proxy", RequirePermission(PermLZTProxy), func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		return router
+	}
+
+	t.Run("granted", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newRouter([]string{PermLZTRead, PermLZTProxy}).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/proxy", nil))
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("expected 204, got %d", rec.Code)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newRouter([]string{PermLZTRead}).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/proxy", nil))
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected 403, got %d", rec.Code)
+		}
+	})
+}
+
+func TestClaimStrings(t *testing.T) {
+	got := claimStrings([]interface{}{"users:read", 42, "", "cases:move"})
+	if len(got) != 2 || got[0] != "users:read" || got[1] != "cases:move" {
+		t.Fatalf("unexpected claim strings: %v", got)
+	}
+	if len(claimStrings(nil)) != 0 {
+		t.Fatal("expected empty slice for missing claim")
+	}
+}
@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"backend-gin/config"
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminsession"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// adminActivityResolution limits last_activity_at writes to one per minute per session
+const adminActivityResolution = time.Minute
+
+func adminSessionIdleTimeout() time.Duration {
+	if config.AdminSessionIdleMinutes > 0 {
+		return time.Duration(config.AdminSessionIdleMinutes) * time.Minute
+	}
+	return 30 * time.Minute
+}
+
+// adminSessionRejectReason returns why a session can no longer be used, or "" if it is valid
+func adminSessionRejectReason(sess *ent.AdminSession, now time.Time, idle time.Duration) string {
+	switch {
+	case sess.RevokedAt != nil:
+		return "revoked"
+	case !now.Before(sess.ExpiresAt):
+		return "expired"
+	case now.Sub(sess.LastActivityAt) > idle:
+		return "idle_timeout"
+	default:
+		return ""
+	}
+}
+
+// loadAdminSession validates the session behind an admin token and records activity.
+// Idle sessions are revoked so they cannot be resumed.
+func loadAdminSession(c *gin.Context, adminID int, sessionID string) (*ent.AdminSession, bool) {
+	if sessionID == "" {
+		return nil, false
+	}
+	ctx := c.Request.Context()
+	client := database.GetEntClient()
+
+	sess, err := client.AdminSession.Query().
+		Where(adminsession.SessionIDEQ(sessionID), adminsession.AdminIDEQ(adminID)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Error("Failed to load admin session", zap.Error(err), zap.Int("admin_id", adminID))
+		}
+		return nil, false
+	}
+
+	now := time.Now()
+	if reason := adminSessionRejectReason(sess, now, adminSessionIdleTimeout()); reason != "" {
+		if reason == "idle_timeout" {
+			if err := client.AdminSession.UpdateOne(sess).
+				SetRevokedAt(now).
+				SetRevokeReason(reason).
+				Exec(ctx); err != nil {
+				logger.Warn("Failed to revoke idle admin session", zap.Error(err), zap.Int("admin_id", adminID))
+			}
+		}
+		return nil, false
+	}
+
+	if now.Sub(sess.LastActivityAt) >= adminActivityResolution {
+		if err := client.AdminSession.UpdateOne(sess).SetLastActivityAt(now).Exec(ctx); err != nil {
+			logger.Warn("Failed to update admin session activity", zap.Error(err), zap.Int("admin_id", adminID))
+		}
+	}
+	return sess, true
+}
+
+// RequireAdminMFA rejects enrollment-only sessions (admins that have not yet passed or
+// enrolled a second factor). Must run after AdminAuthMiddleware.
+func RequireAdminMFA() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool("admin_mfa_verified") {
+			response := apperrors.ErrorResponse(apperrors.ErrAdminMFARequired)
+			response["require_mfa_enrollment"] = true
+			c.AbortWithStatusJSON(http.StatusForbidden, response)
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireAdminSudo requires the admin session to be in sudo mode (POST /admin/auth/sudo).
+// Must run after AdminAuthMiddleware.
+func RequireAdminSudo() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		until, _ := c.Get("admin_sudo_until")
+		t, ok := until.(time.Time)
+		if !ok || !time.Now().Before(t) {
+			response := apperrors.ErrorResponse(apperrors.ErrAdminSudoRequired)
+			response["require_sudo"] = true
+			c.AbortWithStatusJSON(http.StatusForbidden, response)
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireAdminSudoToEnroll guards second-factor enrollment. A session that passed MFA must be
+// in sudo mode to add a factor, so a stolen token cannot plant a persistent TOTP or passkey.
+// Enrollment-only sessions may enroll without sudo only while the admin has no factor yet.
+// Must run after AdminAuthMiddleware.
+func RequireAdminSudoToEnroll() gin.HandlerFunc {
+	return requireAdminSudoToEnroll(adminHasSecondFactor)
+}
+
+func requireAdminSudoToEnroll(hasFactor func(ctx context.Context, adminID int) (bool, error)) gin.HandlerFunc {
+	sudo := RequireAdminSudo()
+	return func(c *gin.Context) {
+		if c.GetBool("admin_mfa_verified") {
+			sudo(c)
+			return
+		}
+		adminID := int(c.GetUint("admin_id"))
+		enrolled, err := hasFactor(c.Request.Context(), adminID)
+		if err != nil {
+			logger.Error("Failed to check admin second factors", zap.Error(err), zap.Int("admin_id", adminID))
+			c.AbortWithStatusJSON(http.StatusInternalServerError, apperrors.ErrorResponse(apperrors.ErrDatabase))
+			return
+		}
+		if enrolled {
+			c.AbortWithStatusJSON(http.StatusForbidden, apperrors.ErrorResponse(apperrors.ErrAdminMFARequired.WithDetails(
+				"Admin sudah memiliki faktor kedua. Login ulang dengan TOTP atau passkey, lalu verifikasi sudo untuk menambah faktor baru")))
+			return
+		}
+		c.Next()
+	}
+}
+
+// adminHasSecondFactor reports whether the admin has enabled TOTP or registered a passkey
+func adminHasSecondFactor(ctx context.Context, adminID int) (bool, error) {
+	client := database.GetEntClient()
+	totp, err := client.Admin.Query().
+		Where(admin.IDEQ(adminID), admin.TotpEnabledEQ(true)).
+		Exist(ctx)
+	if err != nil || totp {
+		return totp, err
+	}
+	return client.AdminPasskey.Query().
+		Where(adminpasskey.AdminIDEQ(adminID)).
+		Exist(ctx)
+}
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"backend-gin/ent"
+	"backend-gin/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAdminSessionRejectReason(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Minute)
+	idle := 30 * time.Minute
+
+	tests := []struct {
+		name string
+		sess *ent.AdminSession
+		want string
+	}{
+		{"active", &ent.AdminSession{ExpiresAt: now.Add(time.Hour), LastActivityAt: now.Add(-5 * time.Minute)}, ""},
+		{"revoked", &ent.AdminSession{ExpiresAt: now.Add(time.Hour), LastActivityAt: now, RevokedAt: &revokedAt}, "revoked"},
+		{"expired", &ent.AdminSession{ExpiresAt: now, LastActivityAt: now}, "expired"},
+		{"idle", &ent.AdminSession{ExpiresAt: now.Add(time.Hour), LastActivityAt: now.Add(-31 * time.Minute)}, "idle_timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := adminSessionRejectReason(tt.sess, now, idle); got != tt.want {
+				t.Fatalf("adminSessionRejectReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequireAdminMFAAndSudo(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mfaVerified bool, sudoUntil interface{}) *gin.Engine {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set("admin_mfa_verified", mfaVerified)
+			if sudoUntil != nil {
+				c.Set("admin_sudo_until", sudoUntil)
+			}
+			c.Next()
+		})
+		router.DELETE("/badges/1", RequireAdminMFA(), RequireAdminSudo(), func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		return router
+	}
+	serve := func(router *gin.Engine) int {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/badges/1", nil))
+		return rec.Code
+	}
+
+	if code := serve(newRouter(false, time.Now().Add(time.Minute))); code != http.StatusForbidden {
+		t.Fatalf("enrollment-only session: expected 403, got %d", code)
+	}
+	if code := serve(newRouter(true, nil)); code != http.StatusForbidden {
+		t.Fatalf("no sudo: expected 403, got %d", code)
+	}
+	if code := serve(newRouter(true, time.Now().Add(-time.Second))); code != http.StatusForbidden {
+		t.Fatalf("expired sudo: expected 403, got %d", code)
+	}
+	if code := serve(newRouter(true, time.Now().Add(time.Minute))); code != http.StatusNoContent {
+		t.Fatalf("sudo active: expected 204, got %d", code)
+	}
+}
+
+func TestRequireAdminSudoToEnroll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	if logger.Log == nil {
+		logger.InitLogger()
+	}
+
+	serve := func(mfaVerified bool, sudoUntil interface{}, hasFactor bool, lookupErr error) int {
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set("admin_id", uint(7))
+			c.Set("admin_mfa_verified", mfaVerified)
+			if sudoUntil != nil {
+				c.Set("admin_sudo_until", sudoUntil)
+			}
+			c.Next()
+		})
+		lookup := func(ctx context.Context, adminID int) (bool, error) {
+			if adminID != 7 {
+				t.Fatalf("unexpected admin id %d", adminID)
+			}
+			return hasFactor, lookupErr
+		}
+		router.POST("/auth/mfa/totp/setup", requireAdminSudoToEnroll(lookup), func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/auth/mfa/totp/setup", nil))
+		return rec.Code
+	}
+
+	if code := serve(false, nil, false, nil); code != http.StatusNoContent {
+		t.Fatalf("first factor from enrollment-only session: expected 204, got %d", code)
+	}
+	if code := serve(false, nil, true, nil); code != http.StatusForbidden {
+		t.Fatalf("enrollment-only session of admin with a factor: expected 403, got %d", code)
+	}
+	if code := serve(false, nil, false, errors.New("db down")); code != http.StatusInternalServerError {
+		t.Fatalf("factor lookup error: expected 500, got %d", code)
+	}
+	if code := serve(true, nil, true, nil); code != http.StatusForbidden {
+		t.Fatalf("MFA session without sudo: expected 403, got %d", code)
+	}
+	if code := serve(true, time.Now().Add(time.Minute), true, nil); code != http.StatusNoContent {
+		t.Fatalf("MFA session in sudo: expected 204, got %d", code)
+	}
+}
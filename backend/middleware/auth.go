@@ -35,6 +35,23 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Admin impersonation tokens are read-only and audited per request. They skip the
+		// account lock so support can still see what a locked user sees.
+		if claims.TokenType == TokenTypeImpersonation {
+			entUser, sess, ok := authenticateImpersonation(c, claims)
+			if !ok {
+				return
+			}
+			c.Set("user", entUser)
+			c.Set("user_id", uint(entUser.ID))
+			c.Set("ent_user", entUser)
+			c.Set("claims", claims)
+			c.Set("impersonation", sess)
+			c.Next()
+			recordImpersonatedRequest(c, sess)
+			return
+		}
+
 		// Validate token type - must be access token
 		if claims.TokenType != "" && claims.TokenType != TokenTypeAccess {
 			abortWithAppError(c, apperrors.ErrInvalidToken.WithDetails("Token type tidak valid"), nil)
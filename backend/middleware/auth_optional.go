@@ -15,6 +15,20 @@ func AuthOptionalMiddleware() gin.HandlerFunc {
 		tokenString, ok := parseBearerToken(c.GetHeader("Authorization"))
 		if ok {
 			claims, err := ParseJWT(tokenString)
+			if err == nil && claims.TokenType == TokenTypeImpersonation {
+				u, sess, ok := authenticateImpersonation(c, claims)
+				if !ok {
+					return
+				}
+				c.Set("user", u)
+				c.Set("user_id", uint(u.ID))
+				c.Set("ent_user", u)
+				c.Set("claims", claims)
+				c.Set("impersonation", sess)
+				c.Next()
+				recordImpersonatedRequest(c, sess)
+				return
+			}
 			if err == nil {
 				// Optional auth should only trust access tokens (or legacy tokens without explicit type).
 				if claims.TokenType != "" && claims.TokenType != TokenTypeAccess {
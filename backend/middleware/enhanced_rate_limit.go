@@ -71,6 +71,20 @@ type EnhancedRateLimiter struct {
 	blacklistCIDRs    []netip.Prefix
 	mu                sync.RWMutex
 	blacklist         map[string]time.Time // IP -> block expiry
+	dynamicAllow      []DynamicIPRule
+	dynamicBlock      []DynamicIPRule
+	onBlock           func(ip string, duration time.Duration)
+}
+
+// DynamicIPRule is an allow or block rule loaded at runtime (from the ip_rules table).
+// A zero ExpiresAt never expires.
+type DynamicIPRule struct {
+	Prefix    netip.Prefix
+	ExpiresAt time.Time
+}
+
+func (d DynamicIPRule) activeFor(addr netip.Addr, now time.Time) bool {
+	return d.Prefix.Contains(addr) && (d.ExpiresAt.IsZero() || now.Before(d.ExpiresAt))
 }
 
 // NewEnhancedRateLimiter creates a new enhanced rate limiter
@@ -110,6 +124,38 @@ func (r *EnhancedRateLimiter) SetRedisClient(client *redis.Client) {
 	r.searchLimiter.SetRedisClient(client)
 }
 
+// SetDynamicIPRules replaces the runtime allow/block rules. Env rules stay in effect.
+func (r *EnhancedRateLimiter) SetDynamicIPRules(allow, block []DynamicIPRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dynamicAllow = allow
+	r.dynamicBlock = block
+}
+
+// OnBlock registers a callback for IPs blocked by the auth limiter, so the block can be
+// persisted and shared with other instances
+func (r *EnhancedRateLimiter) OnBlock(fn func(ip string, duration time.Duration)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onBlock = fn
+}
+
+func (r *EnhancedRateLimiter) matchesDynamic(allow bool, addr netip.Addr) bool {
+	r.mu.RLock()
+	list := r.dynamicBlock
+	if allow {
+		list = r.dynamicAllow
+	}
+	r.mu.RUnlock()
+	now := time.Now()
+	for _, rule := range list {
+		if rule.activeFor(addr, now) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetClientIP returns the client IP resolved by Gin.
 // Important: this respects TrustedProxies configuration set in main.go
 // and avoids trusting spoofable forwarding headers directly.
@@ -133,6 +179,9 @@ func (r *EnhancedRateLimiter) IsWhitelisted(ip string) bool {
 				return true
 			}
 		}
+		if r.matchesDynamic(true, addr) {
+			return true
+		}
 	}
 	return false
 }
@@ -154,6 +203,9 @@ func (r *EnhancedRateLimiter) IsBlacklisted(ip string) bool {
 				return true
 			}
 		}
+		if r.matchesDynamic(false, addr) {
+			return true
+		}
 	}
 
 	// Check dynamic blacklist
@@ -175,11 +227,16 @@ func (r *EnhancedRateLimiter) IsBlacklisted(ip string) bool {
 	return false
 }
 
-// BlockIP temporarily blocks an IP
+// BlockIP temporarily blocks an IP on this instance and reports it to the OnBlock callback
 func (r *EnhancedRateLimiter) BlockIP(ip string, duration time.Duration) {
 	r.mu.Lock()
-	defer r.mu.Unlock()
 	r.blacklist[normalizedIPKey(ip)] = time.Now().Add(duration)
+	onBlock := r.onBlock
+	r.mu.Unlock()
+
+	if onBlock != nil {
+		onBlock(ip, duration)
+	}
 }
 
 // Middleware returns a Gin middleware for rate limiting
@@ -3,6 +3,7 @@ package middleware
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"strings"
 	"testing"
 	"time"
@@ -271,3 +272,45 @@ func TestEnhancedRateLimiter_DynamicBlacklistUsesNormalizedIPKey(t *testing.T) {
 		t.Fatalf("expected equivalent IPv6 address to be blacklisted")
 	}
 }
+
+func TestEnhancedRateLimiter_DynamicIPRules(t *testing.T) {
+	limiter := NewEnhancedRateLimiter(DefaultRateLimitConfig())
+
+	limiter.SetDynamicIPRules(
+		[]DynamicIPRule{{Prefix: netip.MustParsePrefix("198.51.100.0/24")}},
+		[]DynamicIPRule{
+			{Prefix: netip.MustParsePrefix("203.0.113.0/24")},
+			{Prefix: netip.MustParsePrefix("192.0.2.1/32"), ExpiresAt: time.Now().Add(-time.Minute)},
+		},
+	)
+
+	if !limiter.IsWhitelisted("198.51.100.20") {
+		t.Fatalf("expected dynamic allow rule to whitelist IP")
+	}
+	if !limiter.IsBlacklisted("203.0.113.9") {
+		t.Fatalf("expected dynamic block rule to blacklist IP")
+	}
+	if limiter.IsBlacklisted("192.0.2.1") {
+		t.Fatalf("expired dynamic block rule should not apply")
+	}
+
+	limiter.SetDynamicIPRules(nil, nil)
+	if limiter.IsBlacklisted("203.0.113.9") {
+		t.Fatalf("replaced rules should no longer apply")
+	}
+}
+
+func TestEnhancedRateLimiter_BlockIPNotifiesCallback(t *testing.T) {
+	limiter := NewEnhancedRateLimiter(DefaultRateLimitConfig())
+
+	var gotIP string
+	var gotDuration time.Duration
+	limiter.OnBlock(func(ip string, duration time.Duration) {
+		gotIP, gotDuration = ip, duration
+	})
+	limiter.BlockIP("203.0.113.5", 15*time.Minute)
+
+	if gotIP != "203.0.113.5" || gotDuration != 15*time.Minute {
+		t.Fatalf("unexpected callback args: %q %v", gotIP, gotDuration)
+	}
+}
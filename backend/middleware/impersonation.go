@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/impersonationsession"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// Response headers that mark an impersonated request, so clients can show a banner
+const (
+	ImpersonationHeader          = "X-Impersonation"
+	ImpersonationAdminHeader     = "X-Impersonated-By"
+	ImpersonationExpiresAtHeader = "X-Impersonation-Expires-At"
+)
+
+// impersonationAuditPrefix marks impersonated requests in the admin audit trail
+const impersonationAuditPrefix = "IMPERSONATE "
+
+// ImpersonationFromContext returns the impersonation session behind the request, if any
+func ImpersonationFromContext(c *gin.Context) (*ent.ImpersonationSession, bool) {
+	v, ok := c.Get("impersonation")
+	if !ok {
+		return nil, false
+	}
+	sess, ok := v.(*ent.ImpersonationSession)
+	return sess, ok
+}
+
+// impersonationAllowsMethod reports whether an impersonation token may be used for method
+func impersonationAllowsMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// RejectImpersonation blocks impersonation tokens on GET routes that have side effects
+// (e.g. revealing a contact writes a case log entry as the user)
+func RejectImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := ImpersonationFromContext(c); ok {
+			abortWithAppError(c, apperrors.ErrImpersonationReadOnly, nil)
+			return
+		}
+		c.Next()
+	}
+}
+
+// authenticateImpersonation validates an impersonation token against its session row and
+// loads the impersonated user. It writes the error response on failure.
+func authenticateImpersonation(c *gin.Context, claims *Claims) (*ent.User, *ent.ImpersonationSession, bool) {
+	if !impersonationAllowsMethod(c.Request.Method) {
+		abortWithAppError(c, apperrors.ErrImpersonationReadOnly, nil)
+		return nil, nil, false
+	}
+	if claims.JTI == "" || claims.UserID == 0 || claims.ImpersonatorID == 0 {
+		abortWithAppError(c, apperrors.ErrInvalidToken.WithDetails("Token tidak valid"), nil)
+		return nil, nil, false
+	}
+
+	ctx := c.Request.Context()
+	client := database.GetEntClient()
+	sess, err := client.ImpersonationSession.Query().
+		Where(
+			impersonationsession.JtiEQ(claims.JTI),
+			impersonationsession.UserIDEQ(int(claims.UserID)),
+			impersonationsession.AdminIDEQ(int(claims.ImpersonatorID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) && !isRequestContextError(err) {
+			logger.Error("Failed to validate impersonation session", zap.Error(err), zap.String("jti", claims.JTI))
+		}
+		abortWithAppError(c, apperrors.ErrSessionInvalid, nil)
+		return nil, nil, false
+	}
+	if sess.RevokedAt != nil || !sess.ExpiresAt.After(time.Now()) {
+		abortWithAppError(c, apperrors.ErrSessionInvalid, nil)
+		return nil, nil, false
+	}
+
+	u, err := client.User.Get(ctx, sess.UserID)
+	if err != nil {
+		abortWithAppError(c, apperrors.ErrInvalidToken.WithDetails("User tidak ditemukan"), nil)
+		return nil, nil, false
+	}
+
+	c.Header(ImpersonationHeader, "true")
+	c.Header(ImpersonationAdminHeader, strconv.Itoa(sess.AdminID))
+	c.Header(ImpersonationExpiresAtHeader, sess.ExpiresAt.UTC().Format(time.RFC3339))
+	c.Header("Cache-Control", "no-store")
+	return u, sess, true
+}
+
+// recordImpersonatedRequest appends the request to the admin audit trail under the
+// impersonating admin and bumps the session's usage counters. Call after c.Next().
+func recordImpersonatedRequest(c *gin.Context, sess *ent.ImpersonationSession) {
+	action := c.FullPath()
+	if action == "" {
+		action = c.Request.URL.Path
+	}
+	status := c.Writer.Status()
+
+	// The request context may already be cancelled; the audit row must still land
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := database.GetEntClient()
+	err := client.AdminAuditLog.Create().
+		SetAdminID(sess.AdminID).
+		SetAdminEmail(sess.AdminEmail).
+		SetAction(truncateAudit(impersonationAuditPrefix+c.Request.Method+" "+action, 200)).
+		SetMethod(c.Request.Method).
+		SetPath(truncateAudit(c.Request.URL.RequestURI(), 512)).
+		SetTargetType("User").
+		SetTargetID(strconv.Itoa(sess.UserID)).
+		SetRequestID(truncateAudit(GetRequestID(c), 64)).
+		SetIPAddress(truncateAudit(c.ClientIP(), 45)).
+		SetUserAgent(truncateAudit(c.GetHeader("User-Agent"), 512)).
+		SetReason(truncateAudit(sess.Reason, maxAdminAuditReasonLen)).
+		SetStatusCode(status).
+		SetSuccess(status < http.StatusBadRequest).
+		Exec(ctx)
+	if err != nil {
+		logger.Error("Failed to write impersonation audit log",
+			zap.Error(err),
+			zap.Int("impersonation_id", sess.ID),
+			zap.String("action", action))
+	}
+
+	if err := client.ImpersonationSession.UpdateOneID(sess.ID).
+		SetLastUsedAt(time.Now()).
+		AddRequestCount(1).
+		Exec(ctx); err != nil {
+		logger.Warn("Failed to update impersonation session usage", zap.Error(err), zap.Int("impersonation_id", sess.ID))
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"backend-gin/config"
+	"backend-gin/ent"
+
+	"github.com/gin-gonic/gin"
+)
+
+func withTestJWTConfig(t *testing.T) {
+	t.Helper()
+	key, issuer, audience := config.JWTKey, config.JWTIssuer, config.JWTAudience
+	config.JWTKey = []byte("test-secret")
+	config.JWTIssuer = "test-issuer"
+	config.JWTAudience = "test-audience"
+	t.Cleanup(func() {
+		config.JWTKey, config.JWTIssuer, config.JWTAudience = key, issuer, audience
+	})
+}
+
+func TestGenerateImpersonationToken_Claims(t *testing.T) {
+	withTestJWTConfig(t)
+
+	token, jti, err := GenerateImpersonationToken(42, "user@example.com", "user", 7, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	claims, err := ParseJWT(token)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if claims.TokenType != TokenTypeImpersonation || claims.UserID != 42 || claims.ImpersonatorID != 7 || claims.JTI != jti {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	if ttl := time.Until(claims.ExpiresAt.Time); ttl > 15*time.Minute || ttl < 14*time.Minute {
+		t.Fatalf("unexpected expiry in %s", ttl)
+	}
+}
+
+func TestAuthMiddleware_ImpersonationTokenRejectsWrites(t *testing.T) {
+	withTestJWTConfig(t)
+	gin.SetMode(gin.TestMode)
+	token, _, err := GenerateImpersonationToken(42, "user@example.com", "user", 7, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := gin.New()
+	r.Use(AuthMiddleware())
+	handler := func(c *gin.Context) { c.Status(http.StatusOK) }
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		r.Handle(method, "/resource", handler)
+
+		req := httptest.NewRequest(method, "/resource", strings.NewReader(`{}`))
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), "AUTH026") {
+			t.Fatalf("%s: expected AUTH026, got %d %s", method, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestRejectImpersonation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/contact", func(c *gin.Context) {
+		if c.Query("impersonated") == "1" {
+			c.Set("impersonation", &ent.ImpersonationSession{ID: 1})
+		}
+		c.Next()
+	}, RejectImpersonation(), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/contact", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected regular request to pass, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/contact?impersonated=1", nil))
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected impersonated request to be rejected, got %d", w.Code)
+	}
+}
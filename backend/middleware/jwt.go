@@ -17,6 +17,8 @@ type TokenType string
 const (
 	TokenTypeAccess  TokenType = "access"
 	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeImpersonation is a read-only access token issued to an admin for a user
+	TokenTypeImpersonation TokenType = "impersonation"
 )
 
 // Claims represents JWT claims with enhanced security fields
@@ -27,6 +29,8 @@ type Claims struct {
 	TotpEnabled bool      `json:"totp_enabled"`
 	TokenType   TokenType `json:"type"`
 	JTI         string    `json:"jti"` // Unique token ID
+	// ImpersonatorID is the admin behind an impersonation token
+	ImpersonatorID uint `json:"imp_admin_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -83,6 +87,32 @@ func GenerateRefreshToken(userID uint, email string, username string, totpEnable
 	return signed, jti, err
 }
 
+// GenerateImpersonationToken creates a read-only access token for a user on behalf of an
+// admin. It has no refresh token; AuthMiddleware also requires a matching
+// ImpersonationSession row.
+func GenerateImpersonationToken(userID uint, email string, username string, adminID uint, ttl time.Duration) (string, string, error) {
+	jti := generateJTI()
+	now := time.Now()
+	claims := &Claims{
+		UserID:         userID,
+		Email:          email,
+		Username:       username,
+		TokenType:      TokenTypeImpersonation,
+		JTI:            jti,
+		ImpersonatorID: adminID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    config.JWTIssuer,
+			Subject:   fmt.Sprintf("%d", userID),
+			Audience:  jwt.ClaimStrings{config.JWTAudience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(config.JWTKey)
+	return signed, jti, err
+}
+
 // GenerateJWT creates a JWT token (legacy - for backward compatibility during migration)
 func GenerateJWT(email string, duration time.Duration) (string, error) {
 	claims := &Claims{
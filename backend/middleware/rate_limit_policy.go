@@ -0,0 +1,530 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Rate limit policy algorithms
+const (
+	PolicySlidingWindow = "sliding_window"
+	PolicyTokenBucket   = "token_bucket"
+)
+
+// Rate limit policy keys
+const (
+	PolicyKeyIP        = "ip"
+	PolicyKeyUser      = "user"       // authenticated user, IP for anonymous requests
+	PolicyKeyUserRoute = "user_route" // user (or IP) per matched route pattern
+)
+
+// RateLimitPolicy maps a route pattern to a limit. Route is a Gin route pattern
+// ("/api/validation-cases/:id/contact"); a trailing "*" matches by prefix.
+// For token_bucket, Limit is the bucket size and it refills Limit tokens per Window.
+type RateLimitPolicy struct {
+	Name      string         `json:"name"`
+	Method    string         `json:"method,omitempty"`
+	Route     string         `json:"route"`
+	Algorithm string         `json:"algorithm"`
+	Key       string         `json:"key"`
+	Limit     int            `json:"limit"`
+	Window    PolicyDuration `json:"window"`
+}
+
+// RateLimitPolicyFile is the policy file format. The first matching policy applies.
+type RateLimitPolicyFile struct {
+	Policies []RateLimitPolicy `json:"policies"`
+}
+
+// PolicyDuration reads durations written as Go duration strings ("1m", "24h")
+type PolicyDuration time.Duration
+
+func (d *PolicyDuration) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("window must be a duration string: %w", err)
+	}
+	parsed, err := time.ParseDuration(raw)
+	if err != nil {
+		return err
+	}
+	*d = PolicyDuration(parsed)
+	return nil
+}
+
+func (d PolicyDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// ParseRateLimitPolicies decodes and validates a policy file
+func ParseRateLimitPolicies(data []byte) ([]RateLimitPolicy, error) {
+	var file RateLimitPolicyFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(file.Policies))
+	for i := range file.Policies {
+		p := &file.Policies[i]
+		p.Method = strings.ToUpper(strings.TrimSpace(p.Method))
+		if p.Name == "" {
+			return nil, fmt.Errorf("policy %d: name is required", i)
+		}
+		if names[p.Name] {
+			return nil, fmt.Errorf("policy %q: duplicate name", p.Name)
+		}
+		names[p.Name] = true
+		if p.Route == "" {
+			return nil, fmt.Errorf("policy %q: route is required", p.Name)
+		}
+		switch p.Algorithm {
+		case PolicySlidingWindow, PolicyTokenBucket:
+		default:
+			return nil, fmt.Errorf("policy %q: unknown algorithm %q", p.Name, p.Algorithm)
+		}
+		switch p.Key {
+		case PolicyKeyIP, PolicyKeyUser, PolicyKeyUserRoute:
+		default:
+			return nil, fmt.Errorf("policy %q: unknown key %q", p.Name, p.Key)
+		}
+		if p.Limit < 1 {
+			return nil, fmt.Errorf("policy %q: limit must be positive", p.Name)
+		}
+		if time.Duration(p.Window) < time.Second {
+			return nil, fmt.Errorf("policy %q: window must be at least 1s", p.Name)
+		}
+	}
+	return file.Policies, nil
+}
+
+func (p *RateLimitPolicy) matches(method, route string) bool {
+	if p.Method != "" && p.Method != "*" && p.Method != method {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(p.Route, "*"); ok {
+		return strings.HasPrefix(route, prefix)
+	}
+	return p.Route == route
+}
+
+// rateDecision is the outcome of one request against a policy
+type rateDecision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is when the full quota is available again
+	Reset time.Duration
+	// RetryAfter is when the next request would be allowed (only when denied)
+	RetryAfter time.Duration
+}
+
+// PolicyRateLimiter enforces per-route policies loaded from a file
+type PolicyRateLimiter struct {
+	policies    atomic.Pointer[[]RateLimitPolicy]
+	redisClient *redis.Client
+	exempt      func(ip string) bool
+
+	mu        sync.Mutex
+	windows   map[string][]time.Time
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+type tokenBucket struct {
+	tokens float64
+	at     time.Time
+}
+
+// NewPolicyRateLimiter creates a limiter with the given policies
+func NewPolicyRateLimiter(policies []RateLimitPolicy) *PolicyRateLimiter {
+	r := &PolicyRateLimiter{
+		windows: make(map[string][]time.Time),
+		buckets: make(map[string]*tokenBucket),
+	}
+	r.SetPolicies(policies)
+	return r
+}
+
+// SetRedisClient enables distributed counters
+func (r *PolicyRateLimiter) SetRedisClient(client *redis.Client) {
+	r.redisClient = client
+}
+
+// SetExempt skips policies for IPs the callback accepts (e.g. the rate limiter whitelist)
+func (r *PolicyRateLimiter) SetExempt(fn func(ip string) bool) {
+	r.exempt = fn
+}
+
+// SetPolicies replaces the active policies
+func (r *PolicyRateLimiter) SetPolicies(policies []RateLimitPolicy) {
+	r.policies.Store(&policies)
+}
+
+// Policies returns the active policies
+func (r *PolicyRateLimiter) Policies() []RateLimitPolicy {
+	return *r.policies.Load()
+}
+
+// LoadFile replaces the policies with the contents of path. On error the current
+// policies stay in effect.
+func (r *PolicyRateLimiter) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	policies, err := ParseRateLimitPolicies(data)
+	if err != nil {
+		return err
+	}
+	r.SetPolicies(policies)
+	return nil
+}
+
+// WatchFile reloads path whenever its modification time or size changes
+func (r *PolicyRateLimiter) WatchFile(path string, interval time.Duration) {
+	if r.stopCh != nil {
+		return
+	}
+	r.stopCh = make(chan struct{})
+	r.doneCh = make(chan struct{})
+
+	var lastMod time.Time
+	var lastSize int64
+	if info, err := os.Stat(path); err == nil {
+		lastMod, lastSize = info.ModTime(), info.Size()
+	}
+
+	go func() {
+		defer close(r.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				info, err := os.Stat(path)
+				if err != nil || (info.ModTime().Equal(lastMod) && info.Size() == lastSize) {
+					continue
+				}
+				lastMod, lastSize = info.ModTime(), info.Size()
+				if err := r.LoadFile(path); err != nil {
+					logger.Error("Invalid rate limit policy file, keeping previous policies",
+						zap.String("path", path), zap.Error(err))
+					continue
+				}
+				logger.Info("Rate limit policies reloaded",
+					zap.String("path", path), zap.Int("policies", len(r.Policies())))
+			case <-r.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop ends WatchFile
+func (r *PolicyRateLimiter) Stop() {
+	if r.stopCh == nil {
+		return
+	}
+	close(r.stopCh)
+	<-r.doneCh
+}
+
+// Middleware applies the first policy matching the request's route pattern and sets
+// RateLimit-Limit/Remaining/Reset (plus Retry-After when denied)
+func (r *PolicyRateLimiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			c.Next()
+			return
+		}
+		policy := r.match(c.Request.Method, route)
+		if policy == nil {
+			c.Next()
+			return
+		}
+		ip := GetClientIP(c)
+		if r.exempt != nil && r.exempt(ip) {
+			c.Next()
+			return
+		}
+
+		key := "ratelimit:policy:" + policy.Name + ":" + policyRequestKey(c, policy.Key, ip, route)
+		decision := r.take(policy, key, time.Now())
+		setRateLimitHeaders(c, policy, decision)
+
+		if !decision.Allowed {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"success": false,
+				"code":    apperrors.ErrRateLimitExceeded.Code,
+				"message": "Terlalu banyak permintaan. Silakan coba lagi nanti.",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func (r *PolicyRateLimiter) match(method, route string) *RateLimitPolicy {
+	policies := r.Policies()
+	for i := range policies {
+		if policies[i].matches(method, route) {
+			return &policies[i]
+		}
+	}
+	return nil
+}
+
+// policyRequestKey identifies the caller. User keys fall back to the verified access
+// token (route auth middleware has not run yet) and then to the IP.
+func policyRequestKey(c *gin.Context, keyType, ip, route string) string {
+	if keyType == PolicyKeyIP {
+		return "ip:" + ip
+	}
+	identity := getUserRateLimitKey(c)
+	if identity == "" {
+		if token, ok := parseBearerToken(c.GetHeader("Authorization")); ok {
+			if claims, err := ParseJWT(token); err == nil && claims.UserID > 0 &&
+				(claims.TokenType == "" || claims.TokenType == TokenTypeAccess) {
+				identity = "user:" + strconv.FormatUint(uint64(claims.UserID), 10)
+			}
+		}
+	}
+	if identity == "" {
+		identity = "ip:" + ip
+	}
+	if keyType == PolicyKeyUserRoute {
+		return identity + ":" + route
+	}
+	return identity
+}
+
+func setRateLimitHeaders(c *gin.Context, policy *RateLimitPolicy, d rateDecision) {
+	c.Header("RateLimit-Limit", strconv.Itoa(d.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+	c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(d.Reset)))
+	c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Limit, ceilSeconds(time.Duration(policy.Window))))
+}
+
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
+
+func (r *PolicyRateLimiter) take(policy *RateLimitPolicy, key string, now time.Time) rateDecision {
+	if r.redisClient != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		var (
+			d   rateDecision
+			err error
+		)
+		if policy.Algorithm == PolicyTokenBucket {
+			d, err = r.takeTokenBucketRedis(ctx, policy, key, now)
+		} else {
+			d, err = r.takeSlidingWindowRedis(ctx, policy, key, now)
+		}
+		if err == nil {
+			return d
+		}
+		// Fall through to in-memory on Redis error
+	}
+	if policy.Algorithm == PolicyTokenBucket {
+		return r.takeTokenBucket(policy, key, now)
+	}
+	return r.takeSlidingWindow(policy, key, now)
+}
+
+func (r *PolicyRateLimiter) takeSlidingWindow(policy *RateLimitPolicy, key string, now time.Time) rateDecision {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweepLocked(now)
+
+	window := time.Duration(policy.Window)
+	cutoff := now.Add(-window)
+	var hits []time.Time
+	for _, t := range r.windows[key] {
+		if t.After(cutoff) {
+			hits = append(hits, t)
+		}
+	}
+	d := rateDecision{Limit: policy.Limit}
+	if len(hits) < policy.Limit {
+		hits = append(hits, now)
+		d.Allowed = true
+	} else {
+		d.RetryAfter = hits[0].Add(window).Sub(now)
+	}
+	r.windows[key] = hits
+	d.Remaining = policy.Limit - len(hits)
+	d.Reset = hits[len(hits)-1].Add(window).Sub(now)
+	return d
+}
+
+func (r *PolicyRateLimiter) takeTokenBucket(policy *RateLimitPolicy, key string, now time.Time) rateDecision {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweepLocked(now)
+
+	capacity := float64(policy.Limit)
+	rate := capacity / time.Duration(policy.Window).Seconds() // tokens per second
+	b := r.buckets[key]
+	if b == nil {
+		b = &tokenBucket{tokens: capacity, at: now}
+		r.buckets[key] = b
+	}
+	b.tokens = math.Min(capacity, b.tokens+now.Sub(b.at).Seconds()*rate)
+	b.at = now
+	return bucketDecision(policy.Limit, rate, &b.tokens)
+}
+
+// bucketDecision takes one token if available and describes the bucket afterwards
+func bucketDecision(limit int, rate float64, tokens *float64) rateDecision {
+	d := rateDecision{Limit: limit}
+	if *tokens >= 1 {
+		*tokens--
+		d.Allowed = true
+	} else {
+		d.RetryAfter = time.Duration((1 - *tokens) / rate * float64(time.Second))
+	}
+	d.Remaining = int(math.Floor(*tokens))
+	d.Reset = time.Duration((float64(limit) - *tokens) / rate * float64(time.Second))
+	return d
+}
+
+// sweepLocked drops idle in-memory entries at most once a minute
+func (r *PolicyRateLimiter) sweepLocked(now time.Time) {
+	if now.Sub(r.lastSweep) < time.Minute {
+		return
+	}
+	r.lastSweep = now
+	longest := time.Duration(0)
+	for _, p := range r.Policies() {
+		if w := time.Duration(p.Window); w > longest {
+			longest = w
+		}
+	}
+	for key, hits := range r.windows {
+		if len(hits) == 0 || now.Sub(hits[len(hits)-1]) > longest {
+			delete(r.windows, key)
+		}
+	}
+	for key, b := range r.buckets {
+		if now.Sub(b.at) > longest {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+// Sliding window in a sorted set. Returns {allowed, count, oldest_us, newest_us}.
+var slidingWindowScript = redis.NewScript(`
+	local key = KEYS[1]
+	local now = tonumber(ARGV[1])
+	local window = tonumber(ARGV[2])
+	local limit = tonumber(ARGV[3])
+
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+	local count = redis.call('ZCARD', key)
+	local allowed = 0
+	if count < limit then
+		redis.call('ZADD', key, now, now .. ':' .. math.random(1000000))
+		count = count + 1
+		allowed = 1
+	end
+	redis.call('PEXPIRE', key, math.ceil(window / 1000))
+	local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+	local newest = redis.call('ZRANGE', key, -1, -1, 'WITHSCORES')
+	return {allowed, count, tonumber(oldest[2] or now), tonumber(newest[2] or now)}
+`)
+
+func (r *PolicyRateLimiter) takeSlidingWindowRedis(ctx context.Context, policy *RateLimitPolicy, key string, now time.Time) (rateDecision, error) {
+	window := time.Duration(policy.Window)
+	res, err := slidingWindowScript.Run(ctx, r.redisClient, []string{key},
+		now.UnixMicro(), window.Microseconds(), policy.Limit).Int64Slice()
+	if err != nil {
+		return rateDecision{}, err
+	}
+	nowUs := now.UnixMicro()
+	d := rateDecision{
+		Allowed:   res[0] == 1,
+		Limit:     policy.Limit,
+		Remaining: policy.Limit - int(res[1]),
+		Reset:     time.Duration(res[3]+window.Microseconds()-nowUs) * time.Microsecond,
+	}
+	if !d.Allowed {
+		d.RetryAfter = time.Duration(res[2]+window.Microseconds()-nowUs) * time.Microsecond
+	}
+	if d.Remaining < 0 {
+		d.Remaining = 0
+	}
+	return d, nil
+}
+
+// Token bucket in a hash of {tokens, at_us}. Returns {allowed, tokens * 1000}.
+var tokenBucketScript = redis.NewScript(`
+	local key = KEYS[1]
+	local now = tonumber(ARGV[1])
+	local capacity = tonumber(ARGV[2])
+	local rate = tonumber(ARGV[3]) -- tokens per microsecond
+	local ttl_ms = tonumber(ARGV[4])
+
+	local state = redis.call('HMGET', key, 'tokens', 'at')
+	local tokens = tonumber(state[1]) or capacity
+	local at = tonumber(state[2]) or now
+	tokens = math.min(capacity, tokens + math.max(0, now - at) * rate)
+	local allowed = 0
+	if tokens >= 1 then
+		tokens = tokens - 1
+		allowed = 1
+	end
+	redis.call('HSET', key, 'tokens', tostring(tokens), 'at', now)
+	redis.call('PEXPIRE', key, ttl_ms)
+	return {allowed, math.floor(tokens * 1000)}
+`)
+
+func (r *PolicyRateLimiter) takeTokenBucketRedis(ctx context.Context, policy *RateLimitPolicy, key string, now time.Time) (rateDecision, error) {
+	window := time.Duration(policy.Window)
+	rate := float64(policy.Limit) / window.Seconds()
+	res, err := tokenBucketScript.Run(ctx, r.redisClient, []string{key},
+		now.UnixMicro(), policy.Limit, rate/1e6, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return rateDecision{}, err
+	}
+	// The script already took the token; describe the bucket from the remaining tokens
+	tokens := float64(res[1]) / 1000
+	d := rateDecision{
+		Allowed:   res[0] == 1,
+		Limit:     policy.Limit,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration((float64(policy.Limit) - tokens) / rate * float64(time.Second)),
+	}
+	if !d.Allowed {
+		d.RetryAfter = time.Duration((1 - tokens) / rate * float64(time.Second))
+	}
+	return d, nil
+}
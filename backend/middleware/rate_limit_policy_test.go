@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseRateLimitPolicies_Validates(t *testing.T) {
+	valid := `{"policies":[{"name":"contact","method":"get","route":"/api/x/:id","algorithm":"sliding_window","key":"user","limit":5,"window":"1m"}]}`
+	policies, err := ParseRateLimitPolicies([]byte(valid))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policies[0].Method != "GET" || time.Duration(policies[0].Window) != time.Minute {
+		t.Fatalf("unexpected policy: %+v", policies[0])
+	}
+
+	invalid := map[string]string{
+		"unknown algorithm": `{"policies":[{"name":"a","route":"/x","algorithm":"leaky","key":"ip","limit":1,"window":"1m"}]}`,
+		"unknown key":       `{"policies":[{"name":"a","route":"/x","algorithm":"token_bucket","key":"session","limit":1,"window":"1m"}]}`,
+		"zero limit":        `{"policies":[{"name":"a","route":"/x","algorithm":"token_bucket","key":"ip","limit":0,"window":"1m"}]}`,
+		"short window":      `{"policies":[{"name":"a","route":"/x","algorithm":"token_bucket","key":"ip","limit":1,"window":"10ms"}]}`,
+		"duplicate name":    `{"policies":[{"name":"a","route":"/x","algorithm":"token_bucket","key":"ip","limit":1,"window":"1m"},{"name":"a","route":"/y","algorithm":"token_bucket","key":"ip","limit":1,"window":"1m"}]}`,
+		"unknown field":     `{"policies":[{"name":"a","route":"/x","algorithm":"token_bucket","key":"ip","limit":1,"window":"1m","burst":3}]}`,
+		"bad duration":      `{"policies":[{"name":"a","route":"/x","algorithm":"token_bucket","key":"ip","limit":1,"window":"soon"}]}`,
+	}
+	for name, raw := range invalid {
+		if _, err := ParseRateLimitPolicies([]byte(raw)); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestRateLimitPolicy_FirstMatchWins(t *testing.T) {
+	limiter := NewPolicyRateLimiter([]RateLimitPolicy{
+		{Name: "contact", Method: "GET", Route: "/api/cases/:id/contact"},
+		{Name: "default", Route: "/api/*"},
+	})
+
+	if p := limiter.match("GET", "/api/cases/:id/contact"); p == nil || p.Name != "contact" {
+		t.Fatalf("expected contact policy, got %+v", p)
+	}
+	if p := limiter.match("POST", "/api/cases/:id/contact"); p == nil || p.Name != "default" {
+		t.Fatalf("expected default policy for other method, got %+v", p)
+	}
+	if p := limiter.match("GET", "/admin/users"); p != nil {
+		t.Fatalf("expected no policy, got %+v", p)
+	}
+}
+
+func TestPolicyRateLimiter_SlidingWindowHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewPolicyRateLimiter([]RateLimitPolicy{
+		{Name: "contact", Route: "/cases/:id/contact", Algorithm: PolicySlidingWindow, Key: PolicyKeyIP, Limit: 2, Window: PolicyDuration(time.Minute)},
+	})
+	router := gin.New()
+	router.Use(limiter.Middleware())
+	router.GET("/cases/:id/contact", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/cases/7/contact", nil)
+		req.RemoteAddr = "203.0.113.10:1234"
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := do()
+	if first.Code != http.StatusOK || first.Header().Get("RateLimit-Limit") != "2" || first.Header().Get("RateLimit-Remaining") != "1" {
+		t.Fatalf("unexpected first response: %d %v", first.Code, first.Header())
+	}
+	if first.Header().Get("RateLimit-Policy") != "2;w=60" {
+		t.Fatalf("unexpected RateLimit-Policy %q", first.Header().Get("RateLimit-Policy"))
+	}
+	do()
+	denied := do()
+	if denied.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", denied.Code)
+	}
+	if denied.Header().Get("RateLimit-Remaining") != "0" || denied.Header().Get("Retry-After") == "" {
+		t.Fatalf("expected exhausted headers, got %v", denied.Header())
+	}
+	if !strings.Contains(denied.Body.String(), "Terlalu banyak permintaan") {
+		t.Fatalf("unexpected body %s", denied.Body.String())
+	}
+}
+
+func TestPolicyRateLimiter_TokenBucketRefills(t *testing.T) {
+	policy := RateLimitPolicy{Name: "orders", Route: "/orders", Algorithm: PolicyTokenBucket, Key: PolicyKeyUser, Limit: 2, Window: PolicyDuration(10 * time.Second)}
+	limiter := NewPolicyRateLimiter([]RateLimitPolicy{policy})
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if d := limiter.take(&policy, "k", now); !d.Allowed {
+			t.Fatalf("request %d should be allowed", i)
+		}
+	}
+	d := limiter.take(&policy, "k", now)
+	if d.Allowed || d.RetryAfter != 5*time.Second {
+		t.Fatalf("expected denial with 5s retry, got %+v", d)
+	}
+	// One token refills every 5 seconds
+	if d := limiter.take(&policy, "k", now.Add(5*time.Second)); !d.Allowed || d.Remaining != 0 {
+		t.Fatalf("expected refilled token, got %+v", d)
+	}
+}
+
+func TestPolicyRequestKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/x", nil)
+
+	if got := policyRequestKey(c, PolicyKeyUser, "203.0.113.1", "/x"); got != "ip:203.0.113.1" {
+		t.Fatalf("anonymous user key should fall back to IP, got %q", got)
+	}
+	c.Set("user_id", uint(42))
+	if got := policyRequestKey(c, PolicyKeyUser, "203.0.113.1", "/x"); got != "user:42" {
+		t.Fatalf("unexpected user key %q", got)
+	}
+	if got := policyRequestKey(c, PolicyKeyUserRoute, "203.0.113.1", "/x/:id"); got != "user:42:/x/:id" {
+		t.Fatalf("unexpected user_route key %q", got)
+	}
+	if got := policyRequestKey(c, PolicyKeyIP, "203.0.113.1", "/x"); got != "ip:203.0.113.1" {
+		t.Fatalf("unexpected ip key %q", got)
+	}
+}
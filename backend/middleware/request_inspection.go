@@ -0,0 +1,504 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apperrors "backend-gin/errors"
+	"backend-gin/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Request inspection modes
+const (
+	InspectionOff     = "off"
+	InspectionMonitor = "monitor" // record hits, never reject
+	InspectionBlock   = "block"   // reject requests with a hit from a blocking detector
+)
+
+// Request inspection detectors
+const (
+	DetectorSQLInjection     = "sql_injection"
+	DetectorXSS              = "xss"
+	DetectorPathTraversal    = "path_traversal"
+	DetectorCommandInjection = "command_injection"
+	DetectorLDAPInjection    = "ldap_injection"
+	DetectorHomoglyph        = "homoglyph"
+	DetectorEntropy          = "entropy"
+)
+
+// Where an inspected value came from. Field names are "<location>:<name>", e.g.
+// "query:q", "param:id" or "body:content.sections.text" (array indexes are dropped).
+const (
+	InspectQuery = "query"
+	InspectParam = "param"
+	InspectBody  = "body"
+)
+
+const (
+	defaultInspectionMaxBody = 1 << 20
+	inspectionSampleLen      = 120
+	inspectionHookTimeout    = 5 * time.Second
+)
+
+// InspectionDetector runs one check over a value. Blocking detectors reject the request in
+// block mode; the others are only recorded because their false positive rate is too high.
+type InspectionDetector struct {
+	Name     string
+	Blocking bool
+	Detect   func(string) (bool, string)
+}
+
+// DefaultInspectionDetectors wraps InputSecurityValidator and AdvancedSecurityValidator.
+// Homoglyph and entropy checks never block.
+func DefaultInspectionDetectors() []InspectionDetector {
+	basic := utils.GetInputSecurityValidator()
+	advanced := utils.GetAdvancedSecurityValidator()
+	return []InspectionDetector{
+		{Name: DetectorSQLInjection, Blocking: true, Detect: basic.DetectSQLInjection},
+		{Name: DetectorXSS, Blocking: true, Detect: basic.DetectXSS},
+		{Name: DetectorPathTraversal, Blocking: true, Detect: basic.DetectPathTraversal},
+		{Name: DetectorCommandInjection, Blocking: true, Detect: basic.DetectCommandInjection},
+		{Name: DetectorLDAPInjection, Blocking: true, Detect: basic.DetectLDAPInjection},
+		{Name: DetectorHomoglyph, Detect: advanced.DetectHomoglyphAttack},
+		{Name: DetectorEntropy, Detect: advanced.DetectEntropyAnomaly},
+	}
+}
+
+// InspectionAllowRule exempts fields on a route from some or all detectors. Route is a Gin
+// route pattern; a trailing "*" matches by prefix, as do field patterns ("body:content*").
+// Empty Fields or Detectors means all of them.
+type InspectionAllowRule struct {
+	Method    string   `json:"method,omitempty"`
+	Route     string   `json:"route"`
+	Fields    []string `json:"fields,omitempty"`
+	Detectors []string `json:"detectors,omitempty"`
+	Reason    string   `json:"reason,omitempty"`
+}
+
+// RequestInspectionFile is the allowlist file format
+type RequestInspectionFile struct {
+	// SkipFields are never inspected on any route, matched against the last segment of
+	// the field name. Meant for secrets (passwords, tokens) that should not be recorded.
+	SkipFields []string              `json:"skip_fields"`
+	Allowlist  []InspectionAllowRule `json:"allowlist"`
+}
+
+// ParseRequestInspectionFile decodes and validates an allowlist file
+func ParseRequestInspectionFile(data []byte, detectors []InspectionDetector) (RequestInspectionFile, error) {
+	var file RequestInspectionFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return file, err
+	}
+	known := make(map[string]bool, len(detectors))
+	for _, d := range detectors {
+		known[d.Name] = true
+	}
+	for i := range file.Allowlist {
+		r := &file.Allowlist[i]
+		r.Method = strings.ToUpper(strings.TrimSpace(r.Method))
+		if r.Route == "" {
+			return file, fmt.Errorf("allowlist %d: route is required", i)
+		}
+		for _, f := range r.Fields {
+			loc, _, _ := strings.Cut(f, ":")
+			if loc != InspectQuery && loc != InspectParam && loc != InspectBody {
+				return file, fmt.Errorf("allowlist %d: field %q must start with query:, param: or body:", i, f)
+			}
+		}
+		for _, d := range r.Detectors {
+			if !known[d] {
+				return file, fmt.Errorf("allowlist %d: unknown detector %q", i, d)
+			}
+		}
+	}
+	for i, f := range file.SkipFields {
+		file.SkipFields[i] = strings.ToLower(strings.TrimSpace(f))
+	}
+	return file, nil
+}
+
+func (r *InspectionAllowRule) allows(method, route, field, detector string) bool {
+	if r.Method != "" && r.Method != "*" && r.Method != method {
+		return false
+	}
+	if !matchInspectionPattern(r.Route, route) {
+		return false
+	}
+	if len(r.Detectors) > 0 && !containsFold(r.Detectors, detector) {
+		return false
+	}
+	if len(r.Fields) == 0 {
+		return true
+	}
+	for _, f := range r.Fields {
+		if matchInspectionPattern(f, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchInspectionPattern(pattern, value string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+	return pattern == value
+}
+
+func containsFold(list []string, v string) bool {
+	for _, s := range list {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// InspectionHit is one detector match on one field
+type InspectionHit struct {
+	Detector string `json:"detector"`
+	Field    string `json:"field"`
+	Pattern  string `json:"pattern"`
+	Sample   string `json:"sample"`
+	Blocking bool   `json:"blocking"`
+}
+
+// InspectionReport describes a request with at least one (non-allowlisted) hit
+type InspectionReport struct {
+	Mode      string
+	Method    string
+	Route     string
+	IP        string
+	UserAgent string
+	Blocked   bool
+	Hits      []InspectionHit
+}
+
+// InspectionDetectorStats are this instance's counters for one detector since startup.
+// Suppressed counts hits on allowlisted fields, which are known false positives.
+type InspectionDetectorStats struct {
+	Detector   string `json:"detector"`
+	Blocking   bool   `json:"blocking"`
+	Inspected  int64  `json:"inspected"`
+	Hits       int64  `json:"hits"`
+	Suppressed int64  `json:"suppressed"`
+	Blocked    int64  `json:"blocked"`
+}
+
+type inspectionCounters struct {
+	inspected, hits, suppressed, blocked atomic.Int64
+}
+
+// RequestInspector runs the detectors over query params, path params and JSON string fields
+type RequestInspector struct {
+	mode         string
+	detectors    []InspectionDetector
+	counters     []*inspectionCounters
+	maxBodyBytes int64
+
+	mu         sync.RWMutex
+	allowlist  []InspectionAllowRule
+	skipFields map[string]bool
+
+	onHit func(ctx context.Context, report InspectionReport)
+}
+
+// NewRequestInspector creates an inspector. A nil detector list uses the defaults.
+func NewRequestInspector(mode string, detectors []InspectionDetector) *RequestInspector {
+	switch mode {
+	case InspectionOff, InspectionMonitor, InspectionBlock:
+	default:
+		mode = InspectionMonitor
+	}
+	if detectors == nil {
+		detectors = DefaultInspectionDetectors()
+	}
+	ri := &RequestInspector{
+		mode:         mode,
+		detectors:    detectors,
+		counters:     make([]*inspectionCounters, len(detectors)),
+		maxBodyBytes: defaultInspectionMaxBody,
+		skipFields:   map[string]bool{},
+	}
+	for i := range ri.counters {
+		ri.counters[i] = &inspectionCounters{}
+	}
+	return ri
+}
+
+// Mode returns the configured mode
+func (ri *RequestInspector) Mode() string { return ri.mode }
+
+// SetAllowlist replaces the allowlist and skipped field names
+func (ri *RequestInspector) SetAllowlist(file RequestInspectionFile) {
+	skip := make(map[string]bool, len(file.SkipFields))
+	for _, f := range file.SkipFields {
+		skip[f] = true
+	}
+	ri.mu.Lock()
+	ri.allowlist = file.Allowlist
+	ri.skipFields = skip
+	ri.mu.Unlock()
+}
+
+// LoadFile reads the allowlist file
+func (ri *RequestInspector) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	file, err := ParseRequestInspectionFile(data, ri.detectors)
+	if err != nil {
+		return err
+	}
+	ri.SetAllowlist(file)
+	return nil
+}
+
+// OnHit registers a callback for requests with hits, e.g. to record a SecurityEvent.
+// It runs in the background so recording never delays the request.
+func (ri *RequestInspector) OnHit(fn func(ctx context.Context, report InspectionReport)) {
+	ri.onHit = fn
+}
+
+// Stats returns per-detector counters
+func (ri *RequestInspector) Stats() []InspectionDetectorStats {
+	out := make([]InspectionDetectorStats, len(ri.detectors))
+	for i, d := range ri.detectors {
+		c := ri.counters[i]
+		out[i] = InspectionDetectorStats{
+			Detector:   d.Name,
+			Blocking:   d.Blocking,
+			Inspected:  c.inspected.Load(),
+			Hits:       c.hits.Load(),
+			Suppressed: c.suppressed.Load(),
+			Blocked:    c.blocked.Load(),
+		}
+	}
+	return out
+}
+
+// inspectedValue is one string taken from the request
+type inspectedValue struct {
+	field string
+	value string
+}
+
+// Middleware inspects the request before the handler runs. Must be registered on a
+// group so the matched route pattern is known.
+func (ri *RequestInspector) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if ri.mode == InspectionOff {
+			c.Next()
+			return
+		}
+
+		report, ok := ri.inspect(c)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if report.Blocked {
+			for _, h := range report.Hits {
+				if h.Blocking {
+					ri.counterFor(h.Detector).blocked.Add(1)
+				}
+			}
+		}
+		if ri.onHit != nil {
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), inspectionHookTimeout)
+				defer cancel()
+				ri.onHit(ctx, report)
+			}()
+		}
+		if report.Blocked {
+			fields := make([]string, 0, len(report.Hits))
+			for _, h := range report.Hits {
+				if h.Blocking && !containsFold(fields, h.Field) {
+					fields = append(fields, h.Field)
+				}
+			}
+			abortWithAppError(c, apperrors.ErrSuspiciousInput, map[string]interface{}{"fields": fields})
+			return
+		}
+		c.Next()
+	}
+}
+
+// inspect runs every detector over the request values. ok is false when nothing matched.
+func (ri *RequestInspector) inspect(c *gin.Context) (InspectionReport, bool) {
+	method := c.Request.Method
+	route := c.FullPath()
+	values := ri.collect(c)
+	if len(values) == 0 {
+		return InspectionReport{}, false
+	}
+
+	ri.mu.RLock()
+	allowlist := ri.allowlist
+	ri.mu.RUnlock()
+
+	report := InspectionReport{
+		Mode:      ri.mode,
+		Method:    method,
+		Route:     route,
+		IP:        GetClientIP(c),
+		UserAgent: c.Request.UserAgent(),
+	}
+	for _, v := range values {
+		for i, d := range ri.detectors {
+			counters := ri.counters[i]
+			counters.inspected.Add(1)
+			hit, pattern := d.Detect(v.value)
+			if !hit {
+				continue
+			}
+			if inspectionAllowed(allowlist, method, route, v.field, d.Name) {
+				counters.suppressed.Add(1)
+				continue
+			}
+			counters.hits.Add(1)
+			report.Hits = append(report.Hits, InspectionHit{
+				Detector: d.Name,
+				Field:    v.field,
+				Pattern:  pattern,
+				Sample:   truncateInspectionSample(v.value),
+				Blocking: d.Blocking,
+			})
+			if d.Blocking && ri.mode == InspectionBlock {
+				report.Blocked = true
+			}
+		}
+	}
+	return report, len(report.Hits) > 0
+}
+
+func inspectionAllowed(allowlist []InspectionAllowRule, method, route, field, detector string) bool {
+	for i := range allowlist {
+		if allowlist[i].allows(method, route, field, detector) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ri *RequestInspector) counterFor(detector string) *inspectionCounters {
+	for i, d := range ri.detectors {
+		if d.Name == detector {
+			return ri.counters[i]
+		}
+	}
+	return &inspectionCounters{}
+}
+
+// collect gathers query values, path params and JSON string fields, skipping secret
+// fields. The body is restored for the handler.
+func (ri *RequestInspector) collect(c *gin.Context) []inspectedValue {
+	ri.mu.RLock()
+	skip := ri.skipFields
+	ri.mu.RUnlock()
+
+	var out []inspectedValue
+	add := func(field, name, value string) {
+		if value == "" || skip[strings.ToLower(name)] {
+			return
+		}
+		out = append(out, inspectedValue{field: field, value: value})
+	}
+
+	query := c.Request.URL.Query()
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range query[k] {
+			add(InspectQuery+":"+k, k, v)
+		}
+	}
+	for _, p := range c.Params {
+		add(InspectParam+":"+p.Key, p.Key, p.Value)
+	}
+
+	if c.Request.Body == nil || c.ContentType() != gin.MIMEJSON {
+		return out
+	}
+	if c.Request.ContentLength > ri.maxBodyBytes {
+		return out
+	}
+	raw, err := io.ReadAll(io.LimitReader(c.Request.Body, ri.maxBodyBytes+1))
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(raw), c.Request.Body))
+	if err != nil || int64(len(raw)) > ri.maxBodyBytes {
+		return out
+	}
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return out
+	}
+	walkInspectionJSON(doc, "", func(path, name, value string) {
+		add(InspectBody+":"+path, name, value)
+	})
+	return out
+}
+
+// walkInspectionJSON visits every string in a decoded JSON document with its dotted path
+// and the name of the closest object key
+func walkInspectionJSON(v interface{}, path string, visit func(path, name, value string)) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			if s, ok := t[k].(string); ok {
+				visit(child, k, s)
+				continue
+			}
+			walkInspectionJSON(t[k], child, visit)
+		}
+	case []interface{}:
+		name := path
+		if i := strings.LastIndex(path, "."); i >= 0 {
+			name = path[i+1:]
+		}
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				visit(path, name, s)
+				continue
+			}
+			walkInspectionJSON(item, path, visit)
+		}
+	case string:
+		visit(path, path, t)
+	}
+}
+
+func truncateInspectionSample(s string) string {
+	r := []rune(s)
+	if len(r) <= inspectionSampleLen {
+		return s
+	}
+	return string(r[:inspectionSampleLen]) + "…"
+}
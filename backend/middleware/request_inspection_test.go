@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newInspectionRouter(ri *RequestInspector, gotBody *string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	g := r.Group("")
+	g.Use(ri.Middleware())
+	handler := func(c *gin.Context) {
+		if gotBody != nil {
+			raw, _ := io.ReadAll(c.Request.Body)
+			*gotBody = string(raw)
+		}
+		c.Status(http.StatusOK)
+	}
+	g.GET("/cases/:id", handler)
+	g.POST("/cases", handler)
+	return r
+}
+
+func doInspected(r *gin.Engine, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func inspectionStat(ri *RequestInspector, detector string) InspectionDetectorStats {
+	for _, s := range ri.Stats() {
+		if s.Detector == detector {
+			return s
+		}
+	}
+	return InspectionDetectorStats{}
+}
+
+func TestParseRequestInspectionFile_Validates(t *testing.T) {
+	detectors := DefaultInspectionDetectors()
+	file, err := ParseRequestInspectionFile([]byte(`{"skip_fields":["Password"],"allowlist":[{"method":"post","route":"/cases","fields":["body:content*"],"detectors":["sql_injection"]}]}`), detectors)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file.Allowlist[0].Method != "POST" || file.SkipFields[0] != "password" {
+		t.Fatalf("unexpected file: %+v", file)
+	}
+
+	invalid := map[string]string{
+		"missing route":    `{"allowlist":[{"fields":["body:x"]}]}`,
+		"bad location":     `{"allowlist":[{"route":"/x","fields":["header:x"]}]}`,
+		"unknown detector": `{"allowlist":[{"route":"/x","detectors":["regex"]}]}`,
+		"unknown field":    `{"allowlist":[{"route":"/x","mode":"block"}]}`,
+	}
+	for name, raw := range invalid {
+		if _, err := ParseRequestInspectionFile([]byte(raw), detectors); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestParseRequestInspectionFile_ShippedConfig(t *testing.T) {
+	data, err := os.ReadFile("../config/request_inspection.json")
+	if err != nil {
+		t.Fatalf("read shipped config: %v", err)
+	}
+	if _, err := ParseRequestInspectionFile(data, DefaultInspectionDetectors()); err != nil {
+		t.Fatalf("shipped config invalid: %v", err)
+	}
+}
+
+func TestRequestInspector_MonitorRecordsWithoutBlocking(t *testing.T) {
+	ri := NewRequestInspector(InspectionMonitor, nil)
+	reports := make(chan InspectionReport, 1)
+	ri.OnHit(func(_ context.Context, report InspectionReport) { reports <- report })
+	r := newInspectionRouter(ri, nil)
+
+	w := doInspected(r, http.MethodGet, "/cases/1?q=1%27%20OR%20%271%27=%271", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("monitor mode must not block, got %d", w.Code)
+	}
+	select {
+	case report := <-reports:
+		if report.Blocked || report.Route != "/cases/:id" || len(report.Hits) == 0 {
+			t.Fatalf("unexpected report: %+v", report)
+		}
+		if report.Hits[0].Detector != DetectorSQLInjection || report.Hits[0].Field != "query:q" {
+			t.Fatalf("unexpected hit: %+v", report.Hits[0])
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected hit callback")
+	}
+	if s := inspectionStat(ri, DetectorSQLInjection); s.Hits != 1 || s.Blocked != 0 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+}
+
+func TestRequestInspector_BlockModeRejectsAndRestoresCleanBody(t *testing.T) {
+	ri := NewRequestInspector(InspectionBlock, nil)
+	var got string
+	r := newInspectionRouter(ri, &got)
+
+	w := doInspected(r, http.MethodPost, "/cases", `{"title":"<script>alert(1)</script>"}`)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "VAL005") {
+		t.Fatalf("expected VAL005 rejection, got %d %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "body:title") {
+		t.Fatalf("expected rejected field in response, got %s", w.Body.String())
+	}
+	if s := inspectionStat(ri, DetectorXSS); s.Blocked != 1 {
+		t.Fatalf("expected blocked counter, got %+v", s)
+	}
+
+	clean := `{"title":"Laporan audit","items":[{"note":"semua aman"}]}`
+	w = doInspected(r, http.MethodPost, "/cases", clean)
+	if w.Code != http.StatusOK || got != clean {
+		t.Fatalf("clean request should pass with body intact, got %d %q", w.Code, got)
+	}
+}
+
+func TestRequestInspector_NonBlockingDetectorOnlyRecords(t *testing.T) {
+	ri := NewRequestInspector(InspectionBlock, []InspectionDetector{
+		{Name: DetectorEntropy, Detect: func(s string) (bool, string) { return s == "noisy", "test" }},
+	})
+	r := newInspectionRouter(ri, nil)
+
+	if w := doInspected(r, http.MethodGet, "/cases/1?q=noisy", ""); w.Code != http.StatusOK {
+		t.Fatalf("non-blocking detector must not block, got %d", w.Code)
+	}
+	if s := inspectionStat(ri, DetectorEntropy); s.Hits != 1 || s.Blocked != 0 {
+		t.Fatalf("unexpected stats: %+v", s)
+	}
+}
+
+func TestRequestInspector_AllowlistAndSkipFields(t *testing.T) {
+	ri := NewRequestInspector(InspectionBlock, nil)
+	ri.SetAllowlist(RequestInspectionFile{
+		SkipFields: []string{"password"},
+		Allowlist: []InspectionAllowRule{
+			{Method: "POST", Route: "/cases", Fields: []string{"body:content*"}},
+		},
+	})
+	reports := make(chan InspectionReport, 1)
+	ri.OnHit(func(_ context.Context, report InspectionReport) { reports <- report })
+	r := newInspectionRouter(ri, nil)
+
+	body := `{"content":{"sections":[{"text":"SELECT * FROM users WHERE 1=1"}]},"password":"x; rm -rf /"}`
+	if w := doInspected(r, http.MethodPost, "/cases", body); w.Code != http.StatusOK {
+		t.Fatalf("allowlisted content should pass, got %d %s", w.Code, w.Body.String())
+	}
+	if s := inspectionStat(ri, DetectorSQLInjection); s.Suppressed != 1 || s.Hits != 0 {
+		t.Fatalf("expected suppressed hit, got %+v", s)
+	}
+	if s := inspectionStat(ri, DetectorCommandInjection); s.Hits != 0 {
+		t.Fatalf("skipped field must not be inspected, got %+v", s)
+	}
+
+	// The allowlist is scoped to the field: the same payload elsewhere is blocked.
+	if w := doInspected(r, http.MethodPost, "/cases", `{"title":"SELECT * FROM users WHERE 1=1"}`); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected block outside allowlisted field, got %d", w.Code)
+	}
+	select {
+	case report := <-reports:
+		if !report.Blocked {
+			t.Fatalf("expected blocked report, got %+v", report)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected hit callback for blocked request")
+	}
+}
+
+func TestRequestInspector_OffSkipsInspection(t *testing.T) {
+	ri := NewRequestInspector(InspectionOff, nil)
+	r := newInspectionRouter(ri, nil)
+	if w := doInspected(r, http.MethodGet, "/cases/1?q=../../etc/passwd", ""); w.Code != http.StatusOK {
+		t.Fatalf("expected pass, got %d", w.Code)
+	}
+	if s := inspectionStat(ri, DetectorPathTraversal); s.Inspected != 0 {
+		t.Fatalf("off mode must not inspect, got %+v", s)
+	}
+}
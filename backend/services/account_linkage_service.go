@@ -0,0 +1,564 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/devicefingerprint"
+	"backend-gin/ent/deviceusermapping"
+	"backend-gin/ent/session"
+	"backend-gin/ent/user"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+// Kinds of identifier that link two accounts
+const (
+	LinkFingerprint = "fingerprint"
+	LinkIP          = "ip"
+	LinkTelegram    = "telegram"
+)
+
+const (
+	// LinkageDefaultDepth is how many hops from the seed user are explored by default
+	LinkageDefaultDepth = 2
+	LinkageMaxDepth     = 4
+
+	LinkageDefaultMaxNodes = 100
+	LinkageMaxNodes        = 250
+
+	// LinkageHubThreshold skips identifiers shared by more accounts than this (office NAT,
+	// mobile carrier IPs, public devices); they would merge unrelated users into one cluster
+	LinkageHubThreshold = 25
+)
+
+// LinkageOptions bounds a cluster search
+type LinkageOptions struct {
+	MaxDepth   int
+	MaxNodes   int
+	IncludeIPs bool
+}
+
+// LinkageNode is an account in the cluster
+type LinkageNode struct {
+	UserID      int        `json:"user_id"`
+	Email       string     `json:"email"`
+	Username    *string    `json:"username,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	Depth       int        `json:"depth"`
+	Seed        bool       `json:"seed"`
+}
+
+// LinkageReason is one identifier shared by the two accounts of an edge
+type LinkageReason struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// LinkageEdge connects two accounts that share at least one identifier
+type LinkageEdge struct {
+	From    int             `json:"from"`
+	To      int             `json:"to"`
+	Reasons []LinkageReason `json:"reasons"`
+}
+
+// LinkageSkipped records an identifier that was not expanded because too many accounts share it
+type LinkageSkipped struct {
+	Kind     string `json:"kind"`
+	Value    string `json:"value"`
+	Accounts int    `json:"accounts"`
+}
+
+// LinkageGraph is the connected component around a user
+type LinkageGraph struct {
+	SeedUserID int              `json:"seed_user_id"`
+	Nodes      []LinkageNode    `json:"nodes"`
+	Edges      []LinkageEdge    `json:"edges"`
+	Skipped    []LinkageSkipped `json:"skipped,omitempty"`
+	Truncated  bool             `json:"truncated"`
+}
+
+// linkIdentifier says that a user holds an identifier value of some kind
+type linkIdentifier struct {
+	UserID int
+	Kind   string
+	Value  string
+}
+
+type linkKey struct{ Kind, Value string }
+
+// linkageSource reads identifiers from storage; split out so the graph walk can be tested
+type linkageSource interface {
+	// identifiersOf returns the identifiers held by the given users
+	identifiersOf(ctx context.Context, userIDs []int, includeIPs bool) ([]linkIdentifier, error)
+	// holdersOf returns every user holding any of the given identifier values
+	holdersOf(ctx context.Context, values map[string][]string) ([]linkIdentifier, error)
+}
+
+// buildLinkageGraph walks the identifier graph breadth-first from seed. Node metadata is
+// filled in by the caller.
+func buildLinkageGraph(ctx context.Context, src linkageSource, seed int, opts LinkageOptions) (*LinkageGraph, map[int]int, error) {
+	depthOf := map[int]int{seed: 0}
+	edges := make(map[[2]int]*LinkageEdge)
+	expanded := make(map[linkKey]bool)
+	graph := &LinkageGraph{SeedUserID: seed}
+
+	frontier := []int{seed}
+	for depth := 0; depth < opts.MaxDepth && len(frontier) > 0; depth++ {
+		own, err := src.identifiersOf(ctx, frontier, opts.IncludeIPs)
+		if err != nil {
+			return nil, nil, err
+		}
+		wanted := make(map[string][]string)
+		for _, id := range own {
+			k := linkKey{id.Kind, id.Value}
+			if !expanded[k] {
+				expanded[k] = true
+				wanted[id.Kind] = append(wanted[id.Kind], id.Value)
+			}
+		}
+		if len(wanted) == 0 {
+			frontier = nil
+			break
+		}
+		shared, err := src.holdersOf(ctx, wanted)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		holders := make(map[linkKey]map[int]bool)
+		for _, id := range append(own, shared...) {
+			k := linkKey{id.Kind, id.Value}
+			if !containsString(wanted[id.Kind], id.Value) {
+				continue
+			}
+			if holders[k] == nil {
+				holders[k] = make(map[int]bool)
+			}
+			holders[k][id.UserID] = true
+		}
+
+		inFrontier := make(map[int]bool, len(frontier))
+		for _, u := range frontier {
+			inFrontier[u] = true
+		}
+
+		var next []int
+		for _, k := range sortedLinkKeys(holders) {
+			users := sortedUserIDs(holders[k])
+			if len(users) < 2 {
+				continue
+			}
+			if len(users) > LinkageHubThreshold {
+				graph.Skipped = append(graph.Skipped, LinkageSkipped{Kind: k.Kind, Value: k.Value, Accounts: len(users)})
+				continue
+			}
+			for _, u := range users {
+				if !inFrontier[u] {
+					continue
+				}
+				for _, v := range users {
+					if v == u {
+						continue
+					}
+					if _, seen := depthOf[v]; !seen {
+						if len(depthOf) >= opts.MaxNodes {
+							graph.Truncated = true
+							continue
+						}
+						depthOf[v] = depth + 1
+						next = append(next, v)
+					}
+					addLinkageReason(edges, u, v, LinkageReason{Kind: k.Kind, Value: k.Value})
+				}
+			}
+		}
+		frontier = next
+	}
+	if len(frontier) > 0 {
+		// Accounts at the depth limit may have further links that were not explored
+		graph.Truncated = true
+	}
+
+	graph.Edges = make([]LinkageEdge, 0, len(edges))
+	for _, e := range edges {
+		graph.Edges = append(graph.Edges, *e)
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	return graph, depthOf, nil
+}
+
+func addLinkageReason(edges map[[2]int]*LinkageEdge, a, b int, reason LinkageReason) {
+	if a > b {
+		a, b = b, a
+	}
+	e := edges[[2]int{a, b}]
+	if e == nil {
+		e = &LinkageEdge{From: a, To: b}
+		edges[[2]int{a, b}] = e
+	}
+	for _, r := range e.Reasons {
+		if r == reason {
+			return
+		}
+	}
+	e.Reasons = append(e.Reasons, reason)
+}
+
+func sortedLinkKeys(m map[linkKey]map[int]bool) []linkKey {
+	keys := make([]linkKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Kind != keys[j].Kind {
+			return keys[i].Kind < keys[j].Kind
+		}
+		return keys[i].Value < keys[j].Value
+	})
+	return keys
+}
+
+func sortedUserIDs(m map[int]bool) []int {
+	ids := make([]int, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func containsString(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// linkableIP drops empty, private and loopback addresses, which say nothing about who is
+// behind them
+func linkableIP(raw string) (string, bool) {
+	ip := net.ParseIP(strings.TrimSpace(raw))
+	if ip == nil || ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() {
+		return "", false
+	}
+	return ip.String(), true
+}
+
+// normalizeTelegramHandle lowercases a Telegram username and strips "@" and t.me links
+func normalizeTelegramHandle(raw string) string {
+	h := strings.ToLower(strings.TrimSpace(raw))
+	for _, prefix := range []string{"https://t.me/", "http://t.me/", "t.me/"} {
+		h = strings.TrimPrefix(h, prefix)
+	}
+	return strings.TrimLeft(h, "@")
+}
+
+// ==================== Ent source ====================
+
+type entLinkageSource struct {
+	client *ent.Client
+}
+
+func (s *entLinkageSource) identifiersOf(ctx context.Context, userIDs []int, includeIPs bool) ([]linkIdentifier, error) {
+	var out []linkIdentifier
+
+	mappings, err := s.client.DeviceUserMapping.Query().
+		Where(deviceusermapping.UserIDIn(userIDs...)).
+		Select(deviceusermapping.FieldUserID, deviceusermapping.FieldFingerprintHash).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range mappings {
+		out = append(out, linkIdentifier{m.UserID, LinkFingerprint, m.FingerprintHash})
+	}
+	fingerprints, err := s.client.DeviceFingerprint.Query().
+		Where(devicefingerprint.UserIDIn(userIDs...)).
+		Select(devicefingerprint.FieldUserID, devicefingerprint.FieldFingerprintHash).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fingerprints {
+		out = append(out, linkIdentifier{f.UserID, LinkFingerprint, f.FingerprintHash})
+	}
+
+	users, err := s.client.User.Query().
+		Where(user.IDIn(userIDs...)).
+		Select(user.FieldLastLoginIP, user.FieldTelegram, user.FieldTelegramAuthUsername).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		for _, handle := range []string{u.Telegram, u.TelegramAuthUsername} {
+			if h := normalizeTelegramHandle(handle); h != "" {
+				out = append(out, linkIdentifier{u.ID, LinkTelegram, h})
+			}
+		}
+		if includeIPs {
+			if ip, ok := linkableIP(u.LastLoginIP); ok {
+				out = append(out, linkIdentifier{u.ID, LinkIP, ip})
+			}
+		}
+	}
+
+	if includeIPs {
+		ips, err := s.sessionIPs(ctx, session.UserIDIn(userIDs...))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, ips...)
+	}
+	return out, nil
+}
+
+func (s *entLinkageSource) sessionIPs(ctx context.Context, where ...func(*sql.Selector)) ([]linkIdentifier, error) {
+	var rows []struct {
+		UserID    int    `json:"user_id"`
+		IPAddress string `json:"ip_address"`
+	}
+	q := s.client.Session.Query().Where(session.IPAddressNEQ(""))
+	for _, w := range where {
+		q = q.Where(w)
+	}
+	if err := q.GroupBy(session.FieldUserID, session.FieldIPAddress).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	out := make([]linkIdentifier, 0, len(rows))
+	for _, r := range rows {
+		if ip, ok := linkableIP(r.IPAddress); ok {
+			out = append(out, linkIdentifier{r.UserID, LinkIP, ip})
+		}
+	}
+	return out, nil
+}
+
+func (s *entLinkageSource) holdersOf(ctx context.Context, values map[string][]string) ([]linkIdentifier, error) {
+	var out []linkIdentifier
+
+	if hashes := values[LinkFingerprint]; len(hashes) > 0 {
+		mappings, err := s.client.DeviceUserMapping.Query().
+			Where(deviceusermapping.FingerprintHashIn(hashes...)).
+			Select(deviceusermapping.FieldUserID, deviceusermapping.FieldFingerprintHash).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mappings {
+			out = append(out, linkIdentifier{m.UserID, LinkFingerprint, m.FingerprintHash})
+		}
+		fingerprints, err := s.client.DeviceFingerprint.Query().
+			Where(devicefingerprint.FingerprintHashIn(hashes...), devicefingerprint.UserIDNotNil()).
+			Select(devicefingerprint.FieldUserID, devicefingerprint.FieldFingerprintHash).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fingerprints {
+			out = append(out, linkIdentifier{f.UserID, LinkFingerprint, f.FingerprintHash})
+		}
+	}
+
+	if ips := values[LinkIP]; len(ips) > 0 {
+		sessionIPs, err := s.sessionIPs(ctx, session.IPAddressIn(ips...))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sessionIPs...)
+		users, err := s.client.User.Query().
+			Where(user.LastLoginIPIn(ips...)).
+			Select(user.FieldLastLoginIP).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			out = append(out, linkIdentifier{u.ID, LinkIP, u.LastLoginIP})
+		}
+	}
+
+	if handles := values[LinkTelegram]; len(handles) > 0 {
+		users, err := s.client.User.Query().
+			Where(func(sel *sql.Selector) {
+				sel.Where(sql.Or(
+					telegramHandleIn(sel.C(user.FieldTelegram), handles),
+					telegramHandleIn(sel.C(user.FieldTelegramAuthUsername), handles),
+				))
+			}).
+			Select(user.FieldTelegram, user.FieldTelegramAuthUsername).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			for _, handle := range []string{u.Telegram, u.TelegramAuthUsername} {
+				if h := normalizeTelegramHandle(handle); h != "" {
+					out = append(out, linkIdentifier{u.ID, LinkTelegram, h})
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// telegramHandleIn compares the handle column case-insensitively, ignoring a leading "@"
+func telegramHandleIn(col string, handles []string) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString("LOWER(LTRIM(BTRIM(").WriteString(col).WriteString("), '@')) IN (")
+		for i, h := range handles {
+			if i > 0 {
+				b.Comma()
+			}
+			b.Arg(h)
+		}
+		b.WriteString(")")
+	})
+}
+
+// ==================== Service ====================
+
+// EntAccountLinkageService builds account clusters for fraud investigation and locks them
+type EntAccountLinkageService struct {
+	client     *ent.Client
+	source     linkageSource
+	moderation *EntAdminUserModerationService
+}
+
+// NewEntAccountLinkageService creates a new linkage service with Ent
+func NewEntAccountLinkageService(moderation *EntAdminUserModerationService) *EntAccountLinkageService {
+	client := database.GetEntClient()
+	return &EntAccountLinkageService{
+		client:     client,
+		source:     &entLinkageSource{client: client},
+		moderation: moderation,
+	}
+}
+
+// NormalizeLinkageOptions applies defaults and caps
+func NormalizeLinkageOptions(opts LinkageOptions) LinkageOptions {
+	if opts.MaxDepth < 1 {
+		opts.MaxDepth = LinkageDefaultDepth
+	}
+	if opts.MaxDepth > LinkageMaxDepth {
+		opts.MaxDepth = LinkageMaxDepth
+	}
+	if opts.MaxNodes < 2 {
+		opts.MaxNodes = LinkageDefaultMaxNodes
+	}
+	if opts.MaxNodes > LinkageMaxNodes {
+		opts.MaxNodes = LinkageMaxNodes
+	}
+	return opts
+}
+
+// Cluster returns the accounts linked to userID through shared fingerprints, IPs and
+// Telegram handles
+func (s *EntAccountLinkageService) Cluster(ctx context.Context, userID int, opts LinkageOptions) (*LinkageGraph, error) {
+	if _, err := s.client.User.Get(ctx, userID); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrUserNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+
+	graph, depthOf, err := buildLinkageGraph(ctx, s.source, userID, NormalizeLinkageOptions(opts))
+	if err != nil {
+		logger.Error("Failed to build account linkage graph", zap.Error(err), zap.Int("user_id", userID))
+		return nil, apperrors.ErrDatabase
+	}
+
+	ids := make([]int, 0, len(depthOf))
+	for id := range depthOf {
+		ids = append(ids, id)
+	}
+	users, err := s.client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		logger.Error("Failed to load linkage nodes", zap.Error(err), zap.Int("user_id", userID))
+		return nil, apperrors.ErrDatabase
+	}
+	graph.Nodes = make([]LinkageNode, 0, len(users))
+	for _, u := range users {
+		graph.Nodes = append(graph.Nodes, LinkageNode{
+			UserID:      u.ID,
+			Email:       u.Email,
+			Username:    u.Username,
+			CreatedAt:   u.CreatedAt,
+			LockedUntil: u.LockedUntil,
+			Depth:       depthOf[u.ID],
+			Seed:        u.ID == userID,
+		})
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		if graph.Nodes[i].Depth != graph.Nodes[j].Depth {
+			return graph.Nodes[i].Depth < graph.Nodes[j].Depth
+		}
+		return graph.Nodes[i].UserID < graph.Nodes[j].UserID
+	})
+	return graph, nil
+}
+
+// ClusterLockResult reports the outcome of a cluster lock
+type ClusterLockResult struct {
+	Locked []int          `json:"locked"`
+	Failed map[int]string `json:"failed,omitempty"`
+	Until  time.Time      `json:"until"`
+}
+
+// LockCluster locks the listed members of userID's cluster. The cluster is rebuilt with
+// the same options so only accounts the admin actually saw linked can be locked.
+func (s *EntAccountLinkageService) LockCluster(ctx context.Context, actor AdminActor, userID int, opts LinkageOptions, memberIDs []int, reason string, until time.Time) (*ClusterLockResult, error) {
+	graph, err := s.Cluster(ctx, userID, opts)
+	if err != nil {
+		return nil, err
+	}
+	inCluster := make(map[int]bool, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		inCluster[n.UserID] = true
+	}
+	for _, id := range memberIDs {
+		if !inCluster[id] {
+			return nil, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("User %d tidak termasuk dalam klaster user %d", id, userID))
+		}
+	}
+
+	if until.IsZero() {
+		until = time.Now().Add(AdminLockIndefinite)
+	}
+	clusterReason := fmt.Sprintf("%s (klaster user %d)", reason, userID)
+	result := &ClusterLockResult{Locked: []int{}, Until: until}
+	for _, id := range memberIDs {
+		if _, err := s.moderation.LockUser(ctx, actor, id, clusterReason, until); err != nil {
+			if result.Failed == nil {
+				result.Failed = make(map[int]string)
+			}
+			result.Failed[id] = err.Error()
+			continue
+		}
+		result.Locked = append(result.Locked, id)
+	}
+	logger.Warn("Account cluster locked",
+		zap.Int("seed_user_id", userID),
+		zap.Int("admin_id", actor.ID),
+		zap.Int("locked", len(result.Locked)),
+		zap.Int("failed", len(result.Failed)))
+	return result, nil
+}
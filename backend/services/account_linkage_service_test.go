@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLinkageSource serves identifiers from memory
+type fakeLinkageSource struct {
+	ids []linkIdentifier
+}
+
+func (f *fakeLinkageSource) identifiersOf(_ context.Context, userIDs []int, includeIPs bool) ([]linkIdentifier, error) {
+	want := make(map[int]bool)
+	for _, id := range userIDs {
+		want[id] = true
+	}
+	var out []linkIdentifier
+	for _, id := range f.ids {
+		if want[id.UserID] && (includeIPs || id.Kind != LinkIP) {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeLinkageSource) holdersOf(_ context.Context, values map[string][]string) ([]linkIdentifier, error) {
+	var out []linkIdentifier
+	for _, id := range f.ids {
+		if containsString(values[id.Kind], id.Value) {
+			out = append(out, id)
+		}
+	}
+	return out, nil
+}
+
+func TestBuildLinkageGraph_ConnectedComponent(t *testing.T) {
+	src := &fakeLinkageSource{ids: []linkIdentifier{
+		{1, LinkFingerprint, "fp-a"},
+		{2, LinkFingerprint, "fp-a"},
+		{2, LinkIP, "203.0.113.7"},
+		{3, LinkIP, "203.0.113.7"},
+		{3, LinkTelegram, "shadow"},
+		{4, LinkTelegram, "shadow"},
+		{5, LinkFingerprint, "fp-unrelated"},
+	}}
+
+	graph, depthOf, err := buildLinkageGraph(context.Background(), src, 1, LinkageOptions{MaxDepth: 4, MaxNodes: 50, IncludeIPs: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[int]int{1: 0, 2: 1, 3: 2, 4: 3}, depthOf)
+	assert.False(t, graph.Truncated)
+	require.Len(t, graph.Edges, 3)
+	assert.Equal(t, LinkageEdge{From: 1, To: 2, Reasons: []LinkageReason{{LinkFingerprint, "fp-a"}}}, graph.Edges[0])
+	assert.Equal(t, LinkageEdge{From: 2, To: 3, Reasons: []LinkageReason{{LinkIP, "203.0.113.7"}}}, graph.Edges[1])
+	assert.Equal(t, LinkageEdge{From: 3, To: 4, Reasons: []LinkageReason{{LinkTelegram, "shadow"}}}, graph.Edges[2])
+}
+
+func TestBuildLinkageGraph_WithoutIPs(t *testing.T) {
+	src := &fakeLinkageSource{ids: []linkIdentifier{
+		{1, LinkIP, "203.0.113.7"},
+		{2, LinkIP, "203.0.113.7"},
+	}}
+
+	graph, depthOf, err := buildLinkageGraph(context.Background(), src, 1, LinkageOptions{MaxDepth: 2, MaxNodes: 50})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{1: 0}, depthOf)
+	assert.Empty(t, graph.Edges)
+}
+
+func TestBuildLinkageGraph_DepthLimitMarksTruncated(t *testing.T) {
+	src := &fakeLinkageSource{ids: []linkIdentifier{
+		{1, LinkFingerprint, "a"},
+		{2, LinkFingerprint, "a"},
+		{2, LinkFingerprint, "b"},
+		{3, LinkFingerprint, "b"},
+	}}
+
+	graph, depthOf, err := buildLinkageGraph(context.Background(), src, 1, LinkageOptions{MaxDepth: 1, MaxNodes: 50})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{1: 0, 2: 1}, depthOf)
+	assert.True(t, graph.Truncated)
+}
+
+func TestBuildLinkageGraph_SkipsHubIdentifiers(t *testing.T) {
+	src := &fakeLinkageSource{ids: []linkIdentifier{
+		{1, LinkFingerprint, "fp-a"},
+		{2, LinkFingerprint, "fp-a"},
+	}}
+	for i := 0; i <= LinkageHubThreshold; i++ {
+		src.ids = append(src.ids, linkIdentifier{100 + i, LinkIP, "198.51.100.1"})
+	}
+	src.ids = append(src.ids, linkIdentifier{1, LinkIP, "198.51.100.1"})
+
+	graph, depthOf, err := buildLinkageGraph(context.Background(), src, 1, LinkageOptions{MaxDepth: 2, MaxNodes: 50, IncludeIPs: true})
+	require.NoError(t, err)
+	assert.Equal(t, map[int]int{1: 0, 2: 1}, depthOf)
+	require.Len(t, graph.Skipped, 1)
+	assert.Equal(t, LinkageSkipped{Kind: LinkIP, Value: "198.51.100.1", Accounts: LinkageHubThreshold + 2}, graph.Skipped[0])
+}
+
+func TestBuildLinkageGraph_MaxNodes(t *testing.T) {
+	src := &fakeLinkageSource{}
+	for i := 1; i <= 10; i++ {
+		src.ids = append(src.ids, linkIdentifier{i, LinkTelegram, "shared"})
+	}
+
+	graph, depthOf, err := buildLinkageGraph(context.Background(), src, 1, LinkageOptions{MaxDepth: 2, MaxNodes: 4})
+	require.NoError(t, err)
+	assert.Len(t, depthOf, 4)
+	assert.True(t, graph.Truncated)
+	for _, e := range graph.Edges {
+		_, from := depthOf[e.From]
+		_, to := depthOf[e.To]
+		assert.True(t, from && to, fmt.Sprintf("edge %d-%d leaves the cluster", e.From, e.To))
+	}
+}
+
+func TestLinkableIP(t *testing.T) {
+	for raw, want := range map[string]bool{
+		"203.0.113.7": true,
+		"2001:db8::1": true,
+		"10.1.2.3":    false,
+		"192.168.0.1": false,
+		"127.0.0.1":   false,
+		"::1":         false,
+		"":            false,
+		"unknown":     false,
+	} {
+		_, ok := linkableIP(raw)
+		assert.Equal(t, want, ok, raw)
+	}
+}
+
+func TestNormalizeTelegramHandle(t *testing.T) {
+	assert.Equal(t, "shadow_user", normalizeTelegramHandle(" @Shadow_User "))
+	assert.Equal(t, "shadow_user", normalizeTelegramHandle("https://t.me/shadow_user"))
+	assert.Equal(t, "", normalizeTelegramHandle("@"))
+}
@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend-gin/config"
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/emailverificationtoken"
+	"backend-gin/ent/user"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/utils"
+	"backend-gin/validators"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// EmailVerificationToken purposes
+const (
+	EmailTokenPurposeVerify = "verify_email"
+	EmailTokenPurposeChange = "email_change"
+	EmailTokenPurposeRevert = "email_revert"
+)
+
+// emailChangeTokenExpiry is how long the confirmation link sent to the new address is valid
+const emailChangeTokenExpiry = 24 * time.Hour
+
+// EntAccountService handles authenticated credential changes (password, email)
+type EntAccountService struct {
+	client         *ent.Client
+	sessionService *EntSessionService
+	audit          *EntSecurityAuditService
+}
+
+// NewEntAccountService creates a new account service with Ent
+func NewEntAccountService(sessionService *EntSessionService) *EntAccountService {
+	return &EntAccountService{
+		client:         database.GetEntClient(),
+		sessionService: sessionService,
+		audit:          NewEntSecurityAuditService(),
+	}
+}
+
+// ChangePassword sets a new password for a logged-in user and revokes every other session.
+// Callers must gate this behind sudo mode; currentJTI identifies the session to keep.
+func (s *EntAccountService) ChangePassword(ctx context.Context, userID int, currentJTI, newPassword, ip string) error {
+	if err := validators.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrUserNotFound
+		}
+		return apperrors.ErrDatabase
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(newPassword)) == nil {
+		return apperrors.ErrInvalidInput.WithDetails("Password baru harus berbeda dari password saat ini")
+	}
+
+	hashPass, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("Failed to hash new password", zap.Error(err))
+		return apperrors.ErrInternalServer.WithDetails("Gagal memproses password")
+	}
+
+	if _, err := s.client.User.UpdateOneID(userID).SetPasswordHash(string(hashPass)).Save(ctx); err != nil {
+		logger.Error("Failed to change password", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase.WithDetails("Gagal menyimpan password baru")
+	}
+
+	if err := s.sessionService.RevokeOtherUserSessions(ctx, userID, currentJTI, "Password changed"); err != nil {
+		logger.Warn("Failed to revoke other sessions after password change", zap.Error(err), zap.Int("user_id", userID))
+	}
+
+	s.audit.LogPasswordChanged(ctx, u, ip)
+	logger.Info("Password changed", zap.Int("user_id", userID))
+	return nil
+}
+
+// RequestEmailChange sends a confirmation link to newEmail. The account email only
+// changes once the link is confirmed.
+func (s *EntAccountService) RequestEmailChange(ctx context.Context, userID int, newEmail, ip, userAgent string) error {
+	newEmail = strings.TrimSpace(strings.ToLower(newEmail))
+	if err := validators.ValidateEmail(newEmail); err != nil {
+		return err
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrUserNotFound
+		}
+		return apperrors.ErrDatabase
+	}
+	if strings.EqualFold(u.Email, newEmail) {
+		return apperrors.ErrInvalidInput.WithDetails("Email baru sama dengan email saat ini")
+	}
+	if err := s.ensureEmailAvailable(ctx, newEmail, userID); err != nil {
+		return err
+	}
+
+	raw, err := randomToken()
+	if err != nil {
+		logger.Error("Failed to generate email change token", zap.Error(err))
+		return apperrors.ErrInternalServer.WithDetails("Gagal membuat token")
+	}
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		// Keep only one pending email change per user.
+		if _, err := tx.EmailVerificationToken.Delete().
+			Where(
+				emailverificationtoken.UserIDEQ(userID),
+				emailverificationtoken.PurposeEQ(EmailTokenPurposeChange),
+				emailverificationtoken.UsedAtIsNil(),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.EmailVerificationToken.Create().
+			SetUserID(userID).
+			SetTokenHash(hashToken(raw)).
+			SetPurpose(EmailTokenPurposeChange).
+			SetTargetEmail(newEmail).
+			SetExpiresAt(time.Now().Add(emailChangeTokenExpiry)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed to create email change token", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase.WithDetails("Gagal menyimpan token")
+	}
+
+	if err := utils.QueueEmailChangeVerificationEmail(newEmail, raw); err != nil {
+		logger.Warn("Failed to queue email change verification", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrEmailService
+	}
+
+	uid := u.ID
+	s.audit.LogEvent(ctx, EventEmailChangeRequested, &uid, u.Email, ip, userAgent,
+		fmt.Sprintf("Requested change to %s", newEmail), "info", true)
+	return nil
+}
+
+// ConfirmEmailChange applies a pending email change and sends the previous address a
+// revert link valid for config.EmailChangeRevertDays.
+func (s *EntAccountService) ConfirmEmailChange(ctx context.Context, token, ip, userAgent string) error {
+	record, err := s.consumableEmailToken(ctx, token, EmailTokenPurposeChange)
+	if err != nil {
+		return err
+	}
+	if record.TargetEmail == nil || *record.TargetEmail == "" {
+		return apperrors.ErrInvalidToken
+	}
+	newEmail := *record.TargetEmail
+
+	u, err := s.client.User.Get(ctx, record.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrInvalidToken
+		}
+		return apperrors.ErrDatabase
+	}
+	oldEmail := u.Email
+
+	// Re-check uniqueness: the address may have been registered since the request.
+	if err := s.ensureEmailAvailable(ctx, newEmail, u.ID); err != nil {
+		return err
+	}
+
+	revertRaw, err := randomToken()
+	if err != nil {
+		logger.Error("Failed to generate email revert token", zap.Error(err))
+		return apperrors.ErrInternalServer.WithDetails("Gagal membuat token")
+	}
+	revertDays := config.EmailChangeRevertDays
+	if revertDays <= 0 {
+		revertDays = 7
+	}
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		now := time.Now()
+		if _, err := tx.EmailVerificationToken.UpdateOneID(record.ID).SetUsedAt(now).Save(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.User.UpdateOneID(u.ID).SetEmail(newEmail).SetEmailVerified(true).Save(ctx); err != nil {
+			return err
+		}
+		_, err := tx.EmailVerificationToken.Create().
+			SetUserID(u.ID).
+			SetTokenHash(hashToken(revertRaw)).
+			SetPurpose(EmailTokenPurposeRevert).
+			SetTargetEmail(oldEmail).
+			SetExpiresAt(now.Add(time.Duration(revertDays) * 24 * time.Hour)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return apperrors.ErrEmailUnavailable
+		}
+		logger.Error("Failed to apply email change", zap.Error(err), zap.Int("user_id", u.ID))
+		return apperrors.ErrDatabase.WithDetails("Gagal memperbarui email")
+	}
+
+	if err := utils.QueueEmailChangedNoticeEmail(oldEmail, newEmail, revertRaw, revertDays); err != nil {
+		logger.Warn("Failed to queue email changed notice", zap.Error(err), zap.Int("user_id", u.ID))
+	}
+
+	uid := u.ID
+	s.audit.LogEvent(ctx, EventEmailChanged, &uid, newEmail, ip, userAgent,
+		fmt.Sprintf("Email changed from %s", oldEmail), "warning", true)
+	logger.Info("Email changed", zap.Int("user_id", u.ID))
+	return nil
+}
+
+// RevertEmailChange restores the previous address from a revert link and revokes all
+// sessions, since the change was likely made by someone else.
+func (s *EntAccountService) RevertEmailChange(ctx context.Context, token, ip, userAgent string) error {
+	record, err := s.consumableEmailToken(ctx, token, EmailTokenPurposeRevert)
+	if err != nil {
+		return err
+	}
+	if record.TargetEmail == nil || *record.TargetEmail == "" {
+		return apperrors.ErrInvalidToken
+	}
+	oldEmail := *record.TargetEmail
+
+	if err := s.ensureEmailAvailable(ctx, oldEmail, record.UserID); err != nil {
+		return err
+	}
+
+	var changedEmail string
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		u, err := tx.User.Get(ctx, record.UserID)
+		if err != nil {
+			return err
+		}
+		changedEmail = u.Email
+
+		now := time.Now()
+		if _, err := tx.EmailVerificationToken.UpdateOneID(record.ID).SetUsedAt(now).Save(ctx); err != nil {
+			return err
+		}
+		// Invalidate any pending change/revert links so the attacker can't re-apply.
+		if _, err := tx.EmailVerificationToken.Delete().
+			Where(
+				emailverificationtoken.UserIDEQ(record.UserID),
+				emailverificationtoken.PurposeIn(EmailTokenPurposeChange, EmailTokenPurposeRevert),
+				emailverificationtoken.UsedAtIsNil(),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err = tx.User.UpdateOneID(record.UserID).SetEmail(oldEmail).SetEmailVerified(true).Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrInvalidToken
+		}
+		if ent.IsConstraintError(err) {
+			return apperrors.ErrEmailUnavailable
+		}
+		logger.Error("Failed to revert email change", zap.Error(err), zap.Int("user_id", record.UserID))
+		return apperrors.ErrDatabase.WithDetails("Gagal mengembalikan email")
+	}
+
+	if err := s.sessionService.RevokeAllUserSessions(ctx, record.UserID, "Email change reverted"); err != nil {
+		logger.Warn("Failed to revoke sessions after email revert", zap.Error(err), zap.Int("user_id", record.UserID))
+	}
+
+	uid := record.UserID
+	s.audit.LogEvent(ctx, EventEmailChangeReverted, &uid, oldEmail, ip, userAgent,
+		fmt.Sprintf("Reverted change to %s; all sessions revoked", changedEmail), "critical", true)
+	logger.Warn("Email change reverted", zap.Int("user_id", record.UserID))
+	return nil
+}
+
+// consumableEmailToken loads an unused, unexpired token of the given purpose
+func (s *EntAccountService) consumableEmailToken(ctx context.Context, token, purpose string) (*ent.EmailVerificationToken, error) {
+	input := validators.VerifyTokenInput{Token: token}
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	record, err := s.client.EmailVerificationToken.Query().
+		Where(
+			emailverificationtoken.TokenHashEQ(hashToken(input.Token)),
+			emailverificationtoken.PurposeEQ(purpose),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrInvalidToken
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if record.UsedAt != nil {
+		return nil, apperrors.ErrInvalidToken.WithDetails("Token sudah digunakan")
+	}
+	if time.Now().After(record.ExpiresAt) {
+		return nil, apperrors.ErrTokenExpired
+	}
+	return record, nil
+}
+
+// ensureEmailAvailable returns ErrEmailUnavailable if another user already owns email
+func (s *EntAccountService) ensureEmailAvailable(ctx context.Context, email string, userID int) error {
+	taken, err := s.client.User.Query().
+		Where(user.EmailEqualFold(email), user.IDNEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if taken {
+		return apperrors.ErrEmailUnavailable
+	}
+	return nil
+}
@@ -0,0 +1,339 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/adminauditlog"
+	"backend-gin/ent/hook"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	adminAuditExportBatch = 500
+	// Attempts to append a row when concurrent inserts keep taking the chain head
+	adminAuditChainRetries = 5
+)
+
+// AdminAuditFilter narrows an admin audit log search; zero values are ignored
+type AdminAuditFilter struct {
+	AdminID    int
+	Action     string // substring match on "METHOD /route"
+	TargetType string
+	TargetID   string
+	RequestID  string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	PageSize   int
+}
+
+// AdminAuditRecord is the exported form of an AdminAuditLog row. The hash is fixed before
+// the row has an ID, so chained records leave ID out; export lines carry it separately.
+type AdminAuditRecord struct {
+	ID         int                      `json:"id,omitempty"`
+	Seq        int                      `json:"seq"`
+	CreatedAt  time.Time                `json:"created_at"`
+	AdminID    int                      `json:"admin_id"`
+	AdminEmail string                   `json:"admin_email"`
+	Action     string                   `json:"action"`
+	Method     string                   `json:"method"`
+	Path       string                   `json:"path"`
+	TargetType string                   `json:"target_type"`
+	TargetID   string                   `json:"target_id"`
+	Changes    []map[string]interface{} `json:"changes"`
+	RequestID  string                   `json:"request_id"`
+	IPAddress  string                   `json:"ip_address"`
+	UserAgent  string                   `json:"user_agent"`
+	Reason     string                   `json:"reason"`
+	StatusCode int                      `json:"status_code"`
+	Success    bool                     `json:"success"`
+}
+
+// NewAdminAuditRecord converts an AdminAuditLog entity
+func NewAdminAuditRecord(l *ent.AdminAuditLog) AdminAuditRecord {
+	return AdminAuditRecord{
+		ID:         l.ID,
+		Seq:        l.Seq,
+		CreatedAt:  l.CreatedAt.UTC(),
+		AdminID:    l.AdminID,
+		AdminEmail: l.AdminEmail,
+		Action:     l.Action,
+		Method:     l.Method,
+		Path:       l.Path,
+		TargetType: l.TargetType,
+		TargetID:   l.TargetID,
+		Changes:    l.Changes,
+		RequestID:  l.RequestID,
+		IPAddress:  l.IPAddress,
+		UserAgent:  l.UserAgent,
+		Reason:     l.Reason,
+		StatusCode: l.StatusCode,
+		Success:    l.Success,
+	}
+}
+
+// AdminAuditExportLine is one JSONL line of an audit export. Hash is
+// hex(sha256(prev_hash + record)), where record is the exact bytes of the "record" value,
+// so any edited, removed or reordered line breaks every hash after it.
+type AdminAuditExportLine struct {
+	ID       int             `json:"id"`
+	Record   json.RawMessage `json:"record"`
+	PrevHash string          `json:"prev_hash"`
+	Hash     string          `json:"hash"`
+}
+
+// ChainAdminAuditHash returns the running hash for a record following prevHash
+func ChainAdminAuditHash(prevHash string, record []byte) string {
+	h := sha256.New()
+	h.Write([]byte(prevHash))
+	h.Write(record)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// adminAuditChainRecord returns the bytes hashed into the chain for a record
+func adminAuditChainRecord(r AdminAuditRecord) ([]byte, error) {
+	r.ID = 0
+	return json.Marshal(r)
+}
+
+func adminAuditRecordFromMutation(m *ent.AdminAuditLogMutation) AdminAuditRecord {
+	var r AdminAuditRecord
+	r.Seq, _ = m.Seq()
+	r.CreatedAt, _ = m.CreatedAt()
+	r.CreatedAt = r.CreatedAt.UTC()
+	r.AdminID, _ = m.AdminID()
+	r.AdminEmail, _ = m.AdminEmail()
+	r.Action, _ = m.Action()
+	r.Method, _ = m.Method()
+	r.Path, _ = m.Path()
+	r.TargetType, _ = m.TargetType()
+	r.TargetID, _ = m.TargetID()
+	r.Changes, _ = m.Changes()
+	r.RequestID, _ = m.RequestID()
+	r.IPAddress, _ = m.IPAddress()
+	r.UserAgent, _ = m.UserAgent()
+	r.Reason, _ = m.Reason()
+	r.StatusCode, _ = m.StatusCode()
+	r.Success, _ = m.Success()
+	return r
+}
+
+// AdminAuditChainHook sets seq, prev_hash and hash on every new AdminAuditLog row, chaining
+// it after the current head. The unique seq index rejects a concurrent insert that chained
+// after the same head; it is retried against the new one.
+// Register once on the client at startup, after middleware.AdminAuditHook.
+func AdminAuditChainHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.AdminAuditLogFunc(func(ctx context.Context, m *ent.AdminAuditLogMutation) (ent.Value, error) {
+			// The stored row must reproduce the hashed bytes: Postgres keeps microseconds, and
+			// changes come back from jsonb as plain maps
+			createdAt, ok := m.CreatedAt()
+			if !ok {
+				createdAt = time.Now()
+			}
+			m.SetCreatedAt(createdAt.UTC().Truncate(time.Microsecond))
+			if changes, ok := m.Changes(); ok {
+				raw, err := json.Marshal(changes)
+				if err != nil {
+					return nil, fmt.Errorf("marshal audit changes: %w", err)
+				}
+				var normalized []map[string]interface{}
+				if err := json.Unmarshal(raw, &normalized); err != nil {
+					return nil, fmt.Errorf("normalize audit changes: %w", err)
+				}
+				m.SetChanges(normalized)
+			}
+
+			var lastErr error
+			for attempt := 0; attempt < adminAuditChainRetries; attempt++ {
+				seq, prevHash := 1, ""
+				head, err := m.Client().AdminAuditLog.Query().
+					Order(ent.Desc(adminauditlog.FieldSeq)).
+					First(ctx)
+				switch {
+				case err == nil:
+					seq, prevHash = head.Seq+1, head.Hash
+				case !ent.IsNotFound(err):
+					return nil, err
+				}
+				m.SetSeq(seq)
+				record, err := adminAuditChainRecord(adminAuditRecordFromMutation(m))
+				if err != nil {
+					return nil, fmt.Errorf("marshal audit record: %w", err)
+				}
+				m.SetPrevHash(prevHash)
+				m.SetHash(ChainAdminAuditHash(prevHash, record))
+
+				v, err := next.Mutate(ctx, m)
+				if err == nil || !ent.IsConstraintError(err) {
+					return v, err
+				}
+				lastErr = err
+			}
+			return nil, lastErr
+		})
+	}, ent.OpCreate)
+}
+
+// EntAdminAuditService reads the admin audit trail
+type EntAdminAuditService struct {
+	client *ent.Client
+}
+
+// NewEntAdminAuditService creates a new admin audit service with Ent
+func NewEntAdminAuditService() *EntAdminAuditService {
+	return &EntAdminAuditService{client: database.GetEntClient()}
+}
+
+// Search returns one page of audit rows matching the filter, newest first, and the total count
+func (s *EntAdminAuditService) Search(ctx context.Context, f AdminAuditFilter) ([]*ent.AdminAuditLog, int, error) {
+	if f.Page < 1 {
+		f.Page = 1
+	}
+	if f.PageSize < 1 || f.PageSize > 100 {
+		f.PageSize = 50
+	}
+
+	buildQuery := func() *ent.AdminAuditLogQuery {
+		q := s.client.AdminAuditLog.Query()
+		if f.AdminID > 0 {
+			q = q.Where(adminauditlog.AdminIDEQ(f.AdminID))
+		}
+		if f.Action != "" {
+			q = q.Where(adminauditlog.ActionContainsFold(f.Action))
+		}
+		if f.TargetType != "" {
+			q = q.Where(adminauditlog.TargetTypeEQ(f.TargetType))
+		}
+		if f.TargetID != "" {
+			q = q.Where(adminauditlog.TargetIDEQ(f.TargetID))
+		}
+		if f.RequestID != "" {
+			q = q.Where(adminauditlog.RequestIDEQ(f.RequestID))
+		}
+		if f.From != nil {
+			q = q.Where(adminauditlog.CreatedAtGTE(*f.From))
+		}
+		if f.To != nil {
+			q = q.Where(adminauditlog.CreatedAtLT(*f.To))
+		}
+		return q
+	}
+
+	total, err := buildQuery().Count(ctx)
+	if err != nil {
+		logger.Error("Failed to count admin audit logs", zap.Error(err))
+		return nil, 0, apperrors.ErrDatabase
+	}
+	logs, err := buildQuery().
+		Order(ent.Desc(adminauditlog.FieldID)).
+		Offset((f.Page - 1) * f.PageSize).
+		Limit(f.PageSize).
+		All(ctx)
+	if err != nil {
+		logger.Error("Failed to query admin audit logs", zap.Error(err))
+		return nil, 0, apperrors.ErrDatabase
+	}
+	return logs, total, nil
+}
+
+// Export streams the hash chain stored on the audit rows as JSONL, in chain order, starting
+// after the row afterID. A full export passes afterID 0 and an empty prevHash; an incremental
+// export passes the last ID and hash of the previous export. Every row is checked against
+// its stored hash and predecessor, so a tampered table aborts the export. Returns the final
+// hash and line count.
+func (s *EntAdminAuditService) Export(ctx context.Context, w io.Writer, afterID int, prevHash string) (string, int, error) {
+	afterSeq := 0
+	if afterID > 0 {
+		last, err := s.client.AdminAuditLog.Get(ctx, afterID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return prevHash, 0, apperrors.ErrInvalidInput.WithDetails("after_id tidak ditemukan")
+			}
+			logger.Error("Failed to load admin audit export cursor", zap.Error(err), zap.Int("after_id", afterID))
+			return prevHash, 0, apperrors.ErrDatabase
+		}
+		afterSeq = last.Seq
+	}
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	count := 0
+	for {
+		logs, err := s.client.AdminAuditLog.Query().
+			Where(adminauditlog.SeqGT(afterSeq)).
+			Order(ent.Asc(adminauditlog.FieldSeq)).
+			Limit(adminAuditExportBatch).
+			All(ctx)
+		if err != nil {
+			logger.Error("Failed to export admin audit logs", zap.Error(err), zap.Int("after_seq", afterSeq))
+			return prevHash, count, apperrors.ErrDatabase
+		}
+
+		for _, l := range logs {
+			record, err := adminAuditChainRecord(NewAdminAuditRecord(l))
+			if err != nil {
+				return prevHash, count, fmt.Errorf("marshal audit record %d: %w", l.ID, err)
+			}
+			if l.PrevHash != prevHash || ChainAdminAuditHash(prevHash, record) != l.Hash {
+				return prevHash, count, fmt.Errorf("admin audit chain broken at id %d (seq %d)", l.ID, l.Seq)
+			}
+			line := AdminAuditExportLine{
+				ID:       l.ID,
+				Record:   record,
+				PrevHash: l.PrevHash,
+				Hash:     l.Hash,
+			}
+			if err := enc.Encode(line); err != nil {
+				return prevHash, count, err
+			}
+			prevHash = line.Hash
+			afterSeq = l.Seq
+			count++
+		}
+		if err := bw.Flush(); err != nil {
+			return prevHash, count, err
+		}
+		if len(logs) < adminAuditExportBatch {
+			return prevHash, count, nil
+		}
+	}
+}
+
+// VerifyAdminAuditExport checks the hash chain of a JSONL export that continues from
+// prevHash. Returns the final hash and number of verified lines.
+func VerifyAdminAuditExport(r io.Reader, prevHash string) (string, int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	count := 0
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var line AdminAuditExportLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return prevHash, count, fmt.Errorf("line %d: %w", count+1, err)
+		}
+		if line.PrevHash != prevHash {
+			return prevHash, count, fmt.Errorf("line %d: prev_hash does not match preceding line", count+1)
+		}
+		if ChainAdminAuditHash(prevHash, line.Record) != line.Hash {
+			return prevHash, count, fmt.Errorf("line %d: hash mismatch", count+1)
+		}
+		prevHash = line.Hash
+		count++
+	}
+	return prevHash, count, scanner.Err()
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildAuditExport mirrors the line encoding of EntAdminAuditService.Export
+func buildAuditExport(t *testing.T, prevHash string, records ...AdminAuditRecord) (string, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		raw, err := adminAuditChainRecord(r)
+		require.NoError(t, err)
+		line := AdminAuditExportLine{ID: r.ID, Record: raw, PrevHash: prevHash, Hash: ChainAdminAuditHash(prevHash, raw)}
+		require.NoError(t, enc.Encode(line))
+		prevHash = line.Hash
+	}
+	return buf.String(), prevHash
+}
+
+func auditRecords() []AdminAuditRecord {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	return []AdminAuditRecord{
+		{ID: 1, Seq: 1, CreatedAt: at, AdminID: 7, Action: "POST /admin/badges", StatusCode: 201, Success: true},
+		{ID: 2, Seq: 2, CreatedAt: at.Add(time.Minute), AdminID: 7, Action: "DELETE /admin/badges/:id", Reason: "duplikat <badge>", StatusCode: 200, Success: true},
+		{ID: 4, Seq: 3, CreatedAt: at.Add(2 * time.Minute), AdminID: 9, Action: "POST /admin/integrations/lzt/request", StatusCode: 403},
+	}
+}
+
+func TestVerifyAdminAuditExport_Valid(t *testing.T) {
+	export, head := buildAuditExport(t, "", auditRecords()...)
+
+	got, n, err := VerifyAdminAuditExport(strings.NewReader(export), "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, head, got)
+}
+
+func TestVerifyAdminAuditExport_Incremental(t *testing.T) {
+	records := auditRecords()
+	first, mid := buildAuditExport(t, "", records[:2]...)
+	second, head := buildAuditExport(t, mid, records[2:]...)
+
+	_, _, err := VerifyAdminAuditExport(strings.NewReader(first), "")
+	require.NoError(t, err)
+	got, n, err := VerifyAdminAuditExport(strings.NewReader(second), mid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, head, got)
+
+	_, _, err = VerifyAdminAuditExport(strings.NewReader(second), "")
+	assert.Error(t, err, "continuation must not verify against the genesis hash")
+}
+
+func TestVerifyAdminAuditExport_DetectsTampering(t *testing.T) {
+	export, _ := buildAuditExport(t, "", auditRecords()...)
+	lines := strings.Split(strings.TrimSpace(export), "\n")
+
+	t.Run("edited record", func(t *testing.T) {
+		edited := strings.Replace(export, `"admin_id":9`, `"admin_id":8`, 1)
+		_, n, err := VerifyAdminAuditExport(strings.NewReader(edited), "")
+		assert.Error(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("removed line", func(t *testing.T) {
+		removed := lines[0] + "\n" + lines[2] + "\n"
+		_, n, err := VerifyAdminAuditExport(strings.NewReader(removed), "")
+		assert.Error(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("reordered lines", func(t *testing.T) {
+		reordered := lines[1] + "\n" + lines[0] + "\n" + lines[2] + "\n"
+		_, n, err := VerifyAdminAuditExport(strings.NewReader(reordered), "")
+		assert.Error(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
+
+func TestAdminAuditChainRecord_OmitsID(t *testing.T) {
+	r := auditRecords()[0]
+	raw, err := adminAuditChainRecord(r)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), `"id"`, "the hash is fixed before the row has an ID")
+	assert.Contains(t, string(raw), `"seq":1`)
+	assert.Equal(t, 1, r.ID, "the caller's record is not modified")
+
+	other := r
+	other.ID = 99
+	otherRaw, err := adminAuditChainRecord(other)
+	require.NoError(t, err)
+	assert.Equal(t, raw, otherRaw)
+}
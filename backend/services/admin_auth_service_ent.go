@@ -0,0 +1,739 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"backend-gin/config"
+	"backend-gin/database"
+	"backend-gin/dto"
+	"backend-gin/ent"
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+	"backend-gin/ent/adminsession"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Admin authentication constants
+const (
+	// AdminMaxFailedAttempts failed password or second-factor attempts lock the admin
+	AdminMaxFailedAttempts = 5
+
+	// AdminLockoutDuration is how long a locked admin must wait
+	AdminLockoutDuration = 15 * time.Minute
+
+	// AdminSessionTTL is the absolute lifetime of an admin session and its JWT
+	AdminSessionTTL = 8 * time.Hour
+
+	// AdminMFAChallengeTTL is how long the password step stays valid for the second factor
+	AdminMFAChallengeTTL = 5 * time.Minute
+
+	AdminMFAMethodTOTP    = "totp"
+	AdminMFAMethodPasskey = "passkey"
+)
+
+// AdminLoginResult is returned by each admin login step. Either Token is set (session
+// created) or MFAToken is set (a second factor is required).
+type AdminLoginResult struct {
+	Token       string
+	ExpiresAt   time.Time
+	Admin       *ent.Admin
+	Roles       []string
+	Permissions []string
+	// MFAEnrollmentRequired marks a session that may only enroll a second factor
+	MFAEnrollmentRequired bool
+
+	MFAToken   string
+	MFAMethods []string
+}
+
+// AdminMFAStatus describes the second factors an admin has enrolled
+type AdminMFAStatus struct {
+	TOTPEnabled bool
+	Passkeys    []*ent.AdminPasskey
+}
+
+// EntAdminAuthService handles admin login with a mandatory second factor, server-side
+// admin sessions, lockout and admin sudo. TOTP and WebAuthn reuse the user-facing logic.
+type EntAdminAuthService struct {
+	client   *ent.Client
+	passkeys *EntPasskeyService
+	audit    *EntSecurityAuditService
+}
+
+// NewEntAdminAuthService creates a new admin auth service with Ent. passkeyService may be
+// nil, in which case admin passkeys are unavailable.
+func NewEntAdminAuthService(passkeyService *EntPasskeyService) *EntAdminAuthService {
+	return &EntAdminAuthService{
+		client:   database.GetEntClient(),
+		passkeys: passkeyService,
+		audit:    NewEntSecurityAuditService(),
+	}
+}
+
+func adminJWTSecret() ([]byte, error) {
+	secret := strings.TrimSpace(os.Getenv("ADMIN_JWT_SECRET"))
+	if secret == "" {
+		return nil, errors.New("ADMIN_JWT_SECRET not configured")
+	}
+	return []byte(secret), nil
+}
+
+// ==================== Login ====================
+
+// Login checks email and password. Admins with a second factor receive an MFA challenge;
+// admins without one receive an enrollment-only session.
+func (s *EntAdminAuthService) Login(ctx context.Context, email, password, ip, userAgent string) (*AdminLoginResult, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	a, err := s.client.Admin.Query().Where(admin.EmailEQ(email)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			s.audit.LogEvent(ctx, EventAdminLoginFailed, nil, email, ip, userAgent, "unknown admin email", "warning", false)
+			return nil, apperrors.ErrAdminInvalidCredentials
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := checkAdminLocked(a, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(a.PasswordHash), []byte(password)); err != nil {
+		return nil, s.recordFailure(ctx, a, ip, userAgent, "password", apperrors.ErrAdminInvalidCredentials)
+	}
+
+	methods, err := s.mfaMethods(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	if len(methods) == 0 {
+		logger.Warn("Admin logged in without a second factor; session limited to MFA enrollment",
+			zap.Int("admin_id", a.ID))
+		return s.startSession(ctx, a, ip, userAgent, "")
+	}
+
+	token, err := s.signMFAChallenge(a.ID)
+	if err != nil {
+		logger.Error("Failed to sign admin MFA challenge", zap.Error(err))
+		return nil, apperrors.ErrInternalServer
+	}
+	return &AdminLoginResult{Admin: a, MFAToken: token, MFAMethods: methods}, nil
+}
+
+// LoginWithTOTP completes a login challenge with a TOTP code
+func (s *EntAdminAuthService) LoginWithTOTP(ctx context.Context, mfaToken, code, ip, userAgent string) (*AdminLoginResult, error) {
+	a, err := s.challengeAdmin(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.consumeTOTP(ctx, a, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, s.recordFailure(ctx, a, ip, userAgent, AdminMFAMethodTOTP, apperrors.ErrAdminMFAInvalid)
+	}
+	return s.startSession(ctx, a, ip, userAgent, AdminMFAMethodTOTP)
+}
+
+// BeginPasskeyLogin starts the passkey step of a login challenge
+func (s *EntAdminAuthService) BeginPasskeyLogin(ctx context.Context, mfaToken string) (*protocol.CredentialAssertion, error) {
+	a, err := s.challengeAdmin(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	return s.beginAssertion(ctx, a.ID, "login")
+}
+
+// FinishPasskeyLogin completes a login challenge with a passkey assertion
+func (s *EntAdminAuthService) FinishPasskeyLogin(ctx context.Context, mfaToken string, response *protocol.ParsedCredentialAssertionData, ip, userAgent string) (*AdminLoginResult, error) {
+	a, err := s.challengeAdmin(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	if s.passkeys == nil {
+		return nil, apperrors.ErrAdminMFAInvalid
+	}
+	if _, err := s.passkeys.FinishAdminAssertion(ctx, a.ID, "login", response); err != nil {
+		return nil, s.recordFailure(ctx, a, ip, userAgent, AdminMFAMethodPasskey, apperrors.ErrAdminMFAInvalid)
+	}
+	return s.startSession(ctx, a, ip, userAgent, AdminMFAMethodPasskey)
+}
+
+// challengeAdmin resolves an MFA challenge token to a non-locked admin
+func (s *EntAdminAuthService) challengeAdmin(ctx context.Context, mfaToken string) (*ent.Admin, error) {
+	adminID, err := parseMFAChallenge(mfaToken)
+	if err != nil {
+		return nil, apperrors.ErrAdminMFAChallenge
+	}
+	a, err := s.client.Admin.Get(ctx, adminID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrAdminMFAChallenge
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := checkAdminLocked(a, time.Now()); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// startSession creates an AdminSession and signs the admin JWT for it. An empty
+// mfaMethod creates an enrollment-only session.
+func (s *EntAdminAuthService) startSession(ctx context.Context, a *ent.Admin, ip, userAgent, mfaMethod string) (*AdminLoginResult, error) {
+	secret, err := adminJWTSecret()
+	if err != nil {
+		logger.Error("ADMIN_JWT_SECRET not configured")
+		return nil, apperrors.ErrInternalServer.WithDetails("Server configuration error")
+	}
+
+	// Roles and merged permissions are carried in the token and enforced per route by RequirePermission
+	roles, permissions, err := AdminRolesAndPermissions(ctx, s.client, a.ID)
+	if err != nil {
+		logger.Error("Failed to load admin roles", zap.Error(err), zap.Int("admin_id", a.ID))
+		return nil, apperrors.ErrDatabase.WithDetails("Gagal memuat role admin")
+	}
+
+	sessionID, err := randomAdminSessionID()
+	if err != nil {
+		return nil, apperrors.ErrInternalServer
+	}
+	now := time.Now()
+	expiresAt := now.Add(AdminSessionTTL)
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if _, err := tx.AdminSession.Create().
+			SetAdminID(a.ID).
+			SetSessionID(sessionID).
+			SetIPAddress(ip).
+			SetUserAgent(truncateString(userAgent, 512)).
+			SetMfaVerified(mfaMethod != "").
+			SetMfaMethod(mfaMethod).
+			SetExpiresAt(expiresAt).
+			SetLastActivityAt(now).
+			Save(ctx); err != nil {
+			return err
+		}
+		return tx.Admin.UpdateOneID(a.ID).
+			SetFailedLoginAttempts(0).
+			ClearLockedUntil().
+			SetLastLoginAt(now).
+			Exec(ctx)
+	})
+	if err != nil {
+		logger.Error("Failed to create admin session", zap.Error(err), zap.Int("admin_id", a.ID))
+		return nil, apperrors.ErrDatabase
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"type":        "admin",
+		"admin_id":    a.ID,
+		"sid":         sessionID,
+		"email":       a.Email,
+		"name":        a.Name,
+		"roles":       roles,
+		"permissions": permissions,
+		"iss":         config.JWTIssuer,
+		"aud":         []string{config.JWTAudience},
+		"exp":         expiresAt.Unix(),
+		"iat":         now.Unix(),
+	})
+	tokenString, err := token.SignedString(secret)
+	if err != nil {
+		logger.Error("Failed to sign admin token", zap.Error(err))
+		return nil, apperrors.ErrInternalServer.WithDetails("Gagal membuat token")
+	}
+
+	method := mfaMethod
+	if method == "" {
+		method = "none (enrollment only)"
+	}
+	s.audit.LogEvent(ctx, EventAdminLoginSuccess, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d mfa=%s", a.ID, method), "info", true)
+
+	return &AdminLoginResult{
+		Token:                 tokenString,
+		ExpiresAt:             expiresAt,
+		Admin:                 a,
+		Roles:                 roles,
+		Permissions:           permissions,
+		MFAEnrollmentRequired: mfaMethod == "",
+	}, nil
+}
+
+// recordFailure counts a failed attempt and locks the admin once the limit is reached.
+// Returns ErrAdminLocked when this attempt triggered the lock, otherwise failErr.
+func (s *EntAdminAuthService) recordFailure(ctx context.Context, a *ent.Admin, ip, userAgent, factor string, failErr error) error {
+	updated, err := s.client.Admin.UpdateOneID(a.ID).AddFailedLoginAttempts(1).Save(ctx)
+	if err != nil {
+		logger.Error("Failed to record admin login failure", zap.Error(err), zap.Int("admin_id", a.ID))
+		return failErr
+	}
+	s.audit.LogEvent(ctx, EventAdminLoginFailed, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d factor=%s attempts=%d", a.ID, factor, updated.FailedLoginAttempts), "warning", false)
+
+	if updated.FailedLoginAttempts < AdminMaxFailedAttempts {
+		return failErr
+	}
+
+	until := time.Now().Add(AdminLockoutDuration)
+	if err := s.client.Admin.UpdateOneID(a.ID).
+		SetFailedLoginAttempts(0).
+		SetLockedUntil(until).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to lock admin", zap.Error(err), zap.Int("admin_id", a.ID))
+		return failErr
+	}
+	s.audit.LogEvent(ctx, EventAdminLocked, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d locked_until=%s", a.ID, until.UTC().Format(time.RFC3339)), "critical", false)
+	logger.Warn("Admin locked after repeated failures", zap.Int("admin_id", a.ID), zap.Time("locked_until", until))
+	return apperrors.ErrAdminLocked.WithDetails("Coba lagi setelah " + until.Format("15:04"))
+}
+
+func checkAdminLocked(a *ent.Admin, now time.Time) error {
+	if a.LockedUntil != nil && now.Before(*a.LockedUntil) {
+		return apperrors.ErrAdminLocked.WithDetails("Coba lagi setelah " + a.LockedUntil.Format("15:04"))
+	}
+	return nil
+}
+
+func (s *EntAdminAuthService) mfaMethods(ctx context.Context, a *ent.Admin) ([]string, error) {
+	methods := []string{}
+	if a.TotpEnabled && a.TotpSecret != nil && *a.TotpSecret != "" {
+		methods = append(methods, AdminMFAMethodTOTP)
+	}
+	if s.passkeys != nil {
+		n, err := s.client.AdminPasskey.Query().Where(adminpasskey.AdminIDEQ(a.ID)).Count(ctx)
+		if err != nil {
+			return nil, apperrors.ErrDatabase
+		}
+		if n > 0 {
+			methods = append(methods, AdminMFAMethodPasskey)
+		}
+	}
+	return methods, nil
+}
+
+// consumeTOTP validates a code and atomically advances totp_last_step so it cannot be reused
+func (s *EntAdminAuthService) consumeTOTP(ctx context.Context, a *ent.Admin, code string) (bool, error) {
+	if !a.TotpEnabled || a.TotpSecret == nil || *a.TotpSecret == "" {
+		return false, nil
+	}
+	step, ok := matchTOTPStep(code, *a.TotpSecret, time.Now())
+	if !ok || step <= a.TotpLastStep {
+		return false, nil
+	}
+	n, err := s.client.Admin.Update().
+		Where(admin.IDEQ(a.ID), admin.TotpLastStepLT(step)).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, apperrors.ErrDatabase
+	}
+	return n == 1, nil
+}
+
+func (s *EntAdminAuthService) beginAssertion(ctx context.Context, adminID int, purpose string) (*protocol.CredentialAssertion, error) {
+	if s.passkeys == nil {
+		return nil, apperrors.ErrAdminPasskeyNotFound
+	}
+	options, err := s.passkeys.BeginAdminAssertion(ctx, adminID, purpose)
+	if err != nil {
+		return nil, apperrors.ErrAdminPasskeyNotFound.WithDetails("Belum ada passkey admin yang terdaftar")
+	}
+	return options, nil
+}
+
+// signMFAChallenge issues the short-lived token that links the password step to the second factor
+func (s *EntAdminAuthService) signMFAChallenge(adminID int) (string, error) {
+	secret, err := adminJWTSecret()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"type":     "admin_mfa",
+		"admin_id": adminID,
+		"iss":      config.JWTIssuer,
+		"aud":      []string{config.JWTAudience},
+		"exp":      now.Add(AdminMFAChallengeTTL).Unix(),
+		"iat":      now.Unix(),
+	}).SignedString(secret)
+}
+
+func parseMFAChallenge(tokenString string) (int, error) {
+	secret, err := adminJWTSecret()
+	if err != nil {
+		return 0, err
+	}
+	token, err := jwt.Parse(tokenString, func(*jwt.Token) (interface{}, error) { return secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(config.JWTIssuer),
+		jwt.WithAudience(config.JWTAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return 0, errors.New("invalid challenge")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, errors.New("invalid challenge claims")
+	}
+	if typ, _ := claims["type"].(string); typ != "admin_mfa" {
+		return 0, errors.New("not an admin MFA challenge")
+	}
+	adminID, _ := claims["admin_id"].(float64)
+	if adminID <= 0 {
+		return 0, errors.New("invalid challenge subject")
+	}
+	return int(adminID), nil
+}
+
+func randomAdminSessionID() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ==================== Sessions ====================
+
+// Logout revokes the calling admin session
+func (s *EntAdminAuthService) Logout(ctx context.Context, sessionID string) error {
+	_, err := s.client.AdminSession.Update().
+		Where(adminsession.SessionIDEQ(sessionID), adminsession.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason("logout").
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	return nil
+}
+
+// ListSessions returns the admin's active (non-revoked, non-expired) sessions, newest first
+func (s *EntAdminAuthService) ListSessions(ctx context.Context, adminID int) ([]*ent.AdminSession, error) {
+	sessions, err := s.client.AdminSession.Query().
+		Where(
+			adminsession.AdminIDEQ(adminID),
+			adminsession.RevokedAtIsNil(),
+			adminsession.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(adminsession.FieldLastActivityAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	return sessions, nil
+}
+
+// RevokeSession revokes one of the admin's own sessions by row ID
+func (s *EntAdminAuthService) RevokeSession(ctx context.Context, adminID, id int) error {
+	n, err := s.client.AdminSession.Update().
+		Where(
+			adminsession.IDEQ(id),
+			adminsession.AdminIDEQ(adminID),
+			adminsession.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason("revoked_by_admin").
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if n == 0 {
+		return apperrors.ErrAdminSessionInvalid.WithDetails("Sesi tidak ditemukan")
+	}
+	return nil
+}
+
+// ==================== MFA enrollment ====================
+
+// MFAStatus returns the admin's enrolled second factors
+func (s *EntAdminAuthService) MFAStatus(ctx context.Context, adminID int) (*AdminMFAStatus, error) {
+	a, err := s.client.Admin.Query().
+		Where(admin.IDEQ(adminID)).
+		WithPasskeys(func(q *ent.AdminPasskeyQuery) {
+			q.Order(ent.Desc(adminpasskey.FieldCreatedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	return &AdminMFAStatus{TOTPEnabled: a.TotpEnabled, Passkeys: a.Edges.Passkeys}, nil
+}
+
+// BeginTOTPSetup stores a new (not yet enabled) TOTP secret for the admin
+func (s *EntAdminAuthService) BeginTOTPSetup(ctx context.Context, adminID int) (*dto.TOTPSetupResponse, error) {
+	a, err := s.client.Admin.Get(ctx, adminID)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if a.TotpEnabled {
+		return nil, apperrors.ErrAdminTOTPAlreadyEnabled
+	}
+
+	key, err := generateTOTPKey(a.Email)
+	if err != nil {
+		logger.Error("Failed to generate admin TOTP key", zap.Error(err), zap.Int("admin_id", adminID))
+		return nil, apperrors.ErrInternalServer
+	}
+	if err := s.client.Admin.UpdateOneID(adminID).SetTotpSecret(key.Secret()).Exec(ctx); err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	return &dto.TOTPSetupResponse{
+		Secret:    key.Secret(),
+		QRCodeURL: key.URL(),
+		Issuer:    TOTPIssuer,
+		Account:   a.Email,
+	}, nil
+}
+
+// EnableTOTP confirms the pending secret with a code and upgrades the calling session
+func (s *EntAdminAuthService) EnableTOTP(ctx context.Context, adminID int, sessionID, code, ip, userAgent string) error {
+	a, err := s.client.Admin.Get(ctx, adminID)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if a.TotpEnabled {
+		return apperrors.ErrAdminTOTPAlreadyEnabled
+	}
+	if a.TotpSecret == nil || *a.TotpSecret == "" {
+		return apperrors.ErrAdminTOTPNotSetup
+	}
+	step, ok := matchTOTPStep(code, *a.TotpSecret, time.Now())
+	if !ok {
+		return apperrors.ErrAdminMFAInvalid
+	}
+
+	now := time.Now()
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := tx.Admin.UpdateOneID(adminID).
+			SetTotpEnabled(true).
+			SetTotpEnabledAt(now).
+			SetTotpLastStep(step).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return markSessionMFAVerified(ctx, tx.AdminSession, sessionID, AdminMFAMethodTOTP)
+	})
+	if err != nil {
+		logger.Error("Failed to enable admin TOTP", zap.Error(err), zap.Int("admin_id", adminID))
+		return apperrors.ErrDatabase
+	}
+
+	s.audit.LogEvent(ctx, EventAdminMFAEnrolled, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d method=totp", adminID), "info", true)
+	return nil
+}
+
+// DisableTOTP removes TOTP; a passkey must remain so the admin keeps a second factor
+func (s *EntAdminAuthService) DisableTOTP(ctx context.Context, adminID int, ip, userAgent string) error {
+	a, err := s.client.Admin.Get(ctx, adminID)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	passkeys, err := s.client.AdminPasskey.Query().Where(adminpasskey.AdminIDEQ(adminID)).Count(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if passkeys == 0 {
+		return apperrors.ErrAdminLastFactor.WithDetails("Daftarkan passkey sebelum menonaktifkan TOTP")
+	}
+
+	if err := s.client.Admin.UpdateOneID(adminID).
+		SetTotpEnabled(false).
+		ClearTotpSecret().
+		ClearTotpEnabledAt().
+		Exec(ctx); err != nil {
+		return apperrors.ErrDatabase
+	}
+	s.audit.LogEvent(ctx, EventAdminMFARemoved, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d method=totp", adminID), "warning", true)
+	return nil
+}
+
+// BeginPasskeyRegistration starts registering an admin passkey
+func (s *EntAdminAuthService) BeginPasskeyRegistration(ctx context.Context, adminID int) (*protocol.CredentialCreation, error) {
+	if s.passkeys == nil {
+		return nil, apperrors.ErrInternalServer.WithDetails("Passkey tidak dikonfigurasi")
+	}
+	options, err := s.passkeys.BeginAdminRegistration(ctx, adminID)
+	if err != nil {
+		return nil, apperrors.ErrInternalServer.WithDetails("Gagal memulai registrasi passkey")
+	}
+	return options, nil
+}
+
+// FinishPasskeyRegistration stores the passkey and upgrades the calling session
+func (s *EntAdminAuthService) FinishPasskeyRegistration(ctx context.Context, adminID int, sessionID, name string, response *protocol.ParsedCredentialCreationData, ip, userAgent string) (*ent.AdminPasskey, error) {
+	if s.passkeys == nil {
+		return nil, apperrors.ErrInternalServer.WithDetails("Passkey tidak dikonfigurasi")
+	}
+	pk, err := s.passkeys.FinishAdminRegistration(ctx, adminID, strings.TrimSpace(name), response)
+	if err != nil {
+		return nil, apperrors.ErrAdminMFAInvalid.WithDetails("Registrasi passkey gagal diverifikasi")
+	}
+	if err := markSessionMFAVerified(ctx, s.client.AdminSession, sessionID, AdminMFAMethodPasskey); err != nil {
+		logger.Error("Failed to upgrade admin session after passkey enrollment", zap.Error(err), zap.Int("admin_id", adminID))
+		return nil, apperrors.ErrDatabase
+	}
+
+	email := ""
+	if a, err := s.client.Admin.Get(ctx, adminID); err == nil {
+		email = a.Email
+	}
+	s.audit.LogEvent(ctx, EventAdminMFAEnrolled, nil, email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d method=passkey passkey_id=%d", adminID, pk.ID), "info", true)
+	return pk, nil
+}
+
+// DeletePasskey removes an admin passkey unless it is the admin's last second factor
+func (s *EntAdminAuthService) DeletePasskey(ctx context.Context, adminID, passkeyID int, ip, userAgent string) error {
+	a, err := s.client.Admin.Get(ctx, adminID)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	remaining, err := s.client.AdminPasskey.Query().
+		Where(adminpasskey.AdminIDEQ(adminID), adminpasskey.IDNEQ(passkeyID)).
+		Count(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if remaining == 0 && !a.TotpEnabled {
+		return apperrors.ErrAdminLastFactor
+	}
+
+	n, err := s.client.AdminPasskey.Delete().
+		Where(adminpasskey.IDEQ(passkeyID), adminpasskey.AdminIDEQ(adminID)).
+		Exec(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if n == 0 {
+		return apperrors.ErrAdminPasskeyNotFound
+	}
+	s.audit.LogEvent(ctx, EventAdminMFARemoved, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d method=passkey passkey_id=%d", adminID, passkeyID), "warning", true)
+	return nil
+}
+
+// markSessionMFAVerified upgrades an enrollment-only session after a factor is enrolled
+func markSessionMFAVerified(ctx context.Context, sessions *ent.AdminSessionClient, sessionID, method string) error {
+	return sessions.Update().
+		Where(adminsession.SessionIDEQ(sessionID), adminsession.RevokedAtIsNil()).
+		SetMfaVerified(true).
+		SetMfaMethod(method).
+		Exec(ctx)
+}
+
+// ==================== Sudo ====================
+
+// SudoWithTOTP re-verifies password and TOTP and enables sudo on the calling session
+func (s *EntAdminAuthService) SudoWithTOTP(ctx context.Context, adminID int, sessionID, password, code, ip, userAgent string) (time.Time, error) {
+	a, err := s.sudoPasswordCheck(ctx, adminID, password, ip, userAgent)
+	if err != nil {
+		return time.Time{}, err
+	}
+	ok, err := s.consumeTOTP(ctx, a, code)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, s.recordFailure(ctx, a, ip, userAgent, "sudo_totp", apperrors.ErrAdminMFAInvalid)
+	}
+	return s.grantSudo(ctx, a, sessionID, AdminMFAMethodTOTP, ip, userAgent)
+}
+
+// BeginPasskeySudo starts a passkey assertion for sudo
+func (s *EntAdminAuthService) BeginPasskeySudo(ctx context.Context, adminID int) (*protocol.CredentialAssertion, error) {
+	return s.beginAssertion(ctx, adminID, "sudo")
+}
+
+// SudoWithPasskey re-verifies password and a passkey assertion and enables sudo
+func (s *EntAdminAuthService) SudoWithPasskey(ctx context.Context, adminID int, sessionID, password string, response *protocol.ParsedCredentialAssertionData, ip, userAgent string) (time.Time, error) {
+	a, err := s.sudoPasswordCheck(ctx, adminID, password, ip, userAgent)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if s.passkeys == nil {
+		return time.Time{}, apperrors.ErrAdminMFAInvalid
+	}
+	if _, err := s.passkeys.FinishAdminAssertion(ctx, adminID, "sudo", response); err != nil {
+		return time.Time{}, s.recordFailure(ctx, a, ip, userAgent, "sudo_passkey", apperrors.ErrAdminMFAInvalid)
+	}
+	return s.grantSudo(ctx, a, sessionID, AdminMFAMethodPasskey, ip, userAgent)
+}
+
+func (s *EntAdminAuthService) sudoPasswordCheck(ctx context.Context, adminID int, password, ip, userAgent string) (*ent.Admin, error) {
+	a, err := s.client.Admin.Get(ctx, adminID)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if err := checkAdminLocked(a, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(a.PasswordHash), []byte(password)); err != nil {
+		return nil, s.recordFailure(ctx, a, ip, userAgent, "sudo_password", apperrors.ErrAdminInvalidCredentials)
+	}
+	return a, nil
+}
+
+func (s *EntAdminAuthService) grantSudo(ctx context.Context, a *ent.Admin, sessionID, method, ip, userAgent string) (time.Time, error) {
+	until := time.Now().Add(SudoTTL)
+	n, err := s.client.AdminSession.Update().
+		Where(adminsession.SessionIDEQ(sessionID), adminsession.AdminIDEQ(a.ID), adminsession.RevokedAtIsNil()).
+		SetSudoUntil(until).
+		Save(ctx)
+	if err != nil {
+		return time.Time{}, apperrors.ErrDatabase
+	}
+	if n == 0 {
+		return time.Time{}, apperrors.ErrAdminSessionInvalid
+	}
+	if err := s.client.Admin.UpdateOneID(a.ID).SetFailedLoginAttempts(0).Exec(ctx); err != nil {
+		logger.Warn("Failed to reset admin failure counter", zap.Error(err), zap.Int("admin_id", a.ID))
+	}
+	s.audit.LogEvent(ctx, EventAdminSudo, nil, a.Email, ip, userAgent,
+		fmt.Sprintf("admin_id=%d method=%s", a.ID, method), "info", true)
+	return until, nil
+}
+
+// ResetAdminMFA clears every second factor and session of an admin (recovery via seed_admin)
+func ResetAdminMFA(ctx context.Context, client *ent.Client, adminID int) error {
+	return WithTx(ctx, client, func(tx *ent.Tx) error {
+		if _, err := tx.AdminPasskey.Delete().Where(adminpasskey.AdminIDEQ(adminID)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Admin.UpdateOneID(adminID).
+			SetTotpEnabled(false).
+			ClearTotpSecret().
+			ClearTotpEnabledAt().
+			SetTotpLastStep(0).
+			SetFailedLoginAttempts(0).
+			ClearLockedUntil().
+			Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.AdminSession.Update().
+			Where(adminsession.AdminIDEQ(adminID), adminsession.RevokedAtIsNil()).
+			SetRevokedAt(time.Now()).
+			SetRevokeReason("mfa_reset").
+			Save(ctx)
+		return err
+	})
+}
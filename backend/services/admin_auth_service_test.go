@@ -0,0 +1,62 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"backend-gin/config"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchTOTPStep(t *testing.T) {
+	key, err := generateTOTPKey("admin@example.com")
+	require.NoError(t, err)
+	secret := key.Secret()
+
+	at := time.Date(2026, 10, 1, 12, 0, 15, 0, time.UTC)
+	step := at.Unix() / TOTPPeriod
+	codeAt := func(s int64) string {
+		code, err := totp.GenerateCodeCustom(secret, time.Unix(s*TOTPPeriod, 0).UTC(), totp.ValidateOpts{
+			Period: TOTPPeriod, Digits: otp.DigitsSix, Algorithm: otp.AlgorithmSHA1,
+		})
+		require.NoError(t, err)
+		return code
+	}
+
+	for _, s := range []int64{step - 1, step, step + 1} {
+		got, ok := matchTOTPStep(codeAt(s), secret, at)
+		assert.True(t, ok, "step %d should match", s)
+		assert.Equal(t, s, got, "matched step must be returned for replay protection")
+	}
+
+	_, ok := matchTOTPStep(codeAt(step+2), secret, at)
+	assert.False(t, ok, "codes outside the skew window must be rejected")
+	_, ok = matchTOTPStep("12345", secret, at)
+	assert.False(t, ok, "short codes must be rejected")
+}
+
+func TestAdminMFAChallengeRoundTrip(t *testing.T) {
+	t.Setenv("ADMIN_JWT_SECRET", "test-admin-secret")
+	issuer, audience := config.JWTIssuer, config.JWTAudience
+	config.JWTIssuer, config.JWTAudience = "test-issuer", "test-audience"
+	t.Cleanup(func() { config.JWTIssuer, config.JWTAudience = issuer, audience })
+
+	s := &EntAdminAuthService{}
+	token, err := s.signMFAChallenge(42)
+	require.NoError(t, err)
+
+	adminID, err := parseMFAChallenge(token)
+	require.NoError(t, err)
+	assert.Equal(t, 42, adminID)
+
+	_, err = parseMFAChallenge(token + "x")
+	assert.Error(t, err, "tampered challenge must be rejected")
+
+	t.Setenv("ADMIN_JWT_SECRET", "rotated-secret")
+	_, err = parseMFAChallenge(token)
+	assert.Error(t, err, "challenge signed with another secret must be rejected")
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminpasskey"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"go.uber.org/zap"
+)
+
+// Admin WebAuthn ceremonies reuse EntPasskeyService's relying party and session store,
+// but keep credentials in admin_passkeys and use a distinct user handle namespace.
+
+// EntWebAuthnAdmin wraps ent.Admin to implement webauthn.User
+type EntWebAuthnAdmin struct {
+	Admin    *ent.Admin
+	Passkeys []*ent.AdminPasskey
+}
+
+// WebAuthnID is prefixed so it can never equal a user's 4-byte handle
+func (a *EntWebAuthnAdmin) WebAuthnID() []byte {
+	return []byte(fmt.Sprintf("admin:%d", a.Admin.ID))
+}
+
+func (a *EntWebAuthnAdmin) WebAuthnName() string {
+	return a.Admin.Email
+}
+
+func (a *EntWebAuthnAdmin) WebAuthnDisplayName() string {
+	if a.Admin.Name != "" {
+		return a.Admin.Name
+	}
+	return a.Admin.Email
+}
+
+func (a *EntWebAuthnAdmin) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(a.Passkeys))
+	for i, pk := range a.Passkeys {
+		credentials[i] = buildWebAuthnCredential(pk.CredentialID, pk.PublicKey, pk.AttestationType, pk.Aaguid,
+			pk.SignCount, pk.Transports, pk.BackupEligible, pk.BackupState)
+	}
+	return credentials
+}
+
+func adminRegistrationSessionKey(adminID int) string {
+	return fmt.Sprintf("webauthn:admin:reg:%d", adminID)
+}
+
+// purpose separates login and sudo ceremonies so one cannot complete the other
+func adminAssertionSessionKey(purpose string, adminID int) string {
+	return fmt.Sprintf("webauthn:admin:%s:%d", purpose, adminID)
+}
+
+func (s *EntPasskeyService) loadWebAuthnAdmin(ctx context.Context, adminID int) (*EntWebAuthnAdmin, error) {
+	a, err := database.GetEntClient().Admin.Query().
+		Where(admin.IDEQ(adminID)).
+		WithPasskeys().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("admin not found: %w", err)
+	}
+	return &EntWebAuthnAdmin{Admin: a, Passkeys: a.Edges.Passkeys}, nil
+}
+
+// BeginAdminRegistration starts registering a passkey for an admin
+func (s *EntPasskeyService) BeginAdminRegistration(ctx context.Context, adminID int) (*protocol.CredentialCreation, error) {
+	webAuthnAdmin, err := s.loadWebAuthnAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	excludeList := make([]protocol.CredentialDescriptor, len(webAuthnAdmin.Passkeys))
+	for i, pk := range webAuthnAdmin.Passkeys {
+		excludeList[i] = protocol.CredentialDescriptor{
+			Type:         protocol.PublicKeyCredentialType,
+			CredentialID: pk.CredentialID,
+		}
+	}
+
+	options, session, err := s.webauthn.BeginRegistration(
+		webAuthnAdmin,
+		webauthn.WithExclusions(excludeList),
+		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationRequired,
+		}),
+	)
+	if err != nil {
+		s.logger.Error("Failed to begin admin passkey registration", zap.Error(err), zap.Int("admin_id", adminID))
+		return nil, fmt.Errorf("failed to begin registration: %w", err)
+	}
+
+	s.storeSession(adminRegistrationSessionKey(adminID), session)
+	return options, nil
+}
+
+// FinishAdminRegistration verifies the attestation and stores the admin passkey
+func (s *EntPasskeyService) FinishAdminRegistration(ctx context.Context, adminID int, name string, response *protocol.ParsedCredentialCreationData) (*ent.AdminPasskey, error) {
+	webAuthnAdmin, err := s.loadWebAuthnAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, ok := s.getSession(adminRegistrationSessionKey(adminID))
+	if !ok {
+		return nil, errors.New("registration session expired or not found")
+	}
+
+	credential, err := s.webauthn.CreateCredential(webAuthnAdmin, *session, response)
+	if err != nil {
+		origin, host := originAndHostFromCreation(response)
+		s.logger.Warn("Failed to create admin credential",
+			zap.Error(err),
+			zap.Int("admin_id", adminID),
+			zap.String("origin", origin),
+			zap.String("host", host))
+		return nil, fmt.Errorf("failed to verify credential: %w", err)
+	}
+
+	transports := make([]string, len(credential.Transport))
+	for i, t := range credential.Transport {
+		transports[i] = string(t)
+	}
+	if name == "" {
+		name = "Passkey"
+	}
+
+	pk, err := database.GetEntClient().AdminPasskey.Create().
+		SetAdminID(adminID).
+		SetCredentialID(credential.ID).
+		SetPublicKey(credential.PublicKey).
+		SetAttestationType(credential.AttestationType).
+		SetAaguid(credential.Authenticator.AAGUID).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetBackupEligible(credential.Flags.BackupEligible).
+		SetBackupState(credential.Flags.BackupState).
+		SetName(name).
+		SetTransports(transports).
+		Save(ctx)
+	if err != nil {
+		s.logger.Error("Failed to save admin passkey", zap.Error(err), zap.Int("admin_id", adminID))
+		return nil, fmt.Errorf("failed to save passkey: %w", err)
+	}
+
+	s.logger.Info("Admin passkey registered", zap.Int("admin_id", adminID), zap.String("name", name))
+	return pk, nil
+}
+
+// BeginAdminAssertion starts a passkey assertion for an admin (purpose is "login" or "sudo")
+func (s *EntPasskeyService) BeginAdminAssertion(ctx context.Context, adminID int, purpose string) (*protocol.CredentialAssertion, error) {
+	webAuthnAdmin, err := s.loadWebAuthnAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if len(webAuthnAdmin.Passkeys) == 0 {
+		return nil, errors.New("no passkeys registered")
+	}
+
+	options, session, err := s.webauthn.BeginLogin(webAuthnAdmin,
+		webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		s.logger.Error("Failed to begin admin passkey assertion", zap.Error(err), zap.Int("admin_id", adminID))
+		return nil, fmt.Errorf("failed to begin login: %w", err)
+	}
+
+	s.storeSession(adminAssertionSessionKey(purpose, adminID), session)
+	return options, nil
+}
+
+// FinishAdminAssertion validates a passkey assertion started by BeginAdminAssertion
+func (s *EntPasskeyService) FinishAdminAssertion(ctx context.Context, adminID int, purpose string, response *protocol.ParsedCredentialAssertionData) (*ent.AdminPasskey, error) {
+	webAuthnAdmin, err := s.loadWebAuthnAdmin(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, ok := s.getSession(adminAssertionSessionKey(purpose, adminID))
+	if !ok {
+		return nil, errors.New("login session expired or not found")
+	}
+
+	credential, err := s.webauthn.ValidateLogin(webAuthnAdmin, *session, response)
+	if err != nil {
+		s.logger.Warn("Failed to validate admin passkey", zap.Error(err), zap.Int("admin_id", adminID))
+		return nil, fmt.Errorf("failed to validate login: %w", err)
+	}
+
+	client := database.GetEntClient()
+	if err := client.AdminPasskey.Update().
+		Where(adminpasskey.CredentialIDEQ(credential.ID), adminpasskey.AdminIDEQ(adminID)).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx); err != nil {
+		s.logger.Warn("Failed to update admin passkey usage", zap.Error(err))
+	}
+
+	pk, err := client.AdminPasskey.Query().
+		Where(adminpasskey.CredentialIDEQ(credential.ID), adminpasskey.AdminIDEQ(adminID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("credential not found: %w", err)
+	}
+	return pk, nil
+}
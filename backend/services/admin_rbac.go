@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"backend-gin/ent"
+	"backend-gin/ent/admin"
+	"backend-gin/ent/adminrole"
+	"backend-gin/logger"
+	"backend-gin/middleware"
+
+	"go.uber.org/zap"
+)
+
+// EnsureBuiltinAdminRoles creates the built-in admin roles and keeps their permissions in
+// sync with middleware.BuiltinAdminRoles. The first time super_admin is created, every
+// existing admin without a role is granted it, so enabling RBAC does not lock anyone out.
+func EnsureBuiltinAdminRoles(ctx context.Context, client *ent.Client) error {
+	return WithTx(ctx, client, func(tx *ent.Tx) error {
+		superAdminCreated := false
+		var superAdmin *ent.AdminRole
+
+		for _, def := range middleware.BuiltinAdminRoles {
+			role, err := tx.AdminRole.Query().Where(adminrole.NameEQ(def.Name)).Only(ctx)
+			switch {
+			case ent.IsNotFound(err):
+				role, err = tx.AdminRole.Create().
+					SetName(def.Name).
+					SetDescription(def.Description).
+					SetPermissions(def.Permissions).
+					SetIsSystem(true).
+					Save(ctx)
+				if err != nil {
+					return fmt.Errorf("create admin role %s: %w", def.Name, err)
+				}
+				if def.Name == middleware.AdminRoleSuperAdmin {
+					superAdminCreated = true
+				}
+			case err != nil:
+				return fmt.Errorf("query admin role %s: %w", def.Name, err)
+			default:
+				role, err = tx.AdminRole.UpdateOne(role).
+					SetDescription(def.Description).
+					SetPermissions(def.Permissions).
+					SetIsSystem(true).
+					Save(ctx)
+				if err != nil {
+					return fmt.Errorf("sync admin role %s: %w", def.Name, err)
+				}
+			}
+			if def.Name == middleware.AdminRoleSuperAdmin {
+				superAdmin = role
+			}
+		}
+
+		if superAdminCreated && superAdmin != nil {
+			legacy, err := tx.Admin.Query().Where(admin.Not(admin.HasRoles())).IDs(ctx)
+			if err != nil {
+				return fmt.Errorf("query admins without roles: %w", err)
+			}
+			if len(legacy) > 0 {
+				if _, err := tx.Admin.Update().
+					Where(admin.IDIn(legacy...)).
+					AddRoleIDs(superAdmin.ID).
+					Save(ctx); err != nil {
+					return fmt.Errorf("grant super_admin to existing admins: %w", err)
+				}
+				logger.Info("Granted super_admin to pre-RBAC admins", zap.Int("count", len(legacy)))
+			}
+		}
+		return nil
+	})
+}
+
+// AdminRolesAndPermissions returns the role names and merged permissions of an admin
+func AdminRolesAndPermissions(ctx context.Context, client *ent.Client, adminID int) ([]string, []string, error) {
+	roles, err := client.AdminRole.Query().
+		Where(adminrole.HasAdminsWith(admin.IDEQ(adminID))).
+		Order(ent.Asc(adminrole.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(roles))
+	sets := make([][]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+		sets = append(sets, r.Permissions)
+	}
+	return names, middleware.MergeAdminPermissions(sets...), nil
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/backupcode"
+	"backend-gin/ent/devicefingerprint"
+	"backend-gin/ent/deviceusermapping"
+	"backend-gin/ent/securityevent"
+	"backend-gin/ent/session"
+	"backend-gin/ent/sessionlock"
+	"backend-gin/ent/totppendingtoken"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+// AdminLockIndefinite is used when an admin locks an account without an end time
+const AdminLockIndefinite = 100 * 365 * 24 * time.Hour
+
+// AdminActor identifies the admin performing a moderation action
+type AdminActor struct {
+	ID        int
+	Email     string
+	IP        string
+	UserAgent string
+}
+
+// lockedBy is stored in session_locks.locked_by
+func (a AdminActor) lockedBy() string {
+	return fmt.Sprintf("admin:%d", a.ID)
+}
+
+// EntAdminUserModerationService implements the admin moderation actions on user accounts.
+// Each action also writes a SecurityEvent on the affected user; the request itself is
+// recorded by the admin audit trail.
+type EntAdminUserModerationService struct {
+	client   *ent.Client
+	sessions *EntSessionService
+	audit    *EntSecurityAuditService
+}
+
+// NewEntAdminUserModerationService creates a new moderation service with Ent
+func NewEntAdminUserModerationService(sessionService *EntSessionService) *EntAdminUserModerationService {
+	return &EntAdminUserModerationService{
+		client:   database.GetEntClient(),
+		sessions: sessionService,
+		audit:    NewEntSecurityAuditService(),
+	}
+}
+
+func (s *EntAdminUserModerationService) getUser(ctx context.Context, userID int) (*ent.User, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrUserNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	return u, nil
+}
+
+func (s *EntAdminUserModerationService) logEvent(ctx context.Context, eventType SecurityEventType, u *ent.User, actor AdminActor, details string) {
+	userID := u.ID
+	s.audit.LogEvent(ctx, eventType, &userID, u.Email, actor.IP, actor.UserAgent,
+		truncateString(fmt.Sprintf("admin=%s %s", actor.Email, details), 1000), "warning", true)
+}
+
+// LockUser locks the account until the given time (zero = until unlocked) and signs it
+// out everywhere.
+func (s *EntAdminUserModerationService) LockUser(ctx context.Context, actor AdminActor, userID int, reason string, until time.Time) (time.Time, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if until.IsZero() {
+		until = time.Now().Add(AdminLockIndefinite)
+	}
+	lockReason := truncateString(reason, 255)
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := tx.User.UpdateOneID(userID).
+			SetLockedUntil(until).
+			SetLockReason(lockReason).
+			Exec(ctx); err != nil {
+			return err
+		}
+		// Same row shape as EntSessionService.ApplySessionLock, inside this transaction
+		existing, err := tx.SessionLock.Query().Where(sessionlock.UserIDEQ(userID)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		now := time.Now()
+		if existing != nil {
+			return tx.SessionLock.UpdateOne(existing).
+				SetLockedAt(now).
+				ClearUnlockedAt().
+				SetExpiresAt(until).
+				SetReason(lockReason).
+				SetLockedBy(actor.lockedBy()).
+				Exec(ctx)
+		}
+		return tx.SessionLock.Create().
+			SetUserID(userID).
+			SetLockedAt(now).
+			SetExpiresAt(until).
+			SetReason(lockReason).
+			SetLockedBy(actor.lockedBy()).
+			Exec(ctx)
+	})
+	if err != nil {
+		logger.Error("Failed to lock user", zap.Error(err), zap.Int("user_id", userID))
+		return time.Time{}, apperrors.ErrDatabase
+	}
+
+	if err := s.sessions.RevokeAllUserSessions(ctx, userID, "Account locked by admin"); err != nil {
+		logger.Error("Failed to revoke sessions of locked user", zap.Error(err), zap.Int("user_id", userID))
+	}
+
+	s.logEvent(ctx, EventAdminUserLocked, u, actor, fmt.Sprintf("until=%s reason=%s", until.Format(time.RFC3339), reason))
+	return until, nil
+}
+
+// UnlockUser lifts admin and brute-force locks and resets the failed login counter
+func (s *EntAdminUserModerationService) UnlockUser(ctx context.Context, actor AdminActor, userID int, reason string) error {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := tx.User.UpdateOneID(userID).
+			ClearLockedUntil().
+			SetLockReason("").
+			SetFailedLoginAttempts(0).
+			Exec(ctx); err != nil {
+			return err
+		}
+		// Login only looks at expires_at, so end the lock now as well as marking it unlocked
+		now := time.Now()
+		_, err := tx.SessionLock.Update().
+			Where(sessionlock.UserIDEQ(userID), sessionlock.UnlockedAtIsNil()).
+			SetUnlockedAt(now).
+			SetExpiresAt(now).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed to unlock user", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase
+	}
+
+	s.logEvent(ctx, EventAdminUserUnlocked, u, actor, "reason="+reason)
+	return nil
+}
+
+// RevokeSessions signs the user out of every session
+func (s *EntAdminUserModerationService) RevokeSessions(ctx context.Context, actor AdminActor, userID int, reason string) (int, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	active, err := s.sessions.GetActiveSessionCount(ctx, userID)
+	if err != nil {
+		logger.Warn("Failed to count sessions before admin revoke", zap.Error(err), zap.Int("user_id", userID))
+	}
+	if err := s.sessions.RevokeAllUserSessions(ctx, userID, "Revoked by admin"); err != nil {
+		logger.Error("Failed to revoke user sessions", zap.Error(err), zap.Int("user_id", userID))
+		return 0, apperrors.ErrDatabase
+	}
+
+	s.logEvent(ctx, EventAdminSessionsRevoked, u, actor, fmt.Sprintf("sessions=%d reason=%s", active, reason))
+	return active, nil
+}
+
+// BlockUserDevices blocks the given fingerprint hashes, or every device seen on the
+// account when none are given, and revokes the sessions opened from them. Hashes that
+// were never seen on this account are rejected.
+func (s *EntAdminUserModerationService) BlockUserDevices(ctx context.Context, actor AdminActor, userID int, hashes []string, reason string) ([]string, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := s.userDeviceHashes(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to load user devices", zap.Error(err), zap.Int("user_id", userID))
+		return nil, apperrors.ErrDatabase
+	}
+
+	targets := known
+	if len(hashes) > 0 {
+		seen := make(map[string]bool, len(known))
+		for _, h := range known {
+			seen[h] = true
+		}
+		targets = make([]string, 0, len(hashes))
+		for _, h := range hashes {
+			h = strings.TrimSpace(h)
+			if !seen[h] {
+				return nil, apperrors.ErrInvalidInput.WithDetails("Perangkat tidak terdaftar pada akun ini: " + truncateString(h, 16))
+			}
+			targets = append(targets, h)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, apperrors.ErrInvalidInput.WithDetails("Akun ini belum memiliki perangkat tercatat")
+	}
+
+	tracker := GetEntDeviceTracker()
+	if tracker == nil {
+		return nil, apperrors.ErrInternalServer.WithDetails("Device tracker belum aktif")
+	}
+	blockReason := truncateString(fmt.Sprintf("admin:%d %s", actor.ID, reason), 255)
+	for _, h := range targets {
+		if err := tracker.BlockDevice(ctx, h, blockReason); err != nil {
+			logger.Error("Failed to block device", zap.Error(err), zap.Int("user_id", userID))
+			return nil, apperrors.ErrDatabase
+		}
+	}
+
+	if _, err := s.client.Session.Update().
+		Where(
+			session.UserIDEQ(userID),
+			session.RevokedAtIsNil(),
+			session.DeviceFingerprintHashIn(targets...),
+		).
+		SetRevokedAt(time.Now()).
+		SetRevokeReason("Device blocked by admin").
+		Save(ctx); err != nil {
+		logger.Warn("Failed to revoke sessions of blocked devices", zap.Error(err), zap.Int("user_id", userID))
+	}
+
+	s.logEvent(ctx, EventAdminDevicesBlocked, u, actor, fmt.Sprintf("devices=%d reason=%s", len(targets), reason))
+	return targets, nil
+}
+
+// userDeviceHashes returns every fingerprint hash recorded for the user, including
+// devices the user removed from their own list
+func (s *EntAdminUserModerationService) userDeviceHashes(ctx context.Context, userID int) ([]string, error) {
+	mapped, err := s.client.DeviceUserMapping.Query().
+		Where(deviceusermapping.UserIDEQ(userID)).
+		Select(deviceusermapping.FieldFingerprintHash).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	owned, err := s.client.DeviceFingerprint.Query().
+		Where(devicefingerprint.UserIDEQ(userID)).
+		Select(devicefingerprint.FieldFingerprintHash).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(mapped)+len(owned))
+	hashes := make([]string, 0, len(mapped)+len(owned))
+	for _, h := range append(mapped, owned...) {
+		if h != "" && !seen[h] {
+			seen[h] = true
+			hashes = append(hashes, h)
+		}
+	}
+	return hashes, nil
+}
+
+// ResetTOTP disables the user's TOTP and removes their backup codes after an out-of-band
+// identity check, so they can sign in with their password and enroll again
+func (s *EntAdminUserModerationService) ResetTOTP(ctx context.Context, actor AdminActor, userID int, reason, identityCheck string) error {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !u.TotpEnabled && u.TotpSecret == nil {
+		return apperrors.NewAppError("TOTP_NOT_ENABLED", "2FA tidak aktif untuk akun ini.", 400)
+	}
+
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := tx.User.UpdateOneID(userID).
+			SetTotpEnabled(false).
+			SetTotpVerified(false).
+			ClearTotpSecret().
+			ClearTotpVerifiedAt().
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.BackupCode.Delete().Where(backupcode.UserIDEQ(userID)).Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.TOTPPendingToken.Delete().Where(totppendingtoken.UserIDEQ(userID)).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed to reset user TOTP", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase
+	}
+
+	s.logEvent(ctx, EventAdminTOTPReset, u, actor, fmt.Sprintf("identity_check=%s reason=%s", identityCheck, reason))
+	return nil
+}
+
+// ListSecurityEvents returns the user's SecurityEvent history, newest first
+func (s *EntAdminUserModerationService) ListSecurityEvents(ctx context.Context, userID int, eventType string, page, pageSize int) ([]*ent.SecurityEvent, int, error) {
+	if _, err := s.getUser(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	query := s.client.SecurityEvent.Query().Where(securityevent.UserIDEQ(userID))
+	if eventType != "" {
+		query = query.Where(securityevent.EventTypeEQ(eventType))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("Failed to count security events", zap.Error(err), zap.Int("user_id", userID))
+		return nil, 0, apperrors.ErrDatabase
+	}
+	events, err := query.
+		Order(ent.Desc(securityevent.FieldCreatedAt), ent.Desc(securityevent.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		logger.Error("Failed to list security events", zap.Error(err), zap.Int("user_id", userID))
+		return nil, 0, apperrors.ErrDatabase
+	}
+	return events, total, nil
+}
@@ -27,10 +27,14 @@ import (
 
 type EntAuthService struct {
 	client *ent.Client
+	audit  *EntSecurityAuditService
 }
 
 func NewEntAuthService() *EntAuthService {
-	return &EntAuthService{client: database.GetEntClient()}
+	return &EntAuthService{
+		client: database.GetEntClient(),
+		audit:  NewEntSecurityAuditService(),
+	}
 }
 
 type VerificationRequestResult struct {
@@ -301,12 +305,16 @@ func (s *EntAuthService) LoginWithSession(ctx context.Context, input validators.
 	if err != nil {
 		if ent.IsNotFound(err) {
 			logger.Debug("Login attempt for non-existent user", zap.String("email", email))
+			if riskEngine != nil {
+				riskEngine.RecordLoginFailure(email, ipAddress)
+			}
 			if loginTracker != nil {
 				locked, _, _ := loginTracker.RecordFailedLogin(email, ipAddress)
 				if locked && securityAudit != nil {
 					securityAudit.LogBruteForceDetected(email, ipAddress, MaxLoginAttempts)
 				}
 			}
+			s.audit.RecordFailedLoginFromIP(ctx, email, ipAddress)
 			if securityAudit != nil {
 				securityAudit.LogLoginFailed(email, ipAddress, userAgent, "User not found")
 			}
@@ -387,6 +395,9 @@ func (s *EntAuthService) LoginWithSession(ctx context.Context, input validators.
 	// Check password
 	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(input.Password)); err != nil {
 		logger.Debug("Invalid password attempt", zap.String("email", email))
+		if riskEngine != nil {
+			riskEngine.RecordLoginFailure(email, ipAddress)
+		}
 
 		if loginTracker != nil {
 			locked, _, _ := loginTracker.RecordFailedLogin(email, ipAddress)
@@ -404,6 +415,7 @@ func (s *EntAuthService) LoginWithSession(ctx context.Context, input validators.
 				}
 			}
 		}
+		s.audit.RecordFailedLoginFromIP(ctx, email, ipAddress)
 
 		if securityAudit != nil {
 			securityAudit.LogLoginFailed(email, ipAddress, userAgent, "Invalid password")
@@ -416,18 +428,41 @@ func (s *EntAuthService) LoginWithSession(ctx context.Context, input validators.
 		return nil, apperrors.ErrEmailNotVerified
 	}
 
-	// Successful password verification - reset failed attempts
-	if loginTracker != nil {
-		loginTracker.ResetAttempts(email)
-	}
-
 	username := ""
 	if u.Username != nil {
 		username = *u.Username
 	}
+	totpActive := u.TotpSecret != nil && *u.TotpSecret != "" && u.TotpVerified
+
+	// Risk-based adaptive authentication: block, or demand a second factor
+	if riskEngine != nil {
+		assessment := riskEngine.Assess(ctx, RiskInput{
+			Kind:            RiskEventLogin,
+			User:            u,
+			IP:              ipAddress,
+			UserAgent:       userAgent,
+			FingerprintHash: deviceFingerprintHash(deviceFingerprint, userAgent),
+		})
+		switch assessment.Decision {
+		case RiskDecisionBlock:
+			return nil, apperrors.ErrRiskBlocked
+		case RiskDecisionStepUp:
+			// TOTP users already get a second factor below; others confirm with a passkey,
+			// or with a code sent to their email when they have no passkey
+			if !totpActive {
+				return s.startLoginStepUp(ctx, u, ipAddress, userAgent, assessment)
+			}
+		}
+		riskEngine.ResetLoginFailures(email)
+	}
+
+	// Successful password verification - reset failed attempts
+	if loginTracker != nil {
+		loginTracker.ResetAttempts(email)
+	}
 
 	// Check if TOTP is enabled
-	if u.TotpSecret != nil && *u.TotpSecret != "" && u.TotpVerified {
+	if totpActive {
 		// Generate a temporary token for TOTP verification step
 		pendingToken, err := s.generateTOTPPendingToken(ctx, u.ID)
 		if err != nil {
@@ -450,14 +485,14 @@ func (s *EntAuthService) LoginWithSession(ctx context.Context, input validators.
 
 	// Create session with token pair (no TOTP required)
 	sessionService := NewEntSessionService()
-	tokenPair, err := sessionService.CreateSession(ctx, u, ipAddress, userAgent)
+	fingerprintHash := deviceFingerprintHash(deviceFingerprint, userAgent)
+	tokenPair, err := sessionService.CreateSessionForDevice(ctx, u, ipAddress, userAgent, fingerprintHash)
 	if err != nil {
 		return nil, err
 	}
 
 	// Record device login if fingerprint provided
-	if deviceFingerprint != "" && deviceTracker != nil {
-		fingerprintHash := HashFingerprint(deviceFingerprint, userAgent)
+	if fingerprintHash != "" && deviceTracker != nil {
 		if err := deviceTracker.RecordDeviceLogin(ctx, u.ID, fingerprintHash, ipAddress, userAgent); err != nil {
 			logger.Warn("Failed to record device login", zap.Error(err))
 		}
@@ -575,6 +610,20 @@ func (s *EntAuthService) LoginWithPasskey(ctx context.Context, u *ent.User, ipAd
 		}
 	}
 
+	// Risk assessment: a passkey already satisfies step-up, so only block decisions apply
+	if riskEngine != nil {
+		assessment := riskEngine.Assess(ctx, RiskInput{
+			Kind:            RiskEventLogin,
+			User:            freshUser,
+			IP:              ipAddress,
+			UserAgent:       userAgent,
+			FingerprintHash: deviceFingerprintHash(deviceFingerprint, userAgent),
+		})
+		if assessment.Decision == RiskDecisionBlock {
+			return nil, apperrors.ErrRiskBlocked
+		}
+	}
+
 	username := ""
 	if freshUser.Username != nil {
 		username = *freshUser.Username
@@ -582,14 +631,14 @@ func (s *EntAuthService) LoginWithPasskey(ctx context.Context, u *ent.User, ipAd
 
 	// Create session with token pair
 	sessionService := NewEntSessionService()
-	tokenPair, err := sessionService.CreateSession(ctx, freshUser, ipAddress, userAgent)
+	fingerprintHash := deviceFingerprintHash(deviceFingerprint, userAgent)
+	tokenPair, err := sessionService.CreateSessionForDevice(ctx, freshUser, ipAddress, userAgent, fingerprintHash)
 	if err != nil {
 		return nil, err
 	}
 
 	// Record device login if fingerprint provided
-	if deviceFingerprint != "" && deviceTracker != nil {
-		fingerprintHash := HashFingerprint(deviceFingerprint, userAgent)
+	if fingerprintHash != "" && deviceTracker != nil {
 		if err := deviceTracker.RecordDeviceLogin(ctx, freshUser.ID, fingerprintHash, ipAddress, userAgent); err != nil {
 			logger.Warn("Failed to record device login for passkey", zap.Error(err))
 		}
@@ -622,7 +671,10 @@ func (s *EntAuthService) ConfirmVerification(ctx context.Context, input validato
 	// Find token
 	record, err := s.client.EmailVerificationToken.
 		Query().
-		Where(emailverificationtoken.TokenHashEQ(hash)).
+		Where(
+			emailverificationtoken.TokenHashEQ(hash),
+			emailverificationtoken.PurposeEQ(EmailTokenPurposeVerify),
+		).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -716,24 +768,9 @@ func (s *EntAuthService) ForgotPassword(ctx context.Context, email, ip string) (
 	}
 
 	// Create reset token
-	raw, err := randomToken()
+	raw, err := createPasswordResetToken(ctx, s.client, u.ID)
 	if err != nil {
-		logger.Error("Failed to generate reset token", zap.Error(err))
-		return nil, apperrors.ErrInternalServer.WithDetails("Gagal membuat token")
-	}
-
-	hash := hashToken(raw)
-	expires := time.Now().Add(1 * time.Hour)
-
-	_, err = s.client.PasswordResetToken.
-		Create().
-		SetUserID(u.ID).
-		SetTokenHash(hash).
-		SetExpiresAt(expires).
-		Save(ctx)
-	if err != nil {
-		logger.Error("Failed to create reset token", zap.Error(err))
-		return nil, apperrors.ErrDatabase.WithDetails("Gagal menyimpan token")
+		return nil, err
 	}
 
 	// Send email asynchronously via queue
@@ -763,6 +800,27 @@ func (s *EntAuthService) ForgotPassword(ctx context.Context, email, ip string) (
 	}, nil
 }
 
+// createPasswordResetToken stores a one-hour reset token for the user and returns the raw value
+func createPasswordResetToken(ctx context.Context, client *ent.Client, userID int) (string, error) {
+	raw, err := randomToken()
+	if err != nil {
+		logger.Error("Failed to generate reset token", zap.Error(err))
+		return "", apperrors.ErrInternalServer.WithDetails("Gagal membuat token")
+	}
+
+	_, err = client.PasswordResetToken.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(hashToken(raw)).
+		SetExpiresAt(time.Now().Add(1 * time.Hour)).
+		Save(ctx)
+	if err != nil {
+		logger.Error("Failed to create reset token", zap.Error(err))
+		return "", apperrors.ErrDatabase.WithDetails("Gagal menyimpan token")
+	}
+	return raw, nil
+}
+
 // ResetPassword resets password with token
 func (s *EntAuthService) ResetPassword(ctx context.Context, token, newPassword string) error {
 	// Validate password
@@ -819,6 +877,17 @@ func (s *EntAuthService) ResetPassword(ctx context.Context, token, newPassword s
 			UpdateOneID(record.UserID).
 			SetPasswordHash(string(hashPass)).
 			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		// A "this wasn't me" lock is lifted by the reset it asked for
+		_, err = tx.SessionLock.Delete().
+			Where(
+				sessionlock.UserIDEQ(record.UserID),
+				sessionlock.LockedByEQ(SessionLockByUserReport),
+			).
+			Exec(ctx)
 		return err
 	})
 
@@ -933,14 +1002,14 @@ func (s *EntAuthService) CompleteTOTPLogin(ctx context.Context, pendingToken, to
 
 	// Create session with token pair
 	sessionService := NewEntSessionService()
-	tokenPair, err := sessionService.CreateSession(ctx, u, ipAddress, userAgent)
+	fingerprintHash := deviceFingerprintHash(deviceFingerprint, userAgent)
+	tokenPair, err := sessionService.CreateSessionForDevice(ctx, u, ipAddress, userAgent, fingerprintHash)
 	if err != nil {
 		return nil, err
 	}
 
 	// Record device login if fingerprint provided
-	if deviceFingerprint != "" && deviceTracker != nil {
-		fingerprintHash := HashFingerprint(deviceFingerprint, userAgent)
+	if fingerprintHash != "" && deviceTracker != nil {
 		if err := deviceTracker.RecordDeviceLogin(ctx, u.ID, fingerprintHash, ipAddress, userAgent); err != nil {
 			logger.Warn("Failed to record device login for TOTP", zap.Error(err))
 		}
@@ -1089,14 +1158,14 @@ func (s *EntAuthService) CompleteTOTPLoginWithBackupCode(ctx context.Context, pe
 
 	// Create session with token pair
 	sessionService := NewEntSessionService()
-	tokenPair, err := sessionService.CreateSession(ctx, u, ipAddress, userAgent)
+	fingerprintHash := deviceFingerprintHash(deviceFingerprint, userAgent)
+	tokenPair, err := sessionService.CreateSessionForDevice(ctx, u, ipAddress, userAgent, fingerprintHash)
 	if err != nil {
 		return nil, err
 	}
 
 	// Record device login if fingerprint provided
-	if deviceFingerprint != "" && deviceTracker != nil {
-		fingerprintHash := HashFingerprint(deviceFingerprint, userAgent)
+	if fingerprintHash != "" && deviceTracker != nil {
 		if err := deviceTracker.RecordDeviceLogin(ctx, u.ID, fingerprintHash, ipAddress, userAgent); err != nil {
 			logger.Warn("Failed to record device login for backup code", zap.Error(err))
 		}
@@ -1218,6 +1287,7 @@ func (s *EntAuthService) createVerificationToken(ctx context.Context, u *ent.Use
 		Delete().
 		Where(
 			emailverificationtoken.UserIDEQ(u.ID),
+			emailverificationtoken.PurposeEQ(EmailTokenPurposeVerify),
 			emailverificationtoken.UsedAtIsNil(),
 		).
 		Exec(ctx)
@@ -1256,6 +1326,12 @@ func (s *EntAuthService) deleteUnverifiedUser(ctx context.Context, u *ent.User)
 
 // generateTOTPPendingToken creates a short-lived token for TOTP verification
 func (s *EntAuthService) generateTOTPPendingToken(ctx context.Context, userID int) (string, error) {
+	return s.createPendingLoginToken(ctx, userID, pendingTokenPurposeTOTP, "")
+}
+
+// createPendingLoginToken stores a single-use token for the second step of a password
+// login. A non-empty emailCode is stored hashed together with the token.
+func (s *EntAuthService) createPendingLoginToken(ctx context.Context, userID int, purpose, emailCode string) (string, error) {
 	tokenBytes := make([]byte, totpPendingTokenLength)
 	if _, err := rand.Read(tokenBytes); err != nil {
 		return "", err
@@ -1272,12 +1348,16 @@ func (s *EntAuthService) generateTOTPPendingToken(ctx context.Context, userID in
 		Exec(ctx)
 
 	// Save pending token
-	_, err := s.client.TOTPPendingToken.
+	create := s.client.TOTPPendingToken.
 		Create().
 		SetUserID(userID).
 		SetTokenHash(tokenHash).
-		SetExpiresAt(time.Now().Add(totpPendingTokenExpiry)).
-		Save(ctx)
+		SetPurpose(purpose).
+		SetExpiresAt(time.Now().Add(totpPendingTokenExpiry))
+	if emailCode != "" {
+		create.SetEmailCodeHash(stepUpCodeHash(token, emailCode))
+	}
+	_, err := create.Save(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -1287,12 +1367,20 @@ func (s *EntAuthService) generateTOTPPendingToken(ctx context.Context, userID in
 
 // validateTOTPPendingToken validates a TOTP pending token without consuming it.
 func (s *EntAuthService) validateTOTPPendingToken(ctx context.Context, token string) (*ent.User, *ent.TOTPPendingToken, error) {
+	return s.validatePendingLoginToken(ctx, token, pendingTokenPurposeTOTP)
+}
+
+// validatePendingLoginToken validates a pending login token of the given purpose without consuming it.
+func (s *EntAuthService) validatePendingLoginToken(ctx context.Context, token, purpose string) (*ent.User, *ent.TOTPPendingToken, error) {
 	hash := sha256.Sum256([]byte(token))
 	tokenHash := hex.EncodeToString(hash[:])
 
 	pending, err := s.client.TOTPPendingToken.
 		Query().
-		Where(totppendingtoken.TokenHashEQ(tokenHash)).
+		Where(
+			totppendingtoken.TokenHashEQ(tokenHash),
+			totppendingtoken.PurposeEQ(purpose),
+		).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -27,6 +27,11 @@ type LoginResponse struct {
 	FullName     string `json:"full_name,omitempty"`
 	RequiresTOTP bool   `json:"requires_totp,omitempty"`
 	TOTPPending  string `json:"totp_pending,omitempty"`
+	// RequiresStepUp is set when the risk engine demands a stronger factor than password.
+	// StepUpToken ties the passkey or email-code step to this password login.
+	RequiresStepUp bool     `json:"requires_step_up,omitempty"`
+	StepUpMethods  []string `json:"step_up_methods,omitempty"`
+	StepUpToken    string   `json:"step_up_token,omitempty"`
 }
 
 // ForgotPasswordResponse represents the response after password reset request
@@ -0,0 +1,495 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-gin/ent"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/consultationrequest"
+	apperrors "backend-gin/errors"
+)
+
+// Clarification Q&A between an approved validator and the case owner. A pending
+// clarification puts the validator's consultation request on the owner-response SLA.
+const (
+	clarificationKindQuestion   = "question"
+	clarificationKindAssumption = "assumption"
+
+	clarificationStatusPending  = "pending"
+	clarificationStatusAnswered = "answered"
+	clarificationStatusApproved = "approved"
+	clarificationStatusRejected = "rejected"
+	clarificationStatusExpired  = "expired"
+
+	clarificationDecisionApprove = "approve"
+	clarificationDecisionReject  = "reject"
+
+	clarificationMinBodyChars = 10
+	clarificationMaxBodyChars = 4000
+)
+
+// ClarificationResponse is the owner's reply. Questions need an Answer; assumptions need a
+// Decision (approve | reject) and may carry an Answer as explanation. Share publishes the
+// exchange to every approved validator on the cycle.
+type ClarificationResponse struct {
+	Answer   string
+	Decision string
+	Share    bool
+}
+
+func normalizeClarificationInput(kind, body string) (string, string, error) {
+	kind = normalizeStatus(kind)
+	if kind == "" {
+		kind = clarificationKindQuestion
+	}
+	if kind != clarificationKindQuestion && kind != clarificationKindAssumption {
+		return "", "", apperrors.ErrInvalidInput.WithDetails("kind harus question atau assumption")
+	}
+	body = strings.TrimSpace(body)
+	if utf8.RuneCountInString(body) < clarificationMinBodyChars {
+		return "", "", apperrors.ErrInvalidInput.WithDetails("isi klarifikasi minimal 10 karakter")
+	}
+	if utf8.RuneCountInString(body) > clarificationMaxBodyChars {
+		return "", "", apperrors.ErrInvalidInput.WithDetails("isi klarifikasi maksimal 4000 karakter")
+	}
+	return kind, body, nil
+}
+
+// clarificationWaitingState is the case clarification_state while a clarification of kind
+// waits for the owner.
+func clarificationWaitingState(kind string) string {
+	if kind == clarificationKindAssumption {
+		return clarificationStateAssumptionPending
+	}
+	return clarificationStateWaitingOwnerResponse
+}
+
+// clarificationOutcome validates the owner's reply to a clarification of kind and returns the
+// clarification status and case clarification_state it results in.
+func clarificationOutcome(kind string, resp ClarificationResponse) (string, string, error) {
+	answer := strings.TrimSpace(resp.Answer)
+	if utf8.RuneCountInString(answer) > clarificationMaxBodyChars {
+		return "", "", apperrors.ErrInvalidInput.WithDetails("jawaban maksimal 4000 karakter")
+	}
+	if kind == clarificationKindAssumption {
+		switch normalizeStatus(resp.Decision) {
+		case clarificationDecisionApprove:
+			return clarificationStatusApproved, clarificationStateAssumptionApproved, nil
+		case clarificationDecisionReject:
+			return clarificationStatusRejected, clarificationStateAssumptionRejected, nil
+		default:
+			return "", "", apperrors.ErrInvalidInput.WithDetails("decision harus approve atau reject untuk asumsi")
+		}
+	}
+	if resp.Decision != "" {
+		return "", "", apperrors.ErrInvalidInput.WithDetails("decision hanya berlaku untuk asumsi")
+	}
+	if answer == "" {
+		return "", "", apperrors.ErrInvalidInput.WithDetails("jawaban wajib diisi")
+	}
+	return clarificationStatusAnswered, clarificationStateOwnerResponded, nil
+}
+
+// clarificationCaseState returns the case status and clarification_state after an owner reply
+// that resulted in outcomeState. Other validators' pending clarifications keep the case
+// waiting; expired ones keep it on hold.
+func clarificationCaseState(vc *ent.ValidationCase, latestPending *ent.CaseClarification, hasExpired bool, outcomeState string) (string, string) {
+	if latestPending != nil {
+		return caseStatusWaitingOwnerResponse, clarificationWaitingState(latestPending.Kind)
+	}
+	if hasExpired {
+		return caseStatusOnHoldOwnerInactive, clarificationStateOwnerInactiveSLAExpired
+	}
+	return deriveCaseStatusFromWorkflowLinkage(vc), outcomeState
+}
+
+func buildCaseClarificationItem(c *ent.CaseClarification) CaseClarificationItem {
+	return CaseClarificationItem{
+		ID:                    uint(c.ID),
+		ConsultationRequestID: uint(c.ConsultationRequestID),
+		ValidatorUserID:       uint(c.ValidatorUserID),
+		Kind:                  c.Kind,
+		Body:                  c.Body,
+		Status:                c.Status,
+		Answer:                c.Answer,
+		Shared:                c.Shared,
+		AnsweredAt:            unixPtr(c.AnsweredAt),
+		ExpiredAt:             unixPtr(c.ExpiredAt),
+		CreatedAt:             c.CreatedAt.Unix(),
+	}
+}
+
+// PostClarification opens a question or proposed assumption from a validator whose
+// consultation was approved, and starts the owner-response SLA on their request.
+func (s *EntValidationCaseWorkflowService) PostClarification(ctx context.Context, validationCaseID uint, validatorUserID uint, kind string, body string) (*CaseClarificationItem, error) {
+	kind, body, err := normalizeClarificationInput(kind, body)
+	if err != nil {
+		return nil, err
+	}
+
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if vc.UserID == int(validatorUserID) {
+		return nil, apperrors.ErrInvalidInput.WithDetails("pemilik kasus tidak dapat mengajukan klarifikasi pada kasusnya sendiri")
+	}
+	previousStatus := normalizeStatus(vc.Status)
+	switch previousStatus {
+	case caseStatusOpen, caseStatusWaitingOwnerResponse:
+		// allowed
+	case caseStatusOnHoldOwnerInactive:
+		return nil, apperrors.ErrInvalidInput.WithDetails("kasus sedang ditahan karena owner tidak merespons klarifikasi sebelumnya")
+	default:
+		return nil, apperrors.ErrInvalidInput.WithDetails("klarifikasi hanya dapat diajukan sebelum Final Offer diterima")
+	}
+	cycle := currentWorkflowCycle(vc)
+
+	req, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidationCaseIDEQ(vc.ID),
+			consultationrequest.ValidatorUserIDEQ(int(validatorUserID)),
+			consultationrequest.WorkflowCycleEQ(cycle),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrConsultationNotApproved
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	switch normalizeStatus(req.Status) {
+	case consultationStatusApproved:
+		// allowed
+	case consultationStatusWaitingOwnerResponse:
+		return nil, apperrors.ErrInvalidInput.WithDetails("klarifikasi sebelumnya masih menunggu respons owner")
+	case consultationStatusOwnerTimeout:
+		return nil, apperrors.ErrInvalidInput.WithDetails("owner belum merespons klarifikasi sebelumnya")
+	default:
+		return nil, apperrors.ErrConsultationNotApproved
+	}
+
+	now := time.Now()
+	policy := slaPolicySnapshotFromJSON(req.SLAPolicySnapshot)
+	dueAt := policy.OwnerResponseDueAt(now, SLAHolidayCalendar())
+	waitingState := clarificationWaitingState(kind)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	clarification, err := tx.CaseClarification.Create().
+		SetValidationCaseID(vc.ID).
+		SetConsultationRequestID(req.ID).
+		SetValidatorUserID(int(validatorUserID)).
+		SetWorkflowCycle(cycle).
+		SetKind(kind).
+		SetBody(body).
+		SetStatus(clarificationStatusPending).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	// Guarded on the approved status so a concurrent post by the same validator loses.
+	updated, err := tx.ConsultationRequest.Update().
+		Where(
+			consultationrequest.IDEQ(req.ID),
+			consultationrequest.StatusEQ(consultationStatusApproved),
+		).
+		SetStatus(consultationStatusWaitingOwnerResponse).
+		SetOwnerResponseStartedAt(now).
+		SetOwnerResponseDueAt(dueAt).
+		SetReminderCount(0).
+		ClearExpiresAt().
+		ClearAutoClosedReason().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrInvalidInput.WithDetails("klarifikasi sebelumnya masih menunggu respons owner")
+	}
+	if _, err := tx.ValidationCase.UpdateOneID(vc.ID).
+		SetStatus(caseStatusWaitingOwnerResponse).
+		SetClarificationState(waitingState).
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	actorID := int(validatorUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "clarification_posted", map[string]interface{}{
+		"clarification_id":        clarification.ID,
+		"consultation_request_id": req.ID,
+		"validator_user_id":       validatorUserID,
+		"kind":                    kind,
+		"owner_response_due_at":   dueAt.Unix(),
+		"sla_policy":              policy.Name,
+	})
+	if previousStatus != caseStatusWaitingOwnerResponse {
+		s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "case_status_changed", map[string]interface{}{
+			"from":   previousStatus,
+			"to":     caseStatusWaitingOwnerResponse,
+			"reason": "clarification_posted",
+		})
+	}
+
+	item := buildCaseClarificationItem(clarification)
+	return &item, nil
+}
+
+// RespondClarification records the owner's answer or assumption decision, stops the
+// owner-response SLA of the validator's request and, once nothing else waits on the owner,
+// returns the case to its workflow status. Expired clarifications can still be answered,
+// which also lifts the owner-inactive hold they caused.
+func (s *EntValidationCaseWorkflowService) RespondClarification(ctx context.Context, validationCaseID uint, ownerUserID uint, clarificationID uint, resp ClarificationResponse) (*CaseClarificationItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if vc.UserID != int(ownerUserID) {
+		return nil, apperrors.ErrValidationCaseOwnership
+	}
+	cycle := currentWorkflowCycle(vc)
+
+	clarification, err := s.client.CaseClarification.Query().
+		Where(
+			caseclarification.IDEQ(int(clarificationID)),
+			caseclarification.ValidationCaseIDEQ(vc.ID),
+			caseclarification.WorkflowCycleEQ(cycle),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrInvalidInput.WithDetails("klarifikasi tidak ditemukan")
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	previousClarificationStatus := normalizeStatus(clarification.Status)
+	if previousClarificationStatus != clarificationStatusPending && previousClarificationStatus != clarificationStatusExpired {
+		return nil, apperrors.ErrInvalidInput.WithDetails("klarifikasi sudah direspons")
+	}
+	status, outcomeState, err := clarificationOutcome(clarification.Kind, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	updated, err := tx.CaseClarification.Update().
+		Where(
+			caseclarification.IDEQ(clarification.ID),
+			caseclarification.StatusEQ(clarification.Status),
+		).
+		SetStatus(status).
+		SetAnswer(strings.TrimSpace(resp.Answer)).
+		SetAnsweredByUserID(int(ownerUserID)).
+		SetAnsweredAt(now).
+		SetShared(resp.Share).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrInvalidInput.WithDetails("klarifikasi sudah direspons")
+	}
+	if _, err := tx.ConsultationRequest.Update().
+		Where(
+			consultationrequest.IDEQ(clarification.ConsultationRequestID),
+			consultationrequest.StatusIn(consultationStatusWaitingOwnerResponse, consultationStatusOwnerTimeout),
+		).
+		SetStatus(consultationStatusApproved).
+		ClearOwnerResponseDueAt().
+		ClearOwnerResponseStartedAt().
+		ClearExpiresAt().
+		SetReminderCount(0).
+		ClearAutoClosedReason().
+		Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+
+	latestPending, err := tx.CaseClarification.Query().
+		Where(
+			caseclarification.ValidationCaseIDEQ(vc.ID),
+			caseclarification.WorkflowCycleEQ(cycle),
+			caseclarification.StatusEQ(clarificationStatusPending),
+		).
+		Order(ent.Desc(caseclarification.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	hasExpired, err := tx.CaseClarification.Query().
+		Where(
+			caseclarification.ValidationCaseIDEQ(vc.ID),
+			caseclarification.WorkflowCycleEQ(cycle),
+			caseclarification.StatusEQ(clarificationStatusExpired),
+		).
+		Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	previousStatus := normalizeStatus(vc.Status)
+	nextStatus, nextState := clarificationCaseState(vc, latestPending, hasExpired, outcomeState)
+	caseUpdate := tx.ValidationCase.UpdateOneID(vc.ID).
+		SetClarificationState(nextState)
+	if previousStatus == caseStatusWaitingOwnerResponse || previousStatus == caseStatusOnHoldOwnerInactive {
+		caseUpdate.SetStatus(nextStatus)
+	} else {
+		nextStatus = previousStatus
+	}
+	if _, err := caseUpdate.Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	actorID := int(ownerUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "clarification_answered", map[string]interface{}{
+		"clarification_id":        clarification.ID,
+		"consultation_request_id": clarification.ConsultationRequestID,
+		"validator_user_id":       clarification.ValidatorUserID,
+		"kind":                    clarification.Kind,
+		"status":                  status,
+		"shared":                  resp.Share,
+		"after_sla_expiry":        previousClarificationStatus == clarificationStatusExpired,
+	})
+	if nextStatus != previousStatus {
+		s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "case_status_changed", map[string]interface{}{
+			"from":   previousStatus,
+			"to":     nextStatus,
+			"reason": "clarification_answered",
+		})
+	}
+
+	clarification, err = s.client.CaseClarification.Get(ctx, clarification.ID)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	item := buildCaseClarificationItem(clarification)
+	return &item, nil
+}
+
+// ListClarifications returns the current cycle's clarifications. The owner sees all of them;
+// a validator sees their own plus answered exchanges the owner chose to share.
+func (s *EntValidationCaseWorkflowService) ListClarifications(ctx context.Context, validationCaseID uint, viewerUserID uint) ([]CaseClarificationItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	cycle := currentWorkflowCycle(vc)
+
+	q := s.client.CaseClarification.Query().
+		Where(
+			caseclarification.ValidationCaseIDEQ(vc.ID),
+			caseclarification.WorkflowCycleEQ(cycle),
+		)
+	if vc.UserID != int(viewerUserID) {
+		approved, err := s.client.ConsultationRequest.Query().
+			Where(
+				consultationrequest.ValidationCaseIDEQ(vc.ID),
+				consultationrequest.ValidatorUserIDEQ(int(viewerUserID)),
+				consultationrequest.WorkflowCycleEQ(cycle),
+				consultationrequest.StatusIn(
+					consultationStatusApproved,
+					consultationStatusWaitingOwnerResponse,
+					consultationStatusOwnerTimeout,
+				),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, apperrors.ErrDatabase
+		}
+		if !approved {
+			return nil, apperrors.ErrConsultationNotApproved
+		}
+		q = q.Where(caseclarification.Or(
+			caseclarification.ValidatorUserIDEQ(int(viewerUserID)),
+			caseclarification.And(
+				caseclarification.SharedEQ(true),
+				caseclarification.StatusIn(
+					clarificationStatusAnswered,
+					clarificationStatusApproved,
+					clarificationStatusRejected,
+				),
+			),
+		))
+	}
+
+	rows, err := q.Order(ent.Asc(caseclarification.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	out := make([]CaseClarificationItem, 0, len(rows))
+	for _, c := range rows {
+		out = append(out, buildCaseClarificationItem(c))
+	}
+	return out, nil
+}
+
+// expireClarifications closes the pending clarifications of a consultation request whose
+// owner-response SLA ran out.
+func (s *EntValidationCaseWorkflowService) expireClarifications(ctx context.Context, consultationRequestID int, now time.Time) error {
+	if _, err := s.client.CaseClarification.Update().
+		Where(
+			caseclarification.ConsultationRequestIDEQ(consultationRequestID),
+			caseclarification.StatusEQ(clarificationStatusPending),
+		).
+		SetStatus(clarificationStatusExpired).
+		SetExpiredAt(now).
+		Save(ctx); err != nil {
+		return apperrors.ErrDatabase
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"testing"
+
+	"backend-gin/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeClarificationInput(t *testing.T) {
+	kind, body, err := normalizeClarificationInput("", "  Apakah log server termasuk scope?  ")
+	require.NoError(t, err)
+	assert.Equal(t, clarificationKindQuestion, kind)
+	assert.Equal(t, "Apakah log server termasuk scope?", body)
+
+	kind, _, err = normalizeClarificationInput(" Assumption ", "Saya asumsikan staging identik dengan produksi")
+	require.NoError(t, err)
+	assert.Equal(t, clarificationKindAssumption, kind)
+
+	_, _, err = normalizeClarificationInput("poll", "Apakah log server termasuk scope?")
+	assert.Error(t, err)
+	_, _, err = normalizeClarificationInput("question", "scope?")
+	assert.Error(t, err, "too short")
+}
+
+func TestClarificationOutcome(t *testing.T) {
+	status, state, err := clarificationOutcome(clarificationKindQuestion, ClarificationResponse{Answer: "Ya, termasuk."})
+	require.NoError(t, err)
+	assert.Equal(t, clarificationStatusAnswered, status)
+	assert.Equal(t, clarificationStateOwnerResponded, state)
+
+	_, _, err = clarificationOutcome(clarificationKindQuestion, ClarificationResponse{Answer: "  "})
+	assert.Error(t, err, "question needs an answer")
+	_, _, err = clarificationOutcome(clarificationKindQuestion, ClarificationResponse{Answer: "Ya", Decision: "approve"})
+	assert.Error(t, err, "decision is for assumptions only")
+
+	status, state, err = clarificationOutcome(clarificationKindAssumption, ClarificationResponse{Decision: "Approve"})
+	require.NoError(t, err)
+	assert.Equal(t, clarificationStatusApproved, status)
+	assert.Equal(t, clarificationStateAssumptionApproved, state)
+
+	status, state, err = clarificationOutcome(clarificationKindAssumption, ClarificationResponse{Decision: "reject", Answer: "Staging berbeda."})
+	require.NoError(t, err)
+	assert.Equal(t, clarificationStatusRejected, status)
+	assert.Equal(t, clarificationStateAssumptionRejected, state)
+
+	_, _, err = clarificationOutcome(clarificationKindAssumption, ClarificationResponse{Answer: "ok"})
+	assert.Error(t, err, "assumption needs a decision")
+}
+
+func TestClarificationCaseState(t *testing.T) {
+	vc := &ent.ValidationCase{Status: caseStatusWaitingOwnerResponse}
+
+	status, state := clarificationCaseState(vc, nil, false, clarificationStateOwnerResponded)
+	assert.Equal(t, caseStatusOpen, status)
+	assert.Equal(t, clarificationStateOwnerResponded, state)
+
+	pending := &ent.CaseClarification{Kind: clarificationKindAssumption}
+	status, state = clarificationCaseState(vc, pending, true, clarificationStateOwnerResponded)
+	assert.Equal(t, caseStatusWaitingOwnerResponse, status)
+	assert.Equal(t, clarificationStateAssumptionPending, state)
+
+	status, state = clarificationCaseState(vc, nil, true, clarificationStateAssumptionApproved)
+	assert.Equal(t, caseStatusOnHoldOwnerInactive, status)
+	assert.Equal(t, clarificationStateOwnerInactiveSLAExpired, state)
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-gin/ent"
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
+	"backend-gin/ent/consultationrequest"
+	apperrors "backend-gin/errors"
+	"backend-gin/utils"
+	"backend-gin/validators"
+)
+
+// Private owner–validator messaging scoped to a case. It replaces Telegram contact reveal
+// and keeps an audit trail that admins can pull into dispute evidence.
+const (
+	caseMessageMaxBodyChars     = 4000
+	caseMessageMaxAttachments   = 5
+	caseMessageMaxDocumentIDLen = 128
+)
+
+// sensitivityAllowsTelegram reports whether the sensitivity tier permits Telegram reveal.
+func sensitivityAllowsTelegram(level string) bool {
+	allowed, _ := validators.SensitivityPolicyByLevel(level)["telegram_allowed"].(bool)
+	return allowed
+}
+
+func telegramRevealAllowed(vc *ent.ValidationCase) bool {
+	return sensitivityAllowsTelegram(vc.SensitivityLevel) && !vc.TelegramRevealDisabled
+}
+
+// normalizeCaseMessageInput trims the body and attachment IDs and rejects empty messages and
+// direct contact details.
+func normalizeCaseMessageInput(body string, attachments []string) (string, []string, error) {
+	body = strings.TrimSpace(body)
+	if utf8.RuneCountInString(body) > caseMessageMaxBodyChars {
+		return "", nil, apperrors.ErrInvalidInput.WithDetails("pesan maksimal 4000 karakter")
+	}
+	if body != "" && !utils.ValidateNoXSS(body) {
+		return "", nil, apperrors.ErrInvalidInput.WithDetails("pesan mengandung karakter atau pola yang tidak diizinkan")
+	}
+
+	seen := make(map[string]struct{}, len(attachments))
+	docs := make([]string, 0, len(attachments))
+	for _, raw := range attachments {
+		id := strings.TrimSpace(raw)
+		if id == "" {
+			continue
+		}
+		if len(id) > caseMessageMaxDocumentIDLen {
+			return "", nil, apperrors.ErrInvalidInput.WithDetails("document_id lampiran tidak valid")
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		docs = append(docs, id)
+	}
+	if len(docs) > caseMessageMaxAttachments {
+		return "", nil, apperrors.ErrInvalidInput.WithDetails("maksimal 5 lampiran per pesan")
+	}
+	if body == "" && len(docs) == 0 {
+		return "", nil, apperrors.ErrInvalidInput.WithDetails("pesan atau lampiran wajib diisi")
+	}
+	return body, docs, nil
+}
+
+func buildCaseMessageItem(m *ent.CaseMessage) CaseMessageItem {
+	docs := m.AttachmentDocumentIds
+	if docs == nil {
+		docs = []string{}
+	}
+	return CaseMessageItem{
+		ID:                    uint(m.ID),
+		ConversationID:        uint(m.ConversationID),
+		SenderUserID:          uint(m.SenderUserID),
+		Body:                  m.Body,
+		AttachmentDocumentIDs: docs,
+		ReadAt:                unixPtr(m.ReadAt),
+		CreatedAt:             m.CreatedAt.Unix(),
+	}
+}
+
+// loadCaseConversationParty checks that userID is a party of the case's thread with
+// validatorUserID: the case owner, or that validator. It returns the other party's user ID.
+func (s *EntValidationCaseWorkflowService) loadCaseConversationParty(ctx context.Context, validationCaseID uint, userID uint, validatorUserID uint) (*ent.ValidationCase, int, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, 0, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, 0, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, 0, err
+	}
+	if int(validatorUserID) == vc.UserID {
+		return nil, 0, apperrors.ErrInvalidInput.WithDetails("percakapan tidak ditemukan")
+	}
+	switch int(userID) {
+	case vc.UserID:
+		return vc, int(validatorUserID), nil
+	case int(validatorUserID):
+		return vc, vc.UserID, nil
+	default:
+		return nil, 0, apperrors.ErrInvalidInput.WithDetails("percakapan tidak ditemukan")
+	}
+}
+
+// ensureCaseMessagingAllowed requires an approved consultation of the validator on the
+// current cycle. Earlier threads stay readable but closed.
+func (s *EntValidationCaseWorkflowService) ensureCaseMessagingAllowed(ctx context.Context, vc *ent.ValidationCase, validatorUserID int) error {
+	approved, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidationCaseIDEQ(vc.ID),
+			consultationrequest.ValidatorUserIDEQ(validatorUserID),
+			consultationrequest.WorkflowCycleEQ(currentWorkflowCycle(vc)),
+			consultationrequest.StatusIn(
+				consultationStatusApproved,
+				consultationStatusWaitingOwnerResponse,
+				consultationStatusOwnerTimeout,
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if !approved {
+		return apperrors.ErrConsultationNotApproved
+	}
+	return nil
+}
+
+func (s *EntValidationCaseWorkflowService) getOrCreateCaseConversation(ctx context.Context, vc *ent.ValidationCase, validatorUserID int) (*ent.CaseConversation, error) {
+	query := func() (*ent.CaseConversation, error) {
+		return s.client.CaseConversation.Query().
+			Where(
+				caseconversation.ValidationCaseIDEQ(vc.ID),
+				caseconversation.ValidatorUserIDEQ(validatorUserID),
+			).
+			Only(ctx)
+	}
+	conv, err := query()
+	if err == nil {
+		return conv, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, apperrors.ErrDatabase
+	}
+	conv, err = s.client.CaseConversation.Create().
+		SetValidationCaseID(vc.ID).
+		SetOwnerUserID(vc.UserID).
+		SetValidatorUserID(validatorUserID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Created concurrently by the other party.
+			if conv, err = query(); err == nil {
+				return conv, nil
+			}
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	return conv, nil
+}
+
+// SendCaseMessage posts a message in the thread between the case owner and validatorUserID.
+// Messages with direct contact details are rejected and logged to the case log. Attachments
+// are shared with the recipient in Feature Service using the sender's Authorization header.
+func (s *EntValidationCaseWorkflowService) SendCaseMessage(ctx context.Context, validationCaseID uint, senderUserID uint, validatorUserID uint, body string, attachmentDocumentIDs []string, authHeader string) (*CaseMessageItem, error) {
+	vc, recipientUserID, err := s.loadCaseConversationParty(ctx, validationCaseID, senderUserID, validatorUserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureCaseMessagingAllowed(ctx, vc, int(validatorUserID)); err != nil {
+		return nil, err
+	}
+	body, docs, err := normalizeCaseMessageInput(body, attachmentDocumentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	actorID := int(senderUserID)
+	if validators.ContainsBlockedContactHint(body) {
+		s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "message_contact_leak_blocked", map[string]interface{}{
+			"validator_user_id": validatorUserID,
+		})
+		return nil, apperrors.ErrInvalidInput.WithDetails("Pesan tidak boleh memuat detail kontak langsung. Lanjutkan koordinasi melalui pesan di platform.")
+	}
+
+	for _, documentID := range docs {
+		if err := s.shareDocumentWithUser(ctx, authHeader, documentID, uint(recipientUserID)); err != nil {
+			return nil, apperrors.ErrInvalidInput.WithDetails(err.Error())
+		}
+	}
+
+	conv, err := s.getOrCreateCaseConversation(ctx, vc, int(validatorUserID))
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.client.CaseMessage.Create().
+		SetConversationID(conv.ID).
+		SetSenderUserID(int(senderUserID)).
+		SetBody(body)
+	if len(docs) > 0 {
+		create.SetAttachmentDocumentIds(docs)
+	}
+	msg, err := create.Save(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	_, _ = s.client.CaseConversation.UpdateOneID(conv.ID).
+		SetLastMessageAt(msg.CreatedAt).
+		Save(ctx)
+
+	item := buildCaseMessageItem(msg)
+	return &item, nil
+}
+
+// ListCaseConversations returns the caller's threads on the case: all of them for the owner,
+// at most one for a validator.
+func (s *EntValidationCaseWorkflowService) ListCaseConversations(ctx context.Context, validationCaseID uint, viewerUserID uint) ([]CaseConversationItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+
+	q := s.client.CaseConversation.Query().
+		Where(caseconversation.ValidationCaseIDEQ(vc.ID))
+	if vc.UserID != int(viewerUserID) {
+		q = q.Where(caseconversation.ValidatorUserIDEQ(int(viewerUserID)))
+	}
+	convs, err := q.Order(ent.Desc(caseconversation.FieldLastMessageAt)).All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	out := make([]CaseConversationItem, 0, len(convs))
+	for _, conv := range convs {
+		unread, err := s.client.CaseMessage.Query().
+			Where(
+				casemessage.ConversationIDEQ(conv.ID),
+				casemessage.SenderUserIDNEQ(int(viewerUserID)),
+				casemessage.ReadAtIsNil(),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, apperrors.ErrDatabase
+		}
+		out = append(out, CaseConversationItem{
+			ID:              uint(conv.ID),
+			OwnerUserID:     uint(conv.OwnerUserID),
+			ValidatorUserID: uint(conv.ValidatorUserID),
+			LastMessageAt:   unixPtr(conv.LastMessageAt),
+			UnreadCount:     unread,
+			CreatedAt:       conv.CreatedAt.Unix(),
+		})
+	}
+	return out, nil
+}
+
+// ListCaseMessages returns the thread between the case owner and validatorUserID, oldest first.
+func (s *EntValidationCaseWorkflowService) ListCaseMessages(ctx context.Context, validationCaseID uint, viewerUserID uint, validatorUserID uint) ([]CaseMessageItem, error) {
+	vc, _, err := s.loadCaseConversationParty(ctx, validationCaseID, viewerUserID, validatorUserID)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := s.client.CaseMessage.Query().
+		Where(casemessage.HasConversationWith(
+			caseconversation.ValidationCaseIDEQ(vc.ID),
+			caseconversation.ValidatorUserIDEQ(int(validatorUserID)),
+		)).
+		Order(ent.Asc(casemessage.FieldCreatedAt), ent.Asc(casemessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	out := make([]CaseMessageItem, 0, len(msgs))
+	for _, m := range msgs {
+		out = append(out, buildCaseMessageItem(m))
+	}
+	return out, nil
+}
+
+// MarkCaseMessagesRead sets the read receipt on every message the caller received in the
+// thread and returns how many were newly marked.
+func (s *EntValidationCaseWorkflowService) MarkCaseMessagesRead(ctx context.Context, validationCaseID uint, viewerUserID uint, validatorUserID uint) (int, error) {
+	vc, _, err := s.loadCaseConversationParty(ctx, validationCaseID, viewerUserID, validatorUserID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := s.client.CaseMessage.Update().
+		Where(
+			casemessage.HasConversationWith(
+				caseconversation.ValidationCaseIDEQ(vc.ID),
+				caseconversation.ValidatorUserIDEQ(int(validatorUserID)),
+			),
+			casemessage.SenderUserIDNEQ(int(viewerUserID)),
+			casemessage.ReadAtIsNil(),
+		).
+		SetReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, apperrors.ErrDatabase
+	}
+	return n, nil
+}
+
+// SetTelegramRevealDisabled lets the owner turn Telegram contact reveal off (or back on) for
+// the case. S2/S3 cases never reveal Telegram, whatever the flag says.
+func (s *EntValidationCaseWorkflowService) SetTelegramRevealDisabled(ctx context.Context, validationCaseID uint, ownerUserID uint, disabled bool) error {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrValidationCaseNotFound
+		}
+		return apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return err
+	}
+	if vc.UserID != int(ownerUserID) {
+		return apperrors.ErrValidationCaseOwnership
+	}
+	if vc.TelegramRevealDisabled == disabled {
+		return nil
+	}
+	if _, err := s.client.ValidationCase.UpdateOneID(vc.ID).
+		SetTelegramRevealDisabled(disabled).
+		Save(ctx); err != nil {
+		return apperrors.ErrDatabase
+	}
+
+	actorID := int(ownerUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "contact_settings_changed", map[string]interface{}{
+		"telegram_reveal_disabled": disabled,
+	})
+	return nil
+}
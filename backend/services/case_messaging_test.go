@@ -0,0 +1,56 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"backend-gin/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeCaseMessageInput(t *testing.T) {
+	body, docs, err := normalizeCaseMessageInput("  Log terlampir.  ", []string{" doc-1 ", "", "doc-1", "doc-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "Log terlampir.", body)
+	assert.Equal(t, []string{"doc-1", "doc-2"}, docs)
+
+	_, docs, err = normalizeCaseMessageInput("", []string{"doc-1"})
+	require.NoError(t, err, "attachment-only message")
+	assert.Len(t, docs, 1)
+
+	_, _, err = normalizeCaseMessageInput("   ", nil)
+	assert.Error(t, err, "empty message")
+
+	_, _, err = normalizeCaseMessageInput(strings.Repeat("a", caseMessageMaxBodyChars+1), nil)
+	assert.Error(t, err, "body too long")
+
+	_, _, err = normalizeCaseMessageInput("lihat", []string{"a", "b", "c", "d", "e", "f"})
+	assert.Error(t, err, "too many attachments")
+
+	_, _, err = normalizeCaseMessageInput("<script>alert(1)</script>", nil)
+	assert.Error(t, err)
+}
+
+func TestTelegramRevealAllowed(t *testing.T) {
+	assert.True(t, telegramRevealAllowed(&ent.ValidationCase{SensitivityLevel: "S1"}))
+	assert.False(t, telegramRevealAllowed(&ent.ValidationCase{SensitivityLevel: "S1", TelegramRevealDisabled: true}))
+	assert.False(t, telegramRevealAllowed(&ent.ValidationCase{SensitivityLevel: "s2"}))
+	assert.False(t, telegramRevealAllowed(&ent.ValidationCase{SensitivityLevel: "S3"}))
+}
+
+func TestDisputeIDsFromCase(t *testing.T) {
+	current := "dsp-3"
+	milestoneDispute := "dsp-2"
+	vc := &ent.ValidationCase{DisputeID: &current}
+	milestones := []*ent.FinalOfferMilestone{{DisputeID: &milestoneDispute}, {}}
+	caseLog := []CaseLogItem{
+		{EventType: "dispute_attached", Detail: map[string]interface{}{"dispute_id": "dsp-1"}},
+		{EventType: "dispute_settled", Detail: map[string]interface{}{"dispute_id": "dsp-1"}},
+		{EventType: "milestone_dispute_attached", Detail: map[string]interface{}{"dispute_id": "dsp-2"}},
+	}
+
+	assert.Equal(t, []string{"dsp-1", "dsp-2", "dsp-3"}, disputeIDsFromCase(vc, milestones, caseLog))
+	assert.Empty(t, disputeIDsFromCase(&ent.ValidationCase{}, nil, nil))
+}
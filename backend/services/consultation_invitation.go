@@ -0,0 +1,620 @@
+package services
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-gin/ent"
+	"backend-gin/ent/consultationinvitation"
+	"backend-gin/ent/consultationrequest"
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/user"
+	"backend-gin/ent/validationcase"
+	apperrors "backend-gin/errors"
+	"backend-gin/utils"
+	"backend-gin/validators"
+)
+
+// Owner-initiated consultation: the owner invites a validator to the current workflow cycle
+// and accepting the invitation creates an already approved ConsultationRequest.
+const (
+	consultationInvitationStatusPending  = "pending"
+	consultationInvitationStatusAccepted = "accepted"
+	consultationInvitationStatusDeclined = "declined"
+	consultationInvitationStatusRevoked  = "revoked"
+	consultationInvitationStatusExpired  = "expired"
+
+	consultationInvitationMaxMessageChars = 1000
+	consultationInvitationMaxPending      = 10
+
+	invitationSuggestionDefaultLimit = 10
+	invitationSuggestionMaxLimit     = 25
+)
+
+// ConsultationInvitationInput selects the invited validator by ID or username.
+type ConsultationInvitationInput struct {
+	ValidatorUserID uint
+	Username        string
+	Message         string
+	ExpiresInHours  int
+}
+
+// consultationInvitationTTL returns the requested expiry window, or
+// CONSULTATION_INVITATION_EXPIRY_HOURS (default 72) when none is given.
+func consultationInvitationTTL(expiresInHours int) (time.Duration, error) {
+	if expiresInHours == 0 {
+		expiresInHours = 72
+		if raw := strings.TrimSpace(os.Getenv("CONSULTATION_INVITATION_EXPIRY_HOURS")); raw != "" {
+			if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+				expiresInHours = v
+			}
+		}
+		if expiresInHours > 30*24 {
+			expiresInHours = 30 * 24
+		}
+	}
+	if expiresInHours < 1 || expiresInHours > 30*24 {
+		return 0, apperrors.ErrInvalidInput.WithDetails("expires_in_hours harus antara 1 dan 720")
+	}
+	return time.Duration(expiresInHours) * time.Hour, nil
+}
+
+func normalizeConsultationInvitationMessage(message string) (string, error) {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return "", nil
+	}
+	if utf8.RuneCountInString(message) > consultationInvitationMaxMessageChars {
+		return "", apperrors.ErrInvalidInput.WithDetails("pesan undangan maksimal 1000 karakter")
+	}
+	if !utils.ValidateNoXSS(message) {
+		return "", apperrors.ErrInvalidInput.WithDetails("pesan undangan mengandung karakter atau pola yang tidak diizinkan")
+	}
+	if validators.ContainsBlockedContactHint(message) {
+		return "", apperrors.ErrInvalidInput.WithDetails("pesan undangan tidak boleh berisi detail kontak langsung")
+	}
+	return message, nil
+}
+
+func buildConsultationInvitationItem(inv *ent.ConsultationInvitation, validator *ent.User) ConsultationInvitationItem {
+	var requestID *uint
+	if inv.ConsultationRequestID != nil {
+		id := uint(*inv.ConsultationRequestID)
+		requestID = &id
+	}
+	summary := buildUserSummaryFromEnt(validator)
+	if summary.ID == 0 {
+		summary.ID = uint(inv.ValidatorUserID)
+	}
+	return ConsultationInvitationItem{
+		ID:                    uint(inv.ID),
+		ValidationCaseID:      uint(inv.ValidationCaseID),
+		OwnerUserID:           uint(inv.OwnerUserID),
+		Validator:             summary,
+		WorkflowCycle:         inv.WorkflowCycle,
+		Status:                normalizeStatus(inv.Status),
+		Message:               inv.Message,
+		ExpiresAt:             inv.ExpiresAt.Unix(),
+		RespondedAt:           unixPtr(inv.RespondedAt),
+		ConsultationRequestID: requestID,
+		CreatedAt:             inv.CreatedAt.Unix(),
+	}
+}
+
+// expireConsultationInvitations closes pending invitations past their expiry. It runs lazily
+// before invitations are listed or answered.
+func (s *EntValidationCaseWorkflowService) expireConsultationInvitations(ctx context.Context, scope ...predicate.ConsultationInvitation) error {
+	now := time.Now()
+	where := append([]predicate.ConsultationInvitation{
+		consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+		consultationinvitation.ExpiresAtLTE(now),
+	}, scope...)
+	stale, err := s.client.ConsultationInvitation.Query().
+		Where(where...).
+		Limit(500).
+		All(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	for _, inv := range stale {
+		updated, err := s.client.ConsultationInvitation.Update().
+			Where(
+				consultationinvitation.IDEQ(inv.ID),
+				consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+			).
+			SetStatus(consultationInvitationStatusExpired).
+			SetRespondedAt(now).
+			Save(ctx)
+		if err != nil {
+			return apperrors.ErrDatabase
+		}
+		if updated != 1 {
+			continue
+		}
+		s.appendCaseLogBestEffort(ctx, inv.ValidationCaseID, nil, "consultation_invitation_expired", map[string]interface{}{
+			"invitation_id":     inv.ID,
+			"validator_user_id": inv.ValidatorUserID,
+			"workflow_cycle":    inv.WorkflowCycle,
+		})
+	}
+	return nil
+}
+
+func (s *EntValidationCaseWorkflowService) resolveInvitedValidator(ctx context.Context, input ConsultationInvitationInput) (*ent.User, error) {
+	query := s.client.User.Query().WithPrimaryBadge()
+	username := strings.TrimPrefix(strings.TrimSpace(input.Username), "@")
+	switch {
+	case input.ValidatorUserID > 0:
+		query = query.Where(user.IDEQ(int(input.ValidatorUserID)))
+	case username != "":
+		query = query.Where(user.UsernameEQ(username))
+	default:
+		return nil, apperrors.ErrInvalidInput.WithDetails("validator_user_id atau username wajib diisi")
+	}
+	validator, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrUserNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	return validator, nil
+}
+
+// InviteValidator lets the case owner invite a validator to consult on the current cycle.
+// The validator must already meet the Credibility Stake for the case's sensitivity.
+func (s *EntValidationCaseWorkflowService) InviteValidator(ctx context.Context, validationCaseID uint, ownerUserID uint, input ConsultationInvitationInput) (*ConsultationInvitationItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if vc.UserID != int(ownerUserID) {
+		return nil, apperrors.ErrValidationCaseOwnership
+	}
+	switch normalizeStatus(vc.Status) {
+	case caseStatusOpen:
+		// allowed
+	case caseStatusWaitingOwnerResponse, caseStatusOnHoldOwnerInactive:
+		return nil, apperrors.ErrInvalidInput.WithDetails("kasus sedang menunggu respons owner. Tidak dapat mengundang validator baru.")
+	default:
+		return nil, apperrors.ErrInvalidInput.WithDetails("undangan konsultasi hanya dapat dikirim saat status kasus masih open")
+	}
+
+	message, err := normalizeConsultationInvitationMessage(input.Message)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := consultationInvitationTTL(input.ExpiresInHours)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := s.resolveInvitedValidator(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if validator.ID == vc.UserID {
+		return nil, apperrors.ErrInvalidInput.WithDetails("pemilik kasus tidak dapat mengundang dirinya sendiri")
+	}
+	if _, err := ensureConsultationStake(vc, validator); err != nil {
+		return nil, err
+	}
+
+	if err := s.expireConsultationInvitations(ctx, consultationinvitation.ValidationCaseIDEQ(vc.ID)); err != nil {
+		return nil, err
+	}
+	cycle := currentWorkflowCycle(vc)
+	requested, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidationCaseIDEQ(vc.ID),
+			consultationrequest.ValidatorUserIDEQ(validator.ID),
+			consultationrequest.WorkflowCycleEQ(cycle),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if requested {
+		return nil, apperrors.ErrInvalidInput.WithDetails("validator sudah memiliki Consultation Request pada kasus ini")
+	}
+	pending, err := s.client.ConsultationInvitation.Query().
+		Where(
+			consultationinvitation.ValidationCaseIDEQ(vc.ID),
+			consultationinvitation.WorkflowCycleEQ(cycle),
+			consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	for _, inv := range pending {
+		if inv.ValidatorUserID == validator.ID {
+			return nil, apperrors.ErrInvalidInput.WithDetails("validator sudah memiliki undangan yang menunggu jawaban")
+		}
+	}
+	if len(pending) >= consultationInvitationMaxPending {
+		return nil, apperrors.ErrInvalidInput.WithDetails("maksimal 10 undangan aktif per kasus")
+	}
+
+	inv, err := s.client.ConsultationInvitation.Create().
+		SetValidationCaseID(vc.ID).
+		SetOwnerUserID(vc.UserID).
+		SetValidatorUserID(validator.ID).
+		SetWorkflowCycle(cycle).
+		SetStatus(consultationInvitationStatusPending).
+		SetMessage(message).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	actorID := int(ownerUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "consultation_invitation_sent", map[string]interface{}{
+		"invitation_id":     inv.ID,
+		"validator_user_id": validator.ID,
+		"workflow_cycle":    cycle,
+		"expires_at_unix":   inv.ExpiresAt.Unix(),
+	})
+
+	item := buildConsultationInvitationItem(inv, validator)
+	return &item, nil
+}
+
+// SuggestValidatorsForInvitation returns the top validator recommendations for the case.
+func (s *EntValidationCaseWorkflowService) SuggestValidatorsForInvitation(ctx context.Context, validationCaseID uint, ownerUserID uint, limit int) ([]ValidatorSuggestionItem, error) {
+	if limit <= 0 {
+		limit = invitationSuggestionDefaultLimit
+	}
+	if limit > invitationSuggestionMaxLimit {
+		limit = invitationSuggestionMaxLimit
+	}
+	recommended, err := s.RecommendValidators(ctx, validationCaseID, ownerUserID, ValidatorRecommendationFilter{Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ValidatorSuggestionItem, 0, len(recommended))
+	for _, it := range recommended {
+		out = append(out, ValidatorSuggestionItem{
+			Validator:     it.Validator,
+			MatchingScore: it.MatchingScore,
+		})
+	}
+	return out, nil
+}
+
+// ListConsultationInvitations returns the current cycle's invitations: all of them for the
+// owner, only their own for a validator.
+func (s *EntValidationCaseWorkflowService) ListConsultationInvitations(ctx context.Context, validationCaseID uint, userID uint) ([]ConsultationInvitationItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if err := s.expireConsultationInvitations(ctx, consultationinvitation.ValidationCaseIDEQ(vc.ID)); err != nil {
+		return nil, err
+	}
+
+	query := s.client.ConsultationInvitation.Query().
+		Where(
+			consultationinvitation.ValidationCaseIDEQ(vc.ID),
+			consultationinvitation.WorkflowCycleEQ(currentWorkflowCycle(vc)),
+		)
+	if vc.UserID != int(userID) {
+		query = query.Where(consultationinvitation.ValidatorUserIDEQ(int(userID)))
+	}
+	invs, err := query.
+		Order(ent.Desc(consultationinvitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if len(invs) == 0 {
+		return []ConsultationInvitationItem{}, nil
+	}
+
+	validatorIDs := make([]int, 0, len(invs))
+	for _, inv := range invs {
+		validatorIDs = append(validatorIDs, inv.ValidatorUserID)
+	}
+	users, err := s.client.User.Query().
+		Where(user.IDIn(validatorIDs...)).
+		WithPrimaryBadge().
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	userByID := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		userByID[u.ID] = u
+	}
+
+	out := make([]ConsultationInvitationItem, 0, len(invs))
+	for _, inv := range invs {
+		out = append(out, buildConsultationInvitationItem(inv, userByID[inv.ValidatorUserID]))
+	}
+	return out, nil
+}
+
+// ListMyConsultationInvitations returns the validator's pending invitations across cases.
+func (s *EntValidationCaseWorkflowService) ListMyConsultationInvitations(ctx context.Context, validatorUserID uint) ([]ConsultationInvitationItem, error) {
+	if err := s.expireConsultationInvitations(ctx, consultationinvitation.ValidatorUserIDEQ(int(validatorUserID))); err != nil {
+		return nil, err
+	}
+	invs, err := s.client.ConsultationInvitation.Query().
+		Where(
+			consultationinvitation.ValidatorUserIDEQ(int(validatorUserID)),
+			consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+		).
+		Order(ent.Asc(consultationinvitation.FieldExpiresAt)).
+		Limit(100).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if len(invs) == 0 {
+		return []ConsultationInvitationItem{}, nil
+	}
+
+	caseIDs := make([]int, 0, len(invs))
+	for _, inv := range invs {
+		caseIDs = append(caseIDs, inv.ValidationCaseID)
+	}
+	cases, err := s.client.ValidationCase.Query().
+		Where(validationcase.IDIn(caseIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	caseByID := make(map[int]*ent.ValidationCase, len(cases))
+	for _, vc := range cases {
+		caseByID[vc.ID] = vc
+	}
+
+	out := make([]ConsultationInvitationItem, 0, len(invs))
+	for _, inv := range invs {
+		vc, ok := caseByID[inv.ValidationCaseID]
+		if !ok || inv.WorkflowCycle != currentWorkflowCycle(vc) {
+			// The case moved to a new cycle; the invitation can no longer be accepted.
+			continue
+		}
+		item := buildConsultationInvitationItem(inv, nil)
+		item.CaseTitle = vc.Title
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func (s *EntValidationCaseWorkflowService) loadConsultationInvitation(ctx context.Context, validationCaseID uint, invitationID uint) (*ent.ValidationCase, *ent.ConsultationInvitation, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, nil, err
+	}
+	inv, err := s.client.ConsultationInvitation.Query().
+		Where(
+			consultationinvitation.IDEQ(int(invitationID)),
+			consultationinvitation.ValidationCaseIDEQ(vc.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, apperrors.ErrInvalidInput.WithDetails("undangan tidak ditemukan")
+		}
+		return nil, nil, apperrors.ErrDatabase
+	}
+	return vc, inv, nil
+}
+
+// ensurePendingInvitation rejects invitations that were already answered or expired,
+// expiring the invitation on the way if its window has passed.
+func (s *EntValidationCaseWorkflowService) ensurePendingInvitation(ctx context.Context, vc *ent.ValidationCase, inv *ent.ConsultationInvitation) error {
+	if normalizeStatus(inv.Status) != consultationInvitationStatusPending {
+		return apperrors.ErrInvalidInput.WithDetails("undangan tidak dalam status pending")
+	}
+	if !inv.ExpiresAt.After(time.Now()) {
+		if err := s.expireConsultationInvitations(ctx, consultationinvitation.IDEQ(inv.ID)); err != nil {
+			return err
+		}
+		return apperrors.ErrInvalidInput.WithDetails("undangan sudah kedaluwarsa")
+	}
+	if inv.WorkflowCycle != currentWorkflowCycle(vc) {
+		return apperrors.ErrInvalidInput.WithDetails("undangan berasal dari siklus workflow sebelumnya")
+	}
+	return nil
+}
+
+// AcceptConsultationInvitation creates an approved ConsultationRequest for the invited
+// validator, which also counts towards their consultation guarantee locks. A pending request
+// the validator filed on their own is approved instead.
+func (s *EntValidationCaseWorkflowService) AcceptConsultationInvitation(ctx context.Context, validationCaseID uint, validatorUserID uint, invitationID uint) (uint, error) {
+	vc, inv, err := s.loadConsultationInvitation(ctx, validationCaseID, invitationID)
+	if err != nil {
+		return 0, err
+	}
+	if inv.ValidatorUserID != int(validatorUserID) {
+		return 0, apperrors.ErrInvalidInput.WithDetails("undangan tidak ditemukan")
+	}
+	if err := s.ensurePendingInvitation(ctx, vc, inv); err != nil {
+		return 0, err
+	}
+	switch normalizeStatus(vc.Status) {
+	case caseStatusOpen:
+		// allowed
+	case caseStatusWaitingOwnerResponse, caseStatusOnHoldOwnerInactive:
+		return 0, apperrors.ErrInvalidInput.WithDetails("kasus sedang menunggu respons owner. Tidak dapat menerima validator baru.")
+	default:
+		return 0, apperrors.ErrInvalidInput.WithDetails("undangan hanya dapat diterima saat status kasus masih open")
+	}
+
+	validator, err := s.client.User.Get(ctx, int(validatorUserID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, apperrors.ErrUserNotFound
+		}
+		return 0, apperrors.ErrDatabase
+	}
+	requiredStake, err := ensureConsultationStake(vc, validator)
+	if err != nil {
+		return 0, err
+	}
+
+	cycle := inv.WorkflowCycle
+	existing, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidationCaseIDEQ(vc.ID),
+			consultationrequest.ValidatorUserIDEQ(int(validatorUserID)),
+			consultationrequest.WorkflowCycleEQ(cycle),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, apperrors.ErrDatabase
+	}
+	if existing != nil && normalizeStatus(existing.Status) != consultationStatusPending {
+		return 0, apperrors.ErrInvalidInput.WithDetails("Request Consultation sudah pernah diajukan untuk kasus ini")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, apperrors.ErrDatabase
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	now := time.Now()
+	var req *ent.ConsultationRequest
+	if existing != nil {
+		req, err = tx.ConsultationRequest.UpdateOneID(existing.ID).
+			SetStatus(consultationStatusApproved).
+			SetApprovedAt(now).
+			ClearRejectedAt().
+			ClearOwnerResponseDueAt().
+			ClearOwnerResponseStartedAt().
+			ClearExpiresAt().
+			SetReminderCount(0).
+			ClearAutoClosedReason().
+			Save(ctx)
+	} else {
+		req, err = tx.ConsultationRequest.Create().
+			SetValidationCaseID(vc.ID).
+			SetValidatorUserID(int(validatorUserID)).
+			SetWorkflowCycle(cycle).
+			SetStatus(consultationStatusApproved).
+			SetApprovedAt(now).
+			SetReminderCount(0).
+			SetSLAPolicySnapshot(resolveSLAPolicySnapshot(ctx, s.client, vc).toJSON()).
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, apperrors.ErrDatabase
+	}
+
+	updated, err := tx.ConsultationInvitation.Update().
+		Where(
+			consultationinvitation.IDEQ(inv.ID),
+			consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+		).
+		SetStatus(consultationInvitationStatusAccepted).
+		SetRespondedAt(now).
+		SetConsultationRequestID(req.ID).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		_ = tx.Rollback()
+		return 0, apperrors.ErrInvalidInput.WithDetails("undangan tidak dalam status pending")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.ErrDatabase
+	}
+
+	actorID := int(validatorUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "consultation_invitation_accepted", map[string]interface{}{
+		"invitation_id":           inv.ID,
+		"validator_user_id":       validatorUserID,
+		"consultation_request_id": req.ID,
+		"required_stake":          requiredStake,
+		"workflow_cycle":          cycle,
+	})
+
+	return uint(req.ID), nil
+}
+
+// DeclineConsultationInvitation lets the invited validator turn the invitation down.
+func (s *EntValidationCaseWorkflowService) DeclineConsultationInvitation(ctx context.Context, validationCaseID uint, validatorUserID uint, invitationID uint) error {
+	vc, inv, err := s.loadConsultationInvitation(ctx, validationCaseID, invitationID)
+	if err != nil {
+		return err
+	}
+	if inv.ValidatorUserID != int(validatorUserID) {
+		return apperrors.ErrInvalidInput.WithDetails("undangan tidak ditemukan")
+	}
+	return s.closeConsultationInvitation(ctx, vc, inv, int(validatorUserID), consultationInvitationStatusDeclined, "consultation_invitation_declined")
+}
+
+// RevokeConsultationInvitation lets the owner withdraw an invitation that was not answered yet.
+func (s *EntValidationCaseWorkflowService) RevokeConsultationInvitation(ctx context.Context, validationCaseID uint, ownerUserID uint, invitationID uint) error {
+	vc, inv, err := s.loadConsultationInvitation(ctx, validationCaseID, invitationID)
+	if err != nil {
+		return err
+	}
+	if vc.UserID != int(ownerUserID) {
+		return apperrors.ErrValidationCaseOwnership
+	}
+	return s.closeConsultationInvitation(ctx, vc, inv, int(ownerUserID), consultationInvitationStatusRevoked, "consultation_invitation_revoked")
+}
+
+func (s *EntValidationCaseWorkflowService) closeConsultationInvitation(ctx context.Context, vc *ent.ValidationCase, inv *ent.ConsultationInvitation, actorID int, status string, eventType string) error {
+	if err := s.ensurePendingInvitation(ctx, vc, inv); err != nil {
+		return err
+	}
+	updated, err := s.client.ConsultationInvitation.Update().
+		Where(
+			consultationinvitation.IDEQ(inv.ID),
+			consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+		).
+		SetStatus(status).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return apperrors.ErrInvalidInput.WithDetails("undangan tidak dalam status pending")
+	}
+
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, eventType, map[string]interface{}{
+		"invitation_id":     inv.ID,
+		"validator_user_id": inv.ValidatorUserID,
+		"workflow_cycle":    inv.WorkflowCycle,
+	})
+	return nil
+}
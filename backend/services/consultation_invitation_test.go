@@ -0,0 +1,46 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsultationInvitationTTL(t *testing.T) {
+	t.Setenv("CONSULTATION_INVITATION_EXPIRY_HOURS", "")
+	ttl, err := consultationInvitationTTL(0)
+	require.NoError(t, err)
+	assert.Equal(t, 72*time.Hour, ttl)
+
+	t.Setenv("CONSULTATION_INVITATION_EXPIRY_HOURS", "9999")
+	ttl, err = consultationInvitationTTL(0)
+	require.NoError(t, err)
+	assert.Equal(t, 720*time.Hour, ttl, "env default is capped")
+
+	ttl, err = consultationInvitationTTL(24)
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, ttl)
+
+	_, err = consultationInvitationTTL(-1)
+	assert.Error(t, err)
+	_, err = consultationInvitationTTL(721)
+	assert.Error(t, err)
+}
+
+func TestNormalizeConsultationInvitationMessage(t *testing.T) {
+	msg, err := normalizeConsultationInvitationMessage("  Kasus ini butuh pengalaman audit smart contract.  ")
+	require.NoError(t, err)
+	assert.Equal(t, "Kasus ini butuh pengalaman audit smart contract.", msg)
+
+	msg, err = normalizeConsultationInvitationMessage("   ")
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+
+	_, err = normalizeConsultationInvitationMessage(strings.Repeat("a", consultationInvitationMaxMessageChars+1))
+	assert.Error(t, err, "too long")
+	_, err = normalizeConsultationInvitationMessage("hubungi saya di t.me/pemilik")
+	assert.Error(t, err, "contact details")
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/deviceusermapping"
+	"backend-gin/ent/session"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/utils"
+
+	"go.uber.org/zap"
+)
+
+// UserDevice is a DeviceUserMapping enriched for the account device list
+type UserDevice struct {
+	Mapping        *ent.DeviceUserMapping
+	UserAgent      utils.UserAgentInfo
+	Location       *GeoLocation // nil when the last IP is private or lookup failed
+	Current        bool
+	ActiveSessions int
+}
+
+// EntDeviceService lets users review and manage the devices recorded for their account
+type EntDeviceService struct {
+	client   *ent.Client
+	sessions *EntSessionService
+	audit    *EntSecurityAuditService
+	geo      *GeoLookupService
+}
+
+// NewEntDeviceService creates a new device service with Ent
+func NewEntDeviceService(sessionService *EntSessionService) *EntDeviceService {
+	return &EntDeviceService{
+		client:   database.GetEntClient(),
+		sessions: sessionService,
+		audit:    NewEntSecurityAuditService(),
+		geo:      GetGeoLookupService(),
+	}
+}
+
+// ListDevices returns the user's devices, newest activity first. currentJTI marks the
+// device behind the calling session.
+func (s *EntDeviceService) ListDevices(ctx context.Context, userID int, currentJTI string) ([]UserDevice, error) {
+	mappings, err := s.client.DeviceUserMapping.Query().
+		Where(deviceusermapping.UserIDEQ(userID), deviceusermapping.RemovedAtIsNil()).
+		Order(ent.Desc(deviceusermapping.FieldLastSeenAt)).
+		All(ctx)
+	if err != nil {
+		logger.Error("Failed to list user devices", zap.Error(err), zap.Int("user_id", userID))
+		return nil, apperrors.ErrDatabase
+	}
+
+	activeSessions, err := s.sessions.GetActiveSessions(ctx, userID)
+	if err != nil {
+		logger.Warn("Failed to load active sessions for device list", zap.Error(err), zap.Int("user_id", userID))
+	}
+	sessionsByDevice := make(map[string]int)
+	currentHash := ""
+	for _, sess := range activeSessions {
+		if sess.DeviceFingerprintHash == "" {
+			continue
+		}
+		sessionsByDevice[sess.DeviceFingerprintHash]++
+		if currentJTI != "" && sess.AccessTokenJti == currentJTI {
+			currentHash = sess.DeviceFingerprintHash
+		}
+	}
+
+	devices := make([]UserDevice, 0, len(mappings))
+	for _, m := range mappings {
+		d := UserDevice{
+			Mapping:        m,
+			UserAgent:      utils.ParseUserAgent(m.LastUserAgent),
+			Current:        currentHash != "" && m.FingerprintHash == currentHash,
+			ActiveSessions: sessionsByDevice[m.FingerprintHash],
+		}
+		if m.LastIP != "" && s.geo != nil {
+			d.Location = s.geo.LookupIP(ctx, m.LastIP)
+		}
+		devices = append(devices, d)
+	}
+	return devices, nil
+}
+
+// RenameDevice sets a user-chosen label for a device
+func (s *EntDeviceService) RenameDevice(ctx context.Context, userID, deviceID int, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 64 {
+		return apperrors.ErrInvalidInput.WithDetails("Nama perangkat harus 1-64 karakter")
+	}
+	if !utils.ValidateNoXSS(name) {
+		return apperrors.ErrInvalidInput.WithDetails("Nama perangkat mengandung karakter yang tidak diizinkan")
+	}
+
+	affected, err := s.client.DeviceUserMapping.Update().
+		Where(
+			deviceusermapping.IDEQ(deviceID),
+			deviceusermapping.UserIDEQ(userID),
+			deviceusermapping.RemovedAtIsNil(),
+		).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		logger.Error("Failed to rename device", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase
+	}
+	if affected == 0 {
+		return apperrors.ErrDeviceNotFound
+	}
+	return nil
+}
+
+// SetDeviceTrusted marks or unmarks a device as trusted. Trusted devices skip risk-based
+// step-up on login (block decisions still apply), so callers gate trusting behind sudo.
+func (s *EntDeviceService) SetDeviceTrusted(ctx context.Context, userID, deviceID int, trusted bool, ip, userAgent string) error {
+	m, err := s.getDevice(ctx, userID, deviceID)
+	if err != nil {
+		return err
+	}
+
+	update := s.client.DeviceUserMapping.UpdateOne(m).SetTrusted(trusted)
+	var eventType SecurityEventType = EventDeviceUntrusted
+	if trusted {
+		update = update.SetTrustedAt(time.Now())
+		eventType = EventDeviceTrusted
+	} else {
+		update = update.ClearTrustedAt()
+	}
+	if _, err := update.Save(ctx); err != nil {
+		logger.Error("Failed to update device trust", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase
+	}
+
+	s.logDeviceEvent(ctx, userID, eventType, ip, userAgent, m, "")
+	return nil
+}
+
+// RemoveDevice hides a device from the user's list and revokes every session that
+// originated from its fingerprint. The next login from it counts as a new device.
+func (s *EntDeviceService) RemoveDevice(ctx context.Context, userID, deviceID int, ip, userAgent string) (int, error) {
+	m, err := s.getDevice(ctx, userID, deviceID)
+	if err != nil {
+		return 0, err
+	}
+
+	var revoked int
+	err = WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		now := time.Now()
+		if _, err := tx.DeviceUserMapping.UpdateOne(m).
+			SetRemovedAt(now).
+			SetTrusted(false).
+			ClearTrustedAt().
+			Save(ctx); err != nil {
+			return err
+		}
+		n, err := tx.Session.Update().
+			Where(
+				session.UserIDEQ(userID),
+				session.DeviceFingerprintHashEQ(m.FingerprintHash),
+				session.RevokedAtIsNil(),
+			).
+			SetRevokedAt(now).
+			SetRevokeReason("Device removed by user").
+			Save(ctx)
+		revoked = n
+		return err
+	})
+	if err != nil {
+		logger.Error("Failed to remove device", zap.Error(err), zap.Int("user_id", userID))
+		return 0, apperrors.ErrDatabase
+	}
+
+	s.logDeviceEvent(ctx, userID, EventDeviceRemoved, ip, userAgent, m, fmt.Sprintf("sessions_revoked=%d", revoked))
+	logger.Info("Device removed",
+		zap.Int("user_id", userID),
+		zap.Int("device_id", deviceID),
+		zap.Int("sessions_revoked", revoked))
+	return revoked, nil
+}
+
+func (s *EntDeviceService) getDevice(ctx context.Context, userID, deviceID int) (*ent.DeviceUserMapping, error) {
+	m, err := s.client.DeviceUserMapping.Query().
+		Where(
+			deviceusermapping.IDEQ(deviceID),
+			deviceusermapping.UserIDEQ(userID),
+			deviceusermapping.RemovedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrDeviceNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	return m, nil
+}
+
+func (s *EntDeviceService) logDeviceEvent(ctx context.Context, userID int, eventType SecurityEventType, ip, userAgent string, m *ent.DeviceUserMapping, extra string) {
+	email := ""
+	if u, err := s.client.User.Get(ctx, userID); err == nil {
+		email = u.Email
+	}
+	details := fmt.Sprintf("device_id=%d device=%s", m.ID, utils.ParseUserAgent(m.LastUserAgent).String())
+	if extra != "" {
+		details += " " + extra
+	}
+	s.audit.LogEvent(ctx, eventType, &userID, email, ip, userAgent, details, "info", true)
+}
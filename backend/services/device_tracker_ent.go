@@ -192,6 +192,8 @@ func (d *EntDeviceTracker) RecordDeviceRegistration(ctx context.Context, userID
 		SetUserID(userID).
 		SetFirstSeenAt(now).
 		SetLastSeenAt(now).
+		SetLastIP(ip).
+		SetLastUserAgent(truncateString(userAgent, 512)).
 		Save(ctx)
 	if err != nil {
 		if !ent.IsConstraintError(err) {
@@ -287,13 +289,26 @@ func (d *EntDeviceTracker) RecordDeviceLogin(ctx context.Context, userID int, fi
 		).
 		Only(ctx)
 
+	// unfamiliarDevice is set when an account that already has known devices signs in from a new one
+	unfamiliarDevice := false
 	if ent.IsNotFound(err) {
+		knownDevices, err := tx.DeviceUserMapping.Query().
+			Where(deviceusermapping.UserIDEQ(userID), deviceusermapping.RemovedAtIsNil()).
+			Count(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Error("RecordDeviceLogin: failed to count user devices", zap.Error(err))
+			return err
+		}
+
 		// New mapping — create
 		_, err = tx.DeviceUserMapping.Create().
 			SetFingerprintHash(fingerprintHash).
 			SetUserID(userID).
 			SetFirstSeenAt(now).
 			SetLastSeenAt(now).
+			SetLastIP(ip).
+			SetLastUserAgent(truncateString(userAgent, 512)).
 			Save(ctx)
 		if err != nil {
 			if ent.IsConstraintError(err) {
@@ -306,15 +321,37 @@ func (d *EntDeviceTracker) RecordDeviceLogin(ctx context.Context, userID int, fi
 				logger.Error("RecordDeviceLogin: failed to create user mapping", zap.Error(err))
 				return err
 			}
+		} else {
+			unfamiliarDevice = knownDevices > 0
 		}
 	} else if err != nil {
 		_ = tx.Rollback()
 		logger.Error("RecordDeviceLogin: failed to query user mapping", zap.Error(err))
 		return err
+	} else if existingMapping.RemovedAt != nil {
+		// Device the user removed — re-add it as a fresh, untrusted device
+		_, err = tx.DeviceUserMapping.UpdateOne(existingMapping).
+			SetFirstSeenAt(now).
+			SetLastSeenAt(now).
+			SetLastIP(ip).
+			SetLastUserAgent(truncateString(userAgent, 512)).
+			ClearName().
+			SetTrusted(false).
+			ClearTrustedAt().
+			ClearRemovedAt().
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			logger.Error("RecordDeviceLogin: failed to restore user mapping", zap.Error(err))
+			return err
+		}
+		unfamiliarDevice = true
 	} else {
 		// Existing mapping — update last_seen_at
 		_, err = tx.DeviceUserMapping.UpdateOne(existingMapping).
 			SetLastSeenAt(now).
+			SetLastIP(ip).
+			SetLastUserAgent(truncateString(userAgent, 512)).
 			Save(ctx)
 		if err != nil {
 			_ = tx.Rollback()
@@ -369,6 +406,12 @@ func (d *EntDeviceTracker) RecordDeviceLogin(ctx context.Context, userID int, fi
 		zap.String("ip", ip),
 		zap.Int("account_count", mappingCount))
 
+	if unfamiliarDevice {
+		if alerts := GetLoginAlertService(); alerts != nil {
+			go alerts.NotifyNewDevice(ctx, userID, ip, userAgent)
+		}
+	}
+
 	return nil
 }
 
@@ -443,11 +486,11 @@ func (d *EntDeviceTracker) IsDeviceBlocked(ctx context.Context, fingerprintHash
 	return deviceFP.Blocked, deviceFP.BlockReason
 }
 
-// GetUserDevices returns all devices used by a user
+// GetUserDevices returns the devices used by a user, excluding ones the user removed
 func (d *EntDeviceTracker) GetUserDevices(ctx context.Context, userID int) ([]*ent.DeviceUserMapping, error) {
 	client := database.GetEntClient()
 	return client.DeviceUserMapping.Query().
-		Where(deviceusermapping.UserIDEQ(userID)).
+		Where(deviceusermapping.UserIDEQ(userID), deviceusermapping.RemovedAtIsNil()).
 		Order(ent.Desc(deviceusermapping.FieldLastSeenAt)).
 		All(ctx)
 }
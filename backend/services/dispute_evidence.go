@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"backend-gin/ent"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	apperrors "backend-gin/errors"
+)
+
+// disputeIDsFromCase collects every dispute attached to the case: the current case-level and
+// milestone disputes, plus earlier ones recorded in the case log (a refund settlement reopens
+// the case and clears its linkage).
+func disputeIDsFromCase(vc *ent.ValidationCase, milestones []*ent.FinalOfferMilestone, caseLog []CaseLogItem) []string {
+	seen := map[string]struct{}{}
+	out := []string{}
+	add := func(id string) {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return
+		}
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	for _, it := range caseLog {
+		switch it.EventType {
+		case "dispute_attached", "milestone_dispute_attached":
+			id, _ := it.Detail["dispute_id"].(string)
+			add(id)
+		}
+	}
+	add(valueOrEmpty(vc.DisputeID))
+	for _, m := range milestones {
+		add(valueOrEmpty(m.DisputeID))
+	}
+	return out
+}
+
+// GetDisputeEvidenceBundle assembles the case log, clarifications and private message
+// transcripts between the owner and every validator whose Final Offer was accepted on the
+// case. Only disputed cases can be exported, so unrelated private threads stay private.
+func (s *EntValidationCaseWorkflowService) GetDisputeEvidenceBundle(ctx context.Context, validationCaseID uint) (*DisputeEvidenceBundle, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+
+	caseLog, err := s.loadCaseLogItems(ctx, vc)
+	if err != nil {
+		return nil, err
+	}
+	milestones, err := s.client.FinalOfferMilestone.Query().
+		Where(finaloffermilestone.ValidationCaseIDEQ(vc.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	disputeIDs := disputeIDsFromCase(vc, milestones, caseLog)
+	if len(disputeIDs) == 0 {
+		return nil, apperrors.ErrInvalidInput.WithDetails("kasus belum memiliki dispute")
+	}
+
+	offers, err := s.client.FinalOffer.Query().
+		Where(
+			finaloffer.ValidationCaseIDEQ(vc.ID),
+			finaloffer.AcceptedAtNotNil(),
+		).
+		Order(ent.Asc(finaloffer.FieldAcceptedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	acceptedOffers := make([]DisputeEvidenceOffer, 0, len(offers))
+	validatorIDs := []int{}
+	seenValidator := map[int]struct{}{}
+	for _, o := range offers {
+		acceptedOffers = append(acceptedOffers, DisputeEvidenceOffer{
+			FinalOfferID:    uint(o.ID),
+			ValidatorUserID: uint(o.ValidatorUserID),
+			WorkflowCycle:   o.WorkflowCycle,
+			Amount:          o.Amount,
+			HoldHours:       o.HoldHours,
+			Terms:           o.Terms,
+			AcceptedAt:      o.AcceptedAt.Unix(),
+		})
+		if _, ok := seenValidator[o.ValidatorUserID]; !ok {
+			seenValidator[o.ValidatorUserID] = struct{}{}
+			validatorIDs = append(validatorIDs, o.ValidatorUserID)
+		}
+	}
+
+	transcripts := make([]DisputeEvidenceThread, 0, len(validatorIDs))
+	for _, validatorID := range validatorIDs {
+		messages, err := s.ListCaseMessages(ctx, validationCaseID, uint(vc.UserID), uint(validatorID))
+		if err != nil {
+			return nil, err
+		}
+		transcripts = append(transcripts, DisputeEvidenceThread{
+			ValidatorUserID: uint(validatorID),
+			Messages:        messages,
+		})
+	}
+
+	clarificationRows, err := s.client.CaseClarification.Query().
+		Where(
+			caseclarification.ValidationCaseIDEQ(vc.ID),
+			caseclarification.ValidatorUserIDIn(validatorIDs...),
+		).
+		Order(ent.Asc(caseclarification.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	clarifications := make([]CaseClarificationItem, 0, len(clarificationRows))
+	for _, c := range clarificationRows {
+		clarifications = append(clarifications, buildCaseClarificationItem(c))
+	}
+
+	return &DisputeEvidenceBundle{
+		ValidationCaseID: uint(vc.ID),
+		Title:            vc.Title,
+		Status:           normalizeStatus(vc.Status),
+		SensitivityLevel: vc.SensitivityLevel,
+		OwnerUserID:      uint(vc.UserID),
+		WorkflowCycle:    currentWorkflowCycle(vc),
+		DisputeIDs:       disputeIDs,
+		AcceptedOffers:   acceptedOffers,
+		Transcripts:      transcripts,
+		Clarifications:   clarifications,
+		CaseLog:          caseLog,
+		GeneratedAt:      time.Now().Unix(),
+	}, nil
+}
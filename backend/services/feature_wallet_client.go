@@ -15,8 +15,9 @@ import (
 )
 
 type FeatureWalletClient struct {
-	baseURL string
-	client  *http.Client
+	baseURL      string
+	serviceToken string
+	client       *http.Client
 }
 
 type FeatureWalletBalanceResult struct {
@@ -100,6 +101,18 @@ type FeatureMarketPurchaseHistoryResult struct {
 	PageSize int                                `json:"pageSize"`
 }
 
+// FeatureGuaranteeSlashResult is Feature Service's record of a guarantee slash.
+type FeatureGuaranteeSlashResult struct {
+	SlashID         string `json:"slashId"`
+	GuaranteeID     string `json:"guaranteeId"`
+	SlashedAmount   int64  `json:"slashedAmount"`
+	RemainingAmount int64  `json:"remainingAmount"`
+	Status          string `json:"status"`
+	// CreditTransactionID is the wallet transaction crediting the slashed amount to the beneficiary
+	CreditTransactionID string `json:"creditTransactionId"`
+	Replayed            bool   `json:"replayed"`
+}
+
 type FeatureMarketDistributionRecipient struct {
 	UserID    uint  `json:"userId"`
 	AmountIDR int64 `json:"amountIdr"`
@@ -107,7 +120,8 @@ type FeatureMarketDistributionRecipient struct {
 
 func NewFeatureWalletClientFromConfig() *FeatureWalletClient {
 	return &FeatureWalletClient{
-		baseURL: strings.TrimRight(config.FeatureServiceURL, "/"),
+		baseURL:      strings.TrimRight(config.FeatureServiceURL, "/"),
+		serviceToken: config.ServiceToken,
 		client: &http.Client{
 			Timeout: 12 * time.Second,
 		},
@@ -385,6 +399,71 @@ func (c *FeatureWalletClient) postMarketWallet(ctx context.Context, authHeader,
 	return &parsed.Data, nil
 }
 
+// SlashGuarantee deducts amount from the user's active guarantee and credits it to the
+// beneficiary's wallet. It is a service-to-service call (X-Service-Token); Feature Service
+// replays the original result for a repeated idempotencyKey.
+func (c *FeatureWalletClient) SlashGuarantee(
+	ctx context.Context,
+	idempotencyKey string,
+	userID uint,
+	beneficiaryUserID uint,
+	amount int64,
+	reason string,
+	referenceID string,
+) (*FeatureGuaranteeSlashResult, error) {
+	if c == nil || c.baseURL == "" || c.serviceToken == "" {
+		return nil, fmt.Errorf("feature wallet client is not configured")
+	}
+
+	payload := map[string]interface{}{
+		"userId":            userID,
+		"beneficiaryUserId": beneficiaryUserID,
+		"amount":            amount,
+		"reason":            reason,
+		"referenceId":       referenceID,
+		"referenceType":     "validation_case_dispute",
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v1/guarantees/internal/slash", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Service-Token", c.serviceToken)
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, parseFeatureWalletError(resp.StatusCode, respBody)
+	}
+
+	var parsed featureApiEnvelope[FeatureGuaranteeSlashResult]
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode guarantee slash response: %w", err)
+	}
+	if !parsed.Success {
+		if parsed.Error != nil && strings.TrimSpace(parsed.Error.Message) != "" {
+			return nil, fmt.Errorf("%s", parsed.Error.Message)
+		}
+		return nil, fmt.Errorf("feature wallet request failed with status %d", resp.StatusCode)
+	}
+	return &parsed.Data, nil
+}
+
 func parseFeatureWalletError(statusCode int, body []byte) error {
 	var wrapped featureApiEnvelope[json.RawMessage]
 	wrappedCode := ""
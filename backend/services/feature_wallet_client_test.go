@@ -264,3 +264,53 @@ func TestFeatureWalletClient_VerifyPin_PqcSignatureInvalidError(t *testing.T) {
 		t.Fatalf("unexpected message: got %q want %q", walletErr.Message, "Missing X-PQC-Signature header")
 	}
 }
+
+func TestFeatureWalletClient_SlashGuarantee_SendsServiceTokenAndIdempotencyKey(t *testing.T) {
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path != "/api/v1/guarantees/internal/slash" {
+				t.Fatalf("unexpected path: got %s", r.URL.Path)
+			}
+			if got := r.Header.Get("X-Service-Token"); got != "svc-token" {
+				t.Fatalf("unexpected X-Service-Token: got %q", got)
+			}
+			if got := r.Header.Get("Idempotency-Key"); got != "guarantee-slash:dsp_1" {
+				t.Fatalf("unexpected Idempotency-Key: got %q", got)
+			}
+			rawBody, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatalf("failed to read request body: %v", err)
+			}
+			if !strings.Contains(string(rawBody), `"amount":50000`) || !strings.Contains(string(rawBody), `"userId":7`) || !strings.Contains(string(rawBody), `"beneficiaryUserId":3`) {
+				t.Fatalf("unexpected request body: %s", string(rawBody))
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"success":true,"data":{"slashId":"gsl_1","guaranteeId":"grt_1","userId":7,"slashedAmount":50000,"remainingAmount":450000,"status":"Active","creditTransactionId":"txn_1","replayed":false}}`)),
+			}, nil
+		}),
+	}
+
+	client := &FeatureWalletClient{
+		baseURL:      "https://feature.example",
+		serviceToken: "svc-token",
+		client:       httpClient,
+	}
+
+	result, err := client.SlashGuarantee(context.Background(), "guarantee-slash:dsp_1", 7, 3, 50_000, "dispute lost", "dsp_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.SlashID != "gsl_1" || result.SlashedAmount != 50_000 || result.RemainingAmount != 450_000 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestFeatureWalletClient_SlashGuarantee_RequiresServiceToken(t *testing.T) {
+	client := &FeatureWalletClient{baseURL: "https://feature.example", client: http.DefaultClient}
+	if _, err := client.SlashGuarantee(context.Background(), "k", 7, 3, 1, "r", "d"); err == nil {
+		t.Fatalf("expected error without service token")
+	}
+}
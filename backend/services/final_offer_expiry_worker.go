@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const finalOfferExpiryLockKey = "final_offer_expiry_worker_lock"
+const finalOfferExpiryLockTTL = 60 * time.Second
+
+// FinalOfferExpiryWorker periodically closes Final Offers whose latest proposal passed
+// FINAL_OFFER_EXPIRY_HOURS without an answer.
+// Uses Redis distributed lock to prevent duplicate processing across multiple instances.
+type FinalOfferExpiryWorker struct {
+	workflow   *EntValidationCaseWorkflowService
+	ticker     *time.Ticker
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+	started    bool
+	instanceID string
+}
+
+func NewFinalOfferExpiryWorker(workflow *EntValidationCaseWorkflowService) *FinalOfferExpiryWorker {
+	return &FinalOfferExpiryWorker{
+		workflow:   workflow,
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+		instanceID: uuid.New().String(),
+	}
+}
+
+func finalOfferExpiryInterval() time.Duration {
+	const fallback = time.Minute
+	raw := strings.TrimSpace(os.Getenv("FINAL_OFFER_EXPIRY_TICK_SECONDS"))
+	if raw == "" {
+		return fallback
+	}
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds <= 0 {
+		return fallback
+	}
+	if seconds < 15 {
+		seconds = 15
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (w *FinalOfferExpiryWorker) Start() {
+	if w == nil || w.started || w.workflow == nil {
+		return
+	}
+	w.started = true
+	w.ticker = time.NewTicker(finalOfferExpiryInterval())
+
+	go func() {
+		defer close(w.doneCh)
+		w.runIteration()
+		for {
+			select {
+			case <-w.ticker.C:
+				w.runIteration()
+			case <-w.stopCh:
+				if w.ticker != nil {
+					w.ticker.Stop()
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (w *FinalOfferExpiryWorker) runIteration() {
+	lockCtx, lockCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	acquired, err := AcquireLock(lockCtx, finalOfferExpiryLockKey, finalOfferExpiryLockTTL)
+	lockCancel()
+	if err != nil {
+		logger.Warn("Final offer expiry worker failed to check distributed lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		logger.Debug("Final offer expiry worker skipping iteration: another instance holds the lock",
+			zap.String("instance_id", w.instanceID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	expired, err := w.workflow.ExpireFinalOffers(ctx)
+	cancel()
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_ = ReleaseLock(releaseCtx, finalOfferExpiryLockKey)
+	releaseCancel()
+
+	if err != nil {
+		logger.Warn("Final offer expiry worker iteration failed", zap.Error(err))
+		return
+	}
+	if expired > 0 {
+		logger.Info("Final offer expiry worker expired offers", zap.Int("expired", expired))
+	}
+}
+
+func (w *FinalOfferExpiryWorker) Stop() {
+	if w == nil || !w.started {
+		return
+	}
+	close(w.stopCh)
+	<-w.doneCh
+}
@@ -0,0 +1,703 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-gin/ent"
+	"backend-gin/ent/finaloffermilestone"
+	apperrors "backend-gin/errors"
+)
+
+// Milestone escrow. A Final Offer may carry a milestone plan; on acceptance the plan becomes
+// final_offer_milestones rows and the case-level Lock Funds / artifact / release / dispute
+// endpoints are replaced by their per-milestone counterparts.
+const (
+	milestoneStatusPending           = "pending"
+	milestoneStatusFundsLocked       = "funds_locked"
+	milestoneStatusArtifactSubmitted = "artifact_submitted"
+	milestoneStatusReleased          = "released"
+	milestoneStatusDisputed          = "disputed"
+	milestoneStatusRefunded          = "refunded"
+
+	// caseStatusMilestonesInProgress: some milestones are settled, the rest not started yet.
+	caseStatusMilestonesInProgress = "milestones_in_progress"
+
+	finalOfferMinMilestones      = 2
+	finalOfferMaxMilestones      = 10
+	milestoneMinDeliverableChars = 3
+	milestoneMaxDeliverableChars = 500
+)
+
+func milestonePlanToJSON(plan []FinalOfferMilestoneSpec) []map[string]interface{} {
+	if len(plan) == 0 {
+		return nil
+	}
+	out := make([]map[string]interface{}, len(plan))
+	for i, m := range plan {
+		out[i] = map[string]interface{}{
+			"deliverable": m.Deliverable,
+			"amount":      m.Amount,
+			"due_at":      m.DueAt,
+		}
+	}
+	return out
+}
+
+func milestonePlanFromJSON(raw []map[string]interface{}) []FinalOfferMilestoneSpec {
+	if len(raw) == 0 {
+		return nil
+	}
+	out := make([]FinalOfferMilestoneSpec, 0, len(raw))
+	for _, m := range raw {
+		deliverable, _ := m["deliverable"].(string)
+		out = append(out, FinalOfferMilestoneSpec{
+			Deliverable: deliverable,
+			Amount:      jsonInt64(m["amount"]),
+			DueAt:       jsonInt64(m["due_at"]),
+		})
+	}
+	return out
+}
+
+func jsonInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+// normalizeFinalOfferMilestones validates a milestone plan against the offer amount. An empty
+// plan means a single-stage offer.
+func normalizeFinalOfferMilestones(plan []FinalOfferMilestoneSpec, totalAmount int64, now time.Time) ([]FinalOfferMilestoneSpec, error) {
+	if len(plan) == 0 {
+		return nil, nil
+	}
+	if len(plan) < finalOfferMinMilestones || len(plan) > finalOfferMaxMilestones {
+		return nil, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("milestone harus berjumlah %d sampai %d", finalOfferMinMilestones, finalOfferMaxMilestones))
+	}
+
+	out := make([]FinalOfferMilestoneSpec, len(plan))
+	var sum int64
+	var prevDue int64
+	for i, m := range plan {
+		m.Deliverable = strings.TrimSpace(m.Deliverable)
+		n := utf8.RuneCountInString(m.Deliverable)
+		if n < milestoneMinDeliverableChars || n > milestoneMaxDeliverableChars {
+			return nil, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("deliverable milestone %d harus 3 sampai 500 karakter", i+1))
+		}
+		if m.Amount < finalOfferMinAmount {
+			return nil, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("amount milestone %d minimal Rp 10.000", i+1))
+		}
+		if m.DueAt <= now.Unix() {
+			return nil, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("due_at milestone %d harus di masa depan", i+1))
+		}
+		if m.DueAt <= prevDue {
+			return nil, apperrors.ErrInvalidInput.WithDetails("due_at milestone harus berurutan")
+		}
+		prevDue = m.DueAt
+		sum += m.Amount
+		out[i] = m
+	}
+	if sum != totalAmount {
+		return nil, apperrors.ErrInvalidInput.WithDetails("total amount milestone harus sama dengan amount Final Offer")
+	}
+	return out, nil
+}
+
+// deriveCaseStatusFromMilestones maps milestone states to the case status. reopen is true when
+// every milestone was refunded, which sends the case back to open like a single-stage refund.
+func deriveCaseStatusFromMilestones(statuses []string) (status string, reopen bool) {
+	if len(statuses) == 0 {
+		return caseStatusOfferAccepted, false
+	}
+	counts := make(map[string]int, len(statuses))
+	for _, st := range statuses {
+		counts[st]++
+	}
+	switch {
+	case counts[milestoneStatusDisputed] > 0:
+		return caseStatusDisputed, false
+	case counts[milestoneStatusArtifactSubmitted] > 0:
+		return caseStatusArtifactSubmitted, false
+	case counts[milestoneStatusFundsLocked] > 0:
+		return caseStatusFundsLocked, false
+	}
+
+	settled := counts[milestoneStatusReleased] + counts[milestoneStatusRefunded]
+	switch {
+	case settled == len(statuses) && counts[milestoneStatusReleased] > 0:
+		return caseStatusCompleted, false
+	case settled == len(statuses):
+		return caseStatusOpen, true
+	case settled > 0:
+		return caseStatusMilestonesInProgress, false
+	default:
+		return caseStatusOfferAccepted, false
+	}
+}
+
+func buildMilestoneItem(m *ent.FinalOfferMilestone) MilestoneItem {
+	return MilestoneItem{
+		ID:                  uint(m.ID),
+		FinalOfferID:        uint(m.FinalOfferID),
+		Sequence:            m.Sequence,
+		Deliverable:         m.Deliverable,
+		Amount:              m.Amount,
+		DueAt:               m.DueAt.Unix(),
+		Status:              m.Status,
+		EscrowTransferID:    valueOrEmpty(m.EscrowTransferID),
+		ArtifactDocumentID:  valueOrEmpty(m.ArtifactDocumentID),
+		DisputeID:           valueOrEmpty(m.DisputeID),
+		FundsLockedAt:       unixPtr(m.FundsLockedAt),
+		ArtifactSubmittedAt: unixPtr(m.ArtifactSubmittedAt),
+		ReleasedAt:          unixPtr(m.ReleasedAt),
+		DisputedAt:          unixPtr(m.DisputedAt),
+		RefundedAt:          unixPtr(m.RefundedAt),
+		CreatedAt:           m.CreatedAt.Unix(),
+	}
+}
+
+// createMilestonesForAcceptedOffer materializes the offer's milestone plan. No-op for
+// single-stage offers.
+func createMilestonesForAcceptedOffer(ctx context.Context, client *ent.Client, offer *ent.FinalOffer) error {
+	plan := milestonePlanFromJSON(offer.MilestonePlan)
+	if len(plan) == 0 {
+		return nil
+	}
+	builders := make([]*ent.FinalOfferMilestoneCreate, len(plan))
+	for i, m := range plan {
+		builders[i] = client.FinalOfferMilestone.Create().
+			SetFinalOfferID(offer.ID).
+			SetValidationCaseID(offer.ValidationCaseID).
+			SetSequence(i + 1).
+			SetDeliverable(m.Deliverable).
+			SetAmount(m.Amount).
+			SetDueAt(time.Unix(m.DueAt, 0)).
+			SetStatus(milestoneStatusPending)
+	}
+	return client.FinalOfferMilestone.CreateBulk(builders...).Exec(ctx)
+}
+
+// caseMilestones returns the milestones of the case's accepted offer, in order. Empty for
+// single-stage cases.
+func (s *EntValidationCaseWorkflowService) caseMilestones(ctx context.Context, vc *ent.ValidationCase) ([]*ent.FinalOfferMilestone, error) {
+	if vc.AcceptedFinalOfferID == nil || *vc.AcceptedFinalOfferID <= 0 {
+		return nil, nil
+	}
+	milestones, err := s.client.FinalOfferMilestone.Query().
+		Where(finaloffermilestone.FinalOfferIDEQ(*vc.AcceptedFinalOfferID)).
+		Order(ent.Asc(finaloffermilestone.FieldSequence)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	return milestones, nil
+}
+
+// ensureSingleStageCase rejects case-level escrow actions on milestone cases.
+func (s *EntValidationCaseWorkflowService) ensureSingleStageCase(ctx context.Context, vc *ent.ValidationCase) error {
+	if vc.AcceptedFinalOfferID == nil || *vc.AcceptedFinalOfferID <= 0 {
+		return nil
+	}
+	exists, err := s.client.FinalOfferMilestone.Query().
+		Where(finaloffermilestone.FinalOfferIDEQ(*vc.AcceptedFinalOfferID)).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if exists {
+		return apperrors.ErrInvalidInput.WithDetails("kasus ini menggunakan milestone. Gunakan endpoint milestone.")
+	}
+	return nil
+}
+
+// loadCaseMilestone loads a milestone of the case's accepted offer and checks that userID is
+// the case owner (ownerOnly) or the accepted validator.
+func (s *EntValidationCaseWorkflowService) loadCaseMilestone(ctx context.Context, validationCaseID uint, userID uint, milestoneID uint, ownerOnly bool) (*ent.ValidationCase, *ent.FinalOffer, *ent.FinalOfferMilestone, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, nil, nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, nil, nil, err
+	}
+	if vc.AcceptedFinalOfferID == nil || *vc.AcceptedFinalOfferID <= 0 {
+		return nil, nil, nil, apperrors.ErrInvalidInput.WithDetails("Final Offer belum diterima")
+	}
+	offer, err := s.client.FinalOffer.Get(ctx, *vc.AcceptedFinalOfferID)
+	if err != nil {
+		return nil, nil, nil, apperrors.ErrDatabase
+	}
+
+	isOwner := vc.UserID == int(userID)
+	isValidator := offer.ValidatorUserID == int(userID)
+	if ownerOnly && !isOwner {
+		return nil, nil, nil, apperrors.ErrValidationCaseOwnership
+	}
+	if !isOwner && !isValidator {
+		return nil, nil, nil, apperrors.ErrValidationCaseOwnership
+	}
+
+	m, err := s.client.FinalOfferMilestone.Query().
+		Where(
+			finaloffermilestone.IDEQ(int(milestoneID)),
+			finaloffermilestone.FinalOfferIDEQ(offer.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil, apperrors.ErrInvalidInput.WithDetails("milestone tidak ditemukan")
+		}
+		return nil, nil, nil, apperrors.ErrDatabase
+	}
+	return vc, offer, m, nil
+}
+
+// ListMilestones returns the milestones of the accepted offer to the owner or the validator.
+func (s *EntValidationCaseWorkflowService) ListMilestones(ctx context.Context, validationCaseID uint, viewerUserID uint) ([]MilestoneItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if vc.AcceptedFinalOfferID == nil || *vc.AcceptedFinalOfferID <= 0 {
+		return []MilestoneItem{}, nil
+	}
+	if vc.UserID != int(viewerUserID) {
+		offer, err := s.client.FinalOffer.Get(ctx, *vc.AcceptedFinalOfferID)
+		if err != nil {
+			return nil, apperrors.ErrDatabase
+		}
+		if offer.ValidatorUserID != int(viewerUserID) {
+			return nil, apperrors.ErrValidationCaseOwnership
+		}
+	}
+
+	milestones, err := s.caseMilestones(ctx, vc)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]MilestoneItem, 0, len(milestones))
+	for _, m := range milestones {
+		out = append(out, buildMilestoneItem(m))
+	}
+	return out, nil
+}
+
+// ConfirmMilestoneLockFunds links a Feature Service escrow transfer to one milestone. Milestones
+// are funded in order.
+func (s *EntValidationCaseWorkflowService) ConfirmMilestoneLockFunds(ctx context.Context, validationCaseID uint, ownerUserID uint, milestoneID uint, transferID string, authHeader string) error {
+	transferID = strings.TrimSpace(transferID)
+	if transferID == "" {
+		return apperrors.ErrMissingField.WithDetails("transfer_id")
+	}
+	vc, offer, m, err := s.loadCaseMilestone(ctx, validationCaseID, ownerUserID, milestoneID, true)
+	if err != nil {
+		return err
+	}
+	if offer.WorkflowCycle != currentWorkflowCycle(vc) {
+		return apperrors.ErrInvalidInput.WithDetails("Final Offer berasal dari siklus workflow sebelumnya")
+	}
+	if m.Status != milestoneStatusPending {
+		return apperrors.ErrInvalidInput.WithDetails("Lock Funds sudah dikonfirmasi untuk milestone ini")
+	}
+	unfundedBefore, err := s.client.FinalOfferMilestone.Query().
+		Where(
+			finaloffermilestone.FinalOfferIDEQ(offer.ID),
+			finaloffermilestone.SequenceLT(m.Sequence),
+			finaloffermilestone.StatusEQ(milestoneStatusPending),
+		).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if unfundedBefore {
+		return apperrors.ErrInvalidInput.WithDetails("Lock Funds milestone sebelumnya terlebih dahulu")
+	}
+
+	ft, err := s.getFeatureTransfer(ctx, authHeader, transferID)
+	if err != nil {
+		return apperrors.ErrInvalidInput.WithDetails(err.Error())
+	}
+	if ft.SenderID != ownerUserID {
+		return apperrors.ErrInvalidInput.WithDetails("transfer_id tidak valid (sender tidak sesuai)")
+	}
+	if ft.ReceiverID != uint(offer.ValidatorUserID) {
+		return apperrors.ErrInvalidInput.WithDetails("transfer_id tidak valid (receiver tidak sesuai)")
+	}
+	if ft.Amount != m.Amount {
+		return apperrors.ErrInvalidInput.WithDetails("transfer_id tidak valid (amount tidak sesuai)")
+	}
+	if normalizeStatus(ft.Status) != "pending" {
+		return apperrors.ErrInvalidInput.WithDetails("transfer harus berstatus pending untuk Lock Funds")
+	}
+
+	lockedAt := time.Now()
+	updated, err := s.client.FinalOfferMilestone.Update().
+		Where(
+			finaloffermilestone.IDEQ(m.ID),
+			finaloffermilestone.StatusEQ(milestoneStatusPending),
+		).
+		SetEscrowTransferID(transferID).
+		SetStatus(milestoneStatusFundsLocked).
+		SetFundsLockedAt(lockedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return apperrors.ErrInvalidInput.WithDetails("transfer_id sudah digunakan")
+		}
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return apperrors.ErrInvalidInput.WithDetails("Lock Funds sudah dikonfirmasi untuk milestone ini")
+	}
+
+	actorID := int(ownerUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "milestone_funds_locked", map[string]interface{}{
+		"milestone_id":       m.ID,
+		"sequence":           m.Sequence,
+		"escrow_transfer_id": transferID,
+		"amount":             m.Amount,
+		"final_offer_id":     offer.ID,
+	})
+	s.startValidatorDeliverySLA(ctx, vc, offer, m, transferID, lockedAt)
+	return s.syncCaseStatusFromMilestones(ctx, vc.ID, "milestone_funds_locked")
+}
+
+// SubmitMilestoneArtifact records the validator's deliverable for a funded milestone. An empty
+// documentID records a manual submission, as for single-stage cases.
+func (s *EntValidationCaseWorkflowService) SubmitMilestoneArtifact(ctx context.Context, validationCaseID uint, validatorUserID uint, milestoneID uint, documentID string, authHeader string) error {
+	vc, offer, m, err := s.loadCaseMilestone(ctx, validationCaseID, validatorUserID, milestoneID, false)
+	if err != nil {
+		return err
+	}
+	if offer.ValidatorUserID != int(validatorUserID) {
+		return apperrors.ErrArtifactSubmissionAccessDenied
+	}
+	switch m.Status {
+	case milestoneStatusFundsLocked:
+	case milestoneStatusPending:
+		return apperrors.ErrInvalidInput.WithDetails("Lock Funds milestone belum dilakukan")
+	default:
+		return apperrors.ErrInvalidInput.WithDetails("Artifact Submission milestone sudah ada")
+	}
+
+	documentID = strings.TrimSpace(documentID)
+	isManualSubmission := false
+	if documentID == "" {
+		documentID = fmt.Sprintf("artifact-submission-auto-%d-m%d-%d-%d", vc.ID, m.Sequence, validatorUserID, time.Now().Unix())
+		isManualSubmission = true
+	}
+	if !isManualSubmission {
+		if err := s.shareDocumentWithUser(ctx, authHeader, documentID, uint(vc.UserID)); err != nil {
+			return apperrors.ErrInvalidInput.WithDetails(err.Error())
+		}
+	}
+
+	submittedAt := time.Now()
+	updated, err := s.client.FinalOfferMilestone.Update().
+		Where(
+			finaloffermilestone.IDEQ(m.ID),
+			finaloffermilestone.StatusEQ(milestoneStatusFundsLocked),
+		).
+		SetArtifactDocumentID(documentID).
+		SetStatus(milestoneStatusArtifactSubmitted).
+		SetArtifactSubmittedAt(submittedAt).
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return apperrors.ErrInvalidInput.WithDetails("Artifact Submission milestone sudah ada")
+	}
+
+	actorID := int(validatorUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "milestone_artifact_submitted", map[string]interface{}{
+		"milestone_id":      m.ID,
+		"sequence":          m.Sequence,
+		"document_id":       documentID,
+		"manual_submission": isManualSubmission,
+	})
+	s.markValidatorDeliverySLADelivered(ctx, vc.ID, valueOrEmpty(m.EscrowTransferID), submittedAt)
+	return s.syncCaseStatusFromMilestones(ctx, vc.ID, "milestone_artifact_submitted")
+}
+
+// MarkMilestoneEscrowReleased confirms the owner released a milestone's escrow in Feature Service.
+func (s *EntValidationCaseWorkflowService) MarkMilestoneEscrowReleased(ctx context.Context, validationCaseID uint, ownerUserID uint, milestoneID uint, authHeader string) error {
+	vc, _, m, err := s.loadCaseMilestone(ctx, validationCaseID, ownerUserID, milestoneID, true)
+	if err != nil {
+		return err
+	}
+	if m.Status == milestoneStatusReleased {
+		return nil
+	}
+	if m.Status != milestoneStatusArtifactSubmitted {
+		return apperrors.ErrInvalidInput.WithDetails("Artifact Submission milestone belum ada")
+	}
+
+	ft, err := s.getFeatureTransfer(ctx, authHeader, valueOrEmpty(m.EscrowTransferID))
+	if err != nil {
+		return apperrors.ErrInvalidInput.WithDetails(err.Error())
+	}
+	if normalizeStatus(ft.Status) != "released" {
+		return apperrors.ErrInvalidInput.WithDetails("transfer belum berstatus released")
+	}
+
+	actorID := int(ownerUserID)
+	return s.releaseMilestone(ctx, vc.ID, m, &actorID, "")
+}
+
+// AttachMilestoneDispute scopes a Feature Service dispute to one milestone. Other milestones
+// continue independently.
+func (s *EntValidationCaseWorkflowService) AttachMilestoneDispute(ctx context.Context, validationCaseID uint, ownerUserID uint, milestoneID uint, disputeID string, authHeader string) error {
+	disputeID = strings.TrimSpace(disputeID)
+	if disputeID == "" {
+		return apperrors.ErrMissingField.WithDetails("dispute_id")
+	}
+	vc, _, m, err := s.loadCaseMilestone(ctx, validationCaseID, ownerUserID, milestoneID, true)
+	if err != nil {
+		return err
+	}
+	switch m.Status {
+	case milestoneStatusFundsLocked, milestoneStatusArtifactSubmitted:
+	case milestoneStatusDisputed:
+		return apperrors.ErrInvalidInput.WithDetails("Dispute sudah terpasang pada milestone ini")
+	default:
+		return apperrors.ErrInvalidInput.WithDetails("milestone tidak dapat didispute pada status " + m.Status)
+	}
+
+	transferID := valueOrEmpty(m.EscrowTransferID)
+	fd, err := s.getFeatureDispute(ctx, authHeader, disputeID)
+	if err != nil {
+		return apperrors.ErrInvalidInput.WithDetails(err.Error())
+	}
+	if strings.TrimSpace(fd.TransferID) != transferID {
+		return apperrors.ErrInvalidInput.WithDetails("dispute_id tidak sesuai dengan escrow_transfer_id milestone ini")
+	}
+
+	updated, err := s.client.FinalOfferMilestone.Update().
+		Where(
+			finaloffermilestone.IDEQ(m.ID),
+			finaloffermilestone.StatusIn(milestoneStatusFundsLocked, milestoneStatusArtifactSubmitted),
+		).
+		SetDisputeID(disputeID).
+		SetStatus(milestoneStatusDisputed).
+		SetDisputedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return apperrors.ErrInvalidInput.WithDetails("status milestone berubah. Muat ulang halaman lalu coba lagi.")
+	}
+
+	actorID := int(ownerUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "milestone_dispute_attached", map[string]interface{}{
+		"milestone_id":   m.ID,
+		"sequence":       m.Sequence,
+		"dispute_id":     disputeID,
+		"transfer_id":    transferID,
+		"dispute_status": fd.Status,
+	})
+	s.cancelValidatorDeliverySLAs(ctx, vc.ID, transferID, "milestone_dispute_attached")
+	return s.syncCaseStatusFromMilestones(ctx, vc.ID, "milestone_dispute_attached")
+}
+
+// releaseMilestone marks a milestone released. source is empty for owner confirmations.
+func (s *EntValidationCaseWorkflowService) releaseMilestone(ctx context.Context, validationCaseID int, m *ent.FinalOfferMilestone, actorUserID *int, source string) error {
+	updated, err := s.client.FinalOfferMilestone.Update().
+		Where(
+			finaloffermilestone.IDEQ(m.ID),
+			finaloffermilestone.StatusIn(milestoneStatusArtifactSubmitted, milestoneStatusDisputed),
+		).
+		SetStatus(milestoneStatusReleased).
+		SetReleasedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		// Already released by a concurrent confirmation.
+		return nil
+	}
+
+	detail := map[string]interface{}{
+		"milestone_id":       m.ID,
+		"sequence":           m.Sequence,
+		"escrow_transfer_id": valueOrEmpty(m.EscrowTransferID),
+	}
+	if source != "" {
+		detail["source"] = source
+	}
+	s.appendCaseLogBestEffort(ctx, validationCaseID, actorUserID, "milestone_escrow_released", detail)
+	return s.syncCaseStatusFromMilestones(ctx, validationCaseID, "milestone_escrow_released")
+}
+
+// settleMilestoneDispute applies a Feature Service dispute settlement to one milestone.
+func (s *EntValidationCaseWorkflowService) settleMilestoneDispute(ctx context.Context, m *ent.FinalOfferMilestone, disputeID string, outcome string, source string) error {
+	current := strings.TrimSpace(valueOrEmpty(m.DisputeID))
+	if current != "" && current != disputeID {
+		return apperrors.ErrInvalidInput.WithDetails("dispute_id tidak sesuai dengan transfer pada milestone ini")
+	}
+
+	now := time.Now()
+	update := s.client.FinalOfferMilestone.Update().
+		Where(
+			finaloffermilestone.IDEQ(m.ID),
+			finaloffermilestone.StatusNotIn(milestoneStatusReleased, milestoneStatusRefunded),
+		).
+		SetDisputeID(disputeID)
+	switch outcome {
+	case disputeSettlementOutcomeOwnerRefund:
+		update.SetStatus(milestoneStatusRefunded).SetRefundedAt(now)
+	case disputeSettlementOutcomeValidatorRelease:
+		update.SetStatus(milestoneStatusReleased).SetReleasedAt(now)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		// Already settled.
+		return nil
+	}
+
+	s.appendCaseLogBestEffort(ctx, m.ValidationCaseID, nil, "milestone_dispute_settled", map[string]interface{}{
+		"milestone_id": m.ID,
+		"sequence":     m.Sequence,
+		"transfer_id":  valueOrEmpty(m.EscrowTransferID),
+		"dispute_id":   disputeID,
+		"outcome":      outcome,
+		"source":       source,
+	})
+	offerID := m.FinalOfferID
+	s.recordDisputeReputationBestEffort(ctx, &offerID, disputeID, outcome, source)
+	if outcome == disputeSettlementOutcomeOwnerRefund {
+		milestoneID := m.ID
+		s.scheduleGuaranteeSlashBestEffort(ctx, offerID, &milestoneID, disputeID)
+	}
+	return s.syncCaseStatusFromMilestones(ctx, m.ValidationCaseID, "milestone_dispute_settled")
+}
+
+// markMilestoneReleasedInternalByTransferID handles Feature Service auto-release of a milestone
+// escrow. Like the case-level variant it trusts the internal caller and checks local state only.
+func (s *EntValidationCaseWorkflowService) markMilestoneReleasedInternalByTransferID(ctx context.Context, transferID string) (*int, error) {
+	m, err := s.client.FinalOfferMilestone.Query().
+		Where(finaloffermilestone.EscrowTransferIDEQ(transferID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	caseID := m.ValidationCaseID
+	switch m.Status {
+	case milestoneStatusReleased:
+		return &caseID, nil
+	case milestoneStatusArtifactSubmitted:
+	default:
+		return nil, apperrors.ErrInvalidInput.WithDetails("Artifact Submission milestone belum ada")
+	}
+	if err := s.releaseMilestone(ctx, caseID, m, nil, "feature_service_auto_release"); err != nil {
+		return nil, err
+	}
+	return &caseID, nil
+}
+
+func (s *EntValidationCaseWorkflowService) settleMilestoneDisputeInternalByTransferID(ctx context.Context, transferID string, disputeID string, outcome string, source string) (*int, error) {
+	m, err := s.client.FinalOfferMilestone.Query().
+		Where(finaloffermilestone.EscrowTransferIDEQ(transferID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	caseID := m.ValidationCaseID
+	if err := s.settleMilestoneDispute(ctx, m, disputeID, outcome, source); err != nil {
+		return nil, err
+	}
+	return &caseID, nil
+}
+
+// syncCaseStatusFromMilestones recomputes the case status from its milestones. When every
+// milestone is settled the last released artifact becomes the certified artifact; when every
+// milestone was refunded the case reopens for a new workflow cycle.
+func (s *EntValidationCaseWorkflowService) syncCaseStatusFromMilestones(ctx context.Context, validationCaseID int, reason string) error {
+	vc, err := s.client.ValidationCase.Get(ctx, validationCaseID)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	milestones, err := s.caseMilestones(ctx, vc)
+	if err != nil {
+		return err
+	}
+	if len(milestones) == 0 {
+		return nil
+	}
+
+	statuses := make([]string, len(milestones))
+	certified := ""
+	for i, m := range milestones {
+		statuses[i] = m.Status
+		if m.Status == milestoneStatusReleased && m.ArtifactDocumentID != nil {
+			certified = strings.TrimSpace(*m.ArtifactDocumentID)
+		}
+	}
+	next, reopen := deriveCaseStatusFromMilestones(statuses)
+	previous := normalizeStatus(vc.Status)
+	if reopen {
+		nextCycle, err := s.clearCaseForNextCycle(ctx, vc)
+		if err != nil {
+			return err
+		}
+		s.logCaseReopened(ctx, vc.ID, previous, currentWorkflowCycle(vc), nextCycle, "milestones_refunded_to_owner")
+		return nil
+	}
+	if next == previous {
+		return nil
+	}
+
+	update := s.client.ValidationCase.UpdateOneID(vc.ID).SetStatus(next)
+	if next == caseStatusCompleted && certified != "" {
+		update.SetCertifiedArtifactDocumentID(certified)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return apperrors.ErrDatabase
+	}
+	s.appendCaseLogBestEffort(ctx, vc.ID, nil, "case_status_changed", map[string]interface{}{
+		"from":   previous,
+		"to":     next,
+		"reason": reason,
+	})
+	if next == caseStatusCompleted && certified != "" {
+		s.appendCaseLogBestEffort(ctx, vc.ID, nil, "certified_artifact_issued", map[string]interface{}{
+			"certified_artifact_document_id": certified,
+		})
+	}
+	if next == caseStatusCompleted {
+		offerID := milestones[0].FinalOfferID
+		s.recordCaseCompletedReputationBestEffort(ctx, &offerID, reason)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeFinalOfferMilestones(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	day := int64(24 * 60 * 60)
+	plan := []FinalOfferMilestoneSpec{
+		{Deliverable: "  Audit awal  ", Amount: 100_000, DueAt: now.Unix() + day},
+		{Deliverable: "Laporan akhir", Amount: 150_000, DueAt: now.Unix() + 3*day},
+	}
+
+	out, err := normalizeFinalOfferMilestones(plan, 250_000, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Audit awal", out[0].Deliverable)
+
+	out, err = normalizeFinalOfferMilestones(nil, 250_000, now)
+	require.NoError(t, err)
+	assert.Nil(t, out)
+
+	_, err = normalizeFinalOfferMilestones(plan[:1], 100_000, now)
+	assert.Error(t, err, "single milestone")
+	_, err = normalizeFinalOfferMilestones(plan, 300_000, now)
+	assert.Error(t, err, "sum mismatch")
+
+	unordered := []FinalOfferMilestoneSpec{plan[1], plan[0]}
+	_, err = normalizeFinalOfferMilestones(unordered, 250_000, now)
+	assert.Error(t, err, "due dates out of order")
+
+	past := []FinalOfferMilestoneSpec{{Deliverable: "Audit", Amount: 100_000, DueAt: now.Unix()}, plan[1]}
+	_, err = normalizeFinalOfferMilestones(past, 250_000, now)
+	assert.Error(t, err, "due date not in the future")
+}
+
+func TestMilestonePlanJSONRoundTrip(t *testing.T) {
+	plan := []FinalOfferMilestoneSpec{
+		{Deliverable: "Audit awal", Amount: 100_000, DueAt: 1_800_000_000},
+		{Deliverable: "Laporan akhir", Amount: 150_000, DueAt: 1_800_086_400},
+	}
+	// Stored plans come back from Postgres as decoded JSON (numbers are float64).
+	raw, err := json.Marshal(milestonePlanToJSON(plan))
+	require.NoError(t, err)
+	var stored []map[string]interface{}
+	require.NoError(t, json.Unmarshal(raw, &stored))
+
+	assert.Equal(t, plan, milestonePlanFromJSON(stored))
+	assert.Nil(t, milestonePlanToJSON(nil))
+}
+
+func TestDeriveCaseStatusFromMilestones(t *testing.T) {
+	cases := []struct {
+		name     string
+		statuses []string
+		status   string
+		reopen   bool
+	}{
+		{"none funded", []string{milestoneStatusPending, milestoneStatusPending}, caseStatusOfferAccepted, false},
+		{"first funded", []string{milestoneStatusFundsLocked, milestoneStatusPending}, caseStatusFundsLocked, false},
+		{"artifact wins over funded", []string{milestoneStatusArtifactSubmitted, milestoneStatusFundsLocked}, caseStatusArtifactSubmitted, false},
+		{"dispute wins", []string{milestoneStatusReleased, milestoneStatusDisputed, milestoneStatusFundsLocked}, caseStatusDisputed, false},
+		{"partially released", []string{milestoneStatusReleased, milestoneStatusPending}, caseStatusMilestonesInProgress, false},
+		{"all released", []string{milestoneStatusReleased, milestoneStatusReleased}, caseStatusCompleted, false},
+		{"released and refunded", []string{milestoneStatusReleased, milestoneStatusRefunded}, caseStatusCompleted, false},
+		{"all refunded", []string{milestoneStatusRefunded, milestoneStatusRefunded}, caseStatusOpen, true},
+	}
+	for _, tc := range cases {
+		status, reopen := deriveCaseStatusFromMilestones(tc.statuses)
+		assert.Equal(t, tc.status, status, tc.name)
+		assert.Equal(t, tc.reopen, reopen, tc.name)
+	}
+}
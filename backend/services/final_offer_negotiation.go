@@ -0,0 +1,505 @@
+package services
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-gin/ent"
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finalofferrevision"
+	apperrors "backend-gin/errors"
+)
+
+// Final Offer negotiation states. An offer is open for negotiation while it is
+// "submitted" (validator's proposal is latest) or "countered" (owner's proposal is latest).
+const (
+	finalOfferStatusSubmitted              = "submitted"
+	finalOfferStatusCountered              = "countered"
+	finalOfferStatusWithdrawn              = "withdrawn"
+	finalOfferStatusExpired                = "expired"
+	finalOfferStatusAcceptedPendingFunding = "accepted_pending_funding"
+
+	finalOfferRoleValidator = "validator"
+	finalOfferRoleOwner     = "owner"
+
+	finalOfferActionSubmitted   = "submitted"
+	finalOfferActionCountered   = "countered"
+	finalOfferActionRevised     = "revised"
+	finalOfferActionResubmitted = "resubmitted"
+
+	finalOfferMinAmount     int64 = 10_000
+	finalOfferMaxTermsChars       = 5000
+	finalOfferMaxNoteChars        = 1000
+)
+
+// FinalOfferProposal is a counter-offer or revision. ExpectedRevision guards against acting
+// on a stale view of the thread; zero skips the check. A nil Milestones keeps the current
+// milestone plan; an empty one turns the offer into a single-stage offer.
+type FinalOfferProposal struct {
+	Amount           int64
+	HoldHours        int
+	Terms            string
+	Note             string
+	Milestones       []FinalOfferMilestoneSpec
+	ExpectedRevision int
+}
+
+// finalOfferExpiryTTL is how long a proposal stays open before the expiry worker closes it.
+func finalOfferExpiryTTL() time.Duration {
+	const fallback = 72
+	hours := fallback
+	if raw := strings.TrimSpace(os.Getenv("FINAL_OFFER_EXPIRY_HOURS")); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+			hours = v
+		}
+	}
+	if hours > 30*24 {
+		hours = 30 * 24
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// normalizeFinalOfferHoldHours applies the default escrow hold window and rejects values
+// outside the discrete options: 1 day 8 hours (light), 7 days (standard), 30 days (long).
+func normalizeFinalOfferHoldHours(holdHours int) (int, error) {
+	if holdHours <= 0 {
+		holdHours = 7 * 24
+	}
+	switch holdHours {
+	case 32, 7 * 24, 30 * 24:
+		return holdHours, nil
+	default:
+		return 0, apperrors.ErrInvalidInput.WithDetails("hold_hours harus 32, 168, atau 720")
+	}
+}
+
+func normalizeFinalOfferProposal(p FinalOfferProposal) (FinalOfferProposal, error) {
+	if p.Amount < finalOfferMinAmount {
+		return p, apperrors.ErrInvalidInput.WithDetails("amount minimal Rp 10.000")
+	}
+	holdHours, err := normalizeFinalOfferHoldHours(p.HoldHours)
+	if err != nil {
+		return p, err
+	}
+	p.HoldHours = holdHours
+	p.Terms = strings.TrimSpace(p.Terms)
+	p.Note = strings.TrimSpace(p.Note)
+	if utf8.RuneCountInString(p.Terms) > finalOfferMaxTermsChars {
+		return p, apperrors.ErrInvalidInput.WithDetails("terms maksimal 5000 karakter")
+	}
+	if utf8.RuneCountInString(p.Note) > finalOfferMaxNoteChars {
+		return p, apperrors.ErrInvalidInput.WithDetails("note maksimal 1000 karakter")
+	}
+	if p.ExpectedRevision < 0 {
+		return p, apperrors.ErrInvalidInput.WithDetails("revision tidak valid")
+	}
+	return p, nil
+}
+
+// finalOfferNextAction returns the revision action a party may take on an offer in status,
+// and the offer status after it. Owners counter the validator's latest proposal; validators
+// revise while negotiating and resubmit after withdrawal or expiry.
+func finalOfferNextAction(status, role string) (action string, next string, ok bool) {
+	switch role {
+	case finalOfferRoleOwner:
+		if status == finalOfferStatusSubmitted {
+			return finalOfferActionCountered, finalOfferStatusCountered, true
+		}
+	case finalOfferRoleValidator:
+		switch status {
+		case finalOfferStatusSubmitted, finalOfferStatusCountered:
+			return finalOfferActionRevised, finalOfferStatusSubmitted, true
+		case finalOfferStatusWithdrawn, finalOfferStatusExpired:
+			return finalOfferActionResubmitted, finalOfferStatusSubmitted, true
+		}
+	}
+	return "", "", false
+}
+
+func finalOfferIsNegotiable(status string) bool {
+	return status == finalOfferStatusSubmitted || status == finalOfferStatusCountered
+}
+
+func finalOfferExpired(offer *ent.FinalOffer, now time.Time) bool {
+	return offer.ExpiresAt != nil && !offer.ExpiresAt.After(now)
+}
+
+func errFinalOfferStale() error {
+	return apperrors.ErrInvalidInput.WithDetails("Final Offer sudah berubah. Muat ulang halaman lalu coba lagi.")
+}
+
+func buildFinalOfferRevisionItem(r *ent.FinalOfferRevision) FinalOfferRevisionItem {
+	return FinalOfferRevisionItem{
+		ID:           uint(r.ID),
+		Revision:     r.Revision,
+		ProposerRole: r.ProposerRole,
+		ProposedBy:   uint(r.ProposedByUserID),
+		Action:       r.Action,
+		Amount:       r.Amount,
+		HoldHours:    r.HoldHours,
+		Terms:        r.Terms,
+		Note:         r.Note,
+		Milestones:   milestonePlanFromJSON(r.MilestonePlan),
+		CreatedAt:    r.CreatedAt.Unix(),
+	}
+}
+
+// loadFinalOfferForParty loads an offer of the case's current cycle and
+// resolves the caller's role in its thread.
+func (s *EntValidationCaseWorkflowService) loadFinalOfferForParty(ctx context.Context, validationCaseID uint, userID uint, offerID uint) (*ent.ValidationCase, *ent.FinalOffer, string, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, "", apperrors.ErrValidationCaseNotFound
+		}
+		return nil, nil, "", apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, nil, "", err
+	}
+
+	offer, err := s.client.FinalOffer.Query().
+		Where(
+			finaloffer.IDEQ(int(offerID)),
+			finaloffer.ValidationCaseIDEQ(vc.ID),
+			finaloffer.WorkflowCycleEQ(currentWorkflowCycle(vc)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, "", apperrors.ErrInvalidInput.WithDetails("Final Offer tidak ditemukan")
+		}
+		return nil, nil, "", apperrors.ErrDatabase
+	}
+
+	switch int(userID) {
+	case vc.UserID:
+		return vc, offer, finalOfferRoleOwner, nil
+	case offer.ValidatorUserID:
+		return vc, offer, finalOfferRoleValidator, nil
+	default:
+		return nil, nil, "", apperrors.ErrInvalidInput.WithDetails("Final Offer tidak ditemukan")
+	}
+}
+
+// CounterFinalOffer records the owner's counter-offer on the validator's latest proposal.
+func (s *EntValidationCaseWorkflowService) CounterFinalOffer(ctx context.Context, validationCaseID uint, ownerUserID uint, offerID uint, p FinalOfferProposal) (*FinalOfferRevisionItem, error) {
+	vc, offer, role, err := s.loadFinalOfferForParty(ctx, validationCaseID, ownerUserID, offerID)
+	if err != nil {
+		return nil, err
+	}
+	if role != finalOfferRoleOwner {
+		return nil, apperrors.ErrValidationCaseOwnership
+	}
+	return s.proposeFinalOfferRevision(ctx, vc, offer, ownerUserID, role, p)
+}
+
+// ReviseFinalOffer records the validator's new proposal: a reply to a counter-offer, a change
+// to their own pending proposal, or a resubmission after withdrawal or expiry.
+func (s *EntValidationCaseWorkflowService) ReviseFinalOffer(ctx context.Context, validationCaseID uint, validatorUserID uint, offerID uint, p FinalOfferProposal) (*FinalOfferRevisionItem, error) {
+	vc, offer, role, err := s.loadFinalOfferForParty(ctx, validationCaseID, validatorUserID, offerID)
+	if err != nil {
+		return nil, err
+	}
+	if role != finalOfferRoleValidator {
+		return nil, apperrors.ErrInvalidInput.WithDetails("hanya validator pengaju yang dapat merevisi Final Offer")
+	}
+	return s.proposeFinalOfferRevision(ctx, vc, offer, validatorUserID, role, p)
+}
+
+func (s *EntValidationCaseWorkflowService) proposeFinalOfferRevision(ctx context.Context, vc *ent.ValidationCase, offer *ent.FinalOffer, actorUserID uint, role string, p FinalOfferProposal) (*FinalOfferRevisionItem, error) {
+	p, err := normalizeFinalOfferProposal(p)
+	if err != nil {
+		return nil, err
+	}
+	if normalizeStatus(vc.Status) != caseStatusOpen || vc.AcceptedFinalOfferID != nil {
+		return nil, apperrors.ErrInvalidInput.WithDetails("negosiasi Final Offer hanya dapat dilakukan saat status kasus open")
+	}
+	if p.ExpectedRevision > 0 && p.ExpectedRevision != offer.CurrentRevision {
+		return nil, errFinalOfferStale()
+	}
+
+	now := time.Now()
+	plan := p.Milestones
+	if plan == nil {
+		plan = milestonePlanFromJSON(offer.MilestonePlan)
+	}
+	plan, err = normalizeFinalOfferMilestones(plan, p.Amount, now)
+	if err != nil {
+		return nil, err
+	}
+	status := normalizeStatus(offer.Status)
+	if finalOfferIsNegotiable(status) && finalOfferExpired(offer, now) {
+		// The worker has not closed it yet; treat it as expired.
+		status = finalOfferStatusExpired
+	}
+	action, nextStatus, ok := finalOfferNextAction(status, role)
+	if !ok {
+		if role == finalOfferRoleOwner && status == finalOfferStatusCountered {
+			return nil, apperrors.ErrInvalidInput.WithDetails("menunggu tanggapan validator atas counter-offer sebelumnya")
+		}
+		return nil, apperrors.ErrInvalidInput.WithDetails("Final Offer tidak dapat dinegosiasikan pada status " + status)
+	}
+	expiresAt := now.Add(finalOfferExpiryTTL())
+	revision := offer.CurrentRevision + 1
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	// Offers submitted before negotiation existed have no revision rows yet.
+	if err := backfillInitialFinalOfferRevision(ctx, tx.Client(), offer); err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+
+	offerUpdate := tx.FinalOffer.Update().
+		Where(
+			finaloffer.IDEQ(offer.ID),
+			finaloffer.CurrentRevisionEQ(offer.CurrentRevision),
+			finaloffer.StatusEQ(offer.Status),
+			finaloffer.AcceptedAtIsNil(),
+		)
+	if len(plan) > 0 {
+		offerUpdate.SetMilestonePlan(milestonePlanToJSON(plan))
+	} else {
+		offerUpdate.ClearMilestonePlan()
+	}
+	updated, err := offerUpdate.
+		SetCurrentRevision(revision).
+		SetLastProposedBy(role).
+		SetStatus(nextStatus).
+		SetAmount(p.Amount).
+		SetHoldHours(p.HoldHours).
+		SetTerms(p.Terms).
+		SetExpiresAt(expiresAt).
+		ClearWithdrawnAt().
+		ClearExpiredAt().
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		_ = tx.Rollback()
+		return nil, errFinalOfferStale()
+	}
+
+	revCreate := tx.FinalOfferRevision.Create()
+	if len(plan) > 0 {
+		revCreate.SetMilestonePlan(milestonePlanToJSON(plan))
+	}
+	rev, err := revCreate.
+		SetFinalOfferID(offer.ID).
+		SetRevision(revision).
+		SetProposedByUserID(int(actorUserID)).
+		SetProposerRole(role).
+		SetAction(action).
+		SetAmount(p.Amount).
+		SetHoldHours(p.HoldHours).
+		SetTerms(p.Terms).
+		SetNote(p.Note).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, errFinalOfferStale()
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	actorID := int(actorUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "final_offer_"+action, map[string]interface{}{
+		"final_offer_id":    offer.ID,
+		"validator_user_id": offer.ValidatorUserID,
+		"revision":          revision,
+		"amount":            p.Amount,
+		"hold_hours":        p.HoldHours,
+		"milestones":        len(plan),
+		"expires_at_unix":   expiresAt.Unix(),
+	})
+
+	item := buildFinalOfferRevisionItem(rev)
+	return &item, nil
+}
+
+// WithdrawFinalOffer lets the validator pull an offer that is still under negotiation.
+func (s *EntValidationCaseWorkflowService) WithdrawFinalOffer(ctx context.Context, validationCaseID uint, validatorUserID uint, offerID uint, reason string) error {
+	vc, offer, role, err := s.loadFinalOfferForParty(ctx, validationCaseID, validatorUserID, offerID)
+	if err != nil {
+		return err
+	}
+	if role != finalOfferRoleValidator {
+		return apperrors.ErrInvalidInput.WithDetails("hanya validator pengaju yang dapat menarik Final Offer")
+	}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > finalOfferMaxNoteChars {
+		return apperrors.ErrInvalidInput.WithDetails("reason maksimal 1000 karakter")
+	}
+	if !finalOfferIsNegotiable(normalizeStatus(offer.Status)) {
+		return apperrors.ErrInvalidInput.WithDetails("Final Offer tidak dapat ditarik pada status " + normalizeStatus(offer.Status))
+	}
+
+	now := time.Now()
+	updated, err := s.client.FinalOffer.Update().
+		Where(
+			finaloffer.IDEQ(offer.ID),
+			finaloffer.StatusIn(finalOfferStatusSubmitted, finalOfferStatusCountered),
+			finaloffer.AcceptedAtIsNil(),
+		).
+		SetStatus(finalOfferStatusWithdrawn).
+		SetWithdrawnAt(now).
+		ClearExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return errFinalOfferStale()
+	}
+
+	actorID := int(validatorUserID)
+	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "final_offer_withdrawn", map[string]interface{}{
+		"final_offer_id": offer.ID,
+		"revision":       offer.CurrentRevision,
+		"reason":         reason,
+	})
+	return nil
+}
+
+// ListFinalOfferRevisions returns the negotiation thread of an offer, oldest first. Only the
+// case owner and the offering validator can read it.
+func (s *EntValidationCaseWorkflowService) ListFinalOfferRevisions(ctx context.Context, validationCaseID uint, viewerUserID uint, offerID uint) ([]FinalOfferRevisionItem, error) {
+	_, offer, _, err := s.loadFinalOfferForParty(ctx, validationCaseID, viewerUserID, offerID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.client.FinalOfferRevision.Query().
+		Where(finalofferrevision.FinalOfferIDEQ(offer.ID)).
+		Order(ent.Asc(finalofferrevision.FieldRevision)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+
+	out := make([]FinalOfferRevisionItem, 0, len(revisions)+1)
+	if len(revisions) == 0 || revisions[0].Revision != 1 {
+		// Legacy offer that was never negotiated: its row is the only revision.
+		out = append(out, FinalOfferRevisionItem{
+			Revision:     1,
+			ProposerRole: finalOfferRoleValidator,
+			ProposedBy:   uint(offer.ValidatorUserID),
+			Action:       finalOfferActionSubmitted,
+			Amount:       offer.Amount,
+			HoldHours:    offer.HoldHours,
+			Terms:        offer.Terms,
+			Milestones:   milestonePlanFromJSON(offer.MilestonePlan),
+			CreatedAt:    offer.CreatedAt.Unix(),
+		})
+	}
+	for _, r := range revisions {
+		out = append(out, buildFinalOfferRevisionItem(r))
+	}
+	return out, nil
+}
+
+// backfillInitialFinalOfferRevision writes revision 1 for an offer that predates revisions.
+func backfillInitialFinalOfferRevision(ctx context.Context, client *ent.Client, offer *ent.FinalOffer) error {
+	if offer.CurrentRevision != 1 {
+		return nil
+	}
+	exists, err := client.FinalOfferRevision.Query().
+		Where(
+			finalofferrevision.FinalOfferIDEQ(offer.ID),
+			finalofferrevision.RevisionEQ(1),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return createInitialFinalOfferRevision(ctx, client, offer)
+}
+
+func createInitialFinalOfferRevision(ctx context.Context, client *ent.Client, offer *ent.FinalOffer) error {
+	create := client.FinalOfferRevision.Create()
+	if len(offer.MilestonePlan) > 0 {
+		create.SetMilestonePlan(offer.MilestonePlan)
+	}
+	return create.
+		SetFinalOfferID(offer.ID).
+		SetRevision(1).
+		SetProposedByUserID(offer.ValidatorUserID).
+		SetProposerRole(finalOfferRoleValidator).
+		SetAction(finalOfferActionSubmitted).
+		SetAmount(offer.Amount).
+		SetHoldHours(offer.HoldHours).
+		SetTerms(offer.Terms).
+		Exec(ctx)
+}
+
+// ExpireFinalOffers closes offers whose latest proposal was left unanswered past its
+// expiry. Returns the number of offers expired.
+func (s *EntValidationCaseWorkflowService) ExpireFinalOffers(ctx context.Context) (int, error) {
+	now := time.Now()
+	offers, err := s.client.FinalOffer.Query().
+		Where(
+			finaloffer.StatusIn(finalOfferStatusSubmitted, finalOfferStatusCountered),
+			finaloffer.ExpiresAtNotNil(),
+			finaloffer.ExpiresAtLTE(now),
+			finaloffer.AcceptedAtIsNil(),
+		).
+		Limit(500).
+		All(ctx)
+	if err != nil {
+		return 0, apperrors.ErrDatabase
+	}
+
+	expired := 0
+	for _, offer := range offers {
+		updated, err := s.client.FinalOffer.Update().
+			Where(
+				finaloffer.IDEQ(offer.ID),
+				finaloffer.CurrentRevisionEQ(offer.CurrentRevision),
+				finaloffer.StatusIn(finalOfferStatusSubmitted, finalOfferStatusCountered),
+				finaloffer.AcceptedAtIsNil(),
+			).
+			SetStatus(finalOfferStatusExpired).
+			SetExpiredAt(now).
+			Save(ctx)
+		if err != nil {
+			return expired, apperrors.ErrDatabase
+		}
+		if updated != 1 {
+			// Revised or accepted since it was loaded.
+			continue
+		}
+		expired++
+
+		awaiting := finalOfferRoleOwner
+		if offer.LastProposedBy == finalOfferRoleOwner {
+			awaiting = finalOfferRoleValidator
+		}
+		s.appendCaseLogBestEffort(ctx, offer.ValidationCaseID, nil, "final_offer_expired", map[string]interface{}{
+			"final_offer_id":    offer.ID,
+			"validator_user_id": offer.ValidatorUserID,
+			"revision":          offer.CurrentRevision,
+			"awaiting":          awaiting,
+			"expires_at_unix":   offer.ExpiresAt.Unix(),
+		})
+	}
+	return expired, nil
+}
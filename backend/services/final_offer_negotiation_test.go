@@ -0,0 +1,63 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinalOfferNextAction(t *testing.T) {
+	cases := []struct {
+		status, role string
+		action, next string
+		ok           bool
+	}{
+		{finalOfferStatusSubmitted, finalOfferRoleOwner, finalOfferActionCountered, finalOfferStatusCountered, true},
+		{finalOfferStatusCountered, finalOfferRoleOwner, "", "", false},
+		{finalOfferStatusWithdrawn, finalOfferRoleOwner, "", "", false},
+		{finalOfferStatusSubmitted, finalOfferRoleValidator, finalOfferActionRevised, finalOfferStatusSubmitted, true},
+		{finalOfferStatusCountered, finalOfferRoleValidator, finalOfferActionRevised, finalOfferStatusSubmitted, true},
+		{finalOfferStatusWithdrawn, finalOfferRoleValidator, finalOfferActionResubmitted, finalOfferStatusSubmitted, true},
+		{finalOfferStatusExpired, finalOfferRoleValidator, finalOfferActionResubmitted, finalOfferStatusSubmitted, true},
+		{finalOfferStatusAcceptedPendingFunding, finalOfferRoleValidator, "", "", false},
+	}
+	for _, tc := range cases {
+		action, next, ok := finalOfferNextAction(tc.status, tc.role)
+		assert.Equal(t, tc.ok, ok, "%s/%s", tc.status, tc.role)
+		assert.Equal(t, tc.action, action, "%s/%s", tc.status, tc.role)
+		assert.Equal(t, tc.next, next, "%s/%s", tc.status, tc.role)
+	}
+}
+
+func TestNormalizeFinalOfferProposal(t *testing.T) {
+	p, err := normalizeFinalOfferProposal(FinalOfferProposal{Amount: 250_000, Terms: "  revisi scope  "})
+	require.NoError(t, err)
+	assert.Equal(t, 168, p.HoldHours)
+	assert.Equal(t, "revisi scope", p.Terms)
+
+	_, err = normalizeFinalOfferProposal(FinalOfferProposal{Amount: 9_999})
+	assert.Error(t, err)
+	_, err = normalizeFinalOfferProposal(FinalOfferProposal{Amount: 250_000, HoldHours: 48})
+	assert.Error(t, err)
+	_, err = normalizeFinalOfferProposal(FinalOfferProposal{Amount: 250_000, Terms: strings.Repeat("a", finalOfferMaxTermsChars+1)})
+	assert.Error(t, err)
+	_, err = normalizeFinalOfferProposal(FinalOfferProposal{Amount: 250_000, ExpectedRevision: -1})
+	assert.Error(t, err)
+}
+
+func TestFinalOfferExpiryTTL(t *testing.T) {
+	t.Setenv("FINAL_OFFER_EXPIRY_HOURS", "")
+	assert.Equal(t, 72*time.Hour, finalOfferExpiryTTL())
+
+	t.Setenv("FINAL_OFFER_EXPIRY_HOURS", "24")
+	assert.Equal(t, 24*time.Hour, finalOfferExpiryTTL())
+
+	t.Setenv("FINAL_OFFER_EXPIRY_HOURS", "10000")
+	assert.Equal(t, 720*time.Hour, finalOfferExpiryTTL())
+
+	t.Setenv("FINAL_OFFER_EXPIRY_HOURS", "abc")
+	assert.Equal(t, 72*time.Hour, finalOfferExpiryTTL())
+}
@@ -30,6 +30,7 @@ type GeoLocation struct {
 	CountryCode string
 	CountryName string
 	City        string
+	ASN         string
 	Latitude    float64
 	Longitude   float64
 	CachedAt    time.Time
@@ -41,6 +42,7 @@ type IPAPIResponse struct {
 	CountryCode string  `json:"countryCode"`
 	Country     string  `json:"country"`
 	City        string  `json:"city"`
+	AS          string  `json:"as"`
 	Lat         float64 `json:"lat"`
 	Lon         float64 `json:"lon"`
 	Message     string  `json:"message"`
@@ -126,6 +128,7 @@ func (g *GeoLookupService) LookupIP(ctx context.Context, ipStr string) *GeoLocat
 			CountryCode: dbCache.CountryCode,
 			CountryName: dbCache.CountryName,
 			City:        dbCache.City,
+			ASN:         dbCache.Asn,
 			Latitude:    dbCache.Latitude,
 			Longitude:   dbCache.Longitude,
 			CachedAt:    dbCache.CachedAt,
@@ -158,6 +161,7 @@ func (g *GeoLookupService) LookupIP(ctx context.Context, ipStr string) *GeoLocat
 			SetCountryCode(loc.CountryCode).
 			SetCountryName(loc.CountryName).
 			SetCity(loc.City).
+			SetAsn(truncateString(loc.ASN, 128)).
 			SetLatitude(loc.Latitude).
 			SetLongitude(loc.Longitude).
 			SetCachedAt(now).
@@ -178,7 +182,7 @@ func (g *GeoLookupService) lookupIPAPI(ctx context.Context, ipStr string) (*GeoL
 	ipStr = strings.TrimSpace(ipStr)
 
 	// Use ip-api.com (free tier: 45 requests/minute)
-	apiURL := "http://ip-api.com/json/" + ipStr + "?fields=status,countryCode,country,city,lat,lon"
+	apiURL := "http://ip-api.com/json/" + ipStr + "?fields=status,countryCode,country,city,as,lat,lon"
 
 	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
@@ -211,6 +215,7 @@ func (g *GeoLookupService) lookupIPAPI(ctx context.Context, ipStr string) (*GeoL
 		CountryCode: result.CountryCode,
 		CountryName: result.Country,
 		City:        result.City,
+		ASN:         result.AS,
 		Latitude:    result.Lat,
 		Longitude:   result.Lon,
 		CachedAt:    time.Now(),
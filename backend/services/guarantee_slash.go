@@ -0,0 +1,637 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend-gin/ent"
+	"backend-gin/ent/guaranteeslash"
+	"backend-gin/ent/validationcase"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/utils"
+
+	"go.uber.org/zap"
+)
+
+// Guarantee slashing: a dispute settled against the validator (refund to the owner) schedules
+// a deduction from their guarantee. The validator can appeal within the appeal window; an
+// appealed slash waits for an admin decision. Unappealed and upheld slashes are executed
+// through Feature Service by the SLA worker; a slash that keeps failing there is marked
+// failed and waits for an admin to retry or overturn it.
+const (
+	guaranteeSlashStatusPendingAppeal = "pending_appeal"
+	guaranteeSlashStatusAppealed      = "appealed"
+	guaranteeSlashStatusUpheld        = "upheld"
+	guaranteeSlashStatusOverturned    = "overturned"
+	guaranteeSlashStatusExecuted      = "executed"
+	guaranteeSlashStatusSkipped       = "skipped"
+	guaranteeSlashStatusFailed        = "failed"
+
+	guaranteeSlashSkipCooldown    = "cooldown"
+	guaranteeSlashSkipNoGuarantee = "no_guarantee"
+
+	guaranteeSlashDecisionUphold   = "uphold"
+	guaranteeSlashDecisionOverturn = "overturn"
+
+	guaranteeSlashMaxNoteChars = 2000
+
+	guaranteeSlashMaxAttempts     = 8
+	guaranteeSlashRetryBaseDelay  = 5 * time.Minute
+	guaranteeSlashRetryMaxDelay   = 6 * time.Hour
+	guaranteeSlashProcessBatchMax = 100
+)
+
+// guaranteeSlashOpenStatuses are slashes that still hold the validator's guarantee. A failed
+// slash keeps holding it so the guarantee cannot be withdrawn before an admin resolves it.
+var guaranteeSlashOpenStatuses = []string{
+	guaranteeSlashStatusPendingAppeal,
+	guaranteeSlashStatusAppealed,
+	guaranteeSlashStatusUpheld,
+	guaranteeSlashStatusFailed,
+}
+
+// guaranteeSlashPolicy is read from GUARANTEE_SLASH_* and snapshotted onto each slash.
+type guaranteeSlashPolicy struct {
+	percent      int
+	capAmount    int64
+	cooldown     time.Duration
+	appealWindow time.Duration
+}
+
+func guaranteeSlashEnvInt(name string, fallback int, min int, max int) int {
+	v := fallback
+	if raw := strings.TrimSpace(os.Getenv(name)); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil && parsed >= min {
+			v = parsed
+		}
+	}
+	if v > max {
+		v = max
+	}
+	return v
+}
+
+func loadGuaranteeSlashPolicy() guaranteeSlashPolicy {
+	return guaranteeSlashPolicy{
+		percent:      guaranteeSlashEnvInt("GUARANTEE_SLASH_PERCENT", 10, 0, 100),
+		capAmount:    int64(guaranteeSlashEnvInt("GUARANTEE_SLASH_MAX_IDR", 1_000_000, 0, 1_000_000_000)),
+		cooldown:     time.Duration(guaranteeSlashEnvInt("GUARANTEE_SLASH_COOLDOWN_HOURS", 30*24, 0, 365*24)) * time.Hour,
+		appealWindow: time.Duration(guaranteeSlashEnvInt("GUARANTEE_SLASH_APPEAL_HOURS", 72, 1, 30*24)) * time.Hour,
+	}
+}
+
+// amountFor returns the slash for a guarantee: percent of it, limited by the cap (0 = no cap).
+func (p guaranteeSlashPolicy) amountFor(guarantee int64) int64 {
+	if guarantee <= 0 || p.percent <= 0 {
+		return 0
+	}
+	amount := guarantee * int64(p.percent) / 100
+	if p.capAmount > 0 && amount > p.capAmount {
+		amount = p.capAmount
+	}
+	if amount > guarantee {
+		amount = guarantee
+	}
+	return amount
+}
+
+// guaranteeSlashRetryDelay is the backoff after the given number of failed attempts:
+// 5 minutes doubling per attempt, capped at 6 hours.
+func guaranteeSlashRetryDelay(attempts int) time.Duration {
+	delay := guaranteeSlashRetryBaseDelay
+	for i := 1; i < attempts && delay < guaranteeSlashRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > guaranteeSlashRetryMaxDelay {
+		delay = guaranteeSlashRetryMaxDelay
+	}
+	return delay
+}
+
+func guaranteeSlashIdempotencyKey(disputeID string) string {
+	return "guarantee-slash:" + strings.TrimSpace(disputeID)
+}
+
+func buildGuaranteeSlashItem(gs *ent.GuaranteeSlash) GuaranteeSlashItem {
+	var milestoneID *uint
+	if gs.MilestoneID != nil {
+		id := uint(*gs.MilestoneID)
+		milestoneID = &id
+	}
+	return GuaranteeSlashItem{
+		ID:                       uint(gs.ID),
+		ValidationCaseID:         uint(gs.ValidationCaseID),
+		ValidatorUserID:          uint(gs.ValidatorUserID),
+		FinalOfferID:             uint(gs.FinalOfferID),
+		MilestoneID:              milestoneID,
+		WorkflowCycle:            gs.WorkflowCycle,
+		DisputeID:                gs.DisputeID,
+		GuaranteeAmount:          gs.GuaranteeAmount,
+		Percent:                  gs.Percent,
+		CapAmount:                gs.CapAmount,
+		Amount:                   gs.Amount,
+		Status:                   normalizeStatus(gs.Status),
+		SkipReason:               valueOrEmpty(gs.SkipReason),
+		AppealDeadlineAt:         gs.AppealDeadlineAt.Unix(),
+		AppealReason:             valueOrEmpty(gs.AppealReason),
+		AppealedAt:               unixPtr(gs.AppealedAt),
+		ResolutionNote:           valueOrEmpty(gs.ResolutionNote),
+		ResolvedAt:               unixPtr(gs.ResolvedAt),
+		ExecutedAt:               unixPtr(gs.ExecutedAt),
+		RemainingGuaranteeAmount: gs.RemainingGuaranteeAmount,
+		AttemptCount:             gs.AttemptCount,
+		NextAttemptAt:            unixPtr(gs.NextAttemptAt),
+		LastError:                gs.LastError,
+		CreatedAt:                gs.CreatedAt.Unix(),
+	}
+}
+
+func normalizeGuaranteeSlashNote(note string, field string) (string, error) {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return "", apperrors.ErrInvalidInput.WithDetails(field + " wajib diisi")
+	}
+	if utf8.RuneCountInString(note) > guaranteeSlashMaxNoteChars {
+		return "", apperrors.ErrInvalidInput.WithDetails(field + " maksimal 2000 karakter")
+	}
+	if !utils.ValidateNoXSS(note) {
+		return "", apperrors.ErrInvalidInput.WithDetails(field + " mengandung karakter atau pola yang tidak diizinkan")
+	}
+	return note, nil
+}
+
+// scheduleGuaranteeSlashBestEffort opens a slash for the validator of the Final Offer whose
+// dispute was refunded to the owner. Replays of the same dispute are ignored; a validator
+// slashed within the cooldown, or without a guarantee, gets a skipped slash for the record.
+// Failures are logged: the dispute settlement itself has already been applied.
+func (s *EntValidationCaseWorkflowService) scheduleGuaranteeSlashBestEffort(ctx context.Context, finalOfferID int, milestoneID *int, disputeID string) {
+	policy := loadGuaranteeSlashPolicy()
+	if policy.percent <= 0 {
+		return
+	}
+	disputeID = strings.TrimSpace(disputeID)
+	key := guaranteeSlashIdempotencyKey(disputeID)
+	logFailure := func(err error) {
+		logger.Error("Failed to schedule guarantee slash",
+			zap.Error(err),
+			zap.Int("final_offer_id", finalOfferID),
+			zap.String("dispute_id", disputeID),
+		)
+	}
+
+	exists, err := s.client.GuaranteeSlash.Query().
+		Where(guaranteeslash.IdempotencyKeyEQ(key)).
+		Exist(ctx)
+	if err != nil {
+		logFailure(err)
+		return
+	}
+	if exists {
+		return
+	}
+	offer, err := s.client.FinalOffer.Get(ctx, finalOfferID)
+	if err != nil {
+		logFailure(err)
+		return
+	}
+	validator, err := s.client.User.Get(ctx, offer.ValidatorUserID)
+	if err != nil {
+		logFailure(err)
+		return
+	}
+
+	now := time.Now()
+	skipReason := ""
+	amount := policy.amountFor(validator.GuaranteeAmount)
+	if amount <= 0 {
+		skipReason = guaranteeSlashSkipNoGuarantee
+	} else if policy.cooldown > 0 {
+		recent, err := s.client.GuaranteeSlash.Query().
+			Where(
+				guaranteeslash.ValidatorUserIDEQ(offer.ValidatorUserID),
+				guaranteeslash.StatusIn(append(guaranteeSlashOpenStatuses, guaranteeSlashStatusExecuted)...),
+				guaranteeslash.CreatedAtGT(now.Add(-policy.cooldown)),
+			).
+			Exist(ctx)
+		if err != nil {
+			logFailure(err)
+			return
+		}
+		if recent {
+			skipReason = guaranteeSlashSkipCooldown
+		}
+	}
+
+	create := s.client.GuaranteeSlash.Create().
+		SetValidationCaseID(offer.ValidationCaseID).
+		SetValidatorUserID(offer.ValidatorUserID).
+		SetFinalOfferID(offer.ID).
+		SetNillableMilestoneID(milestoneID).
+		SetWorkflowCycle(offer.WorkflowCycle).
+		SetDisputeID(disputeID).
+		SetIdempotencyKey(key).
+		SetGuaranteeAmount(validator.GuaranteeAmount).
+		SetPercent(policy.percent).
+		SetCapAmount(policy.capAmount).
+		SetAppealDeadlineAt(now.Add(policy.appealWindow))
+	if skipReason != "" {
+		create.SetAmount(0).
+			SetStatus(guaranteeSlashStatusSkipped).
+			SetSkipReason(skipReason)
+	} else {
+		create.SetAmount(amount).
+			SetStatus(guaranteeSlashStatusPendingAppeal)
+	}
+	gs, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return
+		}
+		logFailure(err)
+		return
+	}
+
+	if skipReason != "" {
+		s.appendCaseLogBestEffort(ctx, gs.ValidationCaseID, nil, "guarantee_slash_skipped", map[string]interface{}{
+			"guarantee_slash_id": gs.ID,
+			"validator_user_id":  gs.ValidatorUserID,
+			"dispute_id":         disputeID,
+			"reason":             skipReason,
+		})
+		return
+	}
+	s.appendCaseLogBestEffort(ctx, gs.ValidationCaseID, nil, "guarantee_slash_scheduled", map[string]interface{}{
+		"guarantee_slash_id": gs.ID,
+		"validator_user_id":  gs.ValidatorUserID,
+		"dispute_id":         disputeID,
+		"guarantee_amount":   gs.GuaranteeAmount,
+		"percent":            gs.Percent,
+		"cap_amount":         gs.CapAmount,
+		"amount":             gs.Amount,
+		"appeal_deadline_at": gs.AppealDeadlineAt.Unix(),
+	})
+}
+
+// ListMyGuaranteeSlashes returns the validator's slashes, newest first.
+func (s *EntValidationCaseWorkflowService) ListMyGuaranteeSlashes(ctx context.Context, validatorUserID uint) ([]GuaranteeSlashItem, error) {
+	rows, err := s.client.GuaranteeSlash.Query().
+		Where(guaranteeslash.ValidatorUserIDEQ(int(validatorUserID))).
+		Order(ent.Desc(guaranteeslash.FieldCreatedAt)).
+		Limit(200).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	out := make([]GuaranteeSlashItem, 0, len(rows))
+	for _, gs := range rows {
+		out = append(out, buildGuaranteeSlashItem(gs))
+	}
+	return out, nil
+}
+
+// ListGuaranteeSlashes returns slashes for admin review, optionally filtered by status.
+func (s *EntValidationCaseWorkflowService) ListGuaranteeSlashes(ctx context.Context, status string) ([]GuaranteeSlashItem, error) {
+	q := s.client.GuaranteeSlash.Query()
+	if status = normalizeStatus(status); status != "" {
+		q = q.Where(guaranteeslash.StatusEQ(status))
+	}
+	rows, err := q.
+		Order(ent.Desc(guaranteeslash.FieldCreatedAt)).
+		Limit(200).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	out := make([]GuaranteeSlashItem, 0, len(rows))
+	for _, gs := range rows {
+		out = append(out, buildGuaranteeSlashItem(gs))
+	}
+	return out, nil
+}
+
+// AppealGuaranteeSlash lets the slashed validator contest a slash before its appeal deadline.
+// The slash then waits for an admin decision instead of executing.
+func (s *EntValidationCaseWorkflowService) AppealGuaranteeSlash(ctx context.Context, validationCaseID uint, slashID uint, validatorUserID uint, reason string) (*GuaranteeSlashItem, error) {
+	reason, err := normalizeGuaranteeSlashNote(reason, "alasan banding")
+	if err != nil {
+		return nil, err
+	}
+	gs, err := s.client.GuaranteeSlash.Query().
+		Where(
+			guaranteeslash.IDEQ(int(slashID)),
+			guaranteeslash.ValidationCaseIDEQ(int(validationCaseID)),
+			guaranteeslash.ValidatorUserIDEQ(int(validatorUserID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrInvalidInput.WithDetails("penalti jaminan tidak ditemukan")
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if normalizeStatus(gs.Status) != guaranteeSlashStatusPendingAppeal {
+		return nil, apperrors.ErrInvalidInput.WithDetails("penalti jaminan tidak dapat dibanding pada status ini")
+	}
+	now := time.Now()
+	if !now.Before(gs.AppealDeadlineAt) {
+		return nil, apperrors.ErrInvalidInput.WithDetails("batas waktu banding sudah lewat")
+	}
+
+	updated, err := s.client.GuaranteeSlash.Update().
+		Where(
+			guaranteeslash.IDEQ(gs.ID),
+			guaranteeslash.StatusEQ(guaranteeSlashStatusPendingAppeal),
+		).
+		SetStatus(guaranteeSlashStatusAppealed).
+		SetAppealReason(reason).
+		SetAppealedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return nil, apperrors.ErrInvalidInput.WithDetails("penalti jaminan tidak dapat dibanding pada status ini")
+	}
+
+	actorID := int(validatorUserID)
+	s.appendCaseLogBestEffort(ctx, gs.ValidationCaseID, &actorID, "guarantee_slash_appealed", map[string]interface{}{
+		"guarantee_slash_id": gs.ID,
+		"dispute_id":         gs.DisputeID,
+		"amount":             gs.Amount,
+	})
+
+	gs, err = s.client.GuaranteeSlash.Get(ctx, gs.ID)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	item := buildGuaranteeSlashItem(gs)
+	return &item, nil
+}
+
+// ResolveGuaranteeSlashAppeal records the admin decision on an appealed or failed slash: upheld
+// slashes are executed by the next worker run (a failed slash starts over with a fresh attempt
+// budget), overturned ones are closed without deduction.
+func (s *EntValidationCaseWorkflowService) ResolveGuaranteeSlashAppeal(ctx context.Context, actor AdminActor, slashID uint, decision string, note string) (*GuaranteeSlashItem, error) {
+	decision = strings.ToLower(strings.TrimSpace(decision))
+	if decision != guaranteeSlashDecisionUphold && decision != guaranteeSlashDecisionOverturn {
+		return nil, apperrors.ErrInvalidInput.WithDetails("decision harus uphold atau overturn")
+	}
+	note, err := normalizeGuaranteeSlashNote(note, "catatan keputusan")
+	if err != nil {
+		return nil, err
+	}
+
+	gs, err := s.client.GuaranteeSlash.Get(ctx, int(slashID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrInvalidInput.WithDetails("penalti jaminan tidak ditemukan")
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	current := normalizeStatus(gs.Status)
+	if current != guaranteeSlashStatusAppealed && current != guaranteeSlashStatusFailed {
+		return nil, apperrors.ErrInvalidInput.WithDetails("hanya penalti jaminan yang sedang dibanding atau gagal dieksekusi yang dapat diputuskan")
+	}
+	next, event := guaranteeSlashStatusOverturned, "guarantee_slash_overturned"
+	if decision == guaranteeSlashDecisionUphold {
+		next, event = guaranteeSlashStatusUpheld, "guarantee_slash_appeal_upheld"
+		if current == guaranteeSlashStatusFailed {
+			event = "guarantee_slash_retry_requested"
+		}
+	}
+
+	now := time.Now()
+	update := s.client.GuaranteeSlash.Update().
+		Where(
+			guaranteeslash.IDEQ(gs.ID),
+			guaranteeslash.StatusEQ(current),
+		).
+		SetStatus(next).
+		SetResolvedByAdminID(actor.ID).
+		SetResolutionNote(note).
+		SetResolvedAt(now)
+	if current == guaranteeSlashStatusFailed {
+		update.SetAttemptCount(0).ClearNextAttemptAt()
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return nil, apperrors.ErrInvalidInput.WithDetails("hanya penalti jaminan yang sedang dibanding atau gagal dieksekusi yang dapat diputuskan")
+	}
+
+	s.appendCaseLogBestEffort(ctx, gs.ValidationCaseID, nil, event, map[string]interface{}{
+		"guarantee_slash_id": gs.ID,
+		"validator_user_id":  gs.ValidatorUserID,
+		"admin_id":           actor.ID,
+		"note":               note,
+	})
+
+	gs, err = s.client.GuaranteeSlash.Get(ctx, gs.ID)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	item := buildGuaranteeSlashItem(gs)
+	return &item, nil
+}
+
+// ProcessGuaranteeSlashes executes slashes whose appeal window passed without an appeal, and
+// upheld appeals. Feature Service failures are retried with backoff (next_attempt_at); slashes
+// still backing off are not picked up, so failing rows cannot starve newer ones.
+func (s *EntValidationCaseWorkflowService) ProcessGuaranteeSlashes(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := s.client.GuaranteeSlash.Query().
+		Where(
+			guaranteeslash.Or(
+				guaranteeslash.And(
+					guaranteeslash.StatusEQ(guaranteeSlashStatusPendingAppeal),
+					guaranteeslash.AppealDeadlineAtLTE(now),
+				),
+				guaranteeslash.StatusEQ(guaranteeSlashStatusUpheld),
+			),
+			guaranteeslash.Or(
+				guaranteeslash.NextAttemptAtIsNil(),
+				guaranteeslash.NextAttemptAtLTE(now),
+			),
+		).
+		Order(ent.Asc(guaranteeslash.FieldAppealDeadlineAt)).
+		Limit(guaranteeSlashProcessBatchMax).
+		All(ctx)
+	if err != nil {
+		return 0, apperrors.ErrDatabase
+	}
+
+	executed := 0
+	wallet := NewFeatureWalletClientFromConfig()
+	for _, gs := range due {
+		ok, err := s.executeGuaranteeSlash(ctx, wallet, gs, now)
+		if err != nil {
+			return executed, err
+		}
+		if ok {
+			executed++
+		}
+	}
+	return executed, nil
+}
+
+func (s *EntValidationCaseWorkflowService) executeGuaranteeSlash(ctx context.Context, wallet *FeatureWalletClient, gs *ent.GuaranteeSlash, now time.Time) (bool, error) {
+	// The slashed amount compensates the case owner, who won the dispute
+	vc, err := s.client.ValidationCase.Get(ctx, gs.ValidationCaseID)
+	if err != nil {
+		return false, apperrors.ErrDatabase
+	}
+	reason := fmt.Sprintf("Penalti jaminan: dispute %s pada Validation Case #%d", gs.DisputeID, gs.ValidationCaseID)
+	result, callErr := wallet.SlashGuarantee(ctx, gs.IdempotencyKey, uint(gs.ValidatorUserID), uint(vc.UserID), gs.Amount, reason, gs.DisputeID)
+	if callErr != nil {
+		msg := callErr.Error()
+		if utf8.RuneCountInString(msg) > 500 {
+			msg = string([]rune(msg)[:500])
+		}
+		attempts := gs.AttemptCount + 1
+		update := s.client.GuaranteeSlash.Update().
+			Where(
+				guaranteeslash.IDEQ(gs.ID),
+				guaranteeslash.StatusIn(guaranteeSlashStatusPendingAppeal, guaranteeSlashStatusUpheld),
+			).
+			SetAttemptCount(attempts).
+			SetLastError(msg)
+		if attempts >= guaranteeSlashMaxAttempts {
+			update.SetStatus(guaranteeSlashStatusFailed).ClearNextAttemptAt()
+		} else {
+			update.SetNextAttemptAt(now.Add(guaranteeSlashRetryDelay(attempts)))
+		}
+		updated, err := update.Save(ctx)
+		if err != nil {
+			return false, apperrors.ErrDatabase
+		}
+		if updated != 1 {
+			return false, nil
+		}
+		if attempts < guaranteeSlashMaxAttempts {
+			logger.Warn("Guarantee slash execution failed; will retry",
+				zap.Error(callErr),
+				zap.Int("guarantee_slash_id", gs.ID),
+				zap.Int("validator_user_id", gs.ValidatorUserID),
+				zap.Int("attempt_count", attempts),
+			)
+			return false, nil
+		}
+		logger.Error("Guarantee slash execution failed permanently",
+			zap.Error(callErr),
+			zap.Int("guarantee_slash_id", gs.ID),
+			zap.Int("validator_user_id", gs.ValidatorUserID),
+			zap.Int("attempt_count", attempts),
+		)
+		s.appendCaseLogBestEffort(ctx, gs.ValidationCaseID, nil, "guarantee_slash_failed", map[string]interface{}{
+			"guarantee_slash_id": gs.ID,
+			"validator_user_id":  gs.ValidatorUserID,
+			"dispute_id":         gs.DisputeID,
+			"attempt_count":      attempts,
+			"last_error":         msg,
+		})
+		return false, nil
+	}
+
+	updated, err := s.client.GuaranteeSlash.Update().
+		Where(
+			guaranteeslash.IDEQ(gs.ID),
+			guaranteeslash.StatusIn(guaranteeSlashStatusPendingAppeal, guaranteeSlashStatusUpheld),
+		).
+		SetStatus(guaranteeSlashStatusExecuted).
+		SetExecutedAt(now).
+		SetAmount(result.SlashedAmount).
+		SetRemainingGuaranteeAmount(result.RemainingAmount).
+		AddAttemptCount(1).
+		ClearNextAttemptAt().
+		ClearLastError().
+		Save(ctx)
+	if err != nil {
+		return false, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return false, nil
+	}
+	// Feature Service also syncs the guarantee back; apply it now so matching sees it at once.
+	if _, err := s.client.User.UpdateOneID(gs.ValidatorUserID).
+		SetGuaranteeAmount(result.RemainingAmount).
+		Save(ctx); err != nil {
+		logger.Warn("Failed to apply slashed guarantee amount locally",
+			zap.Error(err),
+			zap.Int("validator_user_id", gs.ValidatorUserID),
+		)
+	}
+
+	s.appendCaseLogBestEffort(ctx, gs.ValidationCaseID, nil, "guarantee_slash_executed", map[string]interface{}{
+		"guarantee_slash_id":         gs.ID,
+		"validator_user_id":          gs.ValidatorUserID,
+		"dispute_id":                 gs.DisputeID,
+		"amount":                     result.SlashedAmount,
+		"remaining_guarantee_amount": result.RemainingAmount,
+		"idempotency_key":            gs.IdempotencyKey,
+		"beneficiary_user_id":        vc.UserID,
+		"credit_transaction_id":      result.CreditTransactionID,
+	})
+	s.recordReputationEventBestEffort(ctx, reputationEventInput{
+		validatorUserID:  gs.ValidatorUserID,
+		validationCaseID: gs.ValidationCaseID,
+		workflowCycle:    gs.WorkflowCycle,
+		kind:             reputationEventGuaranteeSlashed,
+		dedupeKey:        fmt.Sprintf("guarantee_slash:%d", gs.ID),
+		detail: map[string]interface{}{
+			"guarantee_slash_id": gs.ID,
+			"dispute_id":         gs.DisputeID,
+			"amount":             result.SlashedAmount,
+		},
+	})
+	return true, nil
+}
+
+// guaranteeSlashLocks lists the validator's open slashes as guarantee locks, so the guarantee
+// cannot be released before the slash is executed or overturned.
+func (s *EntValidationCaseWorkflowService) guaranteeSlashLocks(ctx context.Context, validatorUserID uint) ([]ConsultationGuaranteeLockItem, error) {
+	rows, err := s.client.GuaranteeSlash.Query().
+		Where(
+			guaranteeslash.ValidatorUserIDEQ(int(validatorUserID)),
+			guaranteeslash.StatusIn(guaranteeSlashOpenStatuses...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	caseIDs := make([]int, 0, len(rows))
+	for _, gs := range rows {
+		caseIDs = append(caseIDs, gs.ValidationCaseID)
+	}
+	cases, err := s.client.ValidationCase.Query().
+		Where(validationcase.IDIn(caseIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statusByCase := make(map[int]string, len(cases))
+	for _, vc := range cases {
+		statusByCase[vc.ID] = normalizeStatus(vc.Status)
+	}
+
+	out := make([]ConsultationGuaranteeLockItem, 0, len(rows))
+	for _, gs := range rows {
+		out = append(out, ConsultationGuaranteeLockItem{
+			ValidationCaseID:   uint(gs.ValidationCaseID),
+			ValidationStatus:   statusByCase[gs.ValidationCaseID],
+			ConsultationStatus: "guarantee_slash_" + normalizeStatus(gs.Status),
+			DisputeID:          gs.DisputeID,
+		})
+	}
+	return out, nil
+}
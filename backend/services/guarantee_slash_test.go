@@ -0,0 +1,52 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadGuaranteeSlashPolicy(t *testing.T) {
+	t.Setenv("GUARANTEE_SLASH_PERCENT", "")
+	t.Setenv("GUARANTEE_SLASH_MAX_IDR", "")
+	t.Setenv("GUARANTEE_SLASH_COOLDOWN_HOURS", "")
+	t.Setenv("GUARANTEE_SLASH_APPEAL_HOURS", "")
+	p := loadGuaranteeSlashPolicy()
+	assert.Equal(t, 10, p.percent)
+	assert.Equal(t, int64(1_000_000), p.capAmount)
+	assert.Equal(t, 30*24*time.Hour, p.cooldown)
+	assert.Equal(t, 72*time.Hour, p.appealWindow)
+
+	t.Setenv("GUARANTEE_SLASH_PERCENT", "250")
+	t.Setenv("GUARANTEE_SLASH_MAX_IDR", "0")
+	t.Setenv("GUARANTEE_SLASH_APPEAL_HOURS", "0")
+	p = loadGuaranteeSlashPolicy()
+	assert.Equal(t, 100, p.percent, "percent is capped at 100")
+	assert.Equal(t, int64(0), p.capAmount, "0 disables the cap")
+	assert.Equal(t, 72*time.Hour, p.appealWindow, "appeal window below 1 hour falls back")
+}
+
+func TestGuaranteeSlashPolicyAmountFor(t *testing.T) {
+	p := guaranteeSlashPolicy{percent: 10, capAmount: 50_000}
+	assert.Equal(t, int64(20_000), p.amountFor(200_000))
+	assert.Equal(t, int64(50_000), p.amountFor(2_000_000), "capped")
+	assert.Equal(t, int64(0), p.amountFor(0))
+
+	p = guaranteeSlashPolicy{percent: 100}
+	assert.Equal(t, int64(300_000), p.amountFor(300_000), "no cap, never more than the guarantee")
+
+	p = guaranteeSlashPolicy{percent: 0, capAmount: 50_000}
+	assert.Equal(t, int64(0), p.amountFor(300_000), "disabled")
+}
+
+func TestGuaranteeSlashIdempotencyKey(t *testing.T) {
+	assert.Equal(t, "guarantee-slash:dsp_01H", guaranteeSlashIdempotencyKey("  dsp_01H "))
+}
+
+func TestGuaranteeSlashRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, guaranteeSlashRetryDelay(1))
+	assert.Equal(t, 10*time.Minute, guaranteeSlashRetryDelay(2))
+	assert.Equal(t, 80*time.Minute, guaranteeSlashRetryDelay(5))
+	assert.Equal(t, 6*time.Hour, guaranteeSlashRetryDelay(guaranteeSlashMaxAttempts), "capped")
+}
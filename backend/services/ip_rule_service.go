@@ -0,0 +1,337 @@
+package services
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/iprule"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+// IP rule actions and sources
+const (
+	IPRuleAllow = "allow"
+	IPRuleBlock = "block"
+
+	IPRuleSourceAdmin      = "admin"
+	IPRuleSourceBruteForce = "brute_force"
+	IPRuleSourceRateLimit  = "rate_limit"
+)
+
+// ipRulesChannel is the Redis pub/sub channel that tells every instance to reload rules
+const ipRulesChannel = "ip_rules:changed"
+
+// Block rules broader than these prefixes would lock out large parts of the internet
+// (or the admins themselves); they are rejected.
+const (
+	minBlockPrefixV4 = 16
+	minBlockPrefixV6 = 32
+)
+
+// IPRuleInput creates a rule
+type IPRuleInput struct {
+	CIDR      string
+	Action    string
+	Reason    string
+	ExpiresAt *time.Time
+}
+
+// IPRuleUpdate changes the reason or expiry of a rule. Permanent clears the expiry.
+type IPRuleUpdate struct {
+	Reason    *string
+	ExpiresAt *time.Time
+	Permanent bool
+}
+
+// EntIPRuleService manages persistent IP allow/block rules
+type EntIPRuleService struct {
+	client *ent.Client
+}
+
+// NewEntIPRuleService creates a new IP rule service with Ent
+func NewEntIPRuleService() *EntIPRuleService {
+	return &EntIPRuleService{client: database.GetEntClient()}
+}
+
+var (
+	ipRuleService   *EntIPRuleService
+	ipRuleServiceMu sync.RWMutex
+)
+
+// SetIPRuleService registers the service used for automatic blocks
+func SetIPRuleService(s *EntIPRuleService) {
+	ipRuleServiceMu.Lock()
+	defer ipRuleServiceMu.Unlock()
+	ipRuleService = s
+}
+
+// GetIPRuleService returns the registered service, or nil
+func GetIPRuleService() *EntIPRuleService {
+	ipRuleServiceMu.RLock()
+	defer ipRuleServiceMu.RUnlock()
+	return ipRuleService
+}
+
+// ParseIPRuleCIDR accepts an address or CIDR and returns the canonical prefix
+func ParseIPRuleCIDR(raw string) (netip.Prefix, error) {
+	clean := strings.TrimSpace(raw)
+	if strings.Contains(clean, "/") {
+		prefix, err := netip.ParsePrefix(clean)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(clean)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+func validateIPRule(prefix netip.Prefix, action string) error {
+	switch action {
+	case IPRuleAllow:
+	case IPRuleBlock:
+		min := minBlockPrefixV4
+		if prefix.Addr().Is6() {
+			min = minBlockPrefixV6
+		}
+		if prefix.Bits() < min {
+			return apperrors.ErrInvalidInput.WithDetails("Rentang IP terlalu luas untuk diblokir")
+		}
+	default:
+		return apperrors.ErrInvalidInput.WithDetails("action harus allow atau block")
+	}
+	return nil
+}
+
+func ipRuleActive(now time.Time) func(*sql.Selector) {
+	return iprule.Or(iprule.ExpiresAtIsNil(), iprule.ExpiresAtGT(now))
+}
+
+// List returns rules, newest first. Expired rules are included only when asked.
+func (s *EntIPRuleService) List(ctx context.Context, action string, includeExpired bool, page, pageSize int) ([]*ent.IPRule, int, error) {
+	q := s.client.IPRule.Query()
+	if action != "" {
+		q = q.Where(iprule.ActionEQ(action))
+	}
+	if !includeExpired {
+		q = q.Where(ipRuleActive(time.Now()))
+	}
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, apperrors.ErrDatabase
+	}
+	rules, err := q.Order(ent.Desc(iprule.FieldCreatedAt), ent.Desc(iprule.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, apperrors.ErrDatabase
+	}
+	return rules, total, nil
+}
+
+// Create adds a rule and notifies every instance
+func (s *EntIPRuleService) Create(ctx context.Context, actor AdminActor, in IPRuleInput) (*ent.IPRule, error) {
+	prefix, err := ParseIPRuleCIDR(in.CIDR)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput.WithDetails("cidr harus berupa alamat IP atau CIDR")
+	}
+	if err := validateIPRule(prefix, in.Action); err != nil {
+		return nil, err
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(time.Now()) {
+		return nil, apperrors.ErrInvalidInput.WithDetails("expires_at harus di masa depan")
+	}
+
+	rule, err := s.client.IPRule.Create().
+		SetCidr(prefix.String()).
+		SetAction(in.Action).
+		SetReason(truncateString(in.Reason, 255)).
+		SetSource(IPRuleSourceAdmin).
+		SetCreatedByAdminID(actor.ID).
+		SetCreatedByEmail(actor.Email).
+		SetNillableExpiresAt(in.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		logger.Error("Failed to create IP rule", zap.Error(err))
+		return nil, apperrors.ErrDatabase
+	}
+	publishIPRulesChanged(ctx)
+	return rule, nil
+}
+
+// Update changes a rule's reason or expiry
+func (s *EntIPRuleService) Update(ctx context.Context, id int, in IPRuleUpdate) (*ent.IPRule, error) {
+	if in.Permanent && in.ExpiresAt != nil {
+		return nil, apperrors.ErrInvalidInput.WithDetails("Gunakan expires_at atau permanent, bukan keduanya")
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(time.Now()) {
+		return nil, apperrors.ErrInvalidInput.WithDetails("expires_at harus di masa depan")
+	}
+
+	upd := s.client.IPRule.UpdateOneID(id)
+	if in.Reason != nil {
+		upd = upd.SetReason(truncateString(*in.Reason, 255))
+	}
+	if in.ExpiresAt != nil {
+		upd = upd.SetExpiresAt(*in.ExpiresAt)
+	}
+	if in.Permanent {
+		upd = upd.ClearExpiresAt()
+	}
+	rule, err := upd.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrIPRuleNotFound
+		}
+		logger.Error("Failed to update IP rule", zap.Error(err), zap.Int("rule_id", id))
+		return nil, apperrors.ErrDatabase
+	}
+	publishIPRulesChanged(ctx)
+	return rule, nil
+}
+
+// Delete removes a rule
+func (s *EntIPRuleService) Delete(ctx context.Context, id int) error {
+	if err := s.client.IPRule.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrIPRuleNotFound
+		}
+		logger.Error("Failed to delete IP rule", zap.Error(err), zap.Int("rule_id", id))
+		return apperrors.ErrDatabase
+	}
+	publishIPRulesChanged(ctx)
+	return nil
+}
+
+// ActiveRules returns every rule that has not expired
+func (s *EntIPRuleService) ActiveRules(ctx context.Context) ([]*ent.IPRule, error) {
+	return s.client.IPRule.Query().Where(ipRuleActive(time.Now())).All(ctx)
+}
+
+// AutoBlock adds (or extends) a temporary block for a single IP. Allowed IPs are left alone.
+func (s *EntIPRuleService) AutoBlock(ctx context.Context, ip string, duration time.Duration, source, reason string) error {
+	prefix, err := ParseIPRuleCIDR(ip)
+	if err != nil || !prefix.IsSingleIP() {
+		return nil
+	}
+	if prefix.Addr().IsLoopback() {
+		return nil
+	}
+	now := time.Now()
+	until := now.Add(duration)
+
+	rules, err := s.client.IPRule.Query().Where(ipRuleActive(now)).All(ctx)
+	if err != nil {
+		return err
+	}
+	var existing *ent.IPRule
+	for _, r := range rules {
+		p, err := netip.ParsePrefix(r.Cidr)
+		if err != nil || !p.Contains(prefix.Addr()) {
+			continue
+		}
+		if r.Action == IPRuleAllow {
+			return nil
+		}
+		if r.Cidr == prefix.String() && r.Source != IPRuleSourceAdmin {
+			existing = r
+		} else if r.ExpiresAt == nil || !r.ExpiresAt.Before(until) {
+			// Already covered by a longer block
+			return nil
+		}
+	}
+
+	if existing != nil {
+		if existing.ExpiresAt == nil || !existing.ExpiresAt.Before(until) {
+			return nil
+		}
+		err = s.client.IPRule.UpdateOne(existing).SetExpiresAt(until).SetReason(truncateString(reason, 255)).Exec(ctx)
+	} else {
+		err = s.client.IPRule.Create().
+			SetCidr(prefix.String()).
+			SetAction(IPRuleBlock).
+			SetReason(truncateString(reason, 255)).
+			SetSource(source).
+			SetExpiresAt(until).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	logger.Warn("IP automatically blocked",
+		zap.String("ip", prefix.Addr().String()),
+		zap.String("source", source),
+		zap.Time("until", until))
+	publishIPRulesChanged(ctx)
+	return nil
+}
+
+// BruteForceBlockDuration is how long an IP is blocked after brute force detection.
+// BRUTE_FORCE_AUTO_BLOCK_MINUTES=0 disables automatic blocks.
+func BruteForceBlockDuration() time.Duration {
+	const fallback = 30 * time.Minute
+	raw := strings.TrimSpace(os.Getenv("BRUTE_FORCE_AUTO_BLOCK_MINUTES"))
+	if raw == "" {
+		return fallback
+	}
+	minutes, err := strconv.Atoi(raw)
+	if err != nil || minutes < 0 {
+		return fallback
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// bruteForceIPFailures counts failed logins per IP for RecordFailedLoginFromIP
+var bruteForceIPFailures = newFailureVelocityTracker(AttemptWindow)
+
+// BruteForceIPThreshold is how many failed logins from one IP within AttemptWindow count as
+// brute force (BRUTE_FORCE_IP_THRESHOLD, default 20)
+func BruteForceIPThreshold() int {
+	const fallback = 20
+	n, err := strconv.Atoi(strings.TrimSpace(os.Getenv("BRUTE_FORCE_IP_THRESHOLD")))
+	if err != nil || n <= 0 {
+		return fallback
+	}
+	return n
+}
+
+func autoBlockBruteForce(ctx context.Context, ip string, attempts int) {
+	svc := GetIPRuleService()
+	duration := BruteForceBlockDuration()
+	if svc == nil || duration == 0 || ip == "" {
+		return
+	}
+	reason := "Brute force terdeteksi (" + strconv.Itoa(attempts) + " percobaan gagal)"
+	if err := svc.AutoBlock(ctx, ip, duration, IPRuleSourceBruteForce, reason); err != nil {
+		logger.Warn("Failed to auto-block brute force IP", zap.Error(err), zap.String("ip", ip))
+	}
+}
+
+func publishIPRulesChanged(ctx context.Context) {
+	if RedisClient == nil {
+		select {
+		case ipRulesLocalNotify <- struct{}{}:
+		default:
+		}
+		return
+	}
+	if err := RedisClient.Publish(ctx, ipRulesChannel, "reload").Err(); err != nil {
+		logger.Warn("Failed to publish IP rule change", zap.Error(err))
+	}
+}
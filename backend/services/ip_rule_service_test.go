@@ -0,0 +1,57 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIPRuleCIDR(t *testing.T) {
+	cases := map[string]string{
+		"203.0.113.7":        "203.0.113.7/32",
+		" 203.0.113.77/24 ":  "203.0.113.0/24",
+		"2001:db8::1":        "2001:db8::1/128",
+		"::ffff:203.0.113.7": "203.0.113.7/32",
+		"2001:db8::/48":      "2001:db8::/48",
+	}
+	for raw, want := range cases {
+		prefix, err := ParseIPRuleCIDR(raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, prefix.String(), raw)
+	}
+
+	for _, raw := range []string{"", "not-an-ip", "203.0.113.0/33"} {
+		_, err := ParseIPRuleCIDR(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestValidateIPRule(t *testing.T) {
+	wide, _ := ParseIPRuleCIDR("10.0.0.0/8")
+	assert.NoError(t, validateIPRule(wide, IPRuleAllow))
+	assert.Error(t, validateIPRule(wide, IPRuleBlock))
+
+	narrow, _ := ParseIPRuleCIDR("203.0.0.0/16")
+	assert.NoError(t, validateIPRule(narrow, IPRuleBlock))
+
+	wideV6, _ := ParseIPRuleCIDR("2001:db8::/16")
+	assert.Error(t, validateIPRule(wideV6, IPRuleBlock))
+
+	assert.Error(t, validateIPRule(narrow, "deny"))
+}
+
+func TestBruteForceBlockDuration(t *testing.T) {
+	t.Setenv("BRUTE_FORCE_AUTO_BLOCK_MINUTES", "")
+	assert.Equal(t, 30*time.Minute, BruteForceBlockDuration())
+
+	t.Setenv("BRUTE_FORCE_AUTO_BLOCK_MINUTES", "90")
+	assert.Equal(t, 90*time.Minute, BruteForceBlockDuration())
+
+	t.Setenv("BRUTE_FORCE_AUTO_BLOCK_MINUTES", "0")
+	assert.Equal(t, time.Duration(0), BruteForceBlockDuration())
+
+	t.Setenv("BRUTE_FORCE_AUTO_BLOCK_MINUTES", "abc")
+	assert.Equal(t, 30*time.Minute, BruteForceBlockDuration())
+}
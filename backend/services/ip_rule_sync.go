@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+// ipRuleRefreshInterval reloads rules even without a Redis notification, so expired rules
+// drop out and instances without Redis still pick up changes
+const ipRuleRefreshInterval = time.Minute
+
+// ipRulesLocalNotify triggers a reload on this instance when Redis is unavailable
+var ipRulesLocalNotify = make(chan struct{}, 1)
+
+// ActiveIPRule is a parsed, unexpired rule. A zero ExpiresAt never expires.
+type ActiveIPRule struct {
+	Prefix    netip.Prefix
+	Action    string
+	ExpiresAt time.Time
+}
+
+// IPRuleSyncer keeps the rate limiter's rules in sync with the ip_rules table.
+// Reloads on start, on every Redis notification and once a minute.
+type IPRuleSyncer struct {
+	rules   *EntIPRuleService
+	apply   func([]ActiveIPRule)
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	started bool
+}
+
+func NewIPRuleSyncer(rules *EntIPRuleService, apply func([]ActiveIPRule)) *IPRuleSyncer {
+	return &IPRuleSyncer{
+		rules:  rules,
+		apply:  apply,
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+func (s *IPRuleSyncer) Start() {
+	if s == nil || s.started || s.rules == nil || s.apply == nil {
+		return
+	}
+	s.started = true
+	s.reload()
+
+	var notify <-chan struct{}
+	subCtx, cancelSub := context.WithCancel(context.Background())
+	if RedisClient != nil {
+		notify = s.subscribe(subCtx)
+	}
+
+	go func() {
+		defer close(s.doneCh)
+		defer cancelSub()
+		ticker := time.NewTicker(ipRuleRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.reload()
+			case _, ok := <-notify:
+				if !ok {
+					notify = nil
+					continue
+				}
+				s.reload()
+			case <-ipRulesLocalNotify:
+				s.reload()
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// subscribe forwards Redis notifications; the channel closes when ctx ends
+func (s *IPRuleSyncer) subscribe(ctx context.Context) <-chan struct{} {
+	out := make(chan struct{}, 1)
+	pubsub := RedisClient.Subscribe(ctx, ipRulesChannel)
+	go func() {
+		defer close(out)
+		defer func() { _ = pubsub.Close() }()
+		msgs := pubsub.Channel()
+		for {
+			select {
+			case _, ok := <-msgs:
+				if !ok {
+					return
+				}
+				select {
+				case out <- struct{}{}:
+				default:
+					// A reload is already pending
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (s *IPRuleSyncer) reload() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rows, err := s.rules.ActiveRules(ctx)
+	if err != nil {
+		// Keep the previous rules rather than dropping every block
+		logger.Warn("Failed to reload IP rules", zap.Error(err))
+		return
+	}
+	active := make([]ActiveIPRule, 0, len(rows))
+	for _, r := range rows {
+		prefix, err := netip.ParsePrefix(r.Cidr)
+		if err != nil {
+			logger.Warn("Skipping invalid IP rule", zap.Int("rule_id", r.ID), zap.String("cidr", r.Cidr))
+			continue
+		}
+		rule := ActiveIPRule{Prefix: prefix, Action: r.Action}
+		if r.ExpiresAt != nil {
+			rule.ExpiresAt = *r.ExpiresAt
+		}
+		active = append(active, rule)
+	}
+	s.apply(active)
+	logger.Debug("IP rules reloaded", zap.Int("rules", len(active)))
+}
+
+func (s *IPRuleSyncer) Stop() {
+	if s == nil || !s.started {
+		return
+	}
+	close(s.stopCh)
+	<-s.doneCh
+}
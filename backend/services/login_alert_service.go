@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/passwordresettoken"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/utils"
+
+	"go.uber.org/zap"
+)
+
+// SessionLockByUserReport marks locks applied via the "this wasn't me" link.
+// ResetPassword lifts these locks.
+const SessionLockByUserReport = "user_report"
+
+const (
+	// killSwitchTokenTTL is how long the "this wasn't me" link in an alert email stays valid
+	killSwitchTokenTTL = 72 * time.Hour
+
+	// killSwitchLockDuration bounds the lock if the user never completes the password reset
+	killSwitchLockDuration = 30 * 24 * time.Hour
+
+	// loginAlertTimeout bounds geo lookup + queueing for one alert
+	loginAlertTimeout = 10 * time.Second
+
+	loginAlertKindNewDevice  = "new_device"
+	loginAlertKindSuspicious = "suspicious_login"
+)
+
+// loginAlertTimezone renders alert times in the platform's home timezone (WIB)
+var loginAlertTimezone = time.FixedZone("WIB", 7*60*60)
+
+// LoginAlertService emails users about unfamiliar sign-ins and handles the signed
+// "this wasn't me" kill switch included in those emails
+type LoginAlertService struct {
+	client     *ent.Client
+	sessions   *EntSessionService
+	audit      *EntSecurityAuditService
+	geo        *GeoLookupService
+	signingKey []byte
+}
+
+// NewLoginAlertService creates a login alert service. The kill-switch signing key is
+// derived from secret so it never equals the JWT key itself.
+func NewLoginAlertService(secret []byte) *LoginAlertService {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte("aivalid/login-alert/kill-switch"))
+	return &LoginAlertService{
+		client:     database.GetEntClient(),
+		sessions:   NewEntSessionService(),
+		audit:      NewEntSecurityAuditService(),
+		geo:        GetGeoLookupService(),
+		signingKey: mac.Sum(nil),
+	}
+}
+
+// Global login alert service (nil = alerts disabled)
+var loginAlertService *LoginAlertService
+
+// InitLoginAlertService initializes the global login alert service
+func InitLoginAlertService(secret []byte) {
+	loginAlertService = NewLoginAlertService(secret)
+	logger.Info("Login alert service initialized")
+}
+
+// GetLoginAlertService returns the global login alert service (may be nil)
+func GetLoginAlertService() *LoginAlertService {
+	return loginAlertService
+}
+
+// NotifyNewDevice alerts the user that their account signed in from an unfamiliar device
+func (s *LoginAlertService) NotifyNewDevice(ctx context.Context, userID int, ip, userAgent string) {
+	s.notify(ctx, loginAlertKindNewDevice, EventNewDeviceAlert, userID, ip, userAgent, "")
+}
+
+// NotifySuspiciousLogin alerts the user about a sign-in flagged by impossible-travel detection
+func (s *LoginAlertService) NotifySuspiciousLogin(ctx context.Context, userID int, ip, userAgent, reason string) {
+	s.notify(ctx, loginAlertKindSuspicious, EventSuspiciousLoginAlert, userID, ip, userAgent, reason)
+}
+
+// notify builds and queues one alert email. It is called off the request path, so
+// failures are logged rather than returned.
+func (s *LoginAlertService) notify(ctx context.Context, kind string, eventType SecurityEventType, userID int, ip, userAgent, reason string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loginAlertTimeout)
+	defer cancel()
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		logger.Warn("Login alert: failed to load user", zap.Error(err), zap.Int("user_id", userID))
+		return
+	}
+
+	now := time.Now()
+	token, err := s.signKillToken(userID, now)
+	if err != nil {
+		logger.Error("Login alert: failed to sign kill-switch token", zap.Error(err), zap.Int("user_id", userID))
+		return
+	}
+
+	details := utils.LoginAlertDetails{
+		Kind:     kind,
+		Device:   utils.ParseUserAgent(userAgent).String(),
+		Location: s.describeLocation(ctx, ip),
+		IP:       ip,
+		Time:     now.In(loginAlertTimezone).Format("02 Jan 2006 15:04 MST"),
+	}
+	if ip == "" {
+		details.IP = "-"
+	}
+
+	queueErr := utils.QueueLoginAlertEmail(u.Email, token, details)
+	if queueErr != nil {
+		logger.Warn("Login alert: failed to queue email", zap.Error(queueErr), zap.Int("user_id", userID))
+	}
+
+	auditDetails := fmt.Sprintf("device=%s location=%s", details.Device, details.Location)
+	if reason != "" {
+		auditDetails += " reason=" + reason
+	}
+	uid := u.ID
+	s.audit.LogEvent(ctx, eventType, &uid, u.Email, ip, userAgent, auditDetails, "warning", queueErr == nil)
+}
+
+// describeLocation returns "City, Country" for an IP, or a placeholder when unknown
+func (s *LoginAlertService) describeLocation(ctx context.Context, ip string) string {
+	if s.geo == nil || ip == "" {
+		return "Tidak diketahui"
+	}
+	loc := s.geo.LookupIP(ctx, ip)
+	if loc == nil {
+		return "Tidak diketahui"
+	}
+	parts := make([]string, 0, 2)
+	if loc.City != "" {
+		parts = append(parts, loc.City)
+	}
+	if loc.CountryName != "" {
+		parts = append(parts, loc.CountryName)
+	} else if loc.CountryCode != "" {
+		parts = append(parts, loc.CountryCode)
+	}
+	if len(parts) == 0 {
+		return "Tidak diketahui"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// KillSwitch handles the "this wasn't me" link: it revokes every session, locks the
+// account until the password is reset, and emails a password reset link.
+func (s *LoginAlertService) KillSwitch(ctx context.Context, token, ip, userAgent string) error {
+	userID, issuedAt, err := s.verifyKillToken(token, time.Now())
+	if err != nil {
+		return err
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrInvalidToken
+		}
+		return apperrors.ErrDatabase
+	}
+
+	// The link is stateless, so void it once the owner has reset their password;
+	// otherwise a replay would re-lock an account that was already recovered.
+	resetSince, err := s.client.PasswordResetToken.Query().
+		Where(
+			passwordresettoken.UserIDEQ(userID),
+			passwordresettoken.UsedAtGT(issuedAt),
+		).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if resetSince {
+		return apperrors.ErrInvalidToken.WithDetails("Link sudah tidak berlaku karena password telah direset")
+	}
+
+	if err := s.sessions.RevokeAllUserSessions(ctx, userID, "User reported unrecognized login"); err != nil {
+		logger.Error("Kill switch: failed to revoke sessions", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase.WithDetails("Gagal mengeluarkan sesi")
+	}
+
+	if err := s.sessions.ApplySessionLock(ctx, userID, "User reported unrecognized login; password reset required",
+		SessionLockByUserReport, time.Now().Add(killSwitchLockDuration)); err != nil {
+		logger.Error("Kill switch: failed to lock account", zap.Error(err), zap.Int("user_id", userID))
+		return apperrors.ErrDatabase.WithDetails("Gagal mengunci akun")
+	}
+
+	raw, err := createPasswordResetToken(ctx, s.client, userID)
+	if err != nil {
+		return err
+	}
+	resetQueued := utils.QueuePasswordResetEmail(u.Email, raw) == nil
+	if !resetQueued {
+		logger.Warn("Kill switch: failed to queue password reset email", zap.Int("user_id", userID))
+	}
+
+	uid := u.ID
+	s.audit.LogEvent(ctx, EventKillSwitchActivated, &uid, u.Email, ip, userAgent,
+		fmt.Sprintf("All sessions revoked, account locked pending password reset (alert issued %s, reset email queued=%t)",
+			issuedAt.UTC().Format(time.RFC3339), resetQueued),
+		"critical", true)
+
+	logger.Warn("Kill switch activated", zap.Int("user_id", userID), zap.String("ip", ip))
+	return nil
+}
+
+// signKillToken returns base64url(payload).base64url(hmac) where payload is
+// "userID.issuedAt.expiresAt.nonce" (unix seconds)
+func (s *LoginAlertService) signKillToken(userID int, now time.Time) (string, error) {
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	payload := fmt.Sprintf("%d.%d.%d.%s", userID, now.Unix(), now.Add(killSwitchTokenTTL).Unix(), hex.EncodeToString(nonce))
+
+	mac := hmac.New(sha256.New, s.signingKey)
+	_, _ = mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verifyKillToken checks the signature and expiry, returning the user ID and issue time
+func (s *LoginAlertService) verifyKillToken(token string, now time.Time) (int, time.Time, error) {
+	encPayload, encSig, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encPayload)
+	if err != nil {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encSig)
+	if err != nil {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+
+	mac := hmac.New(sha256.New, s.signingKey)
+	_, _ = mac.Write(payload)
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+
+	parts := strings.Split(string(payload), ".")
+	if len(parts) != 4 {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+	userID, err := strconv.Atoi(parts[0])
+	if err != nil || userID <= 0 {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+	iat, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+	exp, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, apperrors.ErrInvalidToken
+	}
+	if now.Unix() > exp {
+		return 0, time.Time{}, apperrors.ErrTokenExpired.WithDetails("Link sudah kedaluwarsa")
+	}
+	return userID, time.Unix(iat, 0), nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "backend-gin/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKillToken_RoundTrip(t *testing.T) {
+	s := &LoginAlertService{signingKey: []byte("test-key")}
+	now := time.Unix(1_760_000_000, 0)
+
+	token, err := s.signKillToken(42, now)
+	require.NoError(t, err)
+
+	userID, issuedAt, err := s.verifyKillToken(token, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 42, userID)
+	assert.True(t, issuedAt.Equal(now))
+}
+
+func TestKillToken_Rejects(t *testing.T) {
+	s := &LoginAlertService{signingKey: []byte("test-key")}
+	now := time.Unix(1_760_000_000, 0)
+
+	token, err := s.signKillToken(42, now)
+	require.NoError(t, err)
+
+	t.Run("expired", func(t *testing.T) {
+		_, _, err := s.verifyKillToken(token, now.Add(killSwitchTokenTTL+time.Second))
+		var appErr *apperrors.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, apperrors.ErrTokenExpired.Code, appErr.Code)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		other := &LoginAlertService{signingKey: []byte("other-key")}
+		_, _, err := other.verifyKillToken(token, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		forged, err := (&LoginAlertService{signingKey: []byte("attacker")}).signKillToken(7, now)
+		require.NoError(t, err)
+		payload, _, _ := strings.Cut(forged, ".")
+		_, sig, _ := strings.Cut(token, ".")
+		_, _, err = s.verifyKillToken(payload+"."+sig, now)
+		assert.Error(t, err)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		for _, bad := range []string{"", "abc", "abc.def", "..."} {
+			_, _, err := s.verifyKillToken(bad, now)
+			assert.Error(t, err, bad)
+		}
+	})
+}
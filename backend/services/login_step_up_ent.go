@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"backend-gin/ent"
+	"backend-gin/ent/sessionlock"
+	"backend-gin/ent/totppendingtoken"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+	"backend-gin/utils"
+
+	"go.uber.org/zap"
+)
+
+// Pending login token purposes
+const (
+	pendingTokenPurposeTOTP   = "totp"
+	pendingTokenPurposeStepUp = "step_up"
+)
+
+// Step-up methods offered to the client
+const (
+	StepUpMethodPasskey   = "passkey"
+	StepUpMethodEmailCode = "email_code"
+)
+
+// stepUpMaxCodeAttempts is how many wrong email codes a step-up challenge accepts
+const stepUpMaxCodeAttempts = 5
+
+// startLoginStepUp answers a password login that the risk engine flagged for step-up. The
+// returned token is bound to this login: users with a passkey complete it with that passkey,
+// others with a 6-digit code sent to their email.
+func (s *EntAuthService) startLoginStepUp(ctx context.Context, u *ent.User, ipAddress, userAgent string, assessment *RiskAssessment) (*LoginResponse, error) {
+	method := StepUpMethodPasskey
+	code := ""
+	if !riskEngine.HasStepUpFactor(ctx, u.ID) {
+		method = StepUpMethodEmailCode
+		var err error
+		if code, err = generateStepUpEmailCode(); err != nil {
+			return nil, apperrors.ErrInternalServer
+		}
+	}
+
+	token, err := s.createPendingLoginToken(ctx, u.ID, pendingTokenPurposeStepUp, code)
+	if err != nil {
+		logger.Error("Failed to create step-up token", zap.Error(err), zap.Int("user_id", u.ID))
+		return nil, apperrors.ErrInternalServer
+	}
+
+	if code != "" {
+		if err := utils.QueueLoginStepUpCodeEmail(u.Email, code, int(totpPendingTokenExpiry/time.Minute)); err != nil {
+			logger.Error("Failed to queue step-up code email", zap.Error(err), zap.Int("user_id", u.ID))
+			return nil, apperrors.ErrInternalServer
+		}
+		riskEngine.LogStepUpEmailCode(ctx, u, ipAddress, userAgent, assessment)
+	}
+
+	username := ""
+	if u.Username != nil {
+		username = *u.Username
+	}
+	return &LoginResponse{
+		RequiresStepUp: true,
+		StepUpMethods:  []string{method},
+		StepUpToken:    token,
+		Email:          u.Email,
+		Username:       username,
+		FullName:       strVal(u.FullName),
+	}, nil
+}
+
+// CompleteStepUpWithEmailCode completes a step-up challenge with the code sent by email
+func (s *EntAuthService) CompleteStepUpWithEmailCode(ctx context.Context, stepUpToken, code, ipAddress, userAgent, deviceFingerprint string) (*LoginResponse, error) {
+	u, pending, err := s.validatePendingLoginToken(ctx, stepUpToken, pendingTokenPurposeStepUp)
+	if err != nil {
+		return nil, err
+	}
+	if pending.EmailCodeHash == nil {
+		return nil, apperrors.ErrInvalidToken.WithDetails("Verifikasi ini harus diselesaikan dengan passkey")
+	}
+
+	// Count the attempt before comparing so concurrent guesses cannot exceed the limit
+	counted, err := s.client.TOTPPendingToken.Update().
+		Where(
+			totppendingtoken.IDEQ(pending.ID),
+			totppendingtoken.CodeAttemptsLT(stepUpMaxCodeAttempts),
+		).
+		AddCodeAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if counted == 0 {
+		return nil, apperrors.ErrTOTPMaxAttempts.WithDetails("Terlalu banyak kode salah. Silakan login ulang.")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(stepUpCodeHash(stepUpToken, strings.TrimSpace(code))), []byte(*pending.EmailCodeHash)) != 1 {
+		if securityAudit != nil {
+			securityAudit.LogLoginFailed(u.Email, ipAddress, userAgent, "Invalid step-up email code")
+		}
+		return nil, apperrors.NewAppError("STEP_UP_INVALID_CODE", "Kode verifikasi tidak valid", 401)
+	}
+
+	if err := s.consumeTOTPPendingToken(ctx, pending.ID); err != nil {
+		return nil, err
+	}
+	if riskEngine != nil {
+		riskEngine.LogStepUpCompleted(ctx, u, ipAddress, userAgent, StepUpMethodEmailCode)
+	}
+
+	return s.finishEmailStepUpLogin(ctx, u, ipAddress, userAgent, deviceFingerprint)
+}
+
+// CompleteStepUpWithPasskey completes a step-up challenge after the passkey assertion for
+// passkeyUser was verified. The passkey must belong to the user who passed the password step.
+func (s *EntAuthService) CompleteStepUpWithPasskey(ctx context.Context, stepUpToken string, passkeyUser *ent.User, ipAddress, userAgent, deviceFingerprint string) (*LoginResponse, error) {
+	u, pending, err := s.validatePendingLoginToken(ctx, stepUpToken, pendingTokenPurposeStepUp)
+	if err != nil {
+		return nil, err
+	}
+	if u.ID != passkeyUser.ID {
+		logger.Warn("Step-up passkey belongs to another user",
+			zap.Int("user_id", u.ID),
+			zap.Int("passkey_user_id", passkeyUser.ID))
+		return nil, apperrors.ErrInvalidToken.WithDetails("Passkey tidak cocok dengan akun yang sedang login")
+	}
+
+	if err := s.consumeTOTPPendingToken(ctx, pending.ID); err != nil {
+		return nil, err
+	}
+	if riskEngine != nil {
+		riskEngine.LogStepUpCompleted(ctx, u, ipAddress, userAgent, StepUpMethodPasskey)
+	}
+
+	return s.LoginWithPasskey(ctx, passkeyUser, ipAddress, userAgent, deviceFingerprint)
+}
+
+// finishEmailStepUpLogin creates the session for a step-up completed with an email code
+func (s *EntAuthService) finishEmailStepUpLogin(ctx context.Context, u *ent.User, ipAddress, userAgent, deviceFingerprint string) (*LoginResponse, error) {
+	if u.LockedUntil != nil && u.LockedUntil.After(time.Now()) {
+		return nil, apperrors.ErrAccountLockedBruteForce.WithDetails(
+			fmt.Sprintf("Akun dikunci selama %s. Alasan: %s", formatDuration(time.Until(*u.LockedUntil)), u.LockReason))
+	}
+	lock, err := s.client.SessionLock.
+		Query().
+		Where(sessionlock.UserIDEQ(u.ID)).
+		Order(ent.Desc(sessionlock.FieldCreatedAt)).
+		First(ctx)
+	if err == nil && lock.ExpiresAt.After(time.Now()) {
+		return nil, apperrors.ErrAccountLocked.WithDetails("Akun terkunci hingga " + lock.ExpiresAt.Format("02 Jan 2006 15:04"))
+	}
+
+	sessionService := NewEntSessionService()
+	fingerprintHash := deviceFingerprintHash(deviceFingerprint, userAgent)
+	tokenPair, err := sessionService.CreateSessionForDevice(ctx, u, ipAddress, userAgent, fingerprintHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if fingerprintHash != "" && deviceTracker != nil {
+		if err := deviceTracker.RecordDeviceLogin(ctx, u.ID, fingerprintHash, ipAddress, userAgent); err != nil {
+			logger.Warn("Failed to record device login for step-up", zap.Error(err))
+		}
+	}
+	if securityAudit != nil {
+		securityAudit.LogLoginSuccessForEnt(u, ipAddress, userAgent)
+		securityAudit.LogSessionCreatedForEnt(u, ipAddress, userAgent)
+	}
+
+	username := ""
+	if u.Username != nil {
+		username = *u.Username
+	}
+
+	logger.Info("User completed step-up login with email code",
+		zap.Int("user_id", u.ID),
+		zap.String("email", u.Email),
+		zap.String("ip", ipAddress))
+
+	return &LoginResponse{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresIn:    tokenPair.ExpiresIn,
+		Email:        u.Email,
+		Username:     username,
+		FullName:     strVal(u.FullName),
+	}, nil
+}
+
+// generateStepUpEmailCode returns a random 6-digit code
+func generateStepUpEmailCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// stepUpCodeHash hashes an email code with its step-up token, which is never stored, so a
+// leaked row cannot be brute-forced offline
+func stepUpCodeHash(token, code string) string {
+	sum := sha256.Sum256([]byte(token + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
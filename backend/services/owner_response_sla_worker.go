@@ -16,7 +16,9 @@ import (
 const slaWorkerLockKey = "sla_worker_lock"
 const slaWorkerLockTTL = 60 * time.Second
 
-// OwnerResponseSLAWorker periodically enforces owner-response SLA reminders and timeout freeze.
+// OwnerResponseSLAWorker periodically enforces owner-response SLA reminders and timeout freeze,
+// validator delivery SLA reminders, misses and escalations after Lock Funds, and executes
+// guarantee slashes whose appeal window has passed.
 // Uses Redis distributed lock to prevent duplicate processing across multiple instances.
 type OwnerResponseSLAWorker struct {
 	workflow   *EntValidationCaseWorkflowService
@@ -95,6 +97,14 @@ func (w *OwnerResponseSLAWorker) runIteration() {
 	reminders, timeouts, err := w.workflow.ProcessOwnerResponseSLA(ctx)
 	cancel()
 
+	deliveryCtx, deliveryCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	deliveryReminders, misses, escalations, deliveryErr := w.workflow.ProcessValidatorDeliverySLA(deliveryCtx)
+	deliveryCancel()
+
+	slashCtx, slashCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	slashes, slashErr := w.workflow.ProcessGuaranteeSlashes(slashCtx)
+	slashCancel()
+
 	// Release lock after processing
 	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	_ = ReleaseLock(releaseCtx, slaWorkerLockKey)
@@ -102,14 +112,28 @@ func (w *OwnerResponseSLAWorker) runIteration() {
 
 	if err != nil {
 		logger.Warn("Owner-response SLA worker iteration failed", zap.Error(err))
-		return
-	}
-	if reminders > 0 || timeouts > 0 {
+	} else if reminders > 0 || timeouts > 0 {
 		logger.Info("Owner-response SLA worker applied updates",
 			zap.Int("reminders", reminders),
 			zap.Int("timeouts", timeouts),
 		)
 	}
+
+	if deliveryErr != nil {
+		logger.Warn("Validator delivery SLA iteration failed", zap.Error(deliveryErr))
+	} else if deliveryReminders > 0 || misses > 0 || escalations > 0 {
+		logger.Info("Validator delivery SLA applied updates",
+			zap.Int("reminders", deliveryReminders),
+			zap.Int("misses", misses),
+			zap.Int("escalations", escalations),
+		)
+	}
+
+	if slashErr != nil {
+		logger.Warn("Guarantee slash iteration failed", zap.Error(slashErr))
+	} else if slashes > 0 {
+		logger.Info("Guarantee slashes executed", zap.Int("executed", slashes))
+	}
 }
 
 func (w *OwnerResponseSLAWorker) Stop() {
@@ -265,24 +265,29 @@ func (u *EntWebAuthnUser) WebAuthnCredentials() []webauthn.Credential {
 
 // entPasskeyToWebAuthnCredential converts an Ent Passkey to webauthn.Credential
 func entPasskeyToWebAuthnCredential(pk *ent.Passkey) webauthn.Credential {
-	transports := pk.Transports
+	return buildWebAuthnCredential(pk.CredentialID, pk.PublicKey, pk.AttestationType, pk.Aaguid,
+		pk.SignCount, pk.Transports, pk.BackupEligible, pk.BackupState)
+}
+
+// buildWebAuthnCredential assembles a webauthn.Credential from stored passkey columns
+func buildWebAuthnCredential(credentialID, publicKey []byte, attestationType string, aaguid []byte, signCount uint32, transports []string, backupEligible, backupState bool) webauthn.Credential {
 	authTransports := make([]protocol.AuthenticatorTransport, len(transports))
 	for i, t := range transports {
 		authTransports[i] = protocol.AuthenticatorTransport(t)
 	}
 
 	return webauthn.Credential{
-		ID:              pk.CredentialID,
-		PublicKey:       pk.PublicKey,
-		AttestationType: pk.AttestationType,
+		ID:              credentialID,
+		PublicKey:       publicKey,
+		AttestationType: attestationType,
 		Authenticator: webauthn.Authenticator{
-			AAGUID:    pk.Aaguid,
-			SignCount: pk.SignCount,
+			AAGUID:    aaguid,
+			SignCount: signCount,
 		},
 		Transport: authTransports,
 		Flags: webauthn.CredentialFlags{
-			BackupEligible: pk.BackupEligible,
-			BackupState:    pk.BackupState,
+			BackupEligible: backupEligible,
+			BackupState:    backupState,
 		},
 	}
 }
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/securityevent"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+// maxRequestInspectionHits bounds the SecurityEvent rows written for one request, so a
+// scanner sending large payloads cannot flood the table
+const maxRequestInspectionHits = 10
+
+// RequestInspectionHit is one detector match recorded by the request inspection middleware
+type RequestInspectionHit struct {
+	Detector string
+	Field    string
+	Pattern  string
+	Sample   string
+	Blocking bool
+}
+
+// RequestInspectionRecord is a request with at least one inspection hit
+type RequestInspectionRecord struct {
+	Mode      string
+	Method    string
+	Route     string
+	IP        string
+	UserAgent string
+	Blocked   bool
+	Hits      []RequestInspectionHit
+}
+
+// requestInspectionDetails is the JSON stored in details of request_inspection_hit events
+type requestInspectionDetails struct {
+	Detector string `json:"detector"`
+	Field    string `json:"field"`
+	Method   string `json:"method"`
+	Route    string `json:"route"`
+	Mode     string `json:"mode"`
+	Action   string `json:"action"` // "blocked" or "monitored"
+	Pattern  string `json:"pattern"`
+	Sample   string `json:"sample"`
+}
+
+// falsePositiveDetails is the JSON stored in details of request_inspection_false_positive events
+type falsePositiveDetails struct {
+	EventID  int    `json:"event_id"`
+	Detector string `json:"detector"`
+	Field    string `json:"field"`
+	Route    string `json:"route"`
+	Note     string `json:"note,omitempty"`
+}
+
+// LogRequestInspection records one request_inspection_hit event per hit
+func (s *EntSecurityAuditService) LogRequestInspection(ctx context.Context, rec RequestInspectionRecord) {
+	action := "monitored"
+	if rec.Blocked {
+		action = "blocked"
+	}
+	hits := rec.Hits
+	if len(hits) > maxRequestInspectionHits {
+		hits = hits[:maxRequestInspectionHits]
+	}
+	for _, h := range hits {
+		details, err := json.Marshal(requestInspectionDetails{
+			Detector: h.Detector,
+			Field:    h.Field,
+			Method:   rec.Method,
+			Route:    rec.Route,
+			Mode:     rec.Mode,
+			Action:   action,
+			Pattern:  truncateString(h.Pattern, 200),
+			Sample:   h.Sample,
+		})
+		if err != nil {
+			continue
+		}
+		severity := "info"
+		if h.Blocking {
+			severity = "warning"
+		}
+		s.LogEvent(ctx, EventRequestInspectionHit, nil, "", rec.IP, rec.UserAgent, string(details), severity, !rec.Blocked)
+	}
+}
+
+// detailsJSONField reads a key from the JSON details column
+func detailsJSONField(s *sql.Selector, key string) string {
+	return "(" + s.C(securityevent.FieldDetails) + "::jsonb ->> '" + key + "')"
+}
+
+func falsePositiveFor(eventID int) predicate.SecurityEvent {
+	return predicate.SecurityEvent(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(detailsJSONField(s, "event_id")).WriteString(" = ").Arg(strconv.Itoa(eventID))
+		}))
+	})
+}
+
+// MarkInspectionFalsePositive records that an admin reviewed a request_inspection_hit
+// event and found the input legitimate. Each hit can be marked once.
+func (s *EntSecurityAuditService) MarkInspectionFalsePositive(ctx context.Context, actor AdminActor, eventID int, note string) (*ent.SecurityEvent, error) {
+	client := database.GetEntClient()
+	hit, err := client.SecurityEvent.Get(ctx, eventID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrSecurityEventNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if hit.EventType != EventRequestInspectionHit {
+		return nil, apperrors.ErrInvalidInput.WithDetails("Hanya event request_inspection_hit yang dapat ditandai false positive")
+	}
+	var hitDetails requestInspectionDetails
+	if err := json.Unmarshal([]byte(hit.Details), &hitDetails); err != nil {
+		return nil, apperrors.ErrInvalidInput.WithDetails("Detail event tidak dapat dibaca")
+	}
+
+	exists, err := client.SecurityEvent.Query().
+		Where(securityevent.EventTypeEQ(EventRequestInspectionFalsePositive), falsePositiveFor(eventID)).
+		Exist(ctx)
+	if err != nil {
+		logger.Error("Failed to check false positive marker", zap.Error(err), zap.Int("event_id", eventID))
+		return nil, apperrors.ErrDatabase
+	}
+	if exists {
+		return nil, apperrors.ErrInvalidInput.WithDetails("Event sudah ditandai false positive")
+	}
+
+	details, err := json.Marshal(falsePositiveDetails{
+		EventID:  eventID,
+		Detector: hitDetails.Detector,
+		Field:    hitDetails.Field,
+		Route:    hitDetails.Route,
+		Note:     truncateString(note, 500),
+	})
+	if err != nil {
+		return nil, apperrors.ErrInternalServer
+	}
+	marker, err := client.SecurityEvent.Create().
+		SetEventType(EventRequestInspectionFalsePositive).
+		SetEmail(actor.Email).
+		SetIPAddress(actor.IP).
+		SetUserAgent(actor.UserAgent).
+		SetSuccess(true).
+		SetDetails(string(details)).
+		SetSeverity("info").
+		Save(ctx)
+	if err != nil {
+		logger.Error("Failed to record false positive", zap.Error(err), zap.Int("event_id", eventID))
+		return nil, apperrors.ErrDatabase
+	}
+	logger.Info("Request inspection hit marked as false positive",
+		zap.Int("event_id", eventID),
+		zap.String("detector", hitDetails.Detector),
+		zap.String("admin_email", actor.Email))
+	return marker, nil
+}
+
+// RequestInspectionDetectorStat summarises recorded hits for one detector. FalsePositiveRate
+// is false positives / hits over the range, or 0 without hits.
+type RequestInspectionDetectorStat struct {
+	Detector          string  `json:"detector"`
+	Hits              int     `json:"hits"`
+	Blocked           int     `json:"blocked"`
+	FalsePositives    int     `json:"false_positives"`
+	FalsePositiveRate float64 `json:"false_positive_rate"`
+}
+
+// RequestInspectionStats aggregates recorded hits and admin false positive markers per
+// detector. Only the time range of the filter is used.
+func (s *EntSecurityAuditService) RequestInspectionStats(ctx context.Context, f SecurityEventFilter) ([]RequestInspectionDetectorStat, error) {
+	f = SecurityEventFilter{
+		EventTypes: []string{EventRequestInspectionHit, EventRequestInspectionFalsePositive},
+		From:       f.From,
+		To:         f.To,
+	}
+	sel, t := securityEventSelector(f)
+	detector := detailsJSONField(sel, "detector")
+	eventType := t.C(securityevent.FieldEventType)
+	isHit := eventType + " = '" + EventRequestInspectionHit + "'"
+	sel.Select(
+		detector+" AS detector",
+		"COUNT(*) FILTER (WHERE "+isHit+") AS hits",
+		"COUNT(*) FILTER (WHERE "+isHit+" AND "+detailsJSONField(sel, "action")+" = 'blocked') AS blocked",
+		"COUNT(*) FILTER (WHERE "+eventType+" = '"+EventRequestInspectionFalsePositive+"') AS false_positives",
+	).
+		GroupBy(detector).
+		OrderExpr(sql.Expr("hits DESC"))
+
+	out := []RequestInspectionDetectorStat{}
+	err := s.queryAggregate(ctx, sel, func(rows interface{ Scan(...any) error }) error {
+		var st RequestInspectionDetectorStat
+		var name *string
+		if err := rows.Scan(&name, &st.Hits, &st.Blocked, &st.FalsePositives); err != nil {
+			return err
+		}
+		if name != nil {
+			st.Detector = *name
+		}
+		if st.Hits > 0 {
+			st.FalsePositiveRate = float64(st.FalsePositives) / float64(st.Hits)
+		}
+		out = append(out, st)
+		return nil
+	})
+	return out, err
+}
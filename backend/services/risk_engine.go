@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/deviceusermapping"
+	"backend-gin/ent/passkey"
+	"backend-gin/ent/session"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+// RiskDecision is the outcome of a risk assessment
+type RiskDecision string
+
+const (
+	RiskDecisionAllow  RiskDecision = "allow"
+	RiskDecisionStepUp RiskDecision = "step_up"
+	RiskDecisionBlock  RiskDecision = "block"
+)
+
+// RiskEventKind identifies what is being assessed
+type RiskEventKind string
+
+const (
+	RiskEventLogin   RiskEventKind = "login"
+	RiskEventRefresh RiskEventKind = "refresh"
+)
+
+// Risk signal codes
+const (
+	RiskSignalImpossibleTravel = "impossible_travel"
+	RiskSignalCountryChange    = "country_change"
+	RiskSignalASNChange        = "asn_change"
+	RiskSignalNewDevice        = "new_device"
+	RiskSignalUnknownDevice    = "unknown_device"
+	RiskSignalSessionAnomaly   = "session_anomaly"
+	RiskSignalFailedVelocity   = "failed_attempt_velocity"
+)
+
+// Signal weights (summed into the score; thresholds are configurable)
+const (
+	riskWeightImpossibleTravel   = 60
+	riskWeightCountryChange      = 30
+	riskWeightASNChange          = 15
+	riskWeightNewDevice          = 25
+	riskWeightUnknownDevice      = 10
+	riskWeightSessionAnomaly     = 25
+	riskWeightFailedVelocityLow  = 15
+	riskWeightFailedVelocityHigh = 35
+)
+
+const (
+	// impossibleTravelSpeedKmh is faster than any commercial flight including airport time
+	impossibleTravelSpeedKmh = 1000.0
+	// impossibleTravelMinDistanceKm ignores geo-IP jitter between nearby cities
+	impossibleTravelMinDistanceKm = 500.0
+
+	// riskFailureWindow is the sliding window for failed-attempt velocity
+	riskFailureWindow = 15 * time.Minute
+	// Failed attempts per email in the window before the velocity signal fires
+	riskFailuresPerEmailLow  = 3
+	riskFailuresPerEmailHigh = 5
+	// Failed attempts per IP (across emails) before the velocity signal fires high
+	riskFailuresPerIPHigh = 10
+)
+
+// RiskSignal is one contributing factor in an assessment
+type RiskSignal struct {
+	Code   string `json:"code"`
+	Score  int    `json:"score"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// RiskAssessment is the scored result for a single login or refresh
+type RiskAssessment struct {
+	Score    int          `json:"score"`
+	Decision RiskDecision `json:"decision"`
+	Signals  []RiskSignal `json:"signals"`
+	// TrustedDevice is set when the login comes from a device the user marked trusted
+	TrustedDevice bool `json:"trusted_device"`
+}
+
+// Reasons returns signal codes with details, for logging
+func (a *RiskAssessment) Reasons() []string {
+	reasons := make([]string, 0, len(a.Signals))
+	for _, sig := range a.Signals {
+		if sig.Detail != "" {
+			reasons = append(reasons, sig.Code+" ("+sig.Detail+")")
+		} else {
+			reasons = append(reasons, sig.Code)
+		}
+	}
+	return reasons
+}
+
+func (a *RiskAssessment) add(code string, score int, detail string) {
+	a.Signals = append(a.Signals, RiskSignal{Code: code, Score: score, Detail: detail})
+	a.Score += score
+}
+
+// RiskConfig holds the decision thresholds
+type RiskConfig struct {
+	Enabled         bool
+	StepUpThreshold int
+	BlockThreshold  int
+}
+
+// DefaultRiskConfig returns the built-in thresholds
+func DefaultRiskConfig() RiskConfig {
+	return RiskConfig{
+		Enabled:         true,
+		StepUpThreshold: 40,
+		BlockThreshold:  90,
+	}
+}
+
+// decideForDevice is decide, except that a trusted device skips step-up (blocks still apply)
+func (c RiskConfig) decideForDevice(score int, trusted bool) RiskDecision {
+	d := c.decide(score)
+	if d == RiskDecisionStepUp && trusted {
+		return RiskDecisionAllow
+	}
+	return d
+}
+
+// decide maps a score to a decision
+func (c RiskConfig) decide(score int) RiskDecision {
+	switch {
+	case c.BlockThreshold > 0 && score >= c.BlockThreshold:
+		return RiskDecisionBlock
+	case c.StepUpThreshold > 0 && score >= c.StepUpThreshold:
+		return RiskDecisionStepUp
+	default:
+		return RiskDecisionAllow
+	}
+}
+
+// RiskInput describes the authentication attempt being assessed
+type RiskInput struct {
+	Kind            RiskEventKind
+	User            *ent.User
+	IP              string
+	UserAgent       string
+	FingerprintHash string // login only; empty when the client sent no fingerprint
+	SessionID       int    // refresh only; excluded from location history
+	PreviousIP      string // refresh only; IP recorded on the session being refreshed
+	PreviousAt      time.Time
+}
+
+// RiskEngine scores logins and refreshes from geo, device, session and velocity signals
+type RiskEngine struct {
+	client   *ent.Client
+	cfg      RiskConfig
+	geo      *GeoLookupService
+	sessions *EntSessionService
+	audit    *EntSecurityAuditService
+	failures *failureVelocityTracker
+}
+
+// NewRiskEngine creates a risk engine with the given thresholds
+func NewRiskEngine(cfg RiskConfig) *RiskEngine {
+	return &RiskEngine{
+		client:   database.GetEntClient(),
+		cfg:      cfg,
+		geo:      GetGeoLookupService(),
+		sessions: NewEntSessionService(),
+		audit:    NewEntSecurityAuditService(),
+		failures: newFailureVelocityTracker(riskFailureWindow),
+	}
+}
+
+// Global risk engine (nil = adaptive authentication disabled)
+var riskEngine *RiskEngine
+
+// InitRiskEngine initializes the global risk engine
+func InitRiskEngine(cfg RiskConfig) {
+	riskEngine = NewRiskEngine(cfg)
+	logger.Info("Risk engine initialized",
+		zap.Bool("enabled", cfg.Enabled),
+		zap.Int("step_up_threshold", cfg.StepUpThreshold),
+		zap.Int("block_threshold", cfg.BlockThreshold))
+}
+
+// GetRiskEngine returns the global risk engine (may be nil)
+func GetRiskEngine() *RiskEngine {
+	return riskEngine
+}
+
+// RecordLoginFailure feeds the failed-attempt velocity signal
+func (e *RiskEngine) RecordLoginFailure(email, ip string) {
+	e.failures.record("email:"+strings.ToLower(email), time.Now())
+	if ip != "" {
+		e.failures.record("ip:"+ip, time.Now())
+	}
+}
+
+// ResetLoginFailures clears per-email failures after a successful login
+func (e *RiskEngine) ResetLoginFailures(email string) {
+	e.failures.reset("email:" + strings.ToLower(email))
+}
+
+// Assess scores an authentication attempt, logs the result, and records a
+// SecurityEvent for step-up and block decisions. It fails open on lookup errors.
+func (e *RiskEngine) Assess(ctx context.Context, in RiskInput) *RiskAssessment {
+	a := &RiskAssessment{Decision: RiskDecisionAllow, Signals: []RiskSignal{}}
+	if !e.cfg.Enabled || in.User == nil {
+		return a
+	}
+
+	e.assessLocation(ctx, in, a)
+	if in.Kind == RiskEventLogin {
+		e.assessDevice(ctx, in, a)
+		e.assessVelocity(in, a)
+	}
+	if anomalous, details := e.sessions.DetectSessionAnomaly(ctx, in.User.ID); anomalous {
+		a.add(RiskSignalSessionAnomaly, riskWeightSessionAnomaly, strings.TrimSuffix(strings.TrimSpace(details), ";"))
+	}
+
+	a.Decision = e.cfg.decideForDevice(a.Score, a.TrustedDevice)
+	e.record(ctx, in, a)
+	return a
+}
+
+// assessLocation compares the current IP with the previous session location
+func (e *RiskEngine) assessLocation(ctx context.Context, in RiskInput, a *RiskAssessment) {
+	prevIP, prevAt := in.PreviousIP, in.PreviousAt
+	if prevIP == "" {
+		q := e.client.Session.Query().
+			Where(session.UserIDEQ(in.User.ID), session.IPAddressNEQ(""))
+		if in.SessionID > 0 {
+			q = q.Where(session.IDNEQ(in.SessionID))
+		}
+		last, err := q.Order(ent.Desc(session.FieldLastUsedAt)).First(ctx)
+		if err != nil {
+			return
+		}
+		prevIP, prevAt = last.IPAddress, last.LastUsedAt
+	}
+	if prevIP == "" || prevIP == in.IP || e.geo == nil {
+		return
+	}
+
+	prev := e.geo.LookupIP(ctx, prevIP)
+	cur := e.geo.LookupIP(ctx, in.IP)
+	for _, sig := range locationChangeSignals(prev, cur, time.Since(prevAt)) {
+		a.add(sig.Code, sig.Score, sig.Detail)
+	}
+}
+
+// locationChangeSignals derives travel, country and network signals from two geo lookups
+func locationChangeSignals(prev, cur *GeoLocation, elapsed time.Duration) []RiskSignal {
+	if prev == nil || cur == nil {
+		return nil
+	}
+	var signals []RiskSignal
+
+	if prev.CountryCode != "" && cur.CountryCode != "" && prev.CountryCode != cur.CountryCode {
+		signals = append(signals, RiskSignal{
+			Code:   RiskSignalCountryChange,
+			Score:  riskWeightCountryChange,
+			Detail: prev.CountryCode + "->" + cur.CountryCode,
+		})
+	}
+
+	if prev.ASN != "" && cur.ASN != "" && asnNumber(prev.ASN) != asnNumber(cur.ASN) {
+		signals = append(signals, RiskSignal{
+			Code:   RiskSignalASNChange,
+			Score:  riskWeightASNChange,
+			Detail: asnNumber(prev.ASN) + "->" + asnNumber(cur.ASN),
+		})
+	}
+
+	if (prev.Latitude != 0 || prev.Longitude != 0) && (cur.Latitude != 0 || cur.Longitude != 0) {
+		km := haversineKm(prev.Latitude, prev.Longitude, cur.Latitude, cur.Longitude)
+		hours := math.Max(elapsed.Hours(), 1.0/60)
+		if km >= impossibleTravelMinDistanceKm && km/hours > impossibleTravelSpeedKmh {
+			signals = append(signals, RiskSignal{
+				Code:   RiskSignalImpossibleTravel,
+				Score:  riskWeightImpossibleTravel,
+				Detail: fmt.Sprintf("%.0f km in %s", km, elapsed.Round(time.Minute)),
+			})
+		}
+	}
+	return signals
+}
+
+// assessDevice flags logins from devices not previously seen for this user
+func (e *RiskEngine) assessDevice(ctx context.Context, in RiskInput, a *RiskAssessment) {
+	if in.FingerprintHash == "" {
+		a.add(RiskSignalUnknownDevice, riskWeightUnknownDevice, "")
+		return
+	}
+	mapping, err := e.client.DeviceUserMapping.Query().
+		Where(
+			deviceusermapping.UserIDEQ(in.User.ID),
+			deviceusermapping.FingerprintHashEQ(in.FingerprintHash),
+			deviceusermapping.RemovedAtIsNil(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		a.add(RiskSignalNewDevice, riskWeightNewDevice, "")
+		return
+	}
+	if err != nil {
+		logger.Debug("Risk engine: device lookup failed", zap.Error(err))
+		return
+	}
+	a.TrustedDevice = mapping.Trusted
+}
+
+// assessVelocity scores recent failed attempts for the email and IP
+func (e *RiskEngine) assessVelocity(in RiskInput, a *RiskAssessment) {
+	now := time.Now()
+	emailFailures := e.failures.count("email:"+strings.ToLower(in.User.Email), now)
+	ipFailures := 0
+	if in.IP != "" {
+		ipFailures = e.failures.count("ip:"+in.IP, now)
+	}
+
+	detail := fmt.Sprintf("%d for account, %d from IP in %s", emailFailures, ipFailures, riskFailureWindow)
+	switch {
+	case emailFailures >= riskFailuresPerEmailHigh || ipFailures >= riskFailuresPerIPHigh:
+		a.add(RiskSignalFailedVelocity, riskWeightFailedVelocityHigh, detail)
+	case emailFailures >= riskFailuresPerEmailLow:
+		a.add(RiskSignalFailedVelocity, riskWeightFailedVelocityLow, detail)
+	}
+}
+
+// record logs every assessment and persists step-up/block decisions as SecurityEvents
+func (e *RiskEngine) record(ctx context.Context, in RiskInput, a *RiskAssessment) {
+	reasons := a.Reasons()
+	logger.Info("Risk assessment",
+		zap.Int("user_id", in.User.ID),
+		zap.String("kind", string(in.Kind)),
+		zap.String("ip", in.IP),
+		zap.Int("score", a.Score),
+		zap.String("decision", string(a.Decision)),
+		zap.Bool("trusted_device", a.TrustedDevice),
+		zap.Strings("reasons", reasons))
+
+	var eventType SecurityEventType
+	severity := "warning"
+	switch a.Decision {
+	case RiskDecisionBlock:
+		eventType = EventRiskBlocked
+		severity = "critical"
+	case RiskDecisionStepUp:
+		eventType = EventRiskStepUp
+	default:
+		return
+	}
+
+	userID := in.User.ID
+	details := fmt.Sprintf("%s score=%d reasons=%s", in.Kind, a.Score, strings.Join(reasons, "; "))
+	e.audit.LogEvent(ctx, eventType, &userID, in.User.Email, in.IP, in.UserAgent, details, severity, a.Decision != RiskDecisionBlock)
+}
+
+// HasStepUpFactor reports whether the user can complete a passkey step-up
+func (e *RiskEngine) HasStepUpFactor(ctx context.Context, userID int) bool {
+	exists, err := e.client.Passkey.Query().Where(passkey.UserIDEQ(userID)).Exist(ctx)
+	return err == nil && exists
+}
+
+// LogStepUpEmailCode records that a user without a second factor was sent an email code
+func (e *RiskEngine) LogStepUpEmailCode(ctx context.Context, u *ent.User, ip, userAgent string, a *RiskAssessment) {
+	userID := u.ID
+	details := fmt.Sprintf("score=%d reasons=%s; no TOTP or passkey enrolled, email code sent", a.Score, strings.Join(a.Reasons(), "; "))
+	e.audit.LogEvent(ctx, EventRiskStepUpEmailCode, &userID, u.Email, ip, userAgent, details, "warning", true)
+}
+
+// LogStepUpCompleted records that a step-up challenge was answered with the given method
+func (e *RiskEngine) LogStepUpCompleted(ctx context.Context, u *ent.User, ip, userAgent, method string) {
+	userID := u.ID
+	e.audit.LogEvent(ctx, EventRiskStepUpCompleted, &userID, u.Email, ip, userAgent, "method="+method, "info", true)
+}
+
+// haversineKm returns the great-circle distance between two coordinates
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadiusKm = 6371.0
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// asnNumber extracts "AS15169" from "AS15169 Google LLC"
+func asnNumber(as string) string {
+	as = strings.TrimSpace(as)
+	if i := strings.IndexByte(as, ' '); i > 0 {
+		return as[:i]
+	}
+	return as
+}
+
+// failureVelocityTracker counts events per key in a sliding window (in-memory)
+type failureVelocityTracker struct {
+	mu     sync.Mutex
+	window time.Duration
+	events map[string][]time.Time
+}
+
+func newFailureVelocityTracker(window time.Duration) *failureVelocityTracker {
+	return &failureVelocityTracker{
+		window: window,
+		events: make(map[string][]time.Time),
+	}
+}
+
+func (t *failureVelocityTracker) record(key string, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events[key] = append(t.prune(key, at), at)
+	// Opportunistic cleanup keeps the map bounded without a background goroutine
+	if len(t.events) > 10000 {
+		for k := range t.events {
+			if len(t.prune(k, at)) == 0 {
+				delete(t.events, k)
+			}
+		}
+	}
+}
+
+func (t *failureVelocityTracker) count(key string, now time.Time) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.prune(key, now))
+}
+
+func (t *failureVelocityTracker) reset(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.events, key)
+}
+
+// prune drops events older than the window; caller must hold mu
+func (t *failureVelocityTracker) prune(key string, now time.Time) []time.Time {
+	events := t.events[key]
+	cutoff := now.Add(-t.window)
+	i := 0
+	for i < len(events) && events[i].Before(cutoff) {
+		i++
+	}
+	events = events[i:]
+	if len(events) == 0 {
+		delete(t.events, key)
+		return nil
+	}
+	t.events[key] = events
+	return events
+}
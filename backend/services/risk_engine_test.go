@@ -0,0 +1,89 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskConfig_Decide(t *testing.T) {
+	cfg := DefaultRiskConfig()
+
+	assert.Equal(t, RiskDecisionAllow, cfg.decide(0))
+	assert.Equal(t, RiskDecisionAllow, cfg.decide(cfg.StepUpThreshold-1))
+	assert.Equal(t, RiskDecisionStepUp, cfg.decide(cfg.StepUpThreshold))
+	assert.Equal(t, RiskDecisionBlock, cfg.decide(cfg.BlockThreshold))
+}
+
+func TestRiskConfig_DecideForTrustedDevice(t *testing.T) {
+	cfg := DefaultRiskConfig()
+
+	assert.Equal(t, RiskDecisionStepUp, cfg.decideForDevice(cfg.StepUpThreshold, false))
+	assert.Equal(t, RiskDecisionAllow, cfg.decideForDevice(cfg.StepUpThreshold, true))
+	assert.Equal(t, RiskDecisionBlock, cfg.decideForDevice(cfg.BlockThreshold, true))
+}
+
+func TestLocationChangeSignals(t *testing.T) {
+	jakarta := &GeoLocation{CountryCode: "ID", ASN: "AS7713 PT Telekomunikasi Indonesia", Latitude: -6.2, Longitude: 106.8}
+	bandung := &GeoLocation{CountryCode: "ID", ASN: "AS7713 Telkom", Latitude: -6.9, Longitude: 107.6}
+	frankfurt := &GeoLocation{CountryCode: "DE", ASN: "AS24940 Hetzner Online GmbH", Latitude: 50.1, Longitude: 8.7}
+
+	codes := func(signals []RiskSignal) []string {
+		out := make([]string, 0, len(signals))
+		for _, s := range signals {
+			out = append(out, s.Code)
+		}
+		return out
+	}
+
+	t.Run("same network nearby city", func(t *testing.T) {
+		assert.Empty(t, locationChangeSignals(jakarta, bandung, 10*time.Minute))
+	})
+
+	t.Run("country and network change within minutes is impossible travel", func(t *testing.T) {
+		got := codes(locationChangeSignals(jakarta, frankfurt, 20*time.Minute))
+		assert.ElementsMatch(t, []string{RiskSignalCountryChange, RiskSignalASNChange, RiskSignalImpossibleTravel}, got)
+	})
+
+	t.Run("country change after a long flight is not impossible travel", func(t *testing.T) {
+		got := codes(locationChangeSignals(jakarta, frankfurt, 24*time.Hour))
+		assert.ElementsMatch(t, []string{RiskSignalCountryChange, RiskSignalASNChange}, got)
+	})
+
+	t.Run("missing geo data fails open", func(t *testing.T) {
+		assert.Empty(t, locationChangeSignals(nil, frankfurt, time.Minute))
+	})
+}
+
+func TestFailureVelocityTracker(t *testing.T) {
+	tracker := newFailureVelocityTracker(15 * time.Minute)
+	now := time.Now()
+
+	tracker.record("email:a@example.com", now.Add(-20*time.Minute))
+	tracker.record("email:a@example.com", now.Add(-5*time.Minute))
+	tracker.record("email:a@example.com", now.Add(-1*time.Minute))
+
+	require.Equal(t, 2, tracker.count("email:a@example.com", now), "events outside the window are pruned")
+
+	tracker.reset("email:a@example.com")
+	assert.Equal(t, 0, tracker.count("email:a@example.com", now))
+}
+
+func TestHaversineKm(t *testing.T) {
+	// Jakarta -> Singapore is roughly 880 km
+	km := haversineKm(-6.2, 106.8, 1.35, 103.8)
+	assert.InDelta(t, 880, km, 30)
+}
+
+func TestStepUpEmailCode(t *testing.T) {
+	code, err := generateStepUpEmailCode()
+	require.NoError(t, err)
+	assert.Regexp(t, `^\d{6}$`, code)
+
+	// The stored hash is bound to the step-up token, so the same code under another
+	// login's token does not match
+	assert.Equal(t, stepUpCodeHash("token-a", code), stepUpCodeHash("token-a", code))
+	assert.NotEqual(t, stepUpCodeHash("token-a", code), stepUpCodeHash("token-b", code))
+}
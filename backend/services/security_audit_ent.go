@@ -89,10 +89,31 @@ func (s *EntSecurityAuditService) LogAccountLocked(ctx context.Context, user *en
 	s.LogEvent(ctx, EventAccountLocked, &userID, user.Email, ip, "", details, "critical", false)
 }
 
-// LogBruteForceDetected logs brute force attack detection
+// LogBruteForceDetected logs brute force attack detection and temporarily blocks the IP
+// on every instance (see BRUTE_FORCE_AUTO_BLOCK_MINUTES)
 func (s *EntSecurityAuditService) LogBruteForceDetected(ctx context.Context, email, ip string, attempts int) {
 	details := fmt.Sprintf("%d failed attempts detected", attempts)
 	s.LogEvent(ctx, EventBruteForce, nil, email, ip, "", details, "critical", false)
+	autoBlockBruteForce(ctx, ip, attempts)
+}
+
+// RecordFailedLoginFromIP counts a failed password login from ip across all accounts and
+// reports brute force once the IP reaches BruteForceIPThreshold within AttemptWindow.
+// The counter is keyed by IP only, so it never locks an account, and it is per instance.
+func (s *EntSecurityAuditService) RecordFailedLoginFromIP(ctx context.Context, email, ip string) {
+	if ip == "" {
+		return
+	}
+	key := "ip:" + ip
+	now := time.Now()
+	bruteForceIPFailures.record(key, now)
+	attempts := bruteForceIPFailures.count(key, now)
+	if attempts < BruteForceIPThreshold() {
+		return
+	}
+	// Start counting afresh so one burst reports (and blocks) once
+	bruteForceIPFailures.reset(key)
+	s.LogBruteForceDetected(ctx, email, ip, attempts)
 }
 
 // LogTOTPFailed logs a failed TOTP attempt
@@ -0,0 +1,501 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/predicate"
+	"backend-gin/ent/securityevent"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+const (
+	// SecurityEventExportMaxRange bounds a single export so incident reports stay scoped
+	SecurityEventExportMaxRange = 93 * 24 * time.Hour
+
+	// SecurityEventAnalyticsDefaultRange applies when an analytics request has no "from"
+	SecurityEventAnalyticsDefaultRange = 7 * 24 * time.Hour
+
+	securityEventExportBatch = 1000
+
+	// securityEventCIDRMaxAddresses bounds the stored addresses a CIDR filter may expand to
+	securityEventCIDRMaxAddresses = 10000
+)
+
+// Export formats for ExportEvents
+const (
+	SecurityEventExportCSV   = "csv"
+	SecurityEventExportJSONL = "jsonl"
+)
+
+// SecurityEventFilter narrows admin security event queries. Empty fields are ignored.
+type SecurityEventFilter struct {
+	EventTypes []string
+	Severities []string
+	IP         string     // exact match
+	CIDR       *net.IPNet // network match, e.g. 10.0.0.0/8
+	Email      string     // case-insensitive exact match
+	UserID     int
+	Success    *bool
+	From       *time.Time
+	To         *time.Time
+
+	// cidrAddresses are the stored addresses inside CIDR, set by resolveCIDR
+	cidrAddresses []string
+	cidrResolved  bool
+}
+
+func (f SecurityEventFilter) predicates() []predicate.SecurityEvent {
+	var preds []predicate.SecurityEvent
+	if len(f.EventTypes) > 0 {
+		preds = append(preds, securityevent.EventTypeIn(f.EventTypes...))
+	}
+	if len(f.Severities) > 0 {
+		preds = append(preds, securityevent.SeverityIn(f.Severities...))
+	}
+	if f.IP != "" {
+		preds = append(preds, securityevent.IPAddressEQ(f.IP))
+	}
+	if f.CIDR != nil {
+		if f.cidrResolved {
+			preds = append(preds, securityevent.IPAddressIn(f.cidrAddresses...))
+		} else {
+			// Fail closed: a network filter must be resolved before querying
+			preds = append(preds, securityevent.IDLT(0))
+		}
+	}
+	if f.Email != "" {
+		preds = append(preds, securityevent.EmailEqualFold(f.Email))
+	}
+	if f.UserID > 0 {
+		preds = append(preds, securityevent.UserIDEQ(f.UserID))
+	}
+	if f.Success != nil {
+		preds = append(preds, securityevent.SuccessEQ(*f.Success))
+	}
+	if f.From != nil {
+		preds = append(preds, securityevent.CreatedAtGTE(*f.From))
+	}
+	if f.To != nil {
+		preds = append(preds, securityevent.CreatedAtLT(*f.To))
+	}
+	return preds
+}
+
+// resolveCIDR expands the network filter into the stored addresses inside it. ip_address is
+// free text, so the distinct values matching the other filters are parsed strictly in Go
+// rather than cast to inet in SQL, where one malformed row fails the whole query.
+func (s *EntSecurityAuditService) resolveCIDR(ctx context.Context, f SecurityEventFilter) (SecurityEventFilter, error) {
+	if f.CIDR == nil || f.cidrResolved {
+		return f, nil
+	}
+	prefix, ok := securityEventPrefix(f.CIDR)
+	if !ok {
+		return f, apperrors.ErrInvalidInput.WithDetails("ip harus berupa alamat IP atau CIDR")
+	}
+	rest := f
+	rest.CIDR = nil
+	candidates, err := database.GetEntClient().SecurityEvent.Query().
+		Where(rest.predicates()...).
+		Where(securityevent.IPAddressNEQ("")).
+		Unique(true).
+		Select(securityevent.FieldIPAddress).
+		Strings(ctx)
+	if err != nil {
+		logger.Error("Failed to resolve security event CIDR filter", zap.Error(err))
+		return f, apperrors.ErrDatabase
+	}
+	f.cidrAddresses = securityEventAddressesWithin(prefix, candidates)
+	if len(f.cidrAddresses) > securityEventCIDRMaxAddresses {
+		return f, apperrors.ErrInvalidInput.WithDetails("Terlalu banyak alamat IP dalam CIDR, persempit rentang atau waktu")
+	}
+	f.cidrResolved = true
+	return f, nil
+}
+
+func securityEventPrefix(n *net.IPNet) (netip.Prefix, bool) {
+	addr, ok := netip.AddrFromSlice(n.IP)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+	ones, bits := n.Mask.Size()
+	if bits == 0 {
+		return netip.Prefix{}, false
+	}
+	addr = addr.Unmap()
+	if addr.Is4() && bits == 128 {
+		ones -= 96
+	}
+	prefix, err := addr.Prefix(ones)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	return prefix, true
+}
+
+// securityEventAddressesWithin keeps the stored values that are valid addresses inside prefix.
+// IPv4-mapped IPv6 values match IPv4 networks; values with a zone never match.
+func securityEventAddressesWithin(prefix netip.Prefix, stored []string) []string {
+	out := []string{}
+	for _, raw := range stored {
+		addr, err := netip.ParseAddr(raw)
+		if err != nil {
+			continue
+		}
+		if prefix.Contains(addr.Unmap()) {
+			out = append(out, raw)
+		}
+	}
+	return out
+}
+
+// SearchEvents returns matching events, newest first
+func (s *EntSecurityAuditService) SearchEvents(ctx context.Context, f SecurityEventFilter, page, pageSize int) ([]*ent.SecurityEvent, int, error) {
+	f, err := s.resolveCIDR(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := database.GetEntClient().SecurityEvent.Query().Where(f.predicates()...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		logger.Error("Failed to count security events", zap.Error(err))
+		return nil, 0, apperrors.ErrDatabase
+	}
+	events, err := query.
+		Order(ent.Desc(securityevent.FieldCreatedAt), ent.Desc(securityevent.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		logger.Error("Failed to search security events", zap.Error(err))
+		return nil, 0, apperrors.ErrDatabase
+	}
+	return events, total, nil
+}
+
+// ValidateSecurityEventExport checks that an export is bounded in time
+func ValidateSecurityEventExport(f SecurityEventFilter, format string) error {
+	if format != SecurityEventExportCSV && format != SecurityEventExportJSONL {
+		return apperrors.ErrInvalidInput.WithDetails("format harus csv atau jsonl")
+	}
+	if f.From == nil || f.To == nil {
+		return apperrors.ErrInvalidInput.WithDetails("Export memerlukan from dan to")
+	}
+	if !f.To.After(*f.From) || f.To.Sub(*f.From) > SecurityEventExportMaxRange {
+		return apperrors.ErrInvalidInput.WithDetails("Rentang export maksimal 93 hari")
+	}
+	return nil
+}
+
+// securityEventExportRow is the JSONL shape of an exported event
+type securityEventExportRow struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	EventType string    `json:"event_type"`
+	Severity  string    `json:"severity"`
+	Success   bool      `json:"success"`
+	UserID    *int      `json:"user_id"`
+	Email     string    `json:"email"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Details   string    `json:"details"`
+}
+
+var securityEventCSVHeader = []string{"id", "created_at", "event_type", "severity", "success", "user_id", "email", "ip_address", "user_agent", "details"}
+
+// ExportEvents streams matching events oldest first as CSV or JSONL and returns the row
+// count. Call ValidateSecurityEventExport first.
+func (s *EntSecurityAuditService) ExportEvents(ctx context.Context, w io.Writer, f SecurityEventFilter, format string) (int, error) {
+	f, err := s.resolveCIDR(ctx, f)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		csvw *csv.Writer
+		enc  *json.Encoder
+	)
+	if format == SecurityEventExportCSV {
+		csvw = csv.NewWriter(w)
+		if err := csvw.Write(securityEventCSVHeader); err != nil {
+			return 0, err
+		}
+	} else {
+		enc = json.NewEncoder(w)
+	}
+
+	client := database.GetEntClient()
+	preds := f.predicates()
+	count, lastID := 0, 0
+	for {
+		batch, err := client.SecurityEvent.Query().
+			Where(preds...).
+			Where(securityevent.IDGT(lastID)).
+			Order(ent.Asc(securityevent.FieldID)).
+			Limit(securityEventExportBatch).
+			All(ctx)
+		if err != nil {
+			return count, err
+		}
+		for _, e := range batch {
+			if csvw != nil {
+				if err := csvw.Write(securityEventCSVRecord(e)); err != nil {
+					return count, err
+				}
+			} else if err := enc.Encode(securityEventExportRow{
+				ID: e.ID, CreatedAt: e.CreatedAt, EventType: e.EventType, Severity: e.Severity,
+				Success: e.Success, UserID: e.UserID, Email: e.Email, IPAddress: e.IPAddress,
+				UserAgent: e.UserAgent, Details: e.Details,
+			}); err != nil {
+				return count, err
+			}
+			count++
+			lastID = e.ID
+		}
+		if csvw != nil {
+			csvw.Flush()
+			if err := csvw.Error(); err != nil {
+				return count, err
+			}
+		}
+		if len(batch) < securityEventExportBatch {
+			return count, nil
+		}
+	}
+}
+
+func securityEventCSVRecord(e *ent.SecurityEvent) []string {
+	userID := ""
+	if e.UserID != nil {
+		userID = strconv.Itoa(*e.UserID)
+	}
+	return []string{
+		strconv.Itoa(e.ID),
+		e.CreatedAt.UTC().Format(time.RFC3339),
+		csvSafe(e.EventType),
+		csvSafe(e.Severity),
+		strconv.FormatBool(e.Success),
+		userID,
+		csvSafe(e.Email),
+		csvSafe(e.IPAddress),
+		csvSafe(e.UserAgent),
+		csvSafe(e.Details),
+	}
+}
+
+// csvSafe stops spreadsheet formula injection from attacker-controlled fields
+// (user agent, email) when a report is opened in Excel or Sheets
+func csvSafe(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// ==================== Aggregates ====================
+
+// SecurityEventBucket is an event count for one time bucket
+type SecurityEventBucket struct {
+	Bucket time.Time `json:"bucket"`
+	Count  int       `json:"count"`
+}
+
+// SecurityEventIPStat summarises the events from one IP address
+type SecurityEventIPStat struct {
+	IPAddress      string    `json:"ip_address"`
+	Events         int       `json:"events"`
+	DistinctEmails int       `json:"distinct_emails"`
+	FirstSeen      time.Time `json:"first_seen"`
+	LastSeen       time.Time `json:"last_seen"`
+}
+
+// TokenReuseIncident groups refresh token reuse detections per account
+type TokenReuseIncident struct {
+	UserID      *int      `json:"user_id"`
+	Email       string    `json:"email"`
+	Incidents   int       `json:"incidents"`
+	DistinctIPs int       `json:"distinct_ips"`
+	FirstSeen   time.Time `json:"first_seen"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// SecurityEventAnalyticsRange fills in a default time range for aggregate views
+func SecurityEventAnalyticsRange(f SecurityEventFilter, now time.Time) SecurityEventFilter {
+	if f.To == nil {
+		f.To = &now
+	}
+	if f.From == nil {
+		from := f.To.Add(-SecurityEventAnalyticsDefaultRange)
+		f.From = &from
+	}
+	return f
+}
+
+// securityEventSelector builds a raw SELECT over security_events with the filter applied,
+// for aggregates the generated query builder cannot express
+func securityEventSelector(f SecurityEventFilter) (*sql.Selector, *sql.SelectTable) {
+	d := sql.Dialect(dialect.Postgres)
+	t := d.Table(securityevent.Table)
+	sel := d.Select().From(t)
+	for _, p := range f.predicates() {
+		p(sel)
+	}
+	return sel, t
+}
+
+func (s *EntSecurityAuditService) queryAggregate(ctx context.Context, sel *sql.Selector, scan func(rows interface{ Scan(...any) error }) error) error {
+	query, args := sel.Query()
+	rows, err := database.GetSQLDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("Security event aggregate failed", zap.Error(err))
+		return apperrors.ErrDatabase
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			logger.Error("Failed to scan security event aggregate", zap.Error(err))
+			return apperrors.ErrDatabase
+		}
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("Security event aggregate failed", zap.Error(err))
+		return apperrors.ErrDatabase
+	}
+	return nil
+}
+
+// bucketExpr truncates created_at to an hour or day in UTC
+func bucketExpr(t *sql.SelectTable, bucket string) string {
+	unit := "day"
+	if bucket == "hour" {
+		unit = "hour"
+	}
+	return "date_trunc('" + unit + "', " + t.C(securityevent.FieldCreatedAt) + " AT TIME ZONE 'UTC')"
+}
+
+// FailedLoginsOverTime counts failed logins per hour or day. Defaults to login_failed
+// events unless the filter names event types.
+func (s *EntSecurityAuditService) FailedLoginsOverTime(ctx context.Context, f SecurityEventFilter, bucket string) ([]SecurityEventBucket, error) {
+	if len(f.EventTypes) == 0 {
+		f.EventTypes = []string{EventLoginFailed}
+	}
+	f.Success = nil
+	return s.countPerBucket(ctx, f, bucket, "COUNT(*)")
+}
+
+// AccountsLockedPerDay counts distinct accounts locked per day, by brute-force protection
+// or by an admin
+func (s *EntSecurityAuditService) AccountsLockedPerDay(ctx context.Context, f SecurityEventFilter) ([]SecurityEventBucket, error) {
+	f.EventTypes = []string{EventAccountLocked, EventAdminUserLocked}
+	f.Success = nil
+	_, t := securityEventSelector(f)
+	distinct := "COUNT(DISTINCT COALESCE(" + t.C(securityevent.FieldUserID) + "::text, " + t.C(securityevent.FieldEmail) + "))"
+	return s.countPerBucket(ctx, f, "day", distinct)
+}
+
+func (s *EntSecurityAuditService) countPerBucket(ctx context.Context, f SecurityEventFilter, bucket, countExpr string) ([]SecurityEventBucket, error) {
+	f, err := s.resolveCIDR(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	sel, t := securityEventSelector(f)
+	expr := bucketExpr(t, bucket)
+	sel.Select(expr+" AS bucket", countExpr+" AS count").
+		GroupBy(expr).
+		OrderExpr(sql.Expr("bucket ASC"))
+
+	out := []SecurityEventBucket{}
+	err = s.queryAggregate(ctx, sel, func(rows interface{ Scan(...any) error }) error {
+		var b SecurityEventBucket
+		if err := rows.Scan(&b.Bucket, &b.Count); err != nil {
+			return err
+		}
+		b.Bucket = time.Date(b.Bucket.Year(), b.Bucket.Month(), b.Bucket.Day(), b.Bucket.Hour(), 0, 0, 0, time.UTC)
+		out = append(out, b)
+		return nil
+	})
+	return out, err
+}
+
+// TopIPs returns the IPs with the most matching events. Defaults to login_failed events
+// unless the filter names event types.
+func (s *EntSecurityAuditService) TopIPs(ctx context.Context, f SecurityEventFilter, limit int) ([]SecurityEventIPStat, error) {
+	if len(f.EventTypes) == 0 {
+		f.EventTypes = []string{EventLoginFailed}
+	}
+	f, err := s.resolveCIDR(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	sel, t := securityEventSelector(f)
+	ip := t.C(securityevent.FieldIPAddress)
+	created := t.C(securityevent.FieldCreatedAt)
+	sel.Where(sql.NEQ(ip, "")).
+		Select(ip, "COUNT(*) AS events",
+			"COUNT(DISTINCT NULLIF("+t.C(securityevent.FieldEmail)+", '')) AS distinct_emails",
+			"MIN("+created+")", "MAX("+created+")").
+		GroupBy(ip).
+		OrderExpr(sql.Expr("events DESC")).
+		Limit(limit)
+
+	out := []SecurityEventIPStat{}
+	err = s.queryAggregate(ctx, sel, func(rows interface{ Scan(...any) error }) error {
+		var st SecurityEventIPStat
+		if err := rows.Scan(&st.IPAddress, &st.Events, &st.DistinctEmails, &st.FirstSeen, &st.LastSeen); err != nil {
+			return err
+		}
+		out = append(out, st)
+		return nil
+	})
+	return out, err
+}
+
+// TokenReuseIncidents groups refresh token reuse detections per account, most incidents first
+func (s *EntSecurityAuditService) TokenReuseIncidents(ctx context.Context, f SecurityEventFilter, limit int) ([]TokenReuseIncident, error) {
+	f.EventTypes = []string{EventTokenReuse}
+	f.Success = nil
+	f, err := s.resolveCIDR(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	sel, t := securityEventSelector(f)
+	userID := t.C(securityevent.FieldUserID)
+	email := t.C(securityevent.FieldEmail)
+	created := t.C(securityevent.FieldCreatedAt)
+	sel.Select(userID, email, "COUNT(*) AS incidents",
+		"COUNT(DISTINCT "+t.C(securityevent.FieldIPAddress)+")",
+		"MIN("+created+")", "MAX("+created+") AS last_seen").
+		GroupBy(userID, email).
+		OrderExpr(sql.Expr("incidents DESC, last_seen DESC")).
+		Limit(limit)
+
+	out := []TokenReuseIncident{}
+	err = s.queryAggregate(ctx, sel, func(rows interface{ Scan(...any) error }) error {
+		var inc TokenReuseIncident
+		var uid *int64
+		if err := rows.Scan(&uid, &inc.Email, &inc.Incidents, &inc.DistinctIPs, &inc.FirstSeen, &inc.LastSeen); err != nil {
+			return err
+		}
+		if uid != nil {
+			id := int(*uid)
+			inc.UserID = &id
+		}
+		out = append(out, inc)
+		return nil
+	})
+	return out, err
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityEventFilter_CIDRQuery(t *testing.T) {
+	_, network, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	f := SecurityEventFilter{
+		EventTypes: []string{EventLoginFailed},
+		CIDR:       network,
+		From:       &from,
+	}
+
+	sel, _ := securityEventSelector(f)
+	query, _ := sel.Query()
+	assert.NotContains(t, query, "::inet", "stored values must never be cast to inet")
+	assert.Contains(t, query, `"security_events"."id" < $2`, "an unresolved network filter matches nothing")
+
+	f.cidrAddresses = []string{"10.0.0.1", "10.2.3.4"}
+	f.cidrResolved = true
+	sel, _ = securityEventSelector(f)
+	query, args := sel.Query()
+	assert.Contains(t, query, `"security_events"."ip_address" IN ($2, $3)`)
+	assert.Equal(t, []any{EventLoginFailed, "10.0.0.1", "10.2.3.4", from}, args)
+}
+
+func TestSecurityEventAddressesWithin(t *testing.T) {
+	stored := []string{"10.1.2.3", "abc", "1.2.3", "10.0.0.256", "::ffff:10.9.9.9", "11.0.0.1", "", "fe80::1%eth0", "2001:db8::1"}
+
+	_, v4, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	prefix, ok := securityEventPrefix(v4)
+	require.True(t, ok)
+	assert.Equal(t, []string{"10.1.2.3", "::ffff:10.9.9.9"}, securityEventAddressesWithin(prefix, stored))
+
+	_, v6, err := net.ParseCIDR("2001:db8::/32")
+	require.NoError(t, err)
+	prefix, ok = securityEventPrefix(v6)
+	require.True(t, ok)
+	assert.Equal(t, []string{"2001:db8::1"}, securityEventAddressesWithin(prefix, stored))
+
+	_, mapped, err := net.ParseCIDR("::ffff:10.0.0.0/104")
+	require.NoError(t, err)
+	prefix, ok = securityEventPrefix(mapped)
+	require.True(t, ok)
+	assert.Equal(t, "10.0.0.0/8", prefix.String())
+}
+
+func TestSecurityEventAnalyticsRange(t *testing.T) {
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+	f := SecurityEventAnalyticsRange(SecurityEventFilter{}, now)
+	require.NotNil(t, f.From)
+	require.NotNil(t, f.To)
+	assert.Equal(t, now, *f.To)
+	assert.Equal(t, now.Add(-SecurityEventAnalyticsDefaultRange), *f.From)
+
+	from := now.Add(-time.Hour)
+	f = SecurityEventAnalyticsRange(SecurityEventFilter{From: &from}, now)
+	assert.Equal(t, from, *f.From, "explicit from is kept")
+}
+
+func TestValidateSecurityEventExport(t *testing.T) {
+	to := time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC)
+	week := to.Add(-7 * 24 * time.Hour)
+	tooLong := to.Add(-SecurityEventExportMaxRange - time.Hour)
+
+	assert.NoError(t, ValidateSecurityEventExport(SecurityEventFilter{From: &week, To: &to}, SecurityEventExportCSV))
+	assert.NoError(t, ValidateSecurityEventExport(SecurityEventFilter{From: &week, To: &to}, SecurityEventExportJSONL))
+	assert.Error(t, ValidateSecurityEventExport(SecurityEventFilter{From: &week, To: &to}, "xlsx"))
+	assert.Error(t, ValidateSecurityEventExport(SecurityEventFilter{To: &to}, SecurityEventExportCSV), "unbounded export")
+	assert.Error(t, ValidateSecurityEventExport(SecurityEventFilter{From: &tooLong, To: &to}, SecurityEventExportCSV))
+	assert.Error(t, ValidateSecurityEventExport(SecurityEventFilter{From: &to, To: &week}, SecurityEventExportCSV), "reversed range")
+}
+
+func TestCSVSafe(t *testing.T) {
+	for _, v := range []string{"=HYPERLINK(\"x\")", "+1", "-2", "@SUM(A1)"} {
+		assert.True(t, strings.HasPrefix(csvSafe(v), "'"), "expected %q to be escaped", v)
+	}
+	assert.Equal(t, "Mozilla/5.0", csvSafe("Mozilla/5.0"))
+	assert.Equal(t, "", csvSafe(""))
+}
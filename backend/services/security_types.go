@@ -110,6 +110,44 @@ const (
 	EventAccountDeleted  = "account_deleted"
 	EventTOTPEnabled     = "totp_enabled"
 	EventTOTPDisabled    = "totp_disabled"
+
+	EventEmailChangeRequested = "email_change_requested"
+	EventEmailChanged         = "email_changed"
+	EventEmailChangeReverted  = "email_change_reverted"
+
+	EventRiskStepUp          = "risk_step_up"
+	EventRiskBlocked         = "risk_blocked"
+	EventRiskStepUpEmailCode = "risk_step_up_email_code"
+	EventRiskStepUpCompleted = "risk_step_up_completed"
+
+	EventNewDeviceAlert       = "new_device_alert"
+	EventSuspiciousLoginAlert = "suspicious_login_alert"
+	EventKillSwitchActivated  = "kill_switch_activated"
+
+	EventDeviceTrusted   = "device_trusted"
+	EventDeviceUntrusted = "device_untrusted"
+	EventDeviceRemoved   = "device_removed"
+
+	// Admin authentication (user_id is empty; email holds the admin email)
+	EventAdminLoginSuccess = "admin_login_success"
+	EventAdminLoginFailed  = "admin_login_failed"
+	EventAdminLocked       = "admin_locked"
+	EventAdminMFAEnrolled  = "admin_mfa_enrolled"
+	EventAdminMFARemoved   = "admin_mfa_removed"
+	EventAdminSudo         = "admin_sudo"
+
+	// Admin moderation of a user account (user_id is the affected user)
+	EventAdminUserLocked      = "admin_user_locked"
+	EventAdminUserUnlocked    = "admin_user_unlocked"
+	EventAdminSessionsRevoked = "admin_sessions_revoked"
+	EventAdminDevicesBlocked  = "admin_devices_blocked"
+	EventAdminTOTPReset       = "admin_totp_reset"
+
+	EventAdminImpersonationStarted = "admin_impersonation_started"
+
+	// Request inspection (details is JSON; see LogRequestInspection)
+	EventRequestInspectionHit           = "request_inspection_hit"
+	EventRequestInspectionFalsePositive = "request_inspection_false_positive"
 )
 
 // ProgressiveDelays defines delays for progressive slowdown on failed attempts
@@ -286,6 +324,14 @@ func HashFingerprint(fingerprint, userAgent string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// deviceFingerprintHash hashes a client fingerprint, or returns "" when none was sent
+func deviceFingerprintHash(fingerprint, userAgent string) string {
+	if fingerprint == "" {
+		return ""
+	}
+	return HashFingerprint(fingerprint, userAgent)
+}
+
 // formatDuration formats a duration into a human-readable Indonesian string
 func formatDuration(d time.Duration) string {
 	if d < time.Minute {
@@ -27,6 +27,12 @@ func NewEntSessionService() *EntSessionService {
 
 // CreateSession creates a new session with token pair
 func (s *EntSessionService) CreateSession(ctx context.Context, u *ent.User, ipAddress, userAgent string) (*TokenPair, error) {
+	return s.CreateSessionForDevice(ctx, u, ipAddress, userAgent, "")
+}
+
+// CreateSessionForDevice creates a session tagged with the hashed device fingerprint it came from,
+// so removing a device can revoke exactly its sessions
+func (s *EntSessionService) CreateSessionForDevice(ctx context.Context, u *ent.User, ipAddress, userAgent, fingerprintHash string) (*TokenPair, error) {
 	// Check if account is locked
 	lock, err := s.client.SessionLock.
 		Query().
@@ -84,6 +90,7 @@ func (s *EntSessionService) CreateSession(ctx context.Context, u *ent.User, ipAd
 		SetLastUsedAt(time.Now()).
 		SetTokenFamily(tokenFamily).
 		SetIsUsed(false).
+		SetDeviceFingerprintHash(fingerprintHash).
 		Save(ctx)
 	if err != nil {
 		logger.Error("Failed to create session", zap.Error(err))
@@ -242,6 +249,29 @@ func (s *EntSessionService) RefreshSession(ctx context.Context, refreshToken, ip
 				zap.String("new_ip", ipAddress))
 
 			s.checkIPRotationPatternEnt(ctx, sess.UserID, ipAddress)
+
+			// Risk-based re-evaluation: refresh can't step up, so force a fresh login instead
+			if riskEngine != nil {
+				assessment := riskEngine.Assess(ctx, RiskInput{
+					Kind:       RiskEventRefresh,
+					User:       u,
+					IP:         ipAddress,
+					UserAgent:  userAgent,
+					SessionID:  sess.ID,
+					PreviousIP: sess.IPAddress,
+					PreviousAt: sess.LastUsedAt,
+				})
+				switch assessment.Decision {
+				case RiskDecisionBlock:
+					_ = s.RevokeTokenFamily(ctx, sess.TokenFamily, "Risk engine: blocked")
+					txErr = apperrors.ErrRiskBlocked
+					return txErr
+				case RiskDecisionStepUp:
+					_ = s.RevokeTokenFamily(ctx, sess.TokenFamily, "Risk engine: re-authentication required")
+					txErr = apperrors.ErrReauthRequired
+					return txErr
+				}
+			}
 		}
 
 		if uaChanged {
@@ -354,6 +384,7 @@ func (s *EntSessionService) RefreshSession(ctx context.Context, refreshToken, ip
 			SetLastUsedAt(time.Now()).
 			SetTokenFamily(sess.TokenFamily). // Same family for rotation tracking
 			SetIsUsed(false).
+			SetDeviceFingerprintHash(sess.DeviceFingerprintHash).
 			Save(ctx)
 		if err != nil {
 			txErr = apperrors.ErrInternalServer.WithDetails("Gagal membuat session baru")
@@ -499,6 +530,10 @@ func (s *EntSessionService) checkIPRotationPatternEnt(ctx context.Context, userI
 			zap.Int("user_id", userID),
 			zap.Duration("lock_duration", lockDuration),
 			zap.Strings("countries", detectedCountries))
+
+		if alerts := GetLoginAlertService(); alerts != nil {
+			go alerts.NotifySuspiciousLogin(ctx, userID, currentIP, "", "Login from "+strings.Join(detectedCountries, ", ")+" within 30 minutes")
+		}
 	}
 }
 
@@ -560,6 +595,30 @@ func (s *EntSessionService) RevokeAllUserSessions(ctx context.Context, userID in
 	return nil
 }
 
+// RevokeOtherUserSessions revokes all of a user's sessions except the one holding keepJTI
+func (s *EntSessionService) RevokeOtherUserSessions(ctx context.Context, userID int, keepJTI, reason string) error {
+	upd := s.client.Session.Update().
+		Where(
+			session.UserIDEQ(userID),
+			session.RevokedAtIsNil(),
+		)
+	if keepJTI != "" {
+		upd = upd.Where(session.AccessTokenJtiNEQ(keepJTI))
+	}
+	affected, err := upd.
+		SetRevokedAt(time.Now()).
+		SetRevokeReason(reason).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Other sessions revoked for user",
+		zap.Int("user_id", userID),
+		zap.Int("sessions_revoked", affected))
+	return nil
+}
+
 // RevokeTokenFamily revokes all sessions in a token family
 func (s *EntSessionService) RevokeTokenFamily(ctx context.Context, tokenFamily, reason string) error {
 	now := time.Now()
@@ -606,6 +665,36 @@ func (s *EntSessionService) LockAccount(ctx context.Context, userID int, reason
 	return nil
 }
 
+// ApplySessionLock locks the account until the given time, replacing any existing lock
+// (session_locks holds at most one row per user)
+func (s *EntSessionService) ApplySessionLock(ctx context.Context, userID int, reason, lockedBy string, until time.Time) error {
+	return WithTx(ctx, s.client, func(tx *ent.Tx) error {
+		now := time.Now()
+		existing, err := tx.SessionLock.Query().Where(sessionlock.UserIDEQ(userID)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if existing != nil {
+			_, err = tx.SessionLock.UpdateOne(existing).
+				SetLockedAt(now).
+				ClearUnlockedAt().
+				SetExpiresAt(until).
+				SetReason(reason).
+				SetLockedBy(lockedBy).
+				Save(ctx)
+			return err
+		}
+		_, err = tx.SessionLock.Create().
+			SetUserID(userID).
+			SetLockedAt(now).
+			SetExpiresAt(until).
+			SetReason(reason).
+			SetLockedBy(lockedBy).
+			Save(ctx)
+		return err
+	})
+}
+
 // GetActiveSessions returns all active sessions for a user
 func (s *EntSessionService) GetActiveSessions(ctx context.Context, userID int) ([]*ent.Session, error) {
 	return s.client.Session.
@@ -0,0 +1,501 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // policies name IANA zones; the runtime image may not ship zoneinfo
+
+	"backend-gin/database"
+	"backend-gin/ent"
+	"backend-gin/ent/category"
+	"backend-gin/ent/slapolicy"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	slaPolicyMaxResponseHours = 30 * 24
+	slaPolicyMaxReminders     = 5
+	slaPolicyDefaultTimezone  = "Asia/Jakarta"
+	slaHolidayDateLayout      = "2006-01-02"
+)
+
+// SLAPolicySnapshot is the owner-response SLA in force for one consultation request. It is
+// stored on the request, so editing or deleting a policy never moves a running timer.
+type SLAPolicySnapshot struct {
+	PolicyID             int    `json:"policy_id,omitempty"`
+	Name                 string `json:"name"`
+	OwnerResponseHours   int    `json:"owner_response_hours"`
+	ReminderHours        []int  `json:"reminder_hours"`
+	BusinessHoursOnly    bool   `json:"business_hours_only"`
+	BusinessDayStartHour int    `json:"business_day_start_hour"`
+	BusinessDayEndHour   int    `json:"business_day_end_hour"`
+	Timezone             string `json:"timezone"`
+}
+
+// defaultSLAPolicySnapshot applies when no active policy matches a case.
+func defaultSLAPolicySnapshot() SLAPolicySnapshot {
+	return SLAPolicySnapshot{
+		Name:                 "default",
+		OwnerResponseHours:   ownerResponseSLAHours,
+		ReminderHours:        []int{ownerReminderFirstHour, ownerReminderSecondHour},
+		BusinessDayStartHour: 9,
+		BusinessDayEndHour:   17,
+		Timezone:             slaPolicyDefaultTimezone,
+	}
+}
+
+func slaPolicySnapshotFromEnt(p *ent.SLAPolicy) SLAPolicySnapshot {
+	return SLAPolicySnapshot{
+		PolicyID:             p.ID,
+		Name:                 p.Name,
+		OwnerResponseHours:   p.OwnerResponseHours,
+		ReminderHours:        append([]int(nil), p.ReminderHours...),
+		BusinessHoursOnly:    p.BusinessHoursOnly,
+		BusinessDayStartHour: p.BusinessDayStartHour,
+		BusinessDayEndHour:   p.BusinessDayEndHour,
+		Timezone:             p.Timezone,
+	}
+}
+
+func (p SLAPolicySnapshot) toJSON() map[string]interface{} {
+	reminders := make([]interface{}, len(p.ReminderHours))
+	for i, h := range p.ReminderHours {
+		reminders[i] = h
+	}
+	out := map[string]interface{}{
+		"name":                    p.Name,
+		"owner_response_hours":    p.OwnerResponseHours,
+		"reminder_hours":          reminders,
+		"business_hours_only":     p.BusinessHoursOnly,
+		"business_day_start_hour": p.BusinessDayStartHour,
+		"business_day_end_hour":   p.BusinessDayEndHour,
+		"timezone":                p.Timezone,
+	}
+	if p.PolicyID > 0 {
+		out["policy_id"] = p.PolicyID
+	}
+	return out
+}
+
+// slaPolicySnapshotFromJSON restores a stored snapshot. Requests created before SLA policies
+// existed have none and get the default policy.
+func slaPolicySnapshotFromJSON(raw map[string]interface{}) SLAPolicySnapshot {
+	if len(raw) == 0 {
+		return defaultSLAPolicySnapshot()
+	}
+	out := defaultSLAPolicySnapshot()
+	out.PolicyID = int(jsonInt64(raw["policy_id"]))
+	if name, ok := raw["name"].(string); ok {
+		out.Name = name
+	}
+	if v := int(jsonInt64(raw["owner_response_hours"])); v > 0 {
+		out.OwnerResponseHours = v
+	}
+	if list, ok := raw["reminder_hours"].([]interface{}); ok {
+		out.ReminderHours = make([]int, 0, len(list))
+		for _, h := range list {
+			out.ReminderHours = append(out.ReminderHours, int(jsonInt64(h)))
+		}
+	}
+	out.BusinessHoursOnly, _ = raw["business_hours_only"].(bool)
+	if _, ok := raw["business_day_start_hour"]; ok {
+		out.BusinessDayStartHour = int(jsonInt64(raw["business_day_start_hour"]))
+	}
+	if v := int(jsonInt64(raw["business_day_end_hour"])); v > 0 {
+		out.BusinessDayEndHour = v
+	}
+	if tz, ok := raw["timezone"].(string); ok && tz != "" {
+		out.Timezone = tz
+	}
+	return out
+}
+
+func (p SLAPolicySnapshot) location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// AddHours returns the time `hours` SLA hours after start. Wall-clock policies add plain
+// hours; business-hours policies only count time inside the business day on weekdays that
+// are not holidays in cal.
+func (p SLAPolicySnapshot) AddHours(start time.Time, hours int, cal *HolidayCalendar) time.Time {
+	remaining := time.Duration(hours) * time.Hour
+	if !p.BusinessHoursOnly || p.BusinessDayEndHour <= p.BusinessDayStartHour {
+		return start.Add(remaining)
+	}
+
+	loc := p.location()
+	cur := start.In(loc)
+	nextDay := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day()+1, p.BusinessDayStartHour, 0, 0, 0, loc)
+	}
+	// Bounded so a calendar without business days cannot loop forever.
+	for i := 0; remaining > 0 && i < 3700; i++ {
+		dayStart := time.Date(cur.Year(), cur.Month(), cur.Day(), p.BusinessDayStartHour, 0, 0, 0, loc)
+		dayEnd := time.Date(cur.Year(), cur.Month(), cur.Day(), p.BusinessDayEndHour, 0, 0, 0, loc)
+		if !cal.IsBusinessDay(cur) || !cur.Before(dayEnd) {
+			cur = nextDay(cur)
+			continue
+		}
+		if cur.Before(dayStart) {
+			cur = dayStart
+		}
+		available := dayEnd.Sub(cur)
+		if remaining <= available {
+			return cur.Add(remaining)
+		}
+		remaining -= available
+		cur = nextDay(cur)
+	}
+	return cur
+}
+
+// OwnerResponseDueAt returns the owner-response deadline for a window opened at start.
+func (p SLAPolicySnapshot) OwnerResponseDueAt(start time.Time, cal *HolidayCalendar) time.Time {
+	return p.AddHours(start, p.OwnerResponseHours, cal)
+}
+
+// slaPolicySpecificity ranks a policy: category and level > category > level > catch-all.
+func slaPolicySpecificity(p *ent.SLAPolicy) int {
+	score := 0
+	if p.CategoryID != nil {
+		score += 2
+	}
+	if p.SensitivityLevel != "" {
+		score++
+	}
+	return score
+}
+
+// selectSLAPolicy returns the most specific active policy matching the case, or nil. Ties go
+// to the newest policy.
+func selectSLAPolicy(policies []*ent.SLAPolicy, sensitivityLevel string, categoryID int) *ent.SLAPolicy {
+	level := strings.ToUpper(strings.TrimSpace(sensitivityLevel))
+	var best *ent.SLAPolicy
+	for _, p := range policies {
+		if !p.IsActive {
+			continue
+		}
+		if p.SensitivityLevel != "" && p.SensitivityLevel != level {
+			continue
+		}
+		if p.CategoryID != nil && *p.CategoryID != categoryID {
+			continue
+		}
+		if best == nil {
+			best = p
+			continue
+		}
+		ps, bs := slaPolicySpecificity(p), slaPolicySpecificity(best)
+		if ps > bs || (ps == bs && p.ID > best.ID) {
+			best = p
+		}
+	}
+	return best
+}
+
+// resolveSLAPolicySnapshot picks the policy for a case. Lookup failures fall back to the
+// default policy: a consultation request must not fail on SLA configuration.
+func resolveSLAPolicySnapshot(ctx context.Context, client *ent.Client, vc *ent.ValidationCase) SLAPolicySnapshot {
+	policies, err := client.SLAPolicy.Query().
+		Where(slapolicy.IsActiveEQ(true)).
+		All(ctx)
+	if err != nil {
+		logger.Warn("Failed to load SLA policies, using default", zap.Error(err), zap.Int("validation_case_id", vc.ID))
+		return defaultSLAPolicySnapshot()
+	}
+	if p := selectSLAPolicy(policies, vc.SensitivityLevel, vc.CategoryID); p != nil {
+		return slaPolicySnapshotFromEnt(p)
+	}
+	return defaultSLAPolicySnapshot()
+}
+
+// Holiday is one non-business day of the SLA calendar
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// HolidayCalendar holds the public holidays skipped by business-hours SLA policies. A nil
+// calendar treats every weekday as a business day.
+type HolidayCalendar struct {
+	mu   sync.RWMutex
+	days map[string]string
+}
+
+var slaHolidayCalendar = &HolidayCalendar{days: map[string]string{}}
+
+// SLAHolidayCalendar returns the process-wide calendar used by the SLA worker
+func SLAHolidayCalendar() *HolidayCalendar {
+	return slaHolidayCalendar
+}
+
+// ParseHolidayCalendarFile parses {"holidays": [{"date": "2026-01-01", "name": "..."}]}
+func ParseHolidayCalendarFile(data []byte) ([]Holiday, error) {
+	var file struct {
+		Holidays []Holiday `json:"holidays"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	for i, h := range file.Holidays {
+		if _, err := time.Parse(slaHolidayDateLayout, h.Date); err != nil {
+			return nil, fmt.Errorf("holiday %d: invalid date %q", i, h.Date)
+		}
+	}
+	return file.Holidays, nil
+}
+
+// Set replaces the calendar's holidays
+func (c *HolidayCalendar) Set(holidays []Holiday) {
+	days := make(map[string]string, len(holidays))
+	for _, h := range holidays {
+		days[h.Date] = h.Name
+	}
+	c.mu.Lock()
+	c.days = days
+	c.mu.Unlock()
+}
+
+// LoadFile reads the holiday file; on error the current holidays are kept
+func (c *HolidayCalendar) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	holidays, err := ParseHolidayCalendarFile(data)
+	if err != nil {
+		return err
+	}
+	c.Set(holidays)
+	return nil
+}
+
+// Holidays returns the loaded holidays in date order
+func (c *HolidayCalendar) Holidays() []Holiday {
+	if c == nil {
+		return []Holiday{}
+	}
+	c.mu.RLock()
+	out := make([]Holiday, 0, len(c.days))
+	for date, name := range c.days {
+		out = append(out, Holiday{Date: date, Name: name})
+	}
+	c.mu.RUnlock()
+	sort.Slice(out, func(i, j int) bool { return out[i].Date < out[j].Date })
+	return out
+}
+
+// HasYear reports whether the calendar lists at least one holiday in year
+func (c *HolidayCalendar) HasYear(year int) bool {
+	if c == nil {
+		return false
+	}
+	prefix := fmt.Sprintf("%04d-", year)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for date := range c.days {
+		if strings.HasPrefix(date, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay reports whether t (in its own location) is a weekday and not a holiday
+func (c *HolidayCalendar) IsBusinessDay(t time.Time) bool {
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	if c == nil {
+		return true
+	}
+	c.mu.RLock()
+	_, holiday := c.days[t.Format(slaHolidayDateLayout)]
+	c.mu.RUnlock()
+	return !holiday
+}
+
+// SLAPolicyInput creates or replaces an SLA policy
+type SLAPolicyInput struct {
+	Name                 string
+	SensitivityLevel     string
+	CategoryID           *int
+	OwnerResponseHours   int
+	ReminderHours        []int
+	BusinessHoursOnly    bool
+	BusinessDayStartHour int
+	BusinessDayEndHour   int
+	Timezone             string
+	IsActive             bool
+}
+
+func normalizeSLAPolicyInput(in SLAPolicyInput) (SLAPolicyInput, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" || len(in.Name) > 100 {
+		return in, apperrors.ErrInvalidInput.WithDetails("name wajib diisi (maksimal 100 karakter)")
+	}
+	in.SensitivityLevel = strings.ToUpper(strings.TrimSpace(in.SensitivityLevel))
+	switch in.SensitivityLevel {
+	case "", "S0", "S1", "S2", "S3":
+	default:
+		return in, apperrors.ErrInvalidInput.WithDetails("sensitivity_level harus S0, S1, S2, S3 atau kosong")
+	}
+	if in.CategoryID != nil && *in.CategoryID <= 0 {
+		in.CategoryID = nil
+	}
+	if in.OwnerResponseHours <= 0 || in.OwnerResponseHours > slaPolicyMaxResponseHours {
+		return in, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("owner_response_hours harus 1 sampai %d", slaPolicyMaxResponseHours))
+	}
+	if len(in.ReminderHours) > slaPolicyMaxReminders {
+		return in, apperrors.ErrInvalidInput.WithDetails(fmt.Sprintf("reminder_hours maksimal %d", slaPolicyMaxReminders))
+	}
+	prev := 0
+	for _, h := range in.ReminderHours {
+		if h <= prev || h >= in.OwnerResponseHours {
+			return in, apperrors.ErrInvalidInput.WithDetails("reminder_hours harus naik dan lebih kecil dari owner_response_hours")
+		}
+		prev = h
+	}
+	if in.BusinessDayStartHour < 0 || in.BusinessDayEndHour > 24 || in.BusinessDayStartHour >= in.BusinessDayEndHour {
+		return in, apperrors.ErrInvalidInput.WithDetails("jam kerja harus 0 <= business_day_start_hour < business_day_end_hour <= 24")
+	}
+	in.Timezone = strings.TrimSpace(in.Timezone)
+	if in.Timezone == "" {
+		in.Timezone = slaPolicyDefaultTimezone
+	}
+	if _, err := time.LoadLocation(in.Timezone); err != nil {
+		return in, apperrors.ErrInvalidInput.WithDetails("timezone tidak dikenal")
+	}
+	return in, nil
+}
+
+// EntSLAPolicyService manages owner-response SLA policies
+type EntSLAPolicyService struct {
+	client *ent.Client
+}
+
+// NewEntSLAPolicyService creates a new SLA policy service with Ent
+func NewEntSLAPolicyService() *EntSLAPolicyService {
+	return &EntSLAPolicyService{client: database.GetEntClient()}
+}
+
+// List returns policies, most specific first
+func (s *EntSLAPolicyService) List(ctx context.Context, includeInactive bool) ([]*ent.SLAPolicy, error) {
+	q := s.client.SLAPolicy.Query()
+	if !includeInactive {
+		q = q.Where(slapolicy.IsActiveEQ(true))
+	}
+	policies, err := q.Order(ent.Asc(slapolicy.FieldID)).All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	sort.SliceStable(policies, func(i, j int) bool {
+		return slaPolicySpecificity(policies[i]) > slaPolicySpecificity(policies[j])
+	})
+	return policies, nil
+}
+
+func (s *EntSLAPolicyService) ensureCategory(ctx context.Context, categoryID *int) error {
+	if categoryID == nil {
+		return nil
+	}
+	exists, err := s.client.Category.Query().Where(category.IDEQ(*categoryID)).Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if !exists {
+		return apperrors.ErrInvalidInput.WithDetails("category_id tidak ditemukan")
+	}
+	return nil
+}
+
+// Create adds a policy
+func (s *EntSLAPolicyService) Create(ctx context.Context, actor AdminActor, in SLAPolicyInput) (*ent.SLAPolicy, error) {
+	in, err := normalizeSLAPolicyInput(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureCategory(ctx, in.CategoryID); err != nil {
+		return nil, err
+	}
+	p, err := s.client.SLAPolicy.Create().
+		SetName(in.Name).
+		SetSensitivityLevel(in.SensitivityLevel).
+		SetNillableCategoryID(in.CategoryID).
+		SetOwnerResponseHours(in.OwnerResponseHours).
+		SetReminderHours(in.ReminderHours).
+		SetBusinessHoursOnly(in.BusinessHoursOnly).
+		SetBusinessDayStartHour(in.BusinessDayStartHour).
+		SetBusinessDayEndHour(in.BusinessDayEndHour).
+		SetTimezone(in.Timezone).
+		SetIsActive(in.IsActive).
+		SetCreatedByAdminID(actor.ID).
+		SetUpdatedByAdminID(actor.ID).
+		Save(ctx)
+	if err != nil {
+		logger.Error("Failed to create SLA policy", zap.Error(err))
+		return nil, apperrors.ErrDatabase
+	}
+	return p, nil
+}
+
+// Update replaces a policy's settings. Running consultation requests keep their snapshot.
+func (s *EntSLAPolicyService) Update(ctx context.Context, actor AdminActor, id int, in SLAPolicyInput) (*ent.SLAPolicy, error) {
+	in, err := normalizeSLAPolicyInput(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureCategory(ctx, in.CategoryID); err != nil {
+		return nil, err
+	}
+	upd := s.client.SLAPolicy.UpdateOneID(id).
+		SetName(in.Name).
+		SetSensitivityLevel(in.SensitivityLevel).
+		SetOwnerResponseHours(in.OwnerResponseHours).
+		SetReminderHours(in.ReminderHours).
+		SetBusinessHoursOnly(in.BusinessHoursOnly).
+		SetBusinessDayStartHour(in.BusinessDayStartHour).
+		SetBusinessDayEndHour(in.BusinessDayEndHour).
+		SetTimezone(in.Timezone).
+		SetIsActive(in.IsActive).
+		SetUpdatedByAdminID(actor.ID)
+	if in.CategoryID != nil {
+		upd.SetCategoryID(*in.CategoryID)
+	} else {
+		upd.ClearCategoryID()
+	}
+	p, err := upd.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrSLAPolicyNotFound
+		}
+		logger.Error("Failed to update SLA policy", zap.Error(err), zap.Int("policy_id", id))
+		return nil, apperrors.ErrDatabase
+	}
+	return p, nil
+}
+
+// Delete removes a policy. Running consultation requests keep their snapshot.
+func (s *EntSLAPolicyService) Delete(ctx context.Context, id int) error {
+	if err := s.client.SLAPolicy.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return apperrors.ErrSLAPolicyNotFound
+		}
+		logger.Error("Failed to delete SLA policy", zap.Error(err), zap.Int("policy_id", id))
+		return apperrors.ErrDatabase
+	}
+	return nil
+}
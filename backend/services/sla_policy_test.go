@@ -0,0 +1,145 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"backend-gin/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLAPolicyAddHoursWallClock(t *testing.T) {
+	policy := defaultSLAPolicySnapshot()
+	start := time.Date(2026, 8, 14, 16, 0, 0, 0, time.UTC)
+	assert.Equal(t, start.Add(12*time.Hour), policy.OwnerResponseDueAt(start, nil))
+}
+
+func TestSLAPolicyAddHoursBusinessCalendar(t *testing.T) {
+	policy := defaultSLAPolicySnapshot()
+	policy.BusinessHoursOnly = true
+	policy.OwnerResponseHours = 12
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+
+	cal := &HolidayCalendar{}
+	cal.Set([]Holiday{{Date: "2026-08-17", Name: "Hari Kemerdekaan Republik Indonesia"}})
+
+	// Friday 15:00: 2h Friday, weekend skipped, Monday 17 Aug is a holiday, 8h Tuesday,
+	// 2h Wednesday.
+	start := time.Date(2026, 8, 14, 15, 0, 0, 0, loc)
+	assert.Equal(t, time.Date(2026, 8, 19, 11, 0, 0, 0, loc), policy.OwnerResponseDueAt(start, cal).In(loc))
+
+	// Before opening hours counts from the start of the business day.
+	early := time.Date(2026, 8, 18, 6, 30, 0, 0, loc)
+	assert.Equal(t, time.Date(2026, 8, 18, 11, 0, 0, 0, loc), policy.AddHours(early, 2, cal).In(loc))
+
+	// Without a calendar only weekends are skipped.
+	assert.Equal(t, time.Date(2026, 8, 18, 11, 0, 0, 0, loc), policy.OwnerResponseDueAt(start, nil).In(loc))
+}
+
+func TestSelectSLAPolicy(t *testing.T) {
+	categoryID := 7
+	otherCategory := 8
+	policies := []*ent.SLAPolicy{
+		{ID: 1, Name: "catch-all", IsActive: true},
+		{ID: 2, Name: "s2", SensitivityLevel: "S2", IsActive: true},
+		{ID: 3, Name: "category", CategoryID: &categoryID, IsActive: true},
+		{ID: 4, Name: "category-s2", SensitivityLevel: "S2", CategoryID: &categoryID, IsActive: true},
+		{ID: 5, Name: "inactive", SensitivityLevel: "S3", CategoryID: &categoryID, IsActive: false},
+		{ID: 6, Name: "other-category", CategoryID: &otherCategory, IsActive: true},
+	}
+
+	assert.Equal(t, "category-s2", selectSLAPolicy(policies, "s2", categoryID).Name)
+	assert.Equal(t, "category", selectSLAPolicy(policies, "S3", categoryID).Name)
+	assert.Equal(t, "s2", selectSLAPolicy(policies, "S2", 99).Name)
+	assert.Equal(t, "catch-all", selectSLAPolicy(policies, "S0", 99).Name)
+	assert.Nil(t, selectSLAPolicy(policies[1:2], "S1", 99))
+}
+
+func TestSLAPolicySnapshotJSONRoundTrip(t *testing.T) {
+	policy := SLAPolicySnapshot{
+		PolicyID:             4,
+		Name:                 "S2 business hours",
+		OwnerResponseHours:   16,
+		ReminderHours:        []int{4, 12},
+		BusinessHoursOnly:    true,
+		BusinessDayStartHour: 8,
+		BusinessDayEndHour:   16,
+		Timezone:             "Asia/Makassar",
+	}
+
+	// Stored through a JSON column, numbers come back as float64.
+	b, err := json.Marshal(policy.toJSON())
+	require.NoError(t, err)
+	var stored map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &stored))
+
+	assert.Equal(t, policy, slaPolicySnapshotFromJSON(stored))
+	assert.Equal(t, defaultSLAPolicySnapshot(), slaPolicySnapshotFromJSON(nil))
+}
+
+func TestOwnerResponseSLAWindow(t *testing.T) {
+	policy := defaultSLAPolicySnapshot()
+	started := time.Date(2026, 8, 14, 8, 0, 0, 0, time.UTC)
+	start, due := ownerResponseSLAWindow(&ent.ConsultationRequest{OwnerResponseStartedAt: &started}, policy, nil)
+	assert.Equal(t, started, start)
+	assert.Equal(t, started.Add(12*time.Hour), due)
+
+	legacyDue := time.Date(2026, 8, 14, 20, 0, 0, 0, time.UTC)
+	start, due = ownerResponseSLAWindow(&ent.ConsultationRequest{OwnerResponseDueAt: &legacyDue}, policy, nil)
+	assert.Equal(t, legacyDue.Add(-12*time.Hour), start)
+	assert.Equal(t, legacyDue, due)
+}
+
+func TestNormalizeSLAPolicyInput(t *testing.T) {
+	valid := SLAPolicyInput{
+		Name:                 " S3 ",
+		SensitivityLevel:     "s3",
+		OwnerResponseHours:   24,
+		ReminderHours:        []int{4, 12},
+		BusinessDayStartHour: 9,
+		BusinessDayEndHour:   17,
+	}
+	out, err := normalizeSLAPolicyInput(valid)
+	require.NoError(t, err)
+	assert.Equal(t, "S3", out.Name)
+	assert.Equal(t, "S3", out.SensitivityLevel)
+	assert.Equal(t, slaPolicyDefaultTimezone, out.Timezone)
+
+	bad := valid
+	bad.ReminderHours = []int{12, 4}
+	_, err = normalizeSLAPolicyInput(bad)
+	assert.Error(t, err, "reminders out of order")
+
+	bad = valid
+	bad.ReminderHours = []int{24}
+	_, err = normalizeSLAPolicyInput(bad)
+	assert.Error(t, err, "reminder at the deadline")
+
+	bad = valid
+	bad.BusinessDayStartHour = 17
+	_, err = normalizeSLAPolicyInput(bad)
+	assert.Error(t, err, "empty business day")
+
+	bad = valid
+	bad.Timezone = "Mars/Olympus"
+	_, err = normalizeSLAPolicyInput(bad)
+	assert.Error(t, err, "unknown timezone")
+}
+
+func TestParseHolidayCalendarFile(t *testing.T) {
+	holidays, err := ParseHolidayCalendarFile([]byte(`{"holidays":[{"date":"2026-12-25","name":"Hari Raya Natal"}]}`))
+	require.NoError(t, err)
+	assert.Len(t, holidays, 1)
+
+	_, err = ParseHolidayCalendarFile([]byte(`{"holidays":[{"date":"25-12-2026","name":"Natal"}]}`))
+	assert.Error(t, err)
+
+	cal := &HolidayCalendar{}
+	cal.Set(holidays)
+	assert.True(t, cal.HasYear(2026))
+	assert.False(t, cal.HasYear(2027))
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"strings"
 	"time"
@@ -46,13 +47,7 @@ func (s *EntTOTPService) GenerateSetup(ctx context.Context, userID int) (*dto.TO
 	}
 
 	// Generate a new TOTP key
-	key, err := totp.Generate(totp.GenerateOpts{
-		Issuer:      TOTPIssuer,
-		AccountName: u.Email,
-		Period:      30,
-		Digits:      otp.DigitsSix,
-		Algorithm:   otp.AlgorithmSHA1,
-	})
+	key, err := generateTOTPKey(u.Email)
 	if err != nil {
 		s.logger.Error("failed to generate TOTP key", zap.Error(err), zap.Int("user_id", userID))
 		return nil, errors.ErrInternalServer
@@ -200,6 +195,39 @@ func (s *EntTOTPService) Disable(ctx context.Context, userID int, password, code
 	return nil
 }
 
+// generateTOTPKey creates a new TOTP key (shared by user and admin enrollment)
+func generateTOTPKey(accountName string) (*otp.Key, error) {
+	return totp.Generate(totp.GenerateOpts{
+		Issuer:      TOTPIssuer,
+		AccountName: accountName,
+		Period:      TOTPPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+}
+
+// matchTOTPStep validates code against the current time step and one step either side,
+// returning the matched step so callers can reject replays of an already used code.
+func matchTOTPStep(code, secret string, at time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+	opts := totp.ValidateOpts{
+		Period:    TOTPPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+	step := at.Unix() / TOTPPeriod
+	for _, candidate := range []int64{step, step - 1, step + 1} {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(candidate*TOTPPeriod, 0).UTC(), opts)
+		if err == nil && subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return candidate, true
+		}
+	}
+	return 0, false
+}
+
 // normalizeBackupCode removes dashes, spaces and uppercases the code
 func normalizeBackupCode(code string) string {
 	code = strings.TrimSpace(code)
@@ -10,10 +10,16 @@ import (
 	"backend-gin/database"
 	"backend-gin/ent"
 	"backend-gin/ent/artifactsubmission"
+	"backend-gin/ent/caseclarification"
+	"backend-gin/ent/caseconversation"
+	"backend-gin/ent/casemessage"
 	"backend-gin/ent/category"
+	"backend-gin/ent/consultationinvitation"
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/endorsement"
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/finalofferrevision"
 	"backend-gin/ent/tag"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
@@ -540,6 +546,19 @@ func (s *EntValidationCaseService) DeleteValidationCase(ctx context.Context, own
 	if vc.AcceptedFinalOfferID != nil {
 		return apperrors.ErrInvalidInput.WithDetails("Validation Case tidak dapat dihapus setelah Final Offer diterima")
 	}
+	// Milestones of an earlier cycle keep their escrow transfers after the case reopens.
+	fundedMilestone, err := tx.FinalOfferMilestone.Query().
+		Where(
+			finaloffermilestone.ValidationCaseIDEQ(int(validationCaseID)),
+			finaloffermilestone.EscrowTransferIDNotNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return apperrors.ErrDatabase
+	}
+	if fundedMilestone {
+		return apperrors.ErrInvalidInput.WithDetails("Validation Case tidak dapat dihapus setelah Lock Funds dilakukan")
+	}
 
 	// FK strategy in current schema uses NO ACTION for most child rows.
 	// Delete children first, then parent case.
@@ -563,6 +582,42 @@ func (s *EntValidationCaseService) DeleteValidationCase(ctx context.Context, own
 		)
 		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Case Log")
 	}
+	if _, err := tx.CaseMessage.Delete().
+		Where(casemessage.HasConversationWith(caseconversation.ValidationCaseIDEQ(int(validationCaseID)))).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to delete case messages",
+			zap.Uint("validation_case_id", validationCaseID),
+			zap.Error(err),
+		)
+		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Pesan")
+	}
+	if _, err := tx.CaseConversation.Delete().
+		Where(caseconversation.ValidationCaseIDEQ(int(validationCaseID))).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to delete case conversations",
+			zap.Uint("validation_case_id", validationCaseID),
+			zap.Error(err),
+		)
+		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Pesan")
+	}
+	if _, err := tx.CaseClarification.Delete().
+		Where(caseclarification.ValidationCaseIDEQ(int(validationCaseID))).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to delete case clarifications",
+			zap.Uint("validation_case_id", validationCaseID),
+			zap.Error(err),
+		)
+		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Klarifikasi")
+	}
+	if _, err := tx.ConsultationInvitation.Delete().
+		Where(consultationinvitation.ValidationCaseIDEQ(int(validationCaseID))).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to delete consultation invitations",
+			zap.Uint("validation_case_id", validationCaseID),
+			zap.Error(err),
+		)
+		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Undangan Konsultasi")
+	}
 	if _, err := tx.ConsultationRequest.Delete().
 		Where(consultationrequest.ValidationCaseIDEQ(int(validationCaseID))).
 		Exec(ctx); err != nil {
@@ -572,6 +627,24 @@ func (s *EntValidationCaseService) DeleteValidationCase(ctx context.Context, own
 		)
 		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Consultation Request")
 	}
+	if _, err := tx.FinalOfferMilestone.Delete().
+		Where(finaloffermilestone.ValidationCaseIDEQ(int(validationCaseID))).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to delete final offer milestones",
+			zap.Uint("validation_case_id", validationCaseID),
+			zap.Error(err),
+		)
+		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Final Offer")
+	}
+	if _, err := tx.FinalOfferRevision.Delete().
+		Where(finalofferrevision.HasFinalOfferWith(finaloffer.ValidationCaseIDEQ(int(validationCaseID)))).
+		Exec(ctx); err != nil {
+		logger.Error("Failed to delete final offer revisions",
+			zap.Uint("validation_case_id", validationCaseID),
+			zap.Error(err),
+		)
+		return apperrors.ErrDatabase.WithDetails("Gagal menghapus Final Offer")
+	}
 	if _, err := tx.FinalOffer.Delete().
 		Where(finaloffer.ValidationCaseIDEQ(int(validationCaseID))).
 		Exec(ctx); err != nil {
@@ -728,6 +801,7 @@ func (s *EntValidationCaseService) validationCaseToDetailResponse(vc *ent.Valida
 		IntakeSchemaVersion:         vc.IntakeSchemaVersion,
 		ClarificationState:          vc.ClarificationState,
 		OwnerInactivityCount:        vc.OwnerInactivityCount,
+		TelegramRevealAllowed:       telegramRevealAllowed(vc),
 		Status:                      vc.Status,
 		BountyAmount:                vc.BountyAmount,
 		EscrowTransferID:            vc.EscrowTransferID,
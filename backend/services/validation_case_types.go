@@ -59,6 +59,8 @@ type ValidationCaseDetailResponse struct {
 	IntakeSchemaVersion  string                 `json:"intake_schema_version,omitempty"`
 	ClarificationState   string                 `json:"clarification_state,omitempty"`
 	OwnerInactivityCount int                    `json:"owner_inactivity_count"`
+	// TelegramRevealAllowed is false when the sensitivity tier or the owner disabled contact reveal.
+	TelegramRevealAllowed bool `json:"telegram_reveal_allowed"`
 
 	Status       string `json:"status"`
 	BountyAmount int64  `json:"bounty_amount"`
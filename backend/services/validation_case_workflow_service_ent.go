@@ -12,16 +12,20 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"backend-gin/config"
 	"backend-gin/database"
 	"backend-gin/ent"
+	"backend-gin/ent/caseclarification"
 	"backend-gin/ent/consultationrequest"
 	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/finaloffermilestone"
 	"backend-gin/ent/tag"
 	"backend-gin/ent/user"
 	"backend-gin/ent/validationcase"
 	"backend-gin/ent/validationcaselog"
+	"backend-gin/ent/validatordeliverysla"
 	apperrors "backend-gin/errors"
 	"backend-gin/logger"
 
@@ -65,6 +69,7 @@ const (
 	consultationStatusWaitingOwnerResponse = "waiting_owner_response"
 	consultationStatusOwnerTimeout         = "owner_timeout"
 
+	// Default owner-response SLA, used when no SLAPolicy matches the case.
 	ownerResponseSLAHours   = 12
 	ownerReminderFirstHour  = 2
 	ownerReminderSecondHour = 8
@@ -172,10 +177,38 @@ func requiredStakeForConsultation(vc *ent.ValidationCase) int64 {
 	}
 }
 
+// ensureConsultationStake checks the validator's Credibility Stake against the case's
+// sensitivity and returns the required amount.
+func ensureConsultationStake(vc *ent.ValidationCase, validator *ent.User) (int64, error) {
+	requiredStake := requiredStakeForConsultation(vc)
+	if validator.GuaranteeAmount < requiredStake {
+		return requiredStake, apperrors.ErrInvalidInput.WithDetails(
+			fmt.Sprintf(
+				"Credibility Stake tidak memenuhi syarat untuk sensitivity %s. Minimal Rp %d",
+				strings.ToUpper(strings.TrimSpace(vc.SensitivityLevel)),
+				requiredStake,
+			),
+		)
+	}
+	return requiredStake, nil
+}
+
 func finalOfferSubmissionKey(validationCaseID int, validatorUserID uint, workflowCycle int) string {
 	return fmt.Sprintf("vc:%d:validator:%d:cycle:%d", validationCaseID, validatorUserID, workflowCycle)
 }
 
+// ownerResponseSLAWindow returns when the owner-response window of req opened and when it is
+// due under its policy snapshot. Requests without a recorded start (created before SLA
+// policies) keep their stored due time.
+func ownerResponseSLAWindow(req *ent.ConsultationRequest, policy SLAPolicySnapshot, cal *HolidayCalendar) (time.Time, time.Time) {
+	if req.OwnerResponseStartedAt != nil {
+		start := *req.OwnerResponseStartedAt
+		return start, policy.OwnerResponseDueAt(start, cal)
+	}
+	due := *req.OwnerResponseDueAt
+	return due.Add(-time.Duration(policy.OwnerResponseHours) * time.Hour), due
+}
+
 func dueTimeFromNow(now time.Time) time.Time {
 	return now.Add(ownerResponseSLAHours * time.Hour)
 }
@@ -247,8 +280,10 @@ func stakeGuaranteeScore(guaranteeAmount int64) int {
 	}
 }
 
-func responsivenessSLAScore(approvedReqs []*ent.ConsultationRequest, firstOfferByCase map[int]time.Time, now time.Time) int {
-	if len(approvedReqs) == 0 {
+// responsivenessSLAScore averages how fast the validator answered approved consultations and
+// whether they delivered within the delivery SLA of locked escrows.
+func responsivenessSLAScore(approvedReqs []*ent.ConsultationRequest, firstOfferByCase map[int]time.Time, deliverySLAs []*ent.ValidatorDeliverySLA, now time.Time) int {
+	if len(approvedReqs) == 0 && len(deliverySLAs) == 0 {
 		return 50
 	}
 
@@ -284,6 +319,14 @@ func responsivenessSLAScore(approvedReqs []*ent.ConsultationRequest, firstOfferB
 		total += score
 		count++
 	}
+	for _, sla := range deliverySLAs {
+		score, ok := validatorDeliveryOutcomeScore(sla)
+		if !ok {
+			continue
+		}
+		total += score
+		count++
+	}
 
 	if count == 0 {
 		return 50
@@ -368,10 +411,23 @@ func (s *EntValidationCaseWorkflowService) buildMatchingScore(
 	if err != nil {
 		return nil, err
 	}
-	responsivenessScore := responsivenessSLAScore(approvedReqs, firstOfferByCase, time.Now())
+	deliverySLAs, err := s.client.ValidatorDeliverySLA.Query().
+		Where(validatordeliverysla.ValidatorUserIDEQ(validatorUserID)).
+		Order(ent.Desc(validatordeliverysla.FieldCreatedAt)).
+		Limit(200).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	responsivenessScore := responsivenessSLAScore(approvedReqs, firstOfferByCase, deliverySLAs, time.Now())
+	reputations, err := loadValidatorReputations(ctx, s.client, []int{validatorUserID})
+	if err != nil {
+		return nil, err
+	}
+	reputationScore := reputationMatchScore(reputations[validatorUserID], caseDomain)
 
 	stakeScore := stakeGuaranteeScore(guaranteeAmount)
-	total := clampScore(int(math.Round(float64(domainScore+evidenceScore+historyDisputeScore+responsivenessScore+stakeScore) / 5)))
+	total := clampScore(int(math.Round(float64(domainScore+evidenceScore+historyDisputeScore+responsivenessScore+stakeScore+reputationScore) / 6)))
 
 	return &MatchingScoreBreakdown{
 		Total:             total,
@@ -380,21 +436,29 @@ func (s *EntValidationCaseWorkflowService) buildMatchingScore(
 		HistoryDispute:    historyDisputeScore,
 		ResponsivenessSLA: responsivenessScore,
 		StakeGuarantee:    stakeScore,
+		Reputation:        reputationScore,
 	}, nil
 }
 
-// buildMatchingScoreBatch computes matching score using pre-loaded data to avoid N+1 queries.
-func (s *EntValidationCaseWorkflowService) buildMatchingScoreBatch(
-	vc *ent.ValidationCase,
-	validatorUserID int,
-	guaranteeAmount int64,
+// validatorHistoryAggregate is the case-independent part of a validator's matching score:
+// the tags of cases they made Final Offers on, their dispute record and responsiveness.
+type validatorHistoryAggregate struct {
+	domainSlugs               map[string]struct{}
+	evidenceSlugs             map[string]struct{}
+	historyCaseCount          int
+	disputedCaseCount         int
+	approvedConsultationCount int
+	deliverySLACount          int
+	responsivenessScore       int
+}
+
+func buildValidatorHistoryAggregate(
 	offers []*ent.FinalOffer,
 	approvedReqs []*ent.ConsultationRequest,
+	deliverySLAs []*ent.ValidatorDeliverySLA,
 	historyCaseByID map[int]*ent.ValidationCase,
-) (*MatchingScoreBreakdown, error) {
-	caseDomain := extractTagSetByPrefix(vc.Edges.Tags, "domain-")
-	caseEvidence := extractTagSetByPrefix(vc.Edges.Tags, "evidence-")
-
+	now time.Time,
+) validatorHistoryAggregate {
 	// Build history from pre-loaded offers
 	firstOfferByCase := make(map[int]time.Time)
 	caseIDs := make([]int, 0)
@@ -409,49 +473,193 @@ func (s *EntValidationCaseWorkflowService) buildMatchingScoreBatch(
 		}
 	}
 
-	historyDomain := map[string]struct{}{}
-	historyEvidence := map[string]struct{}{}
-	disputedCount := 0
-	historyCaseCount := 0
+	agg := validatorHistoryAggregate{
+		domainSlugs:               map[string]struct{}{},
+		evidenceSlugs:             map[string]struct{}{},
+		approvedConsultationCount: len(approvedReqs),
+		deliverySLACount:          len(deliverySLAs),
+	}
 	for _, caseID := range caseIDs {
 		hc, ok := historyCaseByID[caseID]
 		if !ok {
 			continue
 		}
-		historyCaseCount++
+		agg.historyCaseCount++
 		for slug := range extractTagSetByPrefix(hc.Edges.Tags, "domain-") {
-			historyDomain[slug] = struct{}{}
+			agg.domainSlugs[slug] = struct{}{}
 		}
 		for slug := range extractTagSetByPrefix(hc.Edges.Tags, "evidence-") {
-			historyEvidence[slug] = struct{}{}
+			agg.evidenceSlugs[slug] = struct{}{}
 		}
 		if normalizeStatus(hc.Status) == "disputed" {
-			disputedCount++
+			agg.disputedCaseCount++
 		}
 	}
+	agg.responsivenessScore = responsivenessSLAScore(approvedReqs, firstOfferByCase, deliverySLAs, now)
+	return agg
+}
 
-	domainScore := overlapScore(caseDomain, historyDomain)
-	evidenceScore := overlapScore(caseEvidence, historyEvidence)
+// scoreFromHistoryAggregate combines a validator's history aggregate with the case's tags,
+// the validator's current Credibility Stake and their decayed reputation.
+func scoreFromHistoryAggregate(vc *ent.ValidationCase, agg validatorHistoryAggregate, guaranteeAmount int64, rep validatorReputationSnapshot) *MatchingScoreBreakdown {
+	caseDomain := extractTagSetByPrefix(vc.Edges.Tags, "domain-")
+	caseEvidence := extractTagSetByPrefix(vc.Edges.Tags, "evidence-")
+
+	domainScore := overlapScore(caseDomain, agg.domainSlugs)
+	evidenceScore := overlapScore(caseEvidence, agg.evidenceSlugs)
 
 	historyDisputeScore := 50
-	if historyCaseCount > 0 {
-		historyDisputeScore = clampScore(int(math.Round((1 - float64(disputedCount)/float64(historyCaseCount)) * 100)))
+	if agg.historyCaseCount > 0 {
+		historyDisputeScore = clampScore(int(math.Round((1 - float64(agg.disputedCaseCount)/float64(agg.historyCaseCount)) * 100)))
 	}
 
-	responsivenessScore := responsivenessSLAScore(approvedReqs, firstOfferByCase, time.Now())
 	stakeScore := stakeGuaranteeScore(guaranteeAmount)
-	total := clampScore(int(math.Round(float64(domainScore+evidenceScore+historyDisputeScore+responsivenessScore+stakeScore) / 5)))
+	reputationScore := reputationMatchScore(rep, caseDomain)
+	total := clampScore(int(math.Round(float64(domainScore+evidenceScore+historyDisputeScore+agg.responsivenessScore+stakeScore+reputationScore) / 6)))
 
 	return &MatchingScoreBreakdown{
 		Total:             total,
 		DomainFit:         domainScore,
 		EvidenceFit:       evidenceScore,
 		HistoryDispute:    historyDisputeScore,
-		ResponsivenessSLA: responsivenessScore,
+		ResponsivenessSLA: agg.responsivenessScore,
 		StakeGuarantee:    stakeScore,
+		Reputation:        reputationScore,
+	}
+}
+
+// buildMatchingScoreBatch computes matching score using pre-loaded data to avoid N+1 queries.
+func (s *EntValidationCaseWorkflowService) buildMatchingScoreBatch(
+	vc *ent.ValidationCase,
+	validatorUserID int,
+	guaranteeAmount int64,
+	offers []*ent.FinalOffer,
+	approvedReqs []*ent.ConsultationRequest,
+	deliverySLAs []*ent.ValidatorDeliverySLA,
+	historyCaseByID map[int]*ent.ValidationCase,
+	reputation validatorReputationSnapshot,
+) (*MatchingScoreBreakdown, error) {
+	agg := buildValidatorHistoryAggregate(offers, approvedReqs, deliverySLAs, historyCaseByID, time.Now())
+	return scoreFromHistoryAggregate(vc, agg, guaranteeAmount, reputation), nil
+}
+
+// matchingScoreInputs is the batch-loaded history behind buildMatchingScoreBatch for a set
+// of validators.
+type matchingScoreInputs struct {
+	offersByValidator       map[int][]*ent.FinalOffer
+	approvedReqsByValidator map[int][]*ent.ConsultationRequest
+	deliverySLAsByValidator map[int][]*ent.ValidatorDeliverySLA
+	historyCaseByID         map[int]*ent.ValidationCase
+	reputationByValidator   map[int]validatorReputationSnapshot
+}
+
+// loadMatchingScoreInputs batch-loads FinalOffer, ConsultationRequest, delivery SLA and
+// history case and reputation data for all validators to avoid N+1 queries in buildMatchingScore.
+func (s *EntValidationCaseWorkflowService) loadMatchingScoreInputs(ctx context.Context, validatorIDs []int) (*matchingScoreInputs, error) {
+	// Batch load all FinalOffers for these validators
+	allOffers, err := s.client.FinalOffer.Query().
+		Where(finaloffer.ValidatorUserIDIn(validatorIDs...)).
+		Order(ent.Desc(finaloffer.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offersByValidator := make(map[int][]*ent.FinalOffer)
+	for _, o := range allOffers {
+		offersByValidator[o.ValidatorUserID] = append(offersByValidator[o.ValidatorUserID], o)
+	}
+
+	// Batch load all approved ConsultationRequests for these validators
+	allApprovedReqs, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidatorUserIDIn(validatorIDs...),
+			consultationrequest.ApprovedAtNotNil(),
+		).
+		Limit(200 * len(validatorIDs)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	approvedReqsByValidator := make(map[int][]*ent.ConsultationRequest)
+	for _, r := range allApprovedReqs {
+		approvedReqsByValidator[r.ValidatorUserID] = append(approvedReqsByValidator[r.ValidatorUserID], r)
+	}
+
+	// Batch load delivery SLA history for these validators
+	allDeliverySLAs, err := s.client.ValidatorDeliverySLA.Query().
+		Where(validatordeliverysla.ValidatorUserIDIn(validatorIDs...)).
+		Order(ent.Desc(validatordeliverysla.FieldCreatedAt)).
+		Limit(200 * len(validatorIDs)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deliverySLAsByValidator := make(map[int][]*ent.ValidatorDeliverySLA)
+	for _, sla := range allDeliverySLAs {
+		deliverySLAsByValidator[sla.ValidatorUserID] = append(deliverySLAsByValidator[sla.ValidatorUserID], sla)
+	}
+
+	// Batch load all history validation cases (from offers)
+	historyCaseIDSet := make(map[int]struct{})
+	for _, o := range allOffers {
+		historyCaseIDSet[o.ValidationCaseID] = struct{}{}
+	}
+	historyCaseIDs := make([]int, 0, len(historyCaseIDSet))
+	for id := range historyCaseIDSet {
+		historyCaseIDs = append(historyCaseIDs, id)
+	}
+	var allHistoryCases []*ent.ValidationCase
+	if len(historyCaseIDs) > 0 {
+		allHistoryCases, err = s.client.ValidationCase.Query().
+			Where(validationcase.IDIn(historyCaseIDs...)).
+			WithTags(func(q *ent.TagQuery) {
+				q.Where(tag.IsActiveEQ(true))
+			}).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	historyCaseByID := make(map[int]*ent.ValidationCase)
+	for _, hc := range allHistoryCases {
+		historyCaseByID[hc.ID] = hc
+	}
+
+	reputationByValidator, err := loadValidatorReputations(ctx, s.client, validatorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &matchingScoreInputs{
+		offersByValidator:       offersByValidator,
+		approvedReqsByValidator: approvedReqsByValidator,
+		deliverySLAsByValidator: deliverySLAsByValidator,
+		historyCaseByID:         historyCaseByID,
+		reputationByValidator:   reputationByValidator,
 	}, nil
 }
 
+// scoreWithInputs scores one validator from pre-loaded inputs. Failures are logged and
+// leave the score empty rather than failing the listing.
+func (s *EntValidationCaseWorkflowService) scoreWithInputs(vc *ent.ValidationCase, validatorUserID int, guaranteeAmount int64, inputs *matchingScoreInputs) *MatchingScoreBreakdown {
+	score, err := s.buildMatchingScoreBatch(
+		vc, validatorUserID, guaranteeAmount,
+		inputs.offersByValidator[validatorUserID],
+		inputs.approvedReqsByValidator[validatorUserID],
+		inputs.deliverySLAsByValidator[validatorUserID],
+		inputs.historyCaseByID,
+		inputs.reputationByValidator[validatorUserID],
+	)
+	if err != nil {
+		logger.Warn("Failed to compute validator matching score",
+			zap.Error(err),
+			zap.Int("validation_case_id", vc.ID),
+			zap.Int("validator_user_id", validatorUserID),
+		)
+	}
+	return score
+}
+
 func (s *EntValidationCaseWorkflowService) appendCaseLogBestEffort(ctx context.Context, validationCaseID int, actorUserID *int, eventType string, detail map[string]interface{}) {
 	create := s.client.ValidationCaseLog.
 		Create().
@@ -512,15 +720,9 @@ func (s *EntValidationCaseWorkflowService) RequestConsultation(ctx context.Conte
 		return 0, apperrors.ErrDatabase
 	}
 
-	requiredStake := requiredStakeForConsultation(vc)
-	if validator.GuaranteeAmount < requiredStake {
-		return 0, apperrors.ErrInvalidInput.WithDetails(
-			fmt.Sprintf(
-				"Credibility Stake tidak memenuhi syarat untuk sensitivity %s. Minimal Rp %d",
-				strings.ToUpper(strings.TrimSpace(vc.SensitivityLevel)),
-				requiredStake,
-			),
-		)
+	requiredStake, err := ensureConsultationStake(vc, validator)
+	if err != nil {
+		return 0, err
 	}
 
 	cycle := currentWorkflowCycle(vc)
@@ -545,6 +747,7 @@ func (s *EntValidationCaseWorkflowService) RequestConsultation(ctx context.Conte
 		SetWorkflowCycle(cycle).
 		SetStatus(consultationStatusPending).
 		SetReminderCount(0).
+		SetSLAPolicySnapshot(resolveSLAPolicySnapshot(ctx, s.client, vc).toJSON()).
 		Save(ctx)
 	if err != nil {
 		return 0, apperrors.ErrDatabase
@@ -603,6 +806,33 @@ func (s *EntValidationCaseWorkflowService) GetConsultationRequestForValidator(
 	}, nil
 }
 
+// guaranteeLockConsultationStatuses are the consultation request statuses that lock the
+// validator's guarantee.
+var guaranteeLockConsultationStatuses = []string{
+	consultationStatusApproved,
+	consultationStatusWaitingOwnerResponse,
+	consultationStatusOwnerTimeout,
+}
+
+// consultationHoldsGuaranteeLock reports whether an active consultation request still locks
+// the validator's guarantee: it must be on the case's current workflow cycle.
+func consultationHoldsGuaranteeLock(req *ent.ConsultationRequest, vc *ent.ValidationCase) bool {
+	if vc == nil {
+		return false
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return false
+	}
+	if req.WorkflowCycle != currentWorkflowCycle(vc) {
+		return false
+	}
+	// Completed cases with no escrow are treated as already closed and should not block guarantee release.
+	if normalizeStatus(vc.Status) == "completed" && strings.TrimSpace(valueOrEmpty(vc.EscrowTransferID)) == "" {
+		return false
+	}
+	return true
+}
+
 func (s *EntValidationCaseWorkflowService) ListConsultationGuaranteeLocksForValidator(
 	ctx context.Context,
 	validatorUserID uint,
@@ -610,11 +840,7 @@ func (s *EntValidationCaseWorkflowService) ListConsultationGuaranteeLocksForVali
 	reqs, err := s.client.ConsultationRequest.Query().
 		Where(
 			consultationrequest.ValidatorUserIDEQ(int(validatorUserID)),
-			consultationrequest.StatusIn(
-				consultationStatusApproved,
-				consultationStatusWaitingOwnerResponse,
-				consultationStatusOwnerTimeout,
-			),
+			consultationrequest.StatusIn(guaranteeLockConsultationStatuses...),
 		).
 		WithValidationCase().
 		Order(ent.Desc(consultationrequest.FieldCreatedAt)).
@@ -627,29 +853,15 @@ func (s *EntValidationCaseWorkflowService) ListConsultationGuaranteeLocksForVali
 	seenCaseID := make(map[uint]struct{}, len(reqs))
 	for _, req := range reqs {
 		vc := req.Edges.ValidationCase
-		if vc == nil {
-			continue
-		}
-		if err := ensureWorkflowV1Case(vc); err != nil {
-			continue
-		}
-		if req.WorkflowCycle != currentWorkflowCycle(vc) {
-			continue
-		}
-
-		escrowTransferID := strings.TrimSpace(valueOrEmpty(vc.EscrowTransferID))
-		validationStatus := normalizeStatus(vc.Status)
-
-		// Completed cases with no escrow are treated as already closed and should not block guarantee release.
-		if validationStatus == "completed" && escrowTransferID == "" {
+		if !consultationHoldsGuaranteeLock(req, vc) {
 			continue
 		}
 
 		out = append(out, ConsultationGuaranteeLockItem{
 			ValidationCaseID:   uint(vc.ID),
-			ValidationStatus:   validationStatus,
+			ValidationStatus:   normalizeStatus(vc.Status),
 			ConsultationStatus: normalizeStatus(req.Status),
-			EscrowTransferID:   escrowTransferID,
+			EscrowTransferID:   strings.TrimSpace(valueOrEmpty(vc.EscrowTransferID)),
 			DisputeID:          strings.TrimSpace(valueOrEmpty(vc.DisputeID)),
 		})
 		seenCaseID[uint(vc.ID)] = struct{}{}
@@ -689,6 +901,18 @@ func (s *EntValidationCaseWorkflowService) ListConsultationGuaranteeLocksForVali
 		seenCaseID[vcID] = struct{}{}
 	}
 
+	slashLocks, err := s.guaranteeSlashLocks(ctx, validatorUserID)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	for _, lock := range slashLocks {
+		if _, exists := seenCaseID[lock.ValidationCaseID]; exists {
+			continue
+		}
+		out = append(out, lock)
+		seenCaseID[lock.ValidationCaseID] = struct{}{}
+	}
+
 	return out, nil
 }
 
@@ -727,8 +951,7 @@ func (s *EntValidationCaseWorkflowService) ListConsultationRequestsForOwner(ctx
 		return nil, apperrors.ErrDatabase
 	}
 
-	// Batch-load all FinalOffer and ConsultationRequest data for all validators
-	// to avoid N+1 queries in buildMatchingScore.
+	// Collect each validator once so their matching score inputs are batch-loaded.
 	validatorIDs := make([]int, 0, len(items))
 	validatorIDSet := make(map[int]struct{})
 	for _, it := range items {
@@ -741,60 +964,10 @@ func (s *EntValidationCaseWorkflowService) ListConsultationRequestsForOwner(ctx
 		return []ConsultationRequestItem{}, nil
 	}
 
-	// Batch load all FinalOffers for these validators
-	allOffers, err := s.client.FinalOffer.Query().
-		Where(finaloffer.ValidatorUserIDIn(validatorIDs...)).
-		Order(ent.Desc(finaloffer.FieldCreatedAt)).
-		All(ctx)
+	inputs, err := s.loadMatchingScoreInputs(ctx, validatorIDs)
 	if err != nil {
 		return nil, apperrors.ErrDatabase
 	}
-	offersByValidator := make(map[int][]*ent.FinalOffer)
-	for _, o := range allOffers {
-		offersByValidator[o.ValidatorUserID] = append(offersByValidator[o.ValidatorUserID], o)
-	}
-
-	// Batch load all approved ConsultationRequests for these validators
-	allApprovedReqs, err := s.client.ConsultationRequest.Query().
-		Where(
-			consultationrequest.ValidatorUserIDIn(validatorIDs...),
-			consultationrequest.ApprovedAtNotNil(),
-		).
-		Limit(200 * len(validatorIDs)).
-		All(ctx)
-	if err != nil {
-		return nil, apperrors.ErrDatabase
-	}
-	approvedReqsByValidator := make(map[int][]*ent.ConsultationRequest)
-	for _, r := range allApprovedReqs {
-		approvedReqsByValidator[r.ValidatorUserID] = append(approvedReqsByValidator[r.ValidatorUserID], r)
-	}
-
-	// Batch load all history validation cases (from offers)
-	historyCaseIDSet := make(map[int]struct{})
-	for _, o := range allOffers {
-		historyCaseIDSet[o.ValidationCaseID] = struct{}{}
-	}
-	historyCaseIDs := make([]int, 0, len(historyCaseIDSet))
-	for id := range historyCaseIDSet {
-		historyCaseIDs = append(historyCaseIDs, id)
-	}
-	var allHistoryCases []*ent.ValidationCase
-	if len(historyCaseIDs) > 0 {
-		allHistoryCases, err = s.client.ValidationCase.Query().
-			Where(validationcase.IDIn(historyCaseIDs...)).
-			WithTags(func(q *ent.TagQuery) {
-				q.Where(tag.IsActiveEQ(true))
-			}).
-			All(ctx)
-		if err != nil {
-			return nil, apperrors.ErrDatabase
-		}
-	}
-	historyCaseByID := make(map[int]*ent.ValidationCase)
-	for _, hc := range allHistoryCases {
-		historyCaseByID[hc.ID] = hc
-	}
 
 	out := make([]ConsultationRequestItem, 0, len(items))
 	scoreCache := make(map[int]*MatchingScoreBreakdown)
@@ -802,20 +975,7 @@ func (s *EntValidationCaseWorkflowService) ListConsultationRequestsForOwner(ctx
 		validator := buildUserSummaryFromEnt(it.Edges.ValidatorUser)
 		score, cached := scoreCache[it.ValidatorUserID]
 		if !cached {
-			scored, scoreErr := s.buildMatchingScoreBatch(
-				vc, it.ValidatorUserID, validator.GuaranteeAmount,
-				offersByValidator[it.ValidatorUserID],
-				approvedReqsByValidator[it.ValidatorUserID],
-				historyCaseByID,
-			)
-			if scoreErr != nil {
-				logger.Warn("Failed to compute validator matching score",
-					zap.Error(scoreErr),
-					zap.Int("validation_case_id", vc.ID),
-					zap.Int("validator_user_id", it.ValidatorUserID),
-				)
-			}
-			score = scored
+			score = s.scoreWithInputs(vc, it.ValidatorUserID, validator.GuaranteeAmount, inputs)
 			scoreCache[it.ValidatorUserID] = score
 		}
 		out = append(out, ConsultationRequestItem{
@@ -904,6 +1064,7 @@ func (s *EntValidationCaseWorkflowService) ApproveConsultationRequest(ctx contex
 		SetApprovedAt(now).
 		ClearRejectedAt().
 		ClearOwnerResponseDueAt().
+		ClearOwnerResponseStartedAt().
 		ClearExpiresAt().
 		SetReminderCount(0).
 		ClearAutoClosedReason().
@@ -965,6 +1126,7 @@ func (s *EntValidationCaseWorkflowService) RejectConsultationRequest(ctx context
 		SetRejectedAt(now).
 		ClearApprovedAt().
 		ClearOwnerResponseDueAt().
+		ClearOwnerResponseStartedAt().
 		ClearExpiresAt().
 		SetReminderCount(0).
 		ClearAutoClosedReason().
@@ -983,6 +1145,7 @@ func (s *EntValidationCaseWorkflowService) RejectConsultationRequest(ctx context
 
 func (s *EntValidationCaseWorkflowService) ProcessOwnerResponseSLA(ctx context.Context) (int, int, error) {
 	now := time.Now()
+	calendar := SLAHolidayCalendar()
 	reminderEvents := 0
 	timeoutEvents := 0
 
@@ -1024,27 +1187,21 @@ func (s *EntValidationCaseWorkflowService) ProcessOwnerResponseSLA(ctx context.C
 			continue
 		}
 
-		due := req.OwnerResponseDueAt
-		start := due.Add(-ownerResponseSLAHours * time.Hour)
-		elapsed := now.Sub(start)
+		policy := slaPolicySnapshotFromJSON(req.SLAPolicySnapshot)
+		start, due := ownerResponseSLAWindow(req, policy, calendar)
 
 		newReminderCount := req.ReminderCount
-		if elapsed >= ownerReminderFirstHour*time.Hour && newReminderCount < 1 {
-			s.appendCaseLogBestEffort(ctx, vc.ID, nil, "owner_response_sla_reminder", map[string]interface{}{
-				"consultation_request_id": req.ID,
-				"reminder_hour":           ownerReminderFirstHour,
-				"owner_response_due_at":   due.Unix(),
-			})
-			newReminderCount = 1
-			reminderEvents++
-		}
-		if elapsed >= ownerReminderSecondHour*time.Hour && newReminderCount < 2 {
+		for i, hour := range policy.ReminderHours {
+			if newReminderCount > i || now.Before(policy.AddHours(start, hour, calendar)) {
+				continue
+			}
 			s.appendCaseLogBestEffort(ctx, vc.ID, nil, "owner_response_sla_reminder", map[string]interface{}{
 				"consultation_request_id": req.ID,
-				"reminder_hour":           ownerReminderSecondHour,
+				"reminder_hour":           hour,
 				"owner_response_due_at":   due.Unix(),
+				"sla_policy":              policy.Name,
 			})
-			newReminderCount = 2
+			newReminderCount = i + 1
 			reminderEvents++
 		}
 		if newReminderCount != req.ReminderCount {
@@ -1053,7 +1210,7 @@ func (s *EntValidationCaseWorkflowService) ProcessOwnerResponseSLA(ctx context.C
 				Save(ctx)
 		}
 
-		if now.Before(*due) {
+		if now.Before(due) {
 			continue
 		}
 
@@ -1065,6 +1222,9 @@ func (s *EntValidationCaseWorkflowService) ProcessOwnerResponseSLA(ctx context.C
 			Save(ctx); err != nil {
 			return reminderEvents, timeoutEvents, apperrors.ErrDatabase
 		}
+		if err := s.expireClarifications(ctx, req.ID, now); err != nil {
+			return reminderEvents, timeoutEvents, err
+		}
 
 		caseUpdate := s.client.ValidationCase.UpdateOneID(vc.ID).
 			SetStatus(caseStatusOnHoldOwnerInactive).
@@ -1080,6 +1240,7 @@ func (s *EntValidationCaseWorkflowService) ProcessOwnerResponseSLA(ctx context.C
 			"consultation_request_id": req.ID,
 			"owner_response_due_at":   due.Unix(),
 			"timeout_reason":          "owner_inactive_sla_timeout",
+			"sla_policy":              policy.Name,
 		})
 		s.appendCaseLogBestEffort(ctx, vc.ID, nil, "case_status_changed", map[string]interface{}{
 			"from":   normalizeStatus(vc.Status),
@@ -1092,12 +1253,25 @@ func (s *EntValidationCaseWorkflowService) ProcessOwnerResponseSLA(ctx context.C
 			"reassignment":            false,
 			"reputation_impact":       "none",
 		})
+		s.recordReputationEventBestEffort(ctx, reputationEventInput{
+			validatorUserID:  req.ValidatorUserID,
+			validationCaseID: vc.ID,
+			workflowCycle:    req.WorkflowCycle,
+			kind:             reputationEventOwnerTimeoutReleased,
+			dedupeKey:        fmt.Sprintf("owner_timeout:request:%d", req.ID),
+			detail: map[string]interface{}{
+				"consultation_request_id": req.ID,
+				"timeout_reason":          "owner_inactive_sla_timeout",
+			},
+		})
 		timeoutEvents++
 	}
 
 	return reminderEvents, timeoutEvents, nil
 }
 
+// normalizeLegacyClarificationFlow recovers requests and cases left in clarification states
+// by the retired clarification flow. Anything driven by CaseClarification rows is left alone.
 func (s *EntValidationCaseWorkflowService) normalizeLegacyClarificationFlow(ctx context.Context, now time.Time) (int, error) {
 	recovered := 0
 
@@ -1127,11 +1301,21 @@ func (s *EntValidationCaseWorkflowService) normalizeLegacyClarificationFlow(ctx
 		if req.WorkflowCycle != currentWorkflowCycle(vc) {
 			continue
 		}
+		tracked, err := s.client.CaseClarification.Query().
+			Where(caseclarification.ConsultationRequestIDEQ(req.ID)).
+			Exist(ctx)
+		if err != nil {
+			return recovered, apperrors.ErrDatabase
+		}
+		if tracked {
+			continue
+		}
 
 		updateReq := s.client.ConsultationRequest.UpdateOneID(req.ID).
 			SetStatus(consultationStatusApproved).
 			SetReminderCount(0).
 			ClearOwnerResponseDueAt().
+			ClearOwnerResponseStartedAt().
 			ClearExpiresAt().
 			ClearAutoClosedReason().
 			ClearRejectedAt()
@@ -1141,6 +1325,21 @@ func (s *EntValidationCaseWorkflowService) normalizeLegacyClarificationFlow(ctx
 		if _, err := updateReq.Save(ctx); err != nil {
 			return recovered, apperrors.ErrDatabase
 		}
+		recovered++
+
+		// Another validator's clarification may be holding the case; leave its state to that flow.
+		caseTracked, err := s.client.CaseClarification.Query().
+			Where(
+				caseclarification.ValidationCaseIDEQ(vc.ID),
+				caseclarification.WorkflowCycleEQ(currentWorkflowCycle(vc)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return recovered, apperrors.ErrDatabase
+		}
+		if caseTracked {
+			continue
+		}
 
 		previousStatus := normalizeStatus(vc.Status)
 		nextStatus := deriveCaseStatusFromWorkflowLinkage(vc)
@@ -1175,8 +1374,6 @@ func (s *EntValidationCaseWorkflowService) normalizeLegacyClarificationFlow(ctx
 				"reason":                  "clarification_feature_removed",
 			})
 		}
-
-		recovered++
 	}
 
 	legacyCases, err := s.client.ValidationCase.Query().
@@ -1196,6 +1393,18 @@ func (s *EntValidationCaseWorkflowService) normalizeLegacyClarificationFlow(ctx
 		if err := ensureWorkflowV1Case(vc); err != nil {
 			continue
 		}
+		tracked, err := s.client.CaseClarification.Query().
+			Where(
+				caseclarification.ValidationCaseIDEQ(vc.ID),
+				caseclarification.WorkflowCycleEQ(currentWorkflowCycle(vc)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return recovered, apperrors.ErrDatabase
+		}
+		if tracked {
+			continue
+		}
 		previousStatus := normalizeStatus(vc.Status)
 		needsStatusRecovery := previousStatus == caseStatusWaitingOwnerResponse || previousStatus == caseStatusOnHoldOwnerInactive
 		nextStatus := deriveCaseStatusFromWorkflowLinkage(vc)
@@ -1247,9 +1456,11 @@ func (s *EntValidationCaseWorkflowService) RevealOwnerTelegramContact(ctx contex
 	if err := ensureWorkflowV1Case(vc); err != nil {
 		return "", err
 	}
-	switch strings.ToUpper(strings.TrimSpace(vc.SensitivityLevel)) {
-	case "S2", "S3":
-		return "", apperrors.ErrInvalidInput.WithDetails("Sensitivity policy melarang reveal Telegram untuk tier ini")
+	if !sensitivityAllowsTelegram(vc.SensitivityLevel) {
+		return "", apperrors.ErrInvalidInput.WithDetails("Sensitivity policy melarang reveal Telegram untuk tier ini. Gunakan pesan di platform.")
+	}
+	if vc.TelegramRevealDisabled {
+		return "", apperrors.ErrInvalidInput.WithDetails("Pemilik kasus menonaktifkan reveal Telegram. Gunakan pesan di platform.")
 	}
 
 	req, err := s.client.ConsultationRequest.Query().
@@ -1320,7 +1531,9 @@ func (s *EntValidationCaseWorkflowService) RevealOwnerTelegramContact(ctx contex
 	return telegram, nil
 }
 
-func (s *EntValidationCaseWorkflowService) SubmitFinalOffer(ctx context.Context, validationCaseID uint, validatorUserID uint, holdHours int, terms string) (uint, error) {
+// SubmitFinalOffer opens a validator's offer at the posted bounty. A non-empty milestones plan
+// splits the bounty into staged deliveries, each escrowed separately once accepted.
+func (s *EntValidationCaseWorkflowService) SubmitFinalOffer(ctx context.Context, validationCaseID uint, validatorUserID uint, holdHours int, terms string, milestones []FinalOfferMilestoneSpec) (uint, error) {
 	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -1368,13 +1581,18 @@ func (s *EntValidationCaseWorkflowService) SubmitFinalOffer(ctx context.Context,
 		Order(ent.Desc(finaloffer.FieldCreatedAt)).
 		First(ctx)
 	if err == nil && existingOffer != nil {
+		switch normalizeStatus(existingOffer.Status) {
+		case finalOfferStatusWithdrawn, finalOfferStatusExpired:
+			return 0, apperrors.ErrInvalidInput.WithDetails("Final Offer untuk kasus ini sudah pernah diajukan. Ajukan ulang melalui revisi Final Offer.")
+		}
 		return 0, apperrors.ErrInvalidInput.WithDetails("Final Offer untuk kasus ini sudah pernah diajukan")
 	}
 	if err != nil && !ent.IsNotFound(err) {
 		return 0, apperrors.ErrDatabase
 	}
 
-	// Final Offer amount is locked to the posted bounty. Validators do not negotiate amount in-platform.
+	// The opening offer is the posted bounty; the amount can then be negotiated through
+	// counter-offers and revisions.
 	amount := vc.BountyAmount
 	if amount <= 0 {
 		return 0, apperrors.ErrInvalidInput.WithDetails("bounty_amount belum diatur")
@@ -1383,29 +1601,37 @@ func (s *EntValidationCaseWorkflowService) SubmitFinalOffer(ctx context.Context,
 		return 0, apperrors.ErrInvalidInput.WithDetails("bounty_amount minimal Rp 10.000")
 	}
 
-	// Escrow hold windows are discrete options (mirrors wallet transfer UI):
-	// 1 day 8 hours (light), 7 days (standard), 30 days (long).
-	if holdHours <= 0 {
-		holdHours = 7 * 24
+	// Escrow hold windows are discrete options (mirrors wallet transfer UI).
+	holdHours, err = normalizeFinalOfferHoldHours(holdHours)
+	if err != nil {
+		return 0, err
 	}
-	switch holdHours {
-	case 32, 7 * 24, 30 * 24:
-		// ok
-	default:
-		return 0, apperrors.ErrInvalidInput.WithDetails("hold_hours harus 32, 168, atau 720")
+	terms = strings.TrimSpace(terms)
+	if utf8.RuneCountInString(terms) > finalOfferMaxTermsChars {
+		return 0, apperrors.ErrInvalidInput.WithDetails("terms maksimal 5000 karakter")
+	}
+	milestones, err = normalizeFinalOfferMilestones(milestones, amount, time.Now())
+	if err != nil {
+		return 0, err
 	}
 
 	submissionKey := finalOfferSubmissionKey(vc.ID, validatorUserID, cycle)
+	expiresAt := time.Now().Add(finalOfferExpiryTTL())
 
-	offer, err := s.client.FinalOffer.Create().
+	offerCreate := s.client.FinalOffer.Create()
+	if len(milestones) > 0 {
+		offerCreate.SetMilestonePlan(milestonePlanToJSON(milestones))
+	}
+	offer, err := offerCreate.
 		SetValidationCaseID(vc.ID).
 		SetValidatorUserID(int(validatorUserID)).
 		SetSubmissionKey(submissionKey).
 		SetWorkflowCycle(cycle).
 		SetAmount(amount).
 		SetHoldHours(holdHours).
-		SetTerms(strings.TrimSpace(terms)).
-		SetStatus("submitted").
+		SetTerms(terms).
+		SetStatus(finalOfferStatusSubmitted).
+		SetExpiresAt(expiresAt).
 		Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
@@ -1414,13 +1640,20 @@ func (s *EntValidationCaseWorkflowService) SubmitFinalOffer(ctx context.Context,
 		}
 		return 0, apperrors.ErrDatabase
 	}
+	// Revision 1 is backfilled on the first counter-offer if this write fails.
+	if err := createInitialFinalOfferRevision(ctx, s.client, offer); err != nil {
+		logger.Warn("Failed to record initial final offer revision", zap.Error(err), zap.Int("final_offer_id", offer.ID))
+	}
 
 	actorID := int(validatorUserID)
 	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "final_offer_submitted", map[string]interface{}{
-		"final_offer_id": offer.ID,
-		"amount":         amount,
-		"hold_hours":     holdHours,
-		"workflow_cycle": cycle,
+		"final_offer_id":  offer.ID,
+		"amount":          amount,
+		"hold_hours":      holdHours,
+		"workflow_cycle":  cycle,
+		"milestones":      len(milestones),
+		"revision":        1,
+		"expires_at_unix": expiresAt.Unix(),
 	})
 
 	return uint(offer.ID), nil
@@ -1474,13 +1707,21 @@ func (s *EntValidationCaseWorkflowService) ListFinalOffers(ctx context.Context,
 			Status:           it.Status,
 			AcceptedAt:       unixPtr(it.AcceptedAt),
 			RejectedAt:       unixPtr(it.RejectedAt),
+			CurrentRevision:  it.CurrentRevision,
+			LastProposedBy:   it.LastProposedBy,
+			ExpiresAt:        unixPtr(it.ExpiresAt),
+			WithdrawnAt:      unixPtr(it.WithdrawnAt),
+			ExpiredAt:        unixPtr(it.ExpiredAt),
+			Milestones:       milestonePlanFromJSON(it.MilestonePlan),
 			CreatedAt:        it.CreatedAt.Unix(),
 		})
 	}
 	return out, nil
 }
 
-func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context, validationCaseID uint, ownerUserID uint, offerID uint) (*EscrowDraft, error) {
+// AcceptFinalOffer accepts the validator's latest revision of an offer. expectedRevision must
+// match it once the offer has been negotiated; zero is accepted only for unrevised offers.
+func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context, validationCaseID uint, ownerUserID uint, offerID uint, expectedRevision int) (*EscrowDraft, error) {
 	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -1519,6 +1760,25 @@ func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context,
 		return nil, apperrors.ErrDatabase
 	}
 
+	if expectedRevision > 0 && expectedRevision != offer.CurrentRevision {
+		return nil, errFinalOfferStale()
+	}
+	if expectedRevision == 0 && offer.CurrentRevision > 1 {
+		return nil, apperrors.ErrInvalidInput.WithDetails("revision wajib diisi untuk Final Offer yang sudah dinegosiasikan")
+	}
+	if offer.AcceptedAt == nil {
+		switch normalizeStatus(offer.Status) {
+		case finalOfferStatusSubmitted:
+			if finalOfferExpired(offer, time.Now()) {
+				return nil, apperrors.ErrInvalidInput.WithDetails("Final Offer sudah kedaluwarsa")
+			}
+		case finalOfferStatusCountered:
+			return nil, apperrors.ErrInvalidInput.WithDetails("menunggu tanggapan validator atas counter-offer. Hanya revisi terbaru dari validator yang dapat diterima.")
+		default:
+			return nil, apperrors.ErrInvalidInput.WithDetails("Final Offer tidak lagi tersedia untuk diterima")
+		}
+	}
+
 	validator, err := s.client.User.Query().Where(user.IDEQ(offer.ValidatorUserID)).Only(ctx)
 	if err != nil {
 		return nil, apperrors.ErrDatabase
@@ -1568,10 +1828,13 @@ func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context,
 				finaloffer.IDEQ(offer.ID),
 				finaloffer.ValidationCaseIDEQ(vc.ID),
 				finaloffer.WorkflowCycleEQ(cycle),
-				finaloffer.StatusEQ("submitted"),
+				finaloffer.StatusEQ(finalOfferStatusSubmitted),
+				finaloffer.CurrentRevisionEQ(offer.CurrentRevision),
+				finaloffer.Or(finaloffer.ExpiresAtIsNil(), finaloffer.ExpiresAtGT(now)),
 				finaloffer.AcceptedAtIsNil(),
 			).
-			SetStatus("accepted_pending_funding").
+			SetStatus(finalOfferStatusAcceptedPendingFunding).
+			ClearExpiresAt().
 			SetAcceptedAt(now).
 			Save(ctx)
 		if err != nil {
@@ -1582,6 +1845,10 @@ func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context,
 			_ = tx.Rollback()
 			return nil, apperrors.ErrInvalidInput.WithDetails("Final Offer tidak lagi tersedia untuk diterima")
 		}
+		if err := createMilestonesForAcceptedOffer(ctx, tx.Client(), offer); err != nil {
+			_ = tx.Rollback()
+			return nil, apperrors.ErrDatabase
+		}
 		acceptApplied = true
 	} else {
 		currentCase, err := tx.ValidationCase.Query().
@@ -1613,7 +1880,7 @@ func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context,
 			}
 			return nil, apperrors.ErrDatabase
 		}
-		if normalizeStatus(currentOffer.Status) != "accepted_pending_funding" {
+		if normalizeStatus(currentOffer.Status) != finalOfferStatusAcceptedPendingFunding {
 			_ = tx.Rollback()
 			return nil, apperrors.ErrInvalidInput.WithDetails("Final Offer sedang diproses. Silakan muat ulang halaman.")
 		}
@@ -1633,16 +1900,34 @@ func (s *EntValidationCaseWorkflowService) AcceptFinalOffer(ctx context.Context,
 			"validator_user_id": offer.ValidatorUserID,
 			"amount":            offer.Amount,
 			"hold_hours":        offer.HoldHours,
+			"revision":          offer.CurrentRevision,
+			"milestones":        len(offer.MilestonePlan),
 			"accepted_at_unix":  now.Unix(),
 		})
 	}
 
-	return &EscrowDraft{
+	draft := &EscrowDraft{
 		ReceiverUsername: strings.TrimSpace(*validator.Username),
 		Amount:           offer.Amount,
 		HoldHours:        offer.HoldHours,
 		Message:          fmt.Sprintf("Lock Funds: Validation Case #%d", vc.ID),
-	}, nil
+	}
+	// Milestone offers are funded one milestone at a time, starting with the first.
+	first, err := s.client.FinalOfferMilestone.Query().
+		Where(finaloffermilestone.FinalOfferIDEQ(offer.ID)).
+		Order(ent.Asc(finaloffermilestone.FieldSequence)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, apperrors.ErrDatabase
+	}
+	if first != nil {
+		id := uint(first.ID)
+		draft.Amount = first.Amount
+		draft.MilestoneID = &id
+		draft.MilestoneSequence = first.Sequence
+		draft.Message = fmt.Sprintf("Lock Funds: Validation Case #%d milestone %d", vc.ID, first.Sequence)
+	}
+	return draft, nil
 }
 
 type featureServiceError struct {
@@ -1749,6 +2034,9 @@ func (s *EntValidationCaseWorkflowService) ConfirmLockFunds(ctx context.Context,
 	if err := ensureWorkflowV1Case(vc); err != nil {
 		return err
 	}
+	if err := s.ensureSingleStageCase(ctx, vc); err != nil {
+		return err
+	}
 	if vc.UserID != int(ownerUserID) {
 		return apperrors.ErrValidationCaseOwnership
 	}
@@ -1803,6 +2091,7 @@ func (s *EntValidationCaseWorkflowService) ConfirmLockFunds(ctx context.Context,
 		"escrow_transfer_id": transferID,
 		"final_offer_id":     offer.ID,
 	})
+	s.startValidatorDeliverySLA(ctx, vc, offer, nil, transferID, time.Now())
 
 	return nil
 }
@@ -1826,6 +2115,9 @@ func (s *EntValidationCaseWorkflowService) SubmitArtifact(ctx context.Context, v
 	if err := ensureWorkflowV1Case(vc); err != nil {
 		return err
 	}
+	if err := s.ensureSingleStageCase(ctx, vc); err != nil {
+		return err
+	}
 	if vc.AcceptedFinalOfferID == nil || *vc.AcceptedFinalOfferID <= 0 {
 		return apperrors.ErrInvalidInput.WithDetails("Final Offer belum diterima")
 	}
@@ -1847,7 +2139,7 @@ func (s *EntValidationCaseWorkflowService) SubmitArtifact(ctx context.Context, v
 
 	// Share document only when the submission references a real document in Feature Service.
 	if !isManualSubmission {
-		if err := s.shareDocumentWithCaseOwner(ctx, authHeader, documentID, uint(vc.UserID)); err != nil {
+		if err := s.shareDocumentWithUser(ctx, authHeader, documentID, uint(vc.UserID)); err != nil {
 			return apperrors.ErrInvalidInput.WithDetails(err.Error())
 		}
 	}
@@ -1872,14 +2164,16 @@ func (s *EntValidationCaseWorkflowService) SubmitArtifact(ctx context.Context, v
 		"document_id":       documentID,
 		"manual_submission": isManualSubmission,
 	})
+	s.markValidatorDeliverySLADelivered(ctx, vc.ID, strings.TrimSpace(*vc.EscrowTransferID), time.Now())
 
 	return nil
 }
 
-func (s *EntValidationCaseWorkflowService) shareDocumentWithCaseOwner(ctx context.Context, authHeader string, documentID string, ownerUserID uint) error {
+// shareDocumentWithUser grants userID access to a Feature Service document owned by the caller.
+func (s *EntValidationCaseWorkflowService) shareDocumentWithUser(ctx context.Context, authHeader string, documentID string, userID uint) error {
 	url := fmt.Sprintf("%s/api/v1/documents/%s/sharing", strings.TrimRight(config.FeatureServiceURL, "/"), documentID)
 	payload := map[string]interface{}{
-		"sharedWithUserIds": []uint{ownerUserID},
+		"sharedWithUserIds": []uint{userID},
 	}
 	b, _ := json.Marshal(payload)
 
@@ -1921,6 +2215,9 @@ func (s *EntValidationCaseWorkflowService) MarkEscrowReleased(ctx context.Contex
 	if err := ensureWorkflowV1Case(vc); err != nil {
 		return err
 	}
+	if err := s.ensureSingleStageCase(ctx, vc); err != nil {
+		return err
+	}
 	if vc.UserID != int(ownerUserID) {
 		return apperrors.ErrValidationCaseOwnership
 	}
@@ -1957,12 +2254,14 @@ func (s *EntValidationCaseWorkflowService) MarkEscrowReleased(ctx context.Contex
 	s.appendCaseLogBestEffort(ctx, vc.ID, &actorID, "certified_artifact_issued", map[string]interface{}{
 		"certified_artifact_document_id": strings.TrimSpace(*certifiedID),
 	})
+	s.recordCaseCompletedReputationBestEffort(ctx, vc.AcceptedFinalOfferID, "owner_confirmed_release")
 
 	return nil
 }
 
 // MarkEscrowReleasedInternalByTransferID is called by Feature Service (auto-release worker)
-// to finalize a Validation Case after escrow funds are released.
+// to finalize a Validation Case after escrow funds are released. Transfers that belong to a
+// milestone release only that milestone.
 //
 // This endpoint is protected by internal API key auth and therefore does NOT re-fetch transfer state
 // from Feature Service (which would require a user token). Instead, we validate local case invariants.
@@ -1977,7 +2276,7 @@ func (s *EntValidationCaseWorkflowService) MarkEscrowReleasedInternalByTransferI
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, apperrors.ErrValidationCaseNotFound
+			return s.markMilestoneReleasedInternalByTransferID(ctx, transferID)
 		}
 		return nil, apperrors.ErrDatabase
 	}
@@ -2013,11 +2312,13 @@ func (s *EntValidationCaseWorkflowService) MarkEscrowReleasedInternalByTransferI
 		"certified_artifact_document_id": certified,
 		"source":                         "feature_service_auto_release",
 	})
+	s.recordCaseCompletedReputationBestEffort(ctx, vc.AcceptedFinalOfferID, "feature_service_auto_release")
 
 	return &vc.ID, nil
 }
 
 // SettleDisputeInternalByTransferID is called by Feature Service after dispute settlement.
+// Transfers that belong to a milestone settle only that milestone.
 // Protected by InternalServiceAuth middleware (X-Internal-Api-Key).
 func (s *EntValidationCaseWorkflowService) SettleDisputeInternalByTransferID(
 	ctx context.Context,
@@ -2045,7 +2346,7 @@ func (s *EntValidationCaseWorkflowService) SettleDisputeInternalByTransferID(
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, apperrors.ErrValidationCaseNotFound
+			return s.settleMilestoneDisputeInternalByTransferID(ctx, transferID, disputeID, normalizedOutcome, source)
 		}
 		return nil, apperrors.ErrDatabase
 	}
@@ -2063,18 +2364,9 @@ func (s *EntValidationCaseWorkflowService) SettleDisputeInternalByTransferID(
 
 	switch normalizedOutcome {
 	case disputeSettlementOutcomeOwnerRefund:
-		nextCycle := cycle + 1
-		if _, err := s.client.ValidationCase.UpdateOneID(vc.ID).
-			SetStatus(caseStatusOpen).
-			SetClarificationState(clarificationStateNone).
-			SetWorkflowCycle(nextCycle).
-			ClearDisputeID().
-			ClearEscrowTransferID().
-			ClearAcceptedFinalOfferID().
-			ClearArtifactDocumentID().
-			ClearCertifiedArtifactDocumentID().
-			Save(ctx); err != nil {
-			return nil, apperrors.ErrDatabase
+		nextCycle, err := s.clearCaseForNextCycle(ctx, vc)
+		if err != nil {
+			return nil, err
 		}
 
 		s.appendCaseLogBestEffort(ctx, vc.ID, nil, "dispute_settled", map[string]interface{}{
@@ -2086,26 +2378,11 @@ func (s *EntValidationCaseWorkflowService) SettleDisputeInternalByTransferID(
 			"next_cycle":      nextCycle,
 			"previous_status": previousStatus,
 		})
-		s.appendCaseLogBestEffort(ctx, vc.ID, nil, "case_status_changed", map[string]interface{}{
-			"from":   previousStatus,
-			"to":     caseStatusOpen,
-			"reason": "dispute_refund_to_owner",
-		})
-		s.appendCaseLogBestEffort(ctx, vc.ID, nil, "workflow_cycle_incremented", map[string]interface{}{
-			"from_cycle": cycle,
-			"to_cycle":   nextCycle,
-			"reason":     "dispute_refund_to_owner",
-		})
-		s.appendCaseLogBestEffort(ctx, vc.ID, nil, "financial_linkage_cleared", map[string]interface{}{
-			"cleared_fields": []string{
-				"dispute_id",
-				"escrow_transfer_id",
-				"accepted_final_offer_id",
-				"artifact_document_id",
-				"certified_artifact_document_id",
-			},
-			"reason": "dispute_refund_to_owner",
-		})
+		s.logCaseReopened(ctx, vc.ID, previousStatus, cycle, nextCycle, "dispute_refund_to_owner")
+		s.recordDisputeReputationBestEffort(ctx, vc.AcceptedFinalOfferID, disputeID, normalizedOutcome, source)
+		if vc.AcceptedFinalOfferID != nil {
+			s.scheduleGuaranteeSlashBestEffort(ctx, *vc.AcceptedFinalOfferID, nil, disputeID)
+		}
 
 	case disputeSettlementOutcomeValidatorRelease:
 		if previousStatus == caseStatusCompleted && currentDisputeID == "" {
@@ -2131,11 +2408,55 @@ func (s *EntValidationCaseWorkflowService) SettleDisputeInternalByTransferID(
 			"to":     caseStatusCompleted,
 			"reason": "dispute_release_to_validator",
 		})
+		s.recordDisputeReputationBestEffort(ctx, vc.AcceptedFinalOfferID, disputeID, normalizedOutcome, source)
 	}
 
 	return &vc.ID, nil
 }
 
+// clearCaseForNextCycle reopens a case after its escrow was refunded to the owner: the
+// workflow cycle is incremented and all financial linkage is cleared.
+func (s *EntValidationCaseWorkflowService) clearCaseForNextCycle(ctx context.Context, vc *ent.ValidationCase) (int, error) {
+	nextCycle := currentWorkflowCycle(vc) + 1
+	if _, err := s.client.ValidationCase.UpdateOneID(vc.ID).
+		SetStatus(caseStatusOpen).
+		SetClarificationState(clarificationStateNone).
+		SetWorkflowCycle(nextCycle).
+		ClearDisputeID().
+		ClearEscrowTransferID().
+		ClearAcceptedFinalOfferID().
+		ClearArtifactDocumentID().
+		ClearCertifiedArtifactDocumentID().
+		Save(ctx); err != nil {
+		return 0, apperrors.ErrDatabase
+	}
+	s.cancelValidatorDeliverySLAs(ctx, vc.ID, "", "case_reopened")
+	return nextCycle, nil
+}
+
+func (s *EntValidationCaseWorkflowService) logCaseReopened(ctx context.Context, validationCaseID int, previousStatus string, cycle int, nextCycle int, reason string) {
+	s.appendCaseLogBestEffort(ctx, validationCaseID, nil, "case_status_changed", map[string]interface{}{
+		"from":   previousStatus,
+		"to":     caseStatusOpen,
+		"reason": reason,
+	})
+	s.appendCaseLogBestEffort(ctx, validationCaseID, nil, "workflow_cycle_incremented", map[string]interface{}{
+		"from_cycle": cycle,
+		"to_cycle":   nextCycle,
+		"reason":     reason,
+	})
+	s.appendCaseLogBestEffort(ctx, validationCaseID, nil, "financial_linkage_cleared", map[string]interface{}{
+		"cleared_fields": []string{
+			"dispute_id",
+			"escrow_transfer_id",
+			"accepted_final_offer_id",
+			"artifact_document_id",
+			"certified_artifact_document_id",
+		},
+		"reason": reason,
+	})
+}
+
 func (s *EntValidationCaseWorkflowService) AttachDispute(ctx context.Context, validationCaseID uint, ownerUserID uint, disputeID string, authHeader string) error {
 	disputeID = strings.TrimSpace(disputeID)
 	if disputeID == "" {
@@ -2152,6 +2473,9 @@ func (s *EntValidationCaseWorkflowService) AttachDispute(ctx context.Context, va
 	if err := ensureWorkflowV1Case(vc); err != nil {
 		return err
 	}
+	if err := s.ensureSingleStageCase(ctx, vc); err != nil {
+		return err
+	}
 	if vc.UserID != int(ownerUserID) {
 		return apperrors.ErrValidationCaseOwnership
 	}
@@ -2183,6 +2507,7 @@ func (s *EntValidationCaseWorkflowService) AttachDispute(ctx context.Context, va
 		"transfer_id":    strings.TrimSpace(*vc.EscrowTransferID),
 		"dispute_status": fd.Status,
 	})
+	s.cancelValidatorDeliverySLAs(ctx, vc.ID, strings.TrimSpace(*vc.EscrowTransferID), "dispute_attached")
 
 	return nil
 }
@@ -2221,6 +2546,10 @@ func (s *EntValidationCaseWorkflowService) GetCaseLog(ctx context.Context, valid
 		}
 	}
 
+	return s.loadCaseLogItems(ctx, vc)
+}
+
+func (s *EntValidationCaseWorkflowService) loadCaseLogItems(ctx context.Context, vc *ent.ValidationCase) ([]CaseLogItem, error) {
 	logs, err := s.client.ValidationCaseLog.Query().
 		Where(validationcaselog.ValidationCaseIDEQ(vc.ID)).
 		WithActorUser(func(q *ent.UserQuery) {
@@ -2245,7 +2574,7 @@ func (s *EntValidationCaseWorkflowService) GetCaseLog(ctx context.Context, valid
 		}
 		out = append(out, CaseLogItem{
 			ID:               uint(it.ID),
-			ValidationCaseID: validationCaseID,
+			ValidationCaseID: uint(vc.ID),
 			Actor:            actor,
 			EventType:        it.EventType,
 			Detail:           detail,
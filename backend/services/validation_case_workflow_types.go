@@ -35,6 +35,39 @@ type ConsultationGuaranteeLockItem struct {
 	DisputeID          string `json:"dispute_id,omitempty"`
 }
 
+type ConsultationInvitationItem struct {
+	ID                    uint        `json:"id"`
+	ValidationCaseID      uint        `json:"validation_case_id"`
+	CaseTitle             string      `json:"case_title,omitempty"`
+	OwnerUserID           uint        `json:"owner_user_id"`
+	Validator             UserSummary `json:"validator"`
+	WorkflowCycle         int         `json:"workflow_cycle"`
+	Status                string      `json:"status"`
+	Message               string      `json:"message,omitempty"`
+	ExpiresAt             int64       `json:"expires_at"`
+	RespondedAt           *int64      `json:"responded_at,omitempty"`
+	ConsultationRequestID *uint       `json:"consultation_request_id,omitempty"`
+	CreatedAt             int64       `json:"created_at"`
+}
+
+type ValidatorSuggestionItem struct {
+	Validator     UserSummary             `json:"validator"`
+	MatchingScore *MatchingScoreBreakdown `json:"matching_score,omitempty"`
+}
+
+type RecommendedValidatorItem struct {
+	Validator           UserSummary              `json:"validator"`
+	MatchingScore       *MatchingScoreBreakdown  `json:"matching_score"`
+	ScoreExplanation    []MatchingScoreComponent `json:"score_explanation"`
+	ActiveConsultations int                      `json:"active_consultations"`
+}
+
+type MatchingScoreComponent struct {
+	Component   string `json:"component"`
+	Score       int    `json:"score"`
+	Explanation string `json:"explanation"`
+}
+
 type MatchingScoreBreakdown struct {
 	Total             int `json:"total"`
 	DomainFit         int `json:"domain_fit"`
@@ -42,19 +75,190 @@ type MatchingScoreBreakdown struct {
 	HistoryDispute    int `json:"history_dispute"`
 	ResponsivenessSLA int `json:"responsiveness_sla"`
 	StakeGuarantee    int `json:"stake_guarantee"`
+	Reputation        int `json:"reputation"`
+}
+
+// GuaranteeSlashItem is a guarantee penalty for a dispute lost by the validator.
+type GuaranteeSlashItem struct {
+	ID                       uint    `json:"id"`
+	ValidationCaseID         uint    `json:"validation_case_id"`
+	ValidatorUserID          uint    `json:"validator_user_id"`
+	FinalOfferID             uint    `json:"final_offer_id"`
+	MilestoneID              *uint   `json:"milestone_id,omitempty"`
+	WorkflowCycle            int     `json:"workflow_cycle"`
+	DisputeID                string  `json:"dispute_id"`
+	GuaranteeAmount          int64   `json:"guarantee_amount"`
+	Percent                  int     `json:"percent"`
+	CapAmount                int64   `json:"cap_amount"`
+	Amount                   int64   `json:"amount"`
+	Status                   string  `json:"status"`
+	SkipReason               string  `json:"skip_reason,omitempty"`
+	AppealDeadlineAt         int64   `json:"appeal_deadline_at"`
+	AppealReason             string  `json:"appeal_reason,omitempty"`
+	AppealedAt               *int64  `json:"appealed_at,omitempty"`
+	ResolutionNote           string  `json:"resolution_note,omitempty"`
+	ResolvedAt               *int64  `json:"resolved_at,omitempty"`
+	ExecutedAt               *int64  `json:"executed_at,omitempty"`
+	RemainingGuaranteeAmount *int64  `json:"remaining_guarantee_amount,omitempty"`
+	AttemptCount             int     `json:"attempt_count"`
+	NextAttemptAt            *int64  `json:"next_attempt_at,omitempty"`
+	LastError                *string `json:"last_error,omitempty"`
+	CreatedAt                int64   `json:"created_at"`
+}
+
+// ValidatorReputationSummary is the decayed reputation shown on a validator's public profile.
+type ValidatorReputationSummary struct {
+	Score      int                    `json:"score"`
+	EventCount int                    `json:"event_count"`
+	Domains    []DomainReputationItem `json:"domains"`
+}
+
+type DomainReputationItem struct {
+	Domain string `json:"domain"`
+	Score  int    `json:"score"`
 }
 
 type FinalOfferItem struct {
-	ID               uint        `json:"id"`
-	ValidationCaseID uint        `json:"validation_case_id"`
-	Validator        UserSummary `json:"validator"`
-	Amount           int64       `json:"amount"`
-	HoldHours        int         `json:"hold_hours"`
-	Terms            string      `json:"terms"`
-	Status           string      `json:"status"`
-	AcceptedAt       *int64      `json:"accepted_at,omitempty"`
-	RejectedAt       *int64      `json:"rejected_at,omitempty"`
-	CreatedAt        int64       `json:"created_at"`
+	ID               uint                      `json:"id"`
+	ValidationCaseID uint                      `json:"validation_case_id"`
+	Validator        UserSummary               `json:"validator"`
+	Amount           int64                     `json:"amount"`
+	HoldHours        int                       `json:"hold_hours"`
+	Terms            string                    `json:"terms"`
+	Status           string                    `json:"status"`
+	AcceptedAt       *int64                    `json:"accepted_at,omitempty"`
+	RejectedAt       *int64                    `json:"rejected_at,omitempty"`
+	CurrentRevision  int                       `json:"current_revision"`
+	LastProposedBy   string                    `json:"last_proposed_by"`
+	ExpiresAt        *int64                    `json:"expires_at,omitempty"`
+	WithdrawnAt      *int64                    `json:"withdrawn_at,omitempty"`
+	ExpiredAt        *int64                    `json:"expired_at,omitempty"`
+	Milestones       []FinalOfferMilestoneSpec `json:"milestones,omitempty"`
+	CreatedAt        int64                     `json:"created_at"`
+}
+
+// FinalOfferMilestoneSpec is one stage of a milestone plan proposed in a Final Offer.
+type FinalOfferMilestoneSpec struct {
+	Deliverable string `json:"deliverable"`
+	Amount      int64  `json:"amount"`
+	DueAt       int64  `json:"due_at"`
+}
+
+type FinalOfferRevisionItem struct {
+	ID           uint                      `json:"id,omitempty"`
+	Revision     int                       `json:"revision"`
+	ProposerRole string                    `json:"proposer_role"`
+	ProposedBy   uint                      `json:"proposed_by_user_id"`
+	Action       string                    `json:"action"`
+	Amount       int64                     `json:"amount"`
+	HoldHours    int                       `json:"hold_hours"`
+	Terms        string                    `json:"terms"`
+	Note         string                    `json:"note,omitempty"`
+	Milestones   []FinalOfferMilestoneSpec `json:"milestones,omitempty"`
+	CreatedAt    int64                     `json:"created_at"`
+}
+
+type MilestoneItem struct {
+	ID                  uint   `json:"id"`
+	FinalOfferID        uint   `json:"final_offer_id"`
+	Sequence            int    `json:"sequence"`
+	Deliverable         string `json:"deliverable"`
+	Amount              int64  `json:"amount"`
+	DueAt               int64  `json:"due_at"`
+	Status              string `json:"status"`
+	EscrowTransferID    string `json:"escrow_transfer_id,omitempty"`
+	ArtifactDocumentID  string `json:"artifact_document_id,omitempty"`
+	DisputeID           string `json:"dispute_id,omitempty"`
+	FundsLockedAt       *int64 `json:"funds_locked_at,omitempty"`
+	ArtifactSubmittedAt *int64 `json:"artifact_submitted_at,omitempty"`
+	ReleasedAt          *int64 `json:"released_at,omitempty"`
+	DisputedAt          *int64 `json:"disputed_at,omitempty"`
+	RefundedAt          *int64 `json:"refunded_at,omitempty"`
+	CreatedAt           int64  `json:"created_at"`
+}
+
+type ValidatorDeliverySLAItem struct {
+	ID               uint                   `json:"id"`
+	FinalOfferID     uint                   `json:"final_offer_id"`
+	MilestoneID      *uint                  `json:"milestone_id,omitempty"`
+	ValidatorUserID  uint                   `json:"validator_user_id"`
+	EscrowTransferID string                 `json:"escrow_transfer_id"`
+	HoldHours        int                    `json:"hold_hours"`
+	LockedAt         int64                  `json:"locked_at"`
+	DueAt            int64                  `json:"due_at"`
+	GraceUntil       int64                  `json:"grace_until"`
+	ReminderCount    int                    `json:"reminder_count"`
+	Status           string                 `json:"status"`
+	DeliveredAt      *int64                 `json:"delivered_at,omitempty"`
+	MissedAt         *int64                 `json:"missed_at,omitempty"`
+	EscalatedAt      *int64                 `json:"escalated_at,omitempty"`
+	CancelledAt      *int64                 `json:"cancelled_at,omitempty"`
+	Recommendation   string                 `json:"recommendation,omitempty"`
+	DisputeDraft     map[string]interface{} `json:"dispute_draft,omitempty"`
+}
+
+type CaseClarificationItem struct {
+	ID                    uint   `json:"id"`
+	ConsultationRequestID uint   `json:"consultation_request_id"`
+	ValidatorUserID       uint   `json:"validator_user_id"`
+	Kind                  string `json:"kind"`
+	Body                  string `json:"body"`
+	Status                string `json:"status"`
+	Answer                string `json:"answer,omitempty"`
+	Shared                bool   `json:"shared"`
+	AnsweredAt            *int64 `json:"answered_at,omitempty"`
+	ExpiredAt             *int64 `json:"expired_at,omitempty"`
+	CreatedAt             int64  `json:"created_at"`
+}
+
+type CaseConversationItem struct {
+	ID              uint   `json:"id"`
+	OwnerUserID     uint   `json:"owner_user_id"`
+	ValidatorUserID uint   `json:"validator_user_id"`
+	LastMessageAt   *int64 `json:"last_message_at,omitempty"`
+	UnreadCount     int    `json:"unread_count"`
+	CreatedAt       int64  `json:"created_at"`
+}
+
+type CaseMessageItem struct {
+	ID                    uint     `json:"id"`
+	ConversationID        uint     `json:"conversation_id"`
+	SenderUserID          uint     `json:"sender_user_id"`
+	Body                  string   `json:"body"`
+	AttachmentDocumentIDs []string `json:"attachment_document_ids"`
+	ReadAt                *int64   `json:"read_at,omitempty"`
+	CreatedAt             int64    `json:"created_at"`
+}
+
+// DisputeEvidenceBundle collects the on-platform record of a disputed case for admin review.
+type DisputeEvidenceBundle struct {
+	ValidationCaseID uint                    `json:"validation_case_id"`
+	Title            string                  `json:"title"`
+	Status           string                  `json:"status"`
+	SensitivityLevel string                  `json:"sensitivity_level"`
+	OwnerUserID      uint                    `json:"owner_user_id"`
+	WorkflowCycle    int                     `json:"workflow_cycle"`
+	DisputeIDs       []string                `json:"dispute_ids"`
+	AcceptedOffers   []DisputeEvidenceOffer  `json:"accepted_offers"`
+	Transcripts      []DisputeEvidenceThread `json:"transcripts"`
+	Clarifications   []CaseClarificationItem `json:"clarifications"`
+	CaseLog          []CaseLogItem           `json:"case_log"`
+	GeneratedAt      int64                   `json:"generated_at"`
+}
+
+type DisputeEvidenceOffer struct {
+	FinalOfferID    uint   `json:"final_offer_id"`
+	ValidatorUserID uint   `json:"validator_user_id"`
+	WorkflowCycle   int    `json:"workflow_cycle"`
+	Amount          int64  `json:"amount"`
+	HoldHours       int    `json:"hold_hours"`
+	Terms           string `json:"terms"`
+	AcceptedAt      int64  `json:"accepted_at"`
+}
+
+type DisputeEvidenceThread struct {
+	ValidatorUserID uint              `json:"validator_user_id"`
+	Messages        []CaseMessageItem `json:"messages"`
 }
 
 type CaseLogItem struct {
@@ -73,4 +277,7 @@ type EscrowDraft struct {
 	Amount           int64  `json:"amount"`
 	HoldHours        int    `json:"hold_hours"`
 	Message          string `json:"message,omitempty"`
+	// Set for milestone offers: the draft covers this milestone only.
+	MilestoneID       *uint `json:"milestone_id,omitempty"`
+	MilestoneSequence int   `json:"milestone_sequence,omitempty"`
 }
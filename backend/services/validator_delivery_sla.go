@@ -0,0 +1,454 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/ent"
+	"backend-gin/ent/finaloffermilestone"
+	"backend-gin/ent/validatordeliverysla"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+// Validator delivery SLA. Lock Funds starts a delivery deadline for the accepted validator
+// (the offer's hold_hours, or the milestone due date). The SLA worker sends reminders, marks the
+// deadline missed and, after a grace period, escalates with a refund or dispute recommendation.
+const (
+	deliverySLAStatusActive    = "active"
+	deliverySLAStatusDelivered = "delivered"
+	deliverySLAStatusMissed    = "missed"
+	deliverySLAStatusEscalated = "escalated"
+	deliverySLAStatusCancelled = "cancelled"
+
+	deliveryRecommendationOwnerRefund = "owner_refund"
+	deliveryRecommendationDispute     = "dispute"
+)
+
+// deliveryReminderPercents are the points of the delivery window (in percent) at which the
+// validator is reminded.
+var deliveryReminderPercents = []int{50, 90}
+
+func validatorDeliveryGracePeriod() time.Duration {
+	const fallback = 24
+	hours := fallback
+	if raw := strings.TrimSpace(os.Getenv("VALIDATOR_DELIVERY_GRACE_HOURS")); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil && v >= 0 {
+			hours = v
+		}
+	}
+	if hours > 7*24 {
+		hours = 7 * 24
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// validatorDeliveryDueAt returns the delivery deadline for a lock made at lockedAt. A milestone
+// keeps its agreed due date unless that has already passed, in which case the validator gets the
+// full hold window from the lock.
+func validatorDeliveryDueAt(lockedAt time.Time, holdHours int, milestoneDueAt *time.Time) time.Time {
+	if milestoneDueAt != nil && milestoneDueAt.After(lockedAt) {
+		return *milestoneDueAt
+	}
+	return lockedAt.Add(time.Duration(holdHours) * time.Hour)
+}
+
+// validatorDeliveryReminderCount returns how many reminders should have been sent by now.
+func validatorDeliveryReminderCount(lockedAt time.Time, dueAt time.Time, now time.Time) int {
+	window := dueAt.Sub(lockedAt)
+	if window <= 0 {
+		return len(deliveryReminderPercents)
+	}
+	elapsed := now.Sub(lockedAt)
+	count := 0
+	for _, pct := range deliveryReminderPercents {
+		if elapsed*100 >= window*time.Duration(pct) {
+			count++
+		}
+	}
+	return count
+}
+
+// validatorDeliveryRecommendation picks the escalation outcome: a full refund when the validator
+// delivered nothing on the offer, a dispute when earlier milestones were delivered and the
+// split must be adjudicated.
+func validatorDeliveryRecommendation(deliveredMilestones int) string {
+	if deliveredMilestones > 0 {
+		return deliveryRecommendationDispute
+	}
+	return deliveryRecommendationOwnerRefund
+}
+
+// validatorDeliveryOutcomeScore scores one closed delivery SLA for the responsiveness history.
+// ok is false for SLAs that say nothing about the validator (still running, or cancelled).
+func validatorDeliveryOutcomeScore(sla *ent.ValidatorDeliverySLA) (score int, ok bool) {
+	if sla.DeliveredAt != nil {
+		if !sla.DeliveredAt.After(sla.DueAt) {
+			return 100, true
+		}
+		if !sla.DeliveredAt.After(sla.GraceUntil) {
+			return 40, true
+		}
+		return 10, true
+	}
+	switch sla.Status {
+	case deliverySLAStatusMissed, deliverySLAStatusEscalated:
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func buildValidatorDeliveryDisputeDraft(sla *ent.ValidatorDeliverySLA, amount int64, milestoneSequence int, recommendation string) map[string]interface{} {
+	reason := fmt.Sprintf("Validator tidak mengirim Artifact Submission sampai batas waktu %s (grace sampai %s).",
+		sla.DueAt.UTC().Format(time.RFC3339), sla.GraceUntil.UTC().Format(time.RFC3339))
+	if milestoneSequence > 0 {
+		reason = fmt.Sprintf("Milestone %d: %s", milestoneSequence, reason)
+	}
+	draft := map[string]interface{}{
+		"transfer_id":       sla.EscrowTransferID,
+		"amount":            amount,
+		"reason":            reason,
+		"requested_outcome": disputeSettlementOutcomeOwnerRefund,
+		"recommendation":    recommendation,
+		"hold_hours":        sla.HoldHours,
+		"locked_at":         sla.LockedAt.Unix(),
+		"due_at":            sla.DueAt.Unix(),
+		"grace_until":       sla.GraceUntil.Unix(),
+	}
+	if milestoneSequence > 0 {
+		draft["milestone_sequence"] = milestoneSequence
+	}
+	return draft
+}
+
+func buildValidatorDeliverySLAItem(sla *ent.ValidatorDeliverySLA) ValidatorDeliverySLAItem {
+	item := ValidatorDeliverySLAItem{
+		ID:               uint(sla.ID),
+		FinalOfferID:     uint(sla.FinalOfferID),
+		ValidatorUserID:  uint(sla.ValidatorUserID),
+		EscrowTransferID: sla.EscrowTransferID,
+		HoldHours:        sla.HoldHours,
+		LockedAt:         sla.LockedAt.Unix(),
+		DueAt:            sla.DueAt.Unix(),
+		GraceUntil:       sla.GraceUntil.Unix(),
+		ReminderCount:    sla.ReminderCount,
+		Status:           sla.Status,
+		DeliveredAt:      unixPtr(sla.DeliveredAt),
+		MissedAt:         unixPtr(sla.MissedAt),
+		EscalatedAt:      unixPtr(sla.EscalatedAt),
+		CancelledAt:      unixPtr(sla.CancelledAt),
+		Recommendation:   strings.TrimSpace(valueOrEmpty(sla.Recommendation)),
+		DisputeDraft:     sla.DisputeDraft,
+	}
+	if sla.MilestoneID != nil {
+		id := uint(*sla.MilestoneID)
+		item.MilestoneID = &id
+	}
+	return item
+}
+
+// startValidatorDeliverySLA opens the delivery deadline for a confirmed lock. Best effort: the
+// lock itself is already committed and must not fail on SLA bookkeeping.
+func (s *EntValidationCaseWorkflowService) startValidatorDeliverySLA(ctx context.Context, vc *ent.ValidationCase, offer *ent.FinalOffer, m *ent.FinalOfferMilestone, transferID string, lockedAt time.Time) {
+	var milestoneDueAt *time.Time
+	if m != nil {
+		milestoneDueAt = &m.DueAt
+	}
+	dueAt := validatorDeliveryDueAt(lockedAt, offer.HoldHours, milestoneDueAt)
+	graceUntil := dueAt.Add(validatorDeliveryGracePeriod())
+
+	create := s.client.ValidatorDeliverySLA.Create().
+		SetValidationCaseID(vc.ID).
+		SetFinalOfferID(offer.ID).
+		SetValidatorUserID(offer.ValidatorUserID).
+		SetWorkflowCycle(currentWorkflowCycle(vc)).
+		SetEscrowTransferID(transferID).
+		SetHoldHours(offer.HoldHours).
+		SetLockedAt(lockedAt).
+		SetDueAt(dueAt).
+		SetGraceUntil(graceUntil)
+	detail := map[string]interface{}{
+		"validator_user_id":  offer.ValidatorUserID,
+		"escrow_transfer_id": transferID,
+		"hold_hours":         offer.HoldHours,
+		"due_at":             dueAt.Unix(),
+		"grace_until":        graceUntil.Unix(),
+	}
+	if m != nil {
+		create.SetMilestoneID(m.ID)
+		detail["milestone_id"] = m.ID
+		detail["sequence"] = m.Sequence
+	}
+	if _, err := create.Save(ctx); err != nil {
+		logger.Warn("Failed to start validator delivery SLA",
+			zap.Error(err),
+			zap.Int("validation_case_id", vc.ID),
+			zap.String("escrow_transfer_id", transferID),
+		)
+		return
+	}
+	s.appendCaseLogBestEffort(ctx, vc.ID, nil, "validator_delivery_sla_started", detail)
+}
+
+// markValidatorDeliverySLADelivered closes the delivery SLA of a transfer when the artifact is
+// submitted. Escalated SLAs keep their status; lateness stays visible through delivered_at > due_at.
+func (s *EntValidationCaseWorkflowService) markValidatorDeliverySLADelivered(ctx context.Context, validationCaseID int, transferID string, deliveredAt time.Time) {
+	sla, err := s.client.ValidatorDeliverySLA.Query().
+		Where(
+			validatordeliverysla.EscrowTransferIDEQ(transferID),
+			validatordeliverysla.DeliveredAtIsNil(),
+			validatordeliverysla.StatusIn(deliverySLAStatusActive, deliverySLAStatusMissed, deliverySLAStatusEscalated),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Warn("Failed to load validator delivery SLA", zap.Error(err), zap.String("escrow_transfer_id", transferID))
+		}
+		return
+	}
+
+	update := s.client.ValidatorDeliverySLA.UpdateOneID(sla.ID).SetDeliveredAt(deliveredAt)
+	if sla.Status != deliverySLAStatusEscalated {
+		update.SetStatus(deliverySLAStatusDelivered)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		logger.Warn("Failed to close validator delivery SLA", zap.Error(err), zap.Int("delivery_sla_id", sla.ID))
+		return
+	}
+	if deliveredAt.After(sla.DueAt) {
+		s.appendCaseLogBestEffort(ctx, validationCaseID, nil, "validator_delivery_late", map[string]interface{}{
+			"delivery_sla_id":    sla.ID,
+			"escrow_transfer_id": transferID,
+			"due_at":             sla.DueAt.Unix(),
+			"late_seconds":       int64(deliveredAt.Sub(sla.DueAt).Seconds()),
+			"previous_status":    sla.Status,
+		})
+	}
+}
+
+// cancelValidatorDeliverySLAs stops open delivery SLAs of a case (or of one transfer when
+// transferID is set) once the escrow is handed to a dispute or refunded.
+func (s *EntValidationCaseWorkflowService) cancelValidatorDeliverySLAs(ctx context.Context, validationCaseID int, transferID string, reason string) {
+	update := s.client.ValidatorDeliverySLA.Update().
+		Where(
+			validatordeliverysla.ValidationCaseIDEQ(validationCaseID),
+			validatordeliverysla.StatusIn(deliverySLAStatusActive, deliverySLAStatusMissed),
+		)
+	if transferID != "" {
+		update.Where(validatordeliverysla.EscrowTransferIDEQ(transferID))
+	}
+	n, err := update.
+		SetStatus(deliverySLAStatusCancelled).
+		SetCancelledAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		logger.Warn("Failed to cancel validator delivery SLA", zap.Error(err), zap.Int("validation_case_id", validationCaseID))
+		return
+	}
+	if n > 0 {
+		s.appendCaseLogBestEffort(ctx, validationCaseID, nil, "validator_delivery_sla_cancelled", map[string]interface{}{
+			"escrow_transfer_id": transferID,
+			"count":              n,
+			"reason":             reason,
+		})
+	}
+}
+
+// ProcessValidatorDeliverySLA sends delivery reminders, marks missed deadlines and escalates
+// SLAs whose grace period ended. Returns (reminders, misses, escalations).
+func (s *EntValidationCaseWorkflowService) ProcessValidatorDeliverySLA(ctx context.Context) (int, int, int, error) {
+	now := time.Now()
+	reminderEvents := 0
+	missEvents := 0
+	escalationEvents := 0
+
+	items, err := s.client.ValidatorDeliverySLA.Query().
+		Where(validatordeliverysla.StatusIn(deliverySLAStatusActive, deliverySLAStatusMissed)).
+		Order(ent.Asc(validatordeliverysla.FieldDueAt)).
+		All(ctx)
+	if err != nil {
+		return 0, 0, 0, apperrors.ErrDatabase
+	}
+
+	for _, sla := range items {
+		if sla.Status == deliverySLAStatusActive {
+			reminders := validatorDeliveryReminderCount(sla.LockedAt, sla.DueAt, now)
+			if now.Before(sla.DueAt) && reminders > sla.ReminderCount {
+				for i := sla.ReminderCount; i < reminders; i++ {
+					s.appendCaseLogBestEffort(ctx, sla.ValidationCaseID, nil, "validator_delivery_sla_reminder", map[string]interface{}{
+						"delivery_sla_id":   sla.ID,
+						"validator_user_id": sla.ValidatorUserID,
+						"reminder_percent":  deliveryReminderPercents[i],
+						"due_at":            sla.DueAt.Unix(),
+					})
+					reminderEvents++
+				}
+				if _, err := s.client.ValidatorDeliverySLA.UpdateOneID(sla.ID).
+					SetReminderCount(reminders).
+					Save(ctx); err != nil {
+					return reminderEvents, missEvents, escalationEvents, apperrors.ErrDatabase
+				}
+			}
+			if now.Before(sla.DueAt) {
+				continue
+			}
+
+			updated, err := s.client.ValidatorDeliverySLA.Update().
+				Where(
+					validatordeliverysla.IDEQ(sla.ID),
+					validatordeliverysla.StatusEQ(deliverySLAStatusActive),
+				).
+				SetStatus(deliverySLAStatusMissed).
+				SetMissedAt(now).
+				Save(ctx)
+			if err != nil {
+				return reminderEvents, missEvents, escalationEvents, apperrors.ErrDatabase
+			}
+			if updated != 1 {
+				continue
+			}
+			s.appendCaseLogBestEffort(ctx, sla.ValidationCaseID, nil, "validator_delivery_sla_missed", map[string]interface{}{
+				"delivery_sla_id":   sla.ID,
+				"validator_user_id": sla.ValidatorUserID,
+				"due_at":            sla.DueAt.Unix(),
+				"grace_until":       sla.GraceUntil.Unix(),
+			})
+			s.recordOfferReputationEventBestEffort(ctx, &sla.FinalOfferID, reputationEventDeliverySLAMissed,
+				fmt.Sprintf("delivery_sla_missed:%d", sla.ID),
+				map[string]interface{}{"delivery_sla_id": sla.ID},
+			)
+			missEvents++
+			sla.Status = deliverySLAStatusMissed
+		}
+
+		if now.Before(sla.GraceUntil) {
+			continue
+		}
+		escalated, err := s.escalateValidatorDeliverySLA(ctx, sla, now)
+		if err != nil {
+			return reminderEvents, missEvents, escalationEvents, err
+		}
+		if escalated {
+			escalationEvents++
+		}
+	}
+
+	return reminderEvents, missEvents, escalationEvents, nil
+}
+
+// escalateValidatorDeliverySLA records the recommendation and dispute draft for a missed SLA
+// whose grace period ended. The owner decides whether to file the dispute.
+func (s *EntValidationCaseWorkflowService) escalateValidatorDeliverySLA(ctx context.Context, sla *ent.ValidatorDeliverySLA, now time.Time) (bool, error) {
+	amount := int64(0)
+	sequence := 0
+	delivered := 0
+	if sla.MilestoneID != nil {
+		m, err := s.client.FinalOfferMilestone.Get(ctx, *sla.MilestoneID)
+		if err != nil && !ent.IsNotFound(err) {
+			return false, apperrors.ErrDatabase
+		}
+		if m != nil {
+			amount = m.Amount
+			sequence = m.Sequence
+		}
+		delivered, err = s.client.FinalOfferMilestone.Query().
+			Where(
+				finaloffermilestone.FinalOfferIDEQ(sla.FinalOfferID),
+				finaloffermilestone.ArtifactSubmittedAtNotNil(),
+			).
+			Count(ctx)
+		if err != nil {
+			return false, apperrors.ErrDatabase
+		}
+	} else {
+		offer, err := s.client.FinalOffer.Get(ctx, sla.FinalOfferID)
+		if err != nil && !ent.IsNotFound(err) {
+			return false, apperrors.ErrDatabase
+		}
+		if offer != nil {
+			amount = offer.Amount
+		}
+	}
+
+	recommendation := validatorDeliveryRecommendation(delivered)
+	draft := buildValidatorDeliveryDisputeDraft(sla, amount, sequence, recommendation)
+	updated, err := s.client.ValidatorDeliverySLA.Update().
+		Where(
+			validatordeliverysla.IDEQ(sla.ID),
+			validatordeliverysla.StatusEQ(deliverySLAStatusMissed),
+		).
+		SetStatus(deliverySLAStatusEscalated).
+		SetEscalatedAt(now).
+		SetRecommendation(recommendation).
+		SetDisputeDraft(draft).
+		Save(ctx)
+	if err != nil {
+		return false, apperrors.ErrDatabase
+	}
+	if updated != 1 {
+		return false, nil
+	}
+
+	detail := map[string]interface{}{
+		"delivery_sla_id":    sla.ID,
+		"validator_user_id":  sla.ValidatorUserID,
+		"escrow_transfer_id": sla.EscrowTransferID,
+		"recommendation":     recommendation,
+		"dispute_draft":      draft,
+	}
+	if sla.MilestoneID != nil {
+		detail["milestone_id"] = *sla.MilestoneID
+	}
+	s.appendCaseLogBestEffort(ctx, sla.ValidationCaseID, nil, "validator_delivery_sla_escalated", detail)
+	return true, nil
+}
+
+// ListValidatorDeliverySLAs returns the delivery SLAs of the case's current workflow cycle to
+// the owner or the accepted validator.
+func (s *EntValidationCaseWorkflowService) ListValidatorDeliverySLAs(ctx context.Context, validationCaseID uint, viewerUserID uint) ([]ValidatorDeliverySLAItem, error) {
+	vc, err := s.client.ValidationCase.Get(ctx, int(validationCaseID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if vc.UserID != int(viewerUserID) {
+		if vc.AcceptedFinalOfferID == nil || *vc.AcceptedFinalOfferID <= 0 {
+			return nil, apperrors.ErrValidationCaseOwnership
+		}
+		offer, err := s.client.FinalOffer.Get(ctx, *vc.AcceptedFinalOfferID)
+		if err != nil {
+			return nil, apperrors.ErrDatabase
+		}
+		if offer.ValidatorUserID != int(viewerUserID) {
+			return nil, apperrors.ErrValidationCaseOwnership
+		}
+	}
+
+	items, err := s.client.ValidatorDeliverySLA.Query().
+		Where(
+			validatordeliverysla.ValidationCaseIDEQ(vc.ID),
+			validatordeliverysla.WorkflowCycleEQ(currentWorkflowCycle(vc)),
+		).
+		Order(ent.Asc(validatordeliverysla.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	out := make([]ValidatorDeliverySLAItem, 0, len(items))
+	for _, sla := range items {
+		out = append(out, buildValidatorDeliverySLAItem(sla))
+	}
+	return out, nil
+}
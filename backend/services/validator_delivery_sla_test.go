@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"backend-gin/ent"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatorDeliveryDueAt(t *testing.T) {
+	lockedAt := time.Unix(1_800_000_000, 0)
+
+	assert.Equal(t, lockedAt.Add(168*time.Hour), validatorDeliveryDueAt(lockedAt, 168, nil))
+
+	milestoneDue := lockedAt.Add(48 * time.Hour)
+	assert.Equal(t, milestoneDue, validatorDeliveryDueAt(lockedAt, 168, &milestoneDue))
+
+	overdue := lockedAt.Add(-time.Hour)
+	assert.Equal(t, lockedAt.Add(32*time.Hour), validatorDeliveryDueAt(lockedAt, 32, &overdue), "past milestone due date falls back to hold window")
+}
+
+func TestValidatorDeliveryReminderCount(t *testing.T) {
+	lockedAt := time.Unix(1_800_000_000, 0)
+	dueAt := lockedAt.Add(100 * time.Hour)
+
+	assert.Equal(t, 0, validatorDeliveryReminderCount(lockedAt, dueAt, lockedAt.Add(49*time.Hour)))
+	assert.Equal(t, 1, validatorDeliveryReminderCount(lockedAt, dueAt, lockedAt.Add(50*time.Hour)))
+	assert.Equal(t, 2, validatorDeliveryReminderCount(lockedAt, dueAt, lockedAt.Add(90*time.Hour)))
+	assert.Equal(t, 2, validatorDeliveryReminderCount(lockedAt, lockedAt, lockedAt))
+}
+
+func TestValidatorDeliveryRecommendation(t *testing.T) {
+	assert.Equal(t, deliveryRecommendationOwnerRefund, validatorDeliveryRecommendation(0))
+	assert.Equal(t, deliveryRecommendationDispute, validatorDeliveryRecommendation(1))
+}
+
+func TestResponsivenessSLAScoreIncludesDeliverySLA(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	due := now.Add(-48 * time.Hour)
+	grace := due.Add(24 * time.Hour)
+	onTime := due.Add(-time.Hour)
+	late := due.Add(time.Hour)
+
+	slas := []*ent.ValidatorDeliverySLA{
+		{Status: deliverySLAStatusDelivered, DueAt: due, GraceUntil: grace, DeliveredAt: &onTime},
+		{Status: deliverySLAStatusDelivered, DueAt: due, GraceUntil: grace, DeliveredAt: &late},
+		{Status: deliverySLAStatusEscalated, DueAt: due, GraceUntil: grace},
+		{Status: deliverySLAStatusActive, DueAt: now.Add(time.Hour), GraceUntil: now.Add(25 * time.Hour)},
+		{Status: deliverySLAStatusCancelled, DueAt: due, GraceUntil: grace},
+	}
+
+	// (100 + 40 + 0) / 3; running and cancelled SLAs are ignored.
+	assert.Equal(t, 47, responsivenessSLAScore(nil, nil, slas, now))
+	assert.Equal(t, 50, responsivenessSLAScore(nil, nil, slas[3:], now))
+	assert.Equal(t, 50, responsivenessSLAScore(nil, nil, nil, now))
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const validatorMatchingAggregateLockKey = "validator_matching_aggregate_worker_lock"
+const validatorMatchingAggregateLockTTL = 5 * time.Minute
+
+// ValidatorMatchingAggregateWorker periodically recomputes the case-independent matching inputs
+// (history tags, dispute record, responsiveness) older than 30 minutes, so recommendations
+// read stored aggregates instead of rebuilding them per request.
+// Uses Redis distributed lock to prevent duplicate processing across multiple instances.
+type ValidatorMatchingAggregateWorker struct {
+	workflow   *EntValidationCaseWorkflowService
+	ticker     *time.Ticker
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+	started    bool
+	instanceID string
+}
+
+func NewValidatorMatchingAggregateWorker(workflow *EntValidationCaseWorkflowService) *ValidatorMatchingAggregateWorker {
+	return &ValidatorMatchingAggregateWorker{
+		workflow:   workflow,
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+		instanceID: uuid.New().String(),
+	}
+}
+
+func validatorMatchingAggregateInterval() time.Duration {
+	const fallback = 10 * time.Minute
+	raw := strings.TrimSpace(os.Getenv("VALIDATOR_AGGREGATE_TICK_SECONDS"))
+	if raw == "" {
+		return fallback
+	}
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds <= 0 {
+		return fallback
+	}
+	if seconds < 60 {
+		seconds = 60
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (w *ValidatorMatchingAggregateWorker) Start() {
+	if w == nil || w.started || w.workflow == nil {
+		return
+	}
+	w.started = true
+	w.ticker = time.NewTicker(validatorMatchingAggregateInterval())
+
+	go func() {
+		defer close(w.doneCh)
+		w.runIteration()
+		for {
+			select {
+			case <-w.ticker.C:
+				w.runIteration()
+			case <-w.stopCh:
+				if w.ticker != nil {
+					w.ticker.Stop()
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (w *ValidatorMatchingAggregateWorker) runIteration() {
+	lockCtx, lockCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	acquired, err := AcquireLock(lockCtx, validatorMatchingAggregateLockKey, validatorMatchingAggregateLockTTL)
+	lockCancel()
+	if err != nil {
+		logger.Warn("Validator matching aggregate worker failed to check distributed lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		logger.Debug("Validator matching aggregate worker skipping iteration: another instance holds the lock",
+			zap.String("instance_id", w.instanceID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Minute)
+	refreshed, err := w.workflow.RefreshValidatorMatchingAggregates(ctx)
+	cancel()
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_ = ReleaseLock(releaseCtx, validatorMatchingAggregateLockKey)
+	releaseCancel()
+
+	if err != nil {
+		logger.Warn("Validator matching aggregate worker iteration failed",
+			zap.Error(err),
+			zap.Int("refreshed", refreshed),
+		)
+		return
+	}
+	if refreshed > 0 {
+		logger.Info("Validator matching aggregate worker refreshed aggregates", zap.Int("refreshed", refreshed))
+	}
+}
+
+func (w *ValidatorMatchingAggregateWorker) Stop() {
+	if w == nil || !w.started {
+		return
+	}
+	close(w.stopCh)
+	<-w.doneCh
+}
@@ -0,0 +1,468 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"backend-gin/ent"
+	"backend-gin/ent/consultationinvitation"
+	"backend-gin/ent/consultationrequest"
+	"backend-gin/ent/tag"
+	"backend-gin/ent/user"
+	"backend-gin/ent/validationcase"
+	"backend-gin/ent/validatormatchingaggregate"
+	apperrors "backend-gin/errors"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+// Validator recommendations rank every validator whose Credibility Stake covers a case by the
+// same matching score shown on consultation requests. The case-independent part of the score
+// is stored per validator in validator_matching_aggregates and refreshed by
+// ValidatorMatchingAggregateWorker, never on read. Candidates are scored page by page so the
+// whole eligible set is ranked without holding it in memory.
+const (
+	// Rows older than this are recomputed by ValidatorMatchingAggregateWorker.
+	validatorAggregateMaxAge       = 30 * time.Minute
+	validatorAggregateRefreshChunk = 100
+
+	recommendationDefaultLimit  = 20
+	recommendationMaxLimit      = 50
+	recommendationCandidatePage = 500
+)
+
+// ValidatorRecommendationFilter narrows the ranking. MinStake below the case's required stake
+// has no effect; MaxActiveConsultations 0 disables the capacity filter.
+type ValidatorRecommendationFilter struct {
+	MinStake               int64
+	MaxActiveConsultations int
+	Limit                  int
+}
+
+func slugSet(slugs []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(slugs))
+	for _, slug := range slugs {
+		out[slug] = struct{}{}
+	}
+	return out
+}
+
+func sortedSlugs(set map[string]struct{}) []string {
+	out := make([]string, 0, len(set))
+	for slug := range set {
+		out = append(out, slug)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func validatorHistoryAggregateFromEnt(row *ent.ValidatorMatchingAggregate) validatorHistoryAggregate {
+	return validatorHistoryAggregate{
+		domainSlugs:               slugSet(row.DomainSlugs),
+		evidenceSlugs:             slugSet(row.EvidenceSlugs),
+		historyCaseCount:          row.HistoryCaseCount,
+		disputedCaseCount:         row.DisputedCaseCount,
+		approvedConsultationCount: row.ApprovedConsultationCount,
+		deliverySLACount:          row.DeliverySLACount,
+		responsivenessScore:       row.ResponsivenessScore,
+	}
+}
+
+// explainMatchingScore describes how each component of the score was derived.
+func explainMatchingScore(vc *ent.ValidationCase, agg validatorHistoryAggregate, guaranteeAmount int64, rep validatorReputationSnapshot, score *MatchingScoreBreakdown) []MatchingScoreComponent {
+	tagFit := func(kind string, caseTags map[string]struct{}, history map[string]struct{}) string {
+		if len(caseTags) == 0 {
+			return fmt.Sprintf("Kasus tidak memiliki tag %s; skor netral.", kind)
+		}
+		if len(history) == 0 {
+			return fmt.Sprintf("Belum ada riwayat kasus dengan tag %s.", kind)
+		}
+		matches := 0
+		for slug := range caseTags {
+			if _, ok := history[slug]; ok {
+				matches++
+			}
+		}
+		return fmt.Sprintf("%d dari %d tag %s kasus pernah ditangani validator.", matches, len(caseTags), kind)
+	}
+
+	dispute := "Belum ada riwayat Final Offer; skor netral."
+	if agg.historyCaseCount > 0 {
+		dispute = fmt.Sprintf("%d dari %d kasus riwayat berakhir dalam dispute.", agg.disputedCaseCount, agg.historyCaseCount)
+	}
+	responsiveness := "Belum ada konsultasi disetujui atau SLA delivery; skor netral."
+	if agg.approvedConsultationCount > 0 || agg.deliverySLACount > 0 {
+		responsiveness = fmt.Sprintf("Dihitung dari %d konsultasi disetujui dan %d SLA delivery.", agg.approvedConsultationCount, agg.deliverySLACount)
+	}
+	minStake := minCredibilityStakeIDR()
+	if minStake <= 0 {
+		minStake = 100_000
+	}
+	stake := fmt.Sprintf("Credibility Stake Rp %d (%.1fx stake minimum Rp %d).", guaranteeAmount, float64(guaranteeAmount)/float64(minStake), minStake)
+	reputation := "Belum ada riwayat reputasi; skor netral."
+	if rep.eventCount > 0 {
+		reputation = fmt.Sprintf("Reputasi %d dari %d peristiwa workflow, bobot menurun seiring waktu.", rep.overall, rep.eventCount)
+		domainMatches := 0
+		for slug := range extractTagSetByPrefix(vc.Edges.Tags, "domain-") {
+			if _, ok := rep.domains[slug]; ok {
+				domainMatches++
+			}
+		}
+		if domainMatches > 0 {
+			reputation = fmt.Sprintf("Reputasi %d dari %d peristiwa workflow, digabung dengan reputasi pada %d tag domain kasus.", rep.overall, rep.eventCount, domainMatches)
+		}
+	}
+
+	return []MatchingScoreComponent{
+		{Component: "domain_fit", Score: score.DomainFit, Explanation: tagFit("domain", extractTagSetByPrefix(vc.Edges.Tags, "domain-"), agg.domainSlugs)},
+		{Component: "evidence_fit", Score: score.EvidenceFit, Explanation: tagFit("evidence", extractTagSetByPrefix(vc.Edges.Tags, "evidence-"), agg.evidenceSlugs)},
+		{Component: "history_dispute", Score: score.HistoryDispute, Explanation: dispute},
+		{Component: "responsiveness_sla", Score: score.ResponsivenessSLA, Explanation: responsiveness},
+		{Component: "stake_guarantee", Score: score.StakeGuarantee, Explanation: stake},
+		{Component: "reputation", Score: score.Reputation, Explanation: reputation},
+	}
+}
+
+// sortRecommendedValidators orders by matching score, then by Credibility Stake.
+func sortRecommendedValidators(items []RecommendedValidatorItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		si := -1
+		sj := -1
+		if items[i].MatchingScore != nil {
+			si = items[i].MatchingScore.Total
+		}
+		if items[j].MatchingScore != nil {
+			sj = items[j].MatchingScore.Total
+		}
+		if si == sj {
+			return items[i].Validator.GuaranteeAmount > items[j].Validator.GuaranteeAmount
+		}
+		return si > sj
+	})
+}
+
+// loadValidatorHistoryAggregates returns the stored aggregates of the validators. Rows are
+// kept fresh by ValidatorMatchingAggregateWorker; a validator without a row yet gets the
+// aggregate of an empty history.
+func (s *EntValidationCaseWorkflowService) loadValidatorHistoryAggregates(ctx context.Context, validatorIDs []int) (map[int]validatorHistoryAggregate, error) {
+	rows, err := s.client.ValidatorMatchingAggregate.Query().
+		Where(validatormatchingaggregate.ValidatorUserIDIn(validatorIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]validatorHistoryAggregate, len(validatorIDs))
+	for _, row := range rows {
+		out[row.ValidatorUserID] = validatorHistoryAggregateFromEnt(row)
+	}
+	var empty *validatorHistoryAggregate
+	for _, id := range validatorIDs {
+		if _, ok := out[id]; ok {
+			continue
+		}
+		if empty == nil {
+			agg := buildValidatorHistoryAggregate(nil, nil, nil, nil, time.Now())
+			empty = &agg
+		}
+		out[id] = *empty
+	}
+	return out, nil
+}
+
+// RefreshValidatorMatchingAggregates recomputes the aggregates of every user with a Credibility
+// Stake whose row is missing or older than validatorAggregateMaxAge, paging through users by ID.
+// Failing to store one aggregate is not fatal. Returns how many were refreshed.
+func (s *EntValidationCaseWorkflowService) RefreshValidatorMatchingAggregates(ctx context.Context) (int, error) {
+	refreshed := 0
+	lastID := 0
+	for {
+		ids, err := s.client.User.Query().
+			Where(
+				user.GuaranteeAmountGT(0),
+				user.IDGT(lastID),
+			).
+			Order(ent.Asc(user.FieldID)).
+			Limit(validatorAggregateRefreshChunk).
+			IDs(ctx)
+		if err != nil {
+			return refreshed, err
+		}
+		if len(ids) == 0 {
+			return refreshed, nil
+		}
+		lastID = ids[len(ids)-1]
+
+		n, err := s.refreshValidatorMatchingAggregateChunk(ctx, ids)
+		refreshed += n
+		if err != nil {
+			return refreshed, err
+		}
+		if len(ids) < validatorAggregateRefreshChunk {
+			return refreshed, nil
+		}
+	}
+}
+
+func (s *EntValidationCaseWorkflowService) refreshValidatorMatchingAggregateChunk(ctx context.Context, validatorIDs []int) (int, error) {
+	rows, err := s.client.ValidatorMatchingAggregate.Query().
+		Where(validatormatchingaggregate.ValidatorUserIDIn(validatorIDs...)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	rowByValidator := make(map[int]*ent.ValidatorMatchingAggregate, len(rows))
+	for _, row := range rows {
+		rowByValidator[row.ValidatorUserID] = row
+	}
+	stale := make([]int, 0, len(validatorIDs))
+	for _, id := range validatorIDs {
+		if row, ok := rowByValidator[id]; !ok || now.Sub(row.ComputedAt) >= validatorAggregateMaxAge {
+			stale = append(stale, id)
+		}
+	}
+	if len(stale) == 0 {
+		return 0, nil
+	}
+
+	inputs, err := s.loadMatchingScoreInputs(ctx, stale)
+	if err != nil {
+		return 0, err
+	}
+	refreshed := 0
+	for _, id := range stale {
+		agg := buildValidatorHistoryAggregate(
+			inputs.offersByValidator[id],
+			inputs.approvedReqsByValidator[id],
+			inputs.deliverySLAsByValidator[id],
+			inputs.historyCaseByID,
+			now,
+		)
+		if err := s.saveValidatorHistoryAggregate(ctx, rowByValidator[id], id, agg, now); err != nil {
+			logger.Warn("Failed to store validator matching aggregate",
+				zap.Error(err),
+				zap.Int("validator_user_id", id),
+			)
+			continue
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
+
+func (s *EntValidationCaseWorkflowService) saveValidatorHistoryAggregate(ctx context.Context, existing *ent.ValidatorMatchingAggregate, validatorUserID int, agg validatorHistoryAggregate, now time.Time) error {
+	if existing != nil {
+		_, err := s.client.ValidatorMatchingAggregate.UpdateOneID(existing.ID).
+			SetDomainSlugs(sortedSlugs(agg.domainSlugs)).
+			SetEvidenceSlugs(sortedSlugs(agg.evidenceSlugs)).
+			SetHistoryCaseCount(agg.historyCaseCount).
+			SetDisputedCaseCount(agg.disputedCaseCount).
+			SetApprovedConsultationCount(agg.approvedConsultationCount).
+			SetDeliverySLACount(agg.deliverySLACount).
+			SetResponsivenessScore(agg.responsivenessScore).
+			SetComputedAt(now).
+			Save(ctx)
+		return err
+	}
+	_, err := s.client.ValidatorMatchingAggregate.Create().
+		SetValidatorUserID(validatorUserID).
+		SetDomainSlugs(sortedSlugs(agg.domainSlugs)).
+		SetEvidenceSlugs(sortedSlugs(agg.evidenceSlugs)).
+		SetHistoryCaseCount(agg.historyCaseCount).
+		SetDisputedCaseCount(agg.disputedCaseCount).
+		SetApprovedConsultationCount(agg.approvedConsultationCount).
+		SetDeliverySLACount(agg.deliverySLACount).
+		SetResponsivenessScore(agg.responsivenessScore).
+		SetComputedAt(now).
+		Save(ctx)
+	return err
+}
+
+// countActiveConsultations counts the consultations that currently lock each validator's
+// guarantee. Evidence Validation Workspace assignments are not included.
+func (s *EntValidationCaseWorkflowService) countActiveConsultations(ctx context.Context, validatorIDs []int) (map[int]int, error) {
+	reqs, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidatorUserIDIn(validatorIDs...),
+			consultationrequest.StatusIn(guaranteeLockConsultationStatuses...),
+		).
+		WithValidationCase().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int]int, len(validatorIDs))
+	for _, req := range reqs {
+		if consultationHoldsGuaranteeLock(req, req.Edges.ValidationCase) {
+			out[req.ValidatorUserID]++
+		}
+	}
+	return out, nil
+}
+
+// RecommendValidators ranks validators who meet the case's Credibility Stake and are not yet
+// requested or invited on the current cycle.
+func (s *EntValidationCaseWorkflowService) RecommendValidators(ctx context.Context, validationCaseID uint, ownerUserID uint, filter ValidatorRecommendationFilter) ([]RecommendedValidatorItem, error) {
+	vc, err := s.client.ValidationCase.Query().
+		Where(validationcase.IDEQ(int(validationCaseID))).
+		WithTags(func(q *ent.TagQuery) {
+			q.Where(tag.IsActiveEQ(true))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apperrors.ErrValidationCaseNotFound
+		}
+		return nil, apperrors.ErrDatabase
+	}
+	if err := ensureWorkflowV1Case(vc); err != nil {
+		return nil, err
+	}
+	if vc.UserID != int(ownerUserID) {
+		return nil, apperrors.ErrValidationCaseOwnership
+	}
+	if filter.MinStake < 0 || filter.MaxActiveConsultations < 0 {
+		return nil, apperrors.ErrInvalidInput.WithDetails("min_stake dan max_active_consultations tidak boleh negatif")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = recommendationDefaultLimit
+	}
+	if limit > recommendationMaxLimit {
+		limit = recommendationMaxLimit
+	}
+
+	cycle := currentWorkflowCycle(vc)
+	excluded := map[int]struct{}{vc.UserID: {}}
+	reqs, err := s.client.ConsultationRequest.Query().
+		Where(
+			consultationrequest.ValidationCaseIDEQ(vc.ID),
+			consultationrequest.WorkflowCycleEQ(cycle),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	for _, r := range reqs {
+		excluded[r.ValidatorUserID] = struct{}{}
+	}
+	invs, err := s.client.ConsultationInvitation.Query().
+		Where(
+			consultationinvitation.ValidationCaseIDEQ(vc.ID),
+			consultationinvitation.WorkflowCycleEQ(cycle),
+			consultationinvitation.StatusEQ(consultationInvitationStatusPending),
+			consultationinvitation.ExpiresAtGT(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	for _, inv := range invs {
+		excluded[inv.ValidatorUserID] = struct{}{}
+	}
+
+	minStake := requiredStakeForConsultation(vc)
+	if filter.MinStake > minStake {
+		minStake = filter.MinStake
+	}
+	if minStake < 1 {
+		minStake = 1
+	}
+	out := make([]RecommendedValidatorItem, 0, limit)
+	lastID := 0
+	for {
+		candidates, err := s.client.User.Query().
+			Where(
+				user.GuaranteeAmountGTE(minStake),
+				user.IDGT(lastID),
+			).
+			WithPrimaryBadge().
+			Order(ent.Asc(user.FieldID)).
+			Limit(recommendationCandidatePage).
+			All(ctx)
+		if err != nil {
+			return nil, apperrors.ErrDatabase
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		lastID = candidates[len(candidates)-1].ID
+
+		scored, err := s.scoreRecommendationCandidates(ctx, vc, candidates, excluded, filter.MaxActiveConsultations)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, scored...)
+		sortRecommendedValidators(out)
+		if len(out) > limit {
+			out = out[:limit]
+		}
+		if len(candidates) < recommendationCandidatePage {
+			break
+		}
+	}
+	return out, nil
+}
+
+// scoreRecommendationCandidates scores one page of stake-eligible users, skipping excluded
+// validators and those at or above maxActive active consultations (0 disables the filter).
+func (s *EntValidationCaseWorkflowService) scoreRecommendationCandidates(ctx context.Context, vc *ent.ValidationCase, candidates []*ent.User, excluded map[int]struct{}, maxActive int) ([]RecommendedValidatorItem, error) {
+	pool := make([]*ent.User, 0, len(candidates))
+	validatorIDs := make([]int, 0, len(candidates))
+	for _, u := range candidates {
+		if _, skip := excluded[u.ID]; skip {
+			continue
+		}
+		pool = append(pool, u)
+		validatorIDs = append(validatorIDs, u.ID)
+	}
+	if len(pool) == 0 {
+		return nil, nil
+	}
+
+	active, err := s.countActiveConsultations(ctx, validatorIDs)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	if maxActive > 0 {
+		kept := pool[:0]
+		validatorIDs = validatorIDs[:0]
+		for _, u := range pool {
+			if active[u.ID] >= maxActive {
+				continue
+			}
+			kept = append(kept, u)
+			validatorIDs = append(validatorIDs, u.ID)
+		}
+		pool = kept
+		if len(pool) == 0 {
+			return nil, nil
+		}
+	}
+
+	aggregates, err := s.loadValidatorHistoryAggregates(ctx, validatorIDs)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	reputations, err := loadValidatorReputations(ctx, s.client, validatorIDs)
+	if err != nil {
+		return nil, apperrors.ErrDatabase
+	}
+	out := make([]RecommendedValidatorItem, 0, len(pool))
+	for _, u := range pool {
+		validator := buildUserSummaryFromEnt(u)
+		agg := aggregates[u.ID]
+		rep := reputations[u.ID]
+		score := scoreFromHistoryAggregate(vc, agg, validator.GuaranteeAmount, rep)
+		out = append(out, RecommendedValidatorItem{
+			Validator:           validator,
+			MatchingScore:       score,
+			ScoreExplanation:    explainMatchingScore(vc, agg, validator.GuaranteeAmount, rep, score),
+			ActiveConsultations: active[u.ID],
+		})
+	}
+	return out, nil
+}
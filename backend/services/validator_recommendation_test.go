@@ -0,0 +1,107 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"backend-gin/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func matchingTestCase() *ent.ValidationCase {
+	return &ent.ValidationCase{Edges: ent.ValidationCaseEdges{Tags: []*ent.Tag{
+		{Slug: "domain-defi"},
+		{Slug: "domain-nft"},
+		{Slug: "evidence-logs"},
+	}}}
+}
+
+func TestScoreFromCachedAggregateMatchesBatchScore(t *testing.T) {
+	now := time.Now()
+	historyCaseByID := map[int]*ent.ValidationCase{
+		10: {ID: 10, Status: "disputed", Edges: ent.ValidationCaseEdges{Tags: []*ent.Tag{{Slug: "domain-defi"}, {Slug: "evidence-logs"}}}},
+		11: {ID: 11, Status: "completed", Edges: ent.ValidationCaseEdges{Tags: []*ent.Tag{{Slug: "domain-web"}}}},
+	}
+	offers := []*ent.FinalOffer{
+		{ValidationCaseID: 10, CreatedAt: now.Add(-48 * time.Hour)},
+		{ValidationCaseID: 11, CreatedAt: now.Add(-24 * time.Hour)},
+	}
+	guarantee := 2 * minCredibilityStakeIDR()
+	vc := matchingTestCase()
+
+	rep := validatorReputationSnapshot{overall: 70, eventCount: 3, domains: map[string]int{"domain-defi": 90}}
+
+	s := &EntValidationCaseWorkflowService{}
+	batch, err := s.buildMatchingScoreBatch(vc, 7, guarantee, offers, nil, nil, historyCaseByID, rep)
+	require.NoError(t, err)
+
+	agg := buildValidatorHistoryAggregate(offers, nil, nil, historyCaseByID, now)
+	row := &ent.ValidatorMatchingAggregate{
+		DomainSlugs:         sortedSlugs(agg.domainSlugs),
+		EvidenceSlugs:       sortedSlugs(agg.evidenceSlugs),
+		HistoryCaseCount:    agg.historyCaseCount,
+		DisputedCaseCount:   agg.disputedCaseCount,
+		ResponsivenessScore: agg.responsivenessScore,
+	}
+	cached := scoreFromHistoryAggregate(vc, validatorHistoryAggregateFromEnt(row), guarantee, rep)
+
+	assert.Equal(t, batch, cached)
+	assert.Equal(t, []string{"domain-defi", "domain-web"}, row.DomainSlugs)
+	assert.Equal(t, 50, cached.DomainFit)
+	assert.Equal(t, 100, cached.EvidenceFit)
+	assert.Equal(t, 50, cached.HistoryDispute)
+	assert.Equal(t, 80, cached.Reputation)
+}
+
+func TestExplainMatchingScore(t *testing.T) {
+	vc := matchingTestCase()
+	agg := validatorHistoryAggregate{
+		domainSlugs:               map[string]struct{}{"domain-defi": {}},
+		evidenceSlugs:             map[string]struct{}{},
+		historyCaseCount:          4,
+		disputedCaseCount:         1,
+		approvedConsultationCount: 3,
+		deliverySLACount:          2,
+		responsivenessScore:       80,
+	}
+	rep := validatorReputationSnapshot{overall: 60, eventCount: 2, domains: map[string]int{"domain-nft": 40}}
+	score := scoreFromHistoryAggregate(vc, agg, minCredibilityStakeIDR(), rep)
+	components := explainMatchingScore(vc, agg, minCredibilityStakeIDR(), rep, score)
+
+	require.Len(t, components, 6)
+	assert.Equal(t, "domain_fit", components[0].Component)
+	assert.Equal(t, score.DomainFit, components[0].Score)
+	assert.Equal(t, "1 dari 2 tag domain kasus pernah ditangani validator.", components[0].Explanation)
+	assert.Equal(t, "Belum ada riwayat kasus dengan tag evidence.", components[1].Explanation)
+	assert.Equal(t, "1 dari 4 kasus riwayat berakhir dalam dispute.", components[2].Explanation)
+	assert.Equal(t, "Dihitung dari 3 konsultasi disetujui dan 2 SLA delivery.", components[3].Explanation)
+	assert.Equal(t, "stake_guarantee", components[4].Component)
+	assert.Equal(t, "reputation", components[5].Component)
+	assert.Equal(t, 50, components[5].Score)
+	assert.Equal(t, "Reputasi 60 dari 2 peristiwa workflow, digabung dengan reputasi pada 1 tag domain kasus.", components[5].Explanation)
+
+	noTags := &ent.ValidationCase{}
+	none := validatorReputationSnapshot{}
+	components = explainMatchingScore(noTags, validatorHistoryAggregate{}, 0, none, scoreFromHistoryAggregate(noTags, validatorHistoryAggregate{}, 0, none))
+	assert.Equal(t, "Kasus tidak memiliki tag domain; skor netral.", components[0].Explanation)
+	assert.Equal(t, "Belum ada riwayat Final Offer; skor netral.", components[2].Explanation)
+	assert.Equal(t, "Belum ada riwayat reputasi; skor netral.", components[5].Explanation)
+}
+
+func TestSortRecommendedValidators(t *testing.T) {
+	items := []RecommendedValidatorItem{
+		{Validator: UserSummary{ID: 1, GuaranteeAmount: 500_000}},
+		{Validator: UserSummary{ID: 2, GuaranteeAmount: 100_000}, MatchingScore: &MatchingScoreBreakdown{Total: 60}},
+		{Validator: UserSummary{ID: 3, GuaranteeAmount: 900_000}, MatchingScore: &MatchingScoreBreakdown{Total: 60}},
+		{Validator: UserSummary{ID: 4, GuaranteeAmount: 100_000}, MatchingScore: &MatchingScoreBreakdown{Total: 80}},
+	}
+	sortRecommendedValidators(items)
+
+	ids := make([]uint, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.Validator.ID)
+	}
+	assert.Equal(t, []uint{4, 3, 2, 1}, ids)
+}
@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"backend-gin/ent"
+	"backend-gin/ent/finaloffer"
+	"backend-gin/ent/reputationevent"
+	"backend-gin/ent/tag"
+	"backend-gin/ent/validationcase"
+	"backend-gin/ent/validatorreputation"
+	"backend-gin/logger"
+
+	"go.uber.org/zap"
+)
+
+// Validator reputation is an append-only ledger of workflow outcomes (reputation_events) and
+// a materialized, time-decayed score per validator and domain tag (validator_reputations).
+// A validator without events has the neutral score 50. Endorsements are out of scope: they are
+// a Phase 2 model with no workflow that creates them, so they emit no event.
+const (
+	reputationEventCaseCompleted        = "case_completed"
+	reputationEventDisputeWon           = "dispute_won"
+	reputationEventDisputeLost          = "dispute_lost"
+	reputationEventDeliverySLAMissed    = "delivery_sla_missed"
+	reputationEventOwnerTimeoutReleased = "owner_timeout_released"
+	reputationEventGuaranteeSlashed     = "guarantee_slashed"
+
+	// Event weight halves every reputationHalfLife.
+	reputationHalfLife = 180 * 24 * time.Hour
+	// Materialized scores older than this are recomputed by ValidatorReputationDecayWorker so
+	// decay keeps applying; reads always serve the stored rows.
+	reputationMaxAge = 24 * time.Hour
+	// Validators rematerialized per batch by RefreshStaleValidatorReputations.
+	reputationRefreshBatch = 200
+)
+
+func reputationEventPoints(kind string) int {
+	switch kind {
+	case reputationEventCaseCompleted:
+		return 10
+	case reputationEventDisputeWon:
+		return 5
+	case reputationEventDisputeLost:
+		return -20
+	case reputationEventDeliverySLAMissed:
+		return -10
+	default:
+		// owner_timeout_released is recorded without penalty; guarantee_slashed is recorded
+		// without extra points since the lost dispute already counts.
+		return 0
+	}
+}
+
+// reputationDecayFactor is the weight of an event that happened at occurredAt.
+func reputationDecayFactor(occurredAt time.Time, now time.Time) float64 {
+	age := now.Sub(occurredAt)
+	if age <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(age)/float64(reputationHalfLife))
+}
+
+func reputationScoreFromPoints(decayed float64) int {
+	return clampScore(int(math.Round(50 + decayed)))
+}
+
+// reputationTally is one materialized score: overall or for one domain tag.
+type reputationTally struct {
+	decayedPoints float64
+	eventCount    int
+	lastEventAt   time.Time
+}
+
+func (t reputationTally) score() int {
+	return reputationScoreFromPoints(t.decayedPoints)
+}
+
+// tallyReputationEvents decays the validator's events into an overall tally and one tally
+// per domain tag seen on the events.
+func tallyReputationEvents(events []*ent.ReputationEvent, now time.Time) (reputationTally, map[string]reputationTally) {
+	overall := reputationTally{}
+	domains := map[string]reputationTally{}
+	add := func(t reputationTally, ev *ent.ReputationEvent, weighted float64) reputationTally {
+		t.decayedPoints += weighted
+		t.eventCount++
+		if ev.OccurredAt.After(t.lastEventAt) {
+			t.lastEventAt = ev.OccurredAt
+		}
+		return t
+	}
+	for _, ev := range events {
+		weighted := float64(ev.Points) * reputationDecayFactor(ev.OccurredAt, now)
+		overall = add(overall, ev, weighted)
+		for _, slug := range ev.DomainSlugs {
+			domains[slug] = add(domains[slug], ev, weighted)
+		}
+	}
+	return overall, domains
+}
+
+// validatorReputationSnapshot is the materialized reputation of one validator.
+type validatorReputationSnapshot struct {
+	overall    int
+	eventCount int
+	domains    map[string]int
+}
+
+// reputationMatchScore blends the overall reputation with the reputation on the case's
+// domain tags, when the validator has any.
+func reputationMatchScore(rep validatorReputationSnapshot, caseDomains map[string]struct{}) int {
+	if rep.eventCount == 0 {
+		return 50
+	}
+	total := 0
+	matched := 0
+	for slug := range caseDomains {
+		if score, ok := rep.domains[slug]; ok {
+			total += score
+			matched++
+		}
+	}
+	if matched == 0 {
+		return rep.overall
+	}
+	return clampScore(int(math.Round((float64(rep.overall) + float64(total)/float64(matched)) / 2)))
+}
+
+func reputationSnapshotFromRows(rows []*ent.ValidatorReputation) validatorReputationSnapshot {
+	rep := validatorReputationSnapshot{overall: 50, domains: map[string]int{}}
+	for _, row := range rows {
+		if row.DomainSlug == "" {
+			rep.overall = row.Score
+			rep.eventCount = row.EventCount
+			continue
+		}
+		rep.domains[row.DomainSlug] = row.Score
+	}
+	return rep
+}
+
+// recomputeValidatorReputation rematerializes the validator's reputation rows from the ledger.
+func recomputeValidatorReputation(ctx context.Context, client *ent.Client, validatorUserID int, now time.Time) ([]*ent.ValidatorReputation, error) {
+	events, err := client.ReputationEvent.Query().
+		Where(reputationevent.ValidatorUserIDEQ(validatorUserID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, _, err := materializeValidatorReputation(ctx, client, validatorUserID, events, now, time.Time{})
+	return rows, err
+}
+
+// materializeValidatorReputation replaces the validator's reputation rows with the tally of
+// events. A non-zero staleBefore only replaces rows computed before it; when another writer
+// refreshed them first nothing changes and applied is false.
+func materializeValidatorReputation(ctx context.Context, client *ent.Client, validatorUserID int, events []*ent.ReputationEvent, now time.Time, staleBefore time.Time) (rows []*ent.ValidatorReputation, applied bool, err error) {
+	overall, domains := tallyReputationEvents(events, now)
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	del := tx.ValidatorReputation.Delete().
+		Where(validatorreputation.ValidatorUserIDEQ(validatorUserID))
+	if !staleBefore.IsZero() {
+		del = del.Where(validatorreputation.ComputedAtLT(staleBefore))
+	}
+	deleted, err := del.Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, false, err
+	}
+	if !staleBefore.IsZero() && deleted == 0 {
+		_ = tx.Rollback()
+		return nil, false, nil
+	}
+	if len(events) == 0 {
+		if err := tx.Commit(); err != nil {
+			return nil, false, err
+		}
+		return []*ent.ValidatorReputation{}, true, nil
+	}
+
+	slugs := make([]string, 0, len(domains))
+	for slug := range domains {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	builders := make([]*ent.ValidatorReputationCreate, 0, len(slugs)+1)
+	create := func(slug string, t reputationTally) *ent.ValidatorReputationCreate {
+		b := tx.ValidatorReputation.Create().
+			SetValidatorUserID(validatorUserID).
+			SetDomainSlug(slug).
+			SetScore(t.score()).
+			SetDecayedPoints(t.decayedPoints).
+			SetEventCount(t.eventCount).
+			SetComputedAt(now)
+		if !t.lastEventAt.IsZero() {
+			b.SetLastEventAt(t.lastEventAt)
+		}
+		return b
+	}
+	builders = append(builders, create("", overall))
+	for _, slug := range slugs {
+		builders = append(builders, create(slug, domains[slug]))
+	}
+	rows, err = tx.ValidatorReputation.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	return rows, true, nil
+}
+
+// RefreshStaleValidatorReputations rematerializes every validator whose reputation is older
+// than reputationMaxAge, loading the ledger of each batch in one query. Validators refreshed
+// concurrently by a new event are skipped. Returns how many were refreshed.
+func (s *EntValidationCaseWorkflowService) RefreshStaleValidatorReputations(ctx context.Context) (int, error) {
+	now := time.Now()
+	staleBefore := now.Add(-reputationMaxAge)
+	refreshed := 0
+	for {
+		stale, err := s.client.ValidatorReputation.Query().
+			Where(
+				validatorreputation.DomainSlugEQ(""),
+				validatorreputation.ComputedAtLT(staleBefore),
+			).
+			Order(ent.Asc(validatorreputation.FieldComputedAt)).
+			Limit(reputationRefreshBatch).
+			All(ctx)
+		if err != nil {
+			return refreshed, err
+		}
+		if len(stale) == 0 {
+			return refreshed, nil
+		}
+		validatorIDs := make([]int, 0, len(stale))
+		for _, row := range stale {
+			validatorIDs = append(validatorIDs, row.ValidatorUserID)
+		}
+		events, err := s.client.ReputationEvent.Query().
+			Where(reputationevent.ValidatorUserIDIn(validatorIDs...)).
+			All(ctx)
+		if err != nil {
+			return refreshed, err
+		}
+		eventsByValidator := make(map[int][]*ent.ReputationEvent, len(validatorIDs))
+		for _, ev := range events {
+			eventsByValidator[ev.ValidatorUserID] = append(eventsByValidator[ev.ValidatorUserID], ev)
+		}
+
+		batchRefreshed := 0
+		for _, validatorID := range validatorIDs {
+			_, applied, err := materializeValidatorReputation(ctx, s.client, validatorID, eventsByValidator[validatorID], now, staleBefore)
+			if err != nil {
+				logger.Warn("Failed to refresh validator reputation",
+					zap.Error(err),
+					zap.Int("validator_user_id", validatorID),
+				)
+				continue
+			}
+			if applied {
+				batchRefreshed++
+			}
+		}
+		refreshed += batchRefreshed
+		// A batch where nothing could be refreshed would be selected again; leave it for the
+		// next tick.
+		if len(stale) < reputationRefreshBatch || batchRefreshed == 0 {
+			return refreshed, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return refreshed, err
+		}
+	}
+}
+
+// loadValidatorReputations returns the stored reputation of the validators. Decay is kept
+// current by ValidatorReputationDecayWorker. Validators without events are absent.
+func loadValidatorReputations(ctx context.Context, client *ent.Client, validatorIDs []int) (map[int]validatorReputationSnapshot, error) {
+	out := make(map[int]validatorReputationSnapshot, len(validatorIDs))
+	if len(validatorIDs) == 0 {
+		return out, nil
+	}
+	rows, err := client.ValidatorReputation.Query().
+		Where(validatorreputation.ValidatorUserIDIn(validatorIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rowsByValidator := map[int][]*ent.ValidatorReputation{}
+	for _, row := range rows {
+		rowsByValidator[row.ValidatorUserID] = append(rowsByValidator[row.ValidatorUserID], row)
+	}
+	for validatorID, validatorRows := range rowsByValidator {
+		out[validatorID] = reputationSnapshotFromRows(validatorRows)
+	}
+	return out, nil
+}
+
+// GetValidatorReputationSummary returns the public reputation of a validator.
+func GetValidatorReputationSummary(ctx context.Context, client *ent.Client, validatorUserID int) (*ValidatorReputationSummary, error) {
+	reps, err := loadValidatorReputations(ctx, client, []int{validatorUserID})
+	if err != nil {
+		return nil, err
+	}
+	rep, ok := reps[validatorUserID]
+	if !ok {
+		return &ValidatorReputationSummary{Score: 50, Domains: []DomainReputationItem{}}, nil
+	}
+	domains := make([]DomainReputationItem, 0, len(rep.domains))
+	for slug, score := range rep.domains {
+		domains = append(domains, DomainReputationItem{Domain: slug, Score: score})
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		if domains[i].Score == domains[j].Score {
+			return domains[i].Domain < domains[j].Domain
+		}
+		return domains[i].Score > domains[j].Score
+	})
+	return &ValidatorReputationSummary{
+		Score:      rep.overall,
+		EventCount: rep.eventCount,
+		Domains:    domains,
+	}, nil
+}
+
+// reputationEventInput describes an outcome to append to a validator's ledger.
+type reputationEventInput struct {
+	validatorUserID  int
+	validationCaseID int
+	workflowCycle    int
+	kind             string
+	dedupeKey        string
+	detail           map[string]interface{}
+}
+
+// recordReputationEventBestEffort appends the event and rematerializes the validator's
+// reputation. Replays with the same dedupe key are ignored; failures are only logged so the
+// workflow transition itself never fails on reputation.
+func (s *EntValidationCaseWorkflowService) recordReputationEventBestEffort(ctx context.Context, in reputationEventInput) {
+	if in.validatorUserID <= 0 {
+		return
+	}
+	domainSlugs := []string{}
+	vc, err := s.client.ValidationCase.Query().
+		Where(validationcase.IDEQ(in.validationCaseID)).
+		WithTags(func(q *ent.TagQuery) {
+			q.Where(tag.IsActiveEQ(true))
+		}).
+		Only(ctx)
+	if err == nil {
+		domainSlugs = sortedSlugs(extractTagSetByPrefix(vc.Edges.Tags, "domain-"))
+	}
+	if in.workflowCycle <= 0 {
+		in.workflowCycle = 1
+	}
+
+	now := time.Now()
+	if _, err := s.client.ReputationEvent.Create().
+		SetValidatorUserID(in.validatorUserID).
+		SetValidationCaseID(in.validationCaseID).
+		SetWorkflowCycle(in.workflowCycle).
+		SetKind(in.kind).
+		SetPoints(reputationEventPoints(in.kind)).
+		SetDomainSlugs(domainSlugs).
+		SetDedupeKey(in.dedupeKey).
+		SetDetailJSON(in.detail).
+		SetOccurredAt(now).
+		Save(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return
+		}
+		logger.Warn("Failed to append reputation event (best effort)",
+			zap.Error(err),
+			zap.Int("validation_case_id", in.validationCaseID),
+			zap.Int("validator_user_id", in.validatorUserID),
+			zap.String("kind", in.kind),
+		)
+		return
+	}
+	if _, err := recomputeValidatorReputation(ctx, s.client, in.validatorUserID, now); err != nil {
+		logger.Warn("Failed to recompute validator reputation",
+			zap.Error(err),
+			zap.Int("validator_user_id", in.validatorUserID),
+		)
+	}
+}
+
+// recordOfferReputationEventBestEffort records an outcome for the validator of a Final Offer.
+func (s *EntValidationCaseWorkflowService) recordOfferReputationEventBestEffort(ctx context.Context, finalOfferID *int, kind string, dedupeKey string, detail map[string]interface{}) {
+	if finalOfferID == nil || *finalOfferID <= 0 {
+		return
+	}
+	offer, err := s.client.FinalOffer.Query().
+		Where(finaloffer.IDEQ(*finalOfferID)).
+		Only(ctx)
+	if err != nil {
+		logger.Warn("Failed to load Final Offer for reputation event",
+			zap.Error(err),
+			zap.Int("final_offer_id", *finalOfferID),
+			zap.String("kind", kind),
+		)
+		return
+	}
+	if detail == nil {
+		detail = map[string]interface{}{}
+	}
+	detail["final_offer_id"] = offer.ID
+	s.recordReputationEventBestEffort(ctx, reputationEventInput{
+		validatorUserID:  offer.ValidatorUserID,
+		validationCaseID: offer.ValidationCaseID,
+		workflowCycle:    offer.WorkflowCycle,
+		kind:             kind,
+		dedupeKey:        dedupeKey,
+		detail:           detail,
+	})
+}
+
+// recordCaseCompletedReputationBestEffort credits the validator of a completed Final Offer.
+func (s *EntValidationCaseWorkflowService) recordCaseCompletedReputationBestEffort(ctx context.Context, finalOfferID *int, source string) {
+	if finalOfferID == nil {
+		return
+	}
+	s.recordOfferReputationEventBestEffort(ctx, finalOfferID, reputationEventCaseCompleted,
+		fmt.Sprintf("case_completed:offer:%d", *finalOfferID),
+		map[string]interface{}{"source": source},
+	)
+}
+
+// recordDisputeReputationBestEffort records a settled dispute: a refund to the owner counts
+// against the validator, a release to the validator counts for them.
+func (s *EntValidationCaseWorkflowService) recordDisputeReputationBestEffort(ctx context.Context, finalOfferID *int, disputeID string, outcome string, source string) {
+	kind := reputationEventDisputeWon
+	if outcome == disputeSettlementOutcomeOwnerRefund {
+		kind = reputationEventDisputeLost
+	}
+	s.recordOfferReputationEventBestEffort(ctx, finalOfferID, kind,
+		"dispute:"+strings.TrimSpace(disputeID),
+		map[string]interface{}{
+			"dispute_id": strings.TrimSpace(disputeID),
+			"outcome":    outcome,
+			"source":     source,
+		},
+	)
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend-gin/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const validatorReputationDecayLockKey = "validator_reputation_decay_worker_lock"
+const validatorReputationDecayLockTTL = 5 * time.Minute
+
+// ValidatorReputationDecayWorker periodically rematerializes validator reputations older than
+// 24 hours so the stored scores keep decaying without recomputation on read.
+// Uses Redis distributed lock to prevent duplicate processing across multiple instances.
+type ValidatorReputationDecayWorker struct {
+	workflow   *EntValidationCaseWorkflowService
+	ticker     *time.Ticker
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+	started    bool
+	instanceID string
+}
+
+func NewValidatorReputationDecayWorker(workflow *EntValidationCaseWorkflowService) *ValidatorReputationDecayWorker {
+	return &ValidatorReputationDecayWorker{
+		workflow:   workflow,
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+		instanceID: uuid.New().String(),
+	}
+}
+
+func validatorReputationDecayInterval() time.Duration {
+	const fallback = time.Hour
+	raw := strings.TrimSpace(os.Getenv("REPUTATION_DECAY_TICK_SECONDS"))
+	if raw == "" {
+		return fallback
+	}
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds <= 0 {
+		return fallback
+	}
+	if seconds < 60 {
+		seconds = 60
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func (w *ValidatorReputationDecayWorker) Start() {
+	if w == nil || w.started || w.workflow == nil {
+		return
+	}
+	w.started = true
+	w.ticker = time.NewTicker(validatorReputationDecayInterval())
+
+	go func() {
+		defer close(w.doneCh)
+		w.runIteration()
+		for {
+			select {
+			case <-w.ticker.C:
+				w.runIteration()
+			case <-w.stopCh:
+				if w.ticker != nil {
+					w.ticker.Stop()
+				}
+				return
+			}
+		}
+	}()
+}
+
+func (w *ValidatorReputationDecayWorker) runIteration() {
+	lockCtx, lockCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	acquired, err := AcquireLock(lockCtx, validatorReputationDecayLockKey, validatorReputationDecayLockTTL)
+	lockCancel()
+	if err != nil {
+		logger.Warn("Validator reputation decay worker failed to check distributed lock", zap.Error(err))
+		return
+	}
+	if !acquired {
+		logger.Debug("Validator reputation decay worker skipping iteration: another instance holds the lock",
+			zap.String("instance_id", w.instanceID))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Minute)
+	refreshed, err := w.workflow.RefreshStaleValidatorReputations(ctx)
+	cancel()
+
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	_ = ReleaseLock(releaseCtx, validatorReputationDecayLockKey)
+	releaseCancel()
+
+	if err != nil {
+		logger.Warn("Validator reputation decay worker iteration failed",
+			zap.Error(err),
+			zap.Int("refreshed", refreshed),
+		)
+		return
+	}
+	if refreshed > 0 {
+		logger.Info("Validator reputation decay worker refreshed reputations", zap.Int("refreshed", refreshed))
+	}
+}
+
+func (w *ValidatorReputationDecayWorker) Stop() {
+	if w == nil || !w.started {
+		return
+	}
+	close(w.stopCh)
+	<-w.doneCh
+}
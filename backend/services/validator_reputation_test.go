@@ -0,0 +1,65 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"backend-gin/ent"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReputationDecayFactor(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, 1.0, reputationDecayFactor(now, now))
+	assert.Equal(t, 1.0, reputationDecayFactor(now.Add(time.Hour), now), "future events are not amplified")
+	assert.InDelta(t, 0.5, reputationDecayFactor(now.Add(-reputationHalfLife), now), 1e-9)
+	assert.InDelta(t, 0.25, reputationDecayFactor(now.Add(-2*reputationHalfLife), now), 1e-9)
+}
+
+func TestTallyReputationEvents(t *testing.T) {
+	now := time.Now()
+	events := []*ent.ReputationEvent{
+		{Kind: reputationEventCaseCompleted, Points: 10, DomainSlugs: []string{"domain-defi"}, OccurredAt: now},
+		{Kind: reputationEventDisputeLost, Points: -20, DomainSlugs: []string{"domain-nft"}, OccurredAt: now.Add(-reputationHalfLife)},
+		{Kind: reputationEventOwnerTimeoutReleased, Points: 0, OccurredAt: now.Add(-time.Hour)},
+	}
+	overall, domains := tallyReputationEvents(events, now)
+
+	assert.Equal(t, 3, overall.eventCount)
+	assert.InDelta(t, 0, overall.decayedPoints, 1e-9)
+	assert.Equal(t, 50, overall.score())
+	assert.True(t, overall.lastEventAt.Equal(now))
+	assert.Equal(t, 60, domains["domain-defi"].score())
+	assert.Equal(t, 40, domains["domain-nft"].score())
+	assert.Len(t, domains, 2)
+
+	assert.Equal(t, 0, reputationScoreFromPoints(-80))
+	assert.Equal(t, 100, reputationScoreFromPoints(75))
+}
+
+func TestReputationMatchScore(t *testing.T) {
+	caseDomains := map[string]struct{}{"domain-defi": {}, "domain-nft": {}}
+
+	assert.Equal(t, 50, reputationMatchScore(validatorReputationSnapshot{}, caseDomains), "no events is neutral")
+
+	rep := validatorReputationSnapshot{overall: 70, eventCount: 4, domains: map[string]int{"domain-web": 20}}
+	assert.Equal(t, 70, reputationMatchScore(rep, caseDomains), "no matching domain uses overall")
+
+	rep.domains["domain-defi"] = 90
+	rep.domains["domain-nft"] = 50
+	assert.Equal(t, 70, reputationMatchScore(rep, caseDomains))
+
+	rep.domains["domain-nft"] = 100
+	assert.Equal(t, 83, reputationMatchScore(rep, caseDomains))
+}
+
+func TestReputationSnapshotFromRows(t *testing.T) {
+	rep := reputationSnapshotFromRows([]*ent.ValidatorReputation{
+		{DomainSlug: "", Score: 64, EventCount: 5},
+		{DomainSlug: "domain-defi", Score: 72, EventCount: 2},
+	})
+	assert.Equal(t, 64, rep.overall)
+	assert.Equal(t, 5, rep.eventCount)
+	assert.Equal(t, map[string]int{"domain-defi": 72}, rep.domains)
+}
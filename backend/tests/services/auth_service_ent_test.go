@@ -3,8 +3,12 @@ package services_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"backend-gin/database"
+	"backend-gin/ent/iprule"
 	"backend-gin/logger"
+	"backend-gin/services"
 	"backend-gin/tests/enttest"
 	"backend-gin/validators"
 
@@ -148,6 +152,59 @@ func TestEntAuthService_Login(t *testing.T) {
 	})
 }
 
+// TestEntAuthService_FailedLoginsBlockIP tests that repeated failed logins from one IP,
+// across accounts, create a temporary IP block rule without locking any account
+func TestEntAuthService_FailedLoginsBlockIP(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	tc := enttest.NewTestClient(t)
+	ctx := context.Background()
+
+	prevClient := database.EntClient
+	database.EntClient = tc.Client
+	services.SetIPRuleService(services.NewEntIPRuleService())
+	t.Cleanup(func() {
+		database.EntClient = prevClient
+		services.SetIPRuleService(nil)
+	})
+	t.Setenv("BRUTE_FORCE_AUTO_BLOCK_MINUTES", "45")
+	t.Setenv("BRUTE_FORCE_IP_THRESHOLD", "3")
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("SecurePass123!"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	_, err = tc.CreateVerifiedTestUser(ctx, "victim@example.com", string(hashedPassword))
+	require.NoError(t, err)
+
+	svc := services.NewEntAuthService()
+	attempts := []validators.LoginInput{
+		{Email: "victim@example.com", Password: "WrongPass123!"},
+		{Email: "nobody@example.com", Password: "WrongPass123!"},
+		{Email: "victim@example.com", Password: "WrongPass456!"},
+	}
+	for _, input := range attempts {
+		_, err := svc.LoginWithSession(ctx, input, "203.0.113.9", "Test Agent", "")
+		assert.ErrorIs(t, err, apperrors.ErrInvalidCredentials)
+	}
+
+	rule, err := tc.IPRule.Query().
+		Where(
+			iprule.CidrEQ("203.0.113.9/32"),
+			iprule.ActionEQ(services.IPRuleBlock),
+			iprule.SourceEQ(services.IPRuleSourceBruteForce),
+		).
+		Only(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, rule.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(45*time.Minute), *rule.ExpiresAt, time.Minute)
+
+	// The account is not locked: its owner can still sign in from another address
+	resp, err := svc.LoginWithSession(ctx, validators.LoginInput{Email: "victim@example.com", Password: "SecurePass123!"}, "198.51.100.4", "Test Agent", "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+}
+
 // TestEntAuthService_Session tests session management
 func TestEntAuthService_Session(t *testing.T) {
 	if testing.Short() {
@@ -0,0 +1,232 @@
+package utils
+
+import (
+	"bufio"
+	"crypto/sha1" // #nosec G505 -- Pwned Passwords corpus is keyed by SHA-1, not used for security
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// ==============================================================================
+// Breached Password Filter
+// ==============================================================================
+//
+// BreachedPasswordFilter is a Bloom filter over SHA-1 digests of passwords that
+// appeared in public breaches (e.g. the Pwned Passwords dump). It is built
+// offline by cmd/build_breached_filter and loaded at startup, so screening
+// never needs network access. False positives are possible (tuned by the
+// build-time rate); false negatives are not.
+//
+// File layout (little-endian):
+//
+//	magic    [8]byte  "AIVBPF01"
+//	k        uint32   number of hash functions
+//	reserved uint32
+//	m        uint64   number of bits
+//	n        uint64   number of inserted entries
+//	bits     [ceil(m/64)]uint64
+
+const breachedFilterMagic = "AIVBPF01"
+
+// maxBreachedFilterBits guards against corrupt headers allocating unbounded memory (8 GiB of bits).
+const maxBreachedFilterBits = uint64(1) << 36
+
+// BreachedPasswordFilter is a Bloom filter keyed by SHA-1 password digests
+type BreachedPasswordFilter struct {
+	bits  []uint64
+	m     uint64
+	k     uint32
+	count uint64
+}
+
+// NewBreachedPasswordFilter sizes a filter for the expected number of entries and false positive rate
+func NewBreachedPasswordFilter(expected uint64, fpRate float64) (*BreachedPasswordFilter, error) {
+	if expected == 0 {
+		return nil, errors.New("expected entries must be positive")
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		return nil, errors.New("false positive rate must be between 0 and 1")
+	}
+
+	m := uint64(math.Ceil(-float64(expected) * math.Log(fpRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	if m > maxBreachedFilterBits {
+		return nil, fmt.Errorf("filter too large: %d bits", m)
+	}
+	k := uint32(math.Round(float64(m) / float64(expected) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &BreachedPasswordFilter{
+		bits: make([]uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+	}, nil
+}
+
+// indexes derives k bit positions from a digest using double hashing
+func (f *BreachedPasswordFilter) indexes(digest [sha1.Size]byte, fn func(idx uint64) bool) {
+	h1 := binary.BigEndian.Uint64(digest[0:8])
+	h2 := binary.BigEndian.Uint64(digest[8:16]) | 1
+	for i := uint32(0); i < f.k; i++ {
+		if !fn((h1 + uint64(i)*h2) % f.m) {
+			return
+		}
+	}
+}
+
+// AddSHA1 inserts a SHA-1 password digest
+func (f *BreachedPasswordFilter) AddSHA1(digest [sha1.Size]byte) {
+	f.indexes(digest, func(idx uint64) bool {
+		f.bits[idx/64] |= 1 << (idx % 64)
+		return true
+	})
+	f.count++
+}
+
+// ContainsSHA1 reports whether a SHA-1 password digest is (probably) in the filter
+func (f *BreachedPasswordFilter) ContainsSHA1(digest [sha1.Size]byte) bool {
+	found := true
+	f.indexes(digest, func(idx uint64) bool {
+		if f.bits[idx/64]&(1<<(idx%64)) == 0 {
+			found = false
+		}
+		return found
+	})
+	return found
+}
+
+// ContainsPassword hashes a plaintext password and checks it against the filter
+func (f *BreachedPasswordFilter) ContainsPassword(password string) bool {
+	return f.ContainsSHA1(sha1.Sum([]byte(password))) // #nosec G401
+}
+
+// Count returns the number of entries inserted at build time
+func (f *BreachedPasswordFilter) Count() uint64 {
+	return f.count
+}
+
+// WriteTo serializes the filter in the on-disk format
+func (f *BreachedPasswordFilter) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var written int64
+
+	header := make([]byte, 0, 32)
+	header = append(header, breachedFilterMagic...)
+	header = binary.LittleEndian.AppendUint32(header, f.k)
+	header = binary.LittleEndian.AppendUint32(header, 0)
+	header = binary.LittleEndian.AppendUint64(header, f.m)
+	header = binary.LittleEndian.AppendUint64(header, f.count)
+	n, err := bw.Write(header)
+	written += int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	var word [8]byte
+	for _, b := range f.bits {
+		binary.LittleEndian.PutUint64(word[:], b)
+		n, err = bw.Write(word[:])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, bw.Flush()
+}
+
+// ReadBreachedPasswordFilter deserializes a filter written by WriteTo
+func ReadBreachedPasswordFilter(r io.Reader) (*BreachedPasswordFilter, error) {
+	br := bufio.NewReader(r)
+
+	header := make([]byte, 32)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	if string(header[0:8]) != breachedFilterMagic {
+		return nil, errors.New("invalid breached password filter file")
+	}
+
+	f := &BreachedPasswordFilter{
+		k:     binary.LittleEndian.Uint32(header[8:12]),
+		m:     binary.LittleEndian.Uint64(header[16:24]),
+		count: binary.LittleEndian.Uint64(header[24:32]),
+	}
+	if f.k == 0 || f.m == 0 || f.m > maxBreachedFilterBits {
+		return nil, errors.New("invalid breached password filter parameters")
+	}
+
+	f.bits = make([]uint64, (f.m+63)/64)
+	var word [8]byte
+	for i := range f.bits {
+		if _, err := io.ReadFull(br, word[:]); err != nil {
+			return nil, fmt.Errorf("read bits: %w", err)
+		}
+		f.bits[i] = binary.LittleEndian.Uint64(word[:])
+	}
+	return f, nil
+}
+
+// LoadBreachedPasswordFilter reads a filter file from disk
+func LoadBreachedPasswordFilter(path string) (*BreachedPasswordFilter, error) {
+	file, err := os.Open(path) // #nosec G304 -- path comes from operator configuration
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadBreachedPasswordFilter(file)
+}
+
+// ParsePwnedPasswordsLine parses a "SHA1HEX:COUNT" line from the Pwned Passwords dump.
+// A bare hash without a count is accepted and reported with count 0.
+func ParsePwnedPasswordsLine(line string) ([sha1.Size]byte, int, error) {
+	var digest [sha1.Size]byte
+
+	line = strings.TrimSpace(line)
+	hashPart, countPart, hasCount := strings.Cut(line, ":")
+	if len(hashPart) != sha1.Size*2 {
+		return digest, 0, fmt.Errorf("invalid SHA-1 hash length: %d", len(hashPart))
+	}
+	if _, err := hex.Decode(digest[:], []byte(hashPart)); err != nil {
+		return digest, 0, fmt.Errorf("invalid SHA-1 hash: %w", err)
+	}
+
+	count := 0
+	if hasCount {
+		c, err := strconv.Atoi(strings.TrimSpace(countPart))
+		if err != nil {
+			return digest, 0, fmt.Errorf("invalid count: %w", err)
+		}
+		count = c
+	}
+	return digest, count, nil
+}
+
+// Global filter used by password validation (nil = screening disabled)
+var breachedPasswordFilter atomic.Pointer[BreachedPasswordFilter]
+
+// SetBreachedPasswordFilter installs the filter used by IsBreachedPassword
+func SetBreachedPasswordFilter(f *BreachedPasswordFilter) {
+	breachedPasswordFilter.Store(f)
+}
+
+// IsBreachedPassword reports whether a password appears in the loaded breach corpus.
+// Returns false when no filter has been loaded.
+func IsBreachedPassword(password string) bool {
+	f := breachedPasswordFilter.Load()
+	if f == nil {
+		return false
+	}
+	return f.ContainsPassword(password)
+}
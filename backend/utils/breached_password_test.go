@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/sha1" // #nosec G505
+	"testing"
+)
+
+func TestBreachedPasswordFilter_RoundTrip(t *testing.T) {
+	f, err := NewBreachedPasswordFilter(100, 0.001)
+	if err != nil {
+		t.Fatalf("NewBreachedPasswordFilter: %v", err)
+	}
+
+	breached := []string{"password123", "qwertyuiop", "iloveyou2024"}
+	for _, p := range breached {
+		f.AddSHA1(sha1.Sum([]byte(p))) // #nosec G401
+	}
+
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	loaded, err := ReadBreachedPasswordFilter(&buf)
+	if err != nil {
+		t.Fatalf("ReadBreachedPasswordFilter: %v", err)
+	}
+
+	if loaded.Count() != uint64(len(breached)) {
+		t.Errorf("Count() = %d, want %d", loaded.Count(), len(breached))
+	}
+	for _, p := range breached {
+		if !loaded.ContainsPassword(p) {
+			t.Errorf("ContainsPassword(%q) = false, want true", p)
+		}
+	}
+	if loaded.ContainsPassword("Tr0ub4dor&3-unique-xyz") {
+		t.Errorf("ContainsPassword returned true for a password that was never added")
+	}
+}
+
+func TestReadBreachedPasswordFilter_InvalidMagic(t *testing.T) {
+	data := make([]byte, 40)
+	copy(data, "NOTAFILT")
+	if _, err := ReadBreachedPasswordFilter(bytes.NewReader(data)); err == nil {
+		t.Error("expected error for invalid magic")
+	}
+}
+
+func TestParsePwnedPasswordsLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantCount int
+		wantErr   bool
+	}{
+		{"Hash with count", "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824", 9545824, false},
+		{"Lowercase hash with CRLF", "5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8:3\r", 3, false},
+		{"Bare hash", "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8", 0, false},
+		{"Short hash", "5BAA61E4:10", 0, true},
+		{"Non-hex hash", "ZZAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:1", 0, true},
+		{"Bad count", "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:abc", 0, true},
+	}
+
+	want := sha1.Sum([]byte("password")) // #nosec G401
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest, count, err := ParsePwnedPasswordsLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePwnedPasswordsLine(%q) error = %v, wantErr %v", tt.line, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if digest != want {
+				t.Errorf("digest = %x, want %x", digest, want)
+			}
+			if count != tt.wantCount {
+				t.Errorf("count = %d, want %d", count, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestIsBreachedPassword(t *testing.T) {
+	t.Cleanup(func() { SetBreachedPasswordFilter(nil) })
+
+	if IsBreachedPassword("password") {
+		t.Error("IsBreachedPassword should be false when no filter is loaded")
+	}
+
+	f, err := NewBreachedPasswordFilter(10, 0.001)
+	if err != nil {
+		t.Fatalf("NewBreachedPasswordFilter: %v", err)
+	}
+	f.AddSHA1(sha1.Sum([]byte("Summer2024!"))) // #nosec G401
+	SetBreachedPasswordFilter(f)
+
+	if !IsBreachedPassword("Summer2024!") {
+		t.Error("IsBreachedPassword(\"Summer2024!\") = false, want true")
+	}
+	if ok, _ := NewInputSecurityValidator().ValidatePassword("Summer2024!"); ok {
+		t.Error("ValidatePassword should reject a breached password")
+	}
+}
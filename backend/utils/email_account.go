@@ -0,0 +1,258 @@
+package utils
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/resend/resend-go/v2"
+)
+
+// frontendBaseURL returns FRONTEND_BASE_URL without trailing slash
+func frontendBaseURL() string {
+	return strings.TrimSuffix(GetEnv("FRONTEND_BASE_URL", "http://localhost:3000"), "/")
+}
+
+// sendTransactionalEmail sends an HTML email via Resend (logs only in dev mode)
+func sendTransactionalEmail(recipientEmail, subject, htmlBody string) error {
+	apiKey := os.Getenv("RESEND_API_KEY")
+	fromEmail := os.Getenv("RESEND_FROM_EMAIL")
+	fromName := os.Getenv("RESEND_FROM_NAME")
+
+	// Fallback to dev mode if no API key configured
+	if apiKey == "" {
+		// Log without exposing token (security)
+		log.Printf("[DEV MODE] Email %q requested for %s (link sent to email)", subject, recipientEmail)
+		return nil
+	}
+
+	if fromName == "" {
+		fromName = "AIValid"
+	}
+	if fromEmail == "" {
+		fromEmail = "onboarding@resend.dev"
+	}
+	formattedFrom := fmt.Sprintf("%s <%s>", fromName, fromEmail)
+
+	client := resend.NewClient(apiKey)
+	params := &resend.SendEmailRequest{
+		From:    formattedFrom,
+		To:      []string{recipientEmail},
+		ReplyTo: fromEmail,
+		Subject: subject,
+		Html:    htmlBody,
+	}
+
+	sent, err := client.Emails.Send(params)
+	if err != nil {
+		log.Printf("Failed to send email %q to %s: %v", subject, recipientEmail, err)
+		return fmt.Errorf("gagal mengirim email")
+	}
+
+	log.Printf("Email %q sent to %s (ID: %s, From: %s)", subject, recipientEmail, sent.Id, formattedFrom)
+	return nil
+}
+
+// SendEmailChangeVerificationEmail sends the confirmation link to the new address
+func SendEmailChangeVerificationEmail(recipientEmail, token string) error {
+	link := frontendBaseURL() + "/account/email/confirm?token=" + token
+	body := buildActionEmailHTML(
+		"Konfirmasi Email Baru",
+		"Kami menerima permintaan untuk mengganti email akun AIValid Anda ke alamat ini. Klik tombol di bawah untuk mengonfirmasi:",
+		"Konfirmasi Email",
+		link,
+		"#3b82f6",
+		"Link ini akan kedaluwarsa dalam <strong>24 jam</strong>. Jika Anda tidak meminta perubahan ini, abaikan email ini.",
+	)
+	return sendTransactionalEmail(recipientEmail, "Konfirmasi Perubahan Email - AIValid", body)
+}
+
+// SendEmailChangedNoticeEmail notifies the previous address and offers a revert link
+func SendEmailChangedNoticeEmail(recipientEmail, newEmail, revertToken string, validDays int) error {
+	link := frontendBaseURL() + "/account/email/revert?token=" + revertToken
+	body := buildActionEmailHTML(
+		"Email Akun Anda Telah Diubah",
+		fmt.Sprintf("Email akun AIValid Anda baru saja diubah menjadi <strong>%s</strong>. Jika ini bukan Anda, klik tombol di bawah untuk mengembalikan email lama dan mengeluarkan semua sesi:", html.EscapeString(newEmail)),
+		"Batalkan Perubahan",
+		link,
+		"#dc2626",
+		fmt.Sprintf("Link ini berlaku selama <strong>%d hari</strong>. Setelah membatalkan, segera reset password Anda.", validDays),
+	)
+	return sendTransactionalEmail(recipientEmail, "Email Akun Diubah - AIValid", body)
+}
+
+// LoginAlertDetails describes the sign-in shown in a security alert email
+type LoginAlertDetails struct {
+	Kind     string // "new_device" or "suspicious_login"
+	Device   string
+	Location string
+	IP       string
+	Time     string
+}
+
+// SendLoginAlertEmail tells the user about a new-device or suspicious sign-in and offers a
+// one-click "this wasn't me" link that signs out everywhere and locks the account
+func SendLoginAlertEmail(recipientEmail, killToken string, d LoginAlertDetails) error {
+	link := frontendBaseURL() + "/security/not-me?token=" + killToken
+
+	title := "Login dari Perangkat Baru"
+	subject := "Login dari Perangkat Baru - AIValid"
+	lead := "Akun AIValid Anda baru saja digunakan untuk login dari perangkat yang belum pernah dipakai sebelumnya."
+	if d.Kind == "suspicious_login" {
+		title = "Aktivitas Login Mencurigakan"
+		subject = "Aktivitas Login Mencurigakan - AIValid"
+		lead = "Kami mendeteksi login dari lokasi yang tidak mungkin dijangkau dalam waktu singkat. Demi keamanan, akun Anda telah dikunci sementara."
+	}
+
+	intro := fmt.Sprintf(`%s<br><br>
+<strong>Perangkat:</strong> %s<br>
+<strong>Perkiraan lokasi:</strong> %s<br>
+<strong>Alamat IP:</strong> %s<br>
+<strong>Waktu:</strong> %s<br><br>
+Jika ini Anda, abaikan email ini. Jika bukan, klik tombol di bawah untuk mengeluarkan semua sesi dan mengunci akun:`,
+		lead,
+		html.EscapeString(d.Device),
+		html.EscapeString(d.Location),
+		html.EscapeString(d.IP),
+		html.EscapeString(d.Time),
+	)
+
+	body := buildActionEmailHTML(
+		title,
+		intro,
+		"Ini Bukan Saya",
+		link,
+		"#dc2626",
+		"Setelah tombol diklik, akun akan tetap terkunci sampai Anda mengatur ulang password melalui link yang kami kirim terpisah.",
+	)
+	return sendTransactionalEmail(recipientEmail, subject, body)
+}
+
+// SendLoginStepUpCodeEmail sends the one-time code that confirms a login flagged as risky
+func SendLoginStepUpCodeEmail(recipientEmail, code string, validMinutes int) error {
+	body := buildCodeEmailHTML(
+		"Kode Verifikasi Login",
+		"Kami mendeteksi login ke akun AIValid Anda dari perangkat atau lokasi yang tidak biasa. Masukkan kode berikut untuk melanjutkan login:",
+		code,
+		fmt.Sprintf("Kode ini berlaku selama <strong>%d menit</strong>. Jika Anda tidak sedang login, segera ganti password Anda.", validMinutes),
+	)
+	return sendTransactionalEmail(recipientEmail, "Kode Verifikasi Login - AIValid", body)
+}
+
+// buildCodeEmailHTML renders a one-time code email in the standard layout
+func buildCodeEmailHTML(title, intro, code, note string) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>%[1]s</title>
+</head>
+<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; background-color: #f5f5f5;">
+    <table role="presentation" style="width: 100%%; border-collapse: collapse;">
+        <tr>
+            <td align="center" style="padding: 40px 0;">
+                <table role="presentation" style="width: 600px; max-width: 100%%; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+                    <tr>
+                        <td style="padding: 40px 40px 20px; text-align: center; border-bottom: 1px solid #e5e7eb;">
+                            <h1 style="margin: 0; font-size: 28px; font-weight: 600; color: #111827;">
+                                %[1]s
+                            </h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 40px;">
+                            <p style="margin: 0 0 20px; font-size: 16px; line-height: 1.6; color: #374151;">
+                                %[2]s
+                            </p>
+                            <p style="margin: 30px 0; text-align: center; font-size: 32px; font-weight: 600; letter-spacing: 8px; color: #111827;">
+                                %[3]s
+                            </p>
+                            <p style="margin: 20px 0 0; font-size: 14px; line-height: 1.6; color: #6b7280;">
+                                %[4]s
+                            </p>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 30px 40px; background-color: #f9fafb; border-top: 1px solid #e5e7eb; border-radius: 0 0 8px 8px;">
+                            <p style="margin: 0; font-size: 13px; line-height: 1.6; color: #6b7280; text-align: center;">
+                                © 2026 AIValid. All rights reserved.
+                            </p>
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`, title, intro, code, note)
+}
+
+// buildActionEmailHTML renders a single call-to-action email in the standard layout
+func buildActionEmailHTML(title, intro, buttonLabel, link, buttonColor, note string) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>%[1]s</title>
+</head>
+<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; background-color: #f5f5f5;">
+    <table role="presentation" style="width: 100%%; border-collapse: collapse;">
+        <tr>
+            <td align="center" style="padding: 40px 0;">
+                <table role="presentation" style="width: 600px; max-width: 100%%; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1);">
+                    <tr>
+                        <td style="padding: 40px 40px 20px; text-align: center; border-bottom: 1px solid #e5e7eb;">
+                            <h1 style="margin: 0; font-size: 28px; font-weight: 600; color: #111827;">
+                                %[1]s
+                            </h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 40px;">
+                            <p style="margin: 0 0 20px; font-size: 16px; line-height: 1.6; color: #374151;">
+                                %[2]s
+                            </p>
+                            <table role="presentation" style="margin: 30px 0; width: 100%%;">
+                                <tr>
+                                    <td align="center">
+                                        <a href="%[4]s" style="display: inline-block; padding: 14px 32px; background-color: %[5]s; color: #ffffff; text-decoration: none; border-radius: 6px; font-weight: 500; font-size: 16px;">
+                                            %[3]s
+                                        </a>
+                                    </td>
+                                </tr>
+                            </table>
+                            <p style="margin: 20px 0 0; font-size: 14px; line-height: 1.6; color: #6b7280;">
+                                %[6]s
+                            </p>
+                            <div style="margin-top: 30px; padding-top: 20px; border-top: 1px solid #e5e7eb;">
+                                <p style="margin: 0 0 10px; font-size: 13px; color: #6b7280;">
+                                    Jika tombol tidak bekerja, salin dan tempel link berikut ke browser:
+                                </p>
+                                <p style="margin: 0; font-size: 12px; color: %[5]s; word-break: break-all;">
+                                    <a href="%[4]s" style="color: %[5]s;">%[4]s</a>
+                                </p>
+                            </div>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 30px 40px; background-color: #f9fafb; border-top: 1px solid #e5e7eb; border-radius: 0 0 8px 8px;">
+                            <p style="margin: 0; font-size: 13px; line-height: 1.6; color: #6b7280; text-align: center;">
+                                © 2026 AIValid. All rights reserved.
+                            </p>
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>
+`, title, intro, buttonLabel, link, buttonColor, note)
+}
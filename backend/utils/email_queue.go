@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -14,6 +15,10 @@ type EmailType int
 const (
 	EmailTypeVerification EmailType = iota
 	EmailTypePasswordReset
+	EmailTypeEmailChangeVerification
+	EmailTypeEmailChangedNotice
+	EmailTypeLoginAlert
+	EmailTypeLoginStepUpCode
 )
 
 // EmailJob represents an email job to be processed
@@ -21,6 +26,7 @@ type EmailJob struct {
 	Type      EmailType
 	Recipient string
 	Token     string
+	Params    map[string]string // Extra template values (e.g. new_email, valid_days)
 	Retries   int
 	CreatedAt time.Time
 }
@@ -127,6 +133,22 @@ func (q *EmailQueue) processJob(workerID int, job EmailJob) {
 			err = sendVerificationEmailDirect(job.Recipient, job.Token)
 		case EmailTypePasswordReset:
 			err = sendPasswordResetEmailDirect(job.Recipient, job.Token)
+		case EmailTypeEmailChangeVerification:
+			err = SendEmailChangeVerificationEmail(job.Recipient, job.Token)
+		case EmailTypeEmailChangedNotice:
+			days, _ := strconv.Atoi(job.Params["valid_days"])
+			err = SendEmailChangedNoticeEmail(job.Recipient, job.Params["new_email"], job.Token, days)
+		case EmailTypeLoginAlert:
+			err = SendLoginAlertEmail(job.Recipient, job.Token, LoginAlertDetails{
+				Kind:     job.Params["kind"],
+				Device:   job.Params["device"],
+				Location: job.Params["location"],
+				IP:       job.Params["ip"],
+				Time:     job.Params["time"],
+			})
+		case EmailTypeLoginStepUpCode:
+			minutes, _ := strconv.Atoi(job.Params["valid_minutes"])
+			err = SendLoginStepUpCodeEmail(job.Recipient, job.Token, minutes)
 		default:
 			log.Printf("[EmailQueue] Worker %d: Unknown email type %d", workerID, job.Type)
 			return
@@ -174,6 +196,56 @@ func QueuePasswordResetEmail(recipientEmail, resetToken string) error {
 	})
 }
 
+// QueueEmailChangeVerificationEmail queues the confirmation link for a new email address
+func QueueEmailChangeVerificationEmail(newEmail, token string) error {
+	return GetEmailQueue().Enqueue(EmailJob{
+		Type:      EmailTypeEmailChangeVerification,
+		Recipient: newEmail,
+		Token:     token,
+	})
+}
+
+// QueueEmailChangedNoticeEmail queues the change notice (with revert link) for the previous address
+func QueueEmailChangedNoticeEmail(oldEmail, newEmail, revertToken string, validDays int) error {
+	return GetEmailQueue().Enqueue(EmailJob{
+		Type:      EmailTypeEmailChangedNotice,
+		Recipient: oldEmail,
+		Token:     revertToken,
+		Params: map[string]string{
+			"new_email":  newEmail,
+			"valid_days": strconv.Itoa(validDays),
+		},
+	})
+}
+
+// QueueLoginAlertEmail queues a new-device or suspicious-login alert with a kill-switch link
+func QueueLoginAlertEmail(recipientEmail, killToken string, d LoginAlertDetails) error {
+	return GetEmailQueue().Enqueue(EmailJob{
+		Type:      EmailTypeLoginAlert,
+		Recipient: recipientEmail,
+		Token:     killToken,
+		Params: map[string]string{
+			"kind":     d.Kind,
+			"device":   d.Device,
+			"location": d.Location,
+			"ip":       d.IP,
+			"time":     d.Time,
+		},
+	})
+}
+
+// QueueLoginStepUpCodeEmail queues the one-time code for a risk step-up login
+func QueueLoginStepUpCodeEmail(recipientEmail, code string, validMinutes int) error {
+	return GetEmailQueue().Enqueue(EmailJob{
+		Type:      EmailTypeLoginStepUpCode,
+		Recipient: recipientEmail,
+		Token:     code,
+		Params: map[string]string{
+			"valid_minutes": strconv.Itoa(validMinutes),
+		},
+	})
+}
+
 // sendVerificationEmailDirect is the actual email sending implementation
 // This is called by the worker and handles the actual Resend API call
 func sendVerificationEmailDirect(recipientEmail, verificationToken string) error {
@@ -661,6 +661,11 @@ func (v *InputSecurityValidator) ValidatePassword(password string) (bool, string
 		}
 	}
 
+	// Check against offline breached-password corpus (if loaded)
+	if IsBreachedPassword(password) {
+		return false, "this password has appeared in a known data breach"
+	}
+
 	// Check character requirements
 	var (
 		hasUpper   bool
@@ -0,0 +1,93 @@
+package utils
+
+import "strings"
+
+// UserAgentInfo is a coarse, human-readable summary of a User-Agent header.
+// It is meant for security notifications and device lists, not analytics.
+type UserAgentInfo struct {
+	Browser    string `json:"browser"`
+	OS         string `json:"os"`
+	DeviceType string `json:"device_type"` // desktop, mobile, tablet, bot, unknown
+}
+
+// uaRule maps a User-Agent substring to a display name; order matters (first match wins)
+type uaRule struct {
+	token string
+	name  string
+}
+
+// Edge/Opera/Samsung embed "Chrome", and Chrome embeds "Safari", so specific tokens come first
+var uaBrowserRules = []uaRule{
+	{"edg/", "Edge"},
+	{"edga/", "Edge"},
+	{"edgios/", "Edge"},
+	{"opr/", "Opera"},
+	{"samsungbrowser/", "Samsung Internet"},
+	{"firefox/", "Firefox"},
+	{"fxios/", "Firefox"},
+	{"crios/", "Chrome"},
+	{"chrome/", "Chrome"},
+	{"safari/", "Safari"},
+	{"curl/", "curl"},
+	{"okhttp/", "OkHttp"},
+	{"postmanruntime/", "Postman"},
+}
+
+// Android UAs contain "Linux" and iPad UAs may contain "Mac OS X", so they come first
+var uaOSRules = []uaRule{
+	{"android", "Android"},
+	{"iphone", "iOS"},
+	{"ipad", "iPadOS"},
+	{"windows", "Windows"},
+	{"cros", "ChromeOS"},
+	{"mac os x", "macOS"},
+	{"macintosh", "macOS"},
+	{"linux", "Linux"},
+}
+
+// ParseUserAgent extracts browser, OS and device type from a User-Agent header
+func ParseUserAgent(ua string) UserAgentInfo {
+	info := UserAgentInfo{Browser: "Unknown", OS: "Unknown", DeviceType: "unknown"}
+	lower := strings.ToLower(strings.TrimSpace(ua))
+	if lower == "" {
+		return info
+	}
+
+	for _, r := range uaBrowserRules {
+		if strings.Contains(lower, r.token) {
+			info.Browser = r.name
+			break
+		}
+	}
+	for _, r := range uaOSRules {
+		if strings.Contains(lower, r.token) {
+			info.OS = r.name
+			break
+		}
+	}
+
+	switch {
+	case strings.Contains(lower, "bot") || strings.Contains(lower, "spider") || strings.Contains(lower, "crawl"):
+		info.DeviceType = "bot"
+	case strings.Contains(lower, "ipad") || strings.Contains(lower, "tablet"):
+		info.DeviceType = "tablet"
+	case strings.Contains(lower, "mobi") || strings.Contains(lower, "iphone") || strings.Contains(lower, "android"):
+		info.DeviceType = "mobile"
+	case info.OS != "Unknown":
+		info.DeviceType = "desktop"
+	}
+	return info
+}
+
+// String renders the summary as "Browser di OS" for user-facing text
+func (i UserAgentInfo) String() string {
+	switch {
+	case i.Browser == "Unknown" && i.OS == "Unknown":
+		return "Perangkat tidak dikenal"
+	case i.OS == "Unknown":
+		return i.Browser
+	case i.Browser == "Unknown":
+		return i.OS
+	}
+	return i.Browser + " di " + i.OS
+}
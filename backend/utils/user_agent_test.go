@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		name     string
+		ua       string
+		expected UserAgentInfo
+	}{
+		{
+			name:     "Chrome on Windows",
+			ua:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+			expected: UserAgentInfo{Browser: "Chrome", OS: "Windows", DeviceType: "desktop"},
+		},
+		{
+			name:     "Edge is not reported as Chrome",
+			ua:       "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.80",
+			expected: UserAgentInfo{Browser: "Edge", OS: "Windows", DeviceType: "desktop"},
+		},
+		{
+			name:     "Safari on iPhone",
+			ua:       "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1",
+			expected: UserAgentInfo{Browser: "Safari", OS: "iOS", DeviceType: "mobile"},
+		},
+		{
+			name:     "Chrome on Android",
+			ua:       "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36",
+			expected: UserAgentInfo{Browser: "Chrome", OS: "Android", DeviceType: "mobile"},
+		},
+		{
+			name:     "Firefox on macOS",
+			ua:       "Mozilla/5.0 (Macintosh; Intel Mac OS X 14.4; rv:125.0) Gecko/20100101 Firefox/125.0",
+			expected: UserAgentInfo{Browser: "Firefox", OS: "macOS", DeviceType: "desktop"},
+		},
+		{
+			name:     "Empty",
+			ua:       "",
+			expected: UserAgentInfo{Browser: "Unknown", OS: "Unknown", DeviceType: "unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseUserAgent(tt.ua))
+		})
+	}
+}
+
+func TestUserAgentInfoString(t *testing.T) {
+	assert.Equal(t, "Chrome di Windows", UserAgentInfo{Browser: "Chrome", OS: "Windows"}.String())
+	assert.Equal(t, "curl", UserAgentInfo{Browser: "curl", OS: "Unknown"}.String())
+	assert.Equal(t, "Perangkat tidak dikenal", ParseUserAgent("").String())
+}
@@ -37,6 +37,9 @@ func ValidatePassword(password string) error {
 	if len(password) < 8 {
 		return apperrors.ErrWeakPassword.WithDetails("Password minimal 8 karakter")
 	}
+	if utils.IsBreachedPassword(password) {
+		return apperrors.ErrBreachedPassword
+	}
 	return nil
 }
 
@@ -479,7 +479,7 @@ func ParseStructuredIntakeContent(content interface{}) (*StructuredIntake, error
 	if caseRecord == "" {
 		return nil, apperrors.ErrMissingField.WithDetails("content.case_record_text")
 	}
-	if containsBlockedContactHint(caseRecord) {
+	if ContainsBlockedContactHint(caseRecord) {
 		return nil, apperrors.ErrInvalidInput.WithDetails("Case Record tidak boleh memuat detail kontak langsung. Gunakan protocol consultation.")
 	}
 	intake.CaseRecord = caseRecord
@@ -852,7 +852,7 @@ func optionalSanitizedText(input map[string]interface{}, keys []string, label st
 	return "", nil
 }
 
-func containsBlockedContactHint(s string) bool {
+func ContainsBlockedContactHint(s string) bool {
 	lower := strings.ToLower(s)
 	blocked := []string{"t.me/", "telegram.me/", "whatsapp", "wa.me/", "line.me/", "discord.gg/", "kontak langsung"}
 	for _, needle := range blocked {
@@ -1,6 +1,9 @@
+using System.Security.Cryptography;
+using System.Text;
 using FeatureService.Api.Attributes;
 using FeatureService.Api.DTOs;
 using FeatureService.Api.Infrastructure.Auth;
+using FeatureService.Api.Models.Entities;
 using FeatureService.Api.Services;
 using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
@@ -15,6 +18,8 @@ public class GuaranteesController : ApiControllerBase
     private const string ExistingActiveGuaranteeMessage = "Anda sudah memiliki jaminan aktif. Lepaskan terlebih dahulu.";
     private const string NoActiveGuaranteeMessage = "Tidak ada jaminan aktif";
     private const string GuaranteeProcessedByAnotherRequestMessage = "Jaminan sudah diproses oleh request lain";
+    private const string SlashIdempotencyKeyReusedMessage = "Idempotency-Key sudah dipakai untuk slash jaminan lain";
+    private const int MaxSlashIdempotencyKeyLength = 191;
 
     private readonly IGuaranteeService _guaranteeService;
     private readonly IUserContextAccessor _userContextAccessor;
@@ -231,6 +236,93 @@ public class GuaranteesController : ApiControllerBase
         }
     }
 
+    /// <summary>
+    /// Internal: slash a validator's active guarantee after a lost dispute.
+    /// Service token only; a repeated Idempotency-Key replays the original slash.
+    /// </summary>
+    [HttpPost("internal/slash")]
+    [AllowAnonymous]
+    [ProducesResponseType(typeof(ApiResponse<SlashGuaranteeResponse>), 200)]
+    [ProducesResponseType(typeof(ApiErrorResponse), 400)]
+    [ProducesResponseType(typeof(ApiErrorResponse), 401)]
+    [ProducesResponseType(typeof(ApiErrorResponse), 409)]
+    public async Task<IActionResult> SlashGuarantee(
+        [FromBody] SlashGuaranteeRequest request,
+        [FromHeader(Name = "X-Service-Token")] string? serviceToken,
+        [FromHeader(Name = "Idempotency-Key")] string? idempotencyKey)
+    {
+        if (!HasValidServiceToken(serviceToken))
+        {
+            _logger.LogWarning("Unauthorized guarantee slash: missing or invalid service token");
+            return ApiUnauthorized("Service token tidak valid");
+        }
+
+        if (!ModelState.IsValid)
+        {
+            return ApiBadRequest("VALIDATION_ERROR", "Data tidak valid");
+        }
+
+        if (string.IsNullOrWhiteSpace(idempotencyKey) || idempotencyKey.Trim().Length > MaxSlashIdempotencyKeyLength)
+        {
+            return ApiBadRequest("Idempotency-Key wajib diisi");
+        }
+
+        try
+        {
+            var (slash, replayed) = await _guaranteeService.SlashGuaranteeAsync(
+                idempotencyKey,
+                request.UserId,
+                request.BeneficiaryUserId,
+                request.Amount,
+                request.Reason,
+                request.ReferenceId,
+                request.ReferenceType);
+
+            return ApiOk(new SlashGuaranteeResponse(
+                slash.Id,
+                slash.GuaranteeId,
+                slash.UserId,
+                slash.BeneficiaryUserId,
+                slash.SlashedAmount,
+                slash.RemainingAmount,
+                slash.RemainingAmount > 0 ? GuaranteeStatus.Active : GuaranteeStatus.Released,
+                slash.CreditTransactionId,
+                replayed
+            ));
+        }
+        catch (ArgumentException ex)
+        {
+            return ApiBadRequest(ex.Message);
+        }
+        catch (InvalidOperationException ex)
+        {
+            if (IsGuaranteeConflict(ex.Message))
+            {
+                return ApiConflict(ex.Message);
+            }
+
+            return ApiBadRequest(ex.Message);
+        }
+        catch (Exception ex)
+        {
+            _logger.LogError(ex, "Error slashing guarantee for user {UserId}", request.UserId);
+            return ApiInternalError("Gagal memproses slash jaminan");
+        }
+    }
+
+    private static bool HasValidServiceToken(string? serviceToken)
+    {
+        var configured = Environment.GetEnvironmentVariable("SERVICE_TOKEN") ?? "";
+        if (string.IsNullOrEmpty(configured) || string.IsNullOrEmpty(serviceToken))
+        {
+            return false;
+        }
+
+        return CryptographicOperations.FixedTimeEquals(
+            Encoding.UTF8.GetBytes(serviceToken),
+            Encoding.UTF8.GetBytes(configured));
+    }
+
     private static bool IsGuaranteeConflict(string? message)
     {
         if (string.IsNullOrWhiteSpace(message))
@@ -241,6 +333,7 @@ public class GuaranteesController : ApiControllerBase
         return string.Equals(message, ExistingActiveGuaranteeMessage, StringComparison.Ordinal)
                || string.Equals(message, NoActiveGuaranteeMessage, StringComparison.Ordinal)
                || string.Equals(message, GuaranteeProcessedByAnotherRequestMessage, StringComparison.Ordinal)
+               || string.Equals(message, SlashIdempotencyKeyReusedMessage, StringComparison.Ordinal)
                || message.StartsWith("Jaminan tidak bisa dilepas karena", StringComparison.Ordinal);
     }
 }
@@ -32,3 +32,38 @@ public class ReleaseGuaranteeRequest
     public string Pin { get; set; } = string.Empty;
 }
 
+
+public class SlashGuaranteeRequest
+{
+    [Range(1, uint.MaxValue, ErrorMessage = "User ID tidak valid")]
+    public uint UserId { get; set; }
+
+    /// <summary>Wallet credited with the slashed amount (the case owner who won the dispute).</summary>
+    [Range(1, uint.MaxValue, ErrorMessage = "Penerima slash tidak valid")]
+    public uint BeneficiaryUserId { get; set; }
+
+    [Range(1, long.MaxValue, ErrorMessage = "Jumlah slash harus lebih dari 0")]
+    public long Amount { get; set; }
+
+    [Required(ErrorMessage = "Alasan wajib diisi")]
+    [StringLength(500, ErrorMessage = "Alasan maksimal 500 karakter")]
+    public string Reason { get; set; } = string.Empty;
+
+    [StringLength(128)]
+    public string? ReferenceId { get; set; }
+
+    [StringLength(64)]
+    public string? ReferenceType { get; set; }
+}
+
+public record SlashGuaranteeResponse(
+    string SlashId,
+    string GuaranteeId,
+    uint UserId,
+    uint BeneficiaryUserId,
+    long SlashedAmount,
+    long RemainingAmount,
+    GuaranteeStatus Status,
+    string? CreditTransactionId,
+    bool Replayed
+);
@@ -55,6 +55,7 @@ public class MongoDbContext
     public IMongoCollection<Dispute> Disputes => _database.GetCollection<Dispute>("disputes");
     public IMongoCollection<Withdrawal> Withdrawals => _database.GetCollection<Withdrawal>("withdrawals");
     public IMongoCollection<GuaranteeLock> GuaranteeLocks => _database.GetCollection<GuaranteeLock>("guarantee_locks");
+    public IMongoCollection<GuaranteeSlash> GuaranteeSlashes => _database.GetCollection<GuaranteeSlash>("guarantee_slashes");
     public IMongoCollection<MarketPurchaseReservation> MarketPurchaseReservations => _database.GetCollection<MarketPurchaseReservation>("market_purchase_reservations");
 
     #endregion
@@ -226,6 +227,39 @@ public class MongoDbContext
                 })
         });
 
+        GuaranteeSlashes.Indexes.CreateOne(new CreateIndexModel<GuaranteeSlash>(
+            Builders<GuaranteeSlash>.IndexKeys.Ascending(s => s.UserId).Descending(s => s.CreatedAt)));
+
+        try
+        {
+            GuaranteeSlashes.Indexes.CreateOne(new CreateIndexModel<GuaranteeSlash>(
+                Builders<GuaranteeSlash>.IndexKeys.Ascending(s => s.IdempotencyKey),
+                new CreateIndexOptions { Unique = true }
+            ));
+        }
+        catch (Exception ex)
+        {
+            _logger.LogWarning(ex, "Failed to create unique index for guarantee slash idempotencyKey");
+        }
+
+        try
+        {
+            // A slash is credited at most once, even when a pending slash is replayed concurrently.
+            Transactions.Indexes.CreateOne(new CreateIndexModel<Transaction>(
+                Builders<Transaction>.IndexKeys.Ascending(t => t.ReferenceId),
+                new CreateIndexOptions<Transaction>
+                {
+                    Unique = true,
+                    Name = "referenceId_guarantee_slash_unique",
+                    PartialFilterExpression = Builders<Transaction>.Filter.Eq(t => t.Type, TransactionType.GuaranteeSlash)
+                }
+            ));
+        }
+        catch (Exception ex)
+        {
+            _logger.LogWarning(ex, "Failed to create unique index for guarantee slash credit transactions");
+        }
+
         // Dispute indexes
         Disputes.Indexes.CreateMany(new[]
         {
@@ -27,6 +27,12 @@ public class GuaranteeLock
     [BsonElement("releasedAt")]
     [BsonIgnoreIfNull]
     public DateTime? ReleasedAt { get; set; }
+
+    // Id of the last GuaranteeSlash applied to this lock; lets a retried slash detect
+    // that its deduction already landed.
+    [BsonElement("lastSlashId")]
+    [BsonIgnoreIfNull]
+    public string? LastSlashId { get; set; }
 }
 
 public enum GuaranteeStatus
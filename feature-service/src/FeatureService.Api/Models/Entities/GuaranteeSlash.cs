@@ -0,0 +1,72 @@
+using MongoDB.Bson;
+using MongoDB.Bson.Serialization.Attributes;
+
+namespace FeatureService.Api.Models.Entities;
+
+/// <summary>
+/// A deduction from an active guarantee requested by the Go backend after a lost dispute.
+/// The slashed amount is credited to the beneficiary's wallet (the case owner who won the
+/// dispute) as a GuaranteeSlash transaction. The unique IdempotencyKey makes a retried slash
+/// replay this record instead of deducting twice.
+/// </summary>
+public class GuaranteeSlash
+{
+    [BsonId]
+    [BsonRepresentation(BsonType.String)]
+    public string Id { get; set; } = string.Empty; // gsl_xxx format using Ulid
+
+    [BsonElement("idempotencyKey")]
+    public string IdempotencyKey { get; set; } = string.Empty;
+
+    [BsonElement("userId")]
+    public uint UserId { get; set; }
+
+    [BsonElement("beneficiaryUserId")]
+    public uint BeneficiaryUserId { get; set; }
+
+    [BsonElement("guaranteeId")]
+    public string GuaranteeId { get; set; } = string.Empty;
+
+    [BsonElement("status")]
+    public string Status { get; set; } = GuaranteeSlashStatus.Pending;
+
+    [BsonElement("previousAmount")]
+    public long PreviousAmount { get; set; }
+
+    [BsonElement("requestedAmount")]
+    public long RequestedAmount { get; set; }
+
+    [BsonElement("slashedAmount")]
+    public long SlashedAmount { get; set; }
+
+    [BsonElement("remainingAmount")]
+    public long RemainingAmount { get; set; }
+
+    [BsonElement("reason")]
+    public string Reason { get; set; } = string.Empty;
+
+    [BsonElement("referenceId")]
+    [BsonIgnoreIfNull]
+    public string? ReferenceId { get; set; }
+
+    [BsonElement("referenceType")]
+    [BsonIgnoreIfNull]
+    public string? ReferenceType { get; set; }
+
+    [BsonElement("createdAt")]
+    public DateTime CreatedAt { get; set; } = DateTime.UtcNow;
+
+    [BsonElement("creditTransactionId")]
+    [BsonIgnoreIfNull]
+    public string? CreditTransactionId { get; set; }
+
+    [BsonElement("appliedAt")]
+    [BsonIgnoreIfNull]
+    public DateTime? AppliedAt { get; set; }
+}
+
+public static class GuaranteeSlashStatus
+{
+    public const string Pending = "pending";
+    public const string Applied = "applied";
+}
@@ -58,5 +58,6 @@ public enum TransactionType
     GuaranteeLock = 10,    // Money frozen for profile guarantee
     GuaranteeRelease = 11, // Guarantee released back to wallet
     MarketPurchaseReserve = 12, // Reserve wallet balance for market purchase
-    MarketPurchaseRelease = 13  // Release reserved balance back to wallet
+    MarketPurchaseRelease = 13, // Release reserved balance back to wallet
+    GuaranteeSlash = 14         // Slashed guarantee credited to the dispute winner
 }
@@ -14,6 +14,14 @@ public interface IGuaranteeService
     Task<GuaranteeLock> SetGuaranteeAsync(uint userId, long amount, string pin);
     Task<GuaranteeLock> ReleaseGuaranteeAsync(uint userId, string pin);
     Task SyncGuaranteeAmountAsync(uint userId, long amount);
+    Task<(GuaranteeSlash Slash, bool Replayed)> SlashGuaranteeAsync(
+        string idempotencyKey,
+        uint userId,
+        uint beneficiaryUserId,
+        long amount,
+        string reason,
+        string? referenceId,
+        string? referenceType);
 }
 
 public class GuaranteeService : IGuaranteeService
@@ -215,6 +223,205 @@ public class GuaranteeService : IGuaranteeService
         await BestEffortSyncGuaranteeAmountAsync(userId, amount);
     }
 
+    public async Task<(GuaranteeSlash Slash, bool Replayed)> SlashGuaranteeAsync(
+        string idempotencyKey,
+        uint userId,
+        uint beneficiaryUserId,
+        long amount,
+        string reason,
+        string? referenceId,
+        string? referenceType)
+    {
+        idempotencyKey = idempotencyKey?.Trim() ?? string.Empty;
+        if (idempotencyKey.Length == 0)
+        {
+            throw new ArgumentException("Idempotency-Key wajib diisi", nameof(idempotencyKey));
+        }
+        if (amount <= 0)
+        {
+            throw new ArgumentException("Jumlah slash harus lebih dari 0", nameof(amount));
+        }
+        if (beneficiaryUserId == 0 || beneficiaryUserId == userId)
+        {
+            throw new ArgumentException("Penerima slash tidak valid", nameof(beneficiaryUserId));
+        }
+
+        var existing = await FindGuaranteeSlashAsync(idempotencyKey);
+        if (existing != null)
+        {
+            return (await ReplayGuaranteeSlashAsync(existing, userId, beneficiaryUserId), true);
+        }
+
+        var active = await GetActiveGuaranteeAsync(userId);
+        if (active == null)
+        {
+            throw new InvalidOperationException("Tidak ada jaminan aktif");
+        }
+
+        var slashed = Math.Min(amount, active.Amount);
+        var slash = new GuaranteeSlash
+        {
+            Id = $"gsl_{Ulid.NewUlid()}",
+            IdempotencyKey = idempotencyKey,
+            UserId = userId,
+            BeneficiaryUserId = beneficiaryUserId,
+            GuaranteeId = active.Id,
+            Status = GuaranteeSlashStatus.Pending,
+            PreviousAmount = active.Amount,
+            RequestedAmount = amount,
+            SlashedAmount = slashed,
+            RemainingAmount = active.Amount - slashed,
+            Reason = reason.Trim(),
+            ReferenceId = string.IsNullOrWhiteSpace(referenceId) ? null : referenceId.Trim(),
+            ReferenceType = string.IsNullOrWhiteSpace(referenceType) ? null : referenceType.Trim(),
+            CreatedAt = DateTime.UtcNow
+        };
+
+        // Claim the idempotency key before touching the guarantee so a concurrent retry
+        // replays this record instead of deducting a second time.
+        try
+        {
+            await _context.GuaranteeSlashes.InsertOneAsync(slash);
+        }
+        catch (MongoWriteException ex) when (ex.WriteError.Category == ServerErrorCategory.DuplicateKey)
+        {
+            var raced = await FindGuaranteeSlashAsync(idempotencyKey);
+            if (raced == null)
+            {
+                throw;
+            }
+            return (await ReplayGuaranteeSlashAsync(raced, userId, beneficiaryUserId), true);
+        }
+
+        await ApplyGuaranteeSlashAsync(slash);
+        return (slash, false);
+    }
+
+    private async Task<GuaranteeSlash?> FindGuaranteeSlashAsync(string idempotencyKey)
+    {
+        return await _context.GuaranteeSlashes
+            .Find(s => s.IdempotencyKey == idempotencyKey)
+            .FirstOrDefaultAsync();
+    }
+
+    private async Task<GuaranteeSlash> ReplayGuaranteeSlashAsync(GuaranteeSlash slash, uint userId, uint beneficiaryUserId)
+    {
+        if (slash.UserId != userId || slash.BeneficiaryUserId != beneficiaryUserId)
+        {
+            throw new InvalidOperationException("Idempotency-Key sudah dipakai untuk slash jaminan lain");
+        }
+
+        // A pending record means the previous attempt stopped before crediting the beneficiary
+        // (possibly before deducting the guarantee); finish it now.
+        if (slash.Status == GuaranteeSlashStatus.Pending)
+        {
+            await ApplyGuaranteeSlashAsync(slash);
+        }
+        return slash;
+    }
+
+    private async Task ApplyGuaranteeSlashAsync(GuaranteeSlash slash)
+    {
+        var now = DateTime.UtcNow;
+        var filter = Builders<GuaranteeLock>.Filter.And(
+            Builders<GuaranteeLock>.Filter.Eq(g => g.Id, slash.GuaranteeId),
+            Builders<GuaranteeLock>.Filter.Eq(g => g.Status, GuaranteeStatus.Active),
+            Builders<GuaranteeLock>.Filter.Eq(g => g.Amount, slash.PreviousAmount));
+
+        var update = Builders<GuaranteeLock>.Update
+            .Set(g => g.Amount, slash.RemainingAmount)
+            .Set(g => g.LastSlashId, slash.Id)
+            .Set(g => g.UpdatedAt, now);
+        if (slash.RemainingAmount == 0)
+        {
+            update = update
+                .Set(g => g.Status, GuaranteeStatus.Released)
+                .Set(g => g.ReleasedAt, now);
+        }
+
+        var result = await _context.GuaranteeLocks.UpdateOneAsync(filter, update);
+        if (result.ModifiedCount == 0)
+        {
+            var current = await _context.GuaranteeLocks
+                .Find(g => g.Id == slash.GuaranteeId)
+                .FirstOrDefaultAsync();
+            if (current?.LastSlashId != slash.Id)
+            {
+                // The guarantee changed under us (release, top-up or another slash). Drop the
+                // claim so the caller's retry recomputes against the current guarantee.
+                await _context.GuaranteeSlashes.DeleteOneAsync(s => s.Id == slash.Id);
+                throw new InvalidOperationException("Jaminan sudah diproses oleh request lain");
+            }
+        }
+
+        // The slashed funds left the validator's wallet when the guarantee was locked; they
+        // move to the beneficiary's wallet so the ledger still accounts for them. A failure
+        // here leaves the slash pending, and the caller's retry replays it.
+        var creditTransactionId = await CreditGuaranteeSlashAsync(slash);
+
+        await _context.GuaranteeSlashes.UpdateOneAsync(
+            s => s.Id == slash.Id,
+            Builders<GuaranteeSlash>.Update
+                .Set(s => s.Status, GuaranteeSlashStatus.Applied)
+                .Set(s => s.CreditTransactionId, creditTransactionId)
+                .Set(s => s.AppliedAt, now));
+        slash.Status = GuaranteeSlashStatus.Applied;
+        slash.CreditTransactionId = creditTransactionId;
+        slash.AppliedAt = now;
+
+        _logger.LogInformation(
+            "Guarantee {GuaranteeId} of user {UserId} slashed by {Amount} and credited to user {BeneficiaryUserId}. Remaining: {Remaining}. Reference: {ReferenceId}",
+            slash.GuaranteeId,
+            slash.UserId,
+            slash.SlashedAmount,
+            slash.BeneficiaryUserId,
+            slash.RemainingAmount,
+            slash.ReferenceId);
+
+        await BestEffortSyncGuaranteeAmountAsync(slash.UserId, slash.RemainingAmount);
+    }
+
+    /// <summary>
+    /// Credits the slashed amount to the beneficiary once per slash and returns the transaction ID.
+    /// </summary>
+    private async Task<string> CreditGuaranteeSlashAsync(GuaranteeSlash slash)
+    {
+        var existing = await FindGuaranteeSlashCreditAsync(slash.Id);
+        if (existing != null)
+        {
+            return existing.Id;
+        }
+
+        try
+        {
+            return await _walletService.AddBalanceAsync(
+                slash.BeneficiaryUserId,
+                slash.SlashedAmount,
+                "Kompensasi slash jaminan validator",
+                TransactionType.GuaranteeSlash,
+                slash.Id,
+                "guarantee_slash"
+            );
+        }
+        catch (MongoWriteException ex) when (ex.WriteError.Category == ServerErrorCategory.DuplicateKey)
+        {
+            // A concurrent replay credited this slash first.
+            var raced = await FindGuaranteeSlashCreditAsync(slash.Id);
+            if (raced == null)
+            {
+                throw;
+            }
+            return raced.Id;
+        }
+    }
+
+    private async Task<Transaction?> FindGuaranteeSlashCreditAsync(string slashId)
+    {
+        return await _context.Transactions
+            .Find(t => t.ReferenceId == slashId && t.Type == TransactionType.GuaranteeSlash)
+            .FirstOrDefaultAsync();
+    }
+
     private async Task BestEffortRefundAsync(uint userId, long amount, string referenceId)
     {
         try
@@ -0,0 +1,144 @@
+using FeatureService.Api.Controllers.Finance;
+using FeatureService.Api.DTOs;
+using FeatureService.Api.Infrastructure.Auth;
+using FeatureService.Api.Models.Entities;
+using FeatureService.Api.Services;
+using FluentAssertions;
+using Microsoft.AspNetCore.Http;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using Moq;
+
+namespace FeatureService.Api.Tests.Controllers;
+
+public class GuaranteeSlashEndpointTests
+{
+    private const string ServiceToken = "test-service-token";
+
+    private static readonly SlashGuaranteeRequest Request = new()
+    {
+        UserId = 42,
+        BeneficiaryUserId = 7,
+        Amount = 50_000,
+        Reason = "Dispute Validation Case #7 dimenangkan pemilik kasus",
+        ReferenceId = "dsp_01H",
+        ReferenceType = "validation_case_dispute"
+    };
+
+    [Fact]
+    public async Task SlashGuarantee_WithoutValidServiceToken_ReturnsUnauthorized()
+    {
+        Environment.SetEnvironmentVariable("SERVICE_TOKEN", ServiceToken);
+
+        try
+        {
+            var guaranteeService = new Mock<IGuaranteeService>(MockBehavior.Strict);
+            var controller = BuildController(guaranteeService);
+
+            var result = await controller.SlashGuarantee(Request, "wrong-token", "guarantee-slash:dsp_01H");
+
+            var objectResult = result.Should().BeOfType<ObjectResult>().Subject;
+            objectResult.StatusCode.Should().Be(StatusCodes.Status401Unauthorized);
+            guaranteeService.VerifyNoOtherCalls();
+        }
+        finally
+        {
+            Environment.SetEnvironmentVariable("SERVICE_TOKEN", null);
+        }
+    }
+
+    [Fact]
+    public async Task SlashGuarantee_WithServiceToken_PassesIdempotencyKeyAndReturnsSlash()
+    {
+        Environment.SetEnvironmentVariable("SERVICE_TOKEN", ServiceToken);
+
+        try
+        {
+            var guaranteeService = new Mock<IGuaranteeService>(MockBehavior.Strict);
+            guaranteeService
+                .Setup(s => s.SlashGuaranteeAsync(
+                    "guarantee-slash:dsp_01H",
+                    42,
+                    7,
+                    50_000,
+                    Request.Reason,
+                    "dsp_01H",
+                    "validation_case_dispute"))
+                .ReturnsAsync((new GuaranteeSlash
+                {
+                    Id = "gsl_01",
+                    GuaranteeId = "grt_01",
+                    UserId = 42,
+                    BeneficiaryUserId = 7,
+                    Status = GuaranteeSlashStatus.Applied,
+                    SlashedAmount = 50_000,
+                    RemainingAmount = 450_000,
+                    CreditTransactionId = "txn_01"
+                }, true));
+
+            var controller = BuildController(guaranteeService);
+
+            var result = await controller.SlashGuarantee(Request, ServiceToken, "guarantee-slash:dsp_01H");
+
+            var okResult = result.Should().BeOfType<OkObjectResult>().Subject;
+            var response = okResult.Value.Should().BeOfType<ApiResponse<SlashGuaranteeResponse>>().Subject;
+            response.Data!.SlashId.Should().Be("gsl_01");
+            response.Data!.RemainingAmount.Should().Be(450_000);
+            response.Data!.Status.Should().Be(GuaranteeStatus.Active);
+            response.Data!.BeneficiaryUserId.Should().Be(7u);
+            response.Data!.CreditTransactionId.Should().Be("txn_01");
+            response.Data!.Replayed.Should().BeTrue();
+        }
+        finally
+        {
+            Environment.SetEnvironmentVariable("SERVICE_TOKEN", null);
+        }
+    }
+
+    [Fact]
+    public async Task SlashGuarantee_WhenIdempotencyKeyReusedForAnotherUser_ReturnsConflict()
+    {
+        Environment.SetEnvironmentVariable("SERVICE_TOKEN", ServiceToken);
+
+        try
+        {
+            var guaranteeService = new Mock<IGuaranteeService>(MockBehavior.Strict);
+            guaranteeService
+                .Setup(s => s.SlashGuaranteeAsync(
+                    It.IsAny<string>(),
+                    It.IsAny<uint>(),
+                    It.IsAny<uint>(),
+                    It.IsAny<long>(),
+                    It.IsAny<string>(),
+                    It.IsAny<string?>(),
+                    It.IsAny<string?>()))
+                .ThrowsAsync(new InvalidOperationException("Idempotency-Key sudah dipakai untuk slash jaminan lain"));
+
+            var controller = BuildController(guaranteeService);
+
+            var result = await controller.SlashGuarantee(Request, ServiceToken, "guarantee-slash:dsp_01H");
+
+            var objectResult = result.Should().BeOfType<ObjectResult>().Subject;
+            objectResult.StatusCode.Should().Be(StatusCodes.Status409Conflict);
+            var error = objectResult.Value.Should().BeOfType<ApiErrorResponse>().Subject;
+            error.Error.Code.Should().Be(ApiErrorCodes.Conflict);
+        }
+        finally
+        {
+            Environment.SetEnvironmentVariable("SERVICE_TOKEN", null);
+        }
+    }
+
+    private static GuaranteesController BuildController(Mock<IGuaranteeService> guaranteeService)
+    {
+        var controller = new GuaranteesController(
+            guaranteeService.Object,
+            new Mock<IUserContextAccessor>(MockBehavior.Strict).Object,
+            new Mock<ILogger<GuaranteesController>>(MockBehavior.Loose).Object);
+
+        var httpContext = new DefaultHttpContext();
+        httpContext.Items["RequestId"] = "req-guarantee-slash";
+        controller.ControllerContext = new ControllerContext { HttpContext = httpContext };
+        return controller;
+    }
+}
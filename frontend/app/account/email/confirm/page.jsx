@@ -0,0 +1,19 @@
+import { Suspense } from "react";
+import AuthPageLoading from "@/components/auth/AuthPageLoading";
+import EmailTokenAction from "@/components/account/EmailTokenAction";
+
+export const dynamic = "force-dynamic";
+
+export default function ConfirmEmailChangePage() {
+  return (
+    <Suspense fallback={<AuthPageLoading fullPage={false} message="Loading email confirmation" />}>
+      <EmailTokenAction
+        title="Confirm New Email"
+        description="Confirming your new email address..."
+        endpoint="/api/account/email/confirm"
+        autoSubmit
+        successLink={{ href: "/account", label: "Back to account" }}
+      />
+    </Suspense>
+  );
+}
@@ -0,0 +1,8 @@
+export const metadata = {
+  title: "Email Akun",
+  robots: { index: false, follow: false },
+};
+
+export default function AccountEmailLayout({ children }) {
+  return children;
+}
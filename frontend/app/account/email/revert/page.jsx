@@ -0,0 +1,20 @@
+import { Suspense } from "react";
+import AuthPageLoading from "@/components/auth/AuthPageLoading";
+import EmailTokenAction from "@/components/account/EmailTokenAction";
+
+export const dynamic = "force-dynamic";
+
+export default function RevertEmailChangePage() {
+  return (
+    <Suspense fallback={<AuthPageLoading fullPage={false} message="Loading email revert" />}>
+      <EmailTokenAction
+        title="Undo Email Change"
+        description="If you did not change your account email, restore the previous address. All sessions will be signed out."
+        endpoint="/api/account/email/revert"
+        actionLabel="Restore previous email"
+        actionVariant="destructive"
+        successLink={{ href: "/forgot-password", label: "Reset your password" }}
+      />
+    </Suspense>
+  );
+}
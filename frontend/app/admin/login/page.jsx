@@ -11,6 +11,8 @@ export default function AdminLoginPage() {
   const router = useRouter();
   const [email, setEmail] = useState("");
   const [password, setPassword] = useState("");
+  const [mfaToken, setMfaToken] = useState("");
+  const [code, setCode] = useState("");
   const [error, setError] = useState("");
   const [loading, setLoading] = useState(false);
 
@@ -20,18 +22,40 @@ export default function AdminLoginPage() {
     setLoading(true);
 
     try {
-      const data = await fetchJson("/admin/auth/login", {
-        method: "POST",
-        headers: { "Content-Type": "application/json" },
-        body: JSON.stringify({ email, password }),
-      });
+      const data = mfaToken
+        ? await fetchJson("/admin/auth/login/totp", {
+            method: "POST",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({ mfa_token: mfaToken, code }),
+          })
+        : await fetchJson("/admin/auth/login", {
+            method: "POST",
+            headers: { "Content-Type": "application/json" },
+            body: JSON.stringify({ email, password }),
+          });
+
+      // Password accepted; ask for the second factor
+      if (data.mfa_required) {
+        setMfaToken(data.mfa_token);
+        return;
+      }
 
       // Store admin token separately from user token
       setAdminSession(data.token, data.admin);
 
+      if (data.mfa_enrollment_required) {
+        setError("Akun admin belum memiliki 2FA. Daftarkan TOTP atau passkey sebelum mengakses dashboard.");
+        return;
+      }
+
       router.push("/admin");
     } catch (err) {
       setError(err?.message || "Login gagal");
+      if (mfaToken && err?.code === "ADMIN010") {
+        // Challenge expired; start over from the password step
+        setMfaToken("");
+        setCode("");
+      }
     } finally {
       setLoading(false);
     }
@@ -57,23 +81,39 @@ export default function AdminLoginPage() {
           )}
 
           <form onSubmit={handleSubmit} className="space-y-4">
-            <Input
-              type="email"
-              label="Email"
-              placeholder="admin@example.com"
-              value={email}
-              onChange={(e) => setEmail(e.target.value)}
-              required
-            />
+            {mfaToken ? (
+              <Input
+                type="text"
+                label="Kode Authenticator"
+                placeholder="123456"
+                inputMode="numeric"
+                autoComplete="one-time-code"
+                maxLength={6}
+                value={code}
+                onChange={(e) => setCode(e.target.value.replace(/\D/g, ""))}
+                required
+              />
+            ) : (
+              <>
+                <Input
+                  type="email"
+                  label="Email"
+                  placeholder="admin@example.com"
+                  value={email}
+                  onChange={(e) => setEmail(e.target.value)}
+                  required
+                />
 
-            <Input
-              type="password"
-              label="Password"
-              placeholder="••••••••"
-              value={password}
-              onChange={(e) => setPassword(e.target.value)}
-              required
-            />
+                <Input
+                  type="password"
+                  label="Password"
+                  placeholder="••••••••"
+                  value={password}
+                  onChange={(e) => setPassword(e.target.value)}
+                  required
+                />
+              </>
+            )}
 
             <Button
               type="submit"
@@ -81,7 +121,7 @@ export default function AdminLoginPage() {
               disabled={loading}
               className="w-full"
             >
-              {loading ? "Memproses..." : "Login"}
+              {loading ? "Memproses..." : mfaToken ? "Verifikasi" : "Login"}
             </Button>
           </form>
         </div>
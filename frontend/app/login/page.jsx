@@ -4,6 +4,7 @@ import { Suspense, useEffect, useState } from "react";
 import { useRouter, useSearchParams } from "next/navigation";
 import LoginCredentialsForm from "@/components/auth/LoginCredentialsForm";
 import LoginTotpForm from "@/components/auth/LoginTotpForm";
+import LoginStepUpForm from "@/components/auth/LoginStepUpForm";
 import AuthPageLoading from "@/components/auth/AuthPageLoading";
 import { fetchJson, getApiBase } from "@/lib/api";
 import { finalizeAuthSession } from "@/lib/authResponse";
@@ -45,6 +46,10 @@ function LoginContent() {
   const [totpCode, setTotpCode] = useState("");
   const [useBackupCode, setUseBackupCode] = useState(false);
 
+  // Risk step-up: the token ties the passkey or email code to this password login
+  const [stepUp, setStepUp] = useState(null);
+  const [stepUpCode, setStepUpCode] = useState("");
+
   const [webAuthnSupported, setWebAuthnSupported] = useState(false);
   const [passkeyLoading, setPasskeyLoading] = useState(false);
 
@@ -60,7 +65,9 @@ function LoginContent() {
     router.replace(redirectPath);
   };
 
-  async function onPasskeyLogin() {
+  // Without stepUpChallenge this is a usernameless passkey login; with it, the passkey
+  // completes the step-up of the password login that issued the challenge
+  async function onPasskeyLogin(stepUpChallenge = null) {
     if (!isWebAuthnSupported()) {
       setError(new Error("This browser does not support passkeys."));
       return;
@@ -72,12 +79,13 @@ function LoginContent() {
     try {
       const API = getApiBase();
       const deviceFingerprint = await getDeviceFingerprintWithTimeout(3000);
+      const challengeEmail = stepUpChallenge?.email || undefined;
 
       const beginRes = await fetch(`${API}/api/auth/passkeys/login/begin`, {
         method: "POST",
         credentials: "include",
         headers: { "Content-Type": "application/json" },
-        body: JSON.stringify({}),
+        body: JSON.stringify({ email: challengeEmail }),
       });
 
       if (!beginRes.ok) {
@@ -112,9 +120,11 @@ function LoginContent() {
         credentials: "include",
         headers: { "Content-Type": "application/json" },
         body: JSON.stringify({
+          email: challengeEmail,
           session_id: sessionId,
           credential: serializePublicKeyCredential(credential),
           device_fingerprint: deviceFingerprint,
+          step_up_token: stepUpChallenge?.token || undefined,
         }),
       });
 
@@ -163,6 +173,16 @@ function LoginContent() {
         return;
       }
 
+      if (data?.requires_step_up) {
+        setStepUp({
+          token: data?.step_up_token || "",
+          methods: data?.step_up_methods || [],
+          email: data?.user?.email || email,
+        });
+        setStepUpCode("");
+        return;
+      }
+
       finishAuthentication(data || {});
     } catch (err) {
       setError(err);
@@ -208,6 +228,64 @@ function LoginContent() {
     }
   }
 
+  async function onStepUpCodeSubmit(event) {
+    event.preventDefault();
+    setError(null);
+    setLoading(true);
+
+    try {
+      const deviceFingerprint = await getDeviceFingerprintWithTimeout(3000);
+
+      const response = await fetch(`${getApiBase()}/api/auth/login/step-up/email-code`, {
+        method: "POST",
+        credentials: "include",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({
+          step_up_token: stepUp?.token,
+          code: stepUpCode,
+          device_fingerprint: deviceFingerprint,
+        }),
+      });
+
+      if (!response.ok) {
+        await throwApiError(response, "Invalid verification code.");
+      }
+
+      const data = await readJsonSafe(response);
+      if (!data) {
+        throw new Error("Invalid verification code.");
+      }
+
+      finishAuthentication(data);
+    } catch (err) {
+      setError(err);
+    } finally {
+      setLoading(false);
+    }
+  }
+
+  if (stepUp) {
+    return (
+      <LoginStepUpForm
+        method={stepUp.methods.includes("passkey") ? "passkey" : "email_code"}
+        email={stepUp.email}
+        code={stepUpCode}
+        loading={loading}
+        passkeyLoading={passkeyLoading}
+        webAuthnSupported={webAuthnSupported}
+        error={error}
+        onCodeChange={setStepUpCode}
+        onSubmitCode={onStepUpCodeSubmit}
+        onUsePasskey={() => onPasskeyLogin(stepUp)}
+        onBackToLogin={() => {
+          setStepUp(null);
+          setStepUpCode("");
+          setError(null);
+        }}
+      />
+    );
+  }
+
   if (requiresTOTP) {
     return (
       <LoginTotpForm
@@ -246,7 +324,7 @@ function LoginContent() {
       onEmailChange={setEmail}
       onPasswordChange={setPassword}
       onSubmit={onSubmit}
-      onPasskeyLogin={onPasskeyLogin}
+      onPasskeyLogin={() => onPasskeyLogin()}
     />
   );
 }
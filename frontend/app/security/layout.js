@@ -0,0 +1,8 @@
+export const metadata = {
+  title: "Keamanan Akun",
+  robots: { index: false, follow: false },
+};
+
+export default function SecurityLayout({ children }) {
+  return children;
+}
@@ -0,0 +1,19 @@
+import { Suspense } from "react";
+import AuthPageLoading from "@/components/auth/AuthPageLoading";
+import EmailTokenAction from "@/components/account/EmailTokenAction";
+
+export const dynamic = "force-dynamic";
+
+export default function NotMePage() {
+  return (
+    <Suspense fallback={<AuthPageLoading fullPage={false} message="Loading account security" />}>
+      <EmailTokenAction
+        title="This Wasn't Me"
+        description="If you don't recognize this sign-in, sign out every session and lock your account. You will need to reset your password to sign in again."
+        endpoint="/api/account/security/not-me"
+        actionLabel="Sign out everywhere and lock account"
+        actionVariant="destructive"
+      />
+    </Suspense>
+  );
+}
@@ -49,6 +49,7 @@ function statusLabel(statusRaw) {
     offer_accepted: "Offer Accepted",
     funds_locked: "Funds Locked",
     artifact_submitted: "Under Owner Review",
+    milestones_in_progress: "Milestones In Progress",
     completed: "Concluded",
     disputed: "Disputed",
   };
@@ -148,18 +149,41 @@ function caseLogEventLabel(eventTypeRaw) {
     consultation_requested: "Permintaan konsultasi diajukan.",
     consultation_approved: "Permintaan konsultasi disetujui.",
     consultation_rejected: "Permintaan konsultasi ditolak.",
+    consultation_invitation_sent: "Pemilik kasus mengundang validator untuk konsultasi.",
+    consultation_invitation_accepted: "Undangan konsultasi diterima oleh validator.",
+    consultation_invitation_declined: "Undangan konsultasi ditolak oleh validator.",
+    consultation_invitation_revoked: "Undangan konsultasi dibatalkan oleh pemilik kasus.",
+    consultation_invitation_expired: "Undangan konsultasi kedaluwarsa.",
     owner_clarification_submitted: "Pemilik kasus memberikan klarifikasi.",
     assumption_mode_submitted: "Asumsi kerja diajukan untuk persetujuan pemilik kasus.",
+    clarification_posted: "Validator mengajukan klarifikasi kepada pemilik kasus.",
+    clarification_answered: "Pemilik kasus merespons klarifikasi.",
     case_status_changed: "Status kasus diperbarui.",
     case_resumed_from_owner_inactive: "Kasus dibuka kembali setelah respons pemilik diterima.",
     owner_response_sla_reminder: "Pengingat respons dikirim ke pemilik kasus.",
     owner_response_sla_expired: "Batas waktu respons pemilik kasus telah berakhir.",
     validator_released_without_penalty: "Validator dilepas tanpa penalti.",
+    guarantee_slash_scheduled: "Penalti jaminan validator dijadwalkan setelah dispute.",
+    guarantee_slash_skipped: "Penalti jaminan validator dilewati.",
+    guarantee_slash_appealed: "Validator mengajukan banding atas penalti jaminan.",
+    guarantee_slash_appeal_upheld: "Banding penalti jaminan ditolak admin.",
+    guarantee_slash_overturned: "Penalti jaminan dibatalkan admin.",
+    guarantee_slash_executed: "Penalti jaminan validator dieksekusi.",
+    guarantee_slash_failed: "Eksekusi penalti jaminan gagal; menunggu keputusan admin.",
+    guarantee_slash_retry_requested: "Admin meminta eksekusi ulang penalti jaminan.",
     contact_revealed: "Kontak privat dibuka untuk pihak terkait.",
+    contact_settings_changed: "Pengaturan kontak Telegram diperbarui.",
+    message_contact_leak_blocked: "Pesan berisi detail kontak langsung diblokir.",
     final_offer_submitted: "Final offer diajukan oleh validator.",
     final_offer_accepted: "Final offer diterima oleh pemilik kasus.",
     funds_locked: "Dana berhasil dikunci di escrow.",
     artifact_submitted: "Hasil validasi telah diserahkan.",
+    validator_delivery_sla_started: "Batas waktu penyerahan hasil validasi dimulai.",
+    validator_delivery_sla_reminder: "Pengingat batas waktu penyerahan dikirim ke validator.",
+    validator_delivery_sla_missed: "Validator melewati batas waktu penyerahan.",
+    validator_delivery_sla_escalated: "Keterlambatan penyerahan dieskalasi dengan rekomendasi refund atau dispute.",
+    validator_delivery_sla_cancelled: "Batas waktu penyerahan dihentikan.",
+    validator_delivery_late: "Hasil validasi diserahkan setelah batas waktu.",
     escrow_released_confirmed: "Dana escrow telah dirilis.",
     certified_artifact_issued: "Sertifikasi artefak diterbitkan.",
     dispute_attached: "Dispute diajukan.",
@@ -1125,7 +1149,7 @@ export default function ValidationCaseRecordPage({ initialCaseData = null }) {
                                   {Number(r.matching_score.total || 0)}/100
                                 </div>
                                 <div className="mt-1 text-[11px] text-muted-foreground">
-                                  D:{Number(r.matching_score.domain_fit || 0)} E:{Number(r.matching_score.evidence_fit || 0)} H:{Number(r.matching_score.history_dispute || 0)} R:{Number(r.matching_score.responsiveness_sla || 0)} S:{Number(r.matching_score.stake_guarantee || 0)}
+                                  D:{Number(r.matching_score.domain_fit || 0)} E:{Number(r.matching_score.evidence_fit || 0)} H:{Number(r.matching_score.history_dispute || 0)} R:{Number(r.matching_score.responsiveness_sla || 0)} S:{Number(r.matching_score.stake_guarantee || 0)} Rep:{Number(r.matching_score.reputation || 0)}
                                 </div>
                               </div>
                             ) : (
@@ -0,0 +1,84 @@
+"use client";
+
+import Link from "next/link";
+import { useCallback, useEffect, useRef, useState } from "react";
+import { useSearchParams } from "next/navigation";
+import { fetchJson } from "@/lib/api";
+import Button from "@/components/ui/Button";
+
+const STATUS_STYLES = {
+  idle: "bg-muted/40 border-border text-muted-foreground",
+  pending: "bg-warning/10 border-warning/20 text-warning",
+  success: "bg-success/10 border-success/20 text-success",
+  error: "bg-destructive/10 border-destructive/20 text-destructive",
+};
+
+// EmailTokenAction posts the ?token= from an emailed link to a token endpoint. With
+// autoSubmit the request is sent on load; otherwise the user confirms with a button, which
+// keeps link scanners from triggering actions that sign the user out.
+export default function EmailTokenAction({
+  title,
+  description,
+  endpoint,
+  actionLabel,
+  actionVariant = "primary",
+  autoSubmit = false,
+  successLink,
+}) {
+  const params = useSearchParams();
+  const token = params.get("token") || "";
+  const [status, setStatus] = useState(token ? "idle" : "error");
+  const [message, setMessage] = useState(token ? description : "The link is missing its token.");
+  const submitted = useRef(false);
+
+  const submit = useCallback(async () => {
+    if (!token || submitted.current) return;
+    submitted.current = true;
+    setStatus("pending");
+    setMessage("Processing...");
+
+    try {
+      const data = await fetchJson(endpoint, {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({ token }),
+      });
+      setStatus("success");
+      setMessage(data?.message || "Done.");
+    } catch (err) {
+      setStatus("error");
+      setMessage(err?.details || err?.message || "The link is invalid or has expired.");
+    }
+  }, [endpoint, token]);
+
+  useEffect(() => {
+    if (autoSubmit) submit();
+  }, [autoSubmit, submit]);
+
+  return (
+    <div className="w-full max-w-lg mx-auto">
+      <div className="space-y-3 rounded-lg border border-border bg-card p-6">
+        <h1 className="text-lg font-semibold text-foreground">{title}</h1>
+
+        <div className={`rounded-md border px-4 py-3 text-sm ${STATUS_STYLES[status] || STATUS_STYLES.idle}`}>
+          {message}
+        </div>
+
+        {!autoSubmit && status === "idle" && (
+          <Button type="button" variant={actionVariant} className="w-full" onClick={submit}>
+            {actionLabel}
+          </Button>
+        )}
+
+        {status === "success" && successLink && (
+          <Link
+            href={successLink.href}
+            className="inline-flex items-center gap-2 text-sm font-medium text-foreground underline"
+          >
+            {successLink.label} →
+          </Link>
+        )}
+      </div>
+    </div>
+  );
+}
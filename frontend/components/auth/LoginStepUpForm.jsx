@@ -0,0 +1,105 @@
+import ApiErrorAlert from "@/components/ApiErrorAlert";
+import {
+  AUTH_INPUT_CLASS,
+  AUTH_PRIMARY_BUTTON_CLASS,
+  AuthCard,
+  AuthContainer,
+  AuthField,
+  AuthHeader,
+} from "@/components/auth/AuthPrimitives";
+
+export default function LoginStepUpForm({
+  method,
+  email,
+  code,
+  loading,
+  passkeyLoading,
+  webAuthnSupported,
+  error,
+  onCodeChange,
+  onSubmitCode,
+  onUsePasskey,
+  onBackToLogin,
+}) {
+  const usePasskey = method === "passkey";
+
+  return (
+    <AuthContainer>
+      <AuthHeader
+        title="Confirm It's You"
+        description={
+          usePasskey
+            ? "This sign-in looks unusual. Confirm it with your passkey to continue."
+            : `This sign-in looks unusual. Enter the 6-digit code we sent to ${email}.`
+        }
+      />
+
+      <AuthCard>
+        {usePasskey ? (
+          <div className="space-y-4">
+            <ApiErrorAlert error={error} />
+
+            <button
+              type="button"
+              onClick={onUsePasskey}
+              disabled={passkeyLoading || !webAuthnSupported}
+              className={AUTH_PRIMARY_BUTTON_CLASS}
+            >
+              {passkeyLoading ? "Waiting for passkey..." : "Continue with passkey"}
+            </button>
+
+            {!webAuthnSupported && (
+              <p className="text-center text-sm text-muted-foreground">
+                This browser does not support passkeys. Sign in from a device that has your passkey.
+              </p>
+            )}
+
+            <div className="text-center">
+              <button
+                type="button"
+                onClick={onBackToLogin}
+                className="text-sm text-muted-foreground hover:text-foreground hover:underline"
+              >
+                Back to login
+              </button>
+            </div>
+          </div>
+        ) : (
+          <form className="space-y-4" onSubmit={onSubmitCode}>
+            <AuthField label="Verification Code" htmlFor="step-up-code">
+              <input
+                id="step-up-code"
+                type="text"
+                inputMode="numeric"
+                pattern="[0-9]*"
+                maxLength={6}
+                required
+                value={code}
+                onChange={(event) => onCodeChange(event.target.value.replace(/\D/g, ""))}
+                className={`${AUTH_INPUT_CLASS} font-mono text-center text-lg tracking-widest`}
+                placeholder="000000"
+                autoFocus
+              />
+            </AuthField>
+
+            <ApiErrorAlert error={error} />
+
+            <button type="submit" disabled={loading || code.length < 6} className={AUTH_PRIMARY_BUTTON_CLASS}>
+              {loading ? "Verifying..." : "Verify"}
+            </button>
+
+            <div className="text-center">
+              <button
+                type="button"
+                onClick={onBackToLogin}
+                className="text-sm text-muted-foreground hover:text-foreground hover:underline"
+              >
+                Back to login
+              </button>
+            </div>
+          </form>
+        )}
+      </AuthCard>
+    </AuthContainer>
+  );
+}
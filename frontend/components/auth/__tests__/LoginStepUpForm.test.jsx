@@ -0,0 +1,49 @@
+import { fireEvent, render, screen } from "@testing-library/react";
+import LoginStepUpForm from "../LoginStepUpForm";
+
+function renderForm(overrides = {}) {
+  const props = {
+    method: "email_code",
+    email: "user@example.com",
+    code: "",
+    loading: false,
+    passkeyLoading: false,
+    webAuthnSupported: true,
+    error: null,
+    onCodeChange: jest.fn(),
+    onSubmitCode: jest.fn((event) => event.preventDefault()),
+    onUsePasskey: jest.fn(),
+    onBackToLogin: jest.fn(),
+    ...overrides,
+  };
+
+  render(<LoginStepUpForm {...props} />);
+  return props;
+}
+
+describe("LoginStepUpForm", () => {
+  it("sanitizes non-digit input for the email code", () => {
+    const props = renderForm();
+
+    fireEvent.change(screen.getByLabelText("Verification Code"), {
+      target: { value: "12ab34" },
+    });
+
+    expect(props.onCodeChange).toHaveBeenCalledWith("1234");
+  });
+
+  it("disables verify action when code length is incomplete", () => {
+    renderForm({ code: "123" });
+
+    expect(screen.getByRole("button", { name: "Verify" })).toBeDisabled();
+  });
+
+  it("asks for the passkey when the challenge requires one", () => {
+    const props = renderForm({ method: "passkey" });
+
+    fireEvent.click(screen.getByRole("button", { name: "Continue with passkey" }));
+
+    expect(props.onUsePasskey).toHaveBeenCalled();
+    expect(screen.queryByLabelText("Verification Code")).not.toBeInTheDocument();
+  });
+});
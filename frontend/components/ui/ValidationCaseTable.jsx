@@ -22,6 +22,7 @@ function statusLabel(statusRaw) {
     offer_accepted: "Offer Accepted",
     funds_locked: "Funds Locked",
     artifact_submitted: "Artifact Submitted",
+    milestones_in_progress: "Milestones In Progress",
     completed: "Completed",
     disputed: "Disputed",
   };
@@ -40,6 +41,7 @@ function statusStyle(statusRaw) {
     case "waiting_owner_response":
       return "border-blue-200 bg-blue-50 text-blue-900";
     case "funds_locked":
+    case "milestones_in_progress":
       return "border-amber-200 bg-amber-50 text-amber-900";
     case "artifact_submitted":
       return "border-sky-200 bg-sky-50 text-sky-900";